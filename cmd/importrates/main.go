@@ -0,0 +1,75 @@
+// importrates は ECB 形式の為替レートファイル（XML または CSV）をデータベースに取り込むコマンド
+//
+// 使い方:
+//
+//	go run ./cmd/importrates -file eurofxref-hist.csv
+//	go run ./cmd/importrates -file eurofxref-daily.xml -format xml
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hata0/travel-api/internal/infrastructure/config"
+	"github.com/hata0/travel-api/internal/infrastructure/di"
+	"github.com/hata0/travel-api/internal/infrastructure/server"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+func main() {
+	path := flag.String("file", "", "取り込む為替レートファイルのパス (必須)")
+	format := flag.String("format", "", "ファイル形式 (xml, csv)。省略時は拡張子から判定する")
+	flag.Parse()
+
+	if *path == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(*path)), ".")
+	}
+
+	if err := run(*path, *format); err != nil {
+		slog.Error("Failed to import exchange rates", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run(path, format string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(server.SetupLogger(cfg.Log()))
+
+	container, err := di.NewFactory().CreateProductionContainer(cfg)
+	if err != nil {
+		return err
+	}
+	defer container.Close()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	result, err := container.ExchangeRateUsecase().Import(context.Background(), input.ImportExchangeRatesInput{
+		Format: format,
+		Data:   file,
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.Info("Exchange rates imported",
+		"imported", result.Imported,
+		"from_date", result.FromDate.Format("2006-01-02"),
+		"to_date", result.ToDate.Format("2006-01-02"),
+	)
+	return nil
+}
@@ -0,0 +1,50 @@
+// purgetrash は保持期間（TRASH_RETENTION_DAYS）を過ぎたゴミ箱の旅行を、関連するリソースと保存先に置いた添付ファイル・写真の中身とともに完全に削除するコマンド。
+// cron などから定期的に実行する
+//
+// 使い方:
+//
+//	go run ./cmd/purgetrash
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/hata0/travel-api/internal/infrastructure/config"
+	"github.com/hata0/travel-api/internal/infrastructure/di"
+	"github.com/hata0/travel-api/internal/infrastructure/server"
+)
+
+func main() {
+	if err := run(); err != nil {
+		slog.Error("Failed to purge expired trips", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(server.SetupLogger(cfg.Log()))
+
+	container, err := di.NewFactory().CreateProductionContainer(cfg)
+	if err != nil {
+		return err
+	}
+	defer container.Close()
+
+	result, err := container.TrashUsecase().PurgeExpired(context.Background())
+	if err != nil {
+		return err
+	}
+
+	slog.Info("Expired trips purged",
+		"purged", result.Count,
+		"trashed_before", result.Cutoff,
+		"retention_days", cfg.Trash().RetentionDays(),
+	)
+	return nil
+}
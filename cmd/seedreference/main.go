@@ -0,0 +1,52 @@
+// seedreference はバイナリに埋め込んだ空港・国・都市の参照データをデータベースに取り込むコマンド。
+// 既に同じコードのデータがある場合は上書きするため、何度実行してもよい
+//
+// 使い方:
+//
+//	go run ./cmd/seedreference
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	// 参照データのタイムゾーンを検証するため、OS にタイムゾーンデータがなくても動くよう埋め込む
+	_ "time/tzdata"
+
+	"github.com/hata0/travel-api/internal/infrastructure/config"
+	"github.com/hata0/travel-api/internal/infrastructure/di"
+	"github.com/hata0/travel-api/internal/infrastructure/server"
+)
+
+func main() {
+	if err := run(); err != nil {
+		slog.Error("Failed to seed reference data", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(server.SetupLogger(cfg.Log()))
+
+	container, err := di.NewFactory().CreateProductionContainer(cfg)
+	if err != nil {
+		return err
+	}
+	defer container.Close()
+
+	result, err := container.ReferenceUsecase().Seed(context.Background())
+	if err != nil {
+		return err
+	}
+
+	slog.Info("Reference data seeded",
+		"countries", result.Countries,
+		"airports", result.Airports,
+		"cities", result.Cities,
+	)
+	return nil
+}
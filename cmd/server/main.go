@@ -4,6 +4,8 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	// iCalendar の書き出しで IANA のタイムゾーンを扱うため、OS にタイムゾーンデータがなくても動くよう埋め込む
+	_ "time/tzdata"
 
 	"github.com/hata0/travel-api/internal/infrastructure/server"
 )
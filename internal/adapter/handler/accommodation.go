@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+type AccommodationHandler struct {
+	usecase usecase.AccommodationUsecase
+}
+
+func NewAccommodationHandler(usecase usecase.AccommodationUsecase) *AccommodationHandler {
+	return &AccommodationHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *AccommodationHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/accommodations/:accommodation_id", handler.get)
+	router.GET("/trips/:trip_id/accommodations", handler.list)
+	router.POST("/trips/:trip_id/accommodations", handler.create)
+	router.PUT("/trips/:trip_id/accommodations/:accommodation_id", handler.update)
+	router.DELETE("/trips/:trip_id/accommodations/:accommodation_id", handler.delete)
+}
+
+func (handler *AccommodationHandler) get(c *gin.Context) {
+	var uriParams validator.AccommodationURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	accommodationOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID, uriParams.AccommodationID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetAccommodationResponse(accommodationOutput))
+}
+
+func (handler *AccommodationHandler) list(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	accommodationsOutput, err := handler.usecase.List(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListAccommodationResponse(accommodationsOutput))
+}
+
+func (handler *AccommodationHandler) create(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.CreateAccommodationJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	createdAccommodation, err := handler.usecase.Create(c.Request.Context(), input.CreateAccommodationInput{
+		TripID:             uriParams.TripID,
+		Name:               body.Name,
+		Address:            body.Address,
+		Timezone:           body.Timezone,
+		CheckInAt:          body.CheckInAt,
+		CheckOutAt:         body.CheckOutAt,
+		ConfirmationNumber: body.ConfirmationNumber,
+		CostAmount:         body.CostAmount,
+		CostCurrency:       body.CostCurrency,
+		Notes:              body.Notes,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateAccommodationResponse{ID: createdAccommodation.ID})
+}
+
+func (handler *AccommodationHandler) update(c *gin.Context) {
+	var uriParams validator.AccommodationURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.UpdateAccommodationJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Update(c.Request.Context(), input.UpdateAccommodationInput{
+		ID:                 uriParams.AccommodationID,
+		TripID:             uriParams.TripID,
+		Name:               body.Name,
+		Address:            body.Address,
+		Timezone:           body.Timezone,
+		CheckInAt:          body.CheckInAt,
+		CheckOutAt:         body.CheckOutAt,
+		ConfirmationNumber: body.ConfirmationNumber,
+		CostAmount:         body.CostAmount,
+		CostCurrency:       body.CostCurrency,
+		Notes:              body.Notes,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *AccommodationHandler) delete(c *gin.Context) {
+	var uriParams validator.AccommodationURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Delete(c.Request.Context(), uriParams.TripID, uriParams.AccommodationID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	accommodationTestTripID = "00000000-0000-0000-0000-000000000001"
+	accommodationTestID     = "00000000-0000-0000-0000-000000000002"
+)
+
+func setupAccommodationHandler(t *testing.T) (*gin.Engine, *mock_handler.MockAccommodationUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockAccommodationUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewAccommodationHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestAccommodationHandler_Get(t *testing.T) {
+	r, mockUsecase := setupAccommodationHandler(t)
+
+	now := time.Now()
+	expectedOutput := &output.GetAccommodationOutput{
+		Accommodation: &output.Accommodation{
+			ID:           accommodationTestID,
+			TripID:       accommodationTestTripID,
+			Name:         "Test Hotel",
+			CheckInAt:    now,
+			CheckOutAt:   now.Add(20 * time.Hour),
+			CostAmount:   12000,
+			CostCurrency: "JPY",
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		},
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), accommodationTestTripID, accommodationTestID).Return(expectedOutput, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+accommodationTestTripID+"/accommodations/"+accommodationTestID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resBody presenter.GetAccommodationResponse
+		err := json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedOutput.Accommodation.ID, resBody.Accommodation.ID)
+		assert.Equal(t, expectedOutput.Accommodation.Name, resBody.Accommodation.Name)
+		assert.Equal(t, expectedOutput.Accommodation.CostCurrency, resBody.Accommodation.CostCurrency)
+		assert.WithinDuration(t, expectedOutput.Accommodation.CheckInAt, resBody.Accommodation.CheckInAt, time.Second)
+	})
+
+	t.Run("異常系: 宿泊予約が存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Get(gomock.Any(), accommodationTestTripID, accommodationTestID).
+			Return(nil, accommodation.NewAccommodationNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+accommodationTestTripID+"/accommodations/"+accommodationTestID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestAccommodationHandler_List(t *testing.T) {
+	r, mockUsecase := setupAccommodationHandler(t)
+
+	t.Run("正常系: 宿泊予約のない夜が返される", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any(), accommodationTestTripID).Return(&output.ListAccommodationOutput{
+			Accommodations:  []*output.Accommodation{},
+			UncoveredNights: []time.Time{time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+accommodationTestTripID+"/accommodations", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.ListAccommodationResponse
+		err := json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.NoError(t, err)
+		assert.Empty(t, resBody.Accommodations)
+		assert.Equal(t, []string{"2024-05-01"}, resBody.UncoveredNights)
+	})
+
+	t.Run("異常系: Internal server error", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any(), accommodationTestTripID).Return(nil, errors.New("some error"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+accommodationTestTripID+"/accommodations", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestAccommodationHandler_Create(t *testing.T) {
+	r, mockUsecase := setupAccommodationHandler(t)
+
+	checkInAt := time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC)
+	checkOutAt := time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)
+	requestBody := gin.H{
+		"name":          "New Hotel",
+		"timezone":      "Asia/Tokyo",
+		"check_in_at":   checkInAt.Format(time.RFC3339),
+		"check_out_at":  checkOutAt.Format(time.RFC3339),
+		"cost_amount":   12000,
+		"cost_currency": "JPY",
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Create(gomock.Any(), input.CreateAccommodationInput{
+			TripID:       accommodationTestTripID,
+			Name:         "New Hotel",
+			Timezone:     "Asia/Tokyo",
+			CheckInAt:    checkInAt,
+			CheckOutAt:   checkOutAt,
+			CostAmount:   12000,
+			CostCurrency: "JPY",
+		}).Return(&output.CreateAccommodationOutput{ID: accommodationTestID}, nil)
+
+		body, _ := json.Marshal(requestBody)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+accommodationTestTripID+"/accommodations", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody presenter.CreateAccommodationResponse
+		err := json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.NoError(t, err)
+		assert.Equal(t, accommodationTestID, resBody.ID)
+	})
+
+	t.Run("異常系: チェックアウトがチェックインより前", func(t *testing.T) {
+		invalid := gin.H{}
+		for k, v := range requestBody {
+			invalid[k] = v
+		}
+		invalid["check_out_at"] = checkInAt.Add(-time.Hour).Format(time.RFC3339)
+
+		body, _ := json.Marshal(invalid)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+accommodationTestTripID+"/accommodations", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var resBody map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "VALIDATION_ERROR", resBody["code"])
+	})
+}
+
+func TestAccommodationHandler_Delete(t *testing.T) {
+	r, mockUsecase := setupAccommodationHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Delete(gomock.Any(), accommodationTestTripID, accommodationTestID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+accommodationTestTripID+"/accommodations/"+accommodationTestID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系: Internal server error", func(t *testing.T) {
+		mockUsecase.EXPECT().Delete(gomock.Any(), accommodationTestTripID, accommodationTestID).Return(errors.New("some error"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+accommodationTestTripID+"/accommodations/"+accommodationTestID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// AttachmentHandler は旅行や予約・支出に添付するファイル（搭乗券・宿泊バウチャーなど）のアップロードとダウンロードを提供する
+type AttachmentHandler struct {
+	usecase       usecase.AttachmentUsecase
+	maxUploadSize int64
+}
+
+// NewAttachmentHandler は添付ファイルのハンドラを作成する。maxUploadSize はアップロードできるファイル1件あたりの最大サイズ
+func NewAttachmentHandler(usecase usecase.AttachmentUsecase, maxUploadSize int64) *AttachmentHandler {
+	return &AttachmentHandler{
+		usecase:       usecase,
+		maxUploadSize: maxUploadSize,
+	}
+}
+
+func (handler *AttachmentHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/attachments/:attachment_id", handler.get)
+	router.GET("/trips/:trip_id/attachments/:attachment_id/download", handler.download)
+	router.GET("/trips/:trip_id/attachments", handler.list)
+	router.POST("/trips/:trip_id/attachments", handler.upload)
+	router.DELETE("/trips/:trip_id/attachments/:attachment_id", handler.delete)
+}
+
+func (handler *AttachmentHandler) get(c *gin.Context) {
+	var uriParams validator.AttachmentURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	attachmentOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID, uriParams.AttachmentID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetAttachmentResponse(attachmentOutput))
+}
+
+func (handler *AttachmentHandler) list(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var queryParams validator.ListAttachmentQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	attachmentsOutput, err := handler.usecase.List(c.Request.Context(), input.ListAttachmentInput{
+		TripID:     uriParams.TripID,
+		TargetType: queryParams.TargetType,
+		TargetID:   queryParams.TargetID,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListAttachmentResponse(attachmentsOutput))
+}
+
+func (handler *AttachmentHandler) upload(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.UploadAttachmentFormBody
+	cleanup, ok := bindMultipartWithLimit(c, handler.maxUploadSize, &body)
+	if !ok {
+		return
+	}
+	defer cleanup()
+
+	file, err := body.File.Open()
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+	defer file.Close()
+
+	uploadedOutput, err := handler.usecase.Upload(c.Request.Context(), input.UploadAttachmentInput{
+		TripID:     uriParams.TripID,
+		TargetType: body.TargetType,
+		TargetID:   body.TargetID,
+		Filename:   body.File.Filename,
+		Content:    file,
+		Size:       body.File.Size,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.NewUploadAttachmentResponse(uploadedOutput))
+}
+
+func (handler *AttachmentHandler) download(c *gin.Context) {
+	var uriParams validator.AttachmentURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	downloadOutput, err := handler.usecase.Download(c.Request.Context(), uriParams.TripID, uriParams.AttachmentID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+	defer downloadOutput.Content.Close()
+
+	a := downloadOutput.Attachment
+	writeFile(c, "attachment", a.Filename, a.ContentType, a.Size, downloadOutput.Content)
+}
+
+func (handler *AttachmentHandler) delete(c *gin.Context) {
+	var uriParams validator.AttachmentURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	if err := handler.usecase.Delete(c.Request.Context(), uriParams.TripID, uriParams.AttachmentID); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
@@ -0,0 +1,259 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	attachmentTestTripID          = "00000000-0000-0000-0000-000000000001"
+	attachmentTestAttachmentID    = "00000000-0000-0000-0000-000000000002"
+	attachmentTestAccommodationID = "00000000-0000-0000-0000-000000000003"
+	// attachmentTestMaxUploadSize はテストで使うアップロードの最大サイズ
+	attachmentTestMaxUploadSize = 1024
+)
+
+func setupAttachmentHandler(t *testing.T) (*gin.Engine, *mock_handler.MockAttachmentUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockAttachmentUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewAttachmentHandler(mockUsecase, attachmentTestMaxUploadSize).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func newAttachmentTestOutput(filename string) *output.Attachment {
+	return &output.Attachment{
+		ID:          attachmentTestAttachmentID,
+		TripID:      attachmentTestTripID,
+		TargetType:  "accommodation",
+		TargetID:    attachmentTestAccommodationID,
+		Filename:    filename,
+		ContentType: "application/pdf",
+		Size:        int64(len("%PDF-1.7")),
+		UploadedBy:  "00000000-0000-0000-0000-000000000009",
+		CreatedAt:   time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+// newMultipartRequest はファイルとフォームの値を multipart/form-data で送るリクエストを作成する
+func newMultipartRequest(t *testing.T, url string, fields map[string]string, filename string, content []byte) *http.Request {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for k, v := range fields {
+		require.NoError(t, w.WriteField(k, v))
+	}
+	if filename != "" {
+		part, err := w.CreateFormFile("file", filename)
+		require.NoError(t, err)
+		_, err = part.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	req, err := http.NewRequest("POST", url, &buf)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return req
+}
+
+func TestAttachmentHandler_Upload(t *testing.T) {
+	r, mockUsecase := setupAttachmentHandler(t)
+	url := "/trips/" + attachmentTestTripID + "/attachments"
+
+	t.Run("正常系: ファイルの中身とフォームの値がユースケースに渡される", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Upload(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in input.UploadAttachmentInput) (*output.UploadAttachmentOutput, error) {
+				assert.Equal(t, attachmentTestTripID, in.TripID)
+				assert.Equal(t, "accommodation", in.TargetType)
+				assert.Equal(t, attachmentTestAccommodationID, in.TargetID)
+				assert.Equal(t, "voucher.pdf", in.Filename)
+				assert.Equal(t, int64(8), in.Size)
+				content, err := io.ReadAll(in.Content)
+				require.NoError(t, err)
+				assert.Equal(t, "%PDF-1.7", string(content))
+				return &output.UploadAttachmentOutput{Attachment: newAttachmentTestOutput("voucher.pdf")}, nil
+			})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, map[string]string{
+			"target_type": "accommodation",
+			"target_id":   attachmentTestAccommodationID,
+		}, "voucher.pdf", []byte("%PDF-1.7")))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody map[string]map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, "voucher.pdf", resBody["attachment"]["filename"])
+		assert.Equal(t, "application/pdf", resBody["attachment"]["content_type"])
+		assert.Equal(t, "2024-05-01T09:00:00Z", resBody["attachment"]["created_at"])
+	})
+
+	t.Run("異常系: 最大サイズを大きく超えるリクエストは 413 になる", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, map[string]string{"target_type": "trip"}, "large.pdf", bytes.Repeat([]byte("x"), attachmentTestMaxUploadSize+multipartOverhead)))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Contains(t, w.Body.String(), apperr.CodePayloadTooLarge)
+	})
+
+	t.Run("異常系: ユースケースが最大サイズ超過を返すと 413 になる", func(t *testing.T) {
+		mockUsecase.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(nil, attachment.NewFileTooLargeError())
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, map[string]string{"target_type": "trip"}, "large.pdf", []byte("%PDF-1.7")))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("異常系: 許可されていない形式は 415 になる", func(t *testing.T) {
+		mockUsecase.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(nil, attachment.NewUnsupportedContentTypeError())
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, map[string]string{"target_type": "trip"}, "script.pdf", []byte("<script>")))
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+
+	t.Run("異常系: ファイルがない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, map[string]string{"target_type": "trip"}, "", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: multipart/form-data でない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", url, strings.NewReader(`{"target_type":"trip"}`))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+}
+
+func TestAttachmentHandler_Download(t *testing.T) {
+	r, mockUsecase := setupAttachmentHandler(t)
+	url := "/trips/" + attachmentTestTripID + "/attachments/" + attachmentTestAttachmentID + "/download"
+
+	t.Run("正常系: 中身と添付ファイルとしてのヘッダーを返す", func(t *testing.T) {
+		mockUsecase.EXPECT().Download(gomock.Any(), attachmentTestTripID, attachmentTestAttachmentID).Return(&output.DownloadAttachmentOutput{
+			Attachment: newAttachmentTestOutput("voucher.pdf"),
+			Content:    io.NopCloser(strings.NewReader("%PDF-1.7")),
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "%PDF-1.7", w.Body.String())
+		assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+		assert.Equal(t, "8", w.Header().Get("Content-Length"))
+		assert.Equal(t, "attachment; filename=voucher.pdf", w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+	})
+
+	t.Run("正常系: 日本語のファイル名は RFC 2231 形式でエンコードされる", func(t *testing.T) {
+		mockUsecase.EXPECT().Download(gomock.Any(), attachmentTestTripID, attachmentTestAttachmentID).Return(&output.DownloadAttachmentOutput{
+			Attachment: newAttachmentTestOutput("搭乗券.pdf"),
+			Content:    io.NopCloser(strings.NewReader("%PDF-1.7")),
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "attachment; filename*=utf-8''%E6%90%AD%E4%B9%97%E5%88%B8.pdf", w.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("異常系: 添付ファイルが存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().Download(gomock.Any(), attachmentTestTripID, attachmentTestAttachmentID).Return(nil, attachment.NewAttachmentNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Empty(t, w.Header().Get("Content-Disposition"))
+	})
+}
+
+func TestAttachmentHandler_List(t *testing.T) {
+	r, mockUsecase := setupAttachmentHandler(t)
+
+	t.Run("正常系: 添付先で絞り込める", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any(), input.ListAttachmentInput{
+			TripID:     attachmentTestTripID,
+			TargetType: "accommodation",
+			TargetID:   attachmentTestAccommodationID,
+		}).Return(&output.ListAttachmentOutput{Attachments: []*output.Attachment{newAttachmentTestOutput("voucher.pdf")}}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+attachmentTestTripID+"/attachments?target_type=accommodation&target_id="+attachmentTestAccommodationID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody map[string][]map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody["attachments"], 1)
+		assert.Equal(t, attachmentTestAccommodationID, resBody["attachments"][0]["target_id"])
+	})
+
+	t.Run("異常系: 予約の指定で target_id がない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+attachmentTestTripID+"/attachments?target_type=accommodation", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAttachmentHandler_Delete(t *testing.T) {
+	r, mockUsecase := setupAttachmentHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Delete(gomock.Any(), attachmentTestTripID, attachmentTestAttachmentID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+attachmentTestTripID+"/attachments/"+attachmentTestAttachmentID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系: 権限がない", func(t *testing.T) {
+		mockUsecase.EXPECT().Delete(gomock.Any(), attachmentTestTripID, attachmentTestAttachmentID).Return(apperr.NewForbiddenError("forbidden"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+attachmentTestTripID+"/attachments/"+attachmentTestAttachmentID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -55,7 +55,7 @@ func (handler *AuthHandler) login(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, presenter.AuthTokenResponse{
-		Token:        output.Token,
+		Token:        output.AccessToken,
 		RefreshToken: output.RefreshToken,
 	})
 }
@@ -74,7 +74,7 @@ func (handler *AuthHandler) refresh(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, presenter.AuthTokenResponse{
-		Token:        output.Token,
+		Token:        output.AccessToken,
 		RefreshToken: output.RefreshToken,
 	})
 }
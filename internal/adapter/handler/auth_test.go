@@ -31,7 +31,7 @@ func TestAuthHandler_Register(t *testing.T) {
 	userID := "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
 
 	t.Run("正常系: ユーザー登録が成功する", func(t *testing.T) {
-		expectedOutput := output.RegisterOutput{UserID: userID}
+		expectedOutput := &output.RegisterOutput{UserID: userID}
 		mockUsecase.EXPECT().Register(gomock.Any(), username, email, password).Return(expectedOutput, nil).Times(1)
 
 		body, _ := json.Marshal(gin.H{
@@ -83,7 +83,7 @@ func TestAuthHandler_Login(t *testing.T) {
 	token := "mock_jwt_token"
 
 	t.Run("正常系: ユーザーログインが成功する", func(t *testing.T) {
-		expectedOutput := output.TokenPairOutput{Token: token}
+		expectedOutput := &output.TokenPairOutput{AccessToken: token}
 		mockUsecase.EXPECT().Login(gomock.Any(), email, password).Return(expectedOutput, nil).Times(1)
 
 		body, _ := json.Marshal(gin.H{
@@ -117,7 +117,7 @@ func TestAuthHandler_Refresh(t *testing.T) {
 	newRefreshToken := "new_mock_refresh_token"
 
 	t.Run("正常系: リフレッシュトークンが有効で、新しいアクセストークンとリフレッシュトークンが返される", func(t *testing.T) {
-		expectedOutput := output.TokenPairOutput{Token: newAccessToken, RefreshToken: newRefreshToken}
+		expectedOutput := &output.TokenPairOutput{AccessToken: newAccessToken, RefreshToken: newRefreshToken}
 		mockUsecase.EXPECT().VerifyRefreshToken(gomock.Any(), refreshToken).Return(expectedOutput, nil).Times(1)
 
 		body, _ := json.Marshal(gin.H{
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/domain/bookingimport"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// maxBookingFileSize は取り込める確認ファイルの最大サイズ。添付ファイル付きの確認メールを想定している
+const maxBookingFileSize = 10 << 20
+
+// BookingImportHandler は予約の確認ファイルの取り込みを提供する
+type BookingImportHandler struct {
+	usecase usecase.BookingImportUsecase
+}
+
+func NewBookingImportHandler(usecase usecase.BookingImportUsecase) *BookingImportHandler {
+	return &BookingImportHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *BookingImportHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.POST("/trips/:trip_id/import", handler.importBookings)
+}
+
+func (handler *BookingImportHandler) importBookings(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var queryParams validator.ImportBookingsQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxBookingFileSize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(presenter.ConvertToHTTPError(bookingimport.NewFileTooLargeError()))
+			return
+		}
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	dryRun := true
+	if queryParams.DryRun != nil {
+		dryRun = *queryParams.DryRun
+	}
+
+	importOutput, err := handler.usecase.Import(c.Request.Context(), input.ImportBookingsInput{
+		TripID:   uriParams.TripID,
+		Format:   queryParams.Format,
+		Data:     bytes.NewReader(data),
+		DryRun:   dryRun,
+		Keys:     queryParams.Keys,
+		Currency: queryParams.Currency,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewImportBookingsResponse(importOutput))
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/domain/bookingimport"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupBookingImportHandler(t *testing.T) (*gin.Engine, *mock_handler.MockBookingImportUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockBookingImportUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewBookingImportHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestBookingImportHandler_Import(t *testing.T) {
+	r, mockUsecase := setupBookingImportHandler(t)
+
+	startAt := time.Date(2026, 3, 7, 6, 0, 0, 0, time.UTC)
+	endAt := time.Date(2026, 3, 9, 1, 0, 0, 0, time.UTC)
+	amount := int64(45000)
+
+	t.Run("正常系: dry_run を省略するとプレビューになる", func(t *testing.T) {
+		const body = "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"
+		mockUsecase.EXPECT().Import(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, in input.ImportBookingsInput) (*output.ImportBookingsOutput, error) {
+				assert.Equal(t, "trip-id", in.TripID)
+				assert.Equal(t, "ics", in.Format)
+				assert.True(t, in.DryRun)
+				assert.Empty(t, in.Keys)
+				data, err := io.ReadAll(in.Data)
+				require.NoError(t, err)
+				assert.Equal(t, body, string(data), "リクエストボディがそのまま渡されるべき")
+
+				return &output.ImportBookingsOutput{
+					DryRun: true,
+					Drafts: []*output.BookingDraft{
+						{
+							Key: "ABC", Kind: "lodging", Status: "ready", Title: "Hotel", StartAt: &startAt, EndAt: &endAt,
+							ConfirmationNumber: "ABC", PriceAmount: &amount, PriceCurrency: "JPY",
+						},
+						{
+							Key: "XYZ", Kind: "transport", Status: "ready", Title: "NH21",
+							Transport: &bookingimport.Transport{Mode: transport.ModeFlight, Carrier: "NH", Number: "21", Departure: "HND", Arrival: "ITM"},
+						},
+					},
+				}, nil
+			})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/trip-id/import?format=ics", strings.NewReader(body))
+		req.Header.Set("Content-Type", "text/calendar")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var res map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, true, res["dry_run"])
+		drafts := res["drafts"].([]any)
+		require.Len(t, drafts, 2)
+		assert.Equal(t, map[string]any{
+			"key":                 "ABC",
+			"kind":                "lodging",
+			"status":              "ready",
+			"title":               "Hotel",
+			"location":            "",
+			"start_at":            "2026-03-07T06:00:00Z",
+			"end_at":              "2026-03-09T01:00:00Z",
+			"confirmation_number": "ABC",
+			"price_amount":        float64(45000),
+			"price_currency":      "JPY",
+			"notes":               "",
+			"transport":           nil,
+			"accommodation_id":    nil,
+			"transport_leg_id":    nil,
+			"activity_id":         nil,
+		}, drafts[0])
+		assert.Equal(t, map[string]any{
+			"mode":      "flight",
+			"carrier":   "NH",
+			"number":    "21",
+			"departure": "HND",
+			"arrival":   "ITM",
+		}, drafts[1].(map[string]any)["transport"])
+		assert.Nil(t, drafts[1].(map[string]any)["price_currency"])
+	})
+
+	t.Run("正常系: 保存する下書きのキーを指定して取り込む", func(t *testing.T) {
+		mockUsecase.EXPECT().Import(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, in input.ImportBookingsInput) (*output.ImportBookingsOutput, error) {
+				assert.False(t, in.DryRun)
+				assert.Equal(t, []string{"ABC", "DEF"}, in.Keys)
+				assert.Equal(t, "JPY", in.Currency)
+				return &output.ImportBookingsOutput{
+					Drafts: []*output.BookingDraft{
+						{Key: "ABC", Kind: "lodging", Status: "ready", AccommodationID: "acc-id"},
+						{Key: "DEF", Kind: "activity", Status: "ready", ActivityID: "activity-id"},
+					},
+				}, nil
+			})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/trip-id/import?format=eml&dry_run=false&keys=ABC&keys=DEF&currency=JPY", strings.NewReader("From: a@example.com\r\n\r\n"))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var res map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, false, res["dry_run"])
+		drafts := res["drafts"].([]any)
+		assert.Equal(t, "acc-id", drafts[0].(map[string]any)["accommodation_id"])
+		assert.Equal(t, "activity-id", drafts[1].(map[string]any)["activity_id"])
+		assert.Nil(t, drafts[1].(map[string]any)["accommodation_id"])
+	})
+
+	t.Run("異常系: 未対応の形式", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/trip-id/import?format=pdf", strings.NewReader("%PDF"))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: 上限を超えるファイル", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/trip-id/import?format=ics", strings.NewReader(strings.Repeat("a", maxBookingFileSize+1)))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("異常系: ファイルを解析できない", func(t *testing.T) {
+		mockUsecase.EXPECT().Import(gomock.Any(), gomock.Any()).
+			Return(nil, apperr.NewValidationError("Failed to parse booking file"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/trip-id/import?format=ics", strings.NewReader("broken"))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+type BudgetHandler struct {
+	usecase usecase.BudgetUsecase
+}
+
+func NewBudgetHandler(usecase usecase.BudgetUsecase) *BudgetHandler {
+	return &BudgetHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *BudgetHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/budget", handler.get)
+	router.PUT("/trips/:trip_id/budget", handler.save)
+	router.DELETE("/trips/:trip_id/budget", handler.delete)
+	router.GET("/trips/:trip_id/budget/summary", handler.summary)
+}
+
+func (handler *BudgetHandler) get(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	budgetOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetBudgetResponse(budgetOutput))
+}
+
+func (handler *BudgetHandler) save(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.SaveBudgetJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Save(c.Request.Context(), input.SaveBudgetInput{
+		TripID:         uriParams.TripID,
+		Currency:       body.Currency,
+		CategoryLimits: body.CategoryLimits,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *BudgetHandler) delete(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Delete(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *BudgetHandler) summary(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	summaryOutput, err := handler.usecase.Summary(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetBudgetSummaryResponse(summaryOutput))
+}
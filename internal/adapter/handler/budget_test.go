@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const budgetTestTripID = "00000000-0000-0000-0000-000000000001"
+
+func setupBudgetHandler(t *testing.T) (*gin.Engine, *mock_handler.MockBudgetUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockBudgetUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewBudgetHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestBudgetHandler_Save(t *testing.T) {
+	r, mockUsecase := setupBudgetHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Save(gomock.Any(), input.SaveBudgetInput{
+			TripID:         budgetTestTripID,
+			Currency:       "JPY",
+			CategoryLimits: map[string]int64{"food": 30000},
+		}).Return(nil)
+
+		body, _ := json.Marshal(gin.H{"currency": "JPY", "category_limits": gin.H{"food": 30000}})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", "/trips/"+budgetTestTripID+"/budget", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系: 不正なカテゴリ", func(t *testing.T) {
+		body, _ := json.Marshal(gin.H{"currency": "JPY", "category_limits": gin.H{"souvenir": 30000}})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", "/trips/"+budgetTestTripID+"/budget", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestBudgetHandler_Summary(t *testing.T) {
+	r, mockUsecase := setupBudgetHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		remaining := output.Money{Amount: -1000, Decimal: "-1000", Currency: "JPY"}
+		limit := output.Money{Amount: 10000, Decimal: "10000", Currency: "JPY"}
+		mockUsecase.EXPECT().Summary(gomock.Any(), budgetTestTripID).Return(&output.GetBudgetSummaryOutput{
+			Currency: "JPY",
+			Categories: []*output.BudgetCategorySummary{
+				{
+					Category:  "food",
+					Spent:     output.Money{Amount: 11000, Decimal: "11000", Currency: "JPY"},
+					Limit:     &limit,
+					Remaining: &remaining,
+				},
+			},
+			TotalLimit:  limit,
+			TotalSpent:  output.Money{Amount: 11000, Decimal: "11000", Currency: "JPY"},
+			Unconverted: []output.Money{{Amount: 1500, Decimal: "15.00", Currency: "USD"}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+budgetTestTripID+"/budget/summary", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.GetBudgetSummaryResponse
+		err := json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "JPY", resBody.Currency)
+		assert.Len(t, resBody.Categories, 1)
+		assert.Equal(t, int64(-1000), resBody.Categories[0].Remaining.Amount)
+		assert.Equal(t, "15.00", resBody.Unconverted[0].Decimal)
+	})
+
+	t.Run("異常系: 予算が存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().Summary(gomock.Any(), budgetTestTripID).Return(nil, budget.NewBudgetNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+budgetTestTripID+"/budget/summary", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
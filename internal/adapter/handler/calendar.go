@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// calendarContentType は iCalendar 形式のレスポンスの Content-Type
+const calendarContentType = "text/calendar; charset=utf-8"
+
+// CalendarHandler は旅行の iCalendar 書き出しと、実行ユーザーのカレンダーフィードの管理を提供する
+type CalendarHandler struct {
+	usecase usecase.CalendarUsecase
+}
+
+func NewCalendarHandler(usecase usecase.CalendarUsecase) *CalendarHandler {
+	return &CalendarHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *CalendarHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/calendar.ics", handler.exportTrip)
+	router.GET("/calendar-feed", handler.getFeed)
+	router.POST("/calendar-feed", handler.issueFeed)
+	router.DELETE("/calendar-feed", handler.revokeFeed)
+}
+
+func (handler *CalendarHandler) exportTrip(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var queryParams validator.CalendarQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	calendarOutput, err := handler.usecase.ExportTrip(c.Request.Context(), input.ExportTripCalendarInput{
+		TripID:   uriParams.TripID,
+		TimeZone: queryParams.TimeZone,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	writeFile(c, "attachment", calendarOutput.Filename, calendarContentType, int64(len(calendarOutput.Content)), bytes.NewReader(calendarOutput.Content))
+}
+
+func (handler *CalendarHandler) getFeed(c *gin.Context) {
+	feedOutput, err := handler.usecase.GetFeed(c.Request.Context())
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetCalendarFeedResponse(feedOutput))
+}
+
+func (handler *CalendarHandler) issueFeed(c *gin.Context) {
+	feedOutput, err := handler.usecase.IssueFeed(c.Request.Context())
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.NewIssueCalendarFeedResponse(feedOutput))
+}
+
+func (handler *CalendarHandler) revokeFeed(c *gin.Context) {
+	if err := handler.usecase.RevokeFeed(c.Request.Context()); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+// PublicCalendarHandler は認証なしでカレンダーアプリがフィードを購読する手段を提供する。
+// webcal:// の URL はこのエンドポイントの https:// を置き換えたものになる
+type PublicCalendarHandler struct {
+	usecase usecase.CalendarUsecase
+}
+
+func NewPublicCalendarHandler(usecase usecase.CalendarUsecase) *PublicCalendarHandler {
+	return &PublicCalendarHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *PublicCalendarHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/calendar-feeds/:token/calendar.ics", handler.export)
+}
+
+func (handler *PublicCalendarHandler) export(c *gin.Context) {
+	var uriParams validator.CalendarFeedURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var queryParams validator.CalendarQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	calendarOutput, err := handler.usecase.ExportFeed(c.Request.Context(), input.ExportCalendarFeedInput{
+		Token:    uriParams.Token,
+		TimeZone: queryParams.TimeZone,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	writeFile(c, "inline", calendarOutput.Filename, calendarContentType, int64(len(calendarOutput.Content)), bytes.NewReader(calendarOutput.Content))
+}
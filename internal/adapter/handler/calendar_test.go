@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/calendarfeed"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const calendarTestTripID = "00000000-0000-0000-0000-000000000001"
+
+func setupCalendarHandler(t *testing.T) (*gin.Engine, *mock_handler.MockCalendarUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockCalendarUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewCalendarHandler(mockUsecase).RegisterAPI(r.Group("/"))
+	NewPublicCalendarHandler(mockUsecase).RegisterAPI(r.Group("/public"))
+
+	return r, mockUsecase
+}
+
+func TestCalendarHandler_ExportTrip(t *testing.T) {
+	r, mockUsecase := setupCalendarHandler(t)
+
+	t.Run("正常系: タイムゾーンを指定して iCalendar をダウンロードする", func(t *testing.T) {
+		mockUsecase.EXPECT().ExportTrip(gomock.Any(), input.ExportTripCalendarInput{
+			TripID:   calendarTestTripID,
+			TimeZone: "Asia/Tokyo",
+		}).Return(output.NewExportCalendarOutput("京都旅行.ics", []byte("BEGIN:VCALENDAR\r\n")), nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+calendarTestTripID+"/calendar.ics?tz=Asia/Tokyo", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename*=utf-8''%E4%BA%AC%E9%83%BD%E6%97%85%E8%A1%8C.ics", w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "BEGIN:VCALENDAR\r\n", w.Body.String())
+	})
+
+	t.Run("異常系: タイムゾーン名が不正", func(t *testing.T) {
+		mockUsecase.EXPECT().ExportTrip(gomock.Any(), gomock.Any()).Return(nil, apperr.NewValidationError("tz must be an IANA time zone name"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+calendarTestTripID+"/calendar.ics?tz=Mars/Olympus", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCalendarHandler_Feed(t *testing.T) {
+	r, mockUsecase := setupCalendarHandler(t)
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feed := &output.CalendarFeed{ID: "feed-id", CreatedAt: createdAt}
+
+	t.Run("正常系: フィードを発行する", func(t *testing.T) {
+		mockUsecase.EXPECT().IssueFeed(gomock.Any()).Return(&output.IssueCalendarFeedOutput{CalendarFeed: feed, Token: "token"}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/calendar-feed", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody presenter.IssueCalendarFeedResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, "token", resBody.Token)
+		assert.Equal(t, "feed-id", resBody.CalendarFeed.ID)
+	})
+
+	t.Run("正常系: フィードを取得する", func(t *testing.T) {
+		mockUsecase.EXPECT().GetFeed(gomock.Any()).Return(&output.GetCalendarFeedOutput{CalendarFeed: feed}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/calendar-feed", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"calendar_feed":{"id":"feed-id","created_at":"2024-01-01T00:00:00Z"}}`, w.Body.String())
+	})
+
+	t.Run("異常系: 無効化するフィードがない", func(t *testing.T) {
+		mockUsecase.EXPECT().RevokeFeed(gomock.Any()).Return(calendarfeed.NewCalendarFeedNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/calendar-feed", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPublicCalendarHandler_Export(t *testing.T) {
+	r, mockUsecase := setupCalendarHandler(t)
+
+	t.Run("正常系: トークンでフィードを取得する", func(t *testing.T) {
+		mockUsecase.EXPECT().ExportFeed(gomock.Any(), input.ExportCalendarFeedInput{Token: "token"}).
+			Return(output.NewExportCalendarOutput("calendar.ics", []byte("BEGIN:VCALENDAR\r\n")), nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/public/calendar-feeds/token/calendar.ics", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "inline; filename=calendar.ics", w.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("異常系: 無効化済みのフィード", func(t *testing.T) {
+		mockUsecase.EXPECT().ExportFeed(gomock.Any(), gomock.Any()).Return(nil, calendarfeed.NewCalendarFeedNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/public/calendar-feeds/revoked/calendar.ics", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
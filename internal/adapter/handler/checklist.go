@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// ChecklistHandler は旅行の持ち物・出発前のタスク・書類などのチェックリストの管理を提供する
+type ChecklistHandler struct {
+	usecase usecase.ChecklistUsecase
+}
+
+func NewChecklistHandler(usecase usecase.ChecklistUsecase) *ChecklistHandler {
+	return &ChecklistHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *ChecklistHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/checklists/:checklist_id", handler.get)
+	router.GET("/trips/:trip_id/checklists", handler.list)
+	router.POST("/trips/:trip_id/checklists", handler.create)
+	router.POST("/trips/:trip_id/checklists/generate", handler.generate)
+	router.PUT("/trips/:trip_id/checklists/:checklist_id", handler.update)
+	router.DELETE("/trips/:trip_id/checklists/:checklist_id", handler.delete)
+	router.POST("/trips/:trip_id/checklists/:checklist_id/toggle", handler.toggle)
+}
+
+func (handler *ChecklistHandler) get(c *gin.Context) {
+	var uriParams validator.ChecklistURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	checklistOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID, uriParams.ChecklistID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetChecklistResponse(checklistOutput))
+}
+
+func (handler *ChecklistHandler) list(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	checklistsOutput, err := handler.usecase.List(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListChecklistResponse(checklistsOutput))
+}
+
+func (handler *ChecklistHandler) create(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.CreateChecklistJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	createdChecklist, err := handler.usecase.Create(c.Request.Context(), input.CreateChecklistInput{
+		TripID: uriParams.TripID,
+		Name:   body.Name,
+		Kind:   body.Kind,
+		Items:  newChecklistItemInputs(body.Items),
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateChecklistResponse{ID: createdChecklist.ID})
+}
+
+func (handler *ChecklistHandler) generate(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.GenerateChecklistJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	createdChecklist, err := handler.usecase.Generate(c.Request.Context(), input.GenerateChecklistInput{
+		TripID:          uriParams.TripID,
+		Name:            body.Name,
+		ItemTemplateIDs: body.ItemTemplateIDs,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateChecklistResponse{ID: createdChecklist.ID})
+}
+
+func (handler *ChecklistHandler) update(c *gin.Context) {
+	var uriParams validator.ChecklistURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.UpdateChecklistJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Update(c.Request.Context(), input.UpdateChecklistInput{
+		ID:     uriParams.ChecklistID,
+		TripID: uriParams.TripID,
+		Name:   body.Name,
+		Kind:   body.Kind,
+		Items:  newChecklistItemInputs(body.Items),
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *ChecklistHandler) delete(c *gin.Context) {
+	var uriParams validator.ChecklistURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Delete(c.Request.Context(), uriParams.TripID, uriParams.ChecklistID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *ChecklistHandler) toggle(c *gin.Context) {
+	var uriParams validator.ChecklistURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.ToggleChecklistItemsJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.ToggleItems(c.Request.Context(), input.ToggleChecklistItemsInput{
+		ID:      uriParams.ChecklistID,
+		TripID:  uriParams.TripID,
+		ItemIDs: body.ItemIDs,
+		Checked: body.Checked,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func newChecklistItemInputs(items []validator.ChecklistItemJSONBody) []input.ChecklistItemInput {
+	inputs := make([]input.ChecklistItemInput, len(items))
+	for i, item := range items {
+		inputs[i] = input.ChecklistItemInput{
+			ID:         item.ID,
+			Name:       item.Name,
+			Quantity:   item.Quantity,
+			Checked:    item.Checked,
+			AssigneeID: item.AssigneeID,
+		}
+	}
+	return inputs
+}
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	checklistTestTripID      = "00000000-0000-0000-0000-000000000001"
+	checklistTestChecklistID = "00000000-0000-0000-0000-000000000002"
+	checklistTestItemID      = "00000000-0000-0000-0000-000000000003"
+	checklistTestTemplateID  = "00000000-0000-0000-0000-000000000004"
+)
+
+func setupChecklistHandler(t *testing.T) (*gin.Engine, *mock_handler.MockChecklistUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockChecklistUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewChecklistHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestChecklistHandler_Get(t *testing.T) {
+	r, mockUsecase := setupChecklistHandler(t)
+
+	t.Run("正常系: 項目と進捗を返す", func(t *testing.T) {
+		now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		mockUsecase.EXPECT().Get(gomock.Any(), checklistTestTripID, checklistTestChecklistID).Return(&output.GetChecklistOutput{
+			Checklist: &output.Checklist{
+				ID:     checklistTestChecklistID,
+				TripID: checklistTestTripID,
+				Name:   "持ち物",
+				Kind:   "packing",
+				Items: []*output.ChecklistItem{
+					{ID: checklistTestItemID, Name: "パスポート", Quantity: 1, Checked: true},
+				},
+				Progress:  output.ChecklistProgress{Total: 1, Checked: 1, Percent: 100},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+checklistTestTripID+"/checklists/"+checklistTestChecklistID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.GetChecklistResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody.Checklist.Items, 1)
+		assert.True(t, resBody.Checklist.Items[0].Checked)
+		assert.Nil(t, resBody.Checklist.Items[0].AssigneeID)
+		assert.Equal(t, presenter.ChecklistProgress{Total: 1, Checked: 1, Percent: 100}, resBody.Checklist.Progress)
+	})
+
+	t.Run("異常系: チェックリストが存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Get(gomock.Any(), checklistTestTripID, checklistTestChecklistID).
+			Return(nil, checklist.NewChecklistNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+checklistTestTripID+"/checklists/"+checklistTestChecklistID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestChecklistHandler_Create(t *testing.T) {
+	r, mockUsecase := setupChecklistHandler(t)
+
+	t.Run("正常系: 担当者付きの項目を持つチェックリストを作成する", func(t *testing.T) {
+		assigneeID := "00000000-0000-0000-0000-000000000005"
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), input.CreateChecklistInput{
+				TripID: checklistTestTripID,
+				Name:   "出発前",
+				Kind:   "pre_departure",
+				Items: []input.ChecklistItemInput{
+					{Name: "郵便を止める", Quantity: 1, AssigneeID: &assigneeID},
+				},
+			}).
+			Return(&output.CreateChecklistOutput{ID: checklistTestChecklistID}, nil)
+
+		body, _ := json.Marshal(gin.H{
+			"name":  "出発前",
+			"kind":  "pre_departure",
+			"items": []gin.H{{"name": "郵便を止める", "quantity": 1, "assignee_id": assigneeID}},
+		})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+checklistTestTripID+"/checklists", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody presenter.CreateChecklistResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, checklistTestChecklistID, resBody.ID)
+	})
+
+	t.Run("異常系: 不正な種類はバリデーションエラー", func(t *testing.T) {
+		body, _ := json.Marshal(gin.H{"name": "持ち物", "kind": "unknown"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+checklistTestTripID+"/checklists", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestChecklistHandler_Generate(t *testing.T) {
+	r, mockUsecase := setupChecklistHandler(t)
+
+	t.Run("正常系: 項目テンプレートから持ち物リストを生成する", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Generate(gomock.Any(), input.GenerateChecklistInput{
+				TripID:          checklistTestTripID,
+				Name:            "持ち物",
+				ItemTemplateIDs: []string{checklistTestTemplateID},
+			}).
+			Return(&output.CreateChecklistOutput{ID: checklistTestChecklistID}, nil)
+
+		body, _ := json.Marshal(gin.H{"name": "持ち物", "item_template_ids": []string{checklistTestTemplateID}})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+checklistTestTripID+"/checklists/generate", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+}
+
+func TestChecklistHandler_Toggle(t *testing.T) {
+	r, mockUsecase := setupChecklistHandler(t)
+
+	t.Run("正常系: 項目のチェック状態をまとめて変更する", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			ToggleItems(gomock.Any(), input.ToggleChecklistItemsInput{
+				ID:      checklistTestChecklistID,
+				TripID:  checklistTestTripID,
+				ItemIDs: []string{checklistTestItemID},
+				Checked: true,
+			}).
+			Return(nil)
+
+		body, _ := json.Marshal(gin.H{"item_ids": []string{checklistTestItemID}, "checked": true})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+checklistTestTripID+"/checklists/"+checklistTestChecklistID+"/toggle", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系: 項目を指定しないとバリデーションエラー", func(t *testing.T) {
+		body, _ := json.Marshal(gin.H{"item_ids": []string{}, "checked": true})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+checklistTestTripID+"/checklists/"+checklistTestChecklistID+"/toggle", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestChecklistHandler_Delete(t *testing.T) {
+	r, mockUsecase := setupChecklistHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Delete(gomock.Any(), checklistTestTripID, checklistTestChecklistID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+checklistTestTripID+"/checklists/"+checklistTestChecklistID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+)
+
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+// anyVersion は If-Match: * の場合に parseIfMatch が返す、現在のバージョンを問わないことを表す値。
+// バージョンは 1 から始まるため、実際のバージョンと重ならない
+const anyVersion int64 = 0
+
+// formatETag はリソースのバージョンを強い ETag（"<version>"）として表現する
+func formatETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// parseIfMatch は If-Match ヘッダーから更新・削除の前提とするバージョンを取り出す。
+// "*" の場合は既存の旅行であればどのバージョンとも一致するため anyVersion を返す。
+// ヘッダーがない場合は前提条件が必要であることを、弱い ETag（W/"..."）の場合はバリデーションエラーを、
+// GET で返した ETag として解釈できない場合は前提条件の不一致を返す。
+// If-Match は強い比較で評価するため、弱い ETag はどのバージョンとも一致しない
+func parseIfMatch(c *gin.Context) (int64, error) {
+	value := strings.TrimSpace(c.GetHeader(headerIfMatch))
+	if value == "" {
+		return 0, apperr.NewPreconditionRequiredError("If-Match header is required")
+	}
+	if value == "*" {
+		return anyVersion, nil
+	}
+	if strings.HasPrefix(value, "W/") {
+		return 0, apperr.NewValidationError("If-Match header must be a strong ETag; weak ETags cannot be used for updates")
+	}
+
+	unquoted, ok := strings.CutPrefix(value, `"`)
+	if ok {
+		unquoted, ok = strings.CutSuffix(unquoted, `"`)
+	}
+	if !ok {
+		return 0, apperr.NewPreconditionFailedError("If-Match header must be an ETag returned by the server")
+	}
+
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version < 1 {
+		return 0, apperr.NewPreconditionFailedError("If-Match header must be an ETag returned by the server")
+	}
+
+	return version, nil
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFormatETag(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int64
+		want    string
+	}{
+		{name: "正常系: 初期バージョン", version: 1, want: `"1"`},
+		{name: "正常系: 複数桁のバージョン", version: 42, want: `"42"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, formatETag(tt.version))
+		})
+	}
+
+	t.Run("正常系: parseIfMatch で同じバージョンに戻せる", func(t *testing.T) {
+		gin.SetMode(gin.TestMode)
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPut, "/", nil)
+		c.Request.Header.Set("If-Match", formatETag(42))
+
+		got, err := parseIfMatch(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), got)
+	})
+}
+
+func TestParseIfMatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name    string
+		header  string
+		want    int64
+		wantErr *apperr.AppError
+	}{
+		{name: "正常系: 強い ETag", header: `"3"`, want: 3},
+		{name: "正常系: 前後の空白は無視する", header: ` "3" `, want: 3},
+		{name: "正常系: * はどのバージョンとも一致する", header: "*", want: anyVersion},
+		{name: "異常系: ヘッダーがない", header: "", wantErr: apperr.NewPreconditionRequiredError("")},
+		{name: "異常系: 弱い ETag はバリデーションエラー", header: `W/"3"`, wantErr: apperr.NewValidationError("")},
+		{name: "異常系: 引用符で囲まれていない", header: "3", wantErr: apperr.NewPreconditionFailedError("")},
+		{name: "異常系: バージョンとして解釈できない", header: `"abc"`, wantErr: apperr.NewPreconditionFailedError("")},
+		{name: "異常系: 0 以下のバージョン", header: `"0"`, wantErr: apperr.NewPreconditionFailedError("")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodPut, "/", nil)
+			if tt.header != "" {
+				c.Request.Header.Set("If-Match", tt.header)
+			}
+
+			got, err := parseIfMatch(c)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTripHandler_IfMatchAny(t *testing.T) {
+	const tripID = "00000000-0000-0000-0000-000000000001"
+
+	setup := func(t *testing.T) (*gin.Engine, *mock_handler.MockTripUsecase) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mock_handler.NewMockTripUsecase(ctrl)
+		gin.SetMode(gin.TestMode)
+		r := gin.Default()
+		NewTripHandler(mockUsecase).RegisterAPI(r.Group("/"))
+		return r, mockUsecase
+	}
+	current := &output.GetTripOutput{Trip: &output.Trip{ID: tripID, Name: "京都旅行", Version: 4}}
+
+	t.Run("正常系: If-Match: * の更新は現在のバージョンを前提とする", func(t *testing.T) {
+		r, mockUsecase := setup(t)
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(current, nil)
+		mockUsecase.EXPECT().Update(gomock.Any(), input.UpdateTripInput{ID: tripID, Name: "大阪旅行", Version: 4}).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/trips/"+tripID, strings.NewReader(`{"name":"大阪旅行"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", "*")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("正常系: If-Match: * の削除は現在のバージョンを前提とする", func(t *testing.T) {
+		r, mockUsecase := setup(t)
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(current, nil)
+		mockUsecase.EXPECT().Delete(gomock.Any(), tripID, int64(4)).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodDelete, "/trips/"+tripID, nil)
+		req.Header.Set("If-Match", "*")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系: 弱い ETag での更新は 400 を返す", func(t *testing.T) {
+		r, _ := setup(t)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/trips/"+tripID, strings.NewReader(`{"name":"大阪旅行"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `W/"4"`)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
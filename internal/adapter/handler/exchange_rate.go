@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// AdminExchangeRateHandler は管理者向けの為替レート操作を提供する
+type AdminExchangeRateHandler struct {
+	usecase usecase.ExchangeRateUsecase
+}
+
+func NewAdminExchangeRateHandler(usecase usecase.ExchangeRateUsecase) *AdminExchangeRateHandler {
+	return &AdminExchangeRateHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *AdminExchangeRateHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.POST("/exchange-rates/import", handler.importRates)
+}
+
+func (handler *AdminExchangeRateHandler) importRates(c *gin.Context) {
+	var queryParams validator.ImportExchangeRatesQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	importOutput, err := handler.usecase.Import(c.Request.Context(), input.ImportExchangeRatesInput{
+		Format: queryParams.Format,
+		Data:   c.Request.Body,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewImportExchangeRatesResponse(importOutput))
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupAdminExchangeRateHandler(t *testing.T) (*gin.Engine, *mock_handler.MockExchangeRateUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockExchangeRateUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewAdminExchangeRateHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestAdminExchangeRateHandler_Import(t *testing.T) {
+	r, mockUsecase := setupAdminExchangeRateHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		const body = "Date, USD\n2024-05-02, 1.0723\n"
+		mockUsecase.EXPECT().Import(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, in input.ImportExchangeRatesInput) (*output.ImportExchangeRatesOutput, error) {
+				assert.Equal(t, "csv", in.Format)
+				data, err := io.ReadAll(in.Data)
+				require.NoError(t, err)
+				assert.Equal(t, body, string(data), "リクエストボディがそのまま渡されるべき")
+
+				date := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+				return &output.ImportExchangeRatesOutput{Imported: 1, FromDate: date, ToDate: date}, nil
+			})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/exchange-rates/import?format=csv", strings.NewReader(body))
+		req.Header.Set("Content-Type", "text/csv")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var res map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		assert.Equal(t, map[string]any{
+			"imported":  float64(1),
+			"from_date": "2024-05-02",
+			"to_date":   "2024-05-02",
+		}, res)
+	})
+
+	t.Run("異常系: 未対応の形式", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/exchange-rates/import?format=json", strings.NewReader("{}"))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: ファイルを解析できない", func(t *testing.T) {
+		mockUsecase.EXPECT().Import(gomock.Any(), gomock.Any()).
+			Return(nil, apperr.NewValidationError("Failed to parse exchange rate file"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/exchange-rates/import?format=xml", strings.NewReader("<broken"))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
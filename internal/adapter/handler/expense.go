@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+type ExpenseHandler struct {
+	usecase usecase.ExpenseUsecase
+}
+
+func NewExpenseHandler(usecase usecase.ExpenseUsecase) *ExpenseHandler {
+	return &ExpenseHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *ExpenseHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/expenses/:expense_id", handler.get)
+	router.GET("/trips/:trip_id/expenses", handler.list)
+	router.POST("/trips/:trip_id/expenses", handler.create)
+	router.PUT("/trips/:trip_id/expenses/:expense_id", handler.update)
+	router.DELETE("/trips/:trip_id/expenses/:expense_id", handler.delete)
+}
+
+func (handler *ExpenseHandler) get(c *gin.Context) {
+	var uriParams validator.ExpenseURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	expenseOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID, uriParams.ExpenseID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetExpenseResponse(expenseOutput))
+}
+
+func (handler *ExpenseHandler) list(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	expensesOutput, err := handler.usecase.List(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListExpenseResponse(expensesOutput))
+}
+
+func (handler *ExpenseHandler) create(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.CreateExpenseJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	spentOn, err := parseDate(body.SpentOn)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	createdExpense, err := handler.usecase.Create(c.Request.Context(), input.CreateExpenseInput{
+		TripID:      uriParams.TripID,
+		Amount:      body.Amount,
+		AmountMinor: body.AmountMinor,
+		Currency:    body.Currency,
+		Category:    body.Category,
+		SpentOn:     spentOn,
+		Payer:       body.Payer,
+		Description: body.Description,
+		ActivityID:  body.ActivityID,
+		Split:       newSplitInput(body.Split),
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateExpenseResponse{ID: createdExpense.ID})
+}
+
+func (handler *ExpenseHandler) update(c *gin.Context) {
+	var uriParams validator.ExpenseURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.UpdateExpenseJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	spentOn, err := parseDate(body.SpentOn)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err = handler.usecase.Update(c.Request.Context(), input.UpdateExpenseInput{
+		ID:          uriParams.ExpenseID,
+		TripID:      uriParams.TripID,
+		Amount:      body.Amount,
+		AmountMinor: body.AmountMinor,
+		Currency:    body.Currency,
+		Category:    body.Category,
+		SpentOn:     spentOn,
+		Payer:       body.Payer,
+		Description: body.Description,
+		ActivityID:  body.ActivityID,
+		Split:       newSplitInput(body.Split),
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *ExpenseHandler) delete(c *gin.Context) {
+	var uriParams validator.ExpenseURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Delete(c.Request.Context(), uriParams.TripID, uriParams.ExpenseID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+// newSplitInput は分担方法のリクエストボディをユースケースの入力に変換する。未指定の場合は nil を返す
+func newSplitInput(body *validator.ExpenseSplitJSONBody) *input.SplitInput {
+	if body == nil {
+		return nil
+	}
+	entries := make([]input.SplitEntryInput, len(body.Entries))
+	for i, entry := range body.Entries {
+		entries[i] = input.SplitEntryInput{Participant: entry.Participant, Value: entry.Value}
+	}
+	return &input.SplitInput{Method: body.Method, Entries: entries}
+}
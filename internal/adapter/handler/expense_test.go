@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	expenseTestTripID = "00000000-0000-0000-0000-000000000001"
+	expenseTestID     = "00000000-0000-0000-0000-000000000003"
+)
+
+func setupExpenseHandler(t *testing.T) (*gin.Engine, *mock_handler.MockExpenseUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockExpenseUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewExpenseHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestExpenseHandler_Get(t *testing.T) {
+	r, mockUsecase := setupExpenseHandler(t)
+
+	now := time.Now()
+	expectedOutput := &output.GetExpenseOutput{
+		Expense: &output.Expense{
+			ID:            expenseTestID,
+			TripID:        expenseTestTripID,
+			Amount:        1234,
+			AmountDecimal: "12.34",
+			Currency:      "USD",
+			Category:      "food",
+			SpentOn:       time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			Payer:         "Alice",
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		},
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), expenseTestTripID, expenseTestID).Return(expectedOutput, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+expenseTestTripID+"/expenses/"+expenseTestID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resBody map[string]map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.NoError(t, err)
+		assert.Equal(t, expenseTestID, resBody["expense"]["id"])
+		assert.Equal(t, float64(1234), resBody["expense"]["amount"])
+		assert.Equal(t, "12.34", resBody["expense"]["amount_decimal"])
+		assert.Equal(t, "2024-05-01", resBody["expense"]["spent_on"])
+	})
+
+	t.Run("異常系: 支出が存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Get(gomock.Any(), expenseTestTripID, expenseTestID).
+			Return(nil, expense.NewExpenseNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+expenseTestTripID+"/expenses/"+expenseTestID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestExpenseHandler_List(t *testing.T) {
+	r, mockUsecase := setupExpenseHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any(), expenseTestTripID).Return(&output.ListExpenseOutput{
+			Expenses: []*output.Expense{},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+expenseTestTripID+"/expenses", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.ListExpenseResponse
+		err := json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.NoError(t, err)
+		assert.Empty(t, resBody.Expenses)
+	})
+
+	t.Run("異常系: Internal server error", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any(), expenseTestTripID).Return(nil, errors.New("some error"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+expenseTestTripID+"/expenses", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestExpenseHandler_Create(t *testing.T) {
+	r, mockUsecase := setupExpenseHandler(t)
+
+	requestBody := gin.H{
+		"amount":   "12.34",
+		"currency": "USD",
+		"category": "food",
+		"spent_on": "2024-05-01",
+		"payer":    "Alice",
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Create(gomock.Any(), input.CreateExpenseInput{
+			TripID:   expenseTestTripID,
+			Amount:   "12.34",
+			Currency: "USD",
+			Category: "food",
+			SpentOn:  time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			Payer:    "Alice",
+		}).Return(&output.CreateExpenseOutput{ID: expenseTestID}, nil)
+
+		body, _ := json.Marshal(requestBody)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+expenseTestTripID+"/expenses", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody presenter.CreateExpenseResponse
+		err := json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.NoError(t, err)
+		assert.Equal(t, expenseTestID, resBody.ID)
+	})
+
+	t.Run("正常系: 分担方法を指定", func(t *testing.T) {
+		mockUsecase.EXPECT().Create(gomock.Any(), input.CreateExpenseInput{
+			TripID:   expenseTestTripID,
+			Amount:   "12.34",
+			Currency: "USD",
+			Category: "food",
+			SpentOn:  time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			Payer:    "Alice",
+			Split: &input.SplitInput{
+				Method: "percentage",
+				Entries: []input.SplitEntryInput{
+					{Participant: "Alice", Value: 4000},
+					{Participant: "Bob", Value: 6000},
+				},
+			},
+		}).Return(&output.CreateExpenseOutput{ID: expenseTestID}, nil)
+
+		withSplit := gin.H{
+			"split": gin.H{
+				"method": "percentage",
+				"entries": []gin.H{
+					{"participant": "Alice", "value": 4000},
+					{"participant": "Bob", "value": 6000},
+				},
+			},
+		}
+		for k, v := range requestBody {
+			withSplit[k] = v
+		}
+
+		body, _ := json.Marshal(withSplit)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+expenseTestTripID+"/expenses", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("異常系: 金額が未指定", func(t *testing.T) {
+		invalid := gin.H{}
+		for k, v := range requestBody {
+			invalid[k] = v
+		}
+		delete(invalid, "amount")
+
+		body, _ := json.Marshal(invalid)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+expenseTestTripID+"/expenses", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var resBody map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "VALIDATION_ERROR", resBody["code"])
+	})
+}
+
+func TestExpenseHandler_Delete(t *testing.T) {
+	r, mockUsecase := setupExpenseHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Delete(gomock.Any(), expenseTestTripID, expenseTestID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+expenseTestTripID+"/expenses/"+expenseTestID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+)
+
+// multipartOverhead はアップロードするファイル以外にリクエストボディに含まれる、フォームの他のフィールドや区切りの分の余裕
+const multipartOverhead = 64 * 1024
+
+// bindMultipartWithLimit は multipart のフォームを body に読み込む。ファイルが limit を超えるボディは
+// 読み込みの途中で打ち切り、一時ファイルに書き出す前に拒否する。
+// 失敗した場合はエラーのレスポンスを返して ok に false を返す。成功した場合は、フォームの一時ファイルを削除する
+// cleanup をハンドラーの終了時に呼び出す
+func bindMultipartWithLimit(c *gin.Context, limit int64, body any) (cleanup func(), ok bool) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit+multipartOverhead)
+
+	cleanup = func() {
+		if c.Request.MultipartForm != nil {
+			_ = c.Request.MultipartForm.RemoveAll()
+		}
+	}
+	if err := c.ShouldBindWith(body, binding.FormMultipart); err != nil {
+		cleanup()
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return nil, false
+	}
+	return cleanup, true
+}
+
+// writeFile は BlobStore から読み込んだファイルをレスポンスとして返す。
+// dispositionType は attachment（ダウンロード）または inline（ブラウザで表示）で、size が分からない場合は -1 を渡す
+func writeFile(c *gin.Context, dispositionType, filename, contentType string, size int64, content io.Reader) {
+	// ファイル名に日本語などが含まれる場合は RFC 2231 の filename* 形式で送る
+	disposition := dispositionType
+	if filename != "" {
+		if formatted := mime.FormatMediaType(dispositionType, map[string]string{"filename": filename}); formatted != "" {
+			disposition = formatted
+		}
+	}
+
+	c.DataFromReader(http.StatusOK, size, contentType, content, map[string]string{
+		"Content-Disposition":    disposition,
+		"X-Content-Type-Options": "nosniff",
+		"Cache-Control":          "private, no-store",
+	})
+}
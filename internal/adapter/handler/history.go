@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// HistoryHandler は旅行と関連リソースの変更履歴の取得と、以前のリビジョンの状態に戻す操作を提供する
+type HistoryHandler struct {
+	usecase usecase.HistoryUsecase
+}
+
+func NewHistoryHandler(usecase usecase.HistoryUsecase) *HistoryHandler {
+	return &HistoryHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *HistoryHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/history", handler.list)
+	router.POST("/trips/:trip_id/revert", handler.revert)
+}
+
+func (handler *HistoryHandler) list(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	historyOutput, err := handler.usecase.List(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListHistoryResponse(historyOutput))
+}
+
+func (handler *HistoryHandler) revert(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var queryParams validator.RevertTripQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	revertOutput, err := handler.usecase.Revert(c.Request.Context(), input.RevertTripInput{
+		TripID:   uriParams.TripID,
+		Revision: queryParams.To,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewRevertTripResponse(revertOutput))
+}
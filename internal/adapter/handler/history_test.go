@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const historyTestTripID = "00000000-0000-0000-0000-000000000001"
+
+func setupHistoryHandler(t *testing.T) (*gin.Engine, *mock_handler.MockHistoryUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockHistoryUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewHistoryHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestHistoryHandler_List(t *testing.T) {
+	r, mockUsecase := setupHistoryHandler(t)
+
+	t.Run("正常系: リビジョンごとの変更と変更された項目を返す", func(t *testing.T) {
+		createdAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+		mockUsecase.EXPECT().List(gomock.Any(), historyTestTripID).Return(&output.ListHistoryOutput{
+			Revisions: []*output.Revision{{
+				Number:  2,
+				ActorID: "user-id",
+				Changes: []*output.RevisionChange{{
+					ResourceType:  "trip",
+					ResourceID:    historyTestTripID,
+					Action:        "updated",
+					ChangedFields: []string{"name"},
+					Before:        json.RawMessage(`{"name":"旅行"}`),
+					After:         json.RawMessage(`{"name":"新しい名前"}`),
+				}},
+				CreatedAt: createdAt,
+			}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+historyTestTripID+"/history", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.ListHistoryResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody.Revisions, 1)
+		assert.Equal(t, int64(2), resBody.Revisions[0].Revision)
+		assert.Nil(t, resBody.Revisions[0].RevertedTo)
+		require.Len(t, resBody.Revisions[0].Changes, 1)
+		assert.Equal(t, []string{"name"}, resBody.Revisions[0].Changes[0].ChangedFields)
+		assert.JSONEq(t, `{"name":"新しい名前"}`, string(resBody.Revisions[0].Changes[0].After))
+	})
+}
+
+func TestHistoryHandler_Revert(t *testing.T) {
+	r, mockUsecase := setupHistoryHandler(t)
+
+	t.Run("正常系: 戻した変更を記録したリビジョンを返す", func(t *testing.T) {
+		revertedTo := int64(1)
+		mockUsecase.EXPECT().
+			Revert(gomock.Any(), input.RevertTripInput{TripID: historyTestTripID, Revision: 1}).
+			Return(&output.RevertTripOutput{Revision: &output.Revision{Number: 3, RevertedTo: &revertedTo}}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+historyTestTripID+"/revert?to=1", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.RevertTripResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.NotNil(t, resBody.Revision)
+		assert.Equal(t, int64(3), resBody.Revision.Revision)
+		assert.Equal(t, &revertedTo, resBody.Revision.RevertedTo)
+	})
+
+	t.Run("正常系: 既に戻す先と同じ状態の場合は revision が null になる", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Revert(gomock.Any(), input.RevertTripInput{TripID: historyTestTripID, Revision: 2}).
+			Return(&output.RevertTripOutput{}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+historyTestTripID+"/revert?to=2", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"revision":null}`, w.Body.String())
+	})
+
+	t.Run("異常系: to を指定しない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+historyTestTripID+"/revert", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: 存在しないリビジョン", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Revert(gomock.Any(), input.RevertTripInput{TripID: historyTestTripID, Revision: 10}).
+			Return(nil, history.NewRevisionNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+historyTestTripID+"/revert?to=10", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+type InvitationHandler struct {
+	usecase usecase.InvitationUsecase
+}
+
+func NewInvitationHandler(usecase usecase.InvitationUsecase) *InvitationHandler {
+	return &InvitationHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *InvitationHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.POST("/trips/:trip_id/invitations", handler.create)
+	router.GET("/trips/:trip_id/invitations", handler.listByTrip)
+	router.GET("/invitations", handler.listPending)
+	router.POST("/invitations/:invitation_id/accept", handler.accept)
+	router.POST("/invitations/:invitation_id/decline", handler.decline)
+}
+
+func (handler *InvitationHandler) create(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.CreateInvitationJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	createdInvitation, err := handler.usecase.Create(c.Request.Context(), input.CreateInvitationInput{
+		TripID:   uriParams.TripID,
+		Email:    body.Email,
+		Username: body.Username,
+		Role:     body.Role,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateInvitationResponse{ID: createdInvitation.ID})
+}
+
+func (handler *InvitationHandler) listByTrip(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	invitationsOutput, err := handler.usecase.ListByTrip(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListInvitationResponse(invitationsOutput))
+}
+
+func (handler *InvitationHandler) listPending(c *gin.Context) {
+	invitationsOutput, err := handler.usecase.ListPending(c.Request.Context())
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListInvitationResponse(invitationsOutput))
+}
+
+func (handler *InvitationHandler) accept(c *gin.Context) {
+	var uriParams validator.InvitationURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	if err := handler.usecase.Accept(c.Request.Context(), uriParams.InvitationID); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *InvitationHandler) decline(c *gin.Context) {
+	var uriParams validator.InvitationURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	if err := handler.usecase.Decline(c.Request.Context(), uriParams.InvitationID); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	invitationTestTripID = "00000000-0000-0000-0000-000000000001"
+	invitationTestID     = "00000000-0000-0000-0000-000000000003"
+)
+
+func setupInvitationHandler(t *testing.T) (*gin.Engine, *mock_handler.MockInvitationUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockInvitationUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewInvitationHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestInvitationHandler_Create(t *testing.T) {
+	r, mockUsecase := setupInvitationHandler(t)
+	path := "/trips/" + invitationTestTripID + "/invitations"
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Create(gomock.Any(), input.CreateInvitationInput{
+			TripID: invitationTestTripID,
+			Email:  "invitee@example.com",
+			Role:   "editor",
+		}).Return(&output.CreateInvitationOutput{ID: invitationTestID}, nil)
+
+		body, _ := json.Marshal(gin.H{"email": "invitee@example.com", "role": "editor"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody presenter.CreateInvitationResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, invitationTestID, resBody.ID)
+	})
+
+	t.Run("異常系: 宛先が未指定", func(t *testing.T) {
+		body, _ := json.Marshal(gin.H{"role": "editor"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: メールアドレスとユーザー名の両方を指定", func(t *testing.T) {
+		body, _ := json.Marshal(gin.H{"email": "invitee@example.com", "username": "invitee", "role": "editor"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestInvitationHandler_ListPending(t *testing.T) {
+	r, mockUsecase := setupInvitationHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockUsecase.EXPECT().ListPending(gomock.Any()).Return(&output.ListInvitationOutput{
+			Invitations: []*output.Invitation{{
+				ID:        invitationTestID,
+				TripID:    invitationTestTripID,
+				Username:  "invitee",
+				Role:      "viewer",
+				Status:    "pending",
+				ExpiresAt: now.Add(7 * 24 * time.Hour),
+				CreatedAt: now,
+			}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/invitations", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody map[string][]map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody["invitations"], 1)
+		got := resBody["invitations"][0]
+		assert.Equal(t, "invitee", got["username"])
+		assert.NotContains(t, got, "email")
+		assert.Nil(t, got["responded_at"])
+	})
+}
+
+func TestInvitationHandler_Accept(t *testing.T) {
+	r, mockUsecase := setupInvitationHandler(t)
+	path := "/invitations/" + invitationTestID + "/accept"
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Accept(gomock.Any(), invitationTestID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系: 有効期限切れ", func(t *testing.T) {
+		mockUsecase.EXPECT().Accept(gomock.Any(), invitationTestID).Return(membership.NewInvitationExpiredError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("異常系: 招待が存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().Accept(gomock.Any(), invitationTestID).Return(membership.NewInvitationNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestInvitationHandler_Decline(t *testing.T) {
+	r, mockUsecase := setupInvitationHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Decline(gomock.Any(), invitationTestID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/invitations/"+invitationTestID+"/decline", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// ItemTemplateHandler は持ち物リストの生成に使う、ユーザーごとの項目テンプレートの管理を提供する
+type ItemTemplateHandler struct {
+	usecase usecase.ItemTemplateUsecase
+}
+
+func NewItemTemplateHandler(usecase usecase.ItemTemplateUsecase) *ItemTemplateHandler {
+	return &ItemTemplateHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *ItemTemplateHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/checklist-item-templates", handler.list)
+	router.POST("/checklist-item-templates", handler.create)
+	router.PUT("/checklist-item-templates/:item_template_id", handler.update)
+	router.DELETE("/checklist-item-templates/:item_template_id", handler.delete)
+}
+
+func (handler *ItemTemplateHandler) list(c *gin.Context) {
+	templatesOutput, err := handler.usecase.List(c.Request.Context())
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListItemTemplateResponse(templatesOutput))
+}
+
+func (handler *ItemTemplateHandler) create(c *gin.Context) {
+	var body validator.SaveItemTemplateJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	createdTemplate, err := handler.usecase.Create(c.Request.Context(), input.CreateItemTemplateInput{
+		Name:     body.Name,
+		Quantity: body.Quantity,
+		PerDay:   body.PerDay,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateItemTemplateResponse{ID: createdTemplate.ID})
+}
+
+func (handler *ItemTemplateHandler) update(c *gin.Context) {
+	var uriParams validator.ItemTemplateURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.SaveItemTemplateJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Update(c.Request.Context(), input.UpdateItemTemplateInput{
+		ID:       uriParams.ItemTemplateID,
+		Name:     body.Name,
+		Quantity: body.Quantity,
+		PerDay:   body.PerDay,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *ItemTemplateHandler) delete(c *gin.Context) {
+	var uriParams validator.ItemTemplateURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Delete(c.Request.Context(), uriParams.ItemTemplateID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
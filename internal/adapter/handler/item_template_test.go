@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupItemTemplateHandler(t *testing.T) (*gin.Engine, *mock_handler.MockItemTemplateUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockItemTemplateUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewItemTemplateHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestItemTemplateHandler_List(t *testing.T) {
+	r, mockUsecase := setupItemTemplateHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any()).Return(&output.ListItemTemplateOutput{
+			ItemTemplates: []*output.ItemTemplate{{ID: checklistTestTemplateID, Name: "靴下", Quantity: 1, PerDay: true}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/checklist-item-templates", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.ListItemTemplateResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody.ItemTemplates, 1)
+		assert.True(t, resBody.ItemTemplates[0].PerDay)
+	})
+}
+
+func TestItemTemplateHandler_Create(t *testing.T) {
+	r, mockUsecase := setupItemTemplateHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), input.CreateItemTemplateInput{Name: "靴下", Quantity: 1, PerDay: true}).
+			Return(&output.CreateItemTemplateOutput{ID: checklistTestTemplateID}, nil)
+
+		body, _ := json.Marshal(gin.H{"name": "靴下", "quantity": 1, "per_day": true})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/checklist-item-templates", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("異常系: 数量が0はバリデーションエラー", func(t *testing.T) {
+		body, _ := json.Marshal(gin.H{"name": "靴下", "quantity": 0})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/checklist-item-templates", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestItemTemplateHandler_Delete(t *testing.T) {
+	r, mockUsecase := setupItemTemplateHandler(t)
+
+	t.Run("異常系: 他のユーザーの項目テンプレートは存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().Delete(gomock.Any(), checklistTestTemplateID).Return(checklist.NewItemTemplateNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/checklist-item-templates/"+checklistTestTemplateID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// ItineraryHandler は旅行の行程（日ごとの行動と、その間の移動距離・移動時間の見積もり）を提供する
+type ItineraryHandler struct {
+	usecase usecase.ItineraryUsecase
+}
+
+func NewItineraryHandler(usecase usecase.ItineraryUsecase) *ItineraryHandler {
+	return &ItineraryHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *ItineraryHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/itinerary", handler.itinerary)
+	router.GET("/trips/:trip_id/activities/:activity_id", handler.get)
+	router.POST("/trips/:trip_id/activities", handler.create)
+	router.PUT("/trips/:trip_id/activities/:activity_id", handler.update)
+	router.DELETE("/trips/:trip_id/activities/:activity_id", handler.delete)
+	router.POST("/trips/:trip_id/days/:day/optimize", handler.optimize)
+}
+
+func (handler *ItineraryHandler) itinerary(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var queryParams validator.GetItineraryQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	date, err := parseOptionalDate(queryParams.Date)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	itineraryOutput, err := handler.usecase.Itinerary(c.Request.Context(), uriParams.TripID, date)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetItineraryResponse(itineraryOutput))
+}
+
+func (handler *ItineraryHandler) get(c *gin.Context) {
+	var uriParams validator.ActivityURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	activityOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID, uriParams.ActivityID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetActivityResponse(activityOutput))
+}
+
+func (handler *ItineraryHandler) create(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.CreateActivityJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	date, err := parseDate(body.Date)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	createdActivity, err := handler.usecase.Create(c.Request.Context(), input.CreateActivityInput{
+		TripID:     uriParams.TripID,
+		Date:       date,
+		Title:      body.Title,
+		Place:      newActivityPlaceInput(body.Place),
+		Timezone:   body.Timezone,
+		StartAt:    body.StartAt,
+		EndAt:      body.EndAt,
+		TravelMode: body.TravelMode,
+		Notes:      body.Notes,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateActivityResponse{ID: createdActivity.ID})
+}
+
+func (handler *ItineraryHandler) update(c *gin.Context) {
+	var uriParams validator.ActivityURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.UpdateActivityJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	date, err := parseDate(body.Date)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err = handler.usecase.Update(c.Request.Context(), input.UpdateActivityInput{
+		ID:         uriParams.ActivityID,
+		TripID:     uriParams.TripID,
+		Date:       date,
+		Title:      body.Title,
+		Place:      newActivityPlaceInput(body.Place),
+		Timezone:   body.Timezone,
+		StartAt:    body.StartAt,
+		EndAt:      body.EndAt,
+		TravelMode: body.TravelMode,
+		Notes:      body.Notes,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *ItineraryHandler) delete(c *gin.Context) {
+	var uriParams validator.ActivityURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Delete(c.Request.Context(), uriParams.TripID, uriParams.ActivityID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+// optimize は指定した日の行動を巡る順を、合計の移動距離が短くなるように並べ替えた提案を返す。
+// apply を指定しない場合は提案だけを返し、並び順は更新しない
+func (handler *ItineraryHandler) optimize(c *gin.Context) {
+	var uriParams validator.OptimizeDayURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.OptimizeDayJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	optimizedOutput, err := handler.usecase.Optimize(c.Request.Context(), input.OptimizeDayInput{
+		TripID:            uriParams.TripID,
+		Day:               uriParams.Day,
+		Start:             newRouteEndpointInput(body.Start),
+		End:               newRouteEndpointInput(body.End),
+		PinnedActivityIDs: body.PinnedActivityIDs,
+		Apply:             body.Apply,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewOptimizeDayResponse(optimizedOutput))
+}
+
+func newRouteEndpointInput(endpoint *validator.RouteEndpointJSONBody) *input.RouteEndpointInput {
+	if endpoint == nil {
+		return nil
+	}
+	return &input.RouteEndpointInput{
+		Latitude:  *endpoint.Latitude,
+		Longitude: *endpoint.Longitude,
+	}
+}
+
+func newActivityPlaceInput(place *validator.ActivityPlaceJSONBody) *input.ActivityPlaceInput {
+	if place == nil {
+		return nil
+	}
+	return &input.ActivityPlaceInput{
+		Name:      place.Name,
+		Latitude:  place.Latitude,
+		Longitude: place.Longitude,
+		Timezone:  place.Timezone,
+	}
+}
@@ -0,0 +1,347 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	itineraryTestTripID     = "00000000-0000-0000-0000-000000000001"
+	itineraryTestActivityID = "00000000-0000-0000-0000-000000000002"
+)
+
+func setupItineraryHandler(t *testing.T) (*gin.Engine, *mock_handler.MockItineraryUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockItineraryUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewItineraryHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func newItineraryTestActivity(id string) *output.Activity {
+	latitude, longitude := 35.0394, 135.7292
+	return &output.Activity{
+		ID:         id,
+		TripID:     itineraryTestTripID,
+		Date:       time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		Title:      "金閣寺",
+		Place:      &output.ActivityPlace{Name: "金閣寺", Latitude: &latitude, Longitude: &longitude},
+		TravelMode: "walk",
+		CreatedAt:  time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC),
+		UpdatedAt:  time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestItineraryHandler_Itinerary(t *testing.T) {
+	r, mockUsecase := setupItineraryHandler(t)
+	url := "/trips/" + itineraryTestTripID + "/itinerary"
+
+	t.Run("正常系: 移動時間と空き時間は秒で、合計と警告とともに返す", func(t *testing.T) {
+		dayNumber := 1
+		distance := 6431.9
+		travelTime := 80*time.Minute + 24*time.Second
+		gap := 10 * time.Minute
+		mockUsecase.EXPECT().Itinerary(gomock.Any(), itineraryTestTripID, nil).Return(&output.GetItineraryOutput{
+			Days: []*output.ItineraryDay{{
+				Date:       time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+				DayNumber:  &dayNumber,
+				Activities: []*output.Activity{newItineraryTestActivity("a1"), newItineraryTestActivity("a2")},
+				Legs: []*output.ItineraryLeg{
+					{FromActivityID: "a1", ToActivityID: "a2", TravelMode: "walk", DistanceMeters: &distance, TravelTime: &travelTime, Gap: &gap},
+				},
+				TotalDistanceMeters: distance,
+				TotalTravelTime:     travelTime,
+				Warnings: []*output.ItineraryWarning{
+					{Code: output.WarningInsufficientTravelTime, FromActivityID: "a1", ToActivityID: "a2", Gap: gap, TravelTime: travelTime},
+				},
+			}},
+			AverageSpeeds: map[string]float64{"walk": 4.8},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody struct {
+			Days []struct {
+				Date                string           `json:"date"`
+				DayNumber           *int             `json:"day_number"`
+				Activities          []map[string]any `json:"activities"`
+				Legs                []map[string]any `json:"legs"`
+				TotalDistanceMeters float64          `json:"total_distance_meters"`
+				TotalTravelSeconds  float64          `json:"total_travel_seconds"`
+				Warnings            []map[string]any `json:"warnings"`
+			} `json:"days"`
+			AverageSpeedsKmh map[string]float64 `json:"average_speeds_kmh"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody.Days, 1)
+		day := resBody.Days[0]
+		assert.Equal(t, "2024-05-01", day.Date)
+		assert.Equal(t, &dayNumber, day.DayNumber)
+		assert.Equal(t, "2024-05-01", day.Activities[0]["date"])
+		assert.Equal(t, 4824.0, day.Legs[0]["travel_seconds"])
+		assert.Equal(t, 600.0, day.Legs[0]["gap_seconds"])
+		assert.Equal(t, 6431.9, day.TotalDistanceMeters)
+		assert.Equal(t, 4824.0, day.TotalTravelSeconds)
+		require.Len(t, day.Warnings, 1)
+		assert.Equal(t, "insufficient_travel_time", day.Warnings[0]["code"])
+		assert.Equal(t, 4.8, resBody.AverageSpeedsKmh["walk"])
+	})
+
+	t.Run("正常系: 座標が分からない移動の距離と移動時間は null になる", func(t *testing.T) {
+		mockUsecase.EXPECT().Itinerary(gomock.Any(), itineraryTestTripID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, date *time.Time) (*output.GetItineraryOutput, error) {
+				require.NotNil(t, date)
+				assert.Equal(t, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), *date)
+				return &output.GetItineraryOutput{Days: []*output.ItineraryDay{{
+					Date: *date,
+					Legs: []*output.ItineraryLeg{{FromActivityID: "a1", ToActivityID: "a2", TravelMode: "car"}},
+				}}}, nil
+			})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url+"?date=2024-05-02", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"distance_meters":null,"travel_seconds":null,"gap_seconds":null`)
+		assert.Contains(t, w.Body.String(), `"day_number":null`)
+		assert.Contains(t, w.Body.String(), `"warnings":[]`)
+	})
+
+	t.Run("異常系: date の形式が不正", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url+"?date=2024/05/02", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestItineraryHandler_Get(t *testing.T) {
+	r, mockUsecase := setupItineraryHandler(t)
+	url := "/trips/" + itineraryTestTripID + "/activities/" + itineraryTestActivityID
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), itineraryTestTripID, itineraryTestActivityID).
+			Return(&output.GetActivityOutput{Activity: newItineraryTestActivity(itineraryTestActivityID)}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"start_at":null`)
+		assert.Contains(t, w.Body.String(), `"travel_mode":"walk"`)
+	})
+
+	t.Run("異常系: 行動が存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), itineraryTestTripID, itineraryTestActivityID).Return(nil, itinerary.NewActivityNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestItineraryHandler_Create(t *testing.T) {
+	r, mockUsecase := setupItineraryHandler(t)
+	url := "/trips/" + itineraryTestTripID + "/activities"
+
+	t.Run("正常系: 場所と日時がユースケースに渡される", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in input.CreateActivityInput) (*output.CreateActivityOutput, error) {
+				assert.Equal(t, itineraryTestTripID, in.TripID)
+				assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), in.Date)
+				assert.Equal(t, "金閣寺", in.Title)
+				require.NotNil(t, in.Place)
+				assert.Equal(t, 35.0394, *in.Place.Latitude)
+				require.NotNil(t, in.StartAt)
+				assert.True(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC).Equal(*in.StartAt))
+				assert.Nil(t, in.EndAt)
+				assert.Equal(t, "transit", in.TravelMode)
+				return &output.CreateActivityOutput{ID: itineraryTestActivityID}, nil
+			})
+
+		body := `{"date":"2024-05-01","title":"金閣寺","place":{"name":"金閣寺","latitude":35.0394,"longitude":135.7292},"start_at":"2024-05-01T09:00:00+09:00","travel_mode":"transit"}`
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", url, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.JSONEq(t, `{"id":"`+itineraryTestActivityID+`"}`, w.Body.String())
+	})
+
+	t.Run("異常系: 移動手段が不正", func(t *testing.T) {
+		body := `{"date":"2024-05-01","title":"金閣寺","travel_mode":"plane"}`
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", url, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: 終了日時が開始日時より前", func(t *testing.T) {
+		mockUsecase.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, itinerary.NewInvalidTimeRangeError())
+
+		body := `{"date":"2024-05-01","title":"金閣寺","start_at":"2024-05-01T10:00:00Z","end_at":"2024-05-01T09:00:00Z","travel_mode":"walk"}`
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", url, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestItineraryHandler_Update(t *testing.T) {
+	r, mockUsecase := setupItineraryHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in input.UpdateActivityInput) error {
+				assert.Equal(t, itineraryTestActivityID, in.ID)
+				assert.Nil(t, in.Place)
+				assert.Equal(t, "car", in.TravelMode)
+				return nil
+			})
+
+		body := `{"date":"2024-05-02","title":"嵐山","travel_mode":"car"}`
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", "/trips/"+itineraryTestTripID+"/activities/"+itineraryTestActivityID, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"message":"success"}`, w.Body.String())
+	})
+}
+
+func TestItineraryHandler_Delete(t *testing.T) {
+	r, mockUsecase := setupItineraryHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Delete(gomock.Any(), itineraryTestTripID, itineraryTestActivityID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+itineraryTestTripID+"/activities/"+itineraryTestActivityID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestItineraryHandler_Optimize(t *testing.T) {
+	r, mockUsecase := setupItineraryHandler(t)
+	url := "/trips/" + itineraryTestTripID + "/days/2/optimize"
+
+	t.Run("正常系: 宿と固定する行動がユースケースに渡され、提案した順と短くなる距離を返す", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Optimize(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in input.OptimizeDayInput) (*output.OptimizeDayOutput, error) {
+				assert.Equal(t, itineraryTestTripID, in.TripID)
+				assert.Equal(t, 2, in.Day)
+				require.NotNil(t, in.Start)
+				assert.Equal(t, input.RouteEndpointInput{Latitude: 35.0116, Longitude: 135.7681}, *in.Start)
+				assert.Equal(t, in.Start, in.End)
+				assert.Equal(t, []string{itineraryTestActivityID}, in.PinnedActivityIDs)
+				assert.True(t, in.Apply)
+				return &output.OptimizeDayOutput{
+					Day: &output.ItineraryDay{
+						Date:       time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+						Activities: []*output.Activity{newItineraryTestActivity("a2"), newItineraryTestActivity("a1")},
+					},
+					OriginalDistanceMeters:  12000,
+					OptimizedDistanceMeters: 9000,
+					SavedDistanceMeters:     3000,
+					Complete:                true,
+					Applied:                 true,
+				}, nil
+			})
+
+		body := `{"start":{"latitude":35.0116,"longitude":135.7681},"end":{"latitude":35.0116,"longitude":135.7681},"pinned_activity_ids":["` + itineraryTestActivityID + `"],"apply":true}`
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", url, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody struct {
+			Day struct {
+				Date       string `json:"date"`
+				Activities []struct {
+					ID string `json:"id"`
+				} `json:"activities"`
+			} `json:"day"`
+			OriginalDistanceMeters  float64 `json:"original_distance_meters"`
+			OptimizedDistanceMeters float64 `json:"optimized_distance_meters"`
+			SavedDistanceMeters     float64 `json:"saved_distance_meters"`
+			Complete                bool    `json:"complete"`
+			Applied                 bool    `json:"applied"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, "2024-05-02", resBody.Day.Date)
+		require.Len(t, resBody.Day.Activities, 2)
+		assert.Equal(t, "a2", resBody.Day.Activities[0].ID)
+		assert.Equal(t, 12000.0, resBody.OriginalDistanceMeters)
+		assert.Equal(t, 9000.0, resBody.OptimizedDistanceMeters)
+		assert.Equal(t, 3000.0, resBody.SavedDistanceMeters)
+		assert.True(t, resBody.Complete)
+		assert.True(t, resBody.Applied)
+	})
+
+	t.Run("異常系: 旅行期間外の日", func(t *testing.T) {
+		mockUsecase.EXPECT().Optimize(gomock.Any(), gomock.Any()).Return(nil, itinerary.NewInvalidDayError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", url, bytes.NewBufferString(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: 日が数値でない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+itineraryTestTripID+"/days/first/optimize", bytes.NewBufferString(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: 宿の経度がない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", url, bytes.NewBufferString(`{"start":{"latitude":35.0116}}`))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
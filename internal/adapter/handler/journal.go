@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// JournalHandler は旅行の日ごとの日記の管理を提供する
+type JournalHandler struct {
+	usecase usecase.JournalUsecase
+}
+
+func NewJournalHandler(usecase usecase.JournalUsecase) *JournalHandler {
+	return &JournalHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *JournalHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/journal-entries/:journal_entry_id", handler.get)
+	router.GET("/trips/:trip_id/journal-entries", handler.list)
+	router.POST("/trips/:trip_id/journal-entries", handler.create)
+	router.PUT("/trips/:trip_id/journal-entries/:journal_entry_id", handler.update)
+	router.DELETE("/trips/:trip_id/journal-entries/:journal_entry_id", handler.delete)
+}
+
+func (handler *JournalHandler) get(c *gin.Context) {
+	var uriParams validator.JournalEntryURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	entryOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID, uriParams.JournalEntryID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetJournalEntryResponse(entryOutput))
+}
+
+func (handler *JournalHandler) list(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var queryParams validator.ListJournalEntryQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	date, err := parseOptionalDate(queryParams.Date)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	entriesOutput, err := handler.usecase.List(c.Request.Context(), uriParams.TripID, date)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListJournalEntryResponse(entriesOutput))
+}
+
+func (handler *JournalHandler) create(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.CreateJournalEntryJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	date, err := parseDate(body.Date)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	createdEntry, err := handler.usecase.Create(c.Request.Context(), input.CreateJournalEntryInput{
+		TripID: uriParams.TripID,
+		Date:   date,
+		Title:  body.Title,
+		Body:   body.Body,
+		Mood:   body.Mood,
+		Place:  newJournalPlaceInput(body.Place),
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateJournalEntryResponse{ID: createdEntry.ID})
+}
+
+func (handler *JournalHandler) update(c *gin.Context) {
+	var uriParams validator.JournalEntryURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.UpdateJournalEntryJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	date, err := parseDate(body.Date)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err = handler.usecase.Update(c.Request.Context(), input.UpdateJournalEntryInput{
+		ID:     uriParams.JournalEntryID,
+		TripID: uriParams.TripID,
+		Date:   date,
+		Title:  body.Title,
+		Body:   body.Body,
+		Mood:   body.Mood,
+		Place:  newJournalPlaceInput(body.Place),
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *JournalHandler) delete(c *gin.Context) {
+	var uriParams validator.JournalEntryURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Delete(c.Request.Context(), uriParams.TripID, uriParams.JournalEntryID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func newJournalPlaceInput(place *validator.JournalPlaceJSONBody) *input.JournalPlaceInput {
+	if place == nil {
+		return nil
+	}
+	return &input.JournalPlaceInput{
+		Name:      place.Name,
+		Latitude:  place.Latitude,
+		Longitude: place.Longitude,
+		Timezone:  place.Timezone,
+	}
+}
@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	journalTestTripID  = "00000000-0000-0000-0000-000000000001"
+	journalTestEntryID = "00000000-0000-0000-0000-000000000002"
+)
+
+func setupJournalHandler(t *testing.T) (*gin.Engine, *mock_handler.MockJournalUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockJournalUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewJournalHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestJournalHandler_Get(t *testing.T) {
+	r, mockUsecase := setupJournalHandler(t)
+
+	t.Run("正常系: Markdown の本文と HTML に変換した本文の両方を返す", func(t *testing.T) {
+		now := time.Date(2024, 5, 1, 21, 0, 0, 0, time.UTC)
+		latitude, longitude, timezone := 35.0170, 135.6713, "Asia/Tokyo"
+		mockUsecase.EXPECT().Get(gomock.Any(), journalTestTripID, journalTestEntryID).Return(&output.GetJournalEntryOutput{
+			JournalEntry: &output.JournalEntry{
+				ID:        journalTestEntryID,
+				TripID:    journalTestTripID,
+				Date:      time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+				Title:     "嵐山",
+				Body:      "朝の**竹林**",
+				BodyHTML:  "<p>朝の<strong>竹林</strong></p>",
+				Mood:      "great",
+				Place:     &output.JournalPlace{Name: "竹林の小径", Latitude: &latitude, Longitude: &longitude, Timezone: &timezone},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+journalTestTripID+"/journal-entries/"+journalTestEntryID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody map[string]map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		entry := resBody["journal_entry"]
+		assert.Equal(t, "2024-05-01", entry["date"])
+		assert.Equal(t, "朝の**竹林**", entry["body"])
+		assert.Equal(t, "<p>朝の<strong>竹林</strong></p>", entry["body_html"])
+		assert.Equal(t, map[string]any{"name": "竹林の小径", "latitude": latitude, "longitude": longitude, "timezone": timezone}, entry["place"])
+	})
+
+	t.Run("異常系: 日記が存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Get(gomock.Any(), journalTestTripID, journalTestEntryID).
+			Return(nil, journal.NewJournalEntryNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+journalTestTripID+"/journal-entries/"+journalTestEntryID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestJournalHandler_List(t *testing.T) {
+	r, mockUsecase := setupJournalHandler(t)
+
+	t.Run("正常系: 日付で絞り込む", func(t *testing.T) {
+		date := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+		mockUsecase.EXPECT().
+			List(gomock.Any(), journalTestTripID, &date).
+			Return(&output.ListJournalEntryOutput{JournalEntries: []*output.JournalEntry{}}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+journalTestTripID+"/journal-entries?date=2024-05-02", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"journal_entries":[]}`, w.Body.String())
+	})
+
+	t.Run("異常系: 不正な日付はバリデーションエラー", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+journalTestTripID+"/journal-entries?date=2024-5-2", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestJournalHandler_Create(t *testing.T) {
+	r, mockUsecase := setupJournalHandler(t)
+
+	t.Run("正常系: 場所付きの日記を作成する", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), input.CreateJournalEntryInput{
+				TripID: journalTestTripID,
+				Date:   time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+				Title:  "浅草",
+				Body:   "# 雷門\n人が多かった",
+				Mood:   "good",
+				Place:  &input.JournalPlaceInput{Name: "浅草寺"},
+			}).
+			Return(&output.CreateJournalEntryOutput{ID: journalTestEntryID}, nil)
+
+		body, _ := json.Marshal(gin.H{
+			"date":  "2024-05-01",
+			"title": "浅草",
+			"body":  "# 雷門\n人が多かった",
+			"mood":  "good",
+			"place": gin.H{"name": "浅草寺"},
+		})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+journalTestTripID+"/journal-entries", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody presenter.CreateJournalEntryResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, journalTestEntryID, resBody.ID)
+	})
+
+	t.Run("異常系: 不正な気分はバリデーションエラー", func(t *testing.T) {
+		body, _ := json.Marshal(gin.H{"date": "2024-05-01", "title": "浅草", "mood": "happy"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+journalTestTripID+"/journal-entries", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestJournalHandler_Update(t *testing.T) {
+	r, mockUsecase := setupJournalHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Update(gomock.Any(), input.UpdateJournalEntryInput{
+				ID:     journalTestEntryID,
+				TripID: journalTestTripID,
+				Date:   time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+				Title:  "2日目",
+				Body:   "雨",
+				Mood:   "bad",
+			}).
+			Return(nil)
+
+		body, _ := json.Marshal(gin.H{"date": "2024-05-02", "title": "2日目", "body": "雨", "mood": "bad"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", "/trips/"+journalTestTripID+"/journal-entries/"+journalTestEntryID, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestJournalHandler_Delete(t *testing.T) {
+	r, mockUsecase := setupJournalHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Delete(gomock.Any(), journalTestTripID, journalTestEntryID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+journalTestTripID+"/journal-entries/"+journalTestEntryID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
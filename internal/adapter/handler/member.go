@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+type MemberHandler struct {
+	usecase usecase.MemberUsecase
+}
+
+func NewMemberHandler(usecase usecase.MemberUsecase) *MemberHandler {
+	return &MemberHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *MemberHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/members", handler.list)
+	router.PUT("/trips/:trip_id/members/:user_id", handler.changeRole)
+	router.DELETE("/trips/:trip_id/members/:user_id", handler.remove)
+	router.POST("/trips/:trip_id/owner", handler.transferOwnership)
+}
+
+func (handler *MemberHandler) list(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	membersOutput, err := handler.usecase.List(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListMemberResponse(membersOutput))
+}
+
+func (handler *MemberHandler) changeRole(c *gin.Context) {
+	var uriParams validator.MemberURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.ChangeMemberRoleJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.ChangeRole(c.Request.Context(), input.ChangeMemberRoleInput{
+		TripID: uriParams.TripID,
+		UserID: uriParams.UserID,
+		Role:   body.Role,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *MemberHandler) remove(c *gin.Context) {
+	var uriParams validator.MemberURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Remove(c.Request.Context(), uriParams.TripID, uriParams.UserID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *MemberHandler) transferOwnership(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.TransferOwnershipJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.TransferOwnership(c.Request.Context(), input.TransferOwnershipInput{
+		TripID:     uriParams.TripID,
+		NewOwnerID: body.NewOwnerID,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	memberTestTripID = "00000000-0000-0000-0000-000000000001"
+	memberTestUserID = "00000000-0000-0000-0000-000000000002"
+)
+
+func setupMemberHandler(t *testing.T) (*gin.Engine, *mock_handler.MockMemberUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockMemberUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewMemberHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestMemberHandler_List(t *testing.T) {
+	r, mockUsecase := setupMemberHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockUsecase.EXPECT().List(gomock.Any(), memberTestTripID).Return(&output.ListMemberOutput{
+			Members: []*output.Member{{UserID: memberTestUserID, Role: "owner", CreatedAt: now, UpdatedAt: now}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+memberTestTripID+"/members", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.ListMemberResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody.Members, 1)
+		assert.Equal(t, memberTestUserID, resBody.Members[0].UserID)
+		assert.Equal(t, "owner", resBody.Members[0].Role)
+	})
+
+	t.Run("異常系: 閲覧権限がない", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any(), memberTestTripID).Return(nil, apperr.NewForbiddenError("forbidden"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+memberTestTripID+"/members", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestMemberHandler_ChangeRole(t *testing.T) {
+	r, mockUsecase := setupMemberHandler(t)
+	path := "/trips/" + memberTestTripID + "/members/" + memberTestUserID
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().ChangeRole(gomock.Any(), input.ChangeMemberRoleInput{
+			TripID: memberTestTripID,
+			UserID: memberTestUserID,
+			Role:   "editor",
+		}).Return(nil)
+
+		body, _ := json.Marshal(gin.H{"role": "editor"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", path, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系: 所有者のロールは指定できない", func(t *testing.T) {
+		body, _ := json.Marshal(gin.H{"role": "owner"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", path, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: メンバーが存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().ChangeRole(gomock.Any(), gomock.Any()).Return(membership.NewMemberNotFoundError())
+
+		body, _ := json.Marshal(gin.H{"role": "viewer"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", path, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestMemberHandler_Remove(t *testing.T) {
+	r, mockUsecase := setupMemberHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Remove(gomock.Any(), memberTestTripID, memberTestUserID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+memberTestTripID+"/members/"+memberTestUserID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestMemberHandler_TransferOwnership(t *testing.T) {
+	r, mockUsecase := setupMemberHandler(t)
+	path := "/trips/" + memberTestTripID + "/owner"
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().TransferOwnership(gomock.Any(), input.TransferOwnershipInput{
+			TripID:     memberTestTripID,
+			NewOwnerID: memberTestUserID,
+		}).Return(nil)
+
+		body, _ := json.Marshal(gin.H{"new_owner_id": memberTestUserID})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系: 移譲先が未指定", func(t *testing.T) {
+		body, _ := json.Marshal(gin.H{})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
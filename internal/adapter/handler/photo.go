@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+// PhotoHandler は旅行のアルバム（写真のアップロード・一覧・ダウンロード・サムネイル）を提供する
+type PhotoHandler struct {
+	usecase       usecase.PhotoUsecase
+	maxUploadSize int64
+}
+
+// NewPhotoHandler は写真のハンドラを作成する。maxUploadSize はアップロードできる写真1枚あたりの最大サイズ
+func NewPhotoHandler(usecase usecase.PhotoUsecase, maxUploadSize int64) *PhotoHandler {
+	return &PhotoHandler{
+		usecase:       usecase,
+		maxUploadSize: maxUploadSize,
+	}
+}
+
+func (handler *PhotoHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/photos/:photo_id", handler.get)
+	router.GET("/trips/:trip_id/photos/:photo_id/download", handler.download)
+	router.GET("/trips/:trip_id/photos/:photo_id/thumbnail", handler.thumbnail)
+	router.GET("/trips/:trip_id/photos", handler.list)
+	router.POST("/trips/:trip_id/photos", handler.upload)
+	router.DELETE("/trips/:trip_id/photos/:photo_id", handler.delete)
+}
+
+func (handler *PhotoHandler) get(c *gin.Context) {
+	var uriParams validator.PhotoURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	photoOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID, uriParams.PhotoID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetPhotoResponse(photoOutput))
+}
+
+func (handler *PhotoHandler) list(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	photosOutput, err := handler.usecase.List(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListPhotoResponse(photosOutput))
+}
+
+func (handler *PhotoHandler) upload(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.UploadPhotoFormBody
+	cleanup, ok := bindMultipartWithLimit(c, handler.maxUploadSize, &body)
+	if !ok {
+		return
+	}
+	defer cleanup()
+
+	file, err := body.File.Open()
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+	defer file.Close()
+
+	uploadedOutput, err := handler.usecase.Upload(c.Request.Context(), input.UploadPhotoInput{
+		TripID:        uriParams.TripID,
+		Filename:      body.File.Filename,
+		Content:       file,
+		Size:          body.File.Size,
+		StripLocation: body.StripLocation,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.NewUploadPhotoResponse(uploadedOutput))
+}
+
+func (handler *PhotoHandler) download(c *gin.Context) {
+	handler.serve(c, "attachment", handler.usecase.Download)
+}
+
+func (handler *PhotoHandler) thumbnail(c *gin.Context) {
+	handler.serve(c, "inline", handler.usecase.DownloadThumbnail)
+}
+
+// serve は写真またはサムネイルを取得してレスポンスとして返す。サムネイルは画像としてそのまま表示できるよう inline で返す
+func (handler *PhotoHandler) serve(
+	c *gin.Context,
+	dispositionType string,
+	fetch func(ctx context.Context, tripID, id string) (*output.DownloadPhotoOutput, error),
+) {
+	var uriParams validator.PhotoURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	downloadOutput, err := fetch(c.Request.Context(), uriParams.TripID, uriParams.PhotoID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+	defer downloadOutput.Content.Close()
+
+	filename := ""
+	if dispositionType == "attachment" {
+		filename = downloadOutput.Photo.Filename
+	}
+	writeFile(c, dispositionType, filename, downloadOutput.ContentType, downloadOutput.Size, downloadOutput.Content)
+}
+
+func (handler *PhotoHandler) delete(c *gin.Context) {
+	var uriParams validator.PhotoURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	if err := handler.usecase.Delete(c.Request.Context(), uriParams.TripID, uriParams.PhotoID); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/domain/photo"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	photoTestTripID  = "00000000-0000-0000-0000-000000000001"
+	photoTestPhotoID = "00000000-0000-0000-0000-000000000002"
+	// photoTestMaxUploadSize はテストで使うアップロードの最大サイズ
+	photoTestMaxUploadSize = 1024
+)
+
+func setupPhotoHandler(t *testing.T) (*gin.Engine, *mock_handler.MockPhotoUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockPhotoUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewPhotoHandler(mockUsecase, photoTestMaxUploadSize).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func newPhotoTestOutput() *output.Photo {
+	capturedAt := time.Date(2024, 4, 2, 21, 30, 15, 0, time.UTC)
+	date := time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)
+	day := 2
+	distance := 12.5
+	latitude, longitude := 35.0394, 135.7292
+	return &output.Photo{
+		ID:          photoTestPhotoID,
+		TripID:      photoTestTripID,
+		Filename:    "金閣寺.jpg",
+		ContentType: "image/jpeg",
+		Size:        int64(len("jpeg")),
+		Width:       4032,
+		Height:      3024,
+		CapturedAt:  &capturedAt,
+		UploadedBy:  "00000000-0000-0000-0000-000000000009",
+		CreatedAt:   time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+		Suggestion: &output.PhotoSuggestion{
+			Date:           &date,
+			Day:            &day,
+			Place:          &output.JournalPlace{Name: "金閣寺", Latitude: &latitude, Longitude: &longitude},
+			DistanceMeters: &distance,
+		},
+	}
+}
+
+func TestPhotoHandler_List(t *testing.T) {
+	r, mockUsecase := setupPhotoHandler(t)
+
+	t.Run("正常系: 撮影日時は現地時刻、推定の日付は日付の形式で返す", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any(), photoTestTripID).Return(&output.ListPhotoOutput{
+			Photos: []*output.Photo{newPhotoTestOutput()},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+photoTestTripID+"/photos", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody struct {
+			Photos []map[string]any `json:"photos"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody.Photos, 1)
+		p := resBody.Photos[0]
+		assert.Equal(t, "2024-04-02T21:30:15", p["captured_at"])
+		assert.Nil(t, p["latitude"])
+		assert.Equal(t, "2024-05-01T09:00:00Z", p["created_at"])
+		suggestion := p["suggestion"].(map[string]any)
+		assert.Equal(t, "2024-04-02", suggestion["date"])
+		assert.Equal(t, float64(2), suggestion["day"])
+		assert.Equal(t, 12.5, suggestion["distance_meters"])
+		assert.Equal(t, "金閣寺", suggestion["place"].(map[string]any)["name"])
+	})
+}
+
+func TestPhotoHandler_Upload(t *testing.T) {
+	r, mockUsecase := setupPhotoHandler(t)
+	url := "/trips/" + photoTestTripID + "/photos"
+
+	t.Run("正常系: ファイルの中身と位置情報の削除の指定がユースケースに渡される", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Upload(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in input.UploadPhotoInput) (*output.UploadPhotoOutput, error) {
+				assert.Equal(t, photoTestTripID, in.TripID)
+				assert.Equal(t, "kinkakuji.jpg", in.Filename)
+				assert.True(t, in.StripLocation)
+				content, err := io.ReadAll(in.Content)
+				require.NoError(t, err)
+				assert.Equal(t, "jpeg", string(content))
+				return &output.UploadPhotoOutput{Photo: newPhotoTestOutput()}, nil
+			})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, map[string]string{"strip_location": "true"}, "kinkakuji.jpg", []byte("jpeg")))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"photo":`)
+	})
+
+	t.Run("正常系: 位置情報の削除を省略すると削除しない", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Upload(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in input.UploadPhotoInput) (*output.UploadPhotoOutput, error) {
+				assert.False(t, in.StripLocation)
+				return &output.UploadPhotoOutput{Photo: newPhotoTestOutput()}, nil
+			})
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, nil, "kinkakuji.jpg", []byte("jpeg")))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("異常系: デコードできない写真は 400 になる", func(t *testing.T) {
+		mockUsecase.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(nil, photo.NewInvalidImageError())
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, nil, "broken.jpg", []byte("jpeg")))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: 写真として扱えない形式は 415 になる", func(t *testing.T) {
+		mockUsecase.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(nil, photo.NewUnsupportedContentTypeError())
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, nil, "photo.webp", []byte("RIFF")))
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+
+	t.Run("異常系: ファイルがない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, map[string]string{"strip_location": "true"}, "", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPhotoHandler_Download(t *testing.T) {
+	r, mockUsecase := setupPhotoHandler(t)
+	base := "/trips/" + photoTestTripID + "/photos/" + photoTestPhotoID
+
+	t.Run("正常系: 写真を添付ファイルとして返す", func(t *testing.T) {
+		mockUsecase.EXPECT().Download(gomock.Any(), photoTestTripID, photoTestPhotoID).Return(&output.DownloadPhotoOutput{
+			Photo:       newPhotoTestOutput(),
+			ContentType: "image/jpeg",
+			Size:        4,
+			Content:     io.NopCloser(strings.NewReader("jpeg")),
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", base+"/download", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "jpeg", w.Body.String())
+		assert.Equal(t, "image/jpeg", w.Header().Get("Content-Type"))
+		assert.Equal(t, "4", w.Header().Get("Content-Length"))
+		assert.Equal(t, "attachment; filename*=utf-8''%E9%87%91%E9%96%A3%E5%AF%BA.jpg", w.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("正常系: サムネイルはファイル名なしで inline として返す", func(t *testing.T) {
+		mockUsecase.EXPECT().DownloadThumbnail(gomock.Any(), photoTestTripID, photoTestPhotoID).Return(&output.DownloadPhotoOutput{
+			Photo:       newPhotoTestOutput(),
+			ContentType: "image/jpeg",
+			Size:        -1,
+			Content:     io.NopCloser(strings.NewReader("thumbnail")),
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", base+"/thumbnail", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "thumbnail", w.Body.String())
+		assert.Equal(t, "inline", w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+	})
+
+	t.Run("異常系: 写真が存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().DownloadThumbnail(gomock.Any(), photoTestTripID, photoTestPhotoID).Return(nil, photo.NewPhotoNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", base+"/thumbnail", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Empty(t, w.Header().Get("Content-Disposition"))
+	})
+}
+
+func TestPhotoHandler_Delete(t *testing.T) {
+	r, mockUsecase := setupPhotoHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Delete(gomock.Any(), photoTestTripID, photoTestPhotoID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+photoTestTripID+"/photos/"+photoTestPhotoID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"message":"success"}`, w.Body.String())
+	})
+}
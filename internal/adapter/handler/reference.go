@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// ReferenceHandler は移動や目的地に使う空港・国・都市の参照データの検索を提供する
+type ReferenceHandler struct {
+	usecase usecase.ReferenceUsecase
+}
+
+func NewReferenceHandler(usecase usecase.ReferenceUsecase) *ReferenceHandler {
+	return &ReferenceHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *ReferenceHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/reference/airports", handler.searchAirports)
+	router.GET("/reference/countries", handler.listCountries)
+	router.GET("/reference/cities", handler.searchCities)
+}
+
+func (handler *ReferenceHandler) searchAirports(c *gin.Context) {
+	var queryParams validator.ReferenceSearchQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	airportsOutput, err := handler.usecase.SearchAirports(c.Request.Context(), input.SearchReferenceInput{
+		Query: queryParams.Q,
+		Limit: queryParams.Limit,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewSearchAirportsResponse(airportsOutput))
+}
+
+func (handler *ReferenceHandler) listCountries(c *gin.Context) {
+	var queryParams validator.ListCountriesQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	countriesOutput, err := handler.usecase.ListCountries(c.Request.Context(), input.SearchReferenceInput{
+		Query: queryParams.Q,
+		Limit: queryParams.Limit,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListCountriesResponse(countriesOutput))
+}
+
+func (handler *ReferenceHandler) searchCities(c *gin.Context) {
+	var queryParams validator.ReferenceSearchQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	citiesOutput, err := handler.usecase.SearchCities(c.Request.Context(), input.SearchReferenceInput{
+		Query: queryParams.Q,
+		Limit: queryParams.Limit,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewSearchCitiesResponse(citiesOutput))
+}
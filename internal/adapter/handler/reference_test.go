@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/search"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupReferenceHandler(t *testing.T) (*gin.Engine, *mock_handler.MockReferenceUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockReferenceUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewReferenceHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestReferenceHandler_SearchAirports(t *testing.T) {
+	r, mockUsecase := setupReferenceHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().SearchAirports(gomock.Any(), input.SearchReferenceInput{Query: "tokyo", Limit: 5}).
+			Return(&output.SearchAirportsOutput{Airports: []*output.Airport{{
+				IATACode:    "HND",
+				ICAOCode:    "RJTT",
+				Name:        "Haneda Airport",
+				City:        "Tokyo",
+				CountryCode: "JP",
+				Latitude:    35.5523,
+				Longitude:   139.7798,
+				Timezone:    "Asia/Tokyo",
+			}}}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/reference/airports?q=tokyo&limit=5", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"airports":[{"iata_code":"HND","icao_code":"RJTT","name":"Haneda Airport","city":"Tokyo",
+			"country_code":"JP","latitude":35.5523,"longitude":139.7798,"timezone":"Asia/Tokyo"}]}`, w.Body.String())
+	})
+
+	t.Run("異常系: 検索語がない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/reference/airports", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: 検索語が空白のみ", func(t *testing.T) {
+		mockUsecase.EXPECT().SearchAirports(gomock.Any(), gomock.Any()).Return(nil, search.NewEmptyQueryError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/reference/airports?q=+", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: 件数が上限を超える", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/reference/airports?q=tokyo&limit=51", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestReferenceHandler_ListCountries(t *testing.T) {
+	r, mockUsecase := setupReferenceHandler(t)
+
+	t.Run("正常系: 検索語を省略できる", func(t *testing.T) {
+		mockUsecase.EXPECT().ListCountries(gomock.Any(), input.SearchReferenceInput{}).
+			Return(&output.ListCountriesOutput{Countries: []*output.Country{
+				{Code: "JP", Alpha3Code: "JPN", Name: "Japan", Currency: "JPY"},
+			}}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/reference/countries", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"countries":[{"code":"JP","alpha3_code":"JPN","name":"Japan","currency":"JPY"}]}`, w.Body.String())
+	})
+
+	t.Run("正常系: 検索語を指定する", func(t *testing.T) {
+		mockUsecase.EXPECT().ListCountries(gomock.Any(), input.SearchReferenceInput{Query: "jap"}).
+			Return(&output.ListCountriesOutput{Countries: []*output.Country{}}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/reference/countries?q=jap", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"countries":[]}`, w.Body.String())
+	})
+}
+
+func TestReferenceHandler_SearchCities(t *testing.T) {
+	r, mockUsecase := setupReferenceHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().SearchCities(gomock.Any(), input.SearchReferenceInput{Query: "tokio"}).
+			Return(&output.SearchCitiesOutput{Cities: []*output.City{{
+				Code:        "JPTYO",
+				Name:        "Tokyo",
+				CountryCode: "JP",
+				Latitude:    35.6895,
+				Longitude:   139.6917,
+				Timezone:    "Asia/Tokyo",
+			}}}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/reference/cities?q=tokio", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.SearchCitiesResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody.Cities, 1)
+		assert.Equal(t, "JPTYO", resBody.Cities[0].Code)
+		assert.Equal(t, "Asia/Tokyo", resBody.Cities[0].Timezone)
+	})
+
+	t.Run("異常系: 検索語がない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/reference/cities?q=", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// routeContentTypes は経路の書き出し形式ごとのレスポンスの Content-Type
+var routeContentTypes = map[string]string{
+	service.RouteFormatGeoJSON: "application/geo+json",
+	service.RouteFormatKML:     "application/vnd.google-earth.kml+xml",
+	service.RouteFormatGPX:     "application/gpx+xml",
+}
+
+// RouteHandler は旅行の経路の書き出しを提供する
+type RouteHandler struct {
+	usecase usecase.RouteUsecase
+}
+
+func NewRouteHandler(usecase usecase.RouteUsecase) *RouteHandler {
+	return &RouteHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *RouteHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/route.geojson", handler.export(service.RouteFormatGeoJSON))
+	router.GET("/trips/:trip_id/route.kml", handler.export(service.RouteFormatKML))
+	router.GET("/trips/:trip_id/route.gpx", handler.export(service.RouteFormatGPX))
+}
+
+func (handler *RouteHandler) export(format string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var uriParams validator.TripURIParameters
+		if err := c.ShouldBindUri(&uriParams); err != nil {
+			c.JSON(presenter.ConvertToHTTPError(err))
+			return
+		}
+
+		routeOutput, err := handler.usecase.Export(c.Request.Context(), input.ExportRouteInput{
+			TripID: uriParams.TripID,
+			Format: format,
+		})
+		if err != nil {
+			c.JSON(presenter.ConvertToHTTPError(err))
+			return
+		}
+		defer routeOutput.Content.Close()
+
+		// 書き出しながら送るためサイズは分からない
+		writeFile(c, "attachment", routeOutput.Filename, routeContentTypes[routeOutput.Format], -1, routeOutput.Content)
+	}
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupRouteHandler(t *testing.T) (*gin.Engine, *mock_handler.MockRouteUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockRouteUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewRouteHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestRouteHandler_Export(t *testing.T) {
+	r, mockUsecase := setupRouteHandler(t)
+
+	tests := []struct {
+		name        string
+		path        string
+		format      string
+		contentType string
+	}{
+		{name: "正常系: GeoJSON", path: "/trips/trip-id/route.geojson", format: "geojson", contentType: "application/geo+json"},
+		{name: "正常系: KML", path: "/trips/trip-id/route.kml", format: "kml", contentType: "application/vnd.google-earth.kml+xml"},
+		{name: "正常系: GPX", path: "/trips/trip-id/route.gpx", format: "gpx", contentType: "application/gpx+xml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase.EXPECT().Export(gomock.Any(), input.ExportRouteInput{TripID: "trip-id", Format: tt.format}).
+				Return(output.NewExportRouteOutput("京都旅行."+tt.format, tt.format, io.NopCloser(strings.NewReader("content"))), nil)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.contentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, `attachment; filename*=utf-8''%E4%BA%AC%E9%83%BD%E6%97%85%E8%A1%8C.`+tt.format, w.Header().Get("Content-Disposition"))
+			assert.Equal(t, "content", w.Body.String())
+		})
+	}
+
+	t.Run("異常系: 閲覧権限がない", func(t *testing.T) {
+		mockUsecase.EXPECT().Export(gomock.Any(), gomock.Any()).Return(nil, apperr.NewForbiddenError("Forbidden"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/trip-id/route.kml", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
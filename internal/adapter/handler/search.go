@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// SearchHandler は参加している旅行とそのリソースの横断検索を提供する
+type SearchHandler struct {
+	usecase usecase.SearchUsecase
+}
+
+func NewSearchHandler(usecase usecase.SearchUsecase) *SearchHandler {
+	return &SearchHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *SearchHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/search", handler.search)
+}
+
+func (handler *SearchHandler) search(c *gin.Context) {
+	var queryParams validator.SearchQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	searchOutput, err := handler.usecase.Search(c.Request.Context(), input.SearchInput{
+		Query: queryParams.Q,
+		Limit: queryParams.Limit,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewSearchResponse(searchOutput))
+}
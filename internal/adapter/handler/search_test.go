@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupSearchHandler(t *testing.T) (*gin.Engine, *mock_handler.MockSearchUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockSearchUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewSearchHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestSearchHandler_Search(t *testing.T) {
+	r, mockUsecase := setupSearchHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Search(gomock.Any(), input.SearchInput{Query: "京都", Limit: 5}).Return(&output.SearchOutput{
+			Groups: []*output.SearchGroup{{
+				ResourceType: "trip",
+				Hits: []*output.SearchHit{{
+					ResourceType: "trip",
+					ResourceID:   "trip-id",
+					TripID:       "trip-id",
+					Title:        []output.SnippetSegment{{Text: "京都", Highlighted: true}, {Text: "旅行"}},
+					Snippet:      []output.SnippetSegment{},
+					Rank:         0.5,
+				}},
+			}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/search?q="+url.QueryEscape("京都")+"&limit=5", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.SearchResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody.Groups, 1)
+		assert.Equal(t, "trip", resBody.Groups[0].ResourceType)
+		assert.Equal(t, []presenter.SnippetSegment{{Text: "京都", Highlighted: true}, {Text: "旅行"}}, resBody.Groups[0].Hits[0].Title)
+	})
+
+	t.Run("異常系: 検索語がない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/search", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: 件数が上限を超える", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/search?q=kyoto&limit=51", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: ユースケースから検証エラーが返される", func(t *testing.T) {
+		mockUsecase.EXPECT().Search(gomock.Any(), input.SearchInput{Query: " "}).Return(nil, apperr.NewValidationError("Search query must not be empty"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/search?q=+", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
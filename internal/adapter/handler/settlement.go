@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+type SettlementHandler struct {
+	usecase usecase.SettlementUsecase
+}
+
+func NewSettlementHandler(usecase usecase.SettlementUsecase) *SettlementHandler {
+	return &SettlementHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *SettlementHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/settlement", handler.get)
+	router.POST("/trips/:trip_id/settlement/transfers", handler.recordTransfer)
+}
+
+func (handler *SettlementHandler) get(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	settlementOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetSettlementResponse(settlementOutput))
+}
+
+func (handler *SettlementHandler) recordTransfer(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.RecordSettlementTransferJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	paidOn, err := parseDate(body.PaidOn)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	recorded, err := handler.usecase.RecordTransfer(c.Request.Context(), input.RecordSettlementTransferInput{
+		TripID:      uriParams.TripID,
+		From:        body.From,
+		To:          body.To,
+		Amount:      body.Amount,
+		AmountMinor: body.AmountMinor,
+		Currency:    body.Currency,
+		PaidOn:      paidOn,
+		Description: body.Description,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateExpenseResponse{ID: recorded.ID})
+}
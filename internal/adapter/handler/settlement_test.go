@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const settlementTestTripID = "00000000-0000-0000-0000-000000000001"
+
+func setupSettlementHandler(t *testing.T) (*gin.Engine, *mock_handler.MockSettlementUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockSettlementUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewSettlementHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestSettlementHandler_Get(t *testing.T) {
+	r, mockUsecase := setupSettlementHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), settlementTestTripID).Return(&output.GetSettlementOutput{
+			Settlements: []*output.Settlement{{
+				Currency: "JPY",
+				Balances: []*output.SettlementBalance{
+					{Participant: "Alice", Amount: output.Money{Amount: 1000, Decimal: "1000", Currency: "JPY"}},
+					{Participant: "Bob", Amount: output.Money{Amount: -1000, Decimal: "-1000", Currency: "JPY"}},
+				},
+				Transfers: []*output.SettlementTransfer{
+					{From: "Bob", To: "Alice", Amount: output.Money{Amount: 1000, Decimal: "1000", Currency: "JPY"}},
+				},
+			}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+settlementTestTripID+"/settlement", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.GetSettlementResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody.Settlements, 1)
+		assert.Equal(t, "JPY", resBody.Settlements[0].Currency)
+		assert.Equal(t, int64(-1000), resBody.Settlements[0].Balances[1].Amount.Amount)
+		assert.Equal(t, presenter.SettlementTransfer{
+			From:   "Bob",
+			To:     "Alice",
+			Amount: presenter.Money{Amount: 1000, Decimal: "1000", Currency: "JPY"},
+		}, resBody.Settlements[0].Transfers[0])
+	})
+
+	t.Run("異常系: 旅行が存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), settlementTestTripID).Return(nil, trip.NewTripNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+settlementTestTripID+"/settlement", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestSettlementHandler_RecordTransfer(t *testing.T) {
+	r, mockUsecase := setupSettlementHandler(t)
+
+	requestBody := gin.H{
+		"from":     "Bob",
+		"to":       "Alice",
+		"amount":   "1000",
+		"currency": "JPY",
+		"paid_on":  "2024-05-03",
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().RecordTransfer(gomock.Any(), input.RecordSettlementTransferInput{
+			TripID:   settlementTestTripID,
+			From:     "Bob",
+			To:       "Alice",
+			Amount:   "1000",
+			Currency: "JPY",
+			PaidOn:   time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC),
+		}).Return(&output.CreateExpenseOutput{ID: "expense-id"}, nil)
+
+		body, _ := json.Marshal(requestBody)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+settlementTestTripID+"/settlement/transfers", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody presenter.CreateExpenseResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, "expense-id", resBody.ID)
+	})
+
+	t.Run("異常系: 受取人が未指定", func(t *testing.T) {
+		invalid := gin.H{}
+		for k, v := range requestBody {
+			invalid[k] = v
+		}
+		delete(invalid, "to")
+
+		body, _ := json.Marshal(invalid)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+settlementTestTripID+"/settlement/transfers", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
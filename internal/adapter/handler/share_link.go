@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// ShareLinkPasswordHeader は共有リンクのパスワードを受け取るリクエストヘッダー。
+// URLに含めるとアクセスログ等に残るため、クエリパラメータでは受け取らない
+const ShareLinkPasswordHeader = "X-Share-Link-Password"
+
+// ShareLinkHandler は旅行の所有者向けの共有リンク管理を提供する
+type ShareLinkHandler struct {
+	usecase usecase.ShareLinkUsecase
+}
+
+func NewShareLinkHandler(usecase usecase.ShareLinkUsecase) *ShareLinkHandler {
+	return &ShareLinkHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *ShareLinkHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/share-links", handler.list)
+	router.POST("/trips/:trip_id/share-links", handler.create)
+	router.DELETE("/trips/:trip_id/share-links/:share_link_id", handler.revoke)
+}
+
+func (handler *ShareLinkHandler) list(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	linksOutput, err := handler.usecase.List(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListShareLinkResponse(linksOutput))
+}
+
+func (handler *ShareLinkHandler) create(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.CreateShareLinkJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	createdLink, err := handler.usecase.Create(c.Request.Context(), input.CreateShareLinkInput{
+		TripID:    uriParams.TripID,
+		ExpiresAt: body.ExpiresAt,
+		Password:  body.Password,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateShareLinkResponse{ID: createdLink.ID, Token: createdLink.Token})
+}
+
+func (handler *ShareLinkHandler) revoke(c *gin.Context) {
+	var uriParams validator.ShareLinkURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Revoke(c.Request.Context(), uriParams.TripID, uriParams.ShareLinkID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+// PublicShareLinkHandler は認証なしで共有リンクから旅行を閲覧する手段を提供する
+type PublicShareLinkHandler struct {
+	usecase usecase.ShareLinkUsecase
+}
+
+func NewPublicShareLinkHandler(usecase usecase.ShareLinkUsecase) *PublicShareLinkHandler {
+	return &PublicShareLinkHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *PublicShareLinkHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/shared/:token", handler.get)
+}
+
+func (handler *PublicShareLinkHandler) get(c *gin.Context) {
+	var uriParams validator.SharedTripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	sharedOutput, err := handler.usecase.GetShared(c.Request.Context(), input.GetSharedTripInput{
+		Token:    uriParams.Token,
+		Password: c.GetHeader(ShareLinkPasswordHeader),
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetSharedTripResponse(sharedOutput))
+}
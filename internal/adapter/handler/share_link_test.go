@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/sharelink"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	shareLinkTestTripID = "00000000-0000-0000-0000-000000000001"
+	shareLinkTestID     = "00000000-0000-0000-0000-000000000004"
+)
+
+func setupShareLinkHandler(t *testing.T) (*gin.Engine, *mock_handler.MockShareLinkUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockShareLinkUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewShareLinkHandler(mockUsecase).RegisterAPI(r.Group("/"))
+	NewPublicShareLinkHandler(mockUsecase).RegisterAPI(r.Group("/public"))
+
+	return r, mockUsecase
+}
+
+func TestShareLinkHandler_Create(t *testing.T) {
+	r, mockUsecase := setupShareLinkHandler(t)
+	path := "/trips/" + shareLinkTestTripID + "/share-links"
+
+	t.Run("正常系", func(t *testing.T) {
+		expiresAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		mockUsecase.EXPECT().Create(gomock.Any(), input.CreateShareLinkInput{
+			TripID:    shareLinkTestTripID,
+			ExpiresAt: &expiresAt,
+			Password:  "password",
+		}).Return(&output.CreateShareLinkOutput{ID: shareLinkTestID, Token: "token"}, nil)
+
+		body, _ := json.Marshal(gin.H{"expires_at": "2024-06-01T00:00:00Z", "password": "password"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody presenter.CreateShareLinkResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, presenter.CreateShareLinkResponse{ID: shareLinkTestID, Token: "token"}, resBody)
+	})
+
+	t.Run("異常系: パスワードが短すぎる", func(t *testing.T) {
+		body, _ := json.Marshal(gin.H{"password": "short"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", path, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestShareLinkHandler_List(t *testing.T) {
+	r, mockUsecase := setupShareLinkHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockUsecase.EXPECT().List(gomock.Any(), shareLinkTestTripID).Return(&output.ListShareLinkOutput{
+			ShareLinks: []*output.ShareLink{{ID: shareLinkTestID, TripID: shareLinkTestTripID, Status: "active", CreatedAt: now}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+shareLinkTestTripID+"/share-links", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "token", "トークンやそのハッシュ値は一覧に含まれない")
+	})
+}
+
+func TestShareLinkHandler_Revoke(t *testing.T) {
+	r, mockUsecase := setupShareLinkHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Revoke(gomock.Any(), shareLinkTestTripID, shareLinkTestID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+shareLinkTestTripID+"/share-links/"+shareLinkTestID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestPublicShareLinkHandler_Get(t *testing.T) {
+	r, mockUsecase := setupShareLinkHandler(t)
+
+	t.Run("正常系: ヘッダーのパスワードが渡される", func(t *testing.T) {
+		startDate := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		endDate := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+		mockUsecase.EXPECT().GetShared(gomock.Any(), input.GetSharedTripInput{Token: "token", Password: "password"}).Return(&output.GetSharedTripOutput{
+			Trip: &output.SharedTrip{Name: "家族旅行", StartDate: &startDate, EndDate: &endDate},
+			Accommodations: []*output.SharedAccommodation{{
+				Name:       "ホテル",
+				Address:    "東京都",
+				CheckInAt:  startDate.Add(15 * time.Hour),
+				CheckOutAt: endDate.Add(10 * time.Hour),
+			}},
+			JournalEntries: []*output.SharedJournalEntry{{
+				Date:     startDate,
+				Title:    "初日",
+				BodyHTML: "<p>雷門で写真を撮った</p>",
+				Mood:     "great",
+			}},
+			Members: []*output.SharedMember{{Username: "owner", Role: "owner"}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/public/shared/token", nil)
+		req.Header.Set(ShareLinkPasswordHeader, "password")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.GetSharedTripResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.NotNil(t, resBody.Trip.StartDate)
+		assert.Equal(t, "2024-05-01", *resBody.Trip.StartDate)
+		assert.Equal(t, "2024-05-01T15:00:00Z", resBody.Accommodations[0].CheckInAt)
+		assert.Equal(t, []presenter.SharedJournalEntry{{
+			Date:     "2024-05-01",
+			Title:    "初日",
+			BodyHTML: "<p>雷門で写真を撮った</p>",
+			Mood:     "great",
+		}}, resBody.JournalEntries)
+		assert.Equal(t, []presenter.SharedMember{{Username: "owner", Role: "owner"}}, resBody.Members)
+		for _, field := range []string{"cost", "confirmation_number", "email"} {
+			assert.False(t, strings.Contains(w.Body.String(), field), "%s は公開されない", field)
+		}
+	})
+
+	t.Run("異常系: パスワードが一致しない", func(t *testing.T) {
+		mockUsecase.EXPECT().GetShared(gomock.Any(), gomock.Any()).Return(nil, sharelink.NewShareLinkPasswordMismatchError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/public/shared/token", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("異常系: 存在しない共有リンク", func(t *testing.T) {
+		mockUsecase.EXPECT().GetShared(gomock.Any(), gomock.Any()).Return(nil, sharelink.NewShareLinkNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/public/shared/unknown", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// TemplateHandler は旅行から作成する雛形の管理を提供する。雛形から旅行を作成するには POST /trips に template_id を指定する
+type TemplateHandler struct {
+	usecase usecase.TemplateUsecase
+}
+
+func NewTemplateHandler(usecase usecase.TemplateUsecase) *TemplateHandler {
+	return &TemplateHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *TemplateHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.POST("/trips/:trip_id/template", handler.create)
+	router.GET("/templates", handler.list)
+	router.GET("/templates/public", handler.listPublic)
+	router.GET("/templates/:template_id", handler.get)
+	router.PUT("/templates/:template_id", handler.update)
+	router.DELETE("/templates/:template_id", handler.delete)
+}
+
+func (handler *TemplateHandler) create(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.CreateTemplateJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	createdTemplate, err := handler.usecase.Create(c.Request.Context(), input.CreateTemplateInput{
+		TripID:      uriParams.TripID,
+		Name:        body.Name,
+		Description: body.Description,
+		Public:      body.Public,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateTemplateResponse{ID: createdTemplate.ID})
+}
+
+func (handler *TemplateHandler) list(c *gin.Context) {
+	templatesOutput, err := handler.usecase.List(c.Request.Context())
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListTemplateResponse(templatesOutput))
+}
+
+func (handler *TemplateHandler) listPublic(c *gin.Context) {
+	templatesOutput, err := handler.usecase.ListPublic(c.Request.Context())
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListTemplateResponse(templatesOutput))
+}
+
+func (handler *TemplateHandler) get(c *gin.Context) {
+	var uriParams validator.TemplateURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	templateOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TemplateID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetTemplateResponse(templateOutput))
+}
+
+func (handler *TemplateHandler) update(c *gin.Context) {
+	var uriParams validator.TemplateURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.UpdateTemplateJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Update(c.Request.Context(), input.UpdateTemplateInput{
+		ID:          uriParams.TemplateID,
+		Name:        body.Name,
+		Description: body.Description,
+		Public:      body.Public,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *TemplateHandler) delete(c *gin.Context) {
+	var uriParams validator.TemplateURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	if err := handler.usecase.Delete(c.Request.Context(), uriParams.TemplateID); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
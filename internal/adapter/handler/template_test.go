@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/triptemplate"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	templateTestTripID     = "00000000-0000-0000-0000-000000000001"
+	templateTestTemplateID = "00000000-0000-0000-0000-000000000002"
+)
+
+func setupTemplateHandler(t *testing.T) (*gin.Engine, *mock_handler.MockTemplateUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockTemplateUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewTemplateHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestTemplateHandler_Create(t *testing.T) {
+	r, mockUsecase := setupTemplateHandler(t)
+
+	t.Run("正常系: 旅行から雛形を作成する", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Create(gomock.Any(), input.CreateTemplateInput{TripID: templateTestTripID, Name: "京都2泊", Public: true}).
+			Return(&output.CreateTemplateOutput{ID: templateTestTemplateID}, nil)
+
+		body, _ := json.Marshal(gin.H{"name": "京都2泊", "public": true})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+templateTestTripID+"/template", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody presenter.CreateTemplateResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, templateTestTemplateID, resBody.ID)
+	})
+}
+
+func TestTemplateHandler_Get(t *testing.T) {
+	r, mockUsecase := setupTemplateHandler(t)
+
+	t.Run("正常系: 宿泊予約を日数と時刻で返す", func(t *testing.T) {
+		days := 3
+		mockUsecase.EXPECT().Get(gomock.Any(), templateTestTemplateID).Return(&output.GetTemplateOutput{
+			Template: &output.Template{
+				ID:   templateTestTemplateID,
+				Name: "京都2泊",
+				Days: &days,
+				Accommodations: []*output.TemplateAccommodation{{
+					Name:           "Hotel",
+					CheckInOffset:  15 * time.Hour,
+					CheckOutOffset: 58*time.Hour + 30*time.Minute,
+				}},
+			},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/templates/"+templateTestTemplateID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.GetTemplateResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody.Template.Accommodations, 1)
+		a := resBody.Template.Accommodations[0]
+		assert.Equal(t, 0, a.CheckInDay)
+		assert.Equal(t, "15:00", a.CheckInTime)
+		assert.Equal(t, 2, a.CheckOutDay)
+		assert.Equal(t, "10:30", a.CheckOutTime)
+		assert.Nil(t, resBody.Template.Budget)
+	})
+
+	t.Run("異常系: 閲覧できない雛形は 404 を返す", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), templateTestTemplateID).Return(nil, triptemplate.NewTemplateNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/templates/"+templateTestTemplateID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestTemplateHandler_List(t *testing.T) {
+	r, mockUsecase := setupTemplateHandler(t)
+
+	t.Run("正常系: 自分の雛形を返す", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any()).Return(&output.ListTemplateOutput{
+			Templates: []*output.Template{{ID: templateTestTemplateID, Name: "自分の雛形"}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/templates", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.ListTemplateResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody.Templates, 1)
+		assert.Equal(t, "自分の雛形", resBody.Templates[0].Name)
+	})
+
+	t.Run("正常系: 公開された雛形を返す", func(t *testing.T) {
+		mockUsecase.EXPECT().ListPublic(gomock.Any()).Return(&output.ListTemplateOutput{Templates: []*output.Template{}}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/templates/public", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"templates":[]}`, w.Body.String())
+	})
+}
+
+func TestTemplateHandler_UpdateAndDelete(t *testing.T) {
+	r, mockUsecase := setupTemplateHandler(t)
+
+	t.Run("正常系: 雛形を更新する", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Update(gomock.Any(), input.UpdateTemplateInput{ID: templateTestTemplateID, Name: "新しい名前", Description: "説明"}).
+			Return(nil)
+
+		body, _ := json.Marshal(gin.H{"name": "新しい名前", "description": "説明"})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", "/templates/"+templateTestTemplateID, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系: 名前がない場合は更新できない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("PUT", "/templates/"+templateTestTemplateID, bytes.NewBuffer([]byte(`{"public":true}`)))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: 所有者でなければ削除できない", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Delete(gomock.Any(), templateTestTemplateID).
+			Return(triptemplate.NewNotTemplateOwnerError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/templates/"+templateTestTemplateID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
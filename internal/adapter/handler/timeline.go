@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// TimelineHandler は旅行の予定を現地時刻で時系列に並べた一覧を提供する
+type TimelineHandler struct {
+	usecase usecase.TimelineUsecase
+}
+
+func NewTimelineHandler(usecase usecase.TimelineUsecase) *TimelineHandler {
+	return &TimelineHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *TimelineHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/timeline", handler.get)
+}
+
+func (handler *TimelineHandler) get(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var queryParams validator.TimelineQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	timelineOutput, err := handler.usecase.Get(c.Request.Context(), input.GetTimelineInput{
+		TripID:   uriParams.TripID,
+		TimeZone: queryParams.TimeZone,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetTimelineResponse(timelineOutput))
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTimelineHandler(t *testing.T) (*gin.Engine, *mock_handler.MockTimelineUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockTimelineUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewTimelineHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestTimelineHandler_Get(t *testing.T) {
+	r, mockUsecase := setupTimelineHandler(t)
+
+	t.Run("正常系: 項目を現地時刻とオフセットで返す", func(t *testing.T) {
+		newYork, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+		// 2026-11-01 は夏時間が終わるため、同じ 1 時台でもオフセットが異なる
+		first := time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC).In(newYork)
+		second := time.Date(2026, 11, 1, 6, 15, 0, 0, time.UTC).In(newYork)
+		end := time.Date(2026, 11, 1, 7, 0, 0, 0, time.UTC).In(newYork)
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+		departure := time.Date(2026, 11, 1, 16, 0, 0, 0, time.UTC).In(newYork)
+		arrival := time.Date(2026, 11, 2, 5, 0, 0, 0, time.UTC).In(tokyo)
+		mockUsecase.EXPECT().Get(gomock.Any(), input.GetTimelineInput{TripID: "trip-id", TimeZone: "Asia/Tokyo"}).
+			Return(&output.GetTimelineOutput{Items: []output.TimelineItem{
+				{Kind: "activity", ID: "activity-1", Title: "朝食", Timezone: "America/New_York", EndTimezone: "America/New_York", Date: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), StartAt: first},
+				{Kind: "activity", ID: "activity-2", Title: "散歩", Timezone: "America/New_York", EndTimezone: "America/New_York", Date: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), StartAt: second, EndAt: &end},
+				{Kind: "transport_leg", ID: "leg-1", Title: "flight: JFK → NRT", Timezone: "America/New_York", EndTimezone: "Asia/Tokyo", Date: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), StartAt: departure, EndAt: &arrival},
+			}}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/trip-id/timeline?tz=Asia/Tokyo", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"items":[
+			{"kind":"activity","id":"activity-1","title":"朝食","timezone":"America/New_York","end_timezone":"America/New_York","date":"2026-11-01","all_day":false,
+			 "start_at":"2026-11-01T01:30:00-04:00","end_at":null,"utc_offset":"-04:00","end_utc_offset":null},
+			{"kind":"activity","id":"activity-2","title":"散歩","timezone":"America/New_York","end_timezone":"America/New_York","date":"2026-11-01","all_day":false,
+			 "start_at":"2026-11-01T01:15:00-05:00","end_at":"2026-11-01T02:00:00-05:00","utc_offset":"-05:00","end_utc_offset":"-05:00"},
+			{"kind":"transport_leg","id":"leg-1","title":"flight: JFK → NRT","timezone":"America/New_York","end_timezone":"Asia/Tokyo","date":"2026-11-01","all_day":false,
+			 "start_at":"2026-11-01T11:00:00-05:00","end_at":"2026-11-02T14:00:00+09:00","utc_offset":"-05:00","end_utc_offset":"+09:00"}
+		]}`, w.Body.String())
+	})
+
+	t.Run("正常系: 項目がなければ空の配列を返す", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), input.GetTimelineInput{TripID: "trip-id"}).
+			Return(&output.GetTimelineOutput{Items: []output.TimelineItem{}}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/trip-id/timeline", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"items":[]}`, w.Body.String())
+	})
+
+	t.Run("異常系: タイムゾーン名が長すぎる", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/trip-id/timeline?tz="+strings.Repeat("a", 65), nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: IANA のタイムゾーン名でない", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, apperr.NewValidationError("Timezone must be an IANA time zone name"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/trip-id/timeline?tz=Mars/Olympus", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
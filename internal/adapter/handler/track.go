@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// TrackHandler は GPS で記録したトラック（GPX のアップロード・統計・GeoJSON での取得）を提供する
+type TrackHandler struct {
+	usecase       usecase.TrackUsecase
+	maxUploadSize int64
+}
+
+// NewTrackHandler はトラックのハンドラを作成する。maxUploadSize はアップロードできる GPX ファイル1つあたりの最大サイズ
+func NewTrackHandler(usecase usecase.TrackUsecase, maxUploadSize int64) *TrackHandler {
+	return &TrackHandler{
+		usecase:       usecase,
+		maxUploadSize: maxUploadSize,
+	}
+}
+
+func (handler *TrackHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/tracks/:track_id", handler.get)
+	router.GET("/trips/:trip_id/tracks/:track_id/geojson", handler.geoJSON)
+	router.GET("/trips/:trip_id/tracks", handler.list)
+	router.POST("/trips/:trip_id/tracks", handler.upload)
+	router.DELETE("/trips/:trip_id/tracks/:track_id", handler.delete)
+}
+
+func (handler *TrackHandler) get(c *gin.Context) {
+	var uriParams validator.TrackURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	trackOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID, uriParams.TrackID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetTrackResponse(trackOutput))
+}
+
+// geoJSON は間引いた軌跡を地図ライブラリでそのまま表示できる GeoJSON の Feature として返す
+func (handler *TrackHandler) geoJSON(c *gin.Context) {
+	var uriParams validator.TrackURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	trackOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID, uriParams.TrackID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.Header("Content-Type", routeContentTypes[service.RouteFormatGeoJSON])
+	c.JSON(http.StatusOK, presenter.NewTrackFeature(trackOutput))
+}
+
+func (handler *TrackHandler) list(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var queryParams validator.ListTrackQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	tracksOutput, err := handler.usecase.List(c.Request.Context(), uriParams.TripID, queryParams.ActivityID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListTrackResponse(tracksOutput))
+}
+
+func (handler *TrackHandler) upload(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.UploadTrackFormBody
+	cleanup, ok := bindMultipartWithLimit(c, handler.maxUploadSize, &body)
+	if !ok {
+		return
+	}
+	defer cleanup()
+
+	file, err := body.File.Open()
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+	defer file.Close()
+
+	uploadedOutput, err := handler.usecase.Upload(c.Request.Context(), input.UploadTrackInput{
+		TripID:     uriParams.TripID,
+		ActivityID: body.ActivityID,
+		Name:       body.Name,
+		Filename:   body.File.Filename,
+		Content:    file,
+		Size:       body.File.Size,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.NewUploadTrackResponse(uploadedOutput))
+}
+
+func (handler *TrackHandler) delete(c *gin.Context) {
+	var uriParams validator.TrackURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	if err := handler.usecase.Delete(c.Request.Context(), uriParams.TripID, uriParams.TrackID); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
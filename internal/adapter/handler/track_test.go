@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/domain/track"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	trackTestTripID     = "00000000-0000-0000-0000-000000000001"
+	trackTestTrackID    = "00000000-0000-0000-0000-000000000002"
+	trackTestActivityID = "00000000-0000-0000-0000-000000000003"
+	// trackTestMaxUploadSize はテストで使うアップロードの最大サイズ
+	trackTestMaxUploadSize = 1024
+)
+
+func setupTrackHandler(t *testing.T) (*gin.Engine, *mock_handler.MockTrackUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockTrackUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewTrackHandler(mockUsecase, trackTestMaxUploadSize).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func newTrackTestOutput() *output.Track {
+	startedAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	endedAt := startedAt.Add(90 * time.Minute)
+	activityID := trackTestActivityID
+	return &output.Track{
+		ID:         trackTestTrackID,
+		TripID:     trackTestTripID,
+		ActivityID: &activityID,
+		Name:       "高尾山",
+		Stats: output.TrackStats{
+			DistanceMeters:      4210.5,
+			MovingDuration:      75 * time.Minute,
+			ElapsedDuration:     90 * time.Minute,
+			ElevationGainMeters: 398,
+			ElevationLossMeters: 12,
+			MaxSpeed:            1.8,
+			StartedAt:           &startedAt,
+			EndedAt:             &endedAt,
+			Bounds:              output.TrackBounds{MinLatitude: 35.6251, MinLongitude: 139.2435, MaxLatitude: 35.6322, MaxLongitude: 139.2701},
+			PointCount:          540,
+		},
+		UploadedBy: "00000000-0000-0000-0000-000000000009",
+		CreatedAt:  time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestTrackHandler_Get(t *testing.T) {
+	r, mockUsecase := setupTrackHandler(t)
+
+	t.Run("正常系: 統計は秒と bbox で返す", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), trackTestTripID, trackTestTrackID).
+			Return(&output.GetTrackOutput{Track: newTrackTestOutput()}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+trackTestTripID+"/tracks/"+trackTestTrackID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody struct {
+			Track map[string]any `json:"track"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, trackTestActivityID, resBody.Track["activity_id"])
+		assert.Equal(t, "2024-05-01T09:00:00Z", resBody.Track["created_at"])
+		stats := resBody.Track["stats"].(map[string]any)
+		assert.Equal(t, 4500.0, stats["moving_seconds"])
+		assert.Equal(t, 5400.0, stats["elapsed_seconds"])
+		assert.Equal(t, 1.8, stats["max_speed_mps"])
+		assert.Equal(t, []any{139.2435, 35.6251, 139.2701, 35.6322}, stats["bbox"])
+	})
+
+	t.Run("異常系: トラックが存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), trackTestTripID, trackTestTrackID).Return(nil, track.NewTrackNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+trackTestTripID+"/tracks/"+trackTestTrackID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestTrackHandler_GeoJSON(t *testing.T) {
+	r, mockUsecase := setupTrackHandler(t)
+	url := "/trips/" + trackTestTripID + "/tracks/" + trackTestTrackID + "/geojson"
+
+	t.Run("正常系: 間引いた軌跡を MultiLineString の Feature として返す", func(t *testing.T) {
+		elevation := 201.5
+		mockUsecase.EXPECT().Get(gomock.Any(), trackTestTripID, trackTestTrackID).Return(&output.GetTrackOutput{
+			Track: newTrackTestOutput(),
+			Geometry: [][]output.TrackPoint{
+				{{Latitude: 35.6322, Longitude: 139.2701, Elevation: &elevation}, {Latitude: 35.631, Longitude: 139.265}},
+				{{Latitude: 35.6251, Longitude: 139.2435}},
+			},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/geo+json", w.Header().Get("Content-Type"))
+		var resBody struct {
+			Type     string    `json:"type"`
+			ID       string    `json:"id"`
+			BBox     []float64 `json:"bbox"`
+			Geometry struct {
+				Type        string          `json:"type"`
+				Coordinates json.RawMessage `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]any `json:"properties"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, "Feature", resBody.Type)
+		assert.Equal(t, trackTestTrackID, resBody.ID)
+		assert.Equal(t, []float64{139.2435, 35.6251, 139.2701, 35.6322}, resBody.BBox)
+		assert.Equal(t, "MultiLineString", resBody.Geometry.Type)
+		assert.JSONEq(t, `[[[139.2701,35.6322],[139.265,35.631]],[[139.2435,35.6251],[139.2435,35.6251]]]`, string(resBody.Geometry.Coordinates),
+			"標高のない点があれば標高は含めず、1 点だけのセグメントは同じ点を繰り返すべき")
+		assert.Equal(t, "高尾山", resBody.Properties["name"])
+		assert.Equal(t, 4210.5, resBody.Properties["stats"].(map[string]any)["distance_meters"])
+	})
+}
+
+func TestTrackHandler_List(t *testing.T) {
+	r, mockUsecase := setupTrackHandler(t)
+	url := "/trips/" + trackTestTripID + "/tracks"
+
+	t.Run("正常系: 行動を指定して取得できる", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any(), trackTestTripID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, activityID *string) (*output.ListTrackOutput, error) {
+				require.NotNil(t, activityID)
+				assert.Equal(t, trackTestActivityID, *activityID)
+				return &output.ListTrackOutput{Tracks: []*output.Track{newTrackTestOutput()}}, nil
+			})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url+"?activity_id="+trackTestActivityID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"tracks":[{`)
+	})
+
+	t.Run("異常系: activity_id が UUID でない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", url+"?activity_id=invalid", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTrackHandler_Upload(t *testing.T) {
+	r, mockUsecase := setupTrackHandler(t)
+	url := "/trips/" + trackTestTripID + "/tracks"
+
+	t.Run("正常系: ファイルの中身と行動・名前がユースケースに渡される", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Upload(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, in input.UploadTrackInput) (*output.UploadTrackOutput, error) {
+				assert.Equal(t, trackTestTripID, in.TripID)
+				require.NotNil(t, in.ActivityID)
+				assert.Equal(t, trackTestActivityID, *in.ActivityID)
+				assert.Equal(t, "高尾山", in.Name)
+				assert.Equal(t, "takao.gpx", in.Filename)
+				content, err := io.ReadAll(in.Content)
+				require.NoError(t, err)
+				assert.Equal(t, "<gpx/>", string(content))
+				return &output.UploadTrackOutput{Track: newTrackTestOutput()}, nil
+			})
+
+		w := httptest.NewRecorder()
+		fields := map[string]string{"activity_id": trackTestActivityID, "name": "高尾山"}
+		r.ServeHTTP(w, newMultipartRequest(t, url, fields, "takao.gpx", []byte("<gpx/>")))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Contains(t, w.Body.String(), `"track":`)
+	})
+
+	t.Run("異常系: GPX として読み取れないファイルは 400 になる", func(t *testing.T) {
+		mockUsecase.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(nil, track.NewInvalidFileError())
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, nil, "broken.gpx", []byte("gpx")))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("異常系: 最大サイズを超えるファイルは 413 になる", func(t *testing.T) {
+		mockUsecase.EXPECT().Upload(gomock.Any(), gomock.Any()).Return(nil, track.NewFileTooLargeError())
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, nil, "large.gpx", []byte("gpx")))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("異常系: ファイルがない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newMultipartRequest(t, url, map[string]string{"name": "高尾山"}, "", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTrackHandler_Delete(t *testing.T) {
+	r, mockUsecase := setupTrackHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Delete(gomock.Any(), trackTestTripID, trackTestTrackID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+trackTestTripID+"/tracks/"+trackTestTrackID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"message":"success"}`, w.Body.String())
+	})
+}
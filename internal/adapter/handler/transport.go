@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
+)
+
+// TransportHandler は旅行の移動（飛行機や列車など、出発地と到着地のタイムゾーンを持つ区間）を提供する
+type TransportHandler struct {
+	usecase usecase.TransportUsecase
+}
+
+func NewTransportHandler(usecase usecase.TransportUsecase) *TransportHandler {
+	return &TransportHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *TransportHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trips/:trip_id/transport-legs/:transport_leg_id", handler.get)
+	router.GET("/trips/:trip_id/transport-legs", handler.list)
+	router.POST("/trips/:trip_id/transport-legs", handler.create)
+	router.PUT("/trips/:trip_id/transport-legs/:transport_leg_id", handler.update)
+	router.DELETE("/trips/:trip_id/transport-legs/:transport_leg_id", handler.delete)
+}
+
+func (handler *TransportHandler) get(c *gin.Context) {
+	var uriParams validator.TransportLegURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	legOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID, uriParams.TransportLegID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewGetTransportLegResponse(legOutput))
+}
+
+func (handler *TransportHandler) list(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	legsOutput, err := handler.usecase.List(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListTransportLegResponse(legsOutput))
+}
+
+func (handler *TransportHandler) create(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.CreateTransportLegJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	createdLeg, err := handler.usecase.Create(c.Request.Context(), input.CreateTransportLegInput{
+		TripID:      uriParams.TripID,
+		Mode:        body.Mode,
+		Origin:      newTransportPlaceInput(body.Origin),
+		Destination: newTransportPlaceInput(body.Destination),
+		DepartureAt: body.DepartureAt,
+		ArrivalAt:   body.ArrivalAt,
+		Carrier:     body.Carrier,
+		Number:      body.Number,
+		Notes:       body.Notes,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateTransportLegResponse{ID: createdLeg.ID})
+}
+
+func (handler *TransportHandler) update(c *gin.Context) {
+	var uriParams validator.TransportLegURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.UpdateTransportLegJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Update(c.Request.Context(), input.UpdateTransportLegInput{
+		ID:          uriParams.TransportLegID,
+		TripID:      uriParams.TripID,
+		Mode:        body.Mode,
+		Origin:      newTransportPlaceInput(body.Origin),
+		Destination: newTransportPlaceInput(body.Destination),
+		DepartureAt: body.DepartureAt,
+		ArrivalAt:   body.ArrivalAt,
+		Carrier:     body.Carrier,
+		Number:      body.Number,
+		Notes:       body.Notes,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *TransportHandler) delete(c *gin.Context) {
+	var uriParams validator.TransportLegURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Delete(c.Request.Context(), uriParams.TripID, uriParams.TransportLegID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func newTransportPlaceInput(place validator.TransportPlaceJSONBody) input.TransportPlaceInput {
+	return input.TransportPlaceInput{
+		Name:      place.Name,
+		Latitude:  place.Latitude,
+		Longitude: place.Longitude,
+		Timezone:  place.Timezone,
+	}
+}
@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	transportTestTripID = "00000000-0000-0000-0000-000000000001"
+	transportTestID     = "00000000-0000-0000-0000-000000000002"
+)
+
+func setupTransportHandler(t *testing.T) (*gin.Engine, *mock_handler.MockTransportUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockTransportUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewTransportHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestTransportHandler_Get(t *testing.T) {
+	r, mockUsecase := setupTransportHandler(t)
+
+	departureAt := time.Date(2024, 5, 1, 1, 0, 0, 0, time.UTC)
+	tokyo, honolulu := "Asia/Tokyo", "Pacific/Honolulu"
+	expectedOutput := &output.GetTransportLegOutput{
+		TransportLeg: &output.TransportLeg{
+			ID:          transportTestID,
+			TripID:      transportTestTripID,
+			Mode:        "flight",
+			Origin:      &output.ActivityPlace{Name: "羽田空港", Timezone: &tokyo},
+			Destination: &output.ActivityPlace{Name: "ダニエル・K・イノウエ国際空港", Timezone: &honolulu},
+			DepartureAt: departureAt,
+			ArrivalAt:   departureAt.Add(7 * time.Hour),
+			Carrier:     "ANA",
+			Number:      "NH186",
+			CreatedAt:   departureAt,
+			UpdatedAt:   departureAt,
+		},
+	}
+
+	t.Run("正常系: 出発地と到着地のタイムゾーンが返される", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), transportTestTripID, transportTestID).Return(expectedOutput, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+transportTestTripID+"/transport-legs/"+transportTestID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resBody presenter.GetTransportLegResponse
+		err := json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.NoError(t, err)
+		assert.Equal(t, transportTestID, resBody.TransportLeg.ID)
+		assert.Equal(t, "flight", resBody.TransportLeg.Mode)
+		assert.Equal(t, tokyo, *resBody.TransportLeg.Origin.Timezone)
+		assert.Equal(t, honolulu, *resBody.TransportLeg.Destination.Timezone)
+		assert.True(t, departureAt.Equal(resBody.TransportLeg.DepartureAt))
+	})
+
+	t.Run("異常系: 移動が存在しない", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Get(gomock.Any(), transportTestTripID, transportTestID).
+			Return(nil, transport.NewLegNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+transportTestTripID+"/transport-legs/"+transportTestID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestTransportHandler_List(t *testing.T) {
+	r, mockUsecase := setupTransportHandler(t)
+
+	t.Run("異常系: Internal server error", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any(), transportTestTripID).Return(nil, errors.New("some error"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+transportTestTripID+"/transport-legs", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestTransportHandler_Create(t *testing.T) {
+	r, mockUsecase := setupTransportHandler(t)
+
+	departureAt := time.Date(2024, 5, 1, 1, 0, 0, 0, time.UTC)
+	arrivalAt := departureAt.Add(7 * time.Hour)
+	requestBody := gin.H{
+		"mode":         "flight",
+		"origin":       gin.H{"name": "羽田空港", "timezone": "Asia/Tokyo"},
+		"destination":  gin.H{"name": "ダニエル・K・イノウエ国際空港", "timezone": "Pacific/Honolulu"},
+		"departure_at": departureAt.Format(time.RFC3339),
+		"arrival_at":   arrivalAt.Format(time.RFC3339),
+		"number":       "NH186",
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Create(gomock.Any(), input.CreateTransportLegInput{
+			TripID:      transportTestTripID,
+			Mode:        "flight",
+			Origin:      input.TransportPlaceInput{Name: "羽田空港", Timezone: "Asia/Tokyo"},
+			Destination: input.TransportPlaceInput{Name: "ダニエル・K・イノウエ国際空港", Timezone: "Pacific/Honolulu"},
+			DepartureAt: departureAt,
+			ArrivalAt:   arrivalAt,
+			Number:      "NH186",
+		}).Return(&output.CreateTransportLegOutput{ID: transportTestID}, nil)
+
+		body, _ := json.Marshal(requestBody)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+transportTestTripID+"/transport-legs", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody presenter.CreateTransportLegResponse
+		err := json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.NoError(t, err)
+		assert.Equal(t, transportTestID, resBody.ID)
+	})
+
+	t.Run("異常系: 不正な交通機関", func(t *testing.T) {
+		invalid := gin.H{}
+		for k, v := range requestBody {
+			invalid[k] = v
+		}
+		invalid["mode"] = "rocket"
+
+		body, _ := json.Marshal(invalid)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+transportTestTripID+"/transport-legs", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var resBody map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.NoError(t, err)
+		assert.Equal(t, "VALIDATION_ERROR", resBody["code"])
+	})
+}
+
+func TestTransportHandler_Delete(t *testing.T) {
+	r, mockUsecase := setupTransportHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Delete(gomock.Any(), transportTestTripID, transportTestID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+transportTestTripID+"/transport-legs/"+transportTestID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
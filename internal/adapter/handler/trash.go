@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/usecase"
+)
+
+// TrashHandler はゴミ箱に移した旅行の一覧、復元、完全な削除を提供する。旅行をゴミ箱に移すのは DELETE /trips/:trip_id
+type TrashHandler struct {
+	usecase usecase.TrashUsecase
+}
+
+func NewTrashHandler(usecase usecase.TrashUsecase) *TrashHandler {
+	return &TrashHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *TrashHandler) RegisterAPI(router *gin.RouterGroup) {
+	router.GET("/trash", handler.list)
+	router.POST("/trash/:trip_id/restore", handler.restore)
+	router.DELETE("/trash/:trip_id", handler.purge)
+}
+
+func (handler *TrashHandler) list(c *gin.Context) {
+	trashedOutput, err := handler.usecase.List(c.Request.Context())
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.NewListTrashedTripResponse(trashedOutput))
+}
+
+func (handler *TrashHandler) restore(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Restore(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+func (handler *TrashHandler) purge(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err := handler.usecase.Purge(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const trashTestTripID = "00000000-0000-0000-0000-000000000001"
+
+func setupTrashHandler(t *testing.T) (*gin.Engine, *mock_handler.MockTrashUsecase) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_handler.NewMockTrashUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	NewTrashHandler(mockUsecase).RegisterAPI(r.Group("/"))
+
+	return r, mockUsecase
+}
+
+func TestTrashHandler_List(t *testing.T) {
+	r, mockUsecase := setupTrashHandler(t)
+
+	t.Run("正常系: ゴミ箱に移した日時と完全に削除される日時を返す", func(t *testing.T) {
+		trashedAt := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+		purgeAt := trashedAt.AddDate(0, 0, 30)
+		mockUsecase.EXPECT().List(gomock.Any()).Return(&output.ListTrashedTripOutput{
+			Trips: []*output.TrashedTrip{{
+				Trip:      &output.Trip{ID: trashTestTripID, Name: "旅行", CreatedAt: trashedAt, UpdatedAt: trashedAt},
+				TrashedAt: trashedAt,
+				PurgeAt:   purgeAt,
+			}},
+		}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trash", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.ListTrashedTripResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		require.Len(t, resBody.Trips, 1)
+		assert.Equal(t, trashTestTripID, resBody.Trips[0].Trip.ID)
+		assert.True(t, trashedAt.Equal(resBody.Trips[0].TrashedAt))
+		assert.True(t, purgeAt.Equal(resBody.Trips[0].PurgeAt))
+	})
+}
+
+func TestTrashHandler_Restore(t *testing.T) {
+	r, mockUsecase := setupTrashHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Restore(gomock.Any(), trashTestTripID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trash/"+trashTestTripID+"/restore", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系: ゴミ箱にない旅行", func(t *testing.T) {
+		mockUsecase.EXPECT().Restore(gomock.Any(), trashTestTripID).Return(trip.NewTripNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trash/"+trashTestTripID+"/restore", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+}
+
+func TestTrashHandler_Purge(t *testing.T) {
+	r, mockUsecase := setupTrashHandler(t)
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Purge(gomock.Any(), trashTestTripID).Return(nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trash/"+trashTestTripID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -1,12 +1,17 @@
 package handler
 
 import (
+	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/hata0/travel-api/internal/adapter/patch"
 	"github.com/hata0/travel-api/internal/adapter/presenter"
 	"github.com/hata0/travel-api/internal/adapter/validator"
 	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/input"
 )
 
 type TripHandler struct {
@@ -24,7 +29,9 @@ func (handler *TripHandler) RegisterAPI(router *gin.RouterGroup) {
 	router.GET("/trips", handler.list)
 	router.POST("/trips", handler.create)
 	router.PUT("/trips/:trip_id", handler.update)
+	router.PATCH("/trips/:trip_id", handler.patch)
 	router.DELETE("/trips/:trip_id", handler.delete)
+	router.POST("/trips/:trip_id/duplicate", handler.duplicate)
 }
 
 func (handler *TripHandler) get(c *gin.Context) {
@@ -40,11 +47,37 @@ func (handler *TripHandler) get(c *gin.Context) {
 		return
 	}
 
+	c.Header(headerETag, formatETag(tripOutput.Trip.Version))
 	c.JSON(http.StatusOK, presenter.NewGetTripResponse(tripOutput))
 }
 
 func (handler *TripHandler) list(c *gin.Context) {
-	tripsOutput, err := handler.usecase.List(c.Request.Context())
+	var queryParams validator.ListTripQueryParameters
+	if err := c.ShouldBindQuery(&queryParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	from, err := parseOptionalDate(queryParams.From)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+	to, err := parseOptionalDate(queryParams.To)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	tripsOutput, err := handler.usecase.List(c.Request.Context(), input.ListTripInput{
+		Sort:       queryParams.Sort,
+		Order:      queryParams.Order,
+		NamePrefix: queryParams.NamePrefix,
+		From:       from,
+		To:         to,
+		Limit:      queryParams.Limit,
+		Cursor:     queryParams.Cursor,
+	})
 	if err != nil {
 		c.JSON(presenter.ConvertToHTTPError(err))
 		return
@@ -60,13 +93,56 @@ func (handler *TripHandler) create(c *gin.Context) {
 		return
 	}
 
-	createdTripID, err := handler.usecase.Create(c.Request.Context(), body.Name)
+	startDate, err := parseOptionalDate(body.StartDate)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+	endDate, err := parseOptionalDate(body.EndDate)
 	if err != nil {
 		c.JSON(presenter.ConvertToHTTPError(err))
 		return
 	}
 
-	c.JSON(http.StatusCreated, presenter.CreateTripResponse{ID: createdTripID})
+	createdTrip, err := handler.usecase.Create(c.Request.Context(), input.CreateTripInput{
+		Name:         body.Name,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		HomeCurrency: body.HomeCurrency,
+		TemplateID:   body.TemplateID,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateTripResponse{ID: createdTrip.ID})
+}
+
+func (handler *TripHandler) duplicate(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	var body validator.DuplicateTripJSONBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	createdTrip, err := handler.usecase.Duplicate(c.Request.Context(), input.DuplicateTripInput{
+		TripID:     uriParams.TripID,
+		Name:       body.Name,
+		OffsetDays: body.OffsetDays,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusCreated, presenter.CreateTripResponse{ID: createdTrip.ID})
 }
 
 func (handler *TripHandler) update(c *gin.Context) {
@@ -76,13 +152,131 @@ func (handler *TripHandler) update(c *gin.Context) {
 		return
 	}
 
+	version, err := handler.parseIfMatch(c, uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
 	var body validator.UpdateTripJSONBody
 	if err := c.ShouldBindJSON(&body); err != nil {
 		c.JSON(presenter.ConvertToHTTPError(err))
 		return
 	}
 
-	err := handler.usecase.Update(c.Request.Context(), uriParams.TripID, body.Name)
+	startDate, err := parseOptionalDate(body.StartDate)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+	endDate, err := parseOptionalDate(body.EndDate)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err = handler.usecase.Update(c.Request.Context(), input.UpdateTripInput{
+		ID:           uriParams.TripID,
+		Name:         body.Name,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		HomeCurrency: body.HomeCurrency,
+		Version:      version,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
+}
+
+// patch はリクエストボディのパッチを PUT のボディと同じ形の文書に適用し、その結果で旅行を更新する
+func (handler *TripHandler) patch(c *gin.Context) {
+	var uriParams validator.TripURIParameters
+	if err := c.ShouldBindUri(&uriParams); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	version, err := parseIfMatch(c)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	applyPatch, err := patch.ForMediaType(c.ContentType())
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	rawPatch, err := c.GetRawData()
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	tripOutput, err := handler.usecase.Get(c.Request.Context(), uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+	if version == anyVersion {
+		version = tripOutput.Trip.Version
+	}
+
+	var homeCurrency string
+	if tripOutput.Trip.HomeCurrency != nil {
+		homeCurrency = *tripOutput.Trip.HomeCurrency
+	}
+	doc, err := json.Marshal(validator.UpdateTripJSONBody{
+		Name:         tripOutput.Trip.Name,
+		StartDate:    formatOptionalDate(tripOutput.Trip.StartDate),
+		EndDate:      formatOptionalDate(tripOutput.Trip.EndDate),
+		HomeCurrency: homeCurrency,
+	})
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	patched, err := applyPatch(doc, rawPatch)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	// パッチ適用後の文書は PUT のボディと同じ規則で検証する
+	body, err := decodePatchedTrip(patched)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+	if err := binding.Validator.ValidateStruct(&body); err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	startDate, err := parseOptionalDate(body.StartDate)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+	endDate, err := parseOptionalDate(body.EndDate)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err = handler.usecase.Update(c.Request.Context(), input.UpdateTripInput{
+		ID:           uriParams.TripID,
+		Name:         body.Name,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		HomeCurrency: body.HomeCurrency,
+		Version:      version,
+	})
 	if err != nil {
 		c.JSON(presenter.ConvertToHTTPError(err))
 		return
@@ -98,7 +292,13 @@ func (handler *TripHandler) delete(c *gin.Context) {
 		return
 	}
 
-	err := handler.usecase.Delete(c.Request.Context(), uriParams.TripID)
+	version, err := handler.parseIfMatch(c, uriParams.TripID)
+	if err != nil {
+		c.JSON(presenter.ConvertToHTTPError(err))
+		return
+	}
+
+	err = handler.usecase.Delete(c.Request.Context(), uriParams.TripID, version)
 	if err != nil {
 		c.JSON(presenter.ConvertToHTTPError(err))
 		return
@@ -106,3 +306,60 @@ func (handler *TripHandler) delete(c *gin.Context) {
 
 	c.JSON(http.StatusOK, presenter.SuccessResponse{Message: "success"})
 }
+
+// parseIfMatch は If-Match ヘッダーから更新・削除の前提とするバージョンを取り出す。
+// "*" の場合は旅行の現在のバージョンを前提とする
+func (handler *TripHandler) parseIfMatch(c *gin.Context, tripID string) (int64, error) {
+	version, err := parseIfMatch(c)
+	if err != nil {
+		return 0, err
+	}
+	if version != anyVersion {
+		return version, nil
+	}
+
+	tripOutput, err := handler.usecase.Get(c.Request.Context(), tripID)
+	if err != nil {
+		return 0, err
+	}
+	return tripOutput.Trip.Version, nil
+}
+
+// decodePatchedTrip はパッチ適用後の文書を旅行の更新内容として読み込む。
+// パッチで未知のフィールドを追加された場合は、無視せずにそのフィールド名を示す UnknownFieldsError を返す
+func decodePatchedTrip(doc []byte) (validator.UpdateTripJSONBody, error) {
+	var body validator.UpdateTripJSONBody
+	if err := validator.CheckKnownFields(doc, body); err != nil {
+		return validator.UpdateTripJSONBody{}, err
+	}
+	if err := json.Unmarshal(doc, &body); err != nil {
+		return validator.UpdateTripJSONBody{}, err
+	}
+	return body, nil
+}
+
+// parseOptionalDate は YYYY-MM-DD 形式の日付文字列を解析する。未指定の場合は nil を返す
+func parseOptionalDate(value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	t, err := parseDate(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// formatOptionalDate は日付を YYYY-MM-DD 形式の文字列にする。未指定の場合は nil を返す
+func formatOptionalDate(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	s := value.Format("2006-01-02")
+	return &s
+}
+
+// parseDate は YYYY-MM-DD 形式の日付文字列を解析する
+func parseDate(value string) (time.Time, error) {
+	return time.Parse("2006-01-02", value)
+}
@@ -11,7 +11,10 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/hata0/travel-api/internal/adapter/presenter"
-	"github.com/hata0/travel-api/internal/domain"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/pagination"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
 	mock_handler "github.com/hata0/travel-api/internal/usecase/mock"
 	"github.com/hata0/travel-api/internal/usecase/output"
 	"github.com/stretchr/testify/assert"
@@ -30,9 +33,8 @@ func TestTripHandler_Get(t *testing.T) {
 
 	tripID := "00000000-0000-0000-0000-000000000001"
 	now := time.Now()
-	tripIDValue, _ := domain.NewTripID(tripID)
-	expectedTrip := domain.NewTrip(tripIDValue, "Test Trip", now, now)
-	expectedOutput := output.NewGetTripOutput(expectedTrip)
+	expectedTrip := trip.NewTrip(trip.NewTripID(tripID), "Test Trip", nil, "", now, now)
+	expectedOutput := output.NewGetTripOutput(expectedTrip, nil)
 
 	t.Run("正常系", func(t *testing.T) {
 		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(expectedOutput, nil)
@@ -42,6 +44,7 @@ func TestTripHandler_Get(t *testing.T) {
 		r.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"1"`, w.Header().Get("ETag"))
 
 		var resBody presenter.GetTripResponse
 		err := json.Unmarshal(w.Body.Bytes(), &resBody)
@@ -52,14 +55,27 @@ func TestTripHandler_Get(t *testing.T) {
 		assert.WithinDuration(t, expectedOutput.Trip.UpdatedAt, resBody.Trip.UpdatedAt, time.Second)
 	})
 
+	t.Run("正常系: ETag ヘッダーに旅行の現在のバージョンを返す", func(t *testing.T) {
+		updatedTrip := trip.RestoreTrip(trip.NewTripID(tripID), "Test Trip", nil, "", 7, now, now)
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(output.NewGetTripOutput(updatedTrip, nil), nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips/"+tripID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"7"`, w.Header().Get("ETag"))
+	})
+
 	t.Run("異常系: Internal server error", func(t *testing.T) {
-		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(output.GetTripOutput{}, errors.New("some error"))
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(nil, errors.New("some error"))
 
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", "/trips/"+tripID, nil)
 		r.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Empty(t, w.Header().Get("ETag"))
 	})
 }
 
@@ -74,16 +90,15 @@ func TestTripHandler_List(t *testing.T) {
 	tripHandler.RegisterAPI(r.Group("/"))
 
 	now := time.Now()
-	tripID1, _ := domain.NewTripID("00000000-0000-0000-0000-000000000001")
-	tripID2, _ := domain.NewTripID("00000000-0000-0000-0000-000000000002")
-	expectedTrips := []domain.Trip{
-		domain.NewTrip(tripID1, "Trip 1", now, now),
-		domain.NewTrip(tripID2, "Trip 2", now, now),
-	}
-	expectedOutput := output.NewListTripOutput(expectedTrips)
+	expectedOutput := output.NewListTripOutput(&pagination.Page[*trip.Trip]{
+		Items: []*trip.Trip{
+			trip.NewTrip(trip.NewTripID("00000000-0000-0000-0000-000000000001"), "Trip 1", nil, "", now, now),
+			trip.NewTrip(trip.NewTripID("00000000-0000-0000-0000-000000000002"), "Trip 2", nil, "", now, now),
+		},
+	})
 
 	t.Run("正常系: 複数のレコードが存在する", func(t *testing.T) {
-		mockUsecase.EXPECT().List(gomock.Any()).Return(expectedOutput, nil)
+		mockUsecase.EXPECT().List(gomock.Any(), gomock.Any()).Return(expectedOutput, nil)
 
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", "/trips", nil)
@@ -101,7 +116,7 @@ func TestTripHandler_List(t *testing.T) {
 	})
 
 	t.Run("正常系: レコードが存在しない", func(t *testing.T) {
-		mockUsecase.EXPECT().List(gomock.Any()).Return(output.ListTripOutput{Trips: []output.Trip{}}, nil)
+		mockUsecase.EXPECT().List(gomock.Any(), gomock.Any()).Return(&output.ListTripOutput{Trips: []*output.Trip{}}, nil)
 
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", "/trips", nil)
@@ -114,8 +129,75 @@ func TestTripHandler_List(t *testing.T) {
 		assert.Empty(t, resBody.Trips)
 	})
 
+	t.Run("正常系: カーソルと件数をユースケースに渡し、次のページのカーソルを返す", func(t *testing.T) {
+		nextCursor := "next-cursor"
+		mockUsecase.EXPECT().List(gomock.Any(), input.ListTripInput{Limit: 2, Cursor: "current-cursor"}).
+			Return(&output.ListTripOutput{Trips: expectedOutput.Trips, NextCursor: &nextCursor}, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips?limit=2&cursor=current-cursor", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody presenter.ListTripResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Len(t, resBody.Trips, 2)
+		if assert.NotNil(t, resBody.NextCursor) {
+			assert.Equal(t, nextCursor, *resBody.NextCursor)
+		}
+	})
+
+	t.Run("正常系: 最後のページでは次のページのカーソルを null で返す", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any(), input.ListTripInput{Cursor: "last-cursor"}).Return(expectedOutput, nil)
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips?cursor=last-cursor", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resBody map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Contains(t, resBody, "next_cursor")
+		assert.Nil(t, resBody["next_cursor"])
+	})
+
+	invalidLimits := []struct {
+		name  string
+		query string
+	}{
+		{name: "異常系: 件数が上限を超える", query: "limit=101"},
+		{name: "異常系: 件数が負の数", query: "limit=-1"},
+		{name: "異常系: 件数が数値でない", query: "limit=abc"},
+	}
+	for _, tt := range invalidLimits {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/trips?"+tt.query, nil)
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			var resBody map[string]interface{}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+			assert.Equal(t, "VALIDATION_ERROR", resBody["code"])
+		})
+	}
+
+	t.Run("異常系: 不正なカーソルは 400 を返す", func(t *testing.T) {
+		mockUsecase.EXPECT().List(gomock.Any(), input.ListTripInput{Cursor: "malformed-cursor"}).
+			Return(nil, apperr.NewValidationError("cursor is invalid or does not match the requested sort order"))
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/trips?cursor=malformed-cursor", nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var resBody map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, "VALIDATION_ERROR", resBody["code"])
+	})
+
 	t.Run("異常系: Internal server error", func(t *testing.T) {
-		mockUsecase.EXPECT().List(gomock.Any()).Return(output.ListTripOutput{}, errors.New("some error"))
+		mockUsecase.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
 
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("GET", "/trips", nil)
@@ -138,7 +220,7 @@ func TestTripHandler_Create(t *testing.T) {
 	tripName := "New Trip"
 
 	t.Run("正常系", func(t *testing.T) {
-		mockUsecase.EXPECT().Create(gomock.Any(), tripName).Return("new-id", nil)
+		mockUsecase.EXPECT().Create(gomock.Any(), input.CreateTripInput{Name: tripName}).Return(&output.CreateTripOutput{ID: "new-id"}, nil)
 
 		body, _ := json.Marshal(gin.H{"name": tripName})
 		w := httptest.NewRecorder()
@@ -147,10 +229,13 @@ func TestTripHandler_Create(t *testing.T) {
 		r.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody presenter.CreateTripResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, "new-id", resBody.ID)
 	})
 
 	t.Run("異常系: Usecase error (Internal Server Error)", func(t *testing.T) {
-		mockUsecase.EXPECT().Create(gomock.Any(), tripName).Return("", errors.New("some error"))
+		mockUsecase.EXPECT().Create(gomock.Any(), input.CreateTripInput{Name: tripName}).Return(nil, errors.New("some error"))
 
 		body, _ := json.Marshal(gin.H{"name": tripName})
 		w := httptest.NewRecorder()
@@ -187,23 +272,89 @@ func TestTripHandler_Update(t *testing.T) {
 
 	tripID := "00000000-0000-0000-0000-000000000001"
 	updatedName := "Updated Trip"
+	currentOutput := &output.GetTripOutput{Trip: &output.Trip{ID: tripID, Name: "Current Trip", Version: 4}}
 
-	t.Run("正常系", func(t *testing.T) {
-		mockUsecase.EXPECT().Update(gomock.Any(), tripID, updatedName).Return(nil)
-
+	newUpdateRequest := func(ifMatch string) *http.Request {
 		body, _ := json.Marshal(gin.H{"name": updatedName})
-		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("PUT", "/trips/"+tripID, bytes.NewBuffer(body))
 		req.Header.Set("Content-Type", "application/json")
-		r.ServeHTTP(w, req)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		return req
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		mockUsecase.EXPECT().Update(gomock.Any(), input.UpdateTripInput{ID: tripID, Name: updatedName, Version: 1}).Return(nil)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newUpdateRequest(`"1"`))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("正常系: If-Match: * は現在のバージョンと一致する", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(currentOutput, nil)
+		mockUsecase.EXPECT().Update(gomock.Any(), input.UpdateTripInput{ID: tripID, Name: updatedName, Version: 4}).Return(nil)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newUpdateRequest("*"))
 
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("異常系: If-Match: * でも旅行が存在しなければ 404 を返す", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(nil, trip.NewTripNotFoundError())
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newUpdateRequest("*"))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("異常系: If-Match ヘッダーがない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newUpdateRequest(""))
+
+		assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+		var resBody presenter.Error
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, "PRECONDITION_REQUIRED", resBody.Code)
+	})
+
+	t.Run("異常系: 引用符で囲まれていない If-Match は前提条件の不一致になる", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newUpdateRequest("1"))
+
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	})
+
+	t.Run("異常系: 弱い ETag の If-Match は 400 を返す", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newUpdateRequest(`W/"1"`))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var resBody presenter.Error
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, "VALIDATION_ERROR", resBody.Code)
+	})
+
+	t.Run("異常系: バージョンが一致しない", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Update(gomock.Any(), input.UpdateTripInput{ID: tripID, Name: updatedName, Version: 1}).
+			Return(trip.NewTripVersionMismatchError())
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newUpdateRequest(`"1"`))
+
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	})
+
 	t.Run("異常系: 無効なJSONボディ", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("PUT", "/trips/"+tripID, bytes.NewBuffer([]byte(`{"name":`)))
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"1"`)
 		r.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -213,18 +364,171 @@ func TestTripHandler_Update(t *testing.T) {
 	})
 
 	t.Run("異常系: Internal server error", func(t *testing.T) {
-		mockUsecase.EXPECT().Update(gomock.Any(), tripID, updatedName).Return(errors.New("some error"))
+		mockUsecase.EXPECT().
+			Update(gomock.Any(), input.UpdateTripInput{ID: tripID, Name: updatedName, Version: 1}).
+			Return(errors.New("some error"))
 
-		body, _ := json.Marshal(gin.H{"name": updatedName})
 		w := httptest.NewRecorder()
-		req, _ := http.NewRequest("PUT", "/trips/"+tripID, bytes.NewBuffer(body))
-		req.Header.Set("Content-Type", "application/json")
-		r.ServeHTTP(w, req)
+		r.ServeHTTP(w, newUpdateRequest(`"1"`))
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
 }
 
+func TestTripHandler_Patch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_handler.NewMockTripUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	tripHandler := NewTripHandler(mockUsecase)
+	tripHandler.RegisterAPI(r.Group("/"))
+
+	tripID := "00000000-0000-0000-0000-000000000001"
+	startDate := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+	currentOutput := &output.GetTripOutput{
+		Trip: &output.Trip{ID: tripID, Name: "Current Trip", StartDate: &startDate, EndDate: &endDate, Version: 2},
+	}
+
+	newPatchRequest := func(contentType, body string) *http.Request {
+		req, _ := http.NewRequest("PATCH", "/trips/"+tripID, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("If-Match", `"2"`)
+		return req
+	}
+
+	t.Run("正常系: JSON Merge Patch で指定した項目だけを更新する", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(currentOutput, nil)
+		mockUsecase.EXPECT().Update(gomock.Any(), input.UpdateTripInput{
+			ID:        tripID,
+			Name:      "Patched Trip",
+			StartDate: &startDate,
+			EndDate:   &endDate,
+			Version:   2,
+		}).Return(nil)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newPatchRequest("application/merge-patch+json", `{"name":"Patched Trip"}`))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("正常系: JSON Merge Patch の null で期間を未定にする", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(currentOutput, nil)
+		mockUsecase.EXPECT().Update(gomock.Any(), input.UpdateTripInput{
+			ID:      tripID,
+			Name:    "Current Trip",
+			Version: 2,
+		}).Return(nil)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newPatchRequest("application/merge-patch+json", `{"start_date":null,"end_date":null}`))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("正常系: JSON Merge Patch で基準通貨を設定する", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(currentOutput, nil)
+		mockUsecase.EXPECT().Update(gomock.Any(), input.UpdateTripInput{
+			ID:           tripID,
+			Name:         "Current Trip",
+			StartDate:    &startDate,
+			EndDate:      &endDate,
+			HomeCurrency: "EUR",
+			Version:      2,
+		}).Return(nil)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newPatchRequest("application/merge-patch+json", `{"home_currency":"EUR"}`))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("正常系: JSON Patch で更新する", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(currentOutput, nil)
+		mockUsecase.EXPECT().Update(gomock.Any(), input.UpdateTripInput{
+			ID:        tripID,
+			Name:      "Patched Trip",
+			StartDate: &startDate,
+			EndDate:   &endDate,
+			Version:   2,
+		}).Return(nil)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newPatchRequest("application/json-patch+json", `[{"op":"replace","path":"/name","value":"Patched Trip"}]`))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系: パッチ適用後の文書がバリデーションに失敗する", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(currentOutput, nil)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newPatchRequest("application/merge-patch+json", `{"name":null,"end_date":"2024/05/03"}`))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var resBody presenter.Error
+		json.Unmarshal(w.Body.Bytes(), &resBody)
+		assert.Equal(t, "VALIDATION_ERROR", resBody.Code)
+		assert.Len(t, resBody.Details, 2)
+	})
+
+	t.Run("異常系: パッチで未知のフィールドを追加する", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(currentOutput, nil)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newPatchRequest("application/merge-patch+json", `{"nmae":"Patched Trip"}`))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var resBody struct {
+			Code    string                            `json:"code"`
+			Details []presenter.ValidationErrorDetail `json:"details"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, "VALIDATION_ERROR", resBody.Code)
+		assert.Equal(t, []presenter.ValidationErrorDetail{
+			{Field: "nmae", Message: "nmae is not a known field"},
+		}, resBody.Details)
+	})
+
+	t.Run("異常系: JSON Patch で追加した未知のフィールドをすべて示す", func(t *testing.T) {
+		mockUsecase.EXPECT().Get(gomock.Any(), tripID).Return(currentOutput, nil)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newPatchRequest("application/json-patch+json", `[{"op":"add","path":"/budget","value":1},{"op":"add","path":"/tags","value":[]}]`))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var resBody struct {
+			Code    string                            `json:"code"`
+			Details []presenter.ValidationErrorDetail `json:"details"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, "VALIDATION_ERROR", resBody.Code)
+		assert.Equal(t, []presenter.ValidationErrorDetail{
+			{Field: "budget", Message: "budget is not a known field"},
+			{Field: "tags", Message: "tags is not a known field"},
+		}, resBody.Details)
+	})
+
+	t.Run("異常系: 対応していないメディアタイプ", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, newPatchRequest("application/json", `{"name":"Patched Trip"}`))
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+
+	t.Run("異常系: If-Match ヘッダーがない", func(t *testing.T) {
+		req := newPatchRequest("application/merge-patch+json", `{"name":"Patched Trip"}`)
+		req.Header.Del("If-Match")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+	})
+}
+
 func TestTripHandler_Delete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -238,22 +542,84 @@ func TestTripHandler_Delete(t *testing.T) {
 	tripID := "00000000-0000-0000-0000-000000000001"
 
 	t.Run("正常系", func(t *testing.T) {
-		mockUsecase.EXPECT().Delete(gomock.Any(), tripID).Return(nil)
+		mockUsecase.EXPECT().Delete(gomock.Any(), tripID, int64(3)).Return(nil)
 
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("DELETE", "/trips/"+tripID, nil)
+		req.Header.Set("If-Match", `"3"`)
 		r.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("異常系: If-Match ヘッダーがない", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("DELETE", "/trips/"+tripID, nil)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+	})
+
 	t.Run("異常系: Internal server error", func(t *testing.T) {
-		mockUsecase.EXPECT().Delete(gomock.Any(), tripID).Return(errors.New("some error"))
+		mockUsecase.EXPECT().Delete(gomock.Any(), tripID, int64(1)).Return(errors.New("some error"))
 
 		w := httptest.NewRecorder()
 		req, _ := http.NewRequest("DELETE", "/trips/"+tripID, nil)
+		req.Header.Set("If-Match", `"1"`)
 		r.ServeHTTP(w, req)
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
 }
+
+func TestTripHandler_Duplicate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_handler.NewMockTripUsecase(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.Default()
+	tripHandler := NewTripHandler(mockUsecase)
+	tripHandler.RegisterAPI(r.Group("/"))
+
+	tripID := "00000000-0000-0000-0000-000000000001"
+
+	t.Run("正常系: 複製した旅行のIDを返す", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Duplicate(gomock.Any(), input.DuplicateTripInput{TripID: tripID, Name: "来年の旅行", OffsetDays: 365}).
+			Return(&output.CreateTripOutput{ID: "new-id"}, nil)
+
+		body, _ := json.Marshal(gin.H{"name": "来年の旅行", "offset_days": 365})
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+tripID+"/duplicate", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var resBody presenter.CreateTripResponse
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resBody))
+		assert.Equal(t, "new-id", resBody.ID)
+	})
+
+	t.Run("異常系: メンバーでない旅行は複製できない", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Duplicate(gomock.Any(), input.DuplicateTripInput{TripID: tripID}).
+			Return(nil, trip.NewTripNotFoundError())
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+tripID+"/duplicate", bytes.NewBuffer([]byte(`{}`)))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("異常系: ずらす日数が範囲外", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/trips/"+tripID+"/duplicate", bytes.NewBuffer([]byte(`{"offset_days":100000}`)))
+		req.Header.Set("Content-Type", "application/json")
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/presenter"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+)
+
+// AdminAPIKeyHeader は管理用エンドポイントの API キーを受け取るヘッダー
+const AdminAPIKeyHeader = "X-Admin-API-Key"
+
+// AdminAPIKeyMiddleware は管理用エンドポイントへのアクセスを API キーで制限するミドルウェアを返します。
+// apiKey が空の場合は管理用エンドポイントが無効になっているとみなし、すべてのリクエストを拒否します。
+func AdminAPIKeyMiddleware(apiKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if apiKey == "" {
+			slog.Warn("Admin endpoint accessed while admin API key is not configured")
+			c.JSON(presenter.ConvertToHTTPError(
+				apperr.NewInvalidCredentialsError("admin endpoints are disabled"),
+			))
+			c.Abort()
+			return
+		}
+
+		given := c.GetHeader(AdminAPIKeyHeader)
+		if subtle.ConstantTimeCompare([]byte(given), []byte(apiKey)) != 1 {
+			slog.Warn("Invalid admin API key")
+			c.JSON(presenter.ConvertToHTTPError(
+				apperr.NewInvalidCredentialsError("invalid admin api key"),
+			))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
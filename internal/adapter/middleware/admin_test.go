@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminAPIKeyMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const apiKey = "0123456789abcdef0123456789abcdef"
+
+	newRouter := func(key string) *gin.Engine {
+		r := gin.New()
+		r.Use(AdminAPIKeyMiddleware(key))
+		r.GET("/admin", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		return r
+	}
+
+	t.Run("正常系: 正しいAPIキー", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/admin", nil)
+		req.Header.Set(AdminAPIKeyHeader, apiKey)
+		newRouter(apiKey).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("異常系: APIキーが異なる", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/admin", nil)
+		req.Header.Set(AdminAPIKeyHeader, "wrong")
+		newRouter(apiKey).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("異常系: APIキーが未設定の場合は常に拒否する", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/admin", nil)
+		req.Header.Set(AdminAPIKeyHeader, "")
+		newRouter("").ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/hata0/travel-api/internal/adapter/presenter"
 	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/actor"
+	"github.com/hata0/travel-api/internal/domain/user"
 )
 
 func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
@@ -53,8 +55,19 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 		}
 
 		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-			// ユーザーIDをGinのコンテキストに設定
-			c.Set("user_id", claims["user_id"])
+			userID, ok := claims["user_id"].(string)
+			if !ok || userID == "" {
+				slog.Warn("JWT claims do not contain user_id")
+				c.JSON(presenter.ConvertToHTTPError(
+					apperr.NewInvalidCredentialsError("jwt claims do not contain user_id"),
+				))
+				c.Abort()
+				return
+			}
+
+			// ユーザーIDをGinのコンテキストと、ユースケースが参照するリクエストのコンテキストに設定
+			c.Set("user_id", userID)
+			c.Request = c.Request.WithContext(actor.WithUserID(c.Request.Context(), user.NewUserID(userID)))
 			c.Next()
 		} else {
 			slog.Warn("Invalid JWT claims or token not valid", "claims", claims, "valid", token.Valid)
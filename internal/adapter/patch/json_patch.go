@@ -0,0 +1,320 @@
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+)
+
+// MediaTypeJSONPatch は JSON Patch（RFC 6902）のメディアタイプ
+const MediaTypeJSONPatch = "application/json-patch+json"
+
+// operation は JSON Patch の 1 操作。value は null を指定できるため、指定の有無を RawMessage で区別する
+type operation struct {
+	Op    string          `json:"op"`
+	Path  *string         `json:"path"`
+	From  *string         `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// ApplyJSONPatch は JSON 文書 doc に JSON Patch の操作を先頭から順に適用した結果を返す。
+// いずれかの操作が失敗した場合は文書全体を変更しない。test 操作の不一致は競合として扱う
+func ApplyJSONPatch(doc, patch []byte) ([]byte, error) {
+	var target any
+	if err := json.Unmarshal(doc, &target); err != nil {
+		return nil, apperr.NewInternalError("Failed to decode document to patch", apperr.WithCause(err))
+	}
+
+	var operations []operation
+	if err := json.Unmarshal(patch, &operations); err != nil {
+		return nil, apperr.NewValidationError("JSON patch must be an array of operations", apperr.WithCause(err))
+	}
+
+	for i, op := range operations {
+		var err error
+		target, err = op.apply(target)
+		if err != nil {
+			if appErr := apperr.GetAppError(err); appErr != nil && appErr.Code() == apperr.CodeConflict {
+				return nil, err
+			}
+			return nil, apperr.NewValidationError(fmt.Sprintf("JSON patch operation %d (%s) is invalid: %s", i, op.Op, err.Error()))
+		}
+	}
+
+	patched, err := json.Marshal(target)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to encode patched document", apperr.WithCause(err))
+	}
+	return patched, nil
+}
+
+func (op operation) apply(doc any) (any, error) {
+	if op.Path == nil {
+		return nil, fmt.Errorf("path is required")
+	}
+	path, err := parsePointer(*op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "add":
+		value, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+	case "remove":
+		doc, _, err := remove(doc, path)
+		return doc, err
+	case "replace":
+		value, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := get(doc, path); err != nil {
+			return nil, err
+		}
+		if len(path) == 0 {
+			return value, nil
+		}
+		doc, _, err = remove(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+	case "move":
+		from, err := op.from()
+		if err != nil {
+			return nil, err
+		}
+		if isProperPrefix(from, path) {
+			return nil, fmt.Errorf("cannot move a value into one of its children")
+		}
+		doc, value, err := remove(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+	case "copy":
+		from, err := op.from()
+		if err != nil {
+			return nil, err
+		}
+		value, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		copied, err := deepCopy(value)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, copied)
+	case "test":
+		value, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		actual, err := get(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(actual, value) {
+			return nil, apperr.NewConflictError(fmt.Sprintf("JSON patch test failed at %q", *op.Path))
+		}
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("unsupported op")
+	}
+}
+
+func (op operation) value() (any, error) {
+	if op.Value == nil {
+		return nil, fmt.Errorf("value is required")
+	}
+	var value any
+	if err := json.Unmarshal(op.Value, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (op operation) from() ([]string, error) {
+	if op.From == nil {
+		return nil, fmt.Errorf("from is required")
+	}
+	return parsePointer(*op.From)
+}
+
+// parsePointer は JSON Pointer（RFC 6901）を参照トークンの列に分解する。空文字列は文書全体を指す
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("JSON pointer %q must start with '/'", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func isProperPrefix(prefix, path []string) bool {
+	if len(prefix) >= len(path) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func get(doc any, path []string) (any, error) {
+	current := doc
+	for _, token := range path {
+		switch node := current.(type) {
+		case map[string]any:
+			child, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("member %q does not exist", token)
+			}
+			current = child
+		case []any:
+			index, err := parseIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			current = node[index]
+		default:
+			return nil, fmt.Errorf("cannot refer to %q in a scalar value", token)
+		}
+	}
+	return current, nil
+}
+
+// add は path の位置に value を追加した文書を返す。配列では指定位置に挿入し、"-" は末尾への追加を表す
+func add(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	token, rest := path[0], path[1:]
+
+	switch node := doc.(type) {
+	case map[string]any:
+		if len(rest) == 0 {
+			node[token] = value
+			return node, nil
+		}
+		child, ok := node[token]
+		if !ok {
+			return nil, fmt.Errorf("member %q does not exist", token)
+		}
+		updated, err := add(child, rest, value)
+		if err != nil {
+			return nil, err
+		}
+		node[token] = updated
+		return node, nil
+	case []any:
+		if len(rest) == 0 {
+			index := len(node)
+			if token != "-" {
+				var err error
+				if index, err = parseIndex(token, len(node)); err != nil {
+					return nil, err
+				}
+			}
+			node = append(node, nil)
+			copy(node[index+1:], node[index:])
+			node[index] = value
+			return node, nil
+		}
+		index, err := parseIndex(token, len(node)-1)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := add(node[index], rest, value)
+		if err != nil {
+			return nil, err
+		}
+		node[index] = updated
+		return node, nil
+	default:
+		return nil, fmt.Errorf("cannot add %q to a scalar value", token)
+	}
+}
+
+// remove は path の位置の値を取り除いた文書と、取り除いた値を返す
+func remove(doc any, path []string) (any, any, error) {
+	if len(path) == 0 {
+		return nil, nil, fmt.Errorf("cannot remove the whole document")
+	}
+	token, rest := path[0], path[1:]
+
+	switch node := doc.(type) {
+	case map[string]any:
+		child, ok := node[token]
+		if !ok {
+			return nil, nil, fmt.Errorf("member %q does not exist", token)
+		}
+		if len(rest) == 0 {
+			delete(node, token)
+			return node, child, nil
+		}
+		updated, removed, err := remove(child, rest)
+		if err != nil {
+			return nil, nil, err
+		}
+		node[token] = updated
+		return node, removed, nil
+	case []any:
+		index, err := parseIndex(token, len(node)-1)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rest) == 0 {
+			removed := node[index]
+			return append(node[:index], node[index+1:]...), removed, nil
+		}
+		updated, removed, err := remove(node[index], rest)
+		if err != nil {
+			return nil, nil, err
+		}
+		node[index] = updated
+		return node, removed, nil
+	default:
+		return nil, nil, fmt.Errorf("cannot remove %q from a scalar value", token)
+	}
+}
+
+// parseIndex は配列の添字を解析する。先頭の 0 や範囲外（max を超える値）は不正とする
+func parseIndex(token string, max int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || index > max {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	return index, nil
+}
+
+func deepCopy(value any) (any, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var copied any
+	if err := json.Unmarshal(encoded, &copied); err != nil {
+		return nil, err
+	}
+	return copied, nil
+}
@@ -0,0 +1,91 @@
+package patch
+
+import (
+	"testing"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyJSONPatch(t *testing.T) {
+	doc := `{"name":"Trip","start_date":"2024-05-01","items":["a","b"],"a~b/c":1}`
+
+	tests := []struct {
+		name  string
+		patch string
+		want  string
+	}{
+		{
+			name:  "正常系: replace",
+			patch: `[{"op":"replace","path":"/name","value":"Renamed"}]`,
+			want:  `{"name":"Renamed","start_date":"2024-05-01","items":["a","b"],"a~b/c":1}`,
+		},
+		{
+			name:  "正常系: add は既存のメンバーを置き換え、配列では指定位置に挿入する",
+			patch: `[{"op":"add","path":"/end_date","value":"2024-05-03"},{"op":"add","path":"/items/1","value":"x"},{"op":"add","path":"/items/-","value":"z"}]`,
+			want:  `{"name":"Trip","start_date":"2024-05-01","end_date":"2024-05-03","items":["a","x","b","z"],"a~b/c":1}`,
+		},
+		{
+			name:  "正常系: remove",
+			patch: `[{"op":"remove","path":"/start_date"},{"op":"remove","path":"/items/0"}]`,
+			want:  `{"name":"Trip","items":["b"],"a~b/c":1}`,
+		},
+		{
+			name:  "正常系: move と copy",
+			patch: `[{"op":"copy","from":"/name","path":"/title"},{"op":"move","from":"/start_date","path":"/end_date"}]`,
+			want:  `{"name":"Trip","title":"Trip","end_date":"2024-05-01","items":["a","b"],"a~b/c":1}`,
+		},
+		{
+			name:  "正常系: test が一致すれば後続の操作を適用する",
+			patch: `[{"op":"test","path":"/items","value":["a","b"]},{"op":"replace","path":"/name","value":"Tested"}]`,
+			want:  `{"name":"Tested","start_date":"2024-05-01","items":["a","b"],"a~b/c":1}`,
+		},
+		{
+			name:  "正常系: ~0 と ~1 をエスケープとして解釈する",
+			patch: `[{"op":"replace","path":"/a~0b~1c","value":2}]`,
+			want:  `{"name":"Trip","start_date":"2024-05-01","items":["a","b"],"a~b/c":2}`,
+		},
+		{
+			name:  "正常系: value に null を指定できる",
+			patch: `[{"op":"replace","path":"/start_date","value":null}]`,
+			want:  `{"name":"Trip","start_date":null,"items":["a","b"],"a~b/c":1}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplyJSONPatch([]byte(doc), []byte(tt.patch))
+
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+
+	invalidPatches := []struct {
+		name  string
+		patch string
+	}{
+		{name: "異常系: 操作の配列ではない", patch: `{"op":"remove","path":"/name"}`},
+		{name: "異常系: 未知の操作", patch: `[{"op":"merge","path":"/name","value":"x"}]`},
+		{name: "異常系: path がない", patch: `[{"op":"remove"}]`},
+		{name: "異常系: value がない", patch: `[{"op":"replace","path":"/name"}]`},
+		{name: "異常系: 存在しないメンバーの置き換え", patch: `[{"op":"replace","path":"/missing","value":"x"}]`},
+		{name: "異常系: 範囲外の添字", patch: `[{"op":"add","path":"/items/3","value":"x"}]`},
+		{name: "異常系: 先頭が 0 の添字", patch: `[{"op":"remove","path":"/items/01"}]`},
+		{name: "異常系: 自身の子への move", patch: `[{"op":"move","from":"/items","path":"/items/0"}]`},
+	}
+	for _, tt := range invalidPatches {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ApplyJSONPatch([]byte(doc), []byte(tt.patch))
+
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError), "got %v", err)
+		})
+	}
+
+	t.Run("異常系: test が一致しない場合は競合になる", func(t *testing.T) {
+		_, err := ApplyJSONPatch([]byte(doc), []byte(`[{"op":"test","path":"/name","value":"Other"}]`))
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeConflict))
+	})
+}
@@ -0,0 +1,52 @@
+package patch
+
+import (
+	"encoding/json"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+)
+
+// MediaTypeMergePatch は JSON Merge Patch（RFC 7396）のメディアタイプ
+const MediaTypeMergePatch = "application/merge-patch+json"
+
+// ApplyMergePatch は JSON 文書 doc に JSON Merge Patch を適用した結果を返す。
+// パッチ内の null はメンバーの削除を、オブジェクトは再帰的なマージを、それ以外の値は置き換えを表す
+func ApplyMergePatch(doc, patch []byte) ([]byte, error) {
+	var target any
+	if err := json.Unmarshal(doc, &target); err != nil {
+		return nil, apperr.NewInternalError("Failed to decode document to patch", apperr.WithCause(err))
+	}
+
+	var p any
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, apperr.NewValidationError("Merge patch must be a valid JSON document", apperr.WithCause(err))
+	}
+
+	patched, err := json.Marshal(mergePatch(target, p))
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to encode patched document", apperr.WithCause(err))
+	}
+	return patched, nil
+}
+
+// mergePatch は RFC 7396 の MergePatch 関数をそのまま実装したもの
+func mergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+			continue
+		}
+		targetObject[name] = mergePatch(targetObject[name], value)
+	}
+	return targetObject
+}
@@ -0,0 +1,55 @@
+package patch
+
+import (
+	"testing"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyMergePatch(t *testing.T) {
+	doc := `{"name":"Trip","start_date":"2024-05-01","end_date":"2024-05-03","tags":{"a":1,"b":2}}`
+
+	tests := []struct {
+		name  string
+		patch string
+		want  string
+	}{
+		{
+			name:  "正常系: 指定したメンバーだけを置き換える",
+			patch: `{"name":"Renamed"}`,
+			want:  `{"name":"Renamed","start_date":"2024-05-01","end_date":"2024-05-03","tags":{"a":1,"b":2}}`,
+		},
+		{
+			name:  "正常系: null を指定したメンバーを削除する",
+			patch: `{"start_date":null,"end_date":null}`,
+			want:  `{"name":"Trip","tags":{"a":1,"b":2}}`,
+		},
+		{
+			name:  "正常系: オブジェクトは再帰的にマージする",
+			patch: `{"tags":{"a":null,"c":3}}`,
+			want:  `{"name":"Trip","start_date":"2024-05-01","end_date":"2024-05-03","tags":{"b":2,"c":3}}`,
+		},
+		{
+			name:  "正常系: オブジェクト以外のパッチは文書全体を置き換える",
+			patch: `["a"]`,
+			want:  `["a"]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplyMergePatch([]byte(doc), []byte(tt.patch))
+
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+
+	t.Run("異常系: パッチが JSON として解釈できない", func(t *testing.T) {
+		_, err := ApplyMergePatch([]byte(doc), []byte(`{"name":`))
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
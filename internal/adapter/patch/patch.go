@@ -0,0 +1,19 @@
+// Package patch は JSON 文書の部分更新に使用するパッチ形式（JSON Merge Patch と JSON Patch）を扱う
+package patch
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+// ApplyFunc は JSON 文書 doc にパッチを適用した結果を返す
+type ApplyFunc func(doc, patch []byte) ([]byte, error)
+
+// ForMediaType はリクエストボディのメディアタイプに対応するパッチの適用方法を返す
+func ForMediaType(mediaType string) (ApplyFunc, error) {
+	switch mediaType {
+	case MediaTypeMergePatch:
+		return ApplyMergePatch, nil
+	case MediaTypeJSONPatch:
+		return ApplyJSONPatch, nil
+	default:
+		return nil, apperr.NewUnsupportedMediaTypeError("Patch document must be " + MediaTypeMergePatch + " or " + MediaTypeJSONPatch)
+	}
+}
@@ -0,0 +1,26 @@
+package patch
+
+import (
+	"testing"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForMediaType(t *testing.T) {
+	t.Run("正常系: 対応するメディアタイプ", func(t *testing.T) {
+		for _, mediaType := range []string{MediaTypeMergePatch, MediaTypeJSONPatch} {
+			apply, err := ForMediaType(mediaType)
+
+			require.NoError(t, err)
+			assert.NotNil(t, apply)
+		}
+	})
+
+	t.Run("異常系: 対応していないメディアタイプ", func(t *testing.T) {
+		_, err := ForMediaType("application/json")
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeUnsupportedMediaType))
+	})
+}
@@ -0,0 +1,103 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// Accommodation の timezone は宿泊先のタイムゾーンで、設定されていない場合は null
+	Accommodation struct {
+		ID                 string    `json:"id"`
+		TripID             string    `json:"trip_id"`
+		Name               string    `json:"name"`
+		Address            string    `json:"address"`
+		Timezone           *string   `json:"timezone"`
+		CheckInAt          time.Time `json:"check_in_at"`
+		CheckOutAt         time.Time `json:"check_out_at"`
+		ConfirmationNumber string    `json:"confirmation_number"`
+		CostAmount         int64     `json:"cost_amount"`
+		CostCurrency       string    `json:"cost_currency"`
+		Notes              string    `json:"notes"`
+		CreatedAt          time.Time `json:"created_at"`
+		UpdatedAt          time.Time `json:"updated_at"`
+	}
+
+	GetAccommodationResponse struct {
+		Accommodation Accommodation `json:"accommodation"`
+	}
+
+	ListAccommodationResponse struct {
+		Accommodations []Accommodation `json:"accommodations"`
+		// UncoveredNights は宿泊予約のない夜（YYYY-MM-DD）。旅行期間が未定の場合は null
+		UncoveredNights []string `json:"uncovered_nights"`
+	}
+
+	CreateAccommodationResponse struct {
+		ID string `json:"id"`
+	}
+)
+
+func NewGetAccommodationResponse(out *output.GetAccommodationOutput) GetAccommodationResponse {
+	return GetAccommodationResponse{
+		Accommodation: newAccommodation(out.Accommodation),
+	}
+}
+
+func NewListAccommodationResponse(out *output.ListAccommodationOutput) ListAccommodationResponse {
+	formatted := make([]Accommodation, len(out.Accommodations))
+	for i, a := range out.Accommodations {
+		formatted[i] = newAccommodation(a)
+	}
+
+	var uncoveredNights []string
+	if out.UncoveredNights != nil {
+		uncoveredNights = make([]string, len(out.UncoveredNights))
+		for i, night := range out.UncoveredNights {
+			uncoveredNights[i] = night.Format(dateLayout)
+		}
+	}
+
+	return ListAccommodationResponse{
+		Accommodations:  formatted,
+		UncoveredNights: uncoveredNights,
+	}
+}
+
+func newAccommodation(a *output.Accommodation) Accommodation {
+	return Accommodation{
+		ID:                 a.ID,
+		TripID:             a.TripID,
+		Name:               a.Name,
+		Address:            a.Address,
+		Timezone:           a.Timezone,
+		CheckInAt:          a.CheckInAt,
+		CheckOutAt:         a.CheckOutAt,
+		ConfirmationNumber: a.ConfirmationNumber,
+		CostAmount:         a.CostAmount,
+		CostCurrency:       a.CostCurrency,
+		Notes:              a.Notes,
+		CreatedAt:          a.CreatedAt,
+		UpdatedAt:          a.UpdatedAt,
+	}
+}
+
+// MarshalJSON は日時フィールドをRFC3339形式でフォーマットします。
+func (a Accommodation) MarshalJSON() ([]byte, error) {
+	type Alias Accommodation // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		CheckInAt  string `json:"check_in_at"`
+		CheckOutAt string `json:"check_out_at"`
+		CreatedAt  string `json:"created_at"`
+		UpdatedAt  string `json:"updated_at"`
+	}{
+		Alias:      (Alias)(a),
+		CheckInAt:  a.CheckInAt.Format(time.RFC3339Nano),
+		CheckOutAt: a.CheckOutAt.Format(time.RFC3339Nano),
+		CreatedAt:  a.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt:  a.UpdatedAt.Format(time.RFC3339Nano),
+	})
+}
@@ -0,0 +1,84 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// Attachment の target_id は target_type が trip の場合は旅行ID。content_type はアップロードされた内容から判定したもの
+	Attachment struct {
+		ID          string    `json:"id"`
+		TripID      string    `json:"trip_id"`
+		TargetType  string    `json:"target_type"`
+		TargetID    string    `json:"target_id"`
+		Filename    string    `json:"filename"`
+		ContentType string    `json:"content_type"`
+		Size        int64     `json:"size"`
+		UploadedBy  string    `json:"uploaded_by"`
+		CreatedAt   time.Time `json:"created_at"`
+	}
+
+	GetAttachmentResponse struct {
+		Attachment Attachment `json:"attachment"`
+	}
+
+	ListAttachmentResponse struct {
+		Attachments []Attachment `json:"attachments"`
+	}
+
+	UploadAttachmentResponse struct {
+		Attachment Attachment `json:"attachment"`
+	}
+)
+
+func NewGetAttachmentResponse(out *output.GetAttachmentOutput) GetAttachmentResponse {
+	return GetAttachmentResponse{
+		Attachment: newAttachment(out.Attachment),
+	}
+}
+
+func NewListAttachmentResponse(out *output.ListAttachmentOutput) ListAttachmentResponse {
+	formatted := make([]Attachment, len(out.Attachments))
+	for i, a := range out.Attachments {
+		formatted[i] = newAttachment(a)
+	}
+
+	return ListAttachmentResponse{
+		Attachments: formatted,
+	}
+}
+
+func NewUploadAttachmentResponse(out *output.UploadAttachmentOutput) UploadAttachmentResponse {
+	return UploadAttachmentResponse{
+		Attachment: newAttachment(out.Attachment),
+	}
+}
+
+func newAttachment(a *output.Attachment) Attachment {
+	return Attachment{
+		ID:          a.ID,
+		TripID:      a.TripID,
+		TargetType:  a.TargetType,
+		TargetID:    a.TargetID,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		UploadedBy:  a.UploadedBy,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+// MarshalJSON は日時フィールドをRFC3339形式でフォーマットします。
+func (a Attachment) MarshalJSON() ([]byte, error) {
+	type Alias Attachment // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		CreatedAt string `json:"created_at"`
+	}{
+		Alias:     (Alias)(a),
+		CreatedAt: a.CreatedAt.Format(time.RFC3339Nano),
+	})
+}
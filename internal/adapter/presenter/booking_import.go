@@ -0,0 +1,88 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type BookingTransportResponse struct {
+	// Mode は交通手段。読み取れなかった場合は空で、保存するとその他（other）になる
+	Mode      string `json:"mode"`
+	Carrier   string `json:"carrier"`
+	Number    string `json:"number"`
+	Departure string `json:"departure"`
+	Arrival   string `json:"arrival"`
+}
+
+type BookingDraftResponse struct {
+	Key                string     `json:"key"`
+	Kind               string     `json:"kind"`
+	Status             string     `json:"status"`
+	Title              string     `json:"title"`
+	Location           string     `json:"location"`
+	StartAt            *time.Time `json:"start_at"`
+	EndAt              *time.Time `json:"end_at"`
+	ConfirmationNumber string     `json:"confirmation_number"`
+	// PriceAmount と PriceCurrency は金額が読み取れなかった場合は null
+	PriceAmount   *int64                    `json:"price_amount"`
+	PriceCurrency *string                   `json:"price_currency"`
+	Notes         string                    `json:"notes"`
+	Transport     *BookingTransportResponse `json:"transport"`
+	// AccommodationID・TransportLegID・ActivityID は下書きから作成した宿泊予約・移動・行動の ID。保存していない場合は null
+	AccommodationID *string `json:"accommodation_id"`
+	TransportLegID  *string `json:"transport_leg_id"`
+	ActivityID      *string `json:"activity_id"`
+}
+
+type ImportBookingsResponse struct {
+	DryRun bool                   `json:"dry_run"`
+	Drafts []BookingDraftResponse `json:"drafts"`
+}
+
+func NewImportBookingsResponse(out *output.ImportBookingsOutput) ImportBookingsResponse {
+	drafts := make([]BookingDraftResponse, len(out.Drafts))
+	for i, d := range out.Drafts {
+		drafts[i] = BookingDraftResponse{
+			Key:                d.Key,
+			Kind:               d.Kind,
+			Status:             d.Status,
+			Title:              d.Title,
+			Location:           d.Location,
+			StartAt:            d.StartAt,
+			EndAt:              d.EndAt,
+			ConfirmationNumber: d.ConfirmationNumber,
+			PriceAmount:        d.PriceAmount,
+			Notes:              d.Notes,
+		}
+		if d.PriceAmount != nil {
+			currency := d.PriceCurrency
+			drafts[i].PriceCurrency = &currency
+		}
+		if d.Transport != nil {
+			drafts[i].Transport = &BookingTransportResponse{
+				Mode:      d.Transport.Mode.String(),
+				Carrier:   d.Transport.Carrier,
+				Number:    d.Transport.Number,
+				Departure: d.Transport.Departure,
+				Arrival:   d.Transport.Arrival,
+			}
+		}
+		drafts[i].AccommodationID = optionalID(d.AccommodationID)
+		drafts[i].TransportLegID = optionalID(d.TransportLegID)
+		drafts[i].ActivityID = optionalID(d.ActivityID)
+	}
+
+	return ImportBookingsResponse{
+		DryRun: out.DryRun,
+		Drafts: drafts,
+	}
+}
+
+// optionalID は保存していない下書きの空の ID を null として返す
+func optionalID(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,118 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// Money は通貨の最小単位の整数（amount）と10進数表記の文字列（decimal）を併せ持つ金額
+	Money struct {
+		Amount   int64  `json:"amount"`
+		Decimal  string `json:"decimal"`
+		Currency string `json:"currency"`
+	}
+
+	Budget struct {
+		TripID         string           `json:"trip_id"`
+		Currency       string           `json:"currency"`
+		CategoryLimits map[string]Money `json:"category_limits"`
+		CreatedAt      time.Time        `json:"created_at"`
+		UpdatedAt      time.Time        `json:"updated_at"`
+	}
+
+	GetBudgetResponse struct {
+		Budget Budget `json:"budget"`
+	}
+
+	BudgetCategorySummary struct {
+		Category string `json:"category"`
+		Spent    Money  `json:"spent"`
+		// Limit と Remaining は上限が設定されていないカテゴリでは null
+		Limit     *Money `json:"limit"`
+		Remaining *Money `json:"remaining"`
+	}
+
+	GetBudgetSummaryResponse struct {
+		Currency   string                  `json:"currency"`
+		Categories []BudgetCategorySummary `json:"categories"`
+		TotalLimit Money                   `json:"total_limit"`
+		TotalSpent Money                   `json:"total_spent"`
+		// Unconverted は為替レートが見つからず集計に含まれなかった支出の通貨別合計
+		Unconverted []Money `json:"unconverted"`
+	}
+)
+
+func NewGetBudgetResponse(out *output.GetBudgetOutput) GetBudgetResponse {
+	limits := make(map[string]Money, len(out.Budget.CategoryLimits))
+	for category, limit := range out.Budget.CategoryLimits {
+		limits[category] = newMoney(limit)
+	}
+
+	return GetBudgetResponse{
+		Budget: Budget{
+			TripID:         out.Budget.TripID,
+			Currency:       out.Budget.Currency,
+			CategoryLimits: limits,
+			CreatedAt:      out.Budget.CreatedAt,
+			UpdatedAt:      out.Budget.UpdatedAt,
+		},
+	}
+}
+
+func NewGetBudgetSummaryResponse(out *output.GetBudgetSummaryOutput) GetBudgetSummaryResponse {
+	categories := make([]BudgetCategorySummary, len(out.Categories))
+	for i, c := range out.Categories {
+		categories[i] = BudgetCategorySummary{
+			Category:  c.Category,
+			Spent:     newMoney(c.Spent),
+			Limit:     newOptionalMoney(c.Limit),
+			Remaining: newOptionalMoney(c.Remaining),
+		}
+	}
+
+	unconverted := make([]Money, len(out.Unconverted))
+	for i, m := range out.Unconverted {
+		unconverted[i] = newMoney(m)
+	}
+
+	return GetBudgetSummaryResponse{
+		Currency:    out.Currency,
+		Categories:  categories,
+		TotalLimit:  newMoney(out.TotalLimit),
+		TotalSpent:  newMoney(out.TotalSpent),
+		Unconverted: unconverted,
+	}
+}
+
+func newMoney(m output.Money) Money {
+	return Money{
+		Amount:   m.Amount,
+		Decimal:  m.Decimal,
+		Currency: m.Currency,
+	}
+}
+
+func newOptionalMoney(m *output.Money) *Money {
+	if m == nil {
+		return nil
+	}
+	formatted := newMoney(*m)
+	return &formatted
+}
+
+// MarshalJSON は日時フィールドをRFC3339形式でフォーマットします。
+func (b Budget) MarshalJSON() ([]byte, error) {
+	type Alias Budget // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	}{
+		Alias:     (Alias)(b),
+		CreatedAt: b.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: b.UpdatedAt.Format(time.RFC3339Nano),
+	})
+}
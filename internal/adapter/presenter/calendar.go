@@ -0,0 +1,44 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	CalendarFeed struct {
+		ID        string    `json:"id"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	GetCalendarFeedResponse struct {
+		CalendarFeed CalendarFeed `json:"calendar_feed"`
+	}
+
+	// IssueCalendarFeedResponse の token は再取得できないため、発行したクライアントが購読用の URL に組み込んで控えておく必要がある
+	IssueCalendarFeedResponse struct {
+		CalendarFeed CalendarFeed `json:"calendar_feed"`
+		Token        string       `json:"token"`
+	}
+)
+
+func NewGetCalendarFeedResponse(out *output.GetCalendarFeedOutput) GetCalendarFeedResponse {
+	return GetCalendarFeedResponse{
+		CalendarFeed: mapToCalendarFeed(out.CalendarFeed),
+	}
+}
+
+func NewIssueCalendarFeedResponse(out *output.IssueCalendarFeedOutput) IssueCalendarFeedResponse {
+	return IssueCalendarFeedResponse{
+		CalendarFeed: mapToCalendarFeed(out.CalendarFeed),
+		Token:        out.Token,
+	}
+}
+
+func mapToCalendarFeed(feed *output.CalendarFeed) CalendarFeed {
+	return CalendarFeed{
+		ID:        feed.ID,
+		CreatedAt: feed.CreatedAt,
+	}
+}
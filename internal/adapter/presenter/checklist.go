@@ -0,0 +1,163 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	Checklist struct {
+		ID        string            `json:"id"`
+		TripID    string            `json:"trip_id"`
+		Name      string            `json:"name"`
+		Kind      string            `json:"kind"`
+		Items     []ChecklistItem   `json:"items"`
+		Progress  ChecklistProgress `json:"progress"`
+		CreatedAt time.Time         `json:"created_at"`
+		UpdatedAt time.Time         `json:"updated_at"`
+	}
+
+	ChecklistItem struct {
+		ID       string `json:"id"`
+		Name     string `json:"name"`
+		Quantity int    `json:"quantity"`
+		Checked  bool   `json:"checked"`
+		// AssigneeID は担当者のユーザーID。担当者がいない場合は null
+		AssigneeID *string `json:"assignee_id"`
+	}
+
+	// ChecklistProgress の percent はチェック済みの項目の割合（0〜100、小数点以下切り捨て）
+	ChecklistProgress struct {
+		Total   int `json:"total"`
+		Checked int `json:"checked"`
+		Percent int `json:"percent"`
+	}
+
+	GetChecklistResponse struct {
+		Checklist Checklist `json:"checklist"`
+	}
+
+	ListChecklistResponse struct {
+		Checklists []Checklist `json:"checklists"`
+		// Progress は旅行のチェックリスト全体の進捗
+		Progress ChecklistProgress `json:"progress"`
+	}
+
+	CreateChecklistResponse struct {
+		ID string `json:"id"`
+	}
+
+	ItemTemplate struct {
+		ID       string `json:"id"`
+		Name     string `json:"name"`
+		Quantity int    `json:"quantity"`
+		// PerDay が true の場合、持ち物リストの生成時に quantity を旅行の日数倍する
+		PerDay    bool      `json:"per_day"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	ListItemTemplateResponse struct {
+		ItemTemplates []ItemTemplate `json:"item_templates"`
+	}
+
+	CreateItemTemplateResponse struct {
+		ID string `json:"id"`
+	}
+)
+
+func NewGetChecklistResponse(out *output.GetChecklistOutput) GetChecklistResponse {
+	return GetChecklistResponse{
+		Checklist: newChecklist(out.Checklist),
+	}
+}
+
+func NewListChecklistResponse(out *output.ListChecklistOutput) ListChecklistResponse {
+	formatted := make([]Checklist, len(out.Checklists))
+	for i, c := range out.Checklists {
+		formatted[i] = newChecklist(c)
+	}
+	return ListChecklistResponse{
+		Checklists: formatted,
+		Progress:   newChecklistProgress(out.Progress),
+	}
+}
+
+func NewListItemTemplateResponse(out *output.ListItemTemplateOutput) ListItemTemplateResponse {
+	formatted := make([]ItemTemplate, len(out.ItemTemplates))
+	for i, t := range out.ItemTemplates {
+		formatted[i] = ItemTemplate{
+			ID:        t.ID,
+			Name:      t.Name,
+			Quantity:  t.Quantity,
+			PerDay:    t.PerDay,
+			CreatedAt: t.CreatedAt,
+			UpdatedAt: t.UpdatedAt,
+		}
+	}
+	return ListItemTemplateResponse{
+		ItemTemplates: formatted,
+	}
+}
+
+func newChecklist(c *output.Checklist) Checklist {
+	items := make([]ChecklistItem, len(c.Items))
+	for i, item := range c.Items {
+		items[i] = ChecklistItem{
+			ID:         item.ID,
+			Name:       item.Name,
+			Quantity:   item.Quantity,
+			Checked:    item.Checked,
+			AssigneeID: item.AssigneeID,
+		}
+	}
+
+	return Checklist{
+		ID:        c.ID,
+		TripID:    c.TripID,
+		Name:      c.Name,
+		Kind:      c.Kind,
+		Items:     items,
+		Progress:  newChecklistProgress(c.Progress),
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func newChecklistProgress(p output.ChecklistProgress) ChecklistProgress {
+	return ChecklistProgress{
+		Total:   p.Total,
+		Checked: p.Checked,
+		Percent: p.Percent,
+	}
+}
+
+// MarshalJSON は日時フィールドをRFC3339形式でフォーマットします。
+func (c Checklist) MarshalJSON() ([]byte, error) {
+	type Alias Checklist // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	}{
+		Alias:     (Alias)(c),
+		CreatedAt: c.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: c.UpdatedAt.Format(time.RFC3339Nano),
+	})
+}
+
+// MarshalJSON は日時フィールドをRFC3339形式でフォーマットします。
+func (t ItemTemplate) MarshalJSON() ([]byte, error) {
+	type Alias ItemTemplate // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	}{
+		Alias:     (Alias)(t),
+		CreatedAt: t.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: t.UpdatedAt.Format(time.RFC3339Nano),
+	})
+}
@@ -7,10 +7,30 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
+	requestvalidator "github.com/hata0/travel-api/internal/adapter/validator"
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/calendarfeed"
+	"github.com/hata0/travel-api/internal/domain/checklist"
 	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/photo"
+	"github.com/hata0/travel-api/internal/domain/sharelink"
+	"github.com/hata0/travel-api/internal/domain/track"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/triptemplate"
+	"github.com/hata0/travel-api/internal/domain/user"
 )
 
 type Error struct {
@@ -43,11 +63,47 @@ func formatValidationErrors(errs validator.ValidationErrors) []ValidationErrorDe
 	return details
 }
 
+func formatUnknownFields(fields []string) []ValidationErrorDetail {
+	details := make([]ValidationErrorDetail, 0, len(fields))
+	for _, field := range fields {
+		details = append(details, ValidationErrorDetail{
+			Field:   field,
+			Message: fmt.Sprintf("%s is not a known field", field),
+		})
+	}
+	return details
+}
+
 var httpStatusMap = map[string]int{
-	apperr.CodeInvalidCredentials: http.StatusUnauthorized,
-	apperr.CodeNotFound:           http.StatusNotFound,
-	apperr.CodeConflict:           http.StatusConflict,
-	apperr.CodeInternalError:      http.StatusInternalServerError,
+	apperr.CodeValidationError:              http.StatusBadRequest,
+	apperr.CodeInvalidCredentials:           http.StatusUnauthorized,
+	apperr.CodeForbidden:                    http.StatusForbidden,
+	apperr.CodeConflict:                     http.StatusConflict,
+	apperr.CodePreconditionFailed:           http.StatusPreconditionFailed,
+	apperr.CodePreconditionRequired:         http.StatusPreconditionRequired,
+	apperr.CodeUnsupportedMediaType:         http.StatusUnsupportedMediaType,
+	apperr.CodePayloadTooLarge:              http.StatusRequestEntityTooLarge,
+	apperr.CodeInternalError:                http.StatusInternalServerError,
+	trip.CodeTripNotFound:                   http.StatusNotFound,
+	accommodation.CodeAccommodationNotFound: http.StatusNotFound,
+	expense.CodeExpenseNotFound:             http.StatusNotFound,
+	budget.CodeBudgetNotFound:               http.StatusNotFound,
+	exchangerate.CodeExchangeRateNotFound:   http.StatusNotFound,
+	membership.CodeMemberNotFound:           http.StatusNotFound,
+	membership.CodeInvitationNotFound:       http.StatusNotFound,
+	user.CodeUserNotFound:                   http.StatusNotFound,
+	sharelink.CodeShareLinkNotFound:         http.StatusNotFound,
+	history.CodeRevisionNotFound:            http.StatusNotFound,
+	triptemplate.CodeTemplateNotFound:       http.StatusNotFound,
+	checklist.CodeChecklistNotFound:         http.StatusNotFound,
+	checklist.CodeItemTemplateNotFound:      http.StatusNotFound,
+	journal.CodeJournalEntryNotFound:        http.StatusNotFound,
+	attachment.CodeAttachmentNotFound:       http.StatusNotFound,
+	photo.CodePhotoNotFound:                 http.StatusNotFound,
+	calendarfeed.CodeCalendarFeedNotFound:   http.StatusNotFound,
+	track.CodeTrackNotFound:                 http.StatusNotFound,
+	itinerary.CodeActivityNotFound:          http.StatusNotFound,
+	transport.CodeTransportLegNotFound:      http.StatusNotFound,
 }
 
 func getHTTPStatus(code string) int {
@@ -76,6 +132,16 @@ func ConvertToHTTPError(err error) (int, Error) {
 		}
 	}
 
+	var unknownFieldsErr *requestvalidator.UnknownFieldsError
+	if errors.As(err, &unknownFieldsErr) {
+		// 未知のフィールド: 他のバリデーションエラーと同じ形でフィールドごとに伝えます。
+		return http.StatusBadRequest, Error{
+			Code:    apperr.CodeValidationError,
+			Message: "input validation failed. please check the details field for more information.",
+			Details: formatUnknownFields(unknownFieldsErr.Fields),
+		}
+	}
+
 	var unmarshalTypeError *json.UnmarshalTypeError
 	if errors.As(err, &unmarshalTypeError) {
 		// JSONの型エラー: どのフィールドで問題があったかを具体的に伝えます。
@@ -95,24 +161,49 @@ func ConvertToHTTPError(err error) (int, Error) {
 		}
 	}
 
+	var numError *strconv.NumError
+	if errors.As(err, &numError) {
+		// パスやクエリの数値のパラメータに数値として読めない値が指定された場合。
+		return http.StatusBadRequest, Error{
+			Code:    apperr.CodeValidationError,
+			Message: fmt.Sprintf("invalid number %q provided", numError.Num),
+		}
+	}
+
+	var maxBytesError *http.MaxBytesError
+	if errors.As(err, &maxBytesError) {
+		// リクエストボディが上限を超えた場合は、読み込みを打ち切った時点でエラーになります。
+		return http.StatusRequestEntityTooLarge, Error{
+			Code:    apperr.CodePayloadTooLarge,
+			Message: fmt.Sprintf("the request body must not exceed %d bytes", maxBytesError.Limit),
+		}
+	}
+
+	if errors.Is(err, http.ErrNotMultipart) || errors.Is(err, http.ErrMissingBoundary) {
+		return http.StatusUnsupportedMediaType, Error{
+			Code:    apperr.CodeUnsupportedMediaType,
+			Message: "the request body must be multipart/form-data",
+		}
+	}
+
 	var appErr *apperr.AppError
 	if errors.As(err, &appErr) {
 		// アプリケーションで定義されたドメインエラー。
 		// 内部サーバーエラーの場合は、運用者が追跡できるよう詳細をログに出力します。
-		if appErr.Code == apperr.CodeInternalError {
+		if appErr.Code() == apperr.CodeInternalError {
 			slog.Error("Internal server error occurred", "details", appErr.Error())
 		}
 
-		if message, ok := safeMessageMap[appErr.Code]; ok {
-			return getHTTPStatus(appErr.Code), Error{
-				Code:    appErr.Code,
+		if message, ok := safeMessageMap[appErr.Code()]; ok {
+			return getHTTPStatus(appErr.Code()), Error{
+				Code:    appErr.Code(),
 				Message: message,
 			}
 		}
 
-		return getHTTPStatus(appErr.Code), Error{
-			Code:    appErr.Code,
-			Message: appErr.Message,
+		return getHTTPStatus(appErr.Code()), Error{
+			Code:    appErr.Code(),
+			Message: appErr.Message(),
 		}
 	}
 
@@ -0,0 +1,23 @@
+package presenter
+
+import (
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type ImportExchangeRatesResponse struct {
+	Imported int `json:"imported"`
+	// FromDate と ToDate は取り込んだレートが 1 件もない場合は null
+	FromDate *string `json:"from_date"`
+	ToDate   *string `json:"to_date"`
+}
+
+func NewImportExchangeRatesResponse(out *output.ImportExchangeRatesOutput) ImportExchangeRatesResponse {
+	res := ImportExchangeRatesResponse{Imported: out.Imported}
+	if out.Imported > 0 {
+		fromDate := out.FromDate.Format(dateLayout)
+		toDate := out.ToDate.Format(dateLayout)
+		res.FromDate = &fromDate
+		res.ToDate = &toDate
+	}
+	return res
+}
@@ -0,0 +1,121 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// Expense の金額は通貨の最小単位の整数（amount）と10進数表記の文字列（amount_decimal）の両方で返す
+	Expense struct {
+		ID            string    `json:"id"`
+		TripID        string    `json:"trip_id"`
+		Amount        int64     `json:"amount"`
+		AmountDecimal string    `json:"amount_decimal"`
+		Currency      string    `json:"currency"`
+		Category      string    `json:"category"`
+		SpentOn       time.Time `json:"spent_on"`
+		Payer         string    `json:"payer"`
+		Description   string    `json:"description"`
+		ActivityID    *string   `json:"activity_id"`
+		// Split は分担が設定されていない支出では null
+		Split     *ExpenseSplit `json:"split"`
+		CreatedAt time.Time     `json:"created_at"`
+		UpdatedAt time.Time     `json:"updated_at"`
+	}
+
+	ExpenseSplit struct {
+		Method  string              `json:"method"`
+		Entries []ExpenseSplitEntry `json:"entries"`
+	}
+
+	// ExpenseSplitEntry の share は支出額から配分された分担額
+	ExpenseSplitEntry struct {
+		Participant string `json:"participant"`
+		Value       int64  `json:"value"`
+		Share       Money  `json:"share"`
+	}
+
+	GetExpenseResponse struct {
+		Expense Expense `json:"expense"`
+	}
+
+	ListExpenseResponse struct {
+		Expenses []Expense `json:"expenses"`
+	}
+
+	CreateExpenseResponse struct {
+		ID string `json:"id"`
+	}
+)
+
+func NewGetExpenseResponse(out *output.GetExpenseOutput) GetExpenseResponse {
+	return GetExpenseResponse{
+		Expense: newExpense(out.Expense),
+	}
+}
+
+func NewListExpenseResponse(out *output.ListExpenseOutput) ListExpenseResponse {
+	formatted := make([]Expense, len(out.Expenses))
+	for i, e := range out.Expenses {
+		formatted[i] = newExpense(e)
+	}
+
+	return ListExpenseResponse{
+		Expenses: formatted,
+	}
+}
+
+func newExpense(e *output.Expense) Expense {
+	return Expense{
+		ID:            e.ID,
+		TripID:        e.TripID,
+		Amount:        e.Amount,
+		AmountDecimal: e.AmountDecimal,
+		Currency:      e.Currency,
+		Category:      e.Category,
+		SpentOn:       e.SpentOn,
+		Payer:         e.Payer,
+		Description:   e.Description,
+		ActivityID:    e.ActivityID,
+		Split:         newExpenseSplit(e.Split),
+		CreatedAt:     e.CreatedAt,
+		UpdatedAt:     e.UpdatedAt,
+	}
+}
+
+func newExpenseSplit(s *output.ExpenseSplit) *ExpenseSplit {
+	if s == nil {
+		return nil
+	}
+	entries := make([]ExpenseSplitEntry, len(s.Entries))
+	for i, entry := range s.Entries {
+		entries[i] = ExpenseSplitEntry{
+			Participant: entry.Participant,
+			Value:       entry.Value,
+			Share:       newMoney(entry.Share),
+		}
+	}
+	return &ExpenseSplit{
+		Method:  s.Method,
+		Entries: entries,
+	}
+}
+
+// MarshalJSON は支出日をYYYY-MM-DD形式、日時フィールドをRFC3339形式でフォーマットします。
+func (e Expense) MarshalJSON() ([]byte, error) {
+	type Alias Expense // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		SpentOn   string `json:"spent_on"`
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	}{
+		Alias:     (Alias)(e),
+		SpentOn:   e.SpentOn.Format(dateLayout),
+		CreatedAt: e.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: e.UpdatedAt.Format(time.RFC3339Nano),
+	})
+}
@@ -0,0 +1,83 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// RevisionChange の before と after は変更前後のリソースの内容。作成時の before と削除時の after は null になる
+	RevisionChange struct {
+		ResourceType  string          `json:"resource_type"`
+		ResourceID    string          `json:"resource_id"`
+		Action        string          `json:"action"`
+		ChangedFields []string        `json:"changed_fields"`
+		Before        json.RawMessage `json:"before"`
+		After         json.RawMessage `json:"after"`
+	}
+
+	// Revision の reverted_to は以前のリビジョンに戻す操作だった場合の戻した先のリビジョン番号
+	Revision struct {
+		Revision   int64            `json:"revision"`
+		ActorID    string           `json:"actor_id"`
+		RevertedTo *int64           `json:"reverted_to"`
+		Changes    []RevisionChange `json:"changes"`
+		CreatedAt  time.Time        `json:"created_at"`
+	}
+
+	ListHistoryResponse struct {
+		Revisions []Revision `json:"revisions"`
+	}
+
+	// RevertTripResponse の revision は戻した変更を記録したリビジョン。既に戻す先と同じ状態だった場合は null になる
+	RevertTripResponse struct {
+		Revision *Revision `json:"revision"`
+	}
+)
+
+func NewListHistoryResponse(out *output.ListHistoryOutput) ListHistoryResponse {
+	formatted := make([]Revision, len(out.Revisions))
+	for i, r := range out.Revisions {
+		formatted[i] = newRevision(r)
+	}
+	return ListHistoryResponse{
+		Revisions: formatted,
+	}
+}
+
+func NewRevertTripResponse(out *output.RevertTripOutput) RevertTripResponse {
+	if out.Revision == nil {
+		return RevertTripResponse{}
+	}
+	revision := newRevision(out.Revision)
+	return RevertTripResponse{
+		Revision: &revision,
+	}
+}
+
+func newRevision(r *output.Revision) Revision {
+	changes := make([]RevisionChange, len(r.Changes))
+	for i, c := range r.Changes {
+		changedFields := c.ChangedFields
+		if changedFields == nil {
+			changedFields = []string{}
+		}
+		changes[i] = RevisionChange{
+			ResourceType:  c.ResourceType,
+			ResourceID:    c.ResourceID,
+			Action:        c.Action,
+			ChangedFields: changedFields,
+			Before:        c.Before,
+			After:         c.After,
+		}
+	}
+	return Revision{
+		Revision:   r.Number,
+		ActorID:    r.ActorID,
+		RevertedTo: r.RevertedTo,
+		Changes:    changes,
+		CreatedAt:  r.CreatedAt,
+	}
+}
@@ -0,0 +1,70 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// Invitation の email と username は宛先として指定された方だけが含まれる
+	Invitation struct {
+		ID          string     `json:"id"`
+		TripID      string     `json:"trip_id"`
+		InviterID   string     `json:"inviter_id"`
+		Email       string     `json:"email,omitempty"`
+		Username    string     `json:"username,omitempty"`
+		Role        string     `json:"role"`
+		Status      string     `json:"status"`
+		ExpiresAt   time.Time  `json:"expires_at"`
+		CreatedAt   time.Time  `json:"created_at"`
+		RespondedAt *time.Time `json:"responded_at"`
+	}
+
+	ListInvitationResponse struct {
+		Invitations []Invitation `json:"invitations"`
+	}
+
+	CreateInvitationResponse struct {
+		ID string `json:"id"`
+	}
+)
+
+func NewListInvitationResponse(out *output.ListInvitationOutput) ListInvitationResponse {
+	formatted := make([]Invitation, len(out.Invitations))
+	for i, inv := range out.Invitations {
+		formatted[i] = Invitation{
+			ID:          inv.ID,
+			TripID:      inv.TripID,
+			InviterID:   inv.InviterID,
+			Email:       inv.Email,
+			Username:    inv.Username,
+			Role:        inv.Role,
+			Status:      inv.Status,
+			ExpiresAt:   inv.ExpiresAt,
+			CreatedAt:   inv.CreatedAt,
+			RespondedAt: inv.RespondedAt,
+		}
+	}
+
+	return ListInvitationResponse{
+		Invitations: formatted,
+	}
+}
+
+// MarshalJSON は日時フィールドをRFC3339形式でフォーマットします。未応答の場合、RespondedAtはnullになります。
+func (i Invitation) MarshalJSON() ([]byte, error) {
+	type Alias Invitation // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		ExpiresAt   string  `json:"expires_at"`
+		CreatedAt   string  `json:"created_at"`
+		RespondedAt *string `json:"responded_at"`
+	}{
+		Alias:       (Alias)(i),
+		ExpiresAt:   i.ExpiresAt.Format(time.RFC3339Nano),
+		CreatedAt:   i.CreatedAt.Format(time.RFC3339Nano),
+		RespondedAt: formatTimestamp(i.RespondedAt),
+	})
+}
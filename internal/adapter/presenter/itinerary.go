@@ -0,0 +1,240 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// Activity の travel_mode は直前の行動の場所から向かう移動手段。start_at と end_at は決まっていない場合は null
+	Activity struct {
+		ID       string    `json:"id"`
+		TripID   string    `json:"trip_id"`
+		Date     time.Time `json:"date"`
+		Position int       `json:"position"`
+		Title    string    `json:"title"`
+		// Place は場所が設定されていない行動では null
+		Place *ActivityPlace `json:"place"`
+		// Timezone は行動の IANA のタイムゾーン名で、行動にも場所にも設定されていない場合は null
+		Timezone   *string    `json:"timezone"`
+		StartAt    *time.Time `json:"start_at"`
+		EndAt      *time.Time `json:"end_at"`
+		TravelMode string     `json:"travel_mode"`
+		Notes      string     `json:"notes"`
+		CreatedAt  time.Time  `json:"created_at"`
+		UpdatedAt  time.Time  `json:"updated_at"`
+	}
+
+	// ActivityPlace の latitude と longitude は座標、timezone はタイムゾーンが設定されていない場合は null
+	ActivityPlace struct {
+		Name      string   `json:"name"`
+		Latitude  *float64 `json:"latitude"`
+		Longitude *float64 `json:"longitude"`
+		Timezone  *string  `json:"timezone"`
+	}
+
+	GetActivityResponse struct {
+		Activity Activity `json:"activity"`
+	}
+
+	CreateActivityResponse struct {
+		ID string `json:"id"`
+	}
+
+	// GetItineraryResponse の average_speeds_kmh は移動時間の見積もりに使った移動手段ごとの平均の速さ
+	GetItineraryResponse struct {
+		Days             []ItineraryDay     `json:"days"`
+		AverageSpeedsKmh map[string]float64 `json:"average_speeds_kmh"`
+	}
+
+	// ItineraryDay は 1 日分の行程。day_number は旅行の何日目かで、期間未定の旅行では null。
+	// 合計には座標が分かり距離を見積もれた移動だけを含める
+	ItineraryDay struct {
+		Date                time.Time          `json:"date"`
+		DayNumber           *int               `json:"day_number"`
+		Activities          []Activity         `json:"activities"`
+		Legs                []ItineraryLeg     `json:"legs"`
+		TotalDistanceMeters float64            `json:"total_distance_meters"`
+		TotalTravelSeconds  float64            `json:"total_travel_seconds"`
+		Warnings            []ItineraryWarning `json:"warnings"`
+	}
+
+	// ItineraryLeg は連続する行動の間の移動。distance_meters は直線距離で、travel_seconds は平均の速さから見積もった移動時間。
+	// どちらかの行動の座標が分からない場合は null。gap_seconds は前の行動の終了（なければ開始）から次の行動の開始までの時間で、
+	// どちらかの日時が決まっていない場合は null。from_timezone と to_timezone は出発地・到着地の行動のタイムゾーンで、
+	// 決まっていない場合は null
+	ItineraryLeg struct {
+		FromActivityID string   `json:"from_activity_id"`
+		ToActivityID   string   `json:"to_activity_id"`
+		FromTimezone   *string  `json:"from_timezone"`
+		ToTimezone     *string  `json:"to_timezone"`
+		TravelMode     string   `json:"travel_mode"`
+		DistanceMeters *float64 `json:"distance_meters"`
+		TravelSeconds  *float64 `json:"travel_seconds"`
+		GapSeconds     *float64 `json:"gap_seconds"`
+	}
+
+	// OptimizeDayResponse は行動を巡る順を並べ替えた提案。day は提案した順の行程で、距離は出発地と到着地を含む直線距離の合計。
+	// complete が false の場合は制限時間内に探索を終えられず、途中までの最良の順を提案している。
+	// applied が true の場合は提案した順に並び順を更新している
+	OptimizeDayResponse struct {
+		Day                     ItineraryDay `json:"day"`
+		OriginalDistanceMeters  float64      `json:"original_distance_meters"`
+		OptimizedDistanceMeters float64      `json:"optimized_distance_meters"`
+		SavedDistanceMeters     float64      `json:"saved_distance_meters"`
+		Complete                bool         `json:"complete"`
+		Applied                 bool         `json:"applied"`
+	}
+
+	// ItineraryWarning は行程の問題を表す。code が insufficient_travel_time の場合は空き時間が移動時間より短い
+	ItineraryWarning struct {
+		Code           string  `json:"code"`
+		Message        string  `json:"message"`
+		FromActivityID string  `json:"from_activity_id"`
+		ToActivityID   string  `json:"to_activity_id"`
+		GapSeconds     float64 `json:"gap_seconds"`
+		TravelSeconds  float64 `json:"travel_seconds"`
+	}
+)
+
+func NewGetActivityResponse(out *output.GetActivityOutput) GetActivityResponse {
+	return GetActivityResponse{
+		Activity: newActivity(out.Activity),
+	}
+}
+
+func NewGetItineraryResponse(out *output.GetItineraryOutput) GetItineraryResponse {
+	days := make([]ItineraryDay, len(out.Days))
+	for i, d := range out.Days {
+		days[i] = newItineraryDay(d)
+	}
+
+	return GetItineraryResponse{
+		Days:             days,
+		AverageSpeedsKmh: out.AverageSpeeds,
+	}
+}
+
+func NewOptimizeDayResponse(out *output.OptimizeDayOutput) OptimizeDayResponse {
+	return OptimizeDayResponse{
+		Day:                     newItineraryDay(out.Day),
+		OriginalDistanceMeters:  out.OriginalDistanceMeters,
+		OptimizedDistanceMeters: out.OptimizedDistanceMeters,
+		SavedDistanceMeters:     out.SavedDistanceMeters,
+		Complete:                out.Complete,
+		Applied:                 out.Applied,
+	}
+}
+
+func newItineraryDay(d *output.ItineraryDay) ItineraryDay {
+	activities := make([]Activity, len(d.Activities))
+	for i, a := range d.Activities {
+		activities[i] = newActivity(a)
+	}
+
+	legs := make([]ItineraryLeg, len(d.Legs))
+	for i, leg := range d.Legs {
+		legs[i] = ItineraryLeg{
+			FromActivityID: leg.FromActivityID,
+			ToActivityID:   leg.ToActivityID,
+			FromTimezone:   leg.FromTimezone,
+			ToTimezone:     leg.ToTimezone,
+			TravelMode:     leg.TravelMode,
+			DistanceMeters: leg.DistanceMeters,
+			TravelSeconds:  optionalSeconds(leg.TravelTime),
+			GapSeconds:     optionalSeconds(leg.Gap),
+		}
+	}
+
+	warnings := make([]ItineraryWarning, len(d.Warnings))
+	for i, w := range d.Warnings {
+		warnings[i] = ItineraryWarning{
+			Code:           w.Code,
+			Message:        "the time between activities is shorter than the estimated travel time",
+			FromActivityID: w.FromActivityID,
+			ToActivityID:   w.ToActivityID,
+			GapSeconds:     w.Gap.Seconds(),
+			TravelSeconds:  w.TravelTime.Seconds(),
+		}
+	}
+
+	return ItineraryDay{
+		Date:                d.Date,
+		DayNumber:           d.DayNumber,
+		Activities:          activities,
+		Legs:                legs,
+		TotalDistanceMeters: d.TotalDistanceMeters,
+		TotalTravelSeconds:  d.TotalTravelTime.Seconds(),
+		Warnings:            warnings,
+	}
+}
+
+func newActivity(a *output.Activity) Activity {
+	activity := Activity{
+		ID:         a.ID,
+		TripID:     a.TripID,
+		Date:       a.Date,
+		Position:   a.Position,
+		Title:      a.Title,
+		Timezone:   a.Timezone,
+		StartAt:    a.StartAt,
+		EndAt:      a.EndAt,
+		TravelMode: a.TravelMode,
+		Notes:      a.Notes,
+		CreatedAt:  a.CreatedAt,
+		UpdatedAt:  a.UpdatedAt,
+	}
+	activity.Place = newActivityPlace(a.Place)
+	return activity
+}
+
+func newActivityPlace(p *output.ActivityPlace) *ActivityPlace {
+	if p == nil {
+		return nil
+	}
+	return &ActivityPlace{
+		Name:      p.Name,
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+		Timezone:  p.Timezone,
+	}
+}
+
+// optionalSeconds は時間を秒に変換する。nil の場合は nil を返す
+func optionalSeconds(d *time.Duration) *float64 {
+	if d == nil {
+		return nil
+	}
+	seconds := d.Seconds()
+	return &seconds
+}
+
+// MarshalJSON は日付をYYYY-MM-DD形式、日時フィールドをRFC3339形式でフォーマットします。
+func (a Activity) MarshalJSON() ([]byte, error) {
+	type Alias Activity // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		Date      string `json:"date"`
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	}{
+		Alias:     (Alias)(a),
+		Date:      a.Date.Format(dateLayout),
+		CreatedAt: a.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: a.UpdatedAt.Format(time.RFC3339Nano),
+	})
+}
+
+// MarshalJSON は日付をYYYY-MM-DD形式でフォーマットします。
+func (d ItineraryDay) MarshalJSON() ([]byte, error) {
+	type Alias ItineraryDay // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		Date string `json:"date"`
+	}{
+		Alias: (Alias)(d),
+		Date:  d.Date.Format(dateLayout),
+	})
+}
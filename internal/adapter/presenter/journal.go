@@ -0,0 +1,102 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// JournalEntry の body は入力された Markdown、body_html はそれをサニタイズ済みの HTML に変換したもの。
+	// クライアントは body_html をそのまま表示できる
+	JournalEntry struct {
+		ID       string    `json:"id"`
+		TripID   string    `json:"trip_id"`
+		Date     time.Time `json:"date"`
+		Title    string    `json:"title"`
+		Body     string    `json:"body"`
+		BodyHTML string    `json:"body_html"`
+		Mood     string    `json:"mood"`
+		// Place は場所が設定されていない日記では null
+		Place     *JournalPlace `json:"place"`
+		CreatedAt time.Time     `json:"created_at"`
+		UpdatedAt time.Time     `json:"updated_at"`
+	}
+
+	// JournalPlace の latitude と longitude は座標、timezone はタイムゾーンが設定されていない場合は null
+	JournalPlace struct {
+		Name      string   `json:"name"`
+		Latitude  *float64 `json:"latitude"`
+		Longitude *float64 `json:"longitude"`
+		Timezone  *string  `json:"timezone"`
+	}
+
+	GetJournalEntryResponse struct {
+		JournalEntry JournalEntry `json:"journal_entry"`
+	}
+
+	ListJournalEntryResponse struct {
+		JournalEntries []JournalEntry `json:"journal_entries"`
+	}
+
+	CreateJournalEntryResponse struct {
+		ID string `json:"id"`
+	}
+)
+
+func NewGetJournalEntryResponse(out *output.GetJournalEntryOutput) GetJournalEntryResponse {
+	return GetJournalEntryResponse{
+		JournalEntry: newJournalEntry(out.JournalEntry),
+	}
+}
+
+func NewListJournalEntryResponse(out *output.ListJournalEntryOutput) ListJournalEntryResponse {
+	formatted := make([]JournalEntry, len(out.JournalEntries))
+	for i, e := range out.JournalEntries {
+		formatted[i] = newJournalEntry(e)
+	}
+
+	return ListJournalEntryResponse{
+		JournalEntries: formatted,
+	}
+}
+
+func newJournalEntry(e *output.JournalEntry) JournalEntry {
+	entry := JournalEntry{
+		ID:        e.ID,
+		TripID:    e.TripID,
+		Date:      e.Date,
+		Title:     e.Title,
+		Body:      e.Body,
+		BodyHTML:  e.BodyHTML,
+		Mood:      e.Mood,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+	}
+	if e.Place != nil {
+		entry.Place = &JournalPlace{
+			Name:      e.Place.Name,
+			Latitude:  e.Place.Latitude,
+			Longitude: e.Place.Longitude,
+			Timezone:  e.Place.Timezone,
+		}
+	}
+	return entry
+}
+
+// MarshalJSON は日付をYYYY-MM-DD形式、日時フィールドをRFC3339形式でフォーマットします。
+func (e JournalEntry) MarshalJSON() ([]byte, error) {
+	type Alias JournalEntry // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		Date      string `json:"date"`
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	}{
+		Alias:     (Alias)(e),
+		Date:      e.Date.Format(dateLayout),
+		CreatedAt: e.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: e.UpdatedAt.Format(time.RFC3339Nano),
+	})
+}
@@ -0,0 +1,51 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	Member struct {
+		UserID    string    `json:"user_id"`
+		Role      string    `json:"role"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
+	ListMemberResponse struct {
+		Members []Member `json:"members"`
+	}
+)
+
+func NewListMemberResponse(out *output.ListMemberOutput) ListMemberResponse {
+	formatted := make([]Member, len(out.Members))
+	for i, m := range out.Members {
+		formatted[i] = Member{
+			UserID:    m.UserID,
+			Role:      m.Role,
+			CreatedAt: m.CreatedAt,
+			UpdatedAt: m.UpdatedAt,
+		}
+	}
+
+	return ListMemberResponse{
+		Members: formatted,
+	}
+}
+
+// MarshalJSON は日時フィールドをRFC3339形式でフォーマットします。
+func (m Member) MarshalJSON() ([]byte, error) {
+	type Alias Member // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	}{
+		Alias:     (Alias)(m),
+		CreatedAt: m.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: m.UpdatedAt.Format(time.RFC3339Nano),
+	})
+}
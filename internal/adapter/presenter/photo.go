@@ -0,0 +1,146 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+// localDateTimeLayout はタイムゾーンを持たない現地時刻の日時の書式
+const localDateTimeLayout = "2006-01-02T15:04:05"
+
+type (
+	// Photo の captured_at は撮影場所の現地時刻で、タイムゾーンを含まない。
+	// latitude と longitude は撮影位置が不明な場合や、位置情報を削除してアップロードされた場合は null
+	Photo struct {
+		ID               string           `json:"id"`
+		TripID           string           `json:"trip_id"`
+		Filename         string           `json:"filename"`
+		ContentType      string           `json:"content_type"`
+		Size             int64            `json:"size"`
+		Width            int              `json:"width"`
+		Height           int              `json:"height"`
+		CapturedAt       *time.Time       `json:"captured_at"`
+		Latitude         *float64         `json:"latitude"`
+		Longitude        *float64         `json:"longitude"`
+		LocationStripped bool             `json:"location_stripped"`
+		UploadedBy       string           `json:"uploaded_by"`
+		CreatedAt        time.Time        `json:"created_at"`
+		Suggestion       *PhotoSuggestion `json:"suggestion"`
+	}
+
+	// PhotoSuggestion は写真が旅程のどの日・どの場所で撮影されたかの推定。
+	// day は旅行の開始日を1日目とした日数で、撮影日が旅行期間外の場合は null。place は近くに日記の場所がない場合は null
+	PhotoSuggestion struct {
+		Date           *time.Time    `json:"date"`
+		Day            *int          `json:"day"`
+		Place          *JournalPlace `json:"place"`
+		DistanceMeters *float64      `json:"distance_meters"`
+	}
+
+	GetPhotoResponse struct {
+		Photo Photo `json:"photo"`
+	}
+
+	ListPhotoResponse struct {
+		Photos []Photo `json:"photos"`
+	}
+
+	UploadPhotoResponse struct {
+		Photo Photo `json:"photo"`
+	}
+)
+
+func NewGetPhotoResponse(out *output.GetPhotoOutput) GetPhotoResponse {
+	return GetPhotoResponse{
+		Photo: newPhoto(out.Photo),
+	}
+}
+
+func NewListPhotoResponse(out *output.ListPhotoOutput) ListPhotoResponse {
+	formatted := make([]Photo, len(out.Photos))
+	for i, p := range out.Photos {
+		formatted[i] = newPhoto(p)
+	}
+
+	return ListPhotoResponse{
+		Photos: formatted,
+	}
+}
+
+func NewUploadPhotoResponse(out *output.UploadPhotoOutput) UploadPhotoResponse {
+	return UploadPhotoResponse{
+		Photo: newPhoto(out.Photo),
+	}
+}
+
+func newPhoto(p *output.Photo) Photo {
+	formatted := Photo{
+		ID:               p.ID,
+		TripID:           p.TripID,
+		Filename:         p.Filename,
+		ContentType:      p.ContentType,
+		Size:             p.Size,
+		Width:            p.Width,
+		Height:           p.Height,
+		CapturedAt:       p.CapturedAt,
+		Latitude:         p.Latitude,
+		Longitude:        p.Longitude,
+		LocationStripped: p.LocationStripped,
+		UploadedBy:       p.UploadedBy,
+		CreatedAt:        p.CreatedAt,
+	}
+	if s := p.Suggestion; s != nil {
+		formatted.Suggestion = &PhotoSuggestion{
+			Date:           s.Date,
+			Day:            s.Day,
+			DistanceMeters: s.DistanceMeters,
+		}
+		if s.Place != nil {
+			formatted.Suggestion.Place = &JournalPlace{
+				Name:      s.Place.Name,
+				Latitude:  s.Place.Latitude,
+				Longitude: s.Place.Longitude,
+				Timezone:  s.Place.Timezone,
+			}
+		}
+	}
+	return formatted
+}
+
+// MarshalJSON は撮影日時をタイムゾーンなしの現地時刻、日時フィールドをRFC3339形式でフォーマットします。
+func (p Photo) MarshalJSON() ([]byte, error) {
+	type Alias Photo // 無限ループを防ぐためのエイリアス
+	var capturedAt *string
+	if p.CapturedAt != nil {
+		formatted := p.CapturedAt.Format(localDateTimeLayout)
+		capturedAt = &formatted
+	}
+	return json.Marshal(&struct {
+		Alias
+		CapturedAt *string `json:"captured_at"`
+		CreatedAt  string  `json:"created_at"`
+	}{
+		Alias:      (Alias)(p),
+		CapturedAt: capturedAt,
+		CreatedAt:  p.CreatedAt.Format(time.RFC3339Nano),
+	})
+}
+
+// MarshalJSON は日付をYYYY-MM-DD形式でフォーマットします。
+func (s PhotoSuggestion) MarshalJSON() ([]byte, error) {
+	type Alias PhotoSuggestion // 無限ループを防ぐためのエイリアス
+	var date *string
+	if s.Date != nil {
+		formatted := s.Date.Format(dateLayout)
+		date = &formatted
+	}
+	return json.Marshal(&struct {
+		Alias
+		Date *string `json:"date"`
+	}{
+		Alias: (Alias)(s),
+		Date:  date,
+	})
+}
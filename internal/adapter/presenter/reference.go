@@ -0,0 +1,98 @@
+package presenter
+
+import (
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	Country struct {
+		Code       string `json:"code"`
+		Alpha3Code string `json:"alpha3_code"`
+		Name       string `json:"name"`
+		Currency   string `json:"currency"`
+	}
+
+	Airport struct {
+		IATACode    string  `json:"iata_code"`
+		ICAOCode    string  `json:"icao_code"`
+		Name        string  `json:"name"`
+		City        string  `json:"city"`
+		CountryCode string  `json:"country_code"`
+		Latitude    float64 `json:"latitude"`
+		Longitude   float64 `json:"longitude"`
+		Timezone    string  `json:"timezone"`
+	}
+
+	// City の code は UN/LOCODE
+	City struct {
+		Code        string  `json:"code"`
+		Name        string  `json:"name"`
+		CountryCode string  `json:"country_code"`
+		Latitude    float64 `json:"latitude"`
+		Longitude   float64 `json:"longitude"`
+		Timezone    string  `json:"timezone"`
+	}
+
+	ListCountriesResponse struct {
+		Countries []Country `json:"countries"`
+	}
+
+	SearchAirportsResponse struct {
+		Airports []Airport `json:"airports"`
+	}
+
+	SearchCitiesResponse struct {
+		Cities []City `json:"cities"`
+	}
+)
+
+func NewListCountriesResponse(out *output.ListCountriesOutput) ListCountriesResponse {
+	countries := make([]Country, len(out.Countries))
+	for i, c := range out.Countries {
+		countries[i] = Country{
+			Code:       c.Code,
+			Alpha3Code: c.Alpha3Code,
+			Name:       c.Name,
+			Currency:   c.Currency,
+		}
+	}
+	return ListCountriesResponse{
+		Countries: countries,
+	}
+}
+
+func NewSearchAirportsResponse(out *output.SearchAirportsOutput) SearchAirportsResponse {
+	airports := make([]Airport, len(out.Airports))
+	for i, a := range out.Airports {
+		airports[i] = Airport{
+			IATACode:    a.IATACode,
+			ICAOCode:    a.ICAOCode,
+			Name:        a.Name,
+			City:        a.City,
+			CountryCode: a.CountryCode,
+			Latitude:    a.Latitude,
+			Longitude:   a.Longitude,
+			Timezone:    a.Timezone,
+		}
+	}
+	return SearchAirportsResponse{
+		Airports: airports,
+	}
+}
+
+func NewSearchCitiesResponse(out *output.SearchCitiesOutput) SearchCitiesResponse {
+	cities := make([]City, len(out.Cities))
+	for i, c := range out.Cities {
+		cities[i] = City{
+			Code:        c.Code,
+			Name:        c.Name,
+			CountryCode: c.CountryCode,
+			Latitude:    c.Latitude,
+			Longitude:   c.Longitude,
+			Timezone:    c.Timezone,
+		}
+	}
+	return SearchCitiesResponse{
+		Cities: cities,
+	}
+}
@@ -0,0 +1,67 @@
+package presenter
+
+import (
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// SnippetSegment は強調表示用に分割された文字列の一部分。HTML を含まないため、クライアントは highlighted が true の部分だけを装飾して表示する
+	SnippetSegment struct {
+		Text        string `json:"text"`
+		Highlighted bool   `json:"highlighted"`
+	}
+
+	SearchHit struct {
+		ResourceType string           `json:"resource_type"`
+		ResourceID   string           `json:"resource_id"`
+		TripID       string           `json:"trip_id"`
+		Title        []SnippetSegment `json:"title"`
+		Snippet      []SnippetSegment `json:"snippet"`
+		Rank         float64          `json:"rank"`
+	}
+
+	SearchGroup struct {
+		ResourceType string      `json:"resource_type"`
+		Hits         []SearchHit `json:"hits"`
+	}
+
+	// SearchResponse はリソースの種類ごとにまとめた検索結果。一致がない種類は含まない
+	SearchResponse struct {
+		Groups []SearchGroup `json:"groups"`
+	}
+)
+
+func NewSearchResponse(out *output.SearchOutput) SearchResponse {
+	groups := make([]SearchGroup, len(out.Groups))
+	for i, g := range out.Groups {
+		hits := make([]SearchHit, len(g.Hits))
+		for j, h := range g.Hits {
+			hits[j] = SearchHit{
+				ResourceType: h.ResourceType,
+				ResourceID:   h.ResourceID,
+				TripID:       h.TripID,
+				Title:        newSnippetSegments(h.Title),
+				Snippet:      newSnippetSegments(h.Snippet),
+				Rank:         h.Rank,
+			}
+		}
+		groups[i] = SearchGroup{
+			ResourceType: g.ResourceType,
+			Hits:         hits,
+		}
+	}
+	return SearchResponse{
+		Groups: groups,
+	}
+}
+
+func newSnippetSegments(segments []output.SnippetSegment) []SnippetSegment {
+	formatted := make([]SnippetSegment, len(segments))
+	for i, s := range segments {
+		formatted[i] = SnippetSegment{
+			Text:        s.Text,
+			Highlighted: s.Highlighted,
+		}
+	}
+	return formatted
+}
@@ -0,0 +1,55 @@
+package presenter
+
+import (
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// SettlementBalance の amount は正の値なら受け取るべき額、負の値なら支払うべき額
+	SettlementBalance struct {
+		Participant string `json:"participant"`
+		Amount      Money  `json:"amount"`
+	}
+
+	SettlementTransfer struct {
+		From   string `json:"from"`
+		To     string `json:"to"`
+		Amount Money  `json:"amount"`
+	}
+
+	Settlement struct {
+		Currency  string               `json:"currency"`
+		Balances  []SettlementBalance  `json:"balances"`
+		Transfers []SettlementTransfer `json:"transfers"`
+	}
+
+	// GetSettlementResponse は通貨ごとの精算結果を通貨コード順に返す
+	GetSettlementResponse struct {
+		Settlements []Settlement `json:"settlements"`
+	}
+)
+
+func NewGetSettlementResponse(out *output.GetSettlementOutput) GetSettlementResponse {
+	settlements := make([]Settlement, len(out.Settlements))
+	for i, s := range out.Settlements {
+		balances := make([]SettlementBalance, len(s.Balances))
+		for j, b := range s.Balances {
+			balances[j] = SettlementBalance{Participant: b.Participant, Amount: newMoney(b.Amount)}
+		}
+
+		transfers := make([]SettlementTransfer, len(s.Transfers))
+		for j, t := range s.Transfers {
+			transfers[j] = SettlementTransfer{From: t.From, To: t.To, Amount: newMoney(t.Amount)}
+		}
+
+		settlements[i] = Settlement{
+			Currency:  s.Currency,
+			Balances:  balances,
+			Transfers: transfers,
+		}
+	}
+
+	return GetSettlementResponse{
+		Settlements: settlements,
+	}
+}
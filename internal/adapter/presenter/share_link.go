@@ -0,0 +1,179 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	ShareLink struct {
+		ID          string     `json:"id"`
+		TripID      string     `json:"trip_id"`
+		HasPassword bool       `json:"has_password"`
+		Status      string     `json:"status"`
+		ExpiresAt   *time.Time `json:"expires_at"`
+		CreatedBy   string     `json:"created_by"`
+		CreatedAt   time.Time  `json:"created_at"`
+		RevokedAt   *time.Time `json:"revoked_at"`
+	}
+
+	ListShareLinkResponse struct {
+		ShareLinks []ShareLink `json:"share_links"`
+	}
+
+	// CreateShareLinkResponse の token は再取得できないため、作成したクライアントが控えておく必要がある
+	CreateShareLinkResponse struct {
+		ID    string `json:"id"`
+		Token string `json:"token"`
+	}
+
+	SharedTrip struct {
+		Name      string  `json:"name"`
+		StartDate *string `json:"start_date"`
+		EndDate   *string `json:"end_date"`
+	}
+
+	SharedAccommodation struct {
+		Name       string  `json:"name"`
+		Address    string  `json:"address"`
+		Timezone   *string `json:"timezone"`
+		CheckInAt  string  `json:"check_in_at"`
+		CheckOutAt string  `json:"check_out_at"`
+	}
+
+	// SharedJournalEntry の body_html はサニタイズ済みの HTML。Markdown の本文と場所の座標は含めない
+	SharedJournalEntry struct {
+		Date      string  `json:"date"`
+		Title     string  `json:"title"`
+		BodyHTML  string  `json:"body_html"`
+		Mood      string  `json:"mood"`
+		PlaceName *string `json:"place_name"`
+	}
+
+	// SharedActivity は共有リンクで公開する行動。メモと場所の座標は含めない
+	SharedActivity struct {
+		Date       string  `json:"date"`
+		Position   int     `json:"position"`
+		Title      string  `json:"title"`
+		PlaceName  *string `json:"place_name"`
+		Timezone   *string `json:"timezone"`
+		StartAt    *string `json:"start_at"`
+		EndAt      *string `json:"end_at"`
+		TravelMode string  `json:"travel_mode"`
+	}
+
+	SharedMember struct {
+		Username string `json:"username"`
+		Role     string `json:"role"`
+	}
+
+	// GetSharedTripResponse は共有リンクで公開する旅行の情報。費用や予約番号、メールアドレスは含めない
+	GetSharedTripResponse struct {
+		Trip           SharedTrip            `json:"trip"`
+		Accommodations []SharedAccommodation `json:"accommodations"`
+		Activities     []SharedActivity      `json:"activities"`
+		JournalEntries []SharedJournalEntry  `json:"journal_entries"`
+		Members        []SharedMember        `json:"members"`
+	}
+)
+
+func NewListShareLinkResponse(out *output.ListShareLinkOutput) ListShareLinkResponse {
+	formatted := make([]ShareLink, len(out.ShareLinks))
+	for i, l := range out.ShareLinks {
+		formatted[i] = ShareLink{
+			ID:          l.ID,
+			TripID:      l.TripID,
+			HasPassword: l.HasPassword,
+			Status:      l.Status,
+			ExpiresAt:   l.ExpiresAt,
+			CreatedBy:   l.CreatedBy,
+			CreatedAt:   l.CreatedAt,
+			RevokedAt:   l.RevokedAt,
+		}
+	}
+
+	return ListShareLinkResponse{
+		ShareLinks: formatted,
+	}
+}
+
+func NewGetSharedTripResponse(out *output.GetSharedTripOutput) GetSharedTripResponse {
+	accommodations := make([]SharedAccommodation, len(out.Accommodations))
+	for i, a := range out.Accommodations {
+		accommodations[i] = SharedAccommodation{
+			Name:       a.Name,
+			Address:    a.Address,
+			Timezone:   a.Timezone,
+			CheckInAt:  a.CheckInAt.Format(time.RFC3339Nano),
+			CheckOutAt: a.CheckOutAt.Format(time.RFC3339Nano),
+		}
+	}
+
+	activities := make([]SharedActivity, len(out.Activities))
+	for i, a := range out.Activities {
+		activities[i] = SharedActivity{
+			Date:       a.Date.Format(dateLayout),
+			Position:   a.Position,
+			Title:      a.Title,
+			PlaceName:  a.PlaceName,
+			Timezone:   a.Timezone,
+			StartAt:    formatTimestamp(a.StartAt),
+			EndAt:      formatTimestamp(a.EndAt),
+			TravelMode: a.TravelMode,
+		}
+	}
+
+	journalEntries := make([]SharedJournalEntry, len(out.JournalEntries))
+	for i, e := range out.JournalEntries {
+		journalEntries[i] = SharedJournalEntry{
+			Date:      e.Date.Format(dateLayout),
+			Title:     e.Title,
+			BodyHTML:  e.BodyHTML,
+			Mood:      e.Mood,
+			PlaceName: e.PlaceName,
+		}
+	}
+
+	members := make([]SharedMember, len(out.Members))
+	for i, m := range out.Members {
+		members[i] = SharedMember{Username: m.Username, Role: m.Role}
+	}
+
+	return GetSharedTripResponse{
+		Trip: SharedTrip{
+			Name:      out.Trip.Name,
+			StartDate: formatDate(out.Trip.StartDate),
+			EndDate:   formatDate(out.Trip.EndDate),
+		},
+		Accommodations: accommodations,
+		Activities:     activities,
+		JournalEntries: journalEntries,
+		Members:        members,
+	}
+}
+
+// MarshalJSON は日時フィールドをRFC3339形式でフォーマットします。無期限や未無効化の場合、ExpiresAtとRevokedAtはnullになります。
+func (l ShareLink) MarshalJSON() ([]byte, error) {
+	type Alias ShareLink // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		ExpiresAt *string `json:"expires_at"`
+		CreatedAt string  `json:"created_at"`
+		RevokedAt *string `json:"revoked_at"`
+	}{
+		Alias:     (Alias)(l),
+		ExpiresAt: formatTimestamp(l.ExpiresAt),
+		CreatedAt: l.CreatedAt.Format(time.RFC3339Nano),
+		RevokedAt: formatTimestamp(l.RevokedAt),
+	})
+}
+
+func formatTimestamp(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.RFC3339Nano)
+	return &s
+}
@@ -0,0 +1,182 @@
+package presenter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	Template struct {
+		ID          string `json:"id"`
+		OwnerID     string `json:"owner_id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Public      bool   `json:"public"`
+		// Days は旅行の日数。期間未定の旅行から作成した場合は null
+		Days           *int                    `json:"days"`
+		Accommodations []TemplateAccommodation `json:"accommodations"`
+		Budget         *TemplateBudget         `json:"budget"`
+		Checklists     []TemplateChecklist     `json:"checklists"`
+		Activities     []TemplateActivity      `json:"activities"`
+		CreatedAt      time.Time               `json:"created_at"`
+		UpdatedAt      time.Time               `json:"updated_at"`
+	}
+
+	// TemplateAccommodation のチェックイン・チェックアウトは旅行の開始日を 0 日目とした日数と UTC の時刻（HH:MM）で表す
+	TemplateAccommodation struct {
+		Name         string `json:"name"`
+		Address      string `json:"address"`
+		CheckInDay   int    `json:"check_in_day"`
+		CheckInTime  string `json:"check_in_time"`
+		CheckOutDay  int    `json:"check_out_day"`
+		CheckOutTime string `json:"check_out_time"`
+		CostAmount   int64  `json:"cost_amount"`
+		CostCurrency string `json:"cost_currency"`
+		Notes        string `json:"notes"`
+	}
+
+	// TemplateActivity の day は旅行の開始日を 0 日目とした日数。開始・終了は day と同じ形式の日数と UTC の時刻（HH:MM）で表し、
+	// 決まっていない場合は null
+	TemplateActivity struct {
+		Day        int            `json:"day"`
+		Position   int            `json:"position"`
+		Title      string         `json:"title"`
+		Place      *ActivityPlace `json:"place"`
+		Timezone   *string        `json:"timezone"`
+		StartDay   *int           `json:"start_day"`
+		StartTime  *string        `json:"start_time"`
+		EndDay     *int           `json:"end_day"`
+		EndTime    *string        `json:"end_time"`
+		TravelMode string         `json:"travel_mode"`
+		Notes      string         `json:"notes"`
+	}
+
+	TemplateBudget struct {
+		Currency       string           `json:"currency"`
+		CategoryLimits map[string]int64 `json:"category_limits"`
+	}
+
+	TemplateChecklist struct {
+		Name  string                  `json:"name"`
+		Kind  string                  `json:"kind"`
+		Items []TemplateChecklistItem `json:"items"`
+	}
+
+	TemplateChecklistItem struct {
+		Name     string `json:"name"`
+		Quantity int    `json:"quantity"`
+	}
+
+	GetTemplateResponse struct {
+		Template Template `json:"template"`
+	}
+
+	ListTemplateResponse struct {
+		Templates []Template `json:"templates"`
+	}
+
+	CreateTemplateResponse struct {
+		ID string `json:"id"`
+	}
+)
+
+func NewGetTemplateResponse(out *output.GetTemplateOutput) GetTemplateResponse {
+	return GetTemplateResponse{
+		Template: newTemplate(out.Template),
+	}
+}
+
+func NewListTemplateResponse(out *output.ListTemplateOutput) ListTemplateResponse {
+	formatted := make([]Template, len(out.Templates))
+	for i, t := range out.Templates {
+		formatted[i] = newTemplate(t)
+	}
+	return ListTemplateResponse{
+		Templates: formatted,
+	}
+}
+
+func newTemplate(t *output.Template) Template {
+	accommodations := make([]TemplateAccommodation, len(t.Accommodations))
+	for i, a := range t.Accommodations {
+		checkInDay, checkInTime := splitDayOffset(a.CheckInOffset)
+		checkOutDay, checkOutTime := splitDayOffset(a.CheckOutOffset)
+		accommodations[i] = TemplateAccommodation{
+			Name:         a.Name,
+			Address:      a.Address,
+			CheckInDay:   checkInDay,
+			CheckInTime:  checkInTime,
+			CheckOutDay:  checkOutDay,
+			CheckOutTime: checkOutTime,
+			CostAmount:   a.CostAmount,
+			CostCurrency: a.CostCurrency,
+			Notes:        a.Notes,
+		}
+	}
+
+	var b *TemplateBudget
+	if t.Budget != nil {
+		b = &TemplateBudget{Currency: t.Budget.Currency, CategoryLimits: t.Budget.CategoryLimits}
+	}
+
+	checklists := make([]TemplateChecklist, len(t.Checklists))
+	for i, c := range t.Checklists {
+		items := make([]TemplateChecklistItem, len(c.Items))
+		for j, item := range c.Items {
+			items[j] = TemplateChecklistItem{Name: item.Name, Quantity: item.Quantity}
+		}
+		checklists[i] = TemplateChecklist{Name: c.Name, Kind: c.Kind, Items: items}
+	}
+
+	activities := make([]TemplateActivity, len(t.Activities))
+	for i, a := range t.Activities {
+		startDay, startTime := splitOptionalDayOffset(a.StartOffset)
+		endDay, endTime := splitOptionalDayOffset(a.EndOffset)
+		activities[i] = TemplateActivity{
+			Day:        a.Day,
+			Position:   a.Position,
+			Title:      a.Title,
+			Place:      newActivityPlace(a.Place),
+			Timezone:   a.Timezone,
+			StartDay:   startDay,
+			StartTime:  startTime,
+			EndDay:     endDay,
+			EndTime:    endTime,
+			TravelMode: a.TravelMode,
+			Notes:      a.Notes,
+		}
+	}
+
+	return Template{
+		ID:             t.ID,
+		OwnerID:        t.OwnerID,
+		Name:           t.Name,
+		Description:    t.Description,
+		Public:         t.Public,
+		Days:           t.Days,
+		Accommodations: accommodations,
+		Budget:         b,
+		Checklists:     checklists,
+		Activities:     activities,
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+	}
+}
+
+// splitDayOffset は基準日の 0 時からの経過時間を日数と時刻（HH:MM）に分ける
+func splitDayOffset(offset time.Duration) (int, string) {
+	day := int(offset / (24 * time.Hour))
+	rest := offset - time.Duration(day)*24*time.Hour
+	return day, fmt.Sprintf("%02d:%02d", int(rest/time.Hour), int(rest%time.Hour/time.Minute))
+}
+
+// splitOptionalDayOffset は splitDayOffset と同じく日数と時刻に分ける。経過時間が nil の場合はどちらも nil を返す
+func splitOptionalDayOffset(offset *time.Duration) (*int, *string) {
+	if offset == nil {
+		return nil, nil
+	}
+	day, clock := splitDayOffset(*offset)
+	return &day, &clock
+}
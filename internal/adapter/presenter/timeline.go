@@ -0,0 +1,55 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// TimelineItem の kind は activity、transport_leg、check_in、check_out、journal_entry のいずれかで、id はその行動・移動・宿泊予約・日記の ID。
+	// start_at は timezone、end_at は end_timezone の現地時刻をオフセット付きで表し、utc_offset と end_utc_offset はそれぞれのオフセット（例: +09:00）。
+	// end_timezone は移動では到着地のタイムゾーンで、それ以外では timezone と同じ。
+	// 終日の項目は現地の日付が始まる瞬間を start_at とし、end_at と end_utc_offset は null
+	TimelineItem struct {
+		Kind         string     `json:"kind"`
+		ID           string     `json:"id"`
+		Title        string     `json:"title"`
+		Timezone     string     `json:"timezone"`
+		EndTimezone  string     `json:"end_timezone"`
+		Date         string     `json:"date"`
+		AllDay       bool       `json:"all_day"`
+		StartAt      time.Time  `json:"start_at"`
+		EndAt        *time.Time `json:"end_at"`
+		UTCOffset    string     `json:"utc_offset"`
+		EndUTCOffset *string    `json:"end_utc_offset"`
+	}
+
+	GetTimelineResponse struct {
+		Items []TimelineItem `json:"items"`
+	}
+)
+
+func NewGetTimelineResponse(out *output.GetTimelineOutput) GetTimelineResponse {
+	items := make([]TimelineItem, 0, len(out.Items))
+	for _, item := range out.Items {
+		formatted := TimelineItem{
+			Kind:        item.Kind,
+			ID:          item.ID,
+			Title:       item.Title,
+			Timezone:    item.Timezone,
+			EndTimezone: item.EndTimezone,
+			Date:        item.Date.Format(time.DateOnly),
+			AllDay:      item.AllDay,
+			StartAt:     item.StartAt,
+			EndAt:       item.EndAt,
+			UTCOffset:   item.StartAt.Format("-07:00"),
+		}
+		if item.EndAt != nil {
+			offset := item.EndAt.Format("-07:00")
+			formatted.EndUTCOffset = &offset
+		}
+		items = append(items, formatted)
+	}
+	return GetTimelineResponse{Items: items}
+}
@@ -0,0 +1,176 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// Track の activity_id は紐づく行動がない場合は null
+	Track struct {
+		ID         string     `json:"id"`
+		TripID     string     `json:"trip_id"`
+		ActivityID *string    `json:"activity_id"`
+		Name       string     `json:"name"`
+		Stats      TrackStats `json:"stats"`
+		UploadedBy string     `json:"uploaded_by"`
+		CreatedAt  time.Time  `json:"created_at"`
+	}
+
+	// TrackStats は距離と標高をメートル、時間を秒、速さを m/s で表す。
+	// bbox は GeoJSON と同じ [西端の経度, 南端の緯度, 東端の経度, 北端の緯度] の順。
+	// started_at と ended_at は記録日時のない軌跡では null
+	TrackStats struct {
+		DistanceMeters      float64    `json:"distance_meters"`
+		MovingSeconds       float64    `json:"moving_seconds"`
+		ElapsedSeconds      float64    `json:"elapsed_seconds"`
+		ElevationGainMeters float64    `json:"elevation_gain_meters"`
+		ElevationLossMeters float64    `json:"elevation_loss_meters"`
+		MaxSpeed            float64    `json:"max_speed_mps"`
+		StartedAt           *time.Time `json:"started_at"`
+		EndedAt             *time.Time `json:"ended_at"`
+		BBox                [4]float64 `json:"bbox"`
+		PointCount          int        `json:"point_count"`
+	}
+
+	GetTrackResponse struct {
+		Track Track `json:"track"`
+	}
+
+	ListTrackResponse struct {
+		Tracks []Track `json:"tracks"`
+	}
+
+	UploadTrackResponse struct {
+		Track Track `json:"track"`
+	}
+
+	// TrackFeature は間引いた軌跡を MultiLineString とする GeoJSON の Feature。properties にはトラックの情報と統計を含める
+	TrackFeature struct {
+		Type       string               `json:"type"`
+		ID         string               `json:"id"`
+		BBox       [4]float64           `json:"bbox"`
+		Geometry   TrackGeometry        `json:"geometry"`
+		Properties TrackFeatureProperty `json:"properties"`
+	}
+
+	// TrackGeometry の座標は経度、緯度の順。すべての点に標高が記録されている場合は 3 番目に標高を含める
+	TrackGeometry struct {
+		Type        string        `json:"type"`
+		Coordinates [][][]float64 `json:"coordinates"`
+	}
+
+	TrackFeatureProperty struct {
+		Name       string     `json:"name"`
+		TripID     string     `json:"trip_id"`
+		ActivityID *string    `json:"activity_id"`
+		Stats      TrackStats `json:"stats"`
+	}
+)
+
+func NewGetTrackResponse(out *output.GetTrackOutput) GetTrackResponse {
+	return GetTrackResponse{
+		Track: newTrack(out.Track),
+	}
+}
+
+func NewListTrackResponse(out *output.ListTrackOutput) ListTrackResponse {
+	formatted := make([]Track, len(out.Tracks))
+	for i, t := range out.Tracks {
+		formatted[i] = newTrack(t)
+	}
+
+	return ListTrackResponse{
+		Tracks: formatted,
+	}
+}
+
+func NewUploadTrackResponse(out *output.UploadTrackOutput) UploadTrackResponse {
+	return UploadTrackResponse{
+		Track: newTrack(out.Track),
+	}
+}
+
+// NewTrackFeature はトラックの間引いた軌跡を GeoJSON の Feature にする
+func NewTrackFeature(out *output.GetTrackOutput) TrackFeature {
+	withElevation := true
+	for _, segment := range out.Geometry {
+		for _, p := range segment {
+			if p.Elevation == nil {
+				withElevation = false
+			}
+		}
+	}
+
+	coordinates := make([][][]float64, 0, len(out.Geometry))
+	for _, segment := range out.Geometry {
+		line := make([][]float64, 0, max(len(segment), 2))
+		for _, p := range segment {
+			position := []float64{p.Longitude, p.Latitude}
+			if withElevation {
+				position = append(position, *p.Elevation)
+			}
+			line = append(line, position)
+		}
+		// LineString は 2 点以上が必要なため、1 点だけのセグメントは同じ点を繰り返す
+		if len(line) == 1 {
+			line = append(line, line[0])
+		}
+		coordinates = append(coordinates, line)
+	}
+
+	track := newTrack(out.Track)
+	return TrackFeature{
+		Type: "Feature",
+		ID:   track.ID,
+		BBox: track.Stats.BBox,
+		Geometry: TrackGeometry{
+			Type:        "MultiLineString",
+			Coordinates: coordinates,
+		},
+		Properties: TrackFeatureProperty{
+			Name:       track.Name,
+			TripID:     track.TripID,
+			ActivityID: track.ActivityID,
+			Stats:      track.Stats,
+		},
+	}
+}
+
+func newTrack(t *output.Track) Track {
+	s := t.Stats
+	return Track{
+		ID:         t.ID,
+		TripID:     t.TripID,
+		ActivityID: t.ActivityID,
+		Name:       t.Name,
+		Stats: TrackStats{
+			DistanceMeters:      s.DistanceMeters,
+			MovingSeconds:       s.MovingDuration.Seconds(),
+			ElapsedSeconds:      s.ElapsedDuration.Seconds(),
+			ElevationGainMeters: s.ElevationGainMeters,
+			ElevationLossMeters: s.ElevationLossMeters,
+			MaxSpeed:            s.MaxSpeed,
+			StartedAt:           s.StartedAt,
+			EndedAt:             s.EndedAt,
+			BBox:                [4]float64{s.Bounds.MinLongitude, s.Bounds.MinLatitude, s.Bounds.MaxLongitude, s.Bounds.MaxLatitude},
+			PointCount:          s.PointCount,
+		},
+		UploadedBy: t.UploadedBy,
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+// MarshalJSON は日時フィールドをRFC3339形式でフォーマットします。
+func (t Track) MarshalJSON() ([]byte, error) {
+	type Alias Track // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		CreatedAt string `json:"created_at"`
+	}{
+		Alias:     (Alias)(t),
+		CreatedAt: t.CreatedAt.Format(time.RFC3339Nano),
+	})
+}
@@ -0,0 +1,90 @@
+package presenter
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// TransportLeg の origin と destination の timezone は、departure_at と arrival_at をそれぞれの現地時刻で表示するのに使う
+	TransportLeg struct {
+		ID          string         `json:"id"`
+		TripID      string         `json:"trip_id"`
+		Mode        string         `json:"mode"`
+		Origin      *ActivityPlace `json:"origin"`
+		Destination *ActivityPlace `json:"destination"`
+		DepartureAt time.Time      `json:"departure_at"`
+		ArrivalAt   time.Time      `json:"arrival_at"`
+		Carrier     string         `json:"carrier"`
+		Number      string         `json:"number"`
+		Notes       string         `json:"notes"`
+		CreatedAt   time.Time      `json:"created_at"`
+		UpdatedAt   time.Time      `json:"updated_at"`
+	}
+
+	GetTransportLegResponse struct {
+		TransportLeg TransportLeg `json:"transport_leg"`
+	}
+
+	ListTransportLegResponse struct {
+		TransportLegs []TransportLeg `json:"transport_legs"`
+	}
+
+	CreateTransportLegResponse struct {
+		ID string `json:"id"`
+	}
+)
+
+func NewGetTransportLegResponse(out *output.GetTransportLegOutput) GetTransportLegResponse {
+	return GetTransportLegResponse{
+		TransportLeg: newTransportLeg(out.TransportLeg),
+	}
+}
+
+func NewListTransportLegResponse(out *output.ListTransportLegOutput) ListTransportLegResponse {
+	formatted := make([]TransportLeg, len(out.TransportLegs))
+	for i, l := range out.TransportLegs {
+		formatted[i] = newTransportLeg(l)
+	}
+
+	return ListTransportLegResponse{
+		TransportLegs: formatted,
+	}
+}
+
+func newTransportLeg(l *output.TransportLeg) TransportLeg {
+	return TransportLeg{
+		ID:          l.ID,
+		TripID:      l.TripID,
+		Mode:        l.Mode,
+		Origin:      newActivityPlace(l.Origin),
+		Destination: newActivityPlace(l.Destination),
+		DepartureAt: l.DepartureAt,
+		ArrivalAt:   l.ArrivalAt,
+		Carrier:     l.Carrier,
+		Number:      l.Number,
+		Notes:       l.Notes,
+		CreatedAt:   l.CreatedAt,
+		UpdatedAt:   l.UpdatedAt,
+	}
+}
+
+// MarshalJSON は日時フィールドをRFC3339形式でフォーマットします。
+func (l TransportLeg) MarshalJSON() ([]byte, error) {
+	type Alias TransportLeg // 無限ループを防ぐためのエイリアス
+	return json.Marshal(&struct {
+		Alias
+		DepartureAt string `json:"departure_at"`
+		ArrivalAt   string `json:"arrival_at"`
+		CreatedAt   string `json:"created_at"`
+		UpdatedAt   string `json:"updated_at"`
+	}{
+		Alias:       (Alias)(l),
+		DepartureAt: l.DepartureAt.Format(time.RFC3339Nano),
+		ArrivalAt:   l.ArrivalAt.Format(time.RFC3339Nano),
+		CreatedAt:   l.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt:   l.UpdatedAt.Format(time.RFC3339Nano),
+	})
+}
@@ -0,0 +1,34 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+type (
+	// TrashedTrip の purge_at を過ぎると、旅行は関連するリソースとともに完全に削除される
+	TrashedTrip struct {
+		Trip      Trip      `json:"trip"`
+		TrashedAt time.Time `json:"trashed_at"`
+		PurgeAt   time.Time `json:"purge_at"`
+	}
+
+	ListTrashedTripResponse struct {
+		Trips []TrashedTrip `json:"trips"`
+	}
+)
+
+func NewListTrashedTripResponse(out *output.ListTrashedTripOutput) ListTrashedTripResponse {
+	formatted := make([]TrashedTrip, len(out.Trips))
+	for i, t := range out.Trips {
+		formatted[i] = TrashedTrip{
+			Trip:      newTrip(t.Trip),
+			TrashedAt: t.TrashedAt,
+			PurgeAt:   t.PurgeAt,
+		}
+	}
+	return ListTrashedTripResponse{
+		Trips: formatted,
+	}
+}
@@ -7,20 +7,40 @@ import (
 	"github.com/hata0/travel-api/internal/usecase/output"
 )
 
+// dateLayout は日付のみを表すフィールドの JSON 表現
+const dateLayout = "2006-01-02"
+
 type (
 	Trip struct {
-		ID        string    `json:"id"`
-		Name      string    `json:"name"`
-		CreatedAt time.Time `json:"created_at"`
-		UpdatedAt time.Time `json:"updated_at"`
+		ID        string     `json:"id"`
+		Name      string     `json:"name"`
+		StartDate *time.Time `json:"start_date"`
+		EndDate   *time.Time `json:"end_date"`
+		// HomeCurrency は予算の集計に使う基準通貨。未設定の場合は null
+		HomeCurrency *string   `json:"home_currency"`
+		CreatedAt    time.Time `json:"created_at"`
+		UpdatedAt    time.Time `json:"updated_at"`
 	}
 
 	GetTripResponse struct {
 		Trip Trip `json:"trip"`
+		// ChecklistProgress は旅行のチェックリスト全体の進捗
+		ChecklistProgress ChecklistProgress  `json:"checklist_progress"`
+		Checklists        []ChecklistSummary `json:"checklists"`
+	}
+
+	// ChecklistSummary は旅行の取得結果に含めるチェックリストごとの進捗
+	ChecklistSummary struct {
+		ID       string            `json:"id"`
+		Name     string            `json:"name"`
+		Kind     string            `json:"kind"`
+		Progress ChecklistProgress `json:"progress"`
 	}
 
 	ListTripResponse struct {
 		Trips []Trip `json:"trips"`
+		// NextCursor は次のページを取得するために cursor クエリパラメータに指定する値。最後のページでは null
+		NextCursor *string `json:"next_cursor"`
 	}
 
 	CreateTripResponse struct {
@@ -28,43 +48,71 @@ type (
 	}
 )
 
-func NewGetTripResponse(out output.GetTripOutput) GetTripResponse {
+func NewGetTripResponse(out *output.GetTripOutput) GetTripResponse {
+	checklists := make([]ChecklistSummary, len(out.Checklists))
+	for i, c := range out.Checklists {
+		checklists[i] = ChecklistSummary{
+			ID:       c.ID,
+			Name:     c.Name,
+			Kind:     c.Kind,
+			Progress: newChecklistProgress(c.Progress),
+		}
+	}
+
 	return GetTripResponse{
-		Trip: Trip{
-			ID:        out.Trip.ID,
-			Name:      out.Trip.Name,
-			CreatedAt: out.Trip.CreatedAt,
-			UpdatedAt: out.Trip.UpdatedAt,
-		},
+		Trip:              newTrip(out.Trip),
+		ChecklistProgress: newChecklistProgress(out.ChecklistProgress),
+		Checklists:        checklists,
 	}
 }
 
-func NewListTripResponse(out output.ListTripOutput) ListTripResponse {
+func NewListTripResponse(out *output.ListTripOutput) ListTripResponse {
 	formattedTrips := make([]Trip, len(out.Trips))
 	for i, trip := range out.Trips {
-		formattedTrips[i] = Trip{
-			ID:        trip.ID,
-			Name:      trip.Name,
-			CreatedAt: trip.CreatedAt,
-			UpdatedAt: trip.UpdatedAt,
-		}
+		formattedTrips[i] = newTrip(trip)
 	}
 	return ListTripResponse{
-		Trips: formattedTrips,
+		Trips:      formattedTrips,
+		NextCursor: out.NextCursor,
+	}
+}
+
+func newTrip(trip *output.Trip) Trip {
+	return Trip{
+		ID:           trip.ID,
+		Name:         trip.Name,
+		StartDate:    trip.StartDate,
+		EndDate:      trip.EndDate,
+		HomeCurrency: trip.HomeCurrency,
+		CreatedAt:    trip.CreatedAt,
+		UpdatedAt:    trip.UpdatedAt,
 	}
 }
 
 // MarshalJSON はTrip構造体をJSONにマーシャリングする際のカスタム処理を提供します。
-// CreatedAtとUpdatedAtフィールドをRFC3339形式でフォーマットします。
+// CreatedAtとUpdatedAtフィールドをRFC3339形式で、StartDateとEndDateをYYYY-MM-DD形式でフォーマットします。
+// 期間未定の場合、StartDateとEndDateはnullになります。
 func (t Trip) MarshalJSON() ([]byte, error) {
 	type Alias Trip // 無限ループを防ぐためのエイリアス
 	return json.Marshal(&struct {
 		Alias
-		CreatedAt string `json:"created_at"`
-		UpdatedAt string `json:"updated_at"`
+		StartDate *string `json:"start_date"`
+		EndDate   *string `json:"end_date"`
+		CreatedAt string  `json:"created_at"`
+		UpdatedAt string  `json:"updated_at"`
 	}{
 		Alias:     (Alias)(t),
+		StartDate: formatDate(t.StartDate),
+		EndDate:   formatDate(t.EndDate),
 		CreatedAt: t.CreatedAt.Format(time.RFC3339Nano),
 		UpdatedAt: t.UpdatedAt.Format(time.RFC3339Nano),
 	})
 }
+
+func formatDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(dateLayout)
+	return &s
+}
@@ -0,0 +1,34 @@
+package validator
+
+import "time"
+
+type AccommodationURIParameters struct {
+	TripID          string `uri:"trip_id" binding:"required"`
+	AccommodationID string `uri:"accommodation_id" binding:"required"`
+}
+
+// 費用は通貨の最小単位（円なら円、米ドルならセント）の整数と ISO 4217 の通貨コードで指定する。
+// timezone は宿泊先の IANA のタイムゾーン名（例: Asia/Tokyo）で、分からなければ省略する
+type CreateAccommodationJSONBody struct {
+	Name               string    `json:"name" binding:"required"`
+	Address            string    `json:"address"`
+	Timezone           string    `json:"timezone" binding:"max=64"`
+	CheckInAt          time.Time `json:"check_in_at" binding:"required"`
+	CheckOutAt         time.Time `json:"check_out_at" binding:"required,gtfield=CheckInAt"`
+	ConfirmationNumber string    `json:"confirmation_number"`
+	CostAmount         int64     `json:"cost_amount" binding:"gte=0"`
+	CostCurrency       string    `json:"cost_currency" binding:"required,len=3,uppercase"`
+	Notes              string    `json:"notes"`
+}
+
+type UpdateAccommodationJSONBody struct {
+	Name               string    `json:"name" binding:"required"`
+	Address            string    `json:"address"`
+	Timezone           string    `json:"timezone" binding:"max=64"`
+	CheckInAt          time.Time `json:"check_in_at" binding:"required"`
+	CheckOutAt         time.Time `json:"check_out_at" binding:"required,gtfield=CheckInAt"`
+	ConfirmationNumber string    `json:"confirmation_number"`
+	CostAmount         int64     `json:"cost_amount" binding:"gte=0"`
+	CostCurrency       string    `json:"cost_currency" binding:"required,len=3,uppercase"`
+	Notes              string    `json:"notes"`
+}
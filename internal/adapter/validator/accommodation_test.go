@@ -0,0 +1,80 @@
+package validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccommodationURIParameters_Validation(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	t.Run("正常系", func(t *testing.T) {
+		params := AccommodationURIParameters{
+			TripID:          "00000000-0000-0000-0000-000000000001",
+			AccommodationID: "00000000-0000-0000-0000-000000000002",
+		}
+		err := validate.Struct(params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: AccommodationIDが空", func(t *testing.T) {
+		params := AccommodationURIParameters{
+			TripID: "00000000-0000-0000-0000-000000000001",
+		}
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateAccommodationJSONBody_Validation(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	checkInAt := time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC)
+	validBody := func() CreateAccommodationJSONBody {
+		return CreateAccommodationJSONBody{
+			Name:         "test hotel",
+			CheckInAt:    checkInAt,
+			CheckOutAt:   checkInAt.Add(19 * time.Hour),
+			CostAmount:   12000,
+			CostCurrency: "JPY",
+		}
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		err := validate.Struct(validBody())
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: Nameが空", func(t *testing.T) {
+		params := validBody()
+		params.Name = ""
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: チェックアウトがチェックイン以前", func(t *testing.T) {
+		params := validBody()
+		params.CheckOutAt = checkInAt
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 費用が負数", func(t *testing.T) {
+		params := validBody()
+		params.CostAmount = -1
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 通貨コードが小文字", func(t *testing.T) {
+		params := validBody()
+		params.CostCurrency = "jpy"
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+}
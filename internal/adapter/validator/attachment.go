@@ -0,0 +1,21 @@
+package validator
+
+import "mime/multipart"
+
+type AttachmentURIParameters struct {
+	TripID       string `uri:"trip_id" binding:"required"`
+	AttachmentID string `uri:"attachment_id" binding:"required"`
+}
+
+// target_type を指定するとそのリソースの添付ファイルだけを取得する。trip 以外では target_id も指定する
+type ListAttachmentQueryParameters struct {
+	TargetType string `form:"target_type" binding:"omitempty,oneof=trip accommodation expense"`
+	TargetID   string `form:"target_id" binding:"required_if=TargetType accommodation,required_if=TargetType expense,omitempty,uuid"`
+}
+
+// multipart/form-data で送信する。target_type が trip の場合、target_id は不要
+type UploadAttachmentFormBody struct {
+	File       *multipart.FileHeader `form:"file" binding:"required"`
+	TargetType string                `form:"target_type" binding:"required,oneof=trip accommodation expense"`
+	TargetID   string                `form:"target_id" binding:"required_unless=TargetType trip,omitempty,uuid"`
+}
@@ -0,0 +1,83 @@
+package validator
+
+import (
+	"mime/multipart"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadAttachmentFormBody_Validation(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	validBody := func() UploadAttachmentFormBody {
+		return UploadAttachmentFormBody{
+			File:       &multipart.FileHeader{Filename: "voucher.pdf"},
+			TargetType: "accommodation",
+			TargetID:   "00000000-0000-0000-0000-000000000001",
+		}
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		err := validate.Struct(validBody())
+		assert.NoError(t, err)
+	})
+
+	t.Run("正常系: 旅行自体への添付では target_id は不要", func(t *testing.T) {
+		params := validBody()
+		params.TargetType = "trip"
+		params.TargetID = ""
+		err := validate.Struct(params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: ファイルがない", func(t *testing.T) {
+		params := validBody()
+		params.File = nil
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 予約への添付で target_id がない", func(t *testing.T) {
+		params := validBody()
+		params.TargetID = ""
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: target_id が UUID でない", func(t *testing.T) {
+		params := validBody()
+		params.TargetID = "not-a-uuid"
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 不正な target_type", func(t *testing.T) {
+		params := validBody()
+		params.TargetType = "activity"
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+}
+
+func TestListAttachmentQueryParameters_Validation(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	t.Run("正常系: 絞り込みなし", func(t *testing.T) {
+		err := validate.Struct(ListAttachmentQueryParameters{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("正常系: 旅行自体の添付ファイル", func(t *testing.T) {
+		err := validate.Struct(ListAttachmentQueryParameters{TargetType: "trip"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 支出の指定で target_id がない", func(t *testing.T) {
+		err := validate.Struct(ListAttachmentQueryParameters{TargetType: "expense"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,10 @@
+package validator
+
+// 取り込むファイルの本文はリクエストボディにそのまま渡し、形式はクエリパラメータで指定する。
+// dry_run を省略した場合はプレビューとして扱い、保存しない。keys は保存する下書きのキーで、繰り返し指定できる
+type ImportBookingsQueryParameters struct {
+	Format   string   `form:"format" binding:"required,oneof=ics eml"`
+	DryRun   *bool    `form:"dry_run"`
+	Keys     []string `form:"keys"`
+	Currency string   `form:"currency" binding:"omitempty,len=3,uppercase"`
+}
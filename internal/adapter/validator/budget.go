@@ -0,0 +1,7 @@
+package validator
+
+// 上限額はカテゴリをキーとし、currency の最小単位の整数で指定する
+type SaveBudgetJSONBody struct {
+	Currency       string           `json:"currency" binding:"required,len=3,uppercase"`
+	CategoryLimits map[string]int64 `json:"category_limits" binding:"dive,keys,oneof=transport accommodation food activity shopping other,endkeys,gte=0"`
+}
@@ -0,0 +1,10 @@
+package validator
+
+// CalendarQueryParameters は iCalendar の書き出しで使用するタイムゾーン。省略すると UTC で書き出す
+type CalendarQueryParameters struct {
+	TimeZone string `form:"tz" binding:"omitempty,max=64"`
+}
+
+type CalendarFeedURIParameters struct {
+	Token string `uri:"token" binding:"required"`
+}
@@ -0,0 +1,51 @@
+package validator
+
+type ChecklistURIParameters struct {
+	TripID      string `uri:"trip_id" binding:"required"`
+	ChecklistID string `uri:"checklist_id" binding:"required"`
+}
+
+type ItemTemplateURIParameters struct {
+	ItemTemplateID string `uri:"item_template_id" binding:"required"`
+}
+
+// kind は packing（持ち物）、pre_departure（出発前のタスク）、documents（書類）、other のいずれか
+type CreateChecklistJSONBody struct {
+	Name  string                  `json:"name" binding:"required,max=255"`
+	Kind  string                  `json:"kind" binding:"required,oneof=packing pre_departure documents other"`
+	Items []ChecklistItemJSONBody `json:"items" binding:"max=500,dive"`
+}
+
+// items は項目全体を置き換える。id を指定した項目は既存の項目を更新し、id のない項目は追加する
+type UpdateChecklistJSONBody struct {
+	Name  string                  `json:"name" binding:"required,max=255"`
+	Kind  string                  `json:"kind" binding:"required,oneof=packing pre_departure documents other"`
+	Items []ChecklistItemJSONBody `json:"items" binding:"max=500,dive"`
+}
+
+// assignee_id は担当者のユーザーID。旅行のメンバーでなければならない
+type ChecklistItemJSONBody struct {
+	ID         *string `json:"id" binding:"omitempty,uuid"`
+	Name       string  `json:"name" binding:"required,max=255"`
+	Quantity   int     `json:"quantity" binding:"min=1"`
+	Checked    bool    `json:"checked"`
+	AssigneeID *string `json:"assignee_id" binding:"omitempty,uuid"`
+}
+
+type ToggleChecklistItemsJSONBody struct {
+	ItemIDs []string `json:"item_ids" binding:"required,min=1,dive,uuid"`
+	Checked bool     `json:"checked"`
+}
+
+// item_template_ids を省略すると実行ユーザーのすべての項目テンプレートから生成する
+type GenerateChecklistJSONBody struct {
+	Name            string   `json:"name" binding:"required,max=255"`
+	ItemTemplateIDs []string `json:"item_template_ids" binding:"omitempty,dive,uuid"`
+}
+
+// per_day を true にすると、持ち物リストの生成時に quantity を旅行の日数倍する
+type SaveItemTemplateJSONBody struct {
+	Name     string `json:"name" binding:"required,max=255"`
+	Quantity int    `json:"quantity" binding:"min=1"`
+	PerDay   bool   `json:"per_day"`
+}
@@ -0,0 +1,78 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateChecklistJSONBody_Validation(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	validBody := func() CreateChecklistJSONBody {
+		assigneeID := "00000000-0000-0000-0000-000000000001"
+		return CreateChecklistJSONBody{
+			Name: "持ち物",
+			Kind: "packing",
+			Items: []ChecklistItemJSONBody{
+				{Name: "パスポート", Quantity: 1, AssigneeID: &assigneeID},
+			},
+		}
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		err := validate.Struct(validBody())
+		assert.NoError(t, err)
+	})
+
+	t.Run("正常系: 項目なし", func(t *testing.T) {
+		params := validBody()
+		params.Items = nil
+		err := validate.Struct(params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 不正な種類", func(t *testing.T) {
+		params := validBody()
+		params.Kind = "unknown"
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 数量が0", func(t *testing.T) {
+		params := validBody()
+		params.Items[0].Quantity = 0
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 担当者がUUIDでない", func(t *testing.T) {
+		params := validBody()
+		assigneeID := "not-a-uuid"
+		params.Items[0].AssigneeID = &assigneeID
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+}
+
+func TestToggleChecklistItemsJSONBody_Validation(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	t.Run("正常系", func(t *testing.T) {
+		err := validate.Struct(ToggleChecklistItemsJSONBody{ItemIDs: []string{"00000000-0000-0000-0000-000000000001"}, Checked: true})
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 項目が未指定", func(t *testing.T) {
+		err := validate.Struct(ToggleChecklistItemsJSONBody{Checked: true})
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 項目IDがUUIDでない", func(t *testing.T) {
+		err := validate.Struct(ToggleChecklistItemsJSONBody{ItemIDs: []string{"not-a-uuid"}})
+		assert.Error(t, err)
+	})
+}
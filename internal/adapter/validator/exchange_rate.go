@@ -0,0 +1,6 @@
+package validator
+
+// 取り込むファイルの本文はリクエストボディにそのまま渡し、形式はクエリパラメータで指定する
+type ImportExchangeRatesQueryParameters struct {
+	Format string `form:"format" binding:"required,oneof=xml csv"`
+}
@@ -0,0 +1,44 @@
+package validator
+
+type ExpenseURIParameters struct {
+	TripID    string `uri:"trip_id" binding:"required"`
+	ExpenseID string `uri:"expense_id" binding:"required"`
+}
+
+// 金額は amount（"12.34" のような10進数表記の文字列）か amount_minor（通貨の最小単位の整数）のいずれかで指定する。
+// 両方指定された場合は amount_minor を優先する
+type CreateExpenseJSONBody struct {
+	Amount      string                `json:"amount" binding:"required_without=AmountMinor"`
+	AmountMinor *int64                `json:"amount_minor" binding:"omitempty,gt=0"`
+	Currency    string                `json:"currency" binding:"required,len=3,uppercase"`
+	Category    string                `json:"category" binding:"required,oneof=transport accommodation food activity shopping other settlement"`
+	SpentOn     string                `json:"spent_on" binding:"required,datetime=2006-01-02"`
+	Payer       string                `json:"payer" binding:"required"`
+	Description string                `json:"description"`
+	ActivityID  *string               `json:"activity_id" binding:"omitempty,uuid"`
+	Split       *ExpenseSplitJSONBody `json:"split"`
+}
+
+type UpdateExpenseJSONBody struct {
+	Amount      string                `json:"amount" binding:"required_without=AmountMinor"`
+	AmountMinor *int64                `json:"amount_minor" binding:"omitempty,gt=0"`
+	Currency    string                `json:"currency" binding:"required,len=3,uppercase"`
+	Category    string                `json:"category" binding:"required,oneof=transport accommodation food activity shopping other settlement"`
+	SpentOn     string                `json:"spent_on" binding:"required,datetime=2006-01-02"`
+	Payer       string                `json:"payer" binding:"required"`
+	Description string                `json:"description"`
+	ActivityID  *string               `json:"activity_id" binding:"omitempty,uuid"`
+	Split       *ExpenseSplitJSONBody `json:"split"`
+}
+
+// 分担方法。value は method に応じて口数（shares）、最小通貨単位の金額（exact）、
+// ベーシスポイント（percentage、1% = 100）として扱い、equal では使用しない
+type ExpenseSplitJSONBody struct {
+	Method  string                      `json:"method" binding:"required,oneof=equal shares exact percentage"`
+	Entries []ExpenseSplitEntryJSONBody `json:"entries" binding:"required,min=1,dive"`
+}
+
+type ExpenseSplitEntryJSONBody struct {
+	Participant string `json:"participant" binding:"required"`
+	Value       int64  `json:"value" binding:"gte=0"`
+}
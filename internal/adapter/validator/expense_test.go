@@ -0,0 +1,132 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateExpenseJSONBody_Validation(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	validBody := func() CreateExpenseJSONBody {
+		return CreateExpenseJSONBody{
+			Amount:   "12.34",
+			Currency: "USD",
+			Category: "food",
+			SpentOn:  "2024-05-01",
+			Payer:    "Alice",
+		}
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		err := validate.Struct(validBody())
+		assert.NoError(t, err)
+	})
+
+	t.Run("正常系: 最小単位の金額のみ指定", func(t *testing.T) {
+		params := validBody()
+		params.Amount = ""
+		minor := int64(1234)
+		params.AmountMinor = &minor
+		err := validate.Struct(params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 金額が未指定", func(t *testing.T) {
+		params := validBody()
+		params.Amount = ""
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 不正なカテゴリ", func(t *testing.T) {
+		params := validBody()
+		params.Category = "unknown"
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 日付の形式が不正", func(t *testing.T) {
+		params := validBody()
+		params.SpentOn = "2024/05/01"
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: アクティビティIDがUUIDでない", func(t *testing.T) {
+		params := validBody()
+		activityID := "not-a-uuid"
+		params.ActivityID = &activityID
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("正常系: 分担方法を指定", func(t *testing.T) {
+		params := validBody()
+		params.Split = &ExpenseSplitJSONBody{
+			Method:  "shares",
+			Entries: []ExpenseSplitEntryJSONBody{{Participant: "Alice", Value: 1}, {Participant: "Bob", Value: 2}},
+		}
+		err := validate.Struct(params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 未定義の分担方法", func(t *testing.T) {
+		params := validBody()
+		params.Split = &ExpenseSplitJSONBody{
+			Method:  "random",
+			Entries: []ExpenseSplitEntryJSONBody{{Participant: "Alice"}},
+		}
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 分担する参加者がいない", func(t *testing.T) {
+		params := validBody()
+		params.Split = &ExpenseSplitJSONBody{Method: "equal"}
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 分担の値が負", func(t *testing.T) {
+		params := validBody()
+		params.Split = &ExpenseSplitJSONBody{
+			Method:  "exact",
+			Entries: []ExpenseSplitEntryJSONBody{{Participant: "Alice", Value: -1}},
+		}
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+}
+
+func TestSaveBudgetJSONBody_Validation(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	t.Run("正常系", func(t *testing.T) {
+		err := validate.Struct(SaveBudgetJSONBody{
+			Currency:       "JPY",
+			CategoryLimits: map[string]int64{"food": 30000, "transport": 0},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 不正なカテゴリ", func(t *testing.T) {
+		err := validate.Struct(SaveBudgetJSONBody{
+			Currency:       "JPY",
+			CategoryLimits: map[string]int64{"souvenir": 1000},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 負の上限額", func(t *testing.T) {
+		err := validate.Struct(SaveBudgetJSONBody{
+			Currency:       "JPY",
+			CategoryLimits: map[string]int64{"food": -1},
+		})
+		assert.Error(t, err)
+	})
+}
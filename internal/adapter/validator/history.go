@@ -0,0 +1,6 @@
+package validator
+
+// to は戻す先のリビジョン番号
+type RevertTripQueryParameters struct {
+	To int64 `form:"to" binding:"required,min=1"`
+}
@@ -0,0 +1,12 @@
+package validator
+
+type InvitationURIParameters struct {
+	InvitationID string `uri:"invitation_id" binding:"required"`
+}
+
+// 宛先は email か username のいずれか一方で指定する
+type CreateInvitationJSONBody struct {
+	Email    string `json:"email" binding:"omitempty,email,excluded_with=Username"`
+	Username string `json:"username" binding:"required_without=Email"`
+	Role     string `json:"role" binding:"required,oneof=editor viewer"`
+}
@@ -0,0 +1,67 @@
+package validator
+
+import "time"
+
+type ActivityURIParameters struct {
+	TripID     string `uri:"trip_id" binding:"required"`
+	ActivityID string `uri:"activity_id" binding:"required"`
+}
+
+// date を指定するとその日の行程だけを取得する
+type GetItineraryQueryParameters struct {
+	Date *string `form:"date" binding:"omitempty,datetime=2006-01-02"`
+}
+
+// travel_mode は直前の行動の場所から向かう移動手段で、walk、bicycle、car、transit のいずれか。
+// timezone は IANA のタイムゾーン名（例: Asia/Tokyo）で、省略すると場所のタイムゾーンを使う。
+// start_at と end_at は決まっていなければ省略する
+type CreateActivityJSONBody struct {
+	Date       string                 `json:"date" binding:"required,datetime=2006-01-02"`
+	Title      string                 `json:"title" binding:"required,max=255"`
+	Place      *ActivityPlaceJSONBody `json:"place"`
+	Timezone   string                 `json:"timezone" binding:"max=64"`
+	StartAt    *time.Time             `json:"start_at"`
+	EndAt      *time.Time             `json:"end_at"`
+	TravelMode string                 `json:"travel_mode" binding:"required,oneof=walk bicycle car transit"`
+	Notes      string                 `json:"notes" binding:"max=10000"`
+}
+
+type UpdateActivityJSONBody struct {
+	Date       string                 `json:"date" binding:"required,datetime=2006-01-02"`
+	Title      string                 `json:"title" binding:"required,max=255"`
+	Place      *ActivityPlaceJSONBody `json:"place"`
+	Timezone   string                 `json:"timezone" binding:"max=64"`
+	StartAt    *time.Time             `json:"start_at"`
+	EndAt      *time.Time             `json:"end_at"`
+	TravelMode string                 `json:"travel_mode" binding:"required,oneof=walk bicycle car transit"`
+	Notes      string                 `json:"notes" binding:"max=10000"`
+}
+
+// latitude と longitude は両方指定するか、両方省略する。座標のない行動は移動距離の見積もりから外れる。
+// timezone は IANA のタイムゾーン名で、分からなければ省略する
+type ActivityPlaceJSONBody struct {
+	Name      string   `json:"name" binding:"required,max=255"`
+	Latitude  *float64 `json:"latitude" binding:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" binding:"required_with=Latitude,omitempty,min=-180,max=180"`
+	Timezone  string   `json:"timezone" binding:"max=64"`
+}
+
+// day は旅行の開始日を 1 日目とした日数
+type OptimizeDayURIParameters struct {
+	TripID string `uri:"trip_id" binding:"required"`
+	Day    int    `uri:"day" binding:"required,min=1"`
+}
+
+// start と end は 1 日の出発地と到着地（通常は宿泊先）で、決まっていなければ省略する。
+// pinned_activity_ids の行動は今の位置から動かさない。apply を true にすると提案した順に並び順を更新する
+type OptimizeDayJSONBody struct {
+	Start             *RouteEndpointJSONBody `json:"start"`
+	End               *RouteEndpointJSONBody `json:"end"`
+	PinnedActivityIDs []string               `json:"pinned_activity_ids" binding:"max=500,dive,required"`
+	Apply             bool                   `json:"apply"`
+}
+
+type RouteEndpointJSONBody struct {
+	Latitude  *float64 `json:"latitude" binding:"required,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" binding:"required,min=-180,max=180"`
+}
@@ -0,0 +1,36 @@
+package validator
+
+type JournalEntryURIParameters struct {
+	TripID         string `uri:"trip_id" binding:"required"`
+	JournalEntryID string `uri:"journal_entry_id" binding:"required"`
+}
+
+// date を指定するとその日の日記だけを取得する
+type ListJournalEntryQueryParameters struct {
+	Date *string `form:"date" binding:"omitempty,datetime=2006-01-02"`
+}
+
+// body は Markdown。mood は great、good、neutral、bad、terrible のいずれか
+type CreateJournalEntryJSONBody struct {
+	Date  string                `json:"date" binding:"required,datetime=2006-01-02"`
+	Title string                `json:"title" binding:"required,max=255"`
+	Body  string                `json:"body" binding:"max=100000"`
+	Mood  string                `json:"mood" binding:"required,oneof=great good neutral bad terrible"`
+	Place *JournalPlaceJSONBody `json:"place"`
+}
+
+type UpdateJournalEntryJSONBody struct {
+	Date  string                `json:"date" binding:"required,datetime=2006-01-02"`
+	Title string                `json:"title" binding:"required,max=255"`
+	Body  string                `json:"body" binding:"max=100000"`
+	Mood  string                `json:"mood" binding:"required,oneof=great good neutral bad terrible"`
+	Place *JournalPlaceJSONBody `json:"place"`
+}
+
+// latitude と longitude は両方指定するか、両方省略する。timezone は IANA のタイムゾーン名で、分からなければ省略する
+type JournalPlaceJSONBody struct {
+	Name      string   `json:"name" binding:"required,max=255"`
+	Latitude  *float64 `json:"latitude" binding:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" binding:"required_with=Latitude,omitempty,min=-180,max=180"`
+	Timezone  string   `json:"timezone" binding:"max=64"`
+}
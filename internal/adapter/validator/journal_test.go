@@ -0,0 +1,81 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateJournalEntryJSONBody_Validation(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	validBody := func() CreateJournalEntryJSONBody {
+		latitude, longitude := 0.0, 135.7292
+		return CreateJournalEntryJSONBody{
+			Date:  "2024-05-01",
+			Title: "嵐山",
+			Body:  "朝の**竹林**は静かだった",
+			Mood:  "great",
+			Place: &JournalPlaceJSONBody{Name: "竹林の小径", Latitude: &latitude, Longitude: &longitude},
+		}
+	}
+
+	t.Run("正常系", func(t *testing.T) {
+		err := validate.Struct(validBody())
+		assert.NoError(t, err)
+	})
+
+	t.Run("正常系: 場所と本文なし", func(t *testing.T) {
+		params := validBody()
+		params.Body = ""
+		params.Place = nil
+		err := validate.Struct(params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("正常系: 座標のない場所", func(t *testing.T) {
+		params := validBody()
+		params.Place.Latitude = nil
+		params.Place.Longitude = nil
+		err := validate.Struct(params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 不正な日付", func(t *testing.T) {
+		params := validBody()
+		params.Date = "2024/05/01"
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 不正な気分", func(t *testing.T) {
+		params := validBody()
+		params.Mood = "happy"
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 経度だけが指定されている", func(t *testing.T) {
+		params := validBody()
+		params.Place.Latitude = nil
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 緯度が範囲外", func(t *testing.T) {
+		params := validBody()
+		latitude := 91.0
+		params.Place.Latitude = &latitude
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 場所の名前が空", func(t *testing.T) {
+		params := validBody()
+		params.Place.Name = ""
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+}
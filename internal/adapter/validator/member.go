@@ -0,0 +1,15 @@
+package validator
+
+type MemberURIParameters struct {
+	TripID string `uri:"trip_id" binding:"required"`
+	UserID string `uri:"user_id" binding:"required"`
+}
+
+// 所有者のロールは所有権の移譲でのみ付与できるため、ここでは editor か viewer だけを受け付ける
+type ChangeMemberRoleJSONBody struct {
+	Role string `json:"role" binding:"required,oneof=editor viewer"`
+}
+
+type TransferOwnershipJSONBody struct {
+	NewOwnerID string `json:"new_owner_id" binding:"required"`
+}
@@ -0,0 +1,14 @@
+package validator
+
+import "mime/multipart"
+
+type PhotoURIParameters struct {
+	TripID  string `uri:"trip_id" binding:"required"`
+	PhotoID string `uri:"photo_id" binding:"required"`
+}
+
+// multipart/form-data で送信する。strip_location を true にすると、保存する写真から位置情報を取り除く
+type UploadPhotoFormBody struct {
+	File          *multipart.FileHeader `form:"file" binding:"required"`
+	StripLocation bool                  `form:"strip_location"`
+}
@@ -0,0 +1,13 @@
+package validator
+
+// 空港・都市の検索語はコードか名前。名前は単語の先頭への前方一致と、綴りの揺れを許すあいまい一致で検索する
+type ReferenceSearchQueryParameters struct {
+	Q     string `form:"q" binding:"required,max=200"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=50"`
+}
+
+// 国の一覧は検索語を省略するとすべての国を返す。limit は検索語を指定した場合の最大件数
+type ListCountriesQueryParameters struct {
+	Q     string `form:"q" binding:"max=200"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=50"`
+}
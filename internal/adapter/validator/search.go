@@ -0,0 +1,7 @@
+package validator
+
+// 検索語は空白で区切ると、すべての語を含むものを検索する。limit はリソースの種類ごとの最大件数
+type SearchQueryParameters struct {
+	Q     string `form:"q" binding:"required,max=200"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=50"`
+}
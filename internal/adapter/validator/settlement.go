@@ -0,0 +1,12 @@
+package validator
+
+// 金額は amount（"12.34" のような10進数表記の文字列）か amount_minor（通貨の最小単位の整数）のいずれかで指定する
+type RecordSettlementTransferJSONBody struct {
+	From        string `json:"from" binding:"required"`
+	To          string `json:"to" binding:"required"`
+	Amount      string `json:"amount" binding:"required_without=AmountMinor"`
+	AmountMinor *int64 `json:"amount_minor" binding:"omitempty,gt=0"`
+	Currency    string `json:"currency" binding:"required,len=3,uppercase"`
+	PaidOn      string `json:"paid_on" binding:"required,datetime=2006-01-02"`
+	Description string `json:"description"`
+}
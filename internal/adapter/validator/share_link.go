@@ -0,0 +1,19 @@
+package validator
+
+import "time"
+
+type ShareLinkURIParameters struct {
+	TripID      string `uri:"trip_id" binding:"required"`
+	ShareLinkID string `uri:"share_link_id" binding:"required"`
+}
+
+type SharedTripURIParameters struct {
+	Token string `uri:"token" binding:"required"`
+}
+
+// expires_at を省略すると無期限、password を省略するとパスワードなしの共有リンクになる。
+// パスワードは bcrypt で扱える 72 バイトまで
+type CreateShareLinkJSONBody struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+	Password  string     `json:"password" binding:"omitempty,min=8,max=72"`
+}
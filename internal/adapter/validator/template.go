@@ -0,0 +1,18 @@
+package validator
+
+type TemplateURIParameters struct {
+	TemplateID string `uri:"template_id" binding:"required"`
+}
+
+// name を省略すると旅行の名前を使う。public を true にすると他のユーザーも閲覧・利用できる
+type CreateTemplateJSONBody struct {
+	Name        string `json:"name" binding:"omitempty,max=255"`
+	Description string `json:"description" binding:"omitempty,max=2000"`
+	Public      bool   `json:"public"`
+}
+
+type UpdateTemplateJSONBody struct {
+	Name        string `json:"name" binding:"required,max=255"`
+	Description string `json:"description" binding:"omitempty,max=2000"`
+	Public      bool   `json:"public"`
+}
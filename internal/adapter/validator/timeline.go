@@ -0,0 +1,6 @@
+package validator
+
+// TimelineQueryParameters はタイムゾーンが決まっていない項目と宿泊予約を表すタイムゾーン。省略すると UTC で表す
+type TimelineQueryParameters struct {
+	TimeZone string `form:"tz" binding:"omitempty,max=64"`
+}
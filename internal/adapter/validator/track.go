@@ -0,0 +1,21 @@
+package validator
+
+import "mime/multipart"
+
+type TrackURIParameters struct {
+	TripID  string `uri:"trip_id" binding:"required"`
+	TrackID string `uri:"track_id" binding:"required"`
+}
+
+// activity_id を指定するとその行動のトラックだけを取得する
+type ListTrackQueryParameters struct {
+	ActivityID *string `form:"activity_id" binding:"omitempty,uuid"`
+}
+
+// multipart/form-data で送信する。file は GPX ファイル。
+// activity_id はトラックを記録した行動のIDで任意。name を省略すると GPX に記録された名前かファイル名を使う
+type UploadTrackFormBody struct {
+	File       *multipart.FileHeader `form:"file" binding:"required"`
+	ActivityID *string               `form:"activity_id" binding:"omitempty,uuid"`
+	Name       string                `form:"name" binding:"max=255"`
+}
@@ -0,0 +1,42 @@
+package validator
+
+import "time"
+
+type TransportLegURIParameters struct {
+	TripID         string `uri:"trip_id" binding:"required"`
+	TransportLegID string `uri:"transport_leg_id" binding:"required"`
+}
+
+// mode は flight、train、bus、ferry、car、other のいずれか。
+// departure_at と arrival_at は UTC オフセット付きの日時で、表示には出発地・到着地の timezone を使う。
+// carrier は運行会社、number は便名や列車番号で、分からなければ省略する
+type CreateTransportLegJSONBody struct {
+	Mode        string                 `json:"mode" binding:"required,oneof=flight train bus ferry car other"`
+	Origin      TransportPlaceJSONBody `json:"origin" binding:"required"`
+	Destination TransportPlaceJSONBody `json:"destination" binding:"required"`
+	DepartureAt time.Time              `json:"departure_at" binding:"required"`
+	ArrivalAt   time.Time              `json:"arrival_at" binding:"required"`
+	Carrier     string                 `json:"carrier" binding:"max=255"`
+	Number      string                 `json:"number" binding:"max=64"`
+	Notes       string                 `json:"notes" binding:"max=10000"`
+}
+
+type UpdateTransportLegJSONBody struct {
+	Mode        string                 `json:"mode" binding:"required,oneof=flight train bus ferry car other"`
+	Origin      TransportPlaceJSONBody `json:"origin" binding:"required"`
+	Destination TransportPlaceJSONBody `json:"destination" binding:"required"`
+	DepartureAt time.Time              `json:"departure_at" binding:"required"`
+	ArrivalAt   time.Time              `json:"arrival_at" binding:"required"`
+	Carrier     string                 `json:"carrier" binding:"max=255"`
+	Number      string                 `json:"number" binding:"max=64"`
+	Notes       string                 `json:"notes" binding:"max=10000"`
+}
+
+// latitude と longitude は両方指定するか、両方省略する。
+// timezone は IANA のタイムゾーン名（例: Asia/Tokyo）で、分からなければ省略する
+type TransportPlaceJSONBody struct {
+	Name      string   `json:"name" binding:"required,max=255"`
+	Latitude  *float64 `json:"latitude" binding:"required_with=Longitude,omitempty,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" binding:"required_with=Latitude,omitempty,min=-180,max=180"`
+	Timezone  string   `json:"timezone" binding:"max=64"`
+}
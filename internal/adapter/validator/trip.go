@@ -4,10 +4,39 @@ type TripURIParameters struct {
 	TripID string `uri:"trip_id" binding:"required"`
 }
 
+// 旅行期間は YYYY-MM-DD 形式で指定する。開始日と終了日は両方指定するか、両方省略する。
+// home_currency は予算の集計に使う基準通貨で、省略すると未設定になる。
+// template_id を指定すると雛形から作成し、name を省略すると雛形の名前、end_date を省略すると雛形の日数から終了日を決める
 type CreateTripJSONBody struct {
-	Name string `json:"name" binding:"required"`
+	Name         string  `json:"name" binding:"required_without=TemplateID"`
+	StartDate    *string `json:"start_date" binding:"omitempty,datetime=2006-01-02"`
+	EndDate      *string `json:"end_date" binding:"omitempty,datetime=2006-01-02"`
+	HomeCurrency string  `json:"home_currency" binding:"omitempty,len=3,uppercase"`
+	TemplateID   *string `json:"template_id" binding:"omitempty,uuid"`
 }
 
+// offset_days は複製した旅行の日付をずらす日数。name を省略すると元の旅行の名前を使う
+type DuplicateTripJSONBody struct {
+	Name       string `json:"name"`
+	OffsetDays int    `json:"offset_days" binding:"min=-3650,max=3650"`
+}
+
+// home_currency を省略すると基準通貨は未設定になる
 type UpdateTripJSONBody struct {
-	Name string `json:"name" binding:"required"`
+	Name         string  `json:"name" binding:"required"`
+	StartDate    *string `json:"start_date" binding:"omitempty,datetime=2006-01-02"`
+	EndDate      *string `json:"end_date" binding:"omitempty,datetime=2006-01-02"`
+	HomeCurrency string  `json:"home_currency" binding:"omitempty,len=3,uppercase"`
+}
+
+// 一覧はキーセット方式でページングする。次のページは前回のレスポンスの next_cursor を cursor に指定して取得する。
+// from と to を指定すると、期間がその範囲と重なる旅行だけを返す
+type ListTripQueryParameters struct {
+	Limit      int     `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor     string  `form:"cursor"`
+	Sort       string  `form:"sort" binding:"omitempty,oneof=name created_at updated_at start_date"`
+	Order      string  `form:"order" binding:"omitempty,oneof=asc desc"`
+	NamePrefix string  `form:"name_prefix" binding:"omitempty,max=255"`
+	From       *string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	To         *string `form:"to" binding:"omitempty,datetime=2006-01-02"`
 }
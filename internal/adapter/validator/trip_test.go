@@ -48,3 +48,78 @@ func TestCreateTripJSONBody_Validation(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestCreateTripJSONBody_PeriodValidation(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	startDate := "2024-05-01"
+	endDate := "2024-05-03"
+	invalidDate := "2024/05/01"
+
+	t.Run("正常系: 期間を指定", func(t *testing.T) {
+		params := CreateTripJSONBody{
+			Name:      "test name",
+			StartDate: &startDate,
+			EndDate:   &endDate,
+		}
+		err := validate.Struct(params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 日付の形式が不正", func(t *testing.T) {
+		params := CreateTripJSONBody{
+			Name:      "test name",
+			StartDate: &invalidDate,
+			EndDate:   &endDate,
+		}
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateTripJSONBody_TemplateValidation(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	templateID := "00000000-0000-0000-0000-000000000001"
+	invalidTemplateID := "not-a-uuid"
+
+	t.Run("正常系: 雛形を指定すれば名前を省略できる", func(t *testing.T) {
+		params := CreateTripJSONBody{
+			TemplateID: &templateID,
+		}
+		err := validate.Struct(params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 雛形IDの形式が不正", func(t *testing.T) {
+		params := CreateTripJSONBody{
+			Name:       "test name",
+			TemplateID: &invalidTemplateID,
+		}
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+}
+
+func TestDuplicateTripJSONBody_Validation(t *testing.T) {
+	validate := validator.New()
+	validate.SetTagName("binding")
+
+	t.Run("正常系: 前にずらす", func(t *testing.T) {
+		params := DuplicateTripJSONBody{
+			OffsetDays: -7,
+		}
+		err := validate.Struct(params)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: ずらす日数が大きすぎる", func(t *testing.T) {
+		params := DuplicateTripJSONBody{
+			OffsetDays: 3651,
+		}
+		err := validate.Struct(params)
+		assert.Error(t, err)
+	})
+}
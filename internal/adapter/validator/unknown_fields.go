@@ -0,0 +1,64 @@
+package validator
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// UnknownFieldsError は JSON の文書に受け付けないフィールドが含まれていることを表す
+type UnknownFieldsError struct {
+	Fields []string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	return "unknown fields: " + strings.Join(e.Fields, ", ")
+}
+
+// CheckKnownFields は JSON オブジェクト doc のキーを body の構造体の json タグと突き合わせ、
+// タグにないキーがあればそのフィールド名を名前順に並べた UnknownFieldsError を返す
+func CheckKnownFields(doc []byte, body any) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(doc, &fields); err != nil {
+		return err
+	}
+
+	known := jsonFieldNames(reflect.TypeOf(body))
+	var unknown []string
+	for name := range fields {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	sort.Strings(unknown)
+	return &UnknownFieldsError{Fields: unknown}
+}
+
+// jsonFieldNames は構造体の公開フィールドの JSON での名前を返す
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	names := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = true
+	}
+	return names
+}
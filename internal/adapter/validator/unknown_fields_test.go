@@ -0,0 +1,42 @@
+package validator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckKnownFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		doc        string
+		wantFields []string
+	}{
+		{name: "正常系: すべて既知のフィールド", doc: `{"name":"京都旅行","start_date":null,"home_currency":"JPY"}`},
+		{name: "正常系: 空のオブジェクト", doc: `{}`},
+		{name: "異常系: 未知のフィールドを名前順に返す", doc: `{"nmae":"京都旅行","name":"京都旅行","budget":1}`, wantFields: []string{"budget", "nmae"}},
+		{name: "異常系: 大文字小文字が異なるフィールドは未知とする", doc: `{"Name":"京都旅行"}`, wantFields: []string{"Name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckKnownFields([]byte(tt.doc), UpdateTripJSONBody{})
+
+			if tt.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var unknownFieldsErr *UnknownFieldsError
+			require.ErrorAs(t, err, &unknownFieldsErr)
+			assert.Equal(t, tt.wantFields, unknownFieldsErr.Fields)
+		})
+	}
+
+	t.Run("異常系: オブジェクトでない文書は JSON の型エラーを返す", func(t *testing.T) {
+		err := CheckKnownFields([]byte(`["name"]`), UpdateTripJSONBody{})
+
+		var typeErr *json.UnmarshalTypeError
+		assert.ErrorAs(t, err, &typeErr)
+	})
+}
@@ -0,0 +1,119 @@
+package accommodation
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// Accommodation は旅行に紐づく宿泊予約を表現するエンティティ。
+// timezone は宿泊先のタイムゾーンで、チェックイン・チェックアウトを現地時刻で表示するのに使う。不明な場合は nil
+type Accommodation struct {
+	id                 AccommodationID
+	tripID             trip.TripID
+	name               string
+	address            string
+	timezone           *geo.Timezone
+	stay               Stay
+	confirmationNumber string
+	cost               money.Money
+	notes              string
+	createdAt          time.Time
+	updatedAt          time.Time
+}
+
+// NewAccommodation は新しい宿泊予約を作成する
+func NewAccommodation(
+	id AccommodationID,
+	tripID trip.TripID,
+	name, address string,
+	timezone *geo.Timezone,
+	stay Stay,
+	confirmationNumber string,
+	cost money.Money,
+	notes string,
+	createdAt, updatedAt time.Time,
+) *Accommodation {
+	return &Accommodation{
+		id:                 id,
+		tripID:             tripID,
+		name:               name,
+		address:            address,
+		timezone:           timezone,
+		stay:               stay,
+		confirmationNumber: confirmationNumber,
+		cost:               cost,
+		notes:              notes,
+		createdAt:          createdAt,
+		updatedAt:          updatedAt,
+	}
+}
+
+// Getters
+func (a *Accommodation) ID() AccommodationID        { return a.id }
+func (a *Accommodation) TripID() trip.TripID        { return a.tripID }
+func (a *Accommodation) Name() string               { return a.name }
+func (a *Accommodation) Address() string            { return a.address }
+func (a *Accommodation) Timezone() *geo.Timezone    { return a.timezone }
+func (a *Accommodation) Stay() Stay                 { return a.stay }
+func (a *Accommodation) ConfirmationNumber() string { return a.confirmationNumber }
+func (a *Accommodation) Cost() money.Money          { return a.cost }
+func (a *Accommodation) Notes() string              { return a.notes }
+func (a *Accommodation) CreatedAt() time.Time       { return a.createdAt }
+func (a *Accommodation) UpdatedAt() time.Time       { return a.updatedAt }
+
+// Update は宿泊予約の情報を更新する
+func (a *Accommodation) Update(
+	name, address string,
+	timezone *geo.Timezone,
+	stay Stay,
+	confirmationNumber string,
+	cost money.Money,
+	notes string,
+	updatedAt time.Time,
+) *Accommodation {
+	return &Accommodation{
+		id:                 a.id,
+		tripID:             a.tripID,
+		name:               name,
+		address:            address,
+		timezone:           timezone,
+		stay:               stay,
+		confirmationNumber: confirmationNumber,
+		cost:               cost,
+		notes:              notes,
+		createdAt:          a.createdAt,
+		updatedAt:          updatedAt,
+	}
+}
+
+// Duplicate は宿泊予約を別の旅行へ複製し、滞在期間を offsetDays 日ずらす。
+// 予約番号は元の予約に固有のものであるため引き継がない
+func (a *Accommodation) Duplicate(id AccommodationID, tripID trip.TripID, offsetDays int, createdAt time.Time) *Accommodation {
+	return NewAccommodation(id, tripID, a.name, a.address, a.timezone, a.stay.Shift(offsetDays), "", a.cost, a.notes, createdAt, createdAt)
+}
+
+// Nights は宿泊予約の宿泊日を宿泊先の現地の日付で返す
+func (a *Accommodation) Nights() []time.Time {
+	return a.stay.Nights(a.timezone)
+}
+
+// ValidateFor は宿泊予約が指定された旅行の期間内に収まっているかを宿泊先の現地の日付で検証する
+func (a *Accommodation) ValidateFor(t *trip.Trip) error {
+	if !a.tripID.Equals(t.ID()) {
+		return NewAccommodationNotFoundError()
+	}
+	if !a.stay.Within(t.Period(), a.timezone) {
+		return NewOutsideTripPeriodError()
+	}
+	return nil
+}
+
+func (a *Accommodation) Equals(other *Accommodation) bool {
+	if other == nil {
+		return false
+	}
+	return a.id.Equals(other.id)
+}
@@ -0,0 +1,154 @@
+package accommodation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStay(t *testing.T, checkIn, checkOut time.Time) Stay {
+	t.Helper()
+	stay, err := NewStay(checkIn, checkOut)
+	require.NoError(t, err)
+	return stay
+}
+
+func newTestMoney(t *testing.T, amount int64, currency string) money.Money {
+	t.Helper()
+	m, err := money.NewMoney(amount, currency)
+	require.NoError(t, err)
+	return m
+}
+
+func TestNewAccommodation(t *testing.T) {
+	id := NewAccommodationID("accommodation-id-1")
+	tripID := trip.NewTripID("trip-id-1")
+	stay := newTestStay(t, time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC))
+	cost := newTestMoney(t, 32000, "JPY")
+	createdAt := time.Now().Add(-24 * time.Hour)
+	updatedAt := time.Now()
+
+	tz, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+
+	a := NewAccommodation(id, tripID, "Hotel Kyoto", "Kyoto, Japan", &tz, stay, "ABC123", cost, "朝食付き", createdAt, updatedAt)
+
+	assert.NotNil(t, a, "NewAccommodation は nil を返すべきではない")
+	assert.Equal(t, id, a.ID(), "ID() は正しい ID を返すべき")
+	assert.Equal(t, tripID, a.TripID(), "TripID() は正しい TripID を返すべき")
+	assert.Equal(t, "Hotel Kyoto", a.Name(), "Name() は正しい name を返すべき")
+	assert.Equal(t, "Kyoto, Japan", a.Address(), "Address() は正しい address を返すべき")
+	assert.Equal(t, &tz, a.Timezone(), "Timezone() は正しい timezone を返すべき")
+	assert.Equal(t, stay, a.Stay(), "Stay() は正しい stay を返すべき")
+	assert.Equal(t, "ABC123", a.ConfirmationNumber(), "ConfirmationNumber() は正しい値を返すべき")
+	assert.Equal(t, cost, a.Cost(), "Cost() は正しい cost を返すべき")
+	assert.Equal(t, "朝食付き", a.Notes(), "Notes() は正しい notes を返すべき")
+	assert.Equal(t, createdAt, a.CreatedAt(), "CreatedAt() は正しい createdAt を返すべき")
+	assert.Equal(t, updatedAt, a.UpdatedAt(), "UpdatedAt() は正しい updatedAt を返すべき")
+}
+
+func TestAccommodation_Update(t *testing.T) {
+	stay := newTestStay(t, time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC))
+	cost := newTestMoney(t, 32000, "JPY")
+	createdAt := time.Now().Add(-48 * time.Hour)
+	updatedAt := time.Now().Add(-24 * time.Hour)
+	a := NewAccommodation(NewAccommodationID("accommodation-id-2"), trip.NewTripID("trip-id-1"), "Hotel", "Addr", nil, stay, "A1", cost, "", createdAt, updatedAt)
+
+	newStay := newTestStay(t, time.Date(2024, 5, 2, 15, 0, 0, 0, time.UTC), time.Date(2024, 5, 4, 10, 0, 0, 0, time.UTC))
+	newCost := newTestMoney(t, 25000, "USD")
+	newUpdatedAt := time.Now()
+	newTimezone, err := geo.NewTimezone("Pacific/Honolulu")
+	require.NoError(t, err)
+
+	updated := a.Update("Ryokan", "New Addr", &newTimezone, newStay, "B2", newCost, "notes", newUpdatedAt)
+
+	assert.Equal(t, a.ID(), updated.ID(), "Update は元の ID を保持すべき")
+	assert.Equal(t, a.TripID(), updated.TripID(), "Update は元の TripID を保持すべき")
+	assert.Equal(t, "Ryokan", updated.Name())
+	assert.Equal(t, "New Addr", updated.Address())
+	assert.Equal(t, &newTimezone, updated.Timezone())
+	assert.Equal(t, newStay, updated.Stay())
+	assert.Equal(t, "B2", updated.ConfirmationNumber())
+	assert.Equal(t, newCost, updated.Cost())
+	assert.Equal(t, "notes", updated.Notes())
+	assert.Equal(t, createdAt, updated.CreatedAt(), "Update は元の createdAt を保持すべき")
+	assert.Equal(t, newUpdatedAt, updated.UpdatedAt(), "Update は新しい updatedAt を設定すべき")
+
+	// 元の accommodation が変更されていないことを確認
+	assert.Equal(t, "Hotel", a.Name(), "元の Accommodation の name は変更されてはいけない")
+	assert.Equal(t, stay, a.Stay(), "元の Accommodation の stay は変更されてはいけない")
+}
+
+func TestAccommodation_Duplicate(t *testing.T) {
+	stay := newTestStay(t, time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC))
+	cost := newTestMoney(t, 32000, "JPY")
+	createdAt := time.Now().Add(-48 * time.Hour)
+	tz, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	a := NewAccommodation(NewAccommodationID("accommodation-id-1"), trip.NewTripID("trip-id-1"), "Hotel", "Addr", &tz, stay, "A1", cost, "notes", createdAt, createdAt)
+
+	now := time.Now()
+	copied := a.Duplicate(NewAccommodationID("accommodation-id-2"), trip.NewTripID("trip-id-2"), 3, now)
+
+	assert.Equal(t, NewAccommodationID("accommodation-id-2"), copied.ID(), "新しい ID を持つべき")
+	assert.Equal(t, trip.NewTripID("trip-id-2"), copied.TripID(), "複製先の旅行に紐づくべき")
+	assert.Equal(t, "Hotel", copied.Name())
+	assert.Equal(t, "Addr", copied.Address())
+	assert.Equal(t, &tz, copied.Timezone(), "タイムゾーンは引き継がれるべき")
+	assert.Equal(t, stay.Shift(3), copied.Stay(), "滞在期間がずれるべき")
+	assert.Empty(t, copied.ConfirmationNumber(), "予約番号は引き継がれないべき")
+	assert.Equal(t, cost, copied.Cost())
+	assert.Equal(t, "notes", copied.Notes())
+	assert.Equal(t, now, copied.CreatedAt())
+	assert.Equal(t, now, copied.UpdatedAt())
+}
+
+func TestAccommodation_ValidateFor(t *testing.T) {
+	now := time.Now()
+	tripID := trip.NewTripID("trip-id-1")
+	period, err := trip.NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	tr := trip.NewTrip(tripID, "Trip", period, "", now, now)
+	cost := newTestMoney(t, 0, "JPY")
+
+	t.Run("正常系: 旅行期間内", func(t *testing.T) {
+		stay := newTestStay(t, time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC))
+		a := NewAccommodation(NewAccommodationID("a1"), tripID, "Hotel", "", nil, stay, "", cost, "", now, now)
+
+		assert.NoError(t, a.ValidateFor(tr))
+	})
+
+	t.Run("異常系: 旅行期間外", func(t *testing.T) {
+		stay := newTestStay(t, time.Date(2024, 5, 2, 15, 0, 0, 0, time.UTC), time.Date(2024, 5, 4, 10, 0, 0, 0, time.UTC))
+		a := NewAccommodation(NewAccommodationID("a1"), tripID, "Hotel", "", nil, stay, "", cost, "", now, now)
+
+		assert.ErrorIs(t, a.ValidateFor(tr), NewOutsideTripPeriodError())
+	})
+
+	t.Run("異常系: 別の旅行の宿泊予約", func(t *testing.T) {
+		stay := newTestStay(t, time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC), time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC))
+		a := NewAccommodation(NewAccommodationID("a1"), trip.NewTripID("other-trip"), "Hotel", "", nil, stay, "", cost, "", now, now)
+
+		assert.ErrorIs(t, a.ValidateFor(tr), NewAccommodationNotFoundError())
+	})
+}
+
+func TestAccommodation_Equals(t *testing.T) {
+	now := time.Now()
+	stay := newTestStay(t, now, now.Add(24*time.Hour))
+	cost := newTestMoney(t, 0, "JPY")
+	tripID := trip.NewTripID("trip-id-1")
+
+	a1 := NewAccommodation(NewAccommodationID("a1"), tripID, "A", "", nil, stay, "", cost, "", now, now)
+	a2 := NewAccommodation(NewAccommodationID("a1"), tripID, "B", "", nil, stay, "", cost, "", now, now) // a1 と同じ ID
+	a3 := NewAccommodation(NewAccommodationID("a2"), tripID, "A", "", nil, stay, "", cost, "", now, now) // a1 と異なる ID
+
+	assert.True(t, a1.Equals(a2), "同じ ID を持つ 2 つの Accommodation は等しいと判定されるべき")
+	assert.False(t, a1.Equals(a3), "異なる ID を持つ 2 つの Accommodation は等しくないと判定されるべき")
+	assert.False(t, a1.Equals(nil), "Accommodation は nil と等しいと判定されるべきではない")
+}
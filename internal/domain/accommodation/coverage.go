@@ -0,0 +1,30 @@
+package accommodation
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// FindUncoveredNights は旅行期間中で宿泊予約が存在しない夜（宿泊日）を日付順に返す。
+// 期間未定の旅行では判定できないため nil を返す。
+func FindUncoveredNights(period *trip.Period, accommodations []*Accommodation) []time.Time {
+	if period == nil {
+		return nil
+	}
+
+	covered := make(map[time.Time]struct{})
+	for _, a := range accommodations {
+		for _, night := range a.Nights() {
+			covered[night] = struct{}{}
+		}
+	}
+
+	uncovered := []time.Time{}
+	for _, night := range period.Nights() {
+		if _, ok := covered[night]; !ok {
+			uncovered = append(uncovered, night)
+		}
+	}
+	return uncovered
+}
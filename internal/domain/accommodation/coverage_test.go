@@ -0,0 +1,82 @@
+package accommodation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindUncoveredNights(t *testing.T) {
+	now := time.Now()
+	tripID := trip.NewTripID("trip-id-1")
+	cost := newTestMoney(t, 0, "JPY")
+	day := func(d int) time.Time { return time.Date(2024, 5, d, 0, 0, 0, 0, time.UTC) }
+	newStayed := func(in, out int) *Accommodation {
+		stay := newTestStay(t, day(in).Add(15*time.Hour), day(out).Add(10*time.Hour))
+		return NewAccommodation(NewAccommodationID("a"), tripID, "Hotel", "", nil, stay, "", cost, "", now, now)
+	}
+
+	losAngeles := mustTimezone(t, "America/Los_Angeles")
+	// newStayedInLosAngeles はロサンゼルスの現地時刻で in 日 18:00 にチェックインし、out 日 11:00 にチェックアウトする宿泊予約を生成する
+	newStayedInLosAngeles := func(in, out int) *Accommodation {
+		stay := newTestStay(t, time.Date(2024, 5, in, 18, 0, 0, 0, losAngeles.Location()), time.Date(2024, 5, out, 11, 0, 0, 0, losAngeles.Location()))
+		return NewAccommodation(NewAccommodationID("a"), tripID, "Hotel", "", losAngeles, stay, "", cost, "", now, now)
+	}
+
+	// 5/1 〜 5/5 の旅行（宿泊日は 5/1, 5/2, 5/3, 5/4）
+	period, err := trip.NewPeriod(day(1), day(5))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		period         *trip.Period
+		accommodations []*Accommodation
+		want           []time.Time
+	}{
+		{
+			name:           "宿泊予約なし",
+			period:         period,
+			accommodations: nil,
+			want:           []time.Time{day(1), day(2), day(3), day(4)},
+		},
+		{
+			name:           "全泊カバー",
+			period:         period,
+			accommodations: []*Accommodation{newStayed(1, 3), newStayed(3, 5)},
+			want:           []time.Time{},
+		},
+		{
+			name:           "中抜け",
+			period:         period,
+			accommodations: []*Accommodation{newStayed(1, 2), newStayed(4, 5)},
+			want:           []time.Time{day(2), day(3)},
+		},
+		{
+			name:           "重複した予約",
+			period:         period,
+			accommodations: []*Accommodation{newStayed(1, 4), newStayed(2, 3)},
+			want:           []time.Time{day(4)},
+		},
+		{
+			name:           "UTC では翌日にチェックインする負のオフセットの宿泊先も現地の日付でカバーする",
+			period:         period,
+			accommodations: []*Accommodation{newStayedInLosAngeles(1, 3), newStayedInLosAngeles(3, 5)},
+			want:           []time.Time{},
+		},
+		{
+			name:           "期間未定の旅行",
+			period:         nil,
+			accommodations: []*Accommodation{newStayed(1, 2)},
+			want:           nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FindUncoveredNights(tt.period, tt.accommodations))
+		})
+	}
+}
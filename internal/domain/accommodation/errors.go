@@ -0,0 +1,19 @@
+package accommodation
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeAccommodationNotFound = "ACCOMMODATION_NOT_FOUND"
+)
+
+func NewAccommodationNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeAccommodationNotFound, "Accommodation not found", opts...)
+}
+
+func NewInvalidStayError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Check-out must be after check-in", opts...)
+}
+
+func NewOutsideTripPeriodError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Accommodation must be within the trip period", opts...)
+}
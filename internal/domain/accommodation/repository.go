@@ -0,0 +1,16 @@
+package accommodation
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+//go:generate mockgen -destination mock/accommodation.go github.com/hata0/travel-api/internal/domain/accommodation AccommodationRepository
+type AccommodationRepository interface {
+	FindByID(ctx context.Context, id AccommodationID) (*Accommodation, error)
+	FindByTripID(ctx context.Context, tripID trip.TripID) ([]*Accommodation, error)
+	Create(ctx context.Context, accommodation *Accommodation) error
+	Update(ctx context.Context, accommodation *Accommodation) error
+	Delete(ctx context.Context, id AccommodationID) error
+}
@@ -0,0 +1,83 @@
+package accommodation
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// AccommodationID は宿泊予約IDを表現する値オブジェクト
+type AccommodationID struct {
+	value string
+}
+
+func NewAccommodationID(id string) AccommodationID {
+	return AccommodationID{value: id}
+}
+
+func (id AccommodationID) String() string {
+	return id.value
+}
+
+func (id AccommodationID) Equals(other AccommodationID) bool {
+	return id.value == other.value
+}
+
+// Stay はチェックインからチェックアウトまでの滞在期間を表現する値オブジェクト
+type Stay struct {
+	checkInAt  time.Time
+	checkOutAt time.Time
+}
+
+// NewStay は滞在期間を作成する。チェックアウトはチェックインより後でなければならない
+func NewStay(checkInAt, checkOutAt time.Time) (Stay, error) {
+	if !checkOutAt.After(checkInAt) {
+		return Stay{}, NewInvalidStayError()
+	}
+	return Stay{checkInAt: checkInAt, checkOutAt: checkOutAt}, nil
+}
+
+// Getters
+func (s Stay) CheckInAt() time.Time  { return s.checkInAt }
+func (s Stay) CheckOutAt() time.Time { return s.checkOutAt }
+
+// Nights は滞在に含まれる宿泊日（チェックイン日からチェックアウト日の前日まで）を返す。
+// 日付は宿泊先のタイムゾーン timezone の現地の日付とし、タイムゾーンが分からない場合は UTC の日付とする
+func (s Stay) Nights(timezone *geo.Timezone) []time.Time {
+	var nights []time.Time
+	end := localDate(s.checkOutAt, timezone)
+	for d := localDate(s.checkInAt, timezone); d.Before(end); d = d.AddDate(0, 0, 1) {
+		nights = append(nights, d)
+	}
+	return nights
+}
+
+// Within は滞在が旅行期間内に収まっているかを、宿泊先のタイムゾーン timezone の現地の日付で判定する。
+// タイムゾーンが分からない場合は UTC の日付で判定し、期間未定の旅行では常に true を返す
+func (s Stay) Within(period *trip.Period, timezone *geo.Timezone) bool {
+	if period == nil {
+		return true
+	}
+	return period.Contains(localDate(s.checkInAt, timezone)) && period.Contains(localDate(s.checkOutAt, timezone))
+}
+
+// Shift は滞在期間を days 日ずらした滞在期間を返す。チェックイン・チェックアウトの時刻は変わらない
+func (s Stay) Shift(days int) Stay {
+	return Stay{
+		checkInAt:  s.checkInAt.AddDate(0, 0, days),
+		checkOutAt: s.checkOutAt.AddDate(0, 0, days),
+	}
+}
+
+func (s Stay) Equals(other Stay) bool {
+	return s.checkInAt.Equal(other.checkInAt) && s.checkOutAt.Equal(other.checkOutAt)
+}
+
+// localDate は instant の timezone での日付を返す。timezone が nil の場合は UTC の日付を返す
+func localDate(instant time.Time, timezone *geo.Timezone) time.Time {
+	if timezone == nil {
+		return trip.TruncateToDate(instant)
+	}
+	return timezone.LocalDate(instant)
+}
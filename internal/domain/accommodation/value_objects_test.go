@@ -0,0 +1,209 @@
+package accommodation
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccommodationID_NewAccommodationID(t *testing.T) {
+	idValue := "test-accommodation-id-123"
+	accommodationID := NewAccommodationID(idValue)
+	assert.Equal(t, idValue, accommodationID.value, "NewAccommodationID は正しい値を持つ AccommodationID を生成するべき")
+}
+
+func TestAccommodationID_String(t *testing.T) {
+	idValue := "test-accommodation-id-456"
+	accommodationID := NewAccommodationID(idValue)
+	assert.Equal(t, idValue, accommodationID.String(), "String() は正しい ID 値を返すべき")
+}
+
+func TestAccommodationID_Equals(t *testing.T) {
+	id1 := NewAccommodationID("id-1")
+	id2 := NewAccommodationID("id-1")
+	id3 := NewAccommodationID("id-2")
+
+	assert.True(t, id1.Equals(id2), "同じ値を持つ 2 つの AccommodationID は等しいと判定されるべき")
+	assert.False(t, id1.Equals(id3), "異なる値を持つ 2 つの AccommodationID は等しくないと判定されるべき")
+}
+
+func TestNewStay(t *testing.T) {
+	checkIn := time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC)
+
+	t.Run("正常系", func(t *testing.T) {
+		checkOut := time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC)
+
+		stay, err := NewStay(checkIn, checkOut)
+
+		require.NoError(t, err)
+		assert.Equal(t, checkIn, stay.CheckInAt())
+		assert.Equal(t, checkOut, stay.CheckOutAt())
+	})
+
+	t.Run("異常系: チェックアウトがチェックインと同時刻", func(t *testing.T) {
+		_, err := NewStay(checkIn, checkIn)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+
+	t.Run("異常系: チェックアウトがチェックインより前", func(t *testing.T) {
+		_, err := NewStay(checkIn, checkIn.Add(-time.Hour))
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+// mustTimezone は IANA のタイムゾーン名からタイムゾーンを生成する
+func mustTimezone(t *testing.T, name string) *geo.Timezone {
+	t.Helper()
+	tz, err := geo.NewTimezone(name)
+	require.NoError(t, err)
+	return &tz
+}
+
+func TestStay_Nights(t *testing.T) {
+	losAngeles := mustTimezone(t, "America/Los_Angeles")
+
+	tests := []struct {
+		name     string
+		timezone *geo.Timezone
+		checkIn  time.Time
+		checkOut time.Time
+		want     []time.Time
+	}{
+		{
+			name:     "2泊",
+			checkIn:  time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC),
+			checkOut: time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "同日のデイユースは宿泊日を含まない",
+			checkIn:  time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+			checkOut: time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC),
+			want:     nil,
+		},
+		{
+			// 8/1 18:00 PDT は UTC では 8/2 01:00 になるが、宿泊日は現地の 8/1 から数える
+			name:     "負のオフセットのタイムゾーンでは現地の日付で数える",
+			timezone: losAngeles,
+			checkIn:  time.Date(2024, 8, 1, 18, 0, 0, 0, losAngeles.Location()),
+			checkOut: time.Date(2024, 8, 3, 11, 0, 0, 0, losAngeles.Location()),
+			want: []time.Time{
+				time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 8, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "UTC で表した日時も現地の日付で数える",
+			timezone: mustTimezone(t, "Pacific/Honolulu"),
+			checkIn:  time.Date(2024, 8, 2, 2, 0, 0, 0, time.UTC),
+			checkOut: time.Date(2024, 8, 3, 21, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 8, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "タイムゾーンが分からない場合は UTC の日付で数える",
+			checkIn:  time.Date(2024, 8, 1, 18, 0, 0, 0, losAngeles.Location()),
+			checkOut: time.Date(2024, 8, 3, 11, 0, 0, 0, losAngeles.Location()),
+			want: []time.Time{
+				time.Date(2024, 8, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stay, err := NewStay(tt.checkIn, tt.checkOut)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, stay.Nights(tt.timezone))
+		})
+	}
+}
+
+func TestStay_Shift(t *testing.T) {
+	stay, err := NewStay(time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	shifted := stay.Shift(7)
+
+	assert.Equal(t, time.Date(2024, 5, 8, 15, 0, 0, 0, time.UTC), shifted.CheckInAt(), "時刻を保ったまま日付がずれるべき")
+	assert.Equal(t, time.Date(2024, 5, 10, 10, 0, 0, 0, time.UTC), shifted.CheckOutAt())
+}
+
+func TestStay_Within(t *testing.T) {
+	period, err := trip.NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	losAngeles := mustTimezone(t, "America/Los_Angeles")
+
+	tests := []struct {
+		name     string
+		period   *trip.Period
+		timezone *geo.Timezone
+		checkIn  time.Time
+		checkOut time.Time
+		want     bool
+	}{
+		{
+			name:     "期間内",
+			period:   period,
+			checkIn:  time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC),
+			checkOut: time.Date(2024, 5, 4, 10, 0, 0, 0, time.UTC),
+			want:     true,
+		},
+		{
+			name:     "チェックインが開始日より前",
+			period:   period,
+			checkIn:  time.Date(2024, 4, 30, 15, 0, 0, 0, time.UTC),
+			checkOut: time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC),
+			want:     false,
+		},
+		{
+			name:     "チェックアウトが終了日より後",
+			period:   period,
+			checkIn:  time.Date(2024, 5, 3, 15, 0, 0, 0, time.UTC),
+			checkOut: time.Date(2024, 5, 5, 10, 0, 0, 0, time.UTC),
+			want:     false,
+		},
+		{
+			name:     "期間未定の旅行",
+			period:   nil,
+			checkIn:  time.Date(2030, 1, 1, 15, 0, 0, 0, time.UTC),
+			checkOut: time.Date(2030, 1, 2, 10, 0, 0, 0, time.UTC),
+			want:     true,
+		},
+		{
+			// 5/4 20:00 PDT は UTC では 5/5 03:00 になるが、現地の日付では終了日に収まる
+			name:     "負のオフセットのタイムゾーンでは現地の日付で判定する",
+			period:   period,
+			timezone: losAngeles,
+			checkIn:  time.Date(2024, 5, 1, 18, 0, 0, 0, losAngeles.Location()),
+			checkOut: time.Date(2024, 5, 4, 20, 0, 0, 0, losAngeles.Location()),
+			want:     true,
+		},
+		{
+			name:     "タイムゾーンが分からない場合は UTC の日付で判定する",
+			period:   period,
+			checkIn:  time.Date(2024, 5, 1, 18, 0, 0, 0, losAngeles.Location()),
+			checkOut: time.Date(2024, 5, 4, 20, 0, 0, 0, losAngeles.Location()),
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stay, err := NewStay(tt.checkIn, tt.checkOut)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, stay.Within(tt.period, tt.timezone))
+		})
+	}
+}
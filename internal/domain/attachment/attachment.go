@@ -0,0 +1,69 @@
+package attachment
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// Attachment は旅行や予約に添付されたファイル（搭乗券・宿泊バウチャーなど）を表現するエンティティ。
+// ファイルの中身は BlobStore に保存し、ここでは保存先のキーとメタデータだけを保持する
+type Attachment struct {
+	id          AttachmentID
+	tripID      trip.TripID
+	target      Target
+	filename    string
+	contentType string
+	size        int64
+	storageKey  string
+	uploadedBy  user.UserID
+	createdAt   time.Time
+}
+
+// NewAttachment は新しい添付ファイルを作成する
+func NewAttachment(
+	id AttachmentID,
+	tripID trip.TripID,
+	target Target,
+	filename, contentType string,
+	size int64,
+	storageKey string,
+	uploadedBy user.UserID,
+	createdAt time.Time,
+) *Attachment {
+	return &Attachment{
+		id:          id,
+		tripID:      tripID,
+		target:      target,
+		filename:    filename,
+		contentType: contentType,
+		size:        size,
+		storageKey:  storageKey,
+		uploadedBy:  uploadedBy,
+		createdAt:   createdAt,
+	}
+}
+
+// Getters
+func (a *Attachment) ID() AttachmentID        { return a.id }
+func (a *Attachment) TripID() trip.TripID     { return a.tripID }
+func (a *Attachment) Target() Target          { return a.target }
+func (a *Attachment) Filename() string        { return a.filename }
+func (a *Attachment) ContentType() string     { return a.contentType }
+func (a *Attachment) Size() int64             { return a.size }
+func (a *Attachment) StorageKey() string      { return a.storageKey }
+func (a *Attachment) UploadedBy() user.UserID { return a.uploadedBy }
+func (a *Attachment) CreatedAt() time.Time    { return a.createdAt }
+
+func (a *Attachment) Equals(other *Attachment) bool {
+	if other == nil {
+		return false
+	}
+	return a.id.Equals(other.id)
+}
+
+// StorageKey は添付ファイルの中身を BlobStore に保存するキーを返す。旅行ごとにまとめて削除できるよう旅行IDを前に置く
+func StorageKey(tripID trip.TripID, id AttachmentID) string {
+	return "trips/" + tripID.String() + "/attachments/" + id.String()
+}
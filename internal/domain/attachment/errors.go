@@ -0,0 +1,27 @@
+package attachment
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeAttachmentNotFound = "ATTACHMENT_NOT_FOUND"
+)
+
+func NewAttachmentNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeAttachmentNotFound, "Attachment not found", opts...)
+}
+
+func NewInvalidTargetTypeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Attachment target type must be one of trip, accommodation or expense", opts...)
+}
+
+func NewEmptyFileError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Attachment file must not be empty", opts...)
+}
+
+func NewUnsupportedContentTypeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewUnsupportedMediaTypeError("Attachment must be a PDF, JPEG, PNG, GIF or WebP file", opts...)
+}
+
+func NewFileTooLargeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewPayloadTooLargeError("Attachment file exceeds the maximum size", opts...)
+}
@@ -0,0 +1,16 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+//go:generate mockgen -destination mock/attachment.go github.com/hata0/travel-api/internal/domain/attachment AttachmentRepository
+type AttachmentRepository interface {
+	FindByID(ctx context.Context, id AttachmentID) (*Attachment, error)
+	// FindByTripID は旅行の添付ファイルを追加された順に取得する。target が nil でない場合はそのリソースの添付ファイルだけを返す
+	FindByTripID(ctx context.Context, tripID trip.TripID, target *Target) ([]*Attachment, error)
+	Create(ctx context.Context, attachment *Attachment) error
+	Delete(ctx context.Context, id AttachmentID) error
+}
@@ -0,0 +1,138 @@
+package attachment
+
+import (
+	"bytes"
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// AttachmentID は添付ファイルIDを表現する値オブジェクト
+type AttachmentID struct {
+	value string
+}
+
+func NewAttachmentID(id string) AttachmentID {
+	return AttachmentID{value: id}
+}
+
+func (id AttachmentID) String() string {
+	return id.value
+}
+
+func (id AttachmentID) Equals(other AttachmentID) bool {
+	return id.value == other.value
+}
+
+// TargetType は添付ファイルを紐づけるリソースの種類
+type TargetType string
+
+const (
+	TargetTypeTrip          TargetType = "trip"
+	TargetTypeAccommodation TargetType = "accommodation"
+	TargetTypeExpense       TargetType = "expense"
+)
+
+// TargetTypes は添付ファイルを紐づけられるリソースの種類を返す
+func TargetTypes() []TargetType {
+	return []TargetType{TargetTypeTrip, TargetTypeAccommodation, TargetTypeExpense}
+}
+
+// ParseTargetType は文字列を添付ファイルを紐づけるリソースの種類に変換する
+func ParseTargetType(value string) (TargetType, error) {
+	for _, t := range TargetTypes() {
+		if string(t) == value {
+			return t, nil
+		}
+	}
+	return "", NewInvalidTargetTypeError()
+}
+
+func (t TargetType) String() string {
+	return string(t)
+}
+
+// Target は添付ファイルを紐づけるリソース。旅行そのものに紐づける場合、ID は旅行IDになる
+type Target struct {
+	targetType TargetType
+	id         string
+}
+
+func NewTarget(targetType TargetType, id string) Target {
+	return Target{targetType: targetType, id: id}
+}
+
+func (t Target) Type() TargetType { return t.targetType }
+func (t Target) ID() string       { return t.id }
+
+func (t Target) Equals(other Target) bool {
+	return t.targetType == other.targetType && t.id == other.id
+}
+
+// 添付できるファイルの形式
+const (
+	ContentTypePDF  = "application/pdf"
+	ContentTypeJPEG = "image/jpeg"
+	ContentTypePNG  = "image/png"
+	ContentTypeGIF  = "image/gif"
+	ContentTypeWebP = "image/webp"
+)
+
+// SniffLength は DetectContentType が形式の判定に使う先頭のバイト数
+const SniffLength = 512
+
+// signatures は添付できるファイルの形式と、ファイルの先頭に現れるシグネチャ
+var signatures = []struct {
+	contentType string
+	matches     func(head []byte) bool
+}{
+	{ContentTypePDF, prefix("%PDF-")},
+	{ContentTypePNG, prefix("\x89PNG\r\n\x1a\n")},
+	{ContentTypeJPEG, prefix("\xff\xd8\xff")},
+	{ContentTypeGIF, func(head []byte) bool { return prefix("GIF87a")(head) || prefix("GIF89a")(head) }},
+	{ContentTypeWebP, func(head []byte) bool { return len(head) >= 12 && prefix("RIFF")(head) && string(head[8:12]) == "WEBP" }},
+}
+
+func prefix(signature string) func(head []byte) bool {
+	return func(head []byte) bool { return bytes.HasPrefix(head, []byte(signature)) }
+}
+
+// DetectContentType はファイルの先頭のバイト列から形式を判定する。
+// クライアントが申告した形式は信用せず、添付できる形式のシグネチャに一致しない場合はエラーを返す
+func DetectContentType(head []byte) (string, error) {
+	for _, s := range signatures {
+		if s.matches(head) {
+			return s.contentType, nil
+		}
+	}
+	return "", NewUnsupportedContentTypeError()
+}
+
+// maxFilenameLength はファイル名の最大の文字数
+const maxFilenameLength = 255
+
+// defaultFilename はファイル名が指定されていない場合に使う名前
+const defaultFilename = "attachment"
+
+// SanitizeFilename はクライアントから送られたファイル名からディレクトリ部分と制御文字を取り除き、
+// ダウンロード時の Content-Disposition にそのまま使える名前にする
+func SanitizeFilename(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = path.Base(name)
+	name = strings.Map(func(r rune) rune {
+		if r == utf8.RuneError || unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+
+	if name == "" || name == "." || name == "/" || name == ".." {
+		return defaultFilename
+	}
+	if runes := []rune(name); len(runes) > maxFilenameLength {
+		name = string(runes[:maxFilenameLength])
+	}
+	return name
+}
@@ -0,0 +1,88 @@
+package attachment
+
+import (
+	"strings"
+	"testing"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTargetType(t *testing.T) {
+	for _, targetType := range TargetTypes() {
+		got, err := ParseTargetType(targetType.String())
+		require.NoError(t, err)
+		assert.Equal(t, targetType, got)
+	}
+
+	_, err := ParseTargetType("journal_entry")
+	assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+}
+
+func TestDetectContentType(t *testing.T) {
+	tests := []struct {
+		name string
+		head string
+		want string
+	}{
+		{name: "PDF", head: "%PDF-1.7\n%\xe2\xe3\xcf\xd3", want: ContentTypePDF},
+		{name: "PNG", head: "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR", want: ContentTypePNG},
+		{name: "JPEG", head: "\xff\xd8\xff\xe0\x00\x10JFIF", want: ContentTypeJPEG},
+		{name: "GIF", head: "GIF89a\x01\x00\x01\x00", want: ContentTypeGIF},
+		{name: "WebP", head: "RIFF\x24\x00\x00\x00WEBPVP8 ", want: ContentTypeWebP},
+	}
+	for _, tt := range tests {
+		t.Run("正常系: "+tt.name, func(t *testing.T) {
+			got, err := DetectContentType([]byte(tt.head))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("異常系: 拡張子や申告された形式に関わらず中身が HTML なら受け付けない", func(t *testing.T) {
+		_, err := DetectContentType([]byte("<html><script>alert(1)</script></html>"))
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeUnsupportedMediaType))
+	})
+
+	t.Run("異常系: WebP 以外の RIFF 形式", func(t *testing.T) {
+		_, err := DetectContentType([]byte("RIFF\x24\x00\x00\x00WAVEfmt "))
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeUnsupportedMediaType))
+	})
+
+	t.Run("異常系: 空のファイル", func(t *testing.T) {
+		_, err := DetectContentType(nil)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeUnsupportedMediaType))
+	})
+}
+
+func TestSanitizeFilename(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "そのまま使える名前", input: "搭乗券.pdf", want: "搭乗券.pdf"},
+		{name: "ディレクトリ部分を取り除く", input: "../../etc/passwd", want: "passwd"},
+		{name: "Windows のパス区切り", input: `C:\Users\me\voucher.pdf`, want: "voucher.pdf"},
+		{name: "制御文字と改行を取り除く", input: "ticket\r\n.pdf\x00", want: "ticket.pdf"},
+		{name: "空の名前", input: "  ", want: "attachment"},
+		{name: "ディレクトリだけ", input: "dir/", want: "dir"},
+		{name: "親ディレクトリだけ", input: "..", want: "attachment"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SanitizeFilename(tt.input))
+		})
+	}
+
+	t.Run("長すぎる名前は文字単位で切り詰める", func(t *testing.T) {
+		got := SanitizeFilename(strings.Repeat("あ", 300))
+		assert.Equal(t, strings.Repeat("あ", 255), got)
+	})
+}
+
+func TestStorageKey(t *testing.T) {
+	assert.Equal(t, "trips/trip-id/attachments/attachment-id", StorageKey(trip.NewTripID("trip-id"), NewAttachmentID("attachment-id")))
+}
@@ -0,0 +1,353 @@
+package bookingimport
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// importedTravelMode は取り込んだ行動の、直前の行動の場所から向かう移動手段。確認ファイルからは分からないため公共交通機関とする
+const importedTravelMode = itinerary.TravelModeTransit
+
+// Kind は取り込んだ予約の種類を表現する値オブジェクト
+type Kind string
+
+const (
+	KindLodging   Kind = "lodging"
+	KindTransport Kind = "transport"
+	KindActivity  Kind = "activity"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// Transport は移動区間の予約に固有の情報。航空券の予約なら Mode は飛行機、Carrier は航空会社、Departure と Arrival は空港を表す。
+// Mode が空の場合はその他の交通機関として保存する
+type Transport struct {
+	Mode      transport.Mode
+	Carrier   string
+	Number    string
+	Departure string
+	Arrival   string
+}
+
+// DraftParams は NewDraft に渡す、確認ファイルから読み取った予約の内容。読み取れなかった項目は空または nil とする
+type DraftParams struct {
+	Kind Kind
+	// Key は同じファイルの中で下書きを識別する値。iCalendar なら UID、JSON-LD なら予約番号を元にする
+	Key                string
+	Title              string
+	Location           string
+	StartAt            *time.Time
+	EndAt              *time.Time
+	ConfirmationNumber string
+	Price              *money.Money
+	Notes              string
+	Transport          *Transport
+}
+
+// Draft は確認ファイルから読み取った、保存前の予約の下書きを表現する値オブジェクト
+type Draft struct {
+	kind               Kind
+	key                string
+	title              string
+	location           string
+	startAt            *time.Time
+	endAt              *time.Time
+	confirmationNumber string
+	price              *money.Money
+	notes              string
+	transport          *Transport
+}
+
+func NewDraft(p DraftParams) Draft {
+	return Draft{
+		kind:               p.Kind,
+		key:                p.Key,
+		title:              p.Title,
+		location:           p.Location,
+		startAt:            p.StartAt,
+		endAt:              p.EndAt,
+		confirmationNumber: p.ConfirmationNumber,
+		price:              p.Price,
+		notes:              p.Notes,
+		transport:          p.Transport,
+	}
+}
+
+// Getters
+func (d Draft) Kind() Kind                 { return d.kind }
+func (d Draft) Key() string                { return d.key }
+func (d Draft) Title() string              { return d.title }
+func (d Draft) Location() string           { return d.location }
+func (d Draft) StartAt() *time.Time        { return d.startAt }
+func (d Draft) EndAt() *time.Time          { return d.endAt }
+func (d Draft) ConfirmationNumber() string { return d.confirmationNumber }
+func (d Draft) Price() *money.Money        { return d.price }
+func (d Draft) Notes() string              { return d.notes }
+func (d Draft) Transport() *Transport      { return d.transport }
+
+// Status は下書きを旅行に保存できるかどうかを表現する値オブジェクト。保存はされず、判定時に導出される
+type Status string
+
+const (
+	// StatusReady は下書きをそのまま保存できることを表す
+	StatusReady Status = "ready"
+	// StatusUnsupported は保存先のない種類の予約であることを表す
+	StatusUnsupported Status = "unsupported"
+	// StatusIncomplete は日時・場所・金額の通貨などが読み取れず、保存に必要な項目が揃っていないことを表す
+	StatusIncomplete Status = "incomplete"
+	// StatusOutsideTripPeriod は予約の期間（移動は出発日、行動は開始日）が旅行期間に収まっていないことを表す
+	StatusOutsideTripPeriod Status = "outside_trip_period"
+	// StatusDuplicate は同じ予約がすでに旅行に保存されていることを表す
+	StatusDuplicate Status = "duplicate"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// Existing は下書きが保存済みの予約と重複しているかの判定に使う、旅行に保存済みの宿泊予約・移動・行動
+type Existing struct {
+	Accommodations []*accommodation.Accommodation
+	Legs           []*transport.Leg
+	Activities     []*itinerary.Activity
+}
+
+// StatusFor は下書きを旅行 t に保存できるかを判定する。宿泊は宿泊予約、移動区間は移動、行動は行程の行動として保存する。
+// defaultCurrency は金額が読み取れなかった宿泊の下書きを 0 円（0 ドル等）として保存するときの通貨で、空の場合はそうした下書きを保存できない
+func (d Draft) StatusFor(t *trip.Trip, existing Existing, defaultCurrency string) Status {
+	switch d.kind {
+	case KindLodging:
+		return d.lodgingStatusFor(t, existing.Accommodations, defaultCurrency)
+	case KindTransport:
+		return d.legStatusFor(t, existing.Legs)
+	case KindActivity:
+		return d.activityStatusFor(t, existing.Activities)
+	default:
+		return StatusUnsupported
+	}
+}
+
+// lodgingStatusFor は宿泊の下書きを判定する。同じ予約番号の宿泊予約があれば重複とする
+func (d Draft) lodgingStatusFor(t *trip.Trip, existing []*accommodation.Accommodation, defaultCurrency string) Status {
+	stay, err := d.stay()
+	if err != nil {
+		return StatusIncomplete
+	}
+	if d.price == nil && !money.IsValidCurrencyCode(defaultCurrency) {
+		return StatusIncomplete
+	}
+	if !stay.Within(t.Period(), timezoneOf(*d.startAt)) {
+		return StatusOutsideTripPeriod
+	}
+
+	if d.confirmationNumber != "" {
+		for _, a := range existing {
+			if a.ConfirmationNumber() == d.confirmationNumber {
+				return StatusDuplicate
+			}
+		}
+	}
+
+	return StatusReady
+}
+
+// legStatusFor は移動区間の下書きを判定する。出発日時と便名が同じ移動があれば重複とする
+func (d Draft) legStatusFor(t *trip.Trip, existing []*transport.Leg) Status {
+	l, err := d.ToLeg(transport.NewLegID(""), t.ID(), time.Time{})
+	if err != nil {
+		return StatusIncomplete
+	}
+	if err := l.ValidateFor(t); err != nil {
+		return StatusOutsideTripPeriod
+	}
+
+	for _, e := range existing {
+		if e.DepartureAt().Equal(l.DepartureAt()) && e.Number() == l.Number() {
+			return StatusDuplicate
+		}
+	}
+
+	return StatusReady
+}
+
+// activityStatusFor は行動の下書きを判定する。タイトルと開始日時が同じ行動があれば重複とする
+func (d Draft) activityStatusFor(t *trip.Trip, existing []*itinerary.Activity) Status {
+	a, err := d.ToActivity(itinerary.NewActivityID(""), t.ID(), 0, time.Time{})
+	if err != nil {
+		return StatusIncomplete
+	}
+	if err := a.ValidateFor(t); err != nil {
+		return StatusOutsideTripPeriod
+	}
+
+	for _, e := range existing {
+		if e.Title() == a.Title() && e.StartAt() != nil && e.StartAt().Equal(*a.StartAt()) {
+			return StatusDuplicate
+		}
+	}
+
+	return StatusReady
+}
+
+// ToAccommodation は宿泊の下書きを旅行 tripID の宿泊予約に変換する。チェックイン日時が IANA のタイムゾーン付きで読み取れていれば
+// それを宿泊先のタイムゾーンとする。保存できるかは事前に StatusFor で確認しておく
+func (d Draft) ToAccommodation(id accommodation.AccommodationID, tripID trip.TripID, defaultCurrency string, now time.Time) (*accommodation.Accommodation, error) {
+	stay, err := d.stay()
+	if err != nil {
+		return nil, err
+	}
+
+	cost, err := d.cost(defaultCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	return accommodation.NewAccommodation(
+		id,
+		tripID,
+		d.title,
+		d.location,
+		timezoneOf(*d.startAt),
+		stay,
+		d.confirmationNumber,
+		cost,
+		d.notes,
+		now,
+		now,
+	), nil
+}
+
+// ToLeg は移動区間の下書きを旅行 tripID の移動に変換する。出発地は読み取った出発地（なければ予定の場所）、到着地は読み取った到着地とし、
+// 出発・到着日時が IANA のタイムゾーン付きで読み取れていればそれぞれの場所のタイムゾーンとする。保存できるかは事前に StatusFor で確認しておく
+func (d Draft) ToLeg(id transport.LegID, tripID trip.TripID, now time.Time) (*transport.Leg, error) {
+	if d.startAt == nil || d.endAt == nil {
+		return nil, transport.NewInvalidTimeRangeError()
+	}
+
+	var info Transport
+	if d.transport != nil {
+		info = *d.transport
+	}
+	mode := info.Mode
+	if mode == "" {
+		mode = transport.ModeOther
+	}
+
+	origin, err := geo.NewPlace(firstNonEmpty(info.Departure, d.location), nil, timezoneOf(*d.startAt))
+	if err != nil {
+		return nil, err
+	}
+	destination, err := geo.NewPlace(info.Arrival, nil, timezoneOf(*d.endAt))
+	if err != nil {
+		return nil, err
+	}
+
+	return transport.NewLeg(
+		id,
+		tripID,
+		mode,
+		origin,
+		destination,
+		*d.startAt,
+		*d.endAt,
+		info.Carrier,
+		info.Number,
+		d.notesWithConfirmationNumber(),
+		now,
+		now,
+	)
+}
+
+// ToActivity は行動の下書きを旅行 tripID の行動に変換する。日付は開始日時の現地の日付とし、position はその日の並び順を渡す。
+// 開始日時が IANA のタイムゾーン付きで読み取れていれば行動のタイムゾーンとする。保存できるかは事前に StatusFor で確認しておく
+func (d Draft) ToActivity(id itinerary.ActivityID, tripID trip.TripID, position int, now time.Time) (*itinerary.Activity, error) {
+	if d.startAt == nil {
+		return nil, itinerary.NewInvalidTimeRangeError()
+	}
+
+	var place *geo.Place
+	if d.location != "" {
+		p, err := geo.NewPlace(d.location, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		place = &p
+	}
+
+	start := *d.startAt
+	return itinerary.NewActivity(
+		id,
+		tripID,
+		time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC),
+		position,
+		d.title,
+		place,
+		timezoneOf(start),
+		d.startAt,
+		d.endAt,
+		importedTravelMode,
+		d.notesWithConfirmationNumber(),
+		now,
+		now,
+	)
+}
+
+// notesWithConfirmationNumber は移動と行動には予約番号の項目がないため、予約番号をメモの先頭に加えて返す
+func (d Draft) notesWithConfirmationNumber() string {
+	if d.confirmationNumber == "" {
+		return d.notes
+	}
+	lines := []string{"Confirmation: " + d.confirmationNumber}
+	if d.notes != "" {
+		lines = append(lines, d.notes)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// timezoneOf は日時が IANA のタイムゾーン名の付いた場所の時刻であればそのタイムゾーンを返す。
+// UTC やオフセットだけで表された日時は現地のタイムゾーンが分からないため nil を返す
+func timezoneOf(t time.Time) *geo.Timezone {
+	name := t.Location().String()
+	if name == "UTC" {
+		return nil
+	}
+	tz, err := geo.NewTimezone(name)
+	if err != nil {
+		return nil
+	}
+	return &tz
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// stay はチェックインからチェックアウトまでの滞在期間を返す。どちらかが読み取れていない場合はエラーとする
+func (d Draft) stay() (accommodation.Stay, error) {
+	if d.startAt == nil || d.endAt == nil {
+		return accommodation.Stay{}, accommodation.NewInvalidStayError()
+	}
+	return accommodation.NewStay(*d.startAt, *d.endAt)
+}
+
+// cost は宿泊予約の費用を返す。金額が読み取れていない場合は defaultCurrency の 0 とする
+func (d Draft) cost(defaultCurrency string) (money.Money, error) {
+	if d.price != nil {
+		return *d.price, nil
+	}
+	return money.Zero(defaultCurrency)
+}
@@ -0,0 +1,253 @@
+package bookingimport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTrip(t *testing.T) *trip.Trip {
+	t.Helper()
+	period, err := trip.NewPeriod(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 11, 4, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	return trip.NewTrip(trip.NewTripID("trip-id"), "京都旅行", period, "", time.Time{}, time.Time{})
+}
+
+func newLodgingDraft(t *testing.T, start, end time.Time, price *money.Money) Draft {
+	t.Helper()
+	return NewDraft(DraftParams{
+		Kind:               KindLodging,
+		Key:                "reservation:ABC123",
+		Title:              "Hotel Kyoto",
+		Location:           "京都市下京区",
+		StartAt:            &start,
+		EndAt:              &end,
+		ConfirmationNumber: "ABC123",
+		Price:              price,
+	})
+}
+
+func newFlightDraft(t *testing.T, departure, arrival time.Time) Draft {
+	t.Helper()
+	return NewDraft(DraftParams{
+		Kind:               KindTransport,
+		Key:                "reservation:FL123",
+		Title:              "NH 21",
+		StartAt:            &departure,
+		EndAt:              &arrival,
+		ConfirmationNumber: "FL123",
+		Transport:          &Transport{Mode: transport.ModeFlight, Carrier: "NH", Number: "21", Departure: "HND", Arrival: "ITM"},
+	})
+}
+
+func newActivityDraft(t *testing.T, start time.Time) Draft {
+	t.Helper()
+	return NewDraft(DraftParams{
+		Kind:     KindActivity,
+		Key:      "reservation:EV123",
+		Title:    "茶道体験",
+		Location: "京都市東山区",
+		StartAt:  &start,
+		Notes:    "動きやすい服装で",
+	})
+}
+
+func TestDraft_StatusFor(t *testing.T) {
+	tt := newTestTrip(t)
+	checkIn := time.Date(2026, 11, 1, 6, 0, 0, 0, time.UTC)
+	checkOut := time.Date(2026, 11, 3, 2, 0, 0, 0, time.UTC)
+	price, err := money.NewMoney(30000, "JPY")
+	require.NoError(t, err)
+	// 東京の現地時刻で 11/1 0:30 にチェックインする。UTC では旅行期間前の 10/31 になる
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	localCheckIn := time.Date(2026, 11, 1, 0, 30, 0, 0, tokyo)
+
+	existing := func(confirmationNumber string) []*accommodation.Accommodation {
+		stay, err := accommodation.NewStay(checkIn, checkOut)
+		require.NoError(t, err)
+		return []*accommodation.Accommodation{accommodation.NewAccommodation(
+			accommodation.NewAccommodationID("acc-id"), tt.ID(), "Hotel", "", nil, stay, confirmationNumber, price, "", time.Time{}, time.Time{},
+		)}
+	}
+	existingLeg := func(number string) []*transport.Leg {
+		origin, err := geo.NewPlace("HND", nil, nil)
+		require.NoError(t, err)
+		destination, err := geo.NewPlace("ITM", nil, nil)
+		require.NoError(t, err)
+		l, err := transport.NewLeg(
+			transport.NewLegID("leg-id"), tt.ID(), transport.ModeFlight, origin, destination, checkIn, checkIn.Add(time.Hour), "NH", number, "", time.Time{}, time.Time{},
+		)
+		require.NoError(t, err)
+		return []*transport.Leg{l}
+	}
+	existingActivity := func(title string) []*itinerary.Activity {
+		a, err := itinerary.NewActivity(
+			itinerary.NewActivityID("activity-id"), tt.ID(), checkIn, 0, title, nil, nil, &checkIn, nil, itinerary.TravelModeWalk, "", time.Time{}, time.Time{},
+		)
+		require.NoError(t, err)
+		return []*itinerary.Activity{a}
+	}
+
+	tests := []struct {
+		name            string
+		draft           Draft
+		existing        Existing
+		defaultCurrency string
+		want            Status
+	}{
+		{name: "正常系: 保存できる宿泊", draft: newLodgingDraft(t, checkIn, checkOut, &price), want: StatusReady},
+		{name: "正常系: 金額がなくても既定の通貨があれば保存できる", draft: newLodgingDraft(t, checkIn, checkOut, nil), defaultCurrency: "JPY", want: StatusReady},
+		{name: "異常系: 金額も既定の通貨もない", draft: newLodgingDraft(t, checkIn, checkOut, nil), want: StatusIncomplete},
+		{name: "異常系: チェックアウトがチェックイン以前", draft: newLodgingDraft(t, checkOut, checkIn, &price), want: StatusIncomplete},
+		{name: "異常系: チェックアウトが読み取れない", draft: NewDraft(DraftParams{Kind: KindLodging, StartAt: &checkIn, Price: &price}), want: StatusIncomplete},
+		{name: "正常系: 旅行期間内かはチェックイン日時のタイムゾーンの現地の日付で判定する", draft: newLodgingDraft(t, localCheckIn, checkOut, &price), want: StatusReady},
+		{name: "異常系: 旅行期間外", draft: newLodgingDraft(t, checkIn.AddDate(0, 0, 5), checkOut.AddDate(0, 0, 5), &price), want: StatusOutsideTripPeriod},
+		{name: "異常系: 同じ予約番号の宿泊予約がある", draft: newLodgingDraft(t, checkIn, checkOut, &price), existing: Existing{Accommodations: existing("ABC123")}, want: StatusDuplicate},
+		{name: "正常系: 予約番号が異なる宿泊予約は重複としない", draft: newLodgingDraft(t, checkIn, checkOut, &price), existing: Existing{Accommodations: existing("XYZ")}, want: StatusReady},
+		{name: "正常系: 保存できる移動区間", draft: newFlightDraft(t, checkIn, checkIn.Add(time.Hour)), want: StatusReady},
+		{name: "異常系: 移動区間の到着日時が読み取れない", draft: NewDraft(DraftParams{Kind: KindTransport, StartAt: &checkIn, Transport: &Transport{Arrival: "ITM"}}), want: StatusIncomplete},
+		{name: "異常系: 移動区間の到着地が読み取れない", draft: NewDraft(DraftParams{Kind: KindTransport, StartAt: &checkIn, EndAt: &checkOut, Location: "HND"}), want: StatusIncomplete},
+		{name: "異常系: 移動区間の出発日が旅行期間外", draft: newFlightDraft(t, checkIn.AddDate(0, 0, 5), checkIn.AddDate(0, 0, 5).Add(time.Hour)), want: StatusOutsideTripPeriod},
+		{name: "異常系: 出発日時と便名が同じ移動がある", draft: newFlightDraft(t, checkIn, checkIn.Add(time.Hour)), existing: Existing{Legs: existingLeg("21")}, want: StatusDuplicate},
+		{name: "正常系: 便名が異なる移動は重複としない", draft: newFlightDraft(t, checkIn, checkIn.Add(time.Hour)), existing: Existing{Legs: existingLeg("23")}, want: StatusReady},
+		{name: "正常系: 保存できる行動", draft: newActivityDraft(t, checkIn), want: StatusReady},
+		{name: "異常系: 行動の開始日時が読み取れない", draft: NewDraft(DraftParams{Kind: KindActivity, Title: "茶道体験"}), want: StatusIncomplete},
+		{name: "異常系: 行動のタイトルが読み取れない", draft: NewDraft(DraftParams{Kind: KindActivity, StartAt: &checkIn}), want: StatusIncomplete},
+		{name: "異常系: 行動の開始日が旅行期間外", draft: newActivityDraft(t, checkIn.AddDate(0, 0, 5)), want: StatusOutsideTripPeriod},
+		{name: "異常系: タイトルと開始日時が同じ行動がある", draft: newActivityDraft(t, checkIn), existing: Existing{Activities: existingActivity("茶道体験")}, want: StatusDuplicate},
+		{name: "正常系: タイトルが異なる行動は重複としない", draft: newActivityDraft(t, checkIn), existing: Existing{Activities: existingActivity("座禅体験")}, want: StatusReady},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.draft.StatusFor(tt, tc.existing, tc.defaultCurrency))
+		})
+	}
+}
+
+func TestDraft_ToAccommodation(t *testing.T) {
+	tt := newTestTrip(t)
+	checkIn := time.Date(2026, 11, 1, 6, 0, 0, 0, time.UTC)
+	checkOut := time.Date(2026, 11, 3, 2, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 金額がない場合は既定の通貨の 0 とする", func(t *testing.T) {
+		a, err := newLodgingDraft(t, checkIn, checkOut, nil).ToAccommodation(accommodation.NewAccommodationID("acc-id"), tt.ID(), "JPY", now)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Hotel Kyoto", a.Name())
+		assert.Equal(t, "京都市下京区", a.Address())
+		assert.Equal(t, "ABC123", a.ConfirmationNumber())
+		assert.Equal(t, checkIn, a.Stay().CheckInAt())
+		assert.Equal(t, checkOut, a.Stay().CheckOutAt())
+		assert.Equal(t, int64(0), a.Cost().Amount())
+		assert.Equal(t, "JPY", a.Cost().Currency())
+		assert.NoError(t, a.ValidateFor(tt))
+	})
+
+	t.Run("正常系: チェックイン日時のタイムゾーンを宿泊先のタイムゾーンとする", func(t *testing.T) {
+		tokyo, err := time.LoadLocation("Asia/Tokyo")
+		require.NoError(t, err)
+		localCheckIn := time.Date(2026, 11, 1, 0, 30, 0, 0, tokyo)
+
+		a, err := newLodgingDraft(t, localCheckIn, checkOut, nil).ToAccommodation(accommodation.NewAccommodationID("acc-id"), tt.ID(), "JPY", now)
+
+		require.NoError(t, err)
+		require.NotNil(t, a.Timezone())
+		assert.Equal(t, "Asia/Tokyo", a.Timezone().String())
+		assert.NoError(t, a.ValidateFor(tt))
+	})
+
+	t.Run("異常系: 滞在期間が読み取れていない", func(t *testing.T) {
+		_, err := NewDraft(DraftParams{Kind: KindLodging}).ToAccommodation(accommodation.NewAccommodationID("acc-id"), tt.ID(), "JPY", now)
+
+		assert.ErrorIs(t, err, accommodation.NewInvalidStayError())
+	})
+}
+
+func TestDraft_ToLeg(t *testing.T) {
+	tt := newTestTrip(t)
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	departure := time.Date(2026, 11, 1, 8, 0, 0, 0, tokyo)
+	arrival := time.Date(2026, 11, 1, 9, 10, 0, 0, tokyo)
+
+	t.Run("正常系: 出発・到着日時のタイムゾーンを出発地と到着地のタイムゾーンとする", func(t *testing.T) {
+		l, err := newFlightDraft(t, departure, arrival).ToLeg(transport.NewLegID("leg-id"), tt.ID(), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, transport.ModeFlight, l.Mode())
+		assert.Equal(t, "HND", l.Origin().Name())
+		assert.Equal(t, "ITM", l.Destination().Name())
+		assert.Equal(t, "Asia/Tokyo", l.DepartureTimezone().String())
+		assert.Equal(t, "Asia/Tokyo", l.ArrivalTimezone().String())
+		assert.Equal(t, "NH", l.Carrier())
+		assert.Equal(t, "21", l.Number())
+		assert.Equal(t, "Confirmation: FL123", l.Notes())
+		assert.NoError(t, l.ValidateFor(tt))
+	})
+
+	t.Run("正常系: 交通手段が分からない場合はその他とし、UTC の日時にはタイムゾーンを付けない", func(t *testing.T) {
+		start := departure.UTC()
+		end := arrival.UTC()
+		l, err := NewDraft(DraftParams{
+			Kind:      KindTransport,
+			Location:  "京都駅",
+			StartAt:   &start,
+			EndAt:     &end,
+			Transport: &Transport{Arrival: "東京駅"},
+		}).ToLeg(transport.NewLegID("leg-id"), tt.ID(), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, transport.ModeOther, l.Mode())
+		assert.Equal(t, "京都駅", l.Origin().Name())
+		assert.Nil(t, l.DepartureTimezone())
+		assert.Nil(t, l.ArrivalTimezone())
+	})
+
+	t.Run("異常系: 到着日時が読み取れていない", func(t *testing.T) {
+		_, err := NewDraft(DraftParams{Kind: KindTransport, StartAt: &departure}).ToLeg(transport.NewLegID("leg-id"), tt.ID(), now)
+
+		assert.ErrorIs(t, err, transport.NewInvalidTimeRangeError())
+	})
+}
+
+func TestDraft_ToActivity(t *testing.T) {
+	tt := newTestTrip(t)
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	t.Run("正常系: 開始日時の現地の日付の行動とする", func(t *testing.T) {
+		start := time.Date(2026, 11, 2, 7, 30, 0, 0, tokyo)
+		a, err := newActivityDraft(t, start).ToActivity(itinerary.NewActivityID("activity-id"), tt.ID(), 2, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC), a.Date())
+		assert.Equal(t, 2, a.Position())
+		assert.Equal(t, "茶道体験", a.Title())
+		assert.Equal(t, "京都市東山区", a.Place().Name())
+		assert.Equal(t, "Asia/Tokyo", a.Timezone().String())
+		assert.Equal(t, start, *a.StartAt())
+		assert.Nil(t, a.EndAt())
+		assert.Equal(t, itinerary.TravelModeTransit, a.TravelMode())
+		assert.Equal(t, "動きやすい服装で", a.Notes())
+		assert.NoError(t, a.ValidateFor(tt))
+	})
+
+	t.Run("異常系: 開始日時が読み取れていない", func(t *testing.T) {
+		_, err := NewDraft(DraftParams{Kind: KindActivity, Title: "茶道体験"}).ToActivity(itinerary.NewActivityID("activity-id"), tt.ID(), 0, now)
+
+		assert.ErrorIs(t, err, itinerary.NewInvalidTimeRangeError())
+	})
+}
@@ -0,0 +1,19 @@
+package bookingimport
+
+import (
+	"fmt"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+)
+
+func NewUnknownDraftKeyError(key string, opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError(fmt.Sprintf("No draft found for key: %s", key), opts...)
+}
+
+func NewDraftNotImportableError(key string, status Status, opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError(fmt.Sprintf("Draft %s cannot be imported: %s", key, status), opts...)
+}
+
+func NewFileTooLargeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewPayloadTooLargeError("Booking file exceeds the maximum size", opts...)
+}
@@ -0,0 +1,109 @@
+package budget
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// Budget は旅行ごとの予算を表現するエンティティ。
+// currency は予算を立てた通貨であり、カテゴリごとの上限額はすべてこの通貨で表す。
+// 支出の集計は旅行の基準通貨で行い、旅行に基準通貨がない場合はこの通貨で行う
+type Budget struct {
+	tripID         trip.TripID
+	currency       string
+	categoryLimits map[expense.Category]money.Money
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// NewBudget は予算の通貨とカテゴリごとの上限額（予算の通貨の最小単位）から予算を作成する
+func NewBudget(
+	tripID trip.TripID,
+	currency string,
+	categoryLimits map[expense.Category]int64,
+	createdAt, updatedAt time.Time,
+) (*Budget, error) {
+	limits, err := newCategoryLimits(currency, categoryLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Budget{
+		tripID:         tripID,
+		currency:       currency,
+		categoryLimits: limits,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}, nil
+}
+
+// Getters
+func (b *Budget) TripID() trip.TripID  { return b.tripID }
+func (b *Budget) Currency() string     { return b.currency }
+func (b *Budget) CreatedAt() time.Time { return b.createdAt }
+func (b *Budget) UpdatedAt() time.Time { return b.updatedAt }
+
+// CategoryLimit は指定されたカテゴリの上限額を返す。上限が設定されていない場合は false を返す
+func (b *Budget) CategoryLimit(category expense.Category) (money.Money, bool) {
+	limit, ok := b.categoryLimits[category]
+	return limit, ok
+}
+
+// CategoryLimits はカテゴリごとの上限額のコピーを返す
+func (b *Budget) CategoryLimits() map[expense.Category]money.Money {
+	limits := make(map[expense.Category]money.Money, len(b.categoryLimits))
+	for category, limit := range b.categoryLimits {
+		limits[category] = limit
+	}
+	return limits
+}
+
+// Duplicate は予算を別の旅行へ複製する
+func (b *Budget) Duplicate(tripID trip.TripID, createdAt time.Time) *Budget {
+	return &Budget{
+		tripID:         tripID,
+		currency:       b.currency,
+		categoryLimits: b.CategoryLimits(),
+		createdAt:      createdAt,
+		updatedAt:      createdAt,
+	}
+}
+
+// Update は予算の通貨と上限額を更新する
+func (b *Budget) Update(currency string, categoryLimits map[expense.Category]int64, updatedAt time.Time) (*Budget, error) {
+	limits, err := newCategoryLimits(currency, categoryLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Budget{
+		tripID:         b.tripID,
+		currency:       currency,
+		categoryLimits: limits,
+		createdAt:      b.createdAt,
+		updatedAt:      updatedAt,
+	}, nil
+}
+
+func newCategoryLimits(currency string, categoryLimits map[expense.Category]int64) (map[expense.Category]money.Money, error) {
+	// 上限額がない場合も予算の通貨は検証する
+	if _, err := money.Zero(currency); err != nil {
+		return nil, err
+	}
+
+	limits := make(map[expense.Category]money.Money, len(categoryLimits))
+	for category, amount := range categoryLimits {
+		if amount < 0 {
+			return nil, NewNegativeLimitError()
+		}
+		limit, err := money.NewMoney(amount, currency)
+		if err != nil {
+			return nil, err
+		}
+		limits[category] = limit
+	}
+	return limits, nil
+}
@@ -0,0 +1,91 @@
+package budget
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBudget(t *testing.T) {
+	tripID := trip.NewTripID("trip-id-1")
+	createdAt := time.Now().Add(-24 * time.Hour)
+	updatedAt := time.Now()
+
+	t.Run("正常系", func(t *testing.T) {
+		b, err := NewBudget(tripID, "JPY", map[expense.Category]int64{expense.CategoryFood: 30000}, createdAt, updatedAt)
+
+		require.NoError(t, err)
+		assert.Equal(t, tripID, b.TripID(), "TripID() は正しい TripID を返すべき")
+		assert.Equal(t, "JPY", b.Currency(), "Currency() は正しい予算の通貨を返すべき")
+		assert.Equal(t, createdAt, b.CreatedAt(), "CreatedAt() は正しい createdAt を返すべき")
+		assert.Equal(t, updatedAt, b.UpdatedAt(), "UpdatedAt() は正しい updatedAt を返すべき")
+
+		limit, ok := b.CategoryLimit(expense.CategoryFood)
+		assert.True(t, ok, "設定したカテゴリの上限が取得できるべき")
+		assert.Equal(t, int64(30000), limit.Amount(), "上限額は設定した値であるべき")
+		assert.Equal(t, "JPY", limit.Currency(), "上限額は予算の通貨で表されるべき")
+
+		_, ok = b.CategoryLimit(expense.CategoryShopping)
+		assert.False(t, ok, "未設定のカテゴリは上限なしと判定されるべき")
+	})
+
+	t.Run("異常系: 不正な通貨コード", func(t *testing.T) {
+		_, err := NewBudget(tripID, "yen", nil, createdAt, updatedAt)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+
+	t.Run("異常系: 上限額が負", func(t *testing.T) {
+		_, err := NewBudget(tripID, "JPY", map[expense.Category]int64{expense.CategoryFood: -1}, createdAt, updatedAt)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestBudget_Update(t *testing.T) {
+	createdAt := time.Now().Add(-48 * time.Hour)
+	b, err := NewBudget(trip.NewTripID("trip-id-1"), "JPY", map[expense.Category]int64{expense.CategoryFood: 30000}, createdAt, createdAt)
+	require.NoError(t, err)
+
+	newUpdatedAt := time.Now()
+	updated, err := b.Update("USD", map[expense.Category]int64{expense.CategoryTransport: 50000}, newUpdatedAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, "USD", updated.Currency(), "Update 後は新しい予算の通貨を返すべき")
+	_, ok := updated.CategoryLimit(expense.CategoryFood)
+	assert.False(t, ok, "Update 後は以前の上限が残らないべき")
+	assert.Equal(t, createdAt, updated.CreatedAt(), "Update 後も CreatedAt は変わらないべき")
+	assert.Equal(t, newUpdatedAt, updated.UpdatedAt(), "Update 後は新しい UpdatedAt を返すべき")
+	assert.Equal(t, "JPY", b.Currency(), "元の Budget は変更されないべき")
+}
+
+func TestBudget_CategoryLimits(t *testing.T) {
+	b, err := NewBudget(trip.NewTripID("trip-id-1"), "JPY", map[expense.Category]int64{expense.CategoryFood: 30000}, time.Now(), time.Now())
+	require.NoError(t, err)
+
+	limits := b.CategoryLimits()
+	delete(limits, expense.CategoryFood)
+
+	_, ok := b.CategoryLimit(expense.CategoryFood)
+	assert.True(t, ok, "返されたマップを変更しても Budget は変更されないべき")
+}
+
+func TestBudget_Duplicate(t *testing.T) {
+	createdAt := time.Now().Add(-48 * time.Hour)
+	b, err := NewBudget(trip.NewTripID("trip-id-1"), "JPY", map[expense.Category]int64{expense.CategoryFood: 30000}, createdAt, createdAt)
+	require.NoError(t, err)
+
+	now := time.Now()
+	copied := b.Duplicate(trip.NewTripID("trip-id-2"), now)
+
+	assert.Equal(t, trip.NewTripID("trip-id-2"), copied.TripID(), "複製先の旅行に紐づくべき")
+	assert.Equal(t, "JPY", copied.Currency())
+	assert.Equal(t, b.CategoryLimits(), copied.CategoryLimits(), "上限額が引き継がれるべき")
+	assert.Equal(t, now, copied.CreatedAt())
+	assert.Equal(t, now, copied.UpdatedAt())
+}
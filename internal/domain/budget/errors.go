@@ -0,0 +1,15 @@
+package budget
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeBudgetNotFound = "BUDGET_NOT_FOUND"
+)
+
+func NewBudgetNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeBudgetNotFound, "Budget not found", opts...)
+}
+
+func NewNegativeLimitError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Budget limit must not be negative", opts...)
+}
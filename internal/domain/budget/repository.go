@@ -0,0 +1,15 @@
+package budget
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+//go:generate mockgen -destination mock/budget.go github.com/hata0/travel-api/internal/domain/budget BudgetRepository
+type BudgetRepository interface {
+	FindByTripID(ctx context.Context, tripID trip.TripID) (*Budget, error)
+	// Save は予算を作成または更新する（旅行ごとに1件）
+	Save(ctx context.Context, budget *Budget) error
+	Delete(ctx context.Context, tripID trip.TripID) error
+}
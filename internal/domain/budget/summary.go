@@ -0,0 +1,87 @@
+package budget
+
+import (
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+)
+
+// CategorySummary はカテゴリ単位の予算と支出の比較結果
+type CategorySummary struct {
+	category expense.Category
+	limit    *money.Money
+	spent    money.Money
+}
+
+// Getters
+func (s CategorySummary) Category() expense.Category { return s.category }
+func (s CategorySummary) Spent() money.Money         { return s.spent }
+
+// Limit は上限額を返す。上限が設定されていないカテゴリでは nil を返す
+func (s CategorySummary) Limit() *money.Money { return s.limit }
+
+// Remaining は残額（上限額 - 支出額）を返す。超過している場合は負の値になり、上限がない場合は nil を返す
+func (s CategorySummary) Remaining() *money.Money {
+	if s.limit == nil {
+		return nil
+	}
+	remaining, err := s.limit.Sub(s.spent)
+	if err != nil {
+		return nil
+	}
+	return &remaining
+}
+
+// Summary は旅行全体の予算と支出の比較結果。金額はすべて集計した通貨で表す
+type Summary struct {
+	currency   string
+	categories []CategorySummary
+	totalLimit money.Money
+	totalSpent money.Money
+}
+
+// Getters
+func (s *Summary) Currency() string              { return s.currency }
+func (s *Summary) Categories() []CategorySummary { return s.categories }
+func (s *Summary) TotalLimit() money.Money       { return s.totalLimit }
+func (s *Summary) TotalSpent() money.Money       { return s.totalSpent }
+
+// Summarize は currency に換算済みのカテゴリ別の上限額と支出額を突き合わせる。
+// 上限額か支出のいずれかがあるカテゴリのみを expense.Categories() の順に含める
+func Summarize(currency string, limits, spent map[expense.Category]money.Money) (*Summary, error) {
+	zero, err := money.Zero(currency)
+	if err != nil {
+		return nil, err
+	}
+	totalLimit, totalSpent := zero, zero
+
+	var categories []CategorySummary
+	for _, category := range expense.Categories() {
+		limit, hasLimit := limits[category]
+		categorySpent, hasSpent := spent[category]
+		if !hasLimit && !hasSpent {
+			continue
+		}
+		if !hasSpent {
+			categorySpent = zero
+		}
+
+		summary := CategorySummary{category: category, spent: categorySpent}
+		if hasLimit {
+			summary.limit = &limit
+			if totalLimit, err = totalLimit.Add(limit); err != nil {
+				return nil, err
+			}
+		}
+		if totalSpent, err = totalSpent.Add(categorySpent); err != nil {
+			return nil, err
+		}
+		categories = append(categories, summary)
+	}
+
+	return &Summary{
+		currency:   currency,
+		categories: categories,
+		totalLimit: totalLimit,
+		totalSpent: totalSpent,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package budget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMoney(t *testing.T, amount int64, currency string) money.Money {
+	t.Helper()
+	m, err := money.NewMoney(amount, currency)
+	require.NoError(t, err)
+	return m
+}
+
+func TestSummarize(t *testing.T) {
+	b, err := NewBudget(trip.NewTripID("trip-id-1"), "JPY", map[expense.Category]int64{
+		expense.CategoryFood:      30000,
+		expense.CategoryTransport: 20000,
+	}, time.Now(), time.Now())
+	require.NoError(t, err)
+
+	t.Run("正常系: 上限ありと上限なしのカテゴリが混在する", func(t *testing.T) {
+		summary, err := Summarize("JPY", b.CategoryLimits(), map[expense.Category]money.Money{
+			expense.CategoryFood:     newTestMoney(t, 35000, "JPY"),
+			expense.CategoryShopping: newTestMoney(t, 8000, "JPY"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "JPY", summary.Currency())
+		assert.Equal(t, int64(50000), summary.TotalLimit().Amount(), "上限額の合計が計算されるべき")
+		assert.Equal(t, int64(43000), summary.TotalSpent().Amount(), "支出額の合計が計算されるべき")
+
+		categories := summary.Categories()
+		require.Len(t, categories, 3, "上限か支出があるカテゴリのみ含まれるべき")
+
+		// expense.Categories() の順: transport, food, shopping
+		assert.Equal(t, expense.CategoryTransport, categories[0].Category())
+		assert.Equal(t, int64(0), categories[0].Spent().Amount(), "支出がないカテゴリは0として扱われるべき")
+		assert.Equal(t, int64(20000), categories[0].Remaining().Amount())
+
+		assert.Equal(t, expense.CategoryFood, categories[1].Category())
+		assert.Equal(t, int64(-5000), categories[1].Remaining().Amount(), "超過した場合の残額は負になるべき")
+
+		assert.Equal(t, expense.CategoryShopping, categories[2].Category())
+		assert.Nil(t, categories[2].Limit(), "上限のないカテゴリの上限は nil であるべき")
+		assert.Nil(t, categories[2].Remaining(), "上限のないカテゴリの残額は nil であるべき")
+	})
+
+	t.Run("正常系: 予算とは別の通貨に換算した上限額で集計する", func(t *testing.T) {
+		summary, err := Summarize("USD", map[expense.Category]money.Money{
+			expense.CategoryFood: newTestMoney(t, 20000, "USD"),
+		}, map[expense.Category]money.Money{
+			expense.CategoryFood: newTestMoney(t, 4550, "USD"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "USD", summary.Currency())
+		assert.Equal(t, int64(20000), summary.TotalLimit().Amount())
+		assert.Equal(t, int64(15450), summary.Categories()[0].Remaining().Amount())
+	})
+
+	t.Run("異常系: 集計する通貨以外の支出が渡された", func(t *testing.T) {
+		_, err := Summarize("JPY", b.CategoryLimits(), map[expense.Category]money.Money{
+			expense.CategoryFood: newTestMoney(t, 100, "USD"),
+		})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,15 @@
+package calendarfeed
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeCalendarFeedNotFound = "CALENDAR_FEED_NOT_FOUND"
+)
+
+func NewCalendarFeedNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeCalendarFeedNotFound, "Calendar feed not found", opts...)
+}
+
+func NewCalendarFeedAlreadyRevokedError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewConflictError("Calendar feed has already been revoked", opts...)
+}
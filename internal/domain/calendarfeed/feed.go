@@ -0,0 +1,80 @@
+package calendarfeed
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// FeedID はカレンダーフィードIDを表現する値オブジェクト
+type FeedID struct {
+	value string
+}
+
+func NewFeedID(id string) FeedID {
+	return FeedID{value: id}
+}
+
+func (id FeedID) String() string {
+	return id.value
+}
+
+func (id FeedID) Equals(other FeedID) bool {
+	return id.value == other.value
+}
+
+// Feed はカレンダーアプリが JWT なしで購読できる、ユーザーごとの今後の旅行のカレンダーフィードを表現するエンティティ。
+// URL に含めるトークンはハッシュ値だけを保持し、ユーザーが有効なフィードを持てるのは同時に 1 つまでとなる
+type Feed struct {
+	id        FeedID
+	userID    user.UserID
+	tokenHash string
+	createdAt time.Time
+	revokedAt *time.Time
+}
+
+// NewFeed は新しいカレンダーフィードを作成する
+func NewFeed(id FeedID, userID user.UserID, tokenHash string, createdAt time.Time) *Feed {
+	return RestoreFeed(id, userID, tokenHash, createdAt, nil)
+}
+
+// RestoreFeed は保存済みのカレンダーフィードを復元する
+func RestoreFeed(id FeedID, userID user.UserID, tokenHash string, createdAt time.Time, revokedAt *time.Time) *Feed {
+	return &Feed{
+		id:        id,
+		userID:    userID,
+		tokenHash: tokenHash,
+		createdAt: createdAt,
+		revokedAt: revokedAt,
+	}
+}
+
+// Getters
+func (f *Feed) ID() FeedID            { return f.id }
+func (f *Feed) UserID() user.UserID   { return f.userID }
+func (f *Feed) TokenHash() string     { return f.tokenHash }
+func (f *Feed) CreatedAt() time.Time  { return f.createdAt }
+func (f *Feed) RevokedAt() *time.Time { return f.revokedAt }
+
+// IsActive はフィードが無効化されておらず、購読できるかを返す
+func (f *Feed) IsActive() bool {
+	return f.revokedAt == nil
+}
+
+// Revoke はフィードを無効化した結果を返す
+func (f *Feed) Revoke(now time.Time) (*Feed, error) {
+	if f.revokedAt != nil {
+		return nil, NewCalendarFeedAlreadyRevokedError()
+	}
+
+	revoked := *f
+	revoked.revokedAt = &now
+	return &revoked, nil
+}
+
+func (f *Feed) Equals(other *Feed) bool {
+	if other == nil {
+		return false
+	}
+	return f.id.Equals(other.id)
+}
@@ -0,0 +1,35 @@
+package calendarfeed
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/tokenhash"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeed_Revoke(t *testing.T) {
+	now := time.Now()
+	feed := NewFeed(NewFeedID("feed-id-1"), user.NewUserID("user-id-1"), tokenhash.Hash("token"), now)
+
+	t.Run("正常系: 有効なフィードを無効化", func(t *testing.T) {
+		revoked, err := feed.Revoke(now.Add(time.Hour))
+
+		require.NoError(t, err)
+		assert.False(t, revoked.IsActive())
+		assert.True(t, feed.IsActive(), "元のフィードは変更されないべき")
+		assert.True(t, revoked.Equals(feed))
+	})
+
+	t.Run("異常系: 無効化済みのフィード", func(t *testing.T) {
+		revoked, err := feed.Revoke(now)
+		require.NoError(t, err)
+
+		_, err = revoked.Revoke(now)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeConflict))
+	})
+}
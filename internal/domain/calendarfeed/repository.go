@@ -0,0 +1,16 @@
+package calendarfeed
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+//go:generate mockgen -destination mock/feed.go github.com/hata0/travel-api/internal/domain/calendarfeed FeedRepository
+type FeedRepository interface {
+	FindByTokenHash(ctx context.Context, tokenHash string) (*Feed, error)
+	// FindActiveByUserID は指定されたユーザーの無効化されていないフィードを取得する。存在しない場合は CalendarFeedNotFound を返す
+	FindActiveByUserID(ctx context.Context, userID user.UserID) (*Feed, error)
+	Create(ctx context.Context, feed *Feed) error
+	Update(ctx context.Context, feed *Feed) error
+}
@@ -0,0 +1,158 @@
+package checklist
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// Checklist は持ち物や出発前の準備など、旅行に紐づくチェックリストを表現するエンティティ
+type Checklist struct {
+	id        ChecklistID
+	tripID    trip.TripID
+	name      string
+	kind      Kind
+	items     []Item
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewChecklist は新しいチェックリストを作成する。項目の ID はチェックリスト内で一意でなければならない
+func NewChecklist(
+	id ChecklistID,
+	tripID trip.TripID,
+	name string,
+	kind Kind,
+	items []Item,
+	createdAt, updatedAt time.Time,
+) (*Checklist, error) {
+	seen := make(map[ItemID]struct{}, len(items))
+	for _, item := range items {
+		if _, ok := seen[item.id]; ok {
+			return nil, NewDuplicateItemError()
+		}
+		seen[item.id] = struct{}{}
+	}
+	return &Checklist{
+		id:        id,
+		tripID:    tripID,
+		name:      name,
+		kind:      kind,
+		items:     items,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}, nil
+}
+
+// Getters
+func (c *Checklist) ID() ChecklistID      { return c.id }
+func (c *Checklist) TripID() trip.TripID  { return c.tripID }
+func (c *Checklist) Name() string         { return c.name }
+func (c *Checklist) Kind() Kind           { return c.kind }
+func (c *Checklist) Items() []Item        { return c.items }
+func (c *Checklist) CreatedAt() time.Time { return c.createdAt }
+func (c *Checklist) UpdatedAt() time.Time { return c.updatedAt }
+
+// ResolveItemID は更新で渡された項目の ID を決める。ID がない項目は newID で払い出し、
+// ID がある項目はこのチェックリストの既存の項目でなければならない
+func (c *Checklist) ResolveItemID(id *string, newID func() ItemID) (ItemID, error) {
+	if id == nil {
+		return newID(), nil
+	}
+	itemID := NewItemID(*id)
+	if _, ok := c.indexOf(itemID); !ok {
+		return ItemID{}, NewItemNotFoundError()
+	}
+	return itemID, nil
+}
+
+// Update はチェックリストの名前・種類・項目を置き換える
+func (c *Checklist) Update(name string, kind Kind, items []Item, updatedAt time.Time) (*Checklist, error) {
+	return NewChecklist(c.id, c.tripID, name, kind, items, c.createdAt, updatedAt)
+}
+
+// SetChecked は指定された項目のチェック状態をまとめて変更する。チェックリストにない項目が含まれる場合は何も変更しない
+func (c *Checklist) SetChecked(ids []ItemID, checked bool, updatedAt time.Time) (*Checklist, error) {
+	items := make([]Item, len(c.items))
+	copy(items, c.items)
+	for _, id := range ids {
+		index, ok := c.indexOf(id)
+		if !ok {
+			return nil, NewItemNotFoundError()
+		}
+		items[index] = items[index].withChecked(checked)
+	}
+	return NewChecklist(c.id, c.tripID, c.name, c.kind, items, c.createdAt, updatedAt)
+}
+
+// Progress はチェックリストの進捗を返す
+func (c *Checklist) Progress() Progress {
+	checked := 0
+	for _, item := range c.items {
+		if item.checked {
+			checked++
+		}
+	}
+	return NewProgress(len(c.items), checked)
+}
+
+// Assignees は項目の担当者を重複なく返す
+func (c *Checklist) Assignees() []user.UserID {
+	seen := make(map[user.UserID]struct{})
+	var assignees []user.UserID
+	for _, item := range c.items {
+		if item.assigneeID == nil {
+			continue
+		}
+		if _, ok := seen[*item.assigneeID]; ok {
+			continue
+		}
+		seen[*item.assigneeID] = struct{}{}
+		assignees = append(assignees, *item.assigneeID)
+	}
+	return assignees
+}
+
+// Duplicate はチェックリストを別の旅行へ複製する。
+// 複製先ではまだ準備していないため、項目はすべて未チェックにし、担当者も引き継がない
+func (c *Checklist) Duplicate(id ChecklistID, tripID trip.TripID, newItemID func() ItemID, createdAt time.Time) *Checklist {
+	items := make([]Item, 0, len(c.items))
+	for _, item := range c.items {
+		items = append(items, Item{id: newItemID(), name: item.name, quantity: item.quantity})
+	}
+	return &Checklist{
+		id:        id,
+		tripID:    tripID,
+		name:      c.name,
+		kind:      c.kind,
+		items:     items,
+		createdAt: createdAt,
+		updatedAt: createdAt,
+	}
+}
+
+func (c *Checklist) Equals(other *Checklist) bool {
+	if other == nil {
+		return false
+	}
+	return c.id.Equals(other.id)
+}
+
+func (c *Checklist) indexOf(id ItemID) (int, bool) {
+	for i, item := range c.items {
+		if item.id.Equals(id) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// TotalProgress は旅行のチェックリスト全体の進捗を返す
+func TotalProgress(checklists []*Checklist) Progress {
+	var total Progress
+	for _, c := range checklists {
+		total = total.Add(c.Progress())
+	}
+	return total
+}
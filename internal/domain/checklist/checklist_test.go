@@ -0,0 +1,219 @@
+package checklist
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestItem(t *testing.T, id, name string, quantity int, checked bool, assigneeID *user.UserID) Item {
+	t.Helper()
+	item, err := NewItem(NewItemID(id), name, quantity, checked, assigneeID)
+	require.NoError(t, err)
+	return item
+}
+
+func newTestChecklist(t *testing.T, items ...Item) *Checklist {
+	t.Helper()
+	createdAt := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	c, err := NewChecklist(NewChecklistID("checklist-id-1"), trip.NewTripID("trip-id-1"), "持ち物", KindPacking, items, createdAt, createdAt)
+	require.NoError(t, err)
+	return c
+}
+
+// sequentialItemIDs は item-1, item-2, ... の順に ID を払い出す
+func sequentialItemIDs(prefix string) func() ItemID {
+	n := 0
+	return func() ItemID {
+		n++
+		return NewItemID(prefix + string(rune('0'+n)))
+	}
+}
+
+func TestNewItem(t *testing.T) {
+	tests := []struct {
+		name     string
+		quantity int
+		wantErr  bool
+	}{
+		{name: "正常系: 数量が 1", quantity: 1},
+		{name: "異常系: 数量が 0", quantity: 0, wantErr: true},
+		{name: "異常系: 数量が負", quantity: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item, err := NewItem(NewItemID("item-1"), "歯ブラシ", tt.quantity, false, nil)
+			if tt.wantErr {
+				assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.quantity, item.Quantity())
+		})
+	}
+}
+
+func TestNewChecklist(t *testing.T) {
+	t.Run("正常系: 項目を持つチェックリストを作成できる", func(t *testing.T) {
+		c := newTestChecklist(t, newTestItem(t, "item-1", "パスポート", 1, false, nil))
+
+		assert.Equal(t, "持ち物", c.Name())
+		assert.Equal(t, KindPacking, c.Kind())
+		assert.Len(t, c.Items(), 1)
+	})
+
+	t.Run("異常系: 項目の ID が重複している", func(t *testing.T) {
+		item := newTestItem(t, "item-1", "パスポート", 1, false, nil)
+		_, err := NewChecklist(NewChecklistID("checklist-id-1"), trip.NewTripID("trip-id-1"), "持ち物", KindPacking, []Item{item, item}, time.Now(), time.Now())
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestChecklist_ResolveItemID(t *testing.T) {
+	c := newTestChecklist(t, newTestItem(t, "item-1", "パスポート", 1, false, nil))
+	newID := func() ItemID { return NewItemID("new-item") }
+
+	t.Run("正常系: ID がない項目には新しい ID を払い出す", func(t *testing.T) {
+		id, err := c.ResolveItemID(nil, newID)
+		require.NoError(t, err)
+		assert.Equal(t, NewItemID("new-item"), id)
+	})
+
+	t.Run("正常系: 既存の項目の ID はそのまま使う", func(t *testing.T) {
+		raw := "item-1"
+		id, err := c.ResolveItemID(&raw, newID)
+		require.NoError(t, err)
+		assert.Equal(t, NewItemID("item-1"), id)
+	})
+
+	t.Run("異常系: チェックリストにない ID", func(t *testing.T) {
+		raw := "other-item"
+		_, err := c.ResolveItemID(&raw, newID)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestChecklist_SetChecked(t *testing.T) {
+	c := newTestChecklist(t,
+		newTestItem(t, "item-1", "パスポート", 1, false, nil),
+		newTestItem(t, "item-2", "充電器", 1, false, nil),
+		newTestItem(t, "item-3", "靴下", 3, true, nil),
+	)
+	updatedAt := time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 指定した項目だけチェック状態を変更する", func(t *testing.T) {
+		updated, err := c.SetChecked([]ItemID{NewItemID("item-1"), NewItemID("item-3")}, true, updatedAt)
+		require.NoError(t, err)
+
+		assert.True(t, updated.Items()[0].Checked())
+		assert.False(t, updated.Items()[1].Checked())
+		assert.True(t, updated.Items()[2].Checked())
+		assert.Equal(t, updatedAt, updated.UpdatedAt())
+		assert.False(t, c.Items()[0].Checked(), "元のチェックリストは変更しない")
+	})
+
+	t.Run("異常系: チェックリストにない項目が含まれる", func(t *testing.T) {
+		_, err := c.SetChecked([]ItemID{NewItemID("item-1"), NewItemID("other-item")}, true, updatedAt)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestChecklist_Progress(t *testing.T) {
+	tests := []struct {
+		name        string
+		items       []Item
+		wantTotal   int
+		wantChecked int
+		wantPercent int
+	}{
+		{name: "項目がない", wantPercent: 0},
+		{
+			name: "一部がチェック済み",
+			items: []Item{
+				newTestItem(t, "item-1", "パスポート", 1, true, nil),
+				newTestItem(t, "item-2", "充電器", 1, false, nil),
+				newTestItem(t, "item-3", "靴下", 3, false, nil),
+			},
+			wantTotal:   3,
+			wantChecked: 1,
+			wantPercent: 33,
+		},
+		{
+			name: "すべてチェック済み",
+			items: []Item{
+				newTestItem(t, "item-1", "パスポート", 1, true, nil),
+			},
+			wantTotal:   1,
+			wantChecked: 1,
+			wantPercent: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestChecklist(t, tt.items...).Progress()
+
+			assert.Equal(t, tt.wantTotal, p.Total())
+			assert.Equal(t, tt.wantChecked, p.Checked())
+			assert.Equal(t, tt.wantPercent, p.Percent())
+		})
+	}
+}
+
+func TestTotalProgress(t *testing.T) {
+	a := newTestChecklist(t, newTestItem(t, "item-1", "パスポート", 1, true, nil))
+	b := newTestChecklist(t,
+		newTestItem(t, "item-2", "充電器", 1, false, nil),
+		newTestItem(t, "item-3", "靴下", 3, true, nil),
+		newTestItem(t, "item-4", "傘", 1, false, nil),
+	)
+
+	p := TotalProgress([]*Checklist{a, b})
+
+	assert.Equal(t, 4, p.Total())
+	assert.Equal(t, 2, p.Checked())
+	assert.Equal(t, 50, p.Percent())
+}
+
+func TestChecklist_Assignees(t *testing.T) {
+	alice := user.NewUserID("user-alice")
+	bob := user.NewUserID("user-bob")
+	c := newTestChecklist(t,
+		newTestItem(t, "item-1", "パスポート", 1, false, &alice),
+		newTestItem(t, "item-2", "充電器", 1, false, nil),
+		newTestItem(t, "item-3", "靴下", 3, false, &bob),
+		newTestItem(t, "item-4", "傘", 1, false, &alice),
+	)
+
+	assert.Equal(t, []user.UserID{alice, bob}, c.Assignees())
+}
+
+func TestChecklist_Duplicate(t *testing.T) {
+	alice := user.NewUserID("user-alice")
+	c := newTestChecklist(t,
+		newTestItem(t, "item-1", "パスポート", 1, true, &alice),
+		newTestItem(t, "item-2", "靴下", 3, false, nil),
+	)
+	createdAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	d := c.Duplicate(NewChecklistID("checklist-id-2"), trip.NewTripID("trip-id-2"), sequentialItemIDs("copy-"), createdAt)
+
+	assert.Equal(t, NewChecklistID("checklist-id-2"), d.ID())
+	assert.Equal(t, trip.NewTripID("trip-id-2"), d.TripID())
+	assert.Equal(t, c.Name(), d.Name())
+	assert.Equal(t, c.Kind(), d.Kind())
+	assert.Equal(t, createdAt, d.CreatedAt())
+	require.Len(t, d.Items(), 2)
+	assert.Equal(t, NewItemID("copy-1"), d.Items()[0].ID())
+	assert.Equal(t, "パスポート", d.Items()[0].Name())
+	assert.False(t, d.Items()[0].Checked(), "複製した項目は未チェックにする")
+	assert.Nil(t, d.Items()[0].AssigneeID(), "複製した項目は担当者を引き継がない")
+	assert.Equal(t, 3, d.Items()[1].Quantity())
+}
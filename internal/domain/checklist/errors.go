@@ -0,0 +1,40 @@
+package checklist
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeChecklistNotFound    = "CHECKLIST_NOT_FOUND"
+	CodeItemTemplateNotFound = "CHECKLIST_ITEM_TEMPLATE_NOT_FOUND"
+)
+
+func NewChecklistNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeChecklistNotFound, "Checklist not found", opts...)
+}
+
+func NewItemTemplateNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeItemTemplateNotFound, "Checklist item template not found", opts...)
+}
+
+func NewInvalidKindError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Checklist kind must be one of packing, pre_departure, documents or other", opts...)
+}
+
+func NewNonPositiveQuantityError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Checklist item quantity must be greater than zero", opts...)
+}
+
+func NewItemNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Checklist item does not belong to the checklist", opts...)
+}
+
+func NewDuplicateItemError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Checklist items must be unique", opts...)
+}
+
+func NewAssigneeNotMemberError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Checklist item can only be assigned to a member of the trip", opts...)
+}
+
+func NewTripPeriodRequiredError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Trip period is required to generate per-day items", opts...)
+}
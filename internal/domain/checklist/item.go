@@ -0,0 +1,66 @@
+package checklist
+
+import "github.com/hata0/travel-api/internal/domain/user"
+
+// Item はチェックリストの項目を表現する値オブジェクト。担当者が決まっていない場合 assigneeID は nil
+type Item struct {
+	id         ItemID
+	name       string
+	quantity   int
+	checked    bool
+	assigneeID *user.UserID
+}
+
+// NewItem はチェックリストの項目を作成する。数量は 1 以上でなければならない
+func NewItem(id ItemID, name string, quantity int, checked bool, assigneeID *user.UserID) (Item, error) {
+	if quantity <= 0 {
+		return Item{}, NewNonPositiveQuantityError()
+	}
+	return Item{
+		id:         id,
+		name:       name,
+		quantity:   quantity,
+		checked:    checked,
+		assigneeID: assigneeID,
+	}, nil
+}
+
+// Getters
+func (i Item) ID() ItemID               { return i.id }
+func (i Item) Name() string             { return i.name }
+func (i Item) Quantity() int            { return i.quantity }
+func (i Item) Checked() bool            { return i.checked }
+func (i Item) AssigneeID() *user.UserID { return i.assigneeID }
+
+// withChecked はチェック状態を変えた項目を返す
+func (i Item) withChecked(checked bool) Item {
+	i.checked = checked
+	return i
+}
+
+// Progress はチェックリストの項目数とチェック済みの項目数を表現する値オブジェクト
+type Progress struct {
+	total   int
+	checked int
+}
+
+func NewProgress(total, checked int) Progress {
+	return Progress{total: total, checked: checked}
+}
+
+// Getters
+func (p Progress) Total() int   { return p.total }
+func (p Progress) Checked() int { return p.checked }
+
+// Percent はチェック済みの割合を 0 から 100 の整数（切り捨て）で返す。項目がない場合は 0 を返す
+func (p Progress) Percent() int {
+	if p.total == 0 {
+		return 0
+	}
+	return p.checked * 100 / p.total
+}
+
+// Add は 2 つの進捗を合算する
+func (p Progress) Add(other Progress) Progress {
+	return Progress{total: p.total + other.total, checked: p.checked + other.checked}
+}
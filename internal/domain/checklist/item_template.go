@@ -0,0 +1,100 @@
+package checklist
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// ItemTemplate はユーザーが保存しておく持ち物の雛形を表現するエンティティ。
+// perDay が true の場合、数量は 1 日あたりの数として旅行の日数を掛けて使う
+type ItemTemplate struct {
+	id        ItemTemplateID
+	ownerID   user.UserID
+	name      string
+	quantity  int
+	perDay    bool
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewItemTemplate は新しい項目テンプレートを作成する。数量は 1 以上でなければならない
+func NewItemTemplate(
+	id ItemTemplateID,
+	ownerID user.UserID,
+	name string,
+	quantity int,
+	perDay bool,
+	createdAt, updatedAt time.Time,
+) (*ItemTemplate, error) {
+	if quantity <= 0 {
+		return nil, NewNonPositiveQuantityError()
+	}
+	return &ItemTemplate{
+		id:        id,
+		ownerID:   ownerID,
+		name:      name,
+		quantity:  quantity,
+		perDay:    perDay,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}, nil
+}
+
+// Getters
+func (t *ItemTemplate) ID() ItemTemplateID   { return t.id }
+func (t *ItemTemplate) OwnerID() user.UserID { return t.ownerID }
+func (t *ItemTemplate) Name() string         { return t.name }
+func (t *ItemTemplate) Quantity() int        { return t.quantity }
+func (t *ItemTemplate) PerDay() bool         { return t.perDay }
+func (t *ItemTemplate) CreatedAt() time.Time { return t.createdAt }
+func (t *ItemTemplate) UpdatedAt() time.Time { return t.updatedAt }
+
+// Update は項目テンプレートの名前・数量・日数に応じるかを更新する
+func (t *ItemTemplate) Update(name string, quantity int, perDay bool, updatedAt time.Time) (*ItemTemplate, error) {
+	return NewItemTemplate(t.id, t.ownerID, name, quantity, perDay, t.createdAt, updatedAt)
+}
+
+// AuthorizeFor は項目テンプレートが userID のユーザーのものであることを確認する。
+// 他のユーザーの項目テンプレートは存在しないものとして扱う
+func (t *ItemTemplate) AuthorizeFor(userID user.UserID) error {
+	if !t.ownerID.Equals(userID) {
+		return NewItemTemplateNotFoundError()
+	}
+	return nil
+}
+
+// QuantityFor は days 日の旅行で必要な数量を返す
+func (t *ItemTemplate) QuantityFor(days int) int {
+	if t.perDay {
+		return t.quantity * days
+	}
+	return t.quantity
+}
+
+// GeneratePackingList は項目テンプレートから旅行の持ち物リストを作成する。
+// 日数に応じる項目は旅行の日数を掛けた数量にするため、その場合は旅行期間が決まっている必要がある
+func GeneratePackingList(
+	id ChecklistID,
+	t *trip.Trip,
+	name string,
+	templates []*ItemTemplate,
+	newItemID func() ItemID,
+	createdAt time.Time,
+) (*Checklist, error) {
+	days := 0
+	if period := t.Period(); period != nil {
+		days = period.Days()
+	}
+
+	items := make([]Item, 0, len(templates))
+	for _, tmpl := range templates {
+		if tmpl.perDay && days == 0 {
+			return nil, NewTripPeriodRequiredError()
+		}
+		items = append(items, Item{id: newItemID(), name: tmpl.name, quantity: tmpl.QuantityFor(days)})
+	}
+
+	return NewChecklist(id, t.ID(), name, KindPacking, items, createdAt, createdAt)
+}
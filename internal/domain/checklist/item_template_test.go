@@ -0,0 +1,75 @@
+package checklist
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestItemTemplate(t *testing.T, id, name string, quantity int, perDay bool) *ItemTemplate {
+	t.Helper()
+	now := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	tmpl, err := NewItemTemplate(NewItemTemplateID(id), user.NewUserID("user-id-1"), name, quantity, perDay, now, now)
+	require.NoError(t, err)
+	return tmpl
+}
+
+func TestNewItemTemplate(t *testing.T) {
+	t.Run("異常系: 数量が 0", func(t *testing.T) {
+		_, err := NewItemTemplate(NewItemTemplateID("tmpl-1"), user.NewUserID("user-id-1"), "靴下", 0, true, time.Now(), time.Now())
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestItemTemplate_AuthorizeFor(t *testing.T) {
+	tmpl := newTestItemTemplate(t, "tmpl-1", "靴下", 1, true)
+
+	assert.NoError(t, tmpl.AuthorizeFor(user.NewUserID("user-id-1")))
+	assert.True(t, apperr.IsAppErrorWithCode(tmpl.AuthorizeFor(user.NewUserID("user-id-2")), CodeItemTemplateNotFound),
+		"他のユーザーの項目テンプレートは存在しないものとして扱う")
+}
+
+func TestItemTemplate_QuantityFor(t *testing.T) {
+	assert.Equal(t, 6, newTestItemTemplate(t, "tmpl-1", "靴下", 2, true).QuantityFor(3))
+	assert.Equal(t, 1, newTestItemTemplate(t, "tmpl-2", "パスポート", 1, false).QuantityFor(3))
+}
+
+func TestGeneratePackingList(t *testing.T) {
+	now := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	period, err := trip.NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	withPeriod := trip.NewTrip(trip.NewTripID("trip-id-1"), "京都旅行", period, "", now, now)
+	withoutPeriod := trip.NewTrip(trip.NewTripID("trip-id-2"), "未定の旅行", nil, "", now, now)
+
+	socks := newTestItemTemplate(t, "tmpl-1", "靴下", 1, true)
+	passport := newTestItemTemplate(t, "tmpl-2", "パスポート", 1, false)
+
+	t.Run("正常系: 日数に応じる項目は旅行の日数を掛ける", func(t *testing.T) {
+		c, err := GeneratePackingList(NewChecklistID("checklist-id-1"), withPeriod, "持ち物", []*ItemTemplate{socks, passport}, sequentialItemIDs("item-"), now)
+		require.NoError(t, err)
+
+		assert.Equal(t, KindPacking, c.Kind())
+		assert.Equal(t, withPeriod.ID(), c.TripID())
+		require.Len(t, c.Items(), 2)
+		assert.Equal(t, NewItemID("item-1"), c.Items()[0].ID())
+		assert.Equal(t, "靴下", c.Items()[0].Name())
+		assert.Equal(t, 3, c.Items()[0].Quantity())
+		assert.Equal(t, 1, c.Items()[1].Quantity())
+	})
+
+	t.Run("正常系: 期間未定の旅行でも日数に応じない項目だけなら作成できる", func(t *testing.T) {
+		c, err := GeneratePackingList(NewChecklistID("checklist-id-1"), withoutPeriod, "持ち物", []*ItemTemplate{passport}, sequentialItemIDs("item-"), now)
+		require.NoError(t, err)
+		assert.Len(t, c.Items(), 1)
+	})
+
+	t.Run("異常系: 期間未定の旅行に日数に応じる項目", func(t *testing.T) {
+		_, err := GeneratePackingList(NewChecklistID("checklist-id-1"), withoutPeriod, "持ち物", []*ItemTemplate{socks}, sequentialItemIDs("item-"), now)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
@@ -0,0 +1,28 @@
+package checklist
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+//go:generate mockgen -destination mock/checklist.go github.com/hata0/travel-api/internal/domain/checklist ChecklistRepository
+type ChecklistRepository interface {
+	FindByID(ctx context.Context, id ChecklistID) (*Checklist, error)
+	// FindByTripID は旅行のチェックリストを作成日時の古い順に取得する
+	FindByTripID(ctx context.Context, tripID trip.TripID) ([]*Checklist, error)
+	Create(ctx context.Context, checklist *Checklist) error
+	Update(ctx context.Context, checklist *Checklist) error
+	Delete(ctx context.Context, id ChecklistID) error
+}
+
+//go:generate mockgen -destination mock/item_template.go github.com/hata0/travel-api/internal/domain/checklist ItemTemplateRepository
+type ItemTemplateRepository interface {
+	FindByID(ctx context.Context, id ItemTemplateID) (*ItemTemplate, error)
+	// FindByOwnerID はユーザーの項目テンプレートを作成日時の古い順に取得する
+	FindByOwnerID(ctx context.Context, ownerID user.UserID) ([]*ItemTemplate, error)
+	Create(ctx context.Context, template *ItemTemplate) error
+	Update(ctx context.Context, template *ItemTemplate) error
+	Delete(ctx context.Context, id ItemTemplateID) error
+}
@@ -0,0 +1,84 @@
+package checklist
+
+// ChecklistID はチェックリストIDを表現する値オブジェクト
+type ChecklistID struct {
+	value string
+}
+
+func NewChecklistID(id string) ChecklistID {
+	return ChecklistID{value: id}
+}
+
+func (id ChecklistID) String() string {
+	return id.value
+}
+
+func (id ChecklistID) Equals(other ChecklistID) bool {
+	return id.value == other.value
+}
+
+// ItemID はチェックリスト項目IDを表現する値オブジェクト
+type ItemID struct {
+	value string
+}
+
+func NewItemID(id string) ItemID {
+	return ItemID{value: id}
+}
+
+func (id ItemID) String() string {
+	return id.value
+}
+
+func (id ItemID) Equals(other ItemID) bool {
+	return id.value == other.value
+}
+
+// ItemTemplateID は項目テンプレートIDを表現する値オブジェクト
+type ItemTemplateID struct {
+	value string
+}
+
+func NewItemTemplateID(id string) ItemTemplateID {
+	return ItemTemplateID{value: id}
+}
+
+func (id ItemTemplateID) String() string {
+	return id.value
+}
+
+func (id ItemTemplateID) Equals(other ItemTemplateID) bool {
+	return id.value == other.value
+}
+
+// Kind はチェックリストの種類を表現する値オブジェクト
+type Kind string
+
+const (
+	// KindPacking は持ち物リスト
+	KindPacking Kind = "packing"
+	// KindPreDeparture は出発前にやることのリスト
+	KindPreDeparture Kind = "pre_departure"
+	// KindDocuments はパスポートや予約確認書など必要書類のリスト
+	KindDocuments Kind = "documents"
+	KindOther     Kind = "other"
+)
+
+// Kinds はチェックリストの種類を表示順に返す
+func Kinds() []Kind {
+	return []Kind{KindPacking, KindPreDeparture, KindDocuments, KindOther}
+}
+
+// ParseKind は文字列をチェックリストの種類に変換する
+func ParseKind(value string) (Kind, error) {
+	for _, k := range Kinds() {
+		if string(k) == value {
+			return k, nil
+		}
+	}
+	return "", NewInvalidKindError()
+}
+
+func (k Kind) String() string {
+	return string(k)
+}
@@ -3,6 +3,15 @@ package errors
 const (
 	CodeValidationError    = "VALIDATION_ERROR"
 	CodeInvalidCredentials = "INVALID_CREDENTIALS"
+	CodeForbidden          = "FORBIDDEN"
 	CodeConflict           = "CONFLICT"
-	CodeInternalError      = "INTERNAL_ERROR"
+	// CodePreconditionFailed は更新・削除の前提としたバージョンが現在のものと一致しないことを表す
+	CodePreconditionFailed = "PRECONDITION_FAILED"
+	// CodePreconditionRequired は更新・削除に必要な前提条件（バージョン）が指定されていないことを表す
+	CodePreconditionRequired = "PRECONDITION_REQUIRED"
+	// CodeUnsupportedMediaType はリクエストボディの形式に対応していないことを表す
+	CodeUnsupportedMediaType = "UNSUPPORTED_MEDIA_TYPE"
+	// CodePayloadTooLarge はリクエストボディやアップロードされたファイルが上限を超えていることを表す
+	CodePayloadTooLarge = "PAYLOAD_TOO_LARGE"
+	CodeInternalError   = "INTERNAL_ERROR"
 )
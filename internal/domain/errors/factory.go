@@ -1,13 +1,37 @@
 package errors
 
+func NewValidationError(message string, opts ...AppErrorOption) *AppError {
+	return NewAppError(CodeValidationError, message, opts...)
+}
+
 func NewInvalidCredentialsError(message string, opts ...AppErrorOption) *AppError {
 	return NewAppError(CodeInvalidCredentials, message, opts...)
 }
 
+func NewForbiddenError(message string, opts ...AppErrorOption) *AppError {
+	return NewAppError(CodeForbidden, message, opts...)
+}
+
 func NewConflictError(message string, opts ...AppErrorOption) *AppError {
 	return NewAppError(CodeConflict, message, opts...)
 }
 
+func NewPreconditionFailedError(message string, opts ...AppErrorOption) *AppError {
+	return NewAppError(CodePreconditionFailed, message, opts...)
+}
+
+func NewPreconditionRequiredError(message string, opts ...AppErrorOption) *AppError {
+	return NewAppError(CodePreconditionRequired, message, opts...)
+}
+
+func NewUnsupportedMediaTypeError(message string, opts ...AppErrorOption) *AppError {
+	return NewAppError(CodeUnsupportedMediaType, message, opts...)
+}
+
+func NewPayloadTooLargeError(message string, opts ...AppErrorOption) *AppError {
+	return NewAppError(CodePayloadTooLarge, message, opts...)
+}
+
 func NewInternalError(message string, opts ...AppErrorOption) *AppError {
 	return NewAppError(CodeInternalError, message, opts...)
 }
@@ -0,0 +1,127 @@
+package exchangerate
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+)
+
+// CrossCurrency は直接のレートがない通貨ペアを換算する際に経由する通貨。
+// ECB の参照レートはすべてユーロ建てで提供される
+const CrossCurrency = "EUR"
+
+//go:generate mockgen -destination mock/converter.go github.com/hata0/travel-api/internal/domain/exchangerate CurrencyConverter
+type CurrencyConverter interface {
+	// Convert は金額を指定日時点のレートで別の通貨に換算する。
+	// 指定日のレートがない場合はそれ以前で最も新しい日付のレートを使う
+	Convert(ctx context.Context, amount money.Money, currency string, on time.Time) (money.Money, error)
+}
+
+type currencyConverter struct {
+	repository ExchangeRateRepository
+}
+
+func NewCurrencyConverter(repository ExchangeRateRepository) CurrencyConverter {
+	return &currencyConverter{repository: repository}
+}
+
+// Convert はレートを次の順に探して換算する。
+//  1. 換算元 → 換算先の直接のレート
+//  2. 換算先 → 換算元のレートの逆数
+//  3. CrossCurrency を経由したクロスレート
+//
+// 換算結果は換算先通貨の最小単位に四捨五入（0.5 は 0 から遠い方へ丸める）する
+func (c *currencyConverter) Convert(ctx context.Context, amount money.Money, currency string, on time.Time) (money.Money, error) {
+	if amount.Currency() == currency {
+		return amount, nil
+	}
+	if !money.IsValidCurrencyCode(currency) {
+		return money.Money{}, money.NewInvalidCurrencyError()
+	}
+
+	rate, err := c.findRate(ctx, amount.Currency(), currency, on)
+	if apperr.IsAppErrorWithCode(err, CodeExchangeRateNotFound) &&
+		amount.Currency() != CrossCurrency && currency != CrossCurrency {
+		rate, err = c.findCrossRate(ctx, amount.Currency(), currency, on)
+	}
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return applyRate(amount, currency, rate)
+}
+
+// findRate は直接のレートか、逆方向のレートの逆数を返す
+func (c *currencyConverter) findRate(ctx context.Context, from, to string, on time.Time) (*big.Rat, error) {
+	direct, err := c.repository.FindLatest(ctx, from, to, on)
+	if err == nil {
+		return direct.Rate().rat(), nil
+	}
+	if !apperr.IsAppErrorWithCode(err, CodeExchangeRateNotFound) {
+		return nil, err
+	}
+
+	inverse, err := c.repository.FindLatest(ctx, to, from, on)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Rat).Inv(inverse.Rate().rat()), nil
+}
+
+// findCrossRate は CrossCurrency を経由したレート（CrossCurrency → to ÷ CrossCurrency → from）を返す
+func (c *currencyConverter) findCrossRate(ctx context.Context, from, to string, on time.Time) (*big.Rat, error) {
+	crossToFrom, err := c.findRate(ctx, CrossCurrency, from, on)
+	if err != nil {
+		return nil, err
+	}
+	crossToTo, err := c.findRate(ctx, CrossCurrency, to, on)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Rat).Quo(crossToTo, crossToFrom), nil
+}
+
+// applyRate は最小単位の金額にレートを掛け、通貨ごとの最小単位の桁数の差を補正して丸める
+func applyRate(amount money.Money, currency string, rate *big.Rat) (money.Money, error) {
+	converted := new(big.Rat).Mul(new(big.Rat).SetInt64(amount.Amount()), rate)
+
+	exponentDiff := money.MinorUnitExponent(currency) - money.MinorUnitExponent(amount.Currency())
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(exponentDiff))), nil))
+	if exponentDiff >= 0 {
+		converted.Mul(converted, scale)
+	} else {
+		converted.Quo(converted, scale)
+	}
+
+	rounded := roundHalfAwayFromZero(converted)
+	if !rounded.IsInt64() {
+		return money.Money{}, money.NewInvalidAmountError()
+	}
+	return money.NewMoney(rounded.Int64(), currency)
+}
+
+// roundHalfAwayFromZero は有理数を最も近い整数に丸める。ちょうど中間の場合は 0 から遠い方へ丸める
+func roundHalfAwayFromZero(value *big.Rat) *big.Int {
+	numerator := new(big.Int).Abs(value.Num())
+	denominator := value.Denom()
+
+	// |value| + 1/2 の整数部分 = (2 * |num| + denom) / (2 * denom)
+	twice := new(big.Int).Mul(numerator, big.NewInt(2))
+	twice.Add(twice, denominator)
+	rounded := twice.Quo(twice, new(big.Int).Mul(denominator, big.NewInt(2)))
+
+	if value.Sign() < 0 {
+		rounded.Neg(rounded)
+	}
+	return rounded
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,182 @@
+package exchangerate
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRepository は日付ごとのレートを保持し、指定日以前で最新のものを返すテスト用リポジトリ
+type fakeRepository struct {
+	rates []*ExchangeRate
+	err   error
+}
+
+func (f *fakeRepository) FindLatest(_ context.Context, base, quote string, on time.Time) (*ExchangeRate, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	var latest *ExchangeRate
+	for _, r := range f.rates {
+		if r.BaseCurrency() != base || r.QuoteCurrency() != quote || r.Date().After(on) {
+			continue
+		}
+		if latest == nil || r.Date().After(latest.Date()) {
+			latest = r
+		}
+	}
+	if latest == nil {
+		return nil, NewExchangeRateNotFoundError()
+	}
+	return latest, nil
+}
+
+func (f *fakeRepository) Save(context.Context, *ExchangeRate) error { return nil }
+
+func mustExchangeRate(t *testing.T, date time.Time, base, quote, rate string) *ExchangeRate {
+	t.Helper()
+	r, err := NewRate(rate)
+	require.NoError(t, err)
+	er, err := NewExchangeRate(date, base, quote, r)
+	require.NoError(t, err)
+	return er
+}
+
+func mustMoney(t *testing.T, amount int64, currency string) money.Money {
+	t.Helper()
+	m, err := money.NewMoney(amount, currency)
+	require.NoError(t, err)
+	return m
+}
+
+func TestCurrencyConverter_Convert(t *testing.T) {
+	may1 := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	may3 := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+	repo := &fakeRepository{rates: []*ExchangeRate{
+		mustExchangeRate(t, may1, "EUR", "USD", "1.0703"),
+		mustExchangeRate(t, may1, "EUR", "JPY", "168.5"),
+		mustExchangeRate(t, may3, "EUR", "USD", "1.0800"),
+		mustExchangeRate(t, may1, "EUR", "KWD", "0.3297"),
+	}}
+
+	tests := []struct {
+		name     string
+		amount   money.Money
+		currency string
+		on       time.Time
+		expected money.Money
+	}{
+		{
+			name:     "同じ通貨はそのまま返す",
+			amount:   mustMoney(t, 1234, "USD"),
+			currency: "USD",
+			on:       may1,
+			expected: mustMoney(t, 1234, "USD"),
+		},
+		{
+			name:     "直接のレートで換算する",
+			amount:   mustMoney(t, 10000, "EUR"), // 100.00 EUR
+			currency: "USD",
+			on:       may1,
+			expected: mustMoney(t, 10703, "USD"),
+		},
+		{
+			name:     "当日のレートがなければ直前の日付のレートを使う",
+			amount:   mustMoney(t, 10000, "EUR"),
+			currency: "USD",
+			on:       time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+			expected: mustMoney(t, 10703, "USD"),
+		},
+		{
+			name:     "当日のレートがあればそれを使う",
+			amount:   mustMoney(t, 10000, "EUR"),
+			currency: "USD",
+			on:       may3,
+			expected: mustMoney(t, 10800, "USD"),
+		},
+		{
+			name:     "逆方向のレートの逆数で換算する",
+			amount:   mustMoney(t, 16850, "JPY"),
+			currency: "EUR",
+			on:       may1,
+			expected: mustMoney(t, 10000, "EUR"),
+		},
+		{
+			name:     "EUR を経由したクロスレートで換算する",
+			amount:   mustMoney(t, 10703, "USD"), // 107.03 USD = 100 EUR
+			currency: "JPY",
+			on:       may1,
+			expected: mustMoney(t, 16850, "JPY"),
+		},
+		{
+			name:     "最小単位の桁数が異なる通貨間で補正する",
+			amount:   mustMoney(t, 100, "EUR"), // 1.00 EUR
+			currency: "KWD",
+			on:       may1,
+			expected: mustMoney(t, 330, "KWD"), // 0.3297 KWD → 0.330
+		},
+		{
+			name:     "端数は四捨五入する",
+			amount:   mustMoney(t, 1, "EUR"), // 0.01 EUR * 168.5 = 1.685 JPY
+			currency: "JPY",
+			on:       may1,
+			expected: mustMoney(t, 2, "JPY"),
+		},
+	}
+
+	converter := NewCurrencyConverter(repo)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := converter.Convert(context.Background(), tt.amount, tt.currency, tt.on)
+
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equals(got), "換算結果は %v であるべきだが %v だった", tt.expected, got)
+		})
+	}
+
+	t.Run("異常系: レートが存在しない", func(t *testing.T) {
+		_, err := converter.Convert(context.Background(), mustMoney(t, 100, "USD"), "GBP", may1)
+
+		assert.ErrorIs(t, err, NewExchangeRateNotFoundError())
+	})
+
+	t.Run("異常系: 指定日より前のレートがない", func(t *testing.T) {
+		_, err := converter.Convert(context.Background(), mustMoney(t, 100, "EUR"), "USD", may1.AddDate(0, 0, -1))
+
+		assert.ErrorIs(t, err, NewExchangeRateNotFoundError())
+	})
+
+	t.Run("異常系: リポジトリのエラーはそのまま返す", func(t *testing.T) {
+		repoErr := errors.New("database error")
+		failing := NewCurrencyConverter(&fakeRepository{err: repoErr})
+
+		_, err := failing.Convert(context.Background(), mustMoney(t, 100, "EUR"), "USD", may1)
+
+		assert.ErrorIs(t, err, repoErr)
+	})
+}
+
+func TestRoundHalfAwayFromZero(t *testing.T) {
+	tests := []struct {
+		value    *big.Rat
+		expected int64
+	}{
+		{big.NewRat(5, 2), 3},
+		{big.NewRat(-5, 2), -3},
+		{big.NewRat(249, 100), 2},
+		{big.NewRat(-251, 100), -3},
+		{big.NewRat(4, 1), 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value.RatString(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, roundHalfAwayFromZero(tt.value).Int64())
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package exchangerate
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeExchangeRateNotFound = "EXCHANGE_RATE_NOT_FOUND"
+)
+
+func NewExchangeRateNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeExchangeRateNotFound, "Exchange rate not found", opts...)
+}
+
+func NewInvalidRateError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Exchange rate must be a positive decimal number", opts...)
+}
+
+func NewSameCurrencyPairError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Exchange rate currencies must be different", opts...)
+}
@@ -0,0 +1,49 @@
+package exchangerate
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// ExchangeRate は特定の日付における通貨ペアの為替レートを表現するエンティティ。
+// 日付と通貨ペア（基準通貨・相手通貨）の組で一意に識別される
+type ExchangeRate struct {
+	date          time.Time
+	baseCurrency  string
+	quoteCurrency string
+	rate          Rate
+}
+
+// NewExchangeRate は為替レートを作成する。日付は時刻を切り捨てて保持する
+func NewExchangeRate(date time.Time, baseCurrency, quoteCurrency string, rate Rate) (*ExchangeRate, error) {
+	if !money.IsValidCurrencyCode(baseCurrency) || !money.IsValidCurrencyCode(quoteCurrency) {
+		return nil, money.NewInvalidCurrencyError()
+	}
+	if baseCurrency == quoteCurrency {
+		return nil, NewSameCurrencyPairError()
+	}
+	return &ExchangeRate{
+		date:          trip.TruncateToDate(date),
+		baseCurrency:  baseCurrency,
+		quoteCurrency: quoteCurrency,
+		rate:          rate,
+	}, nil
+}
+
+// Getters
+func (r *ExchangeRate) Date() time.Time       { return r.date }
+func (r *ExchangeRate) BaseCurrency() string  { return r.baseCurrency }
+func (r *ExchangeRate) QuoteCurrency() string { return r.quoteCurrency }
+func (r *ExchangeRate) Rate() Rate            { return r.rate }
+
+func (r *ExchangeRate) Equals(other *ExchangeRate) bool {
+	if other == nil {
+		return false
+	}
+	return r.date.Equal(other.date) &&
+		r.baseCurrency == other.baseCurrency &&
+		r.quoteCurrency == other.quoteCurrency &&
+		r.rate.Equals(other.rate)
+}
@@ -0,0 +1,14 @@
+package exchangerate
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -destination mock/exchange_rate.go github.com/hata0/travel-api/internal/domain/exchangerate ExchangeRateRepository
+type ExchangeRateRepository interface {
+	// FindLatest は指定日以前で最も新しい日付の為替レートを取得する
+	FindLatest(ctx context.Context, baseCurrency, quoteCurrency string, on time.Time) (*ExchangeRate, error)
+	// Save は為替レートを作成し、同じ日付と通貨ペアが既に存在する場合は上書きする
+	Save(ctx context.Context, rate *ExchangeRate) error
+}
@@ -0,0 +1,48 @@
+package exchangerate
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+var ratePattern = regexp.MustCompile(`^\d+(?:\.\d+)?$`)
+
+// Rate は為替レート（基準通貨1単位あたりの相手通貨の量）を表現する値オブジェクト。
+// 換算結果に誤差を持ち込まないよう、浮動小数点を経由せず10進数表記から有理数として保持する
+type Rate struct {
+	value   *big.Rat
+	decimal string
+}
+
+// NewRate は "1.0703" のような10進数表記からレートを作成する。0 以下の値はエラーとする
+func NewRate(decimal string) (Rate, error) {
+	if !ratePattern.MatchString(decimal) {
+		return Rate{}, NewInvalidRateError()
+	}
+	value, ok := new(big.Rat).SetString(decimal)
+	if !ok || value.Sign() <= 0 {
+		return Rate{}, NewInvalidRateError()
+	}
+
+	// "1.50" と "1.5" を同じ表記に揃える
+	if strings.Contains(decimal, ".") {
+		decimal = strings.TrimSuffix(strings.TrimRight(decimal, "0"), ".")
+	}
+
+	return Rate{value: value, decimal: decimal}, nil
+}
+
+// String は10進数表記を返す
+func (r Rate) String() string {
+	return r.decimal
+}
+
+func (r Rate) Equals(other Rate) bool {
+	return r.decimal == other.decimal
+}
+
+// rat は内部の有理数のコピーを返す
+func (r Rate) rat() *big.Rat {
+	return new(big.Rat).Set(r.value)
+}
@@ -0,0 +1,49 @@
+package exchangerate
+
+import (
+	"testing"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		decimal  string
+		expected string
+		wantErr  bool
+	}{
+		{name: "小数", decimal: "1.0703", expected: "1.0703"},
+		{name: "整数", decimal: "162", expected: "162"},
+		{name: "末尾の0は除かれる", decimal: "1.50", expected: "1.5"},
+		{name: "小数部がすべて0", decimal: "2.000", expected: "2"},
+		{name: "0", decimal: "0", wantErr: true},
+		{name: "負の値", decimal: "-1.2", wantErr: true},
+		{name: "指数表記", decimal: "1e3", wantErr: true},
+		{name: "空文字", decimal: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := NewRate(tt.decimal)
+
+			if tt.wantErr {
+				assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError), "不正なレートはバリデーションエラーになるべき")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rate.String(), "String は正規化された10進数表記を返すべき")
+		})
+	}
+}
+
+func TestRate_Equals(t *testing.T) {
+	a, _ := NewRate("1.50")
+	b, _ := NewRate("1.5")
+	c, _ := NewRate("1.51")
+
+	assert.True(t, a.Equals(b), "表記が異なっても同じ値のレートは等しいと判定されるべき")
+	assert.False(t, a.Equals(c), "異なる値のレートは等しくないと判定されるべき")
+}
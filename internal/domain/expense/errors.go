@@ -0,0 +1,43 @@
+package expense
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeExpenseNotFound = "EXPENSE_NOT_FOUND"
+)
+
+func NewExpenseNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeExpenseNotFound, "Expense not found", opts...)
+}
+
+func NewInvalidCategoryError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Expense category is not supported", opts...)
+}
+
+func NewNonPositiveAmountError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Expense amount must be greater than zero", opts...)
+}
+
+func NewPayerRequiredError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Expense payer is required", opts...)
+}
+
+func NewInvalidSplitMethodError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Split method must be one of equal, shares, exact or percentage", opts...)
+}
+
+func NewSplitParticipantsRequiredError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Split requires at least one participant with a name", opts...)
+}
+
+func NewDuplicateSplitParticipantError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Split participants must be unique", opts...)
+}
+
+func NewInvalidSplitValueError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Split value is out of range for the split method", opts...)
+}
+
+func NewSplitTotalMismatchError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Split values must add up to the expense amount (or 10000 basis points for percentage)", opts...)
+}
@@ -0,0 +1,118 @@
+package expense
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// Expense は旅行中の支出を表現するエンティティ
+type Expense struct {
+	id          ExpenseID
+	tripID      trip.TripID
+	amount      money.Money
+	category    Category
+	spentOn     time.Time
+	payer       string
+	description string
+	activityID  *string
+	split       *Split
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewExpense は新しい支出を作成する。spentOn は日付として扱い、時刻は切り捨てる
+func NewExpense(
+	id ExpenseID,
+	tripID trip.TripID,
+	amount money.Money,
+	category Category,
+	spentOn time.Time,
+	payer, description string,
+	activityID *string,
+	split *Split,
+	createdAt, updatedAt time.Time,
+) *Expense {
+	return &Expense{
+		id:          id,
+		tripID:      tripID,
+		amount:      amount,
+		category:    category,
+		spentOn:     trip.TruncateToDate(spentOn),
+		payer:       payer,
+		description: description,
+		activityID:  activityID,
+		split:       split,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Getters
+func (e *Expense) ID() ExpenseID        { return e.id }
+func (e *Expense) TripID() trip.TripID  { return e.tripID }
+func (e *Expense) Amount() money.Money  { return e.amount }
+func (e *Expense) Category() Category   { return e.category }
+func (e *Expense) SpentOn() time.Time   { return e.spentOn }
+func (e *Expense) Payer() string        { return e.payer }
+func (e *Expense) Description() string  { return e.description }
+func (e *Expense) ActivityID() *string  { return e.activityID }
+func (e *Expense) Split() *Split        { return e.split }
+func (e *Expense) CreatedAt() time.Time { return e.createdAt }
+func (e *Expense) UpdatedAt() time.Time { return e.updatedAt }
+
+// Update は支出の情報を更新する
+func (e *Expense) Update(
+	amount money.Money,
+	category Category,
+	spentOn time.Time,
+	payer, description string,
+	activityID *string,
+	split *Split,
+	updatedAt time.Time,
+) *Expense {
+	return &Expense{
+		id:          e.id,
+		tripID:      e.tripID,
+		amount:      amount,
+		category:    category,
+		spentOn:     trip.TruncateToDate(spentOn),
+		payer:       payer,
+		description: description,
+		activityID:  activityID,
+		split:       split,
+		createdAt:   e.createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Shares は参加者ごとの分担額を返す。分担が設定されていない支出は支払者が全額を負担する
+func (e *Expense) Shares() ([]Share, error) {
+	if e.split == nil {
+		return []Share{{participant: e.payer, amount: e.amount}}, nil
+	}
+	return e.split.Allocate(e.amount)
+}
+
+// Validate は支出として成立しているか（正の金額と支払者があり、分担額を配分できるか）を検証する
+func (e *Expense) Validate() error {
+	if e.amount.Amount() <= 0 {
+		return NewNonPositiveAmountError()
+	}
+	if strings.TrimSpace(e.payer) == "" {
+		return NewPayerRequiredError()
+	}
+	if _, err := e.Shares(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (e *Expense) Equals(other *Expense) bool {
+	if other == nil {
+		return false
+	}
+	return e.id.Equals(other.id)
+}
@@ -0,0 +1,115 @@
+package expense
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMoney(t *testing.T, amount int64, currency string) money.Money {
+	t.Helper()
+	m, err := money.NewMoney(amount, currency)
+	require.NoError(t, err)
+	return m
+}
+
+func TestNewExpense(t *testing.T) {
+	id := NewExpenseID("expense-id-1")
+	tripID := trip.NewTripID("trip-id-1")
+	amount := newTestMoney(t, 1200, "JPY")
+	activityID := "activity-id-1"
+	createdAt := time.Now().Add(-24 * time.Hour)
+	updatedAt := time.Now()
+
+	split, err := NewSplit(SplitMethodEqual, []SplitEntry{NewSplitEntry("Alice", 0), NewSplitEntry("Bob", 0)})
+	require.NoError(t, err)
+
+	e := NewExpense(id, tripID, amount, CategoryFood, time.Date(2024, 5, 1, 19, 30, 0, 0, time.UTC), "Alice", "ラーメン", &activityID, split, createdAt, updatedAt)
+
+	assert.NotNil(t, e, "NewExpense は nil を返すべきではない")
+	assert.Equal(t, id, e.ID(), "ID() は正しい ID を返すべき")
+	assert.Equal(t, tripID, e.TripID(), "TripID() は正しい TripID を返すべき")
+	assert.Equal(t, amount, e.Amount(), "Amount() は正しい金額を返すべき")
+	assert.Equal(t, CategoryFood, e.Category(), "Category() は正しい分類を返すべき")
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), e.SpentOn(), "SpentOn() は時刻を切り捨てた日付を返すべき")
+	assert.Equal(t, "Alice", e.Payer(), "Payer() は正しい支払者を返すべき")
+	assert.Equal(t, "ラーメン", e.Description(), "Description() は正しい説明を返すべき")
+	assert.Equal(t, &activityID, e.ActivityID(), "ActivityID() は正しいアクティビティ ID を返すべき")
+	assert.Equal(t, split, e.Split(), "Split() は正しい分担を返すべき")
+	assert.Equal(t, createdAt, e.CreatedAt(), "CreatedAt() は正しい createdAt を返すべき")
+	assert.Equal(t, updatedAt, e.UpdatedAt(), "UpdatedAt() は正しい updatedAt を返すべき")
+}
+
+func TestExpense_Update(t *testing.T) {
+	createdAt := time.Now().Add(-48 * time.Hour)
+	e := NewExpense(NewExpenseID("expense-id-1"), trip.NewTripID("trip-id-1"), newTestMoney(t, 1200, "JPY"), CategoryFood,
+		time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), "Alice", "", nil, nil, createdAt, createdAt)
+
+	newAmount := newTestMoney(t, 2500, "USD")
+	newUpdatedAt := time.Now()
+	updated := e.Update(newAmount, CategoryTransport, time.Date(2024, 5, 2, 8, 0, 0, 0, time.UTC), "Bob", "タクシー", nil, nil, newUpdatedAt)
+
+	assert.Equal(t, e.ID(), updated.ID(), "Update 後も ID は変わらないべき")
+	assert.Equal(t, e.TripID(), updated.TripID(), "Update 後も TripID は変わらないべき")
+	assert.Equal(t, newAmount, updated.Amount(), "Update 後は新しい金額を返すべき")
+	assert.Equal(t, CategoryTransport, updated.Category(), "Update 後は新しい分類を返すべき")
+	assert.Equal(t, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), updated.SpentOn(), "Update 後は新しい日付を返すべき")
+	assert.Equal(t, "Bob", updated.Payer(), "Update 後は新しい支払者を返すべき")
+	assert.Equal(t, createdAt, updated.CreatedAt(), "Update 後も CreatedAt は変わらないべき")
+	assert.Equal(t, newUpdatedAt, updated.UpdatedAt(), "Update 後は新しい UpdatedAt を返すべき")
+	assert.Equal(t, "Alice", e.Payer(), "元の Expense は変更されないべき")
+}
+
+func TestExpense_Validate(t *testing.T) {
+	spentOn := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	exactSplit, err := NewSplit(SplitMethodExact, []SplitEntry{NewSplitEntry("Alice", 60), NewSplitEntry("Bob", 40)})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		amount  int64
+		payer   string
+		split   *Split
+		wantErr bool
+	}{
+		{name: "正常系", amount: 100, payer: "Alice", wantErr: false},
+		{name: "正常系: 金額指定の分担の合計が支出額と一致する", amount: 100, payer: "Alice", split: exactSplit, wantErr: false},
+		{name: "異常系: 金額が0", amount: 0, payer: "Alice", wantErr: true},
+		{name: "異常系: 金額が負", amount: -100, payer: "Alice", wantErr: true},
+		{name: "異常系: 支払者が空白", amount: 100, payer: "  ", wantErr: true},
+		{name: "異常系: 金額指定の分担の合計が支出額と一致しない", amount: 120, payer: "Alice", split: exactSplit, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewExpense(NewExpenseID("expense-id"), trip.NewTripID("trip-id"), newTestMoney(t, tt.amount, "JPY"), CategoryOther,
+				spentOn, tt.payer, "", nil, tt.split, now, now)
+
+			err := e.Validate()
+
+			if tt.wantErr {
+				assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExpense_Equals(t *testing.T) {
+	now := time.Now()
+	amount := newTestMoney(t, 100, "JPY")
+	e1 := NewExpense(NewExpenseID("expense-id-1"), trip.NewTripID("trip-id"), amount, CategoryOther, now, "Alice", "", nil, nil, now, now)
+	e2 := NewExpense(NewExpenseID("expense-id-1"), trip.NewTripID("trip-id"), amount, CategoryFood, now, "Bob", "", nil, nil, now, now)
+	e3 := NewExpense(NewExpenseID("expense-id-2"), trip.NewTripID("trip-id"), amount, CategoryOther, now, "Alice", "", nil, nil, now, now)
+
+	assert.True(t, e1.Equals(e2), "同じ ID の Expense は等しいと判定されるべき")
+	assert.False(t, e1.Equals(e3), "異なる ID の Expense は等しくないと判定されるべき")
+	assert.False(t, e1.Equals(nil), "nil とは等しくないと判定されるべき")
+}
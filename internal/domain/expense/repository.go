@@ -0,0 +1,16 @@
+package expense
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+//go:generate mockgen -destination mock/expense.go github.com/hata0/travel-api/internal/domain/expense ExpenseRepository
+type ExpenseRepository interface {
+	FindByID(ctx context.Context, id ExpenseID) (*Expense, error)
+	FindByTripID(ctx context.Context, tripID trip.TripID) ([]*Expense, error)
+	Create(ctx context.Context, expense *Expense) error
+	Update(ctx context.Context, expense *Expense) error
+	Delete(ctx context.Context, id ExpenseID) error
+}
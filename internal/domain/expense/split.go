@@ -0,0 +1,237 @@
+package expense
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+)
+
+// SplitMethod は支出を参加者間で分担する方法
+type SplitMethod string
+
+const (
+	// SplitMethodEqual は全員で均等に分担する。各参加者の value は使用しない
+	SplitMethodEqual SplitMethod = "equal"
+	// SplitMethodShares は value を口数とみなし、口数に比例して分担する
+	SplitMethodShares SplitMethod = "shares"
+	// SplitMethodExact は value を通貨の最小単位の金額とみなし、そのまま分担額とする。合計は支出額と一致する必要がある
+	SplitMethodExact SplitMethod = "exact"
+	// SplitMethodPercentage は value をベーシスポイント（1% = 100）とみなして分担する。合計は 10000 である必要がある
+	SplitMethodPercentage SplitMethod = "percentage"
+)
+
+// PercentageBasisPoints は割合で分担する場合の value の合計（100%）
+const PercentageBasisPoints = 10000
+
+// ParseSplitMethod は文字列を分担方法に変換する
+func ParseSplitMethod(value string) (SplitMethod, error) {
+	switch m := SplitMethod(value); m {
+	case SplitMethodEqual, SplitMethodShares, SplitMethodExact, SplitMethodPercentage:
+		return m, nil
+	}
+	return "", NewInvalidSplitMethodError()
+}
+
+func (m SplitMethod) String() string {
+	return string(m)
+}
+
+// SplitEntry は分担する参加者と、分担方法に応じた値（口数・金額・ベーシスポイント）の組
+type SplitEntry struct {
+	participant string
+	value       int64
+}
+
+func NewSplitEntry(participant string, value int64) SplitEntry {
+	return SplitEntry{participant: participant, value: value}
+}
+
+// Getters
+func (e SplitEntry) Participant() string { return e.participant }
+func (e SplitEntry) Value() int64        { return e.value }
+
+// Share は参加者ごとの分担額
+type Share struct {
+	participant string
+	amount      money.Money
+}
+
+// Getters
+func (s Share) Participant() string { return s.participant }
+func (s Share) Amount() money.Money { return s.amount }
+
+// Split は支出を誰がどのように分担するかを表現する値オブジェクト
+type Split struct {
+	method  SplitMethod
+	entries []SplitEntry
+}
+
+// NewSplit は分担方法と参加者の一覧から Split を作成する。
+// 参加者は 1 人以上かつ重複不可で、value は分担方法ごとの制約を満たす必要がある
+func NewSplit(method SplitMethod, entries []SplitEntry) (*Split, error) {
+	if len(entries) == 0 {
+		return nil, NewSplitParticipantsRequiredError()
+	}
+
+	seen := make(map[string]struct{}, len(entries))
+	normalized := make([]SplitEntry, len(entries))
+	var total int64
+	for i, entry := range entries {
+		participant := strings.TrimSpace(entry.participant)
+		if participant == "" {
+			return nil, NewSplitParticipantsRequiredError()
+		}
+		if _, ok := seen[participant]; ok {
+			return nil, NewDuplicateSplitParticipantError()
+		}
+		seen[participant] = struct{}{}
+
+		value := entry.value
+		switch method {
+		case SplitMethodEqual:
+			value = 0
+		case SplitMethodShares:
+			if value <= 0 {
+				return nil, NewInvalidSplitValueError()
+			}
+		case SplitMethodExact:
+			if value < 0 {
+				return nil, NewInvalidSplitValueError()
+			}
+		case SplitMethodPercentage:
+			if value < 0 || value > PercentageBasisPoints {
+				return nil, NewInvalidSplitValueError()
+			}
+		default:
+			return nil, NewInvalidSplitMethodError()
+		}
+		if total > math.MaxInt64-value {
+			return nil, NewInvalidSplitValueError()
+		}
+		total += value
+
+		normalized[i] = SplitEntry{participant: participant, value: value}
+	}
+
+	if method == SplitMethodPercentage && total != PercentageBasisPoints {
+		return nil, NewSplitTotalMismatchError()
+	}
+
+	return &Split{method: method, entries: normalized}, nil
+}
+
+// Getters
+func (s *Split) Method() SplitMethod { return s.method }
+
+func (s *Split) Entries() []SplitEntry {
+	return append([]SplitEntry(nil), s.entries...)
+}
+
+// Participants は分担する参加者を登録順に返す
+func (s *Split) Participants() []string {
+	participants := make([]string, len(s.entries))
+	for i, entry := range s.entries {
+		participants[i] = entry.participant
+	}
+	return participants
+}
+
+// Allocate は支出額を参加者ごとの分担額に配分する。配分額の合計は必ず total と一致する。
+//
+// 端数の扱い: 均等・口数・割合では、まず各参加者に total × 重み ÷ 重みの合計 を最小通貨単位で切り捨てた額を割り当てる。
+// 切り捨てで余った最小単位（参加者数未満）は、切り捨てた端数が大きい参加者から 1 単位ずつ配る。
+// 端数が同じ場合は登録順が早い参加者を優先する。金額指定では端数は生じず、合計が total と異なる場合はエラーとする
+func (s *Split) Allocate(total money.Money) ([]Share, error) {
+	if total.Amount() < 0 {
+		return nil, NewNonPositiveAmountError()
+	}
+
+	weights := make([]int64, len(s.entries))
+	for i, entry := range s.entries {
+		switch s.method {
+		case SplitMethodEqual:
+			weights[i] = 1
+		default:
+			weights[i] = entry.value
+		}
+	}
+
+	if s.method == SplitMethodExact {
+		var sum int64
+		for _, w := range weights {
+			sum += w
+		}
+		if sum != total.Amount() {
+			return nil, NewSplitTotalMismatchError()
+		}
+		return s.shares(weights, total.Currency())
+	}
+
+	return s.shares(allocateLargestRemainder(total.Amount(), weights), total.Currency())
+}
+
+func (s *Split) shares(amounts []int64, currency string) ([]Share, error) {
+	shares := make([]Share, len(s.entries))
+	for i, entry := range s.entries {
+		amount, err := money.NewMoney(amounts[i], currency)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = Share{participant: entry.participant, amount: amount}
+	}
+	return shares, nil
+}
+
+// allocateLargestRemainder は total を重みに比例して整数で配分する（最大剰余方式）。
+// 途中計算のオーバーフローを避けるため big.Int で計算する
+func allocateLargestRemainder(total int64, weights []int64) []int64 {
+	weightSum := new(big.Int)
+	for _, w := range weights {
+		weightSum.Add(weightSum, big.NewInt(w))
+	}
+
+	amounts := make([]int64, len(weights))
+	remainders := make([]*big.Int, len(weights))
+	allocated := int64(0)
+	for i, w := range weights {
+		quotient, remainder := new(big.Int).QuoRem(
+			new(big.Int).Mul(big.NewInt(total), big.NewInt(w)),
+			weightSum,
+			new(big.Int),
+		)
+		amounts[i] = quotient.Int64()
+		remainders[i] = remainder
+		allocated += amounts[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+	for _, i := range order[:total-allocated] {
+		amounts[i]++
+	}
+
+	return amounts
+}
+
+func (s *Split) Equals(other *Split) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if s.method != other.method || len(s.entries) != len(other.entries) {
+		return false
+	}
+	for i := range s.entries {
+		if s.entries[i] != other.entries[i] {
+			return false
+		}
+	}
+	return true
+}
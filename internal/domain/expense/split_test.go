@@ -0,0 +1,226 @@
+package expense
+
+import (
+	"math"
+	"testing"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSplitMethod(t *testing.T) {
+	for _, m := range []SplitMethod{SplitMethodEqual, SplitMethodShares, SplitMethodExact, SplitMethodPercentage} {
+		t.Run("正常系: "+m.String(), func(t *testing.T) {
+			got, err := ParseSplitMethod(m.String())
+
+			require.NoError(t, err)
+			assert.Equal(t, m, got)
+		})
+	}
+
+	t.Run("異常系: 未定義の分担方法", func(t *testing.T) {
+		_, err := ParseSplitMethod("random")
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestNewSplit(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  SplitMethod
+		entries []SplitEntry
+		want    []SplitEntry
+		wantErr bool
+	}{
+		{
+			name:    "正常系: 均等では value を無視する",
+			method:  SplitMethodEqual,
+			entries: []SplitEntry{NewSplitEntry("Alice", 5), NewSplitEntry(" Bob ", 0)},
+			want:    []SplitEntry{NewSplitEntry("Alice", 0), NewSplitEntry("Bob", 0)},
+		},
+		{
+			name:    "正常系: 口数",
+			method:  SplitMethodShares,
+			entries: []SplitEntry{NewSplitEntry("Alice", 2), NewSplitEntry("Bob", 1)},
+			want:    []SplitEntry{NewSplitEntry("Alice", 2), NewSplitEntry("Bob", 1)},
+		},
+		{
+			name:    "正常系: 金額指定では 0 円の参加者を許容する",
+			method:  SplitMethodExact,
+			entries: []SplitEntry{NewSplitEntry("Alice", 1000), NewSplitEntry("Bob", 0)},
+			want:    []SplitEntry{NewSplitEntry("Alice", 1000), NewSplitEntry("Bob", 0)},
+		},
+		{
+			name:    "正常系: 割合の合計が 100%",
+			method:  SplitMethodPercentage,
+			entries: []SplitEntry{NewSplitEntry("Alice", 3333), NewSplitEntry("Bob", 6667)},
+			want:    []SplitEntry{NewSplitEntry("Alice", 3333), NewSplitEntry("Bob", 6667)},
+		},
+		{name: "異常系: 参加者がいない", method: SplitMethodEqual, entries: nil, wantErr: true},
+		{name: "異常系: 参加者名が空白", method: SplitMethodEqual, entries: []SplitEntry{NewSplitEntry("  ", 0)}, wantErr: true},
+		{name: "異常系: 参加者が重複している", method: SplitMethodEqual, entries: []SplitEntry{NewSplitEntry("Alice", 0), NewSplitEntry("Alice ", 0)}, wantErr: true},
+		{name: "異常系: 未定義の分担方法", method: SplitMethod("random"), entries: []SplitEntry{NewSplitEntry("Alice", 1)}, wantErr: true},
+		{name: "異常系: 口数が 0", method: SplitMethodShares, entries: []SplitEntry{NewSplitEntry("Alice", 0)}, wantErr: true},
+		{name: "異常系: 金額が負", method: SplitMethodExact, entries: []SplitEntry{NewSplitEntry("Alice", -1)}, wantErr: true},
+		{name: "異常系: 金額の合計がオーバーフローする", method: SplitMethodExact, entries: []SplitEntry{NewSplitEntry("Alice", math.MaxInt64), NewSplitEntry("Bob", 1)}, wantErr: true},
+		{name: "異常系: 割合が 100% を超える参加者がいる", method: SplitMethodPercentage, entries: []SplitEntry{NewSplitEntry("Alice", 10001)}, wantErr: true},
+		{name: "異常系: 割合の合計が 100% ではない", method: SplitMethodPercentage, entries: []SplitEntry{NewSplitEntry("Alice", 5000), NewSplitEntry("Bob", 4999)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewSplit(tt.method, tt.entries)
+
+			if tt.wantErr {
+				assert.Nil(t, got)
+				assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.method, got.Method())
+			assert.Equal(t, tt.want, got.Entries())
+		})
+	}
+}
+
+func TestSplit_Allocate(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   SplitMethod
+		entries  []SplitEntry
+		amount   int64
+		currency string
+		want     map[string]int64
+		wantErr  bool
+	}{
+		{
+			name:     "均等: 割り切れる",
+			method:   SplitMethodEqual,
+			entries:  []SplitEntry{NewSplitEntry("Alice", 0), NewSplitEntry("Bob", 0)},
+			amount:   3000,
+			currency: "JPY",
+			want:     map[string]int64{"Alice": 1500, "Bob": 1500},
+		},
+		{
+			name:     "均等: 端数は登録順が早い参加者から 1 単位ずつ配る",
+			method:   SplitMethodEqual,
+			entries:  []SplitEntry{NewSplitEntry("Carol", 0), NewSplitEntry("Alice", 0), NewSplitEntry("Bob", 0)},
+			amount:   1000,
+			currency: "JPY",
+			want:     map[string]int64{"Carol": 334, "Alice": 333, "Bob": 333},
+		},
+		{
+			name:     "均等: 端数が 2 単位",
+			method:   SplitMethodEqual,
+			entries:  []SplitEntry{NewSplitEntry("Alice", 0), NewSplitEntry("Bob", 0), NewSplitEntry("Carol", 0)},
+			amount:   1001,
+			currency: "USD",
+			want:     map[string]int64{"Alice": 334, "Bob": 334, "Carol": 333},
+		},
+		{
+			name:     "均等: 参加者より支出額の最小単位が少ない",
+			method:   SplitMethodEqual,
+			entries:  []SplitEntry{NewSplitEntry("Alice", 0), NewSplitEntry("Bob", 0), NewSplitEntry("Carol", 0)},
+			amount:   2,
+			currency: "JPY",
+			want:     map[string]int64{"Alice": 1, "Bob": 1, "Carol": 0},
+		},
+		{
+			name:     "口数: 端数は切り捨てた端数が大きい参加者に配る",
+			method:   SplitMethodShares,
+			entries:  []SplitEntry{NewSplitEntry("Alice", 1), NewSplitEntry("Bob", 2)},
+			amount:   1000,
+			currency: "JPY",
+			// Alice 333.33... / Bob 666.66... → 端数の大きい Bob に 1 円
+			want: map[string]int64{"Alice": 333, "Bob": 667},
+		},
+		{
+			name:     "口数: 大きな金額でもオーバーフローしない",
+			method:   SplitMethodShares,
+			entries:  []SplitEntry{NewSplitEntry("Alice", math.MaxInt64/2), NewSplitEntry("Bob", math.MaxInt64/2)},
+			amount:   math.MaxInt64 - 1,
+			currency: "JPY",
+			want:     map[string]int64{"Alice": math.MaxInt64 / 2, "Bob": math.MaxInt64 / 2},
+		},
+		{
+			name:     "金額指定: そのまま分担額になる",
+			method:   SplitMethodExact,
+			entries:  []SplitEntry{NewSplitEntry("Alice", 1234), NewSplitEntry("Bob", 766)},
+			amount:   2000,
+			currency: "USD",
+			want:     map[string]int64{"Alice": 1234, "Bob": 766},
+		},
+		{
+			name:     "金額指定: 合計が支出額と一致しない",
+			method:   SplitMethodExact,
+			entries:  []SplitEntry{NewSplitEntry("Alice", 1234), NewSplitEntry("Bob", 700)},
+			amount:   2000,
+			currency: "USD",
+			wantErr:  true,
+		},
+		{
+			name:     "割合: 割り切れる",
+			method:   SplitMethodPercentage,
+			entries:  []SplitEntry{NewSplitEntry("Alice", 2500), NewSplitEntry("Bob", 7500)},
+			amount:   4000,
+			currency: "JPY",
+			want:     map[string]int64{"Alice": 1000, "Bob": 3000},
+		},
+		{
+			name:     "割合: 端数の合計は最小単位に丸められて配られる",
+			method:   SplitMethodPercentage,
+			entries:  []SplitEntry{NewSplitEntry("Alice", 3333), NewSplitEntry("Bob", 3333), NewSplitEntry("Carol", 3334)},
+			amount:   100,
+			currency: "USD",
+			// 33.33 / 33.33 / 33.34 → 切り捨てで 33 / 33 / 33、余りの 1 セントは端数が最も大きい Carol に配る
+			want: map[string]int64{"Alice": 33, "Bob": 33, "Carol": 34},
+		},
+		{
+			name:     "割合: 0% の参加者には配らない",
+			method:   SplitMethodPercentage,
+			entries:  []SplitEntry{NewSplitEntry("Alice", 0), NewSplitEntry("Bob", 10000)},
+			amount:   999,
+			currency: "JPY",
+			want:     map[string]int64{"Alice": 0, "Bob": 999},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			split, err := NewSplit(tt.method, tt.entries)
+			require.NoError(t, err)
+
+			shares, err := split.Allocate(newTestMoney(t, tt.amount, tt.currency))
+
+			if tt.wantErr {
+				assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, shares, len(tt.entries))
+
+			got := make(map[string]int64, len(shares))
+			var sum int64
+			for i, share := range shares {
+				assert.Equal(t, tt.entries[i].Participant(), share.Participant(), "分担額は登録順に返されるべき")
+				assert.Equal(t, tt.currency, share.Amount().Currency())
+				got[share.Participant()] = share.Amount().Amount()
+				sum += share.Amount().Amount()
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.amount, sum, "分担額の合計は支出額と一致するべき")
+		})
+	}
+}
+
+func TestExpense_Shares(t *testing.T) {
+	t.Run("分担が設定されていない場合は支払者が全額を負担する", func(t *testing.T) {
+		amount := newTestMoney(t, 1200, "JPY")
+		e := &Expense{amount: amount, payer: "Alice"}
+
+		shares, err := e.Shares()
+
+		require.NoError(t, err)
+		assert.Equal(t, []Share{{participant: "Alice", amount: amount}}, shares)
+	})
+}
@@ -0,0 +1,61 @@
+package expense
+
+// ExpenseID は支出IDを表現する値オブジェクト
+type ExpenseID struct {
+	value string
+}
+
+func NewExpenseID(id string) ExpenseID {
+	return ExpenseID{value: id}
+}
+
+func (id ExpenseID) String() string {
+	return id.value
+}
+
+func (id ExpenseID) Equals(other ExpenseID) bool {
+	return id.value == other.value
+}
+
+// Category は支出の分類を表現する値オブジェクト
+type Category string
+
+const (
+	CategoryTransport     Category = "transport"
+	CategoryAccommodation Category = "accommodation"
+	CategoryFood          Category = "food"
+	CategoryActivity      Category = "activity"
+	CategoryShopping      Category = "shopping"
+	CategoryOther         Category = "other"
+	// CategorySettlement は参加者間の精算（立て替えの返済）を表す。支出ではないため予算の集計には含めない
+	CategorySettlement Category = "settlement"
+)
+
+// Categories は予算を設定できる支出分類を表示順に返す。精算は含まない
+func Categories() []Category {
+	return []Category{
+		CategoryTransport,
+		CategoryAccommodation,
+		CategoryFood,
+		CategoryActivity,
+		CategoryShopping,
+		CategoryOther,
+	}
+}
+
+// ParseCategory は文字列を支出分類に変換する
+func ParseCategory(value string) (Category, error) {
+	for _, c := range Categories() {
+		if string(c) == value {
+			return c, nil
+		}
+	}
+	if value == string(CategorySettlement) {
+		return CategorySettlement, nil
+	}
+	return "", NewInvalidCategoryError()
+}
+
+func (c Category) String() string {
+	return string(c)
+}
@@ -0,0 +1,41 @@
+package expense
+
+import (
+	"testing"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpenseID(t *testing.T) {
+	id := NewExpenseID("expense-id-1")
+
+	assert.Equal(t, "expense-id-1", id.String(), "String() は元の ID を返すべき")
+	assert.True(t, id.Equals(NewExpenseID("expense-id-1")), "同じ値の ExpenseID は等しいと判定されるべき")
+	assert.False(t, id.Equals(NewExpenseID("expense-id-2")), "異なる値の ExpenseID は等しくないと判定されるべき")
+}
+
+func TestParseCategory(t *testing.T) {
+	for _, c := range Categories() {
+		t.Run("正常系: "+c.String(), func(t *testing.T) {
+			got, err := ParseCategory(c.String())
+
+			require.NoError(t, err)
+			assert.Equal(t, c, got)
+		})
+	}
+
+	t.Run("正常系: 精算", func(t *testing.T) {
+		got, err := ParseCategory("settlement")
+
+		require.NoError(t, err)
+		assert.Equal(t, CategorySettlement, got)
+	})
+
+	t.Run("異常系: 未定義の分類", func(t *testing.T) {
+		_, err := ParseCategory("gambling")
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
@@ -0,0 +1,15 @@
+package history
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeRevisionNotFound = "REVISION_NOT_FOUND"
+)
+
+func NewRevisionNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeRevisionNotFound, "Revision not found", opts...)
+}
+
+func NewUnsupportedResourceError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewInternalError("Resource is not tracked in trip history", opts...)
+}
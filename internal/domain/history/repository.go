@@ -0,0 +1,19 @@
+package history
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// HistoryRepository は旅行の変更履歴を永続化する。
+// NextRevision と Save は変更の保存と同じトランザクション内で呼び出す
+//
+//go:generate mockgen -destination mock/history.go github.com/hata0/travel-api/internal/domain/history HistoryRepository
+type HistoryRepository interface {
+	// NextRevision は旅行の次のリビジョン番号を払い出す。トランザクションが終わるまで同じ旅行の払い出しは待たされる
+	NextRevision(ctx context.Context, tripID trip.TripID) (int64, error)
+	Save(ctx context.Context, revision *Revision) error
+	// FindByTripID は旅行のリビジョンを新しい順に取得する
+	FindByTripID(ctx context.Context, tripID trip.TripID) ([]*Revision, error)
+}
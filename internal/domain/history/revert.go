@@ -0,0 +1,46 @@
+package history
+
+import "encoding/json"
+
+// TargetState は以前のリビジョンに戻すときの、1 つのリソースの戻す先の状態。Snapshot が nil の場合はリソースが存在しない状態を表す
+type TargetState struct {
+	ResourceType ResourceType
+	ResourceID   string
+	Snapshot     json.RawMessage
+}
+
+// PlanRevert はリビジョン to の時点の状態に戻すために、to より後に変更されたリソースごとの戻す先の状態を返す。
+// 各リソースの戻す先は、to より後の最初の変更の変更前の状態になる。revisions は旅行のすべてのリビジョンを新しい順に並べたもの
+func PlanRevert(revisions []*Revision, to int64) ([]TargetState, error) {
+	found := false
+	var later []*Revision
+	for _, r := range revisions {
+		if r.Number() == to {
+			found = true
+		}
+		if r.Number() > to {
+			later = append(later, r)
+		}
+	}
+	if !found {
+		return nil, NewRevisionNotFoundError()
+	}
+
+	seen := make(map[resourceRef]struct{})
+	var targets []TargetState
+	for i := len(later) - 1; i >= 0; i-- {
+		for _, c := range later[i].Changes() {
+			ref := resourceRef{c.ResourceType(), c.ResourceID()}
+			if _, ok := seen[ref]; ok {
+				continue
+			}
+			seen[ref] = struct{}{}
+			targets = append(targets, TargetState{
+				ResourceType: c.ResourceType(),
+				ResourceID:   c.ResourceID(),
+				Snapshot:     c.Before(),
+			})
+		}
+	}
+	return targets, nil
+}
@@ -0,0 +1,68 @@
+package history
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRevision(number int64, changes ...Change) *Revision {
+	return NewRevision(trip.NewTripID("trip-id"), number, user.NewUserID("user-id"), nil, changes, time.Now())
+}
+
+func TestPlanRevert(t *testing.T) {
+	v1 := json.RawMessage(`{"name":"v1"}`)
+	v2 := json.RawMessage(`{"name":"v2"}`)
+	v3 := json.RawMessage(`{"name":"v3"}`)
+	hotel := json.RawMessage(`{"name":"ホテル"}`)
+
+	// 新しい順に並べる
+	revisions := []*Revision{
+		newTestRevision(4, RestoreChange(ResourceTypeAccommodation, "acc-id", ActionDeleted, hotel, nil)),
+		newTestRevision(3,
+			RestoreChange(ResourceTypeTrip, "trip-id", ActionUpdated, v2, v3),
+			RestoreChange(ResourceTypeAccommodation, "acc-id", ActionCreated, nil, hotel),
+		),
+		newTestRevision(2, RestoreChange(ResourceTypeTrip, "trip-id", ActionUpdated, v1, v2)),
+		newTestRevision(1, RestoreChange(ResourceTypeTrip, "trip-id", ActionCreated, nil, v1)),
+	}
+
+	t.Run("正常系: 各リソースを指定したリビジョンの直後の変更前の状態に戻す", func(t *testing.T) {
+		got, err := PlanRevert(revisions, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, []TargetState{
+			{ResourceType: ResourceTypeTrip, ResourceID: "trip-id", Snapshot: v2},
+			{ResourceType: ResourceTypeAccommodation, ResourceID: "acc-id", Snapshot: nil},
+		}, got)
+	})
+
+	t.Run("正常系: 途中で削除されたリソースは削除前の状態に戻す", func(t *testing.T) {
+		got, err := PlanRevert(revisions, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, []TargetState{
+			{ResourceType: ResourceTypeAccommodation, ResourceID: "acc-id", Snapshot: hotel},
+		}, got)
+	})
+
+	t.Run("正常系: 最新のリビジョンを指定した場合は何も戻さない", func(t *testing.T) {
+		got, err := PlanRevert(revisions, 4)
+
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("異常系: 存在しないリビジョン", func(t *testing.T) {
+		for _, to := range []int64{0, 5} {
+			_, err := PlanRevert(revisions, to)
+
+			assert.ErrorIs(t, err, NewRevisionNotFoundError())
+		}
+	})
+}
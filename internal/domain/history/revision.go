@@ -0,0 +1,182 @@
+package history
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// ResourceType は変更履歴を記録するリソースの種類
+type ResourceType string
+
+const (
+	ResourceTypeTrip          ResourceType = "trip"
+	ResourceTypeAccommodation ResourceType = "accommodation"
+	ResourceTypeExpense       ResourceType = "expense"
+	ResourceTypeBudget        ResourceType = "budget"
+	ResourceTypeChecklist     ResourceType = "checklist"
+	ResourceTypeJournalEntry  ResourceType = "journal_entry"
+	ResourceTypeActivity      ResourceType = "activity"
+	ResourceTypeTransportLeg  ResourceType = "transport_leg"
+)
+
+func (t ResourceType) String() string {
+	return string(t)
+}
+
+// Action はリソースに対する変更の種類
+type Action string
+
+const (
+	ActionCreated Action = "created"
+	ActionUpdated Action = "updated"
+	ActionDeleted Action = "deleted"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// Change は 1 つのリソースに対する変更。before と after は変更前後のスナップショットで、
+// 作成時の before と削除時の after は nil になる
+type Change struct {
+	resourceType ResourceType
+	resourceID   string
+	action       Action
+	before       json.RawMessage
+	after        json.RawMessage
+}
+
+// RestoreChange は保存された変更を復元する
+func RestoreChange(resourceType ResourceType, resourceID string, action Action, before, after json.RawMessage) Change {
+	return Change{
+		resourceType: resourceType,
+		resourceID:   resourceID,
+		action:       action,
+		before:       before,
+		after:        after,
+	}
+}
+
+// NewCreatedChange はリソースの作成を表す変更を作成する
+func NewCreatedChange(resource any) (Change, error) {
+	ref, after, err := snapshotOf(resource)
+	if err != nil {
+		return Change{}, err
+	}
+	return RestoreChange(ref.resourceType, ref.resourceID, ActionCreated, nil, after), nil
+}
+
+// NewUpdatedChange はリソースの更新を表す変更を作成する。before と after は同じリソースである必要がある
+func NewUpdatedChange(before, after any) (Change, error) {
+	ref, beforeSnapshot, err := snapshotOf(before)
+	if err != nil {
+		return Change{}, err
+	}
+	afterRef, afterSnapshot, err := snapshotOf(after)
+	if err != nil {
+		return Change{}, err
+	}
+	if ref != afterRef {
+		return Change{}, NewUnsupportedResourceError()
+	}
+	return RestoreChange(ref.resourceType, ref.resourceID, ActionUpdated, beforeSnapshot, afterSnapshot), nil
+}
+
+// NewDeletedChange はリソースの削除を表す変更を作成する
+func NewDeletedChange(resource any) (Change, error) {
+	ref, before, err := snapshotOf(resource)
+	if err != nil {
+		return Change{}, err
+	}
+	return RestoreChange(ref.resourceType, ref.resourceID, ActionDeleted, before, nil), nil
+}
+
+// Getters
+func (c Change) ResourceType() ResourceType { return c.resourceType }
+func (c Change) ResourceID() string         { return c.resourceID }
+func (c Change) Action() Action             { return c.action }
+func (c Change) Before() json.RawMessage    { return c.before }
+func (c Change) After() json.RawMessage     { return c.after }
+
+// ChangedFields は変更前後で値が異なるスナップショットの項目名を名前順に返す
+func (c Change) ChangedFields() []string {
+	before := decodeFields(c.before)
+	after := decodeFields(c.after)
+
+	var fields []string
+	for name, value := range before {
+		if other, ok := after[name]; !ok || !reflect.DeepEqual(value, other) {
+			fields = append(fields, name)
+		}
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func decodeFields(snapshot json.RawMessage) map[string]any {
+	fields := map[string]any{}
+	if snapshot != nil {
+		_ = json.Unmarshal(snapshot, &fields)
+	}
+	return fields
+}
+
+// SameSnapshot は 2 つのスナップショットが同じ内容かどうかを判定する。どちらも nil の場合は同じとみなす
+func SameSnapshot(a, b json.RawMessage) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	var decodedA, decodedB any
+	if json.Unmarshal(a, &decodedA) != nil || json.Unmarshal(b, &decodedB) != nil {
+		return false
+	}
+	return reflect.DeepEqual(decodedA, decodedB)
+}
+
+// Revision は旅行に対する 1 回の操作で行われた変更の集まり。番号は旅行ごとに 1 から順に振られる。
+// 履歴の記録を始める前からある旅行は最初に記録された変更がリビジョン 1 となる。各リビジョンの時点の状態に戻すことができる
+type Revision struct {
+	tripID     trip.TripID
+	number     int64
+	actorID    user.UserID
+	revertedTo *int64
+	changes    []Change
+	createdAt  time.Time
+}
+
+// NewRevision はリビジョンを作成する。revertedTo は以前のリビジョンに戻す操作の場合に戻した先の番号を表す
+func NewRevision(
+	tripID trip.TripID,
+	number int64,
+	actorID user.UserID,
+	revertedTo *int64,
+	changes []Change,
+	createdAt time.Time,
+) *Revision {
+	return &Revision{
+		tripID:     tripID,
+		number:     number,
+		actorID:    actorID,
+		revertedTo: revertedTo,
+		changes:    changes,
+		createdAt:  createdAt,
+	}
+}
+
+// Getters
+func (r *Revision) TripID() trip.TripID  { return r.tripID }
+func (r *Revision) Number() int64        { return r.number }
+func (r *Revision) ActorID() user.UserID { return r.actorID }
+func (r *Revision) RevertedTo() *int64   { return r.revertedTo }
+func (r *Revision) Changes() []Change    { return r.changes }
+func (r *Revision) CreatedAt() time.Time { return r.createdAt }
@@ -0,0 +1,97 @@
+package history
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTrip(t *testing.T, name string, start, end string) *trip.Trip {
+	t.Helper()
+	var period *trip.Period
+	if start != "" {
+		startDate, err := time.Parse(dateLayout, start)
+		require.NoError(t, err)
+		endDate, err := time.Parse(dateLayout, end)
+		require.NoError(t, err)
+		period, err = trip.NewPeriod(startDate, endDate)
+		require.NoError(t, err)
+	}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return trip.NewTrip(trip.NewTripID("trip-id"), name, period, "", now, now)
+}
+
+func TestNewCreatedChange(t *testing.T) {
+	tr := newTestTrip(t, "沖縄旅行", "2024-05-01", "2024-05-03")
+
+	c, err := NewCreatedChange(tr)
+
+	require.NoError(t, err)
+	assert.Equal(t, ResourceTypeTrip, c.ResourceType())
+	assert.Equal(t, "trip-id", c.ResourceID())
+	assert.Equal(t, ActionCreated, c.Action())
+	assert.Nil(t, c.Before(), "作成時は変更前の状態を持たない")
+	assert.JSONEq(t, `{"name":"沖縄旅行","start_date":"2024-05-01","end_date":"2024-05-03"}`, string(c.After()))
+	assert.Equal(t, []string{"end_date", "name", "start_date"}, c.ChangedFields())
+}
+
+func TestNewUpdatedChange(t *testing.T) {
+	before := newTestTrip(t, "沖縄旅行", "2024-05-01", "2024-05-03")
+	after := before.Update("沖縄旅行", nil, "", time.Now())
+
+	c, err := NewUpdatedChange(before, after)
+
+	require.NoError(t, err)
+	assert.Equal(t, ActionUpdated, c.Action())
+	assert.Equal(t, []string{"end_date", "start_date"}, c.ChangedFields(), "値が変わった項目だけを返す")
+}
+
+func TestNewUpdatedChange_DifferentResources(t *testing.T) {
+	before := newTestTrip(t, "沖縄旅行", "", "")
+	other := trip.NewTrip(trip.NewTripID("other-id"), "別の旅行", nil, "", time.Now(), time.Now())
+
+	_, err := NewUpdatedChange(before, other)
+
+	assert.Error(t, err, "異なるリソースの更新は記録できない")
+}
+
+func TestNewDeletedChange(t *testing.T) {
+	tr := newTestTrip(t, "沖縄旅行", "", "")
+
+	c, err := NewDeletedChange(tr)
+
+	require.NoError(t, err)
+	assert.Equal(t, ActionDeleted, c.Action())
+	assert.NotNil(t, c.Before())
+	assert.Nil(t, c.After(), "削除時は変更後の状態を持たない")
+}
+
+func TestNewCreatedChange_UnsupportedResource(t *testing.T) {
+	_, err := NewCreatedChange("not a resource")
+
+	assert.ErrorIs(t, err, NewUnsupportedResourceError())
+}
+
+func TestSameSnapshot(t *testing.T) {
+	tests := []struct {
+		name string
+		a    json.RawMessage
+		b    json.RawMessage
+		want bool
+	}{
+		{"項目の順序や空白が異なっても同じ内容なら同じ", json.RawMessage(`{"a":1,"b":"x"}`), json.RawMessage(`{"b": "x", "a": 1}`), true},
+		{"値が異なれば異なる", json.RawMessage(`{"a":1}`), json.RawMessage(`{"a":2}`), false},
+		{"どちらも存在しなければ同じ", nil, nil, true},
+		{"片方だけ存在しなければ異なる", json.RawMessage(`{"a":1}`), nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SameSnapshot(tt.a, tt.b))
+		})
+	}
+}
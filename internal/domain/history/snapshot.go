@@ -0,0 +1,533 @@
+package history
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// dateLayout はスナップショットで日付を表す形式
+const dateLayout = "2006-01-02"
+
+// TripSnapshot は変更履歴に記録する旅行の内容。バージョンや更新日時は履歴から戻す対象にならないため含めない
+type TripSnapshot struct {
+	Name         string  `json:"name"`
+	StartDate    *string `json:"start_date"`
+	EndDate      *string `json:"end_date"`
+	HomeCurrency string  `json:"home_currency,omitempty"`
+}
+
+func NewTripSnapshot(t *trip.Trip) TripSnapshot {
+	s := TripSnapshot{Name: t.Name(), HomeCurrency: t.HomeCurrency()}
+	if period := t.Period(); period != nil {
+		startDate := period.StartDate().Format(dateLayout)
+		endDate := period.EndDate().Format(dateLayout)
+		s.StartDate = &startDate
+		s.EndDate = &endDate
+	}
+	return s
+}
+
+// Period はスナップショットの旅行期間を返す。期間未定の場合は nil を返す
+func (s TripSnapshot) Period() (*trip.Period, error) {
+	if s.StartDate == nil || s.EndDate == nil {
+		return nil, nil
+	}
+	startDate, err := time.Parse(dateLayout, *s.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := time.Parse(dateLayout, *s.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	return trip.NewPeriod(startDate, endDate)
+}
+
+// AccommodationSnapshot は変更履歴に記録する宿泊予約の内容
+type AccommodationSnapshot struct {
+	Name               string    `json:"name"`
+	Address            string    `json:"address"`
+	Timezone           *string   `json:"timezone,omitempty"`
+	CheckInAt          time.Time `json:"check_in_at"`
+	CheckOutAt         time.Time `json:"check_out_at"`
+	ConfirmationNumber string    `json:"confirmation_number"`
+	CostAmount         int64     `json:"cost_amount"`
+	CostCurrency       string    `json:"cost_currency"`
+	Notes              string    `json:"notes"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+func NewAccommodationSnapshot(a *accommodation.Accommodation) AccommodationSnapshot {
+	return AccommodationSnapshot{
+		Name:               a.Name(),
+		Address:            a.Address(),
+		Timezone:           timezoneSnapshot(a.Timezone()),
+		CheckInAt:          a.Stay().CheckInAt(),
+		CheckOutAt:         a.Stay().CheckOutAt(),
+		ConfirmationNumber: a.ConfirmationNumber(),
+		CostAmount:         a.Cost().Amount(),
+		CostCurrency:       a.Cost().Currency(),
+		Notes:              a.Notes(),
+		CreatedAt:          a.CreatedAt(),
+	}
+}
+
+// ToAccommodation はスナップショットの内容の宿泊予約を作成する
+func (s AccommodationSnapshot) ToAccommodation(id accommodation.AccommodationID, tripID trip.TripID, updatedAt time.Time) (*accommodation.Accommodation, error) {
+	stay, err := accommodation.NewStay(s.CheckInAt, s.CheckOutAt)
+	if err != nil {
+		return nil, err
+	}
+	cost, err := money.NewMoney(s.CostAmount, s.CostCurrency)
+	if err != nil {
+		return nil, err
+	}
+	timezone, err := toTimezone(s.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	return accommodation.NewAccommodation(id, tripID, s.Name, s.Address, timezone, stay, s.ConfirmationNumber, cost, s.Notes, s.CreatedAt, updatedAt), nil
+}
+
+// ExpenseSnapshot は変更履歴に記録する支出の内容
+type ExpenseSnapshot struct {
+	Amount      int64          `json:"amount"`
+	Currency    string         `json:"currency"`
+	Category    string         `json:"category"`
+	SpentOn     string         `json:"spent_on"`
+	Payer       string         `json:"payer"`
+	Description string         `json:"description"`
+	ActivityID  *string        `json:"activity_id"`
+	Split       *SplitSnapshot `json:"split"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+type SplitSnapshot struct {
+	Method  string               `json:"method"`
+	Entries []SplitEntrySnapshot `json:"entries"`
+}
+
+type SplitEntrySnapshot struct {
+	Participant string `json:"participant"`
+	Value       int64  `json:"value"`
+}
+
+func NewExpenseSnapshot(e *expense.Expense) ExpenseSnapshot {
+	s := ExpenseSnapshot{
+		Amount:      e.Amount().Amount(),
+		Currency:    e.Amount().Currency(),
+		Category:    e.Category().String(),
+		SpentOn:     e.SpentOn().Format(dateLayout),
+		Payer:       e.Payer(),
+		Description: e.Description(),
+		ActivityID:  e.ActivityID(),
+		CreatedAt:   e.CreatedAt(),
+	}
+	if split := e.Split(); split != nil {
+		entries := make([]SplitEntrySnapshot, 0, len(split.Entries()))
+		for _, entry := range split.Entries() {
+			entries = append(entries, SplitEntrySnapshot{Participant: entry.Participant(), Value: entry.Value()})
+		}
+		s.Split = &SplitSnapshot{Method: split.Method().String(), Entries: entries}
+	}
+	return s
+}
+
+// ToExpense はスナップショットの内容の支出を作成する
+func (s ExpenseSnapshot) ToExpense(id expense.ExpenseID, tripID trip.TripID, updatedAt time.Time) (*expense.Expense, error) {
+	amount, err := money.NewMoney(s.Amount, s.Currency)
+	if err != nil {
+		return nil, err
+	}
+	category, err := expense.ParseCategory(s.Category)
+	if err != nil {
+		return nil, err
+	}
+	spentOn, err := time.Parse(dateLayout, s.SpentOn)
+	if err != nil {
+		return nil, err
+	}
+
+	var split *expense.Split
+	if s.Split != nil {
+		method, err := expense.ParseSplitMethod(s.Split.Method)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]expense.SplitEntry, 0, len(s.Split.Entries))
+		for _, entry := range s.Split.Entries {
+			entries = append(entries, expense.NewSplitEntry(entry.Participant, entry.Value))
+		}
+		if split, err = expense.NewSplit(method, entries); err != nil {
+			return nil, err
+		}
+	}
+
+	return expense.NewExpense(id, tripID, amount, category, spentOn, s.Payer, s.Description, s.ActivityID, split, s.CreatedAt, updatedAt), nil
+}
+
+// BudgetSnapshot は変更履歴に記録する予算の内容。上限額はカテゴリごとの基準通貨の最小単位の金額
+type BudgetSnapshot struct {
+	Currency       string           `json:"currency"`
+	CategoryLimits map[string]int64 `json:"category_limits"`
+	CreatedAt      time.Time        `json:"created_at"`
+}
+
+func NewBudgetSnapshot(b *budget.Budget) BudgetSnapshot {
+	limits := make(map[string]int64)
+	for category, limit := range b.CategoryLimits() {
+		limits[category.String()] = limit.Amount()
+	}
+	return BudgetSnapshot{
+		Currency:       b.Currency(),
+		CategoryLimits: limits,
+		CreatedAt:      b.CreatedAt(),
+	}
+}
+
+// ToBudget はスナップショットの内容の予算を作成する
+func (s BudgetSnapshot) ToBudget(tripID trip.TripID, updatedAt time.Time) (*budget.Budget, error) {
+	limits := make(map[expense.Category]int64, len(s.CategoryLimits))
+	for rawCategory, limit := range s.CategoryLimits {
+		category, err := expense.ParseCategory(rawCategory)
+		if err != nil {
+			return nil, err
+		}
+		limits[category] = limit
+	}
+	return budget.NewBudget(tripID, s.Currency, limits, s.CreatedAt, updatedAt)
+}
+
+// ChecklistSnapshot は変更履歴に記録するチェックリストの内容
+type ChecklistSnapshot struct {
+	Name      string                  `json:"name"`
+	Kind      string                  `json:"kind"`
+	Items     []ChecklistItemSnapshot `json:"items"`
+	CreatedAt time.Time               `json:"created_at"`
+}
+
+type ChecklistItemSnapshot struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	Quantity   int     `json:"quantity"`
+	Checked    bool    `json:"checked"`
+	AssigneeID *string `json:"assignee_id"`
+}
+
+func NewChecklistSnapshot(c *checklist.Checklist) ChecklistSnapshot {
+	items := make([]ChecklistItemSnapshot, 0, len(c.Items()))
+	for _, item := range c.Items() {
+		s := ChecklistItemSnapshot{
+			ID:       item.ID().String(),
+			Name:     item.Name(),
+			Quantity: item.Quantity(),
+			Checked:  item.Checked(),
+		}
+		if assigneeID := item.AssigneeID(); assigneeID != nil {
+			id := assigneeID.String()
+			s.AssigneeID = &id
+		}
+		items = append(items, s)
+	}
+	return ChecklistSnapshot{
+		Name:      c.Name(),
+		Kind:      c.Kind().String(),
+		Items:     items,
+		CreatedAt: c.CreatedAt(),
+	}
+}
+
+// ToChecklist はスナップショットの内容のチェックリストを作成する
+func (s ChecklistSnapshot) ToChecklist(id checklist.ChecklistID, tripID trip.TripID, updatedAt time.Time) (*checklist.Checklist, error) {
+	kind, err := checklist.ParseKind(s.Kind)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]checklist.Item, 0, len(s.Items))
+	for _, itemSnapshot := range s.Items {
+		var assigneeID *user.UserID
+		if itemSnapshot.AssigneeID != nil {
+			id := user.NewUserID(*itemSnapshot.AssigneeID)
+			assigneeID = &id
+		}
+		item, err := checklist.NewItem(checklist.NewItemID(itemSnapshot.ID), itemSnapshot.Name, itemSnapshot.Quantity, itemSnapshot.Checked, assigneeID)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return checklist.NewChecklist(id, tripID, s.Name, kind, items, s.CreatedAt, updatedAt)
+}
+
+// JournalEntrySnapshot は変更履歴に記録する日記の内容
+type JournalEntrySnapshot struct {
+	Date      string         `json:"date"`
+	Title     string         `json:"title"`
+	Body      string         `json:"body"`
+	Mood      string         `json:"mood"`
+	Place     *PlaceSnapshot `json:"place"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// PlaceSnapshot の timezone はタイムゾーンを記録する前の変更履歴にはない
+type PlaceSnapshot struct {
+	Name      string   `json:"name"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	Timezone  *string  `json:"timezone,omitempty"`
+}
+
+func NewJournalEntrySnapshot(e *journal.Entry) JournalEntrySnapshot {
+	s := JournalEntrySnapshot{
+		Date:      e.Date().Format(dateLayout),
+		Title:     e.Title(),
+		Body:      e.Body(),
+		Mood:      e.Mood().String(),
+		CreatedAt: e.CreatedAt(),
+	}
+	s.Place = newPlaceSnapshot(e.Place())
+	return s
+}
+
+// ToJournalEntry はスナップショットの内容の日記を作成する
+func (s JournalEntrySnapshot) ToJournalEntry(id journal.EntryID, tripID trip.TripID, updatedAt time.Time) (*journal.Entry, error) {
+	date, err := time.Parse(dateLayout, s.Date)
+	if err != nil {
+		return nil, err
+	}
+	mood, err := journal.ParseMood(s.Mood)
+	if err != nil {
+		return nil, err
+	}
+
+	place, err := s.Place.toPlace()
+	if err != nil {
+		return nil, err
+	}
+
+	return journal.NewEntry(id, tripID, date, s.Title, s.Body, mood, place, s.CreatedAt, updatedAt), nil
+}
+
+// ActivitySnapshot は変更履歴に記録する行程の行動の内容。timezone はタイムゾーンを記録する前の変更履歴にはない
+type ActivitySnapshot struct {
+	Date       string         `json:"date"`
+	Position   int            `json:"position"`
+	Title      string         `json:"title"`
+	Place      *PlaceSnapshot `json:"place"`
+	Timezone   *string        `json:"timezone,omitempty"`
+	StartAt    *time.Time     `json:"start_at"`
+	EndAt      *time.Time     `json:"end_at"`
+	TravelMode string         `json:"travel_mode"`
+	Notes      string         `json:"notes"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+func NewActivitySnapshot(a *itinerary.Activity) ActivitySnapshot {
+	return ActivitySnapshot{
+		Date:       a.Date().Format(dateLayout),
+		Position:   a.Position(),
+		Title:      a.Title(),
+		Place:      newPlaceSnapshot(a.Place()),
+		Timezone:   timezoneSnapshot(a.Timezone()),
+		StartAt:    a.StartAt(),
+		EndAt:      a.EndAt(),
+		TravelMode: a.TravelMode().String(),
+		Notes:      a.Notes(),
+		CreatedAt:  a.CreatedAt(),
+	}
+}
+
+// ToActivity はスナップショットの内容の行動を作成する
+func (s ActivitySnapshot) ToActivity(id itinerary.ActivityID, tripID trip.TripID, updatedAt time.Time) (*itinerary.Activity, error) {
+	date, err := time.Parse(dateLayout, s.Date)
+	if err != nil {
+		return nil, err
+	}
+	mode, err := itinerary.ParseTravelMode(s.TravelMode)
+	if err != nil {
+		return nil, err
+	}
+	place, err := s.Place.toPlace()
+	if err != nil {
+		return nil, err
+	}
+	timezone, err := toTimezone(s.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	return itinerary.NewActivity(id, tripID, date, s.Position, s.Title, place, timezone, s.StartAt, s.EndAt, mode, s.Notes, s.CreatedAt, updatedAt)
+}
+
+// TransportLegSnapshot は変更履歴に記録する移動の内容
+type TransportLegSnapshot struct {
+	Mode        string        `json:"mode"`
+	Origin      PlaceSnapshot `json:"origin"`
+	Destination PlaceSnapshot `json:"destination"`
+	DepartureAt time.Time     `json:"departure_at"`
+	ArrivalAt   time.Time     `json:"arrival_at"`
+	Carrier     string        `json:"carrier"`
+	Number      string        `json:"number"`
+	Notes       string        `json:"notes"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+func NewTransportLegSnapshot(l *transport.Leg) TransportLegSnapshot {
+	origin, destination := l.Origin(), l.Destination()
+	return TransportLegSnapshot{
+		Mode:        l.Mode().String(),
+		Origin:      *newPlaceSnapshot(&origin),
+		Destination: *newPlaceSnapshot(&destination),
+		DepartureAt: l.DepartureAt(),
+		ArrivalAt:   l.ArrivalAt(),
+		Carrier:     l.Carrier(),
+		Number:      l.Number(),
+		Notes:       l.Notes(),
+		CreatedAt:   l.CreatedAt(),
+	}
+}
+
+// ToTransportLeg はスナップショットの内容の移動を作成する
+func (s TransportLegSnapshot) ToTransportLeg(id transport.LegID, tripID trip.TripID, updatedAt time.Time) (*transport.Leg, error) {
+	mode, err := transport.ParseMode(s.Mode)
+	if err != nil {
+		return nil, err
+	}
+	origin, err := s.Origin.toPlace()
+	if err != nil {
+		return nil, err
+	}
+	destination, err := s.Destination.toPlace()
+	if err != nil {
+		return nil, err
+	}
+	return transport.NewLeg(id, tripID, mode, *origin, *destination, s.DepartureAt, s.ArrivalAt, s.Carrier, s.Number, s.Notes, s.CreatedAt, updatedAt)
+}
+
+// newPlaceSnapshot は場所のスナップショットを作成する。場所がない場合は nil を返す
+func newPlaceSnapshot(place *geo.Place) *PlaceSnapshot {
+	if place == nil {
+		return nil
+	}
+	s := &PlaceSnapshot{Name: place.Name(), Timezone: timezoneSnapshot(place.Timezone())}
+	if c := place.Coordinate(); c != nil {
+		latitude, longitude := c.Latitude(), c.Longitude()
+		s.Latitude, s.Longitude = &latitude, &longitude
+	}
+	return s
+}
+
+// toPlace はスナップショットの内容の場所を作成する。スナップショットが nil の場合は nil を返す
+func (s *PlaceSnapshot) toPlace() (*geo.Place, error) {
+	if s == nil {
+		return nil, nil
+	}
+	var coordinate *geo.Coordinate
+	if s.Latitude != nil && s.Longitude != nil {
+		c, err := geo.NewCoordinate(*s.Latitude, *s.Longitude)
+		if err != nil {
+			return nil, err
+		}
+		coordinate = &c
+	}
+	timezone, err := toTimezone(s.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	p, err := geo.NewPlace(s.Name, coordinate, timezone)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// timezoneSnapshot はタイムゾーンを名前で記録する。タイムゾーンがない場合は nil を返す
+func timezoneSnapshot(timezone *geo.Timezone) *string {
+	if timezone == nil {
+		return nil
+	}
+	name := timezone.Name()
+	return &name
+}
+
+// toTimezone は記録したタイムゾーン名からタイムゾーンを作成する。名前がない場合は nil を返す
+func toTimezone(name *string) (*geo.Timezone, error) {
+	if name == nil {
+		return nil, nil
+	}
+	timezone, err := geo.NewTimezone(*name)
+	if err != nil {
+		return nil, err
+	}
+	return &timezone, nil
+}
+
+// resourceRef は変更履歴上でリソースを識別する組
+type resourceRef struct {
+	resourceType ResourceType
+	resourceID   string
+}
+
+// snapshotOf はリソースの種類と ID、スナップショットを返す。予算は旅行ごとに 1 つのため旅行の ID で識別する
+func snapshotOf(resource any) (resourceRef, json.RawMessage, error) {
+	var ref resourceRef
+	var snapshot any
+	switch r := resource.(type) {
+	case *trip.Trip:
+		ref = resourceRef{ResourceTypeTrip, r.ID().String()}
+		snapshot = NewTripSnapshot(r)
+	case *accommodation.Accommodation:
+		ref = resourceRef{ResourceTypeAccommodation, r.ID().String()}
+		snapshot = NewAccommodationSnapshot(r)
+	case *expense.Expense:
+		ref = resourceRef{ResourceTypeExpense, r.ID().String()}
+		snapshot = NewExpenseSnapshot(r)
+	case *budget.Budget:
+		ref = resourceRef{ResourceTypeBudget, r.TripID().String()}
+		snapshot = NewBudgetSnapshot(r)
+	case *checklist.Checklist:
+		ref = resourceRef{ResourceTypeChecklist, r.ID().String()}
+		snapshot = NewChecklistSnapshot(r)
+	case *journal.Entry:
+		ref = resourceRef{ResourceTypeJournalEntry, r.ID().String()}
+		snapshot = NewJournalEntrySnapshot(r)
+	case *itinerary.Activity:
+		ref = resourceRef{ResourceTypeActivity, r.ID().String()}
+		snapshot = NewActivitySnapshot(r)
+	case *transport.Leg:
+		ref = resourceRef{ResourceTypeTransportLeg, r.ID().String()}
+		snapshot = NewTransportLegSnapshot(r)
+	default:
+		return resourceRef{}, nil, NewUnsupportedResourceError()
+	}
+
+	encoded, err := json.Marshal(snapshot)
+	if err != nil {
+		return resourceRef{}, nil, err
+	}
+	return ref, encoded, nil
+}
+
+// EncodeSnapshot はリソースのスナップショットを返す。現在の状態と戻す先の状態を比べるのに使う
+func EncodeSnapshot(resource any) (json.RawMessage, error) {
+	_, snapshot, err := snapshotOf(resource)
+	return snapshot, err
+}
+
+// DecodeSnapshot はスナップショットをリソースの種類に応じた構造体に復元する
+func DecodeSnapshot[T TripSnapshot | AccommodationSnapshot | ExpenseSnapshot | BudgetSnapshot | ChecklistSnapshot | JournalEntrySnapshot | ActivitySnapshot | TransportLegSnapshot](snapshot json.RawMessage) (T, error) {
+	var s T
+	err := json.Unmarshal(snapshot, &s)
+	return s, err
+}
@@ -0,0 +1,236 @@
+package history
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var snapshotTestTime = time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+// roundTrip はスナップショットを JSON を経由して復元する
+func roundTrip[T TripSnapshot | AccommodationSnapshot | ExpenseSnapshot | BudgetSnapshot | ChecklistSnapshot | JournalEntrySnapshot | ActivitySnapshot | TransportLegSnapshot](t *testing.T, snapshot T) T {
+	t.Helper()
+	encoded, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+	decoded, err := DecodeSnapshot[T](encoded)
+	require.NoError(t, err)
+	return decoded
+}
+
+func TestTripSnapshot_Period(t *testing.T) {
+	t.Run("正常系: 期間を復元できる", func(t *testing.T) {
+		tr := newTestTrip(t, "旅行", "2024-05-01", "2024-05-03")
+
+		period, err := roundTrip(t, NewTripSnapshot(tr)).Period()
+
+		require.NoError(t, err)
+		assert.True(t, tr.Period().Equals(period))
+	})
+
+	t.Run("正常系: 期間未定の旅行は nil を返す", func(t *testing.T) {
+		period, err := roundTrip(t, NewTripSnapshot(newTestTrip(t, "旅行", "", ""))).Period()
+
+		require.NoError(t, err)
+		assert.Nil(t, period)
+	})
+}
+
+func TestNewTripSnapshot_HomeCurrency(t *testing.T) {
+	t.Run("正常系: 基準通貨を記録する", func(t *testing.T) {
+		tr := trip.NewTrip(trip.NewTripID("trip-id"), "旅行", nil, "JPY", snapshotTestTime, snapshotTestTime)
+
+		assert.Equal(t, "JPY", roundTrip(t, NewTripSnapshot(tr)).HomeCurrency)
+	})
+
+	t.Run("正常系: 基準通貨が未設定の旅行は空文字を記録する", func(t *testing.T) {
+		assert.Empty(t, roundTrip(t, NewTripSnapshot(newTestTrip(t, "旅行", "", ""))).HomeCurrency)
+	})
+}
+
+func TestAccommodationSnapshot_ToAccommodation(t *testing.T) {
+	stay, err := accommodation.NewStay(snapshotTestTime.Add(6*time.Hour), snapshotTestTime.Add(24*time.Hour))
+	require.NoError(t, err)
+	cost, err := money.NewMoney(15000, "JPY")
+	require.NoError(t, err)
+	tz, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	original := accommodation.NewAccommodation(
+		accommodation.NewAccommodationID("acc-id"), trip.NewTripID("trip-id"),
+		"ホテル", "那覇市", &tz, stay, "ABC123", cost, "朝食付き", snapshotTestTime, snapshotTestTime,
+	)
+	updatedAt := snapshotTestTime.Add(48 * time.Hour)
+
+	restored, err := roundTrip(t, NewAccommodationSnapshot(original)).ToAccommodation(original.ID(), original.TripID(), updatedAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, NewAccommodationSnapshot(original), NewAccommodationSnapshot(restored))
+	assert.Equal(t, "Asia/Tokyo", restored.Timezone().Name())
+	assert.Equal(t, updatedAt, restored.UpdatedAt())
+}
+
+func TestExpenseSnapshot_ToExpense(t *testing.T) {
+	amount, err := money.NewMoney(3000, "JPY")
+	require.NoError(t, err)
+	split, err := expense.NewSplit(expense.SplitMethodShares, []expense.SplitEntry{
+		expense.NewSplitEntry("alice", 2),
+		expense.NewSplitEntry("bob", 1),
+	})
+	require.NoError(t, err)
+	activityID := "activity-id"
+	original := expense.NewExpense(
+		expense.NewExpenseID("expense-id"), trip.NewTripID("trip-id"),
+		amount, expense.CategoryFood, snapshotTestTime, "alice", "夕食", &activityID, split, snapshotTestTime, snapshotTestTime,
+	)
+
+	restored, err := roundTrip(t, NewExpenseSnapshot(original)).ToExpense(original.ID(), original.TripID(), snapshotTestTime)
+
+	require.NoError(t, err)
+	assert.Equal(t, NewExpenseSnapshot(original), NewExpenseSnapshot(restored))
+	assert.True(t, split.Equals(restored.Split()))
+}
+
+func TestBudgetSnapshot_ToBudget(t *testing.T) {
+	original, err := budget.NewBudget(trip.NewTripID("trip-id"), "JPY", map[expense.Category]int64{
+		expense.CategoryFood:      10000,
+		expense.CategoryTransport: 20000,
+	}, snapshotTestTime, snapshotTestTime)
+	require.NoError(t, err)
+
+	restored, err := roundTrip(t, NewBudgetSnapshot(original)).ToBudget(original.TripID(), snapshotTestTime)
+
+	require.NoError(t, err)
+	assert.Equal(t, NewBudgetSnapshot(original), NewBudgetSnapshot(restored))
+}
+
+func TestChecklistSnapshot_ToChecklist(t *testing.T) {
+	assignee := user.NewUserID("user-id")
+	passport, err := checklist.NewItem(checklist.NewItemID("item-1"), "パスポート", 1, true, &assignee)
+	require.NoError(t, err)
+	socks, err := checklist.NewItem(checklist.NewItemID("item-2"), "靴下", 3, false, nil)
+	require.NoError(t, err)
+	original, err := checklist.NewChecklist(
+		checklist.NewChecklistID("checklist-id"), trip.NewTripID("trip-id"),
+		"持ち物", checklist.KindPacking, []checklist.Item{passport, socks}, snapshotTestTime, snapshotTestTime,
+	)
+	require.NoError(t, err)
+	updatedAt := snapshotTestTime.Add(48 * time.Hour)
+
+	restored, err := roundTrip(t, NewChecklistSnapshot(original)).ToChecklist(original.ID(), original.TripID(), updatedAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, NewChecklistSnapshot(original), NewChecklistSnapshot(restored))
+	assert.Equal(t, updatedAt, restored.UpdatedAt())
+}
+
+func TestJournalEntrySnapshot_ToJournalEntry(t *testing.T) {
+	t.Run("正常系: 座標とタイムゾーン付きの場所を含めて復元できる", func(t *testing.T) {
+		c, err := geo.NewCoordinate(35.0394, 135.7292)
+		require.NoError(t, err)
+		tz, err := geo.NewTimezone("Asia/Tokyo")
+		require.NoError(t, err)
+		place, err := geo.NewPlace("金閣寺", &c, &tz)
+		require.NoError(t, err)
+		original := journal.NewEntry(
+			journal.NewEntryID("entry-id"), trip.NewTripID("trip-id"), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			"1日目", "**晴れ**", journal.MoodGreat, &place, snapshotTestTime, snapshotTestTime,
+		)
+		updatedAt := snapshotTestTime.Add(48 * time.Hour)
+
+		restored, err := roundTrip(t, NewJournalEntrySnapshot(original)).ToJournalEntry(original.ID(), original.TripID(), updatedAt)
+
+		require.NoError(t, err)
+		assert.Equal(t, NewJournalEntrySnapshot(original), NewJournalEntrySnapshot(restored))
+		assert.Equal(t, "Asia/Tokyo", restored.Place().Timezone().Name())
+		assert.Equal(t, updatedAt, restored.UpdatedAt())
+	})
+
+	t.Run("正常系: タイムゾーンを記録する前の場所はタイムゾーンなしで復元できる", func(t *testing.T) {
+		snapshot, err := DecodeSnapshot[JournalEntrySnapshot](json.RawMessage(
+			`{"date":"2024-05-01","title":"1日目","body":"","mood":"neutral","place":{"name":"金閣寺","latitude":null,"longitude":null},"created_at":"2024-05-01T00:00:00Z"}`,
+		))
+		require.NoError(t, err)
+
+		restored, err := snapshot.ToJournalEntry(journal.NewEntryID("entry-id"), trip.NewTripID("trip-id"), snapshotTestTime)
+
+		require.NoError(t, err)
+		require.NotNil(t, restored.Place())
+		assert.Nil(t, restored.Place().Timezone())
+	})
+
+	t.Run("正常系: 場所のない日記を復元できる", func(t *testing.T) {
+		original := journal.NewEntry(
+			journal.NewEntryID("entry-id"), trip.NewTripID("trip-id"), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			"1日目", "", journal.MoodNeutral, nil, snapshotTestTime, snapshotTestTime,
+		)
+
+		restored, err := roundTrip(t, NewJournalEntrySnapshot(original)).ToJournalEntry(original.ID(), original.TripID(), snapshotTestTime)
+
+		require.NoError(t, err)
+		assert.Nil(t, restored.Place())
+	})
+}
+
+func TestActivitySnapshot_ToActivity(t *testing.T) {
+	c, err := geo.NewCoordinate(35.0394, 135.7292)
+	require.NoError(t, err)
+	place, err := geo.NewPlace("金閣寺", &c, nil)
+	require.NoError(t, err)
+	tz, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	startAt := time.Date(2024, 5, 1, 0, 30, 0, 0, time.UTC)
+	original, err := itinerary.NewActivity(
+		itinerary.NewActivityID("activity-id"), trip.NewTripID("trip-id"), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		2, "金閣寺", &place, &tz, &startAt, nil, itinerary.TravelModeTransit, "拝観料 500 円", snapshotTestTime, snapshotTestTime,
+	)
+	require.NoError(t, err)
+	updatedAt := snapshotTestTime.Add(48 * time.Hour)
+
+	restored, err := roundTrip(t, NewActivitySnapshot(original)).ToActivity(original.ID(), original.TripID(), updatedAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, NewActivitySnapshot(original), NewActivitySnapshot(restored))
+	assert.Equal(t, &tz, restored.Timezone())
+	assert.Equal(t, updatedAt, restored.UpdatedAt())
+}
+
+func TestTransportLegSnapshot_ToTransportLeg(t *testing.T) {
+	tokyo, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	honolulu, err := geo.NewTimezone("Pacific/Honolulu")
+	require.NoError(t, err)
+	c, err := geo.NewCoordinate(35.5494, 139.7798)
+	require.NoError(t, err)
+	origin, err := geo.NewPlace("羽田空港", &c, &tokyo)
+	require.NoError(t, err)
+	destination, err := geo.NewPlace("ホノルル空港", nil, &honolulu)
+	require.NoError(t, err)
+	departureAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	original, err := transport.NewLeg(
+		transport.NewLegID("leg-id"), trip.NewTripID("trip-id"), transport.ModeFlight, origin, destination,
+		departureAt, departureAt.Add(7*time.Hour), "JAL", "JL74", "窓側の席", snapshotTestTime, snapshotTestTime,
+	)
+	require.NoError(t, err)
+	updatedAt := snapshotTestTime.Add(48 * time.Hour)
+
+	restored, err := roundTrip(t, NewTransportLegSnapshot(original)).ToTransportLeg(original.ID(), original.TripID(), updatedAt)
+
+	require.NoError(t, err)
+	assert.Equal(t, NewTransportLegSnapshot(original), NewTransportLegSnapshot(restored))
+	assert.Equal(t, &honolulu, restored.ArrivalTimezone())
+	assert.Equal(t, updatedAt, restored.UpdatedAt())
+}
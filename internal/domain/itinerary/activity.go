@@ -0,0 +1,166 @@
+package itinerary
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// Activity は旅行のある日の行程に含まれる 1 つの行動（観光・食事・移動先など）を表現するエンティティ。
+// 同じ日の行動は position の小さい順に巡る
+type Activity struct {
+	id         ActivityID
+	tripID     trip.TripID
+	date       time.Time
+	position   int
+	title      string
+	place      *geo.Place
+	timezone   *geo.Timezone
+	startAt    *time.Time
+	endAt      *time.Time
+	travelMode TravelMode
+	notes      string
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+// NewActivity は新しい行動を作成する。日付は時刻を切り捨てたUTCの日付として扱う。
+// 場所・タイムゾーン・開始・終了日時が決まっていない場合は nil を渡す。タイムゾーンが nil の場合は場所のタイムゾーンを使う。
+// 終了日時は開始日時より前であってはならない
+func NewActivity(
+	id ActivityID,
+	tripID trip.TripID,
+	date time.Time,
+	position int,
+	title string,
+	place *geo.Place,
+	timezone *geo.Timezone,
+	startAt, endAt *time.Time,
+	travelMode TravelMode,
+	notes string,
+	createdAt, updatedAt time.Time,
+) (*Activity, error) {
+	if strings.TrimSpace(title) == "" {
+		return nil, NewEmptyTitleError()
+	}
+	if startAt != nil && endAt != nil && endAt.Before(*startAt) {
+		return nil, NewInvalidTimeRangeError()
+	}
+	if timezone == nil && place != nil {
+		timezone = place.Timezone()
+	}
+
+	return &Activity{
+		id:         id,
+		tripID:     tripID,
+		date:       trip.TruncateToDate(date),
+		position:   position,
+		title:      title,
+		place:      place,
+		timezone:   timezone,
+		startAt:    startAt,
+		endAt:      endAt,
+		travelMode: travelMode,
+		notes:      notes,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}, nil
+}
+
+// Getters
+func (a *Activity) ID() ActivityID          { return a.id }
+func (a *Activity) TripID() trip.TripID     { return a.tripID }
+func (a *Activity) Date() time.Time         { return a.date }
+func (a *Activity) Position() int           { return a.position }
+func (a *Activity) Title() string           { return a.title }
+func (a *Activity) Place() *geo.Place       { return a.place }
+func (a *Activity) Timezone() *geo.Timezone { return a.timezone }
+func (a *Activity) StartAt() *time.Time     { return a.startAt }
+func (a *Activity) EndAt() *time.Time       { return a.endAt }
+func (a *Activity) TravelMode() TravelMode  { return a.travelMode }
+func (a *Activity) Notes() string           { return a.notes }
+func (a *Activity) CreatedAt() time.Time    { return a.createdAt }
+func (a *Activity) UpdatedAt() time.Time    { return a.updatedAt }
+
+// Coordinate は行動の場所の座標を返す。場所か座標が決まっていない場合は nil を返す
+func (a *Activity) Coordinate() *geo.Coordinate {
+	if a.place == nil {
+		return nil
+	}
+	return a.place.Coordinate()
+}
+
+// Update は行動の内容を更新する。並び順は変えない
+func (a *Activity) Update(
+	date time.Time,
+	title string,
+	place *geo.Place,
+	timezone *geo.Timezone,
+	startAt, endAt *time.Time,
+	travelMode TravelMode,
+	notes string,
+	updatedAt time.Time,
+) (*Activity, error) {
+	return NewActivity(a.id, a.tripID, date, a.position, title, place, timezone, startAt, endAt, travelMode, notes, a.createdAt, updatedAt)
+}
+
+// Duplicate は行動を旅行 tripID の新しい行動 id として複製する。日付と開始・終了日時は offsetDays 日ずらす
+func (a *Activity) Duplicate(id ActivityID, tripID trip.TripID, offsetDays int, createdAt time.Time) *Activity {
+	copied := *a
+	copied.id = id
+	copied.tripID = tripID
+	copied.date = a.date.AddDate(0, 0, offsetDays)
+	copied.startAt = shiftDays(a.startAt, offsetDays)
+	copied.endAt = shiftDays(a.endAt, offsetDays)
+	copied.createdAt = createdAt
+	copied.updatedAt = createdAt
+	return &copied
+}
+
+// shiftDays は日時を days 日ずらす。未定の場合は nil を返す
+func shiftDays(t *time.Time, days int) *time.Time {
+	if t == nil {
+		return nil
+	}
+	shifted := t.AddDate(0, 0, days)
+	return &shifted
+}
+
+// MoveTo は行動を同じ日の中の並び順 position に移動する
+func (a *Activity) MoveTo(position int, updatedAt time.Time) *Activity {
+	moved := *a
+	moved.position = position
+	moved.updatedAt = updatedAt
+	return &moved
+}
+
+// ValidateFor は行動の日付が指定された旅行の期間内にあるかを検証する。期間未定の旅行ではどの日付も許容する
+func (a *Activity) ValidateFor(t *trip.Trip) error {
+	if !a.tripID.Equals(t.ID()) {
+		return NewActivityNotFoundError()
+	}
+	if t.Period() != nil && !t.Period().Contains(a.date) {
+		return NewOutsideTripPeriodError()
+	}
+	return nil
+}
+
+// NextPosition は同じ日の行動 sameDay の最後に追加するときの並び順を返す
+func NextPosition(sameDay []*Activity) int {
+	next := 0
+	for _, a := range sameDay {
+		if a.position >= next {
+			next = a.position + 1
+		}
+	}
+	return next
+}
+
+func (a *Activity) Equals(other *Activity) bool {
+	if other == nil {
+		return false
+	}
+	return a.id.Equals(other.id)
+}
@@ -0,0 +1,165 @@
+package itinerary
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPlace(t *testing.T, name string, latitude, longitude float64) *geo.Place {
+	t.Helper()
+	c, err := geo.NewCoordinate(latitude, longitude)
+	require.NoError(t, err)
+	p, err := geo.NewPlace(name, &c, nil)
+	require.NoError(t, err)
+	return &p
+}
+
+func TestNewActivity(t *testing.T) {
+	id := NewActivityID("activity-id-1")
+	tripID := trip.NewTripID("trip-id-1")
+	place := newTestPlace(t, "金閣寺", 35.0394, 135.7292)
+	startAt := time.Date(2024, 5, 1, 1, 0, 0, 0, time.UTC)
+	endAt := startAt.Add(time.Hour)
+	createdAt := time.Now()
+
+	t.Run("正常系", func(t *testing.T) {
+		a, err := NewActivity(id, tripID, time.Date(2024, 5, 1, 21, 30, 0, 0, time.UTC), 2, "金閣寺", place, nil, &startAt, &endAt, TravelModeTransit, "拝観料 500 円", createdAt, createdAt)
+		require.NoError(t, err)
+
+		assert.Equal(t, id, a.ID())
+		assert.Equal(t, tripID, a.TripID())
+		assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), a.Date(), "Date() は時刻を切り捨てた日付を返すべき")
+		assert.Equal(t, 2, a.Position())
+		assert.Equal(t, "金閣寺", a.Title())
+		assert.Equal(t, place, a.Place())
+		assert.Equal(t, &startAt, a.StartAt())
+		assert.Equal(t, &endAt, a.EndAt())
+		assert.Equal(t, TravelModeTransit, a.TravelMode())
+		assert.Equal(t, "拝観料 500 円", a.Notes())
+		assert.Equal(t, place.Coordinate(), a.Coordinate())
+	})
+
+	t.Run("正常系: 場所がなければ座標は nil", func(t *testing.T) {
+		a, err := NewActivity(id, tripID, startAt, 0, "自由時間", nil, nil, nil, nil, TravelModeWalk, "", createdAt, createdAt)
+		require.NoError(t, err)
+		assert.Nil(t, a.Coordinate())
+	})
+
+	t.Run("正常系: タイムゾーンを省略すると場所のタイムゾーンを使う", func(t *testing.T) {
+		tokyo, err := geo.NewTimezone("Asia/Tokyo")
+		require.NoError(t, err)
+		seoul, err := geo.NewTimezone("Asia/Seoul")
+		require.NoError(t, err)
+		placeWithTimezone, err := geo.NewPlace("金閣寺", nil, &tokyo)
+		require.NoError(t, err)
+
+		fromPlace, err := NewActivity(id, tripID, startAt, 0, "金閣寺", &placeWithTimezone, nil, nil, nil, TravelModeWalk, "", createdAt, createdAt)
+		require.NoError(t, err)
+		explicit, err := NewActivity(id, tripID, startAt, 0, "金閣寺", &placeWithTimezone, &seoul, nil, nil, TravelModeWalk, "", createdAt, createdAt)
+		require.NoError(t, err)
+		withoutPlace, err := NewActivity(id, tripID, startAt, 0, "自由時間", nil, nil, nil, nil, TravelModeWalk, "", createdAt, createdAt)
+		require.NoError(t, err)
+
+		assert.Equal(t, &tokyo, fromPlace.Timezone())
+		assert.Equal(t, &seoul, explicit.Timezone(), "指定したタイムゾーンは場所のタイムゾーンより優先されるべき")
+		assert.Nil(t, withoutPlace.Timezone())
+	})
+
+	t.Run("異常系: タイトルが空白のみ", func(t *testing.T) {
+		_, err := NewActivity(id, tripID, startAt, 0, " ", nil, nil, nil, nil, TravelModeWalk, "", createdAt, createdAt)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 終了日時が開始日時より前", func(t *testing.T) {
+		before := startAt.Add(-time.Minute)
+		_, err := NewActivity(id, tripID, startAt, 0, "金閣寺", nil, nil, &startAt, &before, TravelModeWalk, "", createdAt, createdAt)
+		assert.Error(t, err)
+	})
+}
+
+func TestActivity_Update(t *testing.T) {
+	createdAt := time.Now().Add(-time.Hour)
+	a, err := NewActivity(NewActivityID("activity-id-1"), trip.NewTripID("trip-id-1"), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), 3, "金閣寺", nil, nil, nil, nil, TravelModeWalk, "", createdAt, createdAt)
+	require.NoError(t, err)
+	updatedAt := time.Now()
+
+	updated, err := a.Update(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), "銀閣寺", nil, nil, nil, nil, TravelModeBicycle, "メモ", updatedAt)
+	require.NoError(t, err)
+
+	assert.Equal(t, a.ID(), updated.ID())
+	assert.Equal(t, 3, updated.Position(), "Update は並び順を変えるべきではない")
+	assert.Equal(t, "銀閣寺", updated.Title())
+	assert.Equal(t, TravelModeBicycle, updated.TravelMode())
+	assert.Equal(t, createdAt, updated.CreatedAt())
+	assert.Equal(t, updatedAt, updated.UpdatedAt())
+	assert.Equal(t, "金閣寺", a.Title(), "元の Activity は変更されてはいけない")
+}
+
+func TestActivity_Duplicate(t *testing.T) {
+	place := newTestPlace(t, "金閣寺", 35.0394, 135.7292)
+	startAt := time.Date(2024, 5, 1, 1, 0, 0, 0, time.UTC)
+	endAt := startAt.Add(time.Hour)
+	createdAt := time.Now().Add(-48 * time.Hour)
+	a, err := NewActivity(NewActivityID("activity-id-1"), trip.NewTripID("trip-id-1"), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), 2, "金閣寺", place, nil, &startAt, &endAt, TravelModeTransit, "拝観料 500 円", createdAt, createdAt)
+	require.NoError(t, err)
+
+	now := time.Now()
+	copied := a.Duplicate(NewActivityID("activity-id-2"), trip.NewTripID("trip-id-2"), 3, now)
+
+	assert.Equal(t, NewActivityID("activity-id-2"), copied.ID(), "新しい ID を持つべき")
+	assert.Equal(t, trip.NewTripID("trip-id-2"), copied.TripID(), "複製先の旅行に紐づくべき")
+	assert.Equal(t, time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC), copied.Date(), "日付がずれるべき")
+	assert.Equal(t, startAt.AddDate(0, 0, 3), *copied.StartAt(), "開始日時がずれるべき")
+	assert.Equal(t, endAt.AddDate(0, 0, 3), *copied.EndAt(), "終了日時がずれるべき")
+	assert.Equal(t, 2, copied.Position())
+	assert.Equal(t, "金閣寺", copied.Title())
+	assert.Equal(t, place, copied.Place())
+	assert.Equal(t, TravelModeTransit, copied.TravelMode())
+	assert.Equal(t, "拝観料 500 円", copied.Notes())
+	assert.Equal(t, now, copied.CreatedAt())
+	assert.Equal(t, now, copied.UpdatedAt())
+	assert.Equal(t, startAt, *a.StartAt(), "元の Activity は変更されてはいけない")
+}
+
+func TestActivity_ValidateFor(t *testing.T) {
+	tripID := trip.NewTripID("trip-id-1")
+	period, err := trip.NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	tr := trip.NewTrip(tripID, "京都旅行", period, "", time.Now(), time.Now())
+
+	tests := []struct {
+		name    string
+		date    time.Time
+		wantErr bool
+	}{
+		{name: "正常系: 期間内", date: time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)},
+		{name: "異常系: 期間外", date: time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewActivity(NewActivityID("activity-id-1"), tripID, tt.date, 0, "金閣寺", nil, nil, nil, nil, TravelModeWalk, "", time.Now(), time.Now())
+			require.NoError(t, err)
+			if tt.wantErr {
+				assert.Error(t, a.ValidateFor(tr))
+			} else {
+				assert.NoError(t, a.ValidateFor(tr))
+			}
+		})
+	}
+}
+
+func TestNextPosition(t *testing.T) {
+	newActivity := func(position int) *Activity {
+		a, err := NewActivity(NewActivityID("id"), trip.NewTripID("trip-id-1"), time.Now(), position, "行動", nil, nil, nil, nil, TravelModeWalk, "", time.Now(), time.Now())
+		require.NoError(t, err)
+		return a
+	}
+
+	assert.Equal(t, 0, NextPosition(nil))
+	assert.Equal(t, 5, NextPosition([]*Activity{newActivity(4), newActivity(1)}))
+}
@@ -0,0 +1,39 @@
+package itinerary
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeActivityNotFound = "ACTIVITY_NOT_FOUND"
+)
+
+func NewActivityNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeActivityNotFound, "Activity not found", opts...)
+}
+
+func NewInvalidTravelModeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Travel mode must be one of walk, bicycle, car or transit", opts...)
+}
+
+func NewEmptyTitleError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Activity title must not be empty", opts...)
+}
+
+func NewInvalidTimeRangeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Activity end time must not be before its start time", opts...)
+}
+
+func NewOutsideTripPeriodError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Activity date must be within the trip period", opts...)
+}
+
+func NewInvalidSpeedError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Average speed of every travel mode must be positive", opts...)
+}
+
+func NewInvalidDayError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Day must be within the trip period", opts...)
+}
+
+func NewPinnedActivityOutsideDayError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Pinned activities must be on the day being optimized", opts...)
+}
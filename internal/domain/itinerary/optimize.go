@@ -0,0 +1,285 @@
+package itinerary
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+)
+
+// optimizeEpsilonMeters はこれより短い距離の差は改善とみなさない。浮動小数点の誤差で入れ替えを繰り返さないようにする
+const optimizeEpsilonMeters = 1e-6
+
+// RouteEndpoints は 1 日の出発地と到着地（通常は宿泊先）。決まっていない場合は nil
+type RouteEndpoints struct {
+	start *geo.Coordinate
+	end   *geo.Coordinate
+}
+
+// NewRouteEndpoints は出発地と到着地から RouteEndpoints を作成する
+func NewRouteEndpoints(start, end *geo.Coordinate) RouteEndpoints {
+	return RouteEndpoints{start: start, end: end}
+}
+
+// Getters
+func (r RouteEndpoints) Start() *geo.Coordinate { return r.start }
+func (r RouteEndpoints) End() *geo.Coordinate   { return r.end }
+
+// Optimization は 1 日の行動を巡る順を並べ替えた提案。距離は出発地と到着地を含む直線距離の合計
+type Optimization struct {
+	activities              []*Activity
+	positions               []int
+	originalDistanceMeters  float64
+	optimizedDistanceMeters float64
+	complete                bool
+}
+
+// Getters
+
+// Activities は提案する順に並べた行動を返す。並び順は今のまま
+func (o Optimization) Activities() []*Activity          { return o.activities }
+func (o Optimization) OriginalDistanceMeters() float64  { return o.originalDistanceMeters }
+func (o Optimization) OptimizedDistanceMeters() float64 { return o.optimizedDistanceMeters }
+
+// SavedDistanceMeters は並べ替えによって短くなる距離を返す
+func (o Optimization) SavedDistanceMeters() float64 {
+	return o.originalDistanceMeters - o.optimizedDistanceMeters
+}
+
+// Complete は制限時間内に改善できなくなるまで探索を終えたかを返す。false の場合は途中までの最良の順を提案している
+func (o Optimization) Complete() bool { return o.complete }
+
+// Reordered は提案した順に並べるための並び順に更新した行動を返す。並び順が変わらない行動は含めない。
+// 今の並び順の値を小さい順に割り当て直すため、動かない行動の並び順はそのまま残る
+func (o Optimization) Reordered(updatedAt time.Time) []*Activity {
+	var moved []*Activity
+	for i, a := range o.activities {
+		if a.Position() != o.positions[i] {
+			moved = append(moved, a.MoveTo(o.positions[i], updatedAt))
+		}
+	}
+	return moved
+}
+
+// OptimizeDay は同じ日の行動を巡る順に並べた activities を、出発地から到着地までの合計距離が短くなるように並べ替える。
+// 最近傍法で作った順と今の順を初期解として 2-opt で改善し、expired が true を返した時点で探索を打ち切る。
+// 次の行動は今の位置から動かさない。
+//   - pinned に含まれる行動
+//   - 開始日時か終了日時が決まっている行動
+//   - 座標が決まっていない行動
+//
+// 日時が決まっている 2 つの行動の間に行動を移す場合は、その間の移動時間が空き時間（今の順の移動時間の方が長い場合はその時間）に
+// 収まらなければならない。座標が決まっていない行動は距離の計算では飛ばし、その前後の場所の間を直接移動するものとみなす
+func OptimizeDay(activities []*Activity, endpoints RouteEndpoints, pinned []ActivityID, speeds Speeds, expired func() bool) Optimization {
+	p := newRouteProblem(activities, endpoints, pinned, speeds)
+
+	original := make([]*Activity, len(activities))
+	copy(original, activities)
+	best, bestCost := original, p.cost(original)
+	complete := true
+
+	seeds := [][]*Activity{p.nearestNeighbour(original), original}
+	for _, seed := range seeds {
+		if !p.feasible(seed) {
+			continue
+		}
+		improved, converged := p.twoOpt(seed, expired)
+		if c := p.cost(improved); c < bestCost-optimizeEpsilonMeters {
+			best, bestCost = improved, c
+		}
+		if !converged {
+			complete = false
+			break
+		}
+	}
+
+	return Optimization{
+		activities:              best,
+		positions:               reassignPositions(activities),
+		originalDistanceMeters:  p.cost(original),
+		optimizedDistanceMeters: bestCost,
+		complete:                complete,
+	}
+}
+
+// timeWindow は日時が決まっている 2 つの行動の間で、移動時間の合計が limit に収まらなければならない区間
+type timeWindow struct {
+	from, to int
+	limit    time.Duration
+}
+
+type routeProblem struct {
+	endpoints RouteEndpoints
+	speeds    Speeds
+	// slots は並べ替えの対象となる行動が入る位置。それ以外の位置の行動は動かさない
+	slots   []int
+	windows []timeWindow
+}
+
+func newRouteProblem(activities []*Activity, endpoints RouteEndpoints, pinned []ActivityID, speeds Speeds) *routeProblem {
+	p := &routeProblem{endpoints: endpoints, speeds: speeds}
+
+	isPinned := make(map[ActivityID]bool, len(pinned))
+	for _, id := range pinned {
+		isPinned[id] = true
+	}
+
+	var timed []int
+	for i, a := range activities {
+		hasTime := a.StartAt() != nil || a.EndAt() != nil
+		if hasTime {
+			timed = append(timed, i)
+		}
+		if !isPinned[a.ID()] && !hasTime && a.Coordinate() != nil {
+			p.slots = append(p.slots, i)
+		}
+	}
+
+	for k := 1; k < len(timed); k++ {
+		from, to := activities[timed[k-1]], activities[timed[k]]
+		departAt := from.EndAt()
+		if departAt == nil {
+			departAt = from.StartAt()
+		}
+		if departAt == nil || to.StartAt() == nil {
+			continue
+		}
+		w := timeWindow{from: timed[k-1], to: timed[k], limit: to.StartAt().Sub(*departAt)}
+		if current := p.travelTime(activities, w.from, w.to); current > w.limit {
+			w.limit = current
+		}
+		p.windows = append(p.windows, w)
+	}
+
+	return p
+}
+
+// cost は出発地から到着地までの直線距離の合計を返す
+func (p *routeProblem) cost(route []*Activity) float64 {
+	var total float64
+	last := p.endpoints.start
+	for _, a := range route {
+		c := a.Coordinate()
+		if c == nil {
+			continue
+		}
+		if last != nil {
+			total += last.DistanceMeters(*c)
+		}
+		last = c
+	}
+	if last != nil && p.endpoints.end != nil {
+		total += last.DistanceMeters(*p.endpoints.end)
+	}
+	return total
+}
+
+// travelTime は route の from 番目の行動から to 番目の行動までの移動時間の合計を返す
+func (p *routeProblem) travelTime(route []*Activity, from, to int) time.Duration {
+	last := p.lastCoordinate(route, from)
+	var total time.Duration
+	for k := from + 1; k <= to; k++ {
+		c := route[k].Coordinate()
+		if c == nil {
+			continue
+		}
+		if last != nil {
+			total += p.speeds.TravelTime(route[k].TravelMode(), last.DistanceMeters(*c))
+		}
+		last = c
+	}
+	return total
+}
+
+// lastCoordinate は route の i 番目までで最後に分かっている場所を返す。どこも分からない場合は出発地を返す
+func (p *routeProblem) lastCoordinate(route []*Activity, i int) *geo.Coordinate {
+	for k := i; k >= 0; k-- {
+		if c := route[k].Coordinate(); c != nil {
+			return c
+		}
+	}
+	return p.endpoints.start
+}
+
+func (p *routeProblem) feasible(route []*Activity) bool {
+	for _, w := range p.windows {
+		if p.travelTime(route, w.from, w.to) > w.limit {
+			return false
+		}
+	}
+	return true
+}
+
+// nearestNeighbour は並べ替えの対象となる位置を前から順に、直前の場所から最も近い行動で埋めた順を返す
+func (p *routeProblem) nearestNeighbour(route []*Activity) []*Activity {
+	next := make([]*Activity, len(route))
+	copy(next, route)
+
+	remaining := make([]*Activity, 0, len(p.slots))
+	for _, slot := range p.slots {
+		remaining = append(remaining, route[slot])
+	}
+
+	for _, slot := range p.slots {
+		last := p.lastCoordinate(next, slot-1)
+		nearest := 0
+		if last != nil {
+			for k := 1; k < len(remaining); k++ {
+				if last.DistanceMeters(*remaining[k].Coordinate()) < last.DistanceMeters(*remaining[nearest].Coordinate()) {
+					nearest = k
+				}
+			}
+		}
+		next[slot] = remaining[nearest]
+		remaining = append(remaining[:nearest], remaining[nearest+1:]...)
+	}
+	return next
+}
+
+// twoOpt は並べ替えの対象となる行動の区間を逆順にして距離が短くなる限り改善を繰り返す。
+// 改善できなくなった場合は converged に true を、expired で打ち切った場合は false を返す
+func (p *routeProblem) twoOpt(route []*Activity, expired func() bool) (best []*Activity, converged bool) {
+	best = route
+	bestCost := p.cost(best)
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < len(p.slots)-1; i++ {
+			for j := i + 1; j < len(p.slots); j++ {
+				if expired() {
+					return best, false
+				}
+				candidate := p.reverse(best, i, j)
+				if c := p.cost(candidate); c < bestCost-optimizeEpsilonMeters && p.feasible(candidate) {
+					best, bestCost = candidate, c
+					improved = true
+				}
+			}
+		}
+	}
+	return best, true
+}
+
+// reverse は i 番目から j 番目までの並べ替えの対象となる位置に入っている行動を逆順にした順を返す
+func (p *routeProblem) reverse(route []*Activity, i, j int) []*Activity {
+	next := make([]*Activity, len(route))
+	copy(next, route)
+	for ; i < j; i, j = i+1, j-1 {
+		next[p.slots[i]], next[p.slots[j]] = next[p.slots[j]], next[p.slots[i]]
+	}
+	return next
+}
+
+// reassignPositions は今の並び順の値を小さい順に並べた値を返す。同じ値が続く場合は 1 ずつずらして順が崩れないようにする
+func reassignPositions(activities []*Activity) []int {
+	positions := make([]int, len(activities))
+	for i, a := range activities {
+		positions[i] = a.Position()
+	}
+	sort.Ints(positions)
+	for i := 1; i < len(positions); i++ {
+		if positions[i] <= positions[i-1] {
+			positions[i] = positions[i-1] + 1
+		}
+	}
+	return positions
+}
@@ -0,0 +1,146 @@
+package itinerary
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimizeDay(t *testing.T) {
+	tripID := trip.NewTripID("trip-id-1")
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hour, minute int) *time.Time {
+		v := day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+		return &v
+	}
+	coordinate := func(latitude, longitude float64) *geo.Coordinate {
+		c, err := geo.NewCoordinate(latitude, longitude)
+		require.NoError(t, err)
+		return &c
+	}
+	newActivity := func(id string, position int, place *geo.Place, startAt, endAt *time.Time) *Activity {
+		a, err := NewActivity(NewActivityID(id), tripID, day, position, id, place, nil, startAt, endAt, TravelModeWalk, "", day, day)
+		require.NoError(t, err)
+		return a
+	}
+	never := func() bool { return false }
+	ids := func(activities []*Activity) []string {
+		var result []string
+		for _, a := range activities {
+			result = append(result, a.ID().String())
+		}
+		return result
+	}
+
+	// 南北に一直線に並んだ場所。出発地を南端、到着地を北端にすると南から順に巡るのが最短になる
+	north := func(i int) *geo.Place { return newTestPlace(t, "north", 35.0+float64(i)*0.01, 135.0) }
+	endpoints := NewRouteEndpoints(coordinate(35.0, 135.0), coordinate(35.1, 135.0))
+
+	t.Run("正常系: 出発地から到着地まで一直線に巡る順に並べ替え、短くなる距離を返す", func(t *testing.T) {
+		activities := []*Activity{
+			newActivity("a5", 0, north(5), nil, nil),
+			newActivity("a2", 1, north(2), nil, nil),
+			newActivity("a8", 2, north(8), nil, nil),
+			newActivity("a1", 3, north(1), nil, nil),
+			newActivity("a9", 4, north(9), nil, nil),
+			newActivity("a3", 5, north(3), nil, nil),
+			newActivity("a7", 6, north(7), nil, nil),
+			newActivity("a4", 7, north(4), nil, nil),
+			newActivity("a6", 8, north(6), nil, nil),
+		}
+
+		o := OptimizeDay(activities, endpoints, nil, DefaultSpeeds(), never)
+
+		assert.Equal(t, []string{"a1", "a2", "a3", "a4", "a5", "a6", "a7", "a8", "a9"}, ids(o.Activities()))
+		assert.True(t, o.Complete())
+		assert.InDelta(t, 11119.5, o.OptimizedDistanceMeters(), 1, "南端から北端までの距離になるべき")
+		assert.InDelta(t, o.OriginalDistanceMeters()-o.OptimizedDistanceMeters(), o.SavedDistanceMeters(), 1e-9)
+		assert.Greater(t, o.SavedDistanceMeters(), 30000.0)
+	})
+
+	t.Run("正常系: 固定した行動は今の位置から動かさない", func(t *testing.T) {
+		a9 := newActivity("a9", 0, north(9), nil, nil)
+		activities := []*Activity{
+			a9,
+			newActivity("a3", 1, north(3), nil, nil),
+			newActivity("a1", 2, north(1), nil, nil),
+			newActivity("a2", 3, north(2), nil, nil),
+		}
+
+		// 到着地を決めない場合は最後の行動で 1 日を終える
+		o := OptimizeDay(activities, NewRouteEndpoints(endpoints.Start(), nil), []ActivityID{a9.ID()}, DefaultSpeeds(), never)
+
+		assert.Equal(t, []string{"a9", "a3", "a2", "a1"}, ids(o.Activities()), "a9 の後は北から順に戻るのが最短になるべき")
+	})
+
+	t.Run("正常系: 日時が決まっている行動と座標が決まっていない行動は動かさない", func(t *testing.T) {
+		activities := []*Activity{
+			newActivity("a9", 0, north(9), nil, nil),
+			newActivity("lunch", 1, north(4), at(12, 0), at(13, 0)),
+			newActivity("a2", 2, north(2), nil, nil),
+			newActivity("unknown", 3, nil, nil, nil),
+			newActivity("a6", 4, north(6), nil, nil),
+		}
+
+		o := OptimizeDay(activities, endpoints, nil, DefaultSpeeds(), never)
+
+		assert.Equal(t, []string{"a2", "lunch", "a6", "unknown", "a9"}, ids(o.Activities()))
+	})
+
+	t.Run("正常系: 日時が決まっている行動の間には空き時間に収まる場合だけ行動を移す", func(t *testing.T) {
+		// a と b の間に近くの z を挟んでいる。遠くの x と入れ替えると合計距離は短くなるが、歩いて 5 分では着かない
+		near := newTestPlace(t, "z", 35.0018, 135.0)
+		far := newTestPlace(t, "x", 35.0, 135.022)
+		endpoints := NewRouteEndpoints(nil, near.Coordinate())
+		activities := func(bStartAt *time.Time) []*Activity {
+			return []*Activity{
+				newActivity("a", 0, newTestPlace(t, "a", 35.0, 135.0), at(9, 0), at(10, 0)),
+				newActivity("z", 1, near, nil, nil),
+				newActivity("b", 2, newTestPlace(t, "b", 35.0, 135.0005), bStartAt, nil),
+				newActivity("x", 3, far, nil, nil),
+			}
+		}
+
+		tight := OptimizeDay(activities(at(10, 5)), endpoints, nil, DefaultSpeeds(), never)
+		assert.Equal(t, []string{"a", "z", "b", "x"}, ids(tight.Activities()))
+		assert.Zero(t, tight.SavedDistanceMeters())
+
+		loose := OptimizeDay(activities(at(12, 0)), endpoints, nil, DefaultSpeeds(), never)
+		assert.Equal(t, []string{"a", "x", "b", "z"}, ids(loose.Activities()))
+		assert.Greater(t, loose.SavedDistanceMeters(), 0.0)
+	})
+
+	t.Run("正常系: 制限時間を過ぎた場合は最近傍法の順から改善を打ち切る", func(t *testing.T) {
+		activities := []*Activity{
+			newActivity("a3", 0, north(3), nil, nil),
+			newActivity("a1", 1, north(1), nil, nil),
+			newActivity("a2", 2, north(2), nil, nil),
+		}
+
+		o := OptimizeDay(activities, endpoints, nil, DefaultSpeeds(), func() bool { return true })
+
+		assert.False(t, o.Complete())
+		assert.Equal(t, []string{"a1", "a2", "a3"}, ids(o.Activities()))
+	})
+
+	t.Run("正常系: 並べ替えた行動には今の並び順の値を小さい順に割り当て直す", func(t *testing.T) {
+		activities := []*Activity{
+			newActivity("a2", 3, north(2), nil, nil),
+			newActivity("a1", 3, north(1), nil, nil),
+			newActivity("a3", 7, north(3), nil, nil),
+		}
+		updatedAt := day.Add(time.Hour)
+
+		o := OptimizeDay(activities, endpoints, nil, DefaultSpeeds(), never)
+		moved := o.Reordered(updatedAt)
+
+		require.Len(t, moved, 1, "並び順が変わらない行動は含めないべき")
+		assert.Equal(t, "a2", moved[0].ID().String())
+		assert.Equal(t, 4, moved[0].Position(), "同じ並び順の値は 1 ずらすべき")
+		assert.Equal(t, updatedAt, moved[0].UpdatedAt())
+	})
+}
@@ -0,0 +1,136 @@
+package itinerary
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+)
+
+// Leg は同じ日の連続する 2 つの行動の間の移動を表現する値オブジェクト。
+// 距離は 2 つの場所の直線距離で、どちらかの座標が決まっていない場合は距離と移動時間は見積もれない。
+// 出発地・到着地のタイムゾーンはそれぞれの行動のタイムゾーンで、決まっていない場合は nil
+type Leg struct {
+	from           ActivityID
+	to             ActivityID
+	fromTimezone   *geo.Timezone
+	toTimezone     *geo.Timezone
+	mode           TravelMode
+	distanceMeters *float64
+	travelTime     *time.Duration
+	gap            *time.Duration
+}
+
+// newLeg は from から to への移動を見積もる。移動手段は to に設定された手段を使う
+func newLeg(from, to *Activity, speeds Speeds) Leg {
+	leg := Leg{
+		from:         from.ID(),
+		to:           to.ID(),
+		fromTimezone: from.Timezone(),
+		toTimezone:   to.Timezone(),
+		mode:         to.TravelMode(),
+	}
+
+	if a, b := from.Coordinate(), to.Coordinate(); a != nil && b != nil {
+		distance := a.DistanceMeters(*b)
+		travelTime := speeds.TravelTime(leg.mode, distance)
+		leg.distanceMeters, leg.travelTime = &distance, &travelTime
+	}
+
+	// 前の行動は終了日時がなければ開始日時に出発するものとみなす
+	departAt := from.EndAt()
+	if departAt == nil {
+		departAt = from.StartAt()
+	}
+	if departAt != nil && to.StartAt() != nil {
+		gap := to.StartAt().Sub(*departAt)
+		leg.gap = &gap
+	}
+
+	return leg
+}
+
+// Getters
+func (l Leg) From() ActivityID            { return l.from }
+func (l Leg) To() ActivityID              { return l.to }
+func (l Leg) FromTimezone() *geo.Timezone { return l.fromTimezone }
+func (l Leg) ToTimezone() *geo.Timezone   { return l.toTimezone }
+func (l Leg) Mode() TravelMode            { return l.mode }
+func (l Leg) DistanceMeters() *float64    { return l.distanceMeters }
+func (l Leg) TravelTime() *time.Duration  { return l.travelTime }
+
+// Gap は前の行動の終了から次の行動の開始までの空き時間を返す。どちらかの日時が決まっていない場合は nil を返す
+func (l Leg) Gap() *time.Duration { return l.gap }
+
+// Insufficient は空き時間が見積もった移動時間より短いかを判定する。どちらかが分からない場合は false を返す
+func (l Leg) Insufficient() bool {
+	return l.gap != nil && l.travelTime != nil && *l.gap < *l.travelTime
+}
+
+// DayPlan は 1 日分の行程。行動を巡る順に並べ、その間の移動と 1 日の合計を持つ
+type DayPlan struct {
+	date                time.Time
+	activities          []*Activity
+	legs                []Leg
+	totalDistanceMeters float64
+	totalTravelTime     time.Duration
+}
+
+// NewDayPlan は date の行動を巡る順に並べた activities から 1 日分の行程を作成する。合計には見積もれた移動だけを含める
+func NewDayPlan(date time.Time, activities []*Activity, speeds Speeds) DayPlan {
+	plan := DayPlan{date: date, activities: activities}
+	for i := 1; i < len(activities); i++ {
+		leg := newLeg(activities[i-1], activities[i], speeds)
+		if leg.distanceMeters != nil {
+			plan.totalDistanceMeters += *leg.distanceMeters
+			plan.totalTravelTime += *leg.travelTime
+		}
+		plan.legs = append(plan.legs, leg)
+	}
+	return plan
+}
+
+// Getters
+func (d DayPlan) Date() time.Time                { return d.date }
+func (d DayPlan) Activities() []*Activity        { return d.activities }
+func (d DayPlan) Legs() []Leg                    { return d.legs }
+func (d DayPlan) TotalDistanceMeters() float64   { return d.totalDistanceMeters }
+func (d DayPlan) TotalTravelTime() time.Duration { return d.totalTravelTime }
+
+// InsufficientLegs は空き時間が移動時間より短い移動を返す
+func (d DayPlan) InsufficientLegs() []Leg {
+	var legs []Leg
+	for _, leg := range d.legs {
+		if leg.Insufficient() {
+			legs = append(legs, leg)
+		}
+	}
+	return legs
+}
+
+// PlanDays は旅行の行動を日付ごとにまとめ、日付順の行程を作成する。同じ日の行動は並び順、作成日時の古い順に巡る
+func PlanDays(activities []*Activity, speeds Speeds) []DayPlan {
+	sorted := make([]*Activity, len(activities))
+	copy(sorted, activities)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if !a.Date().Equal(b.Date()) {
+			return a.Date().Before(b.Date())
+		}
+		if a.Position() != b.Position() {
+			return a.Position() < b.Position()
+		}
+		return a.CreatedAt().Before(b.CreatedAt())
+	})
+
+	var plans []DayPlan
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && sorted[end].Date().Equal(sorted[start].Date()) {
+			end++
+		}
+		plans = append(plans, NewDayPlan(sorted[start].Date(), sorted[start:end], speeds))
+		start = end
+	}
+	return plans
+}
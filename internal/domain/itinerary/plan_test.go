@@ -0,0 +1,137 @@
+package itinerary
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSpeeds(t *testing.T) {
+	t.Run("正常系", func(t *testing.T) {
+		speeds, err := NewSpeeds(map[TravelMode]float64{TravelModeWalk: 4, TravelModeBicycle: 12, TravelModeCar: 36, TravelModeTransit: 20})
+		require.NoError(t, err)
+		assert.Equal(t, 36.0, speeds.KilometersPerHour(TravelModeCar))
+		assert.Equal(t, 15*time.Minute, speeds.TravelTime(TravelModeWalk, 1000))
+	})
+
+	t.Run("異常系: 速さが指定されていない移動手段がある", func(t *testing.T) {
+		_, err := NewSpeeds(map[TravelMode]float64{TravelModeWalk: 4})
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 速さが 0", func(t *testing.T) {
+		_, err := NewSpeeds(map[TravelMode]float64{TravelModeWalk: 0, TravelModeBicycle: 12, TravelModeCar: 36, TravelModeTransit: 20})
+		assert.Error(t, err)
+	})
+}
+
+func TestSpeeds_TravelTime(t *testing.T) {
+	speeds := DefaultSpeeds()
+
+	assert.Equal(t, time.Duration(0), speeds.TravelTime(TravelModeWalk, 0))
+	assert.Equal(t, 2*time.Second, speeds.TravelTime(TravelModeWalk, 1.5), "移動時間は秒単位に切り上げるべき")
+	assert.Equal(t, time.Hour, speeds.TravelTime(TravelModeBicycle, 15000))
+}
+
+func TestPlanDays(t *testing.T) {
+	tripID := trip.NewTripID("trip-id-1")
+	day1 := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	at := func(hour, minute int) *time.Time {
+		v := day1.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+		return &v
+	}
+	kinkakuji := newTestPlace(t, "金閣寺", 35.0394, 135.7292)
+	ginkakuji := newTestPlace(t, "銀閣寺", 35.0270, 135.7982)
+	kiyomizu := newTestPlace(t, "清水寺", 34.9949, 135.7850)
+	newActivity := func(id string, date time.Time, position int, place *geo.Place, startAt, endAt *time.Time, mode TravelMode) *Activity {
+		a, err := NewActivity(NewActivityID(id), tripID, date, position, id, place, nil, startAt, endAt, mode, "", day1, day1)
+		require.NoError(t, err)
+		return a
+	}
+
+	speeds := DefaultSpeeds()
+	a1 := newActivity("a1", day1, 0, kinkakuji, at(9, 0), at(10, 0), TravelModeCar)
+	a2 := newActivity("a2", day1, 1, ginkakuji, at(10, 30), at(11, 30), TravelModeWalk)
+	a3 := newActivity("a3", day1, 2, nil, at(12, 0), nil, TravelModeWalk)
+	a4 := newActivity("a4", day1, 3, kiyomizu, nil, nil, TravelModeWalk)
+	b1 := newActivity("b1", day2, 0, kiyomizu, nil, nil, TravelModeWalk)
+
+	// 並び順とは逆の順に渡しても、日付と並び順で並べ替えるべき
+	plans := PlanDays([]*Activity{b1, a4, a3, a2, a1}, speeds)
+
+	require.Len(t, plans, 2)
+	assert.Equal(t, day1, plans[0].Date())
+	assert.Equal(t, []*Activity{a1, a2, a3, a4}, plans[0].Activities())
+	assert.Equal(t, day2, plans[1].Date())
+	assert.Empty(t, plans[1].Legs(), "行動が 1 つだけの日には移動がないべき")
+
+	legs := plans[0].Legs()
+	require.Len(t, legs, 3)
+
+	t.Run("座標が分かる行動の間は直線距離と移動時間を見積もる", func(t *testing.T) {
+		leg := legs[0]
+		assert.Equal(t, a1.ID(), leg.From())
+		assert.Equal(t, a2.ID(), leg.To())
+		assert.Equal(t, TravelModeWalk, leg.Mode(), "移動手段は移動先の行動のものを使うべき")
+		distance := kinkakuji.Coordinate().DistanceMeters(*ginkakuji.Coordinate())
+		require.NotNil(t, leg.DistanceMeters())
+		assert.InDelta(t, distance, *leg.DistanceMeters(), 1e-9)
+		require.NotNil(t, leg.TravelTime())
+		assert.Equal(t, speeds.TravelTime(TravelModeWalk, distance), *leg.TravelTime())
+		require.NotNil(t, leg.Gap())
+		assert.Equal(t, 30*time.Minute, *leg.Gap())
+	})
+
+	t.Run("座標が分からない行動との間は見積もらない", func(t *testing.T) {
+		assert.Nil(t, legs[1].DistanceMeters())
+		assert.Nil(t, legs[1].TravelTime())
+		assert.Nil(t, legs[2].DistanceMeters())
+		assert.False(t, legs[1].Insufficient())
+	})
+
+	t.Run("前の行動に終了日時がなければ開始日時から空き時間を求める", func(t *testing.T) {
+		require.NotNil(t, legs[1].Gap())
+		assert.Equal(t, 30*time.Minute, *legs[1].Gap())
+		assert.Nil(t, legs[2].Gap(), "次の行動の開始日時がなければ空き時間は分からないべき")
+	})
+
+	t.Run("1 日の合計は見積もれた移動だけを足す", func(t *testing.T) {
+		assert.Equal(t, *legs[0].DistanceMeters(), plans[0].TotalDistanceMeters())
+		assert.Equal(t, *legs[0].TravelTime(), plans[0].TotalTravelTime())
+	})
+
+	t.Run("空き時間が移動時間より短い移動を警告する", func(t *testing.T) {
+		// 金閣寺から銀閣寺までは直線でも約 6.4km あり、徒歩では 30 分で着かない
+		assert.True(t, legs[0].Insufficient())
+		assert.Equal(t, []Leg{legs[0]}, plans[0].InsufficientLegs())
+	})
+
+	t.Run("行動がなければ行程もない", func(t *testing.T) {
+		assert.Empty(t, PlanDays(nil, speeds))
+	})
+
+	t.Run("出発地と到着地のタイムゾーンはそれぞれの行動のタイムゾーン", func(t *testing.T) {
+		tokyo, err := geo.NewTimezone("Asia/Tokyo")
+		require.NoError(t, err)
+		seoul, err := geo.NewTimezone("Asia/Seoul")
+		require.NoError(t, err)
+		haneda, err := NewActivity(NewActivityID("c1"), tripID, day1, 0, "羽田空港", nil, &tokyo, at(0, 0), nil, TravelModeTransit, "", day1, day1)
+		require.NoError(t, err)
+		gimpo, err := NewActivity(NewActivityID("c2"), tripID, day1, 1, "金浦空港", nil, &seoul, at(2, 30), nil, TravelModeTransit, "", day1, day1)
+		require.NoError(t, err)
+		free := newActivity("c3", day1, 2, nil, nil, nil, TravelModeWalk)
+
+		legs := NewDayPlan(day1, []*Activity{haneda, gimpo, free}, speeds).Legs()
+
+		require.Len(t, legs, 2)
+		assert.Equal(t, &tokyo, legs[0].FromTimezone())
+		assert.Equal(t, &seoul, legs[0].ToTimezone())
+		assert.Equal(t, &seoul, legs[1].FromTimezone())
+		assert.Nil(t, legs[1].ToTimezone(), "タイムゾーンが決まっていない行動への移動は nil になるべき")
+	})
+}
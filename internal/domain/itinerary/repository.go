@@ -0,0 +1,19 @@
+package itinerary
+
+import (
+	"context"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+//go:generate mockgen -destination mock/activity.go github.com/hata0/travel-api/internal/domain/itinerary ActivityRepository
+type ActivityRepository interface {
+	FindByID(ctx context.Context, id ActivityID) (*Activity, error)
+	// FindByTripID は旅行の行動を日付順（同じ日付の中では並び順、作成日時の古い順）に取得する。
+	// date が nil でない場合はその日付の行動だけを返す
+	FindByTripID(ctx context.Context, tripID trip.TripID, date *time.Time) ([]*Activity, error)
+	Create(ctx context.Context, activity *Activity) error
+	Update(ctx context.Context, activity *Activity) error
+	Delete(ctx context.Context, id ActivityID) error
+}
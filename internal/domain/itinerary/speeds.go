@@ -0,0 +1,49 @@
+package itinerary
+
+import (
+	"math"
+	"time"
+)
+
+// Speeds は移動手段ごとの平均の速さ（km/h）を表現する値オブジェクト。行動の間の直線距離から移動時間を見積もるのに使う
+type Speeds struct {
+	kilometersPerHour map[TravelMode]float64
+}
+
+// NewSpeeds は移動手段ごとの平均の速さから Speeds を作成する。すべての移動手段の速さが正でなければならない
+func NewSpeeds(kilometersPerHour map[TravelMode]float64) (Speeds, error) {
+	speeds := make(map[TravelMode]float64, len(TravelModes()))
+	for _, mode := range TravelModes() {
+		v, ok := kilometersPerHour[mode]
+		if !ok || !(v > 0) || math.IsInf(v, 0) {
+			return Speeds{}, NewInvalidSpeedError()
+		}
+		speeds[mode] = v
+	}
+	return Speeds{kilometersPerHour: speeds}, nil
+}
+
+// DefaultSpeeds は設定がない場合に使う平均の速さを返す。
+// 乗り換えや信号待ちを含めた街中での目安で、徒歩 4.8km/h、自転車 15km/h、車 30km/h、公共交通機関 25km/h とする
+func DefaultSpeeds() Speeds {
+	return Speeds{kilometersPerHour: map[TravelMode]float64{
+		TravelModeWalk:    4.8,
+		TravelModeBicycle: 15,
+		TravelModeCar:     30,
+		TravelModeTransit: 25,
+	}}
+}
+
+// KilometersPerHour は移動手段の平均の速さを返す
+func (s Speeds) KilometersPerHour(mode TravelMode) float64 {
+	return s.kilometersPerHour[mode]
+}
+
+// TravelTime は移動手段で distanceMeters を移動するのにかかる時間を秒単位に切り上げて返す
+func (s Speeds) TravelTime(mode TravelMode, distanceMeters float64) time.Duration {
+	metersPerSecond := s.kilometersPerHour[mode] * 1000 / 3600
+	if metersPerSecond <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(distanceMeters/metersPerSecond)) * time.Second
+}
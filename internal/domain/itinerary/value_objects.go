@@ -0,0 +1,47 @@
+package itinerary
+
+// ActivityID は行程の行動IDを表現する値オブジェクト
+type ActivityID struct {
+	value string
+}
+
+func NewActivityID(id string) ActivityID {
+	return ActivityID{value: id}
+}
+
+func (id ActivityID) String() string {
+	return id.value
+}
+
+func (id ActivityID) Equals(other ActivityID) bool {
+	return id.value == other.value
+}
+
+// TravelMode は直前の行動の場所からその行動の場所へ向かう移動手段を表現する値オブジェクト
+type TravelMode string
+
+const (
+	TravelModeWalk    TravelMode = "walk"
+	TravelModeBicycle TravelMode = "bicycle"
+	TravelModeCar     TravelMode = "car"
+	TravelModeTransit TravelMode = "transit"
+)
+
+// TravelModes は移動手段を遅い順に返す
+func TravelModes() []TravelMode {
+	return []TravelMode{TravelModeWalk, TravelModeBicycle, TravelModeCar, TravelModeTransit}
+}
+
+// ParseTravelMode は文字列を移動手段に変換する
+func ParseTravelMode(value string) (TravelMode, error) {
+	for _, m := range TravelModes() {
+		if string(m) == value {
+			return m, nil
+		}
+	}
+	return "", NewInvalidTravelModeError()
+}
+
+func (m TravelMode) String() string {
+	return string(m)
+}
@@ -0,0 +1,78 @@
+package journal
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// Entry は旅行の1日分の日記を表現するエンティティ。本文は Markdown のまま保持する
+type Entry struct {
+	id        EntryID
+	tripID    trip.TripID
+	date      time.Time
+	title     string
+	body      string
+	mood      Mood
+	place     *geo.Place
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewEntry は新しい日記を作成する。日付は時刻を切り捨てたUTCの日付として扱う。場所がない場合は nil を渡す
+func NewEntry(
+	id EntryID,
+	tripID trip.TripID,
+	date time.Time,
+	title, body string,
+	mood Mood,
+	place *geo.Place,
+	createdAt, updatedAt time.Time,
+) *Entry {
+	return &Entry{
+		id:        id,
+		tripID:    tripID,
+		date:      trip.TruncateToDate(date),
+		title:     title,
+		body:      body,
+		mood:      mood,
+		place:     place,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// Getters
+func (e *Entry) ID() EntryID          { return e.id }
+func (e *Entry) TripID() trip.TripID  { return e.tripID }
+func (e *Entry) Date() time.Time      { return e.date }
+func (e *Entry) Title() string        { return e.title }
+func (e *Entry) Body() string         { return e.body }
+func (e *Entry) Mood() Mood           { return e.mood }
+func (e *Entry) Place() *geo.Place    { return e.place }
+func (e *Entry) CreatedAt() time.Time { return e.createdAt }
+func (e *Entry) UpdatedAt() time.Time { return e.updatedAt }
+
+// Update は日記の内容を更新する
+func (e *Entry) Update(date time.Time, title, body string, mood Mood, place *geo.Place, updatedAt time.Time) *Entry {
+	return NewEntry(e.id, e.tripID, date, title, body, mood, place, e.createdAt, updatedAt)
+}
+
+// ValidateFor は日記の日付が指定された旅行の期間内にあるかを検証する。期間未定の旅行ではどの日付も許容する
+func (e *Entry) ValidateFor(t *trip.Trip) error {
+	if !e.tripID.Equals(t.ID()) {
+		return NewJournalEntryNotFoundError()
+	}
+	if t.Period() != nil && !t.Period().Contains(e.date) {
+		return NewOutsideTripPeriodError()
+	}
+	return nil
+}
+
+func (e *Entry) Equals(other *Entry) bool {
+	if other == nil {
+		return false
+	}
+	return e.id.Equals(other.id)
+}
@@ -0,0 +1,114 @@
+package journal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPlace(t *testing.T) *geo.Place {
+	t.Helper()
+	c, err := geo.NewCoordinate(35.0394, 135.7292)
+	require.NoError(t, err)
+	p, err := geo.NewPlace("金閣寺", &c, nil)
+	require.NoError(t, err)
+	return &p
+}
+
+func TestNewEntry(t *testing.T) {
+	id := NewEntryID("entry-id-1")
+	tripID := trip.NewTripID("trip-id-1")
+	place := newTestPlace(t)
+	createdAt := time.Now().Add(-time.Hour)
+	updatedAt := time.Now()
+
+	e := NewEntry(id, tripID, time.Date(2024, 5, 1, 21, 30, 0, 0, time.UTC), "1日目", "# 京都\n金閣寺へ", MoodGreat, place, createdAt, updatedAt)
+
+	assert.Equal(t, id, e.ID())
+	assert.Equal(t, tripID, e.TripID())
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), e.Date(), "Date() は時刻を切り捨てた日付を返すべき")
+	assert.Equal(t, "1日目", e.Title())
+	assert.Equal(t, "# 京都\n金閣寺へ", e.Body())
+	assert.Equal(t, MoodGreat, e.Mood())
+	assert.Equal(t, place, e.Place())
+	assert.Equal(t, createdAt, e.CreatedAt())
+	assert.Equal(t, updatedAt, e.UpdatedAt())
+}
+
+func TestEntry_Update(t *testing.T) {
+	createdAt := time.Now().Add(-2 * time.Hour)
+	e := NewEntry(NewEntryID("entry-id-1"), trip.NewTripID("trip-id-1"), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), "1日目", "本文", MoodGood, newTestPlace(t), createdAt, createdAt)
+	updatedAt := time.Now()
+
+	updated := e.Update(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), "2日目", "新しい本文", MoodBad, nil, updatedAt)
+
+	assert.Equal(t, e.ID(), updated.ID(), "Update は元の ID を保持すべき")
+	assert.Equal(t, e.TripID(), updated.TripID(), "Update は元の TripID を保持すべき")
+	assert.Equal(t, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), updated.Date())
+	assert.Equal(t, "2日目", updated.Title())
+	assert.Equal(t, "新しい本文", updated.Body())
+	assert.Equal(t, MoodBad, updated.Mood())
+	assert.Nil(t, updated.Place())
+	assert.Equal(t, createdAt, updated.CreatedAt(), "Update は元の createdAt を保持すべき")
+	assert.Equal(t, updatedAt, updated.UpdatedAt(), "Update は新しい updatedAt を設定すべき")
+
+	// 元の日記が変更されていないことを確認
+	assert.Equal(t, "1日目", e.Title(), "元の Entry の title は変更されてはいけない")
+}
+
+func TestEntry_ValidateFor(t *testing.T) {
+	period, err := trip.NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	tripID := trip.NewTripID("trip-id-1")
+	tr := trip.NewTrip(tripID, "京都旅行", period, "", time.Now(), time.Now())
+	newEntryOn := func(tripID trip.TripID, date time.Time) *Entry {
+		return NewEntry(NewEntryID("entry-id-1"), tripID, date, "日記", "", MoodNeutral, nil, time.Now(), time.Now())
+	}
+
+	t.Run("正常系: 旅行期間の最終日", func(t *testing.T) {
+		err := newEntryOn(tripID, time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)).ValidateFor(tr)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("正常系: 期間未定の旅行ではどの日付も許容する", func(t *testing.T) {
+		undated := trip.NewTrip(tripID, "未定の旅行", nil, "", time.Now(), time.Now())
+
+		err := newEntryOn(tripID, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)).ValidateFor(undated)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 旅行期間外の日付", func(t *testing.T) {
+		err := newEntryOn(tripID, time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC)).ValidateFor(tr)
+
+		assert.ErrorIs(t, err, NewOutsideTripPeriodError())
+	})
+
+	t.Run("異常系: 別の旅行の日記", func(t *testing.T) {
+		err := newEntryOn(trip.NewTripID("trip-id-2"), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)).ValidateFor(tr)
+
+		assert.ErrorIs(t, err, NewJournalEntryNotFoundError())
+	})
+}
+
+func TestParseMood(t *testing.T) {
+	for _, m := range Moods() {
+		t.Run("正常系: "+m.String(), func(t *testing.T) {
+			got, err := ParseMood(m.String())
+
+			require.NoError(t, err)
+			assert.Equal(t, m, got)
+		})
+	}
+
+	t.Run("異常系: 未知の気分", func(t *testing.T) {
+		_, err := ParseMood("happy")
+
+		assert.ErrorIs(t, err, NewInvalidMoodError())
+	})
+}
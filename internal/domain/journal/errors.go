@@ -0,0 +1,19 @@
+package journal
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeJournalEntryNotFound = "JOURNAL_ENTRY_NOT_FOUND"
+)
+
+func NewJournalEntryNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeJournalEntryNotFound, "Journal entry not found", opts...)
+}
+
+func NewInvalidMoodError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Mood must be one of great, good, neutral, bad or terrible", opts...)
+}
+
+func NewOutsideTripPeriodError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Journal entry date must be within the trip period", opts...)
+}
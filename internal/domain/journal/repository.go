@@ -0,0 +1,19 @@
+package journal
+
+import (
+	"context"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+//go:generate mockgen -destination mock/journal.go github.com/hata0/travel-api/internal/domain/journal EntryRepository
+type EntryRepository interface {
+	FindByID(ctx context.Context, id EntryID) (*Entry, error)
+	// FindByTripID は旅行の日記を日付順（同じ日付の中では作成日時の古い順）に取得する。
+	// date が nil でない場合はその日付の日記だけを返す
+	FindByTripID(ctx context.Context, tripID trip.TripID, date *time.Time) ([]*Entry, error)
+	Create(ctx context.Context, entry *Entry) error
+	Update(ctx context.Context, entry *Entry) error
+	Delete(ctx context.Context, id EntryID) error
+}
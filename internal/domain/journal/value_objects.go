@@ -0,0 +1,48 @@
+package journal
+
+// EntryID は日記IDを表現する値オブジェクト
+type EntryID struct {
+	value string
+}
+
+func NewEntryID(id string) EntryID {
+	return EntryID{value: id}
+}
+
+func (id EntryID) String() string {
+	return id.value
+}
+
+func (id EntryID) Equals(other EntryID) bool {
+	return id.value == other.value
+}
+
+// Mood はその日の気分を表現する値オブジェクト
+type Mood string
+
+const (
+	MoodGreat    Mood = "great"
+	MoodGood     Mood = "good"
+	MoodNeutral  Mood = "neutral"
+	MoodBad      Mood = "bad"
+	MoodTerrible Mood = "terrible"
+)
+
+// Moods は気分を良い順に返す
+func Moods() []Mood {
+	return []Mood{MoodGreat, MoodGood, MoodNeutral, MoodBad, MoodTerrible}
+}
+
+// ParseMood は文字列を気分に変換する
+func ParseMood(value string) (Mood, error) {
+	for _, m := range Moods() {
+		if string(m) == value {
+			return m, nil
+		}
+	}
+	return "", NewInvalidMoodError()
+}
+
+func (m Mood) String() string {
+	return string(m)
+}
@@ -0,0 +1,60 @@
+package membership
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeMemberNotFound     = "MEMBER_NOT_FOUND"
+	CodeInvitationNotFound = "INVITATION_NOT_FOUND"
+)
+
+func NewMemberNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeMemberNotFound, "Member not found", opts...)
+}
+
+func NewInvitationNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeInvitationNotFound, "Invitation not found", opts...)
+}
+
+func NewInvalidRoleError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Role must be one of owner, editor or viewer", opts...)
+}
+
+func NewInsufficientRoleError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewForbiddenError("Your role in this trip does not allow this operation", opts...)
+}
+
+func NewInvalidInviteeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Invitation requires exactly one of email or username", opts...)
+}
+
+func NewInvalidInvitationRoleError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Invitation role must be editor or viewer", opts...)
+}
+
+func NewInvalidInvitationExpiryError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Invitation must expire after it is created", opts...)
+}
+
+func NewInvalidInvitationStatusError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Invitation status must be one of pending, accepted or declined", opts...)
+}
+
+func NewInvitationExpiredError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewConflictError("Invitation has expired", opts...)
+}
+
+func NewInvitationAlreadyRespondedError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewConflictError("Invitation has already been responded to", opts...)
+}
+
+func NewAlreadyMemberError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewConflictError("User is already a member of this trip", opts...)
+}
+
+func NewOwnerRoleChangeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Owner role can only be changed by transferring ownership", opts...)
+}
+
+func NewOwnerRemovalError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Owner cannot be removed from the trip; transfer ownership first", opts...)
+}
@@ -0,0 +1,205 @@
+package membership
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// DefaultInvitationTTL は招待の有効期限を指定しなかった場合の有効期間
+const DefaultInvitationTTL = 7 * 24 * time.Hour
+
+// InvitationID は招待IDを表現する値オブジェクト
+type InvitationID struct {
+	value string
+}
+
+func NewInvitationID(id string) InvitationID {
+	return InvitationID{value: id}
+}
+
+func (id InvitationID) String() string {
+	return id.value
+}
+
+func (id InvitationID) Equals(other InvitationID) bool {
+	return id.value == other.value
+}
+
+// InvitationStatus は招待への応答状況を表現する値オブジェクト
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusDeclined InvitationStatus = "declined"
+	// InvitationStatusExpired は応答されないまま有効期限を過ぎた招待。保存はされず、判定時に導出される
+	InvitationStatusExpired InvitationStatus = "expired"
+)
+
+// ParseInvitationStatus は保存された文字列を招待の状態に変換する
+func ParseInvitationStatus(value string) (InvitationStatus, error) {
+	switch status := InvitationStatus(value); status {
+	case InvitationStatusPending, InvitationStatusAccepted, InvitationStatusDeclined:
+		return status, nil
+	default:
+		return "", NewInvalidInvitationStatusError()
+	}
+}
+
+func (s InvitationStatus) String() string {
+	return string(s)
+}
+
+// Invitee は招待の宛先を表現する値オブジェクト。メールアドレスかユーザー名のどちらか一方を持つ
+type Invitee struct {
+	email    string
+	username string
+}
+
+// NewInvitee は招待の宛先を作成する。メールアドレスとユーザー名はちょうど一方を指定する
+func NewInvitee(email, username string) (Invitee, error) {
+	email = strings.TrimSpace(email)
+	username = strings.TrimSpace(username)
+	if (email == "") == (username == "") {
+		return Invitee{}, NewInvalidInviteeError()
+	}
+	return Invitee{email: email, username: username}, nil
+}
+
+func (i Invitee) Email() string    { return i.email }
+func (i Invitee) Username() string { return i.username }
+
+// Matches はユーザーが招待の宛先に該当するかを判定する。メールアドレスは大文字小文字を区別しない
+func (i Invitee) Matches(u *user.User) bool {
+	if u == nil {
+		return false
+	}
+	if i.email != "" {
+		return strings.EqualFold(i.email, u.Email())
+	}
+	return i.username == u.Username()
+}
+
+// Invitation は旅行への招待を表現するエンティティ
+type Invitation struct {
+	id          InvitationID
+	tripID      trip.TripID
+	inviterID   user.UserID
+	invitee     Invitee
+	role        Role
+	status      InvitationStatus
+	expiresAt   time.Time
+	createdAt   time.Time
+	respondedAt *time.Time
+}
+
+// NewInvitation は新しい招待を作成する。所有者のロールでは招待できない
+func NewInvitation(
+	id InvitationID,
+	tripID trip.TripID,
+	inviterID user.UserID,
+	invitee Invitee,
+	role Role,
+	expiresAt time.Time,
+	createdAt time.Time,
+) (*Invitation, error) {
+	if role != RoleEditor && role != RoleViewer {
+		return nil, NewInvalidInvitationRoleError()
+	}
+	if !expiresAt.After(createdAt) {
+		return nil, NewInvalidInvitationExpiryError()
+	}
+	return &Invitation{
+		id:        id,
+		tripID:    tripID,
+		inviterID: inviterID,
+		invitee:   invitee,
+		role:      role,
+		status:    InvitationStatusPending,
+		expiresAt: expiresAt,
+		createdAt: createdAt,
+	}, nil
+}
+
+// RestoreInvitation は保存済みの招待を復元する
+func RestoreInvitation(
+	id InvitationID,
+	tripID trip.TripID,
+	inviterID user.UserID,
+	invitee Invitee,
+	role Role,
+	status InvitationStatus,
+	expiresAt time.Time,
+	createdAt time.Time,
+	respondedAt *time.Time,
+) *Invitation {
+	return &Invitation{
+		id:          id,
+		tripID:      tripID,
+		inviterID:   inviterID,
+		invitee:     invitee,
+		role:        role,
+		status:      status,
+		expiresAt:   expiresAt,
+		createdAt:   createdAt,
+		respondedAt: respondedAt,
+	}
+}
+
+// Getters
+func (i *Invitation) ID() InvitationID         { return i.id }
+func (i *Invitation) TripID() trip.TripID      { return i.tripID }
+func (i *Invitation) InviterID() user.UserID   { return i.inviterID }
+func (i *Invitation) Invitee() Invitee         { return i.invitee }
+func (i *Invitation) Role() Role               { return i.role }
+func (i *Invitation) Status() InvitationStatus { return i.status }
+func (i *Invitation) ExpiresAt() time.Time     { return i.expiresAt }
+func (i *Invitation) CreatedAt() time.Time     { return i.createdAt }
+func (i *Invitation) RespondedAt() *time.Time  { return i.respondedAt }
+
+// StatusAt は指定時刻における招待の状態を返す。未応答のまま有効期限を過ぎた招待は期限切れとなる
+func (i *Invitation) StatusAt(now time.Time) InvitationStatus {
+	if i.status == InvitationStatusPending && !now.Before(i.expiresAt) {
+		return InvitationStatusExpired
+	}
+	return i.status
+}
+
+// Accept は招待を承諾した結果を返す。宛先に該当するユーザーだけが、有効期限内の未応答の招待を承諾できる
+func (i *Invitation) Accept(u *user.User, now time.Time) (*Invitation, error) {
+	return i.respond(u, InvitationStatusAccepted, now)
+}
+
+// Decline は招待を辞退した結果を返す。宛先に該当するユーザーだけが、有効期限内の未応答の招待を辞退できる
+func (i *Invitation) Decline(u *user.User, now time.Time) (*Invitation, error) {
+	return i.respond(u, InvitationStatusDeclined, now)
+}
+
+func (i *Invitation) respond(u *user.User, status InvitationStatus, now time.Time) (*Invitation, error) {
+	// 宛先でないユーザーには招待の存在自体を明かさない
+	if !i.invitee.Matches(u) {
+		return nil, NewInvitationNotFoundError()
+	}
+	switch i.StatusAt(now) {
+	case InvitationStatusPending:
+	case InvitationStatusExpired:
+		return nil, NewInvitationExpiredError()
+	default:
+		return nil, NewInvitationAlreadyRespondedError()
+	}
+
+	responded := *i
+	responded.status = status
+	responded.respondedAt = &now
+	return &responded, nil
+}
+
+func (i *Invitation) Equals(other *Invitation) bool {
+	if other == nil {
+		return false
+	}
+	return i.id.Equals(other.id)
+}
@@ -0,0 +1,167 @@
+package membership
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestInvitation(t *testing.T, invitee Invitee, createdAt time.Time) *Invitation {
+	t.Helper()
+	invitation, err := NewInvitation(
+		NewInvitationID("invitation-id-1"),
+		trip.NewTripID("trip-id-1"),
+		user.NewUserID("owner-id"),
+		invitee,
+		RoleEditor,
+		createdAt.Add(DefaultInvitationTTL),
+		createdAt,
+	)
+	require.NoError(t, err)
+	return invitation
+}
+
+func TestNewInvitee(t *testing.T) {
+	tests := []struct {
+		name         string
+		email        string
+		username     string
+		wantEmail    string
+		wantUsername string
+		wantErr      bool
+	}{
+		{name: "正常系: メールアドレス", email: " alice@example.com ", wantEmail: "alice@example.com"},
+		{name: "正常系: ユーザー名", username: "bob", wantUsername: "bob"},
+		{name: "異常系: どちらも未指定", email: " ", wantErr: true},
+		{name: "異常系: 両方を指定", email: "alice@example.com", username: "bob", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitee, err := NewInvitee(tt.email, tt.username)
+
+			if tt.wantErr {
+				assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEmail, invitee.Email())
+			assert.Equal(t, tt.wantUsername, invitee.Username())
+		})
+	}
+}
+
+func TestInvitee_Matches(t *testing.T) {
+	now := time.Now()
+	u := user.NewUser(user.NewUserID("user-id-1"), "alice", "Alice@Example.com", nil, now, now)
+
+	byEmail, err := NewInvitee("alice@example.com", "")
+	require.NoError(t, err)
+	byUsername, err := NewInvitee("", "alice")
+	require.NoError(t, err)
+	other, err := NewInvitee("", "bob")
+	require.NoError(t, err)
+
+	assert.True(t, byEmail.Matches(u), "メールアドレスは大文字小文字を区別せずに一致するべき")
+	assert.True(t, byUsername.Matches(u))
+	assert.False(t, other.Matches(u))
+	assert.False(t, byEmail.Matches(nil))
+}
+
+func TestNewInvitation(t *testing.T) {
+	invitee, err := NewInvitee("alice@example.com", "")
+	require.NoError(t, err)
+	createdAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 未応答の招待を作成する", func(t *testing.T) {
+		invitation := newTestInvitation(t, invitee, createdAt)
+
+		assert.Equal(t, InvitationStatusPending, invitation.Status())
+		assert.Equal(t, RoleEditor, invitation.Role())
+		assert.Equal(t, invitee, invitation.Invitee())
+		assert.Equal(t, createdAt.Add(DefaultInvitationTTL), invitation.ExpiresAt())
+		assert.Nil(t, invitation.RespondedAt())
+	})
+
+	t.Run("異常系: 所有者として招待する", func(t *testing.T) {
+		_, err := NewInvitation(NewInvitationID("invitation-id-1"), trip.NewTripID("trip-id-1"), user.NewUserID("owner-id"), invitee, RoleOwner, createdAt.Add(time.Hour), createdAt)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+
+	t.Run("異常系: 有効期限が作成日時以前", func(t *testing.T) {
+		_, err := NewInvitation(NewInvitationID("invitation-id-1"), trip.NewTripID("trip-id-1"), user.NewUserID("owner-id"), invitee, RoleViewer, createdAt, createdAt)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestInvitation_StatusAt(t *testing.T) {
+	invitee, err := NewInvitee("", "alice")
+	require.NoError(t, err)
+	createdAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	invitation := newTestInvitation(t, invitee, createdAt)
+
+	assert.Equal(t, InvitationStatusPending, invitation.StatusAt(invitation.ExpiresAt().Add(-time.Second)))
+	assert.Equal(t, InvitationStatusExpired, invitation.StatusAt(invitation.ExpiresAt()))
+}
+
+func TestInvitation_Respond(t *testing.T) {
+	invitee, err := NewInvitee("", "alice")
+	require.NoError(t, err)
+	createdAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	now := createdAt.Add(time.Hour)
+	alice := user.NewUser(user.NewUserID("user-id-1"), "alice", "alice@example.com", nil, createdAt, createdAt)
+	bob := user.NewUser(user.NewUserID("user-id-2"), "bob", "bob@example.com", nil, createdAt, createdAt)
+
+	t.Run("正常系: 承諾する", func(t *testing.T) {
+		invitation := newTestInvitation(t, invitee, createdAt)
+
+		accepted, err := invitation.Accept(alice, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, InvitationStatusAccepted, accepted.Status())
+		assert.Equal(t, &now, accepted.RespondedAt())
+		assert.Equal(t, InvitationStatusPending, invitation.Status(), "元の招待は変更されないべき")
+	})
+
+	t.Run("正常系: 辞退する", func(t *testing.T) {
+		invitation := newTestInvitation(t, invitee, createdAt)
+
+		declined, err := invitation.Decline(alice, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, InvitationStatusDeclined, declined.Status())
+	})
+
+	t.Run("異常系: 宛先でないユーザーには見つからない", func(t *testing.T) {
+		invitation := newTestInvitation(t, invitee, createdAt)
+
+		_, err := invitation.Accept(bob, now)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, CodeInvitationNotFound))
+	})
+
+	t.Run("異常系: 有効期限切れ", func(t *testing.T) {
+		invitation := newTestInvitation(t, invitee, createdAt)
+
+		_, err := invitation.Accept(alice, invitation.ExpiresAt())
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeConflict))
+	})
+
+	t.Run("異常系: 応答済み", func(t *testing.T) {
+		invitation := newTestInvitation(t, invitee, createdAt)
+		declined, err := invitation.Decline(alice, now)
+		require.NoError(t, err)
+
+		_, err = declined.Accept(alice, now)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeConflict))
+	})
+}
@@ -0,0 +1,66 @@
+package membership
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// Member は旅行に参加するユーザーとそのロールを表現するエンティティ
+type Member struct {
+	tripID    trip.TripID
+	userID    user.UserID
+	role      Role
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewMember は新しい旅行メンバーを作成する
+func NewMember(tripID trip.TripID, userID user.UserID, role Role, createdAt, updatedAt time.Time) *Member {
+	return &Member{
+		tripID:    tripID,
+		userID:    userID,
+		role:      role,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// Getters
+func (m *Member) TripID() trip.TripID  { return m.tripID }
+func (m *Member) UserID() user.UserID  { return m.userID }
+func (m *Member) Role() Role           { return m.role }
+func (m *Member) CreatedAt() time.Time { return m.createdAt }
+func (m *Member) UpdatedAt() time.Time { return m.updatedAt }
+
+// IsOwner はメンバーが旅行の所有者かを判定する
+func (m *Member) IsOwner() bool {
+	return m.role == RoleOwner
+}
+
+// Authorize はメンバーのロールが required 以上の権限を持つことを確認する
+func (m *Member) Authorize(required Role) error {
+	if !m.role.Allows(required) {
+		return NewInsufficientRoleError()
+	}
+	return nil
+}
+
+// ChangeRole はロールを変更したメンバーを返す
+func (m *Member) ChangeRole(role Role, updatedAt time.Time) *Member {
+	return &Member{
+		tripID:    m.tripID,
+		userID:    m.userID,
+		role:      role,
+		createdAt: m.createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+func (m *Member) Equals(other *Member) bool {
+	if other == nil {
+		return false
+	}
+	return m.tripID.Equals(other.tripID) && m.userID.Equals(other.userID)
+}
@@ -0,0 +1,67 @@
+package membership
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMember(t *testing.T) {
+	tripID := trip.NewTripID("trip-id-1")
+	userID := user.NewUserID("user-id-1")
+	createdAt := time.Now().Add(-24 * time.Hour)
+	updatedAt := time.Now()
+
+	m := NewMember(tripID, userID, RoleEditor, createdAt, updatedAt)
+
+	assert.Equal(t, tripID, m.TripID())
+	assert.Equal(t, userID, m.UserID())
+	assert.Equal(t, RoleEditor, m.Role())
+	assert.False(t, m.IsOwner())
+	assert.Equal(t, createdAt, m.CreatedAt())
+	assert.Equal(t, updatedAt, m.UpdatedAt())
+}
+
+func TestMember_Authorize(t *testing.T) {
+	now := time.Now()
+	viewer := NewMember(trip.NewTripID("trip-id-1"), user.NewUserID("user-id-1"), RoleViewer, now, now)
+
+	t.Run("正常系: 必要なロール以上の権限を持つ", func(t *testing.T) {
+		assert.NoError(t, viewer.Authorize(RoleViewer))
+	})
+
+	t.Run("異常系: 権限が不足している", func(t *testing.T) {
+		err := viewer.Authorize(RoleEditor)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeForbidden))
+	})
+}
+
+func TestMember_ChangeRole(t *testing.T) {
+	createdAt := time.Now().Add(-24 * time.Hour)
+	updatedAt := time.Now()
+	original := NewMember(trip.NewTripID("trip-id-1"), user.NewUserID("user-id-1"), RoleViewer, createdAt, createdAt)
+
+	changed := original.ChangeRole(RoleOwner, updatedAt)
+
+	assert.Equal(t, RoleOwner, changed.Role())
+	assert.True(t, changed.IsOwner())
+	assert.Equal(t, createdAt, changed.CreatedAt())
+	assert.Equal(t, updatedAt, changed.UpdatedAt())
+	assert.Equal(t, RoleViewer, original.Role(), "元のメンバーは変更されないべき")
+	assert.True(t, original.Equals(changed))
+}
+
+func TestMember_Equals(t *testing.T) {
+	now := time.Now()
+	m := NewMember(trip.NewTripID("trip-id-1"), user.NewUserID("user-id-1"), RoleViewer, now, now)
+
+	assert.True(t, m.Equals(NewMember(trip.NewTripID("trip-id-1"), user.NewUserID("user-id-1"), RoleEditor, now, now)))
+	assert.False(t, m.Equals(NewMember(trip.NewTripID("trip-id-2"), user.NewUserID("user-id-1"), RoleViewer, now, now)))
+	assert.False(t, m.Equals(NewMember(trip.NewTripID("trip-id-1"), user.NewUserID("user-id-2"), RoleViewer, now, now)))
+	assert.False(t, m.Equals(nil))
+}
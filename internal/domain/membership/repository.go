@@ -0,0 +1,32 @@
+package membership
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+//go:generate mockgen -destination mock/member.go github.com/hata0/travel-api/internal/domain/membership MemberRepository
+type MemberRepository interface {
+	// FindByTripAndUser は旅行のメンバーを取得する。ゴミ箱にある旅行のメンバーは見つからないものとして扱う
+	FindByTripAndUser(ctx context.Context, tripID trip.TripID, userID user.UserID) (*Member, error)
+	// FindByTrashedTripAndUser はゴミ箱にある旅行のメンバーを取得する
+	FindByTrashedTripAndUser(ctx context.Context, tripID trip.TripID, userID user.UserID) (*Member, error)
+	// FindByTripID は旅行のメンバーを所有者、編集者、閲覧者の順に取得する
+	FindByTripID(ctx context.Context, tripID trip.TripID) ([]*Member, error)
+	// Save はメンバーを作成し、既に存在する場合はロールを更新する
+	Save(ctx context.Context, member *Member) error
+	Delete(ctx context.Context, tripID trip.TripID, userID user.UserID) error
+}
+
+//go:generate mockgen -destination mock/invitation.go github.com/hata0/travel-api/internal/domain/membership InvitationRepository
+type InvitationRepository interface {
+	FindByID(ctx context.Context, id InvitationID) (*Invitation, error)
+	// FindByTripID は旅行の招待を作成日時の新しい順に取得する
+	FindByTripID(ctx context.Context, tripID trip.TripID) ([]*Invitation, error)
+	// FindPendingByInvitee はメールアドレスかユーザー名が宛先に一致する未応答の招待を取得する。期限切れの招待も含む
+	FindPendingByInvitee(ctx context.Context, email, username string) ([]*Invitation, error)
+	Create(ctx context.Context, invitation *Invitation) error
+	Update(ctx context.Context, invitation *Invitation) error
+}
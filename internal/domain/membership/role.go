@@ -0,0 +1,38 @@
+package membership
+
+// Role は旅行メンバーのロールを表現する値オブジェクト
+type Role string
+
+const (
+	// RoleOwner は旅行の削除やメンバーの管理ができる所有者。旅行ごとに1人だけ存在する
+	RoleOwner Role = "owner"
+	// RoleEditor は旅行と関連リソースを編集できるメンバー
+	RoleEditor Role = "editor"
+	// RoleViewer は旅行と関連リソースを閲覧だけできるメンバー
+	RoleViewer Role = "viewer"
+)
+
+// rank はロールの権限の強さ。値が大きいほど多くの操作ができる
+var rank = map[Role]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleOwner:  3,
+}
+
+// ParseRole は文字列をロールに変換する
+func ParseRole(value string) (Role, error) {
+	role := Role(value)
+	if _, ok := rank[role]; !ok {
+		return "", NewInvalidRoleError()
+	}
+	return role, nil
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// Allows はロールが required 以上の権限を持つかを判定する
+func (r Role) Allows(required Role) bool {
+	return rank[r] > 0 && rank[r] >= rank[required]
+}
@@ -0,0 +1,49 @@
+package membership
+
+import (
+	"testing"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRole(t *testing.T) {
+	for _, value := range []string{"owner", "editor", "viewer"} {
+		t.Run("正常系: "+value, func(t *testing.T) {
+			role, err := ParseRole(value)
+
+			require.NoError(t, err)
+			assert.Equal(t, Role(value), role)
+		})
+	}
+
+	t.Run("異常系: 未定義のロール", func(t *testing.T) {
+		_, err := ParseRole("admin")
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestRole_Allows(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     Role
+		required Role
+		want     bool
+	}{
+		{name: "所有者は全ての操作ができる", role: RoleOwner, required: RoleOwner, want: true},
+		{name: "編集者は編集できる", role: RoleEditor, required: RoleEditor, want: true},
+		{name: "編集者は閲覧できる", role: RoleEditor, required: RoleViewer, want: true},
+		{name: "編集者は所有者の操作ができない", role: RoleEditor, required: RoleOwner, want: false},
+		{name: "閲覧者は閲覧できる", role: RoleViewer, required: RoleViewer, want: true},
+		{name: "閲覧者は編集できない", role: RoleViewer, required: RoleEditor, want: false},
+		{name: "未定義のロールは閲覧もできない", role: Role("guest"), required: RoleViewer, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.role.Allows(tt.required))
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package photo
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodePhotoNotFound = "PHOTO_NOT_FOUND"
+)
+
+func NewPhotoNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodePhotoNotFound, "Photo not found", opts...)
+}
+
+func NewEmptyFileError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Photo file must not be empty", opts...)
+}
+
+func NewUnsupportedContentTypeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewUnsupportedMediaTypeError("Photo must be a JPEG, PNG or GIF file", opts...)
+}
+
+func NewFileTooLargeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewPayloadTooLargeError("Photo file exceeds the maximum size", opts...)
+}
+
+func NewImageTooLargeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewPayloadTooLargeError("Photo dimensions exceed the maximum number of pixels", opts...)
+}
+
+func NewInvalidImageError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Photo file is corrupted or could not be decoded", opts...)
+}
@@ -0,0 +1,101 @@
+package photo
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// Photo は旅行のアルバムに追加された写真を表現するエンティティ。
+// 写真とサムネイルの中身は BlobStore に保存し、ここでは保存先のキーと EXIF から読み取ったメタデータを保持する
+type Photo struct {
+	id               PhotoID
+	tripID           trip.TripID
+	filename         string
+	contentType      string
+	size             int64
+	width            int
+	height           int
+	capturedAt       *time.Time
+	coordinate       *geo.Coordinate
+	locationStripped bool
+	uploadedBy       user.UserID
+	createdAt        time.Time
+}
+
+// NewPhoto は新しい写真を作成する。
+// capturedAt は撮影した場所の現地時刻をタイムゾーンなし（UTC として扱う）で表したもので、撮影日時や位置が不明な場合は nil を渡す
+func NewPhoto(
+	id PhotoID,
+	tripID trip.TripID,
+	filename, contentType string,
+	size int64,
+	width, height int,
+	capturedAt *time.Time,
+	coordinate *geo.Coordinate,
+	locationStripped bool,
+	uploadedBy user.UserID,
+	createdAt time.Time,
+) *Photo {
+	return &Photo{
+		id:               id,
+		tripID:           tripID,
+		filename:         filename,
+		contentType:      contentType,
+		size:             size,
+		width:            width,
+		height:           height,
+		capturedAt:       capturedAt,
+		coordinate:       coordinate,
+		locationStripped: locationStripped,
+		uploadedBy:       uploadedBy,
+		createdAt:        createdAt,
+	}
+}
+
+// Getters
+func (p *Photo) ID() PhotoID                 { return p.id }
+func (p *Photo) TripID() trip.TripID         { return p.tripID }
+func (p *Photo) Filename() string            { return p.filename }
+func (p *Photo) ContentType() string         { return p.contentType }
+func (p *Photo) Size() int64                 { return p.size }
+func (p *Photo) Width() int                  { return p.width }
+func (p *Photo) Height() int                 { return p.height }
+func (p *Photo) CapturedAt() *time.Time      { return p.capturedAt }
+func (p *Photo) Coordinate() *geo.Coordinate { return p.coordinate }
+func (p *Photo) LocationStripped() bool      { return p.locationStripped }
+func (p *Photo) UploadedBy() user.UserID     { return p.uploadedBy }
+func (p *Photo) CreatedAt() time.Time        { return p.createdAt }
+
+// StorageKey は写真の中身を BlobStore に保存するキーを返す
+func (p *Photo) StorageKey() string {
+	return StorageKey(p.tripID, p.id)
+}
+
+// ThumbnailKey は写真のサムネイルを BlobStore に保存するキーを返す
+func (p *Photo) ThumbnailKey() string {
+	return ThumbnailKey(p.tripID, p.id)
+}
+
+func (p *Photo) Equals(other *Photo) bool {
+	if other == nil {
+		return false
+	}
+	return p.id.Equals(other.id)
+}
+
+// StorageKey は写真の中身を BlobStore に保存するキーを返す。旅行ごとにまとめて削除できるよう旅行IDを前に置く
+func StorageKey(tripID trip.TripID, id PhotoID) string {
+	return keyPrefix(tripID, id) + "original"
+}
+
+// ThumbnailKey は写真のサムネイルを BlobStore に保存するキーを返す
+func ThumbnailKey(tripID trip.TripID, id PhotoID) string {
+	return keyPrefix(tripID, id) + "thumbnail"
+}
+
+func keyPrefix(tripID trip.TripID, id PhotoID) string {
+	return "trips/" + tripID.String() + "/photos/" + id.String() + "/"
+}
@@ -0,0 +1,16 @@
+package photo
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+//go:generate mockgen -destination mock/photo.go github.com/hata0/travel-api/internal/domain/photo PhotoRepository
+type PhotoRepository interface {
+	FindByID(ctx context.Context, id PhotoID) (*Photo, error)
+	// FindByTripID は旅行の写真を撮影日時の順に取得する。撮影日時が不明な写真は最後に追加された順で並べる
+	FindByTripID(ctx context.Context, tripID trip.TripID) ([]*Photo, error)
+	Create(ctx context.Context, photo *Photo) error
+	Delete(ctx context.Context, id PhotoID) error
+}
@@ -0,0 +1,125 @@
+package photo
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// PhotoID は写真IDを表現する値オブジェクト
+type PhotoID struct {
+	value string
+}
+
+func NewPhotoID(id string) PhotoID {
+	return PhotoID{value: id}
+}
+
+func (id PhotoID) String() string {
+	return id.value
+}
+
+func (id PhotoID) Equals(other PhotoID) bool {
+	return id.value == other.value
+}
+
+// ThumbnailContentType はサムネイルの形式。元の写真の形式によらず JPEG で保存する
+const ThumbnailContentType = attachment.ContentTypeJPEG
+
+// DetectContentType はファイルの先頭のバイト列から写真の形式を判定する。
+// 添付ファイルとして受け付ける形式のうち、サーバーでデコードできる JPEG・PNG・GIF だけを写真として扱う
+func DetectContentType(head []byte) (string, error) {
+	contentType, err := attachment.DetectContentType(head)
+	if err != nil {
+		return "", NewUnsupportedContentTypeError()
+	}
+
+	switch contentType {
+	case attachment.ContentTypeJPEG, attachment.ContentTypePNG, attachment.ContentTypeGIF:
+		return contentType, nil
+	default:
+		return "", NewUnsupportedContentTypeError()
+	}
+}
+
+// MaxPlaceDistanceMeters は写真の撮影位置から日記の場所を候補とする最大の距離
+const MaxPlaceDistanceMeters = 1000
+
+// Suggestion は写真が旅程のどの日・どの場所で撮影されたかの推定結果
+type Suggestion struct {
+	date           *time.Time
+	day            int
+	place          *geo.Place
+	distanceMeters float64
+}
+
+// Getters
+func (s Suggestion) Date() *time.Time        { return s.date }
+func (s Suggestion) Day() int                { return s.day }
+func (s Suggestion) Place() *geo.Place       { return s.place }
+func (s Suggestion) DistanceMeters() float64 { return s.distanceMeters }
+
+// Suggest は写真の撮影日時と撮影位置から、旅程の何日目のどの場所の写真かを推定する。
+//   - 日: 撮影日が旅行期間内であれば、開始日を1日目とした日数を返す。期間外や期間未定の場合は撮影日だけを返す
+//   - 場所: 座標を持つ日記の場所のうち、撮影位置から MaxPlaceDistanceMeters 以内で最も近いものを返す。
+//     撮影日と同じ日の日記を優先し、該当がなければ旅行中のすべての日記から探す
+//
+// 撮影日時や撮影位置が分からない場合、その項目は推定しない
+func Suggest(p *Photo, t *trip.Trip, entries []*journal.Entry) Suggestion {
+	var s Suggestion
+
+	if p.capturedAt != nil {
+		date := trip.TruncateToDate(*p.capturedAt)
+		s.date = &date
+		if period := t.Period(); period != nil {
+			s.day = period.DayNumber(date)
+		}
+	}
+
+	if p.coordinate == nil {
+		return s
+	}
+
+	if s.date != nil {
+		var sameDay []*journal.Entry
+		for _, e := range entries {
+			if e.Date().Equal(*s.date) {
+				sameDay = append(sameDay, e)
+			}
+		}
+		if place, distance, ok := nearestPlace(*p.coordinate, sameDay); ok {
+			s.place, s.distanceMeters = place, distance
+			return s
+		}
+	}
+
+	if place, distance, ok := nearestPlace(*p.coordinate, entries); ok {
+		s.place, s.distanceMeters = place, distance
+	}
+	return s
+}
+
+// nearestPlace は日記の場所のうち、from から MaxPlaceDistanceMeters 以内で最も近いものを返す
+func nearestPlace(from geo.Coordinate, entries []*journal.Entry) (*geo.Place, float64, bool) {
+	var (
+		nearest  *geo.Place
+		distance float64
+	)
+	for _, e := range entries {
+		place := e.Place()
+		if place == nil || place.Coordinate() == nil {
+			continue
+		}
+		d := from.DistanceMeters(*place.Coordinate())
+		if d > MaxPlaceDistanceMeters {
+			continue
+		}
+		if nearest == nil || d < distance {
+			nearest, distance = place, d
+		}
+	}
+	return nearest, distance, nearest != nil
+}
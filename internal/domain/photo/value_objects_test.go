@@ -0,0 +1,135 @@
+package photo
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectContentType(t *testing.T) {
+	tests := []struct {
+		name string
+		head string
+		want string
+	}{
+		{name: "JPEG", head: "\xff\xd8\xff\xe1\x00\x10Exif", want: "image/jpeg"},
+		{name: "PNG", head: "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR", want: "image/png"},
+		{name: "GIF", head: "GIF89a\x01\x00\x01\x00", want: "image/gif"},
+	}
+	for _, tt := range tests {
+		t.Run("正常系: "+tt.name, func(t *testing.T) {
+			got, err := DetectContentType([]byte(tt.head))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for name, head := range map[string]string{
+		"WebP はデコードできない": "RIFF\x24\x00\x00\x00WEBPVP8 ",
+		"PDF は写真ではない":    "%PDF-1.7\n",
+		"不明な形式":          "hello world",
+	} {
+		t.Run("異常系: "+name, func(t *testing.T) {
+			_, err := DetectContentType([]byte(head))
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeUnsupportedMediaType))
+		})
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	tripID := trip.NewTripID("trip-1")
+	date := func(day int) time.Time { return time.Date(2024, 4, day, 0, 0, 0, 0, time.UTC) }
+	coordinate := func(lat, lng float64) *geo.Coordinate {
+		c, err := geo.NewCoordinate(lat, lng)
+		require.NoError(t, err)
+		return &c
+	}
+	entry := func(id string, day int, name string, c *geo.Coordinate) *journal.Entry {
+		place, err := geo.NewPlace(name, c, nil)
+		require.NoError(t, err)
+		return journal.NewEntry(journal.NewEntryID(id), tripID, date(day), "", "", journal.MoodGood, &place, date(day), date(day))
+	}
+	newPhoto := func(capturedAt *time.Time, c *geo.Coordinate) *Photo {
+		return NewPhoto(NewPhotoID("photo-1"), tripID, "a.jpg", "image/jpeg", 1, 1, 1, capturedAt, c, false, user.NewUserID("user-1"), date(1))
+	}
+
+	period, err := trip.NewPeriod(date(1), date(3))
+	require.NoError(t, err)
+	scheduled := trip.NewTrip(tripID, "京都旅行", period, "", date(1), date(1))
+	unscheduled := trip.NewTrip(tripID, "京都旅行", nil, "", date(1), date(1))
+
+	kinkakuji := coordinate(35.0394, 135.7292)
+	ryoanji := coordinate(35.0345, 135.7182)
+	kiyomizu := coordinate(34.9949, 135.7850)
+	entries := []*journal.Entry{
+		entry("entry-1", 1, "清水寺", kiyomizu),
+		entry("entry-2", 2, "龍安寺", ryoanji),
+		entry("entry-3", 3, "金閣寺", kinkakuji),
+		entry("entry-4", 2, "座標なし", nil),
+	}
+
+	t.Run("正常系: 撮影日から旅行の何日目かを推定する", func(t *testing.T) {
+		capturedAt := time.Date(2024, 4, 2, 21, 30, 0, 0, time.UTC)
+
+		s := Suggest(newPhoto(&capturedAt, nil), scheduled, entries)
+
+		require.NotNil(t, s.Date())
+		assert.Equal(t, date(2), *s.Date())
+		assert.Equal(t, 2, s.Day())
+		assert.Nil(t, s.Place())
+	})
+
+	t.Run("正常系: 期間外や期間未定の旅行では撮影日だけを返す", func(t *testing.T) {
+		capturedAt := time.Date(2024, 4, 5, 9, 0, 0, 0, time.UTC)
+
+		for _, tr := range []*trip.Trip{scheduled, unscheduled} {
+			s := Suggest(newPhoto(&capturedAt, nil), tr, entries)
+
+			require.NotNil(t, s.Date())
+			assert.Equal(t, date(5), *s.Date())
+			assert.Zero(t, s.Day())
+		}
+	})
+
+	t.Run("正常系: 撮影日と同じ日の場所を、より近い別の日の場所より優先する", func(t *testing.T) {
+		// 金閣寺と龍安寺の間（金閣寺寄り）で 2 日目に撮影した写真
+		capturedAt := time.Date(2024, 4, 2, 10, 0, 0, 0, time.UTC)
+
+		s := Suggest(newPhoto(&capturedAt, coordinate(35.0380, 135.7262)), scheduled, entries)
+
+		require.NotNil(t, s.Place())
+		assert.Equal(t, "龍安寺", s.Place().Name())
+		assert.Less(t, s.DistanceMeters(), float64(MaxPlaceDistanceMeters))
+	})
+
+	t.Run("正常系: 同じ日に近い場所がなければ旅行中のすべての場所から最も近いものを選ぶ", func(t *testing.T) {
+		capturedAt := time.Date(2024, 4, 1, 10, 0, 0, 0, time.UTC)
+
+		s := Suggest(newPhoto(&capturedAt, coordinate(35.0390, 135.7290)), scheduled, entries)
+
+		require.NotNil(t, s.Place())
+		assert.Equal(t, "金閣寺", s.Place().Name())
+	})
+
+	t.Run("正常系: 撮影日時が不明でも位置から場所を推定する", func(t *testing.T) {
+		s := Suggest(newPhoto(nil, coordinate(34.9950, 135.7851)), scheduled, entries)
+
+		assert.Nil(t, s.Date())
+		assert.Zero(t, s.Day())
+		require.NotNil(t, s.Place())
+		assert.Equal(t, "清水寺", s.Place().Name())
+	})
+
+	t.Run("正常系: 近くに場所がなければ場所は推定しない", func(t *testing.T) {
+		s := Suggest(newPhoto(nil, coordinate(35.6812, 139.7671)), scheduled, entries)
+
+		assert.Nil(t, s.Place())
+	})
+}
@@ -0,0 +1,19 @@
+package reference
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+func NewInvalidCountryCodeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Country code must be an ISO 3166-1 alpha-2 and alpha-3 code", opts...)
+}
+
+func NewInvalidAirportCodeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Airport code must be a 3-letter IATA code and a 4-letter ICAO code", opts...)
+}
+
+func NewInvalidCityCodeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("City code must be a UN/LOCODE starting with its country code", opts...)
+}
+
+func NewEmptyNameError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Reference data name must not be empty", opts...)
+}
@@ -0,0 +1,128 @@
+package reference
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+)
+
+var (
+	alpha2Pattern = regexp.MustCompile(`^[A-Z]{2}$`)
+	alpha3Pattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	iataPattern   = regexp.MustCompile(`^[A-Z0-9]{3}$`)
+	icaoPattern   = regexp.MustCompile(`^[A-Z0-9]{4}$`)
+	locodePattern = regexp.MustCompile(`^[A-Z]{2}[A-Z2-9]{3}$`)
+)
+
+// Country は ISO 3166-1 の国コードで識別される国（地域を含む）を表現するエンティティ
+type Country struct {
+	code       string
+	alpha3Code string
+	name       string
+	currency   string
+}
+
+// NewCountry は国を作成する。code は ISO 3166-1 alpha-2、alpha3Code は alpha-3、currency は ISO 4217 の通貨コード
+func NewCountry(code, alpha3Code, name, currency string) (*Country, error) {
+	if !alpha2Pattern.MatchString(code) || !alpha3Pattern.MatchString(alpha3Code) {
+		return nil, NewInvalidCountryCodeError()
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, NewEmptyNameError()
+	}
+	if !money.IsValidCurrencyCode(currency) {
+		return nil, money.NewInvalidCurrencyError()
+	}
+	return &Country{
+		code:       code,
+		alpha3Code: alpha3Code,
+		name:       name,
+		currency:   currency,
+	}, nil
+}
+
+// Getters
+func (c *Country) Code() string       { return c.code }
+func (c *Country) Alpha3Code() string { return c.alpha3Code }
+func (c *Country) Name() string       { return c.name }
+func (c *Country) Currency() string   { return c.currency }
+
+// Airport は IATA の空港コードで識別される空港を表現するエンティティ
+type Airport struct {
+	iataCode    string
+	icaoCode    string
+	name        string
+	city        string
+	countryCode string
+	coordinate  geo.Coordinate
+	timezone    geo.Timezone
+}
+
+// NewAirport は空港を作成する。city は空港が主に発着する都市の名前、countryCode は ISO 3166-1 alpha-2 の国コード
+func NewAirport(iataCode, icaoCode, name, city, countryCode string, coordinate geo.Coordinate, timezone geo.Timezone) (*Airport, error) {
+	if !iataPattern.MatchString(iataCode) || !icaoPattern.MatchString(icaoCode) {
+		return nil, NewInvalidAirportCodeError()
+	}
+	if !alpha2Pattern.MatchString(countryCode) {
+		return nil, NewInvalidCountryCodeError()
+	}
+	if strings.TrimSpace(name) == "" || strings.TrimSpace(city) == "" {
+		return nil, NewEmptyNameError()
+	}
+	return &Airport{
+		iataCode:    iataCode,
+		icaoCode:    icaoCode,
+		name:        name,
+		city:        city,
+		countryCode: countryCode,
+		coordinate:  coordinate,
+		timezone:    timezone,
+	}, nil
+}
+
+// Getters
+func (a *Airport) IATACode() string           { return a.iataCode }
+func (a *Airport) ICAOCode() string           { return a.icaoCode }
+func (a *Airport) Name() string               { return a.name }
+func (a *Airport) City() string               { return a.city }
+func (a *Airport) CountryCode() string        { return a.countryCode }
+func (a *Airport) Coordinate() geo.Coordinate { return a.coordinate }
+func (a *Airport) Timezone() geo.Timezone     { return a.timezone }
+
+// City は UN/LOCODE（例: JPTYO）で識別される都市を表現するエンティティ
+type City struct {
+	code        string
+	name        string
+	countryCode string
+	coordinate  geo.Coordinate
+	timezone    geo.Timezone
+}
+
+// NewCity は都市を作成する。UN/LOCODE の先頭 2 文字は国コードと一致しなければならない
+func NewCity(code, name, countryCode string, coordinate geo.Coordinate, timezone geo.Timezone) (*City, error) {
+	if !alpha2Pattern.MatchString(countryCode) {
+		return nil, NewInvalidCountryCodeError()
+	}
+	if !locodePattern.MatchString(code) || !strings.HasPrefix(code, countryCode) {
+		return nil, NewInvalidCityCodeError()
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, NewEmptyNameError()
+	}
+	return &City{
+		code:        code,
+		name:        name,
+		countryCode: countryCode,
+		coordinate:  coordinate,
+		timezone:    timezone,
+	}, nil
+}
+
+// Getters
+func (c *City) Code() string               { return c.code }
+func (c *City) Name() string               { return c.name }
+func (c *City) CountryCode() string        { return c.countryCode }
+func (c *City) Coordinate() geo.Coordinate { return c.coordinate }
+func (c *City) Timezone() geo.Timezone     { return c.timezone }
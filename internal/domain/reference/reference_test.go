@@ -0,0 +1,107 @@
+package reference
+
+import (
+	"testing"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCountry(t *testing.T) {
+	t.Run("正常系", func(t *testing.T) {
+		c, err := NewCountry("JP", "JPN", "Japan", "JPY")
+
+		require.NoError(t, err)
+		assert.Equal(t, "JP", c.Code())
+		assert.Equal(t, "JPN", c.Alpha3Code())
+		assert.Equal(t, "Japan", c.Name())
+		assert.Equal(t, "JPY", c.Currency())
+	})
+
+	tests := []struct {
+		name                                    string
+		code, alpha3Code, countryName, currency string
+	}{
+		{name: "alpha-2 が小文字", code: "jp", alpha3Code: "JPN", countryName: "Japan", currency: "JPY"},
+		{name: "alpha-3 が 2 文字", code: "JP", alpha3Code: "JP", countryName: "Japan", currency: "JPY"},
+		{name: "名前が空白のみ", code: "JP", alpha3Code: "JPN", countryName: " ", currency: "JPY"},
+		{name: "通貨コードが不正", code: "JP", alpha3Code: "JPN", countryName: "Japan", currency: "yen"},
+	}
+	for _, tt := range tests {
+		t.Run("異常系: "+tt.name, func(t *testing.T) {
+			_, err := NewCountry(tt.code, tt.alpha3Code, tt.countryName, tt.currency)
+
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+		})
+	}
+}
+
+func TestNewAirport(t *testing.T) {
+	coordinate, err := geo.NewCoordinate(35.5523, 139.7798)
+	require.NoError(t, err)
+	timezone, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+
+	t.Run("正常系", func(t *testing.T) {
+		a, err := NewAirport("HND", "RJTT", "Haneda Airport", "Tokyo", "JP", coordinate, timezone)
+
+		require.NoError(t, err)
+		assert.Equal(t, "HND", a.IATACode())
+		assert.Equal(t, "RJTT", a.ICAOCode())
+		assert.Equal(t, "Tokyo", a.City())
+		assert.Equal(t, "JP", a.CountryCode())
+		assert.True(t, coordinate.Equals(a.Coordinate()))
+		assert.Equal(t, "Asia/Tokyo", a.Timezone().Name())
+	})
+
+	tests := []struct {
+		name                                       string
+		iata, icao, airportName, city, countryCode string
+	}{
+		{name: "IATA コードが 4 文字", iata: "HNDA", icao: "RJTT", airportName: "Haneda Airport", city: "Tokyo", countryCode: "JP"},
+		{name: "ICAO コードが 3 文字", iata: "HND", icao: "RJT", airportName: "Haneda Airport", city: "Tokyo", countryCode: "JP"},
+		{name: "国コードが不正", iata: "HND", icao: "RJTT", airportName: "Haneda Airport", city: "Tokyo", countryCode: "JPN"},
+		{name: "都市名が空", iata: "HND", icao: "RJTT", airportName: "Haneda Airport", city: "", countryCode: "JP"},
+	}
+	for _, tt := range tests {
+		t.Run("異常系: "+tt.name, func(t *testing.T) {
+			_, err := NewAirport(tt.iata, tt.icao, tt.airportName, tt.city, tt.countryCode, coordinate, timezone)
+
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+		})
+	}
+}
+
+func TestNewCity(t *testing.T) {
+	coordinate, err := geo.NewCoordinate(35.6895, 139.6917)
+	require.NoError(t, err)
+	timezone, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+
+	t.Run("正常系", func(t *testing.T) {
+		c, err := NewCity("JPTYO", "Tokyo", "JP", coordinate, timezone)
+
+		require.NoError(t, err)
+		assert.Equal(t, "JPTYO", c.Code())
+		assert.Equal(t, "Tokyo", c.Name())
+		assert.Equal(t, "JP", c.CountryCode())
+	})
+
+	tests := []struct {
+		name                        string
+		code, cityName, countryCode string
+	}{
+		{name: "UN/LOCODE の国コードが一致しない", code: "USTYO", cityName: "Tokyo", countryCode: "JP"},
+		{name: "UN/LOCODE に 0 と 1 は使えない", code: "JPT01", cityName: "Tokyo", countryCode: "JP"},
+		{name: "名前が空白のみ", code: "JPTYO", cityName: " ", countryCode: "JP"},
+	}
+	for _, tt := range tests {
+		t.Run("異常系: "+tt.name, func(t *testing.T) {
+			_, err := NewCity(tt.code, tt.cityName, tt.countryCode, coordinate, timezone)
+
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package reference
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/search"
+)
+
+//go:generate mockgen -destination mock/reference.go github.com/hata0/travel-api/internal/domain/reference ReferenceRepository
+type ReferenceRepository interface {
+	// SaveCountry は国を作成し、同じ国コードが既に存在する場合は上書きする
+	SaveCountry(ctx context.Context, country *Country) error
+	// SaveAirport は空港を作成し、同じ IATA コードが既に存在する場合は上書きする。国は先に保存しておく必要がある
+	SaveAirport(ctx context.Context, airport *Airport) error
+	// SaveCity は都市を作成し、同じ UN/LOCODE が既に存在する場合は上書きする。国は先に保存しておく必要がある
+	SaveCity(ctx context.Context, city *City) error
+	// ListCountries はすべての国を名前の順に取得する
+	ListCountries(ctx context.Context) ([]*Country, error)
+	// SearchCountries はコードの完全一致、名前の前方一致、あいまい一致の順に関連度の高い国を最大 limit 件取得する
+	SearchCountries(ctx context.Context, query search.Query, limit int) ([]*Country, error)
+	// SearchAirports はコードの完全一致、名前か都市名の前方一致、あいまい一致の順に関連度の高い空港を最大 limit 件取得する
+	SearchAirports(ctx context.Context, query search.Query, limit int) ([]*Airport, error)
+	// SearchCities はコードの完全一致、名前の前方一致、あいまい一致の順に関連度の高い都市を最大 limit 件取得する
+	SearchCities(ctx context.Context, query search.Query, limit int) ([]*City, error)
+}
@@ -0,0 +1,11 @@
+package search
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+func NewEmptyQueryError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Search query must not be empty", opts...)
+}
+
+func NewQueryTooLongError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Search query must be at most 200 characters", opts...)
+}
@@ -0,0 +1,14 @@
+package search
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+//go:generate mockgen -destination mock/search.go github.com/hata0/travel-api/internal/domain/search SearchRepository
+type SearchRepository interface {
+	// Search は指定されたユーザーがメンバーとして参加している旅行のリソースから検索語に一致するものを、
+	// リソースの種類ごとに関連度の高い順で最大 limitPerType 件ずつ取得する
+	Search(ctx context.Context, userID user.UserID, query Query, limitPerType int) ([]*Hit, error)
+}
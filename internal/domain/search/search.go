@@ -0,0 +1,104 @@
+package search
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// MaxQueryLength は検索語の最大文字数
+const MaxQueryLength = 200
+
+// ResourceType は検索対象のリソースの種類
+type ResourceType string
+
+const (
+	ResourceTypeTrip          ResourceType = "trip"
+	ResourceTypeAccommodation ResourceType = "accommodation"
+	ResourceTypeExpense       ResourceType = "expense"
+	ResourceTypeJournalEntry  ResourceType = "journal_entry"
+	ResourceTypeActivity      ResourceType = "activity"
+)
+
+// ResourceTypes は検索結果をまとめて返す際のリソースの種類の順序
+var ResourceTypes = []ResourceType{ResourceTypeTrip, ResourceTypeAccommodation, ResourceTypeExpense, ResourceTypeJournalEntry, ResourceTypeActivity}
+
+func (t ResourceType) String() string {
+	return string(t)
+}
+
+// Query は検索語を表現する値オブジェクト
+type Query struct {
+	text string
+}
+
+// NewQuery は前後の空白を取り除いた検索語を作成する。空または長すぎる場合はエラーになる
+func NewQuery(text string) (Query, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return Query{}, NewEmptyQueryError()
+	}
+	if utf8.RuneCountInString(text) > MaxQueryLength {
+		return Query{}, NewQueryTooLongError()
+	}
+	return Query{text: text}, nil
+}
+
+func (q Query) String() string {
+	return q.text
+}
+
+// Terms は空白で区切られた検索語の一覧を返す。ハイライトの対象を決めるために使用する
+func (q Query) Terms() []string {
+	return strings.Fields(q.text)
+}
+
+// SubstringPatterns は検索語ごとに部分一致で探す LIKE パターンを返す。
+// 単語を空白で区切らない日本語の文章は全文検索では単語に分割されないため、代わりにすべての検索語を部分一致で探す
+func (q Query) SubstringPatterns() []string {
+	terms := q.Terms()
+	patterns := make([]string, 0, len(terms))
+	for _, term := range terms {
+		patterns = append(patterns, "%"+likeEscaper.Replace(term)+"%")
+	}
+	return patterns
+}
+
+// PrefixPattern は検索語全体で前方一致を探す LIKE パターンを返す
+func (q Query) PrefixPattern() string {
+	return likeEscaper.Replace(q.text) + "%"
+}
+
+// likeEscaper は LIKE の特殊文字をエスケープする
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Hit は検索に一致したリソースを表現する。title はリソースの名前、body はそれ以外の検索対象の文章
+type Hit struct {
+	resourceType ResourceType
+	resourceID   string
+	tripID       trip.TripID
+	title        string
+	body         string
+	rank         float64
+}
+
+func NewHit(resourceType ResourceType, resourceID string, tripID trip.TripID, title, body string, rank float64) *Hit {
+	return &Hit{
+		resourceType: resourceType,
+		resourceID:   resourceID,
+		tripID:       tripID,
+		title:        title,
+		body:         body,
+		rank:         rank,
+	}
+}
+
+func (h *Hit) ResourceType() ResourceType { return h.resourceType }
+func (h *Hit) ResourceID() string         { return h.resourceID }
+func (h *Hit) TripID() trip.TripID        { return h.tripID }
+func (h *Hit) Title() string              { return h.title }
+func (h *Hit) Body() string               { return h.body }
+
+// Rank は検索語との関連度。値が大きいほど関連度が高い
+func (h *Hit) Rank() float64 { return h.rank }
@@ -0,0 +1,58 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{name: "正常系: 前後の空白を取り除く", text: "  京都 旅館 ", want: "京都 旅館"},
+		{name: "正常系: 最大文字数", text: strings.Repeat("あ", MaxQueryLength), want: strings.Repeat("あ", MaxQueryLength)},
+		{name: "異常系: 空白のみ", text: "   ", wantErr: true},
+		{name: "異常系: 最大文字数を超える", text: strings.Repeat("あ", MaxQueryLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewQuery(tt.text)
+
+			if tt.wantErr {
+				assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestQuery_Terms(t *testing.T) {
+	q, err := NewQuery("京都  Ryokan\t温泉")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"京都", "Ryokan", "温泉"}, q.Terms())
+}
+
+func TestQuery_SubstringPatterns(t *testing.T) {
+	q, err := NewQuery(`京都 100%_off\`)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{`%京都%`, `%100\%\_off\\%`}, q.SubstringPatterns(), "検索語ごとにパターンを作成し、LIKE の特殊文字はエスケープされるべき")
+}
+
+func TestQuery_PrefixPattern(t *testing.T) {
+	q, err := NewQuery(` New York_% `)
+	require.NoError(t, err)
+
+	assert.Equal(t, `New York\_\%%`, q.PrefixPattern(), "検索語全体を前方一致で探し、LIKE の特殊文字はエスケープされるべき")
+}
@@ -0,0 +1,136 @@
+package search
+
+import (
+	"sort"
+	"unicode"
+)
+
+// ellipsis はスニペットで省略した部分を表す記号
+const ellipsis = "…"
+
+// Segment はスニペットを構成する文字列の一部分。Highlighted が true の部分は検索語に一致している。
+// HTML などのマークアップを含めずに返すことで、クライアントが安全に強調表示できるようにする
+type Segment struct {
+	Text        string
+	Highlighted bool
+}
+
+// Snippet は検索語に一致した箇所の前後を切り出した文章
+type Snippet []Segment
+
+// NewSnippet は text から検索語 terms に最初に一致した箇所の周辺を最大 maxRunes 文字切り出し、
+// 一致した箇所を強調表示の対象として分割する。大文字と小文字は区別しない
+func NewSnippet(text string, terms []string, maxRunes int) Snippet {
+	runes := []rune(text)
+	if len(runes) == 0 {
+		return Snippet{}
+	}
+
+	matches := findMatches(runes, terms)
+
+	start, end := 0, len(runes)
+	if maxRunes > 0 && len(runes) > maxRunes {
+		anchor := 0
+		if len(matches) > 0 {
+			anchor = matches[0].start
+		}
+		// 一致した箇所の前にも文脈が残るよう、切り出す範囲の 1/4 を一致箇所より前に割り当てる
+		start = max(0, anchor-maxRunes/4)
+		end = min(len(runes), start+maxRunes)
+		start = max(0, end-maxRunes)
+	}
+
+	var snippet Snippet
+	appendText := func(s string, highlighted bool) {
+		if s == "" {
+			return
+		}
+		if n := len(snippet); n > 0 && snippet[n-1].Highlighted == highlighted {
+			snippet[n-1].Text += s
+			return
+		}
+		snippet = append(snippet, Segment{Text: s, Highlighted: highlighted})
+	}
+
+	if start > 0 {
+		appendText(ellipsis, false)
+	}
+	pos := start
+	for _, m := range matches {
+		if m.end <= start || m.start >= end {
+			continue
+		}
+		from, to := max(m.start, start), min(m.end, end)
+		appendText(string(runes[pos:from]), false)
+		appendText(string(runes[from:to]), true)
+		pos = to
+	}
+	appendText(string(runes[pos:end]), false)
+	if end < len(runes) {
+		appendText(ellipsis, false)
+	}
+
+	return snippet
+}
+
+// HasHighlight は検索語に一致した箇所を含むかどうかを返す
+func (s Snippet) HasHighlight() bool {
+	for _, segment := range s {
+		if segment.Highlighted {
+			return true
+		}
+	}
+	return false
+}
+
+type runeRange struct {
+	start, end int
+}
+
+// findMatches は runes の中で terms のいずれかに一致する範囲を、重なりを統合して先頭から順に返す
+func findMatches(runes []rune, terms []string) []runeRange {
+	folded := foldRunes(runes)
+
+	var matches []runeRange
+	for _, term := range terms {
+		pattern := foldRunes([]rune(term))
+		if len(pattern) == 0 {
+			continue
+		}
+		for i := 0; i+len(pattern) <= len(folded); i++ {
+			if equalRunes(folded[i:i+len(pattern)], pattern) {
+				matches = append(matches, runeRange{start: i, end: i + len(pattern)})
+			}
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool { return matches[i].start < matches[j].start })
+
+	merged := make([]runeRange, 0, len(matches))
+	for _, m := range matches {
+		if n := len(merged); n > 0 && m.start <= merged[n-1].end {
+			merged[n-1].end = max(merged[n-1].end, m.end)
+			continue
+		}
+		merged = append(merged, m)
+	}
+	return merged
+}
+
+// foldRunes は大文字と小文字を区別せずに比較するため、文字数を変えずに小文字へ変換する
+func foldRunes(runes []rune) []rune {
+	folded := make([]rune, len(runes))
+	for i, r := range runes {
+		folded[i] = unicode.ToLower(r)
+	}
+	return folded
+}
+
+func equalRunes(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
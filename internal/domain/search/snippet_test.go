@@ -0,0 +1,73 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSnippet(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		terms    []string
+		maxRunes int
+		want     Snippet
+	}{
+		{
+			name:     "正常系: 日本語の部分一致を強調する",
+			text:     "京都駅から徒歩5分の旅館",
+			terms:    []string{"旅館"},
+			maxRunes: 100,
+			want:     Snippet{{Text: "京都駅から徒歩5分の"}, {Text: "旅館", Highlighted: true}},
+		},
+		{
+			name:     "正常系: 大文字と小文字を区別しない",
+			text:     "Hotel Kyoto",
+			terms:    []string{"kyoto"},
+			maxRunes: 100,
+			want:     Snippet{{Text: "Hotel "}, {Text: "Kyoto", Highlighted: true}},
+		},
+		{
+			name:     "正常系: 重なる一致箇所は統合する",
+			text:     "abcdef",
+			terms:    []string{"bcd", "cde"},
+			maxRunes: 100,
+			want:     Snippet{{Text: "a"}, {Text: "bcde", Highlighted: true}, {Text: "f"}},
+		},
+		{
+			name:     "正常系: 長い文章は一致箇所の周辺を切り出す",
+			text:     "0123456789京都0123456789",
+			terms:    []string{"京都"},
+			maxRunes: 8,
+			want:     Snippet{{Text: "…89"}, {Text: "京都", Highlighted: true}, {Text: "0123…"}},
+		},
+		{
+			name:     "正常系: 一致しない場合は先頭から切り出す",
+			text:     "0123456789",
+			terms:    []string{"京都"},
+			maxRunes: 4,
+			want:     Snippet{{Text: "0123…"}},
+		},
+		{
+			name:     "正常系: 空の文章",
+			text:     "",
+			terms:    []string{"京都"},
+			maxRunes: 4,
+			want:     Snippet{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSnippet(tt.text, tt.terms, tt.maxRunes)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSnippet_HasHighlight(t *testing.T) {
+	assert.True(t, NewSnippet("京都の旅館", []string{"旅館"}, 100).HasHighlight())
+	assert.False(t, NewSnippet("京都の旅館", []string{"ホテル"}, 100).HasHighlight())
+}
@@ -0,0 +1,11 @@
+package settlement
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+func NewInvalidTransferParticipantsError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Transfer requires different sender and recipient", opts...)
+}
+
+func NewNonPositiveTransferAmountError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Transfer amount must be greater than zero", opts...)
+}
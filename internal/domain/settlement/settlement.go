@@ -0,0 +1,206 @@
+// Package settlement は参加者間の立て替えを精算するためのドメインサービスを提供する
+package settlement
+
+import (
+	"sort"
+
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+)
+
+// Balance は参加者ごとの収支。正の値は受け取るべき額、負の値は支払うべき額を表す
+type Balance struct {
+	participant string
+	amount      money.Money
+}
+
+// Getters
+func (b Balance) Participant() string { return b.participant }
+func (b Balance) Amount() money.Money { return b.amount }
+
+// Transfer は精算のための送金（from から to へ amount を支払う）
+type Transfer struct {
+	from   string
+	to     string
+	amount money.Money
+}
+
+// Getters
+func (t Transfer) From() string        { return t.from }
+func (t Transfer) To() string          { return t.to }
+func (t Transfer) Amount() money.Money { return t.amount }
+
+// Settlement は 1 つの通貨における精算結果
+type Settlement struct {
+	currency  string
+	balances  []Balance
+	transfers []Transfer
+}
+
+// Getters
+func (s *Settlement) Currency() string      { return s.currency }
+func (s *Settlement) Balances() []Balance   { return s.balances }
+func (s *Settlement) Transfers() []Transfer { return s.transfers }
+
+// SettleUp は支出から参加者ごとの収支と、収支を 0 にするための送金の一覧を通貨ごとに計算する。
+// 結果は通貨コード順に並び、各通貨の収支は参加者名順に並ぶ。
+//
+// 支払者は支払った額を受け取る権利を持ち、各参加者は自分の分担額を支払う義務を負う。
+// 精算（CategorySettlement）の支出も同じ規則で扱うため、記録済みの送金は自動的に収支へ反映される。
+// 通貨の換算は行わないため、異なる通貨の支出は別々に精算する
+func SettleUp(expenses []*expense.Expense) ([]*Settlement, error) {
+	nets := make(map[string]map[string]money.Money)
+	for _, e := range expenses {
+		shares, err := e.Shares()
+		if err != nil {
+			return nil, err
+		}
+
+		currency := e.Amount().Currency()
+		if nets[currency] == nil {
+			nets[currency] = make(map[string]money.Money)
+		}
+		if err := addNet(nets[currency], e.Payer(), e.Amount()); err != nil {
+			return nil, err
+		}
+		for _, share := range shares {
+			owed, err := money.Zero(currency)
+			if err != nil {
+				return nil, err
+			}
+			if owed, err = owed.Sub(share.Amount()); err != nil {
+				return nil, err
+			}
+			if err := addNet(nets[currency], share.Participant(), owed); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	currencies := make([]string, 0, len(nets))
+	for currency := range nets {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	settlements := make([]*Settlement, 0, len(currencies))
+	for _, currency := range currencies {
+		s, err := settle(currency, nets[currency])
+		if err != nil {
+			return nil, err
+		}
+		settlements = append(settlements, s)
+	}
+	return settlements, nil
+}
+
+// addNet は参加者の収支に amount を加算する。初めて現れた参加者の収支は 0 から始める
+func addNet(nets map[string]money.Money, participant string, amount money.Money) error {
+	net, ok := nets[participant]
+	if !ok {
+		nets[participant] = amount
+		return nil
+	}
+	sum, err := net.Add(amount)
+	if err != nil {
+		return err
+	}
+	nets[participant] = sum
+	return nil
+}
+
+func settle(currency string, nets map[string]money.Money) (*Settlement, error) {
+	participants := make([]string, 0, len(nets))
+	for participant := range nets {
+		participants = append(participants, participant)
+	}
+	sort.Strings(participants)
+
+	balances := make([]Balance, len(participants))
+	for i, participant := range participants {
+		balances[i] = Balance{participant: participant, amount: nets[participant]}
+	}
+
+	transfers, err := minimizeTransfers(currency, participants, nets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Settlement{currency: currency, balances: balances, transfers: transfers}, nil
+}
+
+// position は送金の計算中に残っている参加者の未精算額（常に正の値）
+type position struct {
+	participant string
+	amount      int64
+}
+
+// minimizeTransfers は収支を 0 にする送金の一覧を計算する。
+// 送金回数を厳密に最小化する問題は NP 困難なため、次の手順で近似する。
+//  1. 支払うべき額と受け取るべき額がちょうど一致する参加者同士を先に組み合わせる
+//  2. 残りは支払うべき額が最も大きい参加者から受け取るべき額が最も大きい参加者へ、どちらかが 0 になるまで送金する
+//
+// これにより送金は高々（収支が 0 でない参加者数 - 1）件になる。額が同じ場合は参加者名順に処理するため、結果は入力順に依存しない
+func minimizeTransfers(currency string, participants []string, nets map[string]money.Money) ([]Transfer, error) {
+	var debtors, creditors []position
+	for _, participant := range participants {
+		switch net := nets[participant].Amount(); {
+		case net < 0:
+			debtors = append(debtors, position{participant: participant, amount: -net})
+		case net > 0:
+			creditors = append(creditors, position{participant: participant, amount: net})
+		}
+	}
+
+	var transfers []Transfer
+	record := func(from, to string, amount int64) error {
+		m, err := money.NewMoney(amount, currency)
+		if err != nil {
+			return err
+		}
+		transfers = append(transfers, Transfer{from: from, to: to, amount: m})
+		return nil
+	}
+
+	// 1. 額がちょうど一致する組は 1 回の送金で両者とも精算が終わる
+	for i := range debtors {
+		for j := range creditors {
+			if creditors[j].amount == 0 || creditors[j].amount != debtors[i].amount {
+				continue
+			}
+			if err := record(debtors[i].participant, creditors[j].participant, debtors[i].amount); err != nil {
+				return nil, err
+			}
+			debtors[i].amount, creditors[j].amount = 0, 0
+			break
+		}
+	}
+
+	// 2. 残りは額の大きい順に貪欲に組み合わせる
+	for {
+		debtor := largest(debtors)
+		creditor := largest(creditors)
+		if debtor == nil || creditor == nil {
+			break
+		}
+		amount := min(debtor.amount, creditor.amount)
+		if err := record(debtor.participant, creditor.participant, amount); err != nil {
+			return nil, err
+		}
+		debtor.amount -= amount
+		creditor.amount -= amount
+	}
+
+	return transfers, nil
+}
+
+// largest は未精算額が最も大きい参加者を返す。額が同じ場合は先頭（参加者名順で早い方）を返し、全員精算済みなら nil を返す
+func largest(positions []position) *position {
+	var found *position
+	for i := range positions {
+		if positions[i].amount > 0 && (found == nil || positions[i].amount > found.amount) {
+			found = &positions[i]
+		}
+	}
+	return found
+}
@@ -0,0 +1,307 @@
+package settlement
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testDate = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestMoney(t *testing.T, amount int64, currency string) money.Money {
+	t.Helper()
+	m, err := money.NewMoney(amount, currency)
+	require.NoError(t, err)
+	return m
+}
+
+// expenseSpec はテスト用の支出の簡易表現。participants が空の場合は分担なし
+type expenseSpec struct {
+	payer        string
+	amount       int64
+	currency     string
+	method       expense.SplitMethod
+	participants []expense.SplitEntry
+}
+
+func equally(participants ...string) (expense.SplitMethod, []expense.SplitEntry) {
+	entries := make([]expense.SplitEntry, len(participants))
+	for i, p := range participants {
+		entries[i] = expense.NewSplitEntry(p, 0)
+	}
+	return expense.SplitMethodEqual, entries
+}
+
+func spec(payer string, amount int64, currency string, method expense.SplitMethod, entries []expense.SplitEntry) expenseSpec {
+	return expenseSpec{payer: payer, amount: amount, currency: currency, method: method, participants: entries}
+}
+
+func buildExpenses(t *testing.T, specs []expenseSpec) []*expense.Expense {
+	t.Helper()
+
+	expenses := make([]*expense.Expense, len(specs))
+	for i, s := range specs {
+		var split *expense.Split
+		if len(s.participants) > 0 {
+			var err error
+			split, err = expense.NewSplit(s.method, s.participants)
+			require.NoError(t, err)
+		}
+		expenses[i] = expense.NewExpense(expense.NewExpenseID("expense-id"), trip.NewTripID("trip-id"),
+			newTestMoney(t, s.amount, s.currency), expense.CategoryFood, testDate, s.payer, "", nil, split, testDate, testDate)
+	}
+	return expenses
+}
+
+type wantTransfer struct {
+	from, to string
+	amount   int64
+}
+
+func TestSettleUp(t *testing.T) {
+	tests := []struct {
+		name          string
+		expenses      []expenseSpec
+		wantCurrency  []string
+		wantBalances  []map[string]int64
+		wantTransfers [][]wantTransfer
+	}{
+		{
+			name:     "支出がない",
+			expenses: nil,
+		},
+		{
+			name: "分担のない支出は収支に影響しない",
+			expenses: []expenseSpec{
+				{payer: "Alice", amount: 1000, currency: "JPY"},
+			},
+			wantCurrency:  []string{"JPY"},
+			wantBalances:  []map[string]int64{{"Alice": 0}},
+			wantTransfers: [][]wantTransfer{nil},
+		},
+		{
+			name: "1 人が全員分を立て替えた",
+			expenses: []expenseSpec{
+				spec("Alice", 3000, "JPY", expense.SplitMethod(""), nil),
+			},
+			wantCurrency:  []string{"JPY"},
+			wantBalances:  []map[string]int64{{"Alice": 0}},
+			wantTransfers: [][]wantTransfer{nil},
+		},
+		{
+			name: "均等に分担した支出を 1 人が立て替えた",
+			expenses: func() []expenseSpec {
+				m, e := equally("Alice", "Bob", "Carol")
+				return []expenseSpec{spec("Alice", 3000, "JPY", m, e)}
+			}(),
+			wantCurrency: []string{"JPY"},
+			wantBalances: []map[string]int64{{"Alice": 2000, "Bob": -1000, "Carol": -1000}},
+			wantTransfers: [][]wantTransfer{{
+				{from: "Bob", to: "Alice", amount: 1000},
+				{from: "Carol", to: "Alice", amount: 1000},
+			}},
+		},
+		{
+			name: "相互に立て替えた分は相殺される",
+			expenses: func() []expenseSpec {
+				m, e := equally("Alice", "Bob")
+				return []expenseSpec{
+					spec("Alice", 3000, "JPY", m, e),
+					spec("Bob", 1000, "JPY", m, e),
+				}
+			}(),
+			wantCurrency:  []string{"JPY"},
+			wantBalances:  []map[string]int64{{"Alice": 1000, "Bob": -1000}},
+			wantTransfers: [][]wantTransfer{{{from: "Bob", to: "Alice", amount: 1000}}},
+		},
+		{
+			name: "支払うべき額と受け取るべき額が大きい参加者から順に組み合わせる",
+			expenses: []expenseSpec{
+				spec("Alice", 6000, "JPY", expense.SplitMethodExact, []expense.SplitEntry{expense.NewSplitEntry("Carol", 2000), expense.NewSplitEntry("Dave", 4000)}),
+				spec("Bob", 4000, "JPY", expense.SplitMethodExact, []expense.SplitEntry{expense.NewSplitEntry("Dave", 1000), expense.NewSplitEntry("Carol", 3000)}),
+			},
+			wantCurrency: []string{"JPY"},
+			wantBalances: []map[string]int64{{"Alice": 6000, "Bob": 4000, "Carol": -5000, "Dave": -5000}},
+			wantTransfers: [][]wantTransfer{{
+				{from: "Carol", to: "Alice", amount: 5000},
+				{from: "Dave", to: "Bob", amount: 4000},
+				{from: "Dave", to: "Alice", amount: 1000},
+			}},
+		},
+		{
+			name: "額がちょうど一致する組は 1 回で精算する",
+			expenses: []expenseSpec{
+				spec("Alice", 700, "JPY", expense.SplitMethodExact, []expense.SplitEntry{expense.NewSplitEntry("Carol", 700)}),
+				spec("Bob", 300, "JPY", expense.SplitMethodExact, []expense.SplitEntry{expense.NewSplitEntry("Dave", 300)}),
+			},
+			wantCurrency: []string{"JPY"},
+			wantBalances: []map[string]int64{{"Alice": 700, "Bob": 300, "Carol": -700, "Dave": -300}},
+			wantTransfers: [][]wantTransfer{{
+				{from: "Carol", to: "Alice", amount: 700},
+				{from: "Dave", to: "Bob", amount: 300},
+			}},
+		},
+		{
+			name: "端数のある均等割りでも収支の合計は 0 になる",
+			expenses: func() []expenseSpec {
+				m, e := equally("Alice", "Bob", "Carol")
+				return []expenseSpec{spec("Alice", 1000, "USD", m, e)}
+			}(),
+			wantCurrency: []string{"USD"},
+			// 分担額は Alice 334 / Bob 333 / Carol 333
+			wantBalances: []map[string]int64{{"Alice": 666, "Bob": -333, "Carol": -333}},
+			wantTransfers: [][]wantTransfer{{
+				{from: "Bob", to: "Alice", amount: 333},
+				{from: "Carol", to: "Alice", amount: 333},
+			}},
+		},
+		{
+			name: "通貨ごとに別々に精算する",
+			expenses: func() []expenseSpec {
+				m, e := equally("Alice", "Bob")
+				return []expenseSpec{
+					spec("Bob", 2000, "USD", m, e),
+					spec("Alice", 4000, "JPY", m, e),
+				}
+			}(),
+			wantCurrency: []string{"JPY", "USD"},
+			wantBalances: []map[string]int64{
+				{"Alice": 2000, "Bob": -2000},
+				{"Alice": -1000, "Bob": 1000},
+			},
+			wantTransfers: [][]wantTransfer{
+				{{from: "Bob", to: "Alice", amount: 2000}},
+				{{from: "Alice", to: "Bob", amount: 1000}},
+			},
+		},
+		{
+			name: "記録済みの精算は収支に反映される",
+			expenses: func() []expenseSpec {
+				m, e := equally("Alice", "Bob")
+				return []expenseSpec{
+					spec("Alice", 3000, "JPY", m, e),
+					spec("Bob", 1000, "JPY", expense.SplitMethodExact, []expense.SplitEntry{expense.NewSplitEntry("Alice", 1000)}),
+				}
+			}(),
+			wantCurrency:  []string{"JPY"},
+			wantBalances:  []map[string]int64{{"Alice": 500, "Bob": -500}},
+			wantTransfers: [][]wantTransfer{{{from: "Bob", to: "Alice", amount: 500}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settlements, err := SettleUp(buildExpenses(t, tt.expenses))
+
+			require.NoError(t, err)
+			require.Len(t, settlements, len(tt.wantCurrency))
+			for i, s := range settlements {
+				assert.Equal(t, tt.wantCurrency[i], s.Currency())
+
+				balances := make(map[string]int64)
+				var sum int64
+				for _, b := range s.Balances() {
+					assert.Equal(t, s.Currency(), b.Amount().Currency())
+					balances[b.Participant()] = b.Amount().Amount()
+					sum += b.Amount().Amount()
+				}
+				assert.Equal(t, tt.wantBalances[i], balances)
+				assert.Zero(t, sum, "収支の合計は 0 になるべき")
+
+				var transfers []wantTransfer
+				for _, tr := range s.Transfers() {
+					assert.Equal(t, s.Currency(), tr.Amount().Currency())
+					transfers = append(transfers, wantTransfer{from: tr.From(), to: tr.To(), amount: tr.Amount().Amount()})
+				}
+				assert.Equal(t, tt.wantTransfers[i], transfers)
+			}
+		})
+	}
+}
+
+func TestSettleUp_TransfersSettleAllBalances(t *testing.T) {
+	m, e := equally("Alice", "Bob", "Carol", "Dave", "Eve")
+	specs := []expenseSpec{
+		spec("Alice", 12345, "JPY", m, e),
+		spec("Bob", 6789, "JPY", expense.SplitMethodShares, []expense.SplitEntry{expense.NewSplitEntry("Bob", 1), expense.NewSplitEntry("Carol", 2), expense.NewSplitEntry("Eve", 3)}),
+		spec("Carol", 1000, "JPY", expense.SplitMethodPercentage, []expense.SplitEntry{expense.NewSplitEntry("Alice", 3333), expense.NewSplitEntry("Dave", 6667)}),
+		spec("Eve", 50, "JPY", m, e),
+	}
+
+	settlements, err := SettleUp(buildExpenses(t, specs))
+	require.NoError(t, err)
+	require.Len(t, settlements, 1)
+
+	remaining := make(map[string]int64)
+	nonZero := 0
+	for _, b := range settlements[0].Balances() {
+		remaining[b.Participant()] = b.Amount().Amount()
+		if b.Amount().Amount() != 0 {
+			nonZero++
+		}
+	}
+	for _, tr := range settlements[0].Transfers() {
+		assert.Positive(t, tr.Amount().Amount())
+		remaining[tr.From()] += tr.Amount().Amount()
+		remaining[tr.To()] -= tr.Amount().Amount()
+	}
+
+	for participant, amount := range remaining {
+		assert.Zero(t, amount, "%s の収支は送金後に 0 になるべき", participant)
+	}
+	assert.LessOrEqual(t, len(settlements[0].Transfers()), nonZero-1, "送金は収支が 0 でない参加者数 - 1 件以下であるべき")
+}
+
+func TestNewTransfer(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		amount  int64
+		wantErr bool
+	}{
+		{name: "正常系", from: "Bob", to: "Alice", amount: 1000},
+		{name: "異常系: 送金者が空", from: " ", to: "Alice", amount: 1000, wantErr: true},
+		{name: "異常系: 送金者と受取人が同じ", from: "Alice", to: "Alice ", amount: 1000, wantErr: true},
+		{name: "異常系: 金額が 0", from: "Bob", to: "Alice", amount: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTransfer(tt.from, tt.to, newTestMoney(t, tt.amount, "JPY"))
+
+			if tt.wantErr {
+				assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.from, got.From())
+			assert.Equal(t, tt.to, got.To())
+			assert.Equal(t, tt.amount, got.Amount().Amount())
+		})
+	}
+}
+
+func TestTransfer_ToExpense(t *testing.T) {
+	transfer, err := NewTransfer("Bob", "Alice", newTestMoney(t, 1500, "JPY"))
+	require.NoError(t, err)
+	now := time.Now()
+
+	e, err := transfer.ToExpense(expense.NewExpenseID("expense-id"), trip.NewTripID("trip-id"), testDate, "精算", now)
+
+	require.NoError(t, err)
+	assert.Equal(t, expense.CategorySettlement, e.Category())
+	assert.Equal(t, "Bob", e.Payer())
+	assert.Equal(t, int64(1500), e.Amount().Amount())
+	assert.Equal(t, "精算", e.Description())
+	assert.Equal(t, now, e.CreatedAt())
+	shares, err := e.Shares()
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	assert.Equal(t, "Alice", shares[0].Participant(), "受取人が全額を分担するべき")
+	assert.Equal(t, int64(1500), shares[0].Amount().Amount())
+}
@@ -0,0 +1,45 @@
+package settlement
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// NewTransfer は送金を作成する。送金者と受取人は空でない別の参加者で、金額は正である必要がある
+func NewTransfer(from, to string, amount money.Money) (Transfer, error) {
+	from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+	if from == "" || to == "" || from == to {
+		return Transfer{}, NewInvalidTransferParticipantsError()
+	}
+	if amount.Amount() <= 0 {
+		return Transfer{}, NewNonPositiveTransferAmountError()
+	}
+	return Transfer{from: from, to: to, amount: amount}, nil
+}
+
+// ToExpense は送金を精算の支出として記録するための Expense に変換する。
+// 送金者が支払者となり、受取人が全額を分担するため、SettleUp では送金者の収支が増え受取人の収支が減る
+func (t Transfer) ToExpense(
+	id expense.ExpenseID,
+	tripID trip.TripID,
+	paidOn time.Time,
+	description string,
+	now time.Time,
+) (*expense.Expense, error) {
+	split, err := expense.NewSplit(expense.SplitMethodExact, []expense.SplitEntry{
+		expense.NewSplitEntry(t.to, t.amount.Amount()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	e := expense.NewExpense(id, tripID, t.amount, expense.CategorySettlement, paidOn, t.from, description, nil, split, now, now)
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
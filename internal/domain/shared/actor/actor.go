@@ -0,0 +1,23 @@
+package actor
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+type userIDKey struct{}
+
+// WithUserID は操作を実行するユーザーのIDをコンテキストに設定する
+func WithUserID(ctx context.Context, userID user.UserID) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserIDFromContext はコンテキストから操作を実行するユーザーのIDを取得する。設定されていない場合 ok は false
+func UserIDFromContext(ctx context.Context) (user.UserID, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(user.UserID)
+	if !ok || userID.String() == "" {
+		return user.UserID{}, false
+	}
+	return userID, true
+}
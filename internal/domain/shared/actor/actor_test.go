@@ -0,0 +1,35 @@
+package actor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+func TestUserIDFromContext(t *testing.T) {
+	t.Run("正常系: 設定したユーザーIDを取得できる", func(t *testing.T) {
+		ctx := WithUserID(context.Background(), user.NewUserID("user-1"))
+
+		userID, ok := UserIDFromContext(ctx)
+
+		assert.True(t, ok)
+		assert.Equal(t, user.NewUserID("user-1"), userID)
+	})
+
+	t.Run("異常系: 設定されていない場合は取得できない", func(t *testing.T) {
+		_, ok := UserIDFromContext(context.Background())
+
+		assert.False(t, ok)
+	})
+
+	t.Run("異常系: 空のユーザーIDは取得できない", func(t *testing.T) {
+		ctx := WithUserID(context.Background(), user.NewUserID(""))
+
+		_, ok := UserIDFromContext(ctx)
+
+		assert.False(t, ok)
+	})
+}
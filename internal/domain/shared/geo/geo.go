@@ -0,0 +1,89 @@
+package geo
+
+import (
+	"math"
+	"strings"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+)
+
+// Coordinate は WGS84 の緯度・経度を表現する値オブジェクト
+type Coordinate struct {
+	latitude  float64
+	longitude float64
+}
+
+// NewCoordinate は緯度・経度から座標を作成する。緯度は -90〜90、経度は -180〜180 の範囲でなければならない
+func NewCoordinate(latitude, longitude float64) (Coordinate, error) {
+	if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+		return Coordinate{}, NewInvalidCoordinateError()
+	}
+	return Coordinate{latitude: latitude, longitude: longitude}, nil
+}
+
+// Getters
+func (c Coordinate) Latitude() float64  { return c.latitude }
+func (c Coordinate) Longitude() float64 { return c.longitude }
+
+func (c Coordinate) Equals(other Coordinate) bool {
+	return c.latitude == other.latitude && c.longitude == other.longitude
+}
+
+// earthRadiusMeters は距離の計算に使う地球の平均半径
+const earthRadiusMeters = 6371008.8
+
+// DistanceMeters は other までの大円距離をメートル単位で返す。地球を球とみなすハバーサイン公式で計算する
+func (c Coordinate) DistanceMeters(other Coordinate) float64 {
+	lat1 := c.latitude * math.Pi / 180
+	lat2 := other.latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (other.longitude - c.longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Place は名前と任意の座標・タイムゾーンを持つ場所を表現する値オブジェクト
+type Place struct {
+	name       string
+	coordinate *Coordinate
+	timezone   *Timezone
+}
+
+// NewPlace は場所を作成する。名前は空白のみであってはならない。座標やタイムゾーンが不明な場合は nil を渡す
+func NewPlace(name string, coordinate *Coordinate, timezone *Timezone) (Place, error) {
+	if strings.TrimSpace(name) == "" {
+		return Place{}, NewEmptyPlaceNameError()
+	}
+	return Place{name: name, coordinate: coordinate, timezone: timezone}, nil
+}
+
+// Getters
+func (p Place) Name() string            { return p.name }
+func (p Place) Coordinate() *Coordinate { return p.coordinate }
+func (p Place) Timezone() *Timezone     { return p.timezone }
+
+func (p Place) Equals(other Place) bool {
+	if p.name != other.name {
+		return false
+	}
+	if (p.timezone == nil) != (other.timezone == nil) || (p.timezone != nil && !p.timezone.Equals(*other.timezone)) {
+		return false
+	}
+	if p.coordinate == nil || other.coordinate == nil {
+		return p.coordinate == nil && other.coordinate == nil
+	}
+	return p.coordinate.Equals(*other.coordinate)
+}
+
+func NewInvalidCoordinateError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Latitude must be between -90 and 90 and longitude between -180 and 180", opts...)
+}
+
+func NewIncompleteCoordinateError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Latitude and longitude must be specified together", opts...)
+}
+
+func NewEmptyPlaceNameError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Place name must not be empty", opts...)
+}
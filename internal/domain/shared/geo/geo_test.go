@@ -0,0 +1,230 @@
+package geo
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCoordinate(t *testing.T) {
+	t.Run("正常系", func(t *testing.T) {
+		c, err := NewCoordinate(35.6812, 139.7671)
+
+		require.NoError(t, err)
+		assert.Equal(t, 35.6812, c.Latitude())
+		assert.Equal(t, 139.7671, c.Longitude())
+	})
+
+	t.Run("正常系: 範囲の端", func(t *testing.T) {
+		_, err := NewCoordinate(-90, 180)
+
+		assert.NoError(t, err)
+	})
+
+	tests := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+	}{
+		{name: "緯度が 90 を超える", latitude: 90.1, longitude: 0},
+		{name: "緯度が -90 未満", latitude: -90.1, longitude: 0},
+		{name: "経度が 180 を超える", latitude: 0, longitude: 180.1},
+		{name: "経度が -180 未満", latitude: 0, longitude: -180.1},
+	}
+	for _, tt := range tests {
+		t.Run("異常系: "+tt.name, func(t *testing.T) {
+			_, err := NewCoordinate(tt.latitude, tt.longitude)
+
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+		})
+	}
+}
+
+func TestCoordinate_DistanceMeters(t *testing.T) {
+	tokyo, err := NewCoordinate(35.6812, 139.7671)
+	require.NoError(t, err)
+	shinOsaka, err := NewCoordinate(34.7334, 135.5002)
+	require.NoError(t, err)
+
+	t.Run("正常系: 東京駅から新大阪駅まで約 400km", func(t *testing.T) {
+		assert.InDelta(t, 403000, tokyo.DistanceMeters(shinOsaka), 2000)
+	})
+
+	t.Run("正常系: 向きによらず同じ距離", func(t *testing.T) {
+		assert.InDelta(t, tokyo.DistanceMeters(shinOsaka), shinOsaka.DistanceMeters(tokyo), 1e-6)
+	})
+
+	t.Run("正常系: 同じ座標は 0", func(t *testing.T) {
+		assert.Zero(t, tokyo.DistanceMeters(tokyo))
+	})
+}
+
+func TestCoordinate_GeodesicDistanceMeters(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     [2]float64
+		to       [2]float64
+		expected float64
+		delta    float64
+	}{
+		{name: "正常系: 経度 1 度の赤道上の距離", from: [2]float64{0, 0}, to: [2]float64{0, 1}, expected: 111319.491, delta: 0.01},
+		{name: "正常系: 子午線に沿った緯度 1 度の距離", from: [2]float64{0, 0}, to: [2]float64{1, 0}, expected: 110574.389, delta: 0.01},
+		{name: "正常系: 対蹠点に近く収束しない場合は大円距離で代用する", from: [2]float64{0, 0}, to: [2]float64{0.5, 179.7}, expected: 19936288, delta: 30000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, err := NewCoordinate(tt.from[0], tt.from[1])
+			require.NoError(t, err)
+			to, err := NewCoordinate(tt.to[0], tt.to[1])
+			require.NoError(t, err)
+
+			assert.InDelta(t, tt.expected, from.GeodesicDistanceMeters(to), tt.delta)
+			assert.InDelta(t, from.GeodesicDistanceMeters(to), to.GeodesicDistanceMeters(from), 1e-6, "向きによらず同じ距離であるべき")
+		})
+	}
+
+	t.Run("正常系: 球とみなした大円距離との差は 0.5% 未満", func(t *testing.T) {
+		tokyo, err := NewCoordinate(35.6812, 139.7671)
+		require.NoError(t, err)
+		shinOsaka, err := NewCoordinate(34.7334, 135.5002)
+		require.NoError(t, err)
+
+		assert.InEpsilon(t, tokyo.DistanceMeters(shinOsaka), tokyo.GeodesicDistanceMeters(shinOsaka), 0.005)
+	})
+
+	t.Run("正常系: 同じ座標は 0", func(t *testing.T) {
+		c, err := NewCoordinate(35.0, 135.7)
+		require.NoError(t, err)
+
+		assert.Zero(t, c.GeodesicDistanceMeters(c))
+	})
+}
+
+func TestNewPlace(t *testing.T) {
+	t.Run("正常系: 座標付き", func(t *testing.T) {
+		c, err := NewCoordinate(35.0, 135.7)
+		require.NoError(t, err)
+
+		p, err := NewPlace("金閣寺", &c, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "金閣寺", p.Name())
+		assert.Equal(t, &c, p.Coordinate())
+	})
+
+	t.Run("正常系: タイムゾーン付き", func(t *testing.T) {
+		tz, err := NewTimezone("Asia/Tokyo")
+		require.NoError(t, err)
+
+		p, err := NewPlace("金閣寺", nil, &tz)
+
+		require.NoError(t, err)
+		require.NotNil(t, p.Timezone())
+		assert.Equal(t, "Asia/Tokyo", p.Timezone().Name())
+	})
+
+	t.Run("正常系: 座標なし", func(t *testing.T) {
+		p, err := NewPlace("金閣寺", nil, nil)
+
+		require.NoError(t, err)
+		assert.Nil(t, p.Coordinate())
+	})
+
+	t.Run("異常系: 名前が空白のみ", func(t *testing.T) {
+		_, err := NewPlace("  ", nil, nil)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestPlace_Equals(t *testing.T) {
+	c1, _ := NewCoordinate(35.0, 135.7)
+	c2, _ := NewCoordinate(35.0, 135.7)
+	c3, _ := NewCoordinate(34.0, 135.7)
+	p1, _ := NewPlace("金閣寺", &c1, nil)
+	p2, _ := NewPlace("金閣寺", &c2, nil)
+	p3, _ := NewPlace("金閣寺", &c3, nil)
+	p4, _ := NewPlace("金閣寺", nil, nil)
+	p5, _ := NewPlace("銀閣寺", &c1, nil)
+
+	assert.True(t, p1.Equals(p2), "名前と座標が同じ場所は等しいと判定されるべき")
+	assert.False(t, p1.Equals(p3), "座標が異なる場所は等しくないと判定されるべき")
+	assert.False(t, p1.Equals(p4), "座標の有無が異なる場所は等しくないと判定されるべき")
+	assert.True(t, p4.Equals(p4), "座標のない同じ名前の場所は等しいと判定されるべき")
+	assert.False(t, p1.Equals(p5), "名前が異なる場所は等しくないと判定されるべき")
+
+	tokyo, _ := NewTimezone("Asia/Tokyo")
+	seoul, _ := NewTimezone("Asia/Seoul")
+	p6, _ := NewPlace("金閣寺", &c1, &tokyo)
+	p7, _ := NewPlace("金閣寺", &c2, &tokyo)
+	p8, _ := NewPlace("金閣寺", &c1, &seoul)
+	assert.True(t, p6.Equals(p7), "タイムゾーンが同じ場所は等しいと判定されるべき")
+	assert.False(t, p6.Equals(p8), "タイムゾーンが異なる場所は等しくないと判定されるべき")
+	assert.False(t, p1.Equals(p6), "タイムゾーンの有無が異なる場所は等しくないと判定されるべき")
+}
+
+func TestNewTimezone(t *testing.T) {
+	t.Run("正常系", func(t *testing.T) {
+		tz, err := NewTimezone("Asia/Tokyo")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Asia/Tokyo", tz.Name())
+		assert.Equal(t, "Asia/Tokyo", tz.Location().String())
+	})
+
+	for _, name := range []string{"", "Local", "Asia/Nowhere", "+09:00"} {
+		t.Run("異常系: "+name, func(t *testing.T) {
+			_, err := NewTimezone(name)
+
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+		})
+	}
+}
+
+func TestTimezone_StartOfDay(t *testing.T) {
+	newTimezone := func(t *testing.T, name string) Timezone {
+		t.Helper()
+		tz, err := NewTimezone(name)
+		require.NoError(t, err)
+		return tz
+	}
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("正常系: 0 時に始まる", func(t *testing.T) {
+		start := newTimezone(t, "Asia/Tokyo").StartOfDay(date(2026, 4, 1))
+
+		assert.Equal(t, "2026-04-01T00:00:00+09:00", start.Format(time.RFC3339))
+	})
+
+	t.Run("正常系: 夏時間が 2 時に始まる日も 0 時に始まる", func(t *testing.T) {
+		start := newTimezone(t, "America/New_York").StartOfDay(date(2026, 3, 8))
+
+		assert.Equal(t, "2026-03-08T00:00:00-05:00", start.Format(time.RFC3339))
+	})
+
+	t.Run("正常系: 夏時間が 0 時に始まる日は時刻が切り替わった 1 時に始まる", func(t *testing.T) {
+		start := newTimezone(t, "America/Santiago").StartOfDay(date(2025, 9, 7))
+
+		assert.Equal(t, "2025-09-07T01:00:00-03:00", start.Format(time.RFC3339))
+	})
+
+	t.Run("正常系: 夏時間が 0 時に終わり 0 時が 2 回ある日は早い方の 0 時に始まる", func(t *testing.T) {
+		start := newTimezone(t, "America/Havana").StartOfDay(date(2026, 11, 1))
+
+		assert.Equal(t, "2026-11-01T00:00:00-04:00", start.Format(time.RFC3339))
+	})
+}
+
+func TestTimezone_LocalDate(t *testing.T) {
+	tz, err := NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+
+	got := tz.LocalDate(time.Date(2026, 4, 1, 15, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC), got, "UTC の 15 時は東京では翌日の 0 時")
+}
@@ -0,0 +1,70 @@
+package geo
+
+import "math"
+
+// WGS84 楕円体の長半径と扁平率
+const (
+	wgs84SemiMajorAxis = 6378137.0
+	wgs84Flattening    = 1 / 298.257223563
+)
+
+// vincentyMaxIterations は Vincenty の反復計算の上限。対蹠点に近い2点では収束しないことがある
+const vincentyMaxIterations = 200
+
+// GeodesicDistanceMeters は other までの WGS84 楕円体上の測地線距離をメートル単位で返す。
+// Vincenty の逆解法で計算し、対蹠点に近く収束しない場合は DistanceMeters の大円距離で代用する。
+// GPS の軌跡のように短い区間を積み上げる距離の計算では、地球を球とみなす DistanceMeters より誤差が小さい
+func (c Coordinate) GeodesicDistanceMeters(other Coordinate) float64 {
+	if c.Equals(other) {
+		return 0
+	}
+
+	const a = wgs84SemiMajorAxis
+	const f = wgs84Flattening
+	const b = a * (1 - f)
+
+	toRad := math.Pi / 180
+	L := (other.longitude - c.longitude) * toRad
+	U1 := math.Atan((1 - f) * math.Tan(c.latitude*toRad))
+	U2 := math.Atan((1 - f) * math.Tan(other.latitude*toRad))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	lambda := L
+	var sinSigma, cosSigma, sigma, cos2Alpha, cos2SigmaM float64
+	converged := false
+	for range vincentyMaxIterations {
+		sinLambda, cosLambda := math.Sincos(lambda)
+		sinSigma = math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			return 0
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cos2Alpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0
+		if cos2Alpha != 0 {
+			// 赤道上の2点では cos2Alpha が 0 になる
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cos2Alpha
+		}
+		C := f / 16 * cos2Alpha * (4 + f*(4-3*cos2Alpha))
+		prev := lambda
+		lambda = L + (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-prev) < 1e-12 {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return c.DistanceMeters(other)
+	}
+
+	uSq := cos2Alpha * (a*a - b*b) / (b * b)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+
+	return b * A * (sigma - deltaSigma)
+}
@@ -0,0 +1,60 @@
+package geo
+
+import (
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+)
+
+// Timezone は IANA のタイムゾーン（例: Asia/Tokyo）を表現する値オブジェクト
+type Timezone struct {
+	name     string
+	location *time.Location
+}
+
+// NewTimezone は IANA のタイムゾーン名からタイムゾーンを作成する。
+// 空の場合や、サーバーのタイムゾーンを表す "Local" の場合はエラーになる
+func NewTimezone(name string) (Timezone, error) {
+	if name == "" || name == "Local" {
+		return Timezone{}, NewInvalidTimezoneError()
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return Timezone{}, NewInvalidTimezoneError(apperr.WithCause(err))
+	}
+	return Timezone{name: name, location: location}, nil
+}
+
+// Getters
+func (t Timezone) Name() string             { return t.name }
+func (t Timezone) Location() *time.Location { return t.location }
+
+// StartOfDay は date の年月日がこのタイムゾーンで始まる瞬間を返す。
+// 夏時間が 0 時に始まり 0 時が存在しない日は、時刻が切り替わった瞬間をその日の始まりとする
+func (t Timezone) StartOfDay(date time.Time) time.Time {
+	year, month, day := date.Date()
+	start := time.Date(year, month, day, 0, 0, 0, 0, t.location)
+	// time.Date は存在しない時刻を切り替わる前のオフセットで解釈するため、前日の時刻になる
+	if _, _, d := start.Date(); d != day {
+		_, start = start.ZoneBounds()
+	}
+	return start
+}
+
+// LocalDate は instant のこのタイムゾーンでの日付を、時刻を切り捨てたUTCの日付として返す
+func (t Timezone) LocalDate(instant time.Time) time.Time {
+	year, month, day := instant.In(t.location).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (t Timezone) String() string {
+	return t.name
+}
+
+func (t Timezone) Equals(other Timezone) bool {
+	return t.name == other.name
+}
+
+func NewInvalidTimezoneError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Timezone must be an IANA time zone name", opts...)
+}
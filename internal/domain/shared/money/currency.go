@@ -0,0 +1,25 @@
+package money
+
+// minorUnitExceptions は最小通貨単位の桁数が 2 ではない ISO 4217 通貨の一覧
+var minorUnitExceptions = map[string]int{
+	// 補助単位を持たない通貨
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	// 補助単位が 1/1000 の通貨
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	// 補助単位が 1/10000 の通貨
+	"CLF": 4, "UYW": 4,
+}
+
+// MinorUnitExponent は通貨の最小単位が主単位の 10 の何乗分の 1 かを返す（USD なら 2、JPY なら 0）
+func MinorUnitExponent(currency string) int {
+	if exponent, ok := minorUnitExceptions[currency]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// IsValidCurrencyCode は ISO 4217 形式（英大文字3文字）の通貨コードかどうかを判定する
+func IsValidCurrencyCode(currency string) bool {
+	return currencyCodePattern.MatchString(currency)
+}
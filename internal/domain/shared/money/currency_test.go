@@ -0,0 +1,13 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinorUnitExponent(t *testing.T) {
+	assert.Equal(t, 2, MinorUnitExponent("USD"), "USD は小数点以下2桁であるべき")
+	assert.Equal(t, 0, MinorUnitExponent("JPY"), "JPY は補助単位を持たないべき")
+	assert.Equal(t, 3, MinorUnitExponent("KWD"), "KWD は小数点以下3桁であるべき")
+}
@@ -0,0 +1,124 @@
+package money
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+)
+
+var (
+	currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	decimalPattern      = regexp.MustCompile(`^(-)?(\d+)(?:\.(\d+))?$`)
+)
+
+// Money は金額を表現する値オブジェクト。
+// 浮動小数点の誤差を避けるため、金額は通貨の最小単位（円なら1円、ドルなら1セント）の整数で保持する。
+type Money struct {
+	amount   int64
+	currency string
+}
+
+// NewMoney は最小通貨単位の金額と ISO 4217 の通貨コードから Money を作成する
+func NewMoney(amount int64, currency string) (Money, error) {
+	if !currencyCodePattern.MatchString(currency) {
+		return Money{}, NewInvalidCurrencyError()
+	}
+	return Money{amount: amount, currency: currency}, nil
+}
+
+// ParseMoney は "12.34" のような10進数表記の金額を Money に変換する。
+// 浮動小数点を経由せず文字列のまま解析し、通貨の最小単位より細かい端数がある場合は丸めずにエラーとする
+func ParseMoney(decimal, currency string) (Money, error) {
+	if !currencyCodePattern.MatchString(currency) {
+		return Money{}, NewInvalidCurrencyError()
+	}
+
+	matches := decimalPattern.FindStringSubmatch(decimal)
+	if matches == nil {
+		return Money{}, NewInvalidAmountError()
+	}
+	sign, integerPart, fractionPart := matches[1], matches[2], matches[3]
+
+	exponent := MinorUnitExponent(currency)
+	if len(fractionPart) > exponent {
+		// 末尾の 0 は最小単位未満の端数ではないため許容する
+		if strings.TrimRight(fractionPart[exponent:], "0") != "" {
+			return Money{}, NewInvalidAmountError()
+		}
+		fractionPart = fractionPart[:exponent]
+	}
+	fractionPart += strings.Repeat("0", exponent-len(fractionPart))
+
+	amount, err := strconv.ParseInt(sign+integerPart+fractionPart, 10, 64)
+	if err != nil {
+		return Money{}, NewInvalidAmountError(apperr.WithCause(err))
+	}
+
+	return Money{amount: amount, currency: currency}, nil
+}
+
+// Zero は指定した通貨の 0 円（0 ドル等）を返す
+func Zero(currency string) (Money, error) {
+	return NewMoney(0, currency)
+}
+
+// Getters
+func (m Money) Amount() int64    { return m.amount }
+func (m Money) Currency() string { return m.currency }
+
+// Add は同じ通貨の金額を加算する。通貨が異なる場合やオーバーフローする場合はエラーを返す
+func (m Money) Add(other Money) (Money, error) {
+	if m.currency != other.currency {
+		return Money{}, NewCurrencyMismatchError()
+	}
+	if (other.amount > 0 && m.amount > math.MaxInt64-other.amount) ||
+		(other.amount < 0 && m.amount < math.MinInt64-other.amount) {
+		return Money{}, NewInvalidAmountError()
+	}
+	return Money{amount: m.amount + other.amount, currency: m.currency}, nil
+}
+
+// Sub は同じ通貨の金額を減算する
+func (m Money) Sub(other Money) (Money, error) {
+	if other.amount == math.MinInt64 {
+		return Money{}, NewInvalidAmountError()
+	}
+	return m.Add(Money{amount: -other.amount, currency: other.currency})
+}
+
+// Decimal は金額を通貨の桁数に合わせた10進数表記（"12.34"、"1250" など）で返す
+func (m Money) Decimal() string {
+	exponent := MinorUnitExponent(m.currency)
+	digits := strconv.FormatInt(m.amount, 10)
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	if exponent == 0 {
+		return sign + digits
+	}
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	split := len(digits) - exponent
+	return sign + digits[:split] + "." + digits[split:]
+}
+
+func (m Money) Equals(other Money) bool {
+	return m.amount == other.amount && m.currency == other.currency
+}
+
+func NewInvalidCurrencyError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Currency must be an ISO 4217 alphabetic code", opts...)
+}
+
+func NewInvalidAmountError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Amount must be a decimal number within the precision of the currency", opts...)
+}
+
+func NewCurrencyMismatchError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Amounts in different currencies cannot be combined", opts...)
+}
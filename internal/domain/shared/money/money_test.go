@@ -0,0 +1,144 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMoney(t *testing.T) {
+	t.Run("正常系", func(t *testing.T) {
+		m, err := NewMoney(1250, "JPY")
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1250), m.Amount())
+		assert.Equal(t, "JPY", m.Currency())
+	})
+
+	invalidCurrencies := []string{"", "jpy", "JP", "JPYY", "1PY"}
+	for _, currency := range invalidCurrencies {
+		t.Run("異常系: 不正な通貨コード "+currency, func(t *testing.T) {
+			_, err := NewMoney(100, currency)
+
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+		})
+	}
+}
+
+func TestMoney_Equals(t *testing.T) {
+	m1, _ := NewMoney(100, "USD")
+	m2, _ := NewMoney(100, "USD")
+	m3, _ := NewMoney(100, "EUR")
+	m4, _ := NewMoney(101, "USD")
+
+	assert.True(t, m1.Equals(m2), "金額と通貨が同じ Money は等しいと判定されるべき")
+	assert.False(t, m1.Equals(m3), "通貨が異なる Money は等しくないと判定されるべき")
+	assert.False(t, m1.Equals(m4), "金額が異なる Money は等しくないと判定されるべき")
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		decimal  string
+		currency string
+		want     int64
+	}{
+		{name: "小数点以下2桁の通貨", decimal: "12.34", currency: "USD", want: 1234},
+		{name: "小数部の省略", decimal: "12", currency: "USD", want: 1200},
+		{name: "小数部が1桁", decimal: "12.5", currency: "EUR", want: 1250},
+		{name: "末尾の0は許容", decimal: "12.3400", currency: "USD", want: 1234},
+		{name: "補助単位のない通貨", decimal: "1500", currency: "JPY", want: 1500},
+		{name: "小数点以下3桁の通貨", decimal: "1.005", currency: "KWD", want: 1005},
+		{name: "負の金額", decimal: "-0.01", currency: "USD", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run("正常系: "+tt.name, func(t *testing.T) {
+			m, err := ParseMoney(tt.decimal, tt.currency)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, m.Amount())
+			assert.Equal(t, tt.currency, m.Currency())
+		})
+	}
+
+	invalid := []struct {
+		name     string
+		decimal  string
+		currency string
+	}{
+		{name: "最小単位未満の端数", decimal: "12.345", currency: "USD"},
+		{name: "補助単位のない通貨の小数", decimal: "12.5", currency: "JPY"},
+		{name: "数値でない", decimal: "abc", currency: "USD"},
+		{name: "指数表記", decimal: "1e3", currency: "USD"},
+		{name: "空文字列", decimal: "", currency: "USD"},
+		{name: "オーバーフロー", decimal: "99999999999999999999", currency: "USD"},
+		{name: "不正な通貨コード", decimal: "1.00", currency: "usd"},
+	}
+	for _, tt := range invalid {
+		t.Run("異常系: "+tt.name, func(t *testing.T) {
+			_, err := ParseMoney(tt.decimal, tt.currency)
+
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+		})
+	}
+}
+
+func TestMoney_Decimal(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		currency string
+		want     string
+	}{
+		{amount: 1234, currency: "USD", want: "12.34"},
+		{amount: 5, currency: "USD", want: "0.05"},
+		{amount: -5, currency: "USD", want: "-0.05"},
+		{amount: 1500, currency: "JPY", want: "1500"},
+		{amount: 1005, currency: "KWD", want: "1.005"},
+		{amount: 0, currency: "EUR", want: "0.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			m, err := NewMoney(tt.amount, tt.currency)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, m.Decimal())
+		})
+	}
+}
+
+func TestMoney_AddSub(t *testing.T) {
+	usd100, _ := NewMoney(100, "USD")
+	usd50, _ := NewMoney(50, "USD")
+	eur50, _ := NewMoney(50, "EUR")
+
+	t.Run("正常系: 加算", func(t *testing.T) {
+		sum, err := usd100.Add(usd50)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(150), sum.Amount())
+	})
+
+	t.Run("正常系: 減算", func(t *testing.T) {
+		diff, err := usd50.Sub(usd100)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(-50), diff.Amount())
+	})
+
+	t.Run("異常系: 通貨が異なる", func(t *testing.T) {
+		_, err := usd100.Add(eur50)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+
+	t.Run("異常系: オーバーフロー", func(t *testing.T) {
+		huge, _ := NewMoney(math.MaxInt64, "USD")
+
+		_, err := huge.Add(usd50)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
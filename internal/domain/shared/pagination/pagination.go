@@ -0,0 +1,132 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/uuid"
+)
+
+const (
+	// DefaultLimit は件数が指定されなかった場合の 1 ページあたりの件数
+	DefaultLimit = 20
+	// MaxLimit は 1 ページあたりに取得できる最大件数
+	MaxLimit = 100
+)
+
+// Direction は並び順
+type Direction string
+
+const (
+	Asc  Direction = "asc"
+	Desc Direction = "desc"
+)
+
+// ParseDirection は並び順を解析する。未指定の場合は defaultDirection を返す
+func ParseDirection(value string, defaultDirection Direction) (Direction, error) {
+	switch Direction(value) {
+	case "":
+		return defaultDirection, nil
+	case Asc, Desc:
+		return Direction(value), nil
+	default:
+		return "", apperr.NewValidationError("order must be either asc or desc")
+	}
+}
+
+func (d Direction) String() string {
+	return string(d)
+}
+
+// Cursor は次のページの開始位置を表す。直前のページ末尾の要素の並び替えキーと ID を保持し、
+// 並び替え条件が変わった場合に誤ったページを返さないよう、発行時の並び替え条件も記録する
+type Cursor struct {
+	Sort string `json:"s"`
+	Key  string `json:"k"`
+	ID   string `json:"id"`
+}
+
+// NewCursor は並び替え条件 sort における要素の位置を表すカーソルを作成する
+func NewCursor(sort, key, id string) Cursor {
+	return Cursor{Sort: sort, Key: key, ID: id}
+}
+
+// Encode はクライアントに返すための不透明な文字列に変換する
+func (c Cursor) Encode() string {
+	// フィールドはすべて文字列なので Marshal は失敗しない
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor は Encode で変換された文字列をカーソルに戻す。並び替え条件が sort と異なる場合、
+// ID が UUID でない場合、並び替えキーが isValidKey を満たさない場合はエラーになる
+func DecodeCursor(value, sort string, isValidKey func(key string) bool) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, newInvalidCursorError(err)
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, newInvalidCursorError(err)
+	}
+	if c.Sort != sort || !uuid.IsValidUUID(c.ID) || !isValidKey(c.Key) {
+		return nil, newInvalidCursorError(nil)
+	}
+
+	return &c, nil
+}
+
+func newInvalidCursorError(cause error) error {
+	if cause == nil {
+		return apperr.NewValidationError("cursor is invalid or does not match the requested sort order")
+	}
+	return apperr.NewValidationError("cursor is invalid or does not match the requested sort order", apperr.WithCause(cause))
+}
+
+// Request はページの取得条件
+type Request struct {
+	limit int
+	after *Cursor
+}
+
+// NewRequest はページの取得条件を作成する。limit が 0 の場合は DefaultLimit を使用する
+func NewRequest(limit int, after *Cursor) (Request, error) {
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+	if limit < 1 || limit > MaxLimit {
+		return Request{}, apperr.NewValidationError("limit must be between 1 and 100")
+	}
+
+	return Request{limit: limit, after: after}, nil
+}
+
+func (r Request) Limit() int {
+	return r.limit
+}
+
+// After は取得を開始する位置を返す。先頭ページの場合は nil
+func (r Request) After() *Cursor {
+	return r.after
+}
+
+// Page は 1 ページ分の取得結果
+type Page[T any] struct {
+	Items []T
+	// NextCursor は次のページの開始位置。最後のページの場合は nil
+	NextCursor *Cursor
+}
+
+// NewPage は limit より 1 件多く取得した items からページを作成する。
+// limit を超えた分があれば次のページが存在するとみなし、ページ末尾の要素からカーソルを作成する
+func NewPage[T any](items []T, limit int, cursorOf func(T) Cursor) *Page[T] {
+	if len(items) <= limit {
+		return &Page[T]{Items: items}
+	}
+
+	items = items[:limit]
+	next := cursorOf(items[len(items)-1])
+	return &Page[T]{Items: items, NextCursor: &next}
+}
@@ -0,0 +1,101 @@
+package pagination
+
+import (
+	"testing"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	const id = "3f9a1c2e-7b4d-4e8a-9c1f-2d5e6a7b8c90"
+	cursor := NewCursor("name:asc", "京都", id)
+	isValidKey := func(key string) bool { return key != "" }
+
+	t.Run("正常系: エンコードした値を同じ並び替え条件で復元できる", func(t *testing.T) {
+		got, err := DecodeCursor(cursor.Encode(), "name:asc", isValidKey)
+
+		require.NoError(t, err)
+		assert.Equal(t, cursor, *got)
+	})
+
+	tests := []struct {
+		name  string
+		value string
+		sort  string
+	}{
+		{name: "異常系: 並び替え条件が異なる", value: cursor.Encode(), sort: "name:desc"},
+		{name: "異常系: base64 として不正", value: "!!!", sort: "name:asc"},
+		{name: "異常系: JSON として不正", value: "bm90LWpzb24", sort: "name:asc"},
+		{name: "異常系: ID がない", value: NewCursor("name:asc", "京都", "").Encode(), sort: "name:asc"},
+		{name: "異常系: ID が UUID でない", value: NewCursor("name:asc", "京都", "id-1").Encode(), sort: "name:asc"},
+		{name: "異常系: 並び替えキーが不正", value: NewCursor("name:asc", "", id).Encode(), sort: "name:asc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeCursor(tt.value, tt.sort, isValidKey)
+
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+		})
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     int
+		wantLimit int
+		wantErr   bool
+	}{
+		{name: "正常系: 未指定の場合は既定の件数", limit: 0, wantLimit: DefaultLimit},
+		{name: "正常系: 上限の件数", limit: MaxLimit, wantLimit: MaxLimit},
+		{name: "異常系: 負の件数", limit: -1, wantErr: true},
+		{name: "異常系: 上限を超える件数", limit: MaxLimit + 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewRequest(tt.limit, nil)
+
+			if tt.wantErr {
+				assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLimit, got.Limit())
+			assert.Nil(t, got.After())
+		})
+	}
+}
+
+func TestParseDirection(t *testing.T) {
+	got, err := ParseDirection("", Desc)
+	require.NoError(t, err)
+	assert.Equal(t, Desc, got, "未指定の場合は既定の並び順になるべき")
+
+	got, err = ParseDirection("asc", Desc)
+	require.NoError(t, err)
+	assert.Equal(t, Asc, got)
+
+	_, err = ParseDirection("ascending", Desc)
+	assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+}
+
+func TestNewPage(t *testing.T) {
+	cursorOf := func(v int) Cursor { return NewCursor("n:asc", "", string(rune('a'+v))) }
+
+	t.Run("正常系: 件数を超えて取得できた場合は末尾の要素からカーソルを作成する", func(t *testing.T) {
+		page := NewPage([]int{0, 1, 2}, 2, cursorOf)
+
+		assert.Equal(t, []int{0, 1}, page.Items)
+		require.NotNil(t, page.NextCursor)
+		assert.Equal(t, cursorOf(1), *page.NextCursor)
+	})
+
+	t.Run("正常系: 件数以下の場合は最後のページになる", func(t *testing.T) {
+		page := NewPage([]int{0, 1}, 2, cursorOf)
+
+		assert.Equal(t, []int{0, 1}, page.Items)
+		assert.Nil(t, page.NextCursor)
+	})
+}
@@ -0,0 +1,13 @@
+package tokenhash
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Hash は URL に含めて発行するトークンを保存用のハッシュ値に変換する。
+// トークン自体は十分な長さの乱数のため、パスワードのような低速なハッシュは用いない
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,13 @@
+package tokenhash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHash(t *testing.T) {
+	assert.Equal(t, Hash("token"), Hash("token"), "同じトークンは同じハッシュ値になるべき")
+	assert.NotEqual(t, Hash("token"), Hash("other"), "異なるトークンは異なるハッシュ値になるべき")
+	assert.NotContains(t, Hash("token"), "token", "ハッシュ値にトークンが含まれてはならない")
+}
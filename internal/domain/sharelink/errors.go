@@ -0,0 +1,23 @@
+package sharelink
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeShareLinkNotFound = "SHARE_LINK_NOT_FOUND"
+)
+
+func NewShareLinkNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeShareLinkNotFound, "Share link not found", opts...)
+}
+
+func NewInvalidShareLinkExpiryError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Share link must expire after it is created", opts...)
+}
+
+func NewShareLinkAlreadyRevokedError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewConflictError("Share link has already been revoked", opts...)
+}
+
+func NewShareLinkPasswordMismatchError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewInvalidCredentialsError("Share link password is missing or incorrect", opts...)
+}
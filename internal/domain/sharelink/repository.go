@@ -0,0 +1,16 @@
+package sharelink
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+//go:generate mockgen -destination mock/share_link.go github.com/hata0/travel-api/internal/domain/sharelink ShareLinkRepository
+type ShareLinkRepository interface {
+	FindByID(ctx context.Context, id ShareLinkID) (*ShareLink, error)
+	FindByTokenHash(ctx context.Context, tokenHash string) (*ShareLink, error)
+	FindByTripID(ctx context.Context, tripID trip.TripID) ([]*ShareLink, error)
+	Create(ctx context.Context, link *ShareLink) error
+	Update(ctx context.Context, link *ShareLink) error
+}
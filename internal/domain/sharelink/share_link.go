@@ -0,0 +1,143 @@
+package sharelink
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// ShareLinkID は共有リンクIDを表現する値オブジェクト
+type ShareLinkID struct {
+	value string
+}
+
+func NewShareLinkID(id string) ShareLinkID {
+	return ShareLinkID{value: id}
+}
+
+func (id ShareLinkID) String() string {
+	return id.value
+}
+
+func (id ShareLinkID) Equals(other ShareLinkID) bool {
+	return id.value == other.value
+}
+
+// ShareLinkStatus は共有リンクの状態を表現する値オブジェクト。保存はされず、判定時に導出される
+type ShareLinkStatus string
+
+const (
+	ShareLinkStatusActive  ShareLinkStatus = "active"
+	ShareLinkStatusExpired ShareLinkStatus = "expired"
+	ShareLinkStatusRevoked ShareLinkStatus = "revoked"
+)
+
+func (s ShareLinkStatus) String() string {
+	return string(s)
+}
+
+// ShareLink はアカウントを持たない人に旅行を読み取り専用で公開するリンクを表現するエンティティ。
+// トークンはハッシュ値だけを保持し、パスワードが設定されていない場合 passwordHash は nil になる
+type ShareLink struct {
+	id           ShareLinkID
+	tripID       trip.TripID
+	tokenHash    string
+	passwordHash []byte
+	expiresAt    *time.Time
+	createdBy    user.UserID
+	createdAt    time.Time
+	revokedAt    *time.Time
+}
+
+// NewShareLink は新しい共有リンクを作成する。有効期限を指定しない場合は無効化するまで有効となる
+func NewShareLink(
+	id ShareLinkID,
+	tripID trip.TripID,
+	tokenHash string,
+	passwordHash []byte,
+	expiresAt *time.Time,
+	createdBy user.UserID,
+	createdAt time.Time,
+) (*ShareLink, error) {
+	if expiresAt != nil && !expiresAt.After(createdAt) {
+		return nil, NewInvalidShareLinkExpiryError()
+	}
+	return &ShareLink{
+		id:           id,
+		tripID:       tripID,
+		tokenHash:    tokenHash,
+		passwordHash: passwordHash,
+		expiresAt:    expiresAt,
+		createdBy:    createdBy,
+		createdAt:    createdAt,
+	}, nil
+}
+
+// RestoreShareLink は保存済みの共有リンクを復元する
+func RestoreShareLink(
+	id ShareLinkID,
+	tripID trip.TripID,
+	tokenHash string,
+	passwordHash []byte,
+	expiresAt *time.Time,
+	createdBy user.UserID,
+	createdAt time.Time,
+	revokedAt *time.Time,
+) *ShareLink {
+	return &ShareLink{
+		id:           id,
+		tripID:       tripID,
+		tokenHash:    tokenHash,
+		passwordHash: passwordHash,
+		expiresAt:    expiresAt,
+		createdBy:    createdBy,
+		createdAt:    createdAt,
+		revokedAt:    revokedAt,
+	}
+}
+
+// Getters
+func (l *ShareLink) ID() ShareLinkID        { return l.id }
+func (l *ShareLink) TripID() trip.TripID    { return l.tripID }
+func (l *ShareLink) TokenHash() string      { return l.tokenHash }
+func (l *ShareLink) PasswordHash() []byte   { return l.passwordHash }
+func (l *ShareLink) ExpiresAt() *time.Time  { return l.expiresAt }
+func (l *ShareLink) CreatedBy() user.UserID { return l.createdBy }
+func (l *ShareLink) CreatedAt() time.Time   { return l.createdAt }
+func (l *ShareLink) RevokedAt() *time.Time  { return l.revokedAt }
+func (l *ShareLink) HasPassword() bool      { return len(l.passwordHash) > 0 }
+
+// StatusAt は指定時刻における共有リンクの状態を返す。無効化は有効期限切れより優先される
+func (l *ShareLink) StatusAt(now time.Time) ShareLinkStatus {
+	if l.revokedAt != nil {
+		return ShareLinkStatusRevoked
+	}
+	if l.expiresAt != nil && !now.Before(*l.expiresAt) {
+		return ShareLinkStatusExpired
+	}
+	return ShareLinkStatusActive
+}
+
+// IsActiveAt は指定時刻に共有リンクで旅行を閲覧できるかを返す
+func (l *ShareLink) IsActiveAt(now time.Time) bool {
+	return l.StatusAt(now) == ShareLinkStatusActive
+}
+
+// Revoke は共有リンクを無効化した結果を返す
+func (l *ShareLink) Revoke(now time.Time) (*ShareLink, error) {
+	if l.revokedAt != nil {
+		return nil, NewShareLinkAlreadyRevokedError()
+	}
+
+	revoked := *l
+	revoked.revokedAt = &now
+	return &revoked, nil
+}
+
+func (l *ShareLink) Equals(other *ShareLink) bool {
+	if other == nil {
+		return false
+	}
+	return l.id.Equals(other.id)
+}
@@ -0,0 +1,111 @@
+package sharelink
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/tokenhash"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestShareLink(t *testing.T, passwordHash []byte, expiresAt *time.Time, createdAt time.Time) *ShareLink {
+	t.Helper()
+	link, err := NewShareLink(
+		NewShareLinkID("share-link-id-1"),
+		trip.NewTripID("trip-id-1"),
+		tokenhash.Hash("token"),
+		passwordHash,
+		expiresAt,
+		user.NewUserID("owner-id"),
+		createdAt,
+	)
+	require.NoError(t, err)
+	return link
+}
+
+func TestNewShareLink(t *testing.T) {
+	now := time.Now()
+
+	t.Run("正常系: 有効期限なし", func(t *testing.T) {
+		link := newTestShareLink(t, nil, nil, now)
+
+		assert.Nil(t, link.ExpiresAt())
+		assert.False(t, link.HasPassword())
+		assert.Nil(t, link.RevokedAt())
+	})
+
+	t.Run("正常系: パスワードあり", func(t *testing.T) {
+		link := newTestShareLink(t, []byte("hash"), nil, now)
+
+		assert.True(t, link.HasPassword())
+	})
+
+	t.Run("異常系: 有効期限が作成日時以前", func(t *testing.T) {
+		_, err := NewShareLink(NewShareLinkID("id"), trip.NewTripID("trip"), tokenhash.Hash("token"), nil, &now, user.NewUserID("owner"), now)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestShareLink_StatusAt(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+
+	t.Run("有効期限内は有効", func(t *testing.T) {
+		link := newTestShareLink(t, nil, &expiresAt, now)
+
+		assert.Equal(t, ShareLinkStatusActive, link.StatusAt(now.Add(59*time.Minute)))
+		assert.True(t, link.IsActiveAt(now))
+	})
+
+	t.Run("有効期限を過ぎると期限切れ", func(t *testing.T) {
+		link := newTestShareLink(t, nil, &expiresAt, now)
+
+		assert.Equal(t, ShareLinkStatusExpired, link.StatusAt(expiresAt))
+		assert.False(t, link.IsActiveAt(expiresAt))
+	})
+
+	t.Run("有効期限がなければ期限切れにならない", func(t *testing.T) {
+		link := newTestShareLink(t, nil, nil, now)
+
+		assert.Equal(t, ShareLinkStatusActive, link.StatusAt(now.Add(365*24*time.Hour)))
+	})
+
+	t.Run("無効化は期限切れより優先される", func(t *testing.T) {
+		link := newTestShareLink(t, nil, &expiresAt, now)
+		revoked, err := link.Revoke(now)
+		require.NoError(t, err)
+
+		assert.Equal(t, ShareLinkStatusRevoked, revoked.StatusAt(expiresAt.Add(time.Hour)))
+	})
+}
+
+func TestShareLink_Revoke(t *testing.T) {
+	now := time.Now()
+
+	t.Run("正常系: 無効化した日時が記録され、元のリンクは変更されない", func(t *testing.T) {
+		link := newTestShareLink(t, nil, nil, now)
+
+		revoked, err := link.Revoke(now.Add(time.Minute))
+
+		require.NoError(t, err)
+		require.NotNil(t, revoked.RevokedAt())
+		assert.Equal(t, now.Add(time.Minute), *revoked.RevokedAt())
+		assert.Nil(t, link.RevokedAt())
+		assert.True(t, link.Equals(revoked))
+	})
+
+	t.Run("異常系: 無効化済みのリンクは再度無効化できない", func(t *testing.T) {
+		link := newTestShareLink(t, nil, nil, now)
+		revoked, err := link.Revoke(now)
+		require.NoError(t, err)
+
+		_, err = revoked.Revoke(now)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeConflict))
+	})
+}
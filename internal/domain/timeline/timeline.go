@@ -0,0 +1,156 @@
+package timeline
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/transport"
+)
+
+// Kind は時系列に並べる項目の種類を表現する値オブジェクト
+type Kind string
+
+const (
+	KindActivity     Kind = "activity"
+	KindTransportLeg Kind = "transport_leg"
+	KindCheckIn      Kind = "check_in"
+	KindCheckOut     Kind = "check_out"
+	KindJournalEntry Kind = "journal_entry"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// Item は旅行の時系列に並べる日付のある 1 つの項目を表現する値オブジェクト。
+// 開始日時は項目のタイムゾーン、終了日時は終了時のタイムゾーンの現地時刻で保持する。終了時のタイムゾーンは移動では到着地のもので、
+// それ以外の項目では項目のタイムゾーンと同じになる。終日の項目は現地の日付が始まる瞬間を開始日時とし、終了日時を持たない
+type Item struct {
+	kind        Kind
+	id          string
+	title       string
+	timezone    geo.Timezone
+	endTimezone geo.Timezone
+	date        time.Time
+	allDay      bool
+	startAt     time.Time
+	endAt       *time.Time
+}
+
+// Getters
+func (i Item) Kind() Kind                { return i.kind }
+func (i Item) ID() string                { return i.id }
+func (i Item) Title() string             { return i.title }
+func (i Item) Timezone() geo.Timezone    { return i.timezone }
+func (i Item) EndTimezone() geo.Timezone { return i.endTimezone }
+func (i Item) AllDay() bool              { return i.allDay }
+func (i Item) StartAt() time.Time        { return i.startAt }
+func (i Item) EndAt() *time.Time         { return i.endAt }
+
+// Date は項目の現地の日付を、時刻を切り捨てたUTCの日付として返す
+func (i Item) Date() time.Time { return i.date }
+
+// newTimedItem は startAt から endAt までの項目を作成する。終了日時がない場合は nil を渡す
+func newTimedItem(kind Kind, id, title string, timezone geo.Timezone, startAt time.Time, endAt *time.Time) Item {
+	return newTimedItemBetween(kind, id, title, timezone, timezone, startAt, endAt)
+}
+
+// newTimedItemBetween は startTimezone の startAt から endTimezone の endAt までの項目を作成する。日付は開始時の現地の日付とする
+func newTimedItemBetween(kind Kind, id, title string, startTimezone, endTimezone geo.Timezone, startAt time.Time, endAt *time.Time) Item {
+	item := Item{
+		kind:        kind,
+		id:          id,
+		title:       title,
+		timezone:    startTimezone,
+		endTimezone: endTimezone,
+		date:        startTimezone.LocalDate(startAt),
+		startAt:     startAt.In(startTimezone.Location()),
+	}
+	if endAt != nil {
+		local := endAt.In(endTimezone.Location())
+		item.endAt = &local
+	}
+	return item
+}
+
+// newAllDayItem は date の終日の項目を作成する
+func newAllDayItem(kind Kind, id, title string, timezone geo.Timezone, date time.Time) Item {
+	return Item{
+		kind:        kind,
+		id:          id,
+		title:       title,
+		timezone:    timezone,
+		endTimezone: timezone,
+		date:        date,
+		allDay:      true,
+		startAt:     timezone.StartOfDay(date),
+	}
+}
+
+// Build は旅行の行動・移動・宿泊予約のチェックインとチェックアウト・日記を、始まる瞬間の早い順に並べる。
+// 移動は出発日時を出発地、到着日時を到着地のタイムゾーンの現地時刻で、宿泊予約は宿泊先のタイムゾーンの現地時刻で扱う。
+// タイムゾーンが決まっていない項目は defaultTimezone の現地時刻で扱う。
+// 開始日時のない行動と日記は、その日付が現地で始まる瞬間に始まる終日の項目とする。
+// 同じ瞬間に始まる項目は終日の項目を先にし、それ以外は引数の順（行動は日付と並び順の順）を保つ
+func Build(
+	activities []*itinerary.Activity,
+	legs []*transport.Leg,
+	accommodations []*accommodation.Accommodation,
+	entries []*journal.Entry,
+	defaultTimezone geo.Timezone,
+) []Item {
+	items := make([]Item, 0, len(activities)+len(legs)+2*len(accommodations)+len(entries))
+	orDefault := func(tz *geo.Timezone) geo.Timezone {
+		if tz == nil {
+			return defaultTimezone
+		}
+		return *tz
+	}
+
+	for _, a := range activities {
+		timezone := orDefault(a.Timezone())
+		if a.StartAt() == nil {
+			items = append(items, newAllDayItem(KindActivity, a.ID().String(), a.Title(), timezone, a.Date()))
+			continue
+		}
+		items = append(items, newTimedItem(KindActivity, a.ID().String(), a.Title(), timezone, *a.StartAt(), a.EndAt()))
+	}
+
+	for _, l := range legs {
+		arrivalAt := l.ArrivalAt()
+		items = append(items, newTimedItemBetween(
+			KindTransportLeg, l.ID().String(), l.Summary(),
+			orDefault(l.DepartureTimezone()), orDefault(l.ArrivalTimezone()), l.DepartureAt(), &arrivalAt,
+		))
+	}
+
+	for _, a := range accommodations {
+		stay := a.Stay()
+		timezone := orDefault(a.Timezone())
+		items = append(items,
+			newTimedItem(KindCheckIn, a.ID().String(), a.Name(), timezone, stay.CheckInAt(), nil),
+			newTimedItem(KindCheckOut, a.ID().String(), a.Name(), timezone, stay.CheckOutAt(), nil),
+		)
+	}
+
+	for _, e := range entries {
+		timezone := defaultTimezone
+		if place := e.Place(); place != nil {
+			timezone = orDefault(place.Timezone())
+		}
+		items = append(items, newAllDayItem(KindJournalEntry, e.ID().String(), e.Title(), timezone, e.Date()))
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if !items[i].startAt.Equal(items[j].startAt) {
+			return items[i].startAt.Before(items[j].startAt)
+		}
+		return items[i].allDay && !items[j].allDay
+	})
+
+	return items
+}
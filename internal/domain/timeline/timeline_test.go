@@ -0,0 +1,221 @@
+package timeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testTripID    = trip.NewTripID("trip-id-1")
+	testCreatedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func newTestTimezone(t *testing.T, name string) geo.Timezone {
+	t.Helper()
+	tz, err := geo.NewTimezone(name)
+	require.NoError(t, err)
+	return tz
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func utc(year int, month time.Month, day, hour, minute int) *time.Time {
+	v := time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	return &v
+}
+
+func newTestActivity(t *testing.T, id string, day time.Time, position int, timezone *geo.Timezone, startAt, endAt *time.Time) *itinerary.Activity {
+	t.Helper()
+	a, err := itinerary.NewActivity(
+		itinerary.NewActivityID(id), testTripID, day, position, id, nil, timezone,
+		startAt, endAt, itinerary.TravelModeWalk, "", testCreatedAt, testCreatedAt,
+	)
+	require.NoError(t, err)
+	return a
+}
+
+// newTestLeg は origin から destination への移動を生成する。タイムゾーンが nil の場所はタイムゾーンの決まっていない場所とする
+func newTestLeg(t *testing.T, id string, originTimezone, destinationTimezone *geo.Timezone, departureAt, arrivalAt time.Time) *transport.Leg {
+	t.Helper()
+	origin, err := geo.NewPlace("出発地", nil, originTimezone)
+	require.NoError(t, err)
+	destination, err := geo.NewPlace("到着地", nil, destinationTimezone)
+	require.NoError(t, err)
+	l, err := transport.NewLeg(
+		transport.NewLegID(id), testTripID, transport.ModeFlight, origin, destination,
+		departureAt, arrivalAt, "", "", "", testCreatedAt, testCreatedAt,
+	)
+	require.NoError(t, err)
+	return l
+}
+
+// rendered は項目を「種類:ID 現地の開始日時」の形式で返す
+func rendered(items []Item) []string {
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		result = append(result, item.Kind().String()+":"+item.ID()+" "+item.StartAt().Format(time.RFC3339))
+	}
+	return result
+}
+
+func TestBuild(t *testing.T) {
+	tokyo := newTestTimezone(t, "Asia/Tokyo")
+	utcZone := newTestTimezone(t, "UTC")
+
+	t.Run("正常系: 行動・宿泊予約・日記を始まる瞬間の順に現地時刻で並べる", func(t *testing.T) {
+		// 東京を 23 時に出る便は UTC では 14 時だが、現地の日付は 5 月 1 日のままになるべき
+		flight := newTestActivity(t, "flight", date(2024, 5, 1), 1, &tokyo, utc(2024, 5, 1, 14, 0), utc(2024, 5, 1, 16, 0))
+		lunch := newTestActivity(t, "lunch", date(2024, 5, 1), 0, &tokyo, utc(2024, 5, 1, 3, 0), nil)
+		free := newTestActivity(t, "free", date(2024, 5, 2), 0, nil, nil, nil)
+		stay, err := accommodation.NewStay(*utc(2024, 5, 1, 6, 0), *utc(2024, 5, 2, 1, 0))
+		require.NoError(t, err)
+		cost, err := money.NewMoney(10000, "JPY")
+		require.NoError(t, err)
+		hotel := accommodation.NewAccommodation(
+			accommodation.NewAccommodationID("hotel"), testTripID, "Hotel", "", nil, stay, "", cost, "", testCreatedAt, testCreatedAt,
+		)
+		place, err := geo.NewPlace("浅草寺", nil, &tokyo)
+		require.NoError(t, err)
+		entry := journal.NewEntry(
+			journal.NewEntryID("entry"), testTripID, date(2024, 5, 1), "1日目", "", journal.MoodGreat, &place, testCreatedAt, testCreatedAt,
+		)
+
+		items := Build([]*itinerary.Activity{lunch, flight, free}, nil, []*accommodation.Accommodation{hotel}, []*journal.Entry{entry}, utcZone)
+
+		assert.Equal(t, []string{
+			"journal_entry:entry 2024-05-01T00:00:00+09:00",
+			"activity:lunch 2024-05-01T12:00:00+09:00",
+			"check_in:hotel 2024-05-01T06:00:00Z",
+			"activity:flight 2024-05-01T23:00:00+09:00",
+			"activity:free 2024-05-02T00:00:00Z",
+			"check_out:hotel 2024-05-02T01:00:00Z",
+		}, rendered(items))
+		assert.Equal(t, date(2024, 5, 1), items[3].Date(), "現地の日付で扱うべき")
+		require.NotNil(t, items[3].EndAt())
+		assert.Equal(t, "2024-05-02T01:00:00+09:00", items[3].EndAt().Format(time.RFC3339))
+		assert.True(t, items[0].AllDay())
+		assert.Nil(t, items[0].EndAt())
+		assert.Equal(t, "Asia/Tokyo", items[0].Timezone().Name(), "日記の場所のタイムゾーンを使うべき")
+		assert.Equal(t, "UTC", items[4].Timezone().Name(), "タイムゾーンのない行動は既定のタイムゾーンを使うべき")
+	})
+
+	t.Run("正常系: 夏時間が終わり同じ時刻が 2 回ある場合は壁時計ではなく瞬間の順に並べ、それぞれのオフセットで表す", func(t *testing.T) {
+		newYork := newTestTimezone(t, "America/New_York")
+		// 2026-11-01 は 2 時（夏時間）に 1 時（標準時）へ戻るため、1 時台が 2 回ある
+		day := date(2026, 11, 1)
+		early := newTestActivity(t, "early", day, 0, &newYork, utc(2026, 11, 1, 5, 30), nil)
+		later := newTestActivity(t, "later", day, 1, &newYork, utc(2026, 11, 1, 6, 15), nil)
+
+		items := Build([]*itinerary.Activity{later, early}, nil, nil, nil, utcZone)
+
+		assert.Equal(t, []string{
+			"activity:early 2026-11-01T01:30:00-04:00",
+			"activity:later 2026-11-01T01:15:00-05:00",
+		}, rendered(items), "現地の時刻が早い later の方が実際には後に始まるべき")
+	})
+
+	t.Run("正常系: 夏時間が始まり時刻が飛ぶ場合は飛んだ後の時刻とオフセットで表す", func(t *testing.T) {
+		newYork := newTestTimezone(t, "America/New_York")
+		// 2026-03-08 は 2 時（標準時）に 3 時（夏時間）へ進むため、2 時台がない
+		day := date(2026, 3, 8)
+		show := newTestActivity(t, "show", day, 0, &newYork, utc(2026, 3, 8, 6, 30), utc(2026, 3, 8, 7, 30))
+		after := newTestActivity(t, "after", day, 1, &newYork, utc(2026, 3, 8, 7, 0), nil)
+
+		items := Build([]*itinerary.Activity{show, after}, nil, nil, nil, utcZone)
+
+		assert.Equal(t, []string{
+			"activity:show 2026-03-08T01:30:00-05:00",
+			"activity:after 2026-03-08T03:00:00-04:00",
+		}, rendered(items))
+		require.NotNil(t, items[0].EndAt())
+		assert.Equal(t, "2026-03-08T03:30:00-04:00", items[0].EndAt().Format(time.RFC3339), "1 時間の予定は時刻が飛んだ分だけ遅い時刻に終わるべき")
+	})
+
+	t.Run("正常系: 0 時が存在しない日の終日の項目は時刻が切り替わった瞬間に始まる", func(t *testing.T) {
+		santiago := newTestTimezone(t, "America/Santiago")
+		// 2025-09-07 は 0 時（標準時）に 1 時（夏時間）へ進むため、0 時台がない
+		before := newTestActivity(t, "before", date(2025, 9, 6), 0, &santiago, utc(2025, 9, 7, 3, 30), nil)
+		allDay := newTestActivity(t, "all-day", date(2025, 9, 7), 0, &santiago, nil, nil)
+		morning := newTestActivity(t, "morning", date(2025, 9, 7), 1, &santiago, utc(2025, 9, 7, 4, 0), nil)
+
+		items := Build([]*itinerary.Activity{morning, allDay, before}, nil, nil, nil, utcZone)
+
+		assert.Equal(t, []string{
+			"activity:before 2025-09-06T23:30:00-04:00",
+			"activity:all-day 2025-09-07T01:00:00-03:00",
+			"activity:morning 2025-09-07T01:00:00-03:00",
+		}, rendered(items), "同じ瞬間に始まる場合は終日の項目が先になるべき")
+		assert.Equal(t, date(2025, 9, 7), items[1].Date())
+		assert.Equal(t, date(2025, 9, 6), items[0].Date())
+	})
+
+	t.Run("正常系: 移動は出発日時を出発地、到着日時を到着地の現地時刻で表す", func(t *testing.T) {
+		honolulu := newTestTimezone(t, "Pacific/Honolulu")
+		// 東京を 5 月 1 日 23 時に出てホノルルに同じ 5 月 1 日の 11 時に着く。日付変更線を越えるため現地の到着時刻の方が早い
+		flight := newTestLeg(t, "flight", &tokyo, &honolulu, *utc(2024, 5, 1, 14, 0), *utc(2024, 5, 1, 21, 0))
+		lunch := newTestActivity(t, "lunch", date(2024, 5, 1), 0, &honolulu, utc(2024, 5, 1, 23, 0), nil)
+		stay, err := accommodation.NewStay(*utc(2024, 5, 2, 1, 0), *utc(2024, 5, 3, 21, 0))
+		require.NoError(t, err)
+		cost, err := money.NewMoney(30000, "USD")
+		require.NoError(t, err)
+		hotel := accommodation.NewAccommodation(
+			accommodation.NewAccommodationID("hotel"), testTripID, "Hotel", "", &honolulu, stay, "", cost, "", testCreatedAt, testCreatedAt,
+		)
+
+		items := Build([]*itinerary.Activity{lunch}, []*transport.Leg{flight}, []*accommodation.Accommodation{hotel}, nil, utcZone)
+
+		assert.Equal(t, []string{
+			"transport_leg:flight 2024-05-01T23:00:00+09:00",
+			"activity:lunch 2024-05-01T13:00:00-10:00",
+			"check_in:hotel 2024-05-01T15:00:00-10:00",
+			"check_out:hotel 2024-05-03T11:00:00-10:00",
+		}, rendered(items), "宿泊予約は宿泊先の現地時刻で表すべき")
+		assert.Equal(t, "flight: 出発地 → 到着地", items[0].Title())
+		assert.Equal(t, date(2024, 5, 1), items[0].Date(), "出発地の現地の日付で扱うべき")
+		assert.Equal(t, "Asia/Tokyo", items[0].Timezone().Name())
+		assert.Equal(t, "Pacific/Honolulu", items[0].EndTimezone().Name())
+		require.NotNil(t, items[0].EndAt())
+		assert.Equal(t, "2024-05-01T11:00:00-10:00", items[0].EndAt().Format(time.RFC3339))
+		assert.Equal(t, "Pacific/Honolulu", items[1].EndTimezone().Name(), "移動以外は終了時も同じタイムゾーンとするべき")
+	})
+
+	t.Run("正常系: 夏時間が始まる時刻をまたぐ移動は出発と到着でオフセットが変わる", func(t *testing.T) {
+		london := newTestTimezone(t, "Europe/London")
+		// 2026-03-29 は 1 時（グリニッジ標準時）に 2 時（夏時間）へ進む
+		bus := newTestLeg(t, "bus", &london, &london, *utc(2026, 3, 29, 0, 30), *utc(2026, 3, 29, 2, 30))
+
+		items := Build(nil, []*transport.Leg{bus}, nil, nil, utcZone)
+
+		require.Len(t, items, 1)
+		assert.Equal(t, "2026-03-29T00:30:00Z", items[0].StartAt().Format(time.RFC3339))
+		require.NotNil(t, items[0].EndAt())
+		assert.Equal(t, "2026-03-29T03:30:00+01:00", items[0].EndAt().Format(time.RFC3339), "2 時間の移動は時刻が進んだ分だけ遅い時刻に着くべき")
+	})
+
+	t.Run("正常系: タイムゾーンの決まっていない出発地と到着地は既定のタイムゾーンで表す", func(t *testing.T) {
+		leg := newTestLeg(t, "leg", nil, nil, *utc(2024, 5, 1, 14, 0), *utc(2024, 5, 1, 16, 0))
+
+		items := Build(nil, []*transport.Leg{leg}, nil, nil, tokyo)
+
+		require.Len(t, items, 1)
+		assert.Equal(t, "Asia/Tokyo", items[0].Timezone().Name())
+		assert.Equal(t, "Asia/Tokyo", items[0].EndTimezone().Name())
+		assert.Equal(t, "2024-05-01T23:00:00+09:00", items[0].StartAt().Format(time.RFC3339))
+	})
+
+	t.Run("正常系: 項目がなければ空", func(t *testing.T) {
+		assert.Empty(t, Build(nil, nil, nil, nil, utcZone))
+	})
+}
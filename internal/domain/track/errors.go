@@ -0,0 +1,27 @@
+package track
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeTrackNotFound = "TRACK_NOT_FOUND"
+)
+
+func NewTrackNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeTrackNotFound, "Track not found", opts...)
+}
+
+func NewEmptyFileError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Track file must not be empty", opts...)
+}
+
+func NewFileTooLargeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewPayloadTooLargeError("Track file exceeds the maximum size", opts...)
+}
+
+func NewInvalidFileError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Track file must be a valid GPX file", opts...)
+}
+
+func NewEmptyTrackError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Track must contain at least one track point", opts...)
+}
@@ -0,0 +1,17 @@
+package track
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+//go:generate mockgen -destination mock/track.go github.com/hata0/travel-api/internal/domain/track TrackRepository
+type TrackRepository interface {
+	FindByID(ctx context.Context, id TrackID) (*Track, error)
+	// FindByTripID は旅行のトラックを記録の開始日時の順に取得する。記録日時のないトラックは最後に追加された順で並べる。
+	// activityID が nil でない場合はその行動に紐づくトラックだけを返す
+	FindByTripID(ctx context.Context, tripID trip.TripID, activityID *string) ([]*Track, error)
+	Create(ctx context.Context, track *Track) error
+	Delete(ctx context.Context, id TrackID) error
+}
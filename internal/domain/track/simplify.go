@@ -0,0 +1,74 @@
+package track
+
+import "math"
+
+// SimplifyToleranceMeters は保存する軌跡を間引くときに許容する、元の軌跡からのずれ
+const SimplifyToleranceMeters = 5.0
+
+// metersPerDegree は緯度 1 度あたりのおおよその距離。間引きの判定は短い範囲で行うため球とみなして近似する
+const metersPerDegree = 111319.49
+
+// Simplify は Douglas-Peucker 法でセグメントの点を間引く。
+// 始点と終点を結ぶ線分から最も離れた点が toleranceMeters を超える場合にその点を残し、両側を同じように分割していく。
+// 始点と終点は必ず残し、点の順序は変えない
+func Simplify(segment Segment, toleranceMeters float64) Segment {
+	if len(segment) <= 2 {
+		return append(Segment(nil), segment...)
+	}
+
+	// 区間の中央の緯度を基準に、緯度・経度をメートル単位の平面座標に変換する
+	var latSum float64
+	for _, p := range segment {
+		latSum += p.coordinate.Latitude()
+	}
+	lngScale := math.Cos(latSum / float64(len(segment)) * math.Pi / 180)
+	xs := make([]float64, len(segment))
+	ys := make([]float64, len(segment))
+	for i, p := range segment {
+		xs[i] = p.coordinate.Longitude() * metersPerDegree * lngScale
+		ys[i] = p.coordinate.Latitude() * metersPerDegree
+	}
+
+	keep := make([]bool, len(segment))
+	keep[0], keep[len(segment)-1] = true, true
+
+	// 長い軌跡で再帰が深くならないよう、分割する区間をスタックで管理する
+	stack := [][2]int{{0, len(segment) - 1}}
+	for len(stack) > 0 {
+		first, last := stack[len(stack)-1][0], stack[len(stack)-1][1]
+		stack = stack[:len(stack)-1]
+
+		farthest, farthestDistance := -1, 0.0
+		for i := first + 1; i < last; i++ {
+			d := distanceToSegment(xs[i], ys[i], xs[first], ys[first], xs[last], ys[last])
+			if d > farthestDistance {
+				farthest, farthestDistance = i, d
+			}
+		}
+		if farthest < 0 || farthestDistance <= toleranceMeters {
+			continue
+		}
+		keep[farthest] = true
+		stack = append(stack, [2]int{first, farthest}, [2]int{farthest, last})
+	}
+
+	simplified := make(Segment, 0, len(segment))
+	for i, p := range segment {
+		if keep[i] {
+			simplified = append(simplified, p)
+		}
+	}
+	return simplified
+}
+
+// distanceToSegment は点 (px, py) から線分 (ax, ay)-(bx, by) までの距離を返す
+func distanceToSegment(px, py, ax, ay, bx, by float64) float64 {
+	dx, dy := bx-ax, by-ay
+	lengthSq := dx*dx + dy*dy
+	if lengthSq == 0 {
+		return math.Hypot(px-ax, py-ay)
+	}
+	t := ((px-ax)*dx + (py-ay)*dy) / lengthSq
+	t = max(0, min(1, t))
+	return math.Hypot(px-(ax+t*dx), py-(ay+t*dy))
+}
@@ -0,0 +1,150 @@
+package track
+
+import (
+	"time"
+)
+
+const (
+	// MovingSpeedThreshold は移動中とみなす最低の速さ（m/s）。これより遅い区間は休憩や GPS の揺らぎとして移動時間に含めない
+	MovingSpeedThreshold = 0.5
+	// ElevationNoiseMeters は獲得標高・損失標高に数える最小の標高差。GPS の標高の揺らぎで累積値が膨らまないよう、
+	// 直前に数えた地点からこの差を超えて上り下りした分だけを数える
+	ElevationNoiseMeters = 3.0
+)
+
+// Stats はトラック全体の距離・時間・標高の統計を表現する値オブジェクト。
+// 記録日時や標高が記録されていない軌跡では、それらから求める項目は 0 または nil となる
+type Stats struct {
+	distanceMeters      float64
+	movingDuration      time.Duration
+	elapsedDuration     time.Duration
+	elevationGainMeters float64
+	elevationLossMeters float64
+	// maxSpeed は区間ごとの速さ（m/s）の最大値
+	maxSpeed   float64
+	startedAt  *time.Time
+	endedAt    *time.Time
+	bounds     Bounds
+	pointCount int
+}
+
+// RestoreStats は保存済みの統計を復元する
+func RestoreStats(
+	distanceMeters float64,
+	movingDuration, elapsedDuration time.Duration,
+	elevationGainMeters, elevationLossMeters float64,
+	maxSpeed float64,
+	startedAt, endedAt *time.Time,
+	bounds Bounds,
+	pointCount int,
+) Stats {
+	return Stats{
+		distanceMeters:      distanceMeters,
+		movingDuration:      movingDuration,
+		elapsedDuration:     elapsedDuration,
+		elevationGainMeters: elevationGainMeters,
+		elevationLossMeters: elevationLossMeters,
+		maxSpeed:            maxSpeed,
+		startedAt:           startedAt,
+		endedAt:             endedAt,
+		bounds:              bounds,
+		pointCount:          pointCount,
+	}
+}
+
+// Getters
+func (s Stats) DistanceMeters() float64        { return s.distanceMeters }
+func (s Stats) MovingDuration() time.Duration  { return s.movingDuration }
+func (s Stats) ElapsedDuration() time.Duration { return s.elapsedDuration }
+func (s Stats) ElevationGainMeters() float64   { return s.elevationGainMeters }
+func (s Stats) ElevationLossMeters() float64   { return s.elevationLossMeters }
+func (s Stats) MaxSpeed() float64              { return s.maxSpeed }
+func (s Stats) StartedAt() *time.Time          { return s.startedAt }
+func (s Stats) EndedAt() *time.Time            { return s.endedAt }
+func (s Stats) Bounds() Bounds                 { return s.bounds }
+func (s Stats) PointCount() int                { return s.pointCount }
+
+// ComputeStats は記録した軌跡から統計を求める。
+//   - 距離: セグメント内で隣り合う点の WGS84 楕円体上の測地線距離の合計。セグメントの間は移動していないものとして数えない
+//   - 移動時間: 隣り合う点の速さが MovingSpeedThreshold 以上の区間の時間の合計
+//   - 経過時間: 最初の記録日時から最後の記録日時まで
+//   - 獲得標高・損失標高: ElevationNoiseMeters を超える上り下りの合計
+//   - 最高速度: 記録日時が進んでいる区間の速さの最大値
+func ComputeStats(segments []Segment) Stats {
+	var s Stats
+	first := true
+	for _, segment := range segments {
+		var reference *float64
+		for i, p := range segment {
+			s.pointCount++
+			if first {
+				s.bounds = NewBounds(p.coordinate.Latitude(), p.coordinate.Longitude(), p.coordinate.Latitude(), p.coordinate.Longitude())
+				first = false
+			} else {
+				s.bounds = s.bounds.extend(p.coordinate)
+			}
+			s.observeTime(p.recordedAt)
+			reference = s.observeElevation(reference, p.elevation)
+
+			if i == 0 {
+				continue
+			}
+			prev := segment[i-1]
+			distance := prev.coordinate.GeodesicDistanceMeters(p.coordinate)
+			s.distanceMeters += distance
+
+			if prev.recordedAt == nil || p.recordedAt == nil {
+				continue
+			}
+			elapsed := p.recordedAt.Sub(*prev.recordedAt)
+			if elapsed <= 0 {
+				continue
+			}
+			speed := distance / elapsed.Seconds()
+			if speed >= MovingSpeedThreshold {
+				s.movingDuration += elapsed
+			}
+			s.maxSpeed = max(s.maxSpeed, speed)
+		}
+	}
+	if s.startedAt != nil {
+		s.elapsedDuration = s.endedAt.Sub(*s.startedAt)
+	}
+	return s
+}
+
+// observeTime は記録日時の最初と最後を更新する。GPX の点は時刻順とは限らないため最小値と最大値をとる
+func (s *Stats) observeTime(at *time.Time) {
+	if at == nil {
+		return
+	}
+	if s.startedAt == nil || at.Before(*s.startedAt) {
+		v := *at
+		s.startedAt = &v
+	}
+	if s.endedAt == nil || at.After(*s.endedAt) {
+		v := *at
+		s.endedAt = &v
+	}
+}
+
+// observeElevation は直前に数えた地点の標高 reference から ElevationNoiseMeters を超えて上り下りしていれば獲得標高・損失標高に加え、
+// 次に比べる標高を返す
+func (s *Stats) observeElevation(reference, elevation *float64) *float64 {
+	if elevation == nil {
+		return reference
+	}
+	if reference == nil {
+		return elevation
+	}
+	switch diff := *elevation - *reference; {
+	case diff > ElevationNoiseMeters:
+		s.elevationGainMeters += diff
+		return elevation
+	case -diff > ElevationNoiseMeters:
+		s.elevationLossMeters += -diff
+		return elevation
+	default:
+		return reference
+	}
+}
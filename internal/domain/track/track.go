@@ -0,0 +1,88 @@
+package track
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// Track は GPS で記録して旅行に追加された軌跡を表現するエンティティ。
+// 統計はアップロードされた軌跡のすべての点から求め、形状は Douglas-Peucker 法で間引いたものだけを保持する
+type Track struct {
+	id         TrackID
+	tripID     trip.TripID
+	activityID *string
+	name       string
+	stats      Stats
+	geometry   []Segment
+	uploadedBy user.UserID
+	createdAt  time.Time
+}
+
+// NewTrack は記録した軌跡から新しいトラックを作成する。
+// activityID はトラックを記録した行動のIDで、紐づく行動がない場合は nil を渡す。点を 1 つも含まない場合はエラーを返す
+func NewTrack(
+	id TrackID,
+	tripID trip.TripID,
+	activityID *string,
+	name string,
+	segments []Segment,
+	uploadedBy user.UserID,
+	createdAt time.Time,
+) (*Track, error) {
+	geometry := make([]Segment, 0, len(segments))
+	for _, segment := range segments {
+		if len(segment) == 0 {
+			continue
+		}
+		geometry = append(geometry, Simplify(segment, SimplifyToleranceMeters))
+	}
+	if len(geometry) == 0 {
+		return nil, NewEmptyTrackError()
+	}
+
+	return &Track{
+		id:         id,
+		tripID:     tripID,
+		activityID: activityID,
+		name:       name,
+		stats:      ComputeStats(segments),
+		geometry:   geometry,
+		uploadedBy: uploadedBy,
+		createdAt:  createdAt,
+	}, nil
+}
+
+// RestoreTrack は保存済みのトラックを復元する
+func RestoreTrack(
+	id TrackID,
+	tripID trip.TripID,
+	activityID *string,
+	name string,
+	stats Stats,
+	geometry []Segment,
+	uploadedBy user.UserID,
+	createdAt time.Time,
+) *Track {
+	return &Track{
+		id:         id,
+		tripID:     tripID,
+		activityID: activityID,
+		name:       name,
+		stats:      stats,
+		geometry:   geometry,
+		uploadedBy: uploadedBy,
+		createdAt:  createdAt,
+	}
+}
+
+// Getters
+func (t *Track) ID() TrackID             { return t.id }
+func (t *Track) TripID() trip.TripID     { return t.tripID }
+func (t *Track) ActivityID() *string     { return t.activityID }
+func (t *Track) Name() string            { return t.name }
+func (t *Track) Stats() Stats            { return t.stats }
+func (t *Track) Geometry() []Segment     { return t.geometry }
+func (t *Track) UploadedBy() user.UserID { return t.uploadedBy }
+func (t *Track) CreatedAt() time.Time    { return t.createdAt }
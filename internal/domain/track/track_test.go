@@ -0,0 +1,147 @@
+package track
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var trackStartedAt = time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+// newTestPoint は記録開始から seconds 秒後に記録した点を生成する。elevation が nil の場合は標高のない点とする
+func newTestPoint(t *testing.T, lat, lng float64, elevation *float64, seconds int) Point {
+	t.Helper()
+	c, err := geo.NewCoordinate(lat, lng)
+	require.NoError(t, err)
+	at := trackStartedAt.Add(time.Duration(seconds) * time.Second)
+	return NewPoint(c, elevation, &at)
+}
+
+func elevation(v float64) *float64 {
+	return &v
+}
+
+func TestComputeStats(t *testing.T) {
+	t.Run("正常系: 距離・時間・標高・範囲を求める", func(t *testing.T) {
+		// 赤道上を経度 0.001 度（約 111 m）ずつ進む
+		segments := []Segment{
+			{
+				newTestPoint(t, 0, 0, elevation(100), 0),
+				newTestPoint(t, 0, 0.001, elevation(110), 60),
+				// 10 分間ほとんど動かずに休憩する
+				newTestPoint(t, 0, 0.001, elevation(111), 660),
+				newTestPoint(t, 0, 0.002, elevation(105), 690),
+			},
+			// 受信が途切れた間の移動は距離に数えない
+			{
+				newTestPoint(t, 0.01, 0.01, elevation(101), 1000),
+				newTestPoint(t, 0.01, 0.011, elevation(99), 1060),
+			},
+		}
+
+		got := ComputeStats(segments)
+
+		assert.InDelta(t, 3*111.319, got.DistanceMeters(), 0.01)
+		assert.Equal(t, 150*time.Second, got.MovingDuration(), "休憩した区間は移動時間に含めないべき")
+		assert.Equal(t, 1060*time.Second, got.ElapsedDuration())
+		assert.InDelta(t, 10, got.ElevationGainMeters(), 1e-9, "ElevationNoiseMeters 以下の上り下りは数えないべき")
+		assert.InDelta(t, 5, got.ElevationLossMeters(), 1e-9)
+		assert.InDelta(t, 111.319/30, got.MaxSpeed(), 0.001)
+		assert.Equal(t, trackStartedAt, *got.StartedAt())
+		assert.Equal(t, trackStartedAt.Add(1060*time.Second), *got.EndedAt())
+		assert.Equal(t, NewBounds(0, 0, 0.01, 0.011), got.Bounds())
+		assert.Equal(t, 6, got.PointCount())
+	})
+
+	t.Run("正常系: 記録日時と標高のない軌跡は距離と範囲だけを求める", func(t *testing.T) {
+		a, err := geo.NewCoordinate(35, 135)
+		require.NoError(t, err)
+		b, err := geo.NewCoordinate(35.001, 135)
+		require.NoError(t, err)
+
+		got := ComputeStats([]Segment{{NewPoint(a, nil, nil), NewPoint(b, nil, nil)}})
+
+		assert.Greater(t, got.DistanceMeters(), 0.0)
+		assert.Zero(t, got.MovingDuration())
+		assert.Zero(t, got.ElapsedDuration())
+		assert.Zero(t, got.ElevationGainMeters())
+		assert.Zero(t, got.MaxSpeed())
+		assert.Nil(t, got.StartedAt())
+		assert.Nil(t, got.EndedAt())
+	})
+}
+
+func TestSimplify(t *testing.T) {
+	t.Run("正常系: 直線上の点を間引き、曲がり角は残す", func(t *testing.T) {
+		segment := Segment{
+			newTestPoint(t, 35, 135, nil, 0),
+			newTestPoint(t, 35, 135.001, nil, 10),
+			newTestPoint(t, 35.00001, 135.002, nil, 20),
+			newTestPoint(t, 35, 135.003, nil, 30),
+			newTestPoint(t, 35.001, 135.003, nil, 40),
+			newTestPoint(t, 35.002, 135.003, nil, 50),
+		}
+
+		got := Simplify(segment, SimplifyToleranceMeters)
+
+		assert.Equal(t, Segment{segment[0], segment[3], segment[5]}, got)
+	})
+
+	t.Run("正常系: 許容するずれを超える点は残す", func(t *testing.T) {
+		segment := Segment{
+			newTestPoint(t, 35, 135, nil, 0),
+			newTestPoint(t, 35.0001, 135.001, nil, 10),
+			newTestPoint(t, 35, 135.002, nil, 20),
+		}
+
+		assert.Len(t, Simplify(segment, SimplifyToleranceMeters), 3)
+		assert.Len(t, Simplify(segment, 20), 2)
+	})
+
+	t.Run("正常系: 2 点以下のセグメントはそのまま返す", func(t *testing.T) {
+		segment := Segment{newTestPoint(t, 35, 135, nil, 0)}
+
+		assert.Equal(t, segment, Simplify(segment, SimplifyToleranceMeters))
+	})
+}
+
+func TestNewTrack(t *testing.T) {
+	activityID := "9b2e4c55-5d1f-4b45-9d7b-4b5ef7e6a1c0"
+	createdAt := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: すべての点から統計を求め、間引いた形状を保持する", func(t *testing.T) {
+		segments := []Segment{
+			{
+				newTestPoint(t, 35, 135, nil, 0),
+				newTestPoint(t, 35, 135.001, nil, 10),
+				newTestPoint(t, 35, 135.002, nil, 20),
+			},
+			{},
+		}
+
+		got, err := NewTrack(NewTrackID("track-id"), trip.NewTripID("trip-id"), &activityID, "朝の散歩", segments, user.NewUserID("user-id"), createdAt)
+
+		require.NoError(t, err)
+		assert.Equal(t, NewTrackID("track-id"), got.ID())
+		assert.Equal(t, trip.NewTripID("trip-id"), got.TripID())
+		assert.Equal(t, &activityID, got.ActivityID())
+		assert.Equal(t, "朝の散歩", got.Name())
+		assert.Equal(t, 3, got.Stats().PointCount())
+		assert.Equal(t, []Segment{{segments[0][0], segments[0][2]}}, got.Geometry(), "空のセグメントは除き、直線上の点は間引くべき")
+		assert.Equal(t, user.NewUserID("user-id"), got.UploadedBy())
+		assert.Equal(t, createdAt, got.CreatedAt())
+	})
+
+	t.Run("異常系: 点を含まない軌跡はバリデーションエラー", func(t *testing.T) {
+		got, err := NewTrack(NewTrackID("track-id"), trip.NewTripID("trip-id"), nil, "空", []Segment{{}}, user.NewUserID("user-id"), createdAt)
+
+		assert.Nil(t, got)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
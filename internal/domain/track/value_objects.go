@@ -0,0 +1,76 @@
+package track
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+)
+
+// TrackID はトラックIDを表現する値オブジェクト
+type TrackID struct {
+	value string
+}
+
+func NewTrackID(id string) TrackID {
+	return TrackID{value: id}
+}
+
+func (id TrackID) String() string {
+	return id.value
+}
+
+func (id TrackID) Equals(other TrackID) bool {
+	return id.value == other.value
+}
+
+// Point は GPS で記録した軌跡の 1 点を表現する値オブジェクト。標高と記録日時は記録されていない場合 nil
+type Point struct {
+	coordinate geo.Coordinate
+	elevation  *float64
+	recordedAt *time.Time
+}
+
+func NewPoint(coordinate geo.Coordinate, elevation *float64, recordedAt *time.Time) Point {
+	return Point{coordinate: coordinate, elevation: elevation, recordedAt: recordedAt}
+}
+
+// Getters
+func (p Point) Coordinate() geo.Coordinate { return p.coordinate }
+func (p Point) Elevation() *float64        { return p.elevation }
+func (p Point) RecordedAt() *time.Time     { return p.recordedAt }
+
+// Segment は途切れずに記録した点の並び。GPS の受信が途切れると GPX では別のセグメントになる
+type Segment []Point
+
+// Bounds は軌跡を囲む緯度・経度の範囲を表現する値オブジェクト
+type Bounds struct {
+	minLatitude  float64
+	minLongitude float64
+	maxLatitude  float64
+	maxLongitude float64
+}
+
+func NewBounds(minLatitude, minLongitude, maxLatitude, maxLongitude float64) Bounds {
+	return Bounds{
+		minLatitude:  minLatitude,
+		minLongitude: minLongitude,
+		maxLatitude:  maxLatitude,
+		maxLongitude: maxLongitude,
+	}
+}
+
+// Getters
+func (b Bounds) MinLatitude() float64  { return b.minLatitude }
+func (b Bounds) MinLongitude() float64 { return b.minLongitude }
+func (b Bounds) MaxLatitude() float64  { return b.maxLatitude }
+func (b Bounds) MaxLongitude() float64 { return b.maxLongitude }
+
+// extend は座標を含むように範囲を広げた Bounds を返す
+func (b Bounds) extend(c geo.Coordinate) Bounds {
+	return Bounds{
+		minLatitude:  min(b.minLatitude, c.Latitude()),
+		minLongitude: min(b.minLongitude, c.Longitude()),
+		maxLatitude:  max(b.maxLatitude, c.Latitude()),
+		maxLongitude: max(b.maxLongitude, c.Longitude()),
+	}
+}
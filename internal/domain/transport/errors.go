@@ -0,0 +1,23 @@
+package transport
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeTransportLegNotFound = "TRANSPORT_LEG_NOT_FOUND"
+)
+
+func NewLegNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeTransportLegNotFound, "Transport leg not found", opts...)
+}
+
+func NewInvalidModeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Transport mode must be one of flight, train, bus, ferry, car or other", opts...)
+}
+
+func NewInvalidTimeRangeError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Transport leg arrival must not be before its departure", opts...)
+}
+
+func NewOutsideTripPeriodError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Transport leg departure date must be within the trip period", opts...)
+}
@@ -0,0 +1,135 @@
+package transport
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// Leg は旅行の中で出発地から到着地へ向かう 1 回の移動（飛行機・列車など）を表現するエンティティ。
+// 出発日時は出発地の、到着日時は到着地のタイムゾーンの現地時刻で扱う
+type Leg struct {
+	id          LegID
+	tripID      trip.TripID
+	mode        Mode
+	origin      geo.Place
+	destination geo.Place
+	departureAt time.Time
+	arrivalAt   time.Time
+	carrier     string
+	number      string
+	notes       string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewLeg は新しい移動を作成する。carrier は航空会社や鉄道会社などの運行会社、number は便名や列車番号で、分からない場合は空文字を渡す。
+// 到着日時は出発日時より前であってはならない
+func NewLeg(
+	id LegID,
+	tripID trip.TripID,
+	mode Mode,
+	origin, destination geo.Place,
+	departureAt, arrivalAt time.Time,
+	carrier, number, notes string,
+	createdAt, updatedAt time.Time,
+) (*Leg, error) {
+	if arrivalAt.Before(departureAt) {
+		return nil, NewInvalidTimeRangeError()
+	}
+
+	return &Leg{
+		id:          id,
+		tripID:      tripID,
+		mode:        mode,
+		origin:      origin,
+		destination: destination,
+		departureAt: departureAt,
+		arrivalAt:   arrivalAt,
+		carrier:     carrier,
+		number:      number,
+		notes:       notes,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}, nil
+}
+
+// Getters
+func (l *Leg) ID() LegID              { return l.id }
+func (l *Leg) TripID() trip.TripID    { return l.tripID }
+func (l *Leg) Mode() Mode             { return l.mode }
+func (l *Leg) Origin() geo.Place      { return l.origin }
+func (l *Leg) Destination() geo.Place { return l.destination }
+func (l *Leg) DepartureAt() time.Time { return l.departureAt }
+func (l *Leg) ArrivalAt() time.Time   { return l.arrivalAt }
+func (l *Leg) Carrier() string        { return l.carrier }
+func (l *Leg) Number() string         { return l.number }
+func (l *Leg) Notes() string          { return l.notes }
+func (l *Leg) CreatedAt() time.Time   { return l.createdAt }
+func (l *Leg) UpdatedAt() time.Time   { return l.updatedAt }
+
+// DepartureTimezone は出発地のタイムゾーンを返す。決まっていない場合は nil を返す
+func (l *Leg) DepartureTimezone() *geo.Timezone { return l.origin.Timezone() }
+
+// ArrivalTimezone は到着地のタイムゾーンを返す。決まっていない場合は nil を返す
+func (l *Leg) ArrivalTimezone() *geo.Timezone { return l.destination.Timezone() }
+
+// DepartureDate は出発日時の出発地での日付を、時刻を切り捨てたUTCの日付として返す。
+// 出発地のタイムゾーンが決まっていない場合は UTC の日付とする
+func (l *Leg) DepartureDate() time.Time {
+	if tz := l.DepartureTimezone(); tz != nil {
+		return tz.LocalDate(l.departureAt)
+	}
+	return trip.TruncateToDate(l.departureAt)
+}
+
+// ArrivalDate は到着日時の到着地での日付を、時刻を切り捨てたUTCの日付として返す。
+// 到着地のタイムゾーンが決まっていない場合は UTC の日付とする
+func (l *Leg) ArrivalDate() time.Time {
+	if tz := l.ArrivalTimezone(); tz != nil {
+		return tz.LocalDate(l.arrivalAt)
+	}
+	return trip.TruncateToDate(l.arrivalAt)
+}
+
+// Summary は移動を 1 行で表す、交通機関・便名と出発地・到着地の名前を返す
+func (l *Leg) Summary() string {
+	label := l.mode.String()
+	if l.number != "" {
+		label = l.number
+		if l.carrier != "" {
+			label = l.carrier + " " + l.number
+		}
+	}
+	return label + ": " + l.origin.Name() + " → " + l.destination.Name()
+}
+
+// Update は移動の内容を更新する
+func (l *Leg) Update(
+	mode Mode,
+	origin, destination geo.Place,
+	departureAt, arrivalAt time.Time,
+	carrier, number, notes string,
+	updatedAt time.Time,
+) (*Leg, error) {
+	return NewLeg(l.id, l.tripID, mode, origin, destination, departureAt, arrivalAt, carrier, number, notes, l.createdAt, updatedAt)
+}
+
+// ValidateFor は移動の出発日が指定された旅行の期間内にあるかを検証する。期間未定の旅行ではどの日付も許容する
+func (l *Leg) ValidateFor(t *trip.Trip) error {
+	if !l.tripID.Equals(t.ID()) {
+		return NewLegNotFoundError()
+	}
+	if t.Period() != nil && !t.Period().Contains(l.DepartureDate()) {
+		return NewOutsideTripPeriodError()
+	}
+	return nil
+}
+
+func (l *Leg) Equals(other *Leg) bool {
+	if other == nil {
+		return false
+	}
+	return l.id.Equals(other.id)
+}
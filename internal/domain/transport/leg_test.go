@@ -0,0 +1,173 @@
+package transport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPlace(t *testing.T, name, timezone string) geo.Place {
+	t.Helper()
+	var tz *geo.Timezone
+	if timezone != "" {
+		parsed, err := geo.NewTimezone(timezone)
+		require.NoError(t, err)
+		tz = &parsed
+	}
+	p, err := geo.NewPlace(name, nil, tz)
+	require.NoError(t, err)
+	return p
+}
+
+func TestNewLeg(t *testing.T) {
+	id := NewLegID("leg-id-1")
+	tripID := trip.NewTripID("trip-id-1")
+	origin := newTestPlace(t, "羽田空港", "Asia/Tokyo")
+	destination := newTestPlace(t, "ホノルル空港", "Pacific/Honolulu")
+	departureAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	arrivalAt := departureAt.Add(7 * time.Hour)
+	createdAt := time.Now()
+
+	t.Run("正常系", func(t *testing.T) {
+		l, err := NewLeg(id, tripID, ModeFlight, origin, destination, departureAt, arrivalAt, "JAL", "JL74", "窓側の席", createdAt, createdAt)
+		require.NoError(t, err)
+
+		assert.Equal(t, id, l.ID())
+		assert.Equal(t, tripID, l.TripID())
+		assert.Equal(t, ModeFlight, l.Mode())
+		assert.Equal(t, origin, l.Origin())
+		assert.Equal(t, destination, l.Destination())
+		assert.Equal(t, departureAt, l.DepartureAt())
+		assert.Equal(t, arrivalAt, l.ArrivalAt())
+		assert.Equal(t, "JAL", l.Carrier())
+		assert.Equal(t, "JL74", l.Number())
+		assert.Equal(t, "窓側の席", l.Notes())
+		assert.Equal(t, "Asia/Tokyo", l.DepartureTimezone().Name())
+		assert.Equal(t, "Pacific/Honolulu", l.ArrivalTimezone().Name())
+	})
+
+	t.Run("正常系: 出発と到着が同じ日時", func(t *testing.T) {
+		_, err := NewLeg(id, tripID, ModeCar, origin, destination, departureAt, departureAt, "", "", "", createdAt, createdAt)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系: 到着日時が出発日時より前", func(t *testing.T) {
+		_, err := NewLeg(id, tripID, ModeFlight, origin, destination, departureAt, departureAt.Add(-time.Minute), "", "", "", createdAt, createdAt)
+		assert.ErrorIs(t, err, NewInvalidTimeRangeError())
+	})
+}
+
+func TestParseMode(t *testing.T) {
+	for _, m := range Modes() {
+		got, err := ParseMode(m.String())
+		require.NoError(t, err)
+		assert.Equal(t, m, got)
+	}
+
+	_, err := ParseMode("rocket")
+	assert.ErrorIs(t, err, NewInvalidModeError())
+}
+
+func TestLeg_DepartureDate(t *testing.T) {
+	tripID := trip.NewTripID("trip-id-1")
+	createdAt := time.Now()
+	// 東京の 5 月 2 日 1 時は UTC では 5 月 1 日
+	departureAt := time.Date(2024, 5, 1, 16, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 出発地の現地の日付を返す", func(t *testing.T) {
+		l, err := NewLeg(NewLegID("leg-id"), tripID, ModeFlight, newTestPlace(t, "羽田空港", "Asia/Tokyo"), newTestPlace(t, "ホノルル空港", ""), departureAt, departureAt.Add(7*time.Hour), "", "", "", createdAt, createdAt)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), l.DepartureDate())
+	})
+
+	t.Run("正常系: 出発地のタイムゾーンが決まっていなければ UTC の日付を返す", func(t *testing.T) {
+		l, err := NewLeg(NewLegID("leg-id"), tripID, ModeFlight, newTestPlace(t, "羽田空港", ""), newTestPlace(t, "ホノルル空港", ""), departureAt, departureAt.Add(7*time.Hour), "", "", "", createdAt, createdAt)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), l.DepartureDate())
+	})
+}
+
+func TestLeg_ArrivalDate(t *testing.T) {
+	tripID := trip.NewTripID("trip-id-1")
+	createdAt := time.Now()
+	// 東京の 5 月 2 日 1 時に出発し、ホノルルの 5 月 1 日 15 時（UTC では 5 月 2 日 1 時）に到着する
+	departureAt := time.Date(2024, 5, 1, 16, 0, 0, 0, time.UTC)
+	arrivalAt := departureAt.Add(9 * time.Hour)
+
+	t.Run("正常系: 到着地の現地の日付を返す", func(t *testing.T) {
+		l, err := NewLeg(NewLegID("leg-id"), tripID, ModeFlight, newTestPlace(t, "羽田空港", "Asia/Tokyo"), newTestPlace(t, "ホノルル空港", "Pacific/Honolulu"), departureAt, arrivalAt, "", "", "", createdAt, createdAt)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), l.ArrivalDate())
+		assert.Equal(t, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), l.DepartureDate())
+	})
+
+	t.Run("正常系: 到着地のタイムゾーンが決まっていなければ UTC の日付を返す", func(t *testing.T) {
+		l, err := NewLeg(NewLegID("leg-id"), tripID, ModeFlight, newTestPlace(t, "羽田空港", "Asia/Tokyo"), newTestPlace(t, "ホノルル空港", ""), departureAt, arrivalAt, "", "", "", createdAt, createdAt)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), l.ArrivalDate())
+	})
+}
+
+func TestLeg_Summary(t *testing.T) {
+	createdAt := time.Now()
+	origin := newTestPlace(t, "東京", "")
+	destination := newTestPlace(t, "京都", "")
+	departureAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		carrier string
+		number  string
+		want    string
+	}{
+		{name: "運行会社と便名", carrier: "JR東海", number: "のぞみ1号", want: "JR東海 のぞみ1号: 東京 → 京都"},
+		{name: "便名だけ", number: "のぞみ1号", want: "のぞみ1号: 東京 → 京都"},
+		{name: "便名がなければ交通機関", carrier: "JR東海", want: "train: 東京 → 京都"},
+	}
+	for _, tt := range tests {
+		t.Run("正常系: "+tt.name, func(t *testing.T) {
+			l, err := NewLeg(NewLegID("leg-id"), trip.NewTripID("trip-id"), ModeTrain, origin, destination, departureAt, departureAt, tt.carrier, tt.number, "", createdAt, createdAt)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, l.Summary())
+		})
+	}
+}
+
+func TestLeg_ValidateFor(t *testing.T) {
+	tripID := trip.NewTripID("trip-id-1")
+	period, err := trip.NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	planned := trip.NewTrip(tripID, "ハワイ旅行", period, "", time.Now(), time.Now())
+	undecided := trip.NewTrip(tripID, "ハワイ旅行", nil, "", time.Now(), time.Now())
+	origin := newTestPlace(t, "羽田空港", "Asia/Tokyo")
+	destination := newTestPlace(t, "ホノルル空港", "Pacific/Honolulu")
+	newLeg := func(departureAt time.Time) *Leg {
+		l, err := NewLeg(NewLegID("leg-id"), tripID, ModeFlight, origin, destination, departureAt, departureAt.Add(7*time.Hour), "", "", "", time.Now(), time.Now())
+		require.NoError(t, err)
+		return l
+	}
+
+	t.Run("正常系: 出発日が旅行の期間内", func(t *testing.T) {
+		assert.NoError(t, newLeg(time.Date(2024, 5, 3, 12, 0, 0, 0, time.UTC)).ValidateFor(planned))
+	})
+
+	t.Run("正常系: 期間未定の旅行", func(t *testing.T) {
+		assert.NoError(t, newLeg(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)).ValidateFor(undecided))
+	})
+
+	t.Run("異常系: 出発地の現地の日付が旅行の期間外", func(t *testing.T) {
+		// UTC では 5 月 3 日だが、東京では 5 月 4 日
+		err := newLeg(time.Date(2024, 5, 3, 16, 0, 0, 0, time.UTC)).ValidateFor(planned)
+		assert.ErrorIs(t, err, NewOutsideTripPeriodError())
+	})
+
+	t.Run("異常系: 別の旅行の移動", func(t *testing.T) {
+		other := trip.NewTrip(trip.NewTripID("other-trip-id"), "別の旅行", nil, "", time.Now(), time.Now())
+		err := newLeg(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)).ValidateFor(other)
+		assert.ErrorIs(t, err, NewLegNotFoundError())
+	})
+}
@@ -0,0 +1,17 @@
+package transport
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+//go:generate mockgen -destination mock/leg.go github.com/hata0/travel-api/internal/domain/transport LegRepository
+type LegRepository interface {
+	FindByID(ctx context.Context, id LegID) (*Leg, error)
+	// FindByTripID は旅行の移動を出発日時の早い順（同じ日時の中では作成日時の古い順）に取得する
+	FindByTripID(ctx context.Context, tripID trip.TripID) ([]*Leg, error)
+	Create(ctx context.Context, leg *Leg) error
+	Update(ctx context.Context, leg *Leg) error
+	Delete(ctx context.Context, id LegID) error
+}
@@ -0,0 +1,49 @@
+package transport
+
+// LegID は移動のIDを表現する値オブジェクト
+type LegID struct {
+	value string
+}
+
+func NewLegID(id string) LegID {
+	return LegID{value: id}
+}
+
+func (id LegID) String() string {
+	return id.value
+}
+
+func (id LegID) Equals(other LegID) bool {
+	return id.value == other.value
+}
+
+// Mode は移動に使う交通機関を表現する値オブジェクト
+type Mode string
+
+const (
+	ModeFlight Mode = "flight"
+	ModeTrain  Mode = "train"
+	ModeBus    Mode = "bus"
+	ModeFerry  Mode = "ferry"
+	ModeCar    Mode = "car"
+	ModeOther  Mode = "other"
+)
+
+// Modes は交通機関の一覧を返す
+func Modes() []Mode {
+	return []Mode{ModeFlight, ModeTrain, ModeBus, ModeFerry, ModeCar, ModeOther}
+}
+
+// ParseMode は文字列を交通機関に変換する
+func ParseMode(value string) (Mode, error) {
+	for _, m := range Modes() {
+		if string(m) == value {
+			return m, nil
+		}
+	}
+	return "", NewInvalidModeError()
+}
+
+func (m Mode) String() string {
+	return string(m)
+}
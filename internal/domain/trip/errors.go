@@ -9,3 +9,15 @@ const (
 func NewTripNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
 	return apperr.NewAppError(CodeTripNotFound, "Trip not found", opts...)
 }
+
+func NewInvalidPeriodError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Trip end date must not be before start date", opts...)
+}
+
+func NewIncompletePeriodError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Trip start date and end date must be specified together", opts...)
+}
+
+func NewTripVersionMismatchError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewPreconditionFailedError("Trip has been modified by another request", opts...)
+}
@@ -0,0 +1,171 @@
+package trip
+
+import (
+	"strings"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/pagination"
+)
+
+// SortKey は旅行一覧の並び替えキー
+type SortKey string
+
+const (
+	SortByName      SortKey = "name"
+	SortByCreatedAt SortKey = "created_at"
+	SortByUpdatedAt SortKey = "updated_at"
+	// SortByStartDate は開始日で並び替える。期間未定の旅行は開始日が無限遠の未来であるものとして扱う
+	SortByStartDate SortKey = "start_date"
+)
+
+// UndatedSortKey は期間未定の旅行の開始日を表すカーソル上の値
+const UndatedSortKey = "infinity"
+
+// ParseSortKey は並び替えキーを解析する。未指定の場合は作成日時で並び替える
+func ParseSortKey(value string) (SortKey, error) {
+	switch SortKey(value) {
+	case "":
+		return SortByCreatedAt, nil
+	case SortByName, SortByCreatedAt, SortByUpdatedAt, SortByStartDate:
+		return SortKey(value), nil
+	default:
+		return "", apperr.NewValidationError("sort must be one of name, created_at, updated_at, start_date")
+	}
+}
+
+func (s SortKey) String() string {
+	return string(s)
+}
+
+// defaultDirection は並び順が指定されなかった場合の並び順。日時は新しい順、それ以外は昇順とする
+func (s SortKey) defaultDirection() pagination.Direction {
+	switch s {
+	case SortByCreatedAt, SortByUpdatedAt:
+		return pagination.Desc
+	default:
+		return pagination.Asc
+	}
+}
+
+// valueOf はカーソルに記録する旅行の並び替えキーの値を返す
+func (s SortKey) valueOf(t *Trip) string {
+	switch s {
+	case SortByName:
+		return t.Name()
+	case SortByUpdatedAt:
+		return t.UpdatedAt().UTC().Format(time.RFC3339Nano)
+	case SortByStartDate:
+		if t.Period() == nil {
+			return UndatedSortKey
+		}
+		return t.Period().StartDate().Format(time.DateOnly)
+	default:
+		return t.CreatedAt().UTC().Format(time.RFC3339Nano)
+	}
+}
+
+// isValidKey はカーソルに記録された並び替えキーの値が、valueOf が返す形式であるかを判定する
+func (s SortKey) isValidKey(key string) bool {
+	switch s {
+	case SortByName:
+		return true
+	case SortByStartDate:
+		if key == UndatedSortKey {
+			return true
+		}
+		_, err := time.Parse(time.DateOnly, key)
+		return err == nil
+	default:
+		_, err := time.Parse(time.RFC3339Nano, key)
+		return err == nil
+	}
+}
+
+// ListQuery は旅行一覧の取得条件
+type ListQuery struct {
+	sort       SortKey
+	direction  pagination.Direction
+	namePrefix string
+	from       *time.Time
+	to         *time.Time
+	page       pagination.Request
+}
+
+// ListQueryParams は ListQuery の作成に使用する未検証の取得条件
+type ListQueryParams struct {
+	Sort  string
+	Order string
+	// NamePrefix は旅行名の前方一致条件
+	NamePrefix string
+	// From と To は旅行期間の絞り込み条件。期間がこの範囲と重なる旅行だけを取得する
+	From   *time.Time
+	To     *time.Time
+	Limit  int
+	Cursor string
+}
+
+// NewListQuery は取得条件を検証して ListQuery を作成する
+func NewListQuery(params ListQueryParams) (ListQuery, error) {
+	sort, err := ParseSortKey(params.Sort)
+	if err != nil {
+		return ListQuery{}, err
+	}
+
+	direction, err := pagination.ParseDirection(params.Order, sort.defaultDirection())
+	if err != nil {
+		return ListQuery{}, err
+	}
+
+	if params.From != nil && params.To != nil && params.To.Before(*params.From) {
+		return ListQuery{}, apperr.NewValidationError("to must not be before from")
+	}
+
+	q := ListQuery{
+		sort:       sort,
+		direction:  direction,
+		namePrefix: strings.TrimSpace(params.NamePrefix),
+		from:       truncateOptionalDate(params.From),
+		to:         truncateOptionalDate(params.To),
+	}
+
+	var after *pagination.Cursor
+	if params.Cursor != "" {
+		after, err = pagination.DecodeCursor(params.Cursor, q.sortName(), sort.isValidKey)
+		if err != nil {
+			return ListQuery{}, err
+		}
+	}
+
+	q.page, err = pagination.NewRequest(params.Limit, after)
+	if err != nil {
+		return ListQuery{}, err
+	}
+
+	return q, nil
+}
+
+func (q ListQuery) Sort() SortKey                   { return q.sort }
+func (q ListQuery) Direction() pagination.Direction { return q.direction }
+func (q ListQuery) NamePrefix() string              { return q.namePrefix }
+func (q ListQuery) From() *time.Time                { return q.from }
+func (q ListQuery) To() *time.Time                  { return q.to }
+func (q ListQuery) Page() pagination.Request        { return q.page }
+
+// CursorOf は旅行 t の直後から取得を再開するためのカーソルを返す
+func (q ListQuery) CursorOf(t *Trip) pagination.Cursor {
+	return pagination.NewCursor(q.sortName(), q.sort.valueOf(t), t.ID().String())
+}
+
+// sortName はカーソルに記録する並び替え条件の名前
+func (q ListQuery) sortName() string {
+	return q.sort.String() + ":" + q.direction.String()
+}
+
+func truncateOptionalDate(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	truncated := TruncateToDate(*t)
+	return &truncated
+}
@@ -0,0 +1,103 @@
+package trip
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const listQueryTripID = "3f9a1c2e-7b4d-4e8a-9c1f-2d5e6a7b8c90"
+
+func TestNewListQuery(t *testing.T) {
+	t.Run("正常系: 未指定の場合は作成日時の新しい順", func(t *testing.T) {
+		q, err := NewListQuery(ListQueryParams{})
+
+		require.NoError(t, err)
+		assert.Equal(t, SortByCreatedAt, q.Sort())
+		assert.Equal(t, pagination.Desc, q.Direction())
+		assert.Equal(t, pagination.DefaultLimit, q.Page().Limit())
+		assert.Nil(t, q.Page().After())
+	})
+
+	t.Run("正常系: 旅行名の並び替えは既定で昇順", func(t *testing.T) {
+		q, err := NewListQuery(ListQueryParams{Sort: "name", NamePrefix: " 京都 "})
+
+		require.NoError(t, err)
+		assert.Equal(t, pagination.Asc, q.Direction())
+		assert.Equal(t, "京都", q.NamePrefix())
+	})
+
+	t.Run("正常系: 発行したカーソルで次のページを指定できる", func(t *testing.T) {
+		first, err := NewListQuery(ListQueryParams{Sort: "start_date", Order: "desc"})
+		require.NoError(t, err)
+		now := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		cursor := first.CursorOf(NewTrip(NewTripID(listQueryTripID), "旅行", nil, "", now, now))
+
+		next, err := NewListQuery(ListQueryParams{Sort: "start_date", Order: "desc", Cursor: cursor.Encode()})
+
+		require.NoError(t, err)
+		require.NotNil(t, next.Page().After())
+		assert.Equal(t, UndatedSortKey, next.Page().After().Key, "期間未定の旅行は無限遠の開始日として記録されるべき")
+		assert.Equal(t, listQueryTripID, next.Page().After().ID)
+	})
+
+	from := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	otherSortCursor := pagination.NewCursor("name:asc", "旅行", listQueryTripID).Encode()
+	nonUUIDCursor := pagination.NewCursor("name:asc", "旅行", "trip-id-1").Encode()
+	nameKeyCursor := pagination.NewCursor("created_at:desc", "旅行", listQueryTripID).Encode()
+	dateTimeKeyCursor := pagination.NewCursor("start_date:asc", "2024-05-01T00:00:00Z", listQueryTripID).Encode()
+
+	tests := []struct {
+		name   string
+		params ListQueryParams
+	}{
+		{name: "異常系: 不正な並び替えキー", params: ListQueryParams{Sort: "budget"}},
+		{name: "異常系: 不正な並び順", params: ListQueryParams{Order: "up"}},
+		{name: "異常系: 終了日が開始日より前", params: ListQueryParams{From: &from, To: &to}},
+		{name: "異常系: 上限を超える件数", params: ListQueryParams{Limit: pagination.MaxLimit + 1}},
+		{name: "異常系: 並び替え条件が異なるカーソル", params: ListQueryParams{Sort: "name", Order: "desc", Cursor: otherSortCursor}},
+		{name: "異常系: ID が UUID でないカーソル", params: ListQueryParams{Sort: "name", Cursor: nonUUIDCursor}},
+		{name: "異常系: 並び替えキーが日時でないカーソル", params: ListQueryParams{Cursor: nameKeyCursor}},
+		{name: "異常系: 並び替えキーが日付でないカーソル", params: ListQueryParams{Sort: "start_date", Cursor: dateTimeKeyCursor}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewListQuery(tt.params)
+
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+		})
+	}
+}
+
+func TestListQuery_CursorOf(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 9, 30, 0, 123456000, time.FixedZone("JST", 9*60*60))
+	period, err := NewPeriod(time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 8, 2, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	tr := NewTrip(NewTripID("trip-id-1"), "夏休み", period, "", createdAt, createdAt)
+
+	tests := []struct {
+		sort    string
+		wantKey string
+	}{
+		{sort: "name", wantKey: "夏休み"},
+		{sort: "created_at", wantKey: "2024-05-01T00:30:00.123456Z"},
+		{sort: "updated_at", wantKey: "2024-05-01T00:30:00.123456Z"},
+		{sort: "start_date", wantKey: "2024-08-01"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			q, err := NewListQuery(ListQueryParams{Sort: tt.sort})
+			require.NoError(t, err)
+
+			cursor := q.CursorOf(tr)
+
+			assert.Equal(t, tt.wantKey, cursor.Key)
+			assert.Equal(t, "trip-id-1", cursor.ID)
+		})
+	}
+}
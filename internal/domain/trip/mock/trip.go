@@ -12,8 +12,11 @@ package mock_trip
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	pagination "github.com/hata0/travel-api/internal/domain/shared/pagination"
 	trip "github.com/hata0/travel-api/internal/domain/trip"
+	user "github.com/hata0/travel-api/internal/domain/user"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,20 +58,6 @@ func (mr *MockTripRepositoryMockRecorder) Create(ctx, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTripRepository)(nil).Create), ctx, arg1)
 }
 
-// Delete mocks base method.
-func (m *MockTripRepository) Delete(ctx context.Context, id trip.TripID) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Delete indicates an expected call of Delete.
-func (mr *MockTripRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTripRepository)(nil).Delete), ctx, id)
-}
-
 // FindByID mocks base method.
 func (m *MockTripRepository) FindByID(ctx context.Context, id trip.TripID) (*trip.Trip, error) {
 	m.ctrl.T.Helper()
@@ -84,19 +73,91 @@ func (mr *MockTripRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTripRepository)(nil).FindByID), ctx, id)
 }
 
-// FindMany mocks base method.
-func (m *MockTripRepository) FindMany(ctx context.Context) ([]*trip.Trip, error) {
+// FindByMember mocks base method.
+func (m *MockTripRepository) FindByMember(ctx context.Context, userID user.UserID, query trip.ListQuery) (*pagination.Page[*trip.Trip], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMember", ctx, userID, query)
+	ret0, _ := ret[0].(*pagination.Page[*trip.Trip])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMember indicates an expected call of FindByMember.
+func (mr *MockTripRepositoryMockRecorder) FindByMember(ctx, userID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMember", reflect.TypeOf((*MockTripRepository)(nil).FindByMember), ctx, userID, query)
+}
+
+// FindTrashedBefore mocks base method.
+func (m *MockTripRepository) FindTrashedBefore(ctx context.Context, before time.Time) ([]trip.TripID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTrashedBefore", ctx, before)
+	ret0, _ := ret[0].([]trip.TripID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTrashedBefore indicates an expected call of FindTrashedBefore.
+func (mr *MockTripRepositoryMockRecorder) FindTrashedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrashedBefore", reflect.TypeOf((*MockTripRepository)(nil).FindTrashedBefore), ctx, before)
+}
+
+// FindTrashedByOwner mocks base method.
+func (m *MockTripRepository) FindTrashedByOwner(ctx context.Context, userID user.UserID) ([]*trip.TrashedTrip, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindMany", ctx)
-	ret0, _ := ret[0].([]*trip.Trip)
+	ret := m.ctrl.Call(m, "FindTrashedByOwner", ctx, userID)
+	ret0, _ := ret[0].([]*trip.TrashedTrip)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindMany indicates an expected call of FindMany.
-func (mr *MockTripRepositoryMockRecorder) FindMany(ctx any) *gomock.Call {
+// FindTrashedByOwner indicates an expected call of FindTrashedByOwner.
+func (mr *MockTripRepositoryMockRecorder) FindTrashedByOwner(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrashedByOwner", reflect.TypeOf((*MockTripRepository)(nil).FindTrashedByOwner), ctx, userID)
+}
+
+// Purge mocks base method.
+func (m *MockTripRepository) Purge(ctx context.Context, id trip.TripID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockTripRepositoryMockRecorder) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockTripRepository)(nil).Purge), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockTripRepository) Restore(ctx context.Context, id trip.TripID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTripRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTripRepository)(nil).Restore), ctx, id)
+}
+
+// Trash mocks base method.
+func (m *MockTripRepository) Trash(ctx context.Context, id trip.TripID, version int64, trashedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trash", ctx, id, version, trashedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Trash indicates an expected call of Trash.
+func (mr *MockTripRepositoryMockRecorder) Trash(ctx, id, version, trashedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMany", reflect.TypeOf((*MockTripRepository)(nil).FindMany), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trash", reflect.TypeOf((*MockTripRepository)(nil).Trash), ctx, id, version, trashedAt)
 }
 
 // Update mocks base method.
@@ -0,0 +1,89 @@
+package trip
+
+import "time"
+
+// Period は旅行期間（開始日から終了日まで、両端を含む）を表現する値オブジェクト
+type Period struct {
+	startDate time.Time
+	endDate   time.Time
+}
+
+// NewPeriod は旅行期間を作成する。日付は時刻を切り捨てたUTCの日付として扱う
+func NewPeriod(startDate, endDate time.Time) (*Period, error) {
+	start := TruncateToDate(startDate)
+	end := TruncateToDate(endDate)
+
+	if end.Before(start) {
+		return nil, NewInvalidPeriodError()
+	}
+
+	return &Period{
+		startDate: start,
+		endDate:   end,
+	}, nil
+}
+
+// NewOptionalPeriod は未定を許容して旅行期間を作成する。
+// 開始日・終了日がどちらも nil の場合は期間未定として nil を返し、片方のみ指定された場合はエラーとする
+func NewOptionalPeriod(startDate, endDate *time.Time) (*Period, error) {
+	if startDate == nil && endDate == nil {
+		return nil, nil
+	}
+	if startDate == nil || endDate == nil {
+		return nil, NewIncompletePeriodError()
+	}
+	return NewPeriod(*startDate, *endDate)
+}
+
+// Getters
+func (p *Period) StartDate() time.Time { return p.startDate }
+func (p *Period) EndDate() time.Time   { return p.endDate }
+
+// Contains は指定された日付が期間内に含まれるかを判定する
+func (p *Period) Contains(date time.Time) bool {
+	d := TruncateToDate(date)
+	return !d.Before(p.startDate) && !d.After(p.endDate)
+}
+
+// Nights は期間中の宿泊日（開始日から終了日の前日まで）を返す
+func (p *Period) Nights() []time.Time {
+	var nights []time.Time
+	for d := p.startDate; d.Before(p.endDate); d = d.AddDate(0, 0, 1) {
+		nights = append(nights, d)
+	}
+	return nights
+}
+
+// Shift は期間を days 日ずらした期間を返す。days が負の場合は前にずらす
+func (p *Period) Shift(days int) *Period {
+	return &Period{
+		startDate: p.startDate.AddDate(0, 0, days),
+		endDate:   p.endDate.AddDate(0, 0, days),
+	}
+}
+
+// Days は期間の日数（開始日と終了日を含む）を返す
+func (p *Period) Days() int {
+	return len(p.Nights()) + 1
+}
+
+// DayNumber は開始日を 1 日目として、指定された日付が何日目かを返す。期間外の場合は 0 を返す
+func (p *Period) DayNumber(date time.Time) int {
+	if !p.Contains(date) {
+		return 0
+	}
+	return int(TruncateToDate(date).Sub(p.startDate).Hours()/24) + 1
+}
+
+func (p *Period) Equals(other *Period) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	return p.startDate.Equal(other.startDate) && p.endDate.Equal(other.endDate)
+}
+
+// TruncateToDate は時刻をUTCの日付（0時0分）に切り捨てる
+func TruncateToDate(t time.Time) time.Time {
+	u := t.UTC()
+	return time.Date(u.Year(), u.Month(), u.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,145 @@
+package trip
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPeriod(t *testing.T) {
+	t.Run("正常系: 時刻は切り捨てられUTCの日付になる", func(t *testing.T) {
+		jst := time.FixedZone("JST", 9*60*60)
+		start := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+		end := time.Date(2024, 5, 3, 8, 0, 0, 0, jst) // UTCでは 5/2 23:00
+
+		period, err := NewPeriod(start, end)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), period.StartDate())
+		assert.Equal(t, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), period.EndDate())
+	})
+
+	t.Run("正常系: 開始日と終了日が同じ日帰り旅行", func(t *testing.T) {
+		day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+		period, err := NewPeriod(day, day)
+
+		require.NoError(t, err)
+		assert.Empty(t, period.Nights())
+	})
+
+	t.Run("異常系: 終了日が開始日より前", func(t *testing.T) {
+		period, err := NewPeriod(time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC))
+
+		assert.Nil(t, period)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestNewOptionalPeriod(t *testing.T) {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 両方省略すると期間未定になる", func(t *testing.T) {
+		period, err := NewOptionalPeriod(nil, nil)
+
+		require.NoError(t, err)
+		assert.Nil(t, period)
+	})
+
+	t.Run("正常系: 両方指定すると期間が作成される", func(t *testing.T) {
+		period, err := NewOptionalPeriod(&start, &end)
+
+		require.NoError(t, err)
+		assert.Equal(t, start, period.StartDate())
+		assert.Equal(t, end, period.EndDate())
+	})
+
+	t.Run("異常系: 片方のみ指定", func(t *testing.T) {
+		_, err := NewOptionalPeriod(&start, nil)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+
+		_, err = NewOptionalPeriod(nil, &end)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestPeriod_Contains(t *testing.T) {
+	period, err := NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		date time.Time
+		want bool
+	}{
+		{name: "開始日より前", date: time.Date(2024, 4, 30, 23, 59, 0, 0, time.UTC), want: false},
+		{name: "開始日", date: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), want: true},
+		{name: "期間中", date: time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC), want: true},
+		{name: "終了日の夜", date: time.Date(2024, 5, 3, 23, 0, 0, 0, time.UTC), want: true},
+		{name: "終了日より後", date: time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, period.Contains(tt.date))
+		})
+	}
+}
+
+func TestPeriod_Nights(t *testing.T) {
+	period, err := NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC),
+	}, period.Nights(), "終了日の夜は宿泊日に含まれないこと")
+}
+
+func TestPeriod_Shift(t *testing.T) {
+	period, err := NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	shifted := period.Shift(30)
+	assert.Equal(t, time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC), shifted.StartDate(), "月をまたいでずらせるべき")
+	assert.Equal(t, time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC), shifted.EndDate())
+
+	back := period.Shift(-1)
+	assert.Equal(t, time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC), back.StartDate(), "負の日数では前にずれるべき")
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), period.StartDate(), "元の期間は変更されないべき")
+}
+
+func TestPeriod_Days(t *testing.T) {
+	oneDay, err := NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	fourDays, err := NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, oneDay.Days(), "日帰りは 1 日")
+	assert.Equal(t, 4, fourDays.Days(), "開始日と終了日を含めて数えるべき")
+}
+
+func TestPeriod_DayNumber(t *testing.T) {
+	period, err := NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, period.DayNumber(time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC)), "開始日は 1 日目")
+	assert.Equal(t, 4, period.DayNumber(time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC)), "終了日も数えるべき")
+	assert.Equal(t, 0, period.DayNumber(time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)), "期間外は 0")
+	assert.Equal(t, 0, period.DayNumber(time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC)), "期間外は 0")
+}
+
+func TestPeriod_Equals(t *testing.T) {
+	p1, _ := NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+	p2, _ := NewPeriod(time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 9, 0, 0, 0, time.UTC))
+	p3, _ := NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC))
+
+	assert.True(t, p1.Equals(p2), "同じ日付の期間は等しいと判定されるべき")
+	assert.False(t, p1.Equals(p3), "異なる日付の期間は等しくないと判定されるべき")
+	assert.False(t, p1.Equals(nil), "期間は nil と等しいと判定されるべきではない")
+}
@@ -1,12 +1,32 @@
 package trip
 
-import "context"
+import (
+	"context"
+	"time"
 
+	"github.com/hata0/travel-api/internal/domain/shared/pagination"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// ゴミ箱に移された旅行は FindByID、FindByMember の対象にならず、見つからないものとして扱う
+//
 //go:generate mockgen -destination mock/trip.go github.com/hata0/travel-api/internal/domain/trip TripRepository
 type TripRepository interface {
 	FindByID(ctx context.Context, id TripID) (*Trip, error)
-	FindMany(ctx context.Context) ([]*Trip, error)
+	// FindByMember は指定されたユーザーがメンバーとして参加している旅行のうち、取得条件に一致するものを 1 ページ分取得する
+	FindByMember(ctx context.Context, userID user.UserID, query ListQuery) (*pagination.Page[*Trip], error)
 	Create(ctx context.Context, trip *Trip) error
+	// Update は保存済みのバージョンが trip.Version() と一致する場合だけ更新し、バージョンを 1 つ進める。
+	// 一致しない場合はバージョン不一致のエラーを返す
 	Update(ctx context.Context, trip *Trip) error
-	Delete(ctx context.Context, id TripID) error
+	// Trash は保存済みのバージョンが version と一致する場合だけ旅行をゴミ箱に移し、バージョンを 1 つ進める
+	Trash(ctx context.Context, id TripID, version int64, trashedAt time.Time) error
+	// FindTrashedByOwner は指定されたユーザーが所有者であるゴミ箱の旅行を、ゴミ箱に移した日時の新しい順に取得する
+	FindTrashedByOwner(ctx context.Context, userID user.UserID) ([]*TrashedTrip, error)
+	// Restore はゴミ箱にある旅行を元に戻し、バージョンを 1 つ進める。ゴミ箱にない場合は TripNotFound を返す
+	Restore(ctx context.Context, id TripID) error
+	// Purge はゴミ箱にある旅行を関連するリソースとともに完全に削除する。ゴミ箱にない場合は TripNotFound を返す
+	Purge(ctx context.Context, id TripID) error
+	// FindTrashedBefore は before より前にゴミ箱に移された旅行の ID を、ゴミ箱に移した日時の古い順に取得する
+	FindTrashedBefore(ctx context.Context, before time.Time) ([]TripID, error)
 }
@@ -0,0 +1,30 @@
+package trip
+
+import "time"
+
+// TrashedTrip はゴミ箱に移された旅行。保持期間を過ぎると関連するリソースとともに完全に削除される
+type TrashedTrip struct {
+	trip      *Trip
+	trashedAt time.Time
+}
+
+func NewTrashedTrip(trip *Trip, trashedAt time.Time) *TrashedTrip {
+	return &TrashedTrip{
+		trip:      trip,
+		trashedAt: trashedAt,
+	}
+}
+
+func (t *TrashedTrip) Trip() *Trip          { return t.trip }
+func (t *TrashedTrip) TrashedAt() time.Time { return t.trashedAt }
+
+// PurgeAt は保持期間が retentionDays 日のとき、旅行が完全に削除される日時を返す
+func (t *TrashedTrip) PurgeAt(retentionDays int) time.Time {
+	return t.trashedAt.AddDate(0, 0, retentionDays)
+}
+
+// TrashRetentionCutoff は保持期間が retentionDays 日のとき、now の時点で完全に削除してよいゴミ箱の旅行の境界を返す。
+// この日時より前にゴミ箱に移された旅行が削除の対象になる
+func TrashRetentionCutoff(now time.Time, retentionDays int) time.Time {
+	return now.AddDate(0, 0, -retentionDays)
+}
@@ -0,0 +1,25 @@
+package trip
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrashedTrip_PurgeAt(t *testing.T) {
+	trashedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	trashed := NewTrashedTrip(NewTrip(NewTripID("trip-id"), "Trip", nil, "", trashedAt, trashedAt), trashedAt)
+
+	assert.Equal(t, time.Date(2024, 5, 31, 12, 0, 0, 0, time.UTC), trashed.PurgeAt(30))
+}
+
+func TestTrashRetentionCutoff(t *testing.T) {
+	now := time.Date(2024, 5, 31, 12, 0, 0, 0, time.UTC)
+
+	cutoff := TrashRetentionCutoff(now, 30)
+
+	assert.Equal(t, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), cutoff)
+	trashed := NewTrashedTrip(NewTrip(NewTripID("trip-id"), "Trip", nil, "", now, now), cutoff)
+	assert.False(t, trashed.PurgeAt(30).After(now), "境界の日時にゴミ箱に移した旅行は保持期間を過ぎている")
+}
@@ -1,39 +1,81 @@
 package trip
 
-import "time"
+import (
+	"time"
 
-// Trip は旅行を表現するエンティティ
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+)
+
+// InitialVersion は作成直後の旅行のバージョン
+const InitialVersion int64 = 1
+
+// Trip は旅行を表現するエンティティ。
+// homeCurrency は予算の集計などで金額を揃える旅行の基準通貨（ISO 4217 の通貨コード）で、未設定の場合は空文字。
+// version は楽観的排他制御に使用し、保存済みの旅行を更新するたびにリポジトリが 1 ずつ進める
 type Trip struct {
-	id        TripID
-	name      string
-	createdAt time.Time
-	updatedAt time.Time
+	id           TripID
+	name         string
+	period       *Period
+	homeCurrency string
+	version      int64
+	createdAt    time.Time
+	updatedAt    time.Time
 }
 
-// NewTrip は新しい旅行を作成する
-func NewTrip(id TripID, name string, createdAt, updatedAt time.Time) *Trip {
+// NewTrip は新しい旅行を作成する。期間が未定の場合 period は nil、基準通貨が未定の場合 homeCurrency は空文字
+func NewTrip(id TripID, name string, period *Period, homeCurrency string, createdAt, updatedAt time.Time) *Trip {
+	return RestoreTrip(id, name, period, homeCurrency, InitialVersion, createdAt, updatedAt)
+}
+
+// RestoreTrip は保存済みの旅行をバージョンを含めて復元する
+func RestoreTrip(id TripID, name string, period *Period, homeCurrency string, version int64, createdAt, updatedAt time.Time) *Trip {
 	return &Trip{
-		id:        id,
-		name:      name,
-		createdAt: createdAt,
-		updatedAt: updatedAt,
+		id:           id,
+		name:         name,
+		period:       period,
+		homeCurrency: homeCurrency,
+		version:      version,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
 	}
 }
 
 // Getters
 func (t *Trip) ID() TripID           { return t.id }
 func (t *Trip) Name() string         { return t.name }
+func (t *Trip) Period() *Period      { return t.period }
+func (t *Trip) HomeCurrency() string { return t.homeCurrency }
+func (t *Trip) Version() int64       { return t.version }
 func (t *Trip) CreatedAt() time.Time { return t.createdAt }
 func (t *Trip) UpdatedAt() time.Time { return t.updatedAt }
 
-// Update は旅行情報を更新する
-func (t *Trip) Update(name string, updatedAt time.Time) *Trip {
+// Update は旅行情報を更新する。バージョンは読み込んだ時点のものを引き継ぎ、保存時の競合検出に使用する
+func (t *Trip) Update(name string, period *Period, homeCurrency string, updatedAt time.Time) *Trip {
 	return &Trip{
-		id:        t.id,
-		name:      name,
-		createdAt: t.createdAt,
-		updatedAt: updatedAt,
+		id:           t.id,
+		name:         name,
+		period:       period,
+		homeCurrency: homeCurrency,
+		version:      t.version,
+		createdAt:    t.createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+// ValidateHomeCurrency は基準通貨が未設定（空文字）か ISO 4217 形式の通貨コードであることを確認する
+func ValidateHomeCurrency(homeCurrency string) error {
+	if homeCurrency != "" && !money.IsValidCurrencyCode(homeCurrency) {
+		return money.NewInvalidCurrencyError()
+	}
+	return nil
+}
+
+// CheckVersion は旅行のバージョンが更新・削除の前提とした expected と一致するかを確認する
+func (t *Trip) CheckVersion(expected int64) error {
+	if t.version != expected {
+		return NewTripVersionMismatchError()
 	}
+	return nil
 }
 
 func (t *Trip) Equals(other *Trip) bool {
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -13,11 +14,17 @@ func TestNewTrip(t *testing.T) {
 	createdAt := time.Now().Add(-24 * time.Hour)
 	updatedAt := time.Now()
 
-	trip := NewTrip(id, name, createdAt, updatedAt)
+	period, err := NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+
+	trip := NewTrip(id, name, period, "JPY", createdAt, updatedAt)
 
 	assert.NotNil(t, trip, "NewTrip は nil を返すべきではない")
 	assert.Equal(t, id, trip.id, "NewTrip は正しい ID を設定するべき")
 	assert.Equal(t, name, trip.name, "NewTrip は正しい name を設定するべき")
+	assert.Equal(t, period, trip.period, "NewTrip は正しい period を設定するべき")
+	assert.Equal(t, "JPY", trip.homeCurrency, "NewTrip は正しい homeCurrency を設定するべき")
+	assert.Equal(t, InitialVersion, trip.version, "NewTrip は初期バージョンを設定するべき")
 	assert.Equal(t, createdAt, trip.createdAt, "NewTrip は正しい createdAt を設定するべき")
 	assert.Equal(t, updatedAt, trip.updatedAt, "NewTrip は正しい updatedAt を設定するべき")
 }
@@ -28,10 +35,12 @@ func TestTrip_Getters(t *testing.T) {
 	createdAt := time.Now().Add(-48 * time.Hour)
 	updatedAt := time.Now().Add(-24 * time.Hour)
 
-	trip := NewTrip(id, name, createdAt, updatedAt)
+	trip := NewTrip(id, name, nil, "", createdAt, updatedAt)
 
 	assert.Equal(t, id, trip.ID(), "ID() は正しい ID を返すべき")
 	assert.Equal(t, name, trip.Name(), "Name() は正しい name を返すべき")
+	assert.Nil(t, trip.Period(), "Period() は期間未定の場合 nil を返すべき")
+	assert.Empty(t, trip.HomeCurrency(), "HomeCurrency() は基準通貨が未定の場合空文字を返すべき")
 	assert.Equal(t, createdAt, trip.CreatedAt(), "CreatedAt() は正しい createdAt を返すべき")
 	assert.Equal(t, updatedAt, trip.UpdatedAt(), "UpdatedAt() は正しい updatedAt を返すべき")
 }
@@ -42,32 +51,62 @@ func TestTrip_Update(t *testing.T) {
 	originalCreatedAt := time.Now().Add(-72 * time.Hour)
 	originalUpdatedAt := time.Now().Add(-48 * time.Hour)
 
-	trip := NewTrip(id, originalName, originalCreatedAt, originalUpdatedAt)
+	trip := NewTrip(id, originalName, nil, "", originalCreatedAt, originalUpdatedAt)
 
 	newName := "Updated Trip Name"
+	newPeriod, err := NewPeriod(time.Date(2024, 8, 10, 0, 0, 0, 0, time.UTC), time.Date(2024, 8, 15, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
 	newUpdatedAt := time.Now()
 
-	updatedTrip := trip.Update(newName, newUpdatedAt)
+	updatedTrip := trip.Update(newName, newPeriod, "EUR", newUpdatedAt)
 
 	assert.NotNil(t, updatedTrip, "Update は新しい Trip インスタンスを返すべき")
 	assert.Equal(t, id, updatedTrip.ID(), "Update は元の ID を保持すべき")
 	assert.Equal(t, newName, updatedTrip.Name(), "Update は新しい name を設定すべき")
+	assert.Equal(t, newPeriod, updatedTrip.Period(), "Update は新しい period を設定すべき")
+	assert.Equal(t, "EUR", updatedTrip.HomeCurrency(), "Update は新しい homeCurrency を設定すべき")
 	assert.Equal(t, originalCreatedAt, updatedTrip.CreatedAt(), "Update は元の createdAt を保持すべき")
 	assert.Equal(t, newUpdatedAt, updatedTrip.UpdatedAt(), "Update は新しい updatedAt を設定すべき")
+	assert.Equal(t, trip.Version(), updatedTrip.Version(), "Update は読み込んだ時点のバージョンを保持すべき")
 
 	// 元の trip が変更されていないことを確認
 	assert.Equal(t, originalName, trip.Name(), "元の Trip の name は変更されてはいけない")
+	assert.Nil(t, trip.Period(), "元の Trip の period は変更されてはいけない")
+	assert.Empty(t, trip.HomeCurrency(), "元の Trip の homeCurrency は変更されてはいけない")
 	assert.Equal(t, originalUpdatedAt, trip.UpdatedAt(), "元の Trip の updatedAt は変更されてはいけない")
 }
 
+func TestRestoreTrip(t *testing.T) {
+	now := time.Now()
+
+	trip := RestoreTrip(NewTripID("trip-id-6"), "Restored Trip", nil, "USD", 7, now, now)
+
+	assert.Equal(t, int64(7), trip.Version(), "RestoreTrip は保存済みのバージョンを復元するべき")
+	assert.Equal(t, "USD", trip.HomeCurrency(), "RestoreTrip は保存済みの基準通貨を復元するべき")
+}
+
+func TestValidateHomeCurrency(t *testing.T) {
+	assert.NoError(t, ValidateHomeCurrency("JPY"))
+	assert.NoError(t, ValidateHomeCurrency(""), "未設定の基準通貨は許可するべき")
+	assert.True(t, apperr.IsAppErrorWithCode(ValidateHomeCurrency("jpy"), apperr.CodeValidationError), "ISO 4217 形式でない通貨コードは検証エラーになるべき")
+}
+
+func TestTrip_CheckVersion(t *testing.T) {
+	now := time.Now()
+	trip := RestoreTrip(NewTripID("trip-id-7"), "Trip", nil, "", 3, now, now)
+
+	assert.NoError(t, trip.CheckVersion(3))
+	assert.True(t, apperr.IsAppErrorWithCode(trip.CheckVersion(2), apperr.CodePreconditionFailed), "バージョンが異なる場合は前提条件エラーになるべき")
+}
+
 func TestTrip_Equals(t *testing.T) {
 	id1 := NewTripID("trip-id-4")
 	id2 := NewTripID("trip-id-5")
 	now := time.Now()
 
-	trip1 := NewTrip(id1, "Trip A", now, now)
-	trip2 := NewTrip(id1, "Trip A", now, now) // trip1 と同じ ID
-	trip3 := NewTrip(id2, "Trip B", now, now) // trip1 と異なる ID
+	trip1 := NewTrip(id1, "Trip A", nil, "", now, now)
+	trip2 := NewTrip(id1, "Trip A", nil, "", now, now) // trip1 と同じ ID
+	trip3 := NewTrip(id2, "Trip B", nil, "", now, now) // trip1 と異なる ID
 
 	assert.True(t, trip1.Equals(trip2), "同じ ID を持つ 2 つの Trip は等しいと判定されるべき")
 	assert.False(t, trip1.Equals(trip3), "異なる ID を持つ 2 つの Trip は等しくないと判定されるべき")
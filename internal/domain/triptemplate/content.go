@@ -0,0 +1,385 @@
+package triptemplate
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// Content は雛形に含める旅行の内容を表現する値オブジェクト。
+// 日時は基準日（作成元の旅行の開始日、期間未定の場合は最初のチェックイン日か行動の日付）の 0 時からの経過時間として持ち、
+// 適用時に新しい旅行の開始日を基準に戻す
+type Content struct {
+	days           *int
+	accommodations []AccommodationItem
+	budget         *BudgetItem
+	checklists     []ChecklistItem
+	activities     []ActivityItem
+}
+
+// NewContent は雛形の内容を作成する。旅行期間が未定の場合 days は nil、予算がない場合 budget は nil
+func NewContent(days *int, accommodations []AccommodationItem, budget *BudgetItem, checklists []ChecklistItem, activities []ActivityItem) Content {
+	return Content{
+		days:           days,
+		accommodations: accommodations,
+		budget:         budget,
+		checklists:     checklists,
+		activities:     activities,
+	}
+}
+
+// NewContentFromTrip は旅行と宿泊予約・予算・チェックリスト・行動から雛形の内容を取り込む。
+// 予約番号やチェック状態、担当者は元の旅行に固有のものであるため取り込まない
+func NewContentFromTrip(
+	t *trip.Trip,
+	accommodations []*accommodation.Accommodation,
+	b *budget.Budget,
+	checklists []*checklist.Checklist,
+	activities []*itinerary.Activity,
+) Content {
+	var days *int
+	var anchor time.Time
+	if period := t.Period(); period != nil {
+		d := period.Days()
+		days = &d
+		anchor = period.StartDate()
+	} else {
+		for _, a := range accommodations {
+			checkIn := trip.TruncateToDate(a.Stay().CheckInAt())
+			if anchor.IsZero() || checkIn.Before(anchor) {
+				anchor = checkIn
+			}
+		}
+		for _, a := range activities {
+			if anchor.IsZero() || a.Date().Before(anchor) {
+				anchor = a.Date()
+			}
+		}
+	}
+
+	items := make([]AccommodationItem, 0, len(accommodations))
+	for _, a := range accommodations {
+		items = append(items, NewAccommodationItem(
+			a.Name(),
+			a.Address(),
+			a.Timezone(),
+			a.Stay().CheckInAt().Sub(anchor),
+			a.Stay().CheckOutAt().Sub(anchor),
+			a.Cost(),
+			a.Notes(),
+		))
+	}
+
+	var budgetItem *BudgetItem
+	if b != nil {
+		limits := make(map[expense.Category]int64)
+		for category, limit := range b.CategoryLimits() {
+			limits[category] = limit.Amount()
+		}
+		item := NewBudgetItem(b.Currency(), limits)
+		budgetItem = &item
+	}
+
+	checklistItems := make([]ChecklistItem, 0, len(checklists))
+	for _, c := range checklists {
+		entries := make([]ChecklistEntry, 0, len(c.Items()))
+		for _, item := range c.Items() {
+			entries = append(entries, NewChecklistEntry(item.Name(), item.Quantity()))
+		}
+		checklistItems = append(checklistItems, NewChecklistItem(c.Name(), c.Kind(), entries))
+	}
+
+	activityItems := make([]ActivityItem, 0, len(activities))
+	for _, a := range activities {
+		activityItems = append(activityItems, NewActivityItem(
+			int(a.Date().Sub(anchor)/(24*time.Hour)),
+			a.Position(),
+			a.Title(),
+			a.Place(),
+			a.Timezone(),
+			offsetFrom(anchor, a.StartAt()),
+			offsetFrom(anchor, a.EndAt()),
+			a.TravelMode(),
+			a.Notes(),
+		))
+	}
+
+	return NewContent(days, items, budgetItem, checklistItems, activityItems)
+}
+
+// offsetFrom は基準日 anchor の 0 時から t までの経過時間を返す。t が未定の場合は nil を返す
+func offsetFrom(anchor time.Time, t *time.Time) *time.Duration {
+	if t == nil {
+		return nil
+	}
+	offset := t.Sub(anchor)
+	return &offset
+}
+
+// Getters
+func (c Content) Days() *int                          { return c.days }
+func (c Content) Accommodations() []AccommodationItem { return c.accommodations }
+func (c Content) Budget() *BudgetItem                 { return c.budget }
+func (c Content) Checklists() []ChecklistItem         { return c.checklists }
+func (c Content) Activities() []ActivityItem          { return c.activities }
+
+// RequiresStartDate は雛形を適用する際に旅行の開始日が必要かを判定する
+func (c Content) RequiresStartDate() bool {
+	return c.days != nil || len(c.accommodations) > 0 || len(c.activities) > 0
+}
+
+// NewPeriod は雛形から作成する旅行の期間を決める。
+// 終了日が省略された場合は雛形の日数から求め、日付を伴う内容がある雛形では開始日を必須とする
+func (c Content) NewPeriod(startDate, endDate *time.Time) (*trip.Period, error) {
+	if startDate == nil {
+		if endDate == nil && c.RequiresStartDate() {
+			return nil, NewStartDateRequiredError()
+		}
+		return trip.NewOptionalPeriod(startDate, endDate)
+	}
+	if endDate == nil && c.days != nil {
+		end := trip.TruncateToDate(*startDate).AddDate(0, 0, *c.days-1)
+		endDate = &end
+	}
+	return trip.NewOptionalPeriod(startDate, endDate)
+}
+
+// NewAccommodations は雛形の宿泊予約を startDate を基準日として旅行 tripID に作成する。IDは newID で払い出す
+func (c Content) NewAccommodations(
+	tripID trip.TripID,
+	startDate time.Time,
+	newID func() accommodation.AccommodationID,
+	createdAt time.Time,
+) ([]*accommodation.Accommodation, error) {
+	anchor := trip.TruncateToDate(startDate)
+	accommodations := make([]*accommodation.Accommodation, 0, len(c.accommodations))
+	for _, item := range c.accommodations {
+		stay, err := accommodation.NewStay(anchor.Add(item.checkInOffset), anchor.Add(item.checkOutOffset))
+		if err != nil {
+			return nil, err
+		}
+		accommodations = append(accommodations, accommodation.NewAccommodation(
+			newID(), tripID, item.name, item.address, item.timezone, stay, "", item.cost, item.notes, createdAt, createdAt,
+		))
+	}
+	return accommodations, nil
+}
+
+// NewBudget は雛形の予算を旅行 tripID に作成する。雛形に予算がない場合は nil を返す
+func (c Content) NewBudget(tripID trip.TripID, createdAt time.Time) (*budget.Budget, error) {
+	if c.budget == nil {
+		return nil, nil
+	}
+	return budget.NewBudget(tripID, c.budget.currency, c.budget.CategoryLimits(), createdAt, createdAt)
+}
+
+// NewChecklists は雛形のチェックリストを未チェック・担当者なしの状態で旅行 tripID に作成する。IDは newID と newItemID で払い出す
+func (c Content) NewChecklists(
+	tripID trip.TripID,
+	newID func() checklist.ChecklistID,
+	newItemID func() checklist.ItemID,
+	createdAt time.Time,
+) ([]*checklist.Checklist, error) {
+	checklists := make([]*checklist.Checklist, 0, len(c.checklists))
+	for _, item := range c.checklists {
+		items := make([]checklist.Item, 0, len(item.entries))
+		for _, entry := range item.entries {
+			i, err := checklist.NewItem(newItemID(), entry.name, entry.quantity, false, nil)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, i)
+		}
+		cl, err := checklist.NewChecklist(newID(), tripID, item.name, item.kind, items, createdAt, createdAt)
+		if err != nil {
+			return nil, err
+		}
+		checklists = append(checklists, cl)
+	}
+	return checklists, nil
+}
+
+// NewActivities は雛形の行動を startDate を基準日として旅行 tripID に作成する。IDは newID で払い出す
+func (c Content) NewActivities(
+	tripID trip.TripID,
+	startDate time.Time,
+	newID func() itinerary.ActivityID,
+	createdAt time.Time,
+) ([]*itinerary.Activity, error) {
+	anchor := trip.TruncateToDate(startDate)
+	activities := make([]*itinerary.Activity, 0, len(c.activities))
+	for _, item := range c.activities {
+		a, err := itinerary.NewActivity(
+			newID(),
+			tripID,
+			anchor.AddDate(0, 0, item.day),
+			item.position,
+			item.title,
+			item.place,
+			item.timezone,
+			offsetTo(anchor, item.startOffset),
+			offsetTo(anchor, item.endOffset),
+			item.travelMode,
+			item.notes,
+			createdAt,
+			createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		activities = append(activities, a)
+	}
+	return activities, nil
+}
+
+// offsetTo は基準日 anchor の 0 時から offset 経過した日時を返す。offset が未定の場合は nil を返す
+func offsetTo(anchor time.Time, offset *time.Duration) *time.Time {
+	if offset == nil {
+		return nil
+	}
+	t := anchor.Add(*offset)
+	return &t
+}
+
+// AccommodationItem は雛形に含める宿泊予約。チェックイン・チェックアウトは基準日の 0 時からの経過時間で持つ
+type AccommodationItem struct {
+	name           string
+	address        string
+	timezone       *geo.Timezone
+	checkInOffset  time.Duration
+	checkOutOffset time.Duration
+	cost           money.Money
+	notes          string
+}
+
+func NewAccommodationItem(
+	name, address string,
+	timezone *geo.Timezone,
+	checkInOffset, checkOutOffset time.Duration,
+	cost money.Money,
+	notes string,
+) AccommodationItem {
+	return AccommodationItem{
+		name:           name,
+		address:        address,
+		timezone:       timezone,
+		checkInOffset:  checkInOffset,
+		checkOutOffset: checkOutOffset,
+		cost:           cost,
+		notes:          notes,
+	}
+}
+
+// Getters
+func (i AccommodationItem) Name() string                  { return i.name }
+func (i AccommodationItem) Address() string               { return i.address }
+func (i AccommodationItem) Timezone() *geo.Timezone       { return i.timezone }
+func (i AccommodationItem) CheckInOffset() time.Duration  { return i.checkInOffset }
+func (i AccommodationItem) CheckOutOffset() time.Duration { return i.checkOutOffset }
+func (i AccommodationItem) Cost() money.Money             { return i.cost }
+func (i AccommodationItem) Notes() string                 { return i.notes }
+
+// BudgetItem は雛形に含める予算。上限額は基準通貨の最小単位で持つ
+type BudgetItem struct {
+	currency       string
+	categoryLimits map[expense.Category]int64
+}
+
+func NewBudgetItem(currency string, categoryLimits map[expense.Category]int64) BudgetItem {
+	return BudgetItem{currency: currency, categoryLimits: categoryLimits}
+}
+
+// Getters
+func (i BudgetItem) Currency() string { return i.currency }
+
+// CategoryLimits はカテゴリごとの上限額のコピーを返す
+func (i BudgetItem) CategoryLimits() map[expense.Category]int64 {
+	limits := make(map[expense.Category]int64, len(i.categoryLimits))
+	for category, limit := range i.categoryLimits {
+		limits[category] = limit
+	}
+	return limits
+}
+
+// ChecklistItem は雛形に含めるチェックリスト
+type ChecklistItem struct {
+	name    string
+	kind    checklist.Kind
+	entries []ChecklistEntry
+}
+
+func NewChecklistItem(name string, kind checklist.Kind, entries []ChecklistEntry) ChecklistItem {
+	return ChecklistItem{name: name, kind: kind, entries: entries}
+}
+
+// Getters
+func (i ChecklistItem) Name() string              { return i.name }
+func (i ChecklistItem) Kind() checklist.Kind      { return i.kind }
+func (i ChecklistItem) Entries() []ChecklistEntry { return i.entries }
+
+// ChecklistEntry は雛形のチェックリストの項目。チェック状態と担当者は持たない
+type ChecklistEntry struct {
+	name     string
+	quantity int
+}
+
+func NewChecklistEntry(name string, quantity int) ChecklistEntry {
+	return ChecklistEntry{name: name, quantity: quantity}
+}
+
+// Getters
+func (e ChecklistEntry) Name() string  { return e.name }
+func (e ChecklistEntry) Quantity() int { return e.quantity }
+
+// ActivityItem は雛形に含める行動。日付は基準日を 0 日目とした日数、開始・終了日時は基準日の 0 時からの経過時間で持つ
+type ActivityItem struct {
+	day         int
+	position    int
+	title       string
+	place       *geo.Place
+	timezone    *geo.Timezone
+	startOffset *time.Duration
+	endOffset   *time.Duration
+	travelMode  itinerary.TravelMode
+	notes       string
+}
+
+func NewActivityItem(
+	day, position int,
+	title string,
+	place *geo.Place,
+	timezone *geo.Timezone,
+	startOffset, endOffset *time.Duration,
+	travelMode itinerary.TravelMode,
+	notes string,
+) ActivityItem {
+	return ActivityItem{
+		day:         day,
+		position:    position,
+		title:       title,
+		place:       place,
+		timezone:    timezone,
+		startOffset: startOffset,
+		endOffset:   endOffset,
+		travelMode:  travelMode,
+		notes:       notes,
+	}
+}
+
+// Getters
+func (i ActivityItem) Day() int                         { return i.day }
+func (i ActivityItem) Position() int                    { return i.position }
+func (i ActivityItem) Title() string                    { return i.title }
+func (i ActivityItem) Place() *geo.Place                { return i.place }
+func (i ActivityItem) Timezone() *geo.Timezone          { return i.timezone }
+func (i ActivityItem) StartOffset() *time.Duration      { return i.startOffset }
+func (i ActivityItem) EndOffset() *time.Duration        { return i.endOffset }
+func (i ActivityItem) TravelMode() itinerary.TravelMode { return i.travelMode }
+func (i ActivityItem) Notes() string                    { return i.notes }
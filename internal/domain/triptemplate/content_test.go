@@ -0,0 +1,299 @@
+package triptemplate
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(month time.Month, day, hour int) time.Time {
+	return time.Date(2024, month, day, hour, 0, 0, 0, time.UTC)
+}
+
+func newTestAccommodation(t *testing.T, id string, checkIn, checkOut time.Time) *accommodation.Accommodation {
+	t.Helper()
+	stay, err := accommodation.NewStay(checkIn, checkOut)
+	require.NoError(t, err)
+	cost, err := money.NewMoney(32000, "JPY")
+	require.NoError(t, err)
+	tz, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	return accommodation.NewAccommodation(accommodation.NewAccommodationID(id), trip.NewTripID("trip-id-1"), "Hotel "+id, "Kyoto", &tz, stay, "ABC123", cost, "朝食付き", checkIn, checkIn)
+}
+
+func newTestChecklist(t *testing.T) *checklist.Checklist {
+	t.Helper()
+	assignee := user.NewUserID("user-id-1")
+	passport, err := checklist.NewItem(checklist.NewItemID("item-1"), "パスポート", 1, true, &assignee)
+	require.NoError(t, err)
+	socks, err := checklist.NewItem(checklist.NewItemID("item-2"), "靴下", 3, false, nil)
+	require.NoError(t, err)
+	c, err := checklist.NewChecklist(checklist.NewChecklistID("checklist-id-1"), trip.NewTripID("trip-id-1"), "持ち物", checklist.KindPacking, []checklist.Item{passport, socks}, date(4, 1, 0), date(4, 1, 0))
+	require.NoError(t, err)
+	return c
+}
+
+func newTestActivity(t *testing.T, id string, day time.Time, startAt *time.Time) *itinerary.Activity {
+	t.Helper()
+	tokyo, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	place, err := geo.NewPlace("金閣寺", nil, &tokyo)
+	require.NoError(t, err)
+	a, err := itinerary.NewActivity(itinerary.NewActivityID(id), trip.NewTripID("trip-id-1"), day, 1, "金閣寺", &place, nil, startAt, nil, itinerary.TravelModeTransit, "拝観料 500 円", day, day)
+	require.NoError(t, err)
+	return a
+}
+
+func sequentialIDs() func() accommodation.AccommodationID {
+	n := 0
+	return func() accommodation.AccommodationID {
+		n++
+		return accommodation.NewAccommodationID(fmt.Sprintf("new-accommodation-%d", n))
+	}
+}
+
+func TestNewContentFromTrip(t *testing.T) {
+	now := date(1, 1, 0)
+
+	t.Run("正常系: 旅行の開始日を基準日とする", func(t *testing.T) {
+		period, err := trip.NewPeriod(date(5, 1, 0), date(5, 3, 0))
+		require.NoError(t, err)
+		tr := trip.NewTrip(trip.NewTripID("trip-id-1"), "京都旅行", period, "", now, now)
+		b, err := budget.NewBudget(tr.ID(), "JPY", map[expense.Category]int64{expense.CategoryFood: 30000}, now, now)
+		require.NoError(t, err)
+
+		content := NewContentFromTrip(tr, []*accommodation.Accommodation{
+			newTestAccommodation(t, "a1", date(5, 2, 15), date(5, 3, 10)),
+		}, b, []*checklist.Checklist{newTestChecklist(t)}, nil)
+
+		require.NotNil(t, content.Days())
+		assert.Equal(t, 3, *content.Days())
+		require.Len(t, content.Accommodations(), 1)
+		item := content.Accommodations()[0]
+		assert.Equal(t, "Hotel a1", item.Name())
+		assert.Equal(t, "Asia/Tokyo", item.Timezone().Name())
+		assert.Equal(t, 39*time.Hour, item.CheckInOffset(), "開始日の 0 時からの経過時間で持つべき")
+		assert.Equal(t, 58*time.Hour, item.CheckOutOffset())
+		require.NotNil(t, content.Budget())
+		assert.Equal(t, "JPY", content.Budget().Currency())
+		assert.Equal(t, map[expense.Category]int64{expense.CategoryFood: 30000}, content.Budget().CategoryLimits())
+		require.Len(t, content.Checklists(), 1)
+		assert.Equal(t, "持ち物", content.Checklists()[0].Name())
+		assert.Equal(t, checklist.KindPacking, content.Checklists()[0].Kind())
+		assert.Equal(t, []ChecklistEntry{NewChecklistEntry("パスポート", 1), NewChecklistEntry("靴下", 3)}, content.Checklists()[0].Entries(),
+			"チェック状態と担当者は取り込まないべき")
+	})
+
+	t.Run("正常系: 期間未定の旅行は最初のチェックイン日を基準日とする", func(t *testing.T) {
+		tr := trip.NewTrip(trip.NewTripID("trip-id-1"), "京都旅行", nil, "", now, now)
+
+		content := NewContentFromTrip(tr, []*accommodation.Accommodation{
+			newTestAccommodation(t, "a2", date(5, 3, 15), date(5, 4, 10)),
+			newTestAccommodation(t, "a1", date(5, 1, 15), date(5, 3, 10)),
+		}, nil, nil, nil)
+
+		assert.Nil(t, content.Days())
+		assert.Nil(t, content.Budget())
+		assert.Equal(t, 63*time.Hour, content.Accommodations()[0].CheckInOffset())
+		assert.Equal(t, 15*time.Hour, content.Accommodations()[1].CheckInOffset())
+	})
+
+	t.Run("正常系: 行動は基準日からの日数と経過時間で取り込む", func(t *testing.T) {
+		period, err := trip.NewPeriod(date(5, 1, 0), date(5, 3, 0))
+		require.NoError(t, err)
+		tr := trip.NewTrip(trip.NewTripID("trip-id-1"), "京都旅行", period, "", now, now)
+		startAt := date(5, 2, 10)
+
+		content := NewContentFromTrip(tr, nil, nil, nil, []*itinerary.Activity{
+			newTestActivity(t, "activity-1", date(5, 2, 0), &startAt),
+			newTestActivity(t, "activity-2", date(5, 3, 0), nil),
+		})
+
+		require.Len(t, content.Activities(), 2)
+		item := content.Activities()[0]
+		assert.Equal(t, 1, item.Day(), "開始日を 0 日目とした日数で持つべき")
+		assert.Equal(t, 1, item.Position())
+		assert.Equal(t, "金閣寺", item.Title())
+		assert.Equal(t, "Asia/Tokyo", item.Timezone().Name())
+		require.NotNil(t, item.StartOffset())
+		assert.Equal(t, 34*time.Hour, *item.StartOffset(), "開始日の 0 時からの経過時間で持つべき")
+		assert.Nil(t, item.EndOffset())
+		assert.Equal(t, itinerary.TravelModeTransit, item.TravelMode())
+		assert.Equal(t, "拝観料 500 円", item.Notes())
+		assert.Equal(t, 2, content.Activities()[1].Day())
+	})
+
+	t.Run("正常系: 期間未定で宿泊予約のない旅行は最初の行動の日付を基準日とする", func(t *testing.T) {
+		tr := trip.NewTrip(trip.NewTripID("trip-id-1"), "京都旅行", nil, "", now, now)
+
+		content := NewContentFromTrip(tr, nil, nil, nil, []*itinerary.Activity{
+			newTestActivity(t, "activity-2", date(5, 4, 0), nil),
+			newTestActivity(t, "activity-1", date(5, 2, 0), nil),
+		})
+
+		assert.Equal(t, 2, content.Activities()[0].Day())
+		assert.Equal(t, 0, content.Activities()[1].Day())
+	})
+}
+
+func TestContent_NewPeriod(t *testing.T) {
+	days := 3
+	start := date(8, 10, 0)
+	end := date(8, 11, 0)
+
+	tests := []struct {
+		name      string
+		content   Content
+		startDate *time.Time
+		endDate   *time.Time
+		want      *trip.Period
+		wantErr   bool
+	}{
+		{name: "終了日を省略すると雛形の日数から求める", content: NewContent(&days, nil, nil, nil, nil), startDate: &start, want: mustPeriod(t, start, date(8, 12, 0))},
+		{name: "終了日を指定するとそれを優先する", content: NewContent(&days, nil, nil, nil, nil), startDate: &start, endDate: &end, want: mustPeriod(t, start, end)},
+		{name: "日付を伴わない雛形は期間未定で作成できる", content: NewContent(nil, nil, nil, nil, nil), want: nil},
+		{name: "日数を持つ雛形は開始日が必須", content: NewContent(&days, nil, nil, nil, nil), wantErr: true},
+		{name: "宿泊予約を持つ雛形は開始日が必須", content: NewContent(nil, []AccommodationItem{{}}, nil, nil, nil), wantErr: true},
+		{name: "行動を持つ雛形は開始日が必須", content: NewContent(nil, nil, nil, nil, []ActivityItem{{}}), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period, err := tt.content.NewPeriod(tt.startDate, tt.endDate)
+
+			if tt.wantErr {
+				assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equals(period), "期待する期間と一致すべき: %v", period)
+		})
+	}
+}
+
+func mustPeriod(t *testing.T, start, end time.Time) *trip.Period {
+	t.Helper()
+	p, err := trip.NewPeriod(start, end)
+	require.NoError(t, err)
+	return p
+}
+
+func TestContent_NewAccommodations(t *testing.T) {
+	cost, err := money.NewMoney(32000, "JPY")
+	require.NoError(t, err)
+	tz, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	content := NewContent(nil, []AccommodationItem{
+		NewAccommodationItem("Hotel", "Kyoto", &tz, 39*time.Hour, 58*time.Hour, cost, "朝食付き"),
+	}, nil, nil, nil)
+	now := date(7, 1, 0)
+
+	accommodations, err := content.NewAccommodations(trip.NewTripID("new-trip-id"), date(8, 10, 9), sequentialIDs(), now)
+
+	require.NoError(t, err)
+	require.Len(t, accommodations, 1)
+	a := accommodations[0]
+	assert.Equal(t, accommodation.NewAccommodationID("new-accommodation-1"), a.ID())
+	assert.Equal(t, trip.NewTripID("new-trip-id"), a.TripID())
+	assert.Equal(t, date(8, 11, 15), a.Stay().CheckInAt(), "開始日の 0 時を基準日とすべき")
+	assert.Equal(t, date(8, 12, 10), a.Stay().CheckOutAt())
+	assert.Equal(t, &tz, a.Timezone())
+	assert.Empty(t, a.ConfirmationNumber())
+	assert.Equal(t, cost, a.Cost())
+	assert.Equal(t, now, a.CreatedAt())
+}
+
+func TestContent_NewActivities(t *testing.T) {
+	startOffset := 34 * time.Hour
+	content := NewContent(nil, nil, nil, nil, []ActivityItem{
+		NewActivityItem(1, 2, "金閣寺", nil, nil, &startOffset, nil, itinerary.TravelModeWalk, "拝観料 500 円"),
+	})
+	now := date(7, 1, 0)
+	n := 0
+
+	activities, err := content.NewActivities(trip.NewTripID("new-trip-id"), date(8, 10, 9), func() itinerary.ActivityID {
+		n++
+		return itinerary.NewActivityID(fmt.Sprintf("new-activity-%d", n))
+	}, now)
+
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	a := activities[0]
+	assert.Equal(t, itinerary.NewActivityID("new-activity-1"), a.ID())
+	assert.Equal(t, trip.NewTripID("new-trip-id"), a.TripID())
+	assert.Equal(t, date(8, 11, 0), a.Date(), "開始日を 0 日目とした日付にすべき")
+	assert.Equal(t, 2, a.Position())
+	require.NotNil(t, a.StartAt())
+	assert.Equal(t, date(8, 11, 10), *a.StartAt(), "開始日の 0 時を基準日とすべき")
+	assert.Nil(t, a.EndAt())
+	assert.Equal(t, "拝観料 500 円", a.Notes())
+	assert.Equal(t, now, a.CreatedAt())
+}
+
+func TestContent_NewBudget(t *testing.T) {
+	now := date(7, 1, 0)
+
+	t.Run("正常系: 予算あり", func(t *testing.T) {
+		item := NewBudgetItem("JPY", map[expense.Category]int64{expense.CategoryFood: 30000})
+
+		b, err := NewContent(nil, nil, &item, nil, nil).NewBudget(trip.NewTripID("new-trip-id"), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, trip.NewTripID("new-trip-id"), b.TripID())
+		assert.Equal(t, "JPY", b.Currency())
+		limit, ok := b.CategoryLimit(expense.CategoryFood)
+		assert.True(t, ok)
+		assert.Equal(t, int64(30000), limit.Amount())
+	})
+
+	t.Run("正常系: 予算なし", func(t *testing.T) {
+		b, err := NewContent(nil, nil, nil, nil, nil).NewBudget(trip.NewTripID("new-trip-id"), now)
+
+		require.NoError(t, err)
+		assert.Nil(t, b)
+	})
+}
+
+func TestContent_NewChecklists(t *testing.T) {
+	content := NewContent(nil, nil, nil, []ChecklistItem{
+		NewChecklistItem("持ち物", checklist.KindPacking, []ChecklistEntry{NewChecklistEntry("靴下", 3)}),
+	}, nil)
+	now := date(7, 1, 0)
+	checklistIDs := 0
+	itemIDs := 0
+
+	checklists, err := content.NewChecklists(
+		trip.NewTripID("new-trip-id"),
+		func() checklist.ChecklistID {
+			checklistIDs++
+			return checklist.NewChecklistID(fmt.Sprintf("new-checklist-%d", checklistIDs))
+		},
+		func() checklist.ItemID { itemIDs++; return checklist.NewItemID(fmt.Sprintf("new-item-%d", itemIDs)) },
+		now,
+	)
+
+	require.NoError(t, err)
+	require.Len(t, checklists, 1)
+	c := checklists[0]
+	assert.Equal(t, checklist.NewChecklistID("new-checklist-1"), c.ID())
+	assert.Equal(t, trip.NewTripID("new-trip-id"), c.TripID())
+	assert.Equal(t, checklist.KindPacking, c.Kind())
+	require.Len(t, c.Items(), 1)
+	assert.Equal(t, checklist.NewItemID("new-item-1"), c.Items()[0].ID())
+	assert.Equal(t, 3, c.Items()[0].Quantity())
+	assert.False(t, c.Items()[0].Checked())
+	assert.Equal(t, now, c.CreatedAt())
+}
@@ -0,0 +1,19 @@
+package triptemplate
+
+import apperr "github.com/hata0/travel-api/internal/domain/errors"
+
+const (
+	CodeTemplateNotFound = "TEMPLATE_NOT_FOUND"
+)
+
+func NewTemplateNotFoundError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewAppError(CodeTemplateNotFound, "Template not found", opts...)
+}
+
+func NewNotTemplateOwnerError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewForbiddenError("Only the owner can modify this template", opts...)
+}
+
+func NewStartDateRequiredError(opts ...apperr.AppErrorOption) *apperr.AppError {
+	return apperr.NewValidationError("Start date is required to create a trip from this template", opts...)
+}
@@ -0,0 +1,19 @@
+package triptemplate
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+//go:generate mockgen -destination mock/template.go github.com/hata0/travel-api/internal/domain/triptemplate TemplateRepository
+type TemplateRepository interface {
+	FindByID(ctx context.Context, id TemplateID) (*Template, error)
+	// FindByOwnerID はユーザーが所有する雛形を新しい順に取得する
+	FindByOwnerID(ctx context.Context, ownerID user.UserID) ([]*Template, error)
+	// FindPublic は公開されている雛形を新しい順に取得する
+	FindPublic(ctx context.Context) ([]*Template, error)
+	Create(ctx context.Context, template *Template) error
+	Update(ctx context.Context, template *Template) error
+	Delete(ctx context.Context, id TemplateID) error
+}
@@ -0,0 +1,109 @@
+package triptemplate
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// TemplateID は旅行テンプレートIDを表現する値オブジェクト
+type TemplateID struct {
+	value string
+}
+
+func NewTemplateID(id string) TemplateID {
+	return TemplateID{value: id}
+}
+
+func (id TemplateID) String() string {
+	return id.value
+}
+
+func (id TemplateID) Equals(other TemplateID) bool {
+	return id.value == other.value
+}
+
+// Template は既存の旅行から作成する再利用可能な旅行の雛形を表現するエンティティ。
+// 所有者だけが編集でき、公開された雛形は他のユーザーも閲覧・利用できる
+type Template struct {
+	id          TemplateID
+	ownerID     user.UserID
+	name        string
+	description string
+	public      bool
+	content     Content
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewTemplate は新しい旅行テンプレートを作成する
+func NewTemplate(
+	id TemplateID,
+	ownerID user.UserID,
+	name, description string,
+	public bool,
+	content Content,
+	createdAt, updatedAt time.Time,
+) *Template {
+	return &Template{
+		id:          id,
+		ownerID:     ownerID,
+		name:        name,
+		description: description,
+		public:      public,
+		content:     content,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// Getters
+func (t *Template) ID() TemplateID       { return t.id }
+func (t *Template) OwnerID() user.UserID { return t.ownerID }
+func (t *Template) Name() string         { return t.name }
+func (t *Template) Description() string  { return t.description }
+func (t *Template) IsPublic() bool       { return t.public }
+func (t *Template) Content() Content     { return t.content }
+func (t *Template) CreatedAt() time.Time { return t.createdAt }
+func (t *Template) UpdatedAt() time.Time { return t.updatedAt }
+
+// Update は雛形の名前・説明・公開設定を更新する。内容は作成元の旅行から取り込んだものを保持する
+func (t *Template) Update(name, description string, public bool, updatedAt time.Time) *Template {
+	return &Template{
+		id:          t.id,
+		ownerID:     t.ownerID,
+		name:        name,
+		description: description,
+		public:      public,
+		content:     t.content,
+		createdAt:   t.createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// AuthorizeView はユーザーが雛形を閲覧・利用できるかを確認する。
+// 公開されていない他人の雛形は存在を明かさないため NotFound とする
+func (t *Template) AuthorizeView(userID user.UserID) error {
+	if t.public || t.ownerID.Equals(userID) {
+		return nil
+	}
+	return NewTemplateNotFoundError()
+}
+
+// AuthorizeEdit はユーザーが雛形を編集・削除できるかを確認する
+func (t *Template) AuthorizeEdit(userID user.UserID) error {
+	if err := t.AuthorizeView(userID); err != nil {
+		return err
+	}
+	if !t.ownerID.Equals(userID) {
+		return NewNotTemplateOwnerError()
+	}
+	return nil
+}
+
+func (t *Template) Equals(other *Template) bool {
+	if other == nil {
+		return false
+	}
+	return t.id.Equals(other.id)
+}
@@ -0,0 +1,66 @@
+package triptemplate
+
+import (
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTemplate(public bool) *Template {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return NewTemplate(NewTemplateID("template-id-1"), user.NewUserID("owner-id"), "京都2泊", "定番の京都旅行", public, NewContent(nil, nil, nil, nil, nil), now, now)
+}
+
+func TestTemplate_Update(t *testing.T) {
+	tmpl := newTestTemplate(false)
+	updatedAt := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	updated := tmpl.Update("京都3泊", "延泊版", true, updatedAt)
+
+	assert.Equal(t, tmpl.ID(), updated.ID(), "Update は元の ID を保持すべき")
+	assert.Equal(t, tmpl.OwnerID(), updated.OwnerID(), "Update は元の所有者を保持すべき")
+	assert.Equal(t, "京都3泊", updated.Name())
+	assert.Equal(t, "延泊版", updated.Description())
+	assert.True(t, updated.IsPublic())
+	assert.Equal(t, tmpl.CreatedAt(), updated.CreatedAt(), "Update は元の createdAt を保持すべき")
+	assert.Equal(t, updatedAt, updated.UpdatedAt())
+	assert.Equal(t, "京都2泊", tmpl.Name(), "元の Template は変更されないべき")
+}
+
+func TestTemplate_Authorize(t *testing.T) {
+	owner := user.NewUserID("owner-id")
+	other := user.NewUserID("other-id")
+
+	tests := []struct {
+		name     string
+		public   bool
+		userID   user.UserID
+		viewCode string
+		editCode string
+	}{
+		{name: "所有者は非公開の雛形を閲覧・編集できる", public: false, userID: owner},
+		{name: "他人の非公開の雛形は存在しないものとして扱う", public: false, userID: other, viewCode: CodeTemplateNotFound, editCode: CodeTemplateNotFound},
+		{name: "他人の公開された雛形は閲覧できるが編集できない", public: true, userID: other, editCode: apperr.CodeForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := newTestTemplate(tt.public)
+
+			assertCode(t, tt.viewCode, tmpl.AuthorizeView(tt.userID))
+			assertCode(t, tt.editCode, tmpl.AuthorizeEdit(tt.userID))
+		})
+	}
+}
+
+func assertCode(t *testing.T, code string, err error) {
+	t.Helper()
+	if code == "" {
+		assert.NoError(t, err)
+		return
+	}
+	assert.True(t, apperr.IsAppErrorWithCode(err, code), "エラーコード %s を返すべき: %v", code, err)
+}
@@ -0,0 +1,94 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// LocalBlobStore はローカルのファイルシステムのディレクトリにデータを保存する BlobStore。
+// キーの "/" をディレクトリの区切りとして扱う
+type LocalBlobStore struct {
+	root string
+}
+
+func NewLocalBlobStore(root string) service.BlobStore {
+	return &LocalBlobStore{root: root}
+}
+
+// Put はデータを一時ファイルに書き込んでから置き換えるため、書き込み途中のファイルが読まれることはない
+func (s *LocalBlobStore) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(name), 0o750); err != nil {
+		return apperr.NewInternalError("Failed to create blob directory", apperr.WithCause(err))
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return apperr.NewInternalError("Failed to create temporary blob file", apperr.WithCause(err))
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, io.LimitReader(body, size))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return apperr.NewInternalError("Failed to write blob file", apperr.WithCause(err))
+	}
+	if written != size {
+		return apperr.NewInternalError("Blob body is shorter than the declared size")
+	}
+
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return apperr.NewInternalError("Failed to move blob file into place", apperr.WithCause(err))
+	}
+	return nil
+}
+
+func (s *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, service.ErrBlobNotFound
+		}
+		return nil, apperr.NewInternalError("Failed to open blob file", apperr.WithCause(err))
+	}
+	return f, nil
+}
+
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(name); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return apperr.NewInternalError("Failed to delete blob file", apperr.WithCause(err))
+	}
+	return nil
+}
+
+// path はキーをルートディレクトリの下のファイルパスに変換する。ルートディレクトリの外を指すキーは受け付けない
+func (s *LocalBlobStore) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") || path.Clean(key) != key || strings.HasPrefix(key, "../") || key == ".." {
+		return "", apperr.NewInternalError("Invalid blob key: " + key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,63 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hata0/travel-api/internal/usecase/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 保存したデータを読み込んで削除できる", func(t *testing.T) {
+		root := t.TempDir()
+		store := NewLocalBlobStore(root)
+		key := "trips/trip-id/attachments/attachment-id"
+
+		require.NoError(t, store.Put(ctx, key, strings.NewReader("%PDF-1.7"), 8, "application/pdf"))
+		assert.FileExists(t, filepath.Join(root, "trips", "trip-id", "attachments", "attachment-id"))
+
+		r, err := store.Get(ctx, key)
+		require.NoError(t, err)
+		body, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		assert.Equal(t, "%PDF-1.7", string(body))
+
+		require.NoError(t, store.Delete(ctx, key))
+		_, err = store.Get(ctx, key)
+		assert.ErrorIs(t, err, service.ErrBlobNotFound)
+	})
+
+	t.Run("正常系: 存在しないデータの削除は成功する", func(t *testing.T) {
+		store := NewLocalBlobStore(t.TempDir())
+		assert.NoError(t, store.Delete(ctx, "missing"))
+	})
+
+	t.Run("異常系: 申告されたサイズより短いデータは保存しない", func(t *testing.T) {
+		root := t.TempDir()
+		store := NewLocalBlobStore(root)
+
+		err := store.Put(ctx, "short", strings.NewReader("abc"), 10, "application/pdf")
+
+		assert.Error(t, err)
+		entries, err := os.ReadDir(root)
+		require.NoError(t, err)
+		assert.Empty(t, entries, "一時ファイルも残さない")
+	})
+
+	t.Run("異常系: ルートディレクトリの外を指すキー", func(t *testing.T) {
+		store := NewLocalBlobStore(t.TempDir())
+		for _, key := range []string{"../escape", "/etc/passwd", "a/../../b", `a\..\b`, ""} {
+			err := store.Put(ctx, key, strings.NewReader("x"), 1, "text/plain")
+			assert.Error(t, err, key)
+		}
+	})
+}
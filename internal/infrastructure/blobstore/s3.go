@@ -0,0 +1,173 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// maxErrorBodyBytes はエラーの原因として読み込むレスポンスボディの最大バイト数
+const maxErrorBodyBytes = 1024
+
+// S3Options は S3 互換ストレージへの接続設定
+type S3Options struct {
+	// Endpoint は "https://s3.ap-northeast-1.amazonaws.com" や "http://localhost:9000" のようなスキーム付きの URL
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle が true の場合はバケット名をパスに含める（Endpoint/Bucket/Key）。
+	// false の場合はバケット名をホスト名に含める（Bucket.Endpoint/Key）
+	UsePathStyle bool
+	// HTTPClient が nil の場合は http.DefaultClient を使う
+	HTTPClient *http.Client
+}
+
+// S3BlobStore は S3 の REST API を使ってデータを保存する BlobStore。
+// AWS の S3 のほか、MinIO などの S3 互換ストレージでも動作する
+type S3BlobStore struct {
+	endpoint     *url.URL
+	bucket       string
+	usePathStyle bool
+	credentials  credentials
+	client       *http.Client
+	now          func() time.Time
+}
+
+func NewS3BlobStore(opts S3Options) (service.BlobStore, error) {
+	endpoint, err := url.Parse(opts.Endpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q", opts.Endpoint)
+	}
+	if opts.Bucket == "" {
+		return nil, fmt.Errorf("S3 bucket is required")
+	}
+
+	client := opts.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &S3BlobStore{
+		endpoint:     endpoint,
+		bucket:       opts.Bucket,
+		usePathStyle: opts.UsePathStyle,
+		credentials: credentials{
+			accessKeyID:     opts.AccessKeyID,
+			secretAccessKey: opts.SecretAccessKey,
+			region:          opts.Region,
+		},
+		client: client,
+		now:    time.Now,
+	}, nil
+}
+
+// Put はボディのハッシュ値を計算せずにストリームで送る（UNSIGNED-PAYLOAD）
+func (s *S3BlobStore) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, io.LimitReader(body, size))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+	s.credentials.sign(req, unsignedPayload, s.now())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return apperr.NewInternalError("Failed to upload blob to S3", apperr.WithCause(err))
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return apperr.NewInternalError("Failed to upload blob to S3", apperr.WithCause(responseError(req, res)))
+	}
+	return nil
+}
+
+func (s *S3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.credentials.sign(req, emptyPayloadHash, s.now())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to download blob from S3", apperr.WithCause(err))
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return res.Body, nil
+	case http.StatusNotFound:
+		res.Body.Close()
+		return nil, service.ErrBlobNotFound
+	default:
+		defer res.Body.Close()
+		return nil, apperr.NewInternalError("Failed to download blob from S3", apperr.WithCause(responseError(req, res)))
+	}
+}
+
+// Delete は S3 がオブジェクトの有無に関わらず 204 を返すため、404 も成功として扱う
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	s.credentials.sign(req, emptyPayloadHash, s.now())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete blob from S3", apperr.WithCause(err))
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return apperr.NewInternalError("Failed to delete blob from S3", apperr.WithCause(responseError(req, res)))
+	}
+}
+
+// newRequest はオブジェクトのキーを署名の仕様どおりにエスケープした URL へのリクエストを作成する
+func (s *S3BlobStore) newRequest(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	if key == "" {
+		return nil, apperr.NewInternalError("Blob key must not be empty")
+	}
+
+	u := *s.endpoint
+	escapedKey := uriEncode(key, false)
+	basePath := strings.TrimSuffix(u.EscapedPath(), "/")
+	if s.usePathStyle {
+		u.RawPath = basePath + "/" + uriEncode(s.bucket, true) + "/" + escapedKey
+	} else {
+		u.Host = s.bucket + "." + u.Host
+		u.RawPath = basePath + "/" + escapedKey
+	}
+	decoded, err := url.PathUnescape(u.RawPath)
+	if err != nil {
+		return nil, apperr.NewInternalError("Invalid blob key: "+key, apperr.WithCause(err))
+	}
+	u.Path = decoded
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to create S3 request", apperr.WithCause(err))
+	}
+	return req, nil
+}
+
+// responseError は S3 のエラーレスポンスを原因として記録できるエラーに変換する
+func responseError(req *http.Request, res *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodyBytes))
+	return fmt.Errorf("s3: %s %s: status %d: %s", req.Method, req.URL.Path, res.StatusCode, strings.TrimSpace(string(body)))
+}
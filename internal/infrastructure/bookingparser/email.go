@@ -0,0 +1,139 @@
+package bookingparser
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"github.com/hata0/travel-api/internal/domain/bookingimport"
+)
+
+// maxMultipartDepth は multipart を入れ子でたどる深さの上限
+const maxMultipartDepth = 8
+
+// errNoBooking はメールに読み取れる予約が含まれていないことを表す
+var errNoBooking = errors.New("no booking found in email")
+
+// emailParts はメールの本文のうち、予約の読み取りに使うパート
+type emailParts struct {
+	html      [][]byte
+	calendars [][]byte
+}
+
+// parseEmail は RFC 822 形式の確認メールから予約を読み取る。
+// HTML 本文に埋め込まれた schema.org の JSON-LD を優先し、なければ添付の iCalendar を読み取る
+func parseEmail(r io.Reader) ([]bookingimport.Draft, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var parts emailParts
+	if err := collectParts(textproto.MIMEHeader(msg.Header), msg.Body, &parts, 0); err != nil {
+		return nil, err
+	}
+
+	var drafts []bookingimport.Draft
+	for _, body := range parts.html {
+		found, err := parseJSONLD(body)
+		if err != nil {
+			return nil, err
+		}
+		drafts = append(drafts, found...)
+	}
+	if len(drafts) > 0 {
+		return drafts, nil
+	}
+
+	for _, body := range parts.calendars {
+		found, err := parseCalendar(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		drafts = append(drafts, found...)
+	}
+	if len(drafts) == 0 {
+		return nil, errNoBooking
+	}
+	return drafts, nil
+}
+
+// collectParts は MIME の構造をたどり、HTML 本文と iCalendar のパートを集める。
+// UTF-8 以外の文字コードのテキストは読み取れないため無視する
+func collectParts(header textproto.MIMEHeader, body io.Reader, parts *emailParts, depth int) error {
+	contentType := header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "text/plain"
+	}
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		if depth >= maxMultipartDepth || params["boundary"] == "" {
+			return nil
+		}
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := mr.NextRawPart()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := collectParts(part.Header, part, parts, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch mediaType {
+	case "text/html", "text/calendar", "application/ics":
+	default:
+		return nil
+	}
+	if !isUTF8Compatible(params["charset"]) {
+		return nil
+	}
+
+	decoded, err := io.ReadAll(decodeTransferEncoding(header.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return err
+	}
+	if mediaType == "text/html" {
+		parts.html = append(parts.html, decoded)
+	} else {
+		parts.calendars = append(parts.calendars, decoded)
+	}
+	return nil
+}
+
+// decodeTransferEncoding は Content-Transfer-Encoding に応じて本文を復号する
+func decodeTransferEncoding(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+func isUTF8Compatible(charset string) bool {
+	switch strings.ToLower(charset) {
+	case "", "utf-8", "utf8", "us-ascii":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,272 @@
+package bookingparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hata0/travel-api/internal/domain/bookingimport"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"golang.org/x/net/html"
+)
+
+// jsonLDContentType は HTML に埋め込まれた JSON-LD の script 要素の type
+const jsonLDContentType = "application/ld+json"
+
+// node は JSON-LD のオブジェクト
+type node map[string]any
+
+// parseJSONLD は HTML 本文に埋め込まれた schema.org の予約（FlightReservation、LodgingReservation など）を読み取る。
+// 取り消し済みの予約と予約以外のオブジェクトは無視する
+func parseJSONLD(body []byte) ([]bookingimport.Draft, error) {
+	scripts := extractJSONLDScripts(body)
+
+	var (
+		drafts []bookingimport.Draft
+		seen   = map[string]int{}
+	)
+	for _, script := range scripts {
+		dec := json.NewDecoder(strings.NewReader(script))
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			return nil, err
+		}
+
+		for _, n := range flattenNodes(v) {
+			params, ok, err := reservationParams(n)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			params.Key = uniqueKey(params.Key, seen)
+			drafts = append(drafts, bookingimport.NewDraft(params))
+		}
+	}
+	return drafts, nil
+}
+
+// extractJSONLDScripts は HTML から JSON-LD の script 要素の中身を取り出す
+func extractJSONLDScripts(body []byte) []string {
+	var (
+		scripts  []string
+		inScript bool
+	)
+	z := html.NewTokenizer(bytes.NewReader(body))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return scripts
+		case html.StartTagToken:
+			token := z.Token()
+			if token.Data != "script" {
+				continue
+			}
+			for _, attr := range token.Attr {
+				if attr.Key == "type" && strings.EqualFold(strings.TrimSpace(attr.Val), jsonLDContentType) {
+					inScript = true
+				}
+			}
+		case html.TextToken:
+			if inScript {
+				scripts = append(scripts, string(z.Text()))
+			}
+		case html.EndTagToken:
+			inScript = false
+		}
+	}
+}
+
+// flattenNodes は配列や @graph に入れ子になったオブジェクトを平らにして返す
+func flattenNodes(v any) []node {
+	switch value := v.(type) {
+	case []any:
+		var nodes []node
+		for _, item := range value {
+			nodes = append(nodes, flattenNodes(item)...)
+		}
+		return nodes
+	case map[string]any:
+		if graph, ok := value["@graph"]; ok {
+			return flattenNodes(graph)
+		}
+		return []node{value}
+	default:
+		return nil
+	}
+}
+
+// reservationParams は予約のオブジェクトを下書きの内容に変換する。予約でない場合は ok を false とする
+func reservationParams(n node) (bookingimport.DraftParams, bool, error) {
+	if strings.HasSuffix(n.str("reservationStatus"), "ReservationCancelled") {
+		return bookingimport.DraftParams{}, false, nil
+	}
+
+	var (
+		params = bookingimport.DraftParams{
+			Key:                n.str("reservationNumber"),
+			ConfirmationNumber: n.str("reservationNumber"),
+			Price:              n.price(),
+		}
+		target = n.child("reservationFor")
+		err    error
+	)
+	switch {
+	case n.hasType("FlightReservation"):
+		params.Kind = bookingimport.KindTransport
+		departure, arrival := target.child("departureAirport"), target.child("arrivalAirport")
+		params.Transport = &bookingimport.Transport{
+			Mode:      transport.ModeFlight,
+			Carrier:   firstNonEmpty(target.child("airline").str("iataCode"), target.child("airline").str("name")),
+			Number:    target.str("flightNumber"),
+			Departure: firstNonEmpty(departure.str("iataCode"), departure.str("name")),
+			Arrival:   firstNonEmpty(arrival.str("iataCode"), arrival.str("name")),
+		}
+		params.Title = strings.TrimSpace(fmt.Sprintf("%s%s %s - %s",
+			params.Transport.Carrier, params.Transport.Number, params.Transport.Departure, params.Transport.Arrival))
+		params.Location = departure.str("name")
+		if params.StartAt, err = parseDateTime(target.str("departureTime")); err != nil {
+			return bookingimport.DraftParams{}, false, err
+		}
+		if params.EndAt, err = parseDateTime(target.str("arrivalTime")); err != nil {
+			return bookingimport.DraftParams{}, false, err
+		}
+	case n.hasType("LodgingReservation"):
+		params.Kind = bookingimport.KindLodging
+		params.Title = target.str("name")
+		params.Location = target.address()
+		if params.StartAt, err = parseDateTime(firstNonEmpty(n.str("checkinTime"), n.str("checkinDate"))); err != nil {
+			return bookingimport.DraftParams{}, false, err
+		}
+		if params.EndAt, err = parseDateTime(firstNonEmpty(n.str("checkoutTime"), n.str("checkoutDate"))); err != nil {
+			return bookingimport.DraftParams{}, false, err
+		}
+	case n.hasType("EventReservation"):
+		params.Kind = bookingimport.KindActivity
+		params.Title = target.str("name")
+		params.Location = firstNonEmpty(target.child("location").str("name"), target.child("location").address())
+		if params.StartAt, err = parseDateTime(target.str("startDate")); err != nil {
+			return bookingimport.DraftParams{}, false, err
+		}
+		if params.EndAt, err = parseDateTime(target.str("endDate")); err != nil {
+			return bookingimport.DraftParams{}, false, err
+		}
+	case n.hasType("FoodEstablishmentReservation"), n.hasType("Reservation"):
+		params.Kind = bookingimport.KindActivity
+		params.Title = target.str("name")
+		params.Location = target.address()
+		if params.StartAt, err = parseDateTime(n.str("startTime")); err != nil {
+			return bookingimport.DraftParams{}, false, err
+		}
+		if params.EndAt, err = parseDateTime(n.str("endTime")); err != nil {
+			return bookingimport.DraftParams{}, false, err
+		}
+	default:
+		return bookingimport.DraftParams{}, false, nil
+	}
+	return params, true, nil
+}
+
+// uniqueKey は同じファイルの中で重複しない下書きのキーを返す。
+// 搭乗者ごとに同じ予約番号の FlightReservation が並ぶことがあるため、2 件目以降には連番を付ける
+func uniqueKey(key string, seen map[string]int) string {
+	if key == "" {
+		key = "reservation"
+	}
+	seen[key]++
+	if seen[key] == 1 && key != "reservation" {
+		return key
+	}
+	return fmt.Sprintf("%s-%d", key, seen[key])
+}
+
+// hasType は @type が name（または schema.org の URL 表記）を含むかを判定する。@type は文字列と配列のどちらもありうる
+func (n node) hasType(name string) bool {
+	var types []any
+	switch value := n["@type"].(type) {
+	case string:
+		types = []any{value}
+	case []any:
+		types = value
+	}
+	for _, t := range types {
+		if s, ok := t.(string); ok && (s == name || strings.HasSuffix(s, "/"+name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// str は文字列または数値の項目を文字列として返す。存在しない場合は空文字とする
+func (n node) str(key string) string {
+	switch value := n[key].(type) {
+	case string:
+		return strings.TrimSpace(value)
+	case json.Number:
+		return value.String()
+	default:
+		return ""
+	}
+}
+
+// child は入れ子のオブジェクトを返す。配列の場合は先頭を使い、存在しない場合は空のオブジェクトとする
+func (n node) child(key string) node {
+	switch value := n[key].(type) {
+	case map[string]any:
+		return value
+	case []any:
+		if len(value) > 0 {
+			if m, ok := value[0].(map[string]any); ok {
+				return m
+			}
+		}
+	}
+	return node{}
+}
+
+// address は address の項目を 1 行の住所として返す。文字列と PostalAddress のどちらもありうる
+func (n node) address() string {
+	if s := n.str("address"); s != "" {
+		return s
+	}
+	a := n.child("address")
+	var fields []string
+	for _, key := range []string{"streetAddress", "addressLocality", "addressRegion", "postalCode", "addressCountry"} {
+		if s := a.str(key); s != "" {
+			fields = append(fields, s)
+		} else if s := a.child(key).str("name"); s != "" {
+			fields = append(fields, s)
+		}
+	}
+	return strings.Join(fields, ", ")
+}
+
+// price は totalPrice（なければ price）と priceCurrency から金額を返す。
+// totalPrice が PriceSpecification の場合はその中の price と priceCurrency を使い、読み取れない場合は nil とする
+func (n node) price() *money.Money {
+	amount, currency := firstNonEmpty(n.str("totalPrice"), n.str("price")), n.str("priceCurrency")
+	if spec := n.child("totalPrice"); amount == "" && len(spec) > 0 {
+		amount, currency = spec.str("price"), firstNonEmpty(spec.str("priceCurrency"), currency)
+	}
+	if amount == "" || currency == "" {
+		return nil
+	}
+	m, err := money.ParseMoney(strings.ReplaceAll(amount, ",", ""), strings.ToUpper(currency))
+	if err != nil {
+		return nil
+	}
+	return &m
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,140 @@
+package bookingparser
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/bookingimport"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/infrastructure/icalendar"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// flightNumberPattern は予定のタイトルの先頭にある便名（NH 123、JL5 など）
+var flightNumberPattern = regexp.MustCompile(`^([A-Z0-9]{2})\s?(\d{1,4})\b`)
+
+// airportRoutePattern は予定のタイトル中の "HND-ITM" のような、出発空港と到着空港の IATA コードの組
+var airportRoutePattern = regexp.MustCompile(`\b([A-Z]{3})\s*(?:->|→|-)\s*([A-Z]{3})\b`)
+
+// lodgingKeywords は予定のタイトルから宿泊の予約と判断する語
+var lodgingKeywords = []string{"hotel", "inn", "hostel", "ryokan", "lodging", "check-in", "stay", "ホテル", "旅館", "宿"}
+
+// transportKeywords は予定のタイトルから移動区間の予約と判断する語
+var transportKeywords = []string{"flight", "便"}
+
+// Parser は予約サイトや航空会社が送る確認ファイル（.ics / .eml）の解析を行う
+type Parser struct{}
+
+func NewParser() service.BookingParser {
+	return &Parser{}
+}
+
+// Parse はファイル形式に応じて予約の下書きを解析する。
+// 解析できない内容はバリデーションエラーとして返す
+func (p *Parser) Parse(r io.Reader, format string) ([]bookingimport.Draft, error) {
+	var (
+		drafts []bookingimport.Draft
+		err    error
+	)
+	switch format {
+	case service.BookingFileFormatICS:
+		drafts, err = parseCalendar(r)
+	case service.BookingFileFormatEML:
+		drafts, err = parseEmail(r)
+	default:
+		return nil, apperr.NewValidationError(fmt.Sprintf("Unsupported booking file format: %s", format))
+	}
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewValidationError("Failed to parse booking file", apperr.WithCause(err))
+	}
+	return drafts, nil
+}
+
+// parseCalendar は iCalendar の各 VEVENT をタイトルから種類を推定して下書きにする。
+// iCalendar には予約番号や金額の決まった項目がないため、それらは読み取らない
+func parseCalendar(r io.Reader) ([]bookingimport.Draft, error) {
+	events, err := icalendar.Decode(r)
+	if err != nil {
+		return nil, err
+	}
+
+	drafts := make([]bookingimport.Draft, 0, len(events))
+	for i, e := range events {
+		key := e.UID
+		if key == "" {
+			key = fmt.Sprintf("event-%d", i+1)
+		}
+		start, end := e.Start, e.End
+
+		params := bookingimport.DraftParams{
+			Kind:     classify(e.Summary),
+			Key:      key,
+			Title:    e.Summary,
+			Location: e.Location,
+			StartAt:  &start,
+			EndAt:    &end,
+			Notes:    e.Description,
+		}
+		if params.Kind == bookingimport.KindTransport {
+			params.Transport = transportFromSummary(e.Summary)
+		}
+		drafts = append(drafts, bookingimport.NewDraft(params))
+	}
+	return drafts, nil
+}
+
+// classify は予定のタイトルから予約の種類を推定する。どれにも当てはまらない場合は行動とする
+func classify(summary string) bookingimport.Kind {
+	lower := strings.ToLower(summary)
+	if flightNumberPattern.MatchString(summary) || containsAny(lower, transportKeywords) {
+		return bookingimport.KindTransport
+	}
+	if containsAny(lower, lodgingKeywords) {
+		return bookingimport.KindLodging
+	}
+	return bookingimport.KindActivity
+}
+
+// transportFromSummary は予定のタイトルの先頭にある便名を航空会社と便番号に、"HND-ITM" のような空港の組を出発地と到着地に分けて返す。
+// 移動区間と判断する語はいずれも航空便を表すため、交通手段は飛行機とする
+func transportFromSummary(summary string) *bookingimport.Transport {
+	info := &bookingimport.Transport{Mode: transport.ModeFlight}
+	if matches := flightNumberPattern.FindStringSubmatch(summary); matches != nil {
+		info.Carrier, info.Number = matches[1], matches[2]
+	}
+	if matches := airportRoutePattern.FindStringSubmatch(summary); matches != nil {
+		info.Departure, info.Arrival = matches[1], matches[2]
+	}
+	return info
+}
+
+func containsAny(s string, words []string) bool {
+	for _, w := range words {
+		if strings.Contains(s, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDateTime は JSON-LD の日時を解析する。オフセットのない現地時刻は UTC として扱う
+func parseDateTime(s string) (*time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, errors.New("invalid date time: " + s)
+}
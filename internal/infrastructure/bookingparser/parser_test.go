@@ -0,0 +1,200 @@
+package bookingparser
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/bookingimport"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/usecase/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleICS = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Example//Booking//EN
+BEGIN:VEVENT
+UID:stay-1@example.com
+SUMMARY:Hotel Granvia Kyoto
+LOCATION:京都市下京区
+DTSTART:20260307T060000Z
+DTEND:20260309T010000Z
+END:VEVENT
+BEGIN:VEVENT
+UID:flight-1@example.com
+SUMMARY:NH 21 HND-ITM
+DTSTART:20260307T000000Z
+DTEND:20260307T011000Z
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Tea ceremony
+DTSTART;VALUE=DATE:20260308
+END:VEVENT
+END:VCALENDAR
+`
+
+const sampleJSONLD = `<html><head>
+<script type="application/ld+json">
+{
+  "@context": "http://schema.org",
+  "@graph": [
+    {
+      "@type": "LodgingReservation",
+      "reservationNumber": "ABC123",
+      "reservationStatus": "http://schema.org/ReservationConfirmed",
+      "reservationFor": {
+        "@type": "LodgingBusiness",
+        "name": "Hotel Granvia Kyoto",
+        "address": {"@type": "PostalAddress", "streetAddress": "Karasuma-dori", "addressLocality": "Kyoto", "addressCountry": "JP"}
+      },
+      "checkinTime": "2026-03-07T15:00:00+09:00",
+      "checkoutTime": "2026-03-09T10:00:00+09:00",
+      "totalPrice": 45000,
+      "priceCurrency": "JPY"
+    },
+    {
+      "@type": "FlightReservation",
+      "reservationNumber": "XYZ789",
+      "reservationFor": {
+        "@type": "Flight",
+        "flightNumber": "21",
+        "airline": {"@type": "Airline", "iataCode": "NH"},
+        "departureAirport": {"@type": "Airport", "name": "Tokyo Haneda", "iataCode": "HND"},
+        "arrivalAirport": {"@type": "Airport", "iataCode": "ITM"},
+        "departureTime": "2026-03-07T09:00:00+09:00",
+        "arrivalTime": "2026-03-07T10:10:00+09:00"
+      }
+    },
+    {
+      "@type": "FlightReservation",
+      "reservationNumber": "XYZ789",
+      "reservationFor": {"@type": "Flight", "flightNumber": "21", "airline": {"iataCode": "NH"}}
+    },
+    {
+      "@type": "LodgingReservation",
+      "reservationNumber": "OLD1",
+      "reservationStatus": "http://schema.org/ReservationCancelled",
+      "reservationFor": {"name": "Cancelled Inn"}
+    },
+    {"@type": "Organization", "name": "Example Travel"}
+  ]
+}
+</script>
+</head><body><p>ご予約ありがとうございます</p></body></html>`
+
+func crlf(s string) string {
+	return strings.ReplaceAll(s, "\n", "\r\n")
+}
+
+func TestParser_Parse(t *testing.T) {
+	parser := NewParser()
+
+	t.Run("正常系: iCalendar の予定をタイトルから分類する", func(t *testing.T) {
+		drafts, err := parser.Parse(strings.NewReader(crlf(sampleICS)), service.BookingFileFormatICS)
+
+		require.NoError(t, err)
+		require.Len(t, drafts, 3)
+
+		assert.Equal(t, bookingimport.KindLodging, drafts[0].Kind())
+		assert.Equal(t, "stay-1@example.com", drafts[0].Key())
+		assert.Equal(t, "京都市下京区", drafts[0].Location())
+		assert.Equal(t, time.Date(2026, 3, 7, 6, 0, 0, 0, time.UTC), *drafts[0].StartAt())
+		assert.Nil(t, drafts[0].Price(), "iCalendar からは金額を読み取らないべき")
+
+		assert.Equal(t, bookingimport.KindTransport, drafts[1].Kind())
+		require.NotNil(t, drafts[1].Transport())
+		assert.Equal(t, "NH", drafts[1].Transport().Carrier)
+		assert.Equal(t, "21", drafts[1].Transport().Number)
+		assert.Equal(t, transport.ModeFlight, drafts[1].Transport().Mode)
+		assert.Equal(t, "HND", drafts[1].Transport().Departure)
+		assert.Equal(t, "ITM", drafts[1].Transport().Arrival)
+
+		assert.Equal(t, bookingimport.KindActivity, drafts[2].Kind())
+		assert.Equal(t, "event-3", drafts[2].Key(), "UID がない場合は出現順からキーを作るべき")
+	})
+
+	t.Run("正常系: メールの HTML に埋め込まれた JSON-LD を読み取る", func(t *testing.T) {
+		eml := "From: booking@example.com\nSubject: =?UTF-8?B?5LqI57SE56K66KqN?=\nMIME-Version: 1.0\n" +
+			"Content-Type: multipart/alternative; boundary=\"b1\"\n\n" +
+			"--b1\nContent-Type: text/plain; charset=utf-8\n\nplain body\n" +
+			"--b1\nContent-Type: text/html; charset=utf-8\nContent-Transfer-Encoding: base64\n\n" +
+			wrapBase64(sampleJSONLD) + "\n" +
+			"--b1--\n"
+
+		drafts, err := parser.Parse(strings.NewReader(crlf(eml)), service.BookingFileFormatEML)
+
+		require.NoError(t, err)
+		require.Len(t, drafts, 3, "取り消し済みの予約と予約以外のオブジェクトは無視されるべき")
+
+		lodging := drafts[0]
+		assert.Equal(t, bookingimport.KindLodging, lodging.Kind())
+		assert.Equal(t, "ABC123", lodging.Key())
+		assert.Equal(t, "ABC123", lodging.ConfirmationNumber())
+		assert.Equal(t, "Hotel Granvia Kyoto", lodging.Title())
+		assert.Equal(t, "Karasuma-dori, Kyoto, JP", lodging.Location())
+		assert.True(t, time.Date(2026, 3, 7, 6, 0, 0, 0, time.UTC).Equal(*lodging.StartAt()))
+		require.NotNil(t, lodging.Price())
+		assert.Equal(t, int64(45000), lodging.Price().Amount())
+		assert.Equal(t, "JPY", lodging.Price().Currency())
+
+		flight := drafts[1]
+		assert.Equal(t, bookingimport.KindTransport, flight.Kind())
+		assert.Equal(t, "NH21 HND - ITM", flight.Title())
+		assert.Equal(t, &bookingimport.Transport{Mode: transport.ModeFlight, Carrier: "NH", Number: "21", Departure: "HND", Arrival: "ITM"}, flight.Transport())
+		assert.Equal(t, "XYZ789-2", drafts[2].Key(), "同じ予約番号の 2 件目には連番を付けるべき")
+	})
+
+	t.Run("正常系: JSON-LD がないメールは添付の iCalendar を読み取る", func(t *testing.T) {
+		eml := "From: booking@example.com\nMIME-Version: 1.0\n" +
+			"Content-Type: multipart/mixed; boundary=\"outer\"\n\n" +
+			"--outer\nContent-Type: multipart/alternative; boundary=\"inner\"\n\n" +
+			"--inner\nContent-Type: text/html; charset=utf-8\nContent-Transfer-Encoding: quoted-printable\n\n" +
+			"<p>=E3=81=94=E4=BA=88=E7=B4=84</p>\n" +
+			"--inner--\n" +
+			"--outer\nContent-Type: text/calendar; charset=utf-8; method=PUBLISH\n\n" +
+			sampleICS +
+			"--outer--\n"
+
+		drafts, err := parser.Parse(strings.NewReader(crlf(eml)), service.BookingFileFormatEML)
+
+		require.NoError(t, err)
+		require.Len(t, drafts, 3)
+		assert.Equal(t, "stay-1@example.com", drafts[0].Key())
+	})
+
+	t.Run("異常系: 予約を含まないメールはバリデーションエラー", func(t *testing.T) {
+		eml := "From: someone@example.com\nContent-Type: text/plain\n\nhello\n"
+
+		_, err := parser.Parse(strings.NewReader(crlf(eml)), service.BookingFileFormatEML)
+
+		assert.ErrorIs(t, err, apperr.NewValidationError(""))
+	})
+
+	t.Run("異常系: iCalendar でないファイルはバリデーションエラー", func(t *testing.T) {
+		_, err := parser.Parse(strings.NewReader("not a calendar"), service.BookingFileFormatICS)
+
+		assert.ErrorIs(t, err, apperr.NewValidationError(""))
+	})
+
+	t.Run("異常系: 未対応の形式はバリデーションエラー", func(t *testing.T) {
+		_, err := parser.Parse(strings.NewReader(sampleICS), "pdf")
+
+		assert.ErrorIs(t, err, apperr.NewValidationError(""))
+	})
+}
+
+// wrapBase64 はメール本文と同じく 76 文字ごとに改行した base64 を返す
+func wrapBase64(s string) string {
+	encoded := base64.StdEncoding.EncodeToString([]byte(s))
+	var lines []string
+	for len(encoded) > 76 {
+		lines = append(lines, encoded[:76])
+		encoded = encoded[76:]
+	}
+	lines = append(lines, encoded)
+	return strings.Join(lines, "\n")
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"log/slog"
+	"math"
 	"os"
 	"strconv"
 	"strings"
@@ -17,6 +18,10 @@ type Config interface {
 	JWT() JWTConfig
 	Server() ServerConfig
 	Log() LogConfig
+	Admin() AdminConfig
+	Trash() TrashConfig
+	Attachment() AttachmentConfig
+	Itinerary() ItineraryConfig
 	Environment() string
 	Version() string
 	IsProduction() bool
@@ -30,6 +35,10 @@ type appConfig struct {
 	jwt         JWTConfig
 	server      ServerConfig
 	log         LogConfig
+	admin       AdminConfig
+	trash       TrashConfig
+	attachment  AttachmentConfig
+	itinerary   ItineraryConfig
 	environment string
 	version     string
 }
@@ -68,6 +77,55 @@ type LogConfig interface {
 	AddSource() bool
 }
 
+// AdminConfig は管理用エンドポイントの設定
+type AdminConfig interface {
+	// APIKey は管理用エンドポイントの認証に使うキー。空の場合、管理用エンドポイントは無効になる
+	APIKey() string
+}
+
+// TrashConfig はゴミ箱の設定
+type TrashConfig interface {
+	// RetentionDays はゴミ箱に移した旅行を完全に削除するまで保持する日数
+	RetentionDays() int
+}
+
+// AttachmentConfig は添付ファイルの設定
+type AttachmentConfig interface {
+	// MaxSizeBytes はアップロードできる添付ファイル・写真1件あたりの最大サイズ（バイト）
+	MaxSizeBytes() int
+	// Storage は添付ファイルの保存先（local または s3）
+	Storage() string
+	// LocalDir は Storage が local の場合に添付ファイルを保存するディレクトリ
+	LocalDir() string
+	// S3 は Storage が s3 の場合に使う S3 互換ストレージの設定
+	S3() S3Config
+}
+
+// ItineraryConfig は旅程の設定
+type ItineraryConfig interface {
+	// WalkSpeedKmh は移動時間の見積もりに使う徒歩の平均の速さ（km/h）
+	WalkSpeedKmh() float64
+	// BicycleSpeedKmh は移動時間の見積もりに使う自転車の平均の速さ（km/h）
+	BicycleSpeedKmh() float64
+	// CarSpeedKmh は移動時間の見積もりに使う車の平均の速さ（km/h）
+	CarSpeedKmh() float64
+	// TransitSpeedKmh は移動時間の見積もりに使う公共交通機関の平均の速さ（km/h）
+	TransitSpeedKmh() float64
+	// OptimizeTimeBudget は 1 日の行動を巡る順の並べ替えに使える時間
+	OptimizeTimeBudget() time.Duration
+}
+
+// S3Config は S3 互換ストレージの設定
+type S3Config interface {
+	Endpoint() string
+	Region() string
+	Bucket() string
+	AccessKeyID() string
+	SecretAccessKey() string
+	// UsePathStyle はバケット名をホスト名ではなくパスに含めるかどうか。MinIO などでは true にする
+	UsePathStyle() bool
+}
+
 // 具体的な実装
 type databaseConfig struct {
 	url             string
@@ -120,15 +178,73 @@ func (l logConfig) Level() slog.Level { return l.level }
 func (l logConfig) Format() string    { return l.format }
 func (l logConfig) AddSource() bool   { return l.addSource }
 
+type adminConfig struct {
+	apiKey string
+}
+
+func (a adminConfig) APIKey() string { return a.apiKey }
+
+type trashConfig struct {
+	retentionDays int
+}
+
+func (t trashConfig) RetentionDays() int { return t.retentionDays }
+
+type attachmentConfig struct {
+	maxSizeBytes int
+	storage      string
+	localDir     string
+	s3           s3Config
+}
+
+func (a attachmentConfig) MaxSizeBytes() int { return a.maxSizeBytes }
+func (a attachmentConfig) Storage() string   { return a.storage }
+func (a attachmentConfig) LocalDir() string  { return a.localDir }
+func (a attachmentConfig) S3() S3Config      { return a.s3 }
+
+type itineraryConfig struct {
+	walkSpeedKmh       float64
+	bicycleSpeedKmh    float64
+	carSpeedKmh        float64
+	transitSpeedKmh    float64
+	optimizeTimeBudget time.Duration
+}
+
+func (i itineraryConfig) WalkSpeedKmh() float64             { return i.walkSpeedKmh }
+func (i itineraryConfig) BicycleSpeedKmh() float64          { return i.bicycleSpeedKmh }
+func (i itineraryConfig) CarSpeedKmh() float64              { return i.carSpeedKmh }
+func (i itineraryConfig) TransitSpeedKmh() float64          { return i.transitSpeedKmh }
+func (i itineraryConfig) OptimizeTimeBudget() time.Duration { return i.optimizeTimeBudget }
+
+type s3Config struct {
+	endpoint        string
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+	usePathStyle    bool
+}
+
+func (s s3Config) Endpoint() string        { return s.endpoint }
+func (s s3Config) Region() string          { return s.region }
+func (s s3Config) Bucket() string          { return s.bucket }
+func (s s3Config) AccessKeyID() string     { return s.accessKeyID }
+func (s s3Config) SecretAccessKey() string { return s.secretAccessKey }
+func (s s3Config) UsePathStyle() bool      { return s.usePathStyle }
+
 // appConfig のメソッド実装
-func (c appConfig) Database() DatabaseConfig { return c.database }
-func (c appConfig) JWT() JWTConfig           { return c.jwt }
-func (c appConfig) Server() ServerConfig     { return c.server }
-func (c appConfig) Log() LogConfig           { return c.log }
-func (c appConfig) Environment() string      { return c.environment }
-func (c appConfig) Version() string          { return c.version }
-func (c appConfig) IsProduction() bool       { return c.environment == "production" }
-func (c appConfig) IsDevelopment() bool      { return c.environment == "development" }
+func (c appConfig) Database() DatabaseConfig     { return c.database }
+func (c appConfig) JWT() JWTConfig               { return c.jwt }
+func (c appConfig) Server() ServerConfig         { return c.server }
+func (c appConfig) Log() LogConfig               { return c.log }
+func (c appConfig) Admin() AdminConfig           { return c.admin }
+func (c appConfig) Trash() TrashConfig           { return c.trash }
+func (c appConfig) Attachment() AttachmentConfig { return c.attachment }
+func (c appConfig) Itinerary() ItineraryConfig   { return c.itinerary }
+func (c appConfig) Environment() string          { return c.environment }
+func (c appConfig) Version() string              { return c.version }
+func (c appConfig) IsProduction() bool           { return c.environment == "production" }
+func (c appConfig) IsDevelopment() bool          { return c.environment == "development" }
 
 // ConfigError は設定エラーを表す
 type ConfigError struct {
@@ -254,6 +370,50 @@ func (l *EnvLoader) Load() (Config, error) {
 	}
 	config.log = logConfig
 
+	// Admin設定の構築
+	adminConfig, err := l.loadAdminConfig()
+	if err != nil {
+		if ve, ok := err.(*ValidationErrors); ok {
+			validationErrors.Errors = append(validationErrors.Errors, ve.Errors...)
+		} else {
+			return nil, err
+		}
+	}
+	config.admin = adminConfig
+
+	// Trash設定の構築
+	trashConfig, err := l.loadTrashConfig()
+	if err != nil {
+		if ve, ok := err.(*ValidationErrors); ok {
+			validationErrors.Errors = append(validationErrors.Errors, ve.Errors...)
+		} else {
+			return nil, err
+		}
+	}
+	config.trash = trashConfig
+
+	// Attachment設定の構築
+	attachmentConfig, err := l.loadAttachmentConfig()
+	if err != nil {
+		if ve, ok := err.(*ValidationErrors); ok {
+			validationErrors.Errors = append(validationErrors.Errors, ve.Errors...)
+		} else {
+			return nil, err
+		}
+	}
+	config.attachment = attachmentConfig
+
+	// Itinerary設定の構築
+	itineraryConfig, err := l.loadItineraryConfig()
+	if err != nil {
+		if ve, ok := err.(*ValidationErrors); ok {
+			validationErrors.Errors = append(validationErrors.Errors, ve.Errors...)
+		} else {
+			return nil, err
+		}
+	}
+	config.itinerary = itineraryConfig
+
 	if validationErrors.HasErrors() {
 		return nil, &validationErrors
 	}
@@ -404,6 +564,122 @@ func (l *EnvLoader) loadLogConfig(environment string) (logConfig, error) {
 	}, nil
 }
 
+func (l *EnvLoader) loadAdminConfig() (adminConfig, error) {
+	var errors ValidationErrors
+
+	apiKey := os.Getenv("ADMIN_API_KEY")
+	if apiKey != "" && len(apiKey) < 32 {
+		errors.Add("ADMIN_API_KEY", "***", "must be at least 32 characters")
+	}
+
+	if errors.HasErrors() {
+		return adminConfig{}, &errors
+	}
+
+	return adminConfig{
+		apiKey: apiKey,
+	}, nil
+}
+
+func (l *EnvLoader) loadTrashConfig() (trashConfig, error) {
+	var errors ValidationErrors
+
+	retentionDays := getEnvAsIntOrDefault("TRASH_RETENTION_DAYS", 30)
+	if retentionDays <= 0 {
+		errors.Add("TRASH_RETENTION_DAYS", strconv.Itoa(retentionDays), "must be positive")
+	}
+
+	if errors.HasErrors() {
+		return trashConfig{}, &errors
+	}
+
+	return trashConfig{
+		retentionDays: retentionDays,
+	}, nil
+}
+
+func (l *EnvLoader) loadAttachmentConfig() (attachmentConfig, error) {
+	var errors ValidationErrors
+
+	maxSizeBytes := getEnvAsIntOrDefault("ATTACHMENT_MAX_SIZE_BYTES", 10*1024*1024)
+	if maxSizeBytes <= 0 {
+		errors.Add("ATTACHMENT_MAX_SIZE_BYTES", strconv.Itoa(maxSizeBytes), "must be positive")
+	}
+
+	storage := getEnvOrDefault("ATTACHMENT_STORAGE", "local")
+	validStorages := []string{"local", "s3"}
+	if !contains(validStorages, storage) {
+		errors.Add("ATTACHMENT_STORAGE", storage, fmt.Sprintf("must be one of: %s", strings.Join(validStorages, ", ")))
+	}
+
+	localDir := getEnvOrDefault("ATTACHMENT_LOCAL_DIR", "./data/attachments")
+
+	s3 := s3Config{
+		endpoint:        os.Getenv("S3_ENDPOINT"),
+		region:          getEnvOrDefault("S3_REGION", "us-east-1"),
+		bucket:          os.Getenv("S3_BUCKET"),
+		accessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+		secretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		usePathStyle:    getEnvAsBoolOrDefault("S3_USE_PATH_STYLE", false),
+	}
+	if storage == "s3" {
+		if s3.endpoint == "" {
+			errors.Add("S3_ENDPOINT", "", "required when ATTACHMENT_STORAGE is s3")
+		}
+		if s3.bucket == "" {
+			errors.Add("S3_BUCKET", "", "required when ATTACHMENT_STORAGE is s3")
+		}
+		if s3.accessKeyID == "" || s3.secretAccessKey == "" {
+			errors.Add("S3_ACCESS_KEY_ID", "", "access key ID and secret access key are required when ATTACHMENT_STORAGE is s3")
+		}
+	}
+
+	if errors.HasErrors() {
+		return attachmentConfig{}, &errors
+	}
+
+	return attachmentConfig{
+		maxSizeBytes: maxSizeBytes,
+		storage:      storage,
+		localDir:     localDir,
+		s3:           s3,
+	}, nil
+}
+
+func (l *EnvLoader) loadItineraryConfig() (itineraryConfig, error) {
+	var errors ValidationErrors
+
+	// 既定値は itinerary.DefaultSpeeds と同じ
+	speeds := map[string]float64{
+		"ITINERARY_WALK_SPEED_KMH":    getEnvAsFloatOrDefault("ITINERARY_WALK_SPEED_KMH", 4.8),
+		"ITINERARY_BICYCLE_SPEED_KMH": getEnvAsFloatOrDefault("ITINERARY_BICYCLE_SPEED_KMH", 15),
+		"ITINERARY_CAR_SPEED_KMH":     getEnvAsFloatOrDefault("ITINERARY_CAR_SPEED_KMH", 30),
+		"ITINERARY_TRANSIT_SPEED_KMH": getEnvAsFloatOrDefault("ITINERARY_TRANSIT_SPEED_KMH", 25),
+	}
+	for _, key := range []string{"ITINERARY_WALK_SPEED_KMH", "ITINERARY_BICYCLE_SPEED_KMH", "ITINERARY_CAR_SPEED_KMH", "ITINERARY_TRANSIT_SPEED_KMH"} {
+		if !(speeds[key] > 0) || math.IsInf(speeds[key], 0) {
+			errors.Add(key, strconv.FormatFloat(speeds[key], 'f', -1, 64), "must be positive")
+		}
+	}
+
+	optimizeTimeBudget := getEnvAsDurationOrDefault("ITINERARY_OPTIMIZE_TIME_BUDGET", time.Second)
+	if optimizeTimeBudget <= 0 {
+		errors.Add("ITINERARY_OPTIMIZE_TIME_BUDGET", optimizeTimeBudget.String(), "must be positive")
+	}
+
+	if errors.HasErrors() {
+		return itineraryConfig{}, &errors
+	}
+
+	return itineraryConfig{
+		walkSpeedKmh:       speeds["ITINERARY_WALK_SPEED_KMH"],
+		bicycleSpeedKmh:    speeds["ITINERARY_BICYCLE_SPEED_KMH"],
+		carSpeedKmh:        speeds["ITINERARY_CAR_SPEED_KMH"],
+		transitSpeedKmh:    speeds["ITINERARY_TRANSIT_SPEED_KMH"],
+		optimizeTimeBudget: optimizeTimeBudget,
+	}, nil
+}
+
 // appConfig のバリデーションメソッド
 func (c appConfig) Validate() error {
 	var errors ValidationErrors
@@ -450,6 +726,28 @@ func getEnvAsDurationOrDefault(key string, defaultValue time.Duration) time.Dura
 	return defaultValue
 }
 
+func getEnvAsBoolOrDefault(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+		slog.Warn("Invalid boolean value for environment variable, using default",
+			"key", key, "value", value, "default", defaultValue)
+	}
+	return defaultValue
+}
+
+func getEnvAsFloatOrDefault(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+		slog.Warn("Invalid float value for environment variable, using default",
+			"key", key, "value", value, "default", defaultValue)
+	}
+	return defaultValue
+}
+
 func parseLogLevel(level string) (slog.Level, error) {
 	switch strings.ToLower(level) {
 	case "debug":
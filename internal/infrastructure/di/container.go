@@ -4,8 +4,9 @@ import (
 	"github.com/hata0/travel-api/internal/adapter/handler"
 	"github.com/hata0/travel-api/internal/domain/shared/clock"
 	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
-	"github.com/hata0/travel-api/internal/domain/shared/uuid"
 	"github.com/hata0/travel-api/internal/infrastructure/config"
+	"github.com/hata0/travel-api/internal/usecase"
+	"github.com/hata0/travel-api/internal/usecase/service"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -20,8 +21,11 @@ type Container struct {
 }
 
 // NewContainer は本番用のコンテナを作成する
-func NewContainer(db *pgxpool.Pool, cfg config.Config) *Container {
-	services := NewServices(db)
+func NewContainer(db *pgxpool.Pool, cfg config.Config) (*Container, error) {
+	services, err := NewServices(db, cfg)
+	if err != nil {
+		return nil, err
+	}
 	repositories := NewRepositories(db)
 	usecases := NewUsecases(repositories, services, cfg)
 	handlers := NewHandlers(usecases)
@@ -33,7 +37,7 @@ func NewContainer(db *pgxpool.Pool, cfg config.Config) *Container {
 		repositories: repositories,
 		usecases:     usecases,
 		handlers:     handlers,
-	}
+	}, nil
 }
 
 // NewTestContainer はテスト用のコンテナを作成する（依存関係を外から注入可能）
@@ -69,19 +73,162 @@ func (c *Container) TripHandler() *handler.TripHandler {
 	return c.handlers.TripHandler()
 }
 
+func (c *Container) AccommodationHandler() *handler.AccommodationHandler {
+	return c.handlers.AccommodationHandler()
+}
+
+func (c *Container) ExpenseHandler() *handler.ExpenseHandler {
+	return c.handlers.ExpenseHandler()
+}
+
+func (c *Container) BudgetHandler() *handler.BudgetHandler {
+	return c.handlers.BudgetHandler()
+}
+
+func (c *Container) SettlementHandler() *handler.SettlementHandler {
+	return c.handlers.SettlementHandler()
+}
+
+func (c *Container) MemberHandler() *handler.MemberHandler {
+	return c.handlers.MemberHandler()
+}
+
+func (c *Container) InvitationHandler() *handler.InvitationHandler {
+	return c.handlers.InvitationHandler()
+}
+
+func (c *Container) ShareLinkHandler() *handler.ShareLinkHandler {
+	return c.handlers.ShareLinkHandler()
+}
+
+func (c *Container) PublicShareLinkHandler() *handler.PublicShareLinkHandler {
+	return c.handlers.PublicShareLinkHandler()
+}
+
+func (c *Container) SearchHandler() *handler.SearchHandler {
+	return c.handlers.SearchHandler()
+}
+
+func (c *Container) TrashHandler() *handler.TrashHandler {
+	return c.handlers.TrashHandler()
+}
+
+func (c *Container) HistoryHandler() *handler.HistoryHandler {
+	return c.handlers.HistoryHandler()
+}
+
+func (c *Container) TemplateHandler() *handler.TemplateHandler {
+	return c.handlers.TemplateHandler()
+}
+
+func (c *Container) ChecklistHandler() *handler.ChecklistHandler {
+	return c.handlers.ChecklistHandler()
+}
+
+func (c *Container) ItemTemplateHandler() *handler.ItemTemplateHandler {
+	return c.handlers.ItemTemplateHandler()
+}
+
+func (c *Container) JournalHandler() *handler.JournalHandler {
+	return c.handlers.JournalHandler()
+}
+
+func (c *Container) AttachmentHandler() *handler.AttachmentHandler {
+	return c.handlers.AttachmentHandler()
+}
+
+func (c *Container) PhotoHandler() *handler.PhotoHandler {
+	return c.handlers.PhotoHandler()
+}
+
+func (c *Container) CalendarHandler() *handler.CalendarHandler {
+	return c.handlers.CalendarHandler()
+}
+
+func (c *Container) PublicCalendarHandler() *handler.PublicCalendarHandler {
+	return c.handlers.PublicCalendarHandler()
+}
+
+func (c *Container) BookingImportHandler() *handler.BookingImportHandler {
+	return c.handlers.BookingImportHandler()
+}
+
+func (c *Container) RouteHandler() *handler.RouteHandler {
+	return c.handlers.RouteHandler()
+}
+
+func (c *Container) TrackHandler() *handler.TrackHandler {
+	return c.handlers.TrackHandler()
+}
+
+func (c *Container) ItineraryHandler() *handler.ItineraryHandler {
+	return c.handlers.ItineraryHandler()
+}
+
+func (c *Container) TransportHandler() *handler.TransportHandler {
+	return c.handlers.TransportHandler()
+}
+
+func (c *Container) ReferenceHandler() *handler.ReferenceHandler {
+	return c.handlers.ReferenceHandler()
+}
+
+func (c *Container) TimelineHandler() *handler.TimelineHandler {
+	return c.handlers.TimelineHandler()
+}
+
+func (c *Container) AdminExchangeRateHandler() *handler.AdminExchangeRateHandler {
+	return c.handlers.AdminExchangeRateHandler()
+}
+
 func (c *Container) AuthHandler() *handler.AuthHandler {
 	return c.handlers.AuthHandler()
 }
 
+// ExchangeRateUsecase はコマンドラインからの為替レート取り込みに使うユースケースを返す
+func (c *Container) ExchangeRateUsecase() usecase.ExchangeRateUsecase {
+	return c.usecases.ExchangeRateUsecase()
+}
+
+// ReferenceUsecase はコマンドラインからの参照データの取り込みに使うユースケースを返す
+func (c *Container) ReferenceUsecase() usecase.ReferenceUsecase {
+	return c.usecases.ReferenceUsecase()
+}
+
+// TrashUsecase はコマンドラインからの保持期間を過ぎた旅行の削除に使うユースケースを返す
+func (c *Container) TrashUsecase() usecase.TrashUsecase {
+	return c.usecases.TrashUsecase()
+}
+
 // ServiceProvider インターフェースの実装
 func (c *Container) Clock() clock.Clock {
 	return c.services.Clock()
 }
 
-func (c *Container) UUIDGenerator() uuid.UUIDGenerator {
-	return c.services.UUIDGenerator()
+func (c *Container) IDService() service.IDService {
+	return c.services.IDService()
 }
 
 func (c *Container) TransactionManager() transaction_manager.TransactionManager {
 	return c.services.TransactionManager()
 }
+
+func (c *Container) TokenService() service.TokenService {
+	return c.services.TokenService()
+}
+
+func (c *Container) PasswordService() service.PasswordService {
+	return c.services.PasswordService()
+}
+
+func (c *Container) ShareLinkSecretService() service.ShareLinkSecretService {
+	return c.services.ShareLinkSecretService()
+}
+
+func (c *Container) MarkdownRenderer() service.MarkdownRenderer {
+	return c.services.MarkdownRenderer()
+}
+
+func (c *Container) BlobStore() service.BlobStore {
+	return c.services.BlobStore()
+}
@@ -28,7 +28,13 @@ func (f *Factory) CreateProductionContainer(cfg config.Config) (*Container, erro
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	return NewContainer(db, cfg), nil
+	container, err := NewContainer(db, cfg)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return container, nil
 }
 
 // CreateTestContainer はテスト用コンテナを作成する
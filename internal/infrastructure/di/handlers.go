@@ -8,8 +8,35 @@ import (
 type Handlers struct {
 	usecases *Usecases
 
-	tripHandler *handler.TripHandler
-	authHandler *handler.AuthHandler
+	tripHandler          *handler.TripHandler
+	accommodationHandler *handler.AccommodationHandler
+	expenseHandler       *handler.ExpenseHandler
+	budgetHandler        *handler.BudgetHandler
+	settlementHandler    *handler.SettlementHandler
+	memberHandler        *handler.MemberHandler
+	invitationHandler    *handler.InvitationHandler
+	shareLinkHandler     *handler.ShareLinkHandler
+	publicShareHandler   *handler.PublicShareLinkHandler
+	searchHandler        *handler.SearchHandler
+	trashHandler         *handler.TrashHandler
+	historyHandler       *handler.HistoryHandler
+	templateHandler      *handler.TemplateHandler
+	checklistHandler     *handler.ChecklistHandler
+	itemTemplateHandler  *handler.ItemTemplateHandler
+	journalHandler       *handler.JournalHandler
+	attachmentHandler    *handler.AttachmentHandler
+	photoHandler         *handler.PhotoHandler
+	calendarHandler      *handler.CalendarHandler
+	publicCalendar       *handler.PublicCalendarHandler
+	bookingImportHandler *handler.BookingImportHandler
+	routeHandler         *handler.RouteHandler
+	trackHandler         *handler.TrackHandler
+	itineraryHandler     *handler.ItineraryHandler
+	transportHandler     *handler.TransportHandler
+	referenceHandler     *handler.ReferenceHandler
+	timelineHandler      *handler.TimelineHandler
+	exchangeRateHandler  *handler.AdminExchangeRateHandler
+	authHandler          *handler.AuthHandler
 }
 
 // NewHandlers はハンドラーを初期化する
@@ -26,6 +53,195 @@ func (h *Handlers) TripHandler() *handler.TripHandler {
 	return h.tripHandler
 }
 
+func (h *Handlers) AccommodationHandler() *handler.AccommodationHandler {
+	if h.accommodationHandler == nil {
+		h.accommodationHandler = handler.NewAccommodationHandler(h.usecases.AccommodationUsecase())
+	}
+	return h.accommodationHandler
+}
+
+func (h *Handlers) ExpenseHandler() *handler.ExpenseHandler {
+	if h.expenseHandler == nil {
+		h.expenseHandler = handler.NewExpenseHandler(h.usecases.ExpenseUsecase())
+	}
+	return h.expenseHandler
+}
+
+func (h *Handlers) BudgetHandler() *handler.BudgetHandler {
+	if h.budgetHandler == nil {
+		h.budgetHandler = handler.NewBudgetHandler(h.usecases.BudgetUsecase())
+	}
+	return h.budgetHandler
+}
+
+func (h *Handlers) SettlementHandler() *handler.SettlementHandler {
+	if h.settlementHandler == nil {
+		h.settlementHandler = handler.NewSettlementHandler(h.usecases.SettlementUsecase())
+	}
+	return h.settlementHandler
+}
+
+func (h *Handlers) MemberHandler() *handler.MemberHandler {
+	if h.memberHandler == nil {
+		h.memberHandler = handler.NewMemberHandler(h.usecases.MemberUsecase())
+	}
+	return h.memberHandler
+}
+
+func (h *Handlers) InvitationHandler() *handler.InvitationHandler {
+	if h.invitationHandler == nil {
+		h.invitationHandler = handler.NewInvitationHandler(h.usecases.InvitationUsecase())
+	}
+	return h.invitationHandler
+}
+
+func (h *Handlers) ShareLinkHandler() *handler.ShareLinkHandler {
+	if h.shareLinkHandler == nil {
+		h.shareLinkHandler = handler.NewShareLinkHandler(h.usecases.ShareLinkUsecase())
+	}
+	return h.shareLinkHandler
+}
+
+func (h *Handlers) PublicShareLinkHandler() *handler.PublicShareLinkHandler {
+	if h.publicShareHandler == nil {
+		h.publicShareHandler = handler.NewPublicShareLinkHandler(h.usecases.ShareLinkUsecase())
+	}
+	return h.publicShareHandler
+}
+
+func (h *Handlers) SearchHandler() *handler.SearchHandler {
+	if h.searchHandler == nil {
+		h.searchHandler = handler.NewSearchHandler(h.usecases.SearchUsecase())
+	}
+	return h.searchHandler
+}
+
+func (h *Handlers) TrashHandler() *handler.TrashHandler {
+	if h.trashHandler == nil {
+		h.trashHandler = handler.NewTrashHandler(h.usecases.TrashUsecase())
+	}
+	return h.trashHandler
+}
+
+func (h *Handlers) HistoryHandler() *handler.HistoryHandler {
+	if h.historyHandler == nil {
+		h.historyHandler = handler.NewHistoryHandler(h.usecases.HistoryUsecase())
+	}
+	return h.historyHandler
+}
+
+func (h *Handlers) TemplateHandler() *handler.TemplateHandler {
+	if h.templateHandler == nil {
+		h.templateHandler = handler.NewTemplateHandler(h.usecases.TemplateUsecase())
+	}
+	return h.templateHandler
+}
+
+func (h *Handlers) ChecklistHandler() *handler.ChecklistHandler {
+	if h.checklistHandler == nil {
+		h.checklistHandler = handler.NewChecklistHandler(h.usecases.ChecklistUsecase())
+	}
+	return h.checklistHandler
+}
+
+func (h *Handlers) ItemTemplateHandler() *handler.ItemTemplateHandler {
+	if h.itemTemplateHandler == nil {
+		h.itemTemplateHandler = handler.NewItemTemplateHandler(h.usecases.ItemTemplateUsecase())
+	}
+	return h.itemTemplateHandler
+}
+
+func (h *Handlers) JournalHandler() *handler.JournalHandler {
+	if h.journalHandler == nil {
+		h.journalHandler = handler.NewJournalHandler(h.usecases.JournalUsecase())
+	}
+	return h.journalHandler
+}
+
+func (h *Handlers) AttachmentHandler() *handler.AttachmentHandler {
+	if h.attachmentHandler == nil {
+		h.attachmentHandler = handler.NewAttachmentHandler(h.usecases.AttachmentUsecase(), int64(h.usecases.config.Attachment().MaxSizeBytes()))
+	}
+	return h.attachmentHandler
+}
+
+func (h *Handlers) PhotoHandler() *handler.PhotoHandler {
+	if h.photoHandler == nil {
+		h.photoHandler = handler.NewPhotoHandler(h.usecases.PhotoUsecase(), int64(h.usecases.config.Attachment().MaxSizeBytes()))
+	}
+	return h.photoHandler
+}
+
+func (h *Handlers) CalendarHandler() *handler.CalendarHandler {
+	if h.calendarHandler == nil {
+		h.calendarHandler = handler.NewCalendarHandler(h.usecases.CalendarUsecase())
+	}
+	return h.calendarHandler
+}
+
+func (h *Handlers) PublicCalendarHandler() *handler.PublicCalendarHandler {
+	if h.publicCalendar == nil {
+		h.publicCalendar = handler.NewPublicCalendarHandler(h.usecases.CalendarUsecase())
+	}
+	return h.publicCalendar
+}
+
+func (h *Handlers) BookingImportHandler() *handler.BookingImportHandler {
+	if h.bookingImportHandler == nil {
+		h.bookingImportHandler = handler.NewBookingImportHandler(h.usecases.BookingImportUsecase())
+	}
+	return h.bookingImportHandler
+}
+
+func (h *Handlers) RouteHandler() *handler.RouteHandler {
+	if h.routeHandler == nil {
+		h.routeHandler = handler.NewRouteHandler(h.usecases.RouteUsecase())
+	}
+	return h.routeHandler
+}
+
+func (h *Handlers) TrackHandler() *handler.TrackHandler {
+	if h.trackHandler == nil {
+		h.trackHandler = handler.NewTrackHandler(h.usecases.TrackUsecase(), int64(h.usecases.config.Attachment().MaxSizeBytes()))
+	}
+	return h.trackHandler
+}
+
+func (h *Handlers) ItineraryHandler() *handler.ItineraryHandler {
+	if h.itineraryHandler == nil {
+		h.itineraryHandler = handler.NewItineraryHandler(h.usecases.ItineraryUsecase())
+	}
+	return h.itineraryHandler
+}
+
+func (h *Handlers) TransportHandler() *handler.TransportHandler {
+	if h.transportHandler == nil {
+		h.transportHandler = handler.NewTransportHandler(h.usecases.TransportUsecase())
+	}
+	return h.transportHandler
+}
+
+func (h *Handlers) ReferenceHandler() *handler.ReferenceHandler {
+	if h.referenceHandler == nil {
+		h.referenceHandler = handler.NewReferenceHandler(h.usecases.ReferenceUsecase())
+	}
+	return h.referenceHandler
+}
+
+func (h *Handlers) TimelineHandler() *handler.TimelineHandler {
+	if h.timelineHandler == nil {
+		h.timelineHandler = handler.NewTimelineHandler(h.usecases.TimelineUsecase())
+	}
+	return h.timelineHandler
+}
+
+func (h *Handlers) AdminExchangeRateHandler() *handler.AdminExchangeRateHandler {
+	if h.exchangeRateHandler == nil {
+		h.exchangeRateHandler = handler.NewAdminExchangeRateHandler(h.usecases.ExchangeRateUsecase())
+	}
+	return h.exchangeRateHandler
+}
+
 func (h *Handlers) AuthHandler() *handler.AuthHandler {
 	if h.authHandler == nil {
 		h.authHandler = handler.NewAuthHandler(h.usecases.AuthUsecase())
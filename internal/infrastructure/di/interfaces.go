@@ -2,29 +2,107 @@ package di
 
 import (
 	"github.com/hata0/travel-api/internal/adapter/handler"
-	"github.com/hata0/travel-api/internal/domain"
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/calendarfeed"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/photo"
+	"github.com/hata0/travel-api/internal/domain/reference"
+	refreshtoken "github.com/hata0/travel-api/internal/domain/refresh_token"
+	revokedtoken "github.com/hata0/travel-api/internal/domain/revoked_token"
+	"github.com/hata0/travel-api/internal/domain/search"
 	"github.com/hata0/travel-api/internal/domain/shared/clock"
 	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
-	"github.com/hata0/travel-api/internal/domain/shared/uuid"
+	"github.com/hata0/travel-api/internal/domain/sharelink"
+	"github.com/hata0/travel-api/internal/domain/track"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/triptemplate"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/service"
 )
 
 // HandlerProvider はハンドラー生成のインターフェース
 type HandlerProvider interface {
 	TripHandler() *handler.TripHandler
+	AccommodationHandler() *handler.AccommodationHandler
+	ExpenseHandler() *handler.ExpenseHandler
+	BudgetHandler() *handler.BudgetHandler
+	SettlementHandler() *handler.SettlementHandler
+	MemberHandler() *handler.MemberHandler
+	InvitationHandler() *handler.InvitationHandler
+	ShareLinkHandler() *handler.ShareLinkHandler
+	PublicShareLinkHandler() *handler.PublicShareLinkHandler
+	SearchHandler() *handler.SearchHandler
+	TrashHandler() *handler.TrashHandler
+	HistoryHandler() *handler.HistoryHandler
+	TemplateHandler() *handler.TemplateHandler
+	ChecklistHandler() *handler.ChecklistHandler
+	ItemTemplateHandler() *handler.ItemTemplateHandler
+	JournalHandler() *handler.JournalHandler
+	AttachmentHandler() *handler.AttachmentHandler
+	PhotoHandler() *handler.PhotoHandler
+	CalendarHandler() *handler.CalendarHandler
+	PublicCalendarHandler() *handler.PublicCalendarHandler
+	BookingImportHandler() *handler.BookingImportHandler
+	RouteHandler() *handler.RouteHandler
+	TrackHandler() *handler.TrackHandler
+	ItineraryHandler() *handler.ItineraryHandler
+	TransportHandler() *handler.TransportHandler
+	ReferenceHandler() *handler.ReferenceHandler
+	TimelineHandler() *handler.TimelineHandler
+	AdminExchangeRateHandler() *handler.AdminExchangeRateHandler
 	AuthHandler() *handler.AuthHandler
 }
 
 // ServiceProvider はドメインサービスのインターフェース
 type ServiceProvider interface {
 	Clock() clock.Clock
-	UUIDGenerator() uuid.UUIDGenerator
+	IDService() service.IDService
 	TransactionManager() transaction_manager.TransactionManager
+	TokenService() service.TokenService
+	PasswordService() service.PasswordService
+	ShareLinkSecretService() service.ShareLinkSecretService
+	MarkdownRenderer() service.MarkdownRenderer
+	BlobStore() service.BlobStore
+	PhotoProcessor() service.PhotoProcessor
+	CalendarEncoder() service.CalendarEncoder
+	BookingParser() service.BookingParser
+	RouteWriter() service.RouteWriter
+	TrackParser() service.TrackParser
 }
 
 // RepositoryProvider はリポジトリのインターフェース
 type RepositoryProvider interface {
-	TripRepository() domain.TripRepository
-	UserRepository() domain.UserRepository
-	RefreshTokenRepository() domain.RefreshTokenRepository
-	RevokedTokenRepository() domain.RevokedTokenRepository
+	TripRepository() trip.TripRepository
+	AccommodationRepository() accommodation.AccommodationRepository
+	ExpenseRepository() expense.ExpenseRepository
+	BudgetRepository() budget.BudgetRepository
+	ExchangeRateRepository() exchangerate.ExchangeRateRepository
+	MemberRepository() membership.MemberRepository
+	InvitationRepository() membership.InvitationRepository
+	ShareLinkRepository() sharelink.ShareLinkRepository
+	SearchRepository() search.SearchRepository
+	HistoryRepository() history.HistoryRepository
+	TemplateRepository() triptemplate.TemplateRepository
+	ChecklistRepository() checklist.ChecklistRepository
+	ItemTemplateRepository() checklist.ItemTemplateRepository
+	JournalRepository() journal.EntryRepository
+	AttachmentRepository() attachment.AttachmentRepository
+	PhotoRepository() photo.PhotoRepository
+	CalendarFeedRepository() calendarfeed.FeedRepository
+	TrackRepository() track.TrackRepository
+	ActivityRepository() itinerary.ActivityRepository
+	TransportLegRepository() transport.LegRepository
+	ReferenceRepository() reference.ReferenceRepository
+	UserRepository() user.UserRepository
+	RefreshTokenRepository() refreshtoken.RefreshTokenRepository
+	RevokedTokenRepository() revokedtoken.RevokedTokenRepository
 }
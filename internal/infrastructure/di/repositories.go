@@ -1,43 +1,184 @@
 package di
 
 import (
-	"github.com/hata0/travel-api/internal/domain"
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/calendarfeed"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/photo"
+	"github.com/hata0/travel-api/internal/domain/reference"
+	refreshtoken "github.com/hata0/travel-api/internal/domain/refresh_token"
+	revokedtoken "github.com/hata0/travel-api/internal/domain/revoked_token"
+	"github.com/hata0/travel-api/internal/domain/search"
+	"github.com/hata0/travel-api/internal/domain/sharelink"
+	"github.com/hata0/travel-api/internal/domain/track"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/triptemplate"
+	"github.com/hata0/travel-api/internal/domain/user"
 	"github.com/hata0/travel-api/internal/infrastructure/postgres"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // Repositories はリポジトリの実装を提供する
 type Repositories struct {
-	db                     *pgxpool.Pool
-	tripRepository         domain.TripRepository
-	userRepository         domain.UserRepository
-	refreshTokenRepository domain.RefreshTokenRepository
-	revokedTokenRepository domain.RevokedTokenRepository
+	db                      *pgxpool.Pool
+	tripRepository          trip.TripRepository
+	accommodationRepository accommodation.AccommodationRepository
+	expenseRepository       expense.ExpenseRepository
+	budgetRepository        budget.BudgetRepository
+	exchangeRateRepository  exchangerate.ExchangeRateRepository
+	memberRepository        membership.MemberRepository
+	invitationRepository    membership.InvitationRepository
+	shareLinkRepository     sharelink.ShareLinkRepository
+	searchRepository        search.SearchRepository
+	historyRepository       history.HistoryRepository
+	templateRepository      triptemplate.TemplateRepository
+	checklistRepository     checklist.ChecklistRepository
+	itemTemplateRepository  checklist.ItemTemplateRepository
+	journalRepository       journal.EntryRepository
+	attachmentRepository    attachment.AttachmentRepository
+	photoRepository         photo.PhotoRepository
+	calendarFeedRepository  calendarfeed.FeedRepository
+	trackRepository         track.TrackRepository
+	activityRepository      itinerary.ActivityRepository
+	transportLegRepository  transport.LegRepository
+	referenceRepository     reference.ReferenceRepository
+	userRepository          user.UserRepository
+	refreshTokenRepository  refreshtoken.RefreshTokenRepository
+	revokedTokenRepository  revokedtoken.RevokedTokenRepository
 }
 
 // NewRepositories はリポジトリを初期化する
 func NewRepositories(db *pgxpool.Pool) *Repositories {
 	return &Repositories{
-		db:                     db,
-		tripRepository:         postgres.NewTripPostgresRepository(db),
-		userRepository:         postgres.NewUserPostgresRepository(db),
-		refreshTokenRepository: postgres.NewRefreshTokenPostgresRepository(db),
-		revokedTokenRepository: postgres.NewRevokedTokenPostgresRepository(db),
+		db:                      db,
+		tripRepository:          postgres.NewTripPostgresRepository(db),
+		accommodationRepository: postgres.NewAccommodationPostgresRepository(db),
+		expenseRepository:       postgres.NewExpensePostgresRepository(db),
+		budgetRepository:        postgres.NewBudgetPostgresRepository(db),
+		exchangeRateRepository:  postgres.NewExchangeRatePostgresRepository(db),
+		memberRepository:        postgres.NewMemberPostgresRepository(db),
+		invitationRepository:    postgres.NewInvitationPostgresRepository(db),
+		shareLinkRepository:     postgres.NewShareLinkPostgresRepository(db),
+		searchRepository:        postgres.NewSearchPostgresRepository(db),
+		historyRepository:       postgres.NewHistoryPostgresRepository(db),
+		templateRepository:      postgres.NewTemplatePostgresRepository(db),
+		checklistRepository:     postgres.NewChecklistPostgresRepository(db),
+		itemTemplateRepository:  postgres.NewItemTemplatePostgresRepository(db),
+		journalRepository:       postgres.NewJournalPostgresRepository(db),
+		attachmentRepository:    postgres.NewAttachmentPostgresRepository(db),
+		photoRepository:         postgres.NewPhotoPostgresRepository(db),
+		calendarFeedRepository:  postgres.NewCalendarFeedPostgresRepository(db),
+		trackRepository:         postgres.NewTrackPostgresRepository(db),
+		activityRepository:      postgres.NewActivityPostgresRepository(db),
+		transportLegRepository:  postgres.NewTransportLegPostgresRepository(db),
+		referenceRepository:     postgres.NewReferencePostgresRepository(db),
+		userRepository:          postgres.NewUserPostgresRepository(db),
+		refreshTokenRepository:  postgres.NewRefreshTokenPostgresRepository(db),
+		revokedTokenRepository:  postgres.NewRevokedTokenPostgresRepository(db),
 	}
 }
 
-func (r *Repositories) TripRepository() domain.TripRepository {
+func (r *Repositories) TripRepository() trip.TripRepository {
 	return r.tripRepository
 }
 
-func (r *Repositories) UserRepository() domain.UserRepository {
+func (r *Repositories) AccommodationRepository() accommodation.AccommodationRepository {
+	return r.accommodationRepository
+}
+
+func (r *Repositories) ExpenseRepository() expense.ExpenseRepository {
+	return r.expenseRepository
+}
+
+func (r *Repositories) BudgetRepository() budget.BudgetRepository {
+	return r.budgetRepository
+}
+
+func (r *Repositories) ExchangeRateRepository() exchangerate.ExchangeRateRepository {
+	return r.exchangeRateRepository
+}
+
+func (r *Repositories) MemberRepository() membership.MemberRepository {
+	return r.memberRepository
+}
+
+func (r *Repositories) InvitationRepository() membership.InvitationRepository {
+	return r.invitationRepository
+}
+
+func (r *Repositories) ShareLinkRepository() sharelink.ShareLinkRepository {
+	return r.shareLinkRepository
+}
+
+func (r *Repositories) SearchRepository() search.SearchRepository {
+	return r.searchRepository
+}
+
+func (r *Repositories) HistoryRepository() history.HistoryRepository {
+	return r.historyRepository
+}
+
+func (r *Repositories) TemplateRepository() triptemplate.TemplateRepository {
+	return r.templateRepository
+}
+
+func (r *Repositories) ChecklistRepository() checklist.ChecklistRepository {
+	return r.checklistRepository
+}
+
+func (r *Repositories) ItemTemplateRepository() checklist.ItemTemplateRepository {
+	return r.itemTemplateRepository
+}
+
+func (r *Repositories) JournalRepository() journal.EntryRepository {
+	return r.journalRepository
+}
+
+func (r *Repositories) AttachmentRepository() attachment.AttachmentRepository {
+	return r.attachmentRepository
+}
+
+func (r *Repositories) PhotoRepository() photo.PhotoRepository {
+	return r.photoRepository
+}
+
+func (r *Repositories) CalendarFeedRepository() calendarfeed.FeedRepository {
+	return r.calendarFeedRepository
+}
+
+func (r *Repositories) TrackRepository() track.TrackRepository {
+	return r.trackRepository
+}
+
+func (r *Repositories) ActivityRepository() itinerary.ActivityRepository {
+	return r.activityRepository
+}
+
+func (r *Repositories) TransportLegRepository() transport.LegRepository {
+	return r.transportLegRepository
+}
+
+func (r *Repositories) ReferenceRepository() reference.ReferenceRepository {
+	return r.referenceRepository
+}
+
+func (r *Repositories) UserRepository() user.UserRepository {
 	return r.userRepository
 }
 
-func (r *Repositories) RefreshTokenRepository() domain.RefreshTokenRepository {
+func (r *Repositories) RefreshTokenRepository() refreshtoken.RefreshTokenRepository {
 	return r.refreshTokenRepository
 }
 
-func (r *Repositories) RevokedTokenRepository() domain.RevokedTokenRepository {
+func (r *Repositories) RevokedTokenRepository() revokedtoken.RevokedTokenRepository {
 	return r.revokedTokenRepository
 }
@@ -1,38 +1,157 @@
 package di
 
 import (
+	"fmt"
+
 	"github.com/hata0/travel-api/internal/domain/shared/clock"
 	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
-	"github.com/hata0/travel-api/internal/domain/shared/uuid"
+	"github.com/hata0/travel-api/internal/infrastructure/blobstore"
+	"github.com/hata0/travel-api/internal/infrastructure/bookingparser"
+	"github.com/hata0/travel-api/internal/infrastructure/config"
+	"github.com/hata0/travel-api/internal/infrastructure/geoformat"
+	"github.com/hata0/travel-api/internal/infrastructure/gpx"
+	"github.com/hata0/travel-api/internal/infrastructure/icalendar"
+	"github.com/hata0/travel-api/internal/infrastructure/imaging"
+	"github.com/hata0/travel-api/internal/infrastructure/markdown"
 	"github.com/hata0/travel-api/internal/infrastructure/postgres"
+	infraservice "github.com/hata0/travel-api/internal/infrastructure/service"
+	"github.com/hata0/travel-api/internal/usecase/service"
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const (
+	// tokenJTIBytes はアクセストークンの JTI に使う乱数のバイト数
+	tokenJTIBytes = 16
+	// refreshTokenBytes はリフレッシュトークンに使う乱数のバイト数
+	refreshTokenBytes = 32
+)
+
 // Services はドメインサービスの実装を提供する
 type Services struct {
 	clock              clock.Clock
-	uuidGenerator      uuid.UUIDGenerator
+	idService          service.IDService
 	transactionManager transaction_manager.TransactionManager
+	tokenService       service.TokenService
+	passwordService    service.PasswordService
+	shareLinkSecret    service.ShareLinkSecretService
+	markdownRenderer   service.MarkdownRenderer
+	blobStore          service.BlobStore
+	photoProcessor     service.PhotoProcessor
+	calendarEncoder    service.CalendarEncoder
+	bookingParser      service.BookingParser
+	routeWriter        service.RouteWriter
+	trackParser        service.TrackParser
 }
 
 // NewServices はサービスを初期化する
-func NewServices(db *pgxpool.Pool) *Services {
+func NewServices(db *pgxpool.Pool, cfg config.Config) (*Services, error) {
+	blobStore, err := newBlobStore(cfg.Attachment())
+	if err != nil {
+		return nil, err
+	}
+
+	systemClock := &clock.SystemClock{}
+
 	return &Services{
-		clock:              &clock.SystemClock{},
-		uuidGenerator:      &uuid.DefaultUUIDGenerator{},
+		clock:              systemClock,
+		idService:          infraservice.NewIDService(),
 		transactionManager: postgres.NewTransactionManager(db),
+		tokenService:       newTokenService(systemClock, cfg.JWT()),
+		passwordService:    infraservice.NewPasswordService(bcrypt.DefaultCost),
+		shareLinkSecret:    infraservice.NewShareLinkSecretService(),
+		markdownRenderer:   markdown.NewRenderer(),
+		blobStore:          blobStore,
+		photoProcessor:     imaging.NewProcessor(),
+		calendarEncoder:    icalendar.NewEncoder(),
+		bookingParser:      bookingparser.NewParser(),
+		routeWriter:        geoformat.NewRouteWriter(),
+		trackParser:        gpx.NewParser(),
+	}, nil
+}
+
+// newTokenService は JWT の設定から認証ミドルウェアと同じ鍵で署名するトークンサービスを作成する
+func newTokenService(timeService service.TimeService, cfg config.JWTConfig) service.TokenService {
+	return infraservice.NewTokenService(timeService, &infraservice.TokenSettings{
+		Audience:              cfg.Issuer(),
+		Issuer:                cfg.Issuer(),
+		AccessTokenExpiration: cfg.AccessTokenExpiration(),
+		JTIBytes:              tokenJTIBytes,
+		Secret:                []byte(cfg.Secret()),
+		RefreshTokenBytes:     refreshTokenBytes,
+	})
+}
+
+// newBlobStore は設定に応じて添付ファイルの保存先を作成する
+func newBlobStore(cfg config.AttachmentConfig) (service.BlobStore, error) {
+	if cfg.Storage() != "s3" {
+		return blobstore.NewLocalBlobStore(cfg.LocalDir()), nil
+	}
+
+	s3 := cfg.S3()
+	blobStore, err := blobstore.NewS3BlobStore(blobstore.S3Options{
+		Endpoint:        s3.Endpoint(),
+		Region:          s3.Region(),
+		Bucket:          s3.Bucket(),
+		AccessKeyID:     s3.AccessKeyID(),
+		SecretAccessKey: s3.SecretAccessKey(),
+		UsePathStyle:    s3.UsePathStyle(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 blob store: %w", err)
 	}
+	return blobStore, nil
 }
 
 func (s *Services) Clock() clock.Clock {
 	return s.clock
 }
 
-func (s *Services) UUIDGenerator() uuid.UUIDGenerator {
-	return s.uuidGenerator
+func (s *Services) IDService() service.IDService {
+	return s.idService
 }
 
 func (s *Services) TransactionManager() transaction_manager.TransactionManager {
 	return s.transactionManager
 }
+
+func (s *Services) TokenService() service.TokenService {
+	return s.tokenService
+}
+
+func (s *Services) PasswordService() service.PasswordService {
+	return s.passwordService
+}
+
+func (s *Services) ShareLinkSecretService() service.ShareLinkSecretService {
+	return s.shareLinkSecret
+}
+
+func (s *Services) MarkdownRenderer() service.MarkdownRenderer {
+	return s.markdownRenderer
+}
+
+func (s *Services) BlobStore() service.BlobStore {
+	return s.blobStore
+}
+
+func (s *Services) PhotoProcessor() service.PhotoProcessor {
+	return s.photoProcessor
+}
+
+func (s *Services) CalendarEncoder() service.CalendarEncoder {
+	return s.calendarEncoder
+}
+
+func (s *Services) BookingParser() service.BookingParser {
+	return s.bookingParser
+}
+
+func (s *Services) RouteWriter() service.RouteWriter {
+	return s.routeWriter
+}
+
+func (s *Services) TrackParser() service.TrackParser {
+	return s.trackParser
+}
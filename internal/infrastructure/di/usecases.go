@@ -1,7 +1,11 @@
 package di
 
 import (
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
 	"github.com/hata0/travel-api/internal/infrastructure/config"
+	"github.com/hata0/travel-api/internal/infrastructure/ecb"
+	"github.com/hata0/travel-api/internal/infrastructure/referencedata"
 	"github.com/hata0/travel-api/internal/usecase"
 )
 
@@ -11,8 +15,33 @@ type Usecases struct {
 	services ServiceProvider
 	config   config.Config
 
-	tripUsecase *usecase.TripInteractor
-	authUsecase *usecase.AuthInteractor
+	tripUsecase          usecase.TripUsecase
+	accommodationUsecase usecase.AccommodationUsecase
+	expenseUsecase       usecase.ExpenseUsecase
+	budgetUsecase        usecase.BudgetUsecase
+	settlementUsecase    usecase.SettlementUsecase
+	exchangeRateUsecase  usecase.ExchangeRateUsecase
+	memberUsecase        usecase.MemberUsecase
+	invitationUsecase    usecase.InvitationUsecase
+	shareLinkUsecase     usecase.ShareLinkUsecase
+	searchUsecase        usecase.SearchUsecase
+	trashUsecase         usecase.TrashUsecase
+	historyUsecase       usecase.HistoryUsecase
+	templateUsecase      usecase.TemplateUsecase
+	checklistUsecase     usecase.ChecklistUsecase
+	itemTemplateUsecase  usecase.ItemTemplateUsecase
+	journalUsecase       usecase.JournalUsecase
+	attachmentUsecase    usecase.AttachmentUsecase
+	photoUsecase         usecase.PhotoUsecase
+	calendarUsecase      usecase.CalendarUsecase
+	bookingImportUsecase usecase.BookingImportUsecase
+	routeUsecase         usecase.RouteUsecase
+	trackUsecase         usecase.TrackUsecase
+	itineraryUsecase     usecase.ItineraryUsecase
+	transportUsecase     usecase.TransportUsecase
+	referenceUsecase     usecase.ReferenceUsecase
+	timelineUsecase      usecase.TimelineUsecase
+	authUsecase          usecase.AuthUsecase
 }
 
 // NewUsecases はユースケースを初期化する
@@ -24,27 +53,435 @@ func NewUsecases(repos RepositoryProvider, services ServiceProvider, cfg config.
 	}
 }
 
-func (u *Usecases) TripUsecase() *usecase.TripInteractor {
+func (u *Usecases) TripUsecase() usecase.TripUsecase {
 	if u.tripUsecase == nil {
 		u.tripUsecase = usecase.NewTripInteractor(
 			u.repos.TripRepository(),
+			u.repos.MemberRepository(),
+			u.repos.AccommodationRepository(),
+			u.repos.BudgetRepository(),
+			u.repos.ChecklistRepository(),
+			u.repos.ActivityRepository(),
+			u.repos.TemplateRepository(),
+			u.repos.HistoryRepository(),
+			u.services.TransactionManager(),
 			u.services.Clock(),
-			u.services.UUIDGenerator(),
+			u.services.IDService(),
 		)
 	}
 	return u.tripUsecase
 }
 
-func (u *Usecases) AuthUsecase() *usecase.AuthInteractor {
+func (u *Usecases) AccommodationUsecase() usecase.AccommodationUsecase {
+	if u.accommodationUsecase == nil {
+		u.accommodationUsecase = usecase.NewAccommodationInteractor(
+			u.repos.AccommodationRepository(),
+			u.repos.TripRepository(),
+			u.repos.MemberRepository(),
+			u.repos.HistoryRepository(),
+			u.repos.AttachmentRepository(),
+			u.services.BlobStore(),
+			u.services.TransactionManager(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.accommodationUsecase
+}
+
+func (u *Usecases) ExpenseUsecase() usecase.ExpenseUsecase {
+	if u.expenseUsecase == nil {
+		u.expenseUsecase = usecase.NewExpenseInteractor(
+			u.repos.ExpenseRepository(),
+			u.repos.TripRepository(),
+			u.repos.MemberRepository(),
+			u.repos.HistoryRepository(),
+			u.repos.AttachmentRepository(),
+			u.services.BlobStore(),
+			u.services.TransactionManager(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.expenseUsecase
+}
+
+func (u *Usecases) BudgetUsecase() usecase.BudgetUsecase {
+	if u.budgetUsecase == nil {
+		u.budgetUsecase = usecase.NewBudgetInteractor(
+			u.repos.BudgetRepository(),
+			u.repos.ExpenseRepository(),
+			u.repos.TripRepository(),
+			u.repos.MemberRepository(),
+			u.repos.HistoryRepository(),
+			u.services.TransactionManager(),
+			exchangerate.NewCurrencyConverter(u.repos.ExchangeRateRepository()),
+			u.services.Clock(),
+		)
+	}
+	return u.budgetUsecase
+}
+
+func (u *Usecases) SettlementUsecase() usecase.SettlementUsecase {
+	if u.settlementUsecase == nil {
+		u.settlementUsecase = usecase.NewSettlementInteractor(
+			u.repos.ExpenseRepository(),
+			u.repos.TripRepository(),
+			u.repos.MemberRepository(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.settlementUsecase
+}
+
+func (u *Usecases) ExchangeRateUsecase() usecase.ExchangeRateUsecase {
+	if u.exchangeRateUsecase == nil {
+		u.exchangeRateUsecase = usecase.NewExchangeRateInteractor(
+			u.repos.ExchangeRateRepository(),
+			ecb.NewFeedParser(),
+			u.services.TransactionManager(),
+		)
+	}
+	return u.exchangeRateUsecase
+}
+
+func (u *Usecases) MemberUsecase() usecase.MemberUsecase {
+	if u.memberUsecase == nil {
+		u.memberUsecase = usecase.NewMemberInteractor(
+			u.repos.MemberRepository(),
+			u.services.TransactionManager(),
+			u.services.Clock(),
+		)
+	}
+	return u.memberUsecase
+}
+
+func (u *Usecases) InvitationUsecase() usecase.InvitationUsecase {
+	if u.invitationUsecase == nil {
+		u.invitationUsecase = usecase.NewInvitationInteractor(
+			u.repos.InvitationRepository(),
+			u.repos.MemberRepository(),
+			u.repos.UserRepository(),
+			u.services.TransactionManager(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.invitationUsecase
+}
+
+func (u *Usecases) ShareLinkUsecase() usecase.ShareLinkUsecase {
+	if u.shareLinkUsecase == nil {
+		u.shareLinkUsecase = usecase.NewShareLinkInteractor(
+			u.repos.ShareLinkRepository(),
+			u.repos.TripRepository(),
+			u.repos.AccommodationRepository(),
+			u.repos.ActivityRepository(),
+			u.repos.JournalRepository(),
+			u.repos.MemberRepository(),
+			u.repos.UserRepository(),
+			u.services.ShareLinkSecretService(),
+			u.services.PasswordService(),
+			u.services.MarkdownRenderer(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.shareLinkUsecase
+}
+
+func (u *Usecases) SearchUsecase() usecase.SearchUsecase {
+	if u.searchUsecase == nil {
+		u.searchUsecase = usecase.NewSearchInteractor(u.repos.SearchRepository())
+	}
+	return u.searchUsecase
+}
+
+func (u *Usecases) TrashUsecase() usecase.TrashUsecase {
+	if u.trashUsecase == nil {
+		u.trashUsecase = usecase.NewTrashInteractor(
+			u.repos.TripRepository(),
+			u.repos.MemberRepository(),
+			u.repos.AttachmentRepository(),
+			u.repos.PhotoRepository(),
+			u.services.BlobStore(),
+			u.services.Clock(),
+			u.config.Trash().RetentionDays(),
+		)
+	}
+	return u.trashUsecase
+}
+
+func (u *Usecases) HistoryUsecase() usecase.HistoryUsecase {
+	if u.historyUsecase == nil {
+		u.historyUsecase = usecase.NewHistoryInteractor(
+			u.repos.HistoryRepository(),
+			u.repos.TripRepository(),
+			u.repos.AccommodationRepository(),
+			u.repos.ExpenseRepository(),
+			u.repos.BudgetRepository(),
+			u.repos.ChecklistRepository(),
+			u.repos.JournalRepository(),
+			u.repos.ActivityRepository(),
+			u.repos.TransportLegRepository(),
+			u.repos.MemberRepository(),
+			u.repos.AttachmentRepository(),
+			u.services.BlobStore(),
+			u.services.TransactionManager(),
+			u.services.Clock(),
+		)
+	}
+	return u.historyUsecase
+}
+
+func (u *Usecases) TemplateUsecase() usecase.TemplateUsecase {
+	if u.templateUsecase == nil {
+		u.templateUsecase = usecase.NewTemplateInteractor(
+			u.repos.TemplateRepository(),
+			u.repos.TripRepository(),
+			u.repos.AccommodationRepository(),
+			u.repos.BudgetRepository(),
+			u.repos.ChecklistRepository(),
+			u.repos.ActivityRepository(),
+			u.repos.MemberRepository(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.templateUsecase
+}
+
+func (u *Usecases) ChecklistUsecase() usecase.ChecklistUsecase {
+	if u.checklistUsecase == nil {
+		u.checklistUsecase = usecase.NewChecklistInteractor(
+			u.repos.ChecklistRepository(),
+			u.repos.ItemTemplateRepository(),
+			u.repos.TripRepository(),
+			u.repos.MemberRepository(),
+			u.repos.HistoryRepository(),
+			u.services.TransactionManager(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.checklistUsecase
+}
+
+func (u *Usecases) ItemTemplateUsecase() usecase.ItemTemplateUsecase {
+	if u.itemTemplateUsecase == nil {
+		u.itemTemplateUsecase = usecase.NewItemTemplateInteractor(
+			u.repos.ItemTemplateRepository(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.itemTemplateUsecase
+}
+
+func (u *Usecases) JournalUsecase() usecase.JournalUsecase {
+	if u.journalUsecase == nil {
+		u.journalUsecase = usecase.NewJournalInteractor(
+			u.repos.JournalRepository(),
+			u.repos.TripRepository(),
+			u.repos.MemberRepository(),
+			u.repos.HistoryRepository(),
+			u.services.TransactionManager(),
+			u.services.MarkdownRenderer(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.journalUsecase
+}
+
+func (u *Usecases) AttachmentUsecase() usecase.AttachmentUsecase {
+	if u.attachmentUsecase == nil {
+		u.attachmentUsecase = usecase.NewAttachmentInteractor(
+			u.repos.AttachmentRepository(),
+			u.repos.AccommodationRepository(),
+			u.repos.ExpenseRepository(),
+			u.repos.MemberRepository(),
+			u.services.BlobStore(),
+			u.services.Clock(),
+			u.services.IDService(),
+			int64(u.config.Attachment().MaxSizeBytes()),
+		)
+	}
+	return u.attachmentUsecase
+}
+
+func (u *Usecases) PhotoUsecase() usecase.PhotoUsecase {
+	if u.photoUsecase == nil {
+		u.photoUsecase = usecase.NewPhotoInteractor(
+			u.repos.PhotoRepository(),
+			u.repos.TripRepository(),
+			u.repos.JournalRepository(),
+			u.repos.MemberRepository(),
+			u.services.BlobStore(),
+			u.services.PhotoProcessor(),
+			u.services.Clock(),
+			u.services.IDService(),
+			int64(u.config.Attachment().MaxSizeBytes()),
+		)
+	}
+	return u.photoUsecase
+}
+
+func (u *Usecases) CalendarUsecase() usecase.CalendarUsecase {
+	if u.calendarUsecase == nil {
+		u.calendarUsecase = usecase.NewCalendarInteractor(
+			u.repos.CalendarFeedRepository(),
+			u.repos.TripRepository(),
+			u.repos.AccommodationRepository(),
+			u.repos.ActivityRepository(),
+			u.repos.TransportLegRepository(),
+			u.repos.MemberRepository(),
+			u.services.CalendarEncoder(),
+			u.services.ShareLinkSecretService(),
+			u.services.TransactionManager(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.calendarUsecase
+}
+
+func (u *Usecases) BookingImportUsecase() usecase.BookingImportUsecase {
+	if u.bookingImportUsecase == nil {
+		u.bookingImportUsecase = usecase.NewBookingImportInteractor(
+			u.services.BookingParser(),
+			u.repos.TripRepository(),
+			u.repos.AccommodationRepository(),
+			u.repos.TransportLegRepository(),
+			u.repos.ActivityRepository(),
+			u.repos.MemberRepository(),
+			u.repos.HistoryRepository(),
+			u.services.TransactionManager(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.bookingImportUsecase
+}
+
+func (u *Usecases) RouteUsecase() usecase.RouteUsecase {
+	if u.routeUsecase == nil {
+		u.routeUsecase = usecase.NewRouteInteractor(
+			u.repos.TripRepository(),
+			u.repos.ActivityRepository(),
+			u.repos.TransportLegRepository(),
+			u.repos.JournalRepository(),
+			u.repos.MemberRepository(),
+			u.services.RouteWriter(),
+			u.services.Clock(),
+		)
+	}
+	return u.routeUsecase
+}
+
+func (u *Usecases) TrackUsecase() usecase.TrackUsecase {
+	if u.trackUsecase == nil {
+		u.trackUsecase = usecase.NewTrackInteractor(
+			u.repos.TrackRepository(),
+			u.repos.ActivityRepository(),
+			u.repos.MemberRepository(),
+			u.services.TrackParser(),
+			u.services.Clock(),
+			u.services.IDService(),
+			int64(u.config.Attachment().MaxSizeBytes()),
+		)
+	}
+	return u.trackUsecase
+}
+
+func (u *Usecases) ItineraryUsecase() usecase.ItineraryUsecase {
+	if u.itineraryUsecase == nil {
+		u.itineraryUsecase = usecase.NewItineraryInteractor(
+			u.repos.ActivityRepository(),
+			u.repos.TripRepository(),
+			u.repos.MemberRepository(),
+			u.repos.HistoryRepository(),
+			u.services.TransactionManager(),
+			u.itinerarySpeeds(),
+			u.config.Itinerary().OptimizeTimeBudget(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.itineraryUsecase
+}
+
+func (u *Usecases) TransportUsecase() usecase.TransportUsecase {
+	if u.transportUsecase == nil {
+		u.transportUsecase = usecase.NewTransportInteractor(
+			u.repos.TransportLegRepository(),
+			u.repos.TripRepository(),
+			u.repos.MemberRepository(),
+			u.repos.HistoryRepository(),
+			u.services.TransactionManager(),
+			u.services.Clock(),
+			u.services.IDService(),
+		)
+	}
+	return u.transportUsecase
+}
+
+func (u *Usecases) ReferenceUsecase() usecase.ReferenceUsecase {
+	if u.referenceUsecase == nil {
+		u.referenceUsecase = usecase.NewReferenceInteractor(
+			u.repos.ReferenceRepository(),
+			referencedata.NewDataset(),
+			u.services.TransactionManager(),
+		)
+	}
+	return u.referenceUsecase
+}
+
+func (u *Usecases) TimelineUsecase() usecase.TimelineUsecase {
+	if u.timelineUsecase == nil {
+		u.timelineUsecase = usecase.NewTimelineInteractor(
+			u.repos.TripRepository(),
+			u.repos.ActivityRepository(),
+			u.repos.TransportLegRepository(),
+			u.repos.AccommodationRepository(),
+			u.repos.JournalRepository(),
+			u.repos.MemberRepository(),
+		)
+	}
+	return u.timelineUsecase
+}
+
+// itinerarySpeeds は設定から移動手段ごとの平均の速さを作成する。
+// 設定の読み込み時に正の値であることを検証しているため、作成に失敗した場合は既定値を使う
+func (u *Usecases) itinerarySpeeds() itinerary.Speeds {
+	cfg := u.config.Itinerary()
+	speeds, err := itinerary.NewSpeeds(map[itinerary.TravelMode]float64{
+		itinerary.TravelModeWalk:    cfg.WalkSpeedKmh(),
+		itinerary.TravelModeBicycle: cfg.BicycleSpeedKmh(),
+		itinerary.TravelModeCar:     cfg.CarSpeedKmh(),
+		itinerary.TravelModeTransit: cfg.TransitSpeedKmh(),
+	})
+	if err != nil {
+		return itinerary.DefaultSpeeds()
+	}
+	return speeds
+}
+
+func (u *Usecases) AuthUsecase() usecase.AuthUsecase {
 	if u.authUsecase == nil {
 		u.authUsecase = usecase.NewAuthInteractor(
 			u.repos.UserRepository(),
 			u.repos.RefreshTokenRepository(),
 			u.repos.RevokedTokenRepository(),
 			u.services.Clock(),
-			u.services.UUIDGenerator(),
+			u.services.IDService(),
 			u.services.TransactionManager(),
-			u.config.JWT().Secret(),
+			u.services.TokenService(),
+			u.services.PasswordService(),
+			&usecase.AuthSettings{
+				RefreshTokenExpiration: u.config.JWT().RefreshTokenExpiration(),
+			},
 		)
 	}
 	return u.authUsecase
@@ -0,0 +1,150 @@
+package ecb
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// BaseCurrency は ECB の参照レートの基準通貨
+const BaseCurrency = "EUR"
+
+const dateLayout = "2006-01-02"
+
+// FeedParser は ECB が配布する参照レートファイル（eurofxref の XML / CSV）の解析を行う
+type FeedParser struct{}
+
+func NewFeedParser() service.ExchangeRateFeedParser {
+	return &FeedParser{}
+}
+
+// Parse はファイル形式に応じて参照レートを解析する。
+// 解析できない内容はバリデーションエラーとして返す
+func (p *FeedParser) Parse(r io.Reader, format string) ([]*exchangerate.ExchangeRate, error) {
+	var (
+		rates []*exchangerate.ExchangeRate
+		err   error
+	)
+	switch format {
+	case service.ExchangeRateFeedFormatXML:
+		rates, err = parseXML(r)
+	case service.ExchangeRateFeedFormatCSV:
+		rates, err = parseCSV(r)
+	default:
+		return nil, apperr.NewValidationError(fmt.Sprintf("Unsupported exchange rate file format: %s", format))
+	}
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewValidationError("Failed to parse exchange rate file", apperr.WithCause(err))
+	}
+	return rates, nil
+}
+
+// envelope は eurofxref-daily.xml / eurofxref-hist.xml の構造。
+// <Cube><Cube time="..."><Cube currency="USD" rate="1.0703"/></Cube></Cube> の入れ子になっている
+type envelope struct {
+	Cube struct {
+		Days []struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string `xml:"currency,attr"`
+				Rate     string `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	} `xml:"Cube"`
+}
+
+func parseXML(r io.Reader) ([]*exchangerate.ExchangeRate, error) {
+	var doc envelope
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	var rates []*exchangerate.ExchangeRate
+	for _, day := range doc.Cube.Days {
+		date, err := time.Parse(dateLayout, day.Time)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range day.Rates {
+			rate, err := newExchangeRate(date, entry.Currency, entry.Rate)
+			if err != nil {
+				return nil, err
+			}
+			rates = append(rates, rate)
+		}
+	}
+
+	if len(rates) == 0 {
+		return nil, errors.New("no exchange rates found")
+	}
+	return rates, nil
+}
+
+// parseCSV は eurofxref-hist.csv 形式（1列目が日付、以降の列が通貨ごとのレート）を解析する。
+// 値が "N/A" や空の列はその日のレートがないものとして読み飛ばす
+func parseCSV(r io.Reader) ([]*exchangerate.ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	// ECB のファイルは行末にカンマが付くことがあるため、列数は検証しない
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if len(header) < 2 || !strings.EqualFold(strings.TrimSpace(header[0]), "Date") {
+		return nil, errors.New("missing Date header")
+	}
+
+	var rates []*exchangerate.ExchangeRate
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		date, err := time.Parse(dateLayout, strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, err
+		}
+		for i := 1; i < len(record) && i < len(header); i++ {
+			currency := strings.TrimSpace(header[i])
+			value := strings.TrimSpace(record[i])
+			if currency == "" || value == "" || value == "N/A" {
+				continue
+			}
+			rate, err := newExchangeRate(date, currency, value)
+			if err != nil {
+				return nil, err
+			}
+			rates = append(rates, rate)
+		}
+	}
+
+	if len(rates) == 0 {
+		return nil, errors.New("no exchange rates found")
+	}
+	return rates, nil
+}
+
+func newExchangeRate(date time.Time, currency, decimal string) (*exchangerate.ExchangeRate, error) {
+	rate, err := exchangerate.NewRate(decimal)
+	if err != nil {
+		return nil, err
+	}
+	return exchangerate.NewExchangeRate(date, BaseCurrency, currency, rate)
+}
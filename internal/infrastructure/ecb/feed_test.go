@@ -0,0 +1,81 @@
+package ecb
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleXML = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time="2024-05-02">
+			<Cube currency="USD" rate="1.0723"/>
+			<Cube currency="JPY" rate="165.62"/>
+		</Cube>
+		<Cube time="2024-04-30">
+			<Cube currency="USD" rate="1.0665"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+const sampleCSV = `Date, USD, JPY, CYP, 
+2024-05-02, 1.0723, 165.62, N/A, 
+2024-04-30, 1.0665, 168.46, N/A, 
+`
+
+func TestFeedParser_Parse(t *testing.T) {
+	parser := NewFeedParser()
+
+	t.Run("正常系: XML形式", func(t *testing.T) {
+		rates, err := parser.Parse(strings.NewReader(sampleXML), service.ExchangeRateFeedFormatXML)
+
+		require.NoError(t, err)
+		require.Len(t, rates, 3, "すべての日付と通貨のレートが読み込まれるべき")
+		assert.Equal(t, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), rates[0].Date())
+		assert.Equal(t, "EUR", rates[0].BaseCurrency(), "基準通貨は EUR であるべき")
+		assert.Equal(t, "USD", rates[0].QuoteCurrency())
+		assert.Equal(t, "1.0723", rates[0].Rate().String())
+		assert.Equal(t, "JPY", rates[1].QuoteCurrency())
+		assert.Equal(t, time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC), rates[2].Date())
+	})
+
+	t.Run("正常系: CSV形式では N/A を読み飛ばす", func(t *testing.T) {
+		rates, err := parser.Parse(strings.NewReader(sampleCSV), service.ExchangeRateFeedFormatCSV)
+
+		require.NoError(t, err)
+		require.Len(t, rates, 4, "N/A と末尾の空列は読み飛ばされるべき")
+		assert.Equal(t, "USD", rates[0].QuoteCurrency())
+		assert.Equal(t, "165.62", rates[1].Rate().String())
+		assert.Equal(t, time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC), rates[3].Date())
+	})
+
+	tests := []struct {
+		name   string
+		body   string
+		format string
+	}{
+		{name: "未対応の形式", body: sampleCSV, format: "json"},
+		{name: "壊れたXML", body: "<Cube>", format: service.ExchangeRateFeedFormatXML},
+		{name: "レートを含まないXML", body: "<Envelope><Cube></Cube></Envelope>", format: service.ExchangeRateFeedFormatXML},
+		{name: "Date列がないCSV", body: "USD,JPY\n1.0,160\n", format: service.ExchangeRateFeedFormatCSV},
+		{name: "日付が不正なCSV", body: "Date,USD\n02/05/2024,1.07\n", format: service.ExchangeRateFeedFormatCSV},
+		{name: "レートが不正なCSV", body: "Date,USD\n2024-05-02,-1\n", format: service.ExchangeRateFeedFormatCSV},
+	}
+	for _, tt := range tests {
+		t.Run("異常系: "+tt.name, func(t *testing.T) {
+			_, err := parser.Parse(strings.NewReader(tt.body), tt.format)
+
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError), "解析できない内容はバリデーションエラーになるべき")
+		})
+	}
+}
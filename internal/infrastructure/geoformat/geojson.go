@@ -0,0 +1,88 @@
+package geoformat
+
+import (
+	"bufio"
+	"encoding/json"
+
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// geoJSONFeature は RFC 7946 の Feature
+type geoJSONFeature struct {
+	Type       string          `json:"type"`
+	ID         string          `json:"id,omitempty"`
+	Geometry   geoJSONGeometry `json:"geometry"`
+	Properties map[string]any  `json:"properties"`
+}
+
+type geoJSONGeometry struct {
+	Type        string `json:"type"`
+	Coordinates any    `json:"coordinates"`
+}
+
+// writeGeoJSON は地点ごとの Point と、2 地点以上ある場合は経路全体の LineString を FeatureCollection として書き出す。
+// 座標は RFC 7946 に従い [経度, 緯度] の順とする
+func writeGeoJSON(w *bufio.Writer, route service.Route) error {
+	name, err := json.Marshal(route.Name)
+	if err != nil {
+		return err
+	}
+	w.WriteString(`{"type":"FeatureCollection","name":`)
+	w.Write(name)
+	w.WriteString(`,"features":[`)
+
+	for i, stop := range route.Stops {
+		properties := map[string]any{
+			"name":  stop.Name,
+			"kind":  stop.Kind,
+			"date":  stop.Date.Format(dateLayout),
+			"order": stop.Order,
+			"day":   nil,
+		}
+		if stop.Day > 0 {
+			properties["day"] = stop.Day
+		}
+		if stop.Description != "" {
+			properties["description"] = stop.Description
+		}
+
+		if i > 0 {
+			w.WriteByte(',')
+		}
+		if err := writeJSON(w, geoJSONFeature{
+			Type:       "Feature",
+			ID:         stop.ID,
+			Geometry:   geoJSONGeometry{Type: "Point", Coordinates: [2]float64{stop.Longitude, stop.Latitude}},
+			Properties: properties,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(route.Stops) >= 2 {
+		coordinates := make([][2]float64, len(route.Stops))
+		for i, stop := range route.Stops {
+			coordinates[i] = [2]float64{stop.Longitude, stop.Latitude}
+		}
+		w.WriteByte(',')
+		if err := writeJSON(w, geoJSONFeature{
+			Type:       "Feature",
+			Geometry:   geoJSONGeometry{Type: "LineString", Coordinates: coordinates},
+			Properties: map[string]any{"name": route.Name, "kind": "route"},
+		}); err != nil {
+			return err
+		}
+	}
+
+	_, err = w.WriteString("]}\n")
+	return err
+}
+
+func writeJSON(w *bufio.Writer, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
@@ -0,0 +1,51 @@
+package geoformat
+
+import (
+	"bufio"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+const gpxNamespace = "http://www.topografix.com/GPX/1/1"
+
+// writeGPX は地点を wpt として書き出し、2 地点以上ある場合は訪れる順の rte を続けて書き出す。
+// GPX 1.1 の要素の順序（metadata, wpt, rte）に従う
+func writeGPX(w *bufio.Writer, route service.Route) error {
+	w.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	w.WriteString(`<gpx version="1.1" creator="` + Creator + `" xmlns="` + gpxNamespace + `">`)
+	w.WriteString("<metadata>")
+	writeElement(w, "name", route.Name)
+	if !route.GeneratedAt.IsZero() {
+		writeElement(w, "time", route.GeneratedAt.UTC().Format(time.RFC3339))
+	}
+	w.WriteString("</metadata>")
+
+	for _, stop := range route.Stops {
+		w.WriteString(pointStart("wpt", stop))
+		writeElement(w, "name", stop.Name)
+		if stop.Description != "" {
+			writeElement(w, "desc", stop.Description)
+		}
+		writeElement(w, "type", stop.Kind)
+		w.WriteString("</wpt>")
+	}
+
+	if len(route.Stops) >= 2 {
+		w.WriteString("<rte>")
+		writeElement(w, "name", route.Name)
+		for _, stop := range route.Stops {
+			w.WriteString(pointStart("rtept", stop))
+			writeElement(w, "name", stop.Name)
+			w.WriteString("</rtept>")
+		}
+		w.WriteString("</rte>")
+	}
+
+	_, err := w.WriteString("</gpx>\n")
+	return err
+}
+
+func pointStart(name string, stop service.RouteStop) string {
+	return "<" + name + ` lat="` + formatCoordinate(stop.Latitude) + `" lon="` + formatCoordinate(stop.Longitude) + `">`
+}
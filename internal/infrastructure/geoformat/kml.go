@@ -0,0 +1,83 @@
+package geoformat
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+const kmlNamespace = "http://www.opengis.net/kml/2.2"
+
+// writeKML は日付ごとの Folder に地点の Placemark をまとめ、2 地点以上ある場合は経路全体の LineString を続けて書き出す
+func writeKML(w *bufio.Writer, route service.Route) error {
+	w.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	w.WriteString(`<kml xmlns="` + kmlNamespace + `"><Document>`)
+	writeElement(w, "name", route.Name)
+
+	for i, stop := range route.Stops {
+		if i == 0 || !stop.Date.Equal(route.Stops[i-1].Date) {
+			if i > 0 {
+				w.WriteString("</Folder>")
+			}
+			w.WriteString("<Folder>")
+			writeElement(w, "name", folderName(stop))
+		}
+
+		w.WriteString("<Placemark" + idAttr(stop.ID) + ">")
+		writeElement(w, "name", stop.Name)
+		if stop.Description != "" {
+			writeElement(w, "description", stop.Description)
+		}
+		w.WriteString("<ExtendedData>")
+		writeData(w, "kind", stop.Kind)
+		writeData(w, "date", stop.Date.Format(dateLayout))
+		writeData(w, "order", fmt.Sprint(stop.Order))
+		if stop.Day > 0 {
+			writeData(w, "day", fmt.Sprint(stop.Day))
+		}
+		w.WriteString("</ExtendedData>")
+		w.WriteString("<Point><coordinates>" + formatCoordinate(stop.Longitude) + "," + formatCoordinate(stop.Latitude) + "</coordinates></Point>")
+		w.WriteString("</Placemark>")
+	}
+	if len(route.Stops) > 0 {
+		w.WriteString("</Folder>")
+	}
+
+	if len(route.Stops) >= 2 {
+		w.WriteString("<Placemark>")
+		writeElement(w, "name", route.Name)
+		w.WriteString("<LineString><tessellate>1</tessellate><coordinates>")
+		for i, stop := range route.Stops {
+			if i > 0 {
+				w.WriteByte(' ')
+			}
+			w.WriteString(formatCoordinate(stop.Longitude) + "," + formatCoordinate(stop.Latitude))
+		}
+		w.WriteString("</coordinates></LineString></Placemark>")
+	}
+
+	_, err := w.WriteString("</Document></kml>\n")
+	return err
+}
+
+// folderName は地点の日付のフォルダ名を返す。旅行期間内であれば何日目かを含める
+func folderName(stop service.RouteStop) string {
+	if stop.Day > 0 {
+		return fmt.Sprintf("Day %d (%s)", stop.Day, stop.Date.Format(dateLayout))
+	}
+	return stop.Date.Format(dateLayout)
+}
+
+func writeData(w *bufio.Writer, name, value string) {
+	w.WriteString(`<Data name="` + escapeAttr(name) + `">`)
+	writeElement(w, "value", value)
+	w.WriteString("</Data>")
+}
+
+func idAttr(id string) string {
+	if id == "" {
+		return ""
+	}
+	return ` id="` + escapeAttr(id) + `"`
+}
@@ -0,0 +1,50 @@
+package geoformat
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// Creator は書き出したファイルを作成したアプリケーションとして記録する名前
+const Creator = "travel-api"
+
+const dateLayout = "2006-01-02"
+
+// RouteWriter は旅行の経路を地図アプリで読み込める形式（GeoJSON / KML / GPX）で書き出す
+type RouteWriter struct{}
+
+func NewRouteWriter() service.RouteWriter {
+	return &RouteWriter{}
+}
+
+// Write は経路を指定された形式で書き出す。
+// 書き込みはバッファを経由して地点ごとに行い、途中で失敗した場合はそのエラーを返す
+func (rw *RouteWriter) Write(w io.Writer, route service.Route, format string) error {
+	bw := bufio.NewWriter(w)
+
+	var err error
+	switch format {
+	case service.RouteFormatGeoJSON:
+		err = writeGeoJSON(bw, route)
+	case service.RouteFormatKML:
+		err = writeKML(bw, route)
+	case service.RouteFormatGPX:
+		err = writeGPX(bw, route)
+	default:
+		return apperr.NewValidationError(fmt.Sprintf("Unsupported route format: %s", format))
+	}
+	if err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// formatCoordinate は座標の値を丸めずに最短の10進数表記で返す
+func formatCoordinate(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,141 @@
+package geoformat
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRoute() service.Route {
+	day1 := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	return service.Route{
+		Name: "京都 & 大阪",
+		Stops: []service.RouteStop{
+			{ID: "entry-1", Name: "伏見稲荷大社", Kind: "place", Date: day1, Day: 1, Order: 1, Latitude: 34.9671, Longitude: 135.7727},
+			{ID: "entry-2", Name: "清水寺 <本堂>", Kind: "place", Description: "夕方", Date: day1, Day: 1, Order: 2, Latitude: 34.9949, Longitude: 135.785},
+			{ID: "entry-3", Name: "大阪城", Kind: "place", Date: day2, Day: 2, Order: 3, Latitude: 34.6873, Longitude: 135.5262},
+		},
+		GeneratedAt: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func writeRoute(t *testing.T, route service.Route, format string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, NewRouteWriter().Write(&buf, route, format))
+	return buf.Bytes()
+}
+
+func TestRouteWriter_Write(t *testing.T) {
+	t.Run("正常系: GeoJSON は地点ごとの Point と経路の LineString を含む", func(t *testing.T) {
+		content := writeRoute(t, newTestRoute(), service.RouteFormatGeoJSON)
+
+		var got struct {
+			Type     string `json:"type"`
+			Features []struct {
+				ID       string `json:"id"`
+				Geometry struct {
+					Type        string          `json:"type"`
+					Coordinates json.RawMessage `json:"coordinates"`
+				} `json:"geometry"`
+				Properties map[string]any `json:"properties"`
+			} `json:"features"`
+		}
+		require.NoError(t, json.Unmarshal(content, &got), "正しい JSON であるべき")
+		assert.Equal(t, "FeatureCollection", got.Type)
+		require.Len(t, got.Features, 4)
+		assert.Equal(t, "entry-1", got.Features[0].ID)
+		assert.Equal(t, "Point", got.Features[0].Geometry.Type)
+		assert.JSONEq(t, `[135.7727,34.9671]`, string(got.Features[0].Geometry.Coordinates), "座標は経度、緯度の順であるべき")
+		assert.Equal(t, float64(1), got.Features[0].Properties["day"])
+		assert.Equal(t, float64(3), got.Features[2].Properties["order"])
+		assert.Equal(t, "2026-03-08", got.Features[2].Properties["date"])
+		assert.Equal(t, "LineString", got.Features[3].Geometry.Type)
+	})
+
+	t.Run("正常系: KML は日付ごとのフォルダに分ける", func(t *testing.T) {
+		content := writeRoute(t, newTestRoute(), service.RouteFormatKML)
+
+		var got struct {
+			Document struct {
+				Name    string `xml:"name"`
+				Folders []struct {
+					Name       string `xml:"name"`
+					Placemarks []struct {
+						ID          string `xml:"id,attr"`
+						Name        string `xml:"name"`
+						Coordinates string `xml:"Point>coordinates"`
+					} `xml:"Placemark"`
+				} `xml:"Folder"`
+				Route struct {
+					Coordinates string `xml:"LineString>coordinates"`
+				} `xml:"Placemark"`
+			} `xml:"Document"`
+		}
+		require.NoError(t, xml.Unmarshal(content, &got), "正しい XML であるべき")
+		assert.Equal(t, "京都 & 大阪", got.Document.Name)
+		require.Len(t, got.Document.Folders, 2)
+		assert.Equal(t, "Day 1 (2026-03-07)", got.Document.Folders[0].Name)
+		require.Len(t, got.Document.Folders[0].Placemarks, 2)
+		assert.Equal(t, "清水寺 <本堂>", got.Document.Folders[0].Placemarks[1].Name)
+		assert.Equal(t, "135.7727,34.9671", got.Document.Folders[0].Placemarks[0].Coordinates)
+		assert.Equal(t, "135.7727,34.9671 135.785,34.9949 135.5262,34.6873", got.Document.Route.Coordinates)
+	})
+
+	t.Run("正常系: GPX は地点の wpt と経路の rte を含む", func(t *testing.T) {
+		content := writeRoute(t, newTestRoute(), service.RouteFormatGPX)
+
+		type point struct {
+			Lat  float64 `xml:"lat,attr"`
+			Lon  float64 `xml:"lon,attr"`
+			Name string  `xml:"name"`
+		}
+		var got struct {
+			Creator   string  `xml:"creator,attr"`
+			Time      string  `xml:"metadata>time"`
+			Waypoints []point `xml:"wpt"`
+			Route     []point `xml:"rte>rtept"`
+		}
+		require.NoError(t, xml.Unmarshal(content, &got), "正しい XML であるべき")
+		assert.Equal(t, Creator, got.Creator)
+		assert.Equal(t, "2026-03-01T09:00:00Z", got.Time)
+		require.Len(t, got.Waypoints, 3)
+		assert.Equal(t, point{Lat: 34.6873, Lon: 135.5262, Name: "大阪城"}, got.Waypoints[2])
+		assert.Len(t, got.Route, 3)
+	})
+
+	t.Run("正常系: 地点がない場合も空の経路として書き出す", func(t *testing.T) {
+		route := service.Route{Name: "Empty"}
+
+		assert.JSONEq(t, `{"type":"FeatureCollection","name":"Empty","features":[]}`, string(writeRoute(t, route, service.RouteFormatGeoJSON)))
+		assert.NotContains(t, string(writeRoute(t, route, service.RouteFormatKML)), "<Folder>")
+		assert.NotContains(t, string(writeRoute(t, route, service.RouteFormatGPX)), "<rte>")
+	})
+
+	t.Run("異常系: 書き込み先のエラーを返す", func(t *testing.T) {
+		err := NewRouteWriter().Write(failingWriter{}, newTestRoute(), service.RouteFormatGPX)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系: 未対応の形式はバリデーションエラー", func(t *testing.T) {
+		err := NewRouteWriter().Write(&bytes.Buffer{}, newTestRoute(), "shp")
+
+		assert.ErrorIs(t, err, apperr.NewValidationError(""))
+	})
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
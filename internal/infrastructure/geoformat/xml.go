@@ -0,0 +1,31 @@
+package geoformat
+
+import (
+	"bufio"
+	"encoding/xml"
+)
+
+// writeElement は <name>text</name> の形で、text をエスケープした要素を書き出す
+func writeElement(w *bufio.Writer, name, text string) {
+	w.WriteString("<" + name + ">")
+	xml.EscapeText(w, []byte(text))
+	w.WriteString("</" + name + ">")
+}
+
+// escapeAttr は属性値としてエスケープした文字列を返す
+func escapeAttr(s string) string {
+	var b []byte
+	for _, r := range s {
+		switch r {
+		case '&':
+			b = append(b, "&amp;"...)
+		case '<':
+			b = append(b, "&lt;"...)
+		case '"':
+			b = append(b, "&quot;"...)
+		default:
+			b = append(b, string(r)...)
+		}
+	}
+	return string(b)
+}
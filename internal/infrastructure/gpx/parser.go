@@ -0,0 +1,140 @@
+package gpx
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/track"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// trk は GPX の trk 要素。GPX 1.0 と 1.1 で要素名は同じため、名前空間は区別しない
+type trk struct {
+	Name     string `xml:"name"`
+	Segments []struct {
+		Points []trkpt `xml:"trkpt"`
+	} `xml:"trkseg"`
+}
+
+type trkpt struct {
+	Lat  *float64 `xml:"lat,attr"`
+	Lon  *float64 `xml:"lon,attr"`
+	Ele  *float64 `xml:"ele"`
+	Time *string  `xml:"time"`
+}
+
+// Parser は GPS 機器やアプリが書き出す GPX ファイルの解析を行う
+type Parser struct{}
+
+func NewParser() service.TrackParser {
+	return &Parser{}
+}
+
+// Parse は GPX ファイルのトラック（trk）のセグメントを読み込む。
+// ルート（rte）とウェイポイント（wpt）は記録した軌跡ではないため読み飛ばす
+func (p *Parser) Parse(r io.Reader) (*service.ParsedTrack, error) {
+	parsed, err := parse(r)
+	if err != nil {
+		return nil, track.NewInvalidFileError(apperr.WithCause(err))
+	}
+	return parsed, nil
+}
+
+func parse(r io.Reader) (*service.ParsedTrack, error) {
+	decoder := xml.NewDecoder(r)
+	parsed := &service.ParsedTrack{}
+	root := true
+	var trackName string
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		if root {
+			if start.Name.Local != "gpx" {
+				return nil, errors.New("root element must be gpx")
+			}
+			root = false
+			continue
+		}
+
+		switch start.Name.Local {
+		case "metadata":
+			var metadata struct {
+				Name string `xml:"name"`
+			}
+			if err := decoder.DecodeElement(&metadata, &start); err != nil {
+				return nil, err
+			}
+			parsed.Name = strings.TrimSpace(metadata.Name)
+		case "trk":
+			var t trk
+			if err := decoder.DecodeElement(&t, &start); err != nil {
+				return nil, err
+			}
+			if trackName == "" {
+				trackName = strings.TrimSpace(t.Name)
+			}
+			for _, s := range t.Segments {
+				segment, err := toSegment(s.Points)
+				if err != nil {
+					return nil, err
+				}
+				parsed.Segments = append(parsed.Segments, segment)
+			}
+		case "wpt", "rte", "extensions":
+			if err := decoder.Skip(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if root {
+		return nil, errors.New("root element must be gpx")
+	}
+
+	// GPX 1.0 では metadata がなく、名前は trk にだけ記録されることが多い
+	if parsed.Name == "" {
+		parsed.Name = trackName
+	}
+	return parsed, nil
+}
+
+func toSegment(points []trkpt) (track.Segment, error) {
+	segment := make(track.Segment, 0, len(points))
+	for _, pt := range points {
+		if pt.Lat == nil || pt.Lon == nil {
+			return nil, errors.New("trkpt must have lat and lon attributes")
+		}
+		coordinate, err := geo.NewCoordinate(*pt.Lat, *pt.Lon)
+		if err != nil {
+			return nil, err
+		}
+
+		var recordedAt *time.Time
+		if pt.Time != nil {
+			v, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(*pt.Time))
+			if err != nil {
+				return nil, err
+			}
+			v = v.UTC()
+			recordedAt = &v
+		}
+
+		segment = append(segment, track.NewPoint(coordinate, pt.Ele, recordedAt))
+	}
+	return segment, nil
+}
@@ -0,0 +1,86 @@
+package gpx
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGPX = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="Test" xmlns="http://www.topografix.com/GPX/1/1">
+  <metadata><name> 高尾山ハイキング </name></metadata>
+  <wpt lat="35.6251" lon="139.2435"><name>山頂</name></wpt>
+  <trk>
+    <name>Track 1</name>
+    <trkseg>
+      <trkpt lat="35.6322" lon="139.2701"><ele> 201.5 </ele><time>2024-05-01T09:00:00+09:00</time></trkpt>
+      <trkpt lat="35.6310" lon="139.2650"><ele>250</ele><time>2024-05-01T09:10:00+09:00</time></trkpt>
+    </trkseg>
+    <trkseg>
+      <trkpt lat="35.6251" lon="139.2435"></trkpt>
+    </trkseg>
+  </trk>
+  <trk>
+    <trkseg>
+      <trkpt lat="35.6260" lon="139.2440"><time>2024-05-01T11:00:00Z</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+func TestParser_Parse(t *testing.T) {
+	t.Run("正常系: すべてのトラックのセグメントを順に読み込む", func(t *testing.T) {
+		got, err := NewParser().Parse(strings.NewReader(testGPX))
+
+		require.NoError(t, err)
+		assert.Equal(t, "高尾山ハイキング", got.Name)
+		require.Len(t, got.Segments, 3, "wpt は読み込まないべき")
+		require.Len(t, got.Segments[0], 2)
+
+		first := got.Segments[0][0]
+		assert.Equal(t, 35.6322, first.Coordinate().Latitude())
+		assert.Equal(t, 139.2701, first.Coordinate().Longitude())
+		require.NotNil(t, first.Elevation())
+		assert.Equal(t, 201.5, *first.Elevation())
+		require.NotNil(t, first.RecordedAt())
+		assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), *first.RecordedAt())
+
+		assert.Nil(t, got.Segments[1][0].Elevation())
+		assert.Nil(t, got.Segments[1][0].RecordedAt())
+		assert.Equal(t, time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC), *got.Segments[2][0].RecordedAt())
+	})
+
+	t.Run("正常系: metadata に名前がない場合は最初のトラックの名前を使う", func(t *testing.T) {
+		content := `<gpx version="1.0"><trk><name>Morning Walk</name><trkseg><trkpt lat="0" lon="0"/></trkseg></trk></gpx>`
+
+		got, err := NewParser().Parse(strings.NewReader(content))
+
+		require.NoError(t, err)
+		assert.Equal(t, "Morning Walk", got.Name)
+		assert.Len(t, got.Segments, 1)
+	})
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "XML でない", content: "not xml"},
+		{name: "ルート要素が gpx でない", content: `<kml><Document/></kml>`},
+		{name: "空のファイル", content: ""},
+		{name: "緯度がない", content: `<gpx><trk><trkseg><trkpt lon="0"/></trkseg></trk></gpx>`},
+		{name: "緯度が範囲外", content: `<gpx><trk><trkseg><trkpt lat="91" lon="0"/></trkseg></trk></gpx>`},
+		{name: "記録日時の形式が不正", content: `<gpx><trk><trkseg><trkpt lat="0" lon="0"><time>yesterday</time></trkpt></trkseg></trk></gpx>`},
+		{name: "閉じていない要素", content: `<gpx><trk><trkseg>`},
+	}
+	for _, tt := range tests {
+		t.Run("異常系: "+tt.name, func(t *testing.T) {
+			got, err := NewParser().Parse(strings.NewReader(tt.content))
+
+			assert.Nil(t, got)
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+		})
+	}
+}
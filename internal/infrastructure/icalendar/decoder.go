@@ -0,0 +1,349 @@
+package icalendar
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNotCalendar は入力が VCALENDAR を含まず、iCalendar として読み取れないことを表す
+var ErrNotCalendar = errors.New("not an iCalendar file")
+
+// durationPattern は DURATION 型の値（P1DT2H30M、P2W など）
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// Event は iCalendar から読み取った VEVENT
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	// AllDay が true の場合 Start と End は UTC の 0 時で日付だけを表し、End は最終日の翌日となる
+	AllDay bool
+	Start  time.Time
+	End    time.Time
+}
+
+// property はパラメーター付きのコンテンツ行
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Decode は iCalendar を読み込み、含まれる VEVENT を出現順に返す。
+// TZID 付きの日時は IANA のタイムゾーン名として解釈し、解釈できない場合は同じファイルの VTIMEZONE の標準時のオフセットを使う。
+// タイムゾーンのない現地時刻（floating）は UTC として扱う
+func Decode(r io.Reader) ([]Event, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		found    bool
+		stack    []string
+		events   [][]property
+		current  []property
+		tzid     string
+		offsets  = map[string]int{}
+		observed string
+	)
+	for _, line := range lines {
+		p, ok := parseContentLine(line)
+		if !ok {
+			continue
+		}
+
+		switch p.name {
+		case "BEGIN":
+			component := strings.ToUpper(p.value)
+			stack = append(stack, component)
+			switch component {
+			case "VCALENDAR":
+				found = true
+			case "VEVENT":
+				current = nil
+			case "VTIMEZONE":
+				tzid = ""
+			case "STANDARD", "DAYLIGHT":
+				observed = component
+			}
+			continue
+		case "END":
+			if len(stack) > 0 {
+				if stack[len(stack)-1] == "VEVENT" {
+					events = append(events, current)
+				}
+				stack = stack[:len(stack)-1]
+			}
+			continue
+		}
+
+		if len(stack) == 0 {
+			continue
+		}
+		switch stack[len(stack)-1] {
+		case "VEVENT":
+			current = append(current, p)
+		case "VTIMEZONE":
+			if p.name == "TZID" {
+				tzid = p.value
+			}
+		case "STANDARD", "DAYLIGHT":
+			// 夏時間のない期間のオフセットとして標準時を優先し、標準時がない場合だけ夏時間を使う
+			if _, seen := offsets[tzid]; p.name == "TZOFFSETTO" && tzid != "" && (!seen || observed == "STANDARD") {
+				if offset, err := parseOffset(p.value); err == nil {
+					offsets[tzid] = offset
+				}
+			}
+		}
+	}
+
+	if !found {
+		return nil, ErrNotCalendar
+	}
+
+	result := make([]Event, 0, len(events))
+	for _, props := range events {
+		event, err := newEvent(props, offsets)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, event)
+	}
+	return result, nil
+}
+
+// newEvent は VEVENT のプロパティから予定を作成する。DTEND がない場合は DURATION、それもない場合は
+// 終日の予定なら翌日、時刻のある予定なら開始と同じ日時を終了とする
+func newEvent(props []property, offsets map[string]int) (Event, error) {
+	var (
+		event    Event
+		start    *property
+		end      *property
+		duration string
+	)
+	for i := range props {
+		p := &props[i]
+		switch p.name {
+		case "UID":
+			event.UID = p.value
+		case "SUMMARY":
+			event.Summary = unescapeText(p.value)
+		case "DESCRIPTION":
+			event.Description = unescapeText(p.value)
+		case "LOCATION":
+			event.Location = unescapeText(p.value)
+		case "DTSTART":
+			start = p
+		case "DTEND":
+			end = p
+		case "DURATION":
+			duration = p.value
+		}
+	}
+
+	if start == nil {
+		return Event{}, fmt.Errorf("event %q has no DTSTART", event.UID)
+	}
+
+	var err error
+	event.Start, event.AllDay, err = parseDateTime(*start, offsets)
+	if err != nil {
+		return Event{}, err
+	}
+
+	switch {
+	case end != nil:
+		event.End, _, err = parseDateTime(*end, offsets)
+		if err != nil {
+			return Event{}, err
+		}
+	case duration != "":
+		d, err := parseDuration(duration)
+		if err != nil {
+			return Event{}, err
+		}
+		event.End = event.Start.Add(d)
+	case event.AllDay:
+		event.End = event.Start.AddDate(0, 0, 1)
+	default:
+		event.End = event.Start
+	}
+
+	return event, nil
+}
+
+// unfoldLines は入力を行に分割し、空白で始まる継続行を前の行につなげる
+func unfoldLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// parseContentLine は "NAME;PARAM=VALUE:値" 形式のコンテンツ行を解析する。パラメーターの値は引用符で囲まれていてもよい
+func parseContentLine(line string) (property, bool) {
+	p := property{params: map[string]string{}}
+
+	i := strings.IndexAny(line, ";:")
+	if i <= 0 {
+		return property{}, false
+	}
+	p.name = strings.ToUpper(line[:i])
+
+	for line[i] == ';' {
+		rest := line[i+1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq < 0 {
+			return property{}, false
+		}
+		key := strings.ToUpper(rest[:eq])
+		rest = rest[eq+1:]
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			closing := strings.IndexByte(rest[1:], '"')
+			if closing < 0 {
+				return property{}, false
+			}
+			value = rest[1 : closing+1]
+			rest = rest[closing+2:]
+		} else {
+			j := strings.IndexAny(rest, ";:")
+			if j < 0 {
+				return property{}, false
+			}
+			value = rest[:j]
+			rest = rest[j:]
+		}
+		p.params[key] = value
+
+		i = len(line) - len(rest)
+		if i >= len(line) {
+			return property{}, false
+		}
+	}
+
+	p.value = line[i+1:]
+	return p, true
+}
+
+// parseDateTime は DATE または DATE-TIME 型の値を解析し、終日（DATE 型）かどうかも返す
+func parseDateTime(p property, offsets map[string]int) (time.Time, bool, error) {
+	value := p.value
+	if p.params["VALUE"] == "DATE" || len(value) == len(dateLayout) {
+		t, err := time.Parse(dateLayout, value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid %s: %w", p.name, err)
+		}
+		return t, true, nil
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(utcLayout, value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid %s: %w", p.name, err)
+		}
+		return t, false, nil
+	}
+
+	loc := time.UTC
+	if tzid := p.params["TZID"]; tzid != "" {
+		loc = resolveTimezone(tzid, offsets)
+	}
+	t, err := time.ParseInLocation(localLayout, value, loc)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid %s: %w", p.name, err)
+	}
+	return t.UTC(), false, nil
+}
+
+// resolveTimezone は TZID をタイムゾーンに変換する。IANA の名前として解釈できない場合は VTIMEZONE のオフセットを、
+// それもない場合は UTC を返す
+func resolveTimezone(tzid string, offsets map[string]int) *time.Location {
+	if name := strings.TrimPrefix(tzid, "/"); name != "Local" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+	if offset, ok := offsets[tzid]; ok {
+		return time.FixedZone(tzid, offset)
+	}
+	return time.UTC
+}
+
+// parseOffset は UTC-OFFSET 型の値（+0900、-043000 など）を秒に変換する
+func parseOffset(value string) (int, error) {
+	if len(value) != 5 && len(value) != 7 {
+		return 0, fmt.Errorf("invalid UTC offset: %s", value)
+	}
+	sign := 1
+	switch value[0] {
+	case '+':
+	case '-':
+		sign = -1
+	default:
+		return 0, fmt.Errorf("invalid UTC offset: %s", value)
+	}
+
+	digits := value[1:] + "00"
+	h, errH := strconv.Atoi(digits[0:2])
+	m, errM := strconv.Atoi(digits[2:4])
+	s, errS := strconv.Atoi(digits[4:6])
+	if errH != nil || errM != nil || errS != nil {
+		return 0, fmt.Errorf("invalid UTC offset: %s", value)
+	}
+	return sign * (h*3600 + m*60 + s), nil
+}
+
+// parseDuration は DURATION 型の値を time.Duration に変換する
+func parseDuration(value string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(value)
+	if m == nil || value == "P" || value == "PT" {
+		return 0, fmt.Errorf("invalid DURATION: %s", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid DURATION: %s", value)
+		}
+		d += time.Duration(n) * unit
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+// textUnescaper は TEXT 型の値のエスケープを元に戻す
+var textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, "\n", `\N`, "\n")
+
+// unescapeText は TEXT 型の値のエスケープを元に戻す
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
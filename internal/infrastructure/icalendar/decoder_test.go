@@ -0,0 +1,129 @@
+package icalendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeString(t *testing.T, content string) []Event {
+	t.Helper()
+	events, err := Decode(strings.NewReader(strings.ReplaceAll(content, "\n", "\r\n")))
+	require.NoError(t, err)
+	return events
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("正常系: Encode で書き出したカレンダーを読み戻せる", func(t *testing.T) {
+		event := service.CalendarEvent{
+			UID:         "accommodation-1@travel-api",
+			Summary:     "ホテル; 京都, 本館 " + strings.Repeat("長い名前", 20),
+			Description: "Confirmation: ABC123\n朝食付き",
+			Location:    "京都市下京区",
+			Start:       time.Date(2026, 3, 7, 20, 0, 0, 0, time.UTC),
+			End:         time.Date(2026, 3, 9, 15, 0, 0, 0, time.UTC),
+		}
+		content := NewEncoder().Encode(newTestCalendar(loadLocation(t, "America/New_York"), event))
+
+		events, err := Decode(strings.NewReader(string(content)))
+
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, event.UID, events[0].UID)
+		assert.Equal(t, event.Summary, events[0].Summary)
+		assert.Equal(t, event.Description, events[0].Description)
+		assert.Equal(t, event.Location, events[0].Location)
+		assert.True(t, event.Start.Equal(events[0].Start), "夏時間の切り替わりをまたいでも同じ時刻に戻るべき")
+		assert.True(t, event.End.Equal(events[0].End))
+		assert.False(t, events[0].AllDay)
+	})
+
+	t.Run("正常系: 終日の予定と DURATION、floating の時刻", func(t *testing.T) {
+		events := decodeString(t, `BEGIN:VCALENDAR
+VERSION:2.0
+BEGIN:VEVENT
+UID:all-day
+SUMMARY:Museum
+DTSTART;VALUE=DATE:20261102
+END:VEVENT
+BEGIN:VEVENT
+UID:duration
+SUMMARY:Tour
+DTSTART:20261102T100000
+DURATION:PT2H30M
+END:VEVENT
+END:VCALENDAR
+`)
+
+		require.Len(t, events, 2)
+		assert.True(t, events[0].AllDay)
+		assert.Equal(t, time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC), events[0].Start)
+		assert.Equal(t, time.Date(2026, 11, 3, 0, 0, 0, 0, time.UTC), events[0].End)
+		assert.Equal(t, time.Date(2026, 11, 2, 10, 0, 0, 0, time.UTC), events[1].Start)
+		assert.Equal(t, time.Date(2026, 11, 2, 12, 30, 0, 0, time.UTC), events[1].End)
+	})
+
+	t.Run("正常系: IANA の名前でない TZID は VTIMEZONE の標準時のオフセットで解釈する", func(t *testing.T) {
+		events := decodeString(t, `BEGIN:VCALENDAR
+BEGIN:VTIMEZONE
+TZID:Tokyo Standard Time
+BEGIN:STANDARD
+DTSTART:16010101T000000
+TZOFFSETFROM:+0900
+TZOFFSETTO:+0900
+END:STANDARD
+END:VTIMEZONE
+BEGIN:VEVENT
+UID:outlook
+SUMMARY:Dinner
+DTSTART;TZID="Tokyo Standard Time":20261101T190000
+DTEND;TZID="Tokyo Standard Time":20261101T210000
+END:VEVENT
+END:VCALENDAR
+`)
+
+		require.Len(t, events, 1)
+		assert.Equal(t, time.Date(2026, 11, 1, 10, 0, 0, 0, time.UTC), events[0].Start)
+		assert.Equal(t, time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC), events[0].End)
+	})
+
+	t.Run("異常系: VCALENDAR を含まない", func(t *testing.T) {
+		_, err := Decode(strings.NewReader("hello"))
+
+		assert.ErrorIs(t, err, ErrNotCalendar)
+	})
+
+	t.Run("異常系: DTSTART のない予定", func(t *testing.T) {
+		_, err := Decode(strings.NewReader("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:x\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"PT15M", 15 * time.Minute},
+		{"P1DT2H", 26 * time.Hour},
+		{"P2W", 14 * 24 * time.Hour},
+		{"-PT30S", -30 * time.Second},
+	}
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			got, err := parseDuration(tc.value)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("異常系: 単位のない値", func(t *testing.T) {
+		_, err := parseDuration("P")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,152 @@
+package icalendar
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// ProductID は書き出すカレンダーの PRODID
+const ProductID = "-//hata0//travel-api//EN"
+
+const (
+	utcLayout  = "20060102T150405Z"
+	dateLayout = "20060102"
+)
+
+// Encoder はカレンダーを RFC 5545 の iCalendar 形式に変換する
+type Encoder struct{}
+
+func NewEncoder() service.CalendarEncoder {
+	return &Encoder{}
+}
+
+// Encode はカレンダーを iCalendar 形式に変換する。
+// タイムゾーンが指定された場合、時刻のある予定は TZID 付きの現地時刻で書き出し、予定の期間に必要な VTIMEZONE を含める。
+// 予定ごとのタイムゾーンはカレンダーのタイムゾーンより優先する
+func (e *Encoder) Encode(cal service.Calendar) []byte {
+	loc := zoneOrDefault(cal.Location, nil)
+
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:"+ProductID)
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	if cal.Name != "" {
+		writeLine(&b, "X-WR-CALNAME:"+escapeText(cal.Name))
+	}
+	if loc != nil {
+		writeLine(&b, "X-WR-TIMEZONE:"+loc.String())
+	}
+	for _, r := range timedRanges(cal.Events, loc) {
+		writeTimezone(&b, r.loc, r.from, r.to)
+	}
+
+	stamp := cal.GeneratedAt.UTC().Format(utcLayout)
+	for _, event := range cal.Events {
+		writeEvent(&b, event, loc, stamp)
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// writeEvent は予定を VEVENT として書き出す
+func writeEvent(b *strings.Builder, event service.CalendarEvent, loc *time.Location, stamp string) {
+	writeLine(b, "BEGIN:VEVENT")
+	writeLine(b, "UID:"+event.UID)
+	writeLine(b, "DTSTAMP:"+stamp)
+	startLoc, endLoc := eventZones(event, loc)
+	writeLine(b, formatDateTime("DTSTART", event.Start, event.AllDay, startLoc))
+	if event.AllDay || !event.End.IsZero() {
+		writeLine(b, formatDateTime("DTEND", event.End, event.AllDay, endLoc))
+	}
+	writeLine(b, "SUMMARY:"+escapeText(event.Summary))
+	if event.Location != "" {
+		writeLine(b, "LOCATION:"+escapeText(event.Location))
+	}
+	if event.Description != "" {
+		writeLine(b, "DESCRIPTION:"+escapeText(event.Description))
+	}
+	if !event.LastModified.IsZero() {
+		writeLine(b, "LAST-MODIFIED:"+event.LastModified.UTC().Format(utcLayout))
+	}
+	writeLine(b, "END:VEVENT")
+}
+
+// formatDateTime は日時のプロパティを書き出す。終日の予定は DATE 型、タイムゾーンがない場合は UTC の DATE-TIME 型とする
+func formatDateTime(name string, t time.Time, allDay bool, loc *time.Location) string {
+	switch {
+	case allDay:
+		return name + ";VALUE=DATE:" + t.Format(dateLayout)
+	case loc != nil:
+		return name + ";TZID=" + loc.String() + ":" + t.In(loc).Format(localLayout)
+	default:
+		return name + ":" + t.UTC().Format(utcLayout)
+	}
+}
+
+// zoneOrDefault は予定を書き出すタイムゾーンを返す。tz が nil の場合は def を使い、UTC は nil として UTC の DATE-TIME 型で書き出す
+func zoneOrDefault(tz, def *time.Location) *time.Location {
+	if tz == nil {
+		tz = def
+	}
+	if tz == time.UTC {
+		return nil
+	}
+	return tz
+}
+
+// eventZones は予定の開始と終了を書き出すタイムゾーンを返す
+func eventZones(event service.CalendarEvent, loc *time.Location) (*time.Location, *time.Location) {
+	return zoneOrDefault(event.StartTimeZone, loc), zoneOrDefault(event.EndTimeZone, loc)
+}
+
+// timedRange はあるタイムゾーンで書き出す日時の範囲
+type timedRange struct {
+	loc      *time.Location
+	from, to time.Time
+}
+
+// timedRanges は時刻のある予定の日時をタイムゾーンごとにまとめ、最も早い日時と最も遅い日時を前後に 1 日ずつ余裕を持たせて返す。
+// タイムゾーンは予定に現れた順に並べる
+func timedRanges(events []service.CalendarEvent, loc *time.Location) []timedRange {
+	var ranges []timedRange
+	index := make(map[string]int)
+	add := func(zone *time.Location, t time.Time) {
+		if zone == nil {
+			return
+		}
+		i, ok := index[zone.String()]
+		if !ok {
+			index[zone.String()] = len(ranges)
+			ranges = append(ranges, timedRange{loc: zone, from: t, to: t})
+			return
+		}
+		if t.Before(ranges[i].from) {
+			ranges[i].from = t
+		}
+		if t.After(ranges[i].to) {
+			ranges[i].to = t
+		}
+	}
+
+	for _, event := range events {
+		if event.AllDay {
+			continue
+		}
+		startLoc, endLoc := eventZones(event, loc)
+		add(startLoc, event.Start)
+		if !event.End.IsZero() {
+			add(endLoc, event.End)
+		}
+	}
+
+	for i := range ranges {
+		ranges[i].from = ranges[i].from.UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+		ranges[i].to = ranges[i].to.UTC().Truncate(24*time.Hour).AddDate(0, 0, 2)
+	}
+	return ranges
+}
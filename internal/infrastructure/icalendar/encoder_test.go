@@ -0,0 +1,172 @@
+package icalendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func newTestCalendar(loc *time.Location, events ...service.CalendarEvent) service.Calendar {
+	return service.Calendar{
+		Name:        "京都旅行",
+		Location:    loc,
+		Events:      events,
+		GeneratedAt: time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC),
+	}
+}
+
+// unfold は折り返された行を元に戻して行ごとに分割する
+func unfold(content []byte) []string {
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(string(content), "\r\n ", ""), "\r\n"), "\r\n")
+}
+
+func TestEncoder_Encode(t *testing.T) {
+	encoder := NewEncoder()
+	stay := service.CalendarEvent{
+		UID:          "accommodation-1@travel-api",
+		Summary:      "ホテル; 京都, 本館",
+		Description:  "Confirmation: ABC123\n朝食付き",
+		Location:     "京都市下京区",
+		Start:        time.Date(2026, 11, 1, 6, 0, 0, 0, time.UTC),
+		End:          time.Date(2026, 11, 3, 2, 0, 0, 0, time.UTC),
+		LastModified: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+	period := service.CalendarEvent{
+		UID:     "trip-1@travel-api",
+		Summary: "京都旅行",
+		AllDay:  true,
+		Start:   time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+		End:     time.Date(2026, 11, 4, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("正常系: タイムゾーンなしでは UTC で書き出す", func(t *testing.T) {
+		lines := unfold(encoder.Encode(newTestCalendar(nil, period, stay)))
+
+		assert.Equal(t, "BEGIN:VCALENDAR", lines[0])
+		assert.Equal(t, "END:VCALENDAR", lines[len(lines)-1])
+		assert.Contains(t, lines, "PRODID:"+ProductID)
+		assert.Contains(t, lines, "X-WR-CALNAME:京都旅行")
+		assert.Contains(t, lines, "DTSTART;VALUE=DATE:20261101")
+		assert.Contains(t, lines, "DTEND;VALUE=DATE:20261104")
+		assert.Contains(t, lines, "DTSTART:20261101T060000Z")
+		assert.Contains(t, lines, "DTEND:20261103T020000Z")
+		assert.Contains(t, lines, "DTSTAMP:20261018T093000Z")
+		assert.Contains(t, lines, "LAST-MODIFIED:20261001T000000Z")
+		assert.NotContains(t, lines, "BEGIN:VTIMEZONE")
+	})
+
+	t.Run("正常系: TEXT 型の値をエスケープする", func(t *testing.T) {
+		lines := unfold(encoder.Encode(newTestCalendar(nil, stay)))
+
+		assert.Contains(t, lines, `SUMMARY:ホテル\; 京都\, 本館`)
+		assert.Contains(t, lines, `DESCRIPTION:Confirmation: ABC123\n朝食付き`)
+	})
+
+	t.Run("正常系: タイムゾーンを指定すると TZID 付きの現地時刻と VTIMEZONE を書き出す", func(t *testing.T) {
+		lines := unfold(encoder.Encode(newTestCalendar(loadLocation(t, "Asia/Tokyo"), period, stay)))
+
+		assert.Contains(t, lines, "X-WR-TIMEZONE:Asia/Tokyo")
+		assert.Contains(t, lines, "BEGIN:VTIMEZONE")
+		assert.Contains(t, lines, "TZID:Asia/Tokyo")
+		assert.Contains(t, lines, "TZOFFSETTO:+0900")
+		assert.Contains(t, lines, "DTSTART;TZID=Asia/Tokyo:20261101T150000")
+		assert.Contains(t, lines, "DTEND;TZID=Asia/Tokyo:20261103T110000")
+		assert.Contains(t, lines, "DTSTART;VALUE=DATE:20261101", "終日の予定はタイムゾーンに関係なく日付で書き出すべき")
+		assert.NotContains(t, lines, "BEGIN:DAYLIGHT")
+	})
+
+	t.Run("正常系: 期間中に夏時間が始まる場合は切り替わりを VTIMEZONE に含める", func(t *testing.T) {
+		event := service.CalendarEvent{
+			UID:     "accommodation-2@travel-api",
+			Summary: "Hotel",
+			Start:   time.Date(2026, 3, 7, 20, 0, 0, 0, time.UTC),
+			End:     time.Date(2026, 3, 9, 15, 0, 0, 0, time.UTC),
+		}
+
+		lines := unfold(encoder.Encode(newTestCalendar(loadLocation(t, "America/New_York"), event)))
+
+		content := strings.Join(lines, "\n")
+		assert.Contains(t, content, "BEGIN:STANDARD\nDTSTART:20260305T190000\nTZOFFSETFROM:-0500\nTZOFFSETTO:-0500\nTZNAME:EST\nEND:STANDARD")
+		assert.Contains(t, content, "BEGIN:DAYLIGHT\nDTSTART:20260308T020000\nTZOFFSETFROM:-0500\nTZOFFSETTO:-0400\nTZNAME:EDT\nEND:DAYLIGHT")
+		assert.Contains(t, lines, "DTSTART;TZID=America/New_York:20260307T150000")
+		assert.Contains(t, lines, "DTEND;TZID=America/New_York:20260309T110000")
+	})
+
+	t.Run("正常系: 予定のタイムゾーンはカレンダーのタイムゾーンより優先し、開始と終了で別の TZID を書き出す", func(t *testing.T) {
+		flight := service.CalendarEvent{
+			UID:           "transport-leg-1@travel-api",
+			Summary:       "NH203",
+			Start:         time.Date(2026, 11, 1, 13, 0, 0, 0, time.UTC),
+			End:           time.Date(2026, 11, 1, 21, 0, 0, 0, time.UTC),
+			StartTimeZone: loadLocation(t, "Asia/Tokyo"),
+			EndTimeZone:   loadLocation(t, "Europe/Paris"),
+		}
+
+		lines := unfold(encoder.Encode(newTestCalendar(loadLocation(t, "America/New_York"), flight, stay)))
+
+		assert.Contains(t, lines, "DTSTART;TZID=Asia/Tokyo:20261101T220000")
+		assert.Contains(t, lines, "DTEND;TZID=Europe/Paris:20261101T220000")
+		assert.Contains(t, lines, "DTSTART;TZID=America/New_York:20261101T010000", "タイムゾーンのない予定はカレンダーのタイムゾーンで書き出すべき")
+		assert.Contains(t, lines, "TZID:Asia/Tokyo")
+		assert.Contains(t, lines, "TZID:Europe/Paris")
+		assert.Contains(t, lines, "TZID:America/New_York")
+	})
+
+	t.Run("正常系: 終了日時のない予定は DTEND を書き出さない", func(t *testing.T) {
+		event := service.CalendarEvent{
+			UID:           "activity-1@travel-api",
+			Summary:       "金閣寺",
+			Start:         time.Date(2026, 11, 1, 1, 0, 0, 0, time.UTC),
+			StartTimeZone: loadLocation(t, "Asia/Tokyo"),
+		}
+
+		lines := unfold(encoder.Encode(newTestCalendar(nil, event)))
+
+		assert.Contains(t, lines, "DTSTART;TZID=Asia/Tokyo:20261101T100000")
+		for _, line := range lines {
+			assert.False(t, strings.HasPrefix(line, "DTEND"), "DTEND を書き出すべきではない: %s", line)
+		}
+		assert.Contains(t, lines, "TZID:Asia/Tokyo", "予定のタイムゾーンの VTIMEZONE を含めるべき")
+	})
+
+	t.Run("正常系: UTC を指定した場合はタイムゾーンなしと同じ", func(t *testing.T) {
+		assert.Equal(t, encoder.Encode(newTestCalendar(nil, stay)), encoder.Encode(newTestCalendar(time.UTC, stay)))
+	})
+}
+
+func TestWriteLine(t *testing.T) {
+	t.Run("正常系: 75 オクテット以下の行は折り返さない", func(t *testing.T) {
+		var b strings.Builder
+		writeLine(&b, strings.Repeat("a", 75))
+
+		assert.Equal(t, strings.Repeat("a", 75)+"\r\n", b.String())
+	})
+
+	t.Run("正常系: 長い行を UTF-8 の文字の途中で切らずに折り返す", func(t *testing.T) {
+		line := "SUMMARY:" + strings.Repeat("あ", 60)
+		var b strings.Builder
+		writeLine(&b, line)
+
+		physical := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+		require.Greater(t, len(physical), 1)
+		for i, l := range physical {
+			assert.LessOrEqual(t, len(l), maxLineOctets)
+			assert.True(t, strings.ToValidUTF8(l, "?") == l, "各行は正しい UTF-8 であるべき")
+			if i > 0 {
+				assert.True(t, strings.HasPrefix(l, " "))
+			}
+		}
+		assert.Equal(t, line, strings.Join(unfold([]byte(b.String())), ""))
+	})
+}
@@ -0,0 +1,43 @@
+package icalendar
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// maxLineOctets は折り返し前の 1 行の最大オクテット数（改行を除く）
+const maxLineOctets = 75
+
+// textEscaper は TEXT 型の値で特別な意味を持つ文字をエスケープする
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`;`, `\;`,
+	`,`, `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// escapeText は TEXT 型の値をエスケープする
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// writeLine はコンテンツ行を CRLF で終わる行として書き出す。
+// 75 オクテットを超える行は、UTF-8 の文字の途中で切らないように CRLF と空白で折り返す
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// 継続行の先頭の空白も 1 オクテットとして数える
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,105 @@
+package icalendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// transitionStep は UTC オフセットの変化を探すときの間隔。1 回の間隔の中でオフセットが 2 回変わることはないものとする
+const transitionStep = 12 * time.Hour
+
+// localLayout はタイムゾーンを持たない現地時刻（DATE-TIME の floating 形式）の書式
+const localLayout = "20060102T150405"
+
+// observance は VTIMEZONE の STANDARD または DAYLIGHT に対応する、ある時点から適用される UTC オフセット
+type observance struct {
+	start      time.Time
+	offsetFrom int
+	offsetTo   int
+	name       string
+	daylight   bool
+}
+
+// observances は from から to までの予定を表すのに必要な、タイムゾーンのオフセットの変化を返す。
+// 先頭は from の時点で適用されているオフセットで、それ以降は期間中にオフセットが変わるたびに 1 つずつ続く
+func observances(loc *time.Location, from, to time.Time) []observance {
+	name, offset := from.In(loc).Zone()
+	result := []observance{{
+		start:      from,
+		offsetFrom: offset,
+		offsetTo:   offset,
+		name:       name,
+		daylight:   from.In(loc).IsDST(),
+	}}
+
+	for t := from; t.Before(to); t = t.Add(transitionStep) {
+		next := t.Add(transitionStep)
+		if _, nextOffset := next.In(loc).Zone(); nextOffset == offset {
+			continue
+		}
+
+		at := findTransition(loc, t, next, offset)
+		name, newOffset := at.In(loc).Zone()
+		result = append(result, observance{
+			start:      at,
+			offsetFrom: offset,
+			offsetTo:   newOffset,
+			name:       name,
+			daylight:   at.In(loc).IsDST(),
+		})
+		offset = newOffset
+	}
+
+	return result
+}
+
+// findTransition は lo ではオフセットが offset で hi では異なるとき、オフセットが変わる最初の時刻を秒単位で二分探索する
+func findTransition(loc *time.Location, lo, hi time.Time, offset int) time.Time {
+	l, h := lo.Unix(), hi.Unix()
+	for h-l > 1 {
+		mid := l + (h-l)/2
+		if _, o := time.Unix(mid, 0).In(loc).Zone(); o == offset {
+			l = mid
+		} else {
+			h = mid
+		}
+	}
+	return time.Unix(h, 0).UTC()
+}
+
+// writeTimezone は from から to までの予定に必要な VTIMEZONE を書き出す
+func writeTimezone(b *strings.Builder, loc *time.Location, from, to time.Time) {
+	writeLine(b, "BEGIN:VTIMEZONE")
+	writeLine(b, "TZID:"+loc.String())
+	for _, o := range observances(loc, from, to) {
+		component := "STANDARD"
+		if o.daylight {
+			component = "DAYLIGHT"
+		}
+		writeLine(b, "BEGIN:"+component)
+		// DTSTART は切り替わる直前のオフセットでの現地時刻で表す
+		writeLine(b, "DTSTART:"+o.start.Add(time.Duration(o.offsetFrom)*time.Second).UTC().Format(localLayout))
+		writeLine(b, "TZOFFSETFROM:"+formatOffset(o.offsetFrom))
+		writeLine(b, "TZOFFSETTO:"+formatOffset(o.offsetTo))
+		if o.name != "" {
+			writeLine(b, "TZNAME:"+escapeText(o.name))
+		}
+		writeLine(b, "END:"+component)
+	}
+	writeLine(b, "END:VTIMEZONE")
+}
+
+// formatOffset は UTC からのオフセット（秒）を UTC-OFFSET 型の値（+hhmm、秒がある場合は +hhmmss）に変換する
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	h, m, s := seconds/3600, seconds/60%60, seconds%60
+	if s != 0 {
+		return fmt.Sprintf("%s%02d%02d%02d", sign, h, m, s)
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, h, m)
+}
@@ -0,0 +1,354 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"strings"
+	"time"
+)
+
+// EXIF（TIFF 形式）のタグ
+const (
+	tagOrientation      = 0x0112
+	tagDateTime         = 0x0132
+	tagExifIFDPointer   = 0x8769
+	tagGPSIFDPointer    = 0x8825
+	tagDateTimeOriginal = 0x9003
+	tagGPSLatitudeRef   = 0x0001
+	tagGPSLatitude      = 0x0002
+	tagGPSLongitudeRef  = 0x0003
+	tagGPSLongitude     = 0x0004
+)
+
+// TIFF のデータ型と1要素あたりのバイト数
+var tiffTypeSizes = map[uint16]int{
+	1:  1, // BYTE
+	2:  1, // ASCII
+	3:  2, // SHORT
+	4:  4, // LONG
+	5:  8, // RATIONAL
+	6:  1, // SBYTE
+	7:  1, // UNDEFINED
+	8:  2, // SSHORT
+	9:  4, // SLONG
+	10: 8, // SRATIONAL
+	11: 4, // FLOAT
+	12: 8, // DOUBLE
+}
+
+const (
+	tiffTypeASCII    = 2
+	tiffTypeShort    = 3
+	tiffTypeLong     = 4
+	tiffTypeRational = 5
+)
+
+// exifDateTimeLayout は EXIF の日時の書式
+const exifDateTimeLayout = "2006:01:02 15:04:05"
+
+var (
+	errInvalidTIFF     = errors.New("invalid TIFF structure")
+	errUnknownTIFFType = errors.New("unknown TIFF type in GPS IFD")
+)
+
+// exifInfo は EXIF から読み取った写真のメタデータ。読み取れなかった項目はゼロ値または nil になる
+type exifInfo struct {
+	capturedAt  *time.Time
+	latitude    *float64
+	longitude   *float64
+	orientation int
+}
+
+// tiffReader は EXIF の TIFF 構造を読み込む。オフセットはすべて data の先頭からの位置で、範囲外を指す値はエラーとする
+type tiffReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+// ifdEntry は IFD の1項目。valueOffset は値が格納されている位置で、4バイト以下の値は項目の中に直接格納される。
+// 型が不明な項目は値の位置と大きさが分からないため、unknownType を立てて valueOffset と size を 0 にする
+type ifdEntry struct {
+	tag         uint16
+	typ         uint16
+	count       uint32
+	valueOffset int
+	size        int
+	unknownType bool
+}
+
+// newTIFFReader は TIFF のヘッダーを読み込み、最初の IFD のオフセットを返す
+func newTIFFReader(data []byte) (*tiffReader, uint32, error) {
+	if len(data) < 8 {
+		return nil, 0, errInvalidTIFF
+	}
+
+	var order binary.ByteOrder
+	switch string(data[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, 0, errInvalidTIFF
+	}
+	if order.Uint16(data[2:4]) != 42 {
+		return nil, 0, errInvalidTIFF
+	}
+
+	return &tiffReader{data: data, order: order}, order.Uint32(data[4:8]), nil
+}
+
+// ifdSize は項目数が n の IFD のバイト数（項目数・各項目・次の IFD へのオフセット）
+func ifdSize(n int) int {
+	return 2 + 12*n + 4
+}
+
+// readIFD は offset の位置にある IFD の項目を読み込む。型が不明な項目は値を読まずに unknownType を立てて返す
+func (r *tiffReader) readIFD(offset uint32) ([]ifdEntry, error) {
+	if int64(offset)+2 > int64(len(r.data)) {
+		return nil, errInvalidTIFF
+	}
+	start := int(offset)
+	n := int(r.order.Uint16(r.data[start:]))
+	if start+ifdSize(n)-4 > len(r.data) {
+		return nil, errInvalidTIFF
+	}
+
+	entries := make([]ifdEntry, 0, n)
+	for i := range n {
+		p := start + 2 + 12*i
+		e := ifdEntry{
+			tag:   r.order.Uint16(r.data[p:]),
+			typ:   r.order.Uint16(r.data[p+2:]),
+			count: r.order.Uint32(r.data[p+4:]),
+		}
+		unit, ok := tiffTypeSizes[e.typ]
+		if !ok {
+			e.unknownType = true
+			entries = append(entries, e)
+			continue
+		}
+
+		size := int64(unit) * int64(e.count)
+		if size > int64(len(r.data)) {
+			return nil, errInvalidTIFF
+		}
+		e.size = int(size)
+		if e.size <= 4 {
+			e.valueOffset = p + 8
+		} else {
+			e.valueOffset = int(r.order.Uint32(r.data[p+8:]))
+			if int64(e.valueOffset)+size > int64(len(r.data)) {
+				return nil, errInvalidTIFF
+			}
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// uint は SHORT または LONG の項目の最初の値を返す
+func (r *tiffReader) uint(e ifdEntry) (uint32, bool) {
+	if e.count == 0 {
+		return 0, false
+	}
+	switch e.typ {
+	case tiffTypeShort:
+		return uint32(r.order.Uint16(r.data[e.valueOffset:])), true
+	case tiffTypeLong:
+		return r.order.Uint32(r.data[e.valueOffset:]), true
+	default:
+		return 0, false
+	}
+}
+
+// ascii は ASCII の項目の値を、末尾の NUL と空白を取り除いて返す
+func (r *tiffReader) ascii(e ifdEntry) (string, bool) {
+	if e.typ != tiffTypeASCII {
+		return "", false
+	}
+	value := string(r.data[e.valueOffset : e.valueOffset+e.size])
+	if i := strings.IndexByte(value, 0); i >= 0 {
+		value = value[:i]
+	}
+	return strings.TrimSpace(value), true
+}
+
+// rationals は RATIONAL の項目の値を返す。分母が 0 の値がある場合は読み取れなかったものとする
+func (r *tiffReader) rationals(e ifdEntry) ([]float64, bool) {
+	if e.typ != tiffTypeRational {
+		return nil, false
+	}
+	values := make([]float64, e.count)
+	for i := range values {
+		p := e.valueOffset + 8*i
+		num := r.order.Uint32(r.data[p:])
+		den := r.order.Uint32(r.data[p+4:])
+		if den == 0 {
+			return nil, false
+		}
+		values[i] = float64(num) / float64(den)
+	}
+	return values, true
+}
+
+// subIFD は IFD0 の項目から、Exif IFD や GPS IFD など別の IFD へのオフセットを探す
+func (r *tiffReader) subIFD(entries []ifdEntry, tag uint16) (uint32, bool) {
+	for _, e := range entries {
+		if e.tag == tag {
+			return r.uint(e)
+		}
+	}
+	return 0, false
+}
+
+// parseExif は TIFF 形式の EXIF から撮影日時・撮影位置・画像の向きを読み取る。
+// 一部の項目が壊れていても、読み取れた項目だけを返す
+func parseExif(data []byte) (exifInfo, error) {
+	info := exifInfo{orientation: 1}
+
+	r, ifd0Offset, err := newTIFFReader(data)
+	if err != nil {
+		return info, err
+	}
+	ifd0, err := r.readIFD(ifd0Offset)
+	if err != nil {
+		return info, err
+	}
+
+	for _, e := range ifd0 {
+		switch e.tag {
+		case tagOrientation:
+			if v, ok := r.uint(e); ok && v >= 1 && v <= 8 {
+				info.orientation = int(v)
+			}
+		case tagDateTime:
+			// 撮影日時（DateTimeOriginal）がない場合に限り、ファイルの更新日時で代用する
+			if s, ok := r.ascii(e); ok && info.capturedAt == nil {
+				info.capturedAt = parseExifDateTime(s)
+			}
+		}
+	}
+
+	if offset, ok := r.subIFD(ifd0, tagExifIFDPointer); ok {
+		if entries, err := r.readIFD(offset); err == nil {
+			for _, e := range entries {
+				if e.tag != tagDateTimeOriginal {
+					continue
+				}
+				if s, ok := r.ascii(e); ok {
+					if t := parseExifDateTime(s); t != nil {
+						info.capturedAt = t
+					}
+				}
+			}
+		}
+	}
+
+	if offset, ok := r.subIFD(ifd0, tagGPSIFDPointer); ok {
+		if entries, err := r.readIFD(offset); err == nil {
+			info.latitude, info.longitude = parseGPS(r, entries)
+		}
+	}
+
+	return info, nil
+}
+
+// parseExifDateTime は EXIF の日時を、タイムゾーンなしの現地時刻として UTC で返す
+func parseExifDateTime(value string) *time.Time {
+	t, err := time.ParseInLocation(exifDateTimeLayout, value, time.UTC)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// parseGPS は GPS IFD から緯度・経度を読み取る。どちらかが読み取れない場合はどちらも nil を返す
+func parseGPS(r *tiffReader, entries []ifdEntry) (*float64, *float64) {
+	var (
+		latRef, lngRef string
+		lat, lng       []float64
+	)
+	for _, e := range entries {
+		switch e.tag {
+		case tagGPSLatitudeRef:
+			latRef, _ = r.ascii(e)
+		case tagGPSLatitude:
+			lat, _ = r.rationals(e)
+		case tagGPSLongitudeRef:
+			lngRef, _ = r.ascii(e)
+		case tagGPSLongitude:
+			lng, _ = r.rationals(e)
+		}
+	}
+
+	latitude, ok := degrees(lat, latRef, "S")
+	if !ok || latitude < -90 || latitude > 90 {
+		return nil, nil
+	}
+	longitude, ok := degrees(lng, lngRef, "W")
+	if !ok || longitude < -180 || longitude > 180 {
+		return nil, nil
+	}
+	return &latitude, &longitude
+}
+
+// degrees は度・分・秒の値を度に変換する。ref が negativeRef の場合（南緯・西経）は負の値にする
+func degrees(dms []float64, ref, negativeRef string) (float64, bool) {
+	if len(dms) != 3 || (ref != "N" && ref != "S" && ref != "E" && ref != "W") {
+		return 0, false
+	}
+	value := dms[0] + dms[1]/60 + dms[2]/3600
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, false
+	}
+	if ref == negativeRef {
+		value = -value
+	}
+	return value, true
+}
+
+// stripGPS は TIFF 形式の EXIF から GPS IFD の内容を取り除いたコピーを返す。
+// GPS IFD は項目数 0 の空の IFD にし、IFD の外に格納されていた値も 0 で埋める。
+// 構造を解釈できない場合や、GPS IFD に型が不明で値を消せない項目がある場合は、
+// 位置情報が残っている可能性があるためエラーを返す
+func stripGPS(data []byte) ([]byte, error) {
+	stripped := make([]byte, len(data))
+	copy(stripped, data)
+
+	r, ifd0Offset, err := newTIFFReader(stripped)
+	if err != nil {
+		return nil, err
+	}
+	ifd0, err := r.readIFD(ifd0Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, ok := r.subIFD(ifd0, tagGPSIFDPointer)
+	if !ok {
+		return stripped, nil
+	}
+	entries, err := r.readIFD(offset)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entries {
+		if e.unknownType {
+			return nil, errUnknownTIFFType
+		}
+	}
+
+	for _, e := range entries {
+		if e.size > 4 {
+			clear(stripped[e.valueOffset : e.valueOffset+e.size])
+		}
+	}
+	n := int(r.order.Uint16(stripped[offset:]))
+	end := min(int(offset)+ifdSize(n), len(stripped))
+	clear(stripped[offset:end])
+
+	return stripped, nil
+}
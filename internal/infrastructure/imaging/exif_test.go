@@ -0,0 +1,159 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExif(t *testing.T) {
+	for name, order := range map[string]byteOrder{
+		"リトルエンディアン": binary.LittleEndian,
+		"ビッグエンディアン": binary.BigEndian,
+	} {
+		t.Run("正常系: "+name, func(t *testing.T) {
+			info, err := parseExif(testExif(order, 6))
+
+			require.NoError(t, err)
+			require.NotNil(t, info.capturedAt)
+			// 更新日時（DateTime）より撮影日時（DateTimeOriginal）を優先する
+			assert.Equal(t, time.Date(2024, 4, 2, 9, 15, 30, 0, time.UTC), *info.capturedAt)
+			require.NotNil(t, info.latitude)
+			require.NotNil(t, info.longitude)
+			assert.InDelta(t, 35.6812, *info.latitude, 1e-4)
+			assert.InDelta(t, 139.7671, *info.longitude, 1e-4)
+			assert.Equal(t, 6, info.orientation)
+		})
+	}
+
+	t.Run("正常系: 南緯・西経は負の値になる", func(t *testing.T) {
+		order := binary.LittleEndian
+		tiff := buildTIFF(order, nil, nil, []testTag{
+			asciiTag(tagGPSLatitudeRef, "S"),
+			dmsTag(order, tagGPSLatitude, [3][2]uint32{{33, 1}, {51, 1}, {0, 1}}),
+			asciiTag(tagGPSLongitudeRef, "W"),
+			dmsTag(order, tagGPSLongitude, [3][2]uint32{{70, 1}, {30, 1}, {0, 1}}),
+		})
+
+		info, err := parseExif(tiff)
+
+		require.NoError(t, err)
+		require.NotNil(t, info.latitude)
+		assert.InDelta(t, -33.85, *info.latitude, 1e-9)
+		assert.InDelta(t, -70.5, *info.longitude, 1e-9)
+		assert.Nil(t, info.capturedAt)
+		assert.Equal(t, 1, info.orientation)
+	})
+
+	t.Run("正常系: 撮影日時がない場合は更新日時を使う", func(t *testing.T) {
+		tiff := buildTIFF(binary.LittleEndian, []testTag{asciiTag(tagDateTime, "2024:05:01 12:00:00")}, nil, nil)
+
+		info, err := parseExif(tiff)
+
+		require.NoError(t, err)
+		require.NotNil(t, info.capturedAt)
+		assert.Equal(t, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), *info.capturedAt)
+	})
+
+	t.Run("正常系: 分母が 0 の座標は読み取らない", func(t *testing.T) {
+		order := binary.LittleEndian
+		tiff := buildTIFF(order, nil, nil, []testTag{
+			asciiTag(tagGPSLatitudeRef, "N"),
+			dmsTag(order, tagGPSLatitude, [3][2]uint32{{35, 0}, {0, 1}, {0, 1}}),
+			asciiTag(tagGPSLongitudeRef, "E"),
+			dmsTag(order, tagGPSLongitude, [3][2]uint32{{139, 1}, {0, 1}, {0, 1}}),
+		})
+
+		info, err := parseExif(tiff)
+
+		require.NoError(t, err)
+		assert.Nil(t, info.latitude)
+		assert.Nil(t, info.longitude)
+	})
+
+	t.Run("正常系: 型が不明な項目は読み飛ばし、他の項目は読み取る", func(t *testing.T) {
+		tiff := buildTIFF(binary.LittleEndian,
+			[]testTag{
+				{tag: 0x9999, typ: 99, count: 1},
+				asciiTag(tagDateTime, "2024:05:01 12:00:00"),
+			},
+			nil, nil,
+		)
+
+		info, err := parseExif(tiff)
+
+		require.NoError(t, err)
+		assert.NotNil(t, info.capturedAt)
+	})
+
+	t.Run("異常系: TIFF のヘッダーではない", func(t *testing.T) {
+		_, err := parseExif([]byte("not a tiff header"))
+
+		assert.ErrorIs(t, err, errInvalidTIFF)
+	})
+
+	t.Run("異常系: 途中で切れた EXIF でもパニックしない", func(t *testing.T) {
+		tiff := testExif(binary.BigEndian, 3)
+		for n := range len(tiff) {
+			assert.NotPanics(t, func() {
+				_, _ = parseExif(tiff[:n])
+				_, _ = stripGPS(tiff[:n])
+			})
+		}
+	})
+}
+
+func TestStripGPS(t *testing.T) {
+	t.Run("正常系: GPS IFD を空にし、他の項目は残す", func(t *testing.T) {
+		tiff := testExif(binary.LittleEndian, 6)
+
+		stripped, err := stripGPS(tiff)
+
+		require.NoError(t, err)
+		assert.Len(t, stripped, len(tiff))
+		info, err := parseExif(stripped)
+		require.NoError(t, err)
+		assert.Nil(t, info.latitude)
+		assert.Nil(t, info.longitude)
+		assert.NotNil(t, info.capturedAt)
+		assert.Equal(t, 6, info.orientation)
+		// 元のデータは変更しない
+		original, err := parseExif(tiff)
+		require.NoError(t, err)
+		assert.NotNil(t, original.latitude)
+	})
+
+	t.Run("正常系: GPS IFD がない場合はそのまま返す", func(t *testing.T) {
+		tiff := buildTIFF(binary.LittleEndian, []testTag{asciiTag(tagDateTime, "2024:05:01 12:00:00")}, nil, nil)
+
+		stripped, err := stripGPS(tiff)
+
+		require.NoError(t, err)
+		assert.Equal(t, tiff, stripped)
+	})
+
+	t.Run("異常系: 構造を解釈できない", func(t *testing.T) {
+		_, err := stripGPS([]byte("II*\x00\xff\xff\x00\x00"))
+
+		assert.ErrorIs(t, err, errInvalidTIFF)
+	})
+
+	t.Run("異常系: GPS IFD に型が不明な項目がある", func(t *testing.T) {
+		tiff := buildTIFF(binary.LittleEndian,
+			[]testTag{asciiTag(tagDateTime, "2024:05:01 12:00:00")},
+			nil,
+			[]testTag{
+				asciiTag(tagGPSLatitudeRef, "N"),
+				// 型が不明なため、値の位置と大きさが分からず消せない
+				{tag: tagGPSLatitude, typ: 99, count: 3, value: []byte{0, 0, 0, 0}},
+			},
+		)
+
+		_, err := stripGPS(tiff)
+
+		assert.ErrorIs(t, err, errUnknownTIFFType)
+	})
+}
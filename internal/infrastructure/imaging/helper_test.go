@@ -0,0 +1,194 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// byteOrder はテスト用の EXIF を組み立てるときに使うバイト順
+type byteOrder interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+// testTag はテスト用の EXIF を組み立てるための IFD の項目
+type testTag struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	value []byte
+}
+
+func asciiTag(tag uint16, value string) testTag {
+	return testTag{tag: tag, typ: tiffTypeASCII, count: uint32(len(value) + 1), value: append([]byte(value), 0)}
+}
+
+func shortTag(order byteOrder, tag uint16, value uint16) testTag {
+	return testTag{tag: tag, typ: tiffTypeShort, count: 1, value: order.AppendUint16(nil, value)}
+}
+
+// dmsTag は度・分・秒を分子と分母の組で指定した RATIONAL の項目を作る
+func dmsTag(order byteOrder, tag uint16, dms [3][2]uint32) testTag {
+	var value []byte
+	for _, r := range dms {
+		value = order.AppendUint32(value, r[0])
+		value = order.AppendUint32(value, r[1])
+	}
+	return testTag{tag: tag, typ: tiffTypeRational, count: 3, value: value}
+}
+
+// buildTIFF は IFD0 と、空でなければ Exif IFD・GPS IFD を持つ TIFF 形式の EXIF を組み立てる。
+// IFD をすべて先に並べ、4 バイトに収まらない値はその後ろにまとめて置く
+func buildTIFF(order byteOrder, ifd0, exifIFD, gpsIFD []testTag) []byte {
+	ifd0 = append([]testTag(nil), ifd0...)
+	if len(exifIFD) > 0 {
+		ifd0 = append(ifd0, testTag{tag: tagExifIFDPointer, typ: tiffTypeLong, count: 1})
+	}
+	if len(gpsIFD) > 0 {
+		ifd0 = append(ifd0, testTag{tag: tagGPSIFDPointer, typ: tiffTypeLong, count: 1})
+	}
+
+	ifd0Offset := 8
+	exifOffset := ifd0Offset + ifdSize(len(ifd0))
+	gpsOffset := exifOffset
+	if len(exifIFD) > 0 {
+		gpsOffset += ifdSize(len(exifIFD))
+	}
+	dataOffset := gpsOffset
+	if len(gpsIFD) > 0 {
+		dataOffset += ifdSize(len(gpsIFD))
+	}
+
+	for i, t := range ifd0 {
+		switch t.tag {
+		case tagExifIFDPointer:
+			ifd0[i].value = order.AppendUint32(nil, uint32(exifOffset))
+		case tagGPSIFDPointer:
+			ifd0[i].value = order.AppendUint32(nil, uint32(gpsOffset))
+		}
+	}
+
+	header := []byte("II*\x00")
+	if order == binary.BigEndian {
+		header = []byte("MM\x00*")
+	}
+	out := order.AppendUint32(header, uint32(ifd0Offset))
+
+	var data []byte
+	writeIFD := func(tags []testTag) {
+		out = order.AppendUint16(out, uint16(len(tags)))
+		for _, t := range tags {
+			out = order.AppendUint16(out, t.tag)
+			out = order.AppendUint16(out, t.typ)
+			out = order.AppendUint32(out, t.count)
+			if len(t.value) <= 4 {
+				var inline [4]byte
+				copy(inline[:], t.value)
+				out = append(out, inline[:]...)
+				continue
+			}
+			out = order.AppendUint32(out, uint32(dataOffset+len(data)))
+			data = append(data, t.value...)
+			if len(data)%2 == 1 {
+				data = append(data, 0)
+			}
+		}
+		out = order.AppendUint32(out, 0)
+	}
+	writeIFD(ifd0)
+	if len(exifIFD) > 0 {
+		writeIFD(exifIFD)
+	}
+	if len(gpsIFD) > 0 {
+		writeIFD(gpsIFD)
+	}
+	return append(out, data...)
+}
+
+// testExif は撮影日時・撮影位置（東京駅）・向きを持つ EXIF を組み立てる
+func testExif(order byteOrder, orientation uint16) []byte {
+	return buildTIFF(order,
+		[]testTag{
+			shortTag(order, tagOrientation, orientation),
+			asciiTag(tagDateTime, "2024:05:01 12:00:00"),
+		},
+		[]testTag{
+			asciiTag(tagDateTimeOriginal, "2024:04:02 09:15:30"),
+		},
+		[]testTag{
+			asciiTag(tagGPSLatitudeRef, "N"),
+			dmsTag(order, tagGPSLatitude, [3][2]uint32{{35, 1}, {40, 1}, {5232, 100}}),
+			asciiTag(tagGPSLongitudeRef, "E"),
+			dmsTag(order, tagGPSLongitude, [3][2]uint32{{139, 1}, {46, 1}, {16, 10}}),
+		},
+	)
+}
+
+// testImage は左半分が赤、右半分が青の画像を作る
+func testImage(width, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			if x < width/2 {
+				img.Set(x, y, color.RGBA{R: 0xff, A: 0xff})
+			} else {
+				img.Set(x, y, color.RGBA{B: 0xff, A: 0xff})
+			}
+		}
+	}
+	return img
+}
+
+// testJPEG は testImage を JPEG にし、SOI の直後に APP1 セグメントとして segments を差し込む
+func testJPEG(t *testing.T, width, height int, segments ...[]byte) []byte {
+	t.Helper()
+
+	var b bytes.Buffer
+	require.NoError(t, jpeg.Encode(&b, testImage(width, height), &jpeg.Options{Quality: 95}))
+	encoded := b.Bytes()
+
+	out := append([]byte(nil), encoded[:2]...)
+	for _, payload := range segments {
+		out = append(out, 0xFF, jpegMarkerAPP1)
+		out = binary.BigEndian.AppendUint16(out, uint16(len(payload)+2))
+		out = append(out, payload...)
+	}
+	return append(out, encoded[2:]...)
+}
+
+func exifSegment(tiff []byte) []byte {
+	return append(append([]byte(nil), jpegExifHeader...), tiff...)
+}
+
+func xmpSegment() []byte {
+	return append(append([]byte(nil), jpegXMPHeader...), []byte(`<x:xmpmeta><rdf:Description exif:GPSLatitude="35,40.872N"/></x:xmpmeta>`)...)
+}
+
+// testPNG は testImage を PNG にし、IHDR チャンクの直後に chunks を差し込む
+func testPNG(t *testing.T, width, height int, chunks map[string][]byte) []byte {
+	t.Helper()
+
+	var b bytes.Buffer
+	require.NoError(t, png.Encode(&b, testImage(width, height)))
+	encoded := b.Bytes()
+
+	// シグネチャ（8 バイト）と IHDR チャンク（中身 13 バイト + 12 バイト）の後ろに差し込む
+	ihdrEnd := len(pngSignature) + 25
+	out := append([]byte(nil), encoded[:ihdrEnd]...)
+	for typ, payload := range chunks {
+		out = binary.BigEndian.AppendUint32(out, uint32(len(payload)))
+		start := len(out)
+		out = append(out, typ...)
+		out = append(out, payload...)
+		out = binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(out[start:]))
+	}
+	return append(out, encoded[ihdrEnd:]...)
+}
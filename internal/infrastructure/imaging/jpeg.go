@@ -0,0 +1,131 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+// JPEG のマーカー
+const (
+	jpegMarkerSOI  = 0xD8
+	jpegMarkerEOI  = 0xD9
+	jpegMarkerSOS  = 0xDA
+	jpegMarkerAPP1 = 0xE1
+)
+
+var (
+	jpegExifHeader = []byte("Exif\x00\x00")
+	jpegXMPHeader  = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	errInvalidJPEG = errors.New("invalid JPEG structure")
+)
+
+// jpegSegment は JPEG のマーカーセグメント。data はマーカーを含むセグメント全体、payload は長さの後に続く中身
+type jpegSegment struct {
+	marker  byte
+	data    []byte
+	payload []byte
+}
+
+// splitJPEG は JPEG を SOS（画像データの開始）より前のセグメントと、SOS 以降の残りに分ける
+func splitJPEG(data []byte) ([]jpegSegment, []byte, error) {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != jpegMarkerSOI {
+		return nil, nil, errInvalidJPEG
+	}
+
+	var segments []jpegSegment
+	i := 2
+	for {
+		start := i
+		if i >= len(data) || data[i] != 0xFF {
+			return nil, nil, errInvalidJPEG
+		}
+		// マーカーの前には任意の数の 0xFF（詰め物）を置ける
+		for i < len(data) && data[i] == 0xFF {
+			i++
+		}
+		if i >= len(data) {
+			return nil, nil, errInvalidJPEG
+		}
+		marker := data[i]
+		i++
+
+		switch {
+		case marker == jpegMarkerSOS || marker == jpegMarkerEOI:
+			return segments, data[start:], nil
+		case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7):
+			// 長さを持たないマーカー
+			segments = append(segments, jpegSegment{marker: marker, data: data[start:i]})
+			continue
+		}
+
+		if i+2 > len(data) {
+			return nil, nil, errInvalidJPEG
+		}
+		length := int(binary.BigEndian.Uint16(data[i:]))
+		if length < 2 || i+length > len(data) {
+			return nil, nil, errInvalidJPEG
+		}
+		segments = append(segments, jpegSegment{
+			marker:  marker,
+			data:    data[start : i+length],
+			payload: data[i+2 : i+length],
+		})
+		i += length
+	}
+}
+
+func (s jpegSegment) isExif() bool {
+	return s.marker == jpegMarkerAPP1 && bytes.HasPrefix(s.payload, jpegExifHeader)
+}
+
+func (s jpegSegment) isXMP() bool {
+	return s.marker == jpegMarkerAPP1 && bytes.HasPrefix(s.payload, jpegXMPHeader)
+}
+
+// jpegExif は JPEG の APP1 セグメントから TIFF 形式の EXIF を取り出す。EXIF がない場合は nil を返す
+func jpegExif(data []byte) []byte {
+	segments, _, err := splitJPEG(data)
+	if err != nil {
+		return nil
+	}
+	for _, s := range segments {
+		if s.isExif() {
+			return s.payload[len(jpegExifHeader):]
+		}
+	}
+	return nil
+}
+
+// stripJPEGLocation は JPEG から位置情報を取り除いたコピーを返す。
+// EXIF の GPS IFD を空にし、位置情報を含みうる XMP のセグメントは丸ごと取り除く。
+// EXIF の構造を解釈できない場合は EXIF のセグメントも取り除く
+func stripJPEGLocation(data []byte) ([]byte, error) {
+	segments, rest, err := splitJPEG(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	b.Grow(len(data))
+	b.Write(data[:2])
+	for _, s := range segments {
+		switch {
+		case s.isXMP():
+			continue
+		case s.isExif():
+			tiff, err := stripGPS(s.payload[len(jpegExifHeader):])
+			if err != nil {
+				continue
+			}
+			// GPS IFD を空にしても長さは変わらないため、セグメントの先頭部分はそのまま使える
+			b.Write(s.data[:len(s.data)-len(tiff)])
+			b.Write(tiff)
+		default:
+			b.Write(s.data)
+		}
+	}
+	b.Write(rest)
+
+	return b.Bytes(), nil
+}
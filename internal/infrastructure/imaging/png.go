@@ -0,0 +1,110 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+)
+
+var (
+	pngSignature  = []byte("\x89PNG\r\n\x1a\n")
+	pngXMPKeyword = []byte("XML:com.adobe.xmp\x00")
+	errInvalidPNG = errors.New("invalid PNG structure")
+)
+
+// pngChunk は PNG のチャンク。data は長さ・種類・中身・CRC を含むチャンク全体
+type pngChunk struct {
+	typ     string
+	data    []byte
+	payload []byte
+}
+
+// splitPNG は PNG をチャンクに分ける
+func splitPNG(data []byte) ([]pngChunk, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, errInvalidPNG
+	}
+
+	var chunks []pngChunk
+	for i := len(pngSignature); i < len(data); {
+		if i+8 > len(data) {
+			return nil, errInvalidPNG
+		}
+		length := int64(binary.BigEndian.Uint32(data[i:]))
+		end := int64(i) + 12 + length
+		if end > int64(len(data)) {
+			return nil, errInvalidPNG
+		}
+		chunks = append(chunks, pngChunk{
+			typ:     string(data[i+4 : i+8]),
+			data:    data[i:end],
+			payload: data[i+8 : end-4],
+		})
+		i = int(end)
+	}
+	return chunks, nil
+}
+
+func (c pngChunk) isExif() bool {
+	return c.typ == "eXIf"
+}
+
+// isXMP は XMP を格納したテキストチャンクかを判定する
+func (c pngChunk) isXMP() bool {
+	switch c.typ {
+	case "iTXt", "tEXt", "zTXt":
+		return bytes.HasPrefix(c.payload, pngXMPKeyword)
+	default:
+		return false
+	}
+}
+
+// pngExif は PNG の eXIf チャンクから TIFF 形式の EXIF を取り出す。EXIF がない場合は nil を返す
+func pngExif(data []byte) []byte {
+	chunks, err := splitPNG(data)
+	if err != nil {
+		return nil
+	}
+	for _, c := range chunks {
+		if c.isExif() {
+			return c.payload
+		}
+	}
+	return nil
+}
+
+// stripPNGLocation は PNG から位置情報を取り除いたコピーを返す。
+// eXIf チャンクの GPS IFD を空にして CRC を計算し直し、XMP のチャンクは丸ごと取り除く。
+// EXIF の構造を解釈できない場合は eXIf チャンクも取り除く
+func stripPNGLocation(data []byte) ([]byte, error) {
+	chunks, err := splitPNG(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	b.Grow(len(data))
+	b.Write(pngSignature)
+	for _, c := range chunks {
+		switch {
+		case c.isXMP():
+			continue
+		case c.isExif():
+			tiff, err := stripGPS(c.payload)
+			if err != nil {
+				continue
+			}
+			b.Write(c.data[:8])
+			b.Write(tiff)
+			crc := crc32.NewIEEE()
+			crc.Write(c.data[4:8])
+			crc.Write(tiff)
+			b.Write(binary.BigEndian.AppendUint32(nil, crc.Sum32()))
+		default:
+			b.Write(c.data)
+		}
+	}
+
+	return b.Bytes(), nil
+}
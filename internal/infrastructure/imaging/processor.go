@@ -0,0 +1,120 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+const (
+	// defaultMaxPixels はデコードする画像の縦横のピクセル数の積の上限。デコード時のメモリ使用量を抑えるために制限する
+	defaultMaxPixels = 50_000_000
+	// defaultThumbnailSize はサムネイルの長辺のピクセル数
+	defaultThumbnailSize = 320
+	// thumbnailQuality はサムネイルの JPEG の品質
+	thumbnailQuality = 80
+)
+
+// Processor は標準ライブラリだけで写真の EXIF の読み取り・位置情報の削除・サムネイルの生成を行う。
+// 対応する形式は JPEG・PNG・GIF で、EXIF は JPEG の APP1 セグメントと PNG の eXIf チャンクから読み取る
+type Processor struct {
+	maxPixels     int
+	thumbnailSize int
+}
+
+func NewProcessor() service.PhotoProcessor {
+	return newProcessor(defaultMaxPixels, defaultThumbnailSize)
+}
+
+func newProcessor(maxPixels, thumbnailSize int) *Processor {
+	return &Processor{
+		maxPixels:     maxPixels,
+		thumbnailSize: thumbnailSize,
+	}
+}
+
+// Process は写真からメタデータを読み取り、サムネイルを生成する。
+// デコードする前にヘッダーから縦横のピクセル数を確認し、上限を超える画像は ErrImageTooLarge とする
+func (p *Processor) Process(content []byte, contentType string, stripLocation bool) (*service.ProcessedPhoto, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrInvalidImage, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, service.ErrInvalidImage
+	}
+	if int64(config.Width)*int64(config.Height) > int64(p.maxPixels) {
+		return nil, service.ErrImageTooLarge
+	}
+
+	info := exifInfo{orientation: 1}
+	if tiff := findExif(content, contentType); tiff != nil {
+		// EXIF が壊れていても写真としては扱えるため、読み取れた項目だけを使う
+		info, _ = parseExif(tiff)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrInvalidImage, err)
+	}
+
+	var thumb bytes.Buffer
+	if err := jpeg.Encode(&thumb, thumbnail(img, info.orientation, p.thumbnailSize), &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	stored := content
+	if stripLocation {
+		if stored, err = stripLocationMetadata(content, contentType); err != nil {
+			return nil, fmt.Errorf("%w: %v", service.ErrInvalidImage, err)
+		}
+	}
+
+	// 幅と高さは EXIF の向きを反映した、表示されるときの大きさを返す
+	width, height := config.Width, config.Height
+	if info.orientation >= 5 {
+		width, height = height, width
+	}
+
+	return &service.ProcessedPhoto{
+		Metadata: service.PhotoMetadata{
+			CapturedAt: info.capturedAt,
+			Latitude:   info.latitude,
+			Longitude:  info.longitude,
+			Width:      width,
+			Height:     height,
+		},
+		Content:   stored,
+		Thumbnail: thumb.Bytes(),
+	}, nil
+}
+
+// findExif は写真の形式に応じて TIFF 形式の EXIF を取り出す。GIF は EXIF を持たない
+func findExif(content []byte, contentType string) []byte {
+	switch contentType {
+	case attachment.ContentTypeJPEG:
+		return jpegExif(content)
+	case attachment.ContentTypePNG:
+		return pngExif(content)
+	default:
+		return nil
+	}
+}
+
+// stripLocationMetadata は写真の形式に応じて位置情報を取り除いたコピーを返す。GIF は位置情報を持たないためそのまま返す
+func stripLocationMetadata(content []byte, contentType string) ([]byte, error) {
+	switch contentType {
+	case attachment.ContentTypeJPEG:
+		return stripJPEGLocation(content)
+	case attachment.ContentTypePNG:
+		return stripPNGLocation(content)
+	default:
+		return content, nil
+	}
+}
@@ -0,0 +1,173 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/usecase/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeThumbnail(t *testing.T, processed *service.ProcessedPhoto) image.Image {
+	t.Helper()
+	img, err := jpeg.Decode(bytes.NewReader(processed.Thumbnail))
+	require.NoError(t, err)
+	return img
+}
+
+func TestProcessor_Process(t *testing.T) {
+	processor := newProcessor(defaultMaxPixels, 32)
+
+	t.Run("正常系: JPEG の EXIF から撮影日時と撮影位置を読み取る", func(t *testing.T) {
+		content := testJPEG(t, 40, 20, exifSegment(testExif(binary.LittleEndian, 1)))
+
+		processed, err := processor.Process(content, "image/jpeg", false)
+
+		require.NoError(t, err)
+		metadata := processed.Metadata
+		require.NotNil(t, metadata.CapturedAt)
+		assert.Equal(t, time.Date(2024, 4, 2, 9, 15, 30, 0, time.UTC), *metadata.CapturedAt)
+		require.NotNil(t, metadata.Latitude)
+		require.NotNil(t, metadata.Longitude)
+		assert.InDelta(t, 35.6812, *metadata.Latitude, 1e-4)
+		assert.InDelta(t, 139.7671, *metadata.Longitude, 1e-4)
+		assert.Equal(t, 40, metadata.Width)
+		assert.Equal(t, 20, metadata.Height)
+		// 位置情報の削除を指定しない場合は受け取った写真をそのまま保存する
+		assert.Equal(t, content, processed.Content)
+		assert.Equal(t, image.Rect(0, 0, 32, 16), decodeThumbnail(t, processed).Bounds())
+	})
+
+	t.Run("正常系: EXIF の向きに合わせて回転したサムネイルを生成する", func(t *testing.T) {
+		content := testJPEG(t, 40, 20, exifSegment(testExif(binary.LittleEndian, 6)))
+
+		processed, err := processor.Process(content, "image/jpeg", false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 20, processed.Metadata.Width)
+		assert.Equal(t, 40, processed.Metadata.Height)
+		thumb := decodeThumbnail(t, processed)
+		assert.Equal(t, image.Rect(0, 0, 16, 32), thumb.Bounds())
+		// 時計回りに90度回転すると、元画像の左半分（赤）が上に、右半分（青）が下に来る
+		top := color.RGBAModel.Convert(thumb.At(8, 4)).(color.RGBA)
+		bottom := color.RGBAModel.Convert(thumb.At(8, 28)).(color.RGBA)
+		assert.Greater(t, top.R, top.B)
+		assert.Greater(t, bottom.B, bottom.R)
+	})
+
+	t.Run("正常系: JPEG から位置情報を取り除き、他のメタデータは残す", func(t *testing.T) {
+		content := testJPEG(t, 40, 20, exifSegment(testExif(binary.BigEndian, 1)), xmpSegment())
+
+		processed, err := processor.Process(content, "image/jpeg", true)
+
+		require.NoError(t, err)
+		// 読み取ったメタデータは元の写真のもの
+		assert.NotNil(t, processed.Metadata.Latitude)
+
+		reprocessed, err := processor.Process(processed.Content, "image/jpeg", false)
+		require.NoError(t, err)
+		assert.Nil(t, reprocessed.Metadata.Latitude)
+		assert.Nil(t, reprocessed.Metadata.Longitude)
+		assert.NotNil(t, reprocessed.Metadata.CapturedAt)
+		assert.NotContains(t, string(processed.Content), "GPSLatitude")
+		_, err = jpeg.Decode(bytes.NewReader(processed.Content))
+		assert.NoError(t, err)
+	})
+
+	t.Run("正常系: PNG の eXIf チャンクを読み取り、位置情報を取り除く", func(t *testing.T) {
+		content := testPNG(t, 40, 20, map[string][]byte{
+			"eXIf": testExif(binary.LittleEndian, 1),
+			"iTXt": append(append([]byte(nil), pngXMPKeyword...), "\x00\x00\x00\x00<x:xmpmeta/>"...),
+		})
+
+		processed, err := processor.Process(content, "image/png", true)
+
+		require.NoError(t, err)
+		assert.NotNil(t, processed.Metadata.CapturedAt)
+		assert.NotNil(t, processed.Metadata.Latitude)
+
+		// CRC を計算し直しているため、取り除いた後も PNG として読み込める
+		_, err = png.Decode(bytes.NewReader(processed.Content))
+		require.NoError(t, err)
+		assert.NotContains(t, string(processed.Content), "XML:com.adobe.xmp")
+		reprocessed, err := processor.Process(processed.Content, "image/png", false)
+		require.NoError(t, err)
+		assert.Nil(t, reprocessed.Metadata.Latitude)
+		assert.NotNil(t, reprocessed.Metadata.CapturedAt)
+	})
+
+	t.Run("正常系: EXIF を持たない GIF はメタデータなしで処理する", func(t *testing.T) {
+		var b bytes.Buffer
+		require.NoError(t, gif.Encode(&b, testImage(10, 10), nil))
+
+		processed, err := processor.Process(b.Bytes(), "image/gif", true)
+
+		require.NoError(t, err)
+		assert.Nil(t, processed.Metadata.CapturedAt)
+		assert.Nil(t, processed.Metadata.Latitude)
+		assert.Equal(t, b.Bytes(), processed.Content)
+		// サムネイルの大きさより小さい画像は拡大しない
+		assert.Equal(t, image.Rect(0, 0, 10, 10), decodeThumbnail(t, processed).Bounds())
+	})
+
+	t.Run("正常系: 壊れた EXIF は無視し、位置情報の削除では EXIF ごと取り除く", func(t *testing.T) {
+		content := testJPEG(t, 40, 20, exifSegment([]byte("II*\x00\xff\xff\x00\x00GPS")))
+
+		processed, err := processor.Process(content, "image/jpeg", true)
+
+		require.NoError(t, err)
+		assert.Nil(t, processed.Metadata.CapturedAt)
+		assert.Nil(t, jpegExif(processed.Content))
+	})
+
+	t.Run("正常系: GPS IFD に型が不明な項目がある場合は、位置情報の削除で EXIF ごと取り除く", func(t *testing.T) {
+		tiff := buildTIFF(binary.LittleEndian,
+			[]testTag{asciiTag(tagDateTime, "2024:05:01 12:00:00")},
+			nil,
+			[]testTag{{tag: tagGPSLatitude, typ: 99, count: 3, value: []byte{0, 0, 0, 0}}},
+		)
+		content := testJPEG(t, 40, 20, exifSegment(tiff))
+
+		processed, err := processor.Process(content, "image/jpeg", true)
+
+		require.NoError(t, err)
+		assert.NotNil(t, processed.Metadata.CapturedAt)
+		assert.Nil(t, jpegExif(processed.Content))
+	})
+
+	t.Run("正常系: 透過部分は白で塗りつぶす", func(t *testing.T) {
+		var b bytes.Buffer
+		require.NoError(t, png.Encode(&b, image.NewNRGBA(image.Rect(0, 0, 4, 4))))
+
+		processed, err := processor.Process(b.Bytes(), "image/png", false)
+
+		require.NoError(t, err)
+		c := color.RGBAModel.Convert(decodeThumbnail(t, processed).At(1, 1)).(color.RGBA)
+		assert.Greater(t, c.R, uint8(0xf0))
+		assert.Greater(t, c.G, uint8(0xf0))
+		assert.Greater(t, c.B, uint8(0xf0))
+	})
+
+	t.Run("異常系: 画像としてデコードできない", func(t *testing.T) {
+		_, err := processor.Process([]byte("\xff\xd8\xff\xe0broken"), "image/jpeg", false)
+
+		assert.ErrorIs(t, err, service.ErrInvalidImage)
+	})
+
+	t.Run("異常系: ピクセル数が上限を超える", func(t *testing.T) {
+		small := newProcessor(100, 32)
+		content := testJPEG(t, 20, 10)
+
+		_, err := small.Process(content, "image/jpeg", false)
+
+		assert.ErrorIs(t, err, service.ErrImageTooLarge)
+	})
+}
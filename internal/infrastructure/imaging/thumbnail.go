@@ -0,0 +1,119 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+)
+
+// thumbnail は img を縦横とも maxEdge ピクセル以内に縮小し、EXIF の向き（orientation）に合わせて回転・反転した画像を返す。
+// 縮小には各ピクセルに対応する元画像の範囲の平均を取るボックスフィルタを使い、拡大はしない。
+// サムネイルは JPEG で保存するため、透過部分は白で塗りつぶす
+func thumbnail(img image.Image, orientation, maxEdge int) *image.RGBA {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	dstW, dstH := srcW, srcH
+	if srcW > maxEdge || srcH > maxEdge {
+		if srcW >= srcH {
+			dstW, dstH = maxEdge, max(1, srcH*maxEdge/srcW)
+		} else {
+			dstW, dstH = max(1, srcW*maxEdge/srcH), maxEdge
+		}
+	}
+
+	return orient(resize(img, dstW, dstH), orientation)
+}
+
+// resize はボックスフィルタで img を dstW×dstH に縮小する
+func resize(img image.Image, dstW, dstH int) *image.RGBA {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	at := pixelReader(img)
+
+	// 元画像を1行ずつ読み、対応する縮小後のピクセルに足し込む
+	sums := make([][4]uint64, dstW*dstH)
+	counts := make([]uint64, dstW*dstH)
+	columns := make([]int, srcW)
+	for x := range srcW {
+		columns[x] = x * dstW / srcW
+	}
+	for y := range srcH {
+		row := (y * dstH / srcH) * dstW
+		for x := range srcW {
+			r, g, b, a := at(bounds.Min.X+x, bounds.Min.Y+y)
+			i := row + columns[x]
+			sums[i][0] += uint64(r)
+			sums[i][1] += uint64(g)
+			sums[i][2] += uint64(b)
+			sums[i][3] += uint64(a)
+			counts[i]++
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for i, sum := range sums {
+		n := max(counts[i], 1)
+		a := sum[3] / n
+		// 色の値はアルファ乗算済みのため、白の背景と合成するには残りの不透明度分の白を足せばよい
+		white := 0xffff - a
+		dst.Pix[i*4+0] = uint8((sum[0]/n + white) >> 8)
+		dst.Pix[i*4+1] = uint8((sum[1]/n + white) >> 8)
+		dst.Pix[i*4+2] = uint8((sum[2]/n + white) >> 8)
+		dst.Pix[i*4+3] = 0xff
+	}
+	return dst
+}
+
+// pixelReader は img のピクセルを 16 ビットのアルファ乗算済み RGBA で読む関数を返す。
+// JPEG をデコードした YCbCr の画像は、ピクセルごとの interface の変換を避けるため直接読む
+func pixelReader(img image.Image) func(x, y int) (r, g, b, a uint32) {
+	if ycbcr, ok := img.(*image.YCbCr); ok {
+		return func(x, y int) (uint32, uint32, uint32, uint32) {
+			yi := ycbcr.YOffset(x, y)
+			ci := ycbcr.COffset(x, y)
+			r, g, b := color.YCbCrToRGB(ycbcr.Y[yi], ycbcr.Cb[ci], ycbcr.Cr[ci])
+			return uint32(r) * 0x101, uint32(g) * 0x101, uint32(b) * 0x101, 0xffff
+		}
+	}
+	return func(x, y int) (uint32, uint32, uint32, uint32) {
+		return img.At(x, y).RGBA()
+	}
+}
+
+// orient は EXIF の orientation（1〜8）に従って img を回転・反転し、正しい向きで表示される画像を返す
+func orient(img *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	w, h := img.Rect.Dx(), img.Rect.Dy()
+	dstW, dstH := w, h
+	if orientation >= 5 {
+		dstW, dstH = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := range dstH {
+		for x := range dstW {
+			var sx, sy int
+			switch orientation {
+			case 2: // 左右反転
+				sx, sy = w-1-x, y
+			case 3: // 180度回転
+				sx, sy = w-1-x, h-1-y
+			case 4: // 上下反転
+				sx, sy = x, h-1-y
+			case 5: // 左上と右下を結ぶ対角線で反転
+				sx, sy = y, x
+			case 6: // 時計回りに90度回転
+				sx, sy = y, h-1-x
+			case 7: // 右上と左下を結ぶ対角線で反転
+				sx, sy = w-1-y, h-1-x
+			case 8: // 反時計回りに90度回転
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], img.Pix[img.PixOffset(sx, sy):img.PixOffset(sx, sy)+4])
+		}
+	}
+	return dst
+}
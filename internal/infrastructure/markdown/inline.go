@@ -0,0 +1,297 @@
+package markdown
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// escapablePunctuation はバックスラッシュでエスケープできる ASCII の記号
+const escapablePunctuation = "!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~"
+
+// renderInline は段落・見出しの中身をインライン要素として HTML に書き出す。
+// 生の HTML はタグとして解釈せず、すべてテキストとしてエスケープする
+func renderInline(b *strings.Builder, text string) {
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\\' && i+1 < len(text) && strings.IndexByte(escapablePunctuation, text[i+1]) >= 0:
+			b.WriteString(escapeText(text[i+1 : i+2]))
+			i += 2
+			continue
+
+		case c == '\\' && i+1 < len(text) && text[i+1] == '\n':
+			b.WriteString("<br>\n")
+			i += 2
+			continue
+
+		case c == '\n':
+			if strings.HasSuffix(text[:i], "  ") {
+				trimTrailingSpaces(b)
+				b.WriteString("<br>\n")
+			} else {
+				b.WriteString("\n")
+			}
+			i++
+			continue
+
+		case c == '`':
+			if n := renderCodeSpan(b, text[i:]); n > 0 {
+				i += n
+				continue
+			}
+			// 閉じられていないバッククォートの並びはそのまま出力する
+			run := len(text[i:]) - len(strings.TrimLeft(text[i:], "`"))
+			b.WriteString(text[i : i+run])
+			i += run
+			continue
+
+		case c == '!' && strings.HasPrefix(text[i:], "!["):
+			if n := renderImage(b, text[i:]); n > 0 {
+				i += n
+				continue
+			}
+
+		case c == '[':
+			if n := renderLink(b, text[i:]); n > 0 {
+				i += n
+				continue
+			}
+
+		case c == '<':
+			if n := renderAutolink(b, text[i:]); n > 0 {
+				i += n
+				continue
+			}
+
+		case c == '*' || c == '_' || c == '~':
+			if n := renderEmphasis(b, text, i); n > 0 {
+				i += n
+				continue
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(text[i:])
+		b.WriteString(escapeText(text[i : i+size]))
+		i += size
+	}
+}
+
+// renderCodeSpan は `code` を <code> として書き出し、消費したバイト数を返す。閉じられていない場合は 0 を返す
+func renderCodeSpan(b *strings.Builder, text string) int {
+	run := len(text) - len(strings.TrimLeft(text, "`"))
+	fence := text[:run]
+	for offset := run; offset < len(text); {
+		idx := strings.Index(text[offset:], fence)
+		if idx < 0 {
+			return 0
+		}
+		end := offset + idx
+		// 区切りより長いバッククォートの並びは閉じとして扱わない
+		if end+run < len(text) && text[end+run] == '`' {
+			offset = end + run + len(text[end+run:]) - len(strings.TrimLeft(text[end+run:], "`"))
+			continue
+		}
+		code := strings.ReplaceAll(text[run:end], "\n", " ")
+		if len(code) >= 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+			code = code[1 : len(code)-1]
+		}
+		b.WriteString("<code>" + escapeText(code) + "</code>")
+		return end + run
+	}
+	return 0
+}
+
+// renderLink は [text](url "title") を <a> として書き出し、消費したバイト数を返す。
+// リンク先が許可されていない URL の場合はテキストだけを書き出す
+func renderLink(b *strings.Builder, text string) int {
+	label, destination, title, n := parseLinkSyntax(text)
+	if n == 0 {
+		return 0
+	}
+	if !isAllowedURL(destination, linkSchemes) {
+		renderInline(b, label)
+		return n
+	}
+	b.WriteString(`<a href="` + escapeText(destination) + `"`)
+	if title != "" {
+		b.WriteString(` title="` + escapeText(title) + `"`)
+	}
+	b.WriteString(">")
+	renderInline(b, label)
+	b.WriteString("</a>")
+	return n
+}
+
+// renderImage は ![alt](url "title") を <img> として書き出し、消費したバイト数を返す。
+// 画像は http(s) の URL だけを許可し、それ以外は代替テキストだけを書き出す
+func renderImage(b *strings.Builder, text string) int {
+	alt, source, title, n := parseLinkSyntax(text[1:])
+	if n == 0 {
+		return 0
+	}
+	if !isAllowedURL(source, imageSchemes) {
+		b.WriteString(escapeText(alt))
+		return n + 1
+	}
+	b.WriteString(`<img src="` + escapeText(source) + `" alt="` + escapeText(alt) + `"`)
+	if title != "" {
+		b.WriteString(` title="` + escapeText(title) + `"`)
+	}
+	b.WriteString(">")
+	return n + 1
+}
+
+// parseLinkSyntax は [label](destination "title") を解析する。形式に合わない場合は n に 0 を返す
+func parseLinkSyntax(text string) (label, destination, title string, n int) {
+	depth := 0
+	closeLabel := -1
+	for i := 1; i < len(text) && closeLabel < 0; i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			if depth == 0 {
+				closeLabel = i
+			}
+			depth--
+		}
+	}
+	if closeLabel < 0 || closeLabel+1 >= len(text) || text[closeLabel+1] != '(' {
+		return "", "", "", 0
+	}
+	// リンク先の中の対になった括弧（Wikipedia の URL など）は閉じ括弧として扱わない
+	closeParen, depth := -1, 0
+	for i := closeLabel + 2; i < len(text) && closeParen < 0; i++ {
+		switch text[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				closeParen = i
+			}
+			depth--
+		}
+	}
+	if closeParen < 0 {
+		return "", "", "", 0
+	}
+
+	inner := strings.TrimSpace(text[closeLabel+2 : closeParen])
+	destination, rest, _ := strings.Cut(inner, " ")
+	destination = strings.TrimSuffix(strings.TrimPrefix(destination, "<"), ">")
+	rest = strings.TrimSpace(rest)
+	if rest != "" {
+		if len(rest) < 2 || rest[0] != rest[len(rest)-1] || (rest[0] != '"' && rest[0] != '\'') {
+			return "", "", "", 0
+		}
+		title = rest[1 : len(rest)-1]
+	}
+	return text[1:closeLabel], destination, title, closeParen + 1
+}
+
+// renderAutolink は <https://example.com> を <a> として書き出し、消費したバイト数を返す
+func renderAutolink(b *strings.Builder, text string) int {
+	end := strings.IndexByte(text, '>')
+	if end < 0 {
+		return 0
+	}
+	destination := text[1:end]
+	if strings.ContainsAny(destination, " \t\n<") || !strings.Contains(destination, ":") || !isAllowedURL(destination, linkSchemes) {
+		return 0
+	}
+	b.WriteString(`<a href="` + escapeText(destination) + `">` + escapeText(destination) + "</a>")
+	return end + 1
+}
+
+// renderEmphasis は **strong** / __strong__ / *em* / _em_ / ~~del~~ を書き出し、消費したバイト数を返す。
+// 対応する閉じ記号がない場合は 0 を返す
+func renderEmphasis(b *strings.Builder, text string, start int) int {
+	rest := text[start:]
+	c := rest[0]
+	run := len(rest) - len(strings.TrimLeft(rest, string(c)))
+
+	// 単語の途中の _ は snake_case などの可能性が高いため強調として扱わない
+	if c == '_' && start > 0 {
+		if r, _ := utf8.DecodeLastRuneInString(text[:start]); isWordRune(r) {
+			return 0
+		}
+	}
+
+	var delimiter, tag string
+	switch {
+	case c == '~' && run == 2:
+		delimiter, tag = "~~", "del"
+	case c == '~':
+		return 0
+	case run >= 2:
+		delimiter, tag = rest[:2], "strong"
+	default:
+		delimiter, tag = rest[:1], "em"
+	}
+
+	body := rest[len(delimiter):]
+	if body == "" || unicode.IsSpace(rune(body[0])) {
+		return 0
+	}
+	end := findClosingDelimiter(body, delimiter)
+	if end < 0 {
+		return 0
+	}
+	b.WriteString("<" + tag + ">")
+	renderInline(b, body[:end])
+	b.WriteString("</" + tag + ">")
+	return len(delimiter)*2 + end
+}
+
+// findClosingDelimiter は空白の直後ではない閉じ記号の位置を返す。コードスパンの中は探索しない。
+// 記号の並びが区切りより長い場合（*斜体 **太字*** の *** など）は、内側の強調の閉じが続いたものとして並びの末尾で閉じる
+func findClosingDelimiter(body, delimiter string) int {
+	c := delimiter[0]
+	for i := 0; i < len(body); i++ {
+		switch body[i] {
+		case '\\':
+			i++
+		case '`':
+			run := len(body[i:]) - len(strings.TrimLeft(body[i:], "`"))
+			if closing := strings.Index(body[i+run:], body[i:i+run]); closing >= 0 {
+				i += run + closing + run - 1
+			}
+		case c:
+			run := len(body[i:]) - len(strings.TrimLeft(body[i:], string(c)))
+			closable := i > 0 && !unicode.IsSpace(rune(body[i-1]))
+			if closable && c == '_' && i+run < len(body) {
+				if r, _ := utf8.DecodeRuneInString(body[i+run:]); isWordRune(r) {
+					closable = false
+				}
+			}
+			switch {
+			case closable && run == len(delimiter):
+				return i
+			case closable && run > 2:
+				return i + run - len(delimiter)
+			}
+			i += run - 1
+		}
+	}
+	return -1
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// trimTrailingSpaces は書き出し済みの末尾の空白を取り除く
+func trimTrailingSpaces(b *strings.Builder) {
+	s := strings.TrimRight(b.String(), " ")
+	b.Reset()
+	b.WriteString(s)
+}
+
+// escapeText はテキスト・属性値として埋め込めるよう HTML の特殊文字をエスケープする
+func escapeText(s string) string {
+	return html.EscapeString(s)
+}
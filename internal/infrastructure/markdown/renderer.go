@@ -0,0 +1,256 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+var (
+	atxHeadingPattern   = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	thematicPattern     = regexp.MustCompile(`^ {0,3}(?:(?:\*[ \t]*){3,}|(?:-[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	fencePattern        = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*([^`]*)$")
+	blockquotePattern   = regexp.MustCompile(`^ {0,3}> ?(.*)$`)
+	bulletItemPattern   = regexp.MustCompile(`^( {0,3})([-*+])([ \t]+|$)(.*)$`)
+	orderedItemPattern  = regexp.MustCompile(`^( {0,3})(\d{1,9})([.)])([ \t]+|$)(.*)$`)
+	leadingSpacePattern = regexp.MustCompile(`^[ \t]*`)
+)
+
+// Renderer は Markdown のサブセット（見出し・段落・リスト・引用・コード・リンク・画像・強調）を HTML に変換する。
+// Markdown 中の生の HTML はすべてエスケープし、変換結果も Sanitize で許可リストに絞り込んでから返す
+type Renderer struct{}
+
+func NewRenderer() service.MarkdownRenderer {
+	return &Renderer{}
+}
+
+// Render は Markdown を安全な HTML に変換する
+func (r *Renderer) Render(markdown string) string {
+	markdown = strings.ReplaceAll(markdown, "\r\n", "\n")
+	markdown = strings.ReplaceAll(markdown, "\r", "\n")
+	markdown = strings.ReplaceAll(markdown, "\x00", "�")
+
+	var b strings.Builder
+	renderBlocks(&b, strings.Split(markdown, "\n"), false)
+	return Sanitize(b.String())
+}
+
+// renderBlocks は行の並びをブロック要素として HTML に書き出す。
+// tight が true の場合（空行を挟まないリスト項目の中）は段落を <p> で囲まない
+func renderBlocks(b *strings.Builder, lines []string, tight bool) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+
+		switch {
+		case isBlank(line):
+			i++
+
+		case fencePattern.MatchString(line):
+			i = renderFencedCode(b, lines, i)
+
+		case atxHeadingPattern.MatchString(line):
+			m := atxHeadingPattern.FindStringSubmatch(line)
+			level := string('0' + rune(len(m[1])))
+			b.WriteString("<h" + level + ">")
+			renderInline(b, strings.TrimSpace(m[2]))
+			b.WriteString("</h" + level + ">\n")
+			i++
+
+		case thematicPattern.MatchString(line):
+			b.WriteString("<hr>\n")
+			i++
+
+		case blockquotePattern.MatchString(line):
+			var quoted []string
+			for ; i < len(lines) && blockquotePattern.MatchString(lines[i]); i++ {
+				quoted = append(quoted, blockquotePattern.FindStringSubmatch(lines[i])[1])
+			}
+			b.WriteString("<blockquote>\n")
+			renderBlocks(b, quoted, false)
+			b.WriteString("</blockquote>\n")
+
+		case isListItem(line):
+			i = renderList(b, lines, i)
+
+		default:
+			i = renderParagraph(b, lines, i, tight)
+		}
+	}
+}
+
+func renderFencedCode(b *strings.Builder, lines []string, start int) int {
+	m := fencePattern.FindStringSubmatch(lines[start])
+	indent, fence := len(m[1]), m[2]
+
+	i := start + 1
+	var code []string
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+			i++
+			break
+		}
+		code = append(code, removeIndent(lines[i], indent))
+	}
+
+	b.WriteString("<pre><code>")
+	for _, l := range code {
+		b.WriteString(escapeText(l))
+		b.WriteString("\n")
+	}
+	b.WriteString("</code></pre>\n")
+	return i
+}
+
+// listItem はリストの1項目の開始行を解析した結果
+type listItem struct {
+	ordered bool
+	marker  string
+	number  string
+	indent  int
+	content string
+}
+
+func parseListItem(line string) (listItem, bool) {
+	if m := bulletItemPattern.FindStringSubmatch(line); m != nil && !thematicPattern.MatchString(line) {
+		return listItem{marker: m[2], indent: len(m[1]) + len(m[2]) + contentOffset(m[3]), content: m[4]}, true
+	}
+	if m := orderedItemPattern.FindStringSubmatch(line); m != nil {
+		return listItem{ordered: true, marker: m[3], number: m[2], indent: len(m[1]) + len(m[2]) + 1 + contentOffset(m[4]), content: m[5]}, true
+	}
+	return listItem{}, false
+}
+
+// contentOffset はリストマーカーと本文の間の空白の幅を返す。5 つ以上の空白は 1 つとして扱う
+func contentOffset(spaces string) int {
+	if spaces == "" || len(spaces) > 4 {
+		return 1
+	}
+	return len(spaces)
+}
+
+func isListItem(line string) bool {
+	_, ok := parseListItem(line)
+	return ok
+}
+
+func renderList(b *strings.Builder, lines []string, start int) int {
+	first, _ := parseListItem(lines[start])
+
+	var (
+		items   [][]string
+		loose   bool
+		pending bool // 直前に空行があったか
+		i       = start
+	)
+	for i < len(lines) {
+		line := lines[i]
+		if item, ok := parseListItem(line); ok && leadingIndent(line) < first.indent {
+			if item.ordered != first.ordered || item.marker != first.marker {
+				break
+			}
+			if pending {
+				loose = true
+			}
+			items = append(items, []string{item.content})
+			first.indent = item.indent
+			pending = false
+			i++
+			continue
+		}
+		if isBlank(line) {
+			pending = true
+			i++
+			continue
+		}
+		if leadingIndent(line) >= first.indent {
+			if pending {
+				loose = true
+				items[len(items)-1] = append(items[len(items)-1], "")
+			}
+			items[len(items)-1] = append(items[len(items)-1], removeIndent(line, first.indent))
+			pending = false
+			i++
+			continue
+		}
+		if pending || startsBlock(line) {
+			break
+		}
+		// 遅延継続行は直前の項目の段落の続きとして扱う
+		items[len(items)-1] = append(items[len(items)-1], line)
+		i++
+	}
+	// 末尾の空行はリストに含めない
+	for i > start && isBlank(lines[i-1]) {
+		i--
+	}
+
+	tag := "ul"
+	if first.ordered {
+		tag = "ol"
+		if number := strings.TrimLeft(first.number, "0"); number != "1" {
+			if number == "" {
+				number = "0"
+			}
+			tag = `ol start="` + number + `"`
+		}
+	}
+	b.WriteString("<" + tag + ">\n")
+	for _, content := range items {
+		var item strings.Builder
+		renderBlocks(&item, content, !loose)
+		b.WriteString("<li>" + strings.TrimSuffix(item.String(), "\n") + "</li>\n")
+	}
+	if first.ordered {
+		b.WriteString("</ol>\n")
+	} else {
+		b.WriteString("</ul>\n")
+	}
+	return i
+}
+
+func renderParagraph(b *strings.Builder, lines []string, start int, tight bool) int {
+	paragraph := []string{strings.TrimLeft(lines[start], " \t")}
+	i := start + 1
+	for ; i < len(lines) && !isBlank(lines[i]) && !startsBlock(lines[i]); i++ {
+		paragraph = append(paragraph, strings.TrimLeft(lines[i], " \t"))
+	}
+
+	if !tight {
+		b.WriteString("<p>")
+	}
+	renderInline(b, strings.TrimRight(strings.Join(paragraph, "\n"), " \t"))
+	if !tight {
+		b.WriteString("</p>")
+	}
+	b.WriteString("\n")
+	return i
+}
+
+// startsBlock は段落を中断する行（見出し・コード・区切り線・引用・リスト）かどうかを判定する
+func startsBlock(line string) bool {
+	return fencePattern.MatchString(line) ||
+		atxHeadingPattern.MatchString(line) ||
+		thematicPattern.MatchString(line) ||
+		blockquotePattern.MatchString(line) ||
+		isListItem(line)
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+func leadingIndent(line string) int {
+	return len(strings.ReplaceAll(leadingSpacePattern.FindString(line), "\t", "    "))
+}
+
+// removeIndent は行頭から最大 n 文字分の空白を取り除く
+func removeIndent(line string, n int) string {
+	line = strings.Replace(line, "\t", "    ", 1)
+	removed := 0
+	for removed < n && removed < len(line) && line[removed] == ' ' {
+		removed++
+	}
+	return line[removed:]
+}
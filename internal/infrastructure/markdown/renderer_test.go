@@ -0,0 +1,149 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	renderer := NewRenderer()
+
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{
+			name:     "見出しと段落",
+			markdown: "# 1日目 #\n\n金閣寺へ行った。**最高**だった。",
+			want:     "<h1>1日目</h1>\n<p>金閣寺へ行った。<strong>最高</strong>だった。</p>\n",
+		},
+		{
+			name:     "入れ子のリスト",
+			markdown: "- 朝食\n- 観光\n  - 金閣寺\n  - 銀閣寺\n- 夕食",
+			want:     "<ul>\n<li>朝食</li>\n<li>観光\n<ul>\n<li>金閣寺</li>\n<li>銀閣寺</li>\n</ul></li>\n<li>夕食</li>\n</ul>\n",
+		},
+		{
+			name:     "空行を挟むリストは項目を段落として扱う",
+			markdown: "- a\n\n- b",
+			want:     "<ul>\n<li><p>a</p></li>\n<li><p>b</p></li>\n</ul>\n",
+		},
+		{
+			name:     "1 以外から始まる番号付きリスト",
+			markdown: "3. 清水寺\n4. 八坂神社",
+			want:     "<ol start=\"3\">\n<li>清水寺</li>\n<li>八坂神社</li>\n</ol>\n",
+		},
+		{
+			name:     "段落の直後のリスト",
+			markdown: "持ち物\n- 傘",
+			want:     "<p>持ち物</p>\n<ul>\n<li>傘</li>\n</ul>\n",
+		},
+		{
+			name:     "引用",
+			markdown: "> 引用\n> 続き",
+			want:     "<blockquote>\n<p>引用\n続き</p>\n</blockquote>\n",
+		},
+		{
+			name:     "コードブロックの中の HTML はエスケープされる",
+			markdown: "```go\nfmt.Println(\"<b>\")\n```",
+			want:     "<pre><code>fmt.Println(&#34;&lt;b&gt;&#34;)\n</code></pre>\n",
+		},
+		{
+			name:     "インラインコード",
+			markdown: "`<script>` は書けない",
+			want:     "<p><code>&lt;script&gt;</code> は書けない</p>\n",
+		},
+		{
+			name:     "リンクには rel が付与される",
+			markdown: "[京都](https://example.com/wiki/Kyoto_(city) \"観光\")",
+			want:     "<p><a href=\"https://example.com/wiki/Kyoto_(city)\" title=\"観光\" rel=\"nofollow noopener noreferrer\">京都</a></p>\n",
+		},
+		{
+			name:     "自動リンク",
+			markdown: "<https://example.com>",
+			want:     "<p><a href=\"https://example.com\" rel=\"nofollow noopener noreferrer\">https://example.com</a></p>\n",
+		},
+		{
+			name:     "画像",
+			markdown: "![写真](https://example.com/a.jpg)",
+			want:     "<p><img src=\"https://example.com/a.jpg\" alt=\"写真\"></p>\n",
+		},
+		{
+			name:     "強調の組み合わせ",
+			markdown: "***両方*** と *斜体 **太字** 斜体* と ~~取り消し~~",
+			want:     "<p><strong><em>両方</em></strong> と <em>斜体 <strong>太字</strong> 斜体</em> と <del>取り消し</del></p>\n",
+		},
+		{
+			name:     "単語の途中の _ は強調として扱わない",
+			markdown: "snake_case_name と _強調_",
+			want:     "<p>snake_case_name と <em>強調</em></p>\n",
+		},
+		{
+			name:     "改行と区切り線",
+			markdown: "行末  \n改行\\\n改行\n\n---",
+			want:     "<p>行末<br>\n改行<br>\n改行</p>\n<hr>\n",
+		},
+		{
+			name:     "バックスラッシュによるエスケープ",
+			markdown: "\\*強調しない\\* a & b",
+			want:     "<p>*強調しない* a &amp; b</p>\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run("正常系: "+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, renderer.Render(tt.markdown))
+		})
+	}
+}
+
+func TestRenderer_Render_XSS(t *testing.T) {
+	renderer := NewRenderer()
+
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{
+			name:     "script タグはテキストとしてエスケープされる",
+			markdown: "<script>alert(1)</script>",
+			want:     "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n",
+		},
+		{
+			name:     "イベントハンドラ属性を持つタグはテキストとしてエスケープされる",
+			markdown: "<img src=x onerror=alert(1)>",
+			want:     "<p>&lt;img src=x onerror=alert(1)&gt;</p>\n",
+		},
+		{
+			name:     "javascript スキームのリンクはテキストだけになる",
+			markdown: "[押して](javascript:alert(1))",
+			want:     "<p>押して</p>\n",
+		},
+		{
+			name:     "大文字小文字を混ぜた javascript スキーム",
+			markdown: "[押して](JaVaScRiPt:alert(1))",
+			want:     "<p>押して</p>\n",
+		},
+		{
+			name:     "制御文字を含むスキーム",
+			markdown: "[押して](java\tscript:alert(1))",
+			want:     "<p>押して</p>\n",
+		},
+		{
+			name:     "data スキームの画像は代替テキストだけになる",
+			markdown: "![写真](data:image/svg+xml;base64,PHN2Zz4=)",
+			want:     "<p>写真</p>\n",
+		},
+		{
+			name:     "属性値から抜け出そうとするリンク先",
+			markdown: "[x](https://example.com/\"onmouseover=\"alert(1))",
+			want:     "<p><a href=\"https://example.com/&#34;onmouseover=&#34;alert(1)\" rel=\"nofollow noopener noreferrer\">x</a></p>\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run("異常系: "+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, renderer.Render(tt.markdown))
+		})
+	}
+}
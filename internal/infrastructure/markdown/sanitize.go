@@ -0,0 +1,178 @@
+package markdown
+
+import (
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	// allowedAttributes は出力を許可するタグと、そのタグで許可する属性の一覧
+	allowedAttributes = map[string][]string{
+		"p": nil, "h1": nil, "h2": nil, "h3": nil, "h4": nil, "h5": nil, "h6": nil,
+		"strong": nil, "em": nil, "del": nil, "code": nil, "pre": nil, "blockquote": nil,
+		"ul": nil, "ol": {"start"}, "li": nil, "br": nil, "hr": nil,
+		"a":   {"href", "title"},
+		"img": {"src", "alt", "title"},
+	}
+
+	// voidElements は終了タグを持たない要素
+	voidElements = map[string]bool{"br": true, "hr": true, "img": true}
+
+	// droppedContentElements は中身ごと取り除く要素。テキストとして残すと意図しない内容が表示されるもの
+	droppedContentElements = map[string]bool{
+		"script": true, "style": true, "iframe": true, "object": true, "embed": true,
+		"template": true, "noscript": true, "noembed": true, "noframes": true,
+		"textarea": true, "title": true, "svg": true, "math": true, "xmp": true,
+	}
+
+	linkSchemes  = []string{"http", "https", "mailto"}
+	imageSchemes = []string{"http", "https"}
+
+	digitsPattern = regexp.MustCompile(`^\d{1,9}$`)
+)
+
+// linkRel は外部リンクに付与する rel 属性。リンク先からの参照元の取得と検索順位の受け渡しを防ぐ
+const linkRel = "nofollow noopener noreferrer"
+
+// Sanitize は HTML を許可リストに含まれるタグ・属性だけに絞り込む。
+// 許可されないタグは取り除いて中のテキストだけを残し、script などは中身ごと取り除く。
+// URL を持つ属性は許可したスキームか相対 URL の場合だけ残し、閉じられていないタグは末尾で閉じる
+func Sanitize(input string) string {
+	var (
+		b         strings.Builder
+		open      []string
+		dropDepth int
+		dropTag   string
+	)
+	z := html.NewTokenizer(strings.NewReader(input))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			// 入力の終端（io.EOF）で終了する
+			break
+		}
+		token := z.Token()
+
+		if dropDepth > 0 {
+			switch {
+			case tt == html.StartTagToken && token.Data == dropTag:
+				dropDepth++
+			case tt == html.EndTagToken && token.Data == dropTag:
+				dropDepth--
+			}
+			continue
+		}
+
+		switch tt {
+		case html.TextToken:
+			b.WriteString(html.EscapeString(token.Data))
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if droppedContentElements[token.Data] {
+				if tt == html.StartTagToken {
+					dropDepth, dropTag = 1, token.Data
+				}
+				continue
+			}
+			attrs, ok := sanitizeAttributes(token)
+			if !ok {
+				continue
+			}
+			b.WriteString("<" + token.Data)
+			for _, attr := range attrs {
+				b.WriteString(" " + attr.Key + `="` + html.EscapeString(attr.Val) + `"`)
+			}
+			b.WriteString(">")
+			if !voidElements[token.Data] {
+				open = append(open, token.Data)
+			}
+
+		case html.EndTagToken:
+			// 開いていないタグの終了タグは無視し、内側で閉じ忘れたタグはここで閉じる
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != token.Data {
+					continue
+				}
+				for j := len(open) - 1; j >= i; j-- {
+					b.WriteString("</" + open[j] + ">")
+				}
+				open = open[:i]
+				break
+			}
+		}
+	}
+
+	for i := len(open) - 1; i >= 0; i-- {
+		b.WriteString("</" + open[i] + ">")
+	}
+	return b.String()
+}
+
+// sanitizeAttributes は許可された属性だけを返す。タグ自体を出力しない場合は ok に false を返す
+func sanitizeAttributes(token html.Token) (attrs []html.Attribute, ok bool) {
+	allowed, ok := allowedAttributes[token.Data]
+	if !ok {
+		return nil, false
+	}
+
+	for _, attr := range token.Attr {
+		if attr.Namespace != "" || !slices.Contains(allowed, attr.Key) {
+			continue
+		}
+		switch attr.Key {
+		case "href":
+			if !isAllowedURL(attr.Val, linkSchemes) {
+				continue
+			}
+		case "src":
+			if !isAllowedURL(attr.Val, imageSchemes) {
+				continue
+			}
+		case "start":
+			if !digitsPattern.MatchString(attr.Val) {
+				continue
+			}
+		}
+		attrs = append(attrs, html.Attribute{Key: attr.Key, Val: attr.Val})
+	}
+
+	switch token.Data {
+	case "a":
+		attrs = append(attrs, html.Attribute{Key: "rel", Val: linkRel})
+	case "img":
+		if !hasAttribute(attrs, "src") {
+			return nil, false
+		}
+	}
+	return attrs, true
+}
+
+// isAllowedURL は URL が許可されたスキームを持つか、スキームを持たない相対 URL であるかを判定する。
+// 制御文字を含むなど解析できない URL は許可しない
+func isAllowedURL(raw string, schemes []string) bool {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return false
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "" {
+		// スキームとして解釈されなかった ":" はブラウザによって解釈が異なるため、パスより前にある場合は許可しない
+		head := raw
+		if i := strings.IndexAny(head, "/?#"); i >= 0 {
+			head = head[:i]
+		}
+		return !strings.Contains(head, ":")
+	}
+	return slices.Contains(schemes, strings.ToLower(u.Scheme))
+}
+
+func hasAttribute(attrs []html.Attribute, key string) bool {
+	return slices.ContainsFunc(attrs, func(attr html.Attribute) bool { return attr.Key == key })
+}
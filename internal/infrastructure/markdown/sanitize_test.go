@@ -0,0 +1,58 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "許可されたタグはそのまま残る", input: "<p><strong>a</strong><br></p>", want: "<p><strong>a</strong><br></p>"},
+		{name: "許可されていない属性は取り除かれる", input: `<p onclick="x" style="color:red">a</p>`, want: "<p>a</p>"},
+		{name: "許可されていないタグは中のテキストだけが残る", input: "<div><b>bold</b></div>", want: "bold"},
+		{name: "script は中身ごと取り除かれる", input: `<script>var a = "</p>";</script>after`, want: "after"},
+		{name: "style や iframe は中身ごと取り除かれる", input: "<style>p{}</style><iframe src=x>inner</iframe>ok", want: "ok"},
+		{name: "svg の中の script も取り除かれる", input: "<svg><script>alert(1)</script></svg>tail", want: "tail"},
+		{name: "コメントは取り除かれる", input: "<!-- <script> -->text", want: "text"},
+		{name: "javascript スキームの href は取り除かれる", input: `<a href="javascript:alert(1)">x</a>`, want: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "文字参照で隠した javascript スキームも取り除かれる", input: `<a href="&#106;avascript:alert(1)">x</a>`, want: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "相対 URL は許可される", input: `<a href="/trips/1">x</a>`, want: `<a href="/trips/1" rel="nofollow noopener noreferrer">x</a>`},
+		{name: "src のない画像は取り除かれる", input: `<img src="javascript:alert(1)" alt="x">`, want: ""},
+		{name: "属性値はエスケープされる", input: `<img src="/a.png" alt="&quot;x">`, want: `<img src="/a.png" alt="&#34;x">`},
+		{name: "数値でない start は取り除かれる", input: `<ol start="1;x" type="a"><li>x</li></ol>`, want: "<ol><li>x</li></ol>"},
+		{name: "閉じられていないタグは閉じられる", input: "<ol start=\"3\"><li><em>x</ol>", want: `<ol start="3"><li><em>x</em></li></ol>`},
+		{name: "開いていないタグの終了タグは無視される", input: "</p>stray</em>", want: "stray"},
+		{name: "大文字小文字を混ぜた javascript スキームも取り除かれる", input: `<a href="JaVaScRiPt:alert(1)">x</a>`, want: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "前に空白を置いた javascript スキームも取り除かれる", input: `<a href="  javascript:alert(1)">x</a>`, want: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "文字参照のタブで区切った javascript スキームも取り除かれる", input: `<a href="java&#x09;script:alert(1)">x</a>`, want: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "16 進数の文字参照で隠した javascript スキームも取り除かれる", input: `<a href="&#x6A;&#x61;vascript&#58;alert(1)">x</a>`, want: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "名前付き文字参照のコロンで隠した javascript スキームも取り除かれる", input: `<a href="javascript&colon;alert(1)">x</a>`, want: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "data スキームの href は取り除かれる", input: `<a href="data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==">x</a>`, want: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "大文字の data スキームの href も取り除かれる", input: `<a href="DATA:text/html,x">x</a>`, want: `<a rel="nofollow noopener noreferrer">x</a>`},
+		{name: "data スキームの画像は取り除かれる", input: `<img src="data:image/png;base64,iVBORw0KGgo=" alt="x">`, want: ""},
+		{name: "画像のイベントハンドラーは取り除かれる", input: `<img src="/a.png" onerror="alert(1)">`, want: `<img src="/a.png">`},
+		{name: "大文字小文字を混ぜたイベントハンドラーも取り除かれる", input: `<a href="/x" OnMouseOver="alert(1)">x</a>`, want: `<a href="/x" rel="nofollow noopener noreferrer">x</a>`},
+		{name: "スラッシュで区切ったイベントハンドラーも取り除かれる", input: `<p/onclick=alert(1)>x</p>`, want: "<p>x</p>"},
+		{name: "svg は属性と中身ごと取り除かれる", input: `<svg onload="alert(1)"><a href="/x">x</a></svg>after`, want: "after"},
+		{name: "math は中身ごと取り除かれる", input: `<math><mi xlink:href="javascript:alert(1)">x</mi></math>after`, want: "after"},
+		{name: "入れ子の svg は外側の終了タグまで取り除かれる", input: "<svg><svg><script>alert(1)</script></svg>inside</svg>after", want: "after"},
+		{name: "閉じられていない svg は末尾まで取り除かれる", input: "<p>a</p><svg><p>b", want: "<p>a</p>"},
+		{name: "スラッシュで属性を続けた閉じられていない svg も末尾まで取り除かれる", input: "<svg/onload=alert(1)>after", want: ""},
+		{name: "タグの中に埋め込んだ script はテキストとしてエスケープされる", input: "<scr<script>ipt>alert(1)</script>", want: "ipt&gt;alert(1)"},
+		{name: "交差したタグは入れ子に直される", input: "<em><strong>x</em></strong>", want: "<em><strong>x</strong></em>"},
+		{name: "入れ子のまま閉じられていないタグは内側から閉じられる", input: `<p><a href="/x"><img src="/a.png">`, want: `<p><a href="/x" rel="nofollow noopener noreferrer"><img src="/a.png"></a></p>`},
+		{name: "引用符を閉じようとする属性値はエスケープされる", input: `<a href="/x" title='a" onclick="alert(1)'>x</a>`, want: `<a href="/x" title="a&#34; onclick=&#34;alert(1)" rel="nofollow noopener noreferrer">x</a>`},
+		{name: "属性値の中のタグはエスケープされる", input: `<img src="/a.png" alt='"><svg onload=alert(1)>'>`, want: `<img src="/a.png" alt="&#34;&gt;&lt;svg onload=alert(1)&gt;">`},
+		{name: "URL の中の引用符とタグはエスケープされる", input: `<a href="/x?q=&quot;&gt;<script>">x</a>`, want: `<a href="/x?q=&#34;&gt;&lt;script&gt;" rel="nofollow noopener noreferrer">x</a>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Sanitize(tt.input))
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// AccommodationPostgresRepository はAccommodationエンティティのPostgreSQL実装
+type AccommodationPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewAccommodationPostgresRepository は新しいAccommodationPostgresRepositoryを作成する
+func NewAccommodationPostgresRepository(db postgres.DBTX) accommodation.AccommodationRepository {
+	return &AccommodationPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDのAccommodationを取得する
+func (r *AccommodationPostgresRepository) FindByID(ctx context.Context, id accommodation.AccommodationID) (*accommodation.Accommodation, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUUID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert accommodation ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindAccommodation(ctx, pgUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, accommodation.NewAccommodationNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch accommodation from database", apperr.WithCause(err))
+	}
+
+	a, err := r.mapToAccommodation(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to accommodation domain object", apperr.WithCause(err))
+	}
+
+	return a, nil
+}
+
+// FindByTripID は指定された旅行に紐づくAccommodationをチェックイン順に取得する
+func (r *AccommodationPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID) ([]*accommodation.Accommodation, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListAccommodationsByTripID(ctx, pgTripID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch accommodations list from database", apperr.WithCause(err))
+	}
+
+	accommodations := make([]*accommodation.Accommodation, 0, len(records))
+	for _, record := range records {
+		a, err := r.mapToAccommodation(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to accommodation domain object", apperr.WithCause(err))
+		}
+		accommodations = append(accommodations, a)
+	}
+
+	return accommodations, nil
+}
+
+// Create は新しいAccommodationを作成する
+func (r *AccommodationPostgresRepository) Create(ctx context.Context, a *accommodation.Accommodation) error {
+	if a == nil {
+		return apperr.NewInternalError("Accommodation entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUUID, err := mapper.ToUUID(a.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert accommodation ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgTripID, err := mapper.ToUUID(a.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgCheckInAt, err := mapper.ToTimestamp(a.Stay().CheckInAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert accommodation check_in_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgCheckOutAt, err := mapper.ToTimestamp(a.Stay().CheckOutAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert accommodation check_out_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(a.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert accommodation created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(a.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert accommodation updated_at to timestamp", apperr.WithCause(err))
+	}
+
+	params := postgres.CreateAccommodationParams{
+		ID:                 pgUUID,
+		TripID:             pgTripID,
+		Name:               a.Name(),
+		Address:            a.Address(),
+		CheckInAt:          pgCheckInAt,
+		CheckOutAt:         pgCheckOutAt,
+		ConfirmationNumber: a.ConfirmationNumber(),
+		CostAmount:         a.Cost().Amount(),
+		CostCurrency:       a.Cost().Currency(),
+		Notes:              a.Notes(),
+		CreatedAt:          pgCreatedAt,
+		UpdatedAt:          pgUpdatedAt,
+		Timezone:           timezoneColumn(mapper, a.Timezone()),
+	}
+
+	if err := queries.CreateAccommodation(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create accommodation in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Update は既存のAccommodationを更新する
+func (r *AccommodationPostgresRepository) Update(ctx context.Context, a *accommodation.Accommodation) error {
+	if a == nil {
+		return apperr.NewInternalError("Accommodation entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUUID, err := mapper.ToUUID(a.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert accommodation ID to UUID for update", apperr.WithCause(err))
+	}
+
+	pgCheckInAt, err := mapper.ToTimestamp(a.Stay().CheckInAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert accommodation check_in_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	pgCheckOutAt, err := mapper.ToTimestamp(a.Stay().CheckOutAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert accommodation check_out_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(a.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert accommodation updated_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	params := postgres.UpdateAccommodationParams{
+		ID:                 pgUUID,
+		Name:               a.Name(),
+		Address:            a.Address(),
+		CheckInAt:          pgCheckInAt,
+		CheckOutAt:         pgCheckOutAt,
+		ConfirmationNumber: a.ConfirmationNumber(),
+		CostAmount:         a.Cost().Amount(),
+		CostCurrency:       a.Cost().Currency(),
+		Notes:              a.Notes(),
+		UpdatedAt:          pgUpdatedAt,
+		Timezone:           timezoneColumn(mapper, a.Timezone()),
+	}
+
+	if err := queries.UpdateAccommodation(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to update accommodation in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDのAccommodationを削除する
+func (r *AccommodationPostgresRepository) Delete(ctx context.Context, id accommodation.AccommodationID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUUID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert accommodation ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeleteAccommodation(ctx, pgUUID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete accommodation from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return accommodation.NewAccommodationNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToAccommodation はデータベースレコードをドメインオブジェクトに変換する
+func (r *AccommodationPostgresRepository) mapToAccommodation(record postgres.Accommodation) (*accommodation.Accommodation, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	checkInAt, err := mapper.FromTimestamp(record.CheckInAt)
+	if err != nil {
+		return nil, err
+	}
+
+	checkOutAt, err := mapper.FromTimestamp(record.CheckOutAt)
+	if err != nil {
+		return nil, err
+	}
+
+	stay, err := accommodation.NewStay(checkInAt, checkOutAt)
+	if err != nil {
+		return nil, err
+	}
+
+	cost, err := money.NewMoney(record.CostAmount, record.CostCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone, err := timezoneFromColumn(mapper, record.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt, err := mapper.FromTimestamp(record.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return accommodation.NewAccommodation(
+		accommodation.NewAccommodationID(id),
+		trip.NewTripID(tripID),
+		record.Name,
+		record.Address,
+		timezone,
+		stay,
+		record.ConfirmationNumber,
+		cost,
+		record.Notes,
+		createdAt,
+		updatedAt,
+	), nil
+}
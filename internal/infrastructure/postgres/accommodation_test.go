@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accommodationTestSuite テスト用の共通セットアップ
+type accommodationTestSuite struct {
+	ctx      context.Context
+	repo     accommodation.AccommodationRepository
+	tripRepo trip.TripRepository
+}
+
+// newAccommodationTestSuite テストスイートを作成する（トランザクション分離）
+func newAccommodationTestSuite(t *testing.T) *accommodationTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	return &accommodationTestSuite{
+		ctx:      ctx,
+		repo:     NewAccommodationPostgresRepository(tx),
+		tripRepo: NewTripPostgresRepository(tx),
+	}
+}
+
+// createTrip 宿泊予約の親となるTripを作成する
+func (s *accommodationTestSuite) createTrip(t *testing.T) trip.TripID {
+	t.Helper()
+
+	tt := newTestTrip("宿泊テスト旅行")
+	require.NoError(t, s.tripRepo.Create(s.ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+
+	return tt.ID
+}
+
+// newTestAccommodation テスト用のAccommodationを生成する
+func newTestAccommodation(t *testing.T, tripID trip.TripID, name string, checkInAt time.Time) *accommodation.Accommodation {
+	t.Helper()
+
+	stay, err := accommodation.NewStay(checkInAt, checkInAt.Add(20*time.Hour))
+	require.NoError(t, err, "Stayの生成に失敗")
+	cost, err := money.NewMoney(12000, "JPY")
+	require.NoError(t, err, "Moneyの生成に失敗")
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	return accommodation.NewAccommodation(
+		accommodation.NewAccommodationID(uuid.New().String()),
+		tripID,
+		name,
+		"東京都千代田区1-1",
+		nil,
+		stay,
+		"CONF-001",
+		cost,
+		"",
+		now,
+		now,
+	)
+}
+
+// assertAccommodationEquals Accommodationの等価性をアサートする
+func assertAccommodationEquals(t *testing.T, expected, actual *accommodation.Accommodation) {
+	t.Helper()
+	assert.Equal(t, expected.ID(), actual.ID(), "IDが一致すること")
+	assert.Equal(t, expected.TripID(), actual.TripID(), "TripIDが一致すること")
+	assert.Equal(t, expected.Name(), actual.Name(), "Nameが一致すること")
+	assert.Equal(t, expected.Address(), actual.Address(), "Addressが一致すること")
+	assert.Equal(t, expected.Timezone(), actual.Timezone(), "Timezoneが一致すること")
+	assert.True(t, expected.Stay().Equals(actual.Stay()), "Stayが一致すること")
+	assert.Equal(t, expected.ConfirmationNumber(), actual.ConfirmationNumber(), "ConfirmationNumberが一致すること")
+	assert.True(t, expected.Cost().Equals(actual.Cost()), "Costが一致すること")
+	assert.Equal(t, expected.Notes(), actual.Notes(), "Notesが一致すること")
+}
+
+func TestAccommodationPostgresRepository_CreateAndFindByID(t *testing.T) {
+	t.Run("作成したAccommodationを取得できること", func(t *testing.T) {
+		suite := newAccommodationTestSuite(t)
+
+		// Given: 旅行と宿泊予約
+		tripID := suite.createTrip(t)
+		a := newTestAccommodation(t, tripID, "テストホテル", time.Date(2025, 8, 1, 15, 0, 0, 0, time.UTC))
+
+		// When: 作成して取得する
+		require.NoError(t, suite.repo.Create(suite.ctx, a), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, a.ID())
+
+		// Then: 作成した内容と一致する
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertAccommodationEquals(t, a, found)
+	})
+
+	t.Run("存在しないIDでAccommodationNotFoundが返されること", func(t *testing.T) {
+		suite := newAccommodationTestSuite(t)
+
+		_, err := suite.repo.FindByID(suite.ctx, accommodation.NewAccommodationID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, accommodation.NewAccommodationNotFoundError(),
+			"AccommodationNotFoundが返されるべき")
+	})
+
+	t.Run("存在しない旅行に紐づけるとInternalErrorが返されること", func(t *testing.T) {
+		suite := newAccommodationTestSuite(t)
+
+		a := newTestAccommodation(t, trip.NewTripID(uuid.New().String()), "孤立ホテル", time.Date(2025, 8, 1, 15, 0, 0, 0, time.UTC))
+
+		err := suite.repo.Create(suite.ctx, a)
+
+		assert.ErrorIs(t, err, apperr.NewInternalError(""),
+			"InternalErrorが返されるべき")
+	})
+}
+
+func TestAccommodationPostgresRepository_FindByTripID(t *testing.T) {
+	t.Run("旅行に紐づくAccommodationがチェックイン順に取得できること", func(t *testing.T) {
+		suite := newAccommodationTestSuite(t)
+
+		// Given: 同じ旅行に2件、別の旅行に1件の宿泊予約
+		tripID := suite.createTrip(t)
+		otherTripID := suite.createTrip(t)
+		second := newTestAccommodation(t, tripID, "2泊目", time.Date(2025, 8, 2, 15, 0, 0, 0, time.UTC))
+		first := newTestAccommodation(t, tripID, "1泊目", time.Date(2025, 8, 1, 15, 0, 0, 0, time.UTC))
+		other := newTestAccommodation(t, otherTripID, "別旅行", time.Date(2025, 8, 1, 15, 0, 0, 0, time.UTC))
+		for _, a := range []*accommodation.Accommodation{second, first, other} {
+			require.NoError(t, suite.repo.Create(suite.ctx, a), "Createでエラーが発生してはならない")
+		}
+
+		// When: 旅行IDで取得する
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID)
+
+		// Then: 対象旅行の宿泊予約のみがチェックイン順で返される
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 2, "対象旅行の宿泊予約のみが返されるべき")
+		assert.Equal(t, first.ID(), found[0].ID(), "チェックインが早い宿泊予約が先に返されるべき")
+		assert.Equal(t, second.ID(), found[1].ID(), "チェックインが遅い宿泊予約が後に返されるべき")
+	})
+}
+
+func TestAccommodationPostgresRepository_Update(t *testing.T) {
+	t.Run("既存のAccommodationを更新できること", func(t *testing.T) {
+		suite := newAccommodationTestSuite(t)
+
+		// Given: 作成済みの宿泊予約
+		tripID := suite.createTrip(t)
+		a := newTestAccommodation(t, tripID, "更新前ホテル", time.Date(2025, 8, 1, 15, 0, 0, 0, time.UTC))
+		require.NoError(t, suite.repo.Create(suite.ctx, a), "Createでエラーが発生してはならない")
+
+		// When: 名前・タイムゾーン・費用を更新する
+		cost, err := money.NewMoney(150, "USD")
+		require.NoError(t, err, "Moneyの生成に失敗")
+		tz, err := geo.NewTimezone("Pacific/Honolulu")
+		require.NoError(t, err, "Timezoneの生成に失敗")
+		updated := a.Update("更新後ホテル", a.Address(), &tz, a.Stay(), "CONF-002", cost, "朝食付き",
+			time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, suite.repo.Update(suite.ctx, updated), "Updateでエラーが発生してはならない")
+
+		// Then: 更新内容が反映される
+		found, err := suite.repo.FindByID(suite.ctx, a.ID())
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertAccommodationEquals(t, updated, found)
+	})
+}
+
+func TestAccommodationPostgresRepository_Delete(t *testing.T) {
+	t.Run("既存のAccommodationを削除できること", func(t *testing.T) {
+		suite := newAccommodationTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		a := newTestAccommodation(t, tripID, "削除ホテル", time.Date(2025, 8, 1, 15, 0, 0, 0, time.UTC))
+		require.NoError(t, suite.repo.Create(suite.ctx, a), "Createでエラーが発生してはならない")
+
+		require.NoError(t, suite.repo.Delete(suite.ctx, a.ID()), "Deleteでエラーが発生してはならない")
+
+		_, err := suite.repo.FindByID(suite.ctx, a.ID())
+		assert.ErrorIs(t, err, accommodation.NewAccommodationNotFoundError(),
+			"削除後はAccommodationNotFoundが返されるべき")
+	})
+
+	t.Run("存在しないIDでAccommodationNotFoundが返されること", func(t *testing.T) {
+		suite := newAccommodationTestSuite(t)
+
+		err := suite.repo.Delete(suite.ctx, accommodation.NewAccommodationID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, accommodation.NewAccommodationNotFoundError(),
+			"AccommodationNotFoundが返されるべき")
+	})
+}
@@ -0,0 +1,308 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ActivityPostgresRepository はActivityエンティティのPostgreSQL実装
+type ActivityPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewActivityPostgresRepository は新しいActivityPostgresRepositoryを作成する
+func NewActivityPostgresRepository(db postgres.DBTX) itinerary.ActivityRepository {
+	return &ActivityPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDの行動を取得する
+func (r *ActivityPostgresRepository) FindByID(ctx context.Context, id itinerary.ActivityID) (*itinerary.Activity, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert activity ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindActivity(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, itinerary.NewActivityNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch activity from database", apperr.WithCause(err))
+	}
+
+	a, err := r.mapToActivity(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to activity domain object", apperr.WithCause(err))
+	}
+
+	return a, nil
+}
+
+// FindByTripID は指定された旅行の行動を日付と並び順の順に取得する。date が nil でない場合はその日付の行動だけを取得する
+func (r *ActivityPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID, date *time.Time) ([]*itinerary.Activity, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	var records []postgres.Activity
+	if date == nil {
+		records, err = queries.ListActivitiesByTripID(ctx, pgTripID)
+	} else {
+		var pgDate pgtype.Date
+		pgDate, err = mapper.ToDate(*date)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to convert activity date", apperr.WithCause(err))
+		}
+		records, err = queries.ListActivitiesByTripIDAndDate(ctx, postgres.ListActivitiesByTripIDAndDateParams{
+			TripID:       pgTripID,
+			ActivityDate: pgDate,
+		})
+	}
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch activities list from database", apperr.WithCause(err))
+	}
+
+	activities := make([]*itinerary.Activity, 0, len(records))
+	for _, record := range records {
+		a, err := r.mapToActivity(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to activity domain object", apperr.WithCause(err))
+		}
+		activities = append(activities, a)
+	}
+
+	return activities, nil
+}
+
+// Create は新しい行動を作成する
+func (r *ActivityPostgresRepository) Create(ctx context.Context, a *itinerary.Activity) error {
+	if a == nil {
+		return apperr.NewInternalError("Activity entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(a.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgTripID, err := mapper.ToUUID(a.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgDate, err := mapper.ToDate(a.Date())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity date for creation", apperr.WithCause(err))
+	}
+
+	pgStartAt, err := mapper.ToNullableTimestamp(a.StartAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity start_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgEndAt, err := mapper.ToNullableTimestamp(a.EndAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity end_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(a.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(a.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity updated_at to timestamp", apperr.WithCause(err))
+	}
+
+	placeName, latitude, longitude, placeTimezone := placeColumns(mapper, a.Place())
+	params := postgres.CreateActivityParams{
+		ID:             pgID,
+		TripID:         pgTripID,
+		ActivityDate:   pgDate,
+		Position:       int32(a.Position()),
+		Title:          a.Title(),
+		PlaceName:      placeName,
+		PlaceLatitude:  latitude,
+		PlaceLongitude: longitude,
+		StartAt:        pgStartAt,
+		EndAt:          pgEndAt,
+		TravelMode:     a.TravelMode().String(),
+		Notes:          a.Notes(),
+		CreatedAt:      pgCreatedAt,
+		UpdatedAt:      pgUpdatedAt,
+		PlaceTimezone:  placeTimezone,
+		Timezone:       timezoneColumn(mapper, a.Timezone()),
+	}
+
+	if err := queries.CreateActivity(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create activity in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Update は既存の行動を更新する
+func (r *ActivityPostgresRepository) Update(ctx context.Context, a *itinerary.Activity) error {
+	if a == nil {
+		return apperr.NewInternalError("Activity entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(a.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity ID to UUID for update", apperr.WithCause(err))
+	}
+
+	pgDate, err := mapper.ToDate(a.Date())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity date for update", apperr.WithCause(err))
+	}
+
+	pgStartAt, err := mapper.ToNullableTimestamp(a.StartAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity start_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	pgEndAt, err := mapper.ToNullableTimestamp(a.EndAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity end_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(a.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity updated_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	placeName, latitude, longitude, placeTimezone := placeColumns(mapper, a.Place())
+	rows, err := queries.UpdateActivity(ctx, postgres.UpdateActivityParams{
+		ID:             pgID,
+		ActivityDate:   pgDate,
+		Position:       int32(a.Position()),
+		Title:          a.Title(),
+		PlaceName:      placeName,
+		PlaceLatitude:  latitude,
+		PlaceLongitude: longitude,
+		StartAt:        pgStartAt,
+		EndAt:          pgEndAt,
+		TravelMode:     a.TravelMode().String(),
+		Notes:          a.Notes(),
+		UpdatedAt:      pgUpdatedAt,
+		PlaceTimezone:  placeTimezone,
+		Timezone:       timezoneColumn(mapper, a.Timezone()),
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to update activity in database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return itinerary.NewActivityNotFoundError()
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDの行動を削除する
+func (r *ActivityPostgresRepository) Delete(ctx context.Context, id itinerary.ActivityID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeleteActivity(ctx, pgID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete activity from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return itinerary.NewActivityNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToActivity はデータベースレコードをドメインオブジェクトに変換する
+func (r *ActivityPostgresRepository) mapToActivity(record postgres.Activity) (*itinerary.Activity, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	date, err := mapper.FromDate(record.ActivityDate)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := itinerary.ParseTravelMode(record.TravelMode)
+	if err != nil {
+		return nil, err
+	}
+
+	place, err := placeFromColumns(mapper, record.PlaceName, record.PlaceLatitude, record.PlaceLongitude, record.PlaceTimezone)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone, err := timezoneFromColumn(mapper, record.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt, err := mapper.FromTimestamp(record.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return itinerary.NewActivity(
+		itinerary.NewActivityID(id),
+		trip.NewTripID(tripID),
+		date,
+		int(record.Position),
+		record.Title,
+		place,
+		timezone,
+		utcTime(mapper.FromNullableTimestamp(record.StartAt)),
+		utcTime(mapper.FromNullableTimestamp(record.EndAt)),
+		mode,
+		record.Notes,
+		createdAt,
+		updatedAt,
+	)
+}
@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// activityTestSuite テスト用の共通セットアップ
+type activityTestSuite struct {
+	ctx      context.Context
+	repo     itinerary.ActivityRepository
+	tripRepo trip.TripRepository
+}
+
+// newActivityTestSuite テストスイートを作成する（トランザクション分離）
+func newActivityTestSuite(t *testing.T) *activityTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	return &activityTestSuite{
+		ctx:      ctx,
+		repo:     NewActivityPostgresRepository(tx),
+		tripRepo: NewTripPostgresRepository(tx),
+	}
+}
+
+// createTrip 行動の親となるTripを作成する
+func (s *activityTestSuite) createTrip(t *testing.T) trip.TripID {
+	t.Helper()
+
+	tt := newTestTrip("行程テスト旅行")
+	require.NoError(t, s.tripRepo.Create(s.ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+
+	return tt.ID
+}
+
+// newTestActivity テスト用の行動を生成する
+func newTestActivity(t *testing.T, tripID trip.TripID, date time.Time, position int, place *geo.Place, createdAt time.Time) *itinerary.Activity {
+	t.Helper()
+
+	startAt := date.Add(9 * time.Hour)
+	endAt := startAt.Add(90 * time.Minute)
+	a, err := itinerary.NewActivity(
+		itinerary.NewActivityID(uuid.New().String()),
+		tripID,
+		date,
+		position,
+		"嵐山の竹林",
+		place,
+		nil,
+		&startAt,
+		&endAt,
+		itinerary.TravelModeTransit,
+		"朝早くに行く",
+		createdAt,
+		createdAt,
+	)
+	require.NoError(t, err)
+	return a
+}
+
+// assertActivityEquals 行動の等価性をアサートする
+func assertActivityEquals(t *testing.T, expected, actual *itinerary.Activity) {
+	t.Helper()
+	assert.Equal(t, expected.ID(), actual.ID(), "IDが一致すること")
+	assert.Equal(t, expected.TripID(), actual.TripID(), "TripIDが一致すること")
+	assert.True(t, expected.Date().Equal(actual.Date()), "Dateが一致すること")
+	assert.Equal(t, expected.Position(), actual.Position(), "Positionが一致すること")
+	assert.Equal(t, expected.Title(), actual.Title(), "Titleが一致すること")
+	assert.Equal(t, expected.Place(), actual.Place(), "Placeが一致すること")
+	assert.Equal(t, expected.Timezone(), actual.Timezone(), "Timezoneが一致すること")
+	assert.Equal(t, expected.StartAt(), actual.StartAt(), "StartAtが一致すること")
+	assert.Equal(t, expected.EndAt(), actual.EndAt(), "EndAtが一致すること")
+	assert.Equal(t, expected.TravelMode(), actual.TravelMode(), "TravelModeが一致すること")
+	assert.Equal(t, expected.Notes(), actual.Notes(), "Notesが一致すること")
+	assert.True(t, expected.CreatedAt().Equal(actual.CreatedAt()), "CreatedAtが一致すること")
+	assert.True(t, expected.UpdatedAt().Equal(actual.UpdatedAt()), "UpdatedAtが一致すること")
+}
+
+func TestActivityPostgresRepository_CreateAndFindByID(t *testing.T) {
+	t.Run("作成した行動を場所と日時を含めて取得できること", func(t *testing.T) {
+		suite := newActivityTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		a := newTestActivity(t, tripID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), 0, newTestPlace(t), now)
+
+		require.NoError(t, suite.repo.Create(suite.ctx, a), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, a.ID())
+
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertActivityEquals(t, a, found)
+	})
+
+	t.Run("存在しないIDでActivityNotFoundが返されること", func(t *testing.T) {
+		suite := newActivityTestSuite(t)
+
+		_, err := suite.repo.FindByID(suite.ctx, itinerary.NewActivityID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, itinerary.NewActivityNotFoundError(),
+			"ActivityNotFoundが返されるべき")
+	})
+}
+
+func TestActivityPostgresRepository_FindByTripID(t *testing.T) {
+	suite := newActivityTestSuite(t)
+
+	// Given: 同じ旅行に3件、別の旅行に1件の行動
+	tripID := suite.createTrip(t)
+	otherTripID := suite.createTrip(t)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	day1 := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	secondDay := newTestActivity(t, tripID, day2, 0, nil, now.Add(-2*time.Hour))
+	firstDaySecond := newTestActivity(t, tripID, day1, 1, nil, now.Add(-time.Hour))
+	firstDayFirst := newTestActivity(t, tripID, day1, 0, nil, now)
+	other := newTestActivity(t, otherTripID, day1, 0, nil, now)
+	for _, a := range []*itinerary.Activity{secondDay, firstDaySecond, firstDayFirst, other} {
+		require.NoError(t, suite.repo.Create(suite.ctx, a), "Createでエラーが発生してはならない")
+	}
+
+	t.Run("旅行に紐づく行動が日付順・並び順に取得できること", func(t *testing.T) {
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID, nil)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 3, "対象旅行の行動のみが返されるべき")
+		assert.Equal(t, firstDayFirst.ID(), found[0].ID())
+		assert.Equal(t, firstDaySecond.ID(), found[1].ID())
+		assert.Equal(t, secondDay.ID(), found[2].ID())
+	})
+
+	t.Run("日付を指定するとその日の行動だけが取得できること", func(t *testing.T) {
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID, &day2)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 1)
+		assert.Equal(t, secondDay.ID(), found[0].ID())
+	})
+}
+
+func TestActivityPostgresRepository_Update(t *testing.T) {
+	t.Run("内容の変更と場所・日時の削除が反映されること", func(t *testing.T) {
+		suite := newActivityTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		a := newTestActivity(t, tripID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), 0, newTestPlace(t), now)
+		require.NoError(t, suite.repo.Create(suite.ctx, a), "Createでエラーが発生してはならない")
+
+		updated, err := a.Update(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), "渡月橋", nil, nil, nil, nil, itinerary.TravelModeWalk, "", now.Add(time.Hour))
+		require.NoError(t, err)
+		updated = updated.MoveTo(3, now.Add(time.Hour))
+		require.NoError(t, suite.repo.Update(suite.ctx, updated), "Updateでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByID(suite.ctx, a.ID())
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertActivityEquals(t, updated, found)
+	})
+
+	t.Run("場所のない行動のタイムゾーンが反映されること", func(t *testing.T) {
+		suite := newActivityTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		a := newTestActivity(t, tripID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), 0, nil, now)
+		require.NoError(t, suite.repo.Create(suite.ctx, a), "Createでエラーが発生してはならない")
+
+		paris, err := geo.NewTimezone("Europe/Paris")
+		require.NoError(t, err)
+		updated, err := a.Update(a.Date(), "シャルル・ド・ゴール空港", nil, &paris, a.StartAt(), a.EndAt(), itinerary.TravelModeTransit, "", now.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, suite.repo.Update(suite.ctx, updated), "Updateでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByID(suite.ctx, a.ID())
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		require.NotNil(t, found.Timezone())
+		assert.Equal(t, "Europe/Paris", found.Timezone().Name())
+	})
+
+	t.Run("存在しない行動の更新でActivityNotFoundが返されること", func(t *testing.T) {
+		suite := newActivityTestSuite(t)
+
+		a := newTestActivity(t, trip.NewTripID(uuid.New().String()), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), 0, nil, time.Now())
+
+		err := suite.repo.Update(suite.ctx, a)
+
+		assert.ErrorIs(t, err, itinerary.NewActivityNotFoundError(),
+			"ActivityNotFoundが返されるべき")
+	})
+}
+
+func TestActivityPostgresRepository_Delete(t *testing.T) {
+	t.Run("削除した行動は取得できないこと", func(t *testing.T) {
+		suite := newActivityTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		a := newTestActivity(t, tripID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), 0, nil, time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, suite.repo.Create(suite.ctx, a), "Createでエラーが発生してはならない")
+
+		require.NoError(t, suite.repo.Delete(suite.ctx, a.ID()), "Deleteでエラーが発生してはならない")
+
+		_, err := suite.repo.FindByID(suite.ctx, a.ID())
+		assert.ErrorIs(t, err, itinerary.NewActivityNotFoundError())
+	})
+
+	t.Run("存在しない行動の削除でActivityNotFoundが返されること", func(t *testing.T) {
+		suite := newActivityTestSuite(t)
+
+		err := suite.repo.Delete(suite.ctx, itinerary.NewActivityID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, itinerary.NewActivityNotFoundError())
+	})
+}
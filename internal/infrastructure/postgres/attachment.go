@@ -0,0 +1,216 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// AttachmentPostgresRepository はAttachmentエンティティのPostgreSQL実装
+type AttachmentPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewAttachmentPostgresRepository は新しいAttachmentPostgresRepositoryを作成する
+func NewAttachmentPostgresRepository(db postgres.DBTX) attachment.AttachmentRepository {
+	return &AttachmentPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDの添付ファイルを取得する
+func (r *AttachmentPostgresRepository) FindByID(ctx context.Context, id attachment.AttachmentID) (*attachment.Attachment, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert attachment ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindAttachment(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, attachment.NewAttachmentNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch attachment from database", apperr.WithCause(err))
+	}
+
+	a, err := r.mapToAttachment(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to attachment domain object", apperr.WithCause(err))
+	}
+
+	return a, nil
+}
+
+// FindByTripID は指定された旅行の添付ファイルを追加された順に取得する。target が nil でない場合はそのリソースの添付ファイルだけを取得する
+func (r *AttachmentPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID, target *attachment.Target) ([]*attachment.Attachment, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	var records []postgres.Attachment
+	if target == nil {
+		records, err = queries.ListAttachmentsByTripID(ctx, pgTripID)
+	} else {
+		var pgTargetID pgtype.UUID
+		pgTargetID, err = mapper.ToUUID(target.ID())
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to convert attachment target ID to UUID", apperr.WithCause(err))
+		}
+		records, err = queries.ListAttachmentsByTarget(ctx, postgres.ListAttachmentsByTargetParams{
+			TripID:     pgTripID,
+			TargetType: target.Type().String(),
+			TargetID:   pgTargetID,
+		})
+	}
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch attachments list from database", apperr.WithCause(err))
+	}
+
+	attachments := make([]*attachment.Attachment, 0, len(records))
+	for _, record := range records {
+		a, err := r.mapToAttachment(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to attachment domain object", apperr.WithCause(err))
+		}
+		attachments = append(attachments, a)
+	}
+
+	return attachments, nil
+}
+
+// Create は新しい添付ファイルを作成する
+func (r *AttachmentPostgresRepository) Create(ctx context.Context, a *attachment.Attachment) error {
+	if a == nil {
+		return apperr.NewInternalError("Attachment entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(a.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert attachment ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgTripID, err := mapper.ToUUID(a.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgTargetID, err := mapper.ToUUID(a.Target().ID())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert attachment target ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgUploadedBy, err := mapper.ToUUID(a.UploadedBy().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert uploader ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(a.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert attachment created_at to timestamp", apperr.WithCause(err))
+	}
+
+	params := postgres.CreateAttachmentParams{
+		ID:          pgID,
+		TripID:      pgTripID,
+		TargetType:  a.Target().Type().String(),
+		TargetID:    pgTargetID,
+		Filename:    a.Filename(),
+		ContentType: a.ContentType(),
+		SizeBytes:   a.Size(),
+		StorageKey:  a.StorageKey(),
+		UploadedBy:  pgUploadedBy,
+		CreatedAt:   pgCreatedAt,
+	}
+
+	if err := queries.CreateAttachment(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create attachment in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDの添付ファイルを削除する
+func (r *AttachmentPostgresRepository) Delete(ctx context.Context, id attachment.AttachmentID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert attachment ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeleteAttachment(ctx, pgID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete attachment from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return attachment.NewAttachmentNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToAttachment はデータベースレコードをドメインオブジェクトに変換する
+func (r *AttachmentPostgresRepository) mapToAttachment(record postgres.Attachment) (*attachment.Attachment, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetType, err := attachment.ParseTargetType(record.TargetType)
+	if err != nil {
+		return nil, err
+	}
+
+	targetID, err := mapper.FromUUID(record.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadedBy, err := mapper.FromUUID(record.UploadedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachment.NewAttachment(
+		attachment.NewAttachmentID(id),
+		trip.NewTripID(tripID),
+		attachment.NewTarget(targetType, targetID),
+		record.Filename,
+		record.ContentType,
+		record.SizeBytes,
+		record.StorageKey,
+		user.NewUserID(uploadedBy),
+		createdAt,
+	), nil
+}
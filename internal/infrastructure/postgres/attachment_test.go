@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// attachmentTestSuite テスト用の共通セットアップ
+type attachmentTestSuite struct {
+	ctx        context.Context
+	repo       attachment.AttachmentRepository
+	tripRepo   trip.TripRepository
+	uploaderID user.UserID
+}
+
+// newAttachmentTestSuite テストスイートを作成する（トランザクション分離）
+func newAttachmentTestSuite(t *testing.T) *attachmentTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	uploader := newTestUser("attachment-uploader", "attachment-uploader@example.com")
+	require.NoError(t, NewUserPostgresRepository(tx).Create(ctx, uploader.toDomainUser()), "Userの作成に失敗")
+
+	return &attachmentTestSuite{
+		ctx:        ctx,
+		repo:       NewAttachmentPostgresRepository(tx),
+		tripRepo:   NewTripPostgresRepository(tx),
+		uploaderID: uploader.ID,
+	}
+}
+
+// createTrip 添付ファイルの親となるTripを作成する
+func (s *attachmentTestSuite) createTrip(t *testing.T) trip.TripID {
+	t.Helper()
+
+	tt := newTestTrip("添付ファイルテスト旅行")
+	require.NoError(t, s.tripRepo.Create(s.ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+
+	return tt.ID
+}
+
+// newAttachment テスト用の添付ファイルを生成する
+func (s *attachmentTestSuite) newAttachment(tripID trip.TripID, target attachment.Target, createdAt time.Time) *attachment.Attachment {
+	id := attachment.NewAttachmentID(uuid.New().String())
+	return attachment.NewAttachment(
+		id,
+		tripID,
+		target,
+		"搭乗券.pdf",
+		attachment.ContentTypePDF,
+		2048,
+		attachment.StorageKey(tripID, id),
+		s.uploaderID,
+		createdAt,
+	)
+}
+
+// assertAttachmentEquals 添付ファイルの等価性をアサートする
+func assertAttachmentEquals(t *testing.T, expected, actual *attachment.Attachment) {
+	t.Helper()
+	assert.Equal(t, expected.ID(), actual.ID(), "IDが一致すること")
+	assert.Equal(t, expected.TripID(), actual.TripID(), "TripIDが一致すること")
+	assert.Equal(t, expected.Target(), actual.Target(), "Targetが一致すること")
+	assert.Equal(t, expected.Filename(), actual.Filename(), "Filenameが一致すること")
+	assert.Equal(t, expected.ContentType(), actual.ContentType(), "ContentTypeが一致すること")
+	assert.Equal(t, expected.Size(), actual.Size(), "Sizeが一致すること")
+	assert.Equal(t, expected.StorageKey(), actual.StorageKey(), "StorageKeyが一致すること")
+	assert.Equal(t, expected.UploadedBy(), actual.UploadedBy(), "UploadedByが一致すること")
+	assert.True(t, expected.CreatedAt().Equal(actual.CreatedAt()), "CreatedAtが一致すること")
+}
+
+func TestAttachmentPostgresRepository_CreateAndFindByID(t *testing.T) {
+	t.Run("作成した添付ファイルを取得できること", func(t *testing.T) {
+		suite := newAttachmentTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		a := suite.newAttachment(tripID, attachment.NewTarget(attachment.TargetTypeTrip, tripID.String()), time.Now().UTC().Truncate(time.Microsecond))
+
+		require.NoError(t, suite.repo.Create(suite.ctx, a), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, a.ID())
+
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertAttachmentEquals(t, a, found)
+	})
+
+	t.Run("存在しないIDでAttachmentNotFoundが返されること", func(t *testing.T) {
+		suite := newAttachmentTestSuite(t)
+
+		_, err := suite.repo.FindByID(suite.ctx, attachment.NewAttachmentID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, attachment.NewAttachmentNotFoundError(),
+			"AttachmentNotFoundが返されるべき")
+	})
+}
+
+func TestAttachmentPostgresRepository_FindByTripID(t *testing.T) {
+	suite := newAttachmentTestSuite(t)
+
+	// Given: 同じ旅行に旅行自体の添付ファイルと予約の添付ファイル2件、別の旅行に1件
+	tripID := suite.createTrip(t)
+	otherTripID := suite.createTrip(t)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	accommodationTarget := attachment.NewTarget(attachment.TargetTypeAccommodation, uuid.New().String())
+	tripAttachment := suite.newAttachment(tripID, attachment.NewTarget(attachment.TargetTypeTrip, tripID.String()), now.Add(-2*time.Hour))
+	voucherLater := suite.newAttachment(tripID, accommodationTarget, now)
+	voucherEarlier := suite.newAttachment(tripID, accommodationTarget, now.Add(-time.Hour))
+	other := suite.newAttachment(otherTripID, attachment.NewTarget(attachment.TargetTypeTrip, otherTripID.String()), now)
+	for _, a := range []*attachment.Attachment{tripAttachment, voucherLater, voucherEarlier, other} {
+		require.NoError(t, suite.repo.Create(suite.ctx, a), "Createでエラーが発生してはならない")
+	}
+
+	t.Run("旅行に紐づく添付ファイルが追加された順に取得できること", func(t *testing.T) {
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID, nil)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 3, "対象旅行の添付ファイルのみが返されるべき")
+		assert.Equal(t, tripAttachment.ID(), found[0].ID())
+		assert.Equal(t, voucherEarlier.ID(), found[1].ID())
+		assert.Equal(t, voucherLater.ID(), found[2].ID())
+	})
+
+	t.Run("添付先を指定するとそのリソースの添付ファイルだけが取得できること", func(t *testing.T) {
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID, &accommodationTarget)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 2)
+		assert.Equal(t, voucherEarlier.ID(), found[0].ID())
+		assert.Equal(t, voucherLater.ID(), found[1].ID())
+	})
+}
+
+func TestAttachmentPostgresRepository_Delete(t *testing.T) {
+	t.Run("既存の添付ファイルを削除できること", func(t *testing.T) {
+		suite := newAttachmentTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		a := suite.newAttachment(tripID, attachment.NewTarget(attachment.TargetTypeTrip, tripID.String()), time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, suite.repo.Create(suite.ctx, a), "Createでエラーが発生してはならない")
+
+		require.NoError(t, suite.repo.Delete(suite.ctx, a.ID()), "Deleteでエラーが発生してはならない")
+
+		_, err := suite.repo.FindByID(suite.ctx, a.ID())
+		assert.ErrorIs(t, err, attachment.NewAttachmentNotFoundError(),
+			"削除後はAttachmentNotFoundが返されるべき")
+	})
+
+	t.Run("存在しない添付ファイルの削除でAttachmentNotFoundが返されること", func(t *testing.T) {
+		suite := newAttachmentTestSuite(t)
+
+		err := suite.repo.Delete(suite.ctx, attachment.NewAttachmentID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, attachment.NewAttachmentNotFoundError(),
+			"AttachmentNotFoundが返されるべき")
+	})
+}
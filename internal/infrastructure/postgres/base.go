@@ -29,6 +29,14 @@ func (r *BasePostgresRepository) GetQueries(ctx context.Context) *postgres.Queri
 	return postgres.New(r.db) // なければ通常のDBプール対応のQueriesを返す
 }
 
+// GetDB はコンテキストのトランザクションを考慮した接続を返します。sqlc で表現できない動的なクエリの実行に使用します。
+func (r *BasePostgresRepository) GetDB(ctx context.Context) postgres.DBTX {
+	if tx, ok := GetTxFromContext(ctx); ok {
+		return tx
+	}
+	return r.db
+}
+
 func (r *BasePostgresRepository) GetTypeMapper() *mapper.PostgreSQLTypeMapper {
 	return r.typeMapper
 }
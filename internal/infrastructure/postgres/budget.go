@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/hata0/travel-api/internal/domain/budget"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// BudgetPostgresRepository はBudgetエンティティのPostgreSQL実装
+type BudgetPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewBudgetPostgresRepository は新しいBudgetPostgresRepositoryを作成する
+func NewBudgetPostgresRepository(db postgres.DBTX) budget.BudgetRepository {
+	return &BudgetPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByTripID は指定された旅行のBudgetを取得する
+func (r *BudgetPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID) (*budget.Budget, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindBudgetByTripID(ctx, pgTripID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, budget.NewBudgetNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch budget from database", apperr.WithCause(err))
+	}
+
+	b, err := r.mapToBudget(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to budget domain object", apperr.WithCause(err))
+	}
+
+	return b, nil
+}
+
+// Save はBudgetを作成または更新する
+func (r *BudgetPostgresRepository) Save(ctx context.Context, b *budget.Budget) error {
+	if b == nil {
+		return apperr.NewInternalError("Budget entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(b.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for save", apperr.WithCause(err))
+	}
+
+	categoryLimits, err := r.encodeCategoryLimits(b)
+	if err != nil {
+		return apperr.NewInternalError("Failed to encode budget category limits", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(b.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert budget created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(b.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert budget updated_at to timestamp", apperr.WithCause(err))
+	}
+
+	params := postgres.UpsertBudgetParams{
+		TripID:         pgTripID,
+		Currency:       b.Currency(),
+		CategoryLimits: categoryLimits,
+		CreatedAt:      pgCreatedAt,
+		UpdatedAt:      pgUpdatedAt,
+	}
+
+	if err := queries.UpsertBudget(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to save budget in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は指定された旅行のBudgetを削除する
+func (r *BudgetPostgresRepository) Delete(ctx context.Context, tripID trip.TripID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeleteBudget(ctx, pgTripID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete budget from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return budget.NewBudgetNotFoundError()
+	}
+
+	return nil
+}
+
+// encodeCategoryLimits はカテゴリごとの上限額を {"food": 30000} 形式の JSON に変換する
+func (r *BudgetPostgresRepository) encodeCategoryLimits(b *budget.Budget) ([]byte, error) {
+	limits := make(map[string]int64)
+	for category, limit := range b.CategoryLimits() {
+		limits[category.String()] = limit.Amount()
+	}
+	return json.Marshal(limits)
+}
+
+// mapToBudget はデータベースレコードをドメインオブジェクトに変換する
+func (r *BudgetPostgresRepository) mapToBudget(record postgres.Budget) (*budget.Budget, error) {
+	mapper := r.GetTypeMapper()
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawLimits map[string]int64
+	if err := json.Unmarshal(record.CategoryLimits, &rawLimits); err != nil {
+		return nil, err
+	}
+	categoryLimits := make(map[expense.Category]int64, len(rawLimits))
+	for rawCategory, amount := range rawLimits {
+		category, err := expense.ParseCategory(rawCategory)
+		if err != nil {
+			return nil, err
+		}
+		categoryLimits[category] = amount
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt, err := mapper.FromTimestamp(record.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return budget.NewBudget(
+		trip.NewTripID(tripID),
+		record.Currency,
+		categoryLimits,
+		createdAt,
+		updatedAt,
+	)
+}
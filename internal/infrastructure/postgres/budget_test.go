@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// budgetTestSuite テスト用の共通セットアップ
+type budgetTestSuite struct {
+	ctx      context.Context
+	repo     budget.BudgetRepository
+	tripRepo trip.TripRepository
+}
+
+// newBudgetTestSuite テストスイートを作成する（トランザクション分離）
+func newBudgetTestSuite(t *testing.T) *budgetTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	return &budgetTestSuite{
+		ctx:      ctx,
+		repo:     NewBudgetPostgresRepository(tx),
+		tripRepo: NewTripPostgresRepository(tx),
+	}
+}
+
+// createTrip 予算の親となるTripを作成する
+func (s *budgetTestSuite) createTrip(t *testing.T) trip.TripID {
+	t.Helper()
+
+	tt := newTestTrip("予算テスト旅行")
+	require.NoError(t, s.tripRepo.Create(s.ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+
+	return tt.ID
+}
+
+func TestBudgetPostgresRepository_SaveAndFindByTripID(t *testing.T) {
+	t.Run("保存したBudgetを取得できること", func(t *testing.T) {
+		suite := newBudgetTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		b, err := budget.NewBudget(tripID, "JPY", map[expense.Category]int64{
+			expense.CategoryFood:      30000,
+			expense.CategoryTransport: 20000,
+		}, now, now)
+		require.NoError(t, err)
+
+		require.NoError(t, suite.repo.Save(suite.ctx, b), "Saveでエラーが発生してはならない")
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		assert.Equal(t, "JPY", found.Currency(), "基準通貨が一致すること")
+		assert.Equal(t, b.CategoryLimits(), found.CategoryLimits(), "上限額が一致すること")
+	})
+
+	t.Run("再度保存すると上書きされること", func(t *testing.T) {
+		suite := newBudgetTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		b, err := budget.NewBudget(tripID, "JPY", map[expense.Category]int64{expense.CategoryFood: 30000}, now, now)
+		require.NoError(t, err)
+		require.NoError(t, suite.repo.Save(suite.ctx, b), "Saveでエラーが発生してはならない")
+
+		updated, err := b.Update("USD", map[expense.Category]int64{expense.CategoryShopping: 10000}, now.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, suite.repo.Save(suite.ctx, updated), "Saveでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID)
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		assert.Equal(t, "USD", found.Currency(), "基準通貨が更新されること")
+		assert.Equal(t, updated.CategoryLimits(), found.CategoryLimits(), "上限額が置き換えられること")
+		assert.WithinDuration(t, now, found.CreatedAt(), time.Second, "CreatedAtは維持されること")
+	})
+
+	t.Run("存在しない旅行でBudgetNotFoundが返されること", func(t *testing.T) {
+		suite := newBudgetTestSuite(t)
+
+		_, err := suite.repo.FindByTripID(suite.ctx, trip.NewTripID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, budget.NewBudgetNotFoundError(),
+			"BudgetNotFoundが返されるべき")
+	})
+}
+
+func TestBudgetPostgresRepository_Delete(t *testing.T) {
+	t.Run("存在しないBudgetの削除でBudgetNotFoundが返されること", func(t *testing.T) {
+		suite := newBudgetTestSuite(t)
+
+		err := suite.repo.Delete(suite.ctx, suite.createTrip(t))
+
+		assert.ErrorIs(t, err, budget.NewBudgetNotFoundError(),
+			"BudgetNotFoundが返されるべき")
+	})
+}
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hata0/travel-api/internal/domain/calendarfeed"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/user"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// CalendarFeedPostgresRepository はカレンダーフィードの PostgreSQL 実装
+type CalendarFeedPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewCalendarFeedPostgresRepository は新しいCalendarFeedPostgresRepositoryを作成する
+func NewCalendarFeedPostgresRepository(db postgres.DBTX) calendarfeed.FeedRepository {
+	return &CalendarFeedPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByTokenHash は指定されたトークンのハッシュ値を持つフィードを取得する
+func (r *CalendarFeedPostgresRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*calendarfeed.Feed, error) {
+	queries := r.GetQueries(ctx)
+
+	record, err := queries.FindCalendarFeedByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, calendarfeed.NewCalendarFeedNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch calendar feed from database", apperr.WithCause(err))
+	}
+
+	feed, err := r.mapToFeed(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to calendar feed domain object", apperr.WithCause(err))
+	}
+
+	return feed, nil
+}
+
+// FindActiveByUserID は指定されたユーザーの無効化されていないフィードを取得する
+func (r *CalendarFeedPostgresRepository) FindActiveByUserID(ctx context.Context, userID user.UserID) (*calendarfeed.Feed, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUserID, err := mapper.ToUUID(userID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert user ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindActiveCalendarFeedByUserID(ctx, pgUserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, calendarfeed.NewCalendarFeedNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch calendar feed from database", apperr.WithCause(err))
+	}
+
+	feed, err := r.mapToFeed(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to calendar feed domain object", apperr.WithCause(err))
+	}
+
+	return feed, nil
+}
+
+// Create は新しいフィードを作成する
+func (r *CalendarFeedPostgresRepository) Create(ctx context.Context, feed *calendarfeed.Feed) error {
+	if feed == nil {
+		return apperr.NewInternalError("Calendar feed entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(feed.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert calendar feed ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgUserID, err := mapper.ToUUID(feed.UserID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert user ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(feed.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert calendar feed created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgRevokedAt, err := mapper.ToNullableTimestamp(feed.RevokedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert calendar feed revoked_at to timestamp", apperr.WithCause(err))
+	}
+
+	params := postgres.CreateCalendarFeedParams{
+		ID:        pgID,
+		UserID:    pgUserID,
+		TokenHash: feed.TokenHash(),
+		CreatedAt: pgCreatedAt,
+		RevokedAt: pgRevokedAt,
+	}
+
+	if err := queries.CreateCalendarFeed(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create calendar feed in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Update は既存のフィードの無効化状況を更新する
+func (r *CalendarFeedPostgresRepository) Update(ctx context.Context, feed *calendarfeed.Feed) error {
+	if feed == nil {
+		return apperr.NewInternalError("Calendar feed entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(feed.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert calendar feed ID to UUID for update", apperr.WithCause(err))
+	}
+
+	pgRevokedAt, err := mapper.ToNullableTimestamp(feed.RevokedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert calendar feed revoked_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	rows, err := queries.UpdateCalendarFeed(ctx, postgres.UpdateCalendarFeedParams{
+		ID:        pgID,
+		RevokedAt: pgRevokedAt,
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to update calendar feed in database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return calendarfeed.NewCalendarFeedNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToFeed はデータベースレコードをドメインオブジェクトに変換する
+func (r *CalendarFeedPostgresRepository) mapToFeed(record postgres.CalendarFeed) (*calendarfeed.Feed, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := mapper.FromUUID(record.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return calendarfeed.RestoreFeed(
+		calendarfeed.NewFeedID(id),
+		user.NewUserID(userID),
+		record.TokenHash,
+		createdAt,
+		mapper.FromNullableTimestamp(record.RevokedAt),
+	), nil
+}
@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/calendarfeed"
+	"github.com/hata0/travel-api/internal/domain/shared/tokenhash"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// calendarFeedTestSuite テスト用の共通セットアップ
+type calendarFeedTestSuite struct {
+	ctx    context.Context
+	repo   calendarfeed.FeedRepository
+	userID user.UserID
+}
+
+// newCalendarFeedTestSuite フィードを発行するユーザーを作成したテストスイートを作成する（トランザクション分離）
+func newCalendarFeedTestSuite(t *testing.T) *calendarFeedTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	tu := newTestUser("subscriber", "subscriber@example.com")
+	require.NoError(t, NewUserPostgresRepository(tx).Create(ctx, tu.toDomainUser()), "Userの作成に失敗")
+
+	return &calendarFeedTestSuite{
+		ctx:    ctx,
+		repo:   NewCalendarFeedPostgresRepository(tx),
+		userID: tu.ID,
+	}
+}
+
+// newFeed テスト用のフィードを生成する
+func (s *calendarFeedTestSuite) newFeed(token string, createdAt time.Time) *calendarfeed.Feed {
+	return calendarfeed.NewFeed(
+		calendarfeed.NewFeedID(uuid.New().String()),
+		s.userID,
+		tokenhash.Hash(token),
+		createdAt,
+	)
+}
+
+func TestCalendarFeedPostgresRepository_CreateAndFind(t *testing.T) {
+	t.Run("作成したフィードをトークンのハッシュ値とユーザーIDで取得できること", func(t *testing.T) {
+		suite := newCalendarFeedTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		feed := suite.newFeed("token-1", now)
+		require.NoError(t, suite.repo.Create(suite.ctx, feed), "Createでエラーが発生してはならない")
+
+		byToken, err := suite.repo.FindByTokenHash(suite.ctx, tokenhash.Hash("token-1"))
+		require.NoError(t, err, "FindByTokenHashでエラーが発生してはならない")
+		assert.True(t, feed.Equals(byToken), "同じフィードが取得されること")
+		assert.Equal(t, suite.userID, byToken.UserID(), "ユーザーIDが一致すること")
+		assert.WithinDuration(t, now, byToken.CreatedAt(), time.Second, "作成日時が一致すること")
+		assert.True(t, byToken.IsActive(), "無効化されていないこと")
+
+		byUser, err := suite.repo.FindActiveByUserID(suite.ctx, suite.userID)
+		require.NoError(t, err, "FindActiveByUserIDでエラーが発生してはならない")
+		assert.True(t, feed.Equals(byUser), "同じフィードが取得されること")
+	})
+
+	t.Run("存在しないトークンでCalendarFeedNotFoundが返されること", func(t *testing.T) {
+		suite := newCalendarFeedTestSuite(t)
+
+		_, err := suite.repo.FindByTokenHash(suite.ctx, tokenhash.Hash("unknown"))
+
+		assert.ErrorIs(t, err, calendarfeed.NewCalendarFeedNotFoundError(),
+			"CalendarFeedNotFoundが返されるべき")
+	})
+}
+
+func TestCalendarFeedPostgresRepository_Update(t *testing.T) {
+	t.Run("無効化したフィードは有効なフィードとして取得されないこと", func(t *testing.T) {
+		suite := newCalendarFeedTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		feed := suite.newFeed("token", now)
+		require.NoError(t, suite.repo.Create(suite.ctx, feed))
+		revoked, err := feed.Revoke(now.Add(time.Minute))
+		require.NoError(t, err)
+
+		require.NoError(t, suite.repo.Update(suite.ctx, revoked), "Updateでエラーが発生してはならない")
+
+		byToken, err := suite.repo.FindByTokenHash(suite.ctx, tokenhash.Hash("token"))
+		require.NoError(t, err)
+		assert.False(t, byToken.IsActive(), "無効化されていること")
+
+		_, err = suite.repo.FindActiveByUserID(suite.ctx, suite.userID)
+		assert.ErrorIs(t, err, calendarfeed.NewCalendarFeedNotFoundError(),
+			"CalendarFeedNotFoundが返されるべき")
+	})
+
+	t.Run("無効化した後は新しいフィードを作成できること", func(t *testing.T) {
+		suite := newCalendarFeedTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		old := suite.newFeed("old", now)
+		require.NoError(t, suite.repo.Create(suite.ctx, old))
+		revoked, err := old.Revoke(now)
+		require.NoError(t, err)
+		require.NoError(t, suite.repo.Update(suite.ctx, revoked))
+
+		current := suite.newFeed("current", now)
+		require.NoError(t, suite.repo.Create(suite.ctx, current), "Createでエラーが発生してはならない")
+
+		found, err := suite.repo.FindActiveByUserID(suite.ctx, suite.userID)
+		require.NoError(t, err)
+		assert.True(t, current.Equals(found), "新しいフィードが取得されること")
+	})
+
+	t.Run("存在しないフィードの更新でCalendarFeedNotFoundが返されること", func(t *testing.T) {
+		suite := newCalendarFeedTestSuite(t)
+
+		err := suite.repo.Update(suite.ctx, suite.newFeed("token", time.Now()))
+
+		assert.ErrorIs(t, err, calendarfeed.NewCalendarFeedNotFoundError(),
+			"CalendarFeedNotFoundが返されるべき")
+	})
+}
@@ -0,0 +1,290 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// ChecklistPostgresRepository はChecklistエンティティのPostgreSQL実装
+type ChecklistPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewChecklistPostgresRepository は新しいChecklistPostgresRepositoryを作成する
+func NewChecklistPostgresRepository(db postgres.DBTX) checklist.ChecklistRepository {
+	return &ChecklistPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDのChecklistを取得する
+func (r *ChecklistPostgresRepository) FindByID(ctx context.Context, id checklist.ChecklistID) (*checklist.Checklist, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert checklist ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindChecklist(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, checklist.NewChecklistNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch checklist from database", apperr.WithCause(err))
+	}
+
+	c, err := r.mapToChecklist(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to checklist domain object", apperr.WithCause(err))
+	}
+
+	return c, nil
+}
+
+// FindByTripID は指定された旅行のChecklistを作成日時の古い順に取得する
+func (r *ChecklistPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID) ([]*checklist.Checklist, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListChecklistsByTripID(ctx, pgTripID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch checklists list from database", apperr.WithCause(err))
+	}
+
+	checklists := make([]*checklist.Checklist, 0, len(records))
+	for _, record := range records {
+		c, err := r.mapToChecklist(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to checklist domain object", apperr.WithCause(err))
+		}
+		checklists = append(checklists, c)
+	}
+
+	return checklists, nil
+}
+
+// Create は新しいChecklistを作成する
+func (r *ChecklistPostgresRepository) Create(ctx context.Context, c *checklist.Checklist) error {
+	if c == nil {
+		return apperr.NewInternalError("Checklist entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(c.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert checklist ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgTripID, err := mapper.ToUUID(c.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	items, err := encodeChecklistItems(c.Items())
+	if err != nil {
+		return apperr.NewInternalError("Failed to encode checklist items", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(c.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert checklist created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(c.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert checklist updated_at to timestamp", apperr.WithCause(err))
+	}
+
+	params := postgres.CreateChecklistParams{
+		ID:        pgID,
+		TripID:    pgTripID,
+		Name:      c.Name(),
+		Kind:      c.Kind().String(),
+		Items:     items,
+		CreatedAt: pgCreatedAt,
+		UpdatedAt: pgUpdatedAt,
+	}
+
+	if err := queries.CreateChecklist(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create checklist in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Update は既存のChecklistの名前・種類・項目を更新する
+func (r *ChecklistPostgresRepository) Update(ctx context.Context, c *checklist.Checklist) error {
+	if c == nil {
+		return apperr.NewInternalError("Checklist entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(c.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert checklist ID to UUID for update", apperr.WithCause(err))
+	}
+
+	items, err := encodeChecklistItems(c.Items())
+	if err != nil {
+		return apperr.NewInternalError("Failed to encode checklist items for update", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(c.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert checklist updated_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	rows, err := queries.UpdateChecklist(ctx, postgres.UpdateChecklistParams{
+		ID:        pgID,
+		Name:      c.Name(),
+		Kind:      c.Kind().String(),
+		Items:     items,
+		UpdatedAt: pgUpdatedAt,
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to update checklist in database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return checklist.NewChecklistNotFoundError()
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDのChecklistを削除する
+func (r *ChecklistPostgresRepository) Delete(ctx context.Context, id checklist.ChecklistID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert checklist ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeleteChecklist(ctx, pgID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete checklist from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return checklist.NewChecklistNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToChecklist はデータベースレコードをドメインオブジェクトに変換する
+func (r *ChecklistPostgresRepository) mapToChecklist(record postgres.Checklist) (*checklist.Checklist, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := checklist.ParseKind(record.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := decodeChecklistItems(record.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt, err := mapper.FromTimestamp(record.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return checklist.NewChecklist(
+		checklist.NewChecklistID(id),
+		trip.NewTripID(tripID),
+		record.Name,
+		kind,
+		items,
+		createdAt,
+		updatedAt,
+	)
+}
+
+// checklistItemRecord はチェックリストの項目の JSON 表現
+type checklistItemRecord struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	Quantity   int     `json:"quantity"`
+	Checked    bool    `json:"checked"`
+	AssigneeID *string `json:"assignee_id"`
+}
+
+// encodeChecklistItems はチェックリストの項目を JSON に変換する
+func encodeChecklistItems(items []checklist.Item) ([]byte, error) {
+	records := make([]checklistItemRecord, 0, len(items))
+	for _, item := range items {
+		record := checklistItemRecord{
+			ID:       item.ID().String(),
+			Name:     item.Name(),
+			Quantity: item.Quantity(),
+			Checked:  item.Checked(),
+		}
+		if assigneeID := item.AssigneeID(); assigneeID != nil {
+			id := assigneeID.String()
+			record.AssigneeID = &id
+		}
+		records = append(records, record)
+	}
+	return json.Marshal(records)
+}
+
+// decodeChecklistItems は JSON からチェックリストの項目を復元する
+func decodeChecklistItems(data []byte) ([]checklist.Item, error) {
+	var records []checklistItemRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+
+	items := make([]checklist.Item, 0, len(records))
+	for _, record := range records {
+		var assigneeID *user.UserID
+		if record.AssigneeID != nil {
+			id := user.NewUserID(*record.AssigneeID)
+			assigneeID = &id
+		}
+		item, err := checklist.NewItem(checklist.NewItemID(record.ID), record.Name, record.Quantity, record.Checked, assigneeID)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/user"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// ItemTemplatePostgresRepository はItemTemplateエンティティのPostgreSQL実装
+type ItemTemplatePostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewItemTemplatePostgresRepository は新しいItemTemplatePostgresRepositoryを作成する
+func NewItemTemplatePostgresRepository(db postgres.DBTX) checklist.ItemTemplateRepository {
+	return &ItemTemplatePostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDのItemTemplateを取得する
+func (r *ItemTemplatePostgresRepository) FindByID(ctx context.Context, id checklist.ItemTemplateID) (*checklist.ItemTemplate, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert item template ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindChecklistItemTemplate(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, checklist.NewItemTemplateNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch item template from database", apperr.WithCause(err))
+	}
+
+	t, err := r.mapToItemTemplate(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to item template domain object", apperr.WithCause(err))
+	}
+
+	return t, nil
+}
+
+// FindByOwnerID は指定されたユーザーのItemTemplateを作成日時の古い順に取得する
+func (r *ItemTemplatePostgresRepository) FindByOwnerID(ctx context.Context, ownerID user.UserID) ([]*checklist.ItemTemplate, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgOwnerID, err := mapper.ToUUID(ownerID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert owner ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListChecklistItemTemplatesByOwnerID(ctx, pgOwnerID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch item templates list from database", apperr.WithCause(err))
+	}
+
+	templates := make([]*checklist.ItemTemplate, 0, len(records))
+	for _, record := range records {
+		t, err := r.mapToItemTemplate(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to item template domain object", apperr.WithCause(err))
+		}
+		templates = append(templates, t)
+	}
+
+	return templates, nil
+}
+
+// Create は新しいItemTemplateを作成する
+func (r *ItemTemplatePostgresRepository) Create(ctx context.Context, t *checklist.ItemTemplate) error {
+	if t == nil {
+		return apperr.NewInternalError("Item template entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(t.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert item template ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgOwnerID, err := mapper.ToUUID(t.OwnerID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert owner ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(t.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert item template created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(t.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert item template updated_at to timestamp", apperr.WithCause(err))
+	}
+
+	params := postgres.CreateChecklistItemTemplateParams{
+		ID:        pgID,
+		OwnerID:   pgOwnerID,
+		Name:      t.Name(),
+		Quantity:  int32(t.Quantity()),
+		PerDay:    t.PerDay(),
+		CreatedAt: pgCreatedAt,
+		UpdatedAt: pgUpdatedAt,
+	}
+
+	if err := queries.CreateChecklistItemTemplate(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create item template in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Update は既存のItemTemplateを更新する
+func (r *ItemTemplatePostgresRepository) Update(ctx context.Context, t *checklist.ItemTemplate) error {
+	if t == nil {
+		return apperr.NewInternalError("Item template entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(t.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert item template ID to UUID for update", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(t.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert item template updated_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	rows, err := queries.UpdateChecklistItemTemplate(ctx, postgres.UpdateChecklistItemTemplateParams{
+		ID:        pgID,
+		Name:      t.Name(),
+		Quantity:  int32(t.Quantity()),
+		PerDay:    t.PerDay(),
+		UpdatedAt: pgUpdatedAt,
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to update item template in database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return checklist.NewItemTemplateNotFoundError()
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDのItemTemplateを削除する
+func (r *ItemTemplatePostgresRepository) Delete(ctx context.Context, id checklist.ItemTemplateID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert item template ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeleteChecklistItemTemplate(ctx, pgID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete item template from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return checklist.NewItemTemplateNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToItemTemplate はデータベースレコードをドメインオブジェクトに変換する
+func (r *ItemTemplatePostgresRepository) mapToItemTemplate(record postgres.ChecklistItemTemplate) (*checklist.ItemTemplate, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := mapper.FromUUID(record.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt, err := mapper.FromTimestamp(record.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return checklist.NewItemTemplate(
+		checklist.NewItemTemplateID(id),
+		user.NewUserID(ownerID),
+		record.Name,
+		int(record.Quantity),
+		record.PerDay,
+		createdAt,
+		updatedAt,
+	)
+}
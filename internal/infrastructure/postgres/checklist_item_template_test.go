@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// itemTemplateTestSuite テスト用の共通セットアップ
+type itemTemplateTestSuite struct {
+	ctx     context.Context
+	repo    checklist.ItemTemplateRepository
+	ownerID user.UserID
+	otherID user.UserID
+}
+
+// newItemTemplateTestSuite 項目テンプレートの所有者となるUserを作成したテストスイートを作成する（トランザクション分離）
+func newItemTemplateTestSuite(t *testing.T) *itemTemplateTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	userRepo := NewUserPostgresRepository(tx)
+	owner := newTestUser("item-template-owner", "item-template-owner@example.com")
+	require.NoError(t, userRepo.Create(ctx, owner.toDomainUser()), "Userの作成に失敗")
+	other := newTestUser("item-template-other", "item-template-other@example.com")
+	require.NoError(t, userRepo.Create(ctx, other.toDomainUser()), "Userの作成に失敗")
+
+	return &itemTemplateTestSuite{
+		ctx:     ctx,
+		repo:    NewItemTemplatePostgresRepository(tx),
+		ownerID: owner.ID,
+		otherID: other.ID,
+	}
+}
+
+// newItemTemplate テスト用のItemTemplateを生成する
+func newItemTemplate(t *testing.T, id string, ownerID user.UserID, name string, createdAt time.Time) *checklist.ItemTemplate {
+	t.Helper()
+
+	tmpl, err := checklist.NewItemTemplate(checklist.NewItemTemplateID(id), ownerID, name, 1, true, createdAt, createdAt)
+	require.NoError(t, err)
+	return tmpl
+}
+
+func TestItemTemplatePostgresRepository_CreateAndFindByID(t *testing.T) {
+	t.Run("作成したItemTemplateを取得できること", func(t *testing.T) {
+		suite := newItemTemplateTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		tmpl := newItemTemplate(t, uuid.New().String(), suite.ownerID, "下着", now)
+		require.NoError(t, suite.repo.Create(suite.ctx, tmpl), "Createでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByID(suite.ctx, tmpl.ID())
+		require.NoError(t, err)
+
+		assert.Equal(t, tmpl.ID(), found.ID())
+		assert.Equal(t, suite.ownerID, found.OwnerID())
+		assert.Equal(t, "下着", found.Name())
+		assert.Equal(t, 1, found.Quantity())
+		assert.True(t, found.PerDay())
+		assert.True(t, now.Equal(found.CreatedAt()))
+		assert.True(t, now.Equal(found.UpdatedAt()))
+	})
+
+	t.Run("存在しないIDの場合はItemTemplateNotFoundを返すこと", func(t *testing.T) {
+		suite := newItemTemplateTestSuite(t)
+
+		_, err := suite.repo.FindByID(suite.ctx, checklist.NewItemTemplateID(uuid.New().String()))
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, checklist.CodeItemTemplateNotFound))
+	})
+}
+
+func TestItemTemplatePostgresRepository_FindByOwnerID(t *testing.T) {
+	suite := newItemTemplateTestSuite(t)
+
+	// Given: 所有者の項目テンプレート3つ（うち2つは作成日時が同じ）と、他のユーザーの項目テンプレート1つ
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	older := newItemTemplate(t, uuid.New().String(), suite.ownerID, "パスポート", now.Add(-time.Hour))
+	sameTimeLow := newItemTemplate(t, "00000000-0000-4000-8000-000000000001", suite.ownerID, "下着", now)
+	sameTimeHigh := newItemTemplate(t, "ffffffff-ffff-4fff-bfff-ffffffffffff", suite.ownerID, "靴下", now)
+	others := newItemTemplate(t, uuid.New().String(), suite.otherID, "他人の項目", now.Add(-30*time.Minute))
+	for _, tmpl := range []*checklist.ItemTemplate{sameTimeHigh, others, older, sameTimeLow} {
+		require.NoError(t, suite.repo.Create(suite.ctx, tmpl))
+	}
+
+	found, err := suite.repo.FindByOwnerID(suite.ctx, suite.ownerID)
+	require.NoError(t, err)
+
+	var ids []checklist.ItemTemplateID
+	for _, tmpl := range found {
+		ids = append(ids, tmpl.ID())
+	}
+	assert.Equal(t, []checklist.ItemTemplateID{older.ID(), sameTimeLow.ID(), sameTimeHigh.ID()}, ids,
+		"所有する項目テンプレートだけを作成日時の古い順に、同じ作成日時ではID順に取得すべき")
+
+	t.Run("項目テンプレートがないユーザーでは空のスライスを返すこと", func(t *testing.T) {
+		found, err := suite.repo.FindByOwnerID(suite.ctx, user.NewUserID(uuid.New().String()))
+
+		require.NoError(t, err)
+		assert.NotNil(t, found)
+		assert.Empty(t, found)
+	})
+}
+
+func TestItemTemplatePostgresRepository_Update(t *testing.T) {
+	suite := newItemTemplateTestSuite(t)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	tmpl := newItemTemplate(t, uuid.New().String(), suite.ownerID, "下着", now)
+	require.NoError(t, suite.repo.Create(suite.ctx, tmpl))
+
+	updated, err := tmpl.Update("靴下", 2, false, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, suite.repo.Update(suite.ctx, updated))
+
+	found, err := suite.repo.FindByID(suite.ctx, tmpl.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "靴下", found.Name())
+	assert.Equal(t, 2, found.Quantity())
+	assert.False(t, found.PerDay())
+	assert.True(t, now.Equal(found.CreatedAt()), "作成日時は更新されないべき")
+	assert.True(t, now.Add(time.Hour).Equal(found.UpdatedAt()))
+
+	missing := newItemTemplate(t, uuid.New().String(), suite.ownerID, "存在しない", now)
+	assert.True(t, apperr.IsAppErrorWithCode(suite.repo.Update(suite.ctx, missing), checklist.CodeItemTemplateNotFound))
+}
+
+func TestItemTemplatePostgresRepository_Delete(t *testing.T) {
+	suite := newItemTemplateTestSuite(t)
+
+	tmpl := newItemTemplate(t, uuid.New().String(), suite.ownerID, "下着", time.Now().UTC().Truncate(time.Microsecond))
+	require.NoError(t, suite.repo.Create(suite.ctx, tmpl))
+
+	require.NoError(t, suite.repo.Delete(suite.ctx, tmpl.ID()))
+
+	_, err := suite.repo.FindByID(suite.ctx, tmpl.ID())
+	assert.True(t, apperr.IsAppErrorWithCode(err, checklist.CodeItemTemplateNotFound))
+	assert.True(t, apperr.IsAppErrorWithCode(suite.repo.Delete(suite.ctx, tmpl.ID()), checklist.CodeItemTemplateNotFound), "削除済みの場合はItemTemplateNotFoundを返すべき")
+}
@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// checklistTestSuite テスト用の共通セットアップ
+type checklistTestSuite struct {
+	ctx          context.Context
+	repo         checklist.ChecklistRepository
+	templateRepo checklist.ItemTemplateRepository
+	tripRepo     trip.TripRepository
+	userID       user.UserID
+}
+
+// newChecklistTestSuite 項目の担当者や項目テンプレートの所有者となるUserを作成したテストスイートを作成する（トランザクション分離）
+func newChecklistTestSuite(t *testing.T) *checklistTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	u := newTestUser("checklist-user", "checklist-user@example.com")
+	require.NoError(t, NewUserPostgresRepository(tx).Create(ctx, u.toDomainUser()), "Userの作成に失敗")
+
+	return &checklistTestSuite{
+		ctx:          ctx,
+		repo:         NewChecklistPostgresRepository(tx),
+		templateRepo: NewItemTemplatePostgresRepository(tx),
+		tripRepo:     NewTripPostgresRepository(tx),
+		userID:       u.ID,
+	}
+}
+
+// createTrip チェックリストの親となるTripを作成する
+func (s *checklistTestSuite) createTrip(t *testing.T) trip.TripID {
+	t.Helper()
+
+	tt := newTestTrip("チェックリストテスト旅行")
+	require.NoError(t, s.tripRepo.Create(s.ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+
+	return tt.ID
+}
+
+// newTestChecklist テスト用のChecklistを生成する
+func newTestChecklist(t *testing.T, tripID trip.TripID, name string, assigneeID *user.UserID, createdAt time.Time) *checklist.Checklist {
+	t.Helper()
+
+	passport, err := checklist.NewItem(checklist.NewItemID(uuid.New().String()), "パスポート", 1, true, assigneeID)
+	require.NoError(t, err, "Itemの生成に失敗")
+	socks, err := checklist.NewItem(checklist.NewItemID(uuid.New().String()), "靴下", 3, false, nil)
+	require.NoError(t, err, "Itemの生成に失敗")
+
+	c, err := checklist.NewChecklist(
+		checklist.NewChecklistID(uuid.New().String()),
+		tripID,
+		name,
+		checklist.KindPacking,
+		[]checklist.Item{passport, socks},
+		createdAt,
+		createdAt,
+	)
+	require.NoError(t, err, "Checklistの生成に失敗")
+	return c
+}
+
+// assertChecklistEquals Checklistの等価性をアサートする
+func assertChecklistEquals(t *testing.T, expected, actual *checklist.Checklist) {
+	t.Helper()
+	assert.Equal(t, expected.ID(), actual.ID(), "IDが一致すること")
+	assert.Equal(t, expected.TripID(), actual.TripID(), "TripIDが一致すること")
+	assert.Equal(t, expected.Name(), actual.Name(), "Nameが一致すること")
+	assert.Equal(t, expected.Kind(), actual.Kind(), "Kindが一致すること")
+	assert.Equal(t, expected.Items(), actual.Items(), "Itemsが一致すること")
+}
+
+func TestChecklistPostgresRepository_CreateAndFindByID(t *testing.T) {
+	t.Run("作成したChecklistを項目を含めて取得できること", func(t *testing.T) {
+		suite := newChecklistTestSuite(t)
+
+		// Given: 担当者付きの項目を持つチェックリスト
+		tripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		c := newTestChecklist(t, tripID, "持ち物", &suite.userID, now)
+
+		// When: 作成して取得する
+		require.NoError(t, suite.repo.Create(suite.ctx, c), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, c.ID())
+
+		// Then: 作成した内容と一致する
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertChecklistEquals(t, c, found)
+	})
+
+	t.Run("存在しないIDでChecklistNotFoundが返されること", func(t *testing.T) {
+		suite := newChecklistTestSuite(t)
+
+		_, err := suite.repo.FindByID(suite.ctx, checklist.NewChecklistID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, checklist.NewChecklistNotFoundError(),
+			"ChecklistNotFoundが返されるべき")
+	})
+}
+
+func TestChecklistPostgresRepository_FindByTripID(t *testing.T) {
+	t.Run("旅行に紐づくChecklistが作成順に取得できること", func(t *testing.T) {
+		suite := newChecklistTestSuite(t)
+
+		// Given: 同じ旅行に2件、別の旅行に1件のチェックリスト
+		tripID := suite.createTrip(t)
+		otherTripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		second := newTestChecklist(t, tripID, "出発前", nil, now)
+		first := newTestChecklist(t, tripID, "持ち物", nil, now.Add(-time.Hour))
+		other := newTestChecklist(t, otherTripID, "別旅行", nil, now)
+		for _, c := range []*checklist.Checklist{second, first, other} {
+			require.NoError(t, suite.repo.Create(suite.ctx, c), "Createでエラーが発生してはならない")
+		}
+
+		// When: 旅行IDで取得する
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID)
+
+		// Then: 対象旅行のチェックリストのみが作成順で返される
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 2, "対象旅行のチェックリストのみが返されるべき")
+		assert.Equal(t, first.ID(), found[0].ID(), "先に作成したチェックリストが先に返されるべき")
+		assert.Equal(t, second.ID(), found[1].ID(), "後に作成したチェックリストが後に返されるべき")
+	})
+}
+
+func TestChecklistPostgresRepository_Update(t *testing.T) {
+	t.Run("項目のチェック状態の変更が反映されること", func(t *testing.T) {
+		suite := newChecklistTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		c := newTestChecklist(t, tripID, "持ち物", nil, time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, suite.repo.Create(suite.ctx, c), "Createでエラーが発生してはならない")
+
+		updated, err := c.SetChecked([]checklist.ItemID{c.Items()[1].ID()}, true, time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, err)
+		require.NoError(t, suite.repo.Update(suite.ctx, updated), "Updateでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByID(suite.ctx, c.ID())
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertChecklistEquals(t, updated, found)
+	})
+
+	t.Run("存在しないChecklistの更新でChecklistNotFoundが返されること", func(t *testing.T) {
+		suite := newChecklistTestSuite(t)
+
+		c := newTestChecklist(t, trip.NewTripID(uuid.New().String()), "持ち物", nil, time.Now())
+
+		err := suite.repo.Update(suite.ctx, c)
+
+		assert.ErrorIs(t, err, checklist.NewChecklistNotFoundError(),
+			"ChecklistNotFoundが返されるべき")
+	})
+}
+
+func TestChecklistPostgresRepository_Delete(t *testing.T) {
+	t.Run("既存のChecklistを削除できること", func(t *testing.T) {
+		suite := newChecklistTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		c := newTestChecklist(t, tripID, "持ち物", nil, time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, suite.repo.Create(suite.ctx, c), "Createでエラーが発生してはならない")
+
+		require.NoError(t, suite.repo.Delete(suite.ctx, c.ID()), "Deleteでエラーが発生してはならない")
+
+		_, err := suite.repo.FindByID(suite.ctx, c.ID())
+		assert.ErrorIs(t, err, checklist.NewChecklistNotFoundError(),
+			"削除後はChecklistNotFoundが返されるべき")
+	})
+}
+
+func TestItemTemplatePostgresRepository(t *testing.T) {
+	t.Run("作成・更新・削除したItemTemplateがユーザーごとに取得できること", func(t *testing.T) {
+		suite := newChecklistTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		socks, err := checklist.NewItemTemplate(checklist.NewItemTemplateID(uuid.New().String()), suite.userID, "靴下", 1, true, now.Add(-time.Hour), now.Add(-time.Hour))
+		require.NoError(t, err)
+		passport, err := checklist.NewItemTemplate(checklist.NewItemTemplateID(uuid.New().String()), suite.userID, "パスポート", 1, false, now, now)
+		require.NoError(t, err)
+		for _, tmpl := range []*checklist.ItemTemplate{passport, socks} {
+			require.NoError(t, suite.templateRepo.Create(suite.ctx, tmpl), "Createでエラーが発生してはならない")
+		}
+
+		found, err := suite.templateRepo.FindByOwnerID(suite.ctx, suite.userID)
+		require.NoError(t, err, "FindByOwnerIDでエラーが発生してはならない")
+		require.Len(t, found, 2)
+		assert.Equal(t, socks.ID(), found[0].ID(), "先に作成した項目テンプレートが先に返されるべき")
+		assert.True(t, found[0].PerDay())
+
+		updated, err := socks.Update("靴下（厚手）", 2, true, now)
+		require.NoError(t, err)
+		require.NoError(t, suite.templateRepo.Update(suite.ctx, updated), "Updateでエラーが発生してはならない")
+		got, err := suite.templateRepo.FindByID(suite.ctx, socks.ID())
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assert.Equal(t, "靴下（厚手）", got.Name())
+		assert.Equal(t, 2, got.Quantity())
+
+		require.NoError(t, suite.templateRepo.Delete(suite.ctx, socks.ID()), "Deleteでエラーが発生してはならない")
+		_, err = suite.templateRepo.FindByID(suite.ctx, socks.ID())
+		assert.ErrorIs(t, err, checklist.NewItemTemplateNotFoundError(),
+			"削除後はItemTemplateNotFoundが返されるべき")
+	})
+}
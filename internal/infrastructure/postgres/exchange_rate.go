@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// ExchangeRatePostgresRepository はExchangeRateエンティティのPostgreSQL実装
+type ExchangeRatePostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewExchangeRatePostgresRepository は新しいExchangeRatePostgresRepositoryを作成する
+func NewExchangeRatePostgresRepository(db postgres.DBTX) exchangerate.ExchangeRateRepository {
+	return &ExchangeRatePostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindLatest は指定日以前で最も新しい日付の為替レートを取得する
+func (r *ExchangeRatePostgresRepository) FindLatest(ctx context.Context, baseCurrency, quoteCurrency string, on time.Time) (*exchangerate.ExchangeRate, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgDate, err := mapper.ToDate(on)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert exchange rate date", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindLatestExchangeRate(ctx, postgres.FindLatestExchangeRateParams{
+		BaseCurrency:  baseCurrency,
+		QuoteCurrency: quoteCurrency,
+		RateDate:      pgDate,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, exchangerate.NewExchangeRateNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch exchange rate from database", apperr.WithCause(err))
+	}
+
+	rate, err := r.mapToExchangeRate(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to exchange rate domain object", apperr.WithCause(err))
+	}
+
+	return rate, nil
+}
+
+// Save は為替レートを作成し、同じ日付と通貨ペアが既に存在する場合は上書きする
+func (r *ExchangeRatePostgresRepository) Save(ctx context.Context, rate *exchangerate.ExchangeRate) error {
+	if rate == nil {
+		return apperr.NewInternalError("ExchangeRate entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgDate, err := mapper.ToDate(rate.Date())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert exchange rate date for save", apperr.WithCause(err))
+	}
+
+	pgRate, err := mapper.ToNumeric(rate.Rate().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert exchange rate to numeric", apperr.WithCause(err))
+	}
+
+	params := postgres.UpsertExchangeRateParams{
+		RateDate:      pgDate,
+		BaseCurrency:  rate.BaseCurrency(),
+		QuoteCurrency: rate.QuoteCurrency(),
+		Rate:          pgRate,
+	}
+
+	if err := queries.UpsertExchangeRate(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to save exchange rate in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// mapToExchangeRate はデータベースレコードをドメインオブジェクトに変換する
+func (r *ExchangeRatePostgresRepository) mapToExchangeRate(record postgres.ExchangeRate) (*exchangerate.ExchangeRate, error) {
+	mapper := r.GetTypeMapper()
+
+	date, err := mapper.FromDate(record.RateDate)
+	if err != nil {
+		return nil, err
+	}
+
+	decimal, err := mapper.FromNumeric(record.Rate)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := exchangerate.NewRate(decimal)
+	if err != nil {
+		return nil, err
+	}
+
+	return exchangerate.NewExchangeRate(date, record.BaseCurrency, record.QuoteCurrency, rate)
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newExchangeRateTestRepository トランザクション内で動作するリポジトリを作成する
+func newExchangeRateTestRepository(t *testing.T) (context.Context, exchangerate.ExchangeRateRepository) {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	return ctx, NewExchangeRatePostgresRepository(tx)
+}
+
+func newTestExchangeRate(t *testing.T, date time.Time, base, quote, decimal string) *exchangerate.ExchangeRate {
+	t.Helper()
+
+	rate, err := exchangerate.NewRate(decimal)
+	require.NoError(t, err)
+	er, err := exchangerate.NewExchangeRate(date, base, quote, rate)
+	require.NoError(t, err)
+	return er
+}
+
+func TestExchangeRatePostgresRepository_FindLatest(t *testing.T) {
+	may1 := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	may3 := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+
+	t.Run("指定日以前で最新のレートが取得できること", func(t *testing.T) {
+		ctx, repo := newExchangeRateTestRepository(t)
+		require.NoError(t, repo.Save(ctx, newTestExchangeRate(t, may1, "EUR", "USD", "1.0703")))
+		require.NoError(t, repo.Save(ctx, newTestExchangeRate(t, may3, "EUR", "USD", "1.0800")))
+
+		found, err := repo.FindLatest(ctx, "EUR", "USD", time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC))
+
+		require.NoError(t, err, "FindLatestでエラーが発生してはならない")
+		assert.True(t, found.Date().Equal(may1), "直前の日付のレートが返されるべき")
+		assert.Equal(t, "1.0703", found.Rate().String(), "レートが小数のまま保存されていること")
+	})
+
+	t.Run("同じ日付と通貨ペアは上書きされること", func(t *testing.T) {
+		ctx, repo := newExchangeRateTestRepository(t)
+		require.NoError(t, repo.Save(ctx, newTestExchangeRate(t, may1, "EUR", "JPY", "168.5")))
+		require.NoError(t, repo.Save(ctx, newTestExchangeRate(t, may1, "EUR", "JPY", "169.25")))
+
+		found, err := repo.FindLatest(ctx, "EUR", "JPY", may1)
+
+		require.NoError(t, err, "FindLatestでエラーが発生してはならない")
+		assert.Equal(t, "169.25", found.Rate().String(), "後から保存したレートが返されるべき")
+	})
+
+	t.Run("指定日以前のレートがない場合ExchangeRateNotFoundが返されること", func(t *testing.T) {
+		ctx, repo := newExchangeRateTestRepository(t)
+		require.NoError(t, repo.Save(ctx, newTestExchangeRate(t, may3, "EUR", "USD", "1.0800")))
+
+		_, err := repo.FindLatest(ctx, "EUR", "USD", may1)
+
+		assert.ErrorIs(t, err, exchangerate.NewExchangeRateNotFoundError(),
+			"ExchangeRateNotFoundが返されるべき")
+	})
+}
@@ -0,0 +1,323 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// ExpensePostgresRepository はExpenseエンティティのPostgreSQL実装
+type ExpensePostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewExpensePostgresRepository は新しいExpensePostgresRepositoryを作成する
+func NewExpensePostgresRepository(db postgres.DBTX) expense.ExpenseRepository {
+	return &ExpensePostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDのExpenseを取得する
+func (r *ExpensePostgresRepository) FindByID(ctx context.Context, id expense.ExpenseID) (*expense.Expense, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUUID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert expense ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindExpense(ctx, pgUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, expense.NewExpenseNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch expense from database", apperr.WithCause(err))
+	}
+
+	e, err := r.mapToExpense(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to expense domain object", apperr.WithCause(err))
+	}
+
+	return e, nil
+}
+
+// FindByTripID は指定された旅行に紐づくExpenseを日付順に取得する
+func (r *ExpensePostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID) ([]*expense.Expense, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListExpensesByTripID(ctx, pgTripID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch expenses list from database", apperr.WithCause(err))
+	}
+
+	expenses := make([]*expense.Expense, 0, len(records))
+	for _, record := range records {
+		e, err := r.mapToExpense(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to expense domain object", apperr.WithCause(err))
+		}
+		expenses = append(expenses, e)
+	}
+
+	return expenses, nil
+}
+
+// Create は新しいExpenseを作成する
+func (r *ExpensePostgresRepository) Create(ctx context.Context, e *expense.Expense) error {
+	if e == nil {
+		return apperr.NewInternalError("Expense entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUUID, err := mapper.ToUUID(e.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert expense ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgTripID, err := mapper.ToUUID(e.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgSpentOn, err := mapper.ToDate(e.SpentOn())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert expense spent_on to date", apperr.WithCause(err))
+	}
+
+	pgActivityID, err := mapper.ToNullableUUID(e.ActivityID())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	split, err := encodeSplit(e.Split())
+	if err != nil {
+		return apperr.NewInternalError("Failed to encode expense split", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(e.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert expense created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(e.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert expense updated_at to timestamp", apperr.WithCause(err))
+	}
+
+	params := postgres.CreateExpenseParams{
+		ID:          pgUUID,
+		TripID:      pgTripID,
+		Amount:      e.Amount().Amount(),
+		Currency:    e.Amount().Currency(),
+		Category:    e.Category().String(),
+		SpentOn:     pgSpentOn,
+		Payer:       e.Payer(),
+		Description: e.Description(),
+		ActivityID:  pgActivityID,
+		Split:       split,
+		CreatedAt:   pgCreatedAt,
+		UpdatedAt:   pgUpdatedAt,
+	}
+
+	if err := queries.CreateExpense(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create expense in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Update は既存のExpenseを更新する
+func (r *ExpensePostgresRepository) Update(ctx context.Context, e *expense.Expense) error {
+	if e == nil {
+		return apperr.NewInternalError("Expense entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUUID, err := mapper.ToUUID(e.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert expense ID to UUID for update", apperr.WithCause(err))
+	}
+
+	pgSpentOn, err := mapper.ToDate(e.SpentOn())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert expense spent_on to date for update", apperr.WithCause(err))
+	}
+
+	pgActivityID, err := mapper.ToNullableUUID(e.ActivityID())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity ID to UUID for update", apperr.WithCause(err))
+	}
+
+	split, err := encodeSplit(e.Split())
+	if err != nil {
+		return apperr.NewInternalError("Failed to encode expense split for update", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(e.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert expense updated_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	params := postgres.UpdateExpenseParams{
+		ID:          pgUUID,
+		Amount:      e.Amount().Amount(),
+		Currency:    e.Amount().Currency(),
+		Category:    e.Category().String(),
+		SpentOn:     pgSpentOn,
+		Payer:       e.Payer(),
+		Description: e.Description(),
+		ActivityID:  pgActivityID,
+		Split:       split,
+		UpdatedAt:   pgUpdatedAt,
+	}
+
+	if err := queries.UpdateExpense(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to update expense in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDのExpenseを削除する
+func (r *ExpensePostgresRepository) Delete(ctx context.Context, id expense.ExpenseID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUUID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert expense ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeleteExpense(ctx, pgUUID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete expense from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return expense.NewExpenseNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToExpense はデータベースレコードをドメインオブジェクトに変換する
+func (r *ExpensePostgresRepository) mapToExpense(record postgres.Expense) (*expense.Expense, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := money.NewMoney(record.Amount, record.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := expense.ParseCategory(record.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	spentOn, err := mapper.FromDate(record.SpentOn)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := decodeSplit(record.Split)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt, err := mapper.FromTimestamp(record.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return expense.NewExpense(
+		expense.NewExpenseID(id),
+		trip.NewTripID(tripID),
+		amount,
+		category,
+		spentOn,
+		record.Payer,
+		record.Description,
+		mapper.FromNullableUUID(record.ActivityID),
+		split,
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+// splitRecord は分担方法の JSON 表現
+type splitRecord struct {
+	Method  string             `json:"method"`
+	Entries []splitEntryRecord `json:"entries"`
+}
+
+type splitEntryRecord struct {
+	Participant string `json:"participant"`
+	Value       int64  `json:"value"`
+}
+
+// encodeSplit は分担方法を JSON に変換する。分担がない場合は NULL として保存するため nil を返す
+func encodeSplit(split *expense.Split) ([]byte, error) {
+	if split == nil {
+		return nil, nil
+	}
+	record := splitRecord{Method: split.Method().String()}
+	for _, entry := range split.Entries() {
+		record.Entries = append(record.Entries, splitEntryRecord{Participant: entry.Participant(), Value: entry.Value()})
+	}
+	return json.Marshal(record)
+}
+
+// decodeSplit は JSON から分担方法を復元する。NULL の場合は nil を返す
+func decodeSplit(data []byte) (*expense.Split, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var record splitRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, err
+	}
+	method, err := expense.ParseSplitMethod(record.Method)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]expense.SplitEntry, len(record.Entries))
+	for i, entry := range record.Entries {
+		entries[i] = expense.NewSplitEntry(entry.Participant, entry.Value)
+	}
+	return expense.NewSplit(method, entries)
+}
@@ -0,0 +1,205 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// expenseTestSuite テスト用の共通セットアップ
+type expenseTestSuite struct {
+	ctx      context.Context
+	repo     expense.ExpenseRepository
+	tripRepo trip.TripRepository
+}
+
+// newExpenseTestSuite テストスイートを作成する（トランザクション分離）
+func newExpenseTestSuite(t *testing.T) *expenseTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	return &expenseTestSuite{
+		ctx:      ctx,
+		repo:     NewExpensePostgresRepository(tx),
+		tripRepo: NewTripPostgresRepository(tx),
+	}
+}
+
+// createTrip 支出の親となるTripを作成する
+func (s *expenseTestSuite) createTrip(t *testing.T) trip.TripID {
+	t.Helper()
+
+	tt := newTestTrip("支出テスト旅行")
+	require.NoError(t, s.tripRepo.Create(s.ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+
+	return tt.ID
+}
+
+// newTestExpense テスト用のExpenseを生成する
+func newTestExpense(t *testing.T, tripID trip.TripID, amount int64, currency string, spentOn time.Time, activityID *string) *expense.Expense {
+	t.Helper()
+
+	m, err := money.NewMoney(amount, currency)
+	require.NoError(t, err, "Moneyの生成に失敗")
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	return expense.NewExpense(
+		expense.NewExpenseID(uuid.New().String()),
+		tripID,
+		m,
+		expense.CategoryFood,
+		spentOn,
+		"Alice",
+		"夕食",
+		activityID,
+		nil,
+		now,
+		now,
+	)
+}
+
+// assertExpenseEquals Expenseの等価性をアサートする
+func assertExpenseEquals(t *testing.T, expected, actual *expense.Expense) {
+	t.Helper()
+	assert.Equal(t, expected.ID(), actual.ID(), "IDが一致すること")
+	assert.Equal(t, expected.TripID(), actual.TripID(), "TripIDが一致すること")
+	assert.True(t, expected.Amount().Equals(actual.Amount()), "Amountが一致すること")
+	assert.Equal(t, expected.Category(), actual.Category(), "Categoryが一致すること")
+	assert.True(t, expected.SpentOn().Equal(actual.SpentOn()), "SpentOnが一致すること")
+	assert.Equal(t, expected.Payer(), actual.Payer(), "Payerが一致すること")
+	assert.Equal(t, expected.Description(), actual.Description(), "Descriptionが一致すること")
+	assert.Equal(t, expected.ActivityID(), actual.ActivityID(), "ActivityIDが一致すること")
+	assert.True(t, expected.Split().Equals(actual.Split()), "Splitが一致すること")
+}
+
+func TestExpensePostgresRepository_CreateAndFindByID(t *testing.T) {
+	t.Run("作成したExpenseを取得できること", func(t *testing.T) {
+		suite := newExpenseTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		activityID := uuid.New().String()
+		e := newTestExpense(t, tripID, 1234, "USD", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), &activityID)
+
+		require.NoError(t, suite.repo.Create(suite.ctx, e), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, e.ID())
+
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertExpenseEquals(t, e, found)
+	})
+
+	t.Run("存在しないIDでExpenseNotFoundが返されること", func(t *testing.T) {
+		suite := newExpenseTestSuite(t)
+
+		_, err := suite.repo.FindByID(suite.ctx, expense.NewExpenseID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, expense.NewExpenseNotFoundError(),
+			"ExpenseNotFoundが返されるべき")
+	})
+
+	t.Run("不正な形式のアクティビティIDでInternalErrorが返されること", func(t *testing.T) {
+		suite := newExpenseTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		invalidActivityID := "invalid-uuid-format"
+		e := newTestExpense(t, tripID, 1000, "JPY", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), &invalidActivityID)
+
+		err := suite.repo.Create(suite.ctx, e)
+
+		assert.ErrorIs(t, err, apperr.NewInternalError(""),
+			"InternalErrorが返されるべき")
+	})
+}
+
+func TestExpensePostgresRepository_FindByTripID(t *testing.T) {
+	t.Run("旅行に紐づくExpenseが日付順に取得できること", func(t *testing.T) {
+		suite := newExpenseTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		otherTripID := suite.createTrip(t)
+		later := newTestExpense(t, tripID, 2000, "JPY", time.Date(2025, 8, 2, 0, 0, 0, 0, time.UTC), nil)
+		earlier := newTestExpense(t, tripID, 1000, "JPY", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), nil)
+		other := newTestExpense(t, otherTripID, 500, "JPY", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), nil)
+		for _, e := range []*expense.Expense{later, earlier, other} {
+			require.NoError(t, suite.repo.Create(suite.ctx, e), "Createでエラーが発生してはならない")
+		}
+
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 2, "対象旅行の支出のみが返されるべき")
+		assert.Equal(t, earlier.ID(), found[0].ID(), "日付が早い支出が先に返されるべき")
+		assert.Equal(t, later.ID(), found[1].ID(), "日付が遅い支出が後に返されるべき")
+	})
+}
+
+func TestExpensePostgresRepository_Update(t *testing.T) {
+	t.Run("既存のExpenseを更新できること", func(t *testing.T) {
+		suite := newExpenseTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		e := newTestExpense(t, tripID, 1000, "JPY", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), nil)
+		require.NoError(t, suite.repo.Create(suite.ctx, e), "Createでエラーが発生してはならない")
+
+		amount, err := money.NewMoney(4500, "EUR")
+		require.NoError(t, err, "Moneyの生成に失敗")
+		split, err := expense.NewSplit(expense.SplitMethodShares, []expense.SplitEntry{
+			expense.NewSplitEntry("Alice", 2),
+			expense.NewSplitEntry("Bob", 1),
+		})
+		require.NoError(t, err, "Splitの生成に失敗")
+		updated := e.Update(amount, expense.CategoryTransport, time.Date(2025, 8, 3, 0, 0, 0, 0, time.UTC), "Bob", "電車", nil, split,
+			time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, suite.repo.Update(suite.ctx, updated), "Updateでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByID(suite.ctx, e.ID())
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertExpenseEquals(t, updated, found)
+	})
+}
+
+func TestExpensePostgresRepository_Delete(t *testing.T) {
+	t.Run("既存のExpenseを削除できること", func(t *testing.T) {
+		suite := newExpenseTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		e := newTestExpense(t, tripID, 1000, "JPY", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), nil)
+		require.NoError(t, suite.repo.Create(suite.ctx, e), "Createでエラーが発生してはならない")
+
+		require.NoError(t, suite.repo.Delete(suite.ctx, e.ID()), "Deleteでエラーが発生してはならない")
+
+		_, err := suite.repo.FindByID(suite.ctx, e.ID())
+		assert.ErrorIs(t, err, expense.NewExpenseNotFoundError(),
+			"削除後はExpenseNotFoundが返されるべき")
+	})
+
+	t.Run("存在しないIDでExpenseNotFoundが返されること", func(t *testing.T) {
+		suite := newExpenseTestSuite(t)
+
+		err := suite.repo.Delete(suite.ctx, expense.NewExpenseID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, expense.NewExpenseNotFoundError(),
+			"ExpenseNotFoundが返されるべき")
+	})
+}
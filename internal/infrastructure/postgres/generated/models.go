@@ -8,6 +8,170 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Accommodation struct {
+	ID                 pgtype.UUID
+	TripID             pgtype.UUID
+	Name               string
+	Address            string
+	CheckInAt          pgtype.Timestamptz
+	CheckOutAt         pgtype.Timestamptz
+	ConfirmationNumber string
+	CostAmount         int64
+	CostCurrency       string
+	Notes              string
+	CreatedAt          pgtype.Timestamptz
+	UpdatedAt          pgtype.Timestamptz
+	Timezone           pgtype.Text
+}
+
+type Activity struct {
+	ID             pgtype.UUID
+	TripID         pgtype.UUID
+	ActivityDate   pgtype.Date
+	Position       int32
+	Title          string
+	PlaceName      pgtype.Text
+	PlaceLatitude  pgtype.Float8
+	PlaceLongitude pgtype.Float8
+	StartAt        pgtype.Timestamptz
+	EndAt          pgtype.Timestamptz
+	TravelMode     string
+	Notes          string
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	PlaceTimezone  pgtype.Text
+	Timezone       pgtype.Text
+}
+
+type Airport struct {
+	IataCode    string
+	IcaoCode    string
+	Name        string
+	City        string
+	CountryCode string
+	Latitude    float64
+	Longitude   float64
+	Timezone    string
+}
+
+type Attachment struct {
+	ID          pgtype.UUID
+	TripID      pgtype.UUID
+	TargetType  string
+	TargetID    pgtype.UUID
+	Filename    string
+	ContentType string
+	SizeBytes   int64
+	StorageKey  string
+	UploadedBy  pgtype.UUID
+	CreatedAt   pgtype.Timestamptz
+}
+
+type Budget struct {
+	TripID         pgtype.UUID
+	Currency       string
+	CategoryLimits []byte
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+}
+
+type CalendarFeed struct {
+	ID        pgtype.UUID
+	UserID    pgtype.UUID
+	TokenHash string
+	CreatedAt pgtype.Timestamptz
+	RevokedAt pgtype.Timestamptz
+}
+
+type Checklist struct {
+	ID        pgtype.UUID
+	TripID    pgtype.UUID
+	Name      string
+	Kind      string
+	Items     []byte
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type ChecklistItemTemplate struct {
+	ID        pgtype.UUID
+	OwnerID   pgtype.UUID
+	Name      string
+	Quantity  int32
+	PerDay    bool
+	CreatedAt pgtype.Timestamptz
+	UpdatedAt pgtype.Timestamptz
+}
+
+type City struct {
+	Code        string
+	Name        string
+	CountryCode string
+	Latitude    float64
+	Longitude   float64
+	Timezone    string
+}
+
+type Country struct {
+	Code       string
+	Alpha3Code string
+	Name       string
+	Currency   string
+}
+
+type ExchangeRate struct {
+	RateDate      pgtype.Date
+	BaseCurrency  string
+	QuoteCurrency string
+	Rate          pgtype.Numeric
+}
+
+type Expense struct {
+	ID          pgtype.UUID
+	TripID      pgtype.UUID
+	Amount      int64
+	Currency    string
+	Category    string
+	SpentOn     pgtype.Date
+	Payer       string
+	Description string
+	ActivityID  pgtype.UUID
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+	Split       []byte
+}
+
+type JournalEntry struct {
+	ID             pgtype.UUID
+	TripID         pgtype.UUID
+	EntryDate      pgtype.Date
+	Title          string
+	Body           string
+	Mood           string
+	PlaceName      pgtype.Text
+	PlaceLatitude  pgtype.Float8
+	PlaceLongitude pgtype.Float8
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	PlaceTimezone  pgtype.Text
+}
+
+type Photo struct {
+	ID               pgtype.UUID
+	TripID           pgtype.UUID
+	Filename         string
+	ContentType      string
+	SizeBytes        int64
+	Width            int32
+	Height           int32
+	CapturedAt       pgtype.Timestamp
+	Latitude         pgtype.Float8
+	Longitude        pgtype.Float8
+	LocationStripped bool
+	UploadedBy       pgtype.UUID
+	CreatedAt        pgtype.Timestamptz
+}
+
 type RefreshToken struct {
 	ID        pgtype.UUID
 	UserID    pgtype.UUID
@@ -24,13 +188,133 @@ type RevokedToken struct {
 	RevokedAt pgtype.Timestamptz
 }
 
+type SearchDocument struct {
+	ResourceType string
+	ResourceID   pgtype.UUID
+	TripID       pgtype.UUID
+	Title        string
+	Body         string
+	SearchVector interface{}
+}
+
+type Track struct {
+	ID                  pgtype.UUID
+	TripID              pgtype.UUID
+	ActivityID          pgtype.UUID
+	Name                string
+	DistanceMeters      float64
+	MovingDurationMs    int64
+	ElapsedDurationMs   int64
+	ElevationGainMeters float64
+	ElevationLossMeters float64
+	MaxSpeedMps         float64
+	StartedAt           pgtype.Timestamptz
+	EndedAt             pgtype.Timestamptz
+	MinLatitude         float64
+	MinLongitude        float64
+	MaxLatitude         float64
+	MaxLongitude        float64
+	PointCount          int32
+	Geometry            []byte
+	UploadedBy          pgtype.UUID
+	CreatedAt           pgtype.Timestamptz
+}
+
+type TransportLeg struct {
+	ID                   pgtype.UUID
+	TripID               pgtype.UUID
+	Mode                 string
+	OriginName           string
+	OriginLatitude       pgtype.Float8
+	OriginLongitude      pgtype.Float8
+	OriginTimezone       pgtype.Text
+	DestinationName      string
+	DestinationLatitude  pgtype.Float8
+	DestinationLongitude pgtype.Float8
+	DestinationTimezone  pgtype.Text
+	DepartureAt          pgtype.Timestamptz
+	ArrivalAt            pgtype.Timestamptz
+	Carrier              string
+	Number               string
+	Notes                string
+	CreatedAt            pgtype.Timestamptz
+	UpdatedAt            pgtype.Timestamptz
+}
+
 type Trip struct {
-	ID        pgtype.UUID
-	Name      string
+	ID           pgtype.UUID
+	Name         string
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+	StartDate    pgtype.Date
+	EndDate      pgtype.Date
+	Version      int64
+	DeletedAt    pgtype.Timestamptz
+	HomeCurrency pgtype.Text
+}
+
+type TripInvitation struct {
+	ID              pgtype.UUID
+	TripID          pgtype.UUID
+	InviterID       pgtype.UUID
+	InviteeEmail    pgtype.Text
+	InviteeUsername pgtype.Text
+	Role            string
+	Status          string
+	ExpiresAt       pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+	RespondedAt     pgtype.Timestamptz
+}
+
+type TripMember struct {
+	TripID    pgtype.UUID
+	UserID    pgtype.UUID
+	Role      string
 	CreatedAt pgtype.Timestamptz
 	UpdatedAt pgtype.Timestamptz
 }
 
+type TripRevision struct {
+	TripID     pgtype.UUID
+	Revision   int64
+	ActorID    pgtype.UUID
+	RevertedTo pgtype.Int8
+	CreatedAt  pgtype.Timestamptz
+}
+
+type TripRevisionChange struct {
+	TripID       pgtype.UUID
+	Revision     int64
+	Position     int32
+	ResourceType string
+	ResourceID   pgtype.UUID
+	Action       string
+	BeforeState  []byte
+	AfterState   []byte
+}
+
+type TripShareLink struct {
+	ID           pgtype.UUID
+	TripID       pgtype.UUID
+	TokenHash    string
+	PasswordHash []byte
+	ExpiresAt    pgtype.Timestamptz
+	CreatedBy    pgtype.UUID
+	CreatedAt    pgtype.Timestamptz
+	RevokedAt    pgtype.Timestamptz
+}
+
+type TripTemplate struct {
+	ID          pgtype.UUID
+	OwnerID     pgtype.UUID
+	Name        string
+	Description string
+	IsPublic    bool
+	Content     []byte
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
 type User struct {
 	ID           pgtype.UUID
 	Username     string
@@ -12,43 +12,38 @@ import (
 )
 
 const createTrip = `-- name: CreateTrip :exec
-INSERT INTO trips (id, name, created_at, updated_at)
-VALUES ($1, $2, $3, $4)
+INSERT INTO trips (id, name, start_date, end_date, created_at, updated_at, version, home_currency)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
 type CreateTripParams struct {
-	ID        pgtype.UUID
-	Name      string
-	CreatedAt pgtype.Timestamptz
-	UpdatedAt pgtype.Timestamptz
+	ID           pgtype.UUID
+	Name         string
+	StartDate    pgtype.Date
+	EndDate      pgtype.Date
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+	Version      int64
+	HomeCurrency pgtype.Text
 }
 
 func (q *Queries) CreateTrip(ctx context.Context, arg CreateTripParams) error {
 	_, err := q.db.Exec(ctx, createTrip,
 		arg.ID,
 		arg.Name,
+		arg.StartDate,
+		arg.EndDate,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.Version,
+		arg.HomeCurrency,
 	)
 	return err
 }
 
-const deleteTrip = `-- name: DeleteTrip :execrows
-DELETE FROM trips
-WHERE id = $1
-`
-
-func (q *Queries) DeleteTrip(ctx context.Context, id pgtype.UUID) (int64, error) {
-	result, err := q.db.Exec(ctx, deleteTrip, id)
-	if err != nil {
-		return 0, err
-	}
-	return result.RowsAffected(), nil
-}
-
 const findTrip = `-- name: FindTrip :one
-SELECT id, name, created_at, updated_at FROM trips
-WHERE id = $1
+SELECT id, name, created_at, updated_at, start_date, end_date, version, deleted_at, home_currency FROM trips
+WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) FindTrip(ctx context.Context, id pgtype.UUID) (Trip, error) {
@@ -59,16 +54,50 @@ func (q *Queries) FindTrip(ctx context.Context, id pgtype.UUID) (Trip, error) {
 		&i.Name,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Version,
+		&i.DeletedAt,
+		&i.HomeCurrency,
 	)
 	return i, err
 }
 
-const listTrips = `-- name: ListTrips :many
-SELECT id, name, created_at, updated_at FROM trips
+const listTrashedTripIDsBefore = `-- name: ListTrashedTripIDsBefore :many
+SELECT id FROM trips
+WHERE deleted_at < $1
+ORDER BY deleted_at, id
+`
+
+func (q *Queries) ListTrashedTripIDsBefore(ctx context.Context, deletedAt pgtype.Timestamptz) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listTrashedTripIDsBefore, deletedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrashedTripsByOwner = `-- name: ListTrashedTripsByOwner :many
+SELECT id, name, created_at, updated_at, start_date, end_date, version, deleted_at, home_currency FROM trips
+WHERE deleted_at IS NOT NULL
+  AND id IN (SELECT trip_id FROM trip_members WHERE user_id = $1 AND role = 'owner')
+ORDER BY deleted_at DESC, id
 `
 
-func (q *Queries) ListTrips(ctx context.Context) ([]Trip, error) {
-	rows, err := q.db.Query(ctx, listTrips)
+func (q *Queries) ListTrashedTripsByOwner(ctx context.Context, userID pgtype.UUID) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, listTrashedTripsByOwner, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +110,11 @@ func (q *Queries) ListTrips(ctx context.Context) ([]Trip, error) {
 			&i.Name,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.StartDate,
+			&i.EndDate,
+			&i.Version,
+			&i.DeletedAt,
+			&i.HomeCurrency,
 		); err != nil {
 			return nil, err
 		}
@@ -92,21 +126,91 @@ func (q *Queries) ListTrips(ctx context.Context) ([]Trip, error) {
 	return items, nil
 }
 
-const updateTrip = `-- name: UpdateTrip :exec
+const purgeTrip = `-- name: PurgeTrip :execrows
+DELETE FROM trips
+WHERE id = $1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) PurgeTrip(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeTrip, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreTrip = `-- name: RestoreTrip :execrows
+UPDATE trips
+SET
+  deleted_at = NULL,
+  version = version + 1
+WHERE id = $1 AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) RestoreTrip(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreTrip, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const trashTrip = `-- name: TrashTrip :execrows
+UPDATE trips
+SET
+  deleted_at = $3,
+  version = version + 1
+WHERE id = $1 AND version = $2 AND deleted_at IS NULL
+`
+
+type TrashTripParams struct {
+	ID        pgtype.UUID
+	Version   int64
+	DeletedAt pgtype.Timestamptz
+}
+
+func (q *Queries) TrashTrip(ctx context.Context, arg TrashTripParams) (int64, error) {
+	result, err := q.db.Exec(ctx, trashTrip, arg.ID, arg.Version, arg.DeletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTrip = `-- name: UpdateTrip :execrows
 UPDATE trips
 SET
   name = $2,
-  updated_at = $3
-WHERE id = $1
+  start_date = $3,
+  end_date = $4,
+  updated_at = $5,
+  home_currency = $7,
+  version = version + 1
+WHERE id = $1 AND version = $6 AND deleted_at IS NULL
 `
 
 type UpdateTripParams struct {
-	ID        pgtype.UUID
-	Name      string
-	UpdatedAt pgtype.Timestamptz
+	ID           pgtype.UUID
+	Name         string
+	StartDate    pgtype.Date
+	EndDate      pgtype.Date
+	UpdatedAt    pgtype.Timestamptz
+	Version      int64
+	HomeCurrency pgtype.Text
 }
 
-func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
-	_, err := q.db.Exec(ctx, updateTrip, arg.ID, arg.Name, arg.UpdatedAt)
-	return err
+func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTrip,
+		arg.ID,
+		arg.Name,
+		arg.StartDate,
+		arg.EndDate,
+		arg.UpdatedAt,
+		arg.Version,
+		arg.HomeCurrency,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
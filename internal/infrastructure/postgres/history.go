@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// HistoryPostgresRepository は旅行の変更履歴のPostgreSQL実装
+type HistoryPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewHistoryPostgresRepository は新しいHistoryPostgresRepositoryを作成する
+func NewHistoryPostgresRepository(db postgres.DBTX) history.HistoryRepository {
+	return &HistoryPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// NextRevision は旅行の行をロックしたうえで次のリビジョン番号を払い出す
+func (r *HistoryPostgresRepository) NextRevision(ctx context.Context, tripID trip.TripID) (int64, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return 0, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	revision, err := queries.NextTripRevision(ctx, pgTripID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, trip.NewTripNotFoundError()
+		}
+		return 0, apperr.NewInternalError("Failed to allocate trip revision number", apperr.WithCause(err))
+	}
+
+	return revision, nil
+}
+
+// Save はリビジョンとそれに含まれる変更を保存する
+func (r *HistoryPostgresRepository) Save(ctx context.Context, revision *history.Revision) error {
+	if revision == nil {
+		return apperr.NewInternalError("Revision entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(revision.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgActorID, err := mapper.ToUUID(revision.ActorID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert actor ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(revision.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert revision created_at to timestamp", apperr.WithCause(err))
+	}
+
+	var pgRevertedTo pgtype.Int8
+	if revision.RevertedTo() != nil {
+		pgRevertedTo = pgtype.Int8{Int64: *revision.RevertedTo(), Valid: true}
+	}
+
+	if err := queries.CreateTripRevision(ctx, postgres.CreateTripRevisionParams{
+		TripID:     pgTripID,
+		Revision:   revision.Number(),
+		ActorID:    pgActorID,
+		RevertedTo: pgRevertedTo,
+		CreatedAt:  pgCreatedAt,
+	}); err != nil {
+		return apperr.NewInternalError("Failed to create trip revision in database", apperr.WithCause(err))
+	}
+
+	for i, change := range revision.Changes() {
+		pgResourceID, err := mapper.ToUUID(change.ResourceID())
+		if err != nil {
+			return apperr.NewInternalError("Failed to convert resource ID to UUID for creation", apperr.WithCause(err))
+		}
+
+		if err := queries.CreateTripRevisionChange(ctx, postgres.CreateTripRevisionChangeParams{
+			TripID:       pgTripID,
+			Revision:     revision.Number(),
+			Position:     int32(i),
+			ResourceType: change.ResourceType().String(),
+			ResourceID:   pgResourceID,
+			Action:       change.Action().String(),
+			BeforeState:  change.Before(),
+			AfterState:   change.After(),
+		}); err != nil {
+			return apperr.NewInternalError("Failed to create trip revision change in database", apperr.WithCause(err))
+		}
+	}
+
+	return nil
+}
+
+// FindByTripID は旅行のリビジョンを新しい順に取得する
+func (r *HistoryPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID) ([]*history.Revision, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListTripRevisions(ctx, pgTripID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch trip revisions from database", apperr.WithCause(err))
+	}
+
+	changeRecords, err := queries.ListTripRevisionChanges(ctx, pgTripID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch trip revision changes from database", apperr.WithCause(err))
+	}
+
+	changes := make(map[int64][]history.Change)
+	for _, record := range changeRecords {
+		change, err := r.mapToChange(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to revision change domain object", apperr.WithCause(err))
+		}
+		changes[record.Revision] = append(changes[record.Revision], change)
+	}
+
+	revisions := make([]*history.Revision, 0, len(records))
+	for _, record := range records {
+		revision, err := r.mapToRevision(tripID, record, changes[record.Revision])
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to revision domain object", apperr.WithCause(err))
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+// mapToRevision はデータベースレコードをドメインオブジェクトに変換する
+func (r *HistoryPostgresRepository) mapToRevision(tripID trip.TripID, record postgres.TripRevision, changes []history.Change) (*history.Revision, error) {
+	mapper := r.GetTypeMapper()
+
+	actorID, err := mapper.FromUUID(record.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var revertedTo *int64
+	if record.RevertedTo.Valid {
+		revertedTo = &record.RevertedTo.Int64
+	}
+
+	return history.NewRevision(
+		tripID,
+		record.Revision,
+		user.NewUserID(actorID),
+		revertedTo,
+		changes,
+		createdAt,
+	), nil
+}
+
+// mapToChange はデータベースレコードをドメインオブジェクトに変換する
+func (r *HistoryPostgresRepository) mapToChange(record postgres.TripRevisionChange) (history.Change, error) {
+	resourceID, err := r.GetTypeMapper().FromUUID(record.ResourceID)
+	if err != nil {
+		return history.Change{}, err
+	}
+
+	return history.RestoreChange(
+		history.ResourceType(record.ResourceType),
+		resourceID,
+		history.Action(record.Action),
+		record.BeforeState,
+		record.AfterState,
+	), nil
+}
@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// historyTestSuite テスト用の共通セットアップ
+type historyTestSuite struct {
+	ctx     context.Context
+	repo    history.HistoryRepository
+	trip    *trip.Trip
+	actorID user.UserID
+}
+
+// newHistoryTestSuite 変更履歴の親となるTripと操作者を作成したテストスイートを作成する（トランザクション分離）
+func newHistoryTestSuite(t *testing.T) *historyTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	tt := newTestTrip("履歴テスト旅行")
+	domainTrip := tt.toDomainTrip()
+	require.NoError(t, NewTripPostgresRepository(tx).Create(ctx, domainTrip), "Tripの作成に失敗")
+	tu := newTestUser("historian", "historian@example.com")
+	require.NoError(t, NewUserPostgresRepository(tx).Create(ctx, tu.toDomainUser()), "Userの作成に失敗")
+
+	return &historyTestSuite{
+		ctx:     ctx,
+		repo:    NewHistoryPostgresRepository(tx),
+		trip:    domainTrip,
+		actorID: tu.ID,
+	}
+}
+
+// saveRevision 次のリビジョン番号を払い出して旅行の作成を記録する
+func (s *historyTestSuite) saveRevision(t *testing.T, revertedTo *int64, createdAt time.Time) *history.Revision {
+	t.Helper()
+
+	number, err := s.repo.NextRevision(s.ctx, s.trip.ID())
+	require.NoError(t, err)
+
+	change, err := history.NewCreatedChange(s.trip)
+	require.NoError(t, err)
+
+	revision := history.NewRevision(s.trip.ID(), number, s.actorID, revertedTo, []history.Change{change}, createdAt)
+	require.NoError(t, s.repo.Save(s.ctx, revision), "Saveでエラーが発生してはならない")
+	return revision
+}
+
+func TestHistoryPostgresRepository_NextRevision(t *testing.T) {
+	t.Run("リビジョン番号が旅行ごとに1から順に払い出されること", func(t *testing.T) {
+		suite := newHistoryTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		first := suite.saveRevision(t, nil, now)
+		second := suite.saveRevision(t, nil, now.Add(time.Minute))
+
+		assert.Equal(t, int64(1), first.Number())
+		assert.Equal(t, int64(2), second.Number())
+	})
+
+	t.Run("存在しない旅行の場合はTripNotFoundエラーを返すこと", func(t *testing.T) {
+		suite := newHistoryTestSuite(t)
+
+		_, err := suite.repo.NextRevision(suite.ctx, trip.NewTripID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, trip.NewTripNotFoundError(),
+			"TripNotFoundが返されるべき")
+	})
+}
+
+func TestHistoryPostgresRepository_SaveAndFind(t *testing.T) {
+	t.Run("保存したリビジョンが変更とともに新しい順に取得できること", func(t *testing.T) {
+		suite := newHistoryTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		suite.saveRevision(t, nil, now)
+		revertedTo := int64(1)
+		suite.saveRevision(t, &revertedTo, now.Add(time.Minute))
+
+		revisions, err := suite.repo.FindByTripID(suite.ctx, suite.trip.ID())
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+
+		assert.Equal(t, int64(2), revisions[0].Number())
+		require.NotNil(t, revisions[0].RevertedTo())
+		assert.Equal(t, int64(1), *revisions[0].RevertedTo())
+		assert.Equal(t, suite.actorID, revisions[0].ActorID())
+		assert.True(t, now.Add(time.Minute).Equal(revisions[0].CreatedAt()))
+
+		assert.Equal(t, int64(1), revisions[1].Number())
+		assert.Nil(t, revisions[1].RevertedTo())
+		require.Len(t, revisions[1].Changes(), 1)
+
+		change := revisions[1].Changes()[0]
+		assert.Equal(t, history.ResourceTypeTrip, change.ResourceType())
+		assert.Equal(t, suite.trip.ID().String(), change.ResourceID())
+		assert.Equal(t, history.ActionCreated, change.Action())
+		assert.Nil(t, change.Before())
+
+		expected, err := history.EncodeSnapshot(suite.trip)
+		require.NoError(t, err)
+		assert.True(t, history.SameSnapshot(expected, change.After()))
+	})
+
+	t.Run("リビジョンがない場合は空のスライスを返すこと", func(t *testing.T) {
+		suite := newHistoryTestSuite(t)
+
+		revisions, err := suite.repo.FindByTripID(suite.ctx, suite.trip.ID())
+		require.NoError(t, err)
+		assert.Empty(t, revisions)
+	})
+}
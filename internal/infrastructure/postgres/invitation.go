@@ -0,0 +1,251 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// InvitationPostgresRepository はInvitationエンティティのPostgreSQL実装
+type InvitationPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewInvitationPostgresRepository は新しいInvitationPostgresRepositoryを作成する
+func NewInvitationPostgresRepository(db postgres.DBTX) membership.InvitationRepository {
+	return &InvitationPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDのInvitationを取得する
+func (r *InvitationPostgresRepository) FindByID(ctx context.Context, id membership.InvitationID) (*membership.Invitation, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert invitation ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindTripInvitation(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, membership.NewInvitationNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch invitation from database", apperr.WithCause(err))
+	}
+
+	invitation, err := r.mapToInvitation(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to invitation domain object", apperr.WithCause(err))
+	}
+
+	return invitation, nil
+}
+
+// FindByTripID は指定された旅行のInvitationを作成日時の新しい順に取得する
+func (r *InvitationPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID) ([]*membership.Invitation, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListTripInvitationsByTripID(ctx, pgTripID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch invitations list from database", apperr.WithCause(err))
+	}
+
+	return r.mapToInvitations(records)
+}
+
+// FindPendingByInvitee はメールアドレスかユーザー名が宛先に一致する未応答のInvitationを取得する
+func (r *InvitationPostgresRepository) FindPendingByInvitee(ctx context.Context, email, username string) ([]*membership.Invitation, error) {
+	queries := r.GetQueries(ctx)
+
+	records, err := queries.ListPendingTripInvitationsByInvitee(ctx, postgres.ListPendingTripInvitationsByInviteeParams{
+		Email:    email,
+		Username: username,
+	})
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch pending invitations from database", apperr.WithCause(err))
+	}
+
+	return r.mapToInvitations(records)
+}
+
+// Create は新しいInvitationを作成する
+func (r *InvitationPostgresRepository) Create(ctx context.Context, invitation *membership.Invitation) error {
+	if invitation == nil {
+		return apperr.NewInternalError("Invitation entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(invitation.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert invitation ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgTripID, err := mapper.ToUUID(invitation.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgInviterID, err := mapper.ToUUID(invitation.InviterID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert inviter ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgExpiresAt, err := mapper.ToTimestamp(invitation.ExpiresAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert invitation expires_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(invitation.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert invitation created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgRespondedAt, err := mapper.ToNullableTimestamp(invitation.RespondedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert invitation responded_at to timestamp", apperr.WithCause(err))
+	}
+
+	params := postgres.CreateTripInvitationParams{
+		ID:              pgID,
+		TripID:          pgTripID,
+		InviterID:       pgInviterID,
+		InviteeEmail:    mapper.ToNullableText(invitation.Invitee().Email()),
+		InviteeUsername: mapper.ToNullableText(invitation.Invitee().Username()),
+		Role:            invitation.Role().String(),
+		Status:          invitation.Status().String(),
+		ExpiresAt:       pgExpiresAt,
+		CreatedAt:       pgCreatedAt,
+		RespondedAt:     pgRespondedAt,
+	}
+
+	if err := queries.CreateTripInvitation(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create invitation in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Update は既存のInvitationの応答状況を更新する
+func (r *InvitationPostgresRepository) Update(ctx context.Context, invitation *membership.Invitation) error {
+	if invitation == nil {
+		return apperr.NewInternalError("Invitation entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(invitation.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert invitation ID to UUID for update", apperr.WithCause(err))
+	}
+
+	pgRespondedAt, err := mapper.ToNullableTimestamp(invitation.RespondedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert invitation responded_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	rows, err := queries.UpdateTripInvitation(ctx, postgres.UpdateTripInvitationParams{
+		ID:          pgID,
+		Status:      invitation.Status().String(),
+		RespondedAt: pgRespondedAt,
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to update invitation in database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return membership.NewInvitationNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToInvitations はデータベースレコードの一覧をドメインオブジェクトに変換する
+func (r *InvitationPostgresRepository) mapToInvitations(records []postgres.TripInvitation) ([]*membership.Invitation, error) {
+	invitations := make([]*membership.Invitation, 0, len(records))
+	for _, record := range records {
+		invitation, err := r.mapToInvitation(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to invitation domain object", apperr.WithCause(err))
+		}
+		invitations = append(invitations, invitation)
+	}
+	return invitations, nil
+}
+
+// mapToInvitation はデータベースレコードをドメインオブジェクトに変換する
+func (r *InvitationPostgresRepository) mapToInvitation(record postgres.TripInvitation) (*membership.Invitation, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	inviterID, err := mapper.FromUUID(record.InviterID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitee, err := membership.NewInvitee(
+		mapper.FromNullableText(record.InviteeEmail),
+		mapper.FromNullableText(record.InviteeUsername),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := membership.ParseRole(record.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := membership.ParseInvitationStatus(record.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := mapper.FromTimestamp(record.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return membership.RestoreInvitation(
+		membership.NewInvitationID(id),
+		trip.NewTripID(tripID),
+		user.NewUserID(inviterID),
+		invitee,
+		role,
+		status,
+		expiresAt,
+		createdAt,
+		mapper.FromNullableTimestamp(record.RespondedAt),
+	), nil
+}
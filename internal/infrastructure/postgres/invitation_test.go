@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// invitationTestSuite テスト用の共通セットアップ
+type invitationTestSuite struct {
+	ctx       context.Context
+	repo      membership.InvitationRepository
+	tripID    trip.TripID
+	inviterID user.UserID
+}
+
+// newInvitationTestSuite 招待の親となるTripと招待者を作成したテストスイートを作成する（トランザクション分離）
+func newInvitationTestSuite(t *testing.T) *invitationTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	tt := newTestTrip("招待テスト旅行")
+	require.NoError(t, NewTripPostgresRepository(tx).Create(ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+	tu := newTestUser("inviter", "inviter@example.com")
+	require.NoError(t, NewUserPostgresRepository(tx).Create(ctx, tu.toDomainUser()), "Userの作成に失敗")
+
+	return &invitationTestSuite{
+		ctx:       ctx,
+		repo:      NewInvitationPostgresRepository(tx),
+		tripID:    tt.ID,
+		inviterID: tu.ID,
+	}
+}
+
+// newInvitation テスト用のInvitationを生成する
+func (s *invitationTestSuite) newInvitation(t *testing.T, email, username string, createdAt time.Time) *membership.Invitation {
+	t.Helper()
+
+	invitee, err := membership.NewInvitee(email, username)
+	require.NoError(t, err)
+	invitation, err := membership.NewInvitation(
+		membership.NewInvitationID(uuid.New().String()),
+		s.tripID,
+		s.inviterID,
+		invitee,
+		membership.RoleViewer,
+		createdAt.Add(membership.DefaultInvitationTTL),
+		createdAt,
+	)
+	require.NoError(t, err)
+	return invitation
+}
+
+func TestInvitationPostgresRepository_CreateAndFindByID(t *testing.T) {
+	t.Run("作成したInvitationを取得できること", func(t *testing.T) {
+		suite := newInvitationTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		invitation := suite.newInvitation(t, "guest@example.com", "", now)
+
+		require.NoError(t, suite.repo.Create(suite.ctx, invitation), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, invitation.ID())
+
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assert.Equal(t, invitation.Invitee(), found.Invitee(), "宛先が一致すること")
+		assert.Equal(t, membership.RoleViewer, found.Role(), "ロールが一致すること")
+		assert.Equal(t, membership.InvitationStatusPending, found.Status(), "未応答であること")
+		assert.WithinDuration(t, invitation.ExpiresAt(), found.ExpiresAt(), time.Second, "有効期限が一致すること")
+		assert.Nil(t, found.RespondedAt(), "応答日時は未設定であること")
+	})
+
+	t.Run("存在しないInvitationでInvitationNotFoundが返されること", func(t *testing.T) {
+		suite := newInvitationTestSuite(t)
+
+		_, err := suite.repo.FindByID(suite.ctx, membership.NewInvitationID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, membership.NewInvitationNotFoundError(),
+			"InvitationNotFoundが返されるべき")
+	})
+}
+
+func TestInvitationPostgresRepository_FindPendingByInvitee(t *testing.T) {
+	t.Run("メールアドレスかユーザー名が一致する未応答の招待だけを取得できること", func(t *testing.T) {
+		suite := newInvitationTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		byEmail := suite.newInvitation(t, "Guest@Example.com", "", now)
+		byUsername := suite.newInvitation(t, "", "guest", now.Add(time.Minute))
+		other := suite.newInvitation(t, "", "someone", now)
+		responded := suite.newInvitation(t, "", "guest", now)
+		for _, invitation := range []*membership.Invitation{byEmail, byUsername, other, responded} {
+			require.NoError(t, suite.repo.Create(suite.ctx, invitation), "Createでエラーが発生してはならない")
+		}
+		guest := user.NewUser(user.NewUserID(uuid.New().String()), "guest", "guest@example.com", nil, now, now)
+		declined, err := responded.Decline(guest, now)
+		require.NoError(t, err)
+		require.NoError(t, suite.repo.Update(suite.ctx, declined), "Updateでエラーが発生してはならない")
+
+		found, err := suite.repo.FindPendingByInvitee(suite.ctx, "guest@example.com", "guest")
+
+		require.NoError(t, err, "FindPendingByInviteeでエラーが発生してはならない")
+		require.Len(t, found, 2)
+		assert.True(t, byUsername.Equals(found[0]), "作成日時の新しい順に取得されること")
+		assert.True(t, byEmail.Equals(found[1]), "メールアドレスは大文字小文字を区別しないこと")
+	})
+}
+
+func TestInvitationPostgresRepository_Update(t *testing.T) {
+	t.Run("応答状況を更新できること", func(t *testing.T) {
+		suite := newInvitationTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		invitation := suite.newInvitation(t, "", "guest", now)
+		require.NoError(t, suite.repo.Create(suite.ctx, invitation))
+		guest := user.NewUser(user.NewUserID(uuid.New().String()), "guest", "guest@example.com", nil, now, now)
+		accepted, err := invitation.Accept(guest, now.Add(time.Hour))
+		require.NoError(t, err)
+
+		require.NoError(t, suite.repo.Update(suite.ctx, accepted), "Updateでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByTripID(suite.ctx, suite.tripID)
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 1)
+		assert.Equal(t, membership.InvitationStatusAccepted, found[0].Status(), "承諾済みになること")
+		require.NotNil(t, found[0].RespondedAt())
+		assert.WithinDuration(t, now.Add(time.Hour), *found[0].RespondedAt(), time.Second, "応答日時が保存されること")
+	})
+
+	t.Run("存在しないInvitationの更新でInvitationNotFoundが返されること", func(t *testing.T) {
+		suite := newInvitationTestSuite(t)
+
+		err := suite.repo.Update(suite.ctx, suite.newInvitation(t, "", "guest", time.Now()))
+
+		assert.ErrorIs(t, err, membership.NewInvitationNotFoundError(),
+			"InvitationNotFoundが返されるべき")
+	})
+}
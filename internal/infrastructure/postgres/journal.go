@@ -0,0 +1,334 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	typemapper "github.com/hata0/travel-api/internal/infrastructure/postgres/mapper"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// JournalPostgresRepository はJournal EntryエンティティのPostgreSQL実装
+type JournalPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewJournalPostgresRepository は新しいJournalPostgresRepositoryを作成する
+func NewJournalPostgresRepository(db postgres.DBTX) journal.EntryRepository {
+	return &JournalPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDの日記を取得する
+func (r *JournalPostgresRepository) FindByID(ctx context.Context, id journal.EntryID) (*journal.Entry, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert journal entry ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindJournalEntry(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, journal.NewJournalEntryNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch journal entry from database", apperr.WithCause(err))
+	}
+
+	e, err := r.mapToEntry(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to journal entry domain object", apperr.WithCause(err))
+	}
+
+	return e, nil
+}
+
+// FindByTripID は指定された旅行の日記を日付順に取得する。date が nil でない場合はその日付の日記だけを取得する
+func (r *JournalPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID, date *time.Time) ([]*journal.Entry, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	var records []postgres.JournalEntry
+	if date == nil {
+		records, err = queries.ListJournalEntriesByTripID(ctx, pgTripID)
+	} else {
+		var pgDate pgtype.Date
+		pgDate, err = mapper.ToDate(*date)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to convert journal entry date", apperr.WithCause(err))
+		}
+		records, err = queries.ListJournalEntriesByTripIDAndDate(ctx, postgres.ListJournalEntriesByTripIDAndDateParams{
+			TripID:    pgTripID,
+			EntryDate: pgDate,
+		})
+	}
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch journal entries list from database", apperr.WithCause(err))
+	}
+
+	entries := make([]*journal.Entry, 0, len(records))
+	for _, record := range records {
+		e, err := r.mapToEntry(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to journal entry domain object", apperr.WithCause(err))
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// Create は新しい日記を作成する
+func (r *JournalPostgresRepository) Create(ctx context.Context, e *journal.Entry) error {
+	if e == nil {
+		return apperr.NewInternalError("Journal entry entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(e.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert journal entry ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgTripID, err := mapper.ToUUID(e.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgDate, err := mapper.ToDate(e.Date())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert journal entry date for creation", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(e.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert journal entry created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(e.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert journal entry updated_at to timestamp", apperr.WithCause(err))
+	}
+
+	placeName, latitude, longitude, placeTimezone := placeColumns(mapper, e.Place())
+	params := postgres.CreateJournalEntryParams{
+		ID:             pgID,
+		TripID:         pgTripID,
+		EntryDate:      pgDate,
+		Title:          e.Title(),
+		Body:           e.Body(),
+		Mood:           e.Mood().String(),
+		PlaceName:      placeName,
+		PlaceLatitude:  latitude,
+		PlaceLongitude: longitude,
+		CreatedAt:      pgCreatedAt,
+		UpdatedAt:      pgUpdatedAt,
+		PlaceTimezone:  placeTimezone,
+	}
+
+	if err := queries.CreateJournalEntry(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create journal entry in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Update は既存の日記を更新する
+func (r *JournalPostgresRepository) Update(ctx context.Context, e *journal.Entry) error {
+	if e == nil {
+		return apperr.NewInternalError("Journal entry entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(e.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert journal entry ID to UUID for update", apperr.WithCause(err))
+	}
+
+	pgDate, err := mapper.ToDate(e.Date())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert journal entry date for update", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(e.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert journal entry updated_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	placeName, latitude, longitude, placeTimezone := placeColumns(mapper, e.Place())
+	rows, err := queries.UpdateJournalEntry(ctx, postgres.UpdateJournalEntryParams{
+		ID:             pgID,
+		EntryDate:      pgDate,
+		Title:          e.Title(),
+		Body:           e.Body(),
+		Mood:           e.Mood().String(),
+		PlaceName:      placeName,
+		PlaceLatitude:  latitude,
+		PlaceLongitude: longitude,
+		UpdatedAt:      pgUpdatedAt,
+		PlaceTimezone:  placeTimezone,
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to update journal entry in database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return journal.NewJournalEntryNotFoundError()
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDの日記を削除する
+func (r *JournalPostgresRepository) Delete(ctx context.Context, id journal.EntryID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert journal entry ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeleteJournalEntry(ctx, pgID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete journal entry from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return journal.NewJournalEntryNotFoundError()
+	}
+
+	return nil
+}
+
+// placeColumns は場所を名前・緯度・経度・タイムゾーンの列の値に変換する
+func placeColumns(mapper *typemapper.PostgreSQLTypeMapper, place *geo.Place) (pgtype.Text, pgtype.Float8, pgtype.Float8, pgtype.Text) {
+	if place == nil {
+		return pgtype.Text{}, pgtype.Float8{}, pgtype.Float8{}, pgtype.Text{}
+	}
+	var latitude, longitude *float64
+	if c := place.Coordinate(); c != nil {
+		lat, lng := c.Latitude(), c.Longitude()
+		latitude, longitude = &lat, &lng
+	}
+	return mapper.ToNullableText(place.Name()), mapper.ToNullableFloat8(latitude), mapper.ToNullableFloat8(longitude), timezoneColumn(mapper, place.Timezone())
+}
+
+// timezoneColumn はタイムゾーンを名前の列の値に変換する。タイムゾーンがない場合は NULL にする
+func timezoneColumn(mapper *typemapper.PostgreSQLTypeMapper, timezone *geo.Timezone) pgtype.Text {
+	if timezone == nil {
+		return pgtype.Text{}
+	}
+	return mapper.ToNullableText(timezone.Name())
+}
+
+// mapToEntry はデータベースレコードをドメインオブジェクトに変換する
+func (r *JournalPostgresRepository) mapToEntry(record postgres.JournalEntry) (*journal.Entry, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	date, err := mapper.FromDate(record.EntryDate)
+	if err != nil {
+		return nil, err
+	}
+
+	mood, err := journal.ParseMood(record.Mood)
+	if err != nil {
+		return nil, err
+	}
+
+	place, err := placeFromColumns(mapper, record.PlaceName, record.PlaceLatitude, record.PlaceLongitude, record.PlaceTimezone)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt, err := mapper.FromTimestamp(record.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return journal.NewEntry(
+		journal.NewEntryID(id),
+		trip.NewTripID(tripID),
+		date,
+		record.Title,
+		record.Body,
+		mood,
+		place,
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+// placeFromColumns は名前・緯度・経度・タイムゾーンの列の値から場所を復元する。名前がない場合は nil を返す
+func placeFromColumns(mapper *typemapper.PostgreSQLTypeMapper, name pgtype.Text, latitude, longitude pgtype.Float8, timezone pgtype.Text) (*geo.Place, error) {
+	placeName := mapper.FromNullableText(name)
+	if placeName == "" {
+		return nil, nil
+	}
+
+	var coordinate *geo.Coordinate
+	lat, lng := mapper.FromNullableFloat8(latitude), mapper.FromNullableFloat8(longitude)
+	if lat != nil && lng != nil {
+		c, err := geo.NewCoordinate(*lat, *lng)
+		if err != nil {
+			return nil, err
+		}
+		coordinate = &c
+	}
+	tz, err := timezoneFromColumn(mapper, timezone)
+	if err != nil {
+		return nil, err
+	}
+	p, err := geo.NewPlace(placeName, coordinate, tz)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// timezoneFromColumn はタイムゾーン名の列の値からタイムゾーンを復元する。NULL の場合は nil を返す
+func timezoneFromColumn(mapper *typemapper.PostgreSQLTypeMapper, name pgtype.Text) (*geo.Timezone, error) {
+	value := mapper.FromNullableText(name)
+	if value == "" {
+		return nil, nil
+	}
+	tz, err := geo.NewTimezone(value)
+	if err != nil {
+		return nil, err
+	}
+	return &tz, nil
+}
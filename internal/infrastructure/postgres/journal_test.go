@@ -0,0 +1,220 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// journalTestSuite テスト用の共通セットアップ
+type journalTestSuite struct {
+	ctx      context.Context
+	repo     journal.EntryRepository
+	tripRepo trip.TripRepository
+}
+
+// newJournalTestSuite テストスイートを作成する（トランザクション分離）
+func newJournalTestSuite(t *testing.T) *journalTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	return &journalTestSuite{
+		ctx:      ctx,
+		repo:     NewJournalPostgresRepository(tx),
+		tripRepo: NewTripPostgresRepository(tx),
+	}
+}
+
+// createTrip 日記の親となるTripを作成する
+func (s *journalTestSuite) createTrip(t *testing.T) trip.TripID {
+	t.Helper()
+
+	tt := newTestTrip("日記テスト旅行")
+	require.NoError(t, s.tripRepo.Create(s.ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+
+	return tt.ID
+}
+
+// newTestJournalEntry テスト用の日記を生成する
+func newTestJournalEntry(t *testing.T, tripID trip.TripID, date time.Time, place *geo.Place, createdAt time.Time) *journal.Entry {
+	t.Helper()
+
+	return journal.NewEntry(
+		journal.NewEntryID(uuid.New().String()),
+		tripID,
+		date,
+		"嵐山の竹林",
+		"朝の**竹林**は静かだった",
+		journal.MoodGreat,
+		place,
+		createdAt,
+		createdAt,
+	)
+}
+
+// newTestPlace テスト用の座標とタイムゾーン付きの場所を生成する
+func newTestPlace(t *testing.T) *geo.Place {
+	t.Helper()
+
+	c, err := geo.NewCoordinate(35.0170, 135.6713)
+	require.NoError(t, err)
+	tz, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	p, err := geo.NewPlace("竹林の小径", &c, &tz)
+	require.NoError(t, err)
+	return &p
+}
+
+// assertJournalEntryEquals 日記の等価性をアサートする
+func assertJournalEntryEquals(t *testing.T, expected, actual *journal.Entry) {
+	t.Helper()
+	assert.Equal(t, expected.ID(), actual.ID(), "IDが一致すること")
+	assert.Equal(t, expected.TripID(), actual.TripID(), "TripIDが一致すること")
+	assert.True(t, expected.Date().Equal(actual.Date()), "Dateが一致すること")
+	assert.Equal(t, expected.Title(), actual.Title(), "Titleが一致すること")
+	assert.Equal(t, expected.Body(), actual.Body(), "Bodyが一致すること")
+	assert.Equal(t, expected.Mood(), actual.Mood(), "Moodが一致すること")
+	assert.Equal(t, expected.Place(), actual.Place(), "Placeが一致すること")
+	assert.True(t, expected.CreatedAt().Equal(actual.CreatedAt()), "CreatedAtが一致すること")
+	assert.True(t, expected.UpdatedAt().Equal(actual.UpdatedAt()), "UpdatedAtが一致すること")
+}
+
+func TestJournalPostgresRepository_CreateAndFindByID(t *testing.T) {
+	t.Run("作成した日記を場所を含めて取得できること", func(t *testing.T) {
+		suite := newJournalTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		e := newTestJournalEntry(t, tripID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), newTestPlace(t), now)
+
+		require.NoError(t, suite.repo.Create(suite.ctx, e), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, e.ID())
+
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertJournalEntryEquals(t, e, found)
+	})
+
+	t.Run("場所のない日記を取得できること", func(t *testing.T) {
+		suite := newJournalTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		e := newTestJournalEntry(t, tripID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), nil, time.Now().UTC().Truncate(time.Microsecond))
+
+		require.NoError(t, suite.repo.Create(suite.ctx, e), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, e.ID())
+
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assert.Nil(t, found.Place(), "場所はnilであるべき")
+	})
+
+	t.Run("存在しないIDでJournalEntryNotFoundが返されること", func(t *testing.T) {
+		suite := newJournalTestSuite(t)
+
+		_, err := suite.repo.FindByID(suite.ctx, journal.NewEntryID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, journal.NewJournalEntryNotFoundError(),
+			"JournalEntryNotFoundが返されるべき")
+	})
+}
+
+func TestJournalPostgresRepository_FindByTripID(t *testing.T) {
+	suite := newJournalTestSuite(t)
+
+	// Given: 同じ旅行に3件、別の旅行に1件の日記
+	tripID := suite.createTrip(t)
+	otherTripID := suite.createTrip(t)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	day1 := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	secondDay := newTestJournalEntry(t, tripID, day2, nil, now.Add(-2*time.Hour))
+	firstDayLater := newTestJournalEntry(t, tripID, day1, nil, now)
+	firstDayEarlier := newTestJournalEntry(t, tripID, day1, nil, now.Add(-time.Hour))
+	other := newTestJournalEntry(t, otherTripID, day1, nil, now)
+	for _, e := range []*journal.Entry{secondDay, firstDayLater, firstDayEarlier, other} {
+		require.NoError(t, suite.repo.Create(suite.ctx, e), "Createでエラーが発生してはならない")
+	}
+
+	t.Run("旅行に紐づく日記が日付順・作成順に取得できること", func(t *testing.T) {
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID, nil)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 3, "対象旅行の日記のみが返されるべき")
+		assert.Equal(t, firstDayEarlier.ID(), found[0].ID())
+		assert.Equal(t, firstDayLater.ID(), found[1].ID())
+		assert.Equal(t, secondDay.ID(), found[2].ID())
+	})
+
+	t.Run("日付を指定するとその日の日記だけが取得できること", func(t *testing.T) {
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID, &day2)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 1)
+		assert.Equal(t, secondDay.ID(), found[0].ID())
+	})
+}
+
+func TestJournalPostgresRepository_Update(t *testing.T) {
+	t.Run("内容の変更と場所の削除が反映されること", func(t *testing.T) {
+		suite := newJournalTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		e := newTestJournalEntry(t, tripID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), newTestPlace(t), now)
+		require.NoError(t, suite.repo.Create(suite.ctx, e), "Createでエラーが発生してはならない")
+
+		updated := e.Update(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), "雨の日", "# 雨\n一日中雨だった", journal.MoodBad, nil, now.Add(time.Hour))
+		require.NoError(t, suite.repo.Update(suite.ctx, updated), "Updateでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByID(suite.ctx, e.ID())
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertJournalEntryEquals(t, updated, found)
+	})
+
+	t.Run("存在しない日記の更新でJournalEntryNotFoundが返されること", func(t *testing.T) {
+		suite := newJournalTestSuite(t)
+
+		e := newTestJournalEntry(t, trip.NewTripID(uuid.New().String()), time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), nil, time.Now())
+
+		err := suite.repo.Update(suite.ctx, e)
+
+		assert.ErrorIs(t, err, journal.NewJournalEntryNotFoundError(),
+			"JournalEntryNotFoundが返されるべき")
+	})
+}
+
+func TestJournalPostgresRepository_Delete(t *testing.T) {
+	t.Run("既存の日記を削除できること", func(t *testing.T) {
+		suite := newJournalTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		e := newTestJournalEntry(t, tripID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), nil, time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, suite.repo.Create(suite.ctx, e), "Createでエラーが発生してはならない")
+
+		require.NoError(t, suite.repo.Delete(suite.ctx, e.ID()), "Deleteでエラーが発生してはならない")
+
+		_, err := suite.repo.FindByID(suite.ctx, e.ID())
+		assert.ErrorIs(t, err, journal.NewJournalEntryNotFoundError(),
+			"削除後はJournalEntryNotFoundが返されるべき")
+	})
+}
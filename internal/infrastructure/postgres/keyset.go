@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hata0/travel-api/internal/domain/shared/pagination"
+)
+
+// keysetQuery はキーセット方式でページングする SELECT 文を組み立てる。
+// 並び替えキーが同じ値の行は id で順序付け、(並び替えキー, id) の組をカーソルとして次のページを取得する。
+// 並び替え列が可変で sqlc では生成できない一覧クエリで使用する
+type keysetQuery struct {
+	// selectFrom は WHERE 句より前の SELECT ... FROM ... 部分
+	selectFrom string
+	// idColumn は並び替えキーが同じ行を順序付ける一意な列
+	idColumn   string
+	conditions []string
+	args       []any
+}
+
+func newKeysetQuery(selectFrom, idColumn string) *keysetQuery {
+	return &keysetQuery{selectFrom: selectFrom, idColumn: idColumn}
+}
+
+// bind は値をプレースホルダとして追加し、SQL 中で参照するための "$n" を返す
+func (q *keysetQuery) bind(value any) string {
+	q.args = append(q.args, value)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// where は AND で結合する条件を追加する。条件中の "?" は args の値を参照するプレースホルダに置き換える
+func (q *keysetQuery) where(condition string, args ...any) {
+	for _, arg := range args {
+		condition = strings.Replace(condition, "?", q.bind(arg), 1)
+	}
+	q.conditions = append(q.conditions, condition)
+}
+
+// build は sortExpr で並び替えた 1 ページ分の SELECT 文を返す。
+// 次のページの有無を判定できるよう、取得件数は limit より 1 件多くする。
+// カーソルの並び替えキーは文字列のまま渡し、SQL 側で sortType に変換して比較する
+func (q *keysetQuery) build(sortExpr, sortType string, direction pagination.Direction, page pagination.Request) (string, []any) {
+	comparison, order := ">", "ASC"
+	if direction == pagination.Desc {
+		comparison, order = "<", "DESC"
+	}
+
+	if after := page.After(); after != nil {
+		q.where(fmt.Sprintf("(%s, %s) %s (CAST(? AS %s), CAST(? AS uuid))", sortExpr, q.idColumn, comparison, sortType), after.Key, after.ID)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(q.selectFrom)
+	if len(q.conditions) > 0 {
+		sb.WriteString("\nWHERE ")
+		sb.WriteString(strings.Join(q.conditions, "\n  AND "))
+	}
+	fmt.Fprintf(&sb, "\nORDER BY %s %s, %s %s", sortExpr, order, q.idColumn, order)
+	fmt.Fprintf(&sb, "\nLIMIT %s", q.bind(page.Limit()+1))
+
+	return sb.String(), q.args
+}
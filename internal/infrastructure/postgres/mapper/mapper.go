@@ -2,6 +2,7 @@ package mapper
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
@@ -33,6 +34,77 @@ func (m *PostgreSQLTypeMapper) ToTimestamp(t time.Time) (pgtype.Timestamptz, err
 	return pgTime, nil
 }
 
+// ToDate は日付をpgtype.Dateに変換する
+func (m *PostgreSQLTypeMapper) ToDate(t time.Time) (pgtype.Date, error) {
+	var pgDate pgtype.Date
+	if err := pgDate.Scan(t); err != nil {
+		return pgtype.Date{}, err
+	}
+	return pgDate, nil
+}
+
+// ToNullableUUID は文字列をpgtype.UUIDに変換する。nil の場合は NULL を表す値を返す
+func (m *PostgreSQLTypeMapper) ToNullableUUID(uuidStr *string) (pgtype.UUID, error) {
+	if uuidStr == nil {
+		return pgtype.UUID{}, nil
+	}
+	return m.ToUUID(*uuidStr)
+}
+
+// ToNullableDate は日付をpgtype.Dateに変換する。nil の場合は NULL を表す値を返す
+func (m *PostgreSQLTypeMapper) ToNullableDate(t *time.Time) (pgtype.Date, error) {
+	if t == nil {
+		return pgtype.Date{}, nil
+	}
+	var pgDate pgtype.Date
+	if err := pgDate.Scan(*t); err != nil {
+		return pgtype.Date{}, err
+	}
+	return pgDate, nil
+}
+
+// ToNullableTimestamp は時刻をpgtype.Timestamptzに変換する。nil の場合は NULL を表す値を返す
+func (m *PostgreSQLTypeMapper) ToNullableTimestamp(t *time.Time) (pgtype.Timestamptz, error) {
+	if t == nil {
+		return pgtype.Timestamptz{}, nil
+	}
+	return m.ToTimestamp(*t)
+}
+
+// ToNullableLocalTimestamp はタイムゾーンなしの時刻を pgtype.Timestamp に変換する。nil の場合は NULL を表す値を返す。
+// 時刻は UTC として表された現地時刻とみなし、そのままの日時で保存する
+func (m *PostgreSQLTypeMapper) ToNullableLocalTimestamp(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+	return pgtype.Timestamp{Time: t.UTC(), Valid: true}
+}
+
+// ToNullableText は文字列をpgtype.Textに変換する。空文字列の場合は NULL を表す値を返す
+func (m *PostgreSQLTypeMapper) ToNullableText(s string) pgtype.Text {
+	if s == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: s, Valid: true}
+}
+
+// ToNullableFloat8 は浮動小数点数をpgtype.Float8に変換する。nil の場合は NULL を表す値を返す
+func (m *PostgreSQLTypeMapper) ToNullableFloat8(f *float64) pgtype.Float8 {
+	if f == nil {
+		return pgtype.Float8{}
+	}
+	return pgtype.Float8{Float64: *f, Valid: true}
+}
+
+// ToNumeric は10進数表記の文字列をpgtype.Numericに変換する
+func (m *PostgreSQLTypeMapper) ToNumeric(decimal string) (pgtype.Numeric, error) {
+	var pgNumeric pgtype.Numeric
+	if err := pgNumeric.Scan(decimal); err != nil {
+		return pgtype.Numeric{}, err
+	}
+	return pgNumeric, nil
+}
+
 // FromUUID はpgtype.UUIDを文字列に変換する
 func (m *PostgreSQLTypeMapper) FromUUID(pgUUID pgtype.UUID) (string, error) {
 	if !pgUUID.Valid {
@@ -48,3 +120,80 @@ func (m *PostgreSQLTypeMapper) FromTimestamp(pgTime pgtype.Timestamptz) (time.Ti
 	}
 	return pgTime.Time, nil
 }
+
+// FromDate はpgtype.Dateをtime.Timeに変換する
+func (m *PostgreSQLTypeMapper) FromDate(pgDate pgtype.Date) (time.Time, error) {
+	if !pgDate.Valid {
+		return time.Time{}, errors.New("date value is null or invalid")
+	}
+	return pgDate.Time, nil
+}
+
+// FromNumeric はpgtype.Numericを浮動小数点を経由せず10進数表記の文字列に変換する
+func (m *PostgreSQLTypeMapper) FromNumeric(pgNumeric pgtype.Numeric) (string, error) {
+	if !pgNumeric.Valid {
+		return "", errors.New("numeric value is null or invalid")
+	}
+	value, err := pgNumeric.Value()
+	if err != nil {
+		return "", err
+	}
+	decimal, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected numeric value type: %T", value)
+	}
+	return decimal, nil
+}
+
+// FromNullableUUID はpgtype.UUIDを文字列に変換する。NULL の場合は nil を返す
+func (m *PostgreSQLTypeMapper) FromNullableUUID(pgUUID pgtype.UUID) *string {
+	if !pgUUID.Valid {
+		return nil
+	}
+	s := pgUUID.String()
+	return &s
+}
+
+// FromNullableDate はpgtype.Dateをtime.Timeに変換する。NULL の場合は nil を返す
+func (m *PostgreSQLTypeMapper) FromNullableDate(pgDate pgtype.Date) *time.Time {
+	if !pgDate.Valid {
+		return nil
+	}
+	t := pgDate.Time
+	return &t
+}
+
+// FromNullableTimestamp はpgtype.Timestamptzをtime.Timeに変換する。NULL の場合は nil を返す
+func (m *PostgreSQLTypeMapper) FromNullableTimestamp(pgTime pgtype.Timestamptz) *time.Time {
+	if !pgTime.Valid {
+		return nil
+	}
+	t := pgTime.Time
+	return &t
+}
+
+// FromNullableLocalTimestamp は pgtype.Timestamp をタイムゾーンなしの時刻として UTC の time.Time に変換する。NULL の場合は nil を返す
+func (m *PostgreSQLTypeMapper) FromNullableLocalTimestamp(pgTime pgtype.Timestamp) *time.Time {
+	if !pgTime.Valid {
+		return nil
+	}
+	t := pgTime.Time.UTC()
+	return &t
+}
+
+// FromNullableText はpgtype.Textを文字列に変換する。NULL の場合は空文字列を返す
+func (m *PostgreSQLTypeMapper) FromNullableText(pgText pgtype.Text) string {
+	if !pgText.Valid {
+		return ""
+	}
+	return pgText.String
+}
+
+// FromNullableFloat8 はpgtype.Float8を浮動小数点数に変換する。NULL の場合は nil を返す
+func (m *PostgreSQLTypeMapper) FromNullableFloat8(pgFloat pgtype.Float8) *float64 {
+	if !pgFloat.Valid {
+		return nil
+	}
+	f := pgFloat.Float64
+	return &f
+}
@@ -0,0 +1,219 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// MemberPostgresRepository はMemberエンティティのPostgreSQL実装
+type MemberPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewMemberPostgresRepository は新しいMemberPostgresRepositoryを作成する
+func NewMemberPostgresRepository(db postgres.DBTX) membership.MemberRepository {
+	return &MemberPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByTripAndUser は指定された旅行とユーザーのMemberを取得する。ゴミ箱にある旅行のMemberは取得しない
+func (r *MemberPostgresRepository) FindByTripAndUser(ctx context.Context, tripID trip.TripID, userID user.UserID) (*membership.Member, error) {
+	return r.findMember(ctx, tripID, userID, func(tripID, userID pgtype.UUID) (postgres.TripMember, error) {
+		return r.GetQueries(ctx).FindTripMember(ctx, postgres.FindTripMemberParams{
+			TripID: tripID,
+			UserID: userID,
+		})
+	})
+}
+
+// FindByTrashedTripAndUser はゴミ箱にある指定された旅行とユーザーのMemberを取得する
+func (r *MemberPostgresRepository) FindByTrashedTripAndUser(ctx context.Context, tripID trip.TripID, userID user.UserID) (*membership.Member, error) {
+	return r.findMember(ctx, tripID, userID, func(tripID, userID pgtype.UUID) (postgres.TripMember, error) {
+		return r.GetQueries(ctx).FindTrashedTripMember(ctx, postgres.FindTrashedTripMemberParams{
+			TripID: tripID,
+			UserID: userID,
+		})
+	})
+}
+
+func (r *MemberPostgresRepository) findMember(
+	ctx context.Context,
+	tripID trip.TripID,
+	userID user.UserID,
+	find func(tripID, userID pgtype.UUID) (postgres.TripMember, error),
+) (*membership.Member, error) {
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	pgUserID, err := mapper.ToUUID(userID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert user ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := find(pgTripID, pgUserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, membership.NewMemberNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch trip member from database", apperr.WithCause(err))
+	}
+
+	m, err := r.mapToMember(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to member domain object", apperr.WithCause(err))
+	}
+
+	return m, nil
+}
+
+// FindByTripID は指定された旅行のMemberを所有者、編集者、閲覧者の順に取得する
+func (r *MemberPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID) ([]*membership.Member, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListTripMembers(ctx, pgTripID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch trip members from database", apperr.WithCause(err))
+	}
+
+	members := make([]*membership.Member, 0, len(records))
+	for _, record := range records {
+		m, err := r.mapToMember(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to member domain object", apperr.WithCause(err))
+		}
+		members = append(members, m)
+	}
+
+	return members, nil
+}
+
+// Save はMemberを作成し、既に存在する場合はロールを更新する
+func (r *MemberPostgresRepository) Save(ctx context.Context, m *membership.Member) error {
+	if m == nil {
+		return apperr.NewInternalError("Member entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(m.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for save", apperr.WithCause(err))
+	}
+
+	pgUserID, err := mapper.ToUUID(m.UserID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert user ID to UUID for save", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(m.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert member created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(m.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert member updated_at to timestamp", apperr.WithCause(err))
+	}
+
+	params := postgres.UpsertTripMemberParams{
+		TripID:    pgTripID,
+		UserID:    pgUserID,
+		Role:      m.Role().String(),
+		CreatedAt: pgCreatedAt,
+		UpdatedAt: pgUpdatedAt,
+	}
+
+	if err := queries.UpsertTripMember(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to save trip member in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は指定された旅行とユーザーのMemberを削除する
+func (r *MemberPostgresRepository) Delete(ctx context.Context, tripID trip.TripID, userID user.UserID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	pgUserID, err := mapper.ToUUID(userID.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert user ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeleteTripMember(ctx, postgres.DeleteTripMemberParams{
+		TripID: pgTripID,
+		UserID: pgUserID,
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete trip member from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return membership.NewMemberNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToMember はデータベースレコードをドメインオブジェクトに変換する
+func (r *MemberPostgresRepository) mapToMember(record postgres.TripMember) (*membership.Member, error) {
+	mapper := r.GetTypeMapper()
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := mapper.FromUUID(record.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := membership.ParseRole(record.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt, err := mapper.FromTimestamp(record.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return membership.NewMember(
+		trip.NewTripID(tripID),
+		user.NewUserID(userID),
+		role,
+		createdAt,
+		updatedAt,
+	), nil
+}
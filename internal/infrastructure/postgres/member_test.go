@@ -0,0 +1,344 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memberTestSuite テスト用の共通セットアップ
+type memberTestSuite struct {
+	ctx      context.Context
+	repo     membership.MemberRepository
+	tripRepo trip.TripRepository
+	userRepo user.UserRepository
+}
+
+// newMemberTestSuite テストスイートを作成する（トランザクション分離）
+func newMemberTestSuite(t *testing.T) *memberTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	return &memberTestSuite{
+		ctx:      ctx,
+		repo:     NewMemberPostgresRepository(tx),
+		tripRepo: NewTripPostgresRepository(tx),
+		userRepo: NewUserPostgresRepository(tx),
+	}
+}
+
+// createTrip メンバーの親となるTripを作成する
+func (s *memberTestSuite) createTrip(t *testing.T) trip.TripID {
+	t.Helper()
+
+	tt := newTestTrip("メンバーテスト旅行")
+	require.NoError(t, s.tripRepo.Create(s.ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+
+	return tt.ID
+}
+
+// createUser メンバーとなるUserを作成する
+func (s *memberTestSuite) createUser(t *testing.T) user.UserID {
+	t.Helper()
+
+	name := uuid.New().String()
+	tu := newTestUser(name, name+"@example.com")
+	require.NoError(t, s.userRepo.Create(s.ctx, tu.toDomainUser()), "Userの作成に失敗")
+
+	return tu.ID
+}
+
+func TestMemberPostgresRepository_SaveAndFind(t *testing.T) {
+	t.Run("保存したMemberを取得できること", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		userID := suite.createUser(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		m := membership.NewMember(tripID, userID, membership.RoleEditor, now, now)
+
+		require.NoError(t, suite.repo.Save(suite.ctx, m), "Saveでエラーが発生してはならない")
+		found, err := suite.repo.FindByTripAndUser(suite.ctx, tripID, userID)
+
+		require.NoError(t, err, "FindByTripAndUserでエラーが発生してはならない")
+		assert.True(t, m.Equals(found), "同じメンバーであること")
+		assert.Equal(t, membership.RoleEditor, found.Role(), "ロールが一致すること")
+	})
+
+	t.Run("再度保存するとロールが更新されること", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		userID := suite.createUser(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		m := membership.NewMember(tripID, userID, membership.RoleViewer, now, now)
+		require.NoError(t, suite.repo.Save(suite.ctx, m), "Saveでエラーが発生してはならない")
+
+		require.NoError(t, suite.repo.Save(suite.ctx, m.ChangeRole(membership.RoleEditor, now.Add(time.Hour))), "Saveでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByTripAndUser(suite.ctx, tripID, userID)
+		require.NoError(t, err, "FindByTripAndUserでエラーが発生してはならない")
+		assert.Equal(t, membership.RoleEditor, found.Role(), "ロールが更新されること")
+		assert.WithinDuration(t, now, found.CreatedAt(), time.Second, "CreatedAtは維持されること")
+	})
+
+	t.Run("所有者を2人登録できないこと", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		require.NoError(t, suite.repo.Save(suite.ctx, membership.NewMember(tripID, suite.createUser(t), membership.RoleOwner, now, now)))
+
+		err := suite.repo.Save(suite.ctx, membership.NewMember(tripID, suite.createUser(t), membership.RoleOwner, now, now))
+
+		assert.Error(t, err, "所有者の重複はエラーになるべき")
+	})
+
+	t.Run("存在しないMemberでMemberNotFoundが返されること", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		_, err := suite.repo.FindByTripAndUser(suite.ctx, suite.createTrip(t), user.NewUserID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, membership.NewMemberNotFoundError(),
+			"MemberNotFoundが返されるべき")
+	})
+}
+
+func TestMemberPostgresRepository_FindByTripID(t *testing.T) {
+	t.Run("所有者、編集者、閲覧者の順に取得できること", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		viewer := membership.NewMember(tripID, suite.createUser(t), membership.RoleViewer, now, now)
+		owner := membership.NewMember(tripID, suite.createUser(t), membership.RoleOwner, now.Add(time.Minute), now)
+		editor := membership.NewMember(tripID, suite.createUser(t), membership.RoleEditor, now.Add(2*time.Minute), now)
+		for _, m := range []*membership.Member{viewer, owner, editor} {
+			require.NoError(t, suite.repo.Save(suite.ctx, m), "Saveでエラーが発生してはならない")
+		}
+
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 3)
+		assert.True(t, owner.Equals(found[0]))
+		assert.True(t, editor.Equals(found[1]))
+		assert.True(t, viewer.Equals(found[2]))
+	})
+}
+
+func TestMemberPostgresRepository_Delete(t *testing.T) {
+	t.Run("削除したMemberは取得できないこと", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		userID := suite.createUser(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		require.NoError(t, suite.repo.Save(suite.ctx, membership.NewMember(tripID, userID, membership.RoleViewer, now, now)))
+
+		require.NoError(t, suite.repo.Delete(suite.ctx, tripID, userID), "Deleteでエラーが発生してはならない")
+
+		_, err := suite.repo.FindByTripAndUser(suite.ctx, tripID, userID)
+		assert.ErrorIs(t, err, membership.NewMemberNotFoundError(), "MemberNotFoundが返されるべき")
+	})
+
+	t.Run("存在しないMemberの削除でMemberNotFoundが返されること", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		err := suite.repo.Delete(suite.ctx, suite.createTrip(t), user.NewUserID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, membership.NewMemberNotFoundError(),
+			"MemberNotFoundが返されるべき")
+	})
+}
+
+func TestTripPostgresRepository_FindByMember(t *testing.T) {
+	newQuery := func(t *testing.T, params trip.ListQueryParams) trip.ListQuery {
+		t.Helper()
+		q, err := trip.NewListQuery(params)
+		require.NoError(t, err)
+		return q
+	}
+
+	// joinTrip は期間付きの旅行を作成し、ユーザーをメンバーとして追加する
+	joinTrip := func(t *testing.T, suite *memberTestSuite, userID user.UserID, name string, period *trip.Period, createdAt time.Time) trip.TripID {
+		t.Helper()
+		id := trip.NewTripID(uuid.New().String())
+		require.NoError(t, suite.tripRepo.Create(suite.ctx, trip.NewTrip(id, name, period, "", createdAt, createdAt)))
+		require.NoError(t, suite.repo.Save(suite.ctx, membership.NewMember(id, userID, membership.RoleViewer, createdAt, createdAt)))
+		return id
+	}
+
+	t.Run("メンバーとして参加している旅行だけを取得できること", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		userID := suite.createUser(t)
+		joined := suite.createTrip(t)
+		suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		require.NoError(t, suite.repo.Save(suite.ctx, membership.NewMember(joined, userID, membership.RoleViewer, now, now)))
+
+		page, err := suite.tripRepo.FindByMember(suite.ctx, userID, newQuery(t, trip.ListQueryParams{}))
+
+		require.NoError(t, err, "FindByMemberでエラーが発生してはならない")
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, joined, page.Items[0].ID())
+		assert.Nil(t, page.NextCursor, "最後のページには次のカーソルがないこと")
+	})
+
+	t.Run("カーソルを使って作成日時の新しい順にすべてのページを取得できること", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		userID := suite.createUser(t)
+		base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		var want []trip.TripID
+		for i := range 5 {
+			// 2件ずつ同じ作成日時にして、IDによる順序付けも確認する
+			want = append(want, joinTrip(t, suite, userID, "旅行", nil, base.Add(time.Duration(i/2)*time.Hour)))
+		}
+
+		var got []trip.TripID
+		cursor := ""
+		for range 5 {
+			page, err := suite.tripRepo.FindByMember(suite.ctx, userID, newQuery(t, trip.ListQueryParams{Limit: 2, Cursor: cursor}))
+			require.NoError(t, err)
+			for _, tr := range page.Items {
+				got = append(got, tr.ID())
+			}
+			if page.NextCursor == nil {
+				break
+			}
+			cursor = page.NextCursor.Encode()
+		}
+
+		assert.ElementsMatch(t, want, got, "重複や欠落なくすべての旅行を取得できること")
+		require.Len(t, got, 5)
+		assert.Equal(t, want[4], got[0], "最も新しい旅行が先頭になること")
+	})
+
+	t.Run("開始日の昇順では期間未定の旅行が末尾になること", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		userID := suite.createUser(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		later, err := trip.NewPeriod(time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 8, 3, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		earlier, err := trip.NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		undated := joinTrip(t, suite, userID, "未定", nil, now)
+		laterID := joinTrip(t, suite, userID, "夏", later, now)
+		earlierID := joinTrip(t, suite, userID, "春", earlier, now)
+
+		first, err := suite.tripRepo.FindByMember(suite.ctx, userID, newQuery(t, trip.ListQueryParams{Sort: "start_date", Limit: 2}))
+		require.NoError(t, err)
+		require.NotNil(t, first.NextCursor)
+		second, err := suite.tripRepo.FindByMember(suite.ctx, userID, newQuery(t, trip.ListQueryParams{Sort: "start_date", Limit: 2, Cursor: first.NextCursor.Encode()}))
+		require.NoError(t, err)
+
+		require.Len(t, first.Items, 2)
+		assert.Equal(t, earlierID, first.Items[0].ID())
+		assert.Equal(t, laterID, first.Items[1].ID())
+		require.Len(t, second.Items, 1)
+		assert.Equal(t, undated, second.Items[0].ID())
+		assert.Nil(t, second.NextCursor)
+	})
+
+	t.Run("旅行名の前方一致と期間で絞り込めること", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		userID := suite.createUser(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		may, err := trip.NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		august, err := trip.NewPeriod(time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 8, 3, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		kyotoMay := joinTrip(t, suite, userID, "京都 5月", may, now)
+		joinTrip(t, suite, userID, "京都 8月", august, now)
+		joinTrip(t, suite, userID, "大阪 5月", may, now)
+		joinTrip(t, suite, userID, "京都 未定", nil, now)
+
+		from := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+		page, err := suite.tripRepo.FindByMember(suite.ctx, userID, newQuery(t, trip.ListQueryParams{NamePrefix: "京都", From: &from, To: &to}))
+
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, kyotoMay, page.Items[0].ID())
+	})
+}
+
+func TestMemberPostgresRepository_Trash(t *testing.T) {
+	t.Run("ゴミ箱の旅行のメンバーは通常の検索では取得できずゴミ箱の検索で取得できること", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		userID := suite.createUser(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		require.NoError(t, suite.repo.Save(suite.ctx, membership.NewMember(tripID, userID, membership.RoleOwner, now, now)))
+
+		_, err := suite.repo.FindByTrashedTripAndUser(suite.ctx, tripID, userID)
+		assert.ErrorIs(t, err, membership.NewMemberNotFoundError(), "ゴミ箱にない旅行はゴミ箱の検索で取得できないこと")
+
+		require.NoError(t, suite.tripRepo.Trash(suite.ctx, tripID, trip.InitialVersion, now))
+
+		_, err = suite.repo.FindByTripAndUser(suite.ctx, tripID, userID)
+		assert.ErrorIs(t, err, membership.NewMemberNotFoundError(), "ゴミ箱の旅行のメンバーは取得できないこと")
+
+		found, err := suite.repo.FindByTrashedTripAndUser(suite.ctx, tripID, userID)
+		require.NoError(t, err, "FindByTrashedTripAndUserでエラーが発生してはならない")
+		assert.Equal(t, membership.RoleOwner, found.Role(), "ロールが一致すること")
+	})
+
+	t.Run("ゴミ箱の旅行は一覧に含まれず所有者のゴミ箱一覧に含まれること", func(t *testing.T) {
+		suite := newMemberTestSuite(t)
+
+		owner := suite.createUser(t)
+		viewer := suite.createUser(t)
+		kept := suite.createTrip(t)
+		trashed := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		for _, id := range []trip.TripID{kept, trashed} {
+			require.NoError(t, suite.repo.Save(suite.ctx, membership.NewMember(id, owner, membership.RoleOwner, now, now)))
+			require.NoError(t, suite.repo.Save(suite.ctx, membership.NewMember(id, viewer, membership.RoleViewer, now, now)))
+		}
+		require.NoError(t, suite.tripRepo.Trash(suite.ctx, trashed, trip.InitialVersion, now))
+
+		q, err := trip.NewListQuery(trip.ListQueryParams{})
+		require.NoError(t, err)
+		page, err := suite.tripRepo.FindByMember(suite.ctx, owner, q)
+		require.NoError(t, err, "FindByMemberでエラーが発生してはならない")
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, kept, page.Items[0].ID(), "ゴミ箱にない旅行のみ取得できること")
+
+		ownerTrash, err := suite.tripRepo.FindTrashedByOwner(suite.ctx, owner)
+		require.NoError(t, err, "FindTrashedByOwnerでエラーが発生してはならない")
+		require.Len(t, ownerTrash, 1)
+		assert.Equal(t, trashed, ownerTrash[0].Trip().ID())
+		assert.WithinDuration(t, now, ownerTrash[0].TrashedAt(), time.Second, "ゴミ箱に移した日時が一致すること")
+
+		viewerTrash, err := suite.tripRepo.FindTrashedByOwner(suite.ctx, viewer)
+		require.NoError(t, err, "FindTrashedByOwnerでエラーが発生してはならない")
+		assert.Empty(t, viewerTrash, "所有者でないユーザーのゴミ箱には含まれないこと")
+	})
+}
@@ -0,0 +1,3 @@
+ALTER TABLE trips
+  DROP COLUMN IF EXISTS end_date,
+  DROP COLUMN IF EXISTS start_date;
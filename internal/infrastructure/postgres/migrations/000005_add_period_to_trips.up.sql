@@ -0,0 +1,4 @@
+-- 既存の旅行には期間が存在しないため NULL を許容する（NULL は期間未定を表す）
+ALTER TABLE trips
+  ADD COLUMN IF NOT EXISTS start_date DATE,
+  ADD COLUMN IF NOT EXISTS end_date DATE;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS accommodations (
+  id UUID PRIMARY KEY,
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  address TEXT NOT NULL,
+  check_in_at TIMESTAMPTZ NOT NULL,
+  check_out_at TIMESTAMPTZ NOT NULL,
+  confirmation_number TEXT NOT NULL,
+  cost_amount BIGINT NOT NULL, -- 通貨の最小単位で保存する
+  cost_currency TEXT NOT NULL,
+  notes TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_accommodations_trip_id ON accommodations (trip_id);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS expenses (
+  id UUID PRIMARY KEY,
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  amount BIGINT NOT NULL, -- 通貨の最小単位で保存する
+  currency TEXT NOT NULL,
+  category TEXT NOT NULL,
+  spent_on DATE NOT NULL,
+  payer TEXT NOT NULL,
+  description TEXT NOT NULL,
+  activity_id UUID, -- 任意。紐づくアクティビティがない場合は NULL
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_expenses_trip_id ON expenses (trip_id);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS budgets (
+  trip_id UUID PRIMARY KEY REFERENCES trips(id) ON DELETE CASCADE,
+  currency TEXT NOT NULL, -- 旅行の基準通貨。集計はこの通貨に換算して行う
+  category_limits JSONB NOT NULL DEFAULT '{}', -- カテゴリごとの上限額（基準通貨の最小単位）
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
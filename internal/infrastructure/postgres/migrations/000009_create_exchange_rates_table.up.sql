@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS exchange_rates (
+  rate_date DATE NOT NULL,
+  base_currency TEXT NOT NULL,
+  quote_currency TEXT NOT NULL,
+  rate NUMERIC NOT NULL CHECK (rate > 0), -- 基準通貨1単位あたりの相手通貨の量
+  PRIMARY KEY (base_currency, quote_currency, rate_date)
+);
@@ -0,0 +1,4 @@
+-- 参加者間の分担方法。{"method": "equal", "entries": [{"participant": "Alice", "value": 0}]} 形式で保存する
+-- NULL は分担なし（支払者が全額を負担する）を表す
+ALTER TABLE expenses
+  ADD COLUMN IF NOT EXISTS split JSONB;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS trip_invitations;
+DROP TABLE IF EXISTS trip_members;
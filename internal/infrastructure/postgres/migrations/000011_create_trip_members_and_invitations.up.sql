@@ -0,0 +1,31 @@
+-- 既存の旅行には所有者の情報がないため、メンバーを登録するまではどのユーザーからもアクセスできない
+CREATE TABLE IF NOT EXISTS trip_members (
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  role TEXT NOT NULL CHECK (role IN ('owner', 'editor', 'viewer')),
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (trip_id, user_id)
+);
+
+-- 所有者は旅行ごとに1人だけ
+CREATE UNIQUE INDEX IF NOT EXISTS idx_trip_members_owner ON trip_members (trip_id) WHERE role = 'owner';
+CREATE INDEX IF NOT EXISTS idx_trip_members_user_id ON trip_members (user_id);
+
+CREATE TABLE IF NOT EXISTS trip_invitations (
+  id UUID PRIMARY KEY,
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  inviter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  invitee_email TEXT, -- 宛先はメールアドレスかユーザー名のどちらか一方
+  invitee_username TEXT,
+  role TEXT NOT NULL CHECK (role IN ('editor', 'viewer')),
+  status TEXT NOT NULL CHECK (status IN ('pending', 'accepted', 'declined')),
+  expires_at TIMESTAMPTZ NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  responded_at TIMESTAMPTZ, -- 未応答の場合は NULL
+  CHECK ((invitee_email IS NULL) <> (invitee_username IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_trip_invitations_trip_id ON trip_invitations (trip_id);
+CREATE INDEX IF NOT EXISTS idx_trip_invitations_invitee_email ON trip_invitations (lower(invitee_email)) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_trip_invitations_invitee_username ON trip_invitations (invitee_username) WHERE status = 'pending';
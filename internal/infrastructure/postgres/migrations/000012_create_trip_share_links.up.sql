@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS trip_share_links (
+  id UUID PRIMARY KEY,
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  token_hash TEXT NOT NULL UNIQUE, -- トークンは SHA-256 のハッシュ値だけを保存する
+  password_hash BYTEA, -- パスワードを設定しない場合は NULL
+  expires_at TIMESTAMPTZ, -- 無期限の場合は NULL
+  created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL,
+  revoked_at TIMESTAMPTZ -- 有効な場合は NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_trip_share_links_trip_id ON trip_share_links (trip_id);
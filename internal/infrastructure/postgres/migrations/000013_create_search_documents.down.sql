@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS trg_expenses_search_document ON expenses;
+DROP TRIGGER IF EXISTS trg_accommodations_search_document ON accommodations;
+DROP TRIGGER IF EXISTS trg_trips_search_document ON trips;
+
+DROP FUNCTION IF EXISTS sync_expense_search_document();
+DROP FUNCTION IF EXISTS sync_accommodation_search_document();
+DROP FUNCTION IF EXISTS sync_trip_search_document();
+
+DROP TABLE IF EXISTS search_documents;
+
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,77 @@
+-- 日本語のように単語を空白で区切らない文章は simple 設定の全文検索では単語に分割されないため、
+-- 部分一致検索を併用し、pg_trgm のトライグラム類似度で関連度を補う
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 検索対象のリソースを 1 つのテーブルにまとめ、種類をまたいで検索する。行は各テーブルのトリガーで同期する
+CREATE TABLE IF NOT EXISTS search_documents (
+  resource_type TEXT NOT NULL, -- trip, accommodation, expense
+  resource_id UUID NOT NULL,
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE, -- アクセス制御に使用する
+  title TEXT NOT NULL,
+  body TEXT NOT NULL,
+  search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', title), 'A') || setweight(to_tsvector('simple', body), 'B')
+  ) STORED,
+  PRIMARY KEY (resource_type, resource_id)
+);
+
+-- 部分一致検索は参加している旅行の行に絞り込んでから行う
+CREATE INDEX IF NOT EXISTS idx_search_documents_trip_id ON search_documents (trip_id);
+CREATE INDEX IF NOT EXISTS idx_search_documents_search_vector ON search_documents USING GIN (search_vector);
+
+CREATE OR REPLACE FUNCTION sync_trip_search_document() RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO search_documents (resource_type, resource_id, trip_id, title, body)
+  VALUES ('trip', NEW.id, NEW.id, NEW.name, '')
+  ON CONFLICT (resource_type, resource_id) DO UPDATE SET title = EXCLUDED.title, body = EXCLUDED.body;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION sync_accommodation_search_document() RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    DELETE FROM search_documents WHERE resource_type = 'accommodation' AND resource_id = OLD.id;
+    RETURN OLD;
+  END IF;
+  INSERT INTO search_documents (resource_type, resource_id, trip_id, title, body)
+  VALUES ('accommodation', NEW.id, NEW.trip_id, NEW.name, concat_ws(' ', NEW.address, NEW.notes))
+  ON CONFLICT (resource_type, resource_id) DO UPDATE SET trip_id = EXCLUDED.trip_id, title = EXCLUDED.title, body = EXCLUDED.body;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION sync_expense_search_document() RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    DELETE FROM search_documents WHERE resource_type = 'expense' AND resource_id = OLD.id;
+    RETURN OLD;
+  END IF;
+  INSERT INTO search_documents (resource_type, resource_id, trip_id, title, body)
+  VALUES ('expense', NEW.id, NEW.trip_id, NEW.description, NEW.category)
+  ON CONFLICT (resource_type, resource_id) DO UPDATE SET trip_id = EXCLUDED.trip_id, title = EXCLUDED.title, body = EXCLUDED.body;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- 旅行の削除時は外部キーの ON DELETE CASCADE で関連する行がすべて削除される
+CREATE TRIGGER trg_trips_search_document
+AFTER INSERT OR UPDATE OF name ON trips
+FOR EACH ROW EXECUTE FUNCTION sync_trip_search_document();
+
+CREATE TRIGGER trg_accommodations_search_document
+AFTER INSERT OR UPDATE OF trip_id, name, address, notes OR DELETE ON accommodations
+FOR EACH ROW EXECUTE FUNCTION sync_accommodation_search_document();
+
+CREATE TRIGGER trg_expenses_search_document
+AFTER INSERT OR UPDATE OF trip_id, description, category OR DELETE ON expenses
+FOR EACH ROW EXECUTE FUNCTION sync_expense_search_document();
+
+-- 既存の行を検索対象に登録する
+INSERT INTO search_documents (resource_type, resource_id, trip_id, title, body)
+SELECT 'trip', id, id, name, '' FROM trips
+UNION ALL
+SELECT 'accommodation', id, trip_id, name, concat_ws(' ', address, notes) FROM accommodations
+UNION ALL
+SELECT 'expense', id, trip_id, description, category FROM expenses
+ON CONFLICT (resource_type, resource_id) DO NOTHING;
@@ -0,0 +1,2 @@
+-- 楽観的排他制御に使用するバージョン。更新のたびに 1 ずつ進める
+ALTER TABLE trips ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_trips_deleted_at;
+ALTER TABLE trips DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,5 @@
+-- ゴミ箱に移した日時。NULL でない旅行はゴミ箱にあり、保持期間を過ぎると関連する行とともに物理削除される
+ALTER TABLE trips ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- 保持期間を過ぎた旅行の削除とゴミ箱の一覧に使用する
+CREATE INDEX IF NOT EXISTS idx_trips_deleted_at ON trips (deleted_at) WHERE deleted_at IS NOT NULL;
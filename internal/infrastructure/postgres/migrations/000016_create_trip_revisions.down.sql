@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS trip_revision_changes;
+DROP TABLE IF EXISTS trip_revisions;
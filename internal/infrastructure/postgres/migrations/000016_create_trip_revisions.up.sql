@@ -0,0 +1,23 @@
+-- 旅行に対する操作ごとの変更履歴。リビジョン番号は旅行ごとに 1 から振られる
+CREATE TABLE IF NOT EXISTS trip_revisions (
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  revision BIGINT NOT NULL,
+  actor_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  reverted_to BIGINT, -- 以前のリビジョンに戻す操作の場合に戻した先のリビジョン番号
+  created_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (trip_id, revision)
+);
+
+-- リビジョンに含まれるリソースごとの変更。before_state と after_state は変更前後のスナップショット
+CREATE TABLE IF NOT EXISTS trip_revision_changes (
+  trip_id UUID NOT NULL,
+  revision BIGINT NOT NULL,
+  position INT NOT NULL,
+  resource_type TEXT NOT NULL CHECK (resource_type IN ('trip', 'accommodation', 'expense', 'budget')),
+  resource_id UUID NOT NULL,
+  action TEXT NOT NULL CHECK (action IN ('created', 'updated', 'deleted')),
+  before_state JSONB,
+  after_state JSONB,
+  PRIMARY KEY (trip_id, revision, position),
+  FOREIGN KEY (trip_id, revision) REFERENCES trip_revisions (trip_id, revision) ON DELETE CASCADE
+);
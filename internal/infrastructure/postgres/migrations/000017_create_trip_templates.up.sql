@@ -0,0 +1,14 @@
+-- 旅行から作成する再利用可能な雛形。content には日付を基準日からの相対位置に変換した旅行の内容を保存する
+CREATE TABLE IF NOT EXISTS trip_templates (
+  id UUID PRIMARY KEY,
+  owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  is_public BOOLEAN NOT NULL DEFAULT FALSE,
+  content JSONB NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_trip_templates_owner_id ON trip_templates (owner_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_trip_templates_public ON trip_templates (created_at DESC) WHERE is_public;
@@ -0,0 +1,32 @@
+-- 旅行ごとのチェックリスト。項目は [{"id": "...", "name": "パスポート", "quantity": 1, "checked": false, "assignee_id": null}] 形式で保存する
+CREATE TABLE IF NOT EXISTS checklists (
+  id UUID PRIMARY KEY,
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  kind TEXT NOT NULL, -- packing, pre_departure, documents, other のいずれか
+  items JSONB NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklists_trip_id ON checklists (trip_id, created_at);
+
+-- ユーザーが保存する持ち物の雛形。per_day が真の場合 quantity は 1 日あたりの数量
+CREATE TABLE IF NOT EXISTS checklist_item_templates (
+  id UUID PRIMARY KEY,
+  owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  quantity INT NOT NULL,
+  per_day BOOLEAN NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_checklist_item_templates_owner_id ON checklist_item_templates (owner_id, created_at);
+
+-- チェックリストの変更も変更履歴に記録する
+ALTER TABLE trip_revision_changes
+  DROP CONSTRAINT IF EXISTS trip_revision_changes_resource_type_check;
+ALTER TABLE trip_revision_changes
+  ADD CONSTRAINT trip_revision_changes_resource_type_check
+  CHECK (resource_type IN ('trip', 'accommodation', 'expense', 'budget', 'checklist'));
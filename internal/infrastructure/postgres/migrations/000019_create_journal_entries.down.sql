@@ -0,0 +1,12 @@
+DELETE FROM trip_revision_changes WHERE resource_type = 'journal_entry';
+ALTER TABLE trip_revision_changes
+  DROP CONSTRAINT IF EXISTS trip_revision_changes_resource_type_check;
+ALTER TABLE trip_revision_changes
+  ADD CONSTRAINT trip_revision_changes_resource_type_check
+  CHECK (resource_type IN ('trip', 'accommodation', 'expense', 'budget', 'checklist'));
+
+DROP TRIGGER IF EXISTS trg_journal_entries_search_document ON journal_entries;
+DROP FUNCTION IF EXISTS sync_journal_entry_search_document();
+DELETE FROM search_documents WHERE resource_type = 'journal_entry';
+
+DROP TABLE IF EXISTS journal_entries;
@@ -0,0 +1,44 @@
+-- 旅行の日記。本文は Markdown のまま保存し、HTML への変換は読み出し時に行う
+CREATE TABLE IF NOT EXISTS journal_entries (
+  id UUID PRIMARY KEY,
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  entry_date DATE NOT NULL,
+  title TEXT NOT NULL,
+  body TEXT NOT NULL,
+  mood TEXT NOT NULL, -- great, good, neutral, bad, terrible のいずれか
+  place_name TEXT,
+  place_latitude DOUBLE PRECISION,
+  place_longitude DOUBLE PRECISION,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  -- 座標は場所の名前がある場合だけ、緯度と経度の組で保存する
+  CHECK ((place_latitude IS NULL) = (place_longitude IS NULL)),
+  CHECK (place_name IS NOT NULL OR place_latitude IS NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_journal_entries_trip_id ON journal_entries (trip_id, entry_date, created_at);
+
+-- 日記の本文と場所の名前も旅行内検索の対象にする
+CREATE OR REPLACE FUNCTION sync_journal_entry_search_document() RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    DELETE FROM search_documents WHERE resource_type = 'journal_entry' AND resource_id = OLD.id;
+    RETURN OLD;
+  END IF;
+  INSERT INTO search_documents (resource_type, resource_id, trip_id, title, body)
+  VALUES ('journal_entry', NEW.id, NEW.trip_id, NEW.title, concat_ws(' ', NEW.body, NEW.place_name))
+  ON CONFLICT (resource_type, resource_id) DO UPDATE SET trip_id = EXCLUDED.trip_id, title = EXCLUDED.title, body = EXCLUDED.body;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_journal_entries_search_document
+AFTER INSERT OR UPDATE OF trip_id, title, body, place_name OR DELETE ON journal_entries
+FOR EACH ROW EXECUTE FUNCTION sync_journal_entry_search_document();
+
+-- 日記の変更も変更履歴に記録する
+ALTER TABLE trip_revision_changes
+  DROP CONSTRAINT IF EXISTS trip_revision_changes_resource_type_check;
+ALTER TABLE trip_revision_changes
+  ADD CONSTRAINT trip_revision_changes_resource_type_check
+  CHECK (resource_type IN ('trip', 'accommodation', 'expense', 'budget', 'checklist', 'journal_entry'));
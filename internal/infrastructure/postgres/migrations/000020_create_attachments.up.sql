@@ -0,0 +1,18 @@
+-- 旅行や予約・支出に添付したファイル。中身は BlobStore に保存し、ここには保存先のキーとメタデータだけを持つ
+CREATE TABLE IF NOT EXISTS attachments (
+  id UUID PRIMARY KEY,
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  target_type TEXT NOT NULL CHECK (target_type IN ('trip', 'accommodation', 'expense')),
+  -- 添付先のリソースのID。旅行自体に添付した場合は trip_id と同じになる
+  target_id UUID NOT NULL,
+  filename TEXT NOT NULL,
+  content_type TEXT NOT NULL,
+  size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+  storage_key TEXT NOT NULL UNIQUE,
+  uploaded_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL,
+  CHECK (target_type <> 'trip' OR target_id = trip_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_trip_id ON attachments (trip_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_attachments_target ON attachments (target_type, target_id);
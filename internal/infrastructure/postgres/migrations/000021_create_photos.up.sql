@@ -0,0 +1,21 @@
+-- 旅行のアルバムに追加した写真。写真とサムネイルの中身は BlobStore に保存し、ここには EXIF から読み取ったメタデータを持つ
+CREATE TABLE IF NOT EXISTS photos (
+  id UUID PRIMARY KEY,
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  filename TEXT NOT NULL,
+  content_type TEXT NOT NULL,
+  size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+  width INTEGER NOT NULL CHECK (width > 0),
+  height INTEGER NOT NULL CHECK (height > 0),
+  -- 撮影日時。EXIF に記録された撮影場所の現地時刻をタイムゾーンなしで持つ
+  captured_at TIMESTAMP,
+  latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+  longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+  -- 保存した写真から位置情報を取り除いたかどうか。取り除いた場合も撮影位置は旅程の推定のためにここに残す
+  location_stripped BOOLEAN NOT NULL DEFAULT FALSE,
+  uploaded_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL,
+  CHECK ((latitude IS NULL) = (longitude IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_photos_trip_id ON photos (trip_id, captured_at, created_at);
@@ -0,0 +1,11 @@
+-- ユーザーごとのカレンダーフィード。カレンダーアプリは URL に含めたトークンだけで JWT なしに購読する
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+  id UUID PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  token_hash TEXT NOT NULL UNIQUE, -- トークンは SHA-256 のハッシュ値だけを保存する
+  created_at TIMESTAMPTZ NOT NULL,
+  revoked_at TIMESTAMPTZ -- 有効な場合は NULL
+);
+
+-- 有効なフィードはユーザーごとに 1 つまで
+CREATE UNIQUE INDEX IF NOT EXISTS idx_calendar_feeds_active_user_id ON calendar_feeds (user_id) WHERE revoked_at IS NULL;
@@ -0,0 +1,30 @@
+-- GPS で記録して旅行に追加した軌跡。アップロードされた GPX ファイルは保存せず、統計と間引いた軌跡だけを持つ
+CREATE TABLE IF NOT EXISTS tracks (
+  id UUID PRIMARY KEY,
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  activity_id UUID, -- 任意。紐づく行動がない場合は NULL
+  name TEXT NOT NULL,
+  distance_meters DOUBLE PRECISION NOT NULL CHECK (distance_meters >= 0),
+  moving_duration_ms BIGINT NOT NULL CHECK (moving_duration_ms >= 0),
+  elapsed_duration_ms BIGINT NOT NULL CHECK (elapsed_duration_ms >= 0),
+  elevation_gain_meters DOUBLE PRECISION NOT NULL CHECK (elevation_gain_meters >= 0),
+  elevation_loss_meters DOUBLE PRECISION NOT NULL CHECK (elevation_loss_meters >= 0),
+  max_speed_mps DOUBLE PRECISION NOT NULL CHECK (max_speed_mps >= 0),
+  -- 記録の開始・終了日時。記録日時のない GPX では NULL
+  started_at TIMESTAMPTZ,
+  ended_at TIMESTAMPTZ,
+  min_latitude DOUBLE PRECISION NOT NULL CHECK (min_latitude BETWEEN -90 AND 90),
+  min_longitude DOUBLE PRECISION NOT NULL CHECK (min_longitude BETWEEN -180 AND 180),
+  max_latitude DOUBLE PRECISION NOT NULL CHECK (max_latitude BETWEEN -90 AND 90),
+  max_longitude DOUBLE PRECISION NOT NULL CHECK (max_longitude BETWEEN -180 AND 180),
+  -- 統計を求めた、間引く前の点の数
+  point_count INTEGER NOT NULL CHECK (point_count > 0),
+  -- Douglas-Peucker 法で間引いた軌跡。セグメントごとの点の配列
+  geometry JSONB NOT NULL,
+  uploaded_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL,
+  CHECK ((started_at IS NULL) = (ended_at IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_tracks_trip_id ON tracks (trip_id, started_at, created_at);
+CREATE INDEX IF NOT EXISTS idx_tracks_activity_id ON tracks (activity_id) WHERE activity_id IS NOT NULL;
@@ -0,0 +1,30 @@
+-- 旅行の行程に含まれる行動。同じ日の行動は position の小さい順に巡る
+CREATE TABLE IF NOT EXISTS activities (
+  id UUID PRIMARY KEY,
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  activity_date DATE NOT NULL,
+  position INTEGER NOT NULL CHECK (position >= 0),
+  title TEXT NOT NULL,
+  place_name TEXT,
+  place_latitude DOUBLE PRECISION,
+  place_longitude DOUBLE PRECISION,
+  start_at TIMESTAMPTZ,
+  end_at TIMESTAMPTZ,
+  travel_mode TEXT NOT NULL, -- 直前の行動から向かう移動手段。walk, bicycle, car, transit のいずれか
+  notes TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  -- 座標は場所の名前がある場合だけ、緯度と経度の組で保存する
+  CHECK ((place_latitude IS NULL) = (place_longitude IS NULL)),
+  CHECK (place_name IS NOT NULL OR place_latitude IS NULL),
+  CHECK (start_at IS NULL OR end_at IS NULL OR start_at <= end_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_activities_trip_id ON activities (trip_id, activity_date, position, created_at);
+
+-- 行動の変更も変更履歴に記録する
+ALTER TABLE trip_revision_changes
+  DROP CONSTRAINT IF EXISTS trip_revision_changes_resource_type_check;
+ALTER TABLE trip_revision_changes
+  ADD CONSTRAINT trip_revision_changes_resource_type_check
+  CHECK (resource_type IN ('trip', 'accommodation', 'expense', 'budget', 'checklist', 'journal_entry', 'activity'));
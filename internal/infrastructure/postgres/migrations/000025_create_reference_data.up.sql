@@ -0,0 +1,32 @@
+-- 空港・国・都市の参照データ。cmd/seedreference でバイナリに埋め込んだデータを取り込む
+CREATE TABLE IF NOT EXISTS countries (
+  code TEXT PRIMARY KEY, -- ISO 3166-1 alpha-2
+  alpha3_code TEXT NOT NULL UNIQUE, -- ISO 3166-1 alpha-3
+  name TEXT NOT NULL,
+  currency TEXT NOT NULL -- ISO 4217
+);
+
+CREATE TABLE IF NOT EXISTS airports (
+  iata_code TEXT PRIMARY KEY,
+  icao_code TEXT NOT NULL UNIQUE,
+  name TEXT NOT NULL,
+  city TEXT NOT NULL,
+  country_code TEXT NOT NULL REFERENCES countries(code),
+  latitude DOUBLE PRECISION NOT NULL,
+  longitude DOUBLE PRECISION NOT NULL,
+  timezone TEXT NOT NULL -- IANA のタイムゾーン名
+);
+
+CREATE TABLE IF NOT EXISTS cities (
+  code TEXT PRIMARY KEY, -- UN/LOCODE
+  name TEXT NOT NULL,
+  country_code TEXT NOT NULL REFERENCES countries(code),
+  latitude DOUBLE PRECISION NOT NULL,
+  longitude DOUBLE PRECISION NOT NULL,
+  timezone TEXT NOT NULL -- IANA のタイムゾーン名
+);
+
+-- 前方一致（ILIKE）とあいまい一致（word_similarity）の検索に pg_trgm のトライグラムのインデックスを使う
+CREATE INDEX IF NOT EXISTS idx_countries_name_trgm ON countries USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_airports_name_trgm ON airports USING GIN ((name || ' ' || city) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_cities_name_trgm ON cities USING GIN (name gin_trgm_ops);
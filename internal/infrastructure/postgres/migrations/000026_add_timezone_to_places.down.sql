@@ -0,0 +1,8 @@
+ALTER TABLE activities
+  DROP CONSTRAINT IF EXISTS activities_place_timezone_check,
+  DROP COLUMN IF EXISTS timezone,
+  DROP COLUMN IF EXISTS place_timezone;
+
+ALTER TABLE journal_entries
+  DROP CONSTRAINT IF EXISTS journal_entries_place_timezone_check,
+  DROP COLUMN IF EXISTS place_timezone;
@@ -0,0 +1,14 @@
+-- 日時を現地時刻で表すための IANA のタイムゾーン名（例: Asia/Tokyo）。既存の場所と行動には存在しないため NULL を許容する
+ALTER TABLE journal_entries
+  ADD COLUMN IF NOT EXISTS place_timezone TEXT;
+
+-- timezone は行動のタイムゾーンで、指定がなければ場所のタイムゾーンを保存する
+ALTER TABLE activities
+  ADD COLUMN IF NOT EXISTS place_timezone TEXT,
+  ADD COLUMN IF NOT EXISTS timezone TEXT;
+
+-- タイムゾーンは場所の名前がある場合だけ保存する
+ALTER TABLE journal_entries
+  ADD CONSTRAINT journal_entries_place_timezone_check CHECK (place_name IS NOT NULL OR place_timezone IS NULL);
+ALTER TABLE activities
+  ADD CONSTRAINT activities_place_timezone_check CHECK (place_name IS NOT NULL OR place_timezone IS NULL);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_activities_search_document ON activities;
+DROP FUNCTION IF EXISTS sync_activity_search_document();
+DELETE FROM search_documents WHERE resource_type = 'activity';
@@ -0,0 +1,22 @@
+-- 行程の行動もタイトル・メモ・場所の名前で検索できるようにする
+CREATE OR REPLACE FUNCTION sync_activity_search_document() RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    DELETE FROM search_documents WHERE resource_type = 'activity' AND resource_id = OLD.id;
+    RETURN OLD;
+  END IF;
+  INSERT INTO search_documents (resource_type, resource_id, trip_id, title, body)
+  VALUES ('activity', NEW.id, NEW.trip_id, NEW.title, concat_ws(' ', NEW.notes, NEW.place_name))
+  ON CONFLICT (resource_type, resource_id) DO UPDATE SET trip_id = EXCLUDED.trip_id, title = EXCLUDED.title, body = EXCLUDED.body;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_activities_search_document
+AFTER INSERT OR UPDATE OF trip_id, title, notes, place_name OR DELETE ON activities
+FOR EACH ROW EXECUTE FUNCTION sync_activity_search_document();
+
+-- 既存の行動を検索対象に登録する
+INSERT INTO search_documents (resource_type, resource_id, trip_id, title, body)
+SELECT 'activity', id, trip_id, title, concat_ws(' ', notes, place_name) FROM activities
+ON CONFLICT (resource_type, resource_id) DO NOTHING;
@@ -0,0 +1,8 @@
+DELETE FROM trip_revision_changes WHERE resource_type = 'transport_leg';
+ALTER TABLE trip_revision_changes
+  DROP CONSTRAINT IF EXISTS trip_revision_changes_resource_type_check;
+ALTER TABLE trip_revision_changes
+  ADD CONSTRAINT trip_revision_changes_resource_type_check
+  CHECK (resource_type IN ('trip', 'accommodation', 'expense', 'budget', 'checklist', 'journal_entry', 'activity'));
+
+DROP TABLE IF EXISTS transport_legs;
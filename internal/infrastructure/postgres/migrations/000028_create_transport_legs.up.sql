@@ -0,0 +1,33 @@
+-- 旅行の中の出発地から到着地への移動（飛行機・列車など）。出発日時は出発地の、到着日時は到着地のタイムゾーンで表示する
+CREATE TABLE IF NOT EXISTS transport_legs (
+  id UUID PRIMARY KEY,
+  trip_id UUID NOT NULL REFERENCES trips(id) ON DELETE CASCADE,
+  mode TEXT NOT NULL, -- flight, train, bus, ferry, car, other のいずれか
+  origin_name TEXT NOT NULL,
+  origin_latitude DOUBLE PRECISION,
+  origin_longitude DOUBLE PRECISION,
+  origin_timezone TEXT,
+  destination_name TEXT NOT NULL,
+  destination_latitude DOUBLE PRECISION,
+  destination_longitude DOUBLE PRECISION,
+  destination_timezone TEXT,
+  departure_at TIMESTAMPTZ NOT NULL,
+  arrival_at TIMESTAMPTZ NOT NULL,
+  carrier TEXT NOT NULL, -- 航空会社や鉄道会社などの運行会社
+  number TEXT NOT NULL, -- 便名や列車番号
+  notes TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL,
+  CHECK ((origin_latitude IS NULL) = (origin_longitude IS NULL)),
+  CHECK ((destination_latitude IS NULL) = (destination_longitude IS NULL)),
+  CHECK (departure_at <= arrival_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transport_legs_trip_id ON transport_legs (trip_id, departure_at, created_at);
+
+-- 移動の変更も変更履歴に記録する
+ALTER TABLE trip_revision_changes
+  DROP CONSTRAINT IF EXISTS trip_revision_changes_resource_type_check;
+ALTER TABLE trip_revision_changes
+  ADD CONSTRAINT trip_revision_changes_resource_type_check
+  CHECK (resource_type IN ('trip', 'accommodation', 'expense', 'budget', 'checklist', 'journal_entry', 'activity', 'transport_leg'));
@@ -0,0 +1,2 @@
+ALTER TABLE accommodations
+  DROP COLUMN IF EXISTS timezone;
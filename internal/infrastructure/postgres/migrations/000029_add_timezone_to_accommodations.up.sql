@@ -0,0 +1,4 @@
+-- チェックイン・チェックアウトを現地時刻で表すための宿泊先の IANA のタイムゾーン名（例: Asia/Tokyo）。
+-- 既存の宿泊予約には存在しないため NULL を許容する
+ALTER TABLE accommodations
+  ADD COLUMN IF NOT EXISTS timezone TEXT;
@@ -0,0 +1,4 @@
+-- 予算の集計などで金額を揃える旅行の基準通貨（ISO 4217 の通貨コード）。
+-- 既存の旅行には存在しないため NULL を許容する
+ALTER TABLE trips
+  ADD COLUMN IF NOT EXISTS home_currency TEXT;
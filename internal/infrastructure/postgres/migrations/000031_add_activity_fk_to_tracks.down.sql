@@ -0,0 +1,2 @@
+ALTER TABLE tracks
+  DROP CONSTRAINT IF EXISTS tracks_activity_id_fkey;
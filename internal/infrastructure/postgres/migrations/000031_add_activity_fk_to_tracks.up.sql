@@ -0,0 +1,9 @@
+-- 削除された行動を指したままのトラックが残らないよう、行動の削除で紐づけを外す。
+-- 外部キーを追加する前に、すでに存在しない行動を指している紐づけは外しておく
+UPDATE tracks
+  SET activity_id = NULL
+  WHERE activity_id IS NOT NULL
+    AND NOT EXISTS (SELECT 1 FROM activities WHERE activities.id = tracks.activity_id);
+
+ALTER TABLE tracks
+  ADD CONSTRAINT tracks_activity_id_fkey FOREIGN KEY (activity_id) REFERENCES activities(id) ON DELETE SET NULL;
@@ -0,0 +1,209 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/photo"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// PhotoPostgresRepository はPhotoエンティティのPostgreSQL実装
+type PhotoPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewPhotoPostgresRepository は新しいPhotoPostgresRepositoryを作成する
+func NewPhotoPostgresRepository(db postgres.DBTX) photo.PhotoRepository {
+	return &PhotoPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDの写真を取得する
+func (r *PhotoPostgresRepository) FindByID(ctx context.Context, id photo.PhotoID) (*photo.Photo, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert photo ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindPhoto(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, photo.NewPhotoNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch photo from database", apperr.WithCause(err))
+	}
+
+	p, err := r.mapToPhoto(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to photo domain object", apperr.WithCause(err))
+	}
+
+	return p, nil
+}
+
+// FindByTripID は指定された旅行の写真を撮影日時の順に取得する。撮影日時が不明な写真は最後に追加された順で並べる
+func (r *PhotoPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID) ([]*photo.Photo, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListPhotosByTripID(ctx, pgTripID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch photos list from database", apperr.WithCause(err))
+	}
+
+	photos := make([]*photo.Photo, 0, len(records))
+	for _, record := range records {
+		p, err := r.mapToPhoto(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to photo domain object", apperr.WithCause(err))
+		}
+		photos = append(photos, p)
+	}
+
+	return photos, nil
+}
+
+// Create は新しい写真を作成する
+func (r *PhotoPostgresRepository) Create(ctx context.Context, p *photo.Photo) error {
+	if p == nil {
+		return apperr.NewInternalError("Photo entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(p.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert photo ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgTripID, err := mapper.ToUUID(p.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgUploadedBy, err := mapper.ToUUID(p.UploadedBy().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert uploader ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(p.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert photo created_at to timestamp", apperr.WithCause(err))
+	}
+
+	var latitude, longitude *float64
+	if c := p.Coordinate(); c != nil {
+		lat, lng := c.Latitude(), c.Longitude()
+		latitude, longitude = &lat, &lng
+	}
+
+	params := postgres.CreatePhotoParams{
+		ID:               pgID,
+		TripID:           pgTripID,
+		Filename:         p.Filename(),
+		ContentType:      p.ContentType(),
+		SizeBytes:        p.Size(),
+		Width:            int32(p.Width()),
+		Height:           int32(p.Height()),
+		CapturedAt:       mapper.ToNullableLocalTimestamp(p.CapturedAt()),
+		Latitude:         mapper.ToNullableFloat8(latitude),
+		Longitude:        mapper.ToNullableFloat8(longitude),
+		LocationStripped: p.LocationStripped(),
+		UploadedBy:       pgUploadedBy,
+		CreatedAt:        pgCreatedAt,
+	}
+
+	if err := queries.CreatePhoto(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create photo in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDの写真を削除する
+func (r *PhotoPostgresRepository) Delete(ctx context.Context, id photo.PhotoID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert photo ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeletePhoto(ctx, pgID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete photo from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return photo.NewPhotoNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToPhoto はデータベースレコードをドメインオブジェクトに変換する
+func (r *PhotoPostgresRepository) mapToPhoto(record postgres.Photo) (*photo.Photo, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadedBy, err := mapper.FromUUID(record.UploadedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var coordinate *geo.Coordinate
+	latitude, longitude := mapper.FromNullableFloat8(record.Latitude), mapper.FromNullableFloat8(record.Longitude)
+	if latitude != nil && longitude != nil {
+		c, err := geo.NewCoordinate(*latitude, *longitude)
+		if err != nil {
+			return nil, err
+		}
+		coordinate = &c
+	}
+
+	return photo.NewPhoto(
+		photo.NewPhotoID(id),
+		trip.NewTripID(tripID),
+		record.Filename,
+		record.ContentType,
+		record.SizeBytes,
+		int(record.Width),
+		int(record.Height),
+		mapper.FromNullableLocalTimestamp(record.CapturedAt),
+		coordinate,
+		record.LocationStripped,
+		user.NewUserID(uploadedBy),
+		createdAt,
+	), nil
+}
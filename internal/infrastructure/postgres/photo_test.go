@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/photo"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// photoTestSuite テスト用の共通セットアップ
+type photoTestSuite struct {
+	ctx        context.Context
+	repo       photo.PhotoRepository
+	tripRepo   trip.TripRepository
+	uploaderID user.UserID
+}
+
+// newPhotoTestSuite テストスイートを作成する（トランザクション分離）
+func newPhotoTestSuite(t *testing.T) *photoTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	uploader := newTestUser("photo-uploader", "photo-uploader@example.com")
+	require.NoError(t, NewUserPostgresRepository(tx).Create(ctx, uploader.toDomainUser()), "Userの作成に失敗")
+
+	return &photoTestSuite{
+		ctx:        ctx,
+		repo:       NewPhotoPostgresRepository(tx),
+		tripRepo:   NewTripPostgresRepository(tx),
+		uploaderID: uploader.ID,
+	}
+}
+
+// createTrip 写真の親となるTripを作成する
+func (s *photoTestSuite) createTrip(t *testing.T) trip.TripID {
+	t.Helper()
+
+	tt := newTestTrip("写真テスト旅行")
+	require.NoError(t, s.tripRepo.Create(s.ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+
+	return tt.ID
+}
+
+// newPhoto テスト用の写真を生成する。capturedAt・coordinate が nil の場合は撮影日時・撮影位置が不明な写真になる
+func (s *photoTestSuite) newPhoto(tripID trip.TripID, capturedAt *time.Time, coordinate *geo.Coordinate, createdAt time.Time) *photo.Photo {
+	return photo.NewPhoto(
+		photo.NewPhotoID(uuid.New().String()),
+		tripID,
+		"金閣寺.jpg",
+		"image/jpeg",
+		2048,
+		4032,
+		3024,
+		capturedAt,
+		coordinate,
+		coordinate != nil,
+		s.uploaderID,
+		createdAt,
+	)
+}
+
+// assertPhotoEquals 写真の等価性をアサートする
+func assertPhotoEquals(t *testing.T, expected, actual *photo.Photo) {
+	t.Helper()
+	assert.Equal(t, expected.ID(), actual.ID(), "IDが一致すること")
+	assert.Equal(t, expected.TripID(), actual.TripID(), "TripIDが一致すること")
+	assert.Equal(t, expected.Filename(), actual.Filename(), "Filenameが一致すること")
+	assert.Equal(t, expected.ContentType(), actual.ContentType(), "ContentTypeが一致すること")
+	assert.Equal(t, expected.Size(), actual.Size(), "Sizeが一致すること")
+	assert.Equal(t, expected.Width(), actual.Width(), "Widthが一致すること")
+	assert.Equal(t, expected.Height(), actual.Height(), "Heightが一致すること")
+	assert.Equal(t, expected.CapturedAt(), actual.CapturedAt(), "CapturedAtが一致すること")
+	assert.Equal(t, expected.Coordinate(), actual.Coordinate(), "Coordinateが一致すること")
+	assert.Equal(t, expected.LocationStripped(), actual.LocationStripped(), "LocationStrippedが一致すること")
+	assert.Equal(t, expected.UploadedBy(), actual.UploadedBy(), "UploadedByが一致すること")
+	assert.True(t, expected.CreatedAt().Equal(actual.CreatedAt()), "CreatedAtが一致すること")
+}
+
+func TestPhotoPostgresRepository_CreateAndFindByID(t *testing.T) {
+	t.Run("撮影日時と撮影位置を持つ写真を作成して取得できること", func(t *testing.T) {
+		suite := newPhotoTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		capturedAt := time.Date(2024, 4, 2, 21, 30, 15, 0, time.UTC)
+		coordinate, err := geo.NewCoordinate(35.0394, 135.7292)
+		require.NoError(t, err)
+		p := suite.newPhoto(tripID, &capturedAt, &coordinate, time.Now().UTC().Truncate(time.Microsecond))
+
+		require.NoError(t, suite.repo.Create(suite.ctx, p), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, p.ID())
+
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertPhotoEquals(t, p, found)
+	})
+
+	t.Run("撮影日時と撮影位置が不明な写真を作成して取得できること", func(t *testing.T) {
+		suite := newPhotoTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		p := suite.newPhoto(tripID, nil, nil, time.Now().UTC().Truncate(time.Microsecond))
+
+		require.NoError(t, suite.repo.Create(suite.ctx, p), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, p.ID())
+
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertPhotoEquals(t, p, found)
+	})
+
+	t.Run("存在しないIDでPhotoNotFoundが返されること", func(t *testing.T) {
+		suite := newPhotoTestSuite(t)
+
+		_, err := suite.repo.FindByID(suite.ctx, photo.NewPhotoID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, photo.NewPhotoNotFoundError(), "PhotoNotFoundが返されるべき")
+	})
+}
+
+func TestPhotoPostgresRepository_FindByTripID(t *testing.T) {
+	suite := newPhotoTestSuite(t)
+
+	// Given: 同じ旅行に撮影日時の異なる写真2枚と撮影日時が不明な写真1枚、別の旅行に1枚
+	tripID := suite.createTrip(t)
+	otherTripID := suite.createTrip(t)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	day1 := time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, 4, 2, 9, 0, 0, 0, time.UTC)
+	unknown := suite.newPhoto(tripID, nil, nil, now.Add(-2*time.Hour))
+	later := suite.newPhoto(tripID, &day2, nil, now.Add(-time.Hour))
+	earlier := suite.newPhoto(tripID, &day1, nil, now)
+	other := suite.newPhoto(otherTripID, &day1, nil, now)
+	for _, p := range []*photo.Photo{unknown, later, earlier, other} {
+		require.NoError(t, suite.repo.Create(suite.ctx, p), "Createでエラーが発生してはならない")
+	}
+
+	t.Run("旅行の写真が撮影日時の順に、撮影日時が不明な写真は最後に取得できること", func(t *testing.T) {
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 3, "対象旅行の写真のみが返されるべき")
+		assert.Equal(t, earlier.ID(), found[0].ID())
+		assert.Equal(t, later.ID(), found[1].ID())
+		assert.Equal(t, unknown.ID(), found[2].ID())
+	})
+}
+
+func TestPhotoPostgresRepository_Delete(t *testing.T) {
+	t.Run("既存の写真を削除できること", func(t *testing.T) {
+		suite := newPhotoTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		p := suite.newPhoto(tripID, nil, nil, time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, suite.repo.Create(suite.ctx, p), "Createでエラーが発生してはならない")
+
+		require.NoError(t, suite.repo.Delete(suite.ctx, p.ID()), "Deleteでエラーが発生してはならない")
+
+		_, err := suite.repo.FindByID(suite.ctx, p.ID())
+		assert.ErrorIs(t, err, photo.NewPhotoNotFoundError(), "削除後はPhotoNotFoundが返されるべき")
+	})
+
+	t.Run("存在しない写真の削除でPhotoNotFoundが返されること", func(t *testing.T) {
+		suite := newPhotoTestSuite(t)
+
+		err := suite.repo.Delete(suite.ctx, photo.NewPhotoID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, photo.NewPhotoNotFoundError(), "PhotoNotFoundが返されるべき")
+	})
+}
@@ -0,0 +1,31 @@
+-- name: FindAccommodation :one
+SELECT id, trip_id, name, address, check_in_at, check_out_at, confirmation_number, cost_amount, cost_currency, notes, created_at, updated_at, timezone FROM accommodations
+WHERE id = $1;
+
+-- name: ListAccommodationsByTripID :many
+SELECT id, trip_id, name, address, check_in_at, check_out_at, confirmation_number, cost_amount, cost_currency, notes, created_at, updated_at, timezone FROM accommodations
+WHERE trip_id = $1
+ORDER BY check_in_at, id;
+
+-- name: CreateAccommodation :exec
+INSERT INTO accommodations (id, trip_id, name, address, check_in_at, check_out_at, confirmation_number, cost_amount, cost_currency, notes, created_at, updated_at, timezone)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
+
+-- name: UpdateAccommodation :exec
+UPDATE accommodations
+SET
+  name = $2,
+  address = $3,
+  check_in_at = $4,
+  check_out_at = $5,
+  confirmation_number = $6,
+  cost_amount = $7,
+  cost_currency = $8,
+  notes = $9,
+  updated_at = $10,
+  timezone = $11
+WHERE id = $1;
+
+-- name: DeleteAccommodation :execrows
+DELETE FROM accommodations
+WHERE id = $1;
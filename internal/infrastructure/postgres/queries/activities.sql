@@ -0,0 +1,39 @@
+-- name: FindActivity :one
+SELECT id, trip_id, activity_date, position, title, place_name, place_latitude, place_longitude, start_at, end_at, travel_mode, notes, created_at, updated_at, place_timezone, timezone FROM activities
+WHERE id = $1;
+
+-- name: ListActivitiesByTripID :many
+SELECT id, trip_id, activity_date, position, title, place_name, place_latitude, place_longitude, start_at, end_at, travel_mode, notes, created_at, updated_at, place_timezone, timezone FROM activities
+WHERE trip_id = $1
+ORDER BY activity_date, position, created_at, id;
+
+-- name: ListActivitiesByTripIDAndDate :many
+SELECT id, trip_id, activity_date, position, title, place_name, place_latitude, place_longitude, start_at, end_at, travel_mode, notes, created_at, updated_at, place_timezone, timezone FROM activities
+WHERE trip_id = $1 AND activity_date = $2
+ORDER BY position, created_at, id;
+
+-- name: CreateActivity :exec
+INSERT INTO activities (id, trip_id, activity_date, position, title, place_name, place_latitude, place_longitude, start_at, end_at, travel_mode, notes, created_at, updated_at, place_timezone, timezone)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
+
+-- name: UpdateActivity :execrows
+UPDATE activities
+SET
+  activity_date = $2,
+  position = $3,
+  title = $4,
+  place_name = $5,
+  place_latitude = $6,
+  place_longitude = $7,
+  start_at = $8,
+  end_at = $9,
+  travel_mode = $10,
+  notes = $11,
+  updated_at = $12,
+  place_timezone = $13,
+  timezone = $14
+WHERE id = $1;
+
+-- name: DeleteActivity :execrows
+DELETE FROM activities
+WHERE id = $1;
@@ -0,0 +1,26 @@
+-- name: UpsertAirport :exec
+INSERT INTO airports (iata_code, icao_code, name, city, country_code, latitude, longitude, timezone)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (iata_code) DO UPDATE
+SET
+  icao_code = EXCLUDED.icao_code,
+  name = EXCLUDED.name,
+  city = EXCLUDED.city,
+  country_code = EXCLUDED.country_code,
+  latitude = EXCLUDED.latitude,
+  longitude = EXCLUDED.longitude,
+  timezone = EXCLUDED.timezone;
+
+-- name: SearchAirports :many
+SELECT iata_code, icao_code, name, city, country_code, latitude, longitude, timezone FROM airports
+WHERE iata_code = upper(sqlc.arg(query)::text)
+  OR icao_code = upper(sqlc.arg(query)::text)
+  OR (' ' || name || ' ' || city) ILIKE '% ' || sqlc.arg(prefix)::text
+  OR word_similarity(sqlc.arg(query)::text, name || ' ' || city) >= sqlc.arg(min_similarity)::float8
+ORDER BY
+  (iata_code = upper(sqlc.arg(query)::text) OR icao_code = upper(sqlc.arg(query)::text)) DESC,
+  (city ILIKE sqlc.arg(prefix)::text OR name ILIKE sqlc.arg(prefix)::text) DESC,
+  (' ' || name || ' ' || city) ILIKE '% ' || sqlc.arg(prefix)::text DESC,
+  word_similarity(sqlc.arg(query)::text, name || ' ' || city) DESC,
+  iata_code
+LIMIT sqlc.arg(result_limit)::bigint;
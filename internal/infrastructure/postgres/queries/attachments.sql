@@ -0,0 +1,21 @@
+-- name: FindAttachment :one
+SELECT id, trip_id, target_type, target_id, filename, content_type, size_bytes, storage_key, uploaded_by, created_at FROM attachments
+WHERE id = $1;
+
+-- name: ListAttachmentsByTripID :many
+SELECT id, trip_id, target_type, target_id, filename, content_type, size_bytes, storage_key, uploaded_by, created_at FROM attachments
+WHERE trip_id = $1
+ORDER BY created_at, id;
+
+-- name: ListAttachmentsByTarget :many
+SELECT id, trip_id, target_type, target_id, filename, content_type, size_bytes, storage_key, uploaded_by, created_at FROM attachments
+WHERE trip_id = $1 AND target_type = $2 AND target_id = $3
+ORDER BY created_at, id;
+
+-- name: CreateAttachment :exec
+INSERT INTO attachments (id, trip_id, target_type, target_id, filename, content_type, size_bytes, storage_key, uploaded_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: DeleteAttachment :execrows
+DELETE FROM attachments
+WHERE id = $1;
@@ -0,0 +1,16 @@
+-- name: FindBudgetByTripID :one
+SELECT trip_id, currency, category_limits, created_at, updated_at FROM budgets
+WHERE trip_id = $1;
+
+-- name: UpsertBudget :exec
+INSERT INTO budgets (trip_id, currency, category_limits, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (trip_id) DO UPDATE
+SET
+  currency = EXCLUDED.currency,
+  category_limits = EXCLUDED.category_limits,
+  updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteBudget :execrows
+DELETE FROM budgets
+WHERE trip_id = $1;
@@ -0,0 +1,17 @@
+-- name: FindCalendarFeedByTokenHash :one
+SELECT id, user_id, token_hash, created_at, revoked_at FROM calendar_feeds
+WHERE token_hash = $1;
+
+-- name: FindActiveCalendarFeedByUserID :one
+SELECT id, user_id, token_hash, created_at, revoked_at FROM calendar_feeds
+WHERE user_id = $1 AND revoked_at IS NULL;
+
+-- name: CreateCalendarFeed :exec
+INSERT INTO calendar_feeds (id, user_id, token_hash, created_at, revoked_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: UpdateCalendarFeed :execrows
+UPDATE calendar_feeds
+SET
+  revoked_at = $2
+WHERE id = $1;
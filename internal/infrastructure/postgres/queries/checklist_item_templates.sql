@@ -0,0 +1,25 @@
+-- name: FindChecklistItemTemplate :one
+SELECT id, owner_id, name, quantity, per_day, created_at, updated_at FROM checklist_item_templates
+WHERE id = $1;
+
+-- name: ListChecklistItemTemplatesByOwnerID :many
+SELECT id, owner_id, name, quantity, per_day, created_at, updated_at FROM checklist_item_templates
+WHERE owner_id = $1
+ORDER BY created_at, id;
+
+-- name: CreateChecklistItemTemplate :exec
+INSERT INTO checklist_item_templates (id, owner_id, name, quantity, per_day, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: UpdateChecklistItemTemplate :execrows
+UPDATE checklist_item_templates
+SET
+  name = $2,
+  quantity = $3,
+  per_day = $4,
+  updated_at = $5
+WHERE id = $1;
+
+-- name: DeleteChecklistItemTemplate :execrows
+DELETE FROM checklist_item_templates
+WHERE id = $1;
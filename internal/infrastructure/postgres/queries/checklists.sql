@@ -0,0 +1,25 @@
+-- name: FindChecklist :one
+SELECT id, trip_id, name, kind, items, created_at, updated_at FROM checklists
+WHERE id = $1;
+
+-- name: ListChecklistsByTripID :many
+SELECT id, trip_id, name, kind, items, created_at, updated_at FROM checklists
+WHERE trip_id = $1
+ORDER BY created_at, id;
+
+-- name: CreateChecklist :exec
+INSERT INTO checklists (id, trip_id, name, kind, items, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: UpdateChecklist :execrows
+UPDATE checklists
+SET
+  name = $2,
+  kind = $3,
+  items = $4,
+  updated_at = $5
+WHERE id = $1;
+
+-- name: DeleteChecklist :execrows
+DELETE FROM checklists
+WHERE id = $1;
@@ -0,0 +1,24 @@
+-- name: UpsertCity :exec
+INSERT INTO cities (code, name, country_code, latitude, longitude, timezone)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (code) DO UPDATE
+SET
+  name = EXCLUDED.name,
+  country_code = EXCLUDED.country_code,
+  latitude = EXCLUDED.latitude,
+  longitude = EXCLUDED.longitude,
+  timezone = EXCLUDED.timezone;
+
+-- name: SearchCities :many
+SELECT code, name, country_code, latitude, longitude, timezone FROM cities
+WHERE code = upper(sqlc.arg(query)::text)
+  OR substr(code, 3) = upper(sqlc.arg(query)::text)
+  OR (' ' || name) ILIKE '% ' || sqlc.arg(prefix)::text
+  OR word_similarity(sqlc.arg(query)::text, name) >= sqlc.arg(min_similarity)::float8
+ORDER BY
+  (code = upper(sqlc.arg(query)::text) OR substr(code, 3) = upper(sqlc.arg(query)::text)) DESC,
+  name ILIKE sqlc.arg(prefix)::text DESC,
+  (' ' || name) ILIKE '% ' || sqlc.arg(prefix)::text DESC,
+  word_similarity(sqlc.arg(query)::text, name) DESC,
+  name
+LIMIT sqlc.arg(result_limit)::bigint;
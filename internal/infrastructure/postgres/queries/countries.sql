@@ -0,0 +1,26 @@
+-- name: UpsertCountry :exec
+INSERT INTO countries (code, alpha3_code, name, currency)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (code) DO UPDATE
+SET
+  alpha3_code = EXCLUDED.alpha3_code,
+  name = EXCLUDED.name,
+  currency = EXCLUDED.currency;
+
+-- name: ListCountries :many
+SELECT code, alpha3_code, name, currency FROM countries
+ORDER BY name, code;
+
+-- name: SearchCountries :many
+SELECT code, alpha3_code, name, currency FROM countries
+WHERE code = upper(sqlc.arg(query)::text)
+  OR alpha3_code = upper(sqlc.arg(query)::text)
+  OR (' ' || name) ILIKE '% ' || sqlc.arg(prefix)::text
+  OR word_similarity(sqlc.arg(query)::text, name) >= sqlc.arg(min_similarity)::float8
+ORDER BY
+  (code = upper(sqlc.arg(query)::text) OR alpha3_code = upper(sqlc.arg(query)::text)) DESC,
+  name ILIKE sqlc.arg(prefix)::text DESC,
+  (' ' || name) ILIKE '% ' || sqlc.arg(prefix)::text DESC,
+  word_similarity(sqlc.arg(query)::text, name) DESC,
+  name
+LIMIT sqlc.arg(result_limit)::bigint;
@@ -0,0 +1,12 @@
+-- name: FindLatestExchangeRate :one
+SELECT rate_date, base_currency, quote_currency, rate FROM exchange_rates
+WHERE base_currency = $1 AND quote_currency = $2 AND rate_date <= $3
+ORDER BY rate_date DESC
+LIMIT 1;
+
+-- name: UpsertExchangeRate :exec
+INSERT INTO exchange_rates (rate_date, base_currency, quote_currency, rate)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (base_currency, quote_currency, rate_date) DO UPDATE
+SET
+  rate = EXCLUDED.rate;
@@ -0,0 +1,30 @@
+-- name: FindExpense :one
+SELECT id, trip_id, amount, currency, category, spent_on, payer, description, activity_id, created_at, updated_at, split FROM expenses
+WHERE id = $1;
+
+-- name: ListExpensesByTripID :many
+SELECT id, trip_id, amount, currency, category, spent_on, payer, description, activity_id, created_at, updated_at, split FROM expenses
+WHERE trip_id = $1
+ORDER BY spent_on, created_at, id;
+
+-- name: CreateExpense :exec
+INSERT INTO expenses (id, trip_id, amount, currency, category, spent_on, payer, description, activity_id, split, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+
+-- name: UpdateExpense :exec
+UPDATE expenses
+SET
+  amount = $2,
+  currency = $3,
+  category = $4,
+  spent_on = $5,
+  payer = $6,
+  description = $7,
+  activity_id = $8,
+  split = $9,
+  updated_at = $10
+WHERE id = $1;
+
+-- name: DeleteExpense :execrows
+DELETE FROM expenses
+WHERE id = $1;
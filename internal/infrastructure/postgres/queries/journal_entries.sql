@@ -0,0 +1,35 @@
+-- name: FindJournalEntry :one
+SELECT id, trip_id, entry_date, title, body, mood, place_name, place_latitude, place_longitude, created_at, updated_at, place_timezone FROM journal_entries
+WHERE id = $1;
+
+-- name: ListJournalEntriesByTripID :many
+SELECT id, trip_id, entry_date, title, body, mood, place_name, place_latitude, place_longitude, created_at, updated_at, place_timezone FROM journal_entries
+WHERE trip_id = $1
+ORDER BY entry_date, created_at, id;
+
+-- name: ListJournalEntriesByTripIDAndDate :many
+SELECT id, trip_id, entry_date, title, body, mood, place_name, place_latitude, place_longitude, created_at, updated_at, place_timezone FROM journal_entries
+WHERE trip_id = $1 AND entry_date = $2
+ORDER BY created_at, id;
+
+-- name: CreateJournalEntry :exec
+INSERT INTO journal_entries (id, trip_id, entry_date, title, body, mood, place_name, place_latitude, place_longitude, created_at, updated_at, place_timezone)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+
+-- name: UpdateJournalEntry :execrows
+UPDATE journal_entries
+SET
+  entry_date = $2,
+  title = $3,
+  body = $4,
+  mood = $5,
+  place_name = $6,
+  place_latitude = $7,
+  place_longitude = $8,
+  updated_at = $9,
+  place_timezone = $10
+WHERE id = $1;
+
+-- name: DeleteJournalEntry :execrows
+DELETE FROM journal_entries
+WHERE id = $1;
@@ -0,0 +1,16 @@
+-- name: FindPhoto :one
+SELECT id, trip_id, filename, content_type, size_bytes, width, height, captured_at, latitude, longitude, location_stripped, uploaded_by, created_at FROM photos
+WHERE id = $1;
+
+-- name: ListPhotosByTripID :many
+SELECT id, trip_id, filename, content_type, size_bytes, width, height, captured_at, latitude, longitude, location_stripped, uploaded_by, created_at FROM photos
+WHERE trip_id = $1
+ORDER BY captured_at NULLS LAST, created_at, id;
+
+-- name: CreatePhoto :exec
+INSERT INTO photos (id, trip_id, filename, content_type, size_bytes, width, height, captured_at, latitude, longitude, location_stripped, uploaded_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
+
+-- name: DeletePhoto :execrows
+DELETE FROM photos
+WHERE id = $1;
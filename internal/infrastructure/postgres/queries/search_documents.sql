@@ -0,0 +1,27 @@
+-- name: SearchDocuments :many
+SELECT resource_type, resource_id, trip_id, title, body, rank FROM (
+  SELECT
+    ranked.*,
+    ROW_NUMBER() OVER (PARTITION BY ranked.resource_type ORDER BY ranked.rank DESC, ranked.resource_id) AS position
+  FROM (
+    SELECT
+      d.resource_type,
+      d.resource_id,
+      d.trip_id,
+      d.title,
+      d.body,
+      (ts_rank(d.search_vector, websearch_to_tsquery('simple', sqlc.arg(query)::text))
+        + word_similarity(sqlc.arg(query)::text, d.title || ' ' || d.body))::float8 AS rank
+    FROM search_documents d
+    WHERE d.trip_id IN (
+        SELECT m.trip_id FROM trip_members m JOIN trips t ON t.id = m.trip_id
+        WHERE m.user_id = sqlc.arg(user_id) AND t.deleted_at IS NULL
+      )
+      AND (
+        d.search_vector @@ websearch_to_tsquery('simple', sqlc.arg(query)::text)
+        OR (d.title || ' ' || d.body) ILIKE ALL (sqlc.arg(patterns)::text[])
+      )
+  ) ranked
+) hits
+WHERE position <= sqlc.arg(limit_per_type)::bigint
+ORDER BY resource_type, rank DESC, resource_id;
@@ -0,0 +1,21 @@
+-- name: FindTrack :one
+SELECT id, trip_id, activity_id, name, distance_meters, moving_duration_ms, elapsed_duration_ms, elevation_gain_meters, elevation_loss_meters, max_speed_mps, started_at, ended_at, min_latitude, min_longitude, max_latitude, max_longitude, point_count, geometry, uploaded_by, created_at FROM tracks
+WHERE id = $1;
+
+-- name: ListTracksByTripID :many
+SELECT id, trip_id, activity_id, name, distance_meters, moving_duration_ms, elapsed_duration_ms, elevation_gain_meters, elevation_loss_meters, max_speed_mps, started_at, ended_at, min_latitude, min_longitude, max_latitude, max_longitude, point_count, geometry, uploaded_by, created_at FROM tracks
+WHERE trip_id = $1
+ORDER BY started_at NULLS LAST, created_at, id;
+
+-- name: ListTracksByTripIDAndActivityID :many
+SELECT id, trip_id, activity_id, name, distance_meters, moving_duration_ms, elapsed_duration_ms, elevation_gain_meters, elevation_loss_meters, max_speed_mps, started_at, ended_at, min_latitude, min_longitude, max_latitude, max_longitude, point_count, geometry, uploaded_by, created_at FROM tracks
+WHERE trip_id = $1 AND activity_id = $2
+ORDER BY started_at NULLS LAST, created_at, id;
+
+-- name: CreateTrack :exec
+INSERT INTO tracks (id, trip_id, activity_id, name, distance_meters, moving_duration_ms, elapsed_duration_ms, elevation_gain_meters, elevation_loss_meters, max_speed_mps, started_at, ended_at, min_latitude, min_longitude, max_latitude, max_longitude, point_count, geometry, uploaded_by, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20);
+
+-- name: DeleteTrack :execrows
+DELETE FROM tracks
+WHERE id = $1;
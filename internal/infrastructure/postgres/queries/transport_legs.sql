@@ -0,0 +1,36 @@
+-- name: FindTransportLeg :one
+SELECT id, trip_id, mode, origin_name, origin_latitude, origin_longitude, origin_timezone, destination_name, destination_latitude, destination_longitude, destination_timezone, departure_at, arrival_at, carrier, number, notes, created_at, updated_at FROM transport_legs
+WHERE id = $1;
+
+-- name: ListTransportLegsByTripID :many
+SELECT id, trip_id, mode, origin_name, origin_latitude, origin_longitude, origin_timezone, destination_name, destination_latitude, destination_longitude, destination_timezone, departure_at, arrival_at, carrier, number, notes, created_at, updated_at FROM transport_legs
+WHERE trip_id = $1
+ORDER BY departure_at, created_at, id;
+
+-- name: CreateTransportLeg :exec
+INSERT INTO transport_legs (id, trip_id, mode, origin_name, origin_latitude, origin_longitude, origin_timezone, destination_name, destination_latitude, destination_longitude, destination_timezone, departure_at, arrival_at, carrier, number, notes, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18);
+
+-- name: UpdateTransportLeg :execrows
+UPDATE transport_legs
+SET
+  mode = $2,
+  origin_name = $3,
+  origin_latitude = $4,
+  origin_longitude = $5,
+  origin_timezone = $6,
+  destination_name = $7,
+  destination_latitude = $8,
+  destination_longitude = $9,
+  destination_timezone = $10,
+  departure_at = $11,
+  arrival_at = $12,
+  carrier = $13,
+  number = $14,
+  notes = $15,
+  updated_at = $16
+WHERE id = $1;
+
+-- name: DeleteTransportLeg :execrows
+DELETE FROM transport_legs
+WHERE id = $1;
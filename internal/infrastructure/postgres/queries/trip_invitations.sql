@@ -0,0 +1,25 @@
+-- name: FindTripInvitation :one
+SELECT id, trip_id, inviter_id, invitee_email, invitee_username, role, status, expires_at, created_at, responded_at FROM trip_invitations
+WHERE id = $1;
+
+-- name: ListTripInvitationsByTripID :many
+SELECT id, trip_id, inviter_id, invitee_email, invitee_username, role, status, expires_at, created_at, responded_at FROM trip_invitations
+WHERE trip_id = $1
+ORDER BY created_at DESC;
+
+-- name: ListPendingTripInvitationsByInvitee :many
+SELECT id, trip_id, inviter_id, invitee_email, invitee_username, role, status, expires_at, created_at, responded_at FROM trip_invitations
+WHERE status = 'pending'
+  AND (lower(invitee_email) = lower(@email::text) OR invitee_username = @username::text)
+ORDER BY created_at DESC;
+
+-- name: CreateTripInvitation :exec
+INSERT INTO trip_invitations (id, trip_id, inviter_id, invitee_email, invitee_username, role, status, expires_at, created_at, responded_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: UpdateTripInvitation :execrows
+UPDATE trip_invitations
+SET
+  status = $2,
+  responded_at = $3
+WHERE id = $1;
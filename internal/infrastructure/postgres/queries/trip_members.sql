@@ -0,0 +1,26 @@
+-- name: FindTripMember :one
+SELECT trip_id, user_id, role, created_at, updated_at FROM trip_members
+WHERE trip_id = $1 AND user_id = $2
+  AND EXISTS (SELECT 1 FROM trips WHERE trips.id = trip_members.trip_id AND trips.deleted_at IS NULL);
+
+-- name: FindTrashedTripMember :one
+SELECT trip_id, user_id, role, created_at, updated_at FROM trip_members
+WHERE trip_id = $1 AND user_id = $2
+  AND EXISTS (SELECT 1 FROM trips WHERE trips.id = trip_members.trip_id AND trips.deleted_at IS NOT NULL);
+
+-- name: ListTripMembers :many
+SELECT trip_id, user_id, role, created_at, updated_at FROM trip_members
+WHERE trip_id = $1
+ORDER BY CASE role WHEN 'owner' THEN 0 WHEN 'editor' THEN 1 ELSE 2 END, created_at;
+
+-- name: UpsertTripMember :exec
+INSERT INTO trip_members (trip_id, user_id, role, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (trip_id, user_id) DO UPDATE
+SET
+  role = EXCLUDED.role,
+  updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteTripMember :execrows
+DELETE FROM trip_members
+WHERE trip_id = $1 AND user_id = $2;
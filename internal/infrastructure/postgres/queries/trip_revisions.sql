@@ -0,0 +1,23 @@
+-- name: NextTripRevision :one
+SELECT (COALESCE((SELECT MAX(r.revision) FROM trip_revisions r WHERE r.trip_id = trips.id), 0) + 1)::BIGINT AS revision
+FROM trips
+WHERE trips.id = $1
+FOR UPDATE;
+
+-- name: CreateTripRevision :exec
+INSERT INTO trip_revisions (trip_id, revision, actor_id, reverted_to, created_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: CreateTripRevisionChange :exec
+INSERT INTO trip_revision_changes (trip_id, revision, position, resource_type, resource_id, action, before_state, after_state)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: ListTripRevisions :many
+SELECT trip_id, revision, actor_id, reverted_to, created_at FROM trip_revisions
+WHERE trip_id = $1
+ORDER BY revision DESC;
+
+-- name: ListTripRevisionChanges :many
+SELECT trip_id, revision, position, resource_type, resource_id, action, before_state, after_state FROM trip_revision_changes
+WHERE trip_id = $1
+ORDER BY revision DESC, position;
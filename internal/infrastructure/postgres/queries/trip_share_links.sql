@@ -0,0 +1,22 @@
+-- name: FindTripShareLink :one
+SELECT id, trip_id, token_hash, password_hash, expires_at, created_by, created_at, revoked_at FROM trip_share_links
+WHERE id = $1;
+
+-- name: FindTripShareLinkByTokenHash :one
+SELECT id, trip_id, token_hash, password_hash, expires_at, created_by, created_at, revoked_at FROM trip_share_links
+WHERE token_hash = $1;
+
+-- name: ListTripShareLinksByTripID :many
+SELECT id, trip_id, token_hash, password_hash, expires_at, created_by, created_at, revoked_at FROM trip_share_links
+WHERE trip_id = $1
+ORDER BY created_at DESC;
+
+-- name: CreateTripShareLink :exec
+INSERT INTO trip_share_links (id, trip_id, token_hash, password_hash, expires_at, created_by, created_at, revoked_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: UpdateTripShareLink :execrows
+UPDATE trip_share_links
+SET
+  revoked_at = $2
+WHERE id = $1;
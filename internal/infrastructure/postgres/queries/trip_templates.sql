@@ -0,0 +1,30 @@
+-- name: FindTripTemplate :one
+SELECT id, owner_id, name, description, is_public, content, created_at, updated_at FROM trip_templates
+WHERE id = $1;
+
+-- name: ListTripTemplatesByOwnerID :many
+SELECT id, owner_id, name, description, is_public, content, created_at, updated_at FROM trip_templates
+WHERE owner_id = $1
+ORDER BY created_at DESC;
+
+-- name: ListPublicTripTemplates :many
+SELECT id, owner_id, name, description, is_public, content, created_at, updated_at FROM trip_templates
+WHERE is_public
+ORDER BY created_at DESC;
+
+-- name: CreateTripTemplate :exec
+INSERT INTO trip_templates (id, owner_id, name, description, is_public, content, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: UpdateTripTemplate :execrows
+UPDATE trip_templates
+SET
+  name = $2,
+  description = $3,
+  is_public = $4,
+  updated_at = $5
+WHERE id = $1;
+
+-- name: DeleteTripTemplate :execrows
+DELETE FROM trip_templates
+WHERE id = $1;
@@ -1,21 +1,47 @@
 -- name: FindTrip :one
-SELECT id, name, created_at, updated_at FROM trips
-WHERE id = $1;
-
--- name: ListTrips :many
-SELECT id, name, created_at, updated_at FROM trips;
+SELECT id, name, created_at, updated_at, start_date, end_date, version, deleted_at, home_currency FROM trips
+WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: CreateTrip :exec
-INSERT INTO trips (id, name, created_at, updated_at)
-VALUES ($1, $2, $3, $4);
+INSERT INTO trips (id, name, start_date, end_date, created_at, updated_at, version, home_currency)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
 
--- name: UpdateTrip :exec
+-- name: UpdateTrip :execrows
 UPDATE trips
 SET
   name = $2,
-  updated_at = $3
-WHERE id = $1;
+  start_date = $3,
+  end_date = $4,
+  updated_at = $5,
+  home_currency = $7,
+  version = version + 1
+WHERE id = $1 AND version = $6 AND deleted_at IS NULL;
+
+-- name: TrashTrip :execrows
+UPDATE trips
+SET
+  deleted_at = $3,
+  version = version + 1
+WHERE id = $1 AND version = $2 AND deleted_at IS NULL;
+
+-- name: ListTrashedTripsByOwner :many
+SELECT id, name, created_at, updated_at, start_date, end_date, version, deleted_at, home_currency FROM trips
+WHERE deleted_at IS NOT NULL
+  AND id IN (SELECT trip_id FROM trip_members WHERE user_id = $1 AND role = 'owner')
+ORDER BY deleted_at DESC, id;
 
--- name: DeleteTrip :execrows
+-- name: RestoreTrip :execrows
+UPDATE trips
+SET
+  deleted_at = NULL,
+  version = version + 1
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
+-- name: PurgeTrip :execrows
 DELETE FROM trips
-WHERE id = $1;
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
+-- name: ListTrashedTripIDsBefore :many
+SELECT id FROM trips
+WHERE deleted_at < $1
+ORDER BY deleted_at, id;
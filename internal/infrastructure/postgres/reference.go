@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/reference"
+	"github.com/hata0/travel-api/internal/domain/search"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+)
+
+// referenceMinSimilarity はあいまい一致とみなす word_similarity の最小値。
+// 1 文字の打ち間違い（例: Tokio と Tokyo）は 0.5 程度になる
+const referenceMinSimilarity = 0.4
+
+// ReferencePostgresRepository は空港・国・都市の参照データのPostgreSQL実装
+type ReferencePostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewReferencePostgresRepository は新しいReferencePostgresRepositoryを作成する
+func NewReferencePostgresRepository(db postgres.DBTX) reference.ReferenceRepository {
+	return &ReferencePostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// SaveCountry は国を作成し、同じ国コードが既に存在する場合は上書きする
+func (r *ReferencePostgresRepository) SaveCountry(ctx context.Context, country *reference.Country) error {
+	if country == nil {
+		return apperr.NewInternalError("Country entity cannot be nil")
+	}
+
+	err := r.GetQueries(ctx).UpsertCountry(ctx, postgres.UpsertCountryParams{
+		Code:       country.Code(),
+		Alpha3Code: country.Alpha3Code(),
+		Name:       country.Name(),
+		Currency:   country.Currency(),
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to save country in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// SaveAirport は空港を作成し、同じ IATA コードが既に存在する場合は上書きする
+func (r *ReferencePostgresRepository) SaveAirport(ctx context.Context, airport *reference.Airport) error {
+	if airport == nil {
+		return apperr.NewInternalError("Airport entity cannot be nil")
+	}
+
+	err := r.GetQueries(ctx).UpsertAirport(ctx, postgres.UpsertAirportParams{
+		IataCode:    airport.IATACode(),
+		IcaoCode:    airport.ICAOCode(),
+		Name:        airport.Name(),
+		City:        airport.City(),
+		CountryCode: airport.CountryCode(),
+		Latitude:    airport.Coordinate().Latitude(),
+		Longitude:   airport.Coordinate().Longitude(),
+		Timezone:    airport.Timezone().Name(),
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to save airport in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// SaveCity は都市を作成し、同じ UN/LOCODE が既に存在する場合は上書きする
+func (r *ReferencePostgresRepository) SaveCity(ctx context.Context, city *reference.City) error {
+	if city == nil {
+		return apperr.NewInternalError("City entity cannot be nil")
+	}
+
+	err := r.GetQueries(ctx).UpsertCity(ctx, postgres.UpsertCityParams{
+		Code:        city.Code(),
+		Name:        city.Name(),
+		CountryCode: city.CountryCode(),
+		Latitude:    city.Coordinate().Latitude(),
+		Longitude:   city.Coordinate().Longitude(),
+		Timezone:    city.Timezone().Name(),
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to save city in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// ListCountries はすべての国を名前の順に取得する
+func (r *ReferencePostgresRepository) ListCountries(ctx context.Context) ([]*reference.Country, error) {
+	records, err := r.GetQueries(ctx).ListCountries(ctx)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch countries from database", apperr.WithCause(err))
+	}
+	return mapToCountries(records)
+}
+
+// SearchCountries は国コードの完全一致、名前（単語の先頭）の前方一致、あいまい一致の順に関連度の高い国を取得する
+func (r *ReferencePostgresRepository) SearchCountries(ctx context.Context, query search.Query, limit int) ([]*reference.Country, error) {
+	records, err := r.GetQueries(ctx).SearchCountries(ctx, postgres.SearchCountriesParams{
+		Query:         query.String(),
+		Prefix:        query.PrefixPattern(),
+		MinSimilarity: referenceMinSimilarity,
+		ResultLimit:   int64(limit),
+	})
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to search countries in database", apperr.WithCause(err))
+	}
+	return mapToCountries(records)
+}
+
+// SearchAirports は IATA・ICAO コードの完全一致、名前か都市名（単語の先頭）の前方一致、あいまい一致の順に関連度の高い空港を取得する
+func (r *ReferencePostgresRepository) SearchAirports(ctx context.Context, query search.Query, limit int) ([]*reference.Airport, error) {
+	records, err := r.GetQueries(ctx).SearchAirports(ctx, postgres.SearchAirportsParams{
+		Query:         query.String(),
+		Prefix:        query.PrefixPattern(),
+		MinSimilarity: referenceMinSimilarity,
+		ResultLimit:   int64(limit),
+	})
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to search airports in database", apperr.WithCause(err))
+	}
+
+	airports := make([]*reference.Airport, 0, len(records))
+	for _, record := range records {
+		coordinate, timezone, err := mapToReferenceLocation(record.Latitude, record.Longitude, record.Timezone)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to airport domain object", apperr.WithCause(err))
+		}
+		airport, err := reference.NewAirport(record.IataCode, record.IcaoCode, record.Name, record.City, record.CountryCode, coordinate, timezone)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to airport domain object", apperr.WithCause(err))
+		}
+		airports = append(airports, airport)
+	}
+
+	return airports, nil
+}
+
+// SearchCities は UN/LOCODE（国コードを除いた 3 文字を含む）の完全一致、名前（単語の先頭）の前方一致、あいまい一致の順に関連度の高い都市を取得する
+func (r *ReferencePostgresRepository) SearchCities(ctx context.Context, query search.Query, limit int) ([]*reference.City, error) {
+	records, err := r.GetQueries(ctx).SearchCities(ctx, postgres.SearchCitiesParams{
+		Query:         query.String(),
+		Prefix:        query.PrefixPattern(),
+		MinSimilarity: referenceMinSimilarity,
+		ResultLimit:   int64(limit),
+	})
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to search cities in database", apperr.WithCause(err))
+	}
+
+	cities := make([]*reference.City, 0, len(records))
+	for _, record := range records {
+		coordinate, timezone, err := mapToReferenceLocation(record.Latitude, record.Longitude, record.Timezone)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to city domain object", apperr.WithCause(err))
+		}
+		city, err := reference.NewCity(record.Code, record.Name, record.CountryCode, coordinate, timezone)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to city domain object", apperr.WithCause(err))
+		}
+		cities = append(cities, city)
+	}
+
+	return cities, nil
+}
+
+// mapToCountries はデータベースレコードをドメインオブジェクトに変換する
+func mapToCountries(records []postgres.Country) ([]*reference.Country, error) {
+	countries := make([]*reference.Country, 0, len(records))
+	for _, record := range records {
+		country, err := reference.NewCountry(record.Code, record.Alpha3Code, record.Name, record.Currency)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to country domain object", apperr.WithCause(err))
+		}
+		countries = append(countries, country)
+	}
+	return countries, nil
+}
+
+// mapToReferenceLocation は緯度・経度とタイムゾーン名の列を値オブジェクトに変換する
+func mapToReferenceLocation(latitude, longitude float64, timezone string) (geo.Coordinate, geo.Timezone, error) {
+	coordinate, err := geo.NewCoordinate(latitude, longitude)
+	if err != nil {
+		return geo.Coordinate{}, geo.Timezone{}, err
+	}
+	tz, err := geo.NewTimezone(timezone)
+	if err != nil {
+		return geo.Coordinate{}, geo.Timezone{}, err
+	}
+	return coordinate, tz, nil
+}
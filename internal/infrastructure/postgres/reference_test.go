@@ -0,0 +1,216 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hata0/travel-api/internal/domain/reference"
+	"github.com/hata0/travel-api/internal/domain/search"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newReferenceTestRepository 国・空港・都市を保存したリポジトリを作成する（トランザクション分離）
+func newReferenceTestRepository(t *testing.T) (context.Context, reference.ReferenceRepository) {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	repo := NewReferencePostgresRepository(tx)
+	for _, c := range [][]string{
+		{"JP", "JPN", "Japan", "JPY"},
+		{"FR", "FRA", "France", "EUR"},
+		{"GB", "GBR", "United Kingdom", "GBP"},
+		{"US", "USA", "United States", "USD"},
+	} {
+		country, err := reference.NewCountry(c[0], c[1], c[2], c[3])
+		require.NoError(t, err)
+		require.NoError(t, repo.SaveCountry(ctx, country), "国の保存に失敗")
+	}
+
+	newLocation := func(latitude, longitude float64, timezone string) (geo.Coordinate, geo.Timezone) {
+		coordinate, err := geo.NewCoordinate(latitude, longitude)
+		require.NoError(t, err)
+		tz, err := geo.NewTimezone(timezone)
+		require.NoError(t, err)
+		return coordinate, tz
+	}
+	for _, a := range []struct {
+		iata, icao, name, city, country string
+		latitude, longitude             float64
+		timezone                        string
+	}{
+		{"HND", "RJTT", "Haneda Airport", "Tokyo", "JP", 35.5523, 139.7798, "Asia/Tokyo"},
+		{"NRT", "RJAA", "Narita International Airport", "Tokyo", "JP", 35.7647, 140.3864, "Asia/Tokyo"},
+		{"CDG", "LFPG", "Paris Charles de Gaulle Airport", "Paris", "FR", 49.0097, 2.5479, "Europe/Paris"},
+		{"LHR", "EGLL", "Heathrow Airport", "London", "GB", 51.4700, -0.4543, "Europe/London"},
+	} {
+		coordinate, tz := newLocation(a.latitude, a.longitude, a.timezone)
+		airport, err := reference.NewAirport(a.iata, a.icao, a.name, a.city, a.country, coordinate, tz)
+		require.NoError(t, err)
+		require.NoError(t, repo.SaveAirport(ctx, airport), "空港の保存に失敗")
+	}
+	for _, c := range []struct {
+		code, name, country string
+		latitude, longitude float64
+		timezone            string
+	}{
+		{"JPTYO", "Tokyo", "JP", 35.6895, 139.6917, "Asia/Tokyo"},
+		{"JPUKY", "Kyoto", "JP", 35.0116, 135.7681, "Asia/Tokyo"},
+		{"FRPAR", "Paris", "FR", 48.8566, 2.3522, "Europe/Paris"},
+		{"USNYC", "New York", "US", 40.7128, -74.0060, "America/New_York"},
+	} {
+		coordinate, tz := newLocation(c.latitude, c.longitude, c.timezone)
+		city, err := reference.NewCity(c.code, c.name, c.country, coordinate, tz)
+		require.NoError(t, err)
+		require.NoError(t, repo.SaveCity(ctx, city), "都市の保存に失敗")
+	}
+
+	return ctx, repo
+}
+
+func newReferenceTestQuery(t *testing.T, text string) search.Query {
+	t.Helper()
+
+	q, err := search.NewQuery(text)
+	require.NoError(t, err)
+	return q
+}
+
+func TestReferencePostgresRepository_Countries(t *testing.T) {
+	t.Run("すべての国が名前の順に取得できること", func(t *testing.T) {
+		ctx, repo := newReferenceTestRepository(t)
+
+		countries, err := repo.ListCountries(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, countries, 4)
+		assert.Equal(t, "FR", countries[0].Code())
+		assert.Equal(t, "US", countries[3].Code())
+		assert.Equal(t, "EUR", countries[0].Currency())
+	})
+
+	t.Run("同じ国コードは上書きされること", func(t *testing.T) {
+		ctx, repo := newReferenceTestRepository(t)
+		renamed, err := reference.NewCountry("GB", "GBR", "Great Britain", "GBP")
+		require.NoError(t, err)
+		require.NoError(t, repo.SaveCountry(ctx, renamed))
+
+		countries, err := repo.SearchCountries(ctx, newReferenceTestQuery(t, "gb"), 10)
+
+		require.NoError(t, err)
+		require.Len(t, countries, 1)
+		assert.Equal(t, "Great Britain", countries[0].Name())
+	})
+
+	t.Run("単語の先頭に前方一致する国が取得できること", func(t *testing.T) {
+		ctx, repo := newReferenceTestRepository(t)
+
+		countries, err := repo.SearchCountries(ctx, newReferenceTestQuery(t, "king"), 10)
+
+		require.NoError(t, err)
+		require.Len(t, countries, 1)
+		assert.Equal(t, "GB", countries[0].Code())
+	})
+}
+
+func TestReferencePostgresRepository_SearchAirports(t *testing.T) {
+	codes := func(airports []*reference.Airport) []string {
+		var result []string
+		for _, a := range airports {
+			result = append(result, a.IATACode())
+		}
+		return result
+	}
+
+	t.Run("IATA・ICAO コードに完全一致する空港が先頭に取得できること", func(t *testing.T) {
+		ctx, repo := newReferenceTestRepository(t)
+
+		byIATA, err := repo.SearchAirports(ctx, newReferenceTestQuery(t, "nrt"), 10)
+		require.NoError(t, err)
+		byICAO, err := repo.SearchAirports(ctx, newReferenceTestQuery(t, "EGLL"), 10)
+		require.NoError(t, err)
+
+		require.NotEmpty(t, byIATA)
+		assert.Equal(t, "NRT", byIATA[0].IATACode())
+		assert.Equal(t, "Asia/Tokyo", byIATA[0].Timezone().Name())
+		assert.Equal(t, []string{"LHR"}, codes(byICAO))
+	})
+
+	t.Run("都市名や名前の単語に前方一致する空港が取得できること", func(t *testing.T) {
+		ctx, repo := newReferenceTestRepository(t)
+
+		byCity, err := repo.SearchAirports(ctx, newReferenceTestQuery(t, "tok"), 10)
+		require.NoError(t, err)
+		byName, err := repo.SearchAirports(ctx, newReferenceTestQuery(t, "gaulle"), 10)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"HND", "NRT"}, codes(byCity))
+		assert.Equal(t, []string{"CDG"}, codes(byName))
+	})
+
+	t.Run("綴りが少し異なる空港があいまい一致で取得できること", func(t *testing.T) {
+		ctx, repo := newReferenceTestRepository(t)
+
+		airports, err := repo.SearchAirports(ctx, newReferenceTestQuery(t, "Heatrow"), 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"LHR"}, codes(airports))
+	})
+
+	t.Run("件数の上限まで取得できること", func(t *testing.T) {
+		ctx, repo := newReferenceTestRepository(t)
+
+		airports, err := repo.SearchAirports(ctx, newReferenceTestQuery(t, "Tokyo"), 1)
+
+		require.NoError(t, err)
+		assert.Len(t, airports, 1)
+	})
+}
+
+func TestReferencePostgresRepository_SearchCities(t *testing.T) {
+	t.Run("UN/LOCODE の国コードを除いた部分に一致する都市が取得できること", func(t *testing.T) {
+		ctx, repo := newReferenceTestRepository(t)
+
+		cities, err := repo.SearchCities(ctx, newReferenceTestQuery(t, "tyo"), 10)
+
+		require.NoError(t, err)
+		require.NotEmpty(t, cities)
+		assert.Equal(t, "JPTYO", cities[0].Code())
+	})
+
+	t.Run("名前の単語に前方一致する都市が取得できること", func(t *testing.T) {
+		ctx, repo := newReferenceTestRepository(t)
+
+		cities, err := repo.SearchCities(ctx, newReferenceTestQuery(t, "york"), 10)
+
+		require.NoError(t, err)
+		require.Len(t, cities, 1)
+		assert.Equal(t, "USNYC", cities[0].Code())
+		assert.Equal(t, "America/New_York", cities[0].Timezone().Name())
+	})
+
+	t.Run("綴りが少し異なる都市があいまい一致で取得できること", func(t *testing.T) {
+		ctx, repo := newReferenceTestRepository(t)
+
+		cities, err := repo.SearchCities(ctx, newReferenceTestQuery(t, "Tokio"), 10)
+
+		require.NoError(t, err)
+		require.NotEmpty(t, cities)
+		assert.Equal(t, "JPTYO", cities[0].Code())
+	})
+}
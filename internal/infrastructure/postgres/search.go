@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/search"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+)
+
+// SearchPostgresRepository は全文検索のPostgreSQL実装。
+// 検索対象は各テーブルのトリガーで search_documents テーブルに同期される
+type SearchPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewSearchPostgresRepository は新しいSearchPostgresRepositoryを作成する
+func NewSearchPostgresRepository(db postgres.DBTX) search.SearchRepository {
+	return &SearchPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// Search は指定されたユーザーがメンバーとして参加している旅行のリソースから検索語に一致するものを取得する。
+// 単語単位の全文検索に加えて、日本語の文章にも一致するよう、すべての検索語を含むものを部分一致でも検索する
+func (r *SearchPostgresRepository) Search(ctx context.Context, userID user.UserID, query search.Query, limitPerType int) ([]*search.Hit, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUserID, err := mapper.ToUUID(userID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert user ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.SearchDocuments(ctx, postgres.SearchDocumentsParams{
+		Query:        query.String(),
+		UserID:       pgUserID,
+		Patterns:     query.SubstringPatterns(),
+		LimitPerType: int64(limitPerType),
+	})
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to search documents in database", apperr.WithCause(err))
+	}
+
+	hits := make([]*search.Hit, 0, len(records))
+	for _, record := range records {
+		resourceID, err := mapper.FromUUID(record.ResourceID)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to search hit", apperr.WithCause(err))
+		}
+		tripID, err := mapper.FromUUID(record.TripID)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to search hit", apperr.WithCause(err))
+		}
+
+		hits = append(hits, search.NewHit(
+			search.ResourceType(record.ResourceType),
+			resourceID,
+			trip.NewTripID(tripID),
+			record.Title,
+			record.Body,
+			record.Rank,
+		))
+	}
+
+	return hits, nil
+}
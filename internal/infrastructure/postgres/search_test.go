@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/search"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// searchTestSuite テスト用の共通セットアップ
+type searchTestSuite struct {
+	ctx    context.Context
+	tx     pgx.Tx
+	repo   search.SearchRepository
+	userID user.UserID
+}
+
+// newSearchTestSuite 検索するユーザーを作成したテストスイートを作成する（トランザクション分離）
+func newSearchTestSuite(t *testing.T) *searchTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	tu := newTestUser("searcher", "searcher@example.com")
+	require.NoError(t, NewUserPostgresRepository(tx).Create(ctx, tu.toDomainUser()), "Userの作成に失敗")
+
+	return &searchTestSuite{
+		ctx:    ctx,
+		tx:     tx,
+		repo:   NewSearchPostgresRepository(tx),
+		userID: tu.ID,
+	}
+}
+
+// createTrip 旅行を作成する。joined が true の場合は検索するユーザーをメンバーとして追加する
+func (s *searchTestSuite) createTrip(t *testing.T, name string, joined bool) trip.TripID {
+	t.Helper()
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	id := trip.NewTripID(uuid.New().String())
+	require.NoError(t, NewTripPostgresRepository(s.tx).Create(s.ctx, trip.NewTrip(id, name, nil, "", now, now)), "Tripの作成に失敗")
+	if joined {
+		require.NoError(t, NewMemberPostgresRepository(s.tx).Save(s.ctx, membership.NewMember(id, s.userID, membership.RoleViewer, now, now)), "Memberの作成に失敗")
+	}
+
+	return id
+}
+
+func (s *searchTestSuite) search(t *testing.T, text string) []*search.Hit {
+	t.Helper()
+
+	q, err := search.NewQuery(text)
+	require.NoError(t, err)
+	hits, err := s.repo.Search(s.ctx, s.userID, q, 10)
+	require.NoError(t, err, "Searchでエラーが発生してはならない")
+	return hits
+}
+
+func TestSearchPostgresRepository_Search(t *testing.T) {
+	t.Run("英語の単語で全文検索できること", func(t *testing.T) {
+		suite := newSearchTestSuite(t)
+
+		tripID := suite.createTrip(t, "Summer in Kyoto", true)
+		suite.createTrip(t, "Winter in Sapporo", true)
+
+		hits := suite.search(t, "kyoto")
+
+		require.Len(t, hits, 1)
+		assert.Equal(t, search.ResourceTypeTrip, hits[0].ResourceType())
+		assert.Equal(t, tripID.String(), hits[0].ResourceID())
+		assert.Greater(t, hits[0].Rank(), 0.0)
+	})
+
+	t.Run("空白で区切られていない日本語を部分一致で検索できること", func(t *testing.T) {
+		suite := newSearchTestSuite(t)
+
+		tripID := suite.createTrip(t, "京都紅葉めぐり", true)
+		require.NoError(t, NewAccommodationPostgresRepository(suite.tx).Create(suite.ctx, newTestAccommodation(t, tripID, "嵐山の旅館", time.Date(2024, 11, 20, 15, 0, 0, 0, time.UTC))))
+
+		tripHits := suite.search(t, "紅葉")
+		accommodationHits := suite.search(t, "嵐山")
+
+		require.Len(t, tripHits, 1)
+		assert.Equal(t, search.ResourceTypeTrip, tripHits[0].ResourceType())
+		require.Len(t, accommodationHits, 1)
+		assert.Equal(t, search.ResourceTypeAccommodation, accommodationHits[0].ResourceType())
+		assert.Equal(t, tripID, accommodationHits[0].TripID())
+		assert.Equal(t, "嵐山の旅館", accommodationHits[0].Title())
+	})
+
+	t.Run("日記の本文と場所の名前で検索できること", func(t *testing.T) {
+		suite := newSearchTestSuite(t)
+
+		tripID := suite.createTrip(t, "京都旅行", true)
+		e := newTestJournalEntry(t, tripID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), newTestPlace(t), time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, NewJournalPostgresRepository(suite.tx).Create(suite.ctx, e))
+
+		bodyHits := suite.search(t, "静か")
+		placeHits := suite.search(t, "小径")
+
+		require.Len(t, bodyHits, 1)
+		assert.Equal(t, search.ResourceTypeJournalEntry, bodyHits[0].ResourceType())
+		assert.Equal(t, e.ID().String(), bodyHits[0].ResourceID())
+		assert.Equal(t, "嵐山の竹林", bodyHits[0].Title())
+		require.Len(t, placeHits, 1)
+		assert.Equal(t, search.ResourceTypeJournalEntry, placeHits[0].ResourceType())
+	})
+
+	t.Run("行動のタイトルとメモ、場所の名前で検索できること", func(t *testing.T) {
+		suite := newSearchTestSuite(t)
+
+		tripID := suite.createTrip(t, "京都旅行", true)
+		a := newTestActivity(t, tripID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), 0, newTestPlace(t), time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, NewActivityPostgresRepository(suite.tx).Create(suite.ctx, a))
+
+		titleHits := suite.search(t, "竹林")
+		notesHits := suite.search(t, "朝早く")
+		placeHits := suite.search(t, "小径")
+
+		require.Len(t, titleHits, 1)
+		assert.Equal(t, search.ResourceTypeActivity, titleHits[0].ResourceType())
+		assert.Equal(t, a.ID().String(), titleHits[0].ResourceID())
+		assert.Equal(t, tripID, titleHits[0].TripID())
+		require.Len(t, notesHits, 1)
+		assert.Equal(t, search.ResourceTypeActivity, notesHits[0].ResourceType())
+		require.Len(t, placeHits, 1)
+		assert.Equal(t, search.ResourceTypeActivity, placeHits[0].ResourceType())
+
+		require.NoError(t, NewActivityPostgresRepository(suite.tx).Delete(suite.ctx, a.ID()))
+		assert.Empty(t, suite.search(t, "竹林"), "削除した行動は検索されないべき")
+	})
+
+	t.Run("メンバーとして参加していない旅行のリソースは検索されないこと", func(t *testing.T) {
+		suite := newSearchTestSuite(t)
+
+		otherTripID := suite.createTrip(t, "京都出張", false)
+		require.NoError(t, NewExpensePostgresRepository(suite.tx).Create(suite.ctx, newTestExpense(t, otherTripID, 1000, "JPY", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), nil)))
+
+		assert.Empty(t, suite.search(t, "京都"))
+		assert.Empty(t, suite.search(t, "夕食"))
+	})
+
+	t.Run("更新と削除が検索対象に反映されること", func(t *testing.T) {
+		suite := newSearchTestSuite(t)
+
+		tripID := suite.createTrip(t, "旅行", true)
+		expenseRepo := NewExpensePostgresRepository(suite.tx)
+		e := newTestExpense(t, tripID, 1000, "JPY", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), nil)
+		require.NoError(t, expenseRepo.Create(suite.ctx, e))
+		require.Len(t, suite.search(t, "夕食"), 1)
+
+		require.NoError(t, expenseRepo.Delete(suite.ctx, e.ID()))
+
+		assert.Empty(t, suite.search(t, "夕食"))
+	})
+
+	t.Run("LIKEの特殊文字は文字として検索されること", func(t *testing.T) {
+		suite := newSearchTestSuite(t)
+
+		suite.createTrip(t, "セール100%", true)
+		suite.createTrip(t, "セール1000", true)
+
+		hits := suite.search(t, "100%")
+
+		require.Len(t, hits, 1)
+		assert.Equal(t, "セール100%", hits[0].Title())
+	})
+}
@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/sharelink"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// ShareLinkPostgresRepository はShareLinkエンティティのPostgreSQL実装
+type ShareLinkPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewShareLinkPostgresRepository は新しいShareLinkPostgresRepositoryを作成する
+func NewShareLinkPostgresRepository(db postgres.DBTX) sharelink.ShareLinkRepository {
+	return &ShareLinkPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDのShareLinkを取得する
+func (r *ShareLinkPostgresRepository) FindByID(ctx context.Context, id sharelink.ShareLinkID) (*sharelink.ShareLink, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert share link ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindTripShareLink(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, sharelink.NewShareLinkNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch share link from database", apperr.WithCause(err))
+	}
+
+	link, err := r.mapToShareLink(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to share link domain object", apperr.WithCause(err))
+	}
+
+	return link, nil
+}
+
+// FindByTokenHash は指定されたトークンのハッシュ値を持つShareLinkを取得する
+func (r *ShareLinkPostgresRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*sharelink.ShareLink, error) {
+	queries := r.GetQueries(ctx)
+
+	record, err := queries.FindTripShareLinkByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, sharelink.NewShareLinkNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch share link from database", apperr.WithCause(err))
+	}
+
+	link, err := r.mapToShareLink(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to share link domain object", apperr.WithCause(err))
+	}
+
+	return link, nil
+}
+
+// FindByTripID は指定された旅行のShareLinkを作成日時の新しい順に取得する
+func (r *ShareLinkPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID) ([]*sharelink.ShareLink, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListTripShareLinksByTripID(ctx, pgTripID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch share links list from database", apperr.WithCause(err))
+	}
+
+	links := make([]*sharelink.ShareLink, 0, len(records))
+	for _, record := range records {
+		link, err := r.mapToShareLink(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to share link domain object", apperr.WithCause(err))
+		}
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
+// Create は新しいShareLinkを作成する
+func (r *ShareLinkPostgresRepository) Create(ctx context.Context, link *sharelink.ShareLink) error {
+	if link == nil {
+		return apperr.NewInternalError("ShareLink entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(link.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert share link ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgTripID, err := mapper.ToUUID(link.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgCreatedBy, err := mapper.ToUUID(link.CreatedBy().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert creator ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgExpiresAt, err := mapper.ToNullableTimestamp(link.ExpiresAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert share link expires_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(link.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert share link created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgRevokedAt, err := mapper.ToNullableTimestamp(link.RevokedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert share link revoked_at to timestamp", apperr.WithCause(err))
+	}
+
+	params := postgres.CreateTripShareLinkParams{
+		ID:           pgID,
+		TripID:       pgTripID,
+		TokenHash:    link.TokenHash(),
+		PasswordHash: link.PasswordHash(),
+		ExpiresAt:    pgExpiresAt,
+		CreatedBy:    pgCreatedBy,
+		CreatedAt:    pgCreatedAt,
+		RevokedAt:    pgRevokedAt,
+	}
+
+	if err := queries.CreateTripShareLink(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create share link in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Update は既存のShareLinkの無効化状況を更新する
+func (r *ShareLinkPostgresRepository) Update(ctx context.Context, link *sharelink.ShareLink) error {
+	if link == nil {
+		return apperr.NewInternalError("ShareLink entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(link.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert share link ID to UUID for update", apperr.WithCause(err))
+	}
+
+	pgRevokedAt, err := mapper.ToNullableTimestamp(link.RevokedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert share link revoked_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	rows, err := queries.UpdateTripShareLink(ctx, postgres.UpdateTripShareLinkParams{
+		ID:        pgID,
+		RevokedAt: pgRevokedAt,
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to update share link in database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return sharelink.NewShareLinkNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToShareLink はデータベースレコードをドメインオブジェクトに変換する
+func (r *ShareLinkPostgresRepository) mapToShareLink(record postgres.TripShareLink) (*sharelink.ShareLink, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	createdBy, err := mapper.FromUUID(record.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return sharelink.RestoreShareLink(
+		sharelink.NewShareLinkID(id),
+		trip.NewTripID(tripID),
+		record.TokenHash,
+		record.PasswordHash,
+		mapper.FromNullableTimestamp(record.ExpiresAt),
+		user.NewUserID(createdBy),
+		createdAt,
+		mapper.FromNullableTimestamp(record.RevokedAt),
+	), nil
+}
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/shared/tokenhash"
+	"github.com/hata0/travel-api/internal/domain/sharelink"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// shareLinkTestSuite テスト用の共通セットアップ
+type shareLinkTestSuite struct {
+	ctx       context.Context
+	repo      sharelink.ShareLinkRepository
+	tripID    trip.TripID
+	creatorID user.UserID
+}
+
+// newShareLinkTestSuite 共有リンクの親となるTripと作成者を作成したテストスイートを作成する（トランザクション分離）
+func newShareLinkTestSuite(t *testing.T) *shareLinkTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	tt := newTestTrip("共有テスト旅行")
+	require.NoError(t, NewTripPostgresRepository(tx).Create(ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+	tu := newTestUser("sharer", "sharer@example.com")
+	require.NoError(t, NewUserPostgresRepository(tx).Create(ctx, tu.toDomainUser()), "Userの作成に失敗")
+
+	return &shareLinkTestSuite{
+		ctx:       ctx,
+		repo:      NewShareLinkPostgresRepository(tx),
+		tripID:    tt.ID,
+		creatorID: tu.ID,
+	}
+}
+
+// newShareLink テスト用のShareLinkを生成する
+func (s *shareLinkTestSuite) newShareLink(t *testing.T, token string, passwordHash []byte, expiresAt *time.Time, createdAt time.Time) *sharelink.ShareLink {
+	t.Helper()
+
+	link, err := sharelink.NewShareLink(
+		sharelink.NewShareLinkID(uuid.New().String()),
+		s.tripID,
+		tokenhash.Hash(token),
+		passwordHash,
+		expiresAt,
+		s.creatorID,
+		createdAt,
+	)
+	require.NoError(t, err)
+	return link
+}
+
+func TestShareLinkPostgresRepository_CreateAndFind(t *testing.T) {
+	t.Run("作成したShareLinkをIDとトークンのハッシュ値で取得できること", func(t *testing.T) {
+		suite := newShareLinkTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		expiresAt := now.Add(24 * time.Hour)
+		link := suite.newShareLink(t, "token-1", []byte("password-hash"), &expiresAt, now)
+		require.NoError(t, suite.repo.Create(suite.ctx, link), "Createでエラーが発生してはならない")
+
+		byID, err := suite.repo.FindByID(suite.ctx, link.ID())
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assert.Equal(t, link.TokenHash(), byID.TokenHash(), "トークンのハッシュ値が一致すること")
+		assert.Equal(t, []byte("password-hash"), byID.PasswordHash(), "パスワードのハッシュ値が一致すること")
+		require.NotNil(t, byID.ExpiresAt(), "有効期限が保存されること")
+		assert.WithinDuration(t, expiresAt, *byID.ExpiresAt(), time.Second, "有効期限が一致すること")
+		assert.Nil(t, byID.RevokedAt(), "無効化されていないこと")
+
+		byToken, err := suite.repo.FindByTokenHash(suite.ctx, tokenhash.Hash("token-1"))
+		require.NoError(t, err, "FindByTokenHashでエラーが発生してはならない")
+		assert.True(t, link.Equals(byToken), "同じShareLinkが取得されること")
+	})
+
+	t.Run("パスワードと有効期限のないShareLinkを保存できること", func(t *testing.T) {
+		suite := newShareLinkTestSuite(t)
+
+		link := suite.newShareLink(t, "token-2", nil, nil, time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, suite.repo.Create(suite.ctx, link), "Createでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByID(suite.ctx, link.ID())
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assert.False(t, found.HasPassword(), "パスワードが設定されていないこと")
+		assert.Nil(t, found.ExpiresAt(), "有効期限が設定されていないこと")
+	})
+
+	t.Run("存在しないトークンでShareLinkNotFoundが返されること", func(t *testing.T) {
+		suite := newShareLinkTestSuite(t)
+
+		_, err := suite.repo.FindByTokenHash(suite.ctx, tokenhash.Hash("unknown"))
+
+		assert.ErrorIs(t, err, sharelink.NewShareLinkNotFoundError(),
+			"ShareLinkNotFoundが返されるべき")
+	})
+}
+
+func TestShareLinkPostgresRepository_FindByTripID(t *testing.T) {
+	t.Run("作成日時の新しい順に取得できること", func(t *testing.T) {
+		suite := newShareLinkTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		older := suite.newShareLink(t, "older", nil, nil, now.Add(-time.Hour))
+		newer := suite.newShareLink(t, "newer", nil, nil, now)
+		require.NoError(t, suite.repo.Create(suite.ctx, older))
+		require.NoError(t, suite.repo.Create(suite.ctx, newer))
+
+		links, err := suite.repo.FindByTripID(suite.ctx, suite.tripID)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, links, 2)
+		assert.True(t, newer.Equals(links[0]), "新しいShareLinkが先頭であること")
+		assert.True(t, older.Equals(links[1]), "古いShareLinkが後ろであること")
+	})
+}
+
+func TestShareLinkPostgresRepository_Update(t *testing.T) {
+	t.Run("無効化した日時を保存できること", func(t *testing.T) {
+		suite := newShareLinkTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		link := suite.newShareLink(t, "token", nil, nil, now)
+		require.NoError(t, suite.repo.Create(suite.ctx, link))
+		revoked, err := link.Revoke(now.Add(time.Minute))
+		require.NoError(t, err)
+
+		require.NoError(t, suite.repo.Update(suite.ctx, revoked), "Updateでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByID(suite.ctx, link.ID())
+		require.NoError(t, err)
+		assert.Equal(t, sharelink.ShareLinkStatusRevoked, found.StatusAt(now), "無効化されていること")
+	})
+
+	t.Run("存在しないShareLinkの更新でShareLinkNotFoundが返されること", func(t *testing.T) {
+		suite := newShareLinkTestSuite(t)
+
+		link := suite.newShareLink(t, "token", nil, nil, time.Now())
+
+		err := suite.repo.Update(suite.ctx, link)
+
+		assert.ErrorIs(t, err, sharelink.NewShareLinkNotFoundError(),
+			"ShareLinkNotFoundが返されるべき")
+	})
+}
@@ -0,0 +1,515 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/triptemplate"
+	"github.com/hata0/travel-api/internal/domain/user"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// TemplatePostgresRepository はTemplateエンティティのPostgreSQL実装
+type TemplatePostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewTemplatePostgresRepository は新しいTemplatePostgresRepositoryを作成する
+func NewTemplatePostgresRepository(db postgres.DBTX) triptemplate.TemplateRepository {
+	return &TemplatePostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDのTemplateを取得する
+func (r *TemplatePostgresRepository) FindByID(ctx context.Context, id triptemplate.TemplateID) (*triptemplate.Template, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert template ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindTripTemplate(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, triptemplate.NewTemplateNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch template from database", apperr.WithCause(err))
+	}
+
+	t, err := r.mapToTemplate(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to template domain object", apperr.WithCause(err))
+	}
+
+	return t, nil
+}
+
+// FindByOwnerID は指定されたユーザーが所有するTemplateを作成日時の新しい順に取得する
+func (r *TemplatePostgresRepository) FindByOwnerID(ctx context.Context, ownerID user.UserID) ([]*triptemplate.Template, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgOwnerID, err := mapper.ToUUID(ownerID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert owner ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListTripTemplatesByOwnerID(ctx, pgOwnerID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch templates list from database", apperr.WithCause(err))
+	}
+
+	return r.mapToTemplates(records)
+}
+
+// FindPublic は公開されているTemplateを作成日時の新しい順に取得する
+func (r *TemplatePostgresRepository) FindPublic(ctx context.Context) ([]*triptemplate.Template, error) {
+	queries := r.GetQueries(ctx)
+
+	records, err := queries.ListPublicTripTemplates(ctx)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch public templates list from database", apperr.WithCause(err))
+	}
+
+	return r.mapToTemplates(records)
+}
+
+// Create は新しいTemplateを作成する
+func (r *TemplatePostgresRepository) Create(ctx context.Context, t *triptemplate.Template) error {
+	if t == nil {
+		return apperr.NewInternalError("Template entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(t.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert template ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgOwnerID, err := mapper.ToUUID(t.OwnerID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert owner ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	content, err := encodeTemplateContent(t.Content())
+	if err != nil {
+		return apperr.NewInternalError("Failed to encode template content", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(t.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert template created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(t.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert template updated_at to timestamp", apperr.WithCause(err))
+	}
+
+	params := postgres.CreateTripTemplateParams{
+		ID:          pgID,
+		OwnerID:     pgOwnerID,
+		Name:        t.Name(),
+		Description: t.Description(),
+		IsPublic:    t.IsPublic(),
+		Content:     content,
+		CreatedAt:   pgCreatedAt,
+		UpdatedAt:   pgUpdatedAt,
+	}
+
+	if err := queries.CreateTripTemplate(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create template in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Update は既存のTemplateの名前・説明・公開設定を更新する
+func (r *TemplatePostgresRepository) Update(ctx context.Context, t *triptemplate.Template) error {
+	if t == nil {
+		return apperr.NewInternalError("Template entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(t.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert template ID to UUID for update", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(t.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert template updated_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	rows, err := queries.UpdateTripTemplate(ctx, postgres.UpdateTripTemplateParams{
+		ID:          pgID,
+		Name:        t.Name(),
+		Description: t.Description(),
+		IsPublic:    t.IsPublic(),
+		UpdatedAt:   pgUpdatedAt,
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to update template in database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return triptemplate.NewTemplateNotFoundError()
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDのTemplateを削除する
+func (r *TemplatePostgresRepository) Delete(ctx context.Context, id triptemplate.TemplateID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert template ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeleteTripTemplate(ctx, pgID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete template from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return triptemplate.NewTemplateNotFoundError()
+	}
+
+	return nil
+}
+
+func (r *TemplatePostgresRepository) mapToTemplates(records []postgres.TripTemplate) ([]*triptemplate.Template, error) {
+	templates := make([]*triptemplate.Template, 0, len(records))
+	for _, record := range records {
+		t, err := r.mapToTemplate(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to template domain object", apperr.WithCause(err))
+		}
+		templates = append(templates, t)
+	}
+	return templates, nil
+}
+
+// mapToTemplate はデータベースレコードをドメインオブジェクトに変換する
+func (r *TemplatePostgresRepository) mapToTemplate(record postgres.TripTemplate) (*triptemplate.Template, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := mapper.FromUUID(record.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := decodeTemplateContent(record.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt, err := mapper.FromTimestamp(record.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return triptemplate.NewTemplate(
+		triptemplate.NewTemplateID(id),
+		user.NewUserID(ownerID),
+		record.Name,
+		record.Description,
+		record.IsPublic,
+		content,
+		createdAt,
+		updatedAt,
+	), nil
+}
+
+// templateContentRecord は雛形の内容の JSON 表現
+type templateContentRecord struct {
+	Days           *int                          `json:"days"`
+	Accommodations []templateAccommodationRecord `json:"accommodations"`
+	Budget         *templateBudgetRecord         `json:"budget"`
+	Checklists     []templateChecklistRecord     `json:"checklists"`
+	// Activities は行動を雛形に取り込む前に作成した雛形にはない
+	Activities []templateActivityRecord `json:"activities,omitempty"`
+}
+
+// templateAccommodationRecord は雛形の宿泊予約の JSON 表現。チェックイン・チェックアウトは基準日からの経過秒数で持つ
+type templateAccommodationRecord struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	// Timezone は宿泊先のタイムゾーンを記録する前に作成した雛形にはない
+	Timezone              *string `json:"timezone,omitempty"`
+	CheckInOffsetSeconds  int64   `json:"check_in_offset_seconds"`
+	CheckOutOffsetSeconds int64   `json:"check_out_offset_seconds"`
+	CostAmount            int64   `json:"cost_amount"`
+	CostCurrency          string  `json:"cost_currency"`
+	Notes                 string  `json:"notes"`
+}
+
+type templateBudgetRecord struct {
+	Currency       string           `json:"currency"`
+	CategoryLimits map[string]int64 `json:"category_limits"`
+}
+
+type templateChecklistRecord struct {
+	Name  string                        `json:"name"`
+	Kind  string                        `json:"kind"`
+	Items []templateChecklistItemRecord `json:"items"`
+}
+
+type templateChecklistItemRecord struct {
+	Name     string `json:"name"`
+	Quantity int    `json:"quantity"`
+}
+
+type templateActivityRecord struct {
+	Day                int                  `json:"day"`
+	Position           int                  `json:"position"`
+	Title              string               `json:"title"`
+	Place              *templatePlaceRecord `json:"place"`
+	Timezone           *string              `json:"timezone"`
+	StartOffsetSeconds *int64               `json:"start_offset_seconds"`
+	EndOffsetSeconds   *int64               `json:"end_offset_seconds"`
+	TravelMode         string               `json:"travel_mode"`
+	Notes              string               `json:"notes"`
+}
+
+type templatePlaceRecord struct {
+	Name      string   `json:"name"`
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	Timezone  *string  `json:"timezone"`
+}
+
+// encodeTemplateContent は雛形の内容を JSON に変換する
+func encodeTemplateContent(content triptemplate.Content) ([]byte, error) {
+	record := templateContentRecord{
+		Days:           content.Days(),
+		Accommodations: []templateAccommodationRecord{},
+		Checklists:     []templateChecklistRecord{},
+	}
+	for _, item := range content.Accommodations() {
+		record.Accommodations = append(record.Accommodations, templateAccommodationRecord{
+			Name:                  item.Name(),
+			Address:               item.Address(),
+			Timezone:              templateTimezoneName(item.Timezone()),
+			CheckInOffsetSeconds:  int64(item.CheckInOffset() / time.Second),
+			CheckOutOffsetSeconds: int64(item.CheckOutOffset() / time.Second),
+			CostAmount:            item.Cost().Amount(),
+			CostCurrency:          item.Cost().Currency(),
+			Notes:                 item.Notes(),
+		})
+	}
+	if b := content.Budget(); b != nil {
+		limits := make(map[string]int64)
+		for category, limit := range b.CategoryLimits() {
+			limits[category.String()] = limit
+		}
+		record.Budget = &templateBudgetRecord{Currency: b.Currency(), CategoryLimits: limits}
+	}
+	for _, c := range content.Checklists() {
+		items := make([]templateChecklistItemRecord, 0, len(c.Entries()))
+		for _, entry := range c.Entries() {
+			items = append(items, templateChecklistItemRecord{Name: entry.Name(), Quantity: entry.Quantity()})
+		}
+		record.Checklists = append(record.Checklists, templateChecklistRecord{Name: c.Name(), Kind: c.Kind().String(), Items: items})
+	}
+	for _, a := range content.Activities() {
+		record.Activities = append(record.Activities, templateActivityRecord{
+			Day:                a.Day(),
+			Position:           a.Position(),
+			Title:              a.Title(),
+			Place:              newTemplatePlaceRecord(a.Place()),
+			Timezone:           templateTimezoneName(a.Timezone()),
+			StartOffsetSeconds: templateOffsetSeconds(a.StartOffset()),
+			EndOffsetSeconds:   templateOffsetSeconds(a.EndOffset()),
+			TravelMode:         a.TravelMode().String(),
+			Notes:              a.Notes(),
+		})
+	}
+	return json.Marshal(record)
+}
+
+// decodeTemplateContent は JSON から雛形の内容を復元する
+func decodeTemplateContent(data []byte) (triptemplate.Content, error) {
+	var record templateContentRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return triptemplate.Content{}, err
+	}
+
+	items := make([]triptemplate.AccommodationItem, 0, len(record.Accommodations))
+	for _, a := range record.Accommodations {
+		cost, err := money.NewMoney(a.CostAmount, a.CostCurrency)
+		if err != nil {
+			return triptemplate.Content{}, err
+		}
+		timezone, err := templateTimezone(a.Timezone)
+		if err != nil {
+			return triptemplate.Content{}, err
+		}
+		items = append(items, triptemplate.NewAccommodationItem(
+			a.Name,
+			a.Address,
+			timezone,
+			time.Duration(a.CheckInOffsetSeconds)*time.Second,
+			time.Duration(a.CheckOutOffsetSeconds)*time.Second,
+			cost,
+			a.Notes,
+		))
+	}
+
+	var budgetItem *triptemplate.BudgetItem
+	if record.Budget != nil {
+		limits := make(map[expense.Category]int64, len(record.Budget.CategoryLimits))
+		for rawCategory, amount := range record.Budget.CategoryLimits {
+			category, err := expense.ParseCategory(rawCategory)
+			if err != nil {
+				return triptemplate.Content{}, err
+			}
+			limits[category] = amount
+		}
+		item := triptemplate.NewBudgetItem(record.Budget.Currency, limits)
+		budgetItem = &item
+	}
+
+	checklists := make([]triptemplate.ChecklistItem, 0, len(record.Checklists))
+	for _, c := range record.Checklists {
+		kind, err := checklist.ParseKind(c.Kind)
+		if err != nil {
+			return triptemplate.Content{}, err
+		}
+		entries := make([]triptemplate.ChecklistEntry, 0, len(c.Items))
+		for _, item := range c.Items {
+			entries = append(entries, triptemplate.NewChecklistEntry(item.Name, item.Quantity))
+		}
+		checklists = append(checklists, triptemplate.NewChecklistItem(c.Name, kind, entries))
+	}
+
+	activities := make([]triptemplate.ActivityItem, 0, len(record.Activities))
+	for _, a := range record.Activities {
+		mode, err := itinerary.ParseTravelMode(a.TravelMode)
+		if err != nil {
+			return triptemplate.Content{}, err
+		}
+		place, err := a.Place.toPlace()
+		if err != nil {
+			return triptemplate.Content{}, err
+		}
+		timezone, err := templateTimezone(a.Timezone)
+		if err != nil {
+			return triptemplate.Content{}, err
+		}
+		activities = append(activities, triptemplate.NewActivityItem(
+			a.Day,
+			a.Position,
+			a.Title,
+			place,
+			timezone,
+			templateOffset(a.StartOffsetSeconds),
+			templateOffset(a.EndOffsetSeconds),
+			mode,
+			a.Notes,
+		))
+	}
+
+	return triptemplate.NewContent(record.Days, items, budgetItem, checklists, activities), nil
+}
+
+// newTemplatePlaceRecord は場所を JSON 表現に変換する。場所がない場合は nil を返す
+func newTemplatePlaceRecord(place *geo.Place) *templatePlaceRecord {
+	if place == nil {
+		return nil
+	}
+	record := &templatePlaceRecord{Name: place.Name(), Timezone: templateTimezoneName(place.Timezone())}
+	if c := place.Coordinate(); c != nil {
+		latitude, longitude := c.Latitude(), c.Longitude()
+		record.Latitude, record.Longitude = &latitude, &longitude
+	}
+	return record
+}
+
+// toPlace は JSON 表現から場所を復元する。JSON 表現が nil の場合は nil を返す
+func (r *templatePlaceRecord) toPlace() (*geo.Place, error) {
+	if r == nil {
+		return nil, nil
+	}
+	var coordinate *geo.Coordinate
+	if r.Latitude != nil && r.Longitude != nil {
+		c, err := geo.NewCoordinate(*r.Latitude, *r.Longitude)
+		if err != nil {
+			return nil, err
+		}
+		coordinate = &c
+	}
+	timezone, err := templateTimezone(r.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	p, err := geo.NewPlace(r.Name, coordinate, timezone)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func templateTimezoneName(timezone *geo.Timezone) *string {
+	if timezone == nil {
+		return nil
+	}
+	name := timezone.Name()
+	return &name
+}
+
+func templateTimezone(name *string) (*geo.Timezone, error) {
+	if name == nil {
+		return nil, nil
+	}
+	timezone, err := geo.NewTimezone(*name)
+	if err != nil {
+		return nil, err
+	}
+	return &timezone, nil
+}
+
+func templateOffsetSeconds(offset *time.Duration) *int64 {
+	if offset == nil {
+		return nil
+	}
+	seconds := int64(*offset / time.Second)
+	return &seconds
+}
+
+func templateOffset(seconds *int64) *time.Duration {
+	if seconds == nil {
+		return nil
+	}
+	offset := time.Duration(*seconds) * time.Second
+	return &offset
+}
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/triptemplate"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// templateTestSuite テスト用の共通セットアップ
+type templateTestSuite struct {
+	ctx     context.Context
+	repo    triptemplate.TemplateRepository
+	ownerID user.UserID
+	otherID user.UserID
+}
+
+// newTemplateTestSuite 雛形の所有者となるUserを作成したテストスイートを作成する（トランザクション分離）
+func newTemplateTestSuite(t *testing.T) *templateTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	userRepo := NewUserPostgresRepository(tx)
+	owner := newTestUser("template-owner", "template-owner@example.com")
+	require.NoError(t, userRepo.Create(ctx, owner.toDomainUser()), "Userの作成に失敗")
+	other := newTestUser("template-other", "template-other@example.com")
+	require.NoError(t, userRepo.Create(ctx, other.toDomainUser()), "Userの作成に失敗")
+
+	return &templateTestSuite{
+		ctx:     ctx,
+		repo:    NewTemplatePostgresRepository(tx),
+		ownerID: owner.ID,
+		otherID: other.ID,
+	}
+}
+
+// newTemplate テスト用のTemplateを生成する
+func (s *templateTestSuite) newTemplate(t *testing.T, ownerID user.UserID, name string, public bool, createdAt time.Time) *triptemplate.Template {
+	t.Helper()
+
+	cost, err := money.NewMoney(32000, "JPY")
+	require.NoError(t, err)
+	days := 3
+	budgetItem := triptemplate.NewBudgetItem("JPY", map[expense.Category]int64{expense.CategoryFood: 30000})
+	coordinate, err := geo.NewCoordinate(35.0394, 135.7292)
+	require.NoError(t, err)
+	place, err := geo.NewPlace("金閣寺", &coordinate, nil)
+	require.NoError(t, err)
+	startOffset := 34 * time.Hour
+	content := triptemplate.NewContent(&days, []triptemplate.AccommodationItem{
+		triptemplate.NewAccommodationItem("Hotel", "Kyoto", nil, 15*time.Hour, 34*time.Hour, cost, "朝食付き"),
+	}, &budgetItem, []triptemplate.ChecklistItem{
+		triptemplate.NewChecklistItem("持ち物", checklist.KindPacking, []triptemplate.ChecklistEntry{triptemplate.NewChecklistEntry("靴下", 3)}),
+	}, []triptemplate.ActivityItem{
+		triptemplate.NewActivityItem(1, 0, "金閣寺", &place, nil, &startOffset, nil, itinerary.TravelModeTransit, "拝観料 500 円"),
+	})
+
+	return triptemplate.NewTemplate(triptemplate.NewTemplateID(uuid.New().String()), ownerID, name, "説明", public, content, createdAt, createdAt)
+}
+
+func TestTemplatePostgresRepository_CreateAndFindByID(t *testing.T) {
+	t.Run("作成したTemplateを内容を含めて取得できること", func(t *testing.T) {
+		suite := newTemplateTestSuite(t)
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		tmpl := suite.newTemplate(t, suite.ownerID, "京都2泊", true, now)
+		require.NoError(t, suite.repo.Create(suite.ctx, tmpl), "Createでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByID(suite.ctx, tmpl.ID())
+		require.NoError(t, err)
+
+		assert.Equal(t, tmpl.ID(), found.ID())
+		assert.Equal(t, suite.ownerID, found.OwnerID())
+		assert.Equal(t, "京都2泊", found.Name())
+		assert.Equal(t, "説明", found.Description())
+		assert.True(t, found.IsPublic())
+		assert.Equal(t, tmpl.Content(), found.Content(), "内容が保存前と一致すべき")
+		assert.True(t, now.Equal(found.CreatedAt()))
+	})
+
+	t.Run("存在しないIDの場合はTemplateNotFoundを返すこと", func(t *testing.T) {
+		suite := newTemplateTestSuite(t)
+
+		_, err := suite.repo.FindByID(suite.ctx, triptemplate.NewTemplateID(uuid.New().String()))
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, triptemplate.CodeTemplateNotFound))
+	})
+}
+
+func TestTemplatePostgresRepository_FindByOwnerIDAndPublic(t *testing.T) {
+	suite := newTemplateTestSuite(t)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	older := suite.newTemplate(t, suite.ownerID, "古い雛形", false, now.Add(-time.Hour))
+	newer := suite.newTemplate(t, suite.ownerID, "新しい雛形", true, now)
+	others := suite.newTemplate(t, suite.otherID, "他人の雛形", true, now.Add(-30*time.Minute))
+	for _, tmpl := range []*triptemplate.Template{older, newer, others} {
+		require.NoError(t, suite.repo.Create(suite.ctx, tmpl))
+	}
+
+	owned, err := suite.repo.FindByOwnerID(suite.ctx, suite.ownerID)
+	require.NoError(t, err)
+	require.Len(t, owned, 2, "所有する雛形だけを取得すべき")
+	assert.Equal(t, newer.ID(), owned[0].ID(), "新しい順に並ぶべき")
+	assert.Equal(t, older.ID(), owned[1].ID())
+
+	public, err := suite.repo.FindPublic(suite.ctx)
+	require.NoError(t, err)
+	var publicIDs []triptemplate.TemplateID
+	for _, tmpl := range public {
+		publicIDs = append(publicIDs, tmpl.ID())
+	}
+	assert.Equal(t, []triptemplate.TemplateID{newer.ID(), others.ID()}, publicIDs, "公開された雛形だけを新しい順に取得すべき")
+}
+
+func TestTemplatePostgresRepository_Update(t *testing.T) {
+	suite := newTemplateTestSuite(t)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	tmpl := suite.newTemplate(t, suite.ownerID, "京都2泊", false, now)
+	require.NoError(t, suite.repo.Create(suite.ctx, tmpl))
+
+	updated := tmpl.Update("京都3泊", "延泊版", true, now.Add(time.Hour))
+	require.NoError(t, suite.repo.Update(suite.ctx, updated))
+
+	found, err := suite.repo.FindByID(suite.ctx, tmpl.ID())
+	require.NoError(t, err)
+	assert.Equal(t, "京都3泊", found.Name())
+	assert.Equal(t, "延泊版", found.Description())
+	assert.True(t, found.IsPublic())
+	assert.Equal(t, tmpl.Content(), found.Content(), "内容は更新されないべき")
+
+	missing := suite.newTemplate(t, suite.ownerID, "存在しない", false, now)
+	assert.True(t, apperr.IsAppErrorWithCode(suite.repo.Update(suite.ctx, missing), triptemplate.CodeTemplateNotFound))
+}
+
+func TestTemplatePostgresRepository_Delete(t *testing.T) {
+	suite := newTemplateTestSuite(t)
+
+	tmpl := suite.newTemplate(t, suite.ownerID, "京都2泊", false, time.Now().UTC().Truncate(time.Microsecond))
+	require.NoError(t, suite.repo.Create(suite.ctx, tmpl))
+
+	require.NoError(t, suite.repo.Delete(suite.ctx, tmpl.ID()))
+
+	_, err := suite.repo.FindByID(suite.ctx, tmpl.ID())
+	assert.True(t, apperr.IsAppErrorWithCode(err, triptemplate.CodeTemplateNotFound))
+	assert.True(t, apperr.IsAppErrorWithCode(suite.repo.Delete(suite.ctx, tmpl.ID()), triptemplate.CodeTemplateNotFound), "削除済みの場合はTemplateNotFoundを返すべき")
+}
@@ -0,0 +1,316 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/track"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// TrackPostgresRepository はTrackエンティティのPostgreSQL実装
+type TrackPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewTrackPostgresRepository は新しいTrackPostgresRepositoryを作成する
+func NewTrackPostgresRepository(db postgres.DBTX) track.TrackRepository {
+	return &TrackPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDのトラックを取得する
+func (r *TrackPostgresRepository) FindByID(ctx context.Context, id track.TrackID) (*track.Track, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert track ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindTrack(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, track.NewTrackNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch track from database", apperr.WithCause(err))
+	}
+
+	t, err := r.mapToTrack(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to track domain object", apperr.WithCause(err))
+	}
+
+	return t, nil
+}
+
+// FindByTripID は指定された旅行のトラックを記録の開始日時の順に取得する。
+// activityID が nil でない場合はその行動に紐づくトラックだけを返す
+func (r *TrackPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID, activityID *string) ([]*track.Track, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	var records []postgres.Track
+	if activityID != nil {
+		pgActivityID, err := mapper.ToUUID(*activityID)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to convert activity ID to UUID", apperr.WithCause(err))
+		}
+		records, err = queries.ListTracksByTripIDAndActivityID(ctx, postgres.ListTracksByTripIDAndActivityIDParams{
+			TripID:     pgTripID,
+			ActivityID: pgActivityID,
+		})
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to fetch tracks list from database", apperr.WithCause(err))
+		}
+	} else {
+		records, err = queries.ListTracksByTripID(ctx, pgTripID)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to fetch tracks list from database", apperr.WithCause(err))
+		}
+	}
+
+	tracks := make([]*track.Track, 0, len(records))
+	for _, record := range records {
+		t, err := r.mapToTrack(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to track domain object", apperr.WithCause(err))
+		}
+		tracks = append(tracks, t)
+	}
+
+	return tracks, nil
+}
+
+// Create は新しいトラックを作成する
+func (r *TrackPostgresRepository) Create(ctx context.Context, t *track.Track) error {
+	if t == nil {
+		return apperr.NewInternalError("Track entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(t.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert track ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgTripID, err := mapper.ToUUID(t.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgActivityID, err := mapper.ToNullableUUID(t.ActivityID())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert activity ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgUploadedBy, err := mapper.ToUUID(t.UploadedBy().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert uploader ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	stats := t.Stats()
+	pgStartedAt, err := mapper.ToNullableTimestamp(stats.StartedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert track started_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgEndedAt, err := mapper.ToNullableTimestamp(stats.EndedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert track ended_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(t.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert track created_at to timestamp", apperr.WithCause(err))
+	}
+
+	geometry, err := encodeTrackGeometry(t.Geometry())
+	if err != nil {
+		return apperr.NewInternalError("Failed to encode track geometry", apperr.WithCause(err))
+	}
+
+	bounds := stats.Bounds()
+	params := postgres.CreateTrackParams{
+		ID:                  pgID,
+		TripID:              pgTripID,
+		ActivityID:          pgActivityID,
+		Name:                t.Name(),
+		DistanceMeters:      stats.DistanceMeters(),
+		MovingDurationMs:    stats.MovingDuration().Milliseconds(),
+		ElapsedDurationMs:   stats.ElapsedDuration().Milliseconds(),
+		ElevationGainMeters: stats.ElevationGainMeters(),
+		ElevationLossMeters: stats.ElevationLossMeters(),
+		MaxSpeedMps:         stats.MaxSpeed(),
+		StartedAt:           pgStartedAt,
+		EndedAt:             pgEndedAt,
+		MinLatitude:         bounds.MinLatitude(),
+		MinLongitude:        bounds.MinLongitude(),
+		MaxLatitude:         bounds.MaxLatitude(),
+		MaxLongitude:        bounds.MaxLongitude(),
+		PointCount:          int32(stats.PointCount()),
+		Geometry:            geometry,
+		UploadedBy:          pgUploadedBy,
+		CreatedAt:           pgCreatedAt,
+	}
+
+	if err := queries.CreateTrack(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create track in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDのトラックを削除する
+func (r *TrackPostgresRepository) Delete(ctx context.Context, id track.TrackID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert track ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeleteTrack(ctx, pgID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete track from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return track.NewTrackNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToTrack はデータベースレコードをドメインオブジェクトに変換する
+func (r *TrackPostgresRepository) mapToTrack(record postgres.Track) (*track.Track, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadedBy, err := mapper.FromUUID(record.UploadedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	geometry, err := decodeTrackGeometry(record.Geometry)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := track.RestoreStats(
+		record.DistanceMeters,
+		time.Duration(record.MovingDurationMs)*time.Millisecond,
+		time.Duration(record.ElapsedDurationMs)*time.Millisecond,
+		record.ElevationGainMeters,
+		record.ElevationLossMeters,
+		record.MaxSpeedMps,
+		utcTime(mapper.FromNullableTimestamp(record.StartedAt)),
+		utcTime(mapper.FromNullableTimestamp(record.EndedAt)),
+		track.NewBounds(record.MinLatitude, record.MinLongitude, record.MaxLatitude, record.MaxLongitude),
+		int(record.PointCount),
+	)
+
+	return track.RestoreTrack(
+		track.NewTrackID(id),
+		trip.NewTripID(tripID),
+		mapper.FromNullableUUID(record.ActivityID),
+		record.Name,
+		stats,
+		geometry,
+		user.NewUserID(uploadedBy),
+		createdAt,
+	), nil
+}
+
+// utcTime は記録日時を GPX の読み込み時と同じ UTC で表す。NULL の場合は nil を返す
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.UTC()
+	return &v
+}
+
+// trackPointRecord は間引いた軌跡の点の JSON 表現
+type trackPointRecord struct {
+	Latitude   float64    `json:"lat"`
+	Longitude  float64    `json:"lon"`
+	Elevation  *float64   `json:"ele,omitempty"`
+	RecordedAt *time.Time `json:"time,omitempty"`
+}
+
+// encodeTrackGeometry は間引いた軌跡を JSON に変換する
+func encodeTrackGeometry(segments []track.Segment) ([]byte, error) {
+	records := make([][]trackPointRecord, 0, len(segments))
+	for _, segment := range segments {
+		points := make([]trackPointRecord, 0, len(segment))
+		for _, p := range segment {
+			points = append(points, trackPointRecord{
+				Latitude:   p.Coordinate().Latitude(),
+				Longitude:  p.Coordinate().Longitude(),
+				Elevation:  p.Elevation(),
+				RecordedAt: p.RecordedAt(),
+			})
+		}
+		records = append(records, points)
+	}
+	return json.Marshal(records)
+}
+
+// decodeTrackGeometry は JSON から間引いた軌跡を復元する
+func decodeTrackGeometry(data []byte) ([]track.Segment, error) {
+	var records [][]trackPointRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+
+	segments := make([]track.Segment, 0, len(records))
+	for _, points := range records {
+		segment := make(track.Segment, 0, len(points))
+		for _, record := range points {
+			c, err := geo.NewCoordinate(record.Latitude, record.Longitude)
+			if err != nil {
+				return nil, err
+			}
+			var recordedAt *time.Time
+			if record.RecordedAt != nil {
+				v := record.RecordedAt.UTC()
+				recordedAt = &v
+			}
+			segment = append(segment, track.NewPoint(c, record.Elevation, recordedAt))
+		}
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/track"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// trackTestSuite テスト用の共通セットアップ
+type trackTestSuite struct {
+	ctx          context.Context
+	repo         track.TrackRepository
+	tripRepo     trip.TripRepository
+	activityRepo itinerary.ActivityRepository
+	uploaderID   user.UserID
+}
+
+// newTrackTestSuite テストスイートを作成する（トランザクション分離）
+func newTrackTestSuite(t *testing.T) *trackTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	uploader := newTestUser("track-uploader", "track-uploader@example.com")
+	require.NoError(t, NewUserPostgresRepository(tx).Create(ctx, uploader.toDomainUser()), "Userの作成に失敗")
+
+	return &trackTestSuite{
+		ctx:          ctx,
+		repo:         NewTrackPostgresRepository(tx),
+		tripRepo:     NewTripPostgresRepository(tx),
+		activityRepo: NewActivityPostgresRepository(tx),
+		uploaderID:   uploader.ID,
+	}
+}
+
+// createTrip トラックの親となるTripを作成する
+func (s *trackTestSuite) createTrip(t *testing.T) trip.TripID {
+	t.Helper()
+
+	tt := newTestTrip("トラックテスト旅行")
+	require.NoError(t, s.tripRepo.Create(s.ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+
+	return tt.ID
+}
+
+// createActivity トラックを紐づける行動を作成し、そのIDを返す
+func (s *trackTestSuite) createActivity(t *testing.T, tripID trip.TripID) string {
+	t.Helper()
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	a := newTestActivity(t, tripID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), 0, nil, now)
+	require.NoError(t, s.activityRepo.Create(s.ctx, a), "Activityの作成に失敗")
+
+	return a.ID().String()
+}
+
+// newTrack テスト用のトラックを生成する。startedAt が nil の場合は記録日時のない軌跡になる
+func (s *trackTestSuite) newTrack(t *testing.T, tripID trip.TripID, activityID *string, startedAt *time.Time, createdAt time.Time) *track.Track {
+	t.Helper()
+
+	segment := make(track.Segment, 0, 3)
+	for i, lng := range []float64{139.2701, 139.2650, 139.2435} {
+		c, err := geo.NewCoordinate(35.6322-float64(i)*0.003, lng)
+		require.NoError(t, err)
+		elevation := 200 + float64(i)*150
+		var recordedAt *time.Time
+		if startedAt != nil {
+			at := startedAt.Add(time.Duration(i) * 20 * time.Minute)
+			recordedAt = &at
+		}
+		segment = append(segment, track.NewPoint(c, &elevation, recordedAt))
+	}
+
+	tr, err := track.NewTrack(track.NewTrackID(uuid.New().String()), tripID, activityID, "高尾山", []track.Segment{segment}, s.uploaderID, createdAt)
+	require.NoError(t, err)
+	return tr
+}
+
+// assertTrackEquals トラックの等価性をアサートする
+func assertTrackEquals(t *testing.T, expected, actual *track.Track) {
+	t.Helper()
+	assert.Equal(t, expected.ID(), actual.ID(), "IDが一致すること")
+	assert.Equal(t, expected.TripID(), actual.TripID(), "TripIDが一致すること")
+	assert.Equal(t, expected.ActivityID(), actual.ActivityID(), "ActivityIDが一致すること")
+	assert.Equal(t, expected.Name(), actual.Name(), "Nameが一致すること")
+	assert.Equal(t, expected.Stats(), actual.Stats(), "Statsが一致すること")
+	assert.Equal(t, expected.Geometry(), actual.Geometry(), "Geometryが一致すること")
+	assert.Equal(t, expected.UploadedBy(), actual.UploadedBy(), "UploadedByが一致すること")
+	assert.True(t, expected.CreatedAt().Equal(actual.CreatedAt()), "CreatedAtが一致すること")
+}
+
+func TestTrackPostgresRepository_CreateAndFindByID(t *testing.T) {
+	t.Run("統計と間引いた軌跡を持つトラックを作成して取得できること", func(t *testing.T) {
+		suite := newTrackTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		activityID := suite.createActivity(t, tripID)
+		startedAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		tr := suite.newTrack(t, tripID, &activityID, &startedAt, time.Now().UTC().Truncate(time.Microsecond))
+
+		require.NoError(t, suite.repo.Create(suite.ctx, tr), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, tr.ID())
+
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertTrackEquals(t, tr, found)
+	})
+
+	t.Run("記録日時のないトラックを作成して取得できること", func(t *testing.T) {
+		suite := newTrackTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		tr := suite.newTrack(t, tripID, nil, nil, time.Now().UTC().Truncate(time.Microsecond))
+
+		require.NoError(t, suite.repo.Create(suite.ctx, tr), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, tr.ID())
+
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertTrackEquals(t, tr, found)
+	})
+
+	t.Run("存在しないIDでTrackNotFoundが返されること", func(t *testing.T) {
+		suite := newTrackTestSuite(t)
+
+		_, err := suite.repo.FindByID(suite.ctx, track.NewTrackID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, track.NewTrackNotFoundError(), "TrackNotFoundが返されるべき")
+	})
+}
+
+func TestTrackPostgresRepository_FindByTripID(t *testing.T) {
+	suite := newTrackTestSuite(t)
+
+	// Given: 同じ旅行に記録日時の異なるトラック2つと記録日時のないトラック1つ、別の旅行に1つ
+	tripID := suite.createTrip(t)
+	otherTripID := suite.createTrip(t)
+	activityID := suite.createActivity(t, tripID)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	day1 := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC)
+	unknown := suite.newTrack(t, tripID, nil, nil, now.Add(-2*time.Hour))
+	later := suite.newTrack(t, tripID, &activityID, &day2, now.Add(-time.Hour))
+	earlier := suite.newTrack(t, tripID, nil, &day1, now)
+	other := suite.newTrack(t, otherTripID, &activityID, &day1, now)
+	for _, tr := range []*track.Track{unknown, later, earlier, other} {
+		require.NoError(t, suite.repo.Create(suite.ctx, tr), "Createでエラーが発生してはならない")
+	}
+
+	t.Run("旅行のトラックが記録の開始日時の順に、記録日時のないトラックは最後に取得できること", func(t *testing.T) {
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID, nil)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 3, "対象旅行のトラックのみが返されるべき")
+		assert.Equal(t, earlier.ID(), found[0].ID())
+		assert.Equal(t, later.ID(), found[1].ID())
+		assert.Equal(t, unknown.ID(), found[2].ID())
+	})
+
+	t.Run("行動を指定するとその行動のトラックのみが取得できること", func(t *testing.T) {
+		found, err := suite.repo.FindByTripID(suite.ctx, tripID, &activityID)
+
+		require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+		require.Len(t, found, 1)
+		assert.Equal(t, later.ID(), found[0].ID())
+	})
+}
+
+func TestTrackPostgresRepository_Delete(t *testing.T) {
+	t.Run("既存のトラックを削除できること", func(t *testing.T) {
+		suite := newTrackTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		tr := suite.newTrack(t, tripID, nil, nil, time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, suite.repo.Create(suite.ctx, tr), "Createでエラーが発生してはならない")
+
+		require.NoError(t, suite.repo.Delete(suite.ctx, tr.ID()), "Deleteでエラーが発生してはならない")
+
+		_, err := suite.repo.FindByID(suite.ctx, tr.ID())
+		assert.ErrorIs(t, err, track.NewTrackNotFoundError(), "削除後はTrackNotFoundが返されるべき")
+	})
+
+	t.Run("存在しないトラックの削除でTrackNotFoundが返されること", func(t *testing.T) {
+		suite := newTrackTestSuite(t)
+
+		err := suite.repo.Delete(suite.ctx, track.NewTrackID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, track.NewTrackNotFoundError(), "TrackNotFoundが返されるべき")
+	})
+}
+
+func TestTrackPostgresRepository_ActivityDeletion(t *testing.T) {
+	t.Run("紐づく行動を削除するとトラックの紐づけが外れること", func(t *testing.T) {
+		suite := newTrackTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		activityID := suite.createActivity(t, tripID)
+		tr := suite.newTrack(t, tripID, &activityID, nil, time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, suite.repo.Create(suite.ctx, tr), "Createでエラーが発生してはならない")
+
+		require.NoError(t, suite.activityRepo.Delete(suite.ctx, itinerary.NewActivityID(activityID)), "行動のDeleteでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByID(suite.ctx, tr.ID())
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assert.Nil(t, found.ActivityID(), "行動の削除後はActivityIDがnilになるべき")
+	})
+}
@@ -0,0 +1,300 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
+	"github.com/jackc/pgx/v5"
+)
+
+// TransportLegPostgresRepository はLegエンティティのPostgreSQL実装
+type TransportLegPostgresRepository struct {
+	*BasePostgresRepository
+}
+
+// NewTransportLegPostgresRepository は新しいTransportLegPostgresRepositoryを作成する
+func NewTransportLegPostgresRepository(db postgres.DBTX) transport.LegRepository {
+	return &TransportLegPostgresRepository{
+		BasePostgresRepository: NewBasePostgresRepository(db),
+	}
+}
+
+// FindByID は指定されたIDの移動を取得する
+func (r *TransportLegPostgresRepository) FindByID(ctx context.Context, id transport.LegID) (*transport.Leg, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert transport leg ID to UUID", apperr.WithCause(err))
+	}
+
+	record, err := queries.FindTransportLeg(ctx, pgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, transport.NewLegNotFoundError()
+		}
+		return nil, apperr.NewInternalError("Failed to fetch transport leg from database", apperr.WithCause(err))
+	}
+
+	l, err := r.mapToLeg(record)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to map database record to transport leg domain object", apperr.WithCause(err))
+	}
+
+	return l, nil
+}
+
+// FindByTripID は指定された旅行の移動を出発日時の早い順に取得する
+func (r *TransportLegPostgresRepository) FindByTripID(ctx context.Context, tripID trip.TripID) ([]*transport.Leg, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgTripID, err := mapper.ToUUID(tripID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert trip ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListTransportLegsByTripID(ctx, pgTripID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch transport legs list from database", apperr.WithCause(err))
+	}
+
+	legs := make([]*transport.Leg, 0, len(records))
+	for _, record := range records {
+		l, err := r.mapToLeg(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to transport leg domain object", apperr.WithCause(err))
+		}
+		legs = append(legs, l)
+	}
+
+	return legs, nil
+}
+
+// Create は新しい移動を作成する
+func (r *TransportLegPostgresRepository) Create(ctx context.Context, l *transport.Leg) error {
+	if l == nil {
+		return apperr.NewInternalError("Transport leg entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(l.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert transport leg ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgTripID, err := mapper.ToUUID(l.TripID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for creation", apperr.WithCause(err))
+	}
+
+	pgDepartureAt, err := mapper.ToTimestamp(l.DepartureAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert transport leg departure_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgArrivalAt, err := mapper.ToTimestamp(l.ArrivalAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert transport leg arrival_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgCreatedAt, err := mapper.ToTimestamp(l.CreatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert transport leg created_at to timestamp", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(l.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert transport leg updated_at to timestamp", apperr.WithCause(err))
+	}
+
+	origin, destination := l.Origin(), l.Destination()
+	_, originLatitude, originLongitude, originTimezone := placeColumns(mapper, &origin)
+	_, destinationLatitude, destinationLongitude, destinationTimezone := placeColumns(mapper, &destination)
+	params := postgres.CreateTransportLegParams{
+		ID:                   pgID,
+		TripID:               pgTripID,
+		Mode:                 l.Mode().String(),
+		OriginName:           origin.Name(),
+		OriginLatitude:       originLatitude,
+		OriginLongitude:      originLongitude,
+		OriginTimezone:       originTimezone,
+		DestinationName:      destination.Name(),
+		DestinationLatitude:  destinationLatitude,
+		DestinationLongitude: destinationLongitude,
+		DestinationTimezone:  destinationTimezone,
+		DepartureAt:          pgDepartureAt,
+		ArrivalAt:            pgArrivalAt,
+		Carrier:              l.Carrier(),
+		Number:               l.Number(),
+		Notes:                l.Notes(),
+		CreatedAt:            pgCreatedAt,
+		UpdatedAt:            pgUpdatedAt,
+	}
+
+	if err := queries.CreateTransportLeg(ctx, params); err != nil {
+		return apperr.NewInternalError("Failed to create transport leg in database", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Update は既存の移動を更新する
+func (r *TransportLegPostgresRepository) Update(ctx context.Context, l *transport.Leg) error {
+	if l == nil {
+		return apperr.NewInternalError("Transport leg entity cannot be nil")
+	}
+
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(l.ID().String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert transport leg ID to UUID for update", apperr.WithCause(err))
+	}
+
+	pgDepartureAt, err := mapper.ToTimestamp(l.DepartureAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert transport leg departure_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	pgArrivalAt, err := mapper.ToTimestamp(l.ArrivalAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert transport leg arrival_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	pgUpdatedAt, err := mapper.ToTimestamp(l.UpdatedAt())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert transport leg updated_at to timestamp for update", apperr.WithCause(err))
+	}
+
+	origin, destination := l.Origin(), l.Destination()
+	_, originLatitude, originLongitude, originTimezone := placeColumns(mapper, &origin)
+	_, destinationLatitude, destinationLongitude, destinationTimezone := placeColumns(mapper, &destination)
+	rows, err := queries.UpdateTransportLeg(ctx, postgres.UpdateTransportLegParams{
+		ID:                   pgID,
+		Mode:                 l.Mode().String(),
+		OriginName:           origin.Name(),
+		OriginLatitude:       originLatitude,
+		OriginLongitude:      originLongitude,
+		OriginTimezone:       originTimezone,
+		DestinationName:      destination.Name(),
+		DestinationLatitude:  destinationLatitude,
+		DestinationLongitude: destinationLongitude,
+		DestinationTimezone:  destinationTimezone,
+		DepartureAt:          pgDepartureAt,
+		ArrivalAt:            pgArrivalAt,
+		Carrier:              l.Carrier(),
+		Number:               l.Number(),
+		Notes:                l.Notes(),
+		UpdatedAt:            pgUpdatedAt,
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to update transport leg in database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return transport.NewLegNotFoundError()
+	}
+
+	return nil
+}
+
+// Delete は指定されたIDの移動を削除する
+func (r *TransportLegPostgresRepository) Delete(ctx context.Context, id transport.LegID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert transport leg ID to UUID for deletion", apperr.WithCause(err))
+	}
+
+	rows, err := queries.DeleteTransportLeg(ctx, pgID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to delete transport leg from database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return transport.NewLegNotFoundError()
+	}
+
+	return nil
+}
+
+// mapToLeg はデータベースレコードをドメインオブジェクトに変換する
+func (r *TransportLegPostgresRepository) mapToLeg(record postgres.TransportLeg) (*transport.Leg, error) {
+	mapper := r.GetTypeMapper()
+
+	id, err := mapper.FromUUID(record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID, err := mapper.FromUUID(record.TripID)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := transport.ParseMode(record.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	origin, err := placeFromColumns(mapper, mapper.ToNullableText(record.OriginName), record.OriginLatitude, record.OriginLongitude, record.OriginTimezone)
+	if err != nil {
+		return nil, err
+	}
+
+	destination, err := placeFromColumns(mapper, mapper.ToNullableText(record.DestinationName), record.DestinationLatitude, record.DestinationLongitude, record.DestinationTimezone)
+	if err != nil {
+		return nil, err
+	}
+
+	if origin == nil || destination == nil {
+		return nil, geo.NewEmptyPlaceNameError()
+	}
+
+	departureAt, err := mapper.FromTimestamp(record.DepartureAt)
+	if err != nil {
+		return nil, err
+	}
+
+	arrivalAt, err := mapper.FromTimestamp(record.ArrivalAt)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, err := mapper.FromTimestamp(record.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt, err := mapper.FromTimestamp(record.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return transport.NewLeg(
+		transport.NewLegID(id),
+		trip.NewTripID(tripID),
+		mode,
+		*origin,
+		*destination,
+		departureAt,
+		arrivalAt,
+		record.Carrier,
+		record.Number,
+		record.Notes,
+		createdAt,
+		updatedAt,
+	)
+}
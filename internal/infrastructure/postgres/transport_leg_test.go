@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// transportLegTestSuite テスト用の共通セットアップ
+type transportLegTestSuite struct {
+	ctx      context.Context
+	repo     transport.LegRepository
+	tripRepo trip.TripRepository
+}
+
+// newTransportLegTestSuite テストスイートを作成する（トランザクション分離）
+func newTransportLegTestSuite(t *testing.T) *transportLegTestSuite {
+	t.Helper()
+
+	ctx := context.Background()
+	db := setupDB(t, ctx)
+
+	tx, err := db.Begin(ctx)
+	require.NoError(t, err, "トランザクション開始に失敗")
+
+	t.Cleanup(func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			t.Logf("トランザクションロールバック時の警告: %v", err)
+		}
+	})
+
+	return &transportLegTestSuite{
+		ctx:      ctx,
+		repo:     NewTransportLegPostgresRepository(tx),
+		tripRepo: NewTripPostgresRepository(tx),
+	}
+}
+
+// createTrip 移動の親となるTripを作成する
+func (s *transportLegTestSuite) createTrip(t *testing.T) trip.TripID {
+	t.Helper()
+
+	tt := newTestTrip("移動テスト旅行")
+	require.NoError(t, s.tripRepo.Create(s.ctx, tt.toDomainTrip()), "Tripの作成に失敗")
+
+	return tt.ID
+}
+
+// newTestTransportLeg テスト用の羽田空港からホノルル空港への移動を生成する
+func newTestTransportLeg(t *testing.T, tripID trip.TripID, departureAt, createdAt time.Time) *transport.Leg {
+	t.Helper()
+
+	honolulu, err := geo.NewTimezone("Pacific/Honolulu")
+	require.NoError(t, err)
+	destination, err := geo.NewPlace("ホノルル空港", nil, &honolulu)
+	require.NoError(t, err)
+	l, err := transport.NewLeg(
+		transport.NewLegID(uuid.New().String()),
+		tripID,
+		transport.ModeFlight,
+		*newTestPlace(t),
+		destination,
+		departureAt,
+		departureAt.Add(7*time.Hour),
+		"JAL",
+		"JL74",
+		"窓側の席",
+		createdAt,
+		createdAt,
+	)
+	require.NoError(t, err)
+	return l
+}
+
+// assertTransportLegEquals 移動の等価性をアサートする
+func assertTransportLegEquals(t *testing.T, expected, actual *transport.Leg) {
+	t.Helper()
+	assert.Equal(t, expected.ID(), actual.ID(), "IDが一致すること")
+	assert.Equal(t, expected.TripID(), actual.TripID(), "TripIDが一致すること")
+	assert.Equal(t, expected.Mode(), actual.Mode(), "Modeが一致すること")
+	assert.Equal(t, expected.Origin(), actual.Origin(), "Originが一致すること")
+	assert.Equal(t, expected.Destination(), actual.Destination(), "Destinationが一致すること")
+	assert.True(t, expected.DepartureAt().Equal(actual.DepartureAt()), "DepartureAtが一致すること")
+	assert.True(t, expected.ArrivalAt().Equal(actual.ArrivalAt()), "ArrivalAtが一致すること")
+	assert.Equal(t, expected.Carrier(), actual.Carrier(), "Carrierが一致すること")
+	assert.Equal(t, expected.Number(), actual.Number(), "Numberが一致すること")
+	assert.Equal(t, expected.Notes(), actual.Notes(), "Notesが一致すること")
+	assert.True(t, expected.CreatedAt().Equal(actual.CreatedAt()), "CreatedAtが一致すること")
+	assert.True(t, expected.UpdatedAt().Equal(actual.UpdatedAt()), "UpdatedAtが一致すること")
+}
+
+func TestTransportLegPostgresRepository_CreateAndFindByID(t *testing.T) {
+	t.Run("作成した移動を出発地・到着地のタイムゾーンを含めて取得できること", func(t *testing.T) {
+		suite := newTransportLegTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		l := newTestTransportLeg(t, tripID, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), now)
+
+		require.NoError(t, suite.repo.Create(suite.ctx, l), "Createでエラーが発生してはならない")
+		found, err := suite.repo.FindByID(suite.ctx, l.ID())
+
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertTransportLegEquals(t, l, found)
+		assert.Equal(t, "Asia/Tokyo", found.DepartureTimezone().Name())
+		assert.Equal(t, "Pacific/Honolulu", found.ArrivalTimezone().Name())
+	})
+
+	t.Run("存在しないIDでTransportLegNotFoundが返されること", func(t *testing.T) {
+		suite := newTransportLegTestSuite(t)
+
+		_, err := suite.repo.FindByID(suite.ctx, transport.NewLegID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, transport.NewLegNotFoundError(),
+			"TransportLegNotFoundが返されるべき")
+	})
+}
+
+func TestTransportLegPostgresRepository_FindByTripID(t *testing.T) {
+	suite := newTransportLegTestSuite(t)
+
+	// Given: 同じ旅行に2件、別の旅行に1件の移動
+	tripID := suite.createTrip(t)
+	otherTripID := suite.createTrip(t)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	outbound := newTestTransportLeg(t, tripID, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), now)
+	inbound := newTestTransportLeg(t, tripID, time.Date(2024, 5, 5, 12, 0, 0, 0, time.UTC), now.Add(-time.Hour))
+	other := newTestTransportLeg(t, otherTripID, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), now)
+	for _, l := range []*transport.Leg{inbound, outbound, other} {
+		require.NoError(t, suite.repo.Create(suite.ctx, l), "Createでエラーが発生してはならない")
+	}
+
+	found, err := suite.repo.FindByTripID(suite.ctx, tripID)
+
+	require.NoError(t, err, "FindByTripIDでエラーが発生してはならない")
+	require.Len(t, found, 2, "対象旅行の移動のみが返されるべき")
+	assert.Equal(t, outbound.ID(), found[0].ID(), "出発日時の早い順に返されるべき")
+	assert.Equal(t, inbound.ID(), found[1].ID())
+}
+
+func TestTransportLegPostgresRepository_Update(t *testing.T) {
+	t.Run("内容の変更と座標・タイムゾーンの削除が反映されること", func(t *testing.T) {
+		suite := newTransportLegTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		l := newTestTransportLeg(t, tripID, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), now)
+		require.NoError(t, suite.repo.Create(suite.ctx, l), "Createでエラーが発生してはならない")
+
+		origin, err := geo.NewPlace("東京駅", nil, nil)
+		require.NoError(t, err)
+		destination, err := geo.NewPlace("京都駅", nil, nil)
+		require.NoError(t, err)
+		departureAt := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+		updated, err := l.Update(transport.ModeTrain, origin, destination, departureAt, departureAt.Add(2*time.Hour), "", "のぞみ1号", "", now.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, suite.repo.Update(suite.ctx, updated), "Updateでエラーが発生してはならない")
+
+		found, err := suite.repo.FindByID(suite.ctx, l.ID())
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assertTransportLegEquals(t, updated, found)
+		assert.Nil(t, found.DepartureTimezone())
+	})
+
+	t.Run("存在しない移動の更新でTransportLegNotFoundが返されること", func(t *testing.T) {
+		suite := newTransportLegTestSuite(t)
+
+		l := newTestTransportLeg(t, trip.NewTripID(uuid.New().String()), time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), time.Now())
+
+		err := suite.repo.Update(suite.ctx, l)
+
+		assert.ErrorIs(t, err, transport.NewLegNotFoundError(),
+			"TransportLegNotFoundが返されるべき")
+	})
+}
+
+func TestTransportLegPostgresRepository_Delete(t *testing.T) {
+	t.Run("削除した移動は取得できないこと", func(t *testing.T) {
+		suite := newTransportLegTestSuite(t)
+
+		tripID := suite.createTrip(t)
+		l := newTestTransportLeg(t, tripID, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), time.Now().UTC().Truncate(time.Microsecond))
+		require.NoError(t, suite.repo.Create(suite.ctx, l), "Createでエラーが発生してはならない")
+
+		require.NoError(t, suite.repo.Delete(suite.ctx, l.ID()), "Deleteでエラーが発生してはならない")
+
+		_, err := suite.repo.FindByID(suite.ctx, l.ID())
+		assert.ErrorIs(t, err, transport.NewLegNotFoundError())
+	})
+
+	t.Run("存在しない移動の削除でTransportLegNotFoundが返されること", func(t *testing.T) {
+		suite := newTransportLegTestSuite(t)
+
+		err := suite.repo.Delete(suite.ctx, transport.NewLegID(uuid.New().String()))
+
+		assert.ErrorIs(t, err, transport.NewLegNotFoundError())
+	})
+}
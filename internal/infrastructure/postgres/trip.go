@@ -3,11 +3,15 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/shared/pagination"
 	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
 	postgres "github.com/hata0/travel-api/internal/infrastructure/postgres/generated"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // TripPostgresRepository はTripエンティティのPostgreSQL実装
@@ -48,27 +52,71 @@ func (r *TripPostgresRepository) FindByID(ctx context.Context, id trip.TripID) (
 	return trip, nil
 }
 
-// FindMany はすべてのTripを取得する
-func (r *TripPostgresRepository) FindMany(ctx context.Context) ([]*trip.Trip, error) {
-	queries := r.GetQueries(ctx)
+// tripSortColumns は並び替えキーごとの並び替えに使う式と、カーソルの値を変換する型
+var tripSortColumns = map[trip.SortKey]struct{ expr, typ string }{
+	trip.SortByName:      {"name", "text"},
+	trip.SortByCreatedAt: {"created_at", "timestamptz"},
+	trip.SortByUpdatedAt: {"updated_at", "timestamptz"},
+	// 期間未定の旅行は開始日を無限遠の未来として扱う
+	trip.SortByStartDate: {"COALESCE(start_date, 'infinity'::date)", "date"},
+}
 
-	records, err := queries.ListTrips(ctx)
+// FindByMember は指定されたユーザーがメンバーとして参加しているTripのうち、取得条件に一致するものを1ページ分取得する
+func (r *TripPostgresRepository) FindByMember(ctx context.Context, userID user.UserID, query trip.ListQuery) (*pagination.Page[*trip.Trip], error) {
+	mapper := r.GetTypeMapper()
+
+	pgUserID, err := mapper.ToUUID(userID.String())
 	if err != nil {
-		return nil, apperr.NewInternalError("Failed to fetch trips list from database", apperr.WithCause(err))
+		return nil, apperr.NewInternalError("Failed to convert user ID to UUID", apperr.WithCause(err))
+	}
+
+	sortColumn, ok := tripSortColumns[query.Sort()]
+	if !ok {
+		return nil, apperr.NewInternalError("Unsupported trip sort key: " + query.Sort().String())
+	}
+
+	q := newKeysetQuery("SELECT id, name, created_at, updated_at, start_date, end_date, version, deleted_at, home_currency FROM trips", "id")
+	q.where("deleted_at IS NULL")
+	q.where("id IN (SELECT trip_id FROM trip_members WHERE user_id = ?)", pgUserID)
+	if prefix := query.NamePrefix(); prefix != "" {
+		q.where("starts_with(name, ?)", prefix)
+	}
+	// 期間が [From, To] と重なる旅行だけを対象とする。期間未定の旅行は日付で絞り込むと除外される
+	if from := query.From(); from != nil {
+		pgFrom, err := mapper.ToNullableDate(from)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to convert from date", apperr.WithCause(err))
+		}
+		q.where("end_date >= ?", pgFrom)
+	}
+	if to := query.To(); to != nil {
+		pgTo, err := mapper.ToNullableDate(to)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to convert to date", apperr.WithCause(err))
+		}
+		q.where("start_date <= ?", pgTo)
+	}
+
+	sql, args := q.build(sortColumn.expr, sortColumn.typ, query.Direction(), query.Page())
+	rows, err := r.GetDB(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch member trips from database", apperr.WithCause(err))
+	}
+	records, err := pgx.CollectRows(rows, pgx.RowToStructByPos[postgres.Trip])
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch member trips from database", apperr.WithCause(err))
 	}
 
 	trips := make([]*trip.Trip, 0, len(records))
 	for _, record := range records {
-		trip, err := r.mapToTrip(record)
+		t, err := r.mapToTrip(record)
 		if err != nil {
-			// ログに記録して続行（一つのエラーで全体を失敗させない）
-			// TODO: ロガーを追加してエラーをログに記録
-			continue
+			return nil, apperr.NewInternalError("Failed to map database record to trip domain object", apperr.WithCause(err))
 		}
-		trips = append(trips, trip)
+		trips = append(trips, t)
 	}
 
-	return trips, nil
+	return pagination.NewPage(trips, query.Page().Limit(), query.CursorOf), nil
 }
 
 // Create は新しいTripを作成する
@@ -95,11 +143,20 @@ func (r *TripPostgresRepository) Create(ctx context.Context, trip *trip.Trip) er
 		return apperr.NewInternalError("Failed to convert trip updated_at to timestamp", apperr.WithCause(err))
 	}
 
+	pgStartDate, pgEndDate, err := r.toPeriodDates(trip.Period())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip period to dates", apperr.WithCause(err))
+	}
+
 	params := postgres.CreateTripParams{
-		ID:        pgUUID,
-		Name:      trip.Name(),
-		CreatedAt: pgCreatedAt,
-		UpdatedAt: pgUpdatedAt,
+		ID:           pgUUID,
+		Name:         trip.Name(),
+		StartDate:    pgStartDate,
+		EndDate:      pgEndDate,
+		CreatedAt:    pgCreatedAt,
+		UpdatedAt:    pgUpdatedAt,
+		Version:      trip.Version(),
+		HomeCurrency: mapper.ToNullableText(trip.HomeCurrency()),
 	}
 
 	if err := queries.CreateTrip(ctx, params); err != nil {
@@ -109,7 +166,7 @@ func (r *TripPostgresRepository) Create(ctx context.Context, trip *trip.Trip) er
 	return nil
 }
 
-// Update は既存のTripを更新する
+// Update は既存のTripを更新する。保存済みのバージョンが trip.Version() と一致しない場合は更新しない
 func (r *TripPostgresRepository) Update(ctx context.Context, trip *trip.Trip) error {
 	if trip == nil {
 		return apperr.NewInternalError("Trip entity cannot be nil")
@@ -128,32 +185,130 @@ func (r *TripPostgresRepository) Update(ctx context.Context, trip *trip.Trip) er
 		return apperr.NewInternalError("Failed to convert trip updated_at to timestamp for update", apperr.WithCause(err))
 	}
 
+	pgStartDate, pgEndDate, err := r.toPeriodDates(trip.Period())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip period to dates for update", apperr.WithCause(err))
+	}
+
 	params := postgres.UpdateTripParams{
-		ID:        pgUUID,
-		Name:      trip.Name(),
-		UpdatedAt: pgUpdatedAt,
+		ID:           pgUUID,
+		Name:         trip.Name(),
+		StartDate:    pgStartDate,
+		EndDate:      pgEndDate,
+		UpdatedAt:    pgUpdatedAt,
+		Version:      trip.Version(),
+		HomeCurrency: mapper.ToNullableText(trip.HomeCurrency()),
 	}
 
-	if err := queries.UpdateTrip(ctx, params); err != nil {
+	rows, err := queries.UpdateTrip(ctx, params)
+	if err != nil {
 		return apperr.NewInternalError("Failed to update trip in database", apperr.WithCause(err))
 	}
 
+	if rows == 0 {
+		return r.notAffectedError(ctx, pgUUID)
+	}
+
 	return nil
 }
 
-// Delete は指定されたIDのTripを削除する
-func (r *TripPostgresRepository) Delete(ctx context.Context, id trip.TripID) error {
+// Trash は指定されたIDのTripをゴミ箱に移す。保存済みのバージョンが version と一致しない場合は移さない
+func (r *TripPostgresRepository) Trash(ctx context.Context, id trip.TripID, version int64, trashedAt time.Time) error {
 	queries := r.GetQueries(ctx)
 	mapper := r.GetTypeMapper()
 
 	pgUUID, err := mapper.ToUUID(id.String())
 	if err != nil {
-		return apperr.NewInternalError("Failed to convert trip ID to UUID for deletion", apperr.WithCause(err))
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for trashing", apperr.WithCause(err))
 	}
 
-	rows, err := queries.DeleteTrip(ctx, pgUUID)
+	pgTrashedAt, err := mapper.ToTimestamp(trashedAt)
 	if err != nil {
-		return apperr.NewInternalError("Failed to delete trip from database", apperr.WithCause(err))
+		return apperr.NewInternalError("Failed to convert trip trashed_at to timestamp", apperr.WithCause(err))
+	}
+
+	rows, err := queries.TrashTrip(ctx, postgres.TrashTripParams{
+		ID:        pgUUID,
+		Version:   version,
+		DeletedAt: pgTrashedAt,
+	})
+	if err != nil {
+		return apperr.NewInternalError("Failed to move trip to trash in database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return r.notAffectedError(ctx, pgUUID)
+	}
+
+	return nil
+}
+
+// FindTrashedByOwner は指定されたユーザーが所有者であるゴミ箱のTripを、ゴミ箱に移した日時の新しい順に取得する
+func (r *TripPostgresRepository) FindTrashedByOwner(ctx context.Context, userID user.UserID) ([]*trip.TrashedTrip, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUserID, err := mapper.ToUUID(userID.String())
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert user ID to UUID", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListTrashedTripsByOwner(ctx, pgUserID)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch trashed trips from database", apperr.WithCause(err))
+	}
+
+	trashed := make([]*trip.TrashedTrip, 0, len(records))
+	for _, record := range records {
+		t, err := r.mapToTrip(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map database record to trip domain object", apperr.WithCause(err))
+		}
+		trashedAt, err := mapper.FromTimestamp(record.DeletedAt)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map trip deleted_at", apperr.WithCause(err))
+		}
+		trashed = append(trashed, trip.NewTrashedTrip(t, trashedAt))
+	}
+
+	return trashed, nil
+}
+
+// Restore はゴミ箱にある指定されたIDのTripを元に戻す
+func (r *TripPostgresRepository) Restore(ctx context.Context, id trip.TripID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUUID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for restoring", apperr.WithCause(err))
+	}
+
+	rows, err := queries.RestoreTrip(ctx, pgUUID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to restore trip in database", apperr.WithCause(err))
+	}
+
+	if rows == 0 {
+		return trip.NewTripNotFoundError()
+	}
+
+	return nil
+}
+
+// Purge はゴミ箱にある指定されたIDのTripを削除する。関連する行は外部キーの ON DELETE CASCADE で削除される
+func (r *TripPostgresRepository) Purge(ctx context.Context, id trip.TripID) error {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgUUID, err := mapper.ToUUID(id.String())
+	if err != nil {
+		return apperr.NewInternalError("Failed to convert trip ID to UUID for purging", apperr.WithCause(err))
+	}
+
+	rows, err := queries.PurgeTrip(ctx, pgUUID)
+	if err != nil {
+		return apperr.NewInternalError("Failed to purge trip from database", apperr.WithCause(err))
 	}
 
 	if rows == 0 {
@@ -163,6 +318,45 @@ func (r *TripPostgresRepository) Delete(ctx context.Context, id trip.TripID) err
 	return nil
 }
 
+// FindTrashedBefore は before より前にゴミ箱に移されたTripのIDを、ゴミ箱に移した日時の古い順に取得する
+func (r *TripPostgresRepository) FindTrashedBefore(ctx context.Context, before time.Time) ([]trip.TripID, error) {
+	queries := r.GetQueries(ctx)
+	mapper := r.GetTypeMapper()
+
+	pgBefore, err := mapper.ToTimestamp(before)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to convert purge cutoff to timestamp", apperr.WithCause(err))
+	}
+
+	records, err := queries.ListTrashedTripIDsBefore(ctx, pgBefore)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to fetch expired trashed trips from database", apperr.WithCause(err))
+	}
+
+	ids := make([]trip.TripID, 0, len(records))
+	for _, record := range records {
+		id, err := mapper.FromUUID(record)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to map trip ID", apperr.WithCause(err))
+		}
+		ids = append(ids, trip.NewTripID(id))
+	}
+
+	return ids, nil
+}
+
+// notAffectedError はバージョンを条件とした更新・ゴミ箱への移動の対象行がなかった理由を調べ、
+// Tripが存在しなければ（ゴミ箱にある場合を含む）見つからないエラーを、存在すればバージョン不一致のエラーを返す
+func (r *TripPostgresRepository) notAffectedError(ctx context.Context, id pgtype.UUID) error {
+	if _, err := r.GetQueries(ctx).FindTrip(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return trip.NewTripNotFoundError()
+		}
+		return apperr.NewInternalError("Failed to fetch trip from database", apperr.WithCause(err))
+	}
+	return trip.NewTripVersionMismatchError()
+}
+
 // mapToTrip はデータベースレコードをドメインオブジェクトに変換する
 func (r *TripPostgresRepository) mapToTrip(record postgres.Trip) (*trip.Trip, error) {
 	mapper := r.GetTypeMapper()
@@ -182,10 +376,54 @@ func (r *TripPostgresRepository) mapToTrip(record postgres.Trip) (*trip.Trip, er
 		return nil, err
 	}
 
-	return trip.NewTrip(
+	period, err := r.fromPeriodDates(record.StartDate, record.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return trip.RestoreTrip(
 		trip.NewTripID(id),
 		record.Name,
+		period,
+		mapper.FromNullableText(record.HomeCurrency),
+		record.Version,
 		createdAt,
 		updatedAt,
 	), nil
 }
+
+// toPeriodDates は旅行期間を開始日・終了日のカラム値に変換する。期間未定の場合はどちらも NULL になる
+func (r *TripPostgresRepository) toPeriodDates(period *trip.Period) (pgtype.Date, pgtype.Date, error) {
+	if period == nil {
+		return pgtype.Date{}, pgtype.Date{}, nil
+	}
+
+	mapper := r.GetTypeMapper()
+
+	startDate := period.StartDate()
+	pgStartDate, err := mapper.ToNullableDate(&startDate)
+	if err != nil {
+		return pgtype.Date{}, pgtype.Date{}, err
+	}
+
+	endDate := period.EndDate()
+	pgEndDate, err := mapper.ToNullableDate(&endDate)
+	if err != nil {
+		return pgtype.Date{}, pgtype.Date{}, err
+	}
+
+	return pgStartDate, pgEndDate, nil
+}
+
+// fromPeriodDates は開始日・終了日のカラム値を旅行期間に変換する
+func (r *TripPostgresRepository) fromPeriodDates(pgStartDate, pgEndDate pgtype.Date) (*trip.Period, error) {
+	mapper := r.GetTypeMapper()
+
+	startDate := mapper.FromNullableDate(pgStartDate)
+	endDate := mapper.FromNullableDate(pgEndDate)
+	if startDate == nil || endDate == nil {
+		return nil, nil
+	}
+
+	return trip.NewPeriod(*startDate, *endDate)
+}
@@ -37,7 +37,7 @@ func newTestTrip(name string) testTrip {
 
 // toDomainTrip ドメインオブジェクトに変換する
 func (tt testTrip) toDomainTrip() *trip.Trip {
-	return trip.NewTrip(tt.ID, tt.Name, tt.CreatedAt, tt.UpdatedAt)
+	return trip.NewTrip(tt.ID, tt.Name, nil, "", tt.CreatedAt, tt.UpdatedAt)
 }
 
 // tripTestSuite テスト用の共通セットアップ
@@ -78,21 +78,22 @@ func newTripTestSuite(t *testing.T) *tripTestSuite {
 }
 
 // createTripInDB データベースに直接Tripを作成する
-func (s *tripTestSuite) createTripInDB(t *testing.T, trip testTrip) {
+func (s *tripTestSuite) createTripInDB(t *testing.T, tt testTrip) {
 	t.Helper()
 
-	pgUUID, err := s.mapper.ToUUID(trip.ID.String())
+	pgUUID, err := s.mapper.ToUUID(tt.ID.String())
 	require.NoError(t, err, "UUID変換に失敗")
-	pgCreatedAt, err := s.mapper.ToTimestamp(trip.CreatedAt)
+	pgCreatedAt, err := s.mapper.ToTimestamp(tt.CreatedAt)
 	require.NoError(t, err, "CreatedAt変換に失敗")
-	pgUpdatedAt, err := s.mapper.ToTimestamp(trip.UpdatedAt)
+	pgUpdatedAt, err := s.mapper.ToTimestamp(tt.UpdatedAt)
 	require.NoError(t, err, "UpdatedAt変換に失敗")
 
 	err = s.queries.CreateTrip(s.ctx, postgres.CreateTripParams{
 		ID:        pgUUID,
-		Name:      trip.Name,
+		Name:      tt.Name,
 		CreatedAt: pgCreatedAt,
 		UpdatedAt: pgUpdatedAt,
+		Version:   trip.InitialVersion,
 	})
 	require.NoError(t, err, "テストデータの作成に失敗")
 }
@@ -238,72 +239,57 @@ func TestTripPostgresRepository_FindByID(t *testing.T) {
 	})
 }
 
-func TestTripPostgresRepository_FindMany(t *testing.T) {
-	t.Run("Tripが存在しない場合空のリストが返されること", func(t *testing.T) {
+func TestTripPostgresRepository_Create(t *testing.T) {
+	t.Run("新しいTripを正常に作成できること", func(t *testing.T) {
 		suite := newTripTestSuite(t)
 
-		// Given: データベースが空（他のテストの影響を受けない）
+		// Given: 新しいTrip
+		testTrip := newTestTrip("新規旅行")
+		domainTrip := testTrip.toDomainTrip()
 
-		// When: FindManyでTripを取得する
-		foundTrips, err := suite.repo.FindMany(suite.ctx)
+		// When: Tripを作成する
+		err := suite.repo.Create(suite.ctx, domainTrip)
 
-		// Then: 空のリストが返される
-		require.NoError(t, err, "FindManyでエラーが発生してはならない")
-		assert.Empty(t, foundTrips, "空のリストが返されるべき")
+		// Then: Tripが正常に作成される
+		require.NoError(t, err, "Createでエラーが発生してはならない")
+		suite.assertTripExistsInDB(t, testTrip)
 	})
 
-	t.Run("複数のTripが存在する場合すべて取得できること", func(t *testing.T) {
+	t.Run("期間付きのTripを作成して取得できること", func(t *testing.T) {
 		suite := newTripTestSuite(t)
 
-		// Given: データベースに複数のTripが存在する
-		trip1 := newTestTrip("北海道旅行")
-		trip2 := newTestTrip("沖縄旅行")
-		trip3 := newTestTrip("京都旅行")
-		expectedTrips := []testTrip{trip1, trip2, trip3}
-
-		suite.createTripInDB(t, trip1)
-		suite.createTripInDB(t, trip2)
-		suite.createTripInDB(t, trip3)
-
-		// When: FindManyでTripを取得する
-		foundTrips, err := suite.repo.FindMany(suite.ctx)
+		// Given: 期間を持つTrip
+		testTrip := newTestTrip("期間付き旅行")
+		period, err := trip.NewPeriod(
+			time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, 8, 3, 0, 0, 0, 0, time.UTC),
+		)
+		require.NoError(t, err, "Periodの生成に失敗")
+		domainTrip := trip.NewTrip(testTrip.ID, testTrip.Name, period, "", testTrip.CreatedAt, testTrip.UpdatedAt)
+
+		// When: Tripを作成して取得する
+		require.NoError(t, suite.repo.Create(suite.ctx, domainTrip), "Createでエラーが発生してはならない")
+		foundTrip, err := suite.repo.FindByID(suite.ctx, testTrip.ID)
 
-		// Then: すべてのTripが取得される
-		require.NoError(t, err, "FindManyでエラーが発生してはならない")
-		assertTripsContainAll(t, expectedTrips, foundTrips)
+		// Then: 期間が保持されている
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assert.True(t, period.Equals(foundTrip.Period()), "Periodが一致すること")
 	})
 
-	t.Run("単一のTripが存在する場合正常に取得できること", func(t *testing.T) {
+	t.Run("基準通貨付きのTripを作成して取得できること", func(t *testing.T) {
 		suite := newTripTestSuite(t)
 
-		// Given: データベースに1つのTripが存在する（トランザクション分離により他のテストの影響なし）
-		trip := newTestTrip("単一旅行")
-		suite.createTripInDB(t, trip)
-		expectedTrips := []testTrip{trip}
+		// Given: 基準通貨を持つTrip
+		testTrip := newTestTrip("基準通貨付き旅行")
+		domainTrip := trip.NewTrip(testTrip.ID, testTrip.Name, nil, "JPY", testTrip.CreatedAt, testTrip.UpdatedAt)
 
-		// When: FindManyでTripを取得する
-		foundTrips, err := suite.repo.FindMany(suite.ctx)
-
-		// Then: 1つのTripが取得される
-		require.NoError(t, err, "FindManyでエラーが発生してはならない")
-		assertTripsContainAll(t, expectedTrips, foundTrips)
-	})
-}
-
-func TestTripPostgresRepository_Create(t *testing.T) {
-	t.Run("新しいTripを正常に作成できること", func(t *testing.T) {
-		suite := newTripTestSuite(t)
-
-		// Given: 新しいTrip
-		testTrip := newTestTrip("新規旅行")
-		domainTrip := testTrip.toDomainTrip()
-
-		// When: Tripを作成する
-		err := suite.repo.Create(suite.ctx, domainTrip)
+		// When: Tripを作成して取得する
+		require.NoError(t, suite.repo.Create(suite.ctx, domainTrip), "Createでエラーが発生してはならない")
+		foundTrip, err := suite.repo.FindByID(suite.ctx, testTrip.ID)
 
-		// Then: Tripが正常に作成される
-		require.NoError(t, err, "Createでエラーが発生してはならない")
-		suite.assertTripExistsInDB(t, testTrip)
+		// Then: 基準通貨が保持されている
+		require.NoError(t, err, "FindByIDでエラーが発生してはならない")
+		assert.Equal(t, "JPY", foundTrip.HomeCurrency(), "基準通貨が一致すること")
 	})
 
 	t.Run("nilのTripでInternalErrorが返されること", func(t *testing.T) {
@@ -413,9 +399,35 @@ func TestTripPostgresRepository_Update(t *testing.T) {
 		}
 		err := suite.repo.Update(suite.ctx, updatedTrip.toDomainTrip())
 
-		// Then: Tripが正常に更新される
+		// Then: Tripが正常に更新され、バージョンが 1 つ進む
 		require.NoError(t, err, "Updateでエラーが発生してはならない")
 		suite.assertTripExistsInDB(t, updatedTrip)
+
+		record, err := suite.getTripFromDB(t, originalTrip.ID)
+		require.NoError(t, err)
+		assert.Equal(t, trip.InitialVersion+1, record.Version, "バージョンが 1 つ進むこと")
+	})
+
+	t.Run("バージョンが一致しない場合はバージョン不一致エラーが返されること", func(t *testing.T) {
+		suite := newTripTestSuite(t)
+
+		// Given: 既存のTripと、古いバージョンを前提とした更新内容
+		originalTrip := newTestTrip("競合対象旅行")
+		suite.createTripInDB(t, originalTrip)
+		require.NoError(t, suite.repo.Update(suite.ctx, originalTrip.toDomainTrip()), "1回目の更新に失敗")
+
+		// When: 同じバージョンを前提として再度更新する
+		staleTrip := testTrip{
+			ID:        originalTrip.ID,
+			Name:      "上書きされてはいけない",
+			CreatedAt: originalTrip.CreatedAt,
+			UpdatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		}
+		err := suite.repo.Update(suite.ctx, staleTrip.toDomainTrip())
+
+		// Then: バージョン不一致エラーが返され、内容は変更されない
+		assert.ErrorIs(t, err, trip.NewTripVersionMismatchError(), "バージョン不一致エラーが返されるべき")
+		suite.assertTripExistsInDB(t, originalTrip)
 	})
 
 	t.Run("nilのTripでInternalErrorが返されること", func(t *testing.T) {
@@ -429,7 +441,7 @@ func TestTripPostgresRepository_Update(t *testing.T) {
 			"InternalErrorが返されるべき")
 	})
 
-	t.Run("存在しないIDでもエラーにならないこと", func(t *testing.T) {
+	t.Run("存在しないIDでErrTripNotFoundが返されること", func(t *testing.T) {
 		suite := newTripTestSuite(t)
 
 		// Given: 存在しないTrip
@@ -438,8 +450,8 @@ func TestTripPostgresRepository_Update(t *testing.T) {
 		// When: 存在しないTripを更新する
 		err := suite.repo.Update(suite.ctx, nonExistentTrip.toDomainTrip())
 
-		// Then: エラーにならない（PostgreSQLのUPDATEは影響行数0でもエラーにならない）
-		assert.NoError(t, err, "存在しないIDの更新はエラーにならないべき")
+		// Then: TripNotFoundが返される
+		assert.ErrorIs(t, err, trip.NewTripNotFoundError(), "TripNotFoundが返されるべき")
 	})
 
 	t.Run("不正なIDでInternalErrorが返されること", func(t *testing.T) {
@@ -483,22 +495,51 @@ func TestTripPostgresRepository_Update(t *testing.T) {
 		require.NoError(t, err, "名前の更新でエラーが発生してはならない")
 		suite.assertTripExistsInDB(t, updatedTrip)
 	})
+
+	t.Run("基準通貨を設定・解除できること", func(t *testing.T) {
+		suite := newTripTestSuite(t)
+
+		// Given: 基準通貨が未設定の既存のTrip
+		originalTrip := newTestTrip("基準通貨変更")
+		suite.createTripInDB(t, originalTrip)
+		current, err := suite.repo.FindByID(suite.ctx, originalTrip.ID)
+		require.NoError(t, err)
+
+		// When: 基準通貨を設定する
+		require.NoError(t, suite.repo.Update(suite.ctx, current.Update(current.Name(), nil, "EUR", time.Now())), "基準通貨の設定でエラーが発生してはならない")
+
+		// Then: 基準通貨が保存される
+		current, err = suite.repo.FindByID(suite.ctx, originalTrip.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "EUR", current.HomeCurrency(), "基準通貨が保存されること")
+
+		// When: 基準通貨を解除する
+		require.NoError(t, suite.repo.Update(suite.ctx, current.Update(current.Name(), nil, "", time.Now())), "基準通貨の解除でエラーが発生してはならない")
+
+		// Then: 基準通貨が未設定に戻る
+		record, err := suite.getTripFromDB(t, originalTrip.ID)
+		require.NoError(t, err)
+		assert.False(t, record.HomeCurrency.Valid, "基準通貨が NULL に戻ること")
+	})
 }
 
-func TestTripPostgresRepository_Delete(t *testing.T) {
-	t.Run("存在するIDのTripを正常に削除できること", func(t *testing.T) {
+func TestTripPostgresRepository_Trash(t *testing.T) {
+	t.Run("存在するIDのTripをゴミ箱に移せること", func(t *testing.T) {
 		suite := newTripTestSuite(t)
 
 		// Given: 既存のTrip
-		existingTrip := newTestTrip("削除対象旅行")
+		existingTrip := newTestTrip("ゴミ箱対象旅行")
 		suite.createTripInDB(t, existingTrip)
 
-		// When: Tripを削除する
-		err := suite.repo.Delete(suite.ctx, existingTrip.ID)
+		// When: Tripをゴミ箱に移す
+		err := suite.repo.Trash(suite.ctx, existingTrip.ID, trip.InitialVersion, time.Now())
 
-		// Then: Tripが正常に削除される
-		require.NoError(t, err, "Deleteでエラーが発生してはならない")
+		// Then: Tripは通常の取得の対象にならなくなる
+		require.NoError(t, err, "Trashでエラーが発生してはならない")
 		suite.assertTripNotExistsInDB(t, existingTrip.ID)
+
+		_, err = suite.repo.FindByID(suite.ctx, existingTrip.ID)
+		assert.ErrorIs(t, err, trip.NewTripNotFoundError(), "ゴミ箱のTripはFindByIDで取得できないこと")
 	})
 
 	t.Run("存在しないIDでErrTripNotFoundが返されること", func(t *testing.T) {
@@ -507,8 +548,8 @@ func TestTripPostgresRepository_Delete(t *testing.T) {
 		// Given: 存在しないID
 		nonExistentID := trip.NewTripID(uuid.New().String())
 
-		// When: 存在しないIDでTripを削除する
-		err := suite.repo.Delete(suite.ctx, nonExistentID)
+		// When: 存在しないIDでTripをゴミ箱に移す
+		err := suite.repo.Trash(suite.ctx, nonExistentID, trip.InitialVersion, time.Now())
 
 		// Then: TripNotFoundが返される
 		assert.ErrorIs(t, err, trip.NewTripNotFoundError(),
@@ -521,28 +562,30 @@ func TestTripPostgresRepository_Delete(t *testing.T) {
 		// Given: 不正な形式のID
 		invalidID := trip.NewTripID("invalid-uuid-format")
 
-		// When: 不正なIDでTripを削除する
-		err := suite.repo.Delete(suite.ctx, invalidID)
+		// When: 不正なIDでTripをゴミ箱に移す
+		err := suite.repo.Trash(suite.ctx, invalidID, trip.InitialVersion, time.Now())
 
 		// Then: InternalErrorが返される
 		assert.ErrorIs(t, err, apperr.NewInternalError(""),
 			"InternalErrorが返されるべき")
 	})
 
-	t.Run("空文字列のIDでInternalErrorが返されること", func(t *testing.T) {
+	t.Run("バージョンが一致しない場合はバージョン不一致エラーが返されること", func(t *testing.T) {
 		suite := newTripTestSuite(t)
 
-		// Given: 空文字列のID
-		emptyID := trip.NewTripID("")
+		// Given: 既存のTrip
+		existingTrip := newTestTrip("削除競合旅行")
+		suite.createTripInDB(t, existingTrip)
 
-		// When: 空のIDでTripを削除する
-		err := suite.repo.Delete(suite.ctx, emptyID)
+		// When: 古いバージョンを前提としてゴミ箱に移す
+		err := suite.repo.Trash(suite.ctx, existingTrip.ID, trip.InitialVersion+1, time.Now())
 
-		// Then: InternalErrorが返される
-		assert.ErrorIs(t, err, apperr.NewInternalError(""), "InternalErrorが返されるべき")
+		// Then: バージョン不一致エラーが返され、Tripはゴミ箱に移されない
+		assert.ErrorIs(t, err, trip.NewTripVersionMismatchError(), "バージョン不一致エラーが返されるべき")
+		suite.assertTripExistsInDB(t, existingTrip)
 	})
 
-	t.Run("複数のTripが存在する場合指定したもののみ削除されること", func(t *testing.T) {
+	t.Run("複数のTripが存在する場合指定したもののみゴミ箱に移されること", func(t *testing.T) {
 		suite := newTripTestSuite(t)
 
 		// Given: 複数のTripが存在する
@@ -554,33 +597,121 @@ func TestTripPostgresRepository_Delete(t *testing.T) {
 		suite.createTripInDB(t, trip2)
 		suite.createTripInDB(t, trip3)
 
-		// When: 1つのTripを削除する
-		err := suite.repo.Delete(suite.ctx, trip2.ID)
+		// When: 1つのTripをゴミ箱に移す
+		err := suite.repo.Trash(suite.ctx, trip2.ID, trip.InitialVersion, time.Now())
 
-		// Then: 指定したTripのみが削除される
-		require.NoError(t, err, "Deleteでエラーが発生してはならない")
+		// Then: 指定したTripのみがゴミ箱に移される
+		require.NoError(t, err, "Trashでエラーが発生してはならない")
 		suite.assertTripExistsInDB(t, trip1)
 		suite.assertTripNotExistsInDB(t, trip2.ID)
 		suite.assertTripExistsInDB(t, trip3)
 	})
 
-	t.Run("同じIDを複数回削除した場合2回目はErrTripNotFoundが返されること", func(t *testing.T) {
+	t.Run("ゴミ箱にあるTripを再度ゴミ箱に移そうとするとErrTripNotFoundが返されること", func(t *testing.T) {
 		suite := newTripTestSuite(t)
 
-		// Given: 既存のTrip
+		// Given: ゴミ箱にあるTrip
 		existingTrip := newTestTrip("重複削除対象")
 		suite.createTripInDB(t, existingTrip)
+		require.NoError(t, suite.repo.Trash(suite.ctx, existingTrip.ID, trip.InitialVersion, time.Now()))
 
-		// When: 同じIDを2回削除する
-		err1 := suite.repo.Delete(suite.ctx, existingTrip.ID)
-		err2 := suite.repo.Delete(suite.ctx, existingTrip.ID)
+		// When: 同じTripを再度ゴミ箱に移す
+		err := suite.repo.Trash(suite.ctx, existingTrip.ID, trip.InitialVersion+1, time.Now())
 
-		// Then: 1回目は正常に削除される
-		assert.NoError(t, err1, "1回目の削除でエラーが発生してはならない")
+		// Then: TripNotFoundが返される
+		assert.ErrorIs(t, err, trip.NewTripNotFoundError(), "2回目はTripNotFoundが返されるべき")
+	})
+}
 
-		// 2回目はTripNotFoundが返される
-		assert.ErrorIs(t, err2, trip.NewTripNotFoundError(), "2回目はTripNotFoundが返されるべき")
+func TestTripPostgresRepository_Restore(t *testing.T) {
+	t.Run("ゴミ箱にあるTripを元に戻せること", func(t *testing.T) {
+		suite := newTripTestSuite(t)
 
-		suite.assertTripNotExistsInDB(t, existingTrip.ID)
+		// Given: ゴミ箱にあるTrip
+		existingTrip := newTestTrip("復元対象旅行")
+		suite.createTripInDB(t, existingTrip)
+		require.NoError(t, suite.repo.Trash(suite.ctx, existingTrip.ID, trip.InitialVersion, time.Now()))
+
+		// When: Tripを元に戻す
+		err := suite.repo.Restore(suite.ctx, existingTrip.ID)
+
+		// Then: Tripが再び取得でき、バージョンが進んでいる
+		require.NoError(t, err, "Restoreでエラーが発生してはならない")
+		restored, err := suite.repo.FindByID(suite.ctx, existingTrip.ID)
+		require.NoError(t, err, "復元したTripを取得できること")
+		assertTripEquals(t, existingTrip, restored)
+		assert.Equal(t, trip.InitialVersion+2, restored.Version(), "ゴミ箱への移動と復元でバージョンが進むこと")
+	})
+
+	t.Run("ゴミ箱にないTripではErrTripNotFoundが返されること", func(t *testing.T) {
+		suite := newTripTestSuite(t)
+
+		// Given: ゴミ箱に移していないTrip
+		existingTrip := newTestTrip("通常の旅行")
+		suite.createTripInDB(t, existingTrip)
+
+		// When: Tripを元に戻す
+		err := suite.repo.Restore(suite.ctx, existingTrip.ID)
+
+		// Then: TripNotFoundが返される
+		assert.ErrorIs(t, err, trip.NewTripNotFoundError(), "TripNotFoundが返されるべき")
+	})
+}
+
+func TestTripPostgresRepository_Purge(t *testing.T) {
+	t.Run("ゴミ箱にあるTripを完全に削除できること", func(t *testing.T) {
+		suite := newTripTestSuite(t)
+
+		// Given: ゴミ箱にあるTrip
+		existingTrip := newTestTrip("完全削除対象旅行")
+		suite.createTripInDB(t, existingTrip)
+		require.NoError(t, suite.repo.Trash(suite.ctx, existingTrip.ID, trip.InitialVersion, time.Now()))
+
+		// When: Tripを完全に削除する
+		err := suite.repo.Purge(suite.ctx, existingTrip.ID)
+
+		// Then: Tripは復元もできなくなる
+		require.NoError(t, err, "Purgeでエラーが発生してはならない")
+		assert.ErrorIs(t, suite.repo.Restore(suite.ctx, existingTrip.ID), trip.NewTripNotFoundError(),
+			"完全に削除したTripは復元できないこと")
+	})
+
+	t.Run("ゴミ箱にないTripではErrTripNotFoundが返され削除されないこと", func(t *testing.T) {
+		suite := newTripTestSuite(t)
+
+		// Given: ゴミ箱に移していないTrip
+		existingTrip := newTestTrip("通常の旅行")
+		suite.createTripInDB(t, existingTrip)
+
+		// When: Tripを完全に削除する
+		err := suite.repo.Purge(suite.ctx, existingTrip.ID)
+
+		// Then: TripNotFoundが返され、Tripは残っている
+		assert.ErrorIs(t, err, trip.NewTripNotFoundError(), "TripNotFoundが返されるべき")
+		suite.assertTripExistsInDB(t, existingTrip)
+	})
+}
+
+func TestTripPostgresRepository_FindTrashedBefore(t *testing.T) {
+	t.Run("指定日時より前にゴミ箱に移したTripのみ取得されること", func(t *testing.T) {
+		suite := newTripTestSuite(t)
+
+		// Given: 古くゴミ箱に移したTrip、最近ゴミ箱に移したTrip、通常のTrip
+		now := time.Now().UTC()
+		expired := newTestTrip("期限切れ旅行")
+		recent := newTestTrip("最近の旅行")
+		active := newTestTrip("通常の旅行")
+		suite.createTripInDB(t, expired)
+		suite.createTripInDB(t, recent)
+		suite.createTripInDB(t, active)
+		require.NoError(t, suite.repo.Trash(suite.ctx, expired.ID, trip.InitialVersion, now.AddDate(0, 0, -31)))
+		require.NoError(t, suite.repo.Trash(suite.ctx, recent.ID, trip.InitialVersion, now.AddDate(0, 0, -1)))
+
+		// When: 30日より前にゴミ箱に移したTripを取得する
+		ids, err := suite.repo.FindTrashedBefore(suite.ctx, trip.TrashRetentionCutoff(now, 30))
+
+		// Then: 期限切れのTripのIDのみが返される
+		require.NoError(t, err, "FindTrashedBeforeでエラーが発生してはならない")
+		assert.Equal(t, []trip.TripID{expired.ID}, ids, "期限切れのTripのみが返されること")
 	})
 }
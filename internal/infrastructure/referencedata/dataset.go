@@ -0,0 +1,155 @@
+// Package referencedata はバイナリに埋め込んだ空港・国・都市の参照データを提供する。
+//
+// データは data ディレクトリの CSV ファイルで、先頭行は列名。
+//   - countries.csv: ISO 3166-1 の国コード（alpha-2, alpha-3）、名前、ISO 4217 の通貨コード
+//   - airports.csv: IATA・ICAO の空港コード、名前、都市名、国コード、緯度・経度、IANA のタイムゾーン
+//   - cities.csv: UN/LOCODE、名前、国コード、緯度・経度、IANA のタイムゾーン
+package referencedata
+
+import (
+	"embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strconv"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/reference"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:embed data/*.csv
+var embedded embed.FS
+
+// Dataset は埋め込んだ CSV ファイルから参照データを読み込む
+type Dataset struct {
+	files fs.FS
+}
+
+func NewDataset() service.ReferenceDataset {
+	return &Dataset{files: embedded}
+}
+
+// Load は国・空港・都市の順に CSV ファイルを読み込む。
+// 埋め込んだデータに誤りがある場合は、ファイル名と行番号を含む内部エラーを返す
+func (d *Dataset) Load() (*service.ReferenceData, error) {
+	data := &service.ReferenceData{}
+	countryCodes := make(map[string]bool)
+
+	err := d.readCSV("data/countries.csv", func(record []string) error {
+		country, err := reference.NewCountry(record[0], record[1], record[2], record[3])
+		if err != nil {
+			return err
+		}
+		if countryCodes[country.Code()] {
+			return fmt.Errorf("duplicate country code %s", country.Code())
+		}
+		countryCodes[country.Code()] = true
+		data.Countries = append(data.Countries, country)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	iataCodes := make(map[string]bool)
+	err = d.readCSV("data/airports.csv", func(record []string) error {
+		coordinate, timezone, err := parseLocation(record[5], record[6], record[7])
+		if err != nil {
+			return err
+		}
+		airport, err := reference.NewAirport(record[0], record[1], record[2], record[3], record[4], coordinate, timezone)
+		if err != nil {
+			return err
+		}
+		if !countryCodes[airport.CountryCode()] {
+			return fmt.Errorf("unknown country code %s", airport.CountryCode())
+		}
+		if iataCodes[airport.IATACode()] {
+			return fmt.Errorf("duplicate IATA code %s", airport.IATACode())
+		}
+		iataCodes[airport.IATACode()] = true
+		data.Airports = append(data.Airports, airport)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cityCodes := make(map[string]bool)
+	err = d.readCSV("data/cities.csv", func(record []string) error {
+		coordinate, timezone, err := parseLocation(record[3], record[4], record[5])
+		if err != nil {
+			return err
+		}
+		city, err := reference.NewCity(record[0], record[1], record[2], coordinate, timezone)
+		if err != nil {
+			return err
+		}
+		if !countryCodes[city.CountryCode()] {
+			return fmt.Errorf("unknown country code %s", city.CountryCode())
+		}
+		if cityCodes[city.Code()] {
+			return fmt.Errorf("duplicate UN/LOCODE %s", city.Code())
+		}
+		cityCodes[city.Code()] = true
+		data.Cities = append(data.Cities, city)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// readCSV は先頭行の列名を読み飛ばし、残りの行を 1 行ずつ handle に渡す。列の数は先頭行とそろっていなければならない
+func (d *Dataset) readCSV(name string, handle func(record []string) error) error {
+	file, err := d.files.Open(name)
+	if err != nil {
+		return apperr.NewInternalError("Failed to open reference dataset", apperr.WithCause(err))
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	if _, err := reader.Read(); err != nil {
+		return apperr.NewInternalError(fmt.Sprintf("Failed to read header of %s", name), apperr.WithCause(err))
+	}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return apperr.NewInternalError(fmt.Sprintf("Failed to read %s", name), apperr.WithCause(err))
+		}
+		if err := handle(record); err != nil {
+			line, _ := reader.FieldPos(0)
+			return apperr.NewInternalError(fmt.Sprintf("Invalid reference data at %s:%d", name, line), apperr.WithCause(err))
+		}
+	}
+}
+
+// parseLocation は緯度・経度とタイムゾーン名の列を解析する
+func parseLocation(latitude, longitude, timezone string) (geo.Coordinate, geo.Timezone, error) {
+	lat, err := strconv.ParseFloat(latitude, 64)
+	if err != nil {
+		return geo.Coordinate{}, geo.Timezone{}, err
+	}
+	lng, err := strconv.ParseFloat(longitude, 64)
+	if err != nil {
+		return geo.Coordinate{}, geo.Timezone{}, err
+	}
+	coordinate, err := geo.NewCoordinate(lat, lng)
+	if err != nil {
+		return geo.Coordinate{}, geo.Timezone{}, err
+	}
+	tz, err := geo.NewTimezone(timezone)
+	if err != nil {
+		return geo.Coordinate{}, geo.Timezone{}, err
+	}
+	return coordinate, tz, nil
+}
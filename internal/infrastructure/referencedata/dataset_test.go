@@ -0,0 +1,58 @@
+package referencedata
+
+import (
+	"testing"
+	"testing/fstest"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataset_Load(t *testing.T) {
+	t.Run("正常系: 埋め込んだデータをすべて読み込める", func(t *testing.T) {
+		data, err := NewDataset().Load()
+
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(data.Countries), 240)
+		assert.GreaterOrEqual(t, len(data.Airports), 100)
+		assert.GreaterOrEqual(t, len(data.Cities), 100)
+
+		var haneda bool
+		for _, a := range data.Airports {
+			if a.IATACode() == "HND" {
+				haneda = true
+				assert.Equal(t, "RJTT", a.ICAOCode())
+				assert.Equal(t, "JP", a.CountryCode())
+				assert.Equal(t, "Asia/Tokyo", a.Timezone().Name())
+			}
+		}
+		assert.True(t, haneda, "羽田空港が含まれるべき")
+	})
+
+	countries := "code,alpha3,name,currency\nJP,JPN,Japan,JPY\n"
+	cities := "code,name,country,latitude,longitude,timezone\nJPTYO,Tokyo,JP,35.6895,139.6917,Asia/Tokyo\n"
+	tests := []struct {
+		name     string
+		airports string
+	}{
+		{name: "国コードが国の一覧にない", airports: "HNL,PHNL,Daniel K. Inouye International Airport,Honolulu,US,21.3187,-157.9225,Pacific/Honolulu\n"},
+		{name: "IATA コードが重複している", airports: "HND,RJTT,Haneda Airport,Tokyo,JP,35.5523,139.7798,Asia/Tokyo\nHND,RJTT,Haneda Airport,Tokyo,JP,35.5523,139.7798,Asia/Tokyo\n"},
+		{name: "タイムゾーンが不正", airports: "HND,RJTT,Haneda Airport,Tokyo,JP,35.5523,139.7798,Asia/Edo\n"},
+		{name: "緯度が数値でない", airports: "HND,RJTT,Haneda Airport,Tokyo,JP,north,139.7798,Asia/Tokyo\n"},
+		{name: "列が足りない", airports: "HND,RJTT,Haneda Airport\n"},
+	}
+	for _, tt := range tests {
+		t.Run("異常系: "+tt.name, func(t *testing.T) {
+			dataset := &Dataset{files: fstest.MapFS{
+				"data/countries.csv": {Data: []byte(countries)},
+				"data/airports.csv":  {Data: []byte("iata,icao,name,city,country,latitude,longitude,timezone\n" + tt.airports)},
+				"data/cities.csv":    {Data: []byte(cities)},
+			}}
+
+			_, err := dataset.Load()
+
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeInternalError))
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/infrastructure/di"
+)
+
+func SetupAdminRoutes(group *gin.RouterGroup, container *di.Container) {
+	exchangeRateHandler := container.AdminExchangeRateHandler()
+	exchangeRateHandler.RegisterAPI(group)
+}
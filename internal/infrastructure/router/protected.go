@@ -8,4 +8,76 @@ import (
 func SetupProtectedRoutes(group *gin.RouterGroup, container *di.Container) {
 	tripHandler := container.TripHandler()
 	tripHandler.RegisterAPI(group)
+
+	accommodationHandler := container.AccommodationHandler()
+	accommodationHandler.RegisterAPI(group)
+
+	expenseHandler := container.ExpenseHandler()
+	expenseHandler.RegisterAPI(group)
+
+	budgetHandler := container.BudgetHandler()
+	budgetHandler.RegisterAPI(group)
+
+	settlementHandler := container.SettlementHandler()
+	settlementHandler.RegisterAPI(group)
+
+	memberHandler := container.MemberHandler()
+	memberHandler.RegisterAPI(group)
+
+	invitationHandler := container.InvitationHandler()
+	invitationHandler.RegisterAPI(group)
+
+	shareLinkHandler := container.ShareLinkHandler()
+	shareLinkHandler.RegisterAPI(group)
+
+	searchHandler := container.SearchHandler()
+	searchHandler.RegisterAPI(group)
+
+	trashHandler := container.TrashHandler()
+	trashHandler.RegisterAPI(group)
+
+	historyHandler := container.HistoryHandler()
+	historyHandler.RegisterAPI(group)
+
+	templateHandler := container.TemplateHandler()
+	templateHandler.RegisterAPI(group)
+
+	checklistHandler := container.ChecklistHandler()
+	checklistHandler.RegisterAPI(group)
+
+	itemTemplateHandler := container.ItemTemplateHandler()
+	itemTemplateHandler.RegisterAPI(group)
+
+	journalHandler := container.JournalHandler()
+	journalHandler.RegisterAPI(group)
+
+	attachmentHandler := container.AttachmentHandler()
+	attachmentHandler.RegisterAPI(group)
+
+	photoHandler := container.PhotoHandler()
+	photoHandler.RegisterAPI(group)
+
+	calendarHandler := container.CalendarHandler()
+	calendarHandler.RegisterAPI(group)
+
+	bookingImportHandler := container.BookingImportHandler()
+	bookingImportHandler.RegisterAPI(group)
+
+	routeHandler := container.RouteHandler()
+	routeHandler.RegisterAPI(group)
+
+	trackHandler := container.TrackHandler()
+	trackHandler.RegisterAPI(group)
+
+	itineraryHandler := container.ItineraryHandler()
+	itineraryHandler.RegisterAPI(group)
+
+	transportHandler := container.TransportHandler()
+	transportHandler.RegisterAPI(group)
+
+	referenceHandler := container.ReferenceHandler()
+	referenceHandler.RegisterAPI(group)
+
+	timelineHandler := container.TimelineHandler()
+	timelineHandler.RegisterAPI(group)
 }
@@ -1,11 +1,26 @@
 package router
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"github.com/hata0/travel-api/internal/adapter/middleware"
 	"github.com/hata0/travel-api/internal/infrastructure/di"
 )
 
 func SetupPublicRoutes(group *gin.RouterGroup, container *di.Container) {
 	authHandler := container.AuthHandler()
 	authHandler.RegisterAPI(group)
+
+	// 共有リンクのパスワードの総当たりを防ぐため、認証付きのAPIより厳しく制限する
+	shared := group.Group("/")
+	shared.Use(middleware.RateLimitMiddleware(30, time.Minute))
+	publicShareLinkHandler := container.PublicShareLinkHandler()
+	publicShareLinkHandler.RegisterAPI(shared)
+
+	// カレンダーアプリの取得元は多くのユーザーで同じ IP アドレスになりうるため、認証付きのAPIと同じ制限にとどめる
+	feeds := group.Group("/")
+	feeds.Use(middleware.RateLimitMiddleware(100, time.Minute))
+	publicCalendarHandler := container.PublicCalendarHandler()
+	publicCalendarHandler.RegisterAPI(feeds)
 }
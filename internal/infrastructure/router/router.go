@@ -32,6 +32,10 @@ func SetupRouter(cfg config.Config, container *di.Container, logger *slog.Logger
 	public := v1.Group("/public")
 	SetupPublicRoutes(public, container)
 
+	admin := v1.Group("/admin")
+	admin.Use(middleware.AdminAPIKeyMiddleware(cfg.Admin().APIKey()))
+	SetupAdminRoutes(admin, container)
+
 	protected := v1.Group("/")
 	protected.Use(middleware.RateLimitMiddleware(100, time.Minute))
 	protected.Use(middleware.AuthMiddleware(cfg.JWT().Secret()))
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+type IDServiceImpl struct{}
+
+func NewIDService() service.IDService {
+	return &IDServiceImpl{}
+}
+
+// Generate は UUID v4 の ID を生成する
+func (s *IDServiceImpl) Generate() string {
+	return uuid.New().String()
+}
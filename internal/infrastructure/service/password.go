@@ -0,0 +1,32 @@
+package service
+
+import (
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/service"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type PasswordServiceImpl struct {
+	cost int
+}
+
+// NewPasswordService は bcrypt のコスト cost でパスワードをハッシュ化するサービスを作成する
+func NewPasswordService(cost int) service.PasswordService {
+	return &PasswordServiceImpl{
+		cost: cost,
+	}
+}
+
+// HashPassword はユーザーや共有リンクのパスワードをbcryptでハッシュ化する
+func (s *PasswordServiceImpl) HashPassword(password string) ([]byte, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), s.cost)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to hash password", apperr.WithCause(err))
+	}
+	return hash, nil
+}
+
+// VerifyPassword はパスワードがハッシュ値と一致するかを返す
+func (s *PasswordServiceImpl) VerifyPassword(hash []byte, password string) bool {
+	return bcrypt.CompareHashAndPassword(hash, []byte(password)) == nil
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// shareLinkTokenBytes は共有リンクのトークンに使う乱数のバイト数
+const shareLinkTokenBytes = 32
+
+type ShareLinkSecretServiceImpl struct{}
+
+func NewShareLinkSecretService() service.ShareLinkSecretService {
+	return &ShareLinkSecretServiceImpl{}
+}
+
+// GenerateToken はURLにそのまま含められる共有リンクのトークンを生成する
+func (s *ShareLinkSecretServiceImpl) GenerateToken() (string, error) {
+	b := make([]byte, shareLinkTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", apperr.NewInternalError("Failed to generate random bytes for share link token", apperr.WithCause(err))
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
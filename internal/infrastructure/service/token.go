@@ -1,14 +1,13 @@
 package service
 
 import (
-	"crypto/ecdsa"
 	"crypto/rand"
 	"encoding/base64"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/hata0/travel-api/internal/domain"
 	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/user"
 	"github.com/hata0/travel-api/internal/usecase/service"
 )
 
@@ -17,8 +16,15 @@ type TokenSettings struct {
 	Issuer                string
 	AccessTokenExpiration time.Duration
 	JTIBytes              int
-	ECDSAPrivateKey       *ecdsa.PrivateKey
-	RefreshTokenBytes     int
+	// Secret は認証ミドルウェアと共有する HMAC の署名鍵
+	Secret            []byte
+	RefreshTokenBytes int
+}
+
+// accessTokenClaims はアクセストークンのクレーム。認証ミドルウェアは user_id からユーザーを特定する
+type accessTokenClaims struct {
+	UserID string `json:"user_id"`
+	jwt.RegisteredClaims
 }
 
 type TokenServiceImpl struct {
@@ -34,7 +40,7 @@ func NewTokenService(timeService service.TimeService, settings *TokenSettings) s
 }
 
 // GenerateAccessToken はアクセストークンを生成する
-func (t *TokenServiceImpl) GenerateAccessToken(userID domain.UserID) (string, error) {
+func (t *TokenServiceImpl) GenerateAccessToken(userID user.UserID) (string, error) {
 	now := t.timeService.Now()
 
 	jti, err := t.generateJTI()
@@ -42,18 +48,21 @@ func (t *TokenServiceImpl) GenerateAccessToken(userID domain.UserID) (string, er
 		return "", apperr.NewInternalError("Failed to generate JTI for access token", apperr.WithCause(err))
 	}
 
-	claims := jwt.RegisteredClaims{
-		Subject:   userID.String(),
-		Audience:  jwt.ClaimStrings{t.settings.Audience},
-		Issuer:    t.settings.Issuer,
-		IssuedAt:  jwt.NewNumericDate(now),
-		NotBefore: jwt.NewNumericDate(now),
-		ExpiresAt: jwt.NewNumericDate(now.Add(t.settings.AccessTokenExpiration)),
-		ID:        jti,
+	claims := accessTokenClaims{
+		UserID: userID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID.String(),
+			Audience:  jwt.ClaimStrings{t.settings.Audience},
+			Issuer:    t.settings.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(t.settings.AccessTokenExpiration)),
+			ID:        jti,
+		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
-	signed, err := token.SignedString(t.settings.ECDSAPrivateKey)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(t.settings.Secret)
 	if err != nil {
 		return "", apperr.NewInternalError("Failed to sign access token", apperr.WithCause(err))
 	}
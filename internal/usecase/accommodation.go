@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/accommodation.go github.com/hata0/travel-api/internal/usecase AccommodationUsecase
+type AccommodationUsecase interface {
+	Get(ctx context.Context, tripID, id string) (*output.GetAccommodationOutput, error)
+	List(ctx context.Context, tripID string) (*output.ListAccommodationOutput, error)
+	Create(ctx context.Context, in input.CreateAccommodationInput) (*output.CreateAccommodationOutput, error)
+	Update(ctx context.Context, in input.UpdateAccommodationInput) error
+	Delete(ctx context.Context, tripID, id string) error
+}
+
+type AccommodationInteractor struct {
+	accommodationRepository accommodation.AccommodationRepository
+	tripRepository          trip.TripRepository
+	authorizer              tripAuthorizer
+	history                 historyRecorder
+	attachments             attachmentCleaner
+	transactionManager      transaction_manager.TransactionManager
+	timeService             service.TimeService
+	idService               service.IDService
+}
+
+func NewAccommodationInteractor(
+	accommodationRepository accommodation.AccommodationRepository,
+	tripRepository trip.TripRepository,
+	memberRepository membership.MemberRepository,
+	historyRepository history.HistoryRepository,
+	attachmentRepository attachment.AttachmentRepository,
+	blobStore service.BlobStore,
+	transactionManager transaction_manager.TransactionManager,
+	timeService service.TimeService,
+	idService service.IDService,
+) AccommodationUsecase {
+	return &AccommodationInteractor{
+		accommodationRepository: accommodationRepository,
+		tripRepository:          tripRepository,
+		authorizer:              newTripAuthorizer(memberRepository),
+		history:                 newHistoryRecorder(historyRepository),
+		attachments:             newAttachmentCleaner(attachmentRepository, blobStore),
+		transactionManager:      transactionManager,
+		timeService:             timeService,
+		idService:               idService,
+	}
+}
+
+// Get は旅行に紐づく指定されたIDの宿泊予約を取得する
+func (i *AccommodationInteractor) Get(ctx context.Context, tripID, id string) (*output.GetAccommodationOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	a, err := i.findInTrip(ctx, trip.NewTripID(tripID), accommodation.NewAccommodationID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetAccommodationOutput(a), nil
+}
+
+// List は旅行に紐づく宿泊予約と、宿泊予約のない夜の一覧を取得する
+func (i *AccommodationInteractor) List(ctx context.Context, tripID string) (*output.ListAccommodationOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(tripID))
+	if err != nil {
+		return nil, err
+	}
+
+	accommodations, err := i.accommodationRepository.FindByTripID(ctx, t.ID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list accommodations", apperr.WithCause(err))
+	}
+
+	uncoveredNights := accommodation.FindUncoveredNights(t.Period(), accommodations)
+
+	return output.NewListAccommodationOutput(accommodations, uncoveredNights), nil
+}
+
+// Create は旅行に新しい宿泊予約を追加する
+func (i *AccommodationInteractor) Create(ctx context.Context, in input.CreateAccommodationInput) (*output.CreateAccommodationOutput, error) {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	stay, err := accommodation.NewStay(in.CheckInAt, in.CheckOutAt)
+	if err != nil {
+		return nil, err
+	}
+
+	cost, err := money.NewMoney(in.CostAmount, in.CostCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone, err := newOptionalTimezone(in.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(in.TripID))
+	if err != nil {
+		return nil, err
+	}
+
+	newID := i.idService.Generate()
+	now := i.timeService.Now()
+
+	accommodationID := accommodation.NewAccommodationID(newID)
+
+	a := accommodation.NewAccommodation(
+		accommodationID,
+		t.ID(),
+		in.Name,
+		in.Address,
+		timezone,
+		stay,
+		in.ConfirmationNumber,
+		cost,
+		in.Notes,
+		now,
+		now,
+	)
+
+	if err := a.ValidateFor(t); err != nil {
+		return nil, err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.accommodationRepository.Create(txCtx, a); err != nil {
+			return err
+		}
+		return i.history.recordCreated(txCtx, t.ID(), m.UserID(), now, a)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to create accommodation", apperr.WithCause(err))
+	}
+
+	return output.NewCreateAccommodationOutput(accommodationID), nil
+}
+
+// Update は既存の宿泊予約を更新する
+func (i *AccommodationInteractor) Update(ctx context.Context, in input.UpdateAccommodationInput) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	stay, err := accommodation.NewStay(in.CheckInAt, in.CheckOutAt)
+	if err != nil {
+		return err
+	}
+
+	cost, err := money.NewMoney(in.CostAmount, in.CostCurrency)
+	if err != nil {
+		return err
+	}
+
+	timezone, err := newOptionalTimezone(in.Timezone)
+	if err != nil {
+		return err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(in.TripID))
+	if err != nil {
+		return err
+	}
+
+	a, err := i.findInTrip(ctx, t.ID(), accommodation.NewAccommodationID(in.ID))
+	if err != nil {
+		return err
+	}
+
+	now := i.timeService.Now()
+
+	updated := a.Update(
+		in.Name,
+		in.Address,
+		timezone,
+		stay,
+		in.ConfirmationNumber,
+		cost,
+		in.Notes,
+		now,
+	)
+
+	if err := updated.ValidateFor(t); err != nil {
+		return err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.accommodationRepository.Update(txCtx, updated); err != nil {
+			return err
+		}
+		return i.history.recordUpdated(txCtx, t.ID(), m.UserID(), now, a, updated)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to update accommodation", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は旅行に紐づく指定されたIDの宿泊予約を、宿泊予約に添付したファイルとともに削除する
+func (i *AccommodationInteractor) Delete(ctx context.Context, tripID, id string) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	a, err := i.findInTrip(ctx, trip.NewTripID(tripID), accommodation.NewAccommodationID(id))
+	if err != nil {
+		return err
+	}
+
+	var keys []string
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.accommodationRepository.Delete(txCtx, a.ID()); err != nil {
+			return err
+		}
+		deleted, err := i.attachments.deleteTargetRecords(txCtx, a.TripID(), attachment.NewTarget(attachment.TargetTypeAccommodation, a.ID().String()))
+		if err != nil {
+			return err
+		}
+		keys = deleted
+		return i.history.recordDeleted(txCtx, a.TripID(), m.UserID(), i.timeService.Now(), a)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to delete accommodation", apperr.WithCause(err))
+	}
+
+	i.attachments.deleteBlobs(ctx, keys)
+	return nil
+}
+
+// findTrip は宿泊予約の親となる旅行を取得する
+func (i *AccommodationInteractor) findTrip(ctx context.Context, tripID trip.TripID) (*trip.Trip, error) {
+	t, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+	return t, nil
+}
+
+// findInTrip は宿泊予約を取得し、指定された旅行に属していることを確認する。
+// 別の旅行の宿泊予約は存在しないものとして扱う
+func (i *AccommodationInteractor) findInTrip(ctx context.Context, tripID trip.TripID, id accommodation.AccommodationID) (*accommodation.Accommodation, error) {
+	a, err := i.accommodationRepository.FindByID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get accommodation", apperr.WithCause(err))
+	}
+
+	if !a.TripID().Equals(tripID) {
+		return nil, accommodation.NewAccommodationNotFoundError()
+	}
+
+	return a, nil
+}
@@ -0,0 +1,568 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	mock_accommodation "github.com/hata0/travel-api/internal/domain/accommodation/mock"
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	mock_attachment "github.com/hata0/travel-api/internal/domain/attachment/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	mock_history "github.com/hata0/travel-api/internal/domain/history/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var (
+	accommodationFixedTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	accommodationTripID    = trip.NewTripID("trip-id")
+)
+
+// newAccommodationTestAccommodation は 8/1 の1泊分の宿泊予約を生成する
+func newAccommodationTestAccommodation(t *testing.T, id string, tripID trip.TripID) *accommodation.Accommodation {
+	t.Helper()
+
+	stay, err := accommodation.NewStay(
+		time.Date(2023, 8, 1, 15, 0, 0, 0, time.UTC),
+		time.Date(2023, 8, 2, 10, 0, 0, 0, time.UTC),
+	)
+	require.NoError(t, err)
+	cost, err := money.NewMoney(10000, "JPY")
+	require.NoError(t, err)
+	tz, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+
+	return accommodation.NewAccommodation(
+		accommodation.NewAccommodationID(id),
+		tripID,
+		"Hotel",
+		"Address",
+		&tz,
+		stay,
+		"CONF",
+		cost,
+		"",
+		accommodationFixedTime,
+		accommodationFixedTime,
+	)
+}
+
+func TestAccommodationInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	captureHistory(mockHistoryRepo)
+
+	interactor := NewAccommodationInteractor(mockAccommodationRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockAttachmentRepo, newMemoryBlobStore(), mockTxManager, mockTimeService, mockIDService)
+
+	accommodationID := accommodation.NewAccommodationID("acc-id")
+	a := newAccommodationTestAccommodation(t, "acc-id", accommodationTripID)
+	otherTripAccommodation := newAccommodationTestAccommodation(t, "acc-id", trip.NewTripID("other-trip-id"))
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *output.GetAccommodationOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 旅行に属する宿泊予約が取得できる",
+			setup: func() {
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), accommodationID).Return(a, nil)
+			},
+			want: output.NewGetAccommodationOutput(a),
+		},
+		{
+			name: "異常系: 別の旅行の宿泊予約は NotFound になる",
+			setup: func() {
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), accommodationID).Return(otherTripAccommodation, nil)
+			},
+			wantErr: accommodation.NewAccommodationNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), accommodationID).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get accommodation", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.Get(newActorContext(), "trip-id", "acc-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAccommodationInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	captureHistory(mockHistoryRepo)
+
+	interactor := NewAccommodationInteractor(mockAccommodationRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockAttachmentRepo, newMemoryBlobStore(), mockTxManager, mockTimeService, mockIDService)
+
+	// 東京の現地時刻で 8/1 15:00 にチェックインし、8/2 10:00 にチェックアウトする
+	tokyo, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	stay, err := accommodation.NewStay(time.Date(2023, 8, 1, 15, 0, 0, 0, tokyo.Location()), time.Date(2023, 8, 2, 10, 0, 0, 0, tokyo.Location()))
+	require.NoError(t, err)
+	cost, err := money.NewMoney(10000, "JPY")
+	require.NoError(t, err)
+	accommodations := []*accommodation.Accommodation{
+		accommodation.NewAccommodation(accommodation.NewAccommodationID("acc-id"), accommodationTripID, "Hotel", "Address", &tokyo, stay, "CONF", cost, "", accommodationFixedTime, accommodationFixedTime),
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *output.ListAccommodationOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 宿泊予約と宿泊予約のない夜が返される",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(newPeriodTestTrip(t, accommodationTripID), nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID).Return(accommodations, nil)
+			},
+			// 8/2 の夜は宿泊予約がないと判定される
+			want: output.NewListAccommodationOutput(accommodations, []time.Time{time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC)}),
+		},
+		{
+			name: "異常系: 旅行が存在しない",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(nil, trip.NewTripNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: 宿泊予約の取得で予期しないエラーが返される",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(newPeriodTestTrip(t, accommodationTripID), nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list accommodations", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.List(newActorContext(), "trip-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAccommodationInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	interactor := NewAccommodationInteractor(mockAccommodationRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockAttachmentRepo, newMemoryBlobStore(), mockTxManager, mockTimeService, mockIDService)
+
+	generatedID := "generated-id"
+	validInput := input.CreateAccommodationInput{
+		TripID:       "trip-id",
+		Name:         "Hotel",
+		Address:      "Address",
+		Timezone:     "Asia/Tokyo",
+		CheckInAt:    time.Date(2023, 8, 1, 15, 0, 0, 0, time.UTC),
+		CheckOutAt:   time.Date(2023, 8, 2, 10, 0, 0, 0, time.UTC),
+		CostAmount:   10000,
+		CostCurrency: "JPY",
+	}
+	invalidStayInput := validInput
+	invalidStayInput.CheckOutAt = validInput.CheckInAt.Add(-time.Hour)
+	invalidTimezoneInput := validInput
+	invalidTimezoneInput.Timezone = "Mars/Olympus"
+	invalidCurrencyInput := validInput
+	invalidCurrencyInput.CostCurrency = "yen"
+	outsidePeriodInput := validInput
+	outsidePeriodInput.CheckInAt = time.Date(2023, 8, 3, 15, 0, 0, 0, time.UTC)
+	outsidePeriodInput.CheckOutAt = time.Date(2023, 8, 4, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.CreateAccommodationInput
+		setup   func()
+		want    *output.CreateAccommodationOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 宿泊予約が作成できる",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(newPeriodTestTrip(t, accommodationTripID), nil)
+				mockIDService.EXPECT().Generate().Return(generatedID)
+				mockTimeService.EXPECT().Now().Return(accommodationFixedTime)
+				mockAccommodationRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, a *accommodation.Accommodation) error {
+						assert.Equal(t, generatedID, a.ID().String())
+						assert.Equal(t, accommodationTripID, a.TripID())
+						assert.Equal(t, "Hotel", a.Name())
+						assert.Equal(t, "Asia/Tokyo", a.Timezone().Name())
+						return nil
+					})
+			},
+			want: output.NewCreateAccommodationOutput(accommodation.NewAccommodationID(generatedID)),
+		},
+		{
+			name:    "異常系: チェックアウトがチェックインより前",
+			in:      invalidStayInput,
+			setup:   func() {},
+			wantErr: accommodation.NewInvalidStayError(),
+		},
+		{
+			name:    "異常系: 不正なタイムゾーン",
+			in:      invalidTimezoneInput,
+			setup:   func() {},
+			wantErr: geo.NewInvalidTimezoneError(),
+		},
+		{
+			name:    "異常系: 不正な通貨コード",
+			in:      invalidCurrencyInput,
+			setup:   func() {},
+			wantErr: money.NewInvalidCurrencyError(),
+		},
+		{
+			name: "異常系: 旅行期間外の宿泊予約",
+			in:   outsidePeriodInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(newPeriodTestTrip(t, accommodationTripID), nil)
+				mockIDService.EXPECT().Generate().Return(generatedID)
+				mockTimeService.EXPECT().Now().Return(accommodationFixedTime)
+			},
+			wantErr: accommodation.NewOutsideTripPeriodError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(newPeriodTestTrip(t, accommodationTripID), nil)
+				mockIDService.EXPECT().Generate().Return(generatedID)
+				mockTimeService.EXPECT().Now().Return(accommodationFixedTime)
+				mockAccommodationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to create accommodation", apperr.WithCause(errors.New("database write error"))),
+		},
+		{
+			name:    "異常系: 閲覧者は宿泊予約を追加できない",
+			ctx:     viewerCtx,
+			in:      validInput,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Create(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assertRecordedChange(t, revisions, history.ResourceTypeAccommodation, history.ActionCreated)
+			}
+		})
+	}
+}
+
+func TestAccommodationInteractor_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+
+	interactor := NewAccommodationInteractor(mockAccommodationRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockAttachmentRepo, newMemoryBlobStore(), mockTxManager, mockTimeService, mockIDService)
+
+	accommodationID := accommodation.NewAccommodationID("acc-id")
+	original := newAccommodationTestAccommodation(t, "acc-id", accommodationTripID)
+	updateTime := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	validInput := input.UpdateAccommodationInput{
+		ID:           "acc-id",
+		TripID:       "trip-id",
+		Name:         "Updated Hotel",
+		Address:      "Address",
+		CheckInAt:    time.Date(2023, 8, 2, 15, 0, 0, 0, time.UTC),
+		CheckOutAt:   time.Date(2023, 8, 3, 10, 0, 0, 0, time.UTC),
+		CostAmount:   120,
+		CostCurrency: "USD",
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 宿泊予約が更新できる",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(newPeriodTestTrip(t, accommodationTripID), nil)
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), accommodationID).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+				mockAccommodationRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, a *accommodation.Accommodation) error {
+						assert.Equal(t, "Updated Hotel", a.Name())
+						assert.Equal(t, "USD", a.Cost().Currency())
+						assert.Equal(t, updateTime, a.UpdatedAt())
+						return nil
+					})
+			},
+		},
+		{
+			name: "異常系: 宿泊予約が存在しない",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(newPeriodTestTrip(t, accommodationTripID), nil)
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), accommodationID).Return(nil, accommodation.NewAccommodationNotFoundError())
+			},
+			wantErr: accommodation.NewAccommodationNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			err := interactor.Update(newActorContext(), validInput)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeAccommodation, history.ActionUpdated)
+				assert.Contains(t, (*revisions)[0].Changes()[0].ChangedFields(), "name")
+			}
+		})
+	}
+}
+
+func TestAccommodationInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	blobStore := newMemoryBlobStore()
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+
+	interactor := NewAccommodationInteractor(mockAccommodationRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockAttachmentRepo, blobStore, mockTxManager, mockTimeService, mockIDService)
+
+	a := newAccommodationTestAccommodation(t, "acc-id", accommodationTripID)
+	otherTripAccommodation := newAccommodationTestAccommodation(t, "acc-id", trip.NewTripID("other-trip-id"))
+	target := attachment.NewTarget(attachment.TargetTypeAccommodation, a.ID().String())
+	receipt := newTargetTestAttachment("attachment-1", accommodationTripID, target)
+	other := newAttachmentTestAttachment("attachment-2", accommodationTripID)
+
+	tests := []struct {
+		name  string
+		setup func()
+		// wantBlobKeys は削除後に保存先に残るファイルのキー
+		wantBlobKeys []string
+		wantErr      error
+	}{
+		{
+			name: "正常系: 宿泊予約が削除できる",
+			setup: func() {
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockAccommodationRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(nil)
+				mockAttachmentRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID, &target).Return(nil, nil)
+				mockTimeService.EXPECT().Now().Return(accommodationFixedTime)
+			},
+			wantBlobKeys: []string{},
+		},
+		{
+			name: "正常系: 宿泊予約に添付したファイルはレコードと中身の両方を削除する",
+			setup: func() {
+				blobStore.blobs[receipt.StorageKey()] = attachmentPDF
+				blobStore.blobs[other.StorageKey()] = attachmentPDF
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockAccommodationRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(nil)
+				mockAttachmentRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID, &target).Return([]*attachment.Attachment{receipt}, nil)
+				mockAttachmentRepo.EXPECT().Delete(gomock.Any(), receipt.ID()).Return(nil)
+				mockTimeService.EXPECT().Now().Return(accommodationFixedTime)
+			},
+			// 宿泊予約に添付したファイルの中身だけが削除される
+			wantBlobKeys: []string{other.StorageKey()},
+		},
+		{
+			name: "正常系: コミットした後に中身の削除に失敗しても削除は成功し、残った中身はそのままにする",
+			setup: func() {
+				blobStore.blobs[receipt.StorageKey()] = attachmentPDF
+				blobStore.failKeys[receipt.StorageKey()] = true
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockAccommodationRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(nil)
+				mockAttachmentRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID, &target).Return([]*attachment.Attachment{receipt}, nil)
+				mockAttachmentRepo.EXPECT().Delete(gomock.Any(), receipt.ID()).Return(nil)
+				mockTimeService.EXPECT().Now().Return(accommodationFixedTime)
+			},
+			wantBlobKeys: []string{receipt.StorageKey()},
+		},
+		{
+			name: "異常系: 添付ファイルのレコードの削除に失敗した場合は中身を削除しない",
+			setup: func() {
+				blobStore.blobs[receipt.StorageKey()] = attachmentPDF
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockAccommodationRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(nil)
+				mockAttachmentRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID, &target).Return([]*attachment.Attachment{receipt}, nil)
+				mockAttachmentRepo.EXPECT().Delete(gomock.Any(), receipt.ID()).Return(errors.New("database connection error"))
+			},
+			wantBlobKeys: []string{receipt.StorageKey()},
+			wantErr:      apperr.NewInternalError("Failed to delete attachment", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name: "異常系: 別の旅行の宿泊予約は削除できない",
+			setup: func() {
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(otherTripAccommodation, nil)
+			},
+			wantBlobKeys: []string{},
+			wantErr:      accommodation.NewAccommodationNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			blobStore.blobs = map[string][]byte{}
+			blobStore.failKeys = map[string]bool{}
+			tt.setup()
+
+			err := interactor.Delete(newActorContext(), "trip-id", "acc-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeAccommodation, history.ActionDeleted)
+			}
+			assert.Equal(t, tt.wantBlobKeys, blobStore.keys())
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/attachment.go github.com/hata0/travel-api/internal/usecase AttachmentUsecase
+type AttachmentUsecase interface {
+	Get(ctx context.Context, tripID, id string) (*output.GetAttachmentOutput, error)
+	List(ctx context.Context, in input.ListAttachmentInput) (*output.ListAttachmentOutput, error)
+	Upload(ctx context.Context, in input.UploadAttachmentInput) (*output.UploadAttachmentOutput, error)
+	Download(ctx context.Context, tripID, id string) (*output.DownloadAttachmentOutput, error)
+	Delete(ctx context.Context, tripID, id string) error
+}
+
+type AttachmentInteractor struct {
+	attachmentRepository    attachment.AttachmentRepository
+	accommodationRepository accommodation.AccommodationRepository
+	expenseRepository       expense.ExpenseRepository
+	authorizer              tripAuthorizer
+	blobStore               service.BlobStore
+	timeService             service.TimeService
+	idService               service.IDService
+	maxSizeBytes            int64
+}
+
+// NewAttachmentInteractor は添付ファイルのユースケースを作成する。maxSizeBytes はアップロードできるファイル1件あたりの最大サイズ
+func NewAttachmentInteractor(
+	attachmentRepository attachment.AttachmentRepository,
+	accommodationRepository accommodation.AccommodationRepository,
+	expenseRepository expense.ExpenseRepository,
+	memberRepository membership.MemberRepository,
+	blobStore service.BlobStore,
+	timeService service.TimeService,
+	idService service.IDService,
+	maxSizeBytes int64,
+) AttachmentUsecase {
+	return &AttachmentInteractor{
+		attachmentRepository:    attachmentRepository,
+		accommodationRepository: accommodationRepository,
+		expenseRepository:       expenseRepository,
+		authorizer:              newTripAuthorizer(memberRepository),
+		blobStore:               blobStore,
+		timeService:             timeService,
+		idService:               idService,
+		maxSizeBytes:            maxSizeBytes,
+	}
+}
+
+// Get は旅行に紐づく指定されたIDの添付ファイルの情報を取得する
+func (i *AttachmentInteractor) Get(ctx context.Context, tripID, id string) (*output.GetAttachmentOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	a, err := i.findInTrip(ctx, trip.NewTripID(tripID), attachment.NewAttachmentID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetAttachmentOutput(a), nil
+}
+
+// List は旅行の添付ファイルを追加された順に取得する。対象のリソースが指定された場合はそのリソースの添付ファイルだけを取得する
+func (i *AttachmentInteractor) List(ctx context.Context, in input.ListAttachmentInput) (*output.ListAttachmentOutput, error) {
+	tripID := trip.NewTripID(in.TripID)
+
+	if _, err := i.authorizer.authorize(ctx, tripID, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	var target *attachment.Target
+	if in.TargetType != "" {
+		targetType, err := attachment.ParseTargetType(in.TargetType)
+		if err != nil {
+			return nil, err
+		}
+		t := newAttachmentTarget(tripID, targetType, in.TargetID)
+		target = &t
+	}
+
+	attachments, err := i.attachmentRepository.FindByTripID(ctx, tripID, target)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list attachments", apperr.WithCause(err))
+	}
+
+	return output.NewListAttachmentOutput(attachments), nil
+}
+
+// Upload はファイルを BlobStore に保存し、旅行または旅行の予約・支出に添付する。
+// ファイルの形式は拡張子や申告された Content-Type ではなく先頭のバイト列から判定する
+func (i *AttachmentInteractor) Upload(ctx context.Context, in input.UploadAttachmentInput) (*output.UploadAttachmentOutput, error) {
+	tripID := trip.NewTripID(in.TripID)
+
+	m, err := i.authorizer.authorize(ctx, tripID, membership.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	targetType, err := attachment.ParseTargetType(in.TargetType)
+	if err != nil {
+		return nil, err
+	}
+
+	target := newAttachmentTarget(tripID, targetType, in.TargetID)
+	if err := i.ensureTargetInTrip(ctx, tripID, target); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case in.Size <= 0:
+		return nil, attachment.NewEmptyFileError()
+	case in.Size > i.maxSizeBytes:
+		return nil, attachment.NewFileTooLargeError()
+	}
+
+	head := make([]byte, min(in.Size, attachment.SniffLength))
+	if _, err := io.ReadFull(in.Content, head); err != nil {
+		return nil, apperr.NewInternalError("Failed to read attachment content", apperr.WithCause(err))
+	}
+
+	contentType, err := attachment.DetectContentType(head)
+	if err != nil {
+		return nil, err
+	}
+
+	id := attachment.NewAttachmentID(i.idService.Generate())
+	key := attachment.StorageKey(tripID, id)
+	a := attachment.NewAttachment(
+		id,
+		tripID,
+		target,
+		attachment.SanitizeFilename(in.Filename),
+		contentType,
+		in.Size,
+		key,
+		m.UserID(),
+		i.timeService.Now(),
+	)
+
+	// 判定のために読み込んだ先頭部分を戻して、ファイル全体を保存する
+	if err := i.blobStore.Put(ctx, key, io.MultiReader(bytes.NewReader(head), in.Content), in.Size, contentType); err != nil {
+		return nil, apperr.NewInternalError("Failed to store attachment content", apperr.WithCause(err))
+	}
+
+	if err := i.attachmentRepository.Create(ctx, a); err != nil {
+		// 参照されなくなったファイルを残さないよう削除する。削除の失敗は元のエラーを優先して無視する
+		_ = i.blobStore.Delete(ctx, key)
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to create attachment", apperr.WithCause(err))
+	}
+
+	return output.NewUploadAttachmentOutput(a), nil
+}
+
+// Download は旅行に紐づく指定されたIDの添付ファイルの情報と中身を取得する
+func (i *AttachmentInteractor) Download(ctx context.Context, tripID, id string) (*output.DownloadAttachmentOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	a, err := i.findInTrip(ctx, trip.NewTripID(tripID), attachment.NewAttachmentID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := i.blobStore.Get(ctx, a.StorageKey())
+	if err != nil {
+		if errors.Is(err, service.ErrBlobNotFound) {
+			return nil, apperr.NewInternalError("Attachment content is missing from the blob store", apperr.WithCause(err))
+		}
+		return nil, apperr.NewInternalError("Failed to read attachment content", apperr.WithCause(err))
+	}
+
+	return output.NewDownloadAttachmentOutput(a, content), nil
+}
+
+// Delete は旅行に紐づく指定されたIDの添付ファイルを、BlobStore に保存した中身とともに削除する
+func (i *AttachmentInteractor) Delete(ctx context.Context, tripID, id string) error {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleEditor); err != nil {
+		return err
+	}
+
+	a, err := i.findInTrip(ctx, trip.NewTripID(tripID), attachment.NewAttachmentID(id))
+	if err != nil {
+		return err
+	}
+
+	// 中身を先に消すと、レコードの削除に失敗したときに中身のない添付ファイルが残るため、レコードから削除する
+	if err := i.attachmentRepository.Delete(ctx, a.ID()); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to delete attachment", apperr.WithCause(err))
+	}
+
+	if err := i.blobStore.Delete(ctx, a.StorageKey()); err != nil {
+		return apperr.NewInternalError("Failed to delete attachment content", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// ensureTargetInTrip は添付先のリソースが存在し、指定された旅行に属していることを確認する。
+// 別の旅行のリソースは存在しないものとして扱う
+func (i *AttachmentInteractor) ensureTargetInTrip(ctx context.Context, tripID trip.TripID, target attachment.Target) error {
+	switch target.Type() {
+	case attachment.TargetTypeAccommodation:
+		a, err := i.accommodationRepository.FindByID(ctx, accommodation.NewAccommodationID(target.ID()))
+		if err != nil {
+			if apperr.IsAppError(err) {
+				return err
+			}
+			return apperr.NewInternalError("Failed to get accommodation", apperr.WithCause(err))
+		}
+		if !a.TripID().Equals(tripID) {
+			return accommodation.NewAccommodationNotFoundError()
+		}
+
+	case attachment.TargetTypeExpense:
+		e, err := i.expenseRepository.FindByID(ctx, expense.NewExpenseID(target.ID()))
+		if err != nil {
+			if apperr.IsAppError(err) {
+				return err
+			}
+			return apperr.NewInternalError("Failed to get expense", apperr.WithCause(err))
+		}
+		if !e.TripID().Equals(tripID) {
+			return expense.NewExpenseNotFoundError()
+		}
+	}
+
+	return nil
+}
+
+// findInTrip は添付ファイルを取得し、指定された旅行に属していることを確認する。
+// 別の旅行の添付ファイルは存在しないものとして扱う
+func (i *AttachmentInteractor) findInTrip(ctx context.Context, tripID trip.TripID, id attachment.AttachmentID) (*attachment.Attachment, error) {
+	a, err := i.attachmentRepository.FindByID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get attachment", apperr.WithCause(err))
+	}
+
+	if !a.TripID().Equals(tripID) {
+		return nil, attachment.NewAttachmentNotFoundError()
+	}
+
+	return a, nil
+}
+
+// newAttachmentTarget は添付先のリソースを作成する。旅行自体に添付する場合は旅行IDを添付先のIDとする
+func newAttachmentTarget(tripID trip.TripID, targetType attachment.TargetType, targetID string) attachment.Target {
+	if targetType == attachment.TargetTypeTrip {
+		return attachment.NewTarget(targetType, tripID.String())
+	}
+	return attachment.NewTarget(targetType, targetID)
+}
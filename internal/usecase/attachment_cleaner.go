@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// attachmentCleaner は削除するリソースに紐づく添付ファイルを削除する。
+// レコードはリソースの削除と同じトランザクション内で削除し、中身はコミットした後に削除する。
+// 中身を先に消すと、ロールバックしたときに中身のない添付ファイルが残るため
+type attachmentCleaner struct {
+	attachmentRepository attachment.AttachmentRepository
+	blobStore            service.BlobStore
+}
+
+func newAttachmentCleaner(attachmentRepository attachment.AttachmentRepository, blobStore service.BlobStore) attachmentCleaner {
+	return attachmentCleaner{
+		attachmentRepository: attachmentRepository,
+		blobStore:            blobStore,
+	}
+}
+
+// deleteTargetRecords は添付先のリソースの添付ファイルのレコードを削除し、中身の保存先のキーを返す。
+// 返したキーはコミットした後に deleteBlobs に渡す
+func (c attachmentCleaner) deleteTargetRecords(ctx context.Context, tripID trip.TripID, target attachment.Target) ([]string, error) {
+	attachments, err := c.attachmentRepository.FindByTripID(ctx, tripID, &target)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list attachments", apperr.WithCause(err))
+	}
+
+	keys := make([]string, 0, len(attachments))
+	for _, a := range attachments {
+		if err := c.attachmentRepository.Delete(ctx, a.ID()); err != nil {
+			if apperr.IsAppError(err) {
+				return nil, err
+			}
+			return nil, apperr.NewInternalError("Failed to delete attachment", apperr.WithCause(err))
+		}
+		keys = append(keys, a.StorageKey())
+	}
+
+	return keys, nil
+}
+
+// tripKeys は旅行のすべての添付ファイルの中身の保存先のキーを返す。旅行を完全に削除する前に呼び出す
+func (c attachmentCleaner) tripKeys(ctx context.Context, tripID trip.TripID) ([]string, error) {
+	attachments, err := c.attachmentRepository.FindByTripID(ctx, tripID, nil)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list attachments", apperr.WithCause(err))
+	}
+
+	keys := make([]string, 0, len(attachments))
+	for _, a := range attachments {
+		keys = append(keys, a.StorageKey())
+	}
+	return keys, nil
+}
+
+// deleteBlobs は保存先から keys の中身を削除する。レコードの削除はコミット済みのため、
+// 一部の削除に失敗しても呼び出し元を失敗させず、失敗したキーをログに記録して残りの削除を続ける
+func (c attachmentCleaner) deleteBlobs(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := c.blobStore.Delete(ctx, key); err != nil {
+			slog.ErrorContext(ctx, "Failed to delete attachment content", "key", key, "error", err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// memoryBlobStore はメモリ上に中身を保持する BlobStore。削除した後に保存先に何が残っているかを確かめるために使う
+type memoryBlobStore struct {
+	blobs map[string][]byte
+	// failKeys に含まれるキーの削除はエラーになる
+	failKeys map[string]bool
+}
+
+func newMemoryBlobStore(keys ...string) *memoryBlobStore {
+	store := &memoryBlobStore{blobs: map[string][]byte{}, failKeys: map[string]bool{}}
+	for _, key := range keys {
+		store.blobs[key] = []byte(key)
+	}
+	return store
+}
+
+func (s *memoryBlobStore) Put(_ context.Context, key string, body io.Reader, _ int64, _ string) error {
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	s.blobs[key] = content
+	return nil
+}
+
+func (s *memoryBlobStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	content, ok := s.blobs[key]
+	if !ok {
+		return nil, service.ErrBlobNotFound
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (s *memoryBlobStore) Delete(_ context.Context, key string) error {
+	if s.failKeys[key] {
+		return errors.New("storage unavailable")
+	}
+	delete(s.blobs, key)
+	return nil
+}
+
+// keys は保存されている中身のキーを辞書順に返す
+func (s *memoryBlobStore) keys() []string {
+	keys := make([]string, 0, len(s.blobs))
+	for key := range s.blobs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// newTargetTestAttachment は指定したリソースに添付したファイルを生成する
+func newTargetTestAttachment(id string, tripID trip.TripID, target attachment.Target) *attachment.Attachment {
+	attachmentID := attachment.NewAttachmentID(id)
+	return attachment.NewAttachment(
+		attachmentID,
+		tripID,
+		target,
+		"receipt.pdf",
+		attachment.ContentTypePDF,
+		int64(len(attachmentPDF)),
+		attachment.StorageKey(tripID, attachmentID),
+		testActorID,
+		attachmentFixedTime,
+	)
+}
+
+func TestAttachmentCleaner_DeleteBlobs(t *testing.T) {
+	t.Run("正常系: 指定したキーの中身だけを削除する", func(t *testing.T) {
+		store := newMemoryBlobStore("a", "b", "c")
+
+		newAttachmentCleaner(nil, store).deleteBlobs(context.Background(), []string{"a", "c", "missing"})
+
+		assert.Equal(t, []string{"b"}, store.keys())
+	})
+
+	t.Run("異常系: 一部の削除に失敗しても残りは削除する", func(t *testing.T) {
+		store := newMemoryBlobStore("a", "b", "c")
+		store.failKeys["a"] = true
+
+		newAttachmentCleaner(nil, store).deleteBlobs(context.Background(), []string{"a", "b", "c"})
+
+		assert.Equal(t, []string{"a"}, store.keys())
+	})
+}
@@ -0,0 +1,610 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	mock_accommodation "github.com/hata0/travel-api/internal/domain/accommodation/mock"
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	mock_attachment "github.com/hata0/travel-api/internal/domain/attachment/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	mock_expense "github.com/hata0/travel-api/internal/domain/expense/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/actor"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+// attachmentMaxSize はテストで使うアップロードの最大サイズ
+const attachmentMaxSize = 1024
+
+var (
+	attachmentFixedTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	attachmentTripID    = trip.NewTripID("trip-id")
+	// attachmentPDF は PDF として判定される最小限の内容
+	attachmentPDF = []byte("%PDF-1.7\nboarding pass")
+)
+
+// newAttachmentTestAttachment は旅行自体に添付された PDF を生成する
+func newAttachmentTestAttachment(id string, tripID trip.TripID) *attachment.Attachment {
+	attachmentID := attachment.NewAttachmentID(id)
+	return attachment.NewAttachment(
+		attachmentID,
+		tripID,
+		attachment.NewTarget(attachment.TargetTypeTrip, tripID.String()),
+		"boarding-pass.pdf",
+		attachment.ContentTypePDF,
+		int64(len(attachmentPDF)),
+		attachment.StorageKey(tripID, attachmentID),
+		testActorID,
+		attachmentFixedTime,
+	)
+}
+
+func newAttachmentUploadInput(targetType, targetID string, content []byte) input.UploadAttachmentInput {
+	return input.UploadAttachmentInput{
+		TripID:     "trip-id",
+		TargetType: targetType,
+		TargetID:   targetID,
+		Filename:   "../boarding-pass.pdf",
+		Content:    bytes.NewReader(content),
+		Size:       int64(len(content)),
+	}
+}
+
+// expectBlobPut は BlobStore に保存された内容を返す関数を返す
+func expectBlobPut(blobStore *mock_service.MockBlobStore, key string) func() []byte {
+	var stored []byte
+	blobStore.EXPECT().
+		Put(gomock.Any(), key, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, body io.Reader, _ int64, _ string) error {
+			var err error
+			stored, err = io.ReadAll(body)
+			return err
+		})
+	return func() []byte { return stored }
+}
+
+func TestAttachmentInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockBlobStore := mock_service.NewMockBlobStore(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewAttachmentInteractor(mockAttachmentRepo, mockAccommodationRepo, mockExpenseRepo, mockMemberRepo, mockBlobStore, mockTimeService, mockIDService, attachmentMaxSize)
+
+	a := newAttachmentTestAttachment("attachment-id", attachmentTripID)
+	otherTrips := newAttachmentTestAttachment("attachment-id", trip.NewTripID("other-trip-id"))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.GetAttachmentOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は添付ファイルの情報を取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockAttachmentRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+			},
+			want: output.NewGetAttachmentOutput(a),
+		},
+		{
+			name: "異常系: 別の旅行の添付ファイルは NotFound になる",
+			setup: func() {
+				mockAttachmentRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(otherTrips, nil)
+			},
+			wantErr: attachment.NewAttachmentNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockAttachmentRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get attachment", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Get(ctx, attachmentTripID.String(), "attachment-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAttachmentInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockBlobStore := mock_service.NewMockBlobStore(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewAttachmentInteractor(mockAttachmentRepo, mockAccommodationRepo, mockExpenseRepo, mockMemberRepo, mockBlobStore, mockTimeService, mockIDService, attachmentMaxSize)
+
+	attachments := []*attachment.Attachment{newAttachmentTestAttachment("attachment-id", attachmentTripID)}
+	tripTarget := attachment.NewTarget(attachment.TargetTypeTrip, attachmentTripID.String())
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.ListAttachmentInput
+		setup   func()
+		want    *output.ListAttachmentOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は旅行のすべての添付ファイルを取得できる",
+			ctx:  viewerCtx,
+			in:   input.ListAttachmentInput{TripID: attachmentTripID.String()},
+			setup: func() {
+				mockAttachmentRepo.EXPECT().FindByTripID(gomock.Any(), attachmentTripID, nil).Return(attachments, nil)
+			},
+			want: output.NewListAttachmentOutput(attachments),
+		},
+		{
+			name: "正常系: 旅行自体を指定すると旅行IDを添付先のIDとして絞り込む",
+			in:   input.ListAttachmentInput{TripID: attachmentTripID.String(), TargetType: "trip", TargetID: "ignored"},
+			setup: func() {
+				mockAttachmentRepo.EXPECT().FindByTripID(gomock.Any(), attachmentTripID, &tripTarget).Return(nil, nil)
+			},
+			want: output.NewListAttachmentOutput(nil),
+		},
+		{
+			name:    "異常系: 添付先の種類が不正",
+			in:      input.ListAttachmentInput{TripID: attachmentTripID.String(), TargetType: "activity"},
+			setup:   func() {},
+			wantErr: attachment.NewInvalidTargetTypeError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   input.ListAttachmentInput{TripID: attachmentTripID.String()},
+			setup: func() {
+				mockAttachmentRepo.EXPECT().FindByTripID(gomock.Any(), attachmentTripID, nil).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list attachments", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.List(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAttachmentInteractor_Upload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockBlobStore := mock_service.NewMockBlobStore(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewAttachmentInteractor(mockAttachmentRepo, mockAccommodationRepo, mockExpenseRepo, mockMemberRepo, mockBlobStore, mockTimeService, mockIDService, attachmentMaxSize)
+
+	attachmentID := attachment.NewAttachmentID("attachment-id")
+	key := attachment.StorageKey(attachmentTripID, attachmentID)
+	acc := newAccommodationTestAccommodation(t, "accommodation-id", attachmentTripID)
+	othersExpense := newExpenseTestExpense(t, "expense-id", trip.NewTripID("other-trip-id"))
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte("x"), 900)...)
+	tooLarge := append([]byte("%PDF-"), bytes.Repeat([]byte("x"), attachmentMaxSize)...)
+	tripAttachment := newAttachmentTestAttachment(attachmentID.String(), attachmentTripID)
+	accommodationAttachment := attachment.NewAttachment(
+		attachmentID,
+		attachmentTripID,
+		attachment.NewTarget(attachment.TargetTypeAccommodation, acc.ID().String()),
+		"boarding-pass.pdf",
+		attachment.ContentTypePDF,
+		int64(len(attachmentPDF)),
+		key,
+		testActorID,
+		attachmentFixedTime,
+	)
+	pngAttachment := attachment.NewAttachment(
+		attachmentID,
+		attachmentTripID,
+		attachment.NewTarget(attachment.TargetTypeTrip, attachmentTripID.String()),
+		"boarding-pass.pdf",
+		attachment.ContentTypePNG,
+		int64(len(png)),
+		key,
+		testActorID,
+		attachmentFixedTime,
+	)
+	// stored は BlobStore に保存された内容を返す
+	var stored func() []byte
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		in    input.UploadAttachmentInput
+		setup func()
+		want  *output.UploadAttachmentOutput
+		// wantStored は BlobStore に保存されるべき内容
+		wantStored []byte
+		wantErr    error
+	}{
+		{
+			name: "正常系: 旅行自体にファイルを添付できる",
+			in:   newAttachmentUploadInput("trip", "", attachmentPDF),
+			setup: func() {
+				mockIDService.EXPECT().Generate().Return(attachmentID.String())
+				mockTimeService.EXPECT().Now().Return(attachmentFixedTime)
+				stored = expectBlobPut(mockBlobStore, key)
+				mockAttachmentRepo.EXPECT().Create(gomock.Any(), tripAttachment).Return(nil)
+			},
+			want:       output.NewUploadAttachmentOutput(tripAttachment),
+			wantStored: attachmentPDF,
+		},
+		{
+			name: "正常系: 旅行の予約にファイルを添付できる",
+			in:   newAttachmentUploadInput("accommodation", acc.ID().String(), attachmentPDF),
+			setup: func() {
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), acc.ID()).Return(acc, nil)
+				mockIDService.EXPECT().Generate().Return(attachmentID.String())
+				mockTimeService.EXPECT().Now().Return(attachmentFixedTime)
+				stored = expectBlobPut(mockBlobStore, key)
+				mockAttachmentRepo.EXPECT().Create(gomock.Any(), accommodationAttachment).Return(nil)
+			},
+			want:       output.NewUploadAttachmentOutput(accommodationAttachment),
+			wantStored: attachmentPDF,
+		},
+		{
+			name: "正常系: 512 バイトより大きいファイルも先頭を含めてすべて保存される",
+			in:   newAttachmentUploadInput("trip", "", png),
+			setup: func() {
+				mockIDService.EXPECT().Generate().Return(attachmentID.String())
+				mockTimeService.EXPECT().Now().Return(attachmentFixedTime)
+				stored = expectBlobPut(mockBlobStore, key)
+				mockAttachmentRepo.EXPECT().Create(gomock.Any(), pngAttachment).Return(nil)
+			},
+			want:       output.NewUploadAttachmentOutput(pngAttachment),
+			wantStored: png,
+		},
+		{
+			name:    "異常系: 閲覧者はアップロードできない",
+			ctx:     viewerCtx,
+			in:      newAttachmentUploadInput("trip", "", attachmentPDF),
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+		{
+			name:    "異常系: 添付先の種類が不正",
+			in:      newAttachmentUploadInput("activity", "activity-id", attachmentPDF),
+			setup:   func() {},
+			wantErr: attachment.NewInvalidTargetTypeError(),
+		},
+		{
+			name: "異常系: 別の旅行の支出には添付できない",
+			in:   newAttachmentUploadInput("expense", othersExpense.ID().String(), attachmentPDF),
+			setup: func() {
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), othersExpense.ID()).Return(othersExpense, nil)
+			},
+			wantErr: expense.NewExpenseNotFoundError(),
+		},
+		{
+			name: "異常系: 存在しない予約には添付できない",
+			in:   newAttachmentUploadInput("accommodation", acc.ID().String(), attachmentPDF),
+			setup: func() {
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), acc.ID()).Return(nil, accommodation.NewAccommodationNotFoundError())
+			},
+			wantErr: accommodation.NewAccommodationNotFoundError(),
+		},
+		{
+			name: "異常系: 予約の取得時にリポジトリから予期しないエラーが返される",
+			in:   newAttachmentUploadInput("accommodation", acc.ID().String(), attachmentPDF),
+			setup: func() {
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), acc.ID()).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get accommodation", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name:    "異常系: 空のファイル",
+			in:      newAttachmentUploadInput("trip", "", nil),
+			setup:   func() {},
+			wantErr: attachment.NewEmptyFileError(),
+		},
+		{
+			name:    "異常系: 最大サイズを超えるファイル",
+			in:      newAttachmentUploadInput("trip", "", tooLarge),
+			setup:   func() {},
+			wantErr: attachment.NewFileTooLargeError(),
+		},
+		{
+			name:    "異常系: 許可されていない形式のファイルは拡張子に関わらず拒否される",
+			in:      newAttachmentUploadInput("trip", "", []byte("<html><script>alert(1)</script>")),
+			setup:   func() {},
+			wantErr: attachment.NewUnsupportedContentTypeError(),
+		},
+		{
+			name: "異常系: 記録の作成に失敗すると保存したファイルを削除する",
+			in:   newAttachmentUploadInput("trip", "", attachmentPDF),
+			setup: func() {
+				mockIDService.EXPECT().Generate().Return(attachmentID.String())
+				mockTimeService.EXPECT().Now().Return(attachmentFixedTime)
+				expectBlobPut(mockBlobStore, key)
+				mockAttachmentRepo.EXPECT().Create(gomock.Any(), tripAttachment).Return(errors.New("database connection error"))
+				mockBlobStore.EXPECT().Delete(gomock.Any(), key).Return(nil)
+			},
+			wantErr: apperr.NewInternalError("Failed to create attachment", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name: "異常系: BlobStore への保存に失敗",
+			in:   newAttachmentUploadInput("trip", "", attachmentPDF),
+			setup: func() {
+				mockIDService.EXPECT().Generate().Return(attachmentID.String())
+				mockTimeService.EXPECT().Now().Return(attachmentFixedTime)
+				mockBlobStore.EXPECT().Put(gomock.Any(), key, gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+			},
+			wantErr: apperr.NewInternalError("Failed to store attachment content", apperr.WithCause(errors.New("connection refused"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored = nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Upload(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.wantStored, stored())
+			}
+		})
+	}
+}
+
+func TestAttachmentInteractor_Download(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockBlobStore := mock_service.NewMockBlobStore(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewAttachmentInteractor(mockAttachmentRepo, mockAccommodationRepo, mockExpenseRepo, mockMemberRepo, mockBlobStore, mockTimeService, mockIDService, attachmentMaxSize)
+
+	a := newAttachmentTestAttachment("attachment-id", attachmentTripID)
+	content := io.NopCloser(bytes.NewReader(attachmentPDF))
+	outsiderID := user.NewUserID("outsider-user-id")
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.DownloadAttachmentOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は添付ファイルの中身を取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockAttachmentRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockBlobStore.EXPECT().Get(gomock.Any(), a.StorageKey()).Return(content, nil)
+			},
+			want: output.NewDownloadAttachmentOutput(a, content),
+		},
+		{
+			name: "異常系: BlobStore に中身がない",
+			setup: func() {
+				mockAttachmentRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockBlobStore.EXPECT().Get(gomock.Any(), a.StorageKey()).Return(nil, service.ErrBlobNotFound)
+			},
+			wantErr: apperr.NewInternalError("Attachment content is missing from the blob store", apperr.WithCause(service.ErrBlobNotFound)),
+		},
+		{
+			name: "異常系: BlobStore からの読み込みに失敗",
+			setup: func() {
+				mockAttachmentRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockBlobStore.EXPECT().Get(gomock.Any(), a.StorageKey()).Return(nil, errors.New("connection refused"))
+			},
+			wantErr: apperr.NewInternalError("Failed to read attachment content", apperr.WithCause(errors.New("connection refused"))),
+		},
+		{
+			name: "異常系: メンバーでない旅行の添付ファイルは取得できない",
+			ctx:  actor.WithUserID(context.Background(), outsiderID),
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), attachmentTripID, outsiderID).Return(nil, membership.NewMemberNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Download(ctx, attachmentTripID.String(), "attachment-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				defer got.Content.Close()
+				assert.Equal(t, tt.want, got)
+				body, err := io.ReadAll(got.Content)
+				require.NoError(t, err)
+				assert.Equal(t, attachmentPDF, body)
+			}
+		})
+	}
+}
+
+func TestAttachmentInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockBlobStore := mock_service.NewMockBlobStore(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewAttachmentInteractor(mockAttachmentRepo, mockAccommodationRepo, mockExpenseRepo, mockMemberRepo, mockBlobStore, mockTimeService, mockIDService, attachmentMaxSize)
+
+	a := newAttachmentTestAttachment("attachment-id", attachmentTripID)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 記録と中身が削除される",
+			setup: func() {
+				mockAttachmentRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				gomock.InOrder(
+					mockAttachmentRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(nil),
+					mockBlobStore.EXPECT().Delete(gomock.Any(), a.StorageKey()).Return(nil),
+				)
+			},
+		},
+		{
+			name: "異常系: 記録の削除に失敗すると中身は削除しない",
+			setup: func() {
+				mockAttachmentRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockAttachmentRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to delete attachment", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name: "異常系: 中身の削除に失敗",
+			setup: func() {
+				mockAttachmentRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockAttachmentRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(nil)
+				mockBlobStore.EXPECT().Delete(gomock.Any(), a.StorageKey()).Return(errors.New("connection refused"))
+			},
+			wantErr: apperr.NewInternalError("Failed to delete attachment content", apperr.WithCause(errors.New("connection refused"))),
+		},
+		{
+			name:    "異常系: 閲覧者は削除できない",
+			ctx:     viewerCtx,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			err := interactor.Delete(ctx, attachmentTripID.String(), "attachment-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"time"
 
-	"github.com/hata0/travel-api/internal/domain"
 	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	refreshtoken "github.com/hata0/travel-api/internal/domain/refresh_token"
+	revokedtoken "github.com/hata0/travel-api/internal/domain/revoked_token"
+	"github.com/hata0/travel-api/internal/domain/user"
 	"github.com/hata0/travel-api/internal/usecase/output"
 	"github.com/hata0/travel-api/internal/usecase/service"
 )
@@ -20,28 +22,29 @@ type AuthUsecase interface {
 
 type AuthSettings struct {
 	RefreshTokenExpiration time.Duration
-	PasswordHashCost       int
 }
 
 type AuthInteractor struct {
-	userRepository         domain.UserRepository
-	refreshTokenRepository domain.RefreshTokenRepository
-	revokedTokenRepository domain.RevokedTokenRepository
+	userRepository         user.UserRepository
+	refreshTokenRepository refreshtoken.RefreshTokenRepository
+	revokedTokenRepository revokedtoken.RevokedTokenRepository
 	timeService            service.TimeService
 	idService              service.IDService
 	transactionManager     service.TransactionManager
 	tokenService           service.TokenService
+	passwordService        service.PasswordService
 	authSettings           *AuthSettings
 }
 
 func NewAuthInteractor(
-	userRepository domain.UserRepository,
-	refreshTokenRepository domain.RefreshTokenRepository,
-	revokedTokenRepository domain.RevokedTokenRepository,
+	userRepository user.UserRepository,
+	refreshTokenRepository refreshtoken.RefreshTokenRepository,
+	revokedTokenRepository revokedtoken.RevokedTokenRepository,
 	timeService service.TimeService,
 	idService service.IDService,
 	transactionManager service.TransactionManager,
 	tokenService service.TokenService,
+	passwordService service.PasswordService,
 	authSettings *AuthSettings,
 ) *AuthInteractor {
 	return &AuthInteractor{
@@ -52,6 +55,7 @@ func NewAuthInteractor(
 		idService:              idService,
 		transactionManager:     transactionManager,
 		tokenService:           tokenService,
+		passwordService:        passwordService,
 		authSettings:           authSettings,
 	}
 }
@@ -65,14 +69,15 @@ func (i *AuthInteractor) Register(ctx context.Context, username, email, password
 	}
 
 	userIDStr := i.idService.Generate()
-	userID := domain.NewUserID(userIDStr)
+	userID := user.NewUserID(userIDStr)
 
-	user, err := domain.NewUserWithPassword(userID, username, email, password, i.authSettings.PasswordHashCost, now)
+	passwordHash, err := i.passwordService.HashPassword(password)
 	if err != nil {
-		return nil, apperr.NewInternalError("Failed to create user", apperr.WithCause(err))
+		return nil, err
 	}
 
-	if err := i.userRepository.Create(ctx, user); err != nil {
+	newUser := user.NewUser(userID, username, email, passwordHash, now, now)
+	if err := i.userRepository.Create(ctx, newUser); err != nil {
 		return nil, err
 	}
 
@@ -86,21 +91,21 @@ func (i *AuthInteractor) Login(ctx context.Context, email, password string) (*ou
 	var tokenPair *output.TokenPairOutput
 
 	err := i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
-		user, err := i.findUserByEmail(txCtx, email)
+		foundUser, err := i.findUserByEmail(txCtx, email)
 		if err != nil {
 			return err
 		}
 
-		if err := user.VerifyPassword(password); err != nil {
-			return apperr.NewInvalidCredentialsError("Invalid email or password", apperr.WithCause(err))
+		if !i.passwordService.VerifyPassword(foundUser.PasswordHash(), password) {
+			return apperr.NewInvalidCredentialsError("Invalid email or password")
 		}
 
-		accessToken, refreshToken, err := i.generateTokenPair(user.ID())
+		accessToken, refreshToken, err := i.generateTokenPair(foundUser.ID())
 		if err != nil {
 			return err
 		}
 
-		if err := i.storeRefreshToken(txCtx, user.ID(), refreshToken, now); err != nil {
+		if err := i.storeRefreshToken(txCtx, foundUser.ID(), refreshToken, now); err != nil {
 			return err
 		}
 
@@ -162,7 +167,7 @@ func (i *AuthInteractor) RevokeRefreshToken(ctx context.Context, refreshToken st
 	return i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
 		foundToken, err := i.refreshTokenRepository.FindByToken(txCtx, refreshToken)
 		if err != nil {
-			if apperr.IsRefreshTokenNotFound(err) {
+			if apperr.IsAppErrorWithCode(err, refreshtoken.CodeRefreshTokenNotFound) {
 				return nil
 			}
 			return err
@@ -178,7 +183,7 @@ func (i *AuthInteractor) checkUserExistence(ctx context.Context, username, email
 	if err == nil {
 		return apperr.NewConflictError("Username already exists")
 	}
-	if !apperr.IsUserNotFound(err) {
+	if !apperr.IsAppErrorWithCode(err, user.CodeUserNotFound) {
 		return err
 	}
 
@@ -186,7 +191,7 @@ func (i *AuthInteractor) checkUserExistence(ctx context.Context, username, email
 	if err == nil {
 		return apperr.NewConflictError("Email already exists")
 	}
-	if !apperr.IsUserNotFound(err) {
+	if !apperr.IsAppErrorWithCode(err, user.CodeUserNotFound) {
 		return err
 	}
 
@@ -194,23 +199,23 @@ func (i *AuthInteractor) checkUserExistence(ctx context.Context, username, email
 }
 
 // findUserByEmail はメールアドレスでユーザーを検索する
-func (i *AuthInteractor) findUserByEmail(ctx context.Context, email string) (*domain.User, error) {
-	user, err := i.userRepository.FindByEmail(ctx, email)
+func (i *AuthInteractor) findUserByEmail(ctx context.Context, email string) (*user.User, error) {
+	foundUser, err := i.userRepository.FindByEmail(ctx, email)
 	if err != nil {
-		if apperr.IsUserNotFound(err) {
+		if apperr.IsAppErrorWithCode(err, user.CodeUserNotFound) {
 			return nil, apperr.NewInvalidCredentialsError("Invalid email or password")
 		}
 		return nil, err
 	}
-	return user, nil
+	return foundUser, nil
 }
 
 // storeRefreshToken はリフレッシュトークンを保存する
-func (i *AuthInteractor) storeRefreshToken(ctx context.Context, userID domain.UserID, refreshTokenStr string, now time.Time) error {
+func (i *AuthInteractor) storeRefreshToken(ctx context.Context, userID user.UserID, refreshTokenStr string, now time.Time) error {
 	refreshTokenIDStr := i.idService.Generate()
-	refreshTokenID := domain.NewRefreshTokenID(refreshTokenIDStr)
+	refreshTokenID := refreshtoken.NewRefreshTokenID(refreshTokenIDStr)
 
-	refreshToken := domain.NewRefreshToken(
+	refreshToken := refreshtoken.NewRefreshToken(
 		refreshTokenID,
 		userID,
 		refreshTokenStr,
@@ -236,7 +241,7 @@ func (i *AuthInteractor) checkRevokedToken(ctx context.Context, refreshToken str
 		return apperr.NewInvalidCredentialsError("Token has been revoked")
 	}
 
-	if apperr.IsRevokedTokenNotFound(err) {
+	if apperr.IsAppErrorWithCode(err, revokedtoken.CodeRevokedTokenNotFound) {
 		return nil
 	}
 
@@ -248,7 +253,7 @@ func (i *AuthInteractor) handleTokenReuseAttack(ctx context.Context, refreshToke
 	return i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
 		foundToken, err := i.refreshTokenRepository.FindByToken(txCtx, refreshToken)
 		if err != nil {
-			if apperr.IsRefreshTokenNotFound(err) {
+			if apperr.IsAppErrorWithCode(err, refreshtoken.CodeRefreshTokenNotFound) {
 				return nil
 			}
 			return err
@@ -263,10 +268,10 @@ func (i *AuthInteractor) handleTokenReuseAttack(ctx context.Context, refreshToke
 }
 
 // findAndValidateRefreshToken はリフレッシュトークンを検索し、検証する
-func (i *AuthInteractor) findAndValidateRefreshToken(ctx context.Context, refreshToken string, now time.Time) (*domain.RefreshToken, error) {
+func (i *AuthInteractor) findAndValidateRefreshToken(ctx context.Context, refreshToken string, now time.Time) (*refreshtoken.RefreshToken, error) {
 	foundRefreshToken, err := i.refreshTokenRepository.FindByToken(ctx, refreshToken)
 	if err != nil {
-		if apperr.IsRefreshTokenNotFound(err) {
+		if apperr.IsAppErrorWithCode(err, refreshtoken.CodeRefreshTokenNotFound) {
 			return nil, apperr.NewInvalidCredentialsError("Invalid refresh token")
 		}
 		return nil, err
@@ -284,11 +289,11 @@ func (i *AuthInteractor) findAndValidateRefreshToken(ctx context.Context, refres
 }
 
 // revokeToken はトークンを失効させる
-func (i *AuthInteractor) revokeToken(ctx context.Context, refreshToken *domain.RefreshToken, now time.Time) error {
+func (i *AuthInteractor) revokeToken(ctx context.Context, refreshToken *refreshtoken.RefreshToken, now time.Time) error {
 	revokedTokenIDStr := i.idService.Generate()
-	revokedTokenID := domain.NewRevokedTokenID(revokedTokenIDStr)
+	revokedTokenID := revokedtoken.NewRevokedTokenID(revokedTokenIDStr)
 
-	revokedToken := domain.NewRevokedToken(
+	revokedToken := revokedtoken.NewRevokedToken(
 		revokedTokenID,
 		refreshToken.UserID(),
 		refreshToken.Token(),
@@ -301,7 +306,7 @@ func (i *AuthInteractor) revokeToken(ctx context.Context, refreshToken *domain.R
 	}
 
 	if err := i.refreshTokenRepository.Delete(ctx, refreshToken.ID()); err != nil {
-		if !apperr.IsRefreshTokenNotFound(err) {
+		if !apperr.IsAppErrorWithCode(err, refreshtoken.CodeRefreshTokenNotFound) {
 			return err
 		}
 	}
@@ -310,7 +315,7 @@ func (i *AuthInteractor) revokeToken(ctx context.Context, refreshToken *domain.R
 }
 
 // generateTokenPair はアクセストークンとリフレッシュトークンのペアを生成する
-func (i *AuthInteractor) generateTokenPair(userID domain.UserID) (string, string, error) {
+func (i *AuthInteractor) generateTokenPair(userID user.UserID) (string, string, error) {
 	accessToken, err := i.tokenService.GenerateAccessToken(userID)
 	if err != nil {
 		return "", "", err
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/actor"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// tripAuthorizer は操作を実行するユーザーの旅行メンバーとしてのロールで、旅行と関連リソースへの操作を認可する
+type tripAuthorizer struct {
+	memberRepository membership.MemberRepository
+}
+
+func newTripAuthorizer(memberRepository membership.MemberRepository) tripAuthorizer {
+	return tripAuthorizer{memberRepository: memberRepository}
+}
+
+// authorize は実行ユーザーが旅行に対して required 以上のロールを持つことを確認し、そのメンバーを返す。
+// メンバーでない旅行は存在自体を明かさないよう TripNotFound とする
+func (a tripAuthorizer) authorize(ctx context.Context, tripID trip.TripID, required membership.Role) (*membership.Member, error) {
+	return a.authorizeWith(ctx, tripID, required, a.memberRepository.FindByTripAndUser)
+}
+
+// authorizeTrashed はゴミ箱にある旅行に対して authorize と同じ確認を行う
+func (a tripAuthorizer) authorizeTrashed(ctx context.Context, tripID trip.TripID, required membership.Role) (*membership.Member, error) {
+	return a.authorizeWith(ctx, tripID, required, a.memberRepository.FindByTrashedTripAndUser)
+}
+
+func (a tripAuthorizer) authorizeWith(
+	ctx context.Context,
+	tripID trip.TripID,
+	required membership.Role,
+	find func(ctx context.Context, tripID trip.TripID, userID user.UserID) (*membership.Member, error),
+) (*membership.Member, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := find(ctx, tripID, userID)
+	if err != nil {
+		if apperr.IsAppErrorWithCode(err, membership.CodeMemberNotFound) {
+			return nil, trip.NewTripNotFoundError()
+		}
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip member", apperr.WithCause(err))
+	}
+
+	if err := m.Authorize(required); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// currentUserID は操作を実行するユーザーのIDをコンテキストから取得する
+func currentUserID(ctx context.Context) (user.UserID, error) {
+	userID, ok := actor.UserIDFromContext(ctx)
+	if !ok {
+		return user.UserID{}, apperr.NewInvalidCredentialsError("authenticated user is required")
+	}
+	return userID, nil
+}
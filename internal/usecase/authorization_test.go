@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/actor"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+var (
+	// testActorID はテストで操作を実行するユーザーのID
+	testActorID = user.NewUserID("actor-user-id")
+	// testViewerID はテストで閲覧者として操作を実行する別のユーザーのID
+	testViewerID = user.NewUserID("viewer-user-id")
+)
+
+// newActorContext は testActorID のユーザーが操作を実行するコンテキストを返す
+func newActorContext() context.Context {
+	return actor.WithUserID(context.Background(), testActorID)
+}
+
+// ctxOrActor はテーブル駆動テストのケースで指定されたコンテキストを返す。
+// ケースで ctx を省略した場合は newActorContext() を返し、testActorID のユーザーとして実行する
+func ctxOrActor(ctx context.Context) context.Context {
+	if ctx == nil {
+		return newActorContext()
+	}
+	return ctx
+}
+
+// newViewerContext は testViewerID のユーザーが閲覧者として操作を実行するコンテキストを返す
+func newViewerContext(repo *mock_membership.MockMemberRepository) context.Context {
+	repo.EXPECT().
+		FindByTripAndUser(gomock.Any(), gomock.Any(), testViewerID).
+		DoAndReturn(func(_ context.Context, tripID trip.TripID, userID user.UserID) (*membership.Member, error) {
+			return membership.NewMember(tripID, userID, membership.RoleViewer, time.Time{}, time.Time{}), nil
+		}).
+		AnyTimes()
+	return actor.WithUserID(context.Background(), testViewerID)
+}
+
+// allowAsMember は実行ユーザーを、どの旅行でも指定したロールのメンバーとして扱うよう設定する
+func allowAsMember(repo *mock_membership.MockMemberRepository, role membership.Role) {
+	repo.EXPECT().
+		FindByTripAndUser(gomock.Any(), gomock.Any(), testActorID).
+		DoAndReturn(func(_ context.Context, tripID trip.TripID, userID user.UserID) (*membership.Member, error) {
+			return membership.NewMember(tripID, userID, role, time.Time{}, time.Time{}), nil
+		}).
+		AnyTimes()
+}
+
+// runInTxDirectly はトランザクション内の処理をそのまま実行するよう設定する
+func runInTxDirectly(tm *mock_transaction_manager.MockTransactionManager) {
+	tm.EXPECT().
+		RunInTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+}
+
+func TestTripAuthorizer_Authorize(t *testing.T) {
+	tripID := trip.NewTripID("trip-id")
+
+	t.Run("正常系: 必要なロール以上のメンバーを返す", func(t *testing.T) {
+		repo := mock_membership.NewMockMemberRepository(gomock.NewController(t))
+		editor := membership.NewMember(tripID, testActorID, membership.RoleEditor, time.Time{}, time.Time{})
+		repo.EXPECT().FindByTripAndUser(gomock.Any(), tripID, testActorID).Return(editor, nil)
+
+		got, err := newTripAuthorizer(repo).authorize(newActorContext(), tripID, membership.RoleViewer)
+
+		require.NoError(t, err)
+		assert.Equal(t, editor, got)
+	})
+
+	t.Run("異常系: 実行ユーザーが設定されていない", func(t *testing.T) {
+		repo := mock_membership.NewMockMemberRepository(gomock.NewController(t))
+
+		_, err := newTripAuthorizer(repo).authorize(context.Background(), tripID, membership.RoleViewer)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeInvalidCredentials))
+	})
+
+	t.Run("異常系: メンバーでない旅行は NotFound になる", func(t *testing.T) {
+		repo := mock_membership.NewMockMemberRepository(gomock.NewController(t))
+		repo.EXPECT().FindByTripAndUser(gomock.Any(), tripID, testActorID).Return(nil, membership.NewMemberNotFoundError())
+
+		_, err := newTripAuthorizer(repo).authorize(newActorContext(), tripID, membership.RoleViewer)
+
+		assert.ErrorIs(t, err, trip.NewTripNotFoundError())
+	})
+
+	t.Run("異常系: ロールが不足している", func(t *testing.T) {
+		repo := mock_membership.NewMockMemberRepository(gomock.NewController(t))
+		allowAsMember(repo, membership.RoleViewer)
+
+		_, err := newTripAuthorizer(repo).authorize(newActorContext(), tripID, membership.RoleEditor)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeForbidden))
+	})
+
+	t.Run("異常系: リポジトリから予期しないエラーが返される", func(t *testing.T) {
+		repo := mock_membership.NewMockMemberRepository(gomock.NewController(t))
+		repo.EXPECT().FindByTripAndUser(gomock.Any(), tripID, testActorID).Return(nil, errors.New("database connection error"))
+
+		_, err := newTripAuthorizer(repo).authorize(newActorContext(), tripID, membership.RoleViewer)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeInternalError))
+	})
+}
+
+func TestTripAuthorizer_AuthorizeTrashed(t *testing.T) {
+	tripID := trip.NewTripID("trip-id")
+
+	t.Run("正常系: ゴミ箱の旅行のメンバーを返す", func(t *testing.T) {
+		repo := mock_membership.NewMockMemberRepository(gomock.NewController(t))
+		owner := membership.NewMember(tripID, testActorID, membership.RoleOwner, time.Time{}, time.Time{})
+		repo.EXPECT().FindByTrashedTripAndUser(gomock.Any(), tripID, testActorID).Return(owner, nil)
+
+		got, err := newTripAuthorizer(repo).authorizeTrashed(newActorContext(), tripID, membership.RoleOwner)
+
+		require.NoError(t, err)
+		assert.Equal(t, owner, got)
+	})
+
+	t.Run("異常系: ゴミ箱の旅行のメンバーでない", func(t *testing.T) {
+		repo := mock_membership.NewMockMemberRepository(gomock.NewController(t))
+		repo.EXPECT().FindByTrashedTripAndUser(gomock.Any(), tripID, testActorID).Return(nil, membership.NewMemberNotFoundError())
+
+		_, err := newTripAuthorizer(repo).authorizeTrashed(newActorContext(), tripID, membership.RoleOwner)
+
+		assert.ErrorIs(t, err, trip.NewTripNotFoundError())
+	})
+}
@@ -0,0 +1,243 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/bookingimport"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/booking_import.go github.com/hata0/travel-api/internal/usecase BookingImportUsecase
+type BookingImportUsecase interface {
+	Import(ctx context.Context, in input.ImportBookingsInput) (*output.ImportBookingsOutput, error)
+}
+
+type BookingImportInteractor struct {
+	bookingParser           service.BookingParser
+	tripRepository          trip.TripRepository
+	accommodationRepository accommodation.AccommodationRepository
+	legRepository           transport.LegRepository
+	activityRepository      itinerary.ActivityRepository
+	authorizer              tripAuthorizer
+	history                 historyRecorder
+	transactionManager      transaction_manager.TransactionManager
+	timeService             service.TimeService
+	idService               service.IDService
+}
+
+func NewBookingImportInteractor(
+	bookingParser service.BookingParser,
+	tripRepository trip.TripRepository,
+	accommodationRepository accommodation.AccommodationRepository,
+	legRepository transport.LegRepository,
+	activityRepository itinerary.ActivityRepository,
+	memberRepository membership.MemberRepository,
+	historyRepository history.HistoryRepository,
+	transactionManager transaction_manager.TransactionManager,
+	timeService service.TimeService,
+	idService service.IDService,
+) BookingImportUsecase {
+	return &BookingImportInteractor{
+		bookingParser:           bookingParser,
+		tripRepository:          tripRepository,
+		accommodationRepository: accommodationRepository,
+		legRepository:           legRepository,
+		activityRepository:      activityRepository,
+		authorizer:              newTripAuthorizer(memberRepository),
+		history:                 newHistoryRecorder(historyRepository),
+		transactionManager:      transactionManager,
+		timeService:             timeService,
+		idService:               idService,
+	}
+}
+
+// Import は予約の確認ファイルを読み取り、下書きごとに旅行へ保存できるかを判定する。
+// DryRun でなければ、保存できる下書き（Keys の指定があればそのうちの指定されたもの）を、宿泊は宿泊予約、移動区間は移動、
+// 行動はその日の行程の最後の行動として保存する
+func (i *BookingImportInteractor) Import(ctx context.Context, in input.ImportBookingsInput) (*output.ImportBookingsOutput, error) {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	drafts, err := i.bookingParser.Parse(in.Data, in.Format)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to parse booking file", apperr.WithCause(err))
+	}
+
+	t, err := i.tripRepository.FindByID(ctx, trip.NewTripID(in.TripID))
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+
+	var existing bookingimport.Existing
+	existing.Accommodations, err = i.accommodationRepository.FindByTripID(ctx, t.ID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list accommodations", apperr.WithCause(err))
+	}
+	existing.Legs, err = i.legRepository.FindByTripID(ctx, t.ID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list transport legs", apperr.WithCause(err))
+	}
+	existing.Activities, err = i.activityRepository.FindByTripID(ctx, t.ID(), nil)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list activities", apperr.WithCause(err))
+	}
+
+	statuses := make([]bookingimport.Status, len(drafts))
+	for idx, d := range drafts {
+		statuses[idx] = d.StatusFor(t, existing, in.Currency)
+	}
+
+	selected, err := selectDrafts(drafts, statuses, in.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.DryRun || len(selected) == 0 {
+		return output.NewImportBookingsOutput(in.DryRun, drafts, statuses, nil), nil
+	}
+
+	now := i.timeService.Now()
+	created := make(map[string]string, len(selected))
+	var (
+		accommodations []*accommodation.Accommodation
+		legs           []*transport.Leg
+		activities     []*itinerary.Activity
+	)
+	// 同じ日に複数の行動を取り込む場合も、取り込んだ順に既存の行動の後ろへ並べる
+	sameDay := append([]*itinerary.Activity(nil), existing.Activities...)
+	for _, d := range selected {
+		switch d.Kind() {
+		case bookingimport.KindLodging:
+			a, err := d.ToAccommodation(accommodation.NewAccommodationID(i.idService.Generate()), t.ID(), in.Currency, now)
+			if err != nil {
+				return nil, err
+			}
+			created[d.Key()] = a.ID().String()
+			accommodations = append(accommodations, a)
+		case bookingimport.KindTransport:
+			l, err := d.ToLeg(transport.NewLegID(i.idService.Generate()), t.ID(), now)
+			if err != nil {
+				return nil, err
+			}
+			created[d.Key()] = l.ID().String()
+			legs = append(legs, l)
+		case bookingimport.KindActivity:
+			a, err := d.ToActivity(itinerary.NewActivityID(i.idService.Generate()), t.ID(), 0, now)
+			if err != nil {
+				return nil, err
+			}
+			a = a.MoveTo(itinerary.NextPosition(activitiesOn(sameDay, a.Date())), now)
+			created[d.Key()] = a.ID().String()
+			activities = append(activities, a)
+			sameDay = append(sameDay, a)
+		}
+	}
+
+	// 1 回の取り込みで作成したものは 1 つの変更履歴にまとめる
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		resources := make([]any, 0, len(selected))
+		for _, a := range accommodations {
+			if err := i.accommodationRepository.Create(txCtx, a); err != nil {
+				return err
+			}
+			resources = append(resources, a)
+		}
+		for _, l := range legs {
+			if err := i.legRepository.Create(txCtx, l); err != nil {
+				return err
+			}
+			resources = append(resources, l)
+		}
+		for _, a := range activities {
+			if err := i.activityRepository.Create(txCtx, a); err != nil {
+				return err
+			}
+			resources = append(resources, a)
+		}
+		return i.history.recordCreated(txCtx, t.ID(), m.UserID(), now, resources...)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to import bookings", apperr.WithCause(err))
+	}
+
+	return output.NewImportBookingsOutput(false, drafts, statuses, created), nil
+}
+
+// activitiesOn は行動 activities のうち日付が date のものを返す
+func activitiesOn(activities []*itinerary.Activity, date time.Time) []*itinerary.Activity {
+	var sameDay []*itinerary.Activity
+	for _, a := range activities {
+		if a.Date().Equal(date) {
+			sameDay = append(sameDay, a)
+		}
+	}
+	return sameDay
+}
+
+// selectDrafts は保存する下書きを選ぶ。keys が空の場合は保存できるすべての下書きを選び、
+// 指定がある場合は存在しないキーや保存できない下書きのキーをエラーとする
+func selectDrafts(drafts []bookingimport.Draft, statuses []bookingimport.Status, keys []string) ([]bookingimport.Draft, error) {
+	if len(keys) == 0 {
+		var selected []bookingimport.Draft
+		for idx, d := range drafts {
+			if statuses[idx] == bookingimport.StatusReady {
+				selected = append(selected, d)
+			}
+		}
+		return selected, nil
+	}
+
+	indexes := make(map[string]int, len(drafts))
+	for idx, d := range drafts {
+		indexes[d.Key()] = idx
+	}
+
+	selected := make([]bookingimport.Draft, 0, len(keys))
+	picked := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		idx, ok := indexes[key]
+		if !ok {
+			return nil, bookingimport.NewUnknownDraftKeyError(key)
+		}
+		if statuses[idx] != bookingimport.StatusReady {
+			return nil, bookingimport.NewDraftNotImportableError(key, statuses[idx])
+		}
+		if picked[key] {
+			continue
+		}
+		picked[key] = true
+		selected = append(selected, drafts[idx])
+	}
+	return selected, nil
+}
@@ -0,0 +1,329 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	mock_accommodation "github.com/hata0/travel-api/internal/domain/accommodation/mock"
+	"github.com/hata0/travel-api/internal/domain/bookingimport"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	mock_history "github.com/hata0/travel-api/internal/domain/history/mock"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	mock_itinerary "github.com/hata0/travel-api/internal/domain/itinerary/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	mock_transport "github.com/hata0/travel-api/internal/domain/transport/mock"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+// newBookingImportTestDrafts は旅行期間内の宿泊、旅行期間外の宿泊、航空券、8/1 の行動の下書きを生成する
+func newBookingImportTestDrafts(t *testing.T) []bookingimport.Draft {
+	t.Helper()
+
+	at := func(day, hour int) *time.Time {
+		v := time.Date(2023, 8, day, hour, 0, 0, 0, time.UTC)
+		return &v
+	}
+	price, err := money.NewMoney(20000, "JPY")
+	require.NoError(t, err)
+
+	return []bookingimport.Draft{
+		bookingimport.NewDraft(bookingimport.DraftParams{
+			Kind: bookingimport.KindLodging, Key: "ABC", Title: "Inn", StartAt: at(2, 15), EndAt: at(3, 10),
+			ConfirmationNumber: "ABC", Price: &price,
+		}),
+		bookingimport.NewDraft(bookingimport.DraftParams{
+			Kind: bookingimport.KindLodging, Key: "LATE", Title: "Late Hotel", StartAt: at(10, 15), EndAt: at(11, 10),
+		}),
+		bookingimport.NewDraft(bookingimport.DraftParams{
+			Kind: bookingimport.KindTransport, Key: "XYZ", Title: "NH21", StartAt: at(1, 9), EndAt: at(1, 10),
+			Transport: &bookingimport.Transport{Mode: transport.ModeFlight, Carrier: "NH", Number: "21", Departure: "HND", Arrival: "ITM"},
+		}),
+		bookingimport.NewDraft(bookingimport.DraftParams{
+			Kind: bookingimport.KindActivity, Key: "TEA", Title: "Tea ceremony", Location: "Kyoto", StartAt: at(1, 14), EndAt: at(1, 16),
+		}),
+	}
+}
+
+func TestBookingImportInteractor_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingParser := mock_service.NewMockBookingParser(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	ids := 0
+	mockIDService.EXPECT().Generate().DoAndReturn(func() string {
+		ids++
+		return fmt.Sprintf("generated-id-%d", ids)
+	}).AnyTimes()
+
+	interactor := NewBookingImportInteractor(mockBookingParser, mockTripRepo, mockAccommodationRepo, mockLegRepo, mockActivityRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	newInput := func(dryRun bool, keys ...string) input.ImportBookingsInput {
+		return input.ImportBookingsInput{
+			TripID:   accommodationTripID.String(),
+			Format:   service.BookingFileFormatICS,
+			Data:     strings.NewReader("BEGIN:VCALENDAR"),
+			DryRun:   dryRun,
+			Keys:     keys,
+			Currency: "JPY",
+		}
+	}
+
+	tr := newPeriodTestTrip(t, accommodationTripID)
+	drafts := newBookingImportTestDrafts(t)
+	statuses := []bookingimport.Status{
+		bookingimport.StatusReady,
+		bookingimport.StatusOutsideTripPeriod,
+		bookingimport.StatusReady,
+		bookingimport.StatusReady,
+	}
+	// 既存の行動は 8/1 の並び順 0 の1件とする
+	existingActivities := []*itinerary.Activity{newItineraryTestActivity(t, "act-1", accommodationTripID, 0, nil, nil, nil)}
+
+	existingStay := newAccommodationTestAccommodation(t, "acc-id", accommodationTripID)
+	checkIn, checkOut, cost := existingStay.Stay().CheckInAt(), existingStay.Stay().CheckOutAt(), existingStay.Cost()
+	duplicateDrafts := []bookingimport.Draft{
+		bookingimport.NewDraft(bookingimport.DraftParams{
+			Kind: bookingimport.KindLodging, Key: "CONF", StartAt: &checkIn, EndAt: &checkOut,
+			ConfirmationNumber: "CONF", Price: &cost,
+		}),
+	}
+
+	expectedStay, err := drafts[0].ToAccommodation(accommodation.NewAccommodationID("generated-id-1"), accommodationTripID, "JPY", accommodationFixedTime)
+	require.NoError(t, err)
+	expectedLeg, err := drafts[2].ToLeg(transport.NewLegID("generated-id-2"), accommodationTripID, accommodationFixedTime)
+	require.NoError(t, err)
+	expectedActivity, err := drafts[3].ToActivity(itinerary.NewActivityID("generated-id-3"), accommodationTripID, 0, accommodationFixedTime)
+	require.NoError(t, err)
+	// 既存の行動の後ろに並べる
+	expectedActivity = expectedActivity.MoveTo(1, accommodationFixedTime)
+
+	// expectExisting は既存の宿泊予約・移動・行動の取得を設定する
+	expectExisting := func(accommodations ...*accommodation.Accommodation) {
+		mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID).Return(accommodations, nil)
+		mockLegRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID).Return(nil, nil)
+		mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID, nil).Return(existingActivities, nil)
+	}
+
+	// expectLoaded は確認ファイルの解析と旅行・既存の予約の取得を設定する
+	expectLoaded := func() {
+		mockBookingParser.EXPECT().Parse(gomock.Any(), service.BookingFileFormatICS).Return(drafts, nil)
+		mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(tr, nil)
+		expectExisting()
+	}
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		in    input.ImportBookingsInput
+		setup func()
+		want  *output.ImportBookingsOutput
+		// wantResourceTypes は変更履歴に記録されるリソースの種類。nil の場合は変更履歴を記録しない
+		wantResourceTypes []history.ResourceType
+		wantErr           error
+	}{
+		{
+			name:  "正常系: ドライランでは下書きと判定結果を返し、保存しない",
+			in:    newInput(true),
+			setup: expectLoaded,
+			want:  output.NewImportBookingsOutput(true, drafts, statuses, nil),
+		},
+		{
+			name: "正常系: キーの指定がなければ保存できる下書きを宿泊予約・移動・行動としてすべて保存する",
+			in:   newInput(false),
+			setup: func() {
+				expectLoaded()
+				mockTimeService.EXPECT().Now().Return(accommodationFixedTime)
+				mockAccommodationRepo.EXPECT().Create(gomock.Any(), expectedStay).Return(nil)
+				mockLegRepo.EXPECT().Create(gomock.Any(), expectedLeg).Return(nil)
+				mockActivityRepo.EXPECT().Create(gomock.Any(), expectedActivity).Return(nil)
+			},
+			want: output.NewImportBookingsOutput(false, drafts, statuses, map[string]string{
+				"ABC": "generated-id-1",
+				"XYZ": "generated-id-2",
+				"TEA": "generated-id-3",
+			}),
+			wantResourceTypes: []history.ResourceType{
+				history.ResourceTypeAccommodation,
+				history.ResourceTypeTransportLeg,
+				history.ResourceTypeActivity,
+			},
+		},
+		{
+			name: "正常系: キーを指定した下書きだけを保存し、同じキーは一度だけ保存する",
+			in:   newInput(false, "ABC", "ABC"),
+			setup: func() {
+				expectLoaded()
+				mockTimeService.EXPECT().Now().Return(accommodationFixedTime)
+				mockAccommodationRepo.EXPECT().Create(gomock.Any(), expectedStay).Return(nil)
+			},
+			want:              output.NewImportBookingsOutput(false, drafts, statuses, map[string]string{"ABC": "generated-id-1"}),
+			wantResourceTypes: []history.ResourceType{history.ResourceTypeAccommodation},
+		},
+		{
+			name: "正常系: 予約番号が同じ宿泊予約があれば重複として保存しない",
+			in:   newInput(false),
+			setup: func() {
+				mockBookingParser.EXPECT().Parse(gomock.Any(), service.BookingFileFormatICS).Return(duplicateDrafts, nil)
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(tr, nil)
+				expectExisting(existingStay)
+			},
+			want: output.NewImportBookingsOutput(false, duplicateDrafts, []bookingimport.Status{bookingimport.StatusDuplicate}, nil),
+		},
+		{
+			name:    "異常系: 保存できない下書きのキーを指定した",
+			in:      newInput(false, "ABC", "LATE"),
+			setup:   expectLoaded,
+			wantErr: bookingimport.NewDraftNotImportableError("LATE", bookingimport.StatusOutsideTripPeriod),
+		},
+		{
+			name:    "異常系: 存在しないキーを指定した",
+			in:      newInput(true, "UNKNOWN"),
+			setup:   expectLoaded,
+			wantErr: bookingimport.NewUnknownDraftKeyError("UNKNOWN"),
+		},
+		{
+			name: "異常系: 解析できないファイルはパーサーのエラーを返す",
+			in:   newInput(true),
+			setup: func() {
+				mockBookingParser.EXPECT().Parse(gomock.Any(), service.BookingFileFormatICS).Return(nil, apperr.NewValidationError("Failed to parse booking file"))
+			},
+			wantErr: apperr.NewValidationError("Failed to parse booking file"),
+		},
+		{
+			name: "異常系: パーサーから予期しないエラーが返される",
+			in:   newInput(true),
+			setup: func() {
+				mockBookingParser.EXPECT().Parse(gomock.Any(), service.BookingFileFormatICS).Return(nil, errors.New("read error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to parse booking file", apperr.WithCause(errors.New("read error"))),
+		},
+		{
+			name:    "異常系: 閲覧者は取り込みできない",
+			ctx:     viewerCtx,
+			in:      newInput(true),
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+		{
+			name: "異常系: 旅行の取得に失敗",
+			in:   newInput(true),
+			setup: func() {
+				mockBookingParser.EXPECT().Parse(gomock.Any(), service.BookingFileFormatICS).Return(drafts, nil)
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get trip", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 宿泊予約の取得に失敗",
+			in:   newInput(true),
+			setup: func() {
+				mockBookingParser.EXPECT().Parse(gomock.Any(), service.BookingFileFormatICS).Return(drafts, nil)
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(tr, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list accommodations", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 移動の取得に失敗",
+			in:   newInput(true),
+			setup: func() {
+				mockBookingParser.EXPECT().Parse(gomock.Any(), service.BookingFileFormatICS).Return(drafts, nil)
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(tr, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID).Return(nil, nil)
+				mockLegRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list transport legs", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 行動の取得に失敗",
+			in:   newInput(true),
+			setup: func() {
+				mockBookingParser.EXPECT().Parse(gomock.Any(), service.BookingFileFormatICS).Return(drafts, nil)
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), accommodationTripID).Return(tr, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID).Return(nil, nil)
+				mockLegRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID).Return(nil, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), accommodationTripID, nil).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list activities", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 保存で予期しないエラーが返される",
+			in:   newInput(false, "ABC"),
+			setup: func() {
+				expectLoaded()
+				mockTimeService.EXPECT().Now().Return(accommodationFixedTime)
+				mockAccommodationRepo.EXPECT().Create(gomock.Any(), expectedStay).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to import bookings", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			ids = 0
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Import(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			if tt.wantResourceTypes == nil {
+				assert.Empty(t, *revisions)
+				return
+			}
+			require.Len(t, *revisions, 1, "1 回の取り込みは 1 つの変更履歴にまとめるべき")
+			var gotResourceTypes []history.ResourceType
+			for _, change := range (*revisions)[0].Changes() {
+				gotResourceTypes = append(gotResourceTypes, change.ResourceType())
+			}
+			assert.Equal(t, tt.wantResourceTypes, gotResourceTypes)
+		})
+	}
+}
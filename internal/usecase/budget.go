@@ -0,0 +1,305 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hata0/travel-api/internal/domain/budget"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/budget.go github.com/hata0/travel-api/internal/usecase BudgetUsecase
+type BudgetUsecase interface {
+	Get(ctx context.Context, tripID string) (*output.GetBudgetOutput, error)
+	Save(ctx context.Context, in input.SaveBudgetInput) error
+	Delete(ctx context.Context, tripID string) error
+	Summary(ctx context.Context, tripID string) (*output.GetBudgetSummaryOutput, error)
+}
+
+type BudgetInteractor struct {
+	budgetRepository   budget.BudgetRepository
+	expenseRepository  expense.ExpenseRepository
+	tripRepository     trip.TripRepository
+	authorizer         tripAuthorizer
+	history            historyRecorder
+	transactionManager transaction_manager.TransactionManager
+	currencyConverter  exchangerate.CurrencyConverter
+	timeService        service.TimeService
+}
+
+func NewBudgetInteractor(
+	budgetRepository budget.BudgetRepository,
+	expenseRepository expense.ExpenseRepository,
+	tripRepository trip.TripRepository,
+	memberRepository membership.MemberRepository,
+	historyRepository history.HistoryRepository,
+	transactionManager transaction_manager.TransactionManager,
+	currencyConverter exchangerate.CurrencyConverter,
+	timeService service.TimeService,
+) BudgetUsecase {
+	return &BudgetInteractor{
+		budgetRepository:   budgetRepository,
+		expenseRepository:  expenseRepository,
+		tripRepository:     tripRepository,
+		authorizer:         newTripAuthorizer(memberRepository),
+		history:            newHistoryRecorder(historyRepository),
+		transactionManager: transactionManager,
+		currencyConverter:  currencyConverter,
+		timeService:        timeService,
+	}
+}
+
+// Get は旅行の予算を取得する
+func (i *BudgetInteractor) Get(ctx context.Context, tripID string) (*output.GetBudgetOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	b, err := i.findBudget(ctx, trip.NewTripID(tripID))
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetBudgetOutput(b), nil
+}
+
+// Save は旅行の予算を作成し、既に存在する場合は置き換える
+func (i *BudgetInteractor) Save(ctx context.Context, in input.SaveBudgetInput) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	categoryLimits := make(map[expense.Category]int64, len(in.CategoryLimits))
+	for rawCategory, limit := range in.CategoryLimits {
+		category, err := expense.ParseCategory(rawCategory)
+		if err != nil {
+			return err
+		}
+		categoryLimits[category] = limit
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(in.TripID))
+	if err != nil {
+		return err
+	}
+
+	now := i.timeService.Now()
+
+	existing, err := i.budgetRepository.FindByTripID(ctx, t.ID())
+	if err != nil && !apperr.IsAppErrorWithCode(err, budget.CodeBudgetNotFound) {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to get budget", apperr.WithCause(err))
+	}
+
+	var b *budget.Budget
+	if existing != nil {
+		b, err = existing.Update(in.Currency, categoryLimits, now)
+	} else {
+		b, err = budget.NewBudget(t.ID(), in.Currency, categoryLimits, now, now)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.budgetRepository.Save(txCtx, b); err != nil {
+			return err
+		}
+		if existing != nil {
+			return i.history.recordUpdated(txCtx, t.ID(), m.UserID(), now, existing, b)
+		}
+		return i.history.recordCreated(txCtx, t.ID(), m.UserID(), now, b)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to save budget", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は旅行の予算を削除する
+func (i *BudgetInteractor) Delete(ctx context.Context, tripID string) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	b, err := i.findBudget(ctx, trip.NewTripID(tripID))
+	if err != nil {
+		return err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.budgetRepository.Delete(txCtx, b.TripID()); err != nil {
+			return err
+		}
+		return i.history.recordDeleted(txCtx, b.TripID(), m.UserID(), i.timeService.Now(), b)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to delete budget", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Summary はカテゴリ別の上限額と支出額を旅行の基準通貨で集計し、比較する。精算の記録は支出に含めない。
+// 旅行に基準通貨がない場合や、予算の通貨を基準通貨に換算できない場合は予算の通貨で集計する。
+// 上限額は集計時点のレートで、支出は支出日時点のレートで換算し、レートが見つからない支出は通貨別の合計として別途返す
+func (i *BudgetInteractor) Summary(ctx context.Context, tripID string) (*output.GetBudgetSummaryOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(tripID))
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := i.findBudget(ctx, t.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	currency, limits, err := i.summaryLimits(ctx, t, b)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := i.expenseRepository.FindByTripID(ctx, b.TripID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list expenses", apperr.WithCause(err))
+	}
+
+	spent := make(map[expense.Category]money.Money)
+	unconverted := make(map[string]money.Money)
+	for _, e := range expenses {
+		// 精算は参加者間の立て替えの返済であり、旅行の支出ではないため集計しない
+		if e.Category() == expense.CategorySettlement {
+			continue
+		}
+
+		amount, err := i.currencyConverter.Convert(ctx, e.Amount(), currency, e.SpentOn())
+		if apperr.IsAppErrorWithCode(err, exchangerate.CodeExchangeRateNotFound) {
+			currency := e.Amount().Currency()
+			total, err := addMoney(unconverted, currency, e.Amount())
+			if err != nil {
+				return nil, err
+			}
+			unconverted[currency] = total
+			continue
+		}
+		if err != nil {
+			if apperr.IsAppError(err) {
+				return nil, err
+			}
+			return nil, apperr.NewInternalError("Failed to convert expense amount", apperr.WithCause(err))
+		}
+
+		total, err := addMoney(spent, e.Category(), amount)
+		if err != nil {
+			return nil, err
+		}
+		spent[e.Category()] = total
+	}
+
+	summary, err := budget.Summarize(currency, limits, spent)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetBudgetSummaryOutput(summary, sortedByCurrency(unconverted)), nil
+}
+
+// summaryLimits は集計に使う通貨と、その通貨で表したカテゴリごとの上限額を返す。
+// 旅行に基準通貨がない場合や、上限額を基準通貨に換算するレートが見つからない場合は予算の通貨のまま返す
+func (i *BudgetInteractor) summaryLimits(ctx context.Context, t *trip.Trip, b *budget.Budget) (string, map[expense.Category]money.Money, error) {
+	limits := b.CategoryLimits()
+	currency := t.HomeCurrency()
+	if currency == "" || currency == b.Currency() {
+		return b.Currency(), limits, nil
+	}
+
+	now := i.timeService.Now()
+	converted := make(map[expense.Category]money.Money, len(limits))
+	for category, limit := range limits {
+		amount, err := i.currencyConverter.Convert(ctx, limit, currency, now)
+		if apperr.IsAppErrorWithCode(err, exchangerate.CodeExchangeRateNotFound) {
+			return b.Currency(), limits, nil
+		}
+		if err != nil {
+			if apperr.IsAppError(err) {
+				return "", nil, err
+			}
+			return "", nil, apperr.NewInternalError("Failed to convert budget limit", apperr.WithCause(err))
+		}
+		converted[category] = amount
+	}
+	return currency, converted, nil
+}
+
+// findTrip は予算の親となる旅行を取得する
+func (i *BudgetInteractor) findTrip(ctx context.Context, tripID trip.TripID) (*trip.Trip, error) {
+	t, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+	return t, nil
+}
+
+// findBudget は旅行の予算を取得する
+func (i *BudgetInteractor) findBudget(ctx context.Context, tripID trip.TripID) (*budget.Budget, error) {
+	b, err := i.budgetRepository.FindByTripID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get budget", apperr.WithCause(err))
+	}
+	return b, nil
+}
+
+// addMoney は集計中の合計に金額を加算した結果を返す
+func addMoney[K comparable](totals map[K]money.Money, key K, amount money.Money) (money.Money, error) {
+	total, ok := totals[key]
+	if !ok {
+		return amount, nil
+	}
+	return total.Add(amount)
+}
+
+// sortedByCurrency は通貨別の合計を通貨コード順に並べる
+func sortedByCurrency(totals map[string]money.Money) []money.Money {
+	sorted := make([]money.Money, 0, len(totals))
+	for _, total := range totals {
+		sorted = append(sorted, total)
+	}
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].Currency() < sorted[b].Currency()
+	})
+	return sorted
+}
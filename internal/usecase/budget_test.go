@@ -0,0 +1,533 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/budget"
+	mock_budget "github.com/hata0/travel-api/internal/domain/budget/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+	mock_exchangerate "github.com/hata0/travel-api/internal/domain/exchangerate/mock"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	mock_expense "github.com/hata0/travel-api/internal/domain/expense/mock"
+	"github.com/hata0/travel-api/internal/domain/history"
+	mock_history "github.com/hata0/travel-api/internal/domain/history/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var (
+	budgetFixedTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	budgetTripID    = trip.NewTripID("trip-id")
+)
+
+// newBudgetTestBudget は食費 10,000 円、交通費 20,000 円を上限とする予算を生成する
+func newBudgetTestBudget(t *testing.T) *budget.Budget {
+	t.Helper()
+
+	b, err := budget.NewBudget(budgetTripID, "JPY", map[expense.Category]int64{
+		expense.CategoryFood:      10000,
+		expense.CategoryTransport: 20000,
+	}, budgetFixedTime, budgetFixedTime)
+	require.NoError(t, err)
+
+	return b
+}
+
+func newBudgetTestExpense(t *testing.T, id string, amount int64, currency string, category expense.Category) *expense.Expense {
+	t.Helper()
+
+	m, err := money.NewMoney(amount, currency)
+	require.NoError(t, err)
+
+	return expense.NewExpense(expense.NewExpenseID(id), budgetTripID, m, category,
+		budgetFixedTime, "Alice", "", nil, nil, budgetFixedTime, budgetFixedTime)
+}
+
+// newBudgetTestMoney は集計結果と比較する金額を生成する
+func newBudgetTestMoney(t *testing.T, amount int64, currency string) output.Money {
+	t.Helper()
+
+	m, err := money.NewMoney(amount, currency)
+	require.NoError(t, err)
+
+	return output.Money{Amount: m.Amount(), Decimal: m.Decimal(), Currency: m.Currency()}
+}
+
+// newBudgetTestCategory は集計結果と比較するカテゴリ別の集計を生成する。limit を省略した場合は上限のないカテゴリとする
+func newBudgetTestCategory(t *testing.T, category expense.Category, currency string, spent int64, limit ...int64) *output.BudgetCategorySummary {
+	t.Helper()
+
+	c := &output.BudgetCategorySummary{
+		Category: category.String(),
+		Spent:    newBudgetTestMoney(t, spent, currency),
+	}
+	if len(limit) > 0 {
+		l := newBudgetTestMoney(t, limit[0], currency)
+		remaining := newBudgetTestMoney(t, limit[0]-spent, currency)
+		c.Limit, c.Remaining = &l, &remaining
+	}
+	return c
+}
+
+func TestBudgetInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockConverter := mock_exchangerate.NewMockCurrencyConverter(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+
+	interactor := NewBudgetInteractor(mockBudgetRepo, mockExpenseRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockConverter, mockTimeService)
+
+	b := newBudgetTestBudget(t)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *output.GetBudgetOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 予算が取得できる",
+			setup: func() {
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(b, nil)
+			},
+			want: output.NewGetBudgetOutput(b),
+		},
+		{
+			name: "異常系: 予算が存在しない",
+			setup: func() {
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(nil, budget.NewBudgetNotFoundError())
+			},
+			wantErr: budget.NewBudgetNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get budget", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.Get(newActorContext(), "trip-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBudgetInteractor_Save(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockConverter := mock_exchangerate.NewMockCurrencyConverter(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	interactor := NewBudgetInteractor(mockBudgetRepo, mockExpenseRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockConverter, mockTimeService)
+
+	validInput := input.SaveBudgetInput{
+		TripID:         "trip-id",
+		Currency:       "EUR",
+		CategoryLimits: map[string]int64{"food": 50000},
+	}
+	invalidCategoryInput := validInput
+	invalidCategoryInput.CategoryLimits = map[string]int64{"unknown": 1}
+	saveTime := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	testTrip := trip.NewTrip(budgetTripID, "Trip", nil, "", budgetFixedTime, budgetFixedTime)
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		in    input.SaveBudgetInput
+		setup func()
+		// wantAction は記録される変更履歴の操作
+		wantAction history.Action
+		wantErr    error
+	}{
+		{
+			name: "正常系: 予算が新規作成される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), budgetTripID).Return(testTrip, nil)
+				mockTimeService.EXPECT().Now().Return(saveTime)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(nil, budget.NewBudgetNotFoundError())
+				mockBudgetRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, b *budget.Budget) error {
+						assert.Equal(t, "EUR", b.Currency())
+						limit, ok := b.CategoryLimit(expense.CategoryFood)
+						assert.True(t, ok)
+						assert.Equal(t, int64(50000), limit.Amount())
+						assert.Equal(t, saveTime, b.CreatedAt())
+						return nil
+					})
+			},
+			wantAction: history.ActionCreated,
+		},
+		{
+			name: "正常系: 既存の予算は作成日時を維持して置き換えられる",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), budgetTripID).Return(testTrip, nil)
+				mockTimeService.EXPECT().Now().Return(saveTime)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(newBudgetTestBudget(t), nil)
+				mockBudgetRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, b *budget.Budget) error {
+						_, hasTransport := b.CategoryLimit(expense.CategoryTransport)
+						assert.False(t, hasTransport, "指定されなかったカテゴリの上限は削除されるべき")
+						assert.Equal(t, budgetFixedTime, b.CreatedAt())
+						assert.Equal(t, saveTime, b.UpdatedAt())
+						return nil
+					})
+			},
+			wantAction: history.ActionUpdated,
+		},
+		{
+			name:    "異常系: 不正なカテゴリ",
+			in:      invalidCategoryInput,
+			setup:   func() {},
+			wantErr: expense.NewInvalidCategoryError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), budgetTripID).Return(testTrip, nil)
+				mockTimeService.EXPECT().Now().Return(saveTime)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get budget", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name:    "異常系: 閲覧者は予算を保存できない",
+			ctx:     viewerCtx,
+			in:      validInput,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			err := interactor.Save(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeBudget, tt.wantAction)
+			}
+		})
+	}
+}
+
+func TestBudgetInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockConverter := mock_exchangerate.NewMockCurrencyConverter(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+
+	interactor := NewBudgetInteractor(mockBudgetRepo, mockExpenseRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockConverter, mockTimeService)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 予算が削除できる",
+			setup: func() {
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(newBudgetTestBudget(t), nil)
+				mockBudgetRepo.EXPECT().Delete(gomock.Any(), budgetTripID).Return(nil)
+				mockTimeService.EXPECT().Now().Return(budgetFixedTime)
+			},
+		},
+		{
+			name: "異常系: 予算が存在しない",
+			setup: func() {
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(nil, budget.NewBudgetNotFoundError())
+			},
+			wantErr: budget.NewBudgetNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(newBudgetTestBudget(t), nil)
+				mockBudgetRepo.EXPECT().Delete(gomock.Any(), budgetTripID).Return(errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to delete budget", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			err := interactor.Delete(newActorContext(), "trip-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeBudget, history.ActionDeleted)
+			}
+		})
+	}
+}
+
+func TestBudgetInteractor_Summary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockConverter := mock_exchangerate.NewMockCurrencyConverter(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+
+	interactor := NewBudgetInteractor(mockBudgetRepo, mockExpenseRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockConverter, mockTimeService)
+
+	noHomeCurrencyTrip := trip.NewTrip(budgetTripID, "Trip", nil, "", budgetFixedTime, budgetFixedTime)
+	usdTrip := trip.NewTrip(budgetTripID, "Trip", nil, "USD", budgetFixedTime, budgetFixedTime)
+	summaryTime := budgetFixedTime.Add(24 * time.Hour)
+	// 1 円を 0.01 USD（1 セント）として換算する
+	jpyToUSD := func(_ context.Context, amount money.Money, _ string, _ time.Time) (money.Money, error) {
+		if amount.Currency() == "USD" {
+			return amount, nil
+		}
+		return money.NewMoney(amount.Amount(), "USD")
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *output.GetBudgetSummaryOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 基準通貨が未設定の旅行は予算の通貨で集計され、換算できない支出は別に返され、精算は含まれない",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), budgetTripID).Return(noHomeCurrencyTrip, nil)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(newBudgetTestBudget(t), nil)
+				mockExpenseRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return([]*expense.Expense{
+					newBudgetTestExpense(t, "e1", 4000, "JPY", expense.CategoryFood),
+					newBudgetTestExpense(t, "e2", 7000, "JPY", expense.CategoryFood),
+					newBudgetTestExpense(t, "e3", 2500, "JPY", expense.CategoryShopping),
+					newBudgetTestExpense(t, "e4", 1250, "USD", expense.CategoryFood),
+					newBudgetTestExpense(t, "e5", 300, "EUR", expense.CategoryTransport),
+					newBudgetTestExpense(t, "e6", 250, "EUR", expense.CategoryOther),
+					newBudgetTestExpense(t, "e7", 5000, "JPY", expense.CategorySettlement),
+				}, nil)
+				mockConverter.EXPECT().
+					Convert(gomock.Any(), gomock.Any(), "JPY", budgetFixedTime).
+					DoAndReturn(func(_ context.Context, amount money.Money, _ string, _ time.Time) (money.Money, error) {
+						switch amount.Currency() {
+						case "JPY":
+							return amount, nil
+						case "USD":
+							// 12.50 USD を 1,950 円として換算する
+							return money.NewMoney(1950, "JPY")
+						default:
+							return money.Money{}, exchangerate.NewExchangeRateNotFoundError()
+						}
+					}).Times(6)
+			},
+			want: &output.GetBudgetSummaryOutput{
+				Currency: "JPY",
+				Categories: []*output.BudgetCategorySummary{
+					// 換算できない支出は集計に含まれない
+					newBudgetTestCategory(t, expense.CategoryTransport, "JPY", 0, 20000),
+					// 外貨の支出は換算して加算され、上限超過は負の残額になる
+					newBudgetTestCategory(t, expense.CategoryFood, "JPY", 12950, 10000),
+					newBudgetTestCategory(t, expense.CategoryShopping, "JPY", 2500),
+				},
+				TotalLimit:  newBudgetTestMoney(t, 30000, "JPY"),
+				TotalSpent:  newBudgetTestMoney(t, 15450, "JPY"),
+				Unconverted: []output.Money{newBudgetTestMoney(t, 550, "EUR")},
+			},
+		},
+		{
+			name: "正常系: 旅行の基準通貨で上限額と支出を集計する",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), budgetTripID).Return(usdTrip, nil)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(newBudgetTestBudget(t), nil)
+				mockExpenseRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return([]*expense.Expense{
+					newBudgetTestExpense(t, "e1", 15000, "JPY", expense.CategoryFood),
+					newBudgetTestExpense(t, "e2", 1250, "USD", expense.CategoryFood),
+				}, nil)
+				mockTimeService.EXPECT().Now().Return(summaryTime)
+				// 上限額は集計時点のレートで、支出は支出日時点のレートで換算する
+				mockConverter.EXPECT().Convert(gomock.Any(), gomock.Any(), "USD", summaryTime).DoAndReturn(jpyToUSD).Times(2)
+				mockConverter.EXPECT().Convert(gomock.Any(), gomock.Any(), "USD", budgetFixedTime).DoAndReturn(jpyToUSD).Times(2)
+			},
+			want: &output.GetBudgetSummaryOutput{
+				Currency: "USD",
+				Categories: []*output.BudgetCategorySummary{
+					newBudgetTestCategory(t, expense.CategoryTransport, "USD", 0, 20000),
+					newBudgetTestCategory(t, expense.CategoryFood, "USD", 16250, 10000),
+				},
+				TotalLimit:  newBudgetTestMoney(t, 30000, "USD"),
+				TotalSpent:  newBudgetTestMoney(t, 16250, "USD"),
+				Unconverted: []output.Money{},
+			},
+		},
+		{
+			name: "正常系: 上限額を基準通貨に換算できない場合は予算の通貨で集計する",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), budgetTripID).Return(usdTrip, nil)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(newBudgetTestBudget(t), nil)
+				mockExpenseRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return([]*expense.Expense{
+					newBudgetTestExpense(t, "e1", 4000, "JPY", expense.CategoryFood),
+				}, nil)
+				mockTimeService.EXPECT().Now().Return(budgetFixedTime)
+				mockConverter.EXPECT().Convert(gomock.Any(), gomock.Any(), "USD", budgetFixedTime).
+					Return(money.Money{}, exchangerate.NewExchangeRateNotFoundError())
+				mockConverter.EXPECT().Convert(gomock.Any(), gomock.Any(), "JPY", budgetFixedTime).
+					DoAndReturn(func(_ context.Context, amount money.Money, _ string, _ time.Time) (money.Money, error) {
+						return amount, nil
+					})
+			},
+			want: &output.GetBudgetSummaryOutput{
+				Currency: "JPY",
+				Categories: []*output.BudgetCategorySummary{
+					newBudgetTestCategory(t, expense.CategoryTransport, "JPY", 0, 20000),
+					newBudgetTestCategory(t, expense.CategoryFood, "JPY", 4000, 10000),
+				},
+				TotalLimit:  newBudgetTestMoney(t, 30000, "JPY"),
+				TotalSpent:  newBudgetTestMoney(t, 4000, "JPY"),
+				Unconverted: []output.Money{},
+			},
+		},
+		{
+			name: "異常系: 換算中の予期しないエラー",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), budgetTripID).Return(noHomeCurrencyTrip, nil)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(newBudgetTestBudget(t), nil)
+				mockExpenseRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return([]*expense.Expense{
+					newBudgetTestExpense(t, "e1", 1250, "USD", expense.CategoryFood),
+				}, nil)
+				mockConverter.EXPECT().Convert(gomock.Any(), gomock.Any(), "JPY", gomock.Any()).
+					Return(money.Money{}, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to convert expense amount", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name: "異常系: 旅行が存在しない",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), budgetTripID).Return(nil, trip.NewTripNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: 予算が存在しない",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), budgetTripID).Return(noHomeCurrencyTrip, nil)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), budgetTripID).Return(nil, budget.NewBudgetNotFoundError())
+			},
+			wantErr: budget.NewBudgetNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.Summary(newActorContext(), "trip-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
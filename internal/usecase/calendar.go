@@ -0,0 +1,448 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/calendarfeed"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/pagination"
+	"github.com/hata0/travel-api/internal/domain/shared/tokenhash"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+const (
+	// calendarUIDDomain は予定の UID の @ 以降に付ける、このサービスを表す名前
+	calendarUIDDomain = "travel-api"
+	// calendarFeedName はカレンダーフィードを購読したときに表示されるカレンダー名
+	calendarFeedName = "Upcoming trips"
+	// calendarFeedFilename はカレンダーフィードのファイル名
+	calendarFeedFilename = "calendar.ics"
+)
+
+//go:generate mockgen -destination mock/calendar.go github.com/hata0/travel-api/internal/usecase CalendarUsecase
+type CalendarUsecase interface {
+	ExportTrip(ctx context.Context, in input.ExportTripCalendarInput) (*output.ExportCalendarOutput, error)
+	GetFeed(ctx context.Context) (*output.GetCalendarFeedOutput, error)
+	IssueFeed(ctx context.Context) (*output.IssueCalendarFeedOutput, error)
+	RevokeFeed(ctx context.Context) error
+	ExportFeed(ctx context.Context, in input.ExportCalendarFeedInput) (*output.ExportCalendarOutput, error)
+}
+
+type CalendarInteractor struct {
+	feedRepository          calendarfeed.FeedRepository
+	tripRepository          trip.TripRepository
+	accommodationRepository accommodation.AccommodationRepository
+	activityRepository      itinerary.ActivityRepository
+	legRepository           transport.LegRepository
+	authorizer              tripAuthorizer
+	calendarEncoder         service.CalendarEncoder
+	secretService           service.ShareLinkSecretService
+	transactionManager      transaction_manager.TransactionManager
+	timeService             service.TimeService
+	idService               service.IDService
+}
+
+func NewCalendarInteractor(
+	feedRepository calendarfeed.FeedRepository,
+	tripRepository trip.TripRepository,
+	accommodationRepository accommodation.AccommodationRepository,
+	activityRepository itinerary.ActivityRepository,
+	legRepository transport.LegRepository,
+	memberRepository membership.MemberRepository,
+	calendarEncoder service.CalendarEncoder,
+	secretService service.ShareLinkSecretService,
+	transactionManager transaction_manager.TransactionManager,
+	timeService service.TimeService,
+	idService service.IDService,
+) CalendarUsecase {
+	return &CalendarInteractor{
+		feedRepository:          feedRepository,
+		tripRepository:          tripRepository,
+		accommodationRepository: accommodationRepository,
+		activityRepository:      activityRepository,
+		legRepository:           legRepository,
+		authorizer:              newTripAuthorizer(memberRepository),
+		calendarEncoder:         calendarEncoder,
+		secretService:           secretService,
+		transactionManager:      transactionManager,
+		timeService:             timeService,
+		idService:               idService,
+	}
+}
+
+// ExportTrip は旅行の期間と宿泊予約、行動を iCalendar 形式で書き出す。閲覧者以上のメンバーが書き出せる
+func (i *CalendarInteractor) ExportTrip(ctx context.Context, in input.ExportTripCalendarInput) (*output.ExportCalendarOutput, error) {
+	loc, err := parseTimeZone(in.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID := trip.NewTripID(in.TripID)
+	if _, err := i.authorizer.authorize(ctx, tripID, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	t, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+
+	events, err := i.tripEvents(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	content := i.calendarEncoder.Encode(service.Calendar{
+		Name:        t.Name(),
+		Location:    loc,
+		Events:      events,
+		GeneratedAt: i.timeService.Now(),
+	})
+
+	return output.NewExportCalendarOutput(t.Name()+".ics", content), nil
+}
+
+// GetFeed は実行ユーザーの有効なカレンダーフィードを取得する
+func (i *CalendarInteractor) GetFeed(ctx context.Context) (*output.GetCalendarFeedOutput, error) {
+	feed, err := i.findActiveFeed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetCalendarFeedOutput(feed), nil
+}
+
+// IssueFeed は実行ユーザーのカレンダーフィードを発行する。すでに有効なフィードがある場合はそれを無効化して発行し直すため、
+// 以前の URL で購読しているカレンダーアプリは取得できなくなる。トークンは発行時の出力でしか取得できない
+func (i *CalendarInteractor) IssueFeed(ctx context.Context) (*output.IssueCalendarFeedOutput, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := i.secretService.GenerateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := i.timeService.Now()
+	feed := calendarfeed.NewFeed(
+		calendarfeed.NewFeedID(i.idService.Generate()),
+		userID,
+		tokenhash.Hash(token),
+		now,
+	)
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		current, err := i.feedRepository.FindActiveByUserID(txCtx, userID)
+		if err != nil && !apperr.IsAppErrorWithCode(err, calendarfeed.CodeCalendarFeedNotFound) {
+			return err
+		}
+		if current != nil {
+			revoked, err := current.Revoke(now)
+			if err != nil {
+				return err
+			}
+			if err := i.feedRepository.Update(txCtx, revoked); err != nil {
+				return err
+			}
+		}
+
+		return i.feedRepository.Create(txCtx, feed)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to issue calendar feed", apperr.WithCause(err))
+	}
+
+	return output.NewIssueCalendarFeedOutput(feed, token), nil
+}
+
+// RevokeFeed は実行ユーザーの有効なカレンダーフィードを無効化する
+func (i *CalendarInteractor) RevokeFeed(ctx context.Context) error {
+	feed, err := i.findActiveFeed(ctx)
+	if err != nil {
+		return err
+	}
+
+	revoked, err := feed.Revoke(i.timeService.Now())
+	if err != nil {
+		return err
+	}
+
+	if err := i.feedRepository.Update(ctx, revoked); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to revoke calendar feed", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// ExportFeed はカレンダーフィードのトークンで、フィードを発行したユーザーの今後の旅行を iCalendar 形式で書き出す。
+// 認証は不要で、無効化済みのフィードは存在しないフィードと区別しない。
+// 旅行は終了日が今日以降のものを開始日の近い順に最大 pagination.MaxLimit 件含め、期間未定の旅行は含めない
+func (i *CalendarInteractor) ExportFeed(ctx context.Context, in input.ExportCalendarFeedInput) (*output.ExportCalendarOutput, error) {
+	loc, err := parseTimeZone(in.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	feed, err := i.feedRepository.FindByTokenHash(ctx, tokenhash.Hash(in.Token))
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get calendar feed", apperr.WithCause(err))
+	}
+	if !feed.IsActive() {
+		return nil, calendarfeed.NewCalendarFeedNotFoundError()
+	}
+
+	now := i.timeService.Now()
+	query, err := trip.NewListQuery(trip.ListQueryParams{
+		Sort:  trip.SortByStartDate.String(),
+		From:  &now,
+		Limit: pagination.MaxLimit,
+	})
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to build upcoming trips query", apperr.WithCause(err))
+	}
+
+	page, err := i.tripRepository.FindByMember(ctx, feed.UserID(), query)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list upcoming trips", apperr.WithCause(err))
+	}
+
+	var events []service.CalendarEvent
+	for _, t := range page.Items {
+		tripEvents, err := i.tripEvents(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, tripEvents...)
+	}
+
+	content := i.calendarEncoder.Encode(service.Calendar{
+		Name:        calendarFeedName,
+		Location:    loc,
+		Events:      events,
+		GeneratedAt: now,
+	})
+
+	return output.NewExportCalendarOutput(calendarFeedFilename, content), nil
+}
+
+// findActiveFeed は実行ユーザーの有効なカレンダーフィードを取得する
+func (i *CalendarInteractor) findActiveFeed(ctx context.Context) (*calendarfeed.Feed, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feed, err := i.feedRepository.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get calendar feed", apperr.WithCause(err))
+	}
+
+	return feed, nil
+}
+
+// tripEvents は旅行の期間を終日の予定、宿泊予約をチェックインからチェックアウトまでの予定、行動を行程の予定、
+// 移動を出発から到着までの予定に変換する
+func (i *CalendarInteractor) tripEvents(ctx context.Context, t *trip.Trip) ([]service.CalendarEvent, error) {
+	accommodations, err := i.accommodationRepository.FindByTripID(ctx, t.ID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list accommodations", apperr.WithCause(err))
+	}
+
+	activities, err := i.activityRepository.FindByTripID(ctx, t.ID(), nil)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list activities", apperr.WithCause(err))
+	}
+
+	legs, err := i.legRepository.FindByTripID(ctx, t.ID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list transport legs", apperr.WithCause(err))
+	}
+
+	events := make([]service.CalendarEvent, 0, len(accommodations)+len(activities)+len(legs)+1)
+	if period := t.Period(); period != nil {
+		events = append(events, service.CalendarEvent{
+			UID:          calendarUID("trip", t.ID().String()),
+			Summary:      t.Name(),
+			AllDay:       true,
+			Start:        period.StartDate(),
+			End:          period.EndDate().AddDate(0, 0, 1),
+			LastModified: t.UpdatedAt(),
+		})
+	}
+
+	for _, a := range accommodations {
+		events = append(events, accommodationEvent(t, a))
+	}
+
+	for _, a := range activities {
+		events = append(events, activityEvent(t, a))
+	}
+
+	for _, l := range legs {
+		events = append(events, legEvent(t, l))
+	}
+
+	return events, nil
+}
+
+// activityEvent は行動を予定に変換する。開始日時が決まっている行動は行動のタイムゾーンで書き出す時刻のある予定とし、
+// 終了日時がなければ終了のない予定とする。開始日時が決まっていない行動はその日の終日の予定とする
+func activityEvent(t *trip.Trip, a *itinerary.Activity) service.CalendarEvent {
+	event := service.CalendarEvent{
+		UID:          calendarUID("activity", a.ID().String()),
+		Summary:      a.Title(),
+		Description:  activityDescription(t, a),
+		LastModified: a.UpdatedAt(),
+	}
+	if place := a.Place(); place != nil {
+		event.Location = place.Name()
+	}
+
+	startAt := a.StartAt()
+	if startAt == nil {
+		event.AllDay = true
+		event.Start = a.Date()
+		event.End = a.Date().AddDate(0, 0, 1)
+		return event
+	}
+
+	event.Start = *startAt
+	if endAt := a.EndAt(); endAt != nil {
+		event.End = *endAt
+	}
+	if tz := a.Timezone(); tz != nil {
+		event.StartTimeZone = tz.Location()
+		event.EndTimeZone = tz.Location()
+	}
+	return event
+}
+
+// accommodationEvent は宿泊予約をチェックインからチェックアウトまでの予定に変換する。
+// 宿泊先のタイムゾーンが分かる場合はその現地時刻で書き出す
+func accommodationEvent(t *trip.Trip, a *accommodation.Accommodation) service.CalendarEvent {
+	event := service.CalendarEvent{
+		UID:          calendarUID("accommodation", a.ID().String()),
+		Summary:      a.Name(),
+		Description:  accommodationDescription(t, a),
+		Location:     a.Address(),
+		Start:        a.Stay().CheckInAt(),
+		End:          a.Stay().CheckOutAt(),
+		LastModified: a.UpdatedAt(),
+	}
+	if tz := a.Timezone(); tz != nil {
+		event.StartTimeZone = tz.Location()
+		event.EndTimeZone = tz.Location()
+	}
+	return event
+}
+
+// legEvent は移動を予定に変換する。出発日時は出発地、到着日時は到着地のタイムゾーンで書き出し、
+// タイムゾーンが分からない場所の日時は UTC で書き出す
+func legEvent(t *trip.Trip, l *transport.Leg) service.CalendarEvent {
+	event := service.CalendarEvent{
+		UID:          calendarUID("transport-leg", l.ID().String()),
+		Summary:      l.Summary(),
+		Description:  legDescription(t, l),
+		Location:     l.Origin().Name(),
+		Start:        l.DepartureAt(),
+		End:          l.ArrivalAt(),
+		LastModified: l.UpdatedAt(),
+	}
+	if tz := l.DepartureTimezone(); tz != nil {
+		event.StartTimeZone = tz.Location()
+	}
+	if tz := l.ArrivalTimezone(); tz != nil {
+		event.EndTimeZone = tz.Location()
+	}
+	return event
+}
+
+// legDescription は移動の予定の説明として、旅行名・到着地・メモを改行区切りで返す
+func legDescription(t *trip.Trip, l *transport.Leg) string {
+	lines := []string{"Trip: " + t.Name(), "Arrival: " + l.Destination().Name()}
+	if l.Notes() != "" {
+		lines = append(lines, l.Notes())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// activityDescription は行動の予定の説明として、旅行名とメモを改行区切りで返す
+func activityDescription(t *trip.Trip, a *itinerary.Activity) string {
+	lines := []string{"Trip: " + t.Name()}
+	if a.Notes() != "" {
+		lines = append(lines, a.Notes())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// accommodationDescription は宿泊予約の予定の説明として、旅行名・予約番号・メモを改行区切りで返す
+func accommodationDescription(t *trip.Trip, a *accommodation.Accommodation) string {
+	lines := []string{"Trip: " + t.Name()}
+	if a.ConfirmationNumber() != "" {
+		lines = append(lines, "Confirmation: "+a.ConfirmationNumber())
+	}
+	if a.Notes() != "" {
+		lines = append(lines, a.Notes())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// calendarUID は書き出すたびに変わらない予定の UID を返す
+func calendarUID(kind, id string) string {
+	return kind + "-" + id + "@" + calendarUIDDomain
+}
+
+// parseTimeZone は IANA のタイムゾーン名を解析する。空の場合は nil を返す
+func parseTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return nil, nil
+	}
+	// LoadLocation は "Local" をサーバーのタイムゾーンとして受け付けるため、明示的に除外する
+	if name == "Local" {
+		return nil, apperr.NewValidationError("tz must be an IANA time zone name")
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, apperr.NewValidationError("tz must be an IANA time zone name", apperr.WithCause(err))
+	}
+	return loc, nil
+}
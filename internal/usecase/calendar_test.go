@@ -0,0 +1,761 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	mock_accommodation "github.com/hata0/travel-api/internal/domain/accommodation/mock"
+	"github.com/hata0/travel-api/internal/domain/calendarfeed"
+	mock_calendarfeed "github.com/hata0/travel-api/internal/domain/calendarfeed/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	mock_itinerary "github.com/hata0/travel-api/internal/domain/itinerary/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/actor"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/pagination"
+	"github.com/hata0/travel-api/internal/domain/shared/tokenhash"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	mock_transport "github.com/hata0/travel-api/internal/domain/transport/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var calendarFixedTime = time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+// expectEncode は書き出すカレンダーを記録し、固定の内容を返すよう設定する
+func expectEncode(encoder *mock_service.MockCalendarEncoder) *service.Calendar {
+	var encoded service.Calendar
+	encoder.EXPECT().Encode(gomock.Any()).DoAndReturn(func(cal service.Calendar) []byte {
+		encoded = cal
+		return []byte("BEGIN:VCALENDAR")
+	})
+	return &encoded
+}
+
+func newTestFeed(revokedAt *time.Time) *calendarfeed.Feed {
+	return calendarfeed.RestoreFeed(
+		calendarfeed.NewFeedID("feed-id"),
+		testActorID,
+		tokenhash.Hash("token"),
+		calendarFixedTime,
+		revokedAt,
+	)
+}
+
+// newCalendarTestActivity は開始・終了日時を指定した行動を作成する。startAt が nil の場合は時刻の決まっていない行動とする
+func newCalendarTestActivity(t *testing.T, id string, tripID trip.TripID, startAt, endAt *time.Time) *itinerary.Activity {
+	t.Helper()
+
+	tz, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	place, err := geo.NewPlace("Kinkaku-ji", nil, nil)
+	require.NoError(t, err)
+	a, err := itinerary.NewActivity(itinerary.NewActivityID(id), tripID, time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC), 0, "Temple", &place, &tz, startAt, endAt, itinerary.TravelModeWalk, "Notes", calendarFixedTime, calendarFixedTime)
+	require.NoError(t, err)
+	return a
+}
+
+// newCalendarTestLeg は 8/2 に羽田からホノルルへ向かう飛行機の移動を作成する
+func newCalendarTestLeg(t *testing.T, id string, tripID trip.TripID) *transport.Leg {
+	t.Helper()
+
+	tokyo, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	honolulu, err := geo.NewTimezone("Pacific/Honolulu")
+	require.NoError(t, err)
+	origin, err := geo.NewPlace("Haneda", nil, &tokyo)
+	require.NoError(t, err)
+	destination, err := geo.NewPlace("Honolulu", nil, &honolulu)
+	require.NoError(t, err)
+	departureAt := time.Date(2023, 8, 2, 12, 0, 0, 0, time.UTC)
+	l, err := transport.NewLeg(transport.NewLegID(id), tripID, transport.ModeFlight, origin, destination, departureAt, departureAt.Add(7*time.Hour), "ANA", "NH186", "", calendarFixedTime, calendarFixedTime)
+	require.NoError(t, err)
+	return l
+}
+
+func TestCalendarInteractor_ExportTrip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFeedRepo := mock_calendarfeed.NewMockFeedRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockEncoder := mock_service.NewMockCalendarEncoder(ctrl)
+	mockSecretService := mock_service.NewMockShareLinkSecretService(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	runInTxDirectly(mockTxManager)
+	mockTimeService.EXPECT().Now().Return(calendarFixedTime).AnyTimes()
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewCalendarInteractor(mockFeedRepo, mockTripRepo, mockAccommodationRepo, mockActivityRepo, mockLegRepo, mockMemberRepo, mockEncoder, mockSecretService, mockTxManager, mockTimeService, mockIDService)
+
+	tr := newPeriodTestTrip(t, accommodationTripID)
+	undecided := trip.NewTrip(accommodationTripID, "Trip", nil, "", calendarFixedTime, calendarFixedTime)
+	a := newAccommodationTestAccommodation(t, "acc-id", tr.ID())
+	startAt := time.Date(2023, 8, 2, 1, 0, 0, 0, time.UTC)
+	endAt := time.Date(2023, 8, 2, 2, 30, 0, 0, time.UTC)
+	timed := newCalendarTestActivity(t, "timed-id", tr.ID(), &startAt, &endAt)
+	open := newCalendarTestActivity(t, "open-id", tr.ID(), &startAt, nil)
+	allDay := newCalendarTestActivity(t, "all-day-id", tr.ID(), nil, nil)
+	l := newCalendarTestLeg(t, "leg-id", tr.ID())
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	outsiderID := user.NewUserID("outsider-user-id")
+	outsiderCtx := actor.WithUserID(context.Background(), outsiderID)
+
+	periodEvent := service.CalendarEvent{
+		UID:     "trip-trip-id@travel-api",
+		Summary: "Trip",
+		AllDay:  true,
+		Start:   time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+		// 終了日は最終日の翌日とする
+		End:          time.Date(2023, 8, 4, 0, 0, 0, 0, time.UTC),
+		LastModified: tr.UpdatedAt(),
+	}
+	stayEvent := service.CalendarEvent{
+		UID:           "accommodation-acc-id@travel-api",
+		Summary:       "Hotel",
+		Description:   "Trip: Trip\nConfirmation: CONF",
+		Location:      "Address",
+		Start:         a.Stay().CheckInAt(),
+		End:           a.Stay().CheckOutAt(),
+		StartTimeZone: a.Timezone().Location(),
+		EndTimeZone:   a.Timezone().Location(),
+		LastModified:  a.UpdatedAt(),
+	}
+	timedEvent := service.CalendarEvent{
+		UID:           "activity-timed-id@travel-api",
+		Summary:       "Temple",
+		Description:   "Trip: Trip\nNotes",
+		Location:      "Kinkaku-ji",
+		Start:         startAt,
+		End:           endAt,
+		StartTimeZone: timed.Timezone().Location(),
+		EndTimeZone:   timed.Timezone().Location(),
+		LastModified:  calendarFixedTime,
+	}
+	// 終了日時のない行動は終了のない予定とする
+	openEvent := service.CalendarEvent{
+		UID:           "activity-open-id@travel-api",
+		Summary:       "Temple",
+		Description:   "Trip: Trip\nNotes",
+		Location:      "Kinkaku-ji",
+		Start:         startAt,
+		StartTimeZone: open.Timezone().Location(),
+		EndTimeZone:   open.Timezone().Location(),
+		LastModified:  calendarFixedTime,
+	}
+	allDayEvent := service.CalendarEvent{
+		UID:          "activity-all-day-id@travel-api",
+		Summary:      "Temple",
+		Description:  "Trip: Trip\nNotes",
+		Location:     "Kinkaku-ji",
+		AllDay:       true,
+		Start:        time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC),
+		End:          time.Date(2023, 8, 3, 0, 0, 0, 0, time.UTC),
+		LastModified: calendarFixedTime,
+	}
+	legEvent := service.CalendarEvent{
+		UID:           "transport-leg-leg-id@travel-api",
+		Summary:       "ANA NH186: Haneda → Honolulu",
+		Description:   "Trip: Trip\nArrival: Honolulu",
+		Location:      "Haneda",
+		Start:         l.DepartureAt(),
+		End:           l.ArrivalAt(),
+		StartTimeZone: l.DepartureTimezone().Location(),
+		EndTimeZone:   l.ArrivalTimezone().Location(),
+		LastModified:  calendarFixedTime,
+	}
+
+	// expectSources は旅行と、旅行に属する宿泊予約・行動・移動を返すよう設定する
+	expectSources := func(tr *trip.Trip, accommodations []*accommodation.Accommodation, activities []*itinerary.Activity, legs []*transport.Leg) {
+		mockTripRepo.EXPECT().FindByID(gomock.Any(), tr.ID()).Return(tr, nil)
+		mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), tr.ID()).Return(accommodations, nil)
+		mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), tr.ID(), nil).Return(activities, nil)
+		mockLegRepo.EXPECT().FindByTripID(gomock.Any(), tr.ID()).Return(legs, nil)
+	}
+
+	var encoded *service.Calendar
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		in    input.ExportTripCalendarInput
+		setup func()
+		want  *output.ExportCalendarOutput
+		// wantCalendar は書き出しを依頼したカレンダー
+		wantCalendar *service.Calendar
+		wantErr      error
+	}{
+		{
+			name: "正常系: 旅行の期間を終日の予定、宿泊予約を宿泊先のタイムゾーンで時刻のある予定として書き出す",
+			ctx:  viewerCtx,
+			in:   input.ExportTripCalendarInput{TripID: tr.ID().String(), TimeZone: "Asia/Tokyo"},
+			setup: func() {
+				expectSources(tr, []*accommodation.Accommodation{a}, nil, nil)
+				encoded = expectEncode(mockEncoder)
+			},
+			want: output.NewExportCalendarOutput("Trip.ics", []byte("BEGIN:VCALENDAR")),
+			wantCalendar: &service.Calendar{
+				Name:        "Trip",
+				Location:    tokyo,
+				Events:      []service.CalendarEvent{periodEvent, stayEvent},
+				GeneratedAt: calendarFixedTime,
+			},
+		},
+		{
+			name: "正常系: 開始日時のある行動は行動のタイムゾーンで時刻のある予定、ない行動は終日の予定として書き出す",
+			ctx:  viewerCtx,
+			in:   input.ExportTripCalendarInput{TripID: tr.ID().String()},
+			setup: func() {
+				expectSources(tr, nil, []*itinerary.Activity{timed, open, allDay}, nil)
+				encoded = expectEncode(mockEncoder)
+			},
+			want: output.NewExportCalendarOutput("Trip.ics", []byte("BEGIN:VCALENDAR")),
+			wantCalendar: &service.Calendar{
+				Name:        "Trip",
+				Events:      []service.CalendarEvent{periodEvent, timedEvent, openEvent, allDayEvent},
+				GeneratedAt: calendarFixedTime,
+			},
+		},
+		{
+			name: "正常系: 移動は出発日時を出発地、到着日時を到着地のタイムゾーンで書き出す",
+			ctx:  viewerCtx,
+			in:   input.ExportTripCalendarInput{TripID: tr.ID().String()},
+			setup: func() {
+				expectSources(tr, nil, nil, []*transport.Leg{l})
+				encoded = expectEncode(mockEncoder)
+			},
+			want: output.NewExportCalendarOutput("Trip.ics", []byte("BEGIN:VCALENDAR")),
+			wantCalendar: &service.Calendar{
+				Name:        "Trip",
+				Events:      []service.CalendarEvent{periodEvent, legEvent},
+				GeneratedAt: calendarFixedTime,
+			},
+		},
+		{
+			name: "正常系: 期間未定の旅行は期間の予定を書き出さない",
+			ctx:  viewerCtx,
+			in:   input.ExportTripCalendarInput{TripID: undecided.ID().String()},
+			setup: func() {
+				expectSources(undecided, nil, nil, nil)
+				encoded = expectEncode(mockEncoder)
+			},
+			want: output.NewExportCalendarOutput("Trip.ics", []byte("BEGIN:VCALENDAR")),
+			wantCalendar: &service.Calendar{
+				Name:        "Trip",
+				Events:      []service.CalendarEvent{},
+				GeneratedAt: calendarFixedTime,
+			},
+		},
+		{
+			name:    "異常系: 存在しないタイムゾーン名",
+			in:      input.ExportTripCalendarInput{TripID: tr.ID().String(), TimeZone: "Mars/Olympus"},
+			setup:   func() {},
+			wantErr: apperr.NewValidationError("tz must be an IANA time zone name"),
+		},
+		{
+			name:    "異常系: サーバーのローカルタイムゾーンを表す Local は指定できない",
+			in:      input.ExportTripCalendarInput{TripID: tr.ID().String(), TimeZone: "Local"},
+			setup:   func() {},
+			wantErr: apperr.NewValidationError("tz must be an IANA time zone name"),
+		},
+		{
+			name: "異常系: メンバーでない場合は旅行が存在しないものとして扱う",
+			ctx:  outsiderCtx,
+			in:   input.ExportTripCalendarInput{TripID: tr.ID().String()},
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), tr.ID(), outsiderID).Return(nil, membership.NewMemberNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: 旅行の取得に失敗",
+			ctx:  viewerCtx,
+			in:   input.ExportTripCalendarInput{TripID: tr.ID().String()},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), tr.ID()).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get trip", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 宿泊予約の取得に失敗",
+			ctx:  viewerCtx,
+			in:   input.ExportTripCalendarInput{TripID: tr.ID().String()},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), tr.ID()).Return(tr, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), tr.ID()).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list accommodations", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 行動の取得に失敗",
+			ctx:  viewerCtx,
+			in:   input.ExportTripCalendarInput{TripID: tr.ID().String()},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), tr.ID()).Return(tr, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), tr.ID()).Return(nil, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), tr.ID(), nil).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list activities", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 移動の取得に失敗",
+			ctx:  viewerCtx,
+			in:   input.ExportTripCalendarInput{TripID: tr.ID().String()},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), tr.ID()).Return(tr, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), tr.ID()).Return(nil, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), tr.ID(), nil).Return(nil, nil)
+				mockLegRepo.EXPECT().FindByTripID(gomock.Any(), tr.ID()).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list transport legs", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded = nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.ExportTrip(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.wantCalendar, encoded)
+			}
+		})
+	}
+}
+
+func TestCalendarInteractor_GetFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFeedRepo := mock_calendarfeed.NewMockFeedRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockEncoder := mock_service.NewMockCalendarEncoder(ctrl)
+	mockSecretService := mock_service.NewMockShareLinkSecretService(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	interactor := NewCalendarInteractor(mockFeedRepo, mockTripRepo, mockAccommodationRepo, mockActivityRepo, mockLegRepo, mockMemberRepo, mockEncoder, mockSecretService, mockTxManager, mockTimeService, mockIDService)
+
+	feed := newTestFeed(nil)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.GetCalendarFeedOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 有効なフィードを返す",
+			setup: func() {
+				mockFeedRepo.EXPECT().FindActiveByUserID(gomock.Any(), testActorID).Return(feed, nil)
+			},
+			want: output.NewGetCalendarFeedOutput(feed),
+		},
+		{
+			name: "異常系: 有効なフィードがない",
+			setup: func() {
+				mockFeedRepo.EXPECT().FindActiveByUserID(gomock.Any(), testActorID).Return(nil, calendarfeed.NewCalendarFeedNotFoundError())
+			},
+			wantErr: calendarfeed.NewCalendarFeedNotFoundError(),
+		},
+		{
+			name:    "異常系: 認証されていない",
+			ctx:     context.Background(),
+			setup:   func() {},
+			wantErr: apperr.NewInvalidCredentialsError("authenticated user is required"),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockFeedRepo.EXPECT().FindActiveByUserID(gomock.Any(), testActorID).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get calendar feed", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.GetFeed(ctx)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCalendarInteractor_IssueFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFeedRepo := mock_calendarfeed.NewMockFeedRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockEncoder := mock_service.NewMockCalendarEncoder(ctrl)
+	mockSecretService := mock_service.NewMockShareLinkSecretService(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	runInTxDirectly(mockTxManager)
+	mockTimeService.EXPECT().Now().Return(calendarFixedTime).AnyTimes()
+	interactor := NewCalendarInteractor(mockFeedRepo, mockTripRepo, mockAccommodationRepo, mockActivityRepo, mockLegRepo, mockMemberRepo, mockEncoder, mockSecretService, mockTxManager, mockTimeService, mockIDService)
+
+	current := newTestFeed(nil)
+	revoked, err := current.Revoke(calendarFixedTime)
+	require.NoError(t, err)
+	issued := calendarfeed.NewFeed(calendarfeed.NewFeedID("new-feed-id"), testActorID, tokenhash.Hash("new-token"), calendarFixedTime)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.IssueCalendarFeedOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 有効なフィードがない場合はそのまま発行する",
+			setup: func() {
+				mockSecretService.EXPECT().GenerateToken().Return("new-token", nil)
+				mockIDService.EXPECT().Generate().Return("new-feed-id")
+				mockFeedRepo.EXPECT().FindActiveByUserID(gomock.Any(), testActorID).Return(nil, calendarfeed.NewCalendarFeedNotFoundError())
+				mockFeedRepo.EXPECT().Create(gomock.Any(), issued).Return(nil)
+			},
+			want: output.NewIssueCalendarFeedOutput(issued, "new-token"),
+		},
+		{
+			name: "正常系: 有効なフィードがある場合は無効化してから発行し直す",
+			setup: func() {
+				mockSecretService.EXPECT().GenerateToken().Return("new-token", nil)
+				mockIDService.EXPECT().Generate().Return("new-feed-id")
+				mockFeedRepo.EXPECT().FindActiveByUserID(gomock.Any(), testActorID).Return(current, nil)
+				gomock.InOrder(
+					mockFeedRepo.EXPECT().Update(gomock.Any(), revoked).Return(nil),
+					mockFeedRepo.EXPECT().Create(gomock.Any(), issued).Return(nil),
+				)
+			},
+			want: output.NewIssueCalendarFeedOutput(issued, "new-token"),
+		},
+		{
+			name:    "異常系: 認証されていない",
+			ctx:     context.Background(),
+			setup:   func() {},
+			wantErr: apperr.NewInvalidCredentialsError("authenticated user is required"),
+		},
+		{
+			name: "異常系: フィードの保存に失敗",
+			setup: func() {
+				mockSecretService.EXPECT().GenerateToken().Return("new-token", nil)
+				mockIDService.EXPECT().Generate().Return("new-feed-id")
+				mockFeedRepo.EXPECT().FindActiveByUserID(gomock.Any(), testActorID).Return(nil, calendarfeed.NewCalendarFeedNotFoundError())
+				mockFeedRepo.EXPECT().Create(gomock.Any(), issued).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to issue calendar feed", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.IssueFeed(ctx)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCalendarInteractor_RevokeFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFeedRepo := mock_calendarfeed.NewMockFeedRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockEncoder := mock_service.NewMockCalendarEncoder(ctrl)
+	mockSecretService := mock_service.NewMockShareLinkSecretService(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockTimeService.EXPECT().Now().Return(calendarFixedTime).AnyTimes()
+	interactor := NewCalendarInteractor(mockFeedRepo, mockTripRepo, mockAccommodationRepo, mockActivityRepo, mockLegRepo, mockMemberRepo, mockEncoder, mockSecretService, mockTxManager, mockTimeService, mockIDService)
+
+	feed := newTestFeed(nil)
+	revoked := newTestFeed(&calendarFixedTime)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 有効なフィードを無効化する",
+			setup: func() {
+				mockFeedRepo.EXPECT().FindActiveByUserID(gomock.Any(), testActorID).Return(feed, nil)
+				mockFeedRepo.EXPECT().Update(gomock.Any(), revoked).Return(nil)
+			},
+		},
+		{
+			name: "異常系: 有効なフィードがない",
+			setup: func() {
+				mockFeedRepo.EXPECT().FindActiveByUserID(gomock.Any(), testActorID).Return(nil, calendarfeed.NewCalendarFeedNotFoundError())
+			},
+			wantErr: calendarfeed.NewCalendarFeedNotFoundError(),
+		},
+		{
+			name:    "異常系: 認証されていない",
+			ctx:     context.Background(),
+			setup:   func() {},
+			wantErr: apperr.NewInvalidCredentialsError("authenticated user is required"),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockFeedRepo.EXPECT().FindActiveByUserID(gomock.Any(), testActorID).Return(feed, nil)
+				mockFeedRepo.EXPECT().Update(gomock.Any(), revoked).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to revoke calendar feed", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			err := interactor.RevokeFeed(ctx)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCalendarInteractor_ExportFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFeedRepo := mock_calendarfeed.NewMockFeedRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockEncoder := mock_service.NewMockCalendarEncoder(ctrl)
+	mockSecretService := mock_service.NewMockShareLinkSecretService(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockTimeService.EXPECT().Now().Return(calendarFixedTime).AnyTimes()
+	interactor := NewCalendarInteractor(mockFeedRepo, mockTripRepo, mockAccommodationRepo, mockActivityRepo, mockLegRepo, mockMemberRepo, mockEncoder, mockSecretService, mockTxManager, mockTimeService, mockIDService)
+
+	tr := newPeriodTestTrip(t, accommodationTripID)
+	a := newAccommodationTestAccommodation(t, "acc-id", tr.ID())
+	revokedAt := calendarFixedTime
+	// 終了日が今日以降の旅行を開始日の近い順に取得する
+	upcomingQuery, err := trip.NewListQuery(trip.ListQueryParams{
+		Sort:  trip.SortByStartDate.String(),
+		From:  &calendarFixedTime,
+		Limit: pagination.MaxLimit,
+	})
+	require.NoError(t, err)
+
+	var encoded *service.Calendar
+
+	tests := []struct {
+		name  string
+		in    input.ExportCalendarFeedInput
+		setup func()
+		want  *output.ExportCalendarOutput
+		// wantCalendar は書き出しを依頼したカレンダー
+		wantCalendar *service.Calendar
+		wantErr      error
+	}{
+		{
+			name: "正常系: フィードを発行したユーザーの今後の旅行を書き出す",
+			in:   input.ExportCalendarFeedInput{Token: "token"},
+			setup: func() {
+				mockFeedRepo.EXPECT().FindByTokenHash(gomock.Any(), tokenhash.Hash("token")).Return(newTestFeed(nil), nil)
+				mockTripRepo.EXPECT().FindByMember(gomock.Any(), testActorID, upcomingQuery).Return(&pagination.Page[*trip.Trip]{Items: []*trip.Trip{tr}}, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), tr.ID()).Return([]*accommodation.Accommodation{a}, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), tr.ID(), nil).Return(nil, nil)
+				mockLegRepo.EXPECT().FindByTripID(gomock.Any(), tr.ID()).Return(nil, nil)
+				encoded = expectEncode(mockEncoder)
+			},
+			want: output.NewExportCalendarOutput("calendar.ics", []byte("BEGIN:VCALENDAR")),
+			wantCalendar: &service.Calendar{
+				Name: "Upcoming trips",
+				Events: []service.CalendarEvent{
+					{
+						UID:          "trip-trip-id@travel-api",
+						Summary:      "Trip",
+						AllDay:       true,
+						Start:        time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+						End:          time.Date(2023, 8, 4, 0, 0, 0, 0, time.UTC),
+						LastModified: tr.UpdatedAt(),
+					},
+					{
+						UID:           "accommodation-acc-id@travel-api",
+						Summary:       "Hotel",
+						Description:   "Trip: Trip\nConfirmation: CONF",
+						Location:      "Address",
+						Start:         a.Stay().CheckInAt(),
+						End:           a.Stay().CheckOutAt(),
+						StartTimeZone: a.Timezone().Location(),
+						EndTimeZone:   a.Timezone().Location(),
+						LastModified:  a.UpdatedAt(),
+					},
+				},
+				GeneratedAt: calendarFixedTime,
+			},
+		},
+		{
+			name: "異常系: 無効化済みのフィードは存在しないフィードとして扱う",
+			in:   input.ExportCalendarFeedInput{Token: "token"},
+			setup: func() {
+				mockFeedRepo.EXPECT().FindByTokenHash(gomock.Any(), tokenhash.Hash("token")).Return(newTestFeed(&revokedAt), nil)
+			},
+			wantErr: calendarfeed.NewCalendarFeedNotFoundError(),
+		},
+		{
+			name: "異常系: トークンに一致するフィードがない",
+			in:   input.ExportCalendarFeedInput{Token: "unknown"},
+			setup: func() {
+				mockFeedRepo.EXPECT().FindByTokenHash(gomock.Any(), tokenhash.Hash("unknown")).Return(nil, calendarfeed.NewCalendarFeedNotFoundError())
+			},
+			wantErr: calendarfeed.NewCalendarFeedNotFoundError(),
+		},
+		{
+			name:    "異常系: 存在しないタイムゾーン名",
+			in:      input.ExportCalendarFeedInput{Token: "token", TimeZone: "Mars/Olympus"},
+			setup:   func() {},
+			wantErr: apperr.NewValidationError("tz must be an IANA time zone name"),
+		},
+		{
+			name: "異常系: フィードの取得に失敗",
+			in:   input.ExportCalendarFeedInput{Token: "token"},
+			setup: func() {
+				mockFeedRepo.EXPECT().FindByTokenHash(gomock.Any(), tokenhash.Hash("token")).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get calendar feed", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 旅行の取得に失敗",
+			in:   input.ExportCalendarFeedInput{Token: "token"},
+			setup: func() {
+				mockFeedRepo.EXPECT().FindByTokenHash(gomock.Any(), tokenhash.Hash("token")).Return(newTestFeed(nil), nil)
+				mockTripRepo.EXPECT().FindByMember(gomock.Any(), testActorID, upcomingQuery).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list upcoming trips", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded = nil
+			tt.setup()
+
+			got, err := interactor.ExportFeed(context.Background(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.wantCalendar, encoded)
+			}
+		})
+	}
+}
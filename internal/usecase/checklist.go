@@ -0,0 +1,395 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/checklist.go github.com/hata0/travel-api/internal/usecase ChecklistUsecase
+type ChecklistUsecase interface {
+	Get(ctx context.Context, tripID, id string) (*output.GetChecklistOutput, error)
+	List(ctx context.Context, tripID string) (*output.ListChecklistOutput, error)
+	Create(ctx context.Context, in input.CreateChecklistInput) (*output.CreateChecklistOutput, error)
+	Update(ctx context.Context, in input.UpdateChecklistInput) error
+	Delete(ctx context.Context, tripID, id string) error
+	ToggleItems(ctx context.Context, in input.ToggleChecklistItemsInput) error
+	Generate(ctx context.Context, in input.GenerateChecklistInput) (*output.CreateChecklistOutput, error)
+}
+
+type ChecklistInteractor struct {
+	checklistRepository    checklist.ChecklistRepository
+	itemTemplateRepository checklist.ItemTemplateRepository
+	tripRepository         trip.TripRepository
+	memberRepository       membership.MemberRepository
+	authorizer             tripAuthorizer
+	history                historyRecorder
+	transactionManager     transaction_manager.TransactionManager
+	timeService            service.TimeService
+	idService              service.IDService
+}
+
+func NewChecklistInteractor(
+	checklistRepository checklist.ChecklistRepository,
+	itemTemplateRepository checklist.ItemTemplateRepository,
+	tripRepository trip.TripRepository,
+	memberRepository membership.MemberRepository,
+	historyRepository history.HistoryRepository,
+	transactionManager transaction_manager.TransactionManager,
+	timeService service.TimeService,
+	idService service.IDService,
+) ChecklistUsecase {
+	return &ChecklistInteractor{
+		checklistRepository:    checklistRepository,
+		itemTemplateRepository: itemTemplateRepository,
+		tripRepository:         tripRepository,
+		memberRepository:       memberRepository,
+		authorizer:             newTripAuthorizer(memberRepository),
+		history:                newHistoryRecorder(historyRepository),
+		transactionManager:     transactionManager,
+		timeService:            timeService,
+		idService:              idService,
+	}
+}
+
+// Get は旅行に紐づく指定されたIDのチェックリストを取得する
+func (i *ChecklistInteractor) Get(ctx context.Context, tripID, id string) (*output.GetChecklistOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	c, err := i.findInTrip(ctx, trip.NewTripID(tripID), checklist.NewChecklistID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetChecklistOutput(c), nil
+}
+
+// List は旅行に紐づくチェックリストと、旅行全体の進捗を取得する
+func (i *ChecklistInteractor) List(ctx context.Context, tripID string) (*output.ListChecklistOutput, error) {
+	id := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorize(ctx, id, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	checklists, err := i.checklistRepository.FindByTripID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list checklists", apperr.WithCause(err))
+	}
+
+	return output.NewListChecklistOutput(checklists), nil
+}
+
+// Create は旅行に新しいチェックリストを追加する。項目の担当者は旅行のメンバーでなければならない
+func (i *ChecklistInteractor) Create(ctx context.Context, in input.CreateChecklistInput) (*output.CreateChecklistOutput, error) {
+	tripID := trip.NewTripID(in.TripID)
+
+	m, err := i.authorizer.authorize(ctx, tripID, membership.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := checklist.ParseKind(in.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := newChecklistItems(in.Items, func(*string) (checklist.ItemID, error) {
+		return i.newItemID(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := i.timeService.Now()
+	c, err := checklist.NewChecklist(checklist.NewChecklistID(i.idService.Generate()), tripID, in.Name, kind, items, now, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.validateAssignees(ctx, c); err != nil {
+		return nil, err
+	}
+
+	if err := i.create(ctx, c, m.UserID()); err != nil {
+		return nil, err
+	}
+
+	return output.NewCreateChecklistOutput(c.ID()), nil
+}
+
+// Update はチェックリストの名前・種類・項目を更新する。ID を指定した項目は既存の項目を置き換え、ID のない項目は追加する
+func (i *ChecklistInteractor) Update(ctx context.Context, in input.UpdateChecklistInput) error {
+	tripID := trip.NewTripID(in.TripID)
+
+	m, err := i.authorizer.authorize(ctx, tripID, membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	kind, err := checklist.ParseKind(in.Kind)
+	if err != nil {
+		return err
+	}
+
+	c, err := i.findInTrip(ctx, tripID, checklist.NewChecklistID(in.ID))
+	if err != nil {
+		return err
+	}
+
+	items, err := newChecklistItems(in.Items, func(id *string) (checklist.ItemID, error) {
+		return c.ResolveItemID(id, i.newItemID)
+	})
+	if err != nil {
+		return err
+	}
+
+	updated, err := c.Update(in.Name, kind, items, i.timeService.Now())
+	if err != nil {
+		return err
+	}
+
+	if err := i.validateAssignees(ctx, updated); err != nil {
+		return err
+	}
+
+	return i.update(ctx, c, updated, m.UserID())
+}
+
+// Delete は旅行に紐づく指定されたIDのチェックリストを削除する
+func (i *ChecklistInteractor) Delete(ctx context.Context, tripID, id string) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	c, err := i.findInTrip(ctx, trip.NewTripID(tripID), checklist.NewChecklistID(id))
+	if err != nil {
+		return err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.checklistRepository.Delete(txCtx, c.ID()); err != nil {
+			return err
+		}
+		return i.history.recordDeleted(txCtx, c.TripID(), m.UserID(), i.timeService.Now(), c)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to delete checklist", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// ToggleItems はチェックリストの指定された項目のチェック状態をまとめて変更する
+func (i *ChecklistInteractor) ToggleItems(ctx context.Context, in input.ToggleChecklistItemsInput) error {
+	tripID := trip.NewTripID(in.TripID)
+
+	m, err := i.authorizer.authorize(ctx, tripID, membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	c, err := i.findInTrip(ctx, tripID, checklist.NewChecklistID(in.ID))
+	if err != nil {
+		return err
+	}
+
+	itemIDs := make([]checklist.ItemID, 0, len(in.ItemIDs))
+	for _, id := range in.ItemIDs {
+		itemIDs = append(itemIDs, checklist.NewItemID(id))
+	}
+
+	updated, err := c.SetChecked(itemIDs, in.Checked, i.timeService.Now())
+	if err != nil {
+		return err
+	}
+
+	return i.update(ctx, c, updated, m.UserID())
+}
+
+// Generate は実行ユーザーの項目テンプレートから、旅行の日数に応じた数量の持ち物リストを作成する
+func (i *ChecklistInteractor) Generate(ctx context.Context, in input.GenerateChecklistInput) (*output.CreateChecklistOutput, error) {
+	tripID := trip.NewTripID(in.TripID)
+
+	m, err := i.authorizer.authorize(ctx, tripID, membership.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+
+	templates, err := i.findItemTemplates(ctx, m.UserID(), in.ItemTemplateIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := i.timeService.Now()
+	c, err := checklist.GeneratePackingList(checklist.NewChecklistID(i.idService.Generate()), t, in.Name, templates, i.newItemID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.create(ctx, c, m.UserID()); err != nil {
+		return nil, err
+	}
+
+	return output.NewCreateChecklistOutput(c.ID()), nil
+}
+
+// findItemTemplates は持ち物リストの作成に使う項目テンプレートを取得する。
+// ids が空の場合は実行ユーザーのすべての項目テンプレートを、指定された場合はその順に返す
+func (i *ChecklistInteractor) findItemTemplates(ctx context.Context, userID user.UserID, ids []string) ([]*checklist.ItemTemplate, error) {
+	if len(ids) == 0 {
+		templates, err := i.itemTemplateRepository.FindByOwnerID(ctx, userID)
+		if err != nil {
+			if apperr.IsAppError(err) {
+				return nil, err
+			}
+			return nil, apperr.NewInternalError("Failed to list item templates", apperr.WithCause(err))
+		}
+		return templates, nil
+	}
+
+	templates := make([]*checklist.ItemTemplate, 0, len(ids))
+	for _, id := range ids {
+		tmpl, err := i.itemTemplateRepository.FindByID(ctx, checklist.NewItemTemplateID(id))
+		if err != nil {
+			if apperr.IsAppError(err) {
+				return nil, err
+			}
+			return nil, apperr.NewInternalError("Failed to get item template", apperr.WithCause(err))
+		}
+		if err := tmpl.AuthorizeFor(userID); err != nil {
+			return nil, err
+		}
+		templates = append(templates, tmpl)
+	}
+	return templates, nil
+}
+
+// validateAssignees は項目の担当者がすべて旅行のメンバーであることを確認する
+func (i *ChecklistInteractor) validateAssignees(ctx context.Context, c *checklist.Checklist) error {
+	assignees := c.Assignees()
+	if len(assignees) == 0 {
+		return nil
+	}
+
+	members, err := i.memberRepository.FindByTripID(ctx, c.TripID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to list trip members", apperr.WithCause(err))
+	}
+
+	memberIDs := make(map[user.UserID]struct{}, len(members))
+	for _, member := range members {
+		memberIDs[member.UserID()] = struct{}{}
+	}
+	for _, assignee := range assignees {
+		if _, ok := memberIDs[assignee]; !ok {
+			return checklist.NewAssigneeNotMemberError()
+		}
+	}
+	return nil
+}
+
+func (i *ChecklistInteractor) create(ctx context.Context, c *checklist.Checklist, actorID user.UserID) error {
+	err := i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.checklistRepository.Create(txCtx, c); err != nil {
+			return err
+		}
+		return i.history.recordCreated(txCtx, c.TripID(), actorID, c.CreatedAt(), c)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to create checklist", apperr.WithCause(err))
+	}
+	return nil
+}
+
+func (i *ChecklistInteractor) update(ctx context.Context, before, after *checklist.Checklist, actorID user.UserID) error {
+	err := i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.checklistRepository.Update(txCtx, after); err != nil {
+			return err
+		}
+		return i.history.recordUpdated(txCtx, after.TripID(), actorID, after.UpdatedAt(), before, after)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to update checklist", apperr.WithCause(err))
+	}
+	return nil
+}
+
+// findInTrip はチェックリストを取得し、指定された旅行に属していることを確認する。
+// 別の旅行のチェックリストは存在しないものとして扱う
+func (i *ChecklistInteractor) findInTrip(ctx context.Context, tripID trip.TripID, id checklist.ChecklistID) (*checklist.Checklist, error) {
+	c, err := i.checklistRepository.FindByID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get checklist", apperr.WithCause(err))
+	}
+
+	if !c.TripID().Equals(tripID) {
+		return nil, checklist.NewChecklistNotFoundError()
+	}
+
+	return c, nil
+}
+
+func (i *ChecklistInteractor) newItemID() checklist.ItemID {
+	return checklist.NewItemID(i.idService.Generate())
+}
+
+// newChecklistItems は入力からチェックリストの項目を作成する。項目の ID は resolveID で決める
+func newChecklistItems(in []input.ChecklistItemInput, resolveID func(id *string) (checklist.ItemID, error)) ([]checklist.Item, error) {
+	items := make([]checklist.Item, 0, len(in))
+	for _, itemIn := range in {
+		id, err := resolveID(itemIn.ID)
+		if err != nil {
+			return nil, err
+		}
+		var assigneeID *user.UserID
+		if itemIn.AssigneeID != nil {
+			uid := user.NewUserID(*itemIn.AssigneeID)
+			assigneeID = &uid
+		}
+		item, err := checklist.NewItem(id, itemIn.Name, itemIn.Quantity, itemIn.Checked, assigneeID)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,751 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	mock_checklist "github.com/hata0/travel-api/internal/domain/checklist/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	mock_history "github.com/hata0/travel-api/internal/domain/history/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var (
+	checklistFixedTime = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	checklistTripID    = trip.NewTripID("trip-id")
+)
+
+// newChecklistTestChecklist は未チェックの「パスポート」と「靴下」を持つ持ち物リストを生成する
+func newChecklistTestChecklist(t *testing.T, id string, tripID trip.TripID) *checklist.Checklist {
+	t.Helper()
+
+	passport, err := checklist.NewItem(checklist.NewItemID("item-1"), "パスポート", 1, false, nil)
+	require.NoError(t, err)
+	socks, err := checklist.NewItem(checklist.NewItemID("item-2"), "靴下", 3, false, nil)
+	require.NoError(t, err)
+
+	c, err := checklist.NewChecklist(
+		checklist.NewChecklistID(id),
+		tripID,
+		"持ち物",
+		checklist.KindPacking,
+		[]checklist.Item{passport, socks},
+		checklistFixedTime,
+		checklistFixedTime,
+	)
+	require.NoError(t, err)
+	return c
+}
+
+func TestChecklistInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockItemTemplateRepo := mock_checklist.NewMockItemTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewChecklistInteractor(mockChecklistRepo, mockItemTemplateRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	c := newChecklistTestChecklist(t, "checklist-id", checklistTripID)
+	otherTrips := newChecklistTestChecklist(t, "checklist-id", trip.NewTripID("other-trip-id"))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.GetChecklistOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は旅行に属するチェックリストを取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(c, nil)
+			},
+			want: output.NewGetChecklistOutput(c),
+		},
+		{
+			name: "異常系: 別の旅行のチェックリストは NotFound になる",
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(otherTrips, nil)
+			},
+			wantErr: checklist.NewChecklistNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get checklist", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Get(ctx, checklistTripID.String(), "checklist-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestChecklistInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockItemTemplateRepo := mock_checklist.NewMockItemTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	interactor := NewChecklistInteractor(mockChecklistRepo, mockItemTemplateRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	checked, err := newChecklistTestChecklist(t, "checklist-id", checklistTripID).SetChecked([]checklist.ItemID{checklist.NewItemID("item-1")}, true, checklistFixedTime)
+	require.NoError(t, err)
+	documents := newChecklistTestChecklist(t, "documents-id", checklistTripID)
+	checklists := []*checklist.Checklist{checked, documents}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *output.ListChecklistOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: チェックリストと旅行全体の進捗が返される",
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByTripID(gomock.Any(), checklistTripID).Return(checklists, nil)
+			},
+			want: output.NewListChecklistOutput(checklists),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByTripID(gomock.Any(), checklistTripID).Return(nil, errors.New("database list error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list checklists", apperr.WithCause(errors.New("database list error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.List(newActorContext(), checklistTripID.String())
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, output.ChecklistProgress{Total: 4, Checked: 1, Percent: 25}, got.Progress)
+			}
+		})
+	}
+}
+
+func TestChecklistInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockItemTemplateRepo := mock_checklist.NewMockItemTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	ids := 0
+	mockIDService.EXPECT().Generate().DoAndReturn(func() string {
+		ids++
+		return fmt.Sprintf("generated-id-%d", ids)
+	}).AnyTimes()
+
+	interactor := NewChecklistInteractor(mockChecklistRepo, mockItemTemplateRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	assigneeID := "member-user-id"
+	validInput := input.CreateChecklistInput{
+		TripID: checklistTripID.String(),
+		Name:   "出発前",
+		Kind:   "pre_departure",
+		Items: []input.ChecklistItemInput{
+			{Name: "ガスの元栓を閉める", Quantity: 1, AssigneeID: &assigneeID},
+			{Name: "郵便を止める", Quantity: 1},
+		},
+	}
+	invalidKindInput := validInput
+	invalidKindInput.Kind = "unknown"
+	assignee := membership.NewMember(checklistTripID, user.NewUserID(assigneeID), membership.RoleViewer, checklistFixedTime, checklistFixedTime)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.CreateChecklistInput
+		setup   func()
+		want    *output.CreateChecklistOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 旅行のメンバーを担当者とするチェックリストを作成できる",
+			in:   validInput,
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+				mockMemberRepo.EXPECT().FindByTripID(gomock.Any(), checklistTripID).Return([]*membership.Member{assignee}, nil)
+				mockChecklistRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *checklist.Checklist) error {
+						assert.Equal(t, checklist.NewChecklistID("generated-id-3"), c.ID())
+						assert.Equal(t, checklist.KindPreDeparture, c.Kind())
+						require.Len(t, c.Items(), 2)
+						assert.Equal(t, checklist.NewItemID("generated-id-1"), c.Items()[0].ID())
+						assert.Equal(t, user.NewUserID(assigneeID), *c.Items()[0].AssigneeID())
+						return nil
+					})
+			},
+			want: output.NewCreateChecklistOutput(checklist.NewChecklistID("generated-id-3")),
+		},
+		{
+			name: "異常系: 担当者が旅行のメンバーでない",
+			in:   validInput,
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+				mockMemberRepo.EXPECT().FindByTripID(gomock.Any(), checklistTripID).Return(nil, nil)
+			},
+			wantErr: checklist.NewAssigneeNotMemberError(),
+		},
+		{
+			name:    "異常系: 不正な種類",
+			in:      invalidKindInput,
+			setup:   func() {},
+			wantErr: checklist.NewInvalidKindError(),
+		},
+		{
+			name: "異常系: メンバーの取得時にリポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+				mockMemberRepo.EXPECT().FindByTripID(gomock.Any(), checklistTripID).Return(nil, errors.New("database list error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list trip members", apperr.WithCause(errors.New("database list error"))),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+				mockMemberRepo.EXPECT().FindByTripID(gomock.Any(), checklistTripID).Return([]*membership.Member{assignee}, nil)
+				mockChecklistRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to create checklist", apperr.WithCause(errors.New("database write error"))),
+		},
+		{
+			name:    "異常系: 閲覧者はチェックリストを作成できない",
+			ctx:     viewerCtx,
+			in:      validInput,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			ids = 0
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Create(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assertRecordedChange(t, revisions, history.ResourceTypeChecklist, history.ActionCreated)
+			}
+		})
+	}
+}
+
+func TestChecklistInteractor_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockItemTemplateRepo := mock_checklist.NewMockItemTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+
+	ids := 0
+	mockIDService.EXPECT().Generate().DoAndReturn(func() string {
+		ids++
+		return fmt.Sprintf("generated-id-%d", ids)
+	}).AnyTimes()
+
+	interactor := NewChecklistInteractor(mockChecklistRepo, mockItemTemplateRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	c := newChecklistTestChecklist(t, "checklist-id", checklistTripID)
+	now := checklistFixedTime.Add(time.Hour)
+	existingItemID := "item-2"
+	unknownItemID := "unknown-item-id"
+	validInput := input.UpdateChecklistInput{
+		ID:     "checklist-id",
+		TripID: checklistTripID.String(),
+		Name:   "持ち物",
+		Kind:   "packing",
+		Items: []input.ChecklistItemInput{
+			{ID: &existingItemID, Name: "靴下", Quantity: 5},
+			{Name: "充電器", Quantity: 1},
+		},
+	}
+	unknownItemInput := validInput
+	unknownItemInput.Items = []input.ChecklistItemInput{{ID: &unknownItemID, Name: "靴下", Quantity: 1}}
+
+	tests := []struct {
+		name    string
+		in      input.UpdateChecklistInput
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: ID を指定した項目は置き換え、ID のない項目は追加する",
+			in:   validInput,
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(c, nil)
+				mockTimeService.EXPECT().Now().Return(now)
+				mockChecklistRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, updated *checklist.Checklist) error {
+						require.Len(t, updated.Items(), 2)
+						assert.Equal(t, checklist.NewItemID("item-2"), updated.Items()[0].ID())
+						assert.Equal(t, 5, updated.Items()[0].Quantity())
+						assert.Equal(t, checklist.NewItemID("generated-id-1"), updated.Items()[1].ID())
+						assert.Equal(t, now, updated.UpdatedAt())
+						return nil
+					})
+			},
+		},
+		{
+			name: "異常系: 存在しない項目の ID を指定した",
+			in:   unknownItemInput,
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(c, nil)
+			},
+			wantErr: checklist.NewItemNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(c, nil)
+				mockTimeService.EXPECT().Now().Return(now)
+				mockChecklistRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to update checklist", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			ids = 0
+			tt.setup()
+
+			err := interactor.Update(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeChecklist, history.ActionUpdated)
+			}
+		})
+	}
+}
+
+func TestChecklistInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockItemTemplateRepo := mock_checklist.NewMockItemTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewChecklistInteractor(mockChecklistRepo, mockItemTemplateRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	c := newChecklistTestChecklist(t, "checklist-id", checklistTripID)
+	otherTrips := newChecklistTestChecklist(t, "checklist-id", trip.NewTripID("other-trip-id"))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: チェックリストを削除し、削除を記録する",
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(c, nil)
+				mockChecklistRepo.EXPECT().Delete(gomock.Any(), c.ID()).Return(nil)
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+			},
+		},
+		{
+			name: "異常系: 別の旅行のチェックリストは削除できない",
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(otherTrips, nil)
+			},
+			wantErr: checklist.NewChecklistNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(c, nil)
+				mockChecklistRepo.EXPECT().Delete(gomock.Any(), c.ID()).Return(errors.New("database delete error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to delete checklist", apperr.WithCause(errors.New("database delete error"))),
+		},
+		{
+			name:    "異常系: 閲覧者はチェックリストを削除できない",
+			ctx:     viewerCtx,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			err := interactor.Delete(ctx, checklistTripID.String(), "checklist-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeChecklist, history.ActionDeleted)
+			}
+		})
+	}
+}
+
+func TestChecklistInteractor_ToggleItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockItemTemplateRepo := mock_checklist.NewMockItemTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	interactor := NewChecklistInteractor(mockChecklistRepo, mockItemTemplateRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	c := newChecklistTestChecklist(t, "checklist-id", checklistTripID)
+
+	tests := []struct {
+		name    string
+		in      input.ToggleChecklistItemsInput
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 指定した項目をまとめてチェックする",
+			in: input.ToggleChecklistItemsInput{
+				ID:      "checklist-id",
+				TripID:  checklistTripID.String(),
+				ItemIDs: []string{"item-1", "item-2"},
+				Checked: true,
+			},
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(c, nil)
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+				mockChecklistRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, updated *checklist.Checklist) error {
+						assert.Equal(t, checklist.NewProgress(2, 2), updated.Progress())
+						return nil
+					})
+			},
+		},
+		{
+			name: "異常系: 存在しない項目を含む場合は何も変更しない",
+			in: input.ToggleChecklistItemsInput{
+				ID:      "checklist-id",
+				TripID:  checklistTripID.String(),
+				ItemIDs: []string{"item-1", "unknown-item-id"},
+				Checked: true,
+			},
+			setup: func() {
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(c, nil)
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+			},
+			wantErr: checklist.NewItemNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			err := interactor.ToggleItems(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+				assert.Empty(t, *revisions)
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeChecklist, history.ActionUpdated)
+			}
+		})
+	}
+}
+
+func TestChecklistInteractor_Generate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockItemTemplateRepo := mock_checklist.NewMockItemTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+
+	ids := 0
+	mockIDService.EXPECT().Generate().DoAndReturn(func() string {
+		ids++
+		return fmt.Sprintf("generated-id-%d", ids)
+	}).AnyTimes()
+
+	interactor := NewChecklistInteractor(mockChecklistRepo, mockItemTemplateRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	period, err := trip.NewPeriod(time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 8, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	threeDays := trip.NewTrip(checklistTripID, "旅行", period, "", checklistFixedTime, checklistFixedTime)
+	undecided := trip.NewTrip(checklistTripID, "旅行", nil, "", checklistFixedTime, checklistFixedTime)
+	socks, err := checklist.NewItemTemplate(checklist.NewItemTemplateID("socks-id"), testActorID, "靴下", 1, true, checklistFixedTime, checklistFixedTime)
+	require.NoError(t, err)
+	passport, err := checklist.NewItemTemplate(checklist.NewItemTemplateID("passport-id"), testActorID, "パスポート", 1, false, checklistFixedTime, checklistFixedTime)
+	require.NoError(t, err)
+	others, err := checklist.NewItemTemplate(checklist.NewItemTemplateID("others-id"), user.NewUserID("other-user-id"), "傘", 1, false, checklistFixedTime, checklistFixedTime)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		in      input.GenerateChecklistInput
+		setup   func()
+		want    *output.CreateChecklistOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 実行ユーザーのすべての項目テンプレートから旅行の日数に応じた持ち物リストを作成する",
+			in:   input.GenerateChecklistInput{TripID: checklistTripID.String(), Name: "持ち物"},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), checklistTripID).Return(threeDays, nil)
+				mockItemTemplateRepo.EXPECT().FindByOwnerID(gomock.Any(), testActorID).Return([]*checklist.ItemTemplate{socks, passport}, nil)
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+				mockChecklistRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *checklist.Checklist) error {
+						assert.Equal(t, checklist.KindPacking, c.Kind())
+						require.Len(t, c.Items(), 2)
+						assert.Equal(t, 3, c.Items()[0].Quantity(), "1 日あたりの項目は旅行の日数分にすべき")
+						assert.Equal(t, 1, c.Items()[1].Quantity())
+						return nil
+					})
+			},
+			want: output.NewCreateChecklistOutput(checklist.NewChecklistID("generated-id-1")),
+		},
+		{
+			name: "正常系: 指定した項目テンプレートのみを使う",
+			in: input.GenerateChecklistInput{
+				TripID:          checklistTripID.String(),
+				Name:            "持ち物",
+				ItemTemplateIDs: []string{"passport-id"},
+			},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), checklistTripID).Return(threeDays, nil)
+				mockItemTemplateRepo.EXPECT().FindByID(gomock.Any(), passport.ID()).Return(passport, nil)
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+				mockChecklistRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *checklist.Checklist) error {
+						require.Len(t, c.Items(), 1)
+						assert.Equal(t, "パスポート", c.Items()[0].Name())
+						return nil
+					})
+			},
+			want: output.NewCreateChecklistOutput(checklist.NewChecklistID("generated-id-1")),
+		},
+		{
+			name: "異常系: 他のユーザーの項目テンプレートは存在しないものとして扱う",
+			in: input.GenerateChecklistInput{
+				TripID:          checklistTripID.String(),
+				Name:            "持ち物",
+				ItemTemplateIDs: []string{"others-id"},
+			},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), checklistTripID).Return(threeDays, nil)
+				mockItemTemplateRepo.EXPECT().FindByID(gomock.Any(), others.ID()).Return(others, nil)
+			},
+			wantErr: checklist.NewItemTemplateNotFoundError(),
+		},
+		{
+			name: "異常系: 期間の決まっていない旅行では 1 日あたりの項目を使えない",
+			in:   input.GenerateChecklistInput{TripID: checklistTripID.String(), Name: "持ち物"},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), checklistTripID).Return(undecided, nil)
+				mockItemTemplateRepo.EXPECT().FindByOwnerID(gomock.Any(), testActorID).Return([]*checklist.ItemTemplate{socks}, nil)
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+			},
+			wantErr: checklist.NewTripPeriodRequiredError(),
+		},
+		{
+			name: "異常系: 項目テンプレートの取得時にリポジトリから予期しないエラーが返される",
+			in:   input.GenerateChecklistInput{TripID: checklistTripID.String(), Name: "持ち物"},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), checklistTripID).Return(threeDays, nil)
+				mockItemTemplateRepo.EXPECT().FindByOwnerID(gomock.Any(), testActorID).Return(nil, errors.New("database list error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list item templates", apperr.WithCause(errors.New("database list error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			ids = 0
+			tt.setup()
+
+			got, err := interactor.Generate(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assertRecordedChange(t, revisions, history.ResourceTypeChecklist, history.ActionCreated)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/exchange_rate.go github.com/hata0/travel-api/internal/usecase ExchangeRateUsecase
+type ExchangeRateUsecase interface {
+	Import(ctx context.Context, in input.ImportExchangeRatesInput) (*output.ImportExchangeRatesOutput, error)
+}
+
+type ExchangeRateInteractor struct {
+	exchangeRateRepository exchangerate.ExchangeRateRepository
+	feedParser             service.ExchangeRateFeedParser
+	transactionManager     transaction_manager.TransactionManager
+}
+
+func NewExchangeRateInteractor(
+	exchangeRateRepository exchangerate.ExchangeRateRepository,
+	feedParser service.ExchangeRateFeedParser,
+	transactionManager transaction_manager.TransactionManager,
+) ExchangeRateUsecase {
+	return &ExchangeRateInteractor{
+		exchangeRateRepository: exchangeRateRepository,
+		feedParser:             feedParser,
+		transactionManager:     transactionManager,
+	}
+}
+
+// Import は為替レートファイルを解析し、含まれるレートをすべて保存する。
+// 既に同じ日付と通貨ペアのレートがある場合は上書きする。途中で失敗した場合は何も保存しない
+func (i *ExchangeRateInteractor) Import(ctx context.Context, in input.ImportExchangeRatesInput) (*output.ImportExchangeRatesOutput, error) {
+	rates, err := i.feedParser.Parse(in.Data, in.Format)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to parse exchange rates", apperr.WithCause(err))
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		for _, rate := range rates {
+			if err := i.exchangeRateRepository.Save(txCtx, rate); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to import exchange rates", apperr.WithCause(err))
+	}
+
+	return output.NewImportExchangeRatesOutput(rates), nil
+}
@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+	mock_exchangerate "github.com/hata0/travel-api/internal/domain/exchangerate/mock"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+func newExchangeRateTestRate(t *testing.T, date time.Time, quote, decimal string) *exchangerate.ExchangeRate {
+	t.Helper()
+
+	rate, err := exchangerate.NewRate(decimal)
+	require.NoError(t, err)
+	er, err := exchangerate.NewExchangeRate(date, "EUR", quote, rate)
+	require.NoError(t, err)
+	return er
+}
+
+func TestExchangeRateInteractor_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_exchangerate.NewMockExchangeRateRepository(ctrl)
+	mockFeedParser := mock_service.NewMockExchangeRateFeedParser(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	runInTxDirectly(mockTxManager)
+
+	interactor := NewExchangeRateInteractor(mockRepo, mockFeedParser, mockTxManager)
+
+	may1 := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	may2 := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	rates := []*exchangerate.ExchangeRate{
+		newExchangeRateTestRate(t, may2, "USD", "1.0723"),
+		newExchangeRateTestRate(t, may1, "USD", "1.0703"),
+	}
+	data := strings.NewReader("dummy")
+
+	tests := []struct {
+		name    string
+		in      input.ImportExchangeRatesInput
+		setup   func()
+		want    *output.ImportExchangeRatesOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 解析したレートをトランザクション内で保存する",
+			in:   input.ImportExchangeRatesInput{Format: "csv", Data: data},
+			setup: func() {
+				mockFeedParser.EXPECT().Parse(data, "csv").Return(rates, nil)
+				for _, r := range rates {
+					mockRepo.EXPECT().Save(gomock.Any(), r).Return(nil)
+				}
+			},
+			// 取り込んだレートのうち最も古い日付と最も新しい日付が返される
+			want: &output.ImportExchangeRatesOutput{Imported: 2, FromDate: may1, ToDate: may2},
+		},
+		{
+			name: "異常系: ファイルを解析できない",
+			in:   input.ImportExchangeRatesInput{Format: "xml", Data: data},
+			setup: func() {
+				mockFeedParser.EXPECT().Parse(data, "xml").Return(nil, apperr.NewValidationError("Failed to parse exchange rate file"))
+			},
+			wantErr: apperr.NewValidationError("Failed to parse exchange rate file"),
+		},
+		{
+			name: "異常系: 解析中の予期しないエラー",
+			in:   input.ImportExchangeRatesInput{Format: "csv", Data: data},
+			setup: func() {
+				mockFeedParser.EXPECT().Parse(data, "csv").Return(nil, errors.New("read error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to parse exchange rates", apperr.WithCause(errors.New("read error"))),
+		},
+		{
+			name: "異常系: 保存中の予期しないエラー",
+			in:   input.ImportExchangeRatesInput{Format: "csv", Data: data},
+			setup: func() {
+				mockFeedParser.EXPECT().Parse(data, "csv").Return(rates[:1], nil)
+				mockRepo.EXPECT().Save(gomock.Any(), rates[0]).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to import exchange rates", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.Import(context.Background(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
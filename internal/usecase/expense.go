@@ -0,0 +1,314 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/expense.go github.com/hata0/travel-api/internal/usecase ExpenseUsecase
+type ExpenseUsecase interface {
+	Get(ctx context.Context, tripID, id string) (*output.GetExpenseOutput, error)
+	List(ctx context.Context, tripID string) (*output.ListExpenseOutput, error)
+	Create(ctx context.Context, in input.CreateExpenseInput) (*output.CreateExpenseOutput, error)
+	Update(ctx context.Context, in input.UpdateExpenseInput) error
+	Delete(ctx context.Context, tripID, id string) error
+}
+
+type ExpenseInteractor struct {
+	expenseRepository  expense.ExpenseRepository
+	tripRepository     trip.TripRepository
+	authorizer         tripAuthorizer
+	history            historyRecorder
+	attachments        attachmentCleaner
+	transactionManager transaction_manager.TransactionManager
+	timeService        service.TimeService
+	idService          service.IDService
+}
+
+func NewExpenseInteractor(
+	expenseRepository expense.ExpenseRepository,
+	tripRepository trip.TripRepository,
+	memberRepository membership.MemberRepository,
+	historyRepository history.HistoryRepository,
+	attachmentRepository attachment.AttachmentRepository,
+	blobStore service.BlobStore,
+	transactionManager transaction_manager.TransactionManager,
+	timeService service.TimeService,
+	idService service.IDService,
+) ExpenseUsecase {
+	return &ExpenseInteractor{
+		expenseRepository:  expenseRepository,
+		tripRepository:     tripRepository,
+		authorizer:         newTripAuthorizer(memberRepository),
+		history:            newHistoryRecorder(historyRepository),
+		attachments:        newAttachmentCleaner(attachmentRepository, blobStore),
+		transactionManager: transactionManager,
+		timeService:        timeService,
+		idService:          idService,
+	}
+}
+
+// Get は旅行に紐づく指定されたIDの支出を取得する
+func (i *ExpenseInteractor) Get(ctx context.Context, tripID, id string) (*output.GetExpenseOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	e, err := i.findInTrip(ctx, trip.NewTripID(tripID), expense.NewExpenseID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetExpenseOutput(e), nil
+}
+
+// List は旅行に紐づく支出を日付順に取得する
+func (i *ExpenseInteractor) List(ctx context.Context, tripID string) (*output.ListExpenseOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(tripID))
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := i.expenseRepository.FindByTripID(ctx, t.ID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list expenses", apperr.WithCause(err))
+	}
+
+	return output.NewListExpenseOutput(expenses), nil
+}
+
+// Create は旅行に新しい支出を追加する
+func (i *ExpenseInteractor) Create(ctx context.Context, in input.CreateExpenseInput) (*output.CreateExpenseOutput, error) {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := parseExpenseAmount(in.Amount, in.AmountMinor, in.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := expense.ParseCategory(in.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	split, err := parseExpenseSplit(in.Split)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(in.TripID))
+	if err != nil {
+		return nil, err
+	}
+
+	newID := i.idService.Generate()
+	now := i.timeService.Now()
+
+	expenseID := expense.NewExpenseID(newID)
+
+	e := expense.NewExpense(
+		expenseID,
+		t.ID(),
+		amount,
+		category,
+		in.SpentOn,
+		in.Payer,
+		in.Description,
+		in.ActivityID,
+		split,
+		now,
+		now,
+	)
+
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.expenseRepository.Create(txCtx, e); err != nil {
+			return err
+		}
+		return i.history.recordCreated(txCtx, t.ID(), m.UserID(), now, e)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to create expense", apperr.WithCause(err))
+	}
+
+	return output.NewCreateExpenseOutput(expenseID), nil
+}
+
+// Update は既存の支出を更新する
+func (i *ExpenseInteractor) Update(ctx context.Context, in input.UpdateExpenseInput) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	amount, err := parseExpenseAmount(in.Amount, in.AmountMinor, in.Currency)
+	if err != nil {
+		return err
+	}
+
+	category, err := expense.ParseCategory(in.Category)
+	if err != nil {
+		return err
+	}
+
+	split, err := parseExpenseSplit(in.Split)
+	if err != nil {
+		return err
+	}
+
+	e, err := i.findInTrip(ctx, trip.NewTripID(in.TripID), expense.NewExpenseID(in.ID))
+	if err != nil {
+		return err
+	}
+
+	now := i.timeService.Now()
+
+	updated := e.Update(
+		amount,
+		category,
+		in.SpentOn,
+		in.Payer,
+		in.Description,
+		in.ActivityID,
+		split,
+		now,
+	)
+
+	if err := updated.Validate(); err != nil {
+		return err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.expenseRepository.Update(txCtx, updated); err != nil {
+			return err
+		}
+		return i.history.recordUpdated(txCtx, e.TripID(), m.UserID(), now, e, updated)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to update expense", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は旅行に紐づく指定されたIDの支出を、支出に添付したファイルとともに削除する
+func (i *ExpenseInteractor) Delete(ctx context.Context, tripID, id string) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	e, err := i.findInTrip(ctx, trip.NewTripID(tripID), expense.NewExpenseID(id))
+	if err != nil {
+		return err
+	}
+
+	var keys []string
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.expenseRepository.Delete(txCtx, e.ID()); err != nil {
+			return err
+		}
+		deleted, err := i.attachments.deleteTargetRecords(txCtx, e.TripID(), attachment.NewTarget(attachment.TargetTypeExpense, e.ID().String()))
+		if err != nil {
+			return err
+		}
+		keys = deleted
+		return i.history.recordDeleted(txCtx, e.TripID(), m.UserID(), i.timeService.Now(), e)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to delete expense", apperr.WithCause(err))
+	}
+
+	i.attachments.deleteBlobs(ctx, keys)
+	return nil
+}
+
+// findTrip は支出の親となる旅行を取得する
+func (i *ExpenseInteractor) findTrip(ctx context.Context, tripID trip.TripID) (*trip.Trip, error) {
+	t, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+	return t, nil
+}
+
+// findInTrip は支出を取得し、指定された旅行に属していることを確認する。
+// 別の旅行の支出は存在しないものとして扱う
+func (i *ExpenseInteractor) findInTrip(ctx context.Context, tripID trip.TripID, id expense.ExpenseID) (*expense.Expense, error) {
+	e, err := i.expenseRepository.FindByID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get expense", apperr.WithCause(err))
+	}
+
+	if !e.TripID().Equals(tripID) {
+		return nil, expense.NewExpenseNotFoundError()
+	}
+
+	return e, nil
+}
+
+// parseExpenseAmount は最小単位の整数が指定されていればそれを、なければ10進数表記を金額として解釈する
+func parseExpenseAmount(decimal string, minor *int64, currency string) (money.Money, error) {
+	if minor != nil {
+		return money.NewMoney(*minor, currency)
+	}
+	return money.ParseMoney(decimal, currency)
+}
+
+// parseExpenseSplit は分担方法の入力を値オブジェクトに変換する。未指定の場合は nil を返す
+func parseExpenseSplit(in *input.SplitInput) (*expense.Split, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	method, err := expense.ParseSplitMethod(in.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]expense.SplitEntry, len(in.Entries))
+	for i, entry := range in.Entries {
+		entries[i] = expense.NewSplitEntry(entry.Participant, entry.Value)
+	}
+
+	return expense.NewSplit(method, entries)
+}
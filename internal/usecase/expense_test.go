@@ -0,0 +1,620 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	mock_attachment "github.com/hata0/travel-api/internal/domain/attachment/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	mock_expense "github.com/hata0/travel-api/internal/domain/expense/mock"
+	"github.com/hata0/travel-api/internal/domain/history"
+	mock_history "github.com/hata0/travel-api/internal/domain/history/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var (
+	expenseFixedTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	expenseTripID    = trip.NewTripID("trip-id")
+)
+
+func newExpenseTestTrip() *trip.Trip {
+	return trip.NewTrip(expenseTripID, "Trip", nil, "", expenseFixedTime, expenseFixedTime)
+}
+
+// newExpenseTestExpense は 8/1 の食費 1,500 円の支出を生成する
+func newExpenseTestExpense(t *testing.T, id string, tripID trip.TripID) *expense.Expense {
+	t.Helper()
+
+	amount, err := money.NewMoney(1500, "JPY")
+	require.NoError(t, err)
+
+	return expense.NewExpense(
+		expense.NewExpenseID(id),
+		tripID,
+		amount,
+		expense.CategoryFood,
+		time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+		"Alice",
+		"Lunch",
+		nil,
+		nil,
+		expenseFixedTime,
+		expenseFixedTime,
+	)
+}
+
+func TestExpenseInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+
+	interactor := NewExpenseInteractor(mockExpenseRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockAttachmentRepo, newMemoryBlobStore(), mockTxManager, mockTimeService, mockIDService)
+
+	expenseID := expense.NewExpenseID("expense-id")
+	e := newExpenseTestExpense(t, "expense-id", expenseTripID)
+	otherTripExpense := newExpenseTestExpense(t, "expense-id", trip.NewTripID("other-trip-id"))
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *output.GetExpenseOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 旅行に属する支出が取得できる",
+			setup: func() {
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), expenseID).Return(e, nil)
+			},
+			want: output.NewGetExpenseOutput(e),
+		},
+		{
+			name: "異常系: 別の旅行の支出は NotFound になる",
+			setup: func() {
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), expenseID).Return(otherTripExpense, nil)
+			},
+			wantErr: expense.NewExpenseNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), expenseID).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get expense", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.Get(newActorContext(), "trip-id", "expense-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, "1500", got.Expense.AmountDecimal)
+			}
+		})
+	}
+}
+
+func TestExpenseInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+
+	interactor := NewExpenseInteractor(mockExpenseRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockAttachmentRepo, newMemoryBlobStore(), mockTxManager, mockTimeService, mockIDService)
+
+	expenses := []*expense.Expense{newExpenseTestExpense(t, "expense-id", expenseTripID)}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *output.ListExpenseOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 旅行の支出一覧が返される",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), expenseTripID).Return(newExpenseTestTrip(), nil)
+				mockExpenseRepo.EXPECT().FindByTripID(gomock.Any(), expenseTripID).Return(expenses, nil)
+			},
+			want: output.NewListExpenseOutput(expenses),
+		},
+		{
+			name: "異常系: 旅行が存在しない",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), expenseTripID).Return(nil, trip.NewTripNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), expenseTripID).Return(newExpenseTestTrip(), nil)
+				mockExpenseRepo.EXPECT().FindByTripID(gomock.Any(), expenseTripID).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list expenses", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.List(newActorContext(), "trip-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExpenseInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	interactor := NewExpenseInteractor(mockExpenseRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockAttachmentRepo, newMemoryBlobStore(), mockTxManager, mockTimeService, mockIDService)
+
+	generatedID := "generated-id"
+	validInput := input.CreateExpenseInput{
+		TripID:   "trip-id",
+		Amount:   "12.34",
+		Currency: "USD",
+		Category: "food",
+		SpentOn:  time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC),
+		Payer:    "Alice",
+	}
+	minor := int64(999)
+	minorAmountInput := validInput
+	minorAmountInput.AmountMinor = &minor
+	splitInput := validInput
+	splitInput.Split = &input.SplitInput{
+		Method: "shares",
+		Entries: []input.SplitEntryInput{
+			{Participant: "Alice", Value: 1},
+			{Participant: "Bob", Value: 2},
+		},
+	}
+	splitMismatchInput := validInput
+	splitMismatchInput.Split = &input.SplitInput{
+		Method:  "exact",
+		Entries: []input.SplitEntryInput{{Participant: "Alice", Value: 1000}},
+	}
+	unknownSplitMethodInput := validInput
+	unknownSplitMethodInput.Split = &input.SplitInput{
+		Method:  "random",
+		Entries: []input.SplitEntryInput{{Participant: "Alice"}},
+	}
+	tooPreciseAmountInput := validInput
+	tooPreciseAmountInput.Amount = "12.345"
+	invalidCategoryInput := validInput
+	invalidCategoryInput.Category = "unknown"
+	zeroAmountInput := validInput
+	zeroAmountInput.Amount = "0"
+
+	// expectCreateExpense は旅行の取得から支出の保存までを設定し、保存する支出を check で検証する
+	expectCreateExpense := func(check func(e *expense.Expense)) {
+		mockTripRepo.EXPECT().FindByID(gomock.Any(), expenseTripID).Return(newExpenseTestTrip(), nil)
+		mockIDService.EXPECT().Generate().Return(generatedID)
+		mockTimeService.EXPECT().Now().Return(expenseFixedTime)
+		mockExpenseRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, e *expense.Expense) error {
+				check(e)
+				return nil
+			})
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.CreateExpenseInput
+		setup   func()
+		want    *output.CreateExpenseOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 10進数表記の金額で支出が作成できる",
+			in:   validInput,
+			setup: func() {
+				expectCreateExpense(func(e *expense.Expense) {
+					assert.Equal(t, generatedID, e.ID().String())
+					assert.Equal(t, int64(1234), e.Amount().Amount(), "12.34 USD は 1234 セントとして保存されるべき")
+					assert.Equal(t, expense.CategoryFood, e.Category())
+					assert.Equal(t, time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC), e.SpentOn(), "支出日は日付に切り詰められるべき")
+				})
+			},
+			want: output.NewCreateExpenseOutput(expense.NewExpenseID(generatedID)),
+		},
+		{
+			name: "正常系: 最小単位の金額が10進数表記より優先される",
+			in:   minorAmountInput,
+			setup: func() {
+				expectCreateExpense(func(e *expense.Expense) {
+					assert.Equal(t, int64(999), e.Amount().Amount())
+				})
+			},
+			want: output.NewCreateExpenseOutput(expense.NewExpenseID(generatedID)),
+		},
+		{
+			name: "正常系: 分担方法を指定して支出が作成できる",
+			in:   splitInput,
+			setup: func() {
+				expectCreateExpense(func(e *expense.Expense) {
+					require.NotNil(t, e.Split())
+					assert.Equal(t, expense.SplitMethodShares, e.Split().Method())
+					assert.Equal(t, []string{"Alice", "Bob"}, e.Split().Participants())
+				})
+			},
+			want: output.NewCreateExpenseOutput(expense.NewExpenseID(generatedID)),
+		},
+		{
+			name: "異常系: 金額指定の分担の合計が支出額と一致しない",
+			in:   splitMismatchInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), expenseTripID).Return(newExpenseTestTrip(), nil)
+				mockIDService.EXPECT().Generate().Return(generatedID)
+				mockTimeService.EXPECT().Now().Return(expenseFixedTime)
+			},
+			wantErr: expense.NewSplitTotalMismatchError(),
+		},
+		{
+			name:    "異常系: 未定義の分担方法",
+			in:      unknownSplitMethodInput,
+			setup:   func() {},
+			wantErr: expense.NewInvalidSplitMethodError(),
+		},
+		{
+			name:    "異常系: 通貨の桁数を超える金額",
+			in:      tooPreciseAmountInput,
+			setup:   func() {},
+			wantErr: money.NewInvalidAmountError(),
+		},
+		{
+			name:    "異常系: 不正なカテゴリ",
+			in:      invalidCategoryInput,
+			setup:   func() {},
+			wantErr: expense.NewInvalidCategoryError(),
+		},
+		{
+			name: "異常系: 金額が0",
+			in:   zeroAmountInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), expenseTripID).Return(newExpenseTestTrip(), nil)
+				mockIDService.EXPECT().Generate().Return(generatedID)
+				mockTimeService.EXPECT().Now().Return(expenseFixedTime)
+			},
+			wantErr: expense.NewNonPositiveAmountError(),
+		},
+		{
+			name: "異常系: 旅行が存在しない",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), expenseTripID).Return(nil, trip.NewTripNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), expenseTripID).Return(newExpenseTestTrip(), nil)
+				mockIDService.EXPECT().Generate().Return(generatedID)
+				mockTimeService.EXPECT().Now().Return(expenseFixedTime)
+				mockExpenseRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to create expense", apperr.WithCause(errors.New("database write error"))),
+		},
+		{
+			name:    "異常系: 閲覧者は支出を追加できない",
+			ctx:     viewerCtx,
+			in:      validInput,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Create(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assertRecordedChange(t, revisions, history.ResourceTypeExpense, history.ActionCreated)
+			}
+		})
+	}
+}
+
+func TestExpenseInteractor_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+
+	interactor := NewExpenseInteractor(mockExpenseRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockAttachmentRepo, newMemoryBlobStore(), mockTxManager, mockTimeService, mockIDService)
+
+	expenseID := expense.NewExpenseID("expense-id")
+	original := newExpenseTestExpense(t, "expense-id", expenseTripID)
+	updateTime := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	validInput := input.UpdateExpenseInput{
+		ID:       "expense-id",
+		TripID:   "trip-id",
+		Amount:   "3000",
+		Currency: "JPY",
+		Category: "transport",
+		SpentOn:  time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC),
+		Payer:    "Bob",
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 支出が更新できる",
+			setup: func() {
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), expenseID).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+				mockExpenseRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e *expense.Expense) error {
+						assert.Equal(t, int64(3000), e.Amount().Amount())
+						assert.Equal(t, expense.CategoryTransport, e.Category())
+						assert.Equal(t, "Bob", e.Payer())
+						assert.Equal(t, updateTime, e.UpdatedAt())
+						return nil
+					})
+			},
+		},
+		{
+			name: "異常系: 支出が存在しない",
+			setup: func() {
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), expenseID).Return(nil, expense.NewExpenseNotFoundError())
+			},
+			wantErr: expense.NewExpenseNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), expenseID).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+				mockExpenseRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to update expense", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			err := interactor.Update(newActorContext(), validInput)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeExpense, history.ActionUpdated)
+			}
+		})
+	}
+}
+
+func TestExpenseInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	blobStore := newMemoryBlobStore()
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+
+	interactor := NewExpenseInteractor(mockExpenseRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockAttachmentRepo, blobStore, mockTxManager, mockTimeService, mockIDService)
+
+	e := newExpenseTestExpense(t, "expense-id", expenseTripID)
+	otherTripExpense := newExpenseTestExpense(t, "expense-id", trip.NewTripID("other-trip-id"))
+	target := attachment.NewTarget(attachment.TargetTypeExpense, e.ID().String())
+	receipt := newTargetTestAttachment("attachment-1", expenseTripID, target)
+	other := newAttachmentTestAttachment("attachment-2", expenseTripID)
+
+	tests := []struct {
+		name  string
+		setup func()
+		// wantBlobKeys は削除後に保存先に残るファイルのキー
+		wantBlobKeys []string
+		wantErr      error
+	}{
+		{
+			name: "正常系: 支出が削除できる",
+			setup: func() {
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(e, nil)
+				mockExpenseRepo.EXPECT().Delete(gomock.Any(), e.ID()).Return(nil)
+				mockAttachmentRepo.EXPECT().FindByTripID(gomock.Any(), expenseTripID, &target).Return(nil, nil)
+				mockTimeService.EXPECT().Now().Return(expenseFixedTime)
+			},
+			wantBlobKeys: []string{},
+		},
+		{
+			name: "正常系: 支出に添付したファイルはレコードと中身の両方を削除する",
+			setup: func() {
+				blobStore.blobs[receipt.StorageKey()] = attachmentPDF
+				blobStore.blobs[other.StorageKey()] = attachmentPDF
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(e, nil)
+				mockExpenseRepo.EXPECT().Delete(gomock.Any(), e.ID()).Return(nil)
+				mockAttachmentRepo.EXPECT().FindByTripID(gomock.Any(), expenseTripID, &target).Return([]*attachment.Attachment{receipt}, nil)
+				mockAttachmentRepo.EXPECT().Delete(gomock.Any(), receipt.ID()).Return(nil)
+				mockTimeService.EXPECT().Now().Return(expenseFixedTime)
+			},
+			// 支出に添付したファイルの中身だけが削除される
+			wantBlobKeys: []string{other.StorageKey()},
+		},
+		{
+			name: "正常系: コミットした後に中身の削除に失敗しても削除は成功する",
+			setup: func() {
+				blobStore.blobs[receipt.StorageKey()] = attachmentPDF
+				blobStore.failKeys[receipt.StorageKey()] = true
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(e, nil)
+				mockExpenseRepo.EXPECT().Delete(gomock.Any(), e.ID()).Return(nil)
+				mockAttachmentRepo.EXPECT().FindByTripID(gomock.Any(), expenseTripID, &target).Return([]*attachment.Attachment{receipt}, nil)
+				mockAttachmentRepo.EXPECT().Delete(gomock.Any(), receipt.ID()).Return(nil)
+				mockTimeService.EXPECT().Now().Return(expenseFixedTime)
+			},
+			wantBlobKeys: []string{receipt.StorageKey()},
+		},
+		{
+			name: "異常系: 添付ファイルのレコードの削除に失敗した場合は中身を削除しない",
+			setup: func() {
+				blobStore.blobs[receipt.StorageKey()] = attachmentPDF
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(e, nil)
+				mockExpenseRepo.EXPECT().Delete(gomock.Any(), e.ID()).Return(nil)
+				mockAttachmentRepo.EXPECT().FindByTripID(gomock.Any(), expenseTripID, &target).Return([]*attachment.Attachment{receipt}, nil)
+				mockAttachmentRepo.EXPECT().Delete(gomock.Any(), receipt.ID()).Return(errors.New("database connection error"))
+			},
+			wantBlobKeys: []string{receipt.StorageKey()},
+			wantErr:      apperr.NewInternalError("Failed to delete attachment", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name: "異常系: 別の旅行の支出は削除できない",
+			setup: func() {
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(otherTripExpense, nil)
+			},
+			wantBlobKeys: []string{},
+			wantErr:      expense.NewExpenseNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			blobStore.blobs = map[string][]byte{}
+			blobStore.failKeys = map[string]bool{}
+			tt.setup()
+
+			err := interactor.Delete(newActorContext(), "trip-id", "expense-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeExpense, history.ActionDeleted)
+			}
+			assert.Equal(t, tt.wantBlobKeys, blobStore.keys())
+		})
+	}
+}
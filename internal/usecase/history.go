@@ -0,0 +1,441 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/history.go github.com/hata0/travel-api/internal/usecase HistoryUsecase
+type HistoryUsecase interface {
+	List(ctx context.Context, tripID string) (*output.ListHistoryOutput, error)
+	Revert(ctx context.Context, in input.RevertTripInput) (*output.RevertTripOutput, error)
+}
+
+type HistoryInteractor struct {
+	historyRepository       history.HistoryRepository
+	tripRepository          trip.TripRepository
+	accommodationRepository accommodation.AccommodationRepository
+	expenseRepository       expense.ExpenseRepository
+	budgetRepository        budget.BudgetRepository
+	checklistRepository     checklist.ChecklistRepository
+	journalRepository       journal.EntryRepository
+	activityRepository      itinerary.ActivityRepository
+	legRepository           transport.LegRepository
+	authorizer              tripAuthorizer
+	history                 historyRecorder
+	attachments             attachmentCleaner
+	transactionManager      transaction_manager.TransactionManager
+	timeService             service.TimeService
+}
+
+func NewHistoryInteractor(
+	historyRepository history.HistoryRepository,
+	tripRepository trip.TripRepository,
+	accommodationRepository accommodation.AccommodationRepository,
+	expenseRepository expense.ExpenseRepository,
+	budgetRepository budget.BudgetRepository,
+	checklistRepository checklist.ChecklistRepository,
+	journalRepository journal.EntryRepository,
+	activityRepository itinerary.ActivityRepository,
+	legRepository transport.LegRepository,
+	memberRepository membership.MemberRepository,
+	attachmentRepository attachment.AttachmentRepository,
+	blobStore service.BlobStore,
+	transactionManager transaction_manager.TransactionManager,
+	timeService service.TimeService,
+) HistoryUsecase {
+	return &HistoryInteractor{
+		historyRepository:       historyRepository,
+		tripRepository:          tripRepository,
+		accommodationRepository: accommodationRepository,
+		expenseRepository:       expenseRepository,
+		budgetRepository:        budgetRepository,
+		checklistRepository:     checklistRepository,
+		journalRepository:       journalRepository,
+		activityRepository:      activityRepository,
+		legRepository:           legRepository,
+		authorizer:              newTripAuthorizer(memberRepository),
+		history:                 newHistoryRecorder(historyRepository),
+		attachments:             newAttachmentCleaner(attachmentRepository, blobStore),
+		transactionManager:      transactionManager,
+		timeService:             timeService,
+	}
+}
+
+// List は旅行の変更履歴を新しい順に取得する
+func (i *HistoryInteractor) List(ctx context.Context, tripID string) (*output.ListHistoryOutput, error) {
+	id := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorize(ctx, id, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	revisions, err := i.historyRepository.FindByTripID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list trip history", apperr.WithCause(err))
+	}
+
+	return output.NewListHistoryOutput(revisions), nil
+}
+
+// Revert は旅行と関連リソースをリビジョン in.Revision の時点の状態に戻し、戻した変更を新しいリビジョンとして記録する。
+// それ以降に作成されたリソースは削除し、削除されたリソースは同じ ID で作り直す。
+// 削除したリソースの添付ファイルも削除し、中身はコミットした後に保存先から削除する
+func (i *HistoryInteractor) Revert(ctx context.Context, in input.RevertTripInput) (*output.RevertTripOutput, error) {
+	tripID := trip.NewTripID(in.TripID)
+
+	m, err := i.authorizer.authorize(ctx, tripID, membership.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	now := i.timeService.Now()
+
+	var revision *history.Revision
+	var keys []string
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		keys = nil
+		revisions, err := i.historyRepository.FindByTripID(txCtx, tripID)
+		if err != nil {
+			return err
+		}
+
+		targets, err := history.PlanRevert(revisions, in.Revision)
+		if err != nil {
+			return err
+		}
+
+		var changes []history.Change
+		for _, target := range targets {
+			change, err := i.revertResource(txCtx, tripID, target, now, &keys)
+			if err != nil {
+				return err
+			}
+			if change != nil {
+				changes = append(changes, *change)
+			}
+		}
+
+		revision, err = i.history.record(txCtx, tripID, m.UserID(), &in.Revision, now, changes)
+		return err
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to revert trip", apperr.WithCause(err))
+	}
+
+	i.attachments.deleteBlobs(ctx, keys)
+	return output.NewRevertTripOutput(revision), nil
+}
+
+// revertResource は 1 つのリソースを戻す先の状態にし、行った変更を返す。既に戻す先と同じ状態の場合は nil を返す。
+// リソースの削除で消した添付ファイルの中身の保存先のキーは keys に追加する
+func (i *HistoryInteractor) revertResource(ctx context.Context, tripID trip.TripID, target history.TargetState, now time.Time, keys *[]string) (*history.Change, error) {
+	switch target.ResourceType {
+	case history.ResourceTypeTrip:
+		return i.revertTrip(ctx, tripID, target.Snapshot, now)
+	case history.ResourceTypeAccommodation:
+		return i.revertAccommodation(ctx, tripID, accommodation.NewAccommodationID(target.ResourceID), target.Snapshot, now, keys)
+	case history.ResourceTypeExpense:
+		return i.revertExpense(ctx, tripID, expense.NewExpenseID(target.ResourceID), target.Snapshot, now, keys)
+	case history.ResourceTypeBudget:
+		return i.revertBudget(ctx, tripID, target.Snapshot, now)
+	case history.ResourceTypeChecklist:
+		return i.revertChecklist(ctx, tripID, checklist.NewChecklistID(target.ResourceID), target.Snapshot, now)
+	case history.ResourceTypeJournalEntry:
+		return i.revertJournalEntry(ctx, tripID, journal.NewEntryID(target.ResourceID), target.Snapshot, now)
+	case history.ResourceTypeActivity:
+		return i.revertActivity(ctx, tripID, itinerary.NewActivityID(target.ResourceID), target.Snapshot, now)
+	case history.ResourceTypeTransportLeg:
+		return i.revertTransportLeg(ctx, tripID, transport.NewLegID(target.ResourceID), target.Snapshot, now)
+	default:
+		return nil, history.NewUnsupportedResourceError()
+	}
+}
+
+// revertTrip は旅行の名前と期間を戻す。旅行の作成より前には戻せないため、戻す先の状態は常に存在する
+func (i *HistoryInteractor) revertTrip(ctx context.Context, tripID trip.TripID, snapshot json.RawMessage, now time.Time) (*history.Change, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	current, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := history.DecodeSnapshot[history.TripSnapshot](snapshot)
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to decode trip snapshot", apperr.WithCause(err))
+	}
+	period, err := s.Period()
+	if err != nil {
+		return nil, apperr.NewInternalError("Failed to restore trip period from snapshot", apperr.WithCause(err))
+	}
+
+	return applyRevert(ctx, current, current.Update(s.Name, period, s.HomeCurrency, now), revertWriter[trip.Trip]{
+		update: i.tripRepository.Update,
+	})
+}
+
+func (i *HistoryInteractor) revertAccommodation(ctx context.Context, tripID trip.TripID, id accommodation.AccommodationID, snapshot json.RawMessage, now time.Time, keys *[]string) (*history.Change, error) {
+	current, err := i.accommodationRepository.FindByID(ctx, id)
+	if err != nil && !apperr.IsAppErrorWithCode(err, accommodation.CodeAccommodationNotFound) {
+		return nil, err
+	}
+
+	var restored *accommodation.Accommodation
+	if snapshot != nil {
+		s, err := history.DecodeSnapshot[history.AccommodationSnapshot](snapshot)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to decode accommodation snapshot", apperr.WithCause(err))
+		}
+		if restored, err = s.ToAccommodation(id, tripID, now); err != nil {
+			return nil, apperr.NewInternalError("Failed to restore accommodation from snapshot", apperr.WithCause(err))
+		}
+	}
+
+	return applyRevert(ctx, current, restored, revertWriter[accommodation.Accommodation]{
+		create: i.accommodationRepository.Create,
+		update: i.accommodationRepository.Update,
+		delete: func(ctx context.Context, a *accommodation.Accommodation) error {
+			if err := i.accommodationRepository.Delete(ctx, a.ID()); err != nil {
+				return err
+			}
+			return i.deleteAttachments(ctx, tripID, attachment.NewTarget(attachment.TargetTypeAccommodation, a.ID().String()), keys)
+		},
+	})
+}
+
+func (i *HistoryInteractor) revertExpense(ctx context.Context, tripID trip.TripID, id expense.ExpenseID, snapshot json.RawMessage, now time.Time, keys *[]string) (*history.Change, error) {
+	current, err := i.expenseRepository.FindByID(ctx, id)
+	if err != nil && !apperr.IsAppErrorWithCode(err, expense.CodeExpenseNotFound) {
+		return nil, err
+	}
+
+	var restored *expense.Expense
+	if snapshot != nil {
+		s, err := history.DecodeSnapshot[history.ExpenseSnapshot](snapshot)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to decode expense snapshot", apperr.WithCause(err))
+		}
+		if restored, err = s.ToExpense(id, tripID, now); err != nil {
+			return nil, apperr.NewInternalError("Failed to restore expense from snapshot", apperr.WithCause(err))
+		}
+	}
+
+	return applyRevert(ctx, current, restored, revertWriter[expense.Expense]{
+		create: i.expenseRepository.Create,
+		update: i.expenseRepository.Update,
+		delete: func(ctx context.Context, e *expense.Expense) error {
+			if err := i.expenseRepository.Delete(ctx, e.ID()); err != nil {
+				return err
+			}
+			return i.deleteAttachments(ctx, tripID, attachment.NewTarget(attachment.TargetTypeExpense, e.ID().String()), keys)
+		},
+	})
+}
+
+// deleteAttachments は削除したリソースの添付ファイルのレコードを削除し、中身の保存先のキーを keys に追加する
+func (i *HistoryInteractor) deleteAttachments(ctx context.Context, tripID trip.TripID, target attachment.Target, keys *[]string) error {
+	deleted, err := i.attachments.deleteTargetRecords(ctx, tripID, target)
+	if err != nil {
+		return err
+	}
+	*keys = append(*keys, deleted...)
+	return nil
+}
+
+func (i *HistoryInteractor) revertBudget(ctx context.Context, tripID trip.TripID, snapshot json.RawMessage, now time.Time) (*history.Change, error) {
+	current, err := i.budgetRepository.FindByTripID(ctx, tripID)
+	if err != nil && !apperr.IsAppErrorWithCode(err, budget.CodeBudgetNotFound) {
+		return nil, err
+	}
+
+	var restored *budget.Budget
+	if snapshot != nil {
+		s, err := history.DecodeSnapshot[history.BudgetSnapshot](snapshot)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to decode budget snapshot", apperr.WithCause(err))
+		}
+		if restored, err = s.ToBudget(tripID, now); err != nil {
+			return nil, apperr.NewInternalError("Failed to restore budget from snapshot", apperr.WithCause(err))
+		}
+	}
+
+	return applyRevert(ctx, current, restored, revertWriter[budget.Budget]{
+		create: i.budgetRepository.Save,
+		update: i.budgetRepository.Save,
+		delete: func(ctx context.Context, b *budget.Budget) error {
+			return i.budgetRepository.Delete(ctx, b.TripID())
+		},
+	})
+}
+
+func (i *HistoryInteractor) revertChecklist(ctx context.Context, tripID trip.TripID, id checklist.ChecklistID, snapshot json.RawMessage, now time.Time) (*history.Change, error) {
+	current, err := i.checklistRepository.FindByID(ctx, id)
+	if err != nil && !apperr.IsAppErrorWithCode(err, checklist.CodeChecklistNotFound) {
+		return nil, err
+	}
+
+	var restored *checklist.Checklist
+	if snapshot != nil {
+		s, err := history.DecodeSnapshot[history.ChecklistSnapshot](snapshot)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to decode checklist snapshot", apperr.WithCause(err))
+		}
+		if restored, err = s.ToChecklist(id, tripID, now); err != nil {
+			return nil, apperr.NewInternalError("Failed to restore checklist from snapshot", apperr.WithCause(err))
+		}
+	}
+
+	return applyRevert(ctx, current, restored, revertWriter[checklist.Checklist]{
+		create: i.checklistRepository.Create,
+		update: i.checklistRepository.Update,
+		delete: func(ctx context.Context, c *checklist.Checklist) error {
+			return i.checklistRepository.Delete(ctx, c.ID())
+		},
+	})
+}
+
+func (i *HistoryInteractor) revertJournalEntry(ctx context.Context, tripID trip.TripID, id journal.EntryID, snapshot json.RawMessage, now time.Time) (*history.Change, error) {
+	current, err := i.journalRepository.FindByID(ctx, id)
+	if err != nil && !apperr.IsAppErrorWithCode(err, journal.CodeJournalEntryNotFound) {
+		return nil, err
+	}
+
+	var restored *journal.Entry
+	if snapshot != nil {
+		s, err := history.DecodeSnapshot[history.JournalEntrySnapshot](snapshot)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to decode journal entry snapshot", apperr.WithCause(err))
+		}
+		if restored, err = s.ToJournalEntry(id, tripID, now); err != nil {
+			return nil, apperr.NewInternalError("Failed to restore journal entry from snapshot", apperr.WithCause(err))
+		}
+	}
+
+	return applyRevert(ctx, current, restored, revertWriter[journal.Entry]{
+		create: i.journalRepository.Create,
+		update: i.journalRepository.Update,
+		delete: func(ctx context.Context, e *journal.Entry) error {
+			return i.journalRepository.Delete(ctx, e.ID())
+		},
+	})
+}
+
+func (i *HistoryInteractor) revertActivity(ctx context.Context, tripID trip.TripID, id itinerary.ActivityID, snapshot json.RawMessage, now time.Time) (*history.Change, error) {
+	current, err := i.activityRepository.FindByID(ctx, id)
+	if err != nil && !apperr.IsAppErrorWithCode(err, itinerary.CodeActivityNotFound) {
+		return nil, err
+	}
+
+	var restored *itinerary.Activity
+	if snapshot != nil {
+		s, err := history.DecodeSnapshot[history.ActivitySnapshot](snapshot)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to decode activity snapshot", apperr.WithCause(err))
+		}
+		if restored, err = s.ToActivity(id, tripID, now); err != nil {
+			return nil, apperr.NewInternalError("Failed to restore activity from snapshot", apperr.WithCause(err))
+		}
+	}
+
+	return applyRevert(ctx, current, restored, revertWriter[itinerary.Activity]{
+		create: i.activityRepository.Create,
+		update: i.activityRepository.Update,
+		delete: func(ctx context.Context, a *itinerary.Activity) error {
+			return i.activityRepository.Delete(ctx, a.ID())
+		},
+	})
+}
+
+func (i *HistoryInteractor) revertTransportLeg(ctx context.Context, tripID trip.TripID, id transport.LegID, snapshot json.RawMessage, now time.Time) (*history.Change, error) {
+	current, err := i.legRepository.FindByID(ctx, id)
+	if err != nil && !apperr.IsAppErrorWithCode(err, transport.CodeTransportLegNotFound) {
+		return nil, err
+	}
+
+	var restored *transport.Leg
+	if snapshot != nil {
+		s, err := history.DecodeSnapshot[history.TransportLegSnapshot](snapshot)
+		if err != nil {
+			return nil, apperr.NewInternalError("Failed to decode transport leg snapshot", apperr.WithCause(err))
+		}
+		if restored, err = s.ToTransportLeg(id, tripID, now); err != nil {
+			return nil, apperr.NewInternalError("Failed to restore transport leg from snapshot", apperr.WithCause(err))
+		}
+	}
+
+	return applyRevert(ctx, current, restored, revertWriter[transport.Leg]{
+		create: i.legRepository.Create,
+		update: i.legRepository.Update,
+		delete: func(ctx context.Context, l *transport.Leg) error {
+			return i.legRepository.Delete(ctx, l.ID())
+		},
+	})
+}
+
+// revertWriter はリソースを戻す先の状態にするための書き込み操作
+type revertWriter[T any] struct {
+	create func(ctx context.Context, resource *T) error
+	update func(ctx context.Context, resource *T) error
+	delete func(ctx context.Context, resource *T) error
+}
+
+// applyRevert は現在の状態 current を restored にする。nil はリソースが存在しない状態を表す
+func applyRevert[T any](ctx context.Context, current, restored *T, w revertWriter[T]) (*history.Change, error) {
+	var (
+		change history.Change
+		err    error
+	)
+	switch {
+	case current == nil && restored == nil:
+		return nil, nil
+	case current == nil:
+		if change, err = history.NewCreatedChange(restored); err != nil {
+			return nil, apperr.NewInternalError("Failed to build history change", apperr.WithCause(err))
+		}
+		err = w.create(ctx, restored)
+	case restored == nil:
+		if change, err = history.NewDeletedChange(current); err != nil {
+			return nil, apperr.NewInternalError("Failed to build history change", apperr.WithCause(err))
+		}
+		err = w.delete(ctx, current)
+	default:
+		if change, err = history.NewUpdatedChange(current, restored); err != nil {
+			return nil, apperr.NewInternalError("Failed to build history change", apperr.WithCause(err))
+		}
+		if history.SameSnapshot(change.Before(), change.After()) {
+			return nil, nil
+		}
+		err = w.update(ctx, restored)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+)
+
+// historyRecorder は旅行と関連リソースへの変更をリビジョンとして記録する。
+// 変更の保存と同じトランザクション内で呼び出し、記録に失敗した場合は変更も取り消す
+type historyRecorder struct {
+	repository history.HistoryRepository
+}
+
+func newHistoryRecorder(repository history.HistoryRepository) historyRecorder {
+	return historyRecorder{repository: repository}
+}
+
+// recordCreated はリソースの作成を記録する。複数のリソースを渡した場合は 1 つのリビジョンにまとめる
+func (r historyRecorder) recordCreated(ctx context.Context, tripID trip.TripID, actorID user.UserID, createdAt time.Time, resources ...any) error {
+	changes := make([]history.Change, 0, len(resources))
+	for _, resource := range resources {
+		change, err := history.NewCreatedChange(resource)
+		if err != nil {
+			return apperr.NewInternalError("Failed to build history change", apperr.WithCause(err))
+		}
+		changes = append(changes, change)
+	}
+	_, err := r.record(ctx, tripID, actorID, nil, createdAt, changes)
+	return err
+}
+
+// recordUpdated はリソースの更新を記録する。変更前後でスナップショットが変わらない場合は記録しない
+func (r historyRecorder) recordUpdated(ctx context.Context, tripID trip.TripID, actorID user.UserID, createdAt time.Time, before, after any) error {
+	return r.recordUpdatedAll(ctx, tripID, actorID, createdAt, []any{before}, []any{after})
+}
+
+// recordUpdatedAll は複数のリソースの更新を 1 つのリビジョンにまとめて記録する。befores と afters は同じリソースを同じ順に並べる。
+// 変更前後でスナップショットが変わらないリソースは含めない
+func (r historyRecorder) recordUpdatedAll(ctx context.Context, tripID trip.TripID, actorID user.UserID, createdAt time.Time, befores, afters []any) error {
+	changes := make([]history.Change, 0, len(befores))
+	for k := range befores {
+		change, err := history.NewUpdatedChange(befores[k], afters[k])
+		if err != nil {
+			return apperr.NewInternalError("Failed to build history change", apperr.WithCause(err))
+		}
+		if history.SameSnapshot(change.Before(), change.After()) {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	_, err := r.record(ctx, tripID, actorID, nil, createdAt, changes)
+	return err
+}
+
+// recordDeleted はリソースの削除を記録する
+func (r historyRecorder) recordDeleted(ctx context.Context, tripID trip.TripID, actorID user.UserID, createdAt time.Time, resource any) error {
+	change, err := history.NewDeletedChange(resource)
+	if err != nil {
+		return apperr.NewInternalError("Failed to build history change", apperr.WithCause(err))
+	}
+	_, err = r.record(ctx, tripID, actorID, nil, createdAt, []history.Change{change})
+	return err
+}
+
+// record は変更をまとめて 1 つのリビジョンとして保存し、保存したリビジョンを返す。変更がない場合は何もせず nil を返す
+func (r historyRecorder) record(
+	ctx context.Context,
+	tripID trip.TripID,
+	actorID user.UserID,
+	revertedTo *int64,
+	createdAt time.Time,
+	changes []history.Change,
+) (*history.Revision, error) {
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	number, err := r.repository.NextRevision(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to allocate trip revision", apperr.WithCause(err))
+	}
+
+	revision := history.NewRevision(tripID, number, actorID, revertedTo, changes, createdAt)
+	if err := r.repository.Save(ctx, revision); err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to save trip revision", apperr.WithCause(err))
+	}
+
+	return revision, nil
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	mock_history "github.com/hata0/travel-api/internal/domain/history/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// captureHistory は変更履歴の記録を受け付けるよう設定し、保存されたリビジョンを返す。リビジョン番号は 1 から順に払い出す
+func captureHistory(repo *mock_history.MockHistoryRepository) *[]*history.Revision {
+	var saved []*history.Revision
+	repo.EXPECT().
+		NextRevision(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, trip.TripID) (int64, error) {
+			return int64(len(saved) + 1), nil
+		}).
+		AnyTimes()
+	repo.EXPECT().
+		Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, r *history.Revision) error {
+			saved = append(saved, r)
+			return nil
+		}).
+		AnyTimes()
+	return &saved
+}
+
+func TestHistoryRecorder(t *testing.T) {
+	tripID := trip.NewTripID("trip-id")
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	before := trip.NewTrip(tripID, "旅行", nil, "", now, now)
+
+	t.Run("正常系: 作成を次の番号のリビジョンとして保存する", func(t *testing.T) {
+		repo := mock_history.NewMockHistoryRepository(gomock.NewController(t))
+		saved := captureHistory(repo)
+		recorder := newHistoryRecorder(repo)
+
+		require.NoError(t, recorder.recordCreated(context.Background(), tripID, testActorID, now, before))
+
+		require.Len(t, *saved, 1)
+		revision := (*saved)[0]
+		assert.Equal(t, int64(1), revision.Number())
+		assert.Equal(t, testActorID, revision.ActorID())
+		assert.Nil(t, revision.RevertedTo())
+		assert.Equal(t, now, revision.CreatedAt())
+		require.Len(t, revision.Changes(), 1)
+		assert.Equal(t, history.ActionCreated, revision.Changes()[0].Action())
+	})
+
+	t.Run("正常系: 複数のリソースの作成を 1 つのリビジョンにまとめる", func(t *testing.T) {
+		repo := mock_history.NewMockHistoryRepository(gomock.NewController(t))
+		saved := captureHistory(repo)
+		recorder := newHistoryRecorder(repo)
+
+		other := trip.NewTrip(trip.NewTripID("other-trip-id"), "別の旅行", nil, "", now, now)
+		require.NoError(t, recorder.recordCreated(context.Background(), tripID, testActorID, now, before, other))
+
+		require.Len(t, *saved, 1)
+		assert.Len(t, (*saved)[0].Changes(), 2)
+	})
+
+	t.Run("正常系: 内容が変わらない更新は記録しない", func(t *testing.T) {
+		repo := mock_history.NewMockHistoryRepository(gomock.NewController(t))
+		recorder := newHistoryRecorder(repo)
+
+		after := before.Update(before.Name(), nil, "", now.Add(time.Hour))
+
+		assert.NoError(t, recorder.recordUpdated(context.Background(), tripID, testActorID, now, before, after))
+	})
+
+	t.Run("正常系: 変更がない場合はリビジョンを作らない", func(t *testing.T) {
+		repo := mock_history.NewMockHistoryRepository(gomock.NewController(t))
+		recorder := newHistoryRecorder(repo)
+
+		revision, err := recorder.record(context.Background(), tripID, testActorID, nil, now, nil)
+
+		assert.NoError(t, err)
+		assert.Nil(t, revision)
+	})
+
+	t.Run("異常系: 番号の払い出しに失敗した場合は内部エラーを返す", func(t *testing.T) {
+		repo := mock_history.NewMockHistoryRepository(gomock.NewController(t))
+		repo.EXPECT().NextRevision(gomock.Any(), tripID).Return(int64(0), errors.New("db error"))
+		recorder := newHistoryRecorder(repo)
+
+		err := recorder.recordDeleted(context.Background(), tripID, testActorID, now, before)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeInternalError))
+	})
+}
+
+// assertRecordedChange は実行ユーザーによる 1 つの変更が 1 つのリビジョンとして記録されたことを確認する
+func assertRecordedChange(t *testing.T, revisions *[]*history.Revision, resourceType history.ResourceType, action history.Action) {
+	t.Helper()
+	require.Len(t, *revisions, 1)
+	revision := (*revisions)[0]
+	assert.Equal(t, testActorID, revision.ActorID())
+	require.Len(t, revision.Changes(), 1)
+	assert.Equal(t, resourceType, revision.Changes()[0].ResourceType())
+	assert.Equal(t, action, revision.Changes()[0].Action())
+}
@@ -0,0 +1,853 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	mock_accommodation "github.com/hata0/travel-api/internal/domain/accommodation/mock"
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	mock_attachment "github.com/hata0/travel-api/internal/domain/attachment/mock"
+	mock_budget "github.com/hata0/travel-api/internal/domain/budget/mock"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	mock_checklist "github.com/hata0/travel-api/internal/domain/checklist/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	mock_expense "github.com/hata0/travel-api/internal/domain/expense/mock"
+	"github.com/hata0/travel-api/internal/domain/history"
+	mock_history "github.com/hata0/travel-api/internal/domain/history/mock"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	mock_itinerary "github.com/hata0/travel-api/internal/domain/itinerary/mock"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	mock_journal "github.com/hata0/travel-api/internal/domain/journal/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	mock_transport "github.com/hata0/travel-api/internal/domain/transport/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var (
+	historyTripID    = trip.NewTripID("trip-id")
+	historyFixedTime = time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+)
+
+// newHistoryTestRevision は 1 つの変更からなるリビジョンを生成する
+func newHistoryTestRevision(t *testing.T, number int64, change history.Change, err error) *history.Revision {
+	t.Helper()
+	require.NoError(t, err)
+	return history.NewRevision(historyTripID, number, testActorID, nil, []history.Change{change}, historyFixedTime)
+}
+
+// assertRevertRecorded は戻した変更が戻す先のリビジョン付きで 1 つのリビジョンとして記録され、その内容が返されたことを確認する。
+// wantActions が nil の場合は何も記録されていないことを確認する
+func assertRevertRecorded(t *testing.T, got *output.RevertTripOutput, revisions *[]*history.Revision, revertedTo int64, now time.Time, wantActions map[history.ResourceType]history.Action) {
+	t.Helper()
+	if wantActions == nil {
+		assert.Equal(t, &output.RevertTripOutput{}, got)
+		assert.Empty(t, *revisions)
+		return
+	}
+
+	require.Len(t, *revisions, 1)
+	revision := (*revisions)[0]
+	assert.Equal(t, output.NewRevertTripOutput(revision), got)
+	assert.Equal(t, testActorID, revision.ActorID())
+	require.NotNil(t, revision.RevertedTo())
+	assert.Equal(t, revertedTo, *revision.RevertedTo())
+	assert.Equal(t, now, revision.CreatedAt())
+
+	actions := map[history.ResourceType]history.Action{}
+	for _, c := range revision.Changes() {
+		actions[c.ResourceType()] = c.Action()
+	}
+	assert.Equal(t, wantActions, actions)
+}
+
+func TestHistoryInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	blobStore := newMemoryBlobStore()
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewHistoryInteractor(
+		mockHistoryRepo,
+		mockTripRepo,
+		mockAccommodationRepo,
+		mockExpenseRepo,
+		mockBudgetRepo,
+		mockChecklistRepo,
+		mockJournalRepo,
+		mockActivityRepo,
+		mockLegRepo,
+		mockMemberRepo,
+		mockAttachmentRepo,
+		blobStore,
+		mockTxManager,
+		mockTimeService,
+	)
+
+	created := trip.NewTrip(historyTripID, "旅行", nil, "", historyFixedTime, historyFixedTime)
+	change, err := history.NewCreatedChange(created)
+	revision := newHistoryTestRevision(t, 1, change, err)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.ListHistoryOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は変更履歴を取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return([]*history.Revision{revision}, nil)
+			},
+			want: output.NewListHistoryOutput([]*history.Revision{revision}),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(nil, errors.New("db error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list trip history", apperr.WithCause(errors.New("db error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.List(ctx, "trip-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestHistoryInteractor_Revert(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	blobStore := newMemoryBlobStore()
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewHistoryInteractor(
+		mockHistoryRepo,
+		mockTripRepo,
+		mockAccommodationRepo,
+		mockExpenseRepo,
+		mockBudgetRepo,
+		mockChecklistRepo,
+		mockJournalRepo,
+		mockActivityRepo,
+		mockLegRepo,
+		mockMemberRepo,
+		mockAttachmentRepo,
+		blobStore,
+		mockTxManager,
+		mockTimeService,
+	)
+
+	original := trip.NewTrip(historyTripID, "旅行", nil, "", historyFixedTime, historyFixedTime)
+	renamed := original.Update("新しい名前", nil, "", historyFixedTime.Add(time.Hour))
+	a := newAccommodationTestAccommodation(t, "acc-id", historyTripID)
+	e := newExpenseTestExpense(t, "expense-id", historyTripID)
+	accommodationTarget := attachment.NewTarget(attachment.TargetTypeAccommodation, a.ID().String())
+	receipt := newTargetTestAttachment("attachment-1", historyTripID, accommodationTarget)
+	now := historyFixedTime.Add(24 * time.Hour)
+
+	// リビジョン 1 で旅行を作成し、2 で宿泊予約を追加、3 で旅行の名前を変更、4 で既存の支出を削除した履歴
+	newRevisions := func() []*history.Revision {
+		tripCreated, err := history.NewCreatedChange(original)
+		r1 := newHistoryTestRevision(t, 1, tripCreated, err)
+		accCreated, err := history.NewCreatedChange(a)
+		r2 := newHistoryTestRevision(t, 2, accCreated, err)
+		tripUpdated, err := history.NewUpdatedChange(original, renamed)
+		r3 := newHistoryTestRevision(t, 3, tripUpdated, err)
+		expenseDeleted, err := history.NewDeletedChange(e)
+		r4 := newHistoryTestRevision(t, 4, expenseDeleted, err)
+		return []*history.Revision{r4, r3, r2, r1}
+	}
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		in    input.RevertTripInput
+		setup func()
+		// wantActions は戻した変更のリソースの種類ごとの操作。nil の場合はリビジョンを作らない
+		wantActions map[history.ResourceType]history.Action
+		// wantBlobKeys は戻した後に保存先に残るファイルのキー
+		wantBlobKeys []string
+		wantErr      error
+	}{
+		{
+			name: "正常系: 指定したリビジョンの時点の状態に戻し、戻した変更を記録する",
+			in:   input.RevertTripInput{TripID: "trip-id", Revision: 1},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(newRevisions(), nil)
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockAccommodationRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(nil)
+				mockAttachmentRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID, &accommodationTarget).Return(nil, nil)
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), historyTripID).Return(renamed, nil)
+				mockTripRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, tr *trip.Trip) error {
+						assert.Equal(t, "旅行", tr.Name())
+						assert.Equal(t, now, tr.UpdatedAt())
+						return nil
+					})
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(nil, expense.NewExpenseNotFoundError())
+				mockExpenseRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, restored *expense.Expense) error {
+						assert.Equal(t, e.ID(), restored.ID())
+						assert.Equal(t, e.Amount(), restored.Amount())
+						assert.Equal(t, e.CreatedAt(), restored.CreatedAt())
+						return nil
+					})
+			},
+			wantActions: map[history.ResourceType]history.Action{
+				history.ResourceTypeAccommodation: history.ActionDeleted,
+				history.ResourceTypeTrip:          history.ActionUpdated,
+				history.ResourceTypeExpense:       history.ActionCreated,
+			},
+			wantBlobKeys: []string{},
+		},
+		{
+			name: "正常系: 追加した宿泊予約を削除して戻すと、添付したファイルはレコードと中身の両方を削除する",
+			in:   input.RevertTripInput{TripID: "trip-id", Revision: 1},
+			setup: func() {
+				blobStore.blobs[receipt.StorageKey()] = attachmentPDF
+				mockTimeService.EXPECT().Now().Return(now)
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(newRevisions(), nil)
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockAccommodationRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(nil)
+				mockAttachmentRepo.EXPECT().
+					FindByTripID(gomock.Any(), historyTripID, &accommodationTarget).
+					Return([]*attachment.Attachment{receipt}, nil)
+				mockAttachmentRepo.EXPECT().Delete(gomock.Any(), receipt.ID()).Return(nil)
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), historyTripID).Return(original, nil)
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(e, nil)
+			},
+			wantActions: map[history.ResourceType]history.Action{
+				history.ResourceTypeAccommodation: history.ActionDeleted,
+			},
+			wantBlobKeys: []string{},
+		},
+		{
+			name: "異常系: 添付ファイルのレコードの削除に失敗した場合は中身を削除せず変更も記録しない",
+			in:   input.RevertTripInput{TripID: "trip-id", Revision: 1},
+			setup: func() {
+				blobStore.blobs[receipt.StorageKey()] = attachmentPDF
+				mockTimeService.EXPECT().Now().Return(now)
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(newRevisions(), nil)
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockAccommodationRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(nil)
+				mockAttachmentRepo.EXPECT().
+					FindByTripID(gomock.Any(), historyTripID, &accommodationTarget).
+					Return([]*attachment.Attachment{receipt}, nil)
+				mockAttachmentRepo.EXPECT().Delete(gomock.Any(), receipt.ID()).Return(errors.New("db error"))
+			},
+			wantBlobKeys: []string{receipt.StorageKey()},
+			wantErr:      apperr.NewInternalError("Failed to delete attachment", apperr.WithCause(errors.New("db error"))),
+		},
+		{
+			name: "正常系: 既に戻す先と同じ状態の場合は何も変更せずリビジョンも作らない",
+			in:   input.RevertTripInput{TripID: "trip-id", Revision: 1},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(newRevisions(), nil)
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), historyTripID).Return(original, nil)
+				mockAccommodationRepo.EXPECT().
+					FindByID(gomock.Any(), a.ID()).
+					Return(nil, accommodation.NewAccommodationNotFoundError())
+				mockExpenseRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(e, nil)
+			},
+			wantBlobKeys: []string{},
+		},
+		{
+			name: "異常系: 存在しないリビジョン",
+			in:   input.RevertTripInput{TripID: "trip-id", Revision: 10},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(newRevisions(), nil)
+			},
+			wantBlobKeys: []string{},
+			wantErr:      history.NewRevisionNotFoundError(),
+		},
+		{
+			name:         "異常系: 閲覧者は以前のリビジョンに戻せない",
+			ctx:          viewerCtx,
+			in:           input.RevertTripInput{TripID: "trip-id", Revision: 1},
+			setup:        func() {},
+			wantBlobKeys: []string{},
+			wantErr:      membership.NewInsufficientRoleError(),
+		},
+		{
+			name: "異常系: 書き込みに失敗した場合は変更を記録しない",
+			in:   input.RevertTripInput{TripID: "trip-id", Revision: 1},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(newRevisions(), nil)
+				mockAccommodationRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockAccommodationRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(errors.New("db error"))
+			},
+			wantBlobKeys: []string{},
+			wantErr:      apperr.NewInternalError("Failed to revert trip", apperr.WithCause(errors.New("db error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			blobStore.blobs = map[string][]byte{}
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Revert(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+				assert.Empty(t, *revisions, "変更を記録してはならない")
+			} else {
+				require.NoError(t, err)
+				assertRevertRecorded(t, got, revisions, tt.in.Revision, now, tt.wantActions)
+			}
+			assert.Equal(t, tt.wantBlobKeys, blobStore.keys())
+		})
+	}
+}
+
+func TestHistoryInteractor_Revert_Checklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	blobStore := newMemoryBlobStore()
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	interactor := NewHistoryInteractor(
+		mockHistoryRepo,
+		mockTripRepo,
+		mockAccommodationRepo,
+		mockExpenseRepo,
+		mockBudgetRepo,
+		mockChecklistRepo,
+		mockJournalRepo,
+		mockActivityRepo,
+		mockLegRepo,
+		mockMemberRepo,
+		mockAttachmentRepo,
+		blobStore,
+		mockTxManager,
+		mockTimeService,
+	)
+
+	original := trip.NewTrip(historyTripID, "旅行", nil, "", historyFixedTime, historyFixedTime)
+	c := newChecklistTestChecklist(t, "checklist-id", historyTripID)
+	checked, err := c.SetChecked([]checklist.ItemID{c.Items()[0].ID()}, true, historyFixedTime.Add(time.Hour))
+	require.NoError(t, err)
+	now := historyFixedTime.Add(24 * time.Hour)
+
+	// リビジョン 1 で旅行を作成し、2 でチェックリストを追加、3 で項目をチェックした履歴
+	newRevisions := func() []*history.Revision {
+		tripCreated, err := history.NewCreatedChange(original)
+		r1 := newHistoryTestRevision(t, 1, tripCreated, err)
+		checklistCreated, err := history.NewCreatedChange(c)
+		r2 := newHistoryTestRevision(t, 2, checklistCreated, err)
+		checklistUpdated, err := history.NewUpdatedChange(c, checked)
+		r3 := newHistoryTestRevision(t, 3, checklistUpdated, err)
+		return []*history.Revision{r3, r2, r1}
+	}
+
+	tests := []struct {
+		name  string
+		in    input.RevertTripInput
+		setup func()
+		// wantActions は戻した変更のリソースの種類ごとの操作。nil の場合はリビジョンを作らない
+		wantActions map[history.ResourceType]history.Action
+		wantErr     error
+	}{
+		{
+			name: "正常系: チェックリストの項目のチェック状態を戻す",
+			in:   input.RevertTripInput{TripID: "trip-id", Revision: 2},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(newRevisions(), nil)
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(checked, nil)
+				mockChecklistRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, restored *checklist.Checklist) error {
+						assert.Equal(t, c.Items(), restored.Items())
+						assert.Equal(t, now, restored.UpdatedAt())
+						return nil
+					})
+			},
+			wantActions: map[history.ResourceType]history.Action{
+				history.ResourceTypeChecklist: history.ActionUpdated,
+			},
+		},
+		{
+			name: "正常系: チェックリストを追加する前に戻すと削除する",
+			in:   input.RevertTripInput{TripID: "trip-id", Revision: 1},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(newRevisions(), nil)
+				mockChecklistRepo.EXPECT().FindByID(gomock.Any(), c.ID()).Return(checked, nil)
+				mockChecklistRepo.EXPECT().Delete(gomock.Any(), c.ID()).Return(nil)
+			},
+			wantActions: map[history.ResourceType]history.Action{
+				history.ResourceTypeChecklist: history.ActionDeleted,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			got, err := interactor.Revert(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+				assert.Empty(t, *revisions, "変更を記録してはならない")
+			} else {
+				require.NoError(t, err)
+				assertRevertRecorded(t, got, revisions, tt.in.Revision, now, tt.wantActions)
+			}
+		})
+	}
+}
+
+func TestHistoryInteractor_Revert_JournalEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	blobStore := newMemoryBlobStore()
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	interactor := NewHistoryInteractor(
+		mockHistoryRepo,
+		mockTripRepo,
+		mockAccommodationRepo,
+		mockExpenseRepo,
+		mockBudgetRepo,
+		mockChecklistRepo,
+		mockJournalRepo,
+		mockActivityRepo,
+		mockLegRepo,
+		mockMemberRepo,
+		mockAttachmentRepo,
+		blobStore,
+		mockTxManager,
+		mockTimeService,
+	)
+
+	original := trip.NewTrip(historyTripID, "旅行", nil, "", historyFixedTime, historyFixedTime)
+	e := newJournalTestEntry("entry-id", historyTripID)
+	edited := e.Update(e.Date(), "書き直した日記", "雨だった", journal.MoodBad, nil, historyFixedTime.Add(time.Hour))
+	now := historyFixedTime.Add(24 * time.Hour)
+
+	// リビジョン 1 で旅行を作成し、2 で日記を追加、3 で日記を書き直し、4 で日記を削除した履歴
+	newRevisions := func() []*history.Revision {
+		tripCreated, err := history.NewCreatedChange(original)
+		r1 := newHistoryTestRevision(t, 1, tripCreated, err)
+		entryCreated, err := history.NewCreatedChange(e)
+		r2 := newHistoryTestRevision(t, 2, entryCreated, err)
+		entryUpdated, err := history.NewUpdatedChange(e, edited)
+		r3 := newHistoryTestRevision(t, 3, entryUpdated, err)
+		entryDeleted, err := history.NewDeletedChange(edited)
+		r4 := newHistoryTestRevision(t, 4, entryDeleted, err)
+		return []*history.Revision{r4, r3, r2, r1}
+	}
+
+	tests := []struct {
+		name  string
+		in    input.RevertTripInput
+		setup func()
+		// wantActions は戻した変更のリソースの種類ごとの操作。nil の場合はリビジョンを作らない
+		wantActions map[history.ResourceType]history.Action
+		wantErr     error
+	}{
+		{
+			name: "正常系: 日記の本文と気分を戻す",
+			in:   input.RevertTripInput{TripID: "trip-id", Revision: 2},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				// 日記を削除する前のリビジョン 3 までの履歴
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(newRevisions()[1:], nil)
+				mockJournalRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(edited, nil)
+				mockJournalRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, restored *journal.Entry) error {
+						assert.Equal(t, e.Title(), restored.Title())
+						assert.Equal(t, e.Body(), restored.Body())
+						assert.Equal(t, e.Mood(), restored.Mood())
+						assert.Equal(t, now, restored.UpdatedAt())
+						return nil
+					})
+			},
+			wantActions: map[history.ResourceType]history.Action{
+				history.ResourceTypeJournalEntry: history.ActionUpdated,
+			},
+		},
+		{
+			name: "正常系: 削除された日記を復元する",
+			in:   input.RevertTripInput{TripID: "trip-id", Revision: 3},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(newRevisions(), nil)
+				mockJournalRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(nil, journal.NewJournalEntryNotFoundError())
+				mockJournalRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, restored *journal.Entry) error {
+						assert.Equal(t, edited.Title(), restored.Title())
+						return nil
+					})
+			},
+			wantActions: map[history.ResourceType]history.Action{
+				history.ResourceTypeJournalEntry: history.ActionCreated,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			got, err := interactor.Revert(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+				assert.Empty(t, *revisions, "変更を記録してはならない")
+			} else {
+				require.NoError(t, err)
+				assertRevertRecorded(t, got, revisions, tt.in.Revision, now, tt.wantActions)
+			}
+		})
+	}
+}
+
+func TestHistoryInteractor_Revert_Activity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	blobStore := newMemoryBlobStore()
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	interactor := NewHistoryInteractor(
+		mockHistoryRepo,
+		mockTripRepo,
+		mockAccommodationRepo,
+		mockExpenseRepo,
+		mockBudgetRepo,
+		mockChecklistRepo,
+		mockJournalRepo,
+		mockActivityRepo,
+		mockLegRepo,
+		mockMemberRepo,
+		mockAttachmentRepo,
+		blobStore,
+		mockTxManager,
+		mockTimeService,
+	)
+
+	original := trip.NewTrip(historyTripID, "旅行", nil, "", historyFixedTime, historyFixedTime)
+	a := newItineraryTestActivity(t, "activity-id", historyTripID, 0, nil, nil, nil)
+	now := historyFixedTime.Add(24 * time.Hour)
+
+	// リビジョン 1 で旅行を作成し、2 で行動を追加、3 で行動を削除した履歴
+	newRevisions := func() []*history.Revision {
+		tripCreated, err := history.NewCreatedChange(original)
+		r1 := newHistoryTestRevision(t, 1, tripCreated, err)
+		activityCreated, err := history.NewCreatedChange(a)
+		r2 := newHistoryTestRevision(t, 2, activityCreated, err)
+		activityDeleted, err := history.NewDeletedChange(a)
+		r3 := newHistoryTestRevision(t, 3, activityDeleted, err)
+		return []*history.Revision{r3, r2, r1}
+	}
+
+	tests := []struct {
+		name  string
+		in    input.RevertTripInput
+		setup func()
+		// wantActions は戻した変更のリソースの種類ごとの操作。nil の場合はリビジョンを作らない
+		wantActions map[history.ResourceType]history.Action
+		wantErr     error
+	}{
+		{
+			name: "正常系: 削除された行動を同じ並び順で復元する",
+			in:   input.RevertTripInput{TripID: "trip-id", Revision: 2},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(newRevisions(), nil)
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(nil, itinerary.NewActivityNotFoundError())
+				mockActivityRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, restored *itinerary.Activity) error {
+						assert.Equal(t, a.Title(), restored.Title())
+						assert.Equal(t, a.Position(), restored.Position())
+						return nil
+					})
+			},
+			wantActions: map[history.ResourceType]history.Action{
+				history.ResourceTypeActivity: history.ActionCreated,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			got, err := interactor.Revert(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+				assert.Empty(t, *revisions, "変更を記録してはならない")
+			} else {
+				require.NoError(t, err)
+				assertRevertRecorded(t, got, revisions, tt.in.Revision, now, tt.wantActions)
+			}
+		})
+	}
+}
+
+func TestHistoryInteractor_Revert_TransportLeg(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	blobStore := newMemoryBlobStore()
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	interactor := NewHistoryInteractor(
+		mockHistoryRepo,
+		mockTripRepo,
+		mockAccommodationRepo,
+		mockExpenseRepo,
+		mockBudgetRepo,
+		mockChecklistRepo,
+		mockJournalRepo,
+		mockActivityRepo,
+		mockLegRepo,
+		mockMemberRepo,
+		mockAttachmentRepo,
+		blobStore,
+		mockTxManager,
+		mockTimeService,
+	)
+
+	original := trip.NewTrip(historyTripID, "旅行", nil, "", historyFixedTime, historyFixedTime)
+	l := newTransportTestLeg(t, "leg-id", historyTripID)
+	now := historyFixedTime.Add(24 * time.Hour)
+
+	// リビジョン 1 で旅行を作成し、2 で移動を追加、3 で移動を削除した履歴
+	newRevisions := func() []*history.Revision {
+		tripCreated, err := history.NewCreatedChange(original)
+		r1 := newHistoryTestRevision(t, 1, tripCreated, err)
+		legCreated, err := history.NewCreatedChange(l)
+		r2 := newHistoryTestRevision(t, 2, legCreated, err)
+		legDeleted, err := history.NewDeletedChange(l)
+		r3 := newHistoryTestRevision(t, 3, legDeleted, err)
+		return []*history.Revision{r3, r2, r1}
+	}
+
+	tests := []struct {
+		name  string
+		in    input.RevertTripInput
+		setup func()
+		// wantActions は戻した変更のリソースの種類ごとの操作。nil の場合はリビジョンを作らない
+		wantActions map[history.ResourceType]history.Action
+		wantErr     error
+	}{
+		{
+			name: "正常系: 削除された移動を出発地と到着地のタイムゾーンごと復元する",
+			in:   input.RevertTripInput{TripID: "trip-id", Revision: 2},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockHistoryRepo.EXPECT().FindByTripID(gomock.Any(), historyTripID).Return(newRevisions(), nil)
+				mockLegRepo.EXPECT().FindByID(gomock.Any(), l.ID()).Return(nil, transport.NewLegNotFoundError())
+				mockLegRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, restored *transport.Leg) error {
+						assert.Equal(t, l.Summary(), restored.Summary())
+						assert.Equal(t, l.DepartureAt(), restored.DepartureAt())
+						assert.Equal(t, l.ArrivalTimezone(), restored.ArrivalTimezone())
+						return nil
+					})
+			},
+			wantActions: map[history.ResourceType]history.Action{
+				history.ResourceTypeTransportLeg: history.ActionCreated,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			got, err := interactor.Revert(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+				assert.Empty(t, *revisions, "変更を記録してはならない")
+			} else {
+				require.NoError(t, err)
+				assertRevertRecorded(t, got, revisions, tt.in.Revision, now, tt.wantActions)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package input
+
+import "time"
+
+// CreateAccommodationInput は宿泊予約作成時の入力。
+// Timezone は宿泊先の IANA のタイムゾーン名で、チェックイン・チェックアウトを現地時刻で表示するのに使う。不明な場合は空
+type CreateAccommodationInput struct {
+	TripID             string
+	Name               string
+	Address            string
+	Timezone           string
+	CheckInAt          time.Time
+	CheckOutAt         time.Time
+	ConfirmationNumber string
+	// CostAmount は通貨の最小単位で表した金額
+	CostAmount   int64
+	CostCurrency string
+	Notes        string
+}
+
+// UpdateAccommodationInput は宿泊予約更新時の入力
+type UpdateAccommodationInput struct {
+	ID                 string
+	TripID             string
+	Name               string
+	Address            string
+	Timezone           string
+	CheckInAt          time.Time
+	CheckOutAt         time.Time
+	ConfirmationNumber string
+	CostAmount         int64
+	CostCurrency       string
+	Notes              string
+}
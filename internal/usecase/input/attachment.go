@@ -0,0 +1,21 @@
+package input
+
+import "io"
+
+// UploadAttachmentInput は添付ファイルのアップロード時の入力。
+// TargetType が trip の場合、TargetID は無視して旅行自体に添付する
+type UploadAttachmentInput struct {
+	TripID     string
+	TargetType string
+	TargetID   string
+	Filename   string
+	Content    io.Reader
+	Size       int64
+}
+
+// ListAttachmentInput は添付ファイル一覧取得時の入力。TargetType が空の場合は旅行のすべての添付ファイルを返す
+type ListAttachmentInput struct {
+	TripID     string
+	TargetType string
+	TargetID   string
+}
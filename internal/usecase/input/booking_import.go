@@ -0,0 +1,17 @@
+package input
+
+import "io"
+
+// ImportBookingsInput は予約の確認ファイル取り込み時の入力
+type ImportBookingsInput struct {
+	TripID string
+	// Format はファイル形式（"ics" または "eml"）
+	Format string
+	Data   io.Reader
+	// DryRun が true の場合は下書きの一覧を返すだけで保存しない
+	DryRun bool
+	// Keys は保存する下書きのキー。空の場合は保存できるすべての下書きを保存する
+	Keys []string
+	// Currency は金額が読み取れなかった宿泊の下書きを 0 として保存するときの通貨
+	Currency string
+}
@@ -0,0 +1,10 @@
+package input
+
+// SaveBudgetInput は予算保存時の入力
+type SaveBudgetInput struct {
+	TripID string
+	// Currency は予算を立てる通貨（ISO 4217）
+	Currency string
+	// CategoryLimits はカテゴリごとの上限額。金額は予算の通貨の最小単位で表す
+	CategoryLimits map[string]int64
+}
@@ -0,0 +1,13 @@
+package input
+
+// ExportTripCalendarInput は旅行の iCalendar 書き出し時の入力。TimeZone が空なら UTC で書き出す
+type ExportTripCalendarInput struct {
+	TripID   string
+	TimeZone string
+}
+
+// ExportCalendarFeedInput はカレンダーフィードの取得時の入力。TimeZone が空なら UTC で書き出す
+type ExportCalendarFeedInput struct {
+	Token    string
+	TimeZone string
+}
@@ -0,0 +1,59 @@
+package input
+
+// ChecklistItemInput はチェックリストの項目の入力
+type ChecklistItemInput struct {
+	// ID は既存の項目の ID。新しい項目の場合は nil
+	ID         *string
+	Name       string
+	Quantity   int
+	Checked    bool
+	AssigneeID *string
+}
+
+// CreateChecklistInput はチェックリスト作成時の入力
+type CreateChecklistInput struct {
+	TripID string
+	Name   string
+	Kind   string
+	Items  []ChecklistItemInput
+}
+
+// UpdateChecklistInput はチェックリスト更新時の入力。項目は渡されたもので置き換える
+type UpdateChecklistInput struct {
+	ID     string
+	TripID string
+	Name   string
+	Kind   string
+	Items  []ChecklistItemInput
+}
+
+// ToggleChecklistItemsInput はチェックリストの項目のチェック状態をまとめて変更する時の入力
+type ToggleChecklistItemsInput struct {
+	ID      string
+	TripID  string
+	ItemIDs []string
+	Checked bool
+}
+
+// GenerateChecklistInput は項目テンプレートから持ち物リストを作成する時の入力
+type GenerateChecklistInput struct {
+	TripID string
+	Name   string
+	// ItemTemplateIDs は使う項目テンプレート。空の場合は実行ユーザーのすべての項目テンプレートを使う
+	ItemTemplateIDs []string
+}
+
+// CreateItemTemplateInput は項目テンプレート作成時の入力
+type CreateItemTemplateInput struct {
+	Name     string
+	Quantity int
+	PerDay   bool
+}
+
+// UpdateItemTemplateInput は項目テンプレート更新時の入力
+type UpdateItemTemplateInput struct {
+	ID       string
+	Name     string
+	Quantity int
+	PerDay   bool
+}
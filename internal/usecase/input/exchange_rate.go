@@ -0,0 +1,10 @@
+package input
+
+import "io"
+
+// ImportExchangeRatesInput は為替レート取り込み時の入力
+type ImportExchangeRatesInput struct {
+	// Format はファイル形式（"xml" または "csv"）
+	Format string
+	Data   io.Reader
+}
@@ -0,0 +1,46 @@
+package input
+
+import "time"
+
+// CreateExpenseInput は支出作成時の入力。
+// 金額は Amount（"12.34" のような10進数表記）か AmountMinor（通貨の最小単位の整数）のいずれかで指定する
+type CreateExpenseInput struct {
+	TripID      string
+	Amount      string
+	AmountMinor *int64
+	Currency    string
+	Category    string
+	SpentOn     time.Time
+	Payer       string
+	Description string
+	ActivityID  *string
+	// Split は参加者間の分担方法。nil の場合は分担せず支払者が全額を負担する
+	Split *SplitInput
+}
+
+// UpdateExpenseInput は支出更新時の入力
+type UpdateExpenseInput struct {
+	ID          string
+	TripID      string
+	Amount      string
+	AmountMinor *int64
+	Currency    string
+	Category    string
+	SpentOn     time.Time
+	Payer       string
+	Description string
+	ActivityID  *string
+	Split       *SplitInput
+}
+
+// SplitInput は支出の分担方法の入力。
+// Value は Method に応じて口数（shares）、最小通貨単位の金額（exact）、ベーシスポイント（percentage）として扱い、equal では使用しない
+type SplitInput struct {
+	Method  string
+	Entries []SplitEntryInput
+}
+
+type SplitEntryInput struct {
+	Participant string
+	Value       int64
+}
@@ -0,0 +1,8 @@
+package input
+
+// RevertTripInput は旅行を以前のリビジョンの状態に戻すときの入力
+type RevertTripInput struct {
+	TripID string
+	// Revision は戻す先のリビジョン番号
+	Revision int64
+}
@@ -0,0 +1,9 @@
+package input
+
+// CreateInvitationInput は旅行への招待作成時の入力。宛先は Email か Username のどちらか一方を指定する
+type CreateInvitationInput struct {
+	TripID   string
+	Email    string
+	Username string
+	Role     string
+}
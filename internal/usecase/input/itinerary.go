@@ -0,0 +1,56 @@
+package input
+
+import "time"
+
+// ActivityPlaceInput は行動の場所の入力。緯度と経度は両方指定するか両方省略する。Timezone は IANA のタイムゾーン名で、不明な場合は空
+type ActivityPlaceInput struct {
+	Name      string
+	Latitude  *float64
+	Longitude *float64
+	Timezone  string
+}
+
+// CreateActivityInput は行動作成時の入力。TravelMode は直前の行動の場所から向かう移動手段。
+// Timezone は IANA のタイムゾーン名で、空の場合は場所のタイムゾーンを使う
+type CreateActivityInput struct {
+	TripID     string
+	Date       time.Time
+	Title      string
+	Place      *ActivityPlaceInput
+	Timezone   string
+	StartAt    *time.Time
+	EndAt      *time.Time
+	TravelMode string
+	Notes      string
+}
+
+// UpdateActivityInput は行動更新時の入力
+type UpdateActivityInput struct {
+	ID         string
+	TripID     string
+	Date       time.Time
+	Title      string
+	Place      *ActivityPlaceInput
+	Timezone   string
+	StartAt    *time.Time
+	EndAt      *time.Time
+	TravelMode string
+	Notes      string
+}
+
+// RouteEndpointInput は 1 日の出発地・到着地（通常は宿泊先）の座標の入力
+type RouteEndpointInput struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// OptimizeDayInput は行動を巡る順の並べ替えの入力。Day は旅行の開始日を 1 日目とした日数。
+// PinnedActivityIDs の行動は今の位置から動かさず、Apply が true の場合は提案した順に並び順を更新する
+type OptimizeDayInput struct {
+	TripID            string
+	Day               int
+	Start             *RouteEndpointInput
+	End               *RouteEndpointInput
+	PinnedActivityIDs []string
+	Apply             bool
+}
@@ -0,0 +1,32 @@
+package input
+
+import "time"
+
+// JournalPlaceInput は日記に記録する場所の入力。緯度と経度は両方指定するか両方省略する。Timezone は IANA のタイムゾーン名で、不明な場合は空
+type JournalPlaceInput struct {
+	Name      string
+	Latitude  *float64
+	Longitude *float64
+	Timezone  string
+}
+
+// CreateJournalEntryInput は日記作成時の入力。Body は Markdown
+type CreateJournalEntryInput struct {
+	TripID string
+	Date   time.Time
+	Title  string
+	Body   string
+	Mood   string
+	Place  *JournalPlaceInput
+}
+
+// UpdateJournalEntryInput は日記更新時の入力
+type UpdateJournalEntryInput struct {
+	ID     string
+	TripID string
+	Date   time.Time
+	Title  string
+	Body   string
+	Mood   string
+	Place  *JournalPlaceInput
+}
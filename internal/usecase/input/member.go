@@ -0,0 +1,15 @@
+package input
+
+// ChangeMemberRoleInput はメンバーのロール変更時の入力
+type ChangeMemberRoleInput struct {
+	TripID string
+	UserID string
+	Role   string
+}
+
+// TransferOwnershipInput は旅行の所有権を移譲する際の入力
+type TransferOwnershipInput struct {
+	TripID string
+	// NewOwnerID は新しい所有者となるメンバーのユーザーID
+	NewOwnerID string
+}
@@ -0,0 +1,13 @@
+package input
+
+import "io"
+
+// UploadPhotoInput は写真のアップロード時の入力。
+// StripLocation が true の場合、保存する写真から撮影位置などの位置情報を取り除く
+type UploadPhotoInput struct {
+	TripID        string
+	Filename      string
+	Content       io.Reader
+	Size          int64
+	StripLocation bool
+}
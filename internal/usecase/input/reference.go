@@ -0,0 +1,9 @@
+package input
+
+// SearchReferenceInput は空港・国・都市の参照データの検索時の入力
+type SearchReferenceInput struct {
+	// Query は検索語。国の一覧では省略でき、空の場合はすべての国を返す
+	Query string
+	// Limit は最大件数。0 の場合は既定の件数
+	Limit int
+}
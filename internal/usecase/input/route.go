@@ -0,0 +1,8 @@
+package input
+
+// ExportRouteInput は旅行の経路の書き出し時の入力
+type ExportRouteInput struct {
+	TripID string
+	// Format はファイル形式（"geojson"、"kml" または "gpx"）
+	Format string
+}
@@ -0,0 +1,8 @@
+package input
+
+// SearchInput は横断検索時の入力
+type SearchInput struct {
+	Query string
+	// Limit はリソースの種類ごとの最大件数。0 の場合は既定の件数
+	Limit int
+}
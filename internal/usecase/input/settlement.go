@@ -0,0 +1,16 @@
+package input
+
+import "time"
+
+// RecordSettlementTransferInput は精算の送金を記録する際の入力。
+// 金額は Amount（"12.34" のような10進数表記）か AmountMinor（通貨の最小単位の整数）のいずれかで指定する
+type RecordSettlementTransferInput struct {
+	TripID      string
+	From        string
+	To          string
+	Amount      string
+	AmountMinor *int64
+	Currency    string
+	PaidOn      time.Time
+	Description string
+}
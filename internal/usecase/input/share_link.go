@@ -0,0 +1,16 @@
+package input
+
+import "time"
+
+// CreateShareLinkInput は共有リンク作成時の入力。ExpiresAt が nil なら無期限、Password が空ならパスワードなしとなる
+type CreateShareLinkInput struct {
+	TripID    string
+	ExpiresAt *time.Time
+	Password  string
+}
+
+// GetSharedTripInput は共有リンクでの旅行閲覧時の入力
+type GetSharedTripInput struct {
+	Token    string
+	Password string
+}
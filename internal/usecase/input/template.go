@@ -0,0 +1,18 @@
+package input
+
+// CreateTemplateInput は旅行から雛形を作成する時の入力
+type CreateTemplateInput struct {
+	TripID string
+	// Name は雛形の名前。空の場合は旅行の名前を使う
+	Name        string
+	Description string
+	Public      bool
+}
+
+// UpdateTemplateInput は雛形更新時の入力
+type UpdateTemplateInput struct {
+	ID          string
+	Name        string
+	Description string
+	Public      bool
+}
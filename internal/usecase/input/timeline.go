@@ -0,0 +1,8 @@
+package input
+
+// GetTimelineInput は旅行の時系列の取得時の入力。
+// TimeZone はタイムゾーンが決まっていない項目を表す IANA のタイムゾーン名で、空なら UTC で表す
+type GetTimelineInput struct {
+	TripID   string
+	TimeZone string
+}
@@ -0,0 +1,15 @@
+package input
+
+import "io"
+
+// UploadTrackInput は GPX ファイルのアップロード時の入力。
+// ActivityID はトラックを記録した行動のIDで、紐づく行動がない場合は nil。
+// Name が空の場合は GPX に記録された名前、それもなければファイル名をトラックの名前とする
+type UploadTrackInput struct {
+	TripID     string
+	ActivityID *string
+	Name       string
+	Filename   string
+	Content    io.Reader
+	Size       int64
+}
@@ -0,0 +1,39 @@
+package input
+
+import "time"
+
+// TransportPlaceInput は移動の出発地・到着地の入力。緯度と経度は両方指定するか両方省略する。
+// Timezone は IANA のタイムゾーン名で、出発・到着日時を現地時刻で表示するのに使う。不明な場合は空
+type TransportPlaceInput struct {
+	Name      string
+	Latitude  *float64
+	Longitude *float64
+	Timezone  string
+}
+
+// CreateTransportLegInput は移動作成時の入力。Carrier は運行会社、Number は便名や列車番号で、不明な場合は空
+type CreateTransportLegInput struct {
+	TripID      string
+	Mode        string
+	Origin      TransportPlaceInput
+	Destination TransportPlaceInput
+	DepartureAt time.Time
+	ArrivalAt   time.Time
+	Carrier     string
+	Number      string
+	Notes       string
+}
+
+// UpdateTransportLegInput は移動更新時の入力
+type UpdateTransportLegInput struct {
+	ID          string
+	TripID      string
+	Mode        string
+	Origin      TransportPlaceInput
+	Destination TransportPlaceInput
+	DepartureAt time.Time
+	ArrivalAt   time.Time
+	Carrier     string
+	Number      string
+	Notes       string
+}
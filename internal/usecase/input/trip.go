@@ -0,0 +1,52 @@
+package input
+
+import "time"
+
+// CreateTripInput は旅行作成時の入力
+type CreateTripInput struct {
+	// Name は雛形から作成する場合に限り省略でき、省略すると雛形の名前を使う
+	Name string
+	// StartDate と EndDate は両方指定するか、両方省略する（期間未定）。
+	// 雛形から作成する場合は EndDate を省略すると雛形の日数から求める
+	StartDate *time.Time
+	EndDate   *time.Time
+	// TemplateID は作成に使う雛形のID。nil の場合は空の旅行を作成する
+	TemplateID *string
+	// HomeCurrency は予算の集計に使う旅行の基準通貨。空の場合は未設定とする
+	HomeCurrency string
+}
+
+// DuplicateTripInput は旅行複製時の入力
+type DuplicateTripInput struct {
+	TripID string
+	// Name は複製した旅行の名前。空の場合は元の旅行の名前を使う
+	Name string
+	// OffsetDays は日付をずらす日数。負の場合は前にずらす
+	OffsetDays int
+}
+
+// UpdateTripInput は旅行更新時の入力
+type UpdateTripInput struct {
+	ID        string
+	Name      string
+	StartDate *time.Time
+	EndDate   *time.Time
+	// HomeCurrency は予算の集計に使う旅行の基準通貨。空の場合は未設定とする
+	HomeCurrency string
+	// Version は更新の前提とする旅行のバージョン。現在のバージョンと異なる場合は更新しない
+	Version int64
+}
+
+// ListTripInput は旅行一覧取得時の入力。未指定の項目は既定値または絞り込みなしとして扱う
+type ListTripInput struct {
+	// Sort は name, created_at, updated_at, start_date のいずれか
+	Sort string
+	// Order は asc か desc
+	Order      string
+	NamePrefix string
+	// From と To を指定すると、期間がその範囲と重なる旅行だけを取得する
+	From   *time.Time
+	To     *time.Time
+	Limit  int
+	Cursor string
+}
@@ -0,0 +1,284 @@
+package usecase
+
+import (
+	"context"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/invitation.go github.com/hata0/travel-api/internal/usecase InvitationUsecase
+type InvitationUsecase interface {
+	Create(ctx context.Context, in input.CreateInvitationInput) (*output.CreateInvitationOutput, error)
+	ListByTrip(ctx context.Context, tripID string) (*output.ListInvitationOutput, error)
+	ListPending(ctx context.Context) (*output.ListInvitationOutput, error)
+	Accept(ctx context.Context, id string) error
+	Decline(ctx context.Context, id string) error
+}
+
+type InvitationInteractor struct {
+	invitationRepository membership.InvitationRepository
+	memberRepository     membership.MemberRepository
+	userRepository       user.UserRepository
+	authorizer           tripAuthorizer
+	transactionManager   transaction_manager.TransactionManager
+	timeService          service.TimeService
+	idService            service.IDService
+}
+
+func NewInvitationInteractor(
+	invitationRepository membership.InvitationRepository,
+	memberRepository membership.MemberRepository,
+	userRepository user.UserRepository,
+	transactionManager transaction_manager.TransactionManager,
+	timeService service.TimeService,
+	idService service.IDService,
+) InvitationUsecase {
+	return &InvitationInteractor{
+		invitationRepository: invitationRepository,
+		memberRepository:     memberRepository,
+		userRepository:       userRepository,
+		authorizer:           newTripAuthorizer(memberRepository),
+		transactionManager:   transactionManager,
+		timeService:          timeService,
+		idService:            idService,
+	}
+}
+
+// Create は旅行への招待を作成する。所有者だけが招待でき、有効期限は DefaultInvitationTTL 後となる。
+// ユーザー名で招待する場合はそのユーザーが登録済みである必要がある
+func (i *InvitationInteractor) Create(ctx context.Context, in input.CreateInvitationInput) (*output.CreateInvitationOutput, error) {
+	tripID := trip.NewTripID(in.TripID)
+
+	owner, err := i.authorizer.authorize(ctx, tripID, membership.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := membership.ParseRole(in.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	invitee, err := membership.NewInvitee(in.Email, in.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.ensureInviteeNotMember(ctx, tripID, invitee); err != nil {
+		return nil, err
+	}
+
+	now := i.timeService.Now()
+	invitationID := membership.NewInvitationID(i.idService.Generate())
+
+	invitation, err := membership.NewInvitation(
+		invitationID,
+		tripID,
+		owner.UserID(),
+		invitee,
+		role,
+		now.Add(membership.DefaultInvitationTTL),
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.invitationRepository.Create(ctx, invitation); err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to create invitation", apperr.WithCause(err))
+	}
+
+	return output.NewCreateInvitationOutput(invitationID), nil
+}
+
+// ListByTrip は旅行の招待を作成日時の新しい順に取得する。所有者だけが取得できる
+func (i *InvitationInteractor) ListByTrip(ctx context.Context, tripID string) (*output.ListInvitationOutput, error) {
+	id := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorize(ctx, id, membership.RoleOwner); err != nil {
+		return nil, err
+	}
+
+	invitations, err := i.invitationRepository.FindByTripID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list invitations", apperr.WithCause(err))
+	}
+
+	return output.NewListInvitationOutput(invitations, i.timeService.Now()), nil
+}
+
+// ListPending は実行ユーザー宛ての、有効期限内で未応答の招待を取得する
+func (i *InvitationInteractor) ListPending(ctx context.Context) (*output.ListInvitationOutput, error) {
+	u, err := i.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := i.invitationRepository.FindPendingByInvitee(ctx, u.Email(), u.Username())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list pending invitations", apperr.WithCause(err))
+	}
+
+	now := i.timeService.Now()
+	pending := make([]*membership.Invitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		if invitation.StatusAt(now) == membership.InvitationStatusPending {
+			pending = append(pending, invitation)
+		}
+	}
+
+	return output.NewListInvitationOutput(pending, now), nil
+}
+
+// Accept は実行ユーザー宛ての招待を承諾し、招待されたロールで旅行のメンバーに加える
+func (i *InvitationInteractor) Accept(ctx context.Context, id string) error {
+	u, err := i.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := i.findInvitation(ctx, membership.NewInvitationID(id))
+	if err != nil {
+		return err
+	}
+
+	now := i.timeService.Now()
+
+	accepted, err := invitation.Accept(u, now)
+	if err != nil {
+		return err
+	}
+
+	if err := i.ensureNotMember(ctx, accepted.TripID(), u.ID()); err != nil {
+		return err
+	}
+
+	member := membership.NewMember(accepted.TripID(), u.ID(), accepted.Role(), now, now)
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.invitationRepository.Update(txCtx, accepted); err != nil {
+			return err
+		}
+		return i.memberRepository.Save(txCtx, member)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to accept invitation", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Decline は実行ユーザー宛ての招待を辞退する
+func (i *InvitationInteractor) Decline(ctx context.Context, id string) error {
+	u, err := i.currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := i.findInvitation(ctx, membership.NewInvitationID(id))
+	if err != nil {
+		return err
+	}
+
+	declined, err := invitation.Decline(u, i.timeService.Now())
+	if err != nil {
+		return err
+	}
+
+	if err := i.invitationRepository.Update(ctx, declined); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to decline invitation", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// currentUser は操作を実行するユーザーを取得する
+func (i *InvitationInteractor) currentUser(ctx context.Context) (*user.User, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := i.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get user", apperr.WithCause(err))
+	}
+	return u, nil
+}
+
+// findInvitation は招待を取得する
+func (i *InvitationInteractor) findInvitation(ctx context.Context, id membership.InvitationID) (*membership.Invitation, error) {
+	invitation, err := i.invitationRepository.FindByID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get invitation", apperr.WithCause(err))
+	}
+	return invitation, nil
+}
+
+// ensureInviteeNotMember は招待の宛先が登録済みのユーザーであれば、既に旅行のメンバーでないことを確認する。
+// メールアドレスで招待する場合は、未登録のユーザーも招待できる
+func (i *InvitationInteractor) ensureInviteeNotMember(ctx context.Context, tripID trip.TripID, invitee membership.Invitee) error {
+	var (
+		u   *user.User
+		err error
+	)
+	if invitee.Email() != "" {
+		u, err = i.userRepository.FindByEmail(ctx, invitee.Email())
+		if apperr.IsAppErrorWithCode(err, user.CodeUserNotFound) {
+			return nil
+		}
+	} else {
+		u, err = i.userRepository.FindByUsername(ctx, invitee.Username())
+	}
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to get invitee user", apperr.WithCause(err))
+	}
+
+	return i.ensureNotMember(ctx, tripID, u.ID())
+}
+
+// ensureNotMember はユーザーが既に旅行のメンバーでないことを確認する
+func (i *InvitationInteractor) ensureNotMember(ctx context.Context, tripID trip.TripID, userID user.UserID) error {
+	_, err := i.memberRepository.FindByTripAndUser(ctx, tripID, userID)
+	if err == nil {
+		return membership.NewAlreadyMemberError()
+	}
+	if apperr.IsAppErrorWithCode(err, membership.CodeMemberNotFound) {
+		return nil
+	}
+	if apperr.IsAppError(err) {
+		return err
+	}
+	return apperr.NewInternalError("Failed to get trip member", apperr.WithCause(err))
+}
@@ -0,0 +1,509 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/actor"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	mock_user "github.com/hata0/travel-api/internal/domain/user/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var (
+	invitationTripID    = trip.NewTripID("trip-id")
+	invitationID        = membership.NewInvitationID("invitation-id")
+	invitationFixedTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	inviteeUser         = user.NewUser(user.NewUserID("invitee-user-id"), "invitee", "invitee@example.com", []byte("hash"), invitationFixedTime, invitationFixedTime)
+)
+
+// newInviteeContext は招待されたユーザーを実行ユーザーとするコンテキストを作成する
+func newInviteeContext(repo *mock_user.MockUserRepository) context.Context {
+	repo.EXPECT().FindByID(gomock.Any(), inviteeUser.ID()).Return(inviteeUser, nil).AnyTimes()
+	return actor.WithUserID(context.Background(), inviteeUser.ID())
+}
+
+func newTestInvitation(t *testing.T, invitee membership.Invitee) *membership.Invitation {
+	t.Helper()
+
+	invitation, err := membership.NewInvitation(
+		invitationID,
+		invitationTripID,
+		testActorID,
+		invitee,
+		membership.RoleEditor,
+		invitationFixedTime.Add(membership.DefaultInvitationTTL),
+		invitationFixedTime,
+	)
+	require.NoError(t, err)
+	return invitation
+}
+
+func newEmailInvitee(t *testing.T) membership.Invitee {
+	t.Helper()
+
+	invitee, err := membership.NewInvitee(inviteeUser.Email(), "")
+	require.NoError(t, err)
+	return invitee
+}
+
+func TestInvitationInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInvitationRepo := mock_membership.NewMockInvitationRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockUserRepo := mock_user.NewMockUserRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	interactor := NewInvitationInteractor(mockInvitationRepo, mockMemberRepo, mockUserRepo, mockTxManager, mockTimeService, mockIDService)
+
+	validInput := input.CreateInvitationInput{TripID: "trip-id", Email: "invitee@example.com", Role: "editor"}
+	ownerRoleInput := validInput
+	ownerRoleInput.Role = "owner"
+	existingMember := membership.NewMember(invitationTripID, inviteeUser.ID(), membership.RoleViewer, invitationFixedTime, invitationFixedTime)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.CreateInvitationInput
+		setup   func()
+		want    *output.CreateInvitationOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 未登録のメールアドレス宛てに招待を作成できる",
+			in:   validInput,
+			setup: func() {
+				mockUserRepo.EXPECT().FindByEmail(gomock.Any(), "invitee@example.com").Return(nil, user.NewUserNotFoundError())
+				mockTimeService.EXPECT().Now().Return(invitationFixedTime)
+				mockIDService.EXPECT().Generate().Return("invitation-id")
+				mockInvitationRepo.EXPECT().Create(gomock.Any(), newTestInvitation(t, newEmailInvitee(t))).Return(nil)
+			},
+			want: &output.CreateInvitationOutput{ID: "invitation-id"},
+		},
+		{
+			name: "正常系: 登録済みのユーザー名宛てに招待を作成できる",
+			in:   input.CreateInvitationInput{TripID: "trip-id", Username: "invitee", Role: "viewer"},
+			setup: func() {
+				mockUserRepo.EXPECT().FindByUsername(gomock.Any(), "invitee").Return(inviteeUser, nil)
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), invitationTripID, inviteeUser.ID()).Return(nil, membership.NewMemberNotFoundError())
+				mockTimeService.EXPECT().Now().Return(invitationFixedTime)
+				mockIDService.EXPECT().Generate().Return("invitation-id")
+				mockInvitationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			want: &output.CreateInvitationOutput{ID: "invitation-id"},
+		},
+		{
+			name: "異常系: 未登録のユーザー名宛てには招待できない",
+			in:   input.CreateInvitationInput{TripID: "trip-id", Username: "unknown", Role: "viewer"},
+			setup: func() {
+				mockUserRepo.EXPECT().FindByUsername(gomock.Any(), "unknown").Return(nil, user.NewUserNotFoundError())
+			},
+			wantErr: user.NewUserNotFoundError(),
+		},
+		{
+			name: "異常系: 既にメンバーのユーザーは招待できない",
+			in:   validInput,
+			setup: func() {
+				mockUserRepo.EXPECT().FindByEmail(gomock.Any(), "invitee@example.com").Return(inviteeUser, nil)
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), invitationTripID, inviteeUser.ID()).Return(existingMember, nil)
+			},
+			wantErr: membership.NewAlreadyMemberError(),
+		},
+		{
+			name: "異常系: 所有者のロールでは招待できない",
+			in:   ownerRoleInput,
+			setup: func() {
+				mockUserRepo.EXPECT().FindByEmail(gomock.Any(), "invitee@example.com").Return(nil, user.NewUserNotFoundError())
+				mockTimeService.EXPECT().Now().Return(invitationFixedTime)
+				mockIDService.EXPECT().Generate().Return("invitation-id")
+			},
+			wantErr: membership.NewInvalidInvitationRoleError(),
+		},
+		{
+			name:    "異常系: 宛先が指定されていない",
+			in:      input.CreateInvitationInput{TripID: "trip-id", Role: "editor"},
+			setup:   func() {},
+			wantErr: membership.NewInvalidInviteeError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockUserRepo.EXPECT().FindByEmail(gomock.Any(), "invitee@example.com").Return(nil, user.NewUserNotFoundError())
+				mockTimeService.EXPECT().Now().Return(invitationFixedTime)
+				mockIDService.EXPECT().Generate().Return("invitation-id")
+				mockInvitationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to create invitation", apperr.WithCause(errors.New("database write error"))),
+		},
+		{
+			name:    "異常系: 所有者でなければ招待できない",
+			ctx:     viewerCtx,
+			in:      validInput,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Create(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestInvitationInteractor_ListByTrip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInvitationRepo := mock_membership.NewMockInvitationRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockUserRepo := mock_user.NewMockUserRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	interactor := NewInvitationInteractor(mockInvitationRepo, mockMemberRepo, mockUserRepo, mockTxManager, mockTimeService, mockIDService)
+
+	invitations := []*membership.Invitation{newTestInvitation(t, newEmailInvitee(t))}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.ListInvitationOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 旅行の招待一覧を取得できる",
+			setup: func() {
+				mockInvitationRepo.EXPECT().FindByTripID(gomock.Any(), invitationTripID).Return(invitations, nil)
+				mockTimeService.EXPECT().Now().Return(invitationFixedTime)
+			},
+			want: output.NewListInvitationOutput(invitations, invitationFixedTime),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockInvitationRepo.EXPECT().FindByTripID(gomock.Any(), invitationTripID).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list invitations", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name:    "異常系: 所有者でなければ取得できない",
+			ctx:     viewerCtx,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.ListByTrip(ctx, "trip-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestInvitationInteractor_ListPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInvitationRepo := mock_membership.NewMockInvitationRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockUserRepo := mock_user.NewMockUserRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	inviteeCtx := newInviteeContext(mockUserRepo)
+
+	interactor := NewInvitationInteractor(mockInvitationRepo, mockMemberRepo, mockUserRepo, mockTxManager, mockTimeService, mockIDService)
+
+	valid := newTestInvitation(t, newEmailInvitee(t))
+	expired := membership.RestoreInvitation(
+		membership.NewInvitationID("expired-id"), invitationTripID, testActorID, newEmailInvitee(t),
+		membership.RoleViewer, membership.InvitationStatusPending,
+		invitationFixedTime.Add(time.Hour), invitationFixedTime.Add(-time.Hour), nil,
+	)
+	now := invitationFixedTime.Add(2 * time.Hour)
+
+	tests := []struct {
+		name string
+		// ctx を省略した場合は招待されたユーザーで実行する
+		ctx     context.Context
+		setup   func()
+		want    *output.ListInvitationOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 有効期限切れの招待は除外される",
+			setup: func() {
+				mockInvitationRepo.EXPECT().FindPendingByInvitee(gomock.Any(), inviteeUser.Email(), inviteeUser.Username()).
+					Return([]*membership.Invitation{valid, expired}, nil)
+				mockTimeService.EXPECT().Now().Return(now)
+			},
+			want: output.NewListInvitationOutput([]*membership.Invitation{valid}, now),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockInvitationRepo.EXPECT().FindPendingByInvitee(gomock.Any(), inviteeUser.Email(), inviteeUser.Username()).
+					Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list pending invitations", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name:    "異常系: 実行ユーザーが特定できない",
+			ctx:     context.Background(),
+			setup:   func() {},
+			wantErr: apperr.NewInvalidCredentialsError("authenticated user is required"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = inviteeCtx
+			}
+
+			got, err := interactor.ListPending(ctx)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestInvitationInteractor_Accept(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInvitationRepo := mock_membership.NewMockInvitationRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockUserRepo := mock_user.NewMockUserRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	inviteeCtx := newInviteeContext(mockUserRepo)
+
+	interactor := NewInvitationInteractor(mockInvitationRepo, mockMemberRepo, mockUserRepo, mockTxManager, mockTimeService, mockIDService)
+
+	invitation := newTestInvitation(t, newEmailInvitee(t))
+	now := invitationFixedTime.Add(time.Hour)
+	accepted, err := invitation.Accept(inviteeUser, now)
+	require.NoError(t, err)
+	other, err := membership.NewInvitee("other@example.com", "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 招待を承諾するとメンバーに加わる",
+			setup: func() {
+				mockInvitationRepo.EXPECT().FindByID(gomock.Any(), invitationID).Return(invitation, nil)
+				mockTimeService.EXPECT().Now().Return(now)
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), invitationTripID, inviteeUser.ID()).Return(nil, membership.NewMemberNotFoundError())
+				mockInvitationRepo.EXPECT().Update(gomock.Any(), accepted).Return(nil)
+				mockMemberRepo.EXPECT().Save(gomock.Any(), membership.NewMember(invitationTripID, inviteeUser.ID(), membership.RoleEditor, now, now)).Return(nil)
+			},
+		},
+		{
+			name: "異常系: 他のユーザー宛ての招待は承諾できない",
+			setup: func() {
+				mockInvitationRepo.EXPECT().FindByID(gomock.Any(), invitationID).Return(newTestInvitation(t, other), nil)
+				mockTimeService.EXPECT().Now().Return(invitationFixedTime)
+			},
+			wantErr: membership.NewInvitationNotFoundError(),
+		},
+		{
+			name: "異常系: 有効期限切れの招待は承諾できない",
+			setup: func() {
+				mockInvitationRepo.EXPECT().FindByID(gomock.Any(), invitationID).Return(invitation, nil)
+				mockTimeService.EXPECT().Now().Return(invitationFixedTime.Add(membership.DefaultInvitationTTL))
+			},
+			wantErr: membership.NewInvitationExpiredError(),
+		},
+		{
+			name: "異常系: メンバーの保存に失敗する",
+			setup: func() {
+				mockInvitationRepo.EXPECT().FindByID(gomock.Any(), invitationID).Return(invitation, nil)
+				mockTimeService.EXPECT().Now().Return(invitationFixedTime)
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), invitationTripID, inviteeUser.ID()).Return(nil, membership.NewMemberNotFoundError())
+				mockInvitationRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				mockMemberRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to accept invitation", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			err := interactor.Accept(inviteeCtx, "invitation-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestInvitationInteractor_Decline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInvitationRepo := mock_membership.NewMockInvitationRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockUserRepo := mock_user.NewMockUserRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	inviteeCtx := newInviteeContext(mockUserRepo)
+
+	interactor := NewInvitationInteractor(mockInvitationRepo, mockMemberRepo, mockUserRepo, mockTxManager, mockTimeService, mockIDService)
+
+	invitation := newTestInvitation(t, newEmailInvitee(t))
+	declined, err := invitation.Decline(inviteeUser, invitationFixedTime)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 招待を辞退できる",
+			setup: func() {
+				mockInvitationRepo.EXPECT().FindByID(gomock.Any(), invitationID).Return(invitation, nil)
+				mockTimeService.EXPECT().Now().Return(invitationFixedTime)
+				mockInvitationRepo.EXPECT().Update(gomock.Any(), declined).Return(nil)
+			},
+		},
+		{
+			name: "異常系: 招待が存在しない",
+			setup: func() {
+				mockInvitationRepo.EXPECT().FindByID(gomock.Any(), invitationID).Return(nil, membership.NewInvitationNotFoundError())
+			},
+			wantErr: membership.NewInvitationNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockInvitationRepo.EXPECT().FindByID(gomock.Any(), invitationID).Return(invitation, nil)
+				mockTimeService.EXPECT().Now().Return(invitationFixedTime)
+				mockInvitationRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to decline invitation", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			err := interactor.Decline(inviteeCtx, "invitation-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/item_template.go github.com/hata0/travel-api/internal/usecase ItemTemplateUsecase
+type ItemTemplateUsecase interface {
+	List(ctx context.Context) (*output.ListItemTemplateOutput, error)
+	Create(ctx context.Context, in input.CreateItemTemplateInput) (*output.CreateItemTemplateOutput, error)
+	Update(ctx context.Context, in input.UpdateItemTemplateInput) error
+	Delete(ctx context.Context, id string) error
+}
+
+type ItemTemplateInteractor struct {
+	itemTemplateRepository checklist.ItemTemplateRepository
+	timeService            service.TimeService
+	idService              service.IDService
+}
+
+func NewItemTemplateInteractor(
+	itemTemplateRepository checklist.ItemTemplateRepository,
+	timeService service.TimeService,
+	idService service.IDService,
+) ItemTemplateUsecase {
+	return &ItemTemplateInteractor{
+		itemTemplateRepository: itemTemplateRepository,
+		timeService:            timeService,
+		idService:              idService,
+	}
+}
+
+// List は実行ユーザーの項目テンプレートを作成日時の古い順に取得する
+func (i *ItemTemplateInteractor) List(ctx context.Context) (*output.ListItemTemplateOutput, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := i.itemTemplateRepository.FindByOwnerID(ctx, userID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list item templates", apperr.WithCause(err))
+	}
+
+	return output.NewListItemTemplateOutput(templates), nil
+}
+
+// Create は実行ユーザーの項目テンプレートを作成する
+func (i *ItemTemplateInteractor) Create(ctx context.Context, in input.CreateItemTemplateInput) (*output.CreateItemTemplateOutput, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := i.timeService.Now()
+	tmpl, err := checklist.NewItemTemplate(
+		checklist.NewItemTemplateID(i.idService.Generate()),
+		userID,
+		in.Name,
+		in.Quantity,
+		in.PerDay,
+		now,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.itemTemplateRepository.Create(ctx, tmpl); err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to create item template", apperr.WithCause(err))
+	}
+
+	return output.NewCreateItemTemplateOutput(tmpl.ID()), nil
+}
+
+// Update は実行ユーザーの項目テンプレートを更新する
+func (i *ItemTemplateInteractor) Update(ctx context.Context, in input.UpdateItemTemplateInput) error {
+	tmpl, err := i.findOwned(ctx, in.ID)
+	if err != nil {
+		return err
+	}
+
+	updated, err := tmpl.Update(in.Name, in.Quantity, in.PerDay, i.timeService.Now())
+	if err != nil {
+		return err
+	}
+
+	if err := i.itemTemplateRepository.Update(ctx, updated); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to update item template", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は実行ユーザーの項目テンプレートを削除する。作成済みのチェックリストには影響しない
+func (i *ItemTemplateInteractor) Delete(ctx context.Context, id string) error {
+	tmpl, err := i.findOwned(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := i.itemTemplateRepository.Delete(ctx, tmpl.ID()); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to delete item template", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// findOwned は実行ユーザーの項目テンプレートを取得する。他のユーザーの項目テンプレートは存在しないものとして扱う
+func (i *ItemTemplateInteractor) findOwned(ctx context.Context, id string) (*checklist.ItemTemplate, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := i.itemTemplateRepository.FindByID(ctx, checklist.NewItemTemplateID(id))
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get item template", apperr.WithCause(err))
+	}
+
+	if err := tmpl.AuthorizeFor(userID); err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
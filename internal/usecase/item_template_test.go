@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	mock_checklist "github.com/hata0/travel-api/internal/domain/checklist/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+func TestItemTemplateInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_checklist.NewMockItemTemplateRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+
+	interactor := NewItemTemplateInteractor(mockRepo, mockTimeService, mockIDService)
+
+	socks, err := checklist.NewItemTemplate(checklist.NewItemTemplateID("socks-id"), testActorID, "靴下", 1, true, checklistFixedTime, checklistFixedTime)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.ListItemTemplateOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 実行ユーザーの項目テンプレートが返される",
+			ctx:  newActorContext(),
+			setup: func() {
+				mockRepo.EXPECT().FindByOwnerID(gomock.Any(), testActorID).Return([]*checklist.ItemTemplate{socks}, nil)
+			},
+			want: output.NewListItemTemplateOutput([]*checklist.ItemTemplate{socks}),
+		},
+		{
+			name:    "異常系: 実行ユーザーがいない",
+			ctx:     context.Background(),
+			setup:   func() {},
+			wantErr: apperr.NewInvalidCredentialsError("authenticated user is required"),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			ctx:  newActorContext(),
+			setup: func() {
+				mockRepo.EXPECT().FindByOwnerID(gomock.Any(), testActorID).Return(nil, errors.New("db error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list item templates", apperr.WithCause(errors.New("db error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.List(tt.ctx)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestItemTemplateInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_checklist.NewMockItemTemplateRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+
+	interactor := NewItemTemplateInteractor(mockRepo, mockTimeService, mockIDService)
+
+	templateID := checklist.NewItemTemplateID("template-id")
+	socks, err := checklist.NewItemTemplate(templateID, testActorID, "靴下", 1, true, checklistFixedTime, checklistFixedTime)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		in      input.CreateItemTemplateInput
+		setup   func()
+		want    *output.CreateItemTemplateOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 実行ユーザーを所有者とする項目テンプレートを作成できる",
+			in:   input.CreateItemTemplateInput{Name: "靴下", Quantity: 1, PerDay: true},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+				mockIDService.EXPECT().Generate().Return("template-id")
+				mockRepo.EXPECT().Create(gomock.Any(), socks).Return(nil)
+			},
+			want: output.NewCreateItemTemplateOutput(templateID),
+		},
+		{
+			name: "異常系: 数量が 0 以下",
+			in:   input.CreateItemTemplateInput{Name: "靴下", Quantity: 0},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+				mockIDService.EXPECT().Generate().Return("template-id")
+			},
+			wantErr: checklist.NewNonPositiveQuantityError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   input.CreateItemTemplateInput{Name: "靴下", Quantity: 1},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(checklistFixedTime)
+				mockIDService.EXPECT().Generate().Return("template-id")
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to create item template", apperr.WithCause(errors.New("db error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.Create(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestItemTemplateInteractor_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_checklist.NewMockItemTemplateRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+
+	interactor := NewItemTemplateInteractor(mockRepo, mockTimeService, mockIDService)
+
+	templateID := checklist.NewItemTemplateID("template-id")
+	owned, err := checklist.NewItemTemplate(templateID, testActorID, "靴下", 1, true, checklistFixedTime, checklistFixedTime)
+	require.NoError(t, err)
+	othersTemplate, err := checklist.NewItemTemplate(templateID, user.NewUserID("other-user-id"), "靴下", 1, true, checklistFixedTime, checklistFixedTime)
+	require.NoError(t, err)
+	now := checklistFixedTime.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 自分の項目テンプレートを更新できる",
+			setup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(owned, nil)
+				mockTimeService.EXPECT().Now().Return(now)
+				mockRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, updated *checklist.ItemTemplate) error {
+						assert.Equal(t, "下着", updated.Name())
+						assert.Equal(t, 2, updated.Quantity())
+						assert.False(t, updated.PerDay())
+						assert.Equal(t, now, updated.UpdatedAt())
+						return nil
+					})
+			},
+		},
+		{
+			name: "異常系: 他のユーザーの項目テンプレートは存在しないものとして扱う",
+			setup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(othersTemplate, nil)
+			},
+			wantErr: checklist.NewItemTemplateNotFoundError(),
+		},
+		{
+			name: "異常系: 更新時に予期しないエラーが返される",
+			setup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(owned, nil)
+				mockTimeService.EXPECT().Now().Return(now)
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to update item template", apperr.WithCause(errors.New("db error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			err := interactor.Update(newActorContext(), input.UpdateItemTemplateInput{ID: "template-id", Name: "下着", Quantity: 2})
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestItemTemplateInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_checklist.NewMockItemTemplateRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+
+	interactor := NewItemTemplateInteractor(mockRepo, mockTimeService, mockIDService)
+
+	templateID := checklist.NewItemTemplateID("template-id")
+	owned, err := checklist.NewItemTemplate(templateID, testActorID, "靴下", 1, true, checklistFixedTime, checklistFixedTime)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 自分の項目テンプレートを削除できる",
+			setup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(owned, nil)
+				mockRepo.EXPECT().Delete(gomock.Any(), templateID).Return(nil)
+			},
+		},
+		{
+			name: "異常系: 存在しない項目テンプレート",
+			setup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(nil, checklist.NewItemTemplateNotFoundError())
+			},
+			wantErr: checklist.NewItemTemplateNotFoundError(),
+		},
+		{
+			name: "異常系: 取得時に予期しないエラーが返される",
+			setup: func() {
+				mockRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(nil, errors.New("db error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get item template", apperr.WithCause(errors.New("db error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			err := interactor.Delete(newActorContext(), "template-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,437 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/itinerary.go github.com/hata0/travel-api/internal/usecase ItineraryUsecase
+type ItineraryUsecase interface {
+	Get(ctx context.Context, tripID, id string) (*output.GetActivityOutput, error)
+	Itinerary(ctx context.Context, tripID string, date *time.Time) (*output.GetItineraryOutput, error)
+	Create(ctx context.Context, in input.CreateActivityInput) (*output.CreateActivityOutput, error)
+	Update(ctx context.Context, in input.UpdateActivityInput) error
+	Delete(ctx context.Context, tripID, id string) error
+	Optimize(ctx context.Context, in input.OptimizeDayInput) (*output.OptimizeDayOutput, error)
+}
+
+type ItineraryInteractor struct {
+	activityRepository itinerary.ActivityRepository
+	tripRepository     trip.TripRepository
+	authorizer         tripAuthorizer
+	history            historyRecorder
+	transactionManager transaction_manager.TransactionManager
+	speeds             itinerary.Speeds
+	optimizeTimeBudget time.Duration
+	timeService        service.TimeService
+	idService          service.IDService
+}
+
+// NewItineraryInteractor は行程のユースケースを作成する。speeds は行動の間の移動時間の見積もりに使う移動手段ごとの平均の速さ、
+// optimizeTimeBudget は行動を巡る順の並べ替えに使える時間
+func NewItineraryInteractor(
+	activityRepository itinerary.ActivityRepository,
+	tripRepository trip.TripRepository,
+	memberRepository membership.MemberRepository,
+	historyRepository history.HistoryRepository,
+	transactionManager transaction_manager.TransactionManager,
+	speeds itinerary.Speeds,
+	optimizeTimeBudget time.Duration,
+	timeService service.TimeService,
+	idService service.IDService,
+) ItineraryUsecase {
+	return &ItineraryInteractor{
+		activityRepository: activityRepository,
+		tripRepository:     tripRepository,
+		authorizer:         newTripAuthorizer(memberRepository),
+		history:            newHistoryRecorder(historyRepository),
+		transactionManager: transactionManager,
+		speeds:             speeds,
+		optimizeTimeBudget: optimizeTimeBudget,
+		timeService:        timeService,
+		idService:          idService,
+	}
+}
+
+// Get は旅行に紐づく指定されたIDの行動を取得する
+func (i *ItineraryInteractor) Get(ctx context.Context, tripID, id string) (*output.GetActivityOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	a, err := i.findInTrip(ctx, trip.NewTripID(tripID), itinerary.NewActivityID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetActivityOutput(a), nil
+}
+
+// Itinerary は旅行の行程を日付順に取得する。連続する行動の間の直線距離と移動時間を見積もり、
+// 空き時間が移動時間より短い移動を警告として返す。date が nil でない場合はその日の行程だけを取得する
+func (i *ItineraryInteractor) Itinerary(ctx context.Context, tripID string, date *time.Time) (*output.GetItineraryOutput, error) {
+	id := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorize(ctx, id, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	t, err := i.findTrip(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	activities, err := i.activityRepository.FindByTripID(ctx, id, date)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list activities", apperr.WithCause(err))
+	}
+
+	return output.NewGetItineraryOutput(itinerary.PlanDays(activities, i.speeds), t.Period(), i.speeds), nil
+}
+
+// Create は旅行の行程に新しい行動を追加する。行動はその日の最後に追加し、日付は旅行期間内でなければならない
+func (i *ItineraryInteractor) Create(ctx context.Context, in input.CreateActivityInput) (*output.CreateActivityOutput, error) {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := itinerary.ParseTravelMode(in.TravelMode)
+	if err != nil {
+		return nil, err
+	}
+
+	place, err := newActivityPlace(in.Place)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone, err := newOptionalTimezone(in.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(in.TripID))
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := i.nextPosition(ctx, t.ID(), in.Date, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := i.timeService.Now()
+	a, err := itinerary.NewActivity(
+		itinerary.NewActivityID(i.idService.Generate()), t.ID(), in.Date, position,
+		in.Title, place, timezone, in.StartAt, in.EndAt, mode, in.Notes, now, now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.ValidateFor(t); err != nil {
+		return nil, err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.activityRepository.Create(txCtx, a); err != nil {
+			return err
+		}
+		return i.history.recordCreated(txCtx, t.ID(), m.UserID(), now, a)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to create activity", apperr.WithCause(err))
+	}
+
+	return output.NewCreateActivityOutput(a.ID()), nil
+}
+
+// Update は既存の行動を更新する。別の日に移した場合は移した先の日の最後に並べる
+func (i *ItineraryInteractor) Update(ctx context.Context, in input.UpdateActivityInput) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	mode, err := itinerary.ParseTravelMode(in.TravelMode)
+	if err != nil {
+		return err
+	}
+
+	place, err := newActivityPlace(in.Place)
+	if err != nil {
+		return err
+	}
+
+	timezone, err := newOptionalTimezone(in.Timezone)
+	if err != nil {
+		return err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(in.TripID))
+	if err != nil {
+		return err
+	}
+
+	a, err := i.findInTrip(ctx, t.ID(), itinerary.NewActivityID(in.ID))
+	if err != nil {
+		return err
+	}
+
+	now := i.timeService.Now()
+	updated, err := a.Update(in.Date, in.Title, place, timezone, in.StartAt, in.EndAt, mode, in.Notes, now)
+	if err != nil {
+		return err
+	}
+
+	if err := updated.ValidateFor(t); err != nil {
+		return err
+	}
+
+	if !updated.Date().Equal(a.Date()) {
+		position, err := i.nextPosition(ctx, t.ID(), updated.Date(), a)
+		if err != nil {
+			return err
+		}
+		updated = updated.MoveTo(position, now)
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.activityRepository.Update(txCtx, updated); err != nil {
+			return err
+		}
+		return i.history.recordUpdated(txCtx, t.ID(), m.UserID(), now, a, updated)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to update activity", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は旅行に紐づく指定されたIDの行動を削除する
+func (i *ItineraryInteractor) Delete(ctx context.Context, tripID, id string) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	a, err := i.findInTrip(ctx, trip.NewTripID(tripID), itinerary.NewActivityID(id))
+	if err != nil {
+		return err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.activityRepository.Delete(txCtx, a.ID()); err != nil {
+			return err
+		}
+		return i.history.recordDeleted(txCtx, a.TripID(), m.UserID(), i.timeService.Now(), a)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to delete activity", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Optimize は旅行の in.Day 日目の行動を巡る順を、出発地から到着地までの合計距離が短くなるように並べ替えた提案を返す。
+// 探索は optimizeTimeBudget の時間で打ち切る。in.Apply が true の場合は提案した順に並び順を更新し、編集者以上の権限が必要になる
+func (i *ItineraryInteractor) Optimize(ctx context.Context, in input.OptimizeDayInput) (*output.OptimizeDayOutput, error) {
+	id := trip.NewTripID(in.TripID)
+
+	role := membership.RoleViewer
+	if in.Apply {
+		role = membership.RoleEditor
+	}
+	m, err := i.authorizer.authorize(ctx, id, role)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints, err := newRouteEndpoints(in.Start, in.End)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := i.findTrip(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	period := t.Period()
+	if period == nil || in.Day < 1 || in.Day > period.Days() {
+		return nil, itinerary.NewInvalidDayError()
+	}
+	date := period.StartDate().AddDate(0, 0, in.Day-1)
+
+	activities, err := i.activityRepository.FindByTripID(ctx, id, &date)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list activities", apperr.WithCause(err))
+	}
+
+	// 今の巡る順は行程と同じく並び順、作成日時の古い順
+	var current []*itinerary.Activity
+	if plans := itinerary.PlanDays(activities, i.speeds); len(plans) > 0 {
+		current = plans[0].Activities()
+	}
+
+	pinned := make([]itinerary.ActivityID, 0, len(in.PinnedActivityIDs))
+	for _, pinnedID := range in.PinnedActivityIDs {
+		activityID := itinerary.NewActivityID(pinnedID)
+		if findActivity(current, activityID) == nil {
+			return nil, itinerary.NewPinnedActivityOutsideDayError()
+		}
+		pinned = append(pinned, activityID)
+	}
+
+	deadline := i.timeService.Now().Add(i.optimizeTimeBudget)
+	expired := func() bool {
+		return ctx.Err() != nil || !i.timeService.Now().Before(deadline)
+	}
+	o := itinerary.OptimizeDay(current, endpoints, pinned, i.speeds, expired)
+
+	proposed := o.Activities()
+	if in.Apply {
+		now := i.timeService.Now()
+		moved := o.Reordered(now)
+		err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+			befores, afters := make([]any, 0, len(moved)), make([]any, 0, len(moved))
+			for _, updated := range moved {
+				if err := i.activityRepository.Update(txCtx, updated); err != nil {
+					return err
+				}
+				befores, afters = append(befores, findActivity(current, updated.ID())), append(afters, updated)
+			}
+			// 並べ替えは 1 つの操作なので、動かした行動の変更を 1 つのリビジョンにまとめる
+			return i.history.recordUpdatedAll(txCtx, t.ID(), m.UserID(), now, befores, afters)
+		})
+		if err != nil {
+			if apperr.IsAppError(err) {
+				return nil, err
+			}
+			return nil, apperr.NewInternalError("Failed to reorder activities", apperr.WithCause(err))
+		}
+
+		reordered := make([]*itinerary.Activity, len(proposed))
+		for k, a := range proposed {
+			reordered[k] = a
+			if updated := findActivity(moved, a.ID()); updated != nil {
+				reordered[k] = updated
+			}
+		}
+		proposed = reordered
+	}
+
+	return output.NewOptimizeDayOutput(o, itinerary.NewDayPlan(date, proposed, i.speeds), period, in.Apply), nil
+}
+
+// nextPosition は date の行程の最後に行動を追加するときの並び順を返す。moving が nil でない場合はその行動を除いて数える
+func (i *ItineraryInteractor) nextPosition(ctx context.Context, tripID trip.TripID, date time.Time, moving *itinerary.Activity) (int, error) {
+	day := trip.TruncateToDate(date)
+	sameDay, err := i.activityRepository.FindByTripID(ctx, tripID, &day)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return 0, err
+		}
+		return 0, apperr.NewInternalError("Failed to list activities", apperr.WithCause(err))
+	}
+
+	others := make([]*itinerary.Activity, 0, len(sameDay))
+	for _, a := range sameDay {
+		if !a.Equals(moving) {
+			others = append(others, a)
+		}
+	}
+	return itinerary.NextPosition(others), nil
+}
+
+// findTrip は行動の親となる旅行を取得する
+func (i *ItineraryInteractor) findTrip(ctx context.Context, tripID trip.TripID) (*trip.Trip, error) {
+	t, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+	return t, nil
+}
+
+// findInTrip は行動を取得し、指定された旅行に属していることを確認する。
+// 別の旅行の行動は存在しないものとして扱う
+func (i *ItineraryInteractor) findInTrip(ctx context.Context, tripID trip.TripID, id itinerary.ActivityID) (*itinerary.Activity, error) {
+	a, err := i.activityRepository.FindByID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get activity", apperr.WithCause(err))
+	}
+
+	if !a.TripID().Equals(tripID) {
+		return nil, itinerary.NewActivityNotFoundError()
+	}
+
+	return a, nil
+}
+
+// newRouteEndpoints は入力から 1 日の出発地と到着地を作成する。指定がない場合は決まっていないものとする
+func newRouteEndpoints(start, end *input.RouteEndpointInput) (itinerary.RouteEndpoints, error) {
+	coordinates := make([]*geo.Coordinate, 2)
+	for k, in := range []*input.RouteEndpointInput{start, end} {
+		if in == nil {
+			continue
+		}
+		c, err := geo.NewCoordinate(in.Latitude, in.Longitude)
+		if err != nil {
+			return itinerary.RouteEndpoints{}, err
+		}
+		coordinates[k] = &c
+	}
+	return itinerary.NewRouteEndpoints(coordinates[0], coordinates[1]), nil
+}
+
+// findActivity は activities から指定されたIDの行動を探す。見つからない場合は nil を返す
+func findActivity(activities []*itinerary.Activity, id itinerary.ActivityID) *itinerary.Activity {
+	for _, a := range activities {
+		if a.ID().Equals(id) {
+			return a
+		}
+	}
+	return nil
+}
+
+// newActivityPlace は入力から行動の場所を作成する。場所の指定がない場合は nil を返す
+func newActivityPlace(in *input.ActivityPlaceInput) (*geo.Place, error) {
+	if in == nil {
+		return nil, nil
+	}
+	return newPlace(in.Name, in.Latitude, in.Longitude, in.Timezone)
+}
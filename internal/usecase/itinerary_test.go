@@ -0,0 +1,761 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	mock_history "github.com/hata0/travel-api/internal/domain/history/mock"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	mock_itinerary "github.com/hata0/travel-api/internal/domain/itinerary/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var (
+	itineraryFixedTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	itineraryTripID    = trip.NewTripID("trip-id")
+)
+
+// newItineraryTestTrip は 2023/8/1〜8/3 の旅行を生成する
+func newItineraryTestTrip(t *testing.T) *trip.Trip {
+	t.Helper()
+
+	period, err := trip.NewPeriod(
+		time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 8, 3, 0, 0, 0, 0, time.UTC),
+	)
+	require.NoError(t, err)
+
+	return trip.NewTrip(itineraryTripID, "Trip", period, "", itineraryFixedTime, itineraryFixedTime)
+}
+
+// newItineraryTestActivity は 8/1 に徒歩で向かう行動を生成する。place が nil の場合は場所のない行動になる
+func newItineraryTestActivity(t *testing.T, id string, tripID trip.TripID, position int, place *geo.Place, startAt, endAt *time.Time) *itinerary.Activity {
+	t.Helper()
+
+	a, err := itinerary.NewActivity(
+		itinerary.NewActivityID(id),
+		tripID,
+		time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+		position,
+		"行動 "+id,
+		place,
+		nil,
+		startAt,
+		endAt,
+		itinerary.TravelModeWalk,
+		"",
+		itineraryFixedTime,
+		itineraryFixedTime,
+	)
+	require.NoError(t, err)
+	return a
+}
+
+// newItineraryTestPlace は座標付きの場所を生成する
+func newItineraryTestPlace(t *testing.T, name string, latitude, longitude float64) *geo.Place {
+	t.Helper()
+
+	c, err := geo.NewCoordinate(latitude, longitude)
+	require.NoError(t, err)
+	p, err := geo.NewPlace(name, &c, nil)
+	require.NoError(t, err)
+	return &p
+}
+
+func TestItineraryInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewItineraryInteractor(mockActivityRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, itinerary.DefaultSpeeds(), time.Second, mockTimeService, mockIDService)
+
+	a := newItineraryTestActivity(t, "activity-id", itineraryTripID, 0, nil, nil, nil)
+	otherTrips := newItineraryTestActivity(t, "activity-id", trip.NewTripID("other-trip-id"), 0, nil, nil, nil)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.GetActivityOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は旅行に属する行動を取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+			},
+			want: output.NewGetActivityOutput(a),
+		},
+		{
+			name: "異常系: 別の旅行の行動は NotFound になる",
+			setup: func() {
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(otherTrips, nil)
+			},
+			wantErr: itinerary.NewActivityNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get activity", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Get(ctx, itineraryTripID.String(), "activity-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestItineraryInteractor_Itinerary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewItineraryInteractor(mockActivityRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, itinerary.DefaultSpeeds(), time.Second, mockTimeService, mockIDService)
+
+	tr := newItineraryTestTrip(t)
+	day1 := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	startAt := day1
+	endAt := startAt.Add(time.Hour)
+	nextStartAt := endAt.Add(10 * time.Minute)
+	// 金閣寺から銀閣寺までは直線で約 6.4km あり、徒歩で 10 分では着かない
+	activities := []*itinerary.Activity{
+		newItineraryTestActivity(t, "a1", itineraryTripID, 0, newItineraryTestPlace(t, "金閣寺", 35.0394, 135.7292), &startAt, &endAt),
+		newItineraryTestActivity(t, "a2", itineraryTripID, 1, newItineraryTestPlace(t, "銀閣寺", 35.0270, 135.7982), &nextStartAt, nil),
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		date    *time.Time
+		setup   func()
+		want    *output.GetItineraryOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は行動の間の距離と移動時間、1 日の合計と警告を取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, nil).Return(activities, nil)
+			},
+			want: output.NewGetItineraryOutput(itinerary.PlanDays(activities, itinerary.DefaultSpeeds()), tr.Period(), itinerary.DefaultSpeeds()),
+		},
+		{
+			name: "正常系: 日付を指定するとその日の行程だけを取得する",
+			date: &day1,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, &day1).Return(activities, nil)
+			},
+			want: output.NewGetItineraryOutput(itinerary.PlanDays(activities, itinerary.DefaultSpeeds()), tr.Period(), itinerary.DefaultSpeeds()),
+		},
+		{
+			name: "異常系: 旅行の取得で予期しないエラーが返される",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get trip", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 行動の取得で予期しないエラーが返される",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, nil).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list activities", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Itinerary(ctx, itineraryTripID.String(), tt.date)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestItineraryInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewItineraryInteractor(mockActivityRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, itinerary.DefaultSpeeds(), time.Second, mockTimeService, mockIDService)
+
+	tr := newItineraryTestTrip(t)
+	day1 := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	day4 := time.Date(2023, 8, 4, 0, 0, 0, 0, time.UTC)
+	latitude, longitude := 35.0394, 135.7292
+	validInput := input.CreateActivityInput{
+		TripID:     itineraryTripID.String(),
+		Date:       day1,
+		Title:      "金閣寺",
+		Place:      &input.ActivityPlaceInput{Name: "金閣寺", Latitude: &latitude, Longitude: &longitude},
+		TravelMode: "transit",
+	}
+	invalidModeInput := validInput
+	invalidModeInput.TravelMode = "plane"
+	outsidePeriodInput := validInput
+	outsidePeriodInput.Date = day4
+
+	sameDay := []*itinerary.Activity{
+		newItineraryTestActivity(t, "a1", itineraryTripID, 0, nil, nil, nil),
+		newItineraryTestActivity(t, "a2", itineraryTripID, 1, nil, nil, nil),
+	}
+	// その日の最後に追加する
+	expected, err := itinerary.NewActivity(
+		itinerary.NewActivityID("generated-id"), itineraryTripID, day1, 2,
+		"金閣寺", newItineraryTestPlace(t, "金閣寺", latitude, longitude), nil, nil, nil, itinerary.TravelModeTransit, "", itineraryFixedTime, itineraryFixedTime,
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.CreateActivityInput
+		setup   func()
+		want    *output.CreateActivityOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: その日の最後に行動が追加される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, &day1).Return(sameDay, nil)
+				mockTimeService.EXPECT().Now().Return(itineraryFixedTime)
+				mockIDService.EXPECT().Generate().Return("generated-id")
+				mockActivityRepo.EXPECT().Create(gomock.Any(), expected).Return(nil)
+			},
+			want: output.NewCreateActivityOutput(itinerary.NewActivityID("generated-id")),
+		},
+		{
+			name:    "異常系: 不正な移動手段",
+			in:      invalidModeInput,
+			setup:   func() {},
+			wantErr: itinerary.NewInvalidTravelModeError(),
+		},
+		{
+			name: "異常系: 旅行期間外の日付",
+			in:   outsidePeriodInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, &day4).Return(nil, nil)
+				mockTimeService.EXPECT().Now().Return(itineraryFixedTime)
+				mockIDService.EXPECT().Generate().Return("generated-id")
+			},
+			wantErr: itinerary.NewOutsideTripPeriodError(),
+		},
+		{
+			name:    "異常系: 閲覧者は行動を追加できない",
+			ctx:     viewerCtx,
+			in:      validInput,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+		{
+			name: "異常系: 旅行の取得で予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get trip", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: その日の行動の取得で予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, &day1).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list activities", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, &day1).Return(sameDay, nil)
+				mockTimeService.EXPECT().Now().Return(itineraryFixedTime)
+				mockIDService.EXPECT().Generate().Return("generated-id")
+				mockActivityRepo.EXPECT().Create(gomock.Any(), expected).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to create activity", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Create(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assertRecordedChange(t, revisions, history.ResourceTypeActivity, history.ActionCreated)
+			}
+		})
+	}
+}
+
+func TestItineraryInteractor_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	interactor := NewItineraryInteractor(mockActivityRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, itinerary.DefaultSpeeds(), time.Second, mockTimeService, mockIDService)
+
+	tr := newItineraryTestTrip(t)
+	day1 := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC)
+	updateTime := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	validInput := input.UpdateActivityInput{
+		ID:         "activity-id",
+		TripID:     itineraryTripID.String(),
+		Date:       day1,
+		Title:      "銀閣寺",
+		TravelMode: "bicycle",
+	}
+	movedInput := validInput
+	movedInput.Date = day2
+	startAt := time.Date(2023, 8, 1, 3, 0, 0, 0, time.UTC)
+	endAt := startAt.Add(-time.Hour)
+	invalidRangeInput := validInput
+	invalidRangeInput.StartAt, invalidRangeInput.EndAt = &startAt, &endAt
+
+	original := newItineraryTestActivity(t, "activity-id", itineraryTripID, 3, nil, nil, nil)
+	// 同じ日のまま更新すると並び順は変わらない
+	updated, err := original.Update(day1, "銀閣寺", nil, nil, nil, nil, itinerary.TravelModeBicycle, "", updateTime)
+	require.NoError(t, err)
+	// 別の日に移すとその日の最後に並べる
+	moved, err := original.Update(day2, "銀閣寺", nil, nil, nil, nil, itinerary.TravelModeBicycle, "", updateTime)
+	require.NoError(t, err)
+	moved = moved.MoveTo(5, updateTime)
+	day2Activities := []*itinerary.Activity{newItineraryTestActivity(t, "other-id", itineraryTripID, 4, nil, nil, nil)}
+
+	tests := []struct {
+		name    string
+		in      input.UpdateActivityInput
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 同じ日のまま更新すると並び順は変わらない",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+				mockActivityRepo.EXPECT().Update(gomock.Any(), updated).Return(nil)
+			},
+		},
+		{
+			name: "正常系: 別の日に移すとその日の最後に並べる",
+			in:   movedInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, &day2).Return(day2Activities, nil)
+				mockActivityRepo.EXPECT().Update(gomock.Any(), moved).Return(nil)
+			},
+		},
+		{
+			name: "異常系: 終了日時が開始日時より前",
+			in:   invalidRangeInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+			},
+			wantErr: itinerary.NewInvalidTimeRangeError(),
+		},
+		{
+			name: "異常系: 行動が存在しない",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(nil, itinerary.NewActivityNotFoundError())
+			},
+			wantErr: itinerary.NewActivityNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+				mockActivityRepo.EXPECT().Update(gomock.Any(), updated).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to update activity", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			err := interactor.Update(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeActivity, history.ActionUpdated)
+			}
+		})
+	}
+}
+
+func TestItineraryInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	interactor := NewItineraryInteractor(mockActivityRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, itinerary.DefaultSpeeds(), time.Second, mockTimeService, mockIDService)
+
+	a := newItineraryTestActivity(t, "activity-id", itineraryTripID, 0, nil, nil, nil)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 行動が削除できる",
+			setup: func() {
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockActivityRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(nil)
+				mockTimeService.EXPECT().Now().Return(itineraryFixedTime)
+			},
+		},
+		{
+			name: "異常系: 行動が存在しない",
+			setup: func() {
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(nil, itinerary.NewActivityNotFoundError())
+			},
+			wantErr: itinerary.NewActivityNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), a.ID()).Return(a, nil)
+				mockActivityRepo.EXPECT().Delete(gomock.Any(), a.ID()).Return(errors.New("database delete error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to delete activity", apperr.WithCause(errors.New("database delete error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			err := interactor.Delete(newActorContext(), itineraryTripID.String(), "activity-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeActivity, history.ActionDeleted)
+			}
+		})
+	}
+}
+
+func TestItineraryInteractor_Optimize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	// 時刻が進まないため、探索は時間切れで打ち切られない
+	applyTime := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	mockTimeService.EXPECT().Now().Return(applyTime).AnyTimes()
+	interactor := NewItineraryInteractor(mockActivityRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, itinerary.DefaultSpeeds(), time.Second, mockTimeService, mockIDService)
+
+	tr := newItineraryTestTrip(t)
+	day1 := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	// 宿から北へ一直線に並んだ 3 か所を、遠い順に並べている
+	lodging := &input.RouteEndpointInput{Latitude: 35.0, Longitude: 135.0}
+	a3 := newItineraryTestActivity(t, "a3", itineraryTripID, 0, newItineraryTestPlace(t, "3", 35.03, 135.0), nil, nil)
+	a1 := newItineraryTestActivity(t, "a1", itineraryTripID, 1, newItineraryTestPlace(t, "1", 35.01, 135.0), nil, nil)
+	a2 := newItineraryTestActivity(t, "a2", itineraryTripID, 2, newItineraryTestPlace(t, "2", 35.02, 135.0), nil, nil)
+	activities := []*itinerary.Activity{a3, a1, a2}
+
+	lodgingCoordinate, err := geo.NewCoordinate(35.0, 135.0)
+	require.NoError(t, err)
+	endpoints := itinerary.NewRouteEndpoints(&lodgingCoordinate, &lodgingCoordinate)
+	never := func() bool { return false }
+	// 宿から近い順に巡る
+	suggested := itinerary.OptimizeDay(activities, endpoints, nil, itinerary.DefaultSpeeds(), never)
+	// a3 を先頭に固定すると、残りは北から順に戻るのが最短になる
+	pinned := itinerary.OptimizeDay(activities, endpoints, []itinerary.ActivityID{a3.ID()}, itinerary.DefaultSpeeds(), never)
+	movedA2 := a2.MoveTo(1, applyTime)
+	movedA1 := a1.MoveTo(2, applyTime)
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		in    input.OptimizeDayInput
+		setup func()
+		want  *output.OptimizeDayOutput
+		// wantResourceTypes は変更履歴に記録されるリソースの種類。nil の場合は変更履歴を記録しない
+		wantResourceTypes []history.ResourceType
+		wantErr           error
+	}{
+		{
+			name: "正常系: 閲覧者は宿から出て宿に戻る順の提案を取得でき、並び順は更新しない",
+			ctx:  viewerCtx,
+			in:   input.OptimizeDayInput{TripID: itineraryTripID.String(), Day: 1, Start: lodging, End: lodging},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, &day1).Return(activities, nil)
+			},
+			want: output.NewOptimizeDayOutput(
+				suggested,
+				itinerary.NewDayPlan(day1, []*itinerary.Activity{a1, a2, a3}, itinerary.DefaultSpeeds()),
+				tr.Period(),
+				false,
+			),
+		},
+		{
+			name: "正常系: apply を指定すると並び順を更新し、1 つの変更履歴にまとめる",
+			in: input.OptimizeDayInput{
+				TripID:            itineraryTripID.String(),
+				Day:               1,
+				Start:             lodging,
+				End:               lodging,
+				PinnedActivityIDs: []string{"a3"},
+				Apply:             true,
+			},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, &day1).Return(activities, nil)
+				mockActivityRepo.EXPECT().Update(gomock.Any(), movedA2).Return(nil)
+				mockActivityRepo.EXPECT().Update(gomock.Any(), movedA1).Return(nil)
+			},
+			want: output.NewOptimizeDayOutput(
+				pinned,
+				itinerary.NewDayPlan(day1, []*itinerary.Activity{a3, movedA2, movedA1}, itinerary.DefaultSpeeds()),
+				tr.Period(),
+				true,
+			),
+			wantResourceTypes: []history.ResourceType{history.ResourceTypeActivity, history.ResourceTypeActivity},
+		},
+		{
+			name:    "異常系: 閲覧者は apply できない",
+			ctx:     viewerCtx,
+			in:      input.OptimizeDayInput{TripID: itineraryTripID.String(), Day: 1, Apply: true},
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+		{
+			name: "異常系: 旅行期間外の日",
+			in:   input.OptimizeDayInput{TripID: itineraryTripID.String(), Day: 4},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+			},
+			wantErr: itinerary.NewInvalidDayError(),
+		},
+		{
+			name: "異常系: 固定する行動がその日にない",
+			in:   input.OptimizeDayInput{TripID: itineraryTripID.String(), Day: 1, PinnedActivityIDs: []string{"other-id"}},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, &day1).Return(activities, nil)
+			},
+			wantErr: itinerary.NewPinnedActivityOutsideDayError(),
+		},
+		{
+			name: "異常系: 行動の取得で予期しないエラーが返される",
+			in:   input.OptimizeDayInput{TripID: itineraryTripID.String(), Day: 1},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, &day1).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list activities", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 並び順の更新で予期しないエラーが返される",
+			in:   input.OptimizeDayInput{TripID: itineraryTripID.String(), Day: 1, Start: lodging, End: lodging, Apply: true},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), itineraryTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), itineraryTripID, &day1).Return(activities, nil)
+				mockActivityRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to reorder activities", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Optimize(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			if tt.wantResourceTypes == nil {
+				assert.Empty(t, *revisions)
+				return
+			}
+			require.Len(t, *revisions, 1, "並べ替えは 1 つのリビジョンにまとめるべき")
+			var gotResourceTypes []history.ResourceType
+			for _, change := range (*revisions)[0].Changes() {
+				gotResourceTypes = append(gotResourceTypes, change.ResourceType())
+			}
+			assert.Equal(t, tt.wantResourceTypes, gotResourceTypes)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/journal.go github.com/hata0/travel-api/internal/usecase JournalUsecase
+type JournalUsecase interface {
+	Get(ctx context.Context, tripID, id string) (*output.GetJournalEntryOutput, error)
+	List(ctx context.Context, tripID string, date *time.Time) (*output.ListJournalEntryOutput, error)
+	Create(ctx context.Context, in input.CreateJournalEntryInput) (*output.CreateJournalEntryOutput, error)
+	Update(ctx context.Context, in input.UpdateJournalEntryInput) error
+	Delete(ctx context.Context, tripID, id string) error
+}
+
+type JournalInteractor struct {
+	journalRepository  journal.EntryRepository
+	tripRepository     trip.TripRepository
+	authorizer         tripAuthorizer
+	history            historyRecorder
+	transactionManager transaction_manager.TransactionManager
+	markdownRenderer   service.MarkdownRenderer
+	timeService        service.TimeService
+	idService          service.IDService
+}
+
+func NewJournalInteractor(
+	journalRepository journal.EntryRepository,
+	tripRepository trip.TripRepository,
+	memberRepository membership.MemberRepository,
+	historyRepository history.HistoryRepository,
+	transactionManager transaction_manager.TransactionManager,
+	markdownRenderer service.MarkdownRenderer,
+	timeService service.TimeService,
+	idService service.IDService,
+) JournalUsecase {
+	return &JournalInteractor{
+		journalRepository:  journalRepository,
+		tripRepository:     tripRepository,
+		authorizer:         newTripAuthorizer(memberRepository),
+		history:            newHistoryRecorder(historyRepository),
+		transactionManager: transactionManager,
+		markdownRenderer:   markdownRenderer,
+		timeService:        timeService,
+		idService:          idService,
+	}
+}
+
+// Get は旅行に紐づく指定されたIDの日記を、本文を HTML に変換して取得する
+func (i *JournalInteractor) Get(ctx context.Context, tripID, id string) (*output.GetJournalEntryOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	e, err := i.findInTrip(ctx, trip.NewTripID(tripID), journal.NewEntryID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetJournalEntryOutput(e, i.markdownRenderer.Render), nil
+}
+
+// List は旅行に紐づく日記を日付順に取得する。date が nil でない場合はその日の日記だけを取得する
+func (i *JournalInteractor) List(ctx context.Context, tripID string, date *time.Time) (*output.ListJournalEntryOutput, error) {
+	id := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorize(ctx, id, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	entries, err := i.journalRepository.FindByTripID(ctx, id, date)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list journal entries", apperr.WithCause(err))
+	}
+
+	return output.NewListJournalEntryOutput(entries, i.markdownRenderer.Render), nil
+}
+
+// Create は旅行に新しい日記を追加する。日付は旅行期間内でなければならない
+func (i *JournalInteractor) Create(ctx context.Context, in input.CreateJournalEntryInput) (*output.CreateJournalEntryOutput, error) {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	mood, err := journal.ParseMood(in.Mood)
+	if err != nil {
+		return nil, err
+	}
+
+	place, err := newJournalPlace(in.Place)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(in.TripID))
+	if err != nil {
+		return nil, err
+	}
+
+	now := i.timeService.Now()
+	e := journal.NewEntry(journal.NewEntryID(i.idService.Generate()), t.ID(), in.Date, in.Title, in.Body, mood, place, now, now)
+
+	if err := e.ValidateFor(t); err != nil {
+		return nil, err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.journalRepository.Create(txCtx, e); err != nil {
+			return err
+		}
+		return i.history.recordCreated(txCtx, t.ID(), m.UserID(), now, e)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to create journal entry", apperr.WithCause(err))
+	}
+
+	return output.NewCreateJournalEntryOutput(e.ID()), nil
+}
+
+// Update は既存の日記を更新する
+func (i *JournalInteractor) Update(ctx context.Context, in input.UpdateJournalEntryInput) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	mood, err := journal.ParseMood(in.Mood)
+	if err != nil {
+		return err
+	}
+
+	place, err := newJournalPlace(in.Place)
+	if err != nil {
+		return err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(in.TripID))
+	if err != nil {
+		return err
+	}
+
+	e, err := i.findInTrip(ctx, t.ID(), journal.NewEntryID(in.ID))
+	if err != nil {
+		return err
+	}
+
+	now := i.timeService.Now()
+	updated := e.Update(in.Date, in.Title, in.Body, mood, place, now)
+
+	if err := updated.ValidateFor(t); err != nil {
+		return err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.journalRepository.Update(txCtx, updated); err != nil {
+			return err
+		}
+		return i.history.recordUpdated(txCtx, t.ID(), m.UserID(), now, e, updated)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to update journal entry", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は旅行に紐づく指定されたIDの日記を削除する
+func (i *JournalInteractor) Delete(ctx context.Context, tripID, id string) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	e, err := i.findInTrip(ctx, trip.NewTripID(tripID), journal.NewEntryID(id))
+	if err != nil {
+		return err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.journalRepository.Delete(txCtx, e.ID()); err != nil {
+			return err
+		}
+		return i.history.recordDeleted(txCtx, e.TripID(), m.UserID(), i.timeService.Now(), e)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to delete journal entry", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// findTrip は日記の親となる旅行を取得する
+func (i *JournalInteractor) findTrip(ctx context.Context, tripID trip.TripID) (*trip.Trip, error) {
+	t, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+	return t, nil
+}
+
+// findInTrip は日記を取得し、指定された旅行に属していることを確認する。
+// 別の旅行の日記は存在しないものとして扱う
+func (i *JournalInteractor) findInTrip(ctx context.Context, tripID trip.TripID, id journal.EntryID) (*journal.Entry, error) {
+	e, err := i.journalRepository.FindByID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get journal entry", apperr.WithCause(err))
+	}
+
+	if !e.TripID().Equals(tripID) {
+		return nil, journal.NewJournalEntryNotFoundError()
+	}
+
+	return e, nil
+}
+
+// newJournalPlace は入力から日記の場所を作成する。場所の指定がない場合は nil を返す
+func newJournalPlace(in *input.JournalPlaceInput) (*geo.Place, error) {
+	if in == nil {
+		return nil, nil
+	}
+	return newPlace(in.Name, in.Latitude, in.Longitude, in.Timezone)
+}
+
+// newPlace は名前と緯度・経度・タイムゾーン名から場所を作成する。緯度と経度は両方指定するか両方省略しなければならない
+func newPlace(name string, latitude, longitude *float64, timezoneName string) (*geo.Place, error) {
+	var coordinate *geo.Coordinate
+	switch {
+	case latitude != nil && longitude != nil:
+		c, err := geo.NewCoordinate(*latitude, *longitude)
+		if err != nil {
+			return nil, err
+		}
+		coordinate = &c
+	case latitude != nil || longitude != nil:
+		return nil, geo.NewIncompleteCoordinateError()
+	}
+
+	timezone, err := newOptionalTimezone(timezoneName)
+	if err != nil {
+		return nil, err
+	}
+
+	place, err := geo.NewPlace(name, coordinate, timezone)
+	if err != nil {
+		return nil, err
+	}
+	return &place, nil
+}
+
+// newOptionalTimezone は IANA のタイムゾーン名からタイムゾーンを作成する。名前が空の場合は nil を返す
+func newOptionalTimezone(name string) (*geo.Timezone, error) {
+	if name == "" {
+		return nil, nil
+	}
+	timezone, err := geo.NewTimezone(name)
+	if err != nil {
+		return nil, err
+	}
+	return &timezone, nil
+}
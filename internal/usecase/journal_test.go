@@ -0,0 +1,521 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	mock_history "github.com/hata0/travel-api/internal/domain/history/mock"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	mock_journal "github.com/hata0/travel-api/internal/domain/journal/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var (
+	journalFixedTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	journalTripID    = trip.NewTripID("trip-id")
+)
+
+// renderJournalForTest は Markdown の変換結果の代わりに本文を <p> で囲んだ文字列を返す
+func renderJournalForTest(markdown string) string {
+	return "<p>" + markdown + "</p>"
+}
+
+// newJournalTestEntry は 8/1 の場所のない日記を生成する
+func newJournalTestEntry(id string, tripID trip.TripID) *journal.Entry {
+	return journal.NewEntry(
+		journal.NewEntryID(id),
+		tripID,
+		time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+		"1日目",
+		"**晴れ**",
+		journal.MoodGood,
+		nil,
+		journalFixedTime,
+		journalFixedTime,
+	)
+}
+
+func TestJournalInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockRenderer := mock_service.NewMockMarkdownRenderer(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	mockRenderer.EXPECT().Render(gomock.Any()).DoAndReturn(renderJournalForTest).AnyTimes()
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewJournalInteractor(mockJournalRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockRenderer, mockTimeService, mockIDService)
+
+	e := newJournalTestEntry("entry-id", journalTripID)
+	otherTrips := newJournalTestEntry("entry-id", trip.NewTripID("other-trip-id"))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.GetJournalEntryOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は本文を HTML に変換した日記を取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockJournalRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(e, nil)
+			},
+			want: output.NewGetJournalEntryOutput(e, renderJournalForTest),
+		},
+		{
+			name: "異常系: 別の旅行の日記は NotFound になる",
+			setup: func() {
+				mockJournalRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(otherTrips, nil)
+			},
+			wantErr: journal.NewJournalEntryNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockJournalRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get journal entry", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Get(ctx, journalTripID.String(), "entry-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, "**晴れ**", got.JournalEntry.Body)
+				assert.Equal(t, "<p>**晴れ**</p>", got.JournalEntry.BodyHTML)
+			}
+		})
+	}
+}
+
+func TestJournalInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockRenderer := mock_service.NewMockMarkdownRenderer(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	mockRenderer.EXPECT().Render(gomock.Any()).DoAndReturn(renderJournalForTest).AnyTimes()
+	interactor := NewJournalInteractor(mockJournalRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockRenderer, mockTimeService, mockIDService)
+
+	date := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	entries := []*journal.Entry{newJournalTestEntry("entry-id", journalTripID)}
+
+	tests := []struct {
+		name    string
+		date    *time.Time
+		setup   func()
+		want    *output.ListJournalEntryOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 指定した日付の日記が返される",
+			date: &date,
+			setup: func() {
+				mockJournalRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, &date).Return(entries, nil)
+			},
+			want: output.NewListJournalEntryOutput(entries, renderJournalForTest),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockJournalRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list journal entries", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.List(newActorContext(), journalTripID.String(), tt.date)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestJournalInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockRenderer := mock_service.NewMockMarkdownRenderer(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewJournalInteractor(mockJournalRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockRenderer, mockTimeService, mockIDService)
+
+	latitude, longitude := 35.0394, 135.7292
+	validInput := input.CreateJournalEntryInput{
+		TripID: journalTripID.String(),
+		Date:   time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC),
+		Title:  "2日目",
+		Body:   "金閣寺へ",
+		Mood:   "great",
+		Place:  &input.JournalPlaceInput{Name: "金閣寺", Latitude: &latitude, Longitude: &longitude},
+	}
+	invalidMoodInput := validInput
+	invalidMoodInput.Mood = "happy"
+	incompleteCoordinateInput := validInput
+	incompleteCoordinateInput.Place = &input.JournalPlaceInput{Name: "金閣寺", Latitude: &latitude}
+	outsidePeriodInput := validInput
+	outsidePeriodInput.Date = time.Date(2023, 8, 4, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.CreateJournalEntryInput
+		setup   func()
+		want    *output.CreateJournalEntryOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 場所付きの日記が作成できる",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(newPeriodTestTrip(t, journalTripID), nil)
+				mockIDService.EXPECT().Generate().Return("generated-id")
+				mockTimeService.EXPECT().Now().Return(journalFixedTime)
+				mockJournalRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e *journal.Entry) error {
+						assert.Equal(t, "generated-id", e.ID().String())
+						assert.Equal(t, journalTripID, e.TripID())
+						assert.Equal(t, "2日目", e.Title())
+						assert.Equal(t, journal.MoodGreat, e.Mood())
+						require.NotNil(t, e.Place())
+						assert.Equal(t, "金閣寺", e.Place().Name())
+						assert.Equal(t, latitude, e.Place().Coordinate().Latitude())
+						return nil
+					})
+			},
+			want: output.NewCreateJournalEntryOutput(journal.NewEntryID("generated-id")),
+		},
+		{
+			name:    "異常系: 不正な気分",
+			in:      invalidMoodInput,
+			setup:   func() {},
+			wantErr: journal.NewInvalidMoodError(),
+		},
+		{
+			name:    "異常系: 緯度だけが指定されている",
+			in:      incompleteCoordinateInput,
+			setup:   func() {},
+			wantErr: geo.NewIncompleteCoordinateError(),
+		},
+		{
+			name: "異常系: 旅行期間外の日付",
+			in:   outsidePeriodInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(newPeriodTestTrip(t, journalTripID), nil)
+				mockIDService.EXPECT().Generate().Return("generated-id")
+				mockTimeService.EXPECT().Now().Return(journalFixedTime)
+			},
+			wantErr: journal.NewOutsideTripPeriodError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(newPeriodTestTrip(t, journalTripID), nil)
+				mockIDService.EXPECT().Generate().Return("generated-id")
+				mockTimeService.EXPECT().Now().Return(journalFixedTime)
+				mockJournalRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to create journal entry", apperr.WithCause(errors.New("database write error"))),
+		},
+		{
+			name:    "異常系: 閲覧者は日記を追加できない",
+			ctx:     viewerCtx,
+			in:      validInput,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Create(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assertRecordedChange(t, revisions, history.ResourceTypeJournalEntry, history.ActionCreated)
+			}
+		})
+	}
+}
+
+func TestJournalInteractor_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockRenderer := mock_service.NewMockMarkdownRenderer(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	interactor := NewJournalInteractor(mockJournalRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockRenderer, mockTimeService, mockIDService)
+
+	original := newJournalTestEntry("entry-id", journalTripID)
+	updateTime := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	validInput := input.UpdateJournalEntryInput{
+		ID:     "entry-id",
+		TripID: journalTripID.String(),
+		Date:   time.Date(2023, 8, 3, 0, 0, 0, 0, time.UTC),
+		Title:  "最終日",
+		Body:   "雨",
+		Mood:   "bad",
+	}
+	outsidePeriodInput := validInput
+	outsidePeriodInput.Date = time.Date(2023, 8, 4, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		in      input.UpdateJournalEntryInput
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 日記が更新できる",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(newPeriodTestTrip(t, journalTripID), nil)
+				mockJournalRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+				mockJournalRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e *journal.Entry) error {
+						assert.Equal(t, "最終日", e.Title())
+						assert.Equal(t, journal.MoodBad, e.Mood())
+						assert.Equal(t, updateTime, e.UpdatedAt())
+						return nil
+					})
+			},
+		},
+		{
+			name: "異常系: 日記が存在しない",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(newPeriodTestTrip(t, journalTripID), nil)
+				mockJournalRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(nil, journal.NewJournalEntryNotFoundError())
+			},
+			wantErr: journal.NewJournalEntryNotFoundError(),
+		},
+		{
+			name: "異常系: 旅行期間外の日付",
+			in:   outsidePeriodInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(newPeriodTestTrip(t, journalTripID), nil)
+				mockJournalRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+			},
+			wantErr: journal.NewOutsideTripPeriodError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(newPeriodTestTrip(t, journalTripID), nil)
+				mockJournalRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+				mockJournalRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to update journal entry", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			err := interactor.Update(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeJournalEntry, history.ActionUpdated)
+				assert.Contains(t, (*revisions)[0].Changes()[0].ChangedFields(), "title")
+			}
+		})
+	}
+}
+
+func TestJournalInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockRenderer := mock_service.NewMockMarkdownRenderer(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewJournalInteractor(mockJournalRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockRenderer, mockTimeService, mockIDService)
+
+	e := newJournalTestEntry("entry-id", journalTripID)
+	otherTrips := newJournalTestEntry("entry-id", trip.NewTripID("other-trip-id"))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 日記が削除できる",
+			setup: func() {
+				mockJournalRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(e, nil)
+				mockJournalRepo.EXPECT().Delete(gomock.Any(), e.ID()).Return(nil)
+				mockTimeService.EXPECT().Now().Return(journalFixedTime)
+			},
+		},
+		{
+			name: "異常系: 別の旅行の日記は削除できない",
+			setup: func() {
+				mockJournalRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(otherTrips, nil)
+			},
+			wantErr: journal.NewJournalEntryNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockJournalRepo.EXPECT().FindByID(gomock.Any(), e.ID()).Return(e, nil)
+				mockJournalRepo.EXPECT().Delete(gomock.Any(), e.ID()).Return(errors.New("database delete error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to delete journal entry", apperr.WithCause(errors.New("database delete error"))),
+		},
+		{
+			name:    "異常系: 閲覧者は日記を削除できない",
+			ctx:     viewerCtx,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			err := interactor.Delete(ctx, journalTripID.String(), "entry-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeJournalEntry, history.ActionDeleted)
+			}
+		})
+	}
+}
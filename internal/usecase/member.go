@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/member.go github.com/hata0/travel-api/internal/usecase MemberUsecase
+type MemberUsecase interface {
+	List(ctx context.Context, tripID string) (*output.ListMemberOutput, error)
+	ChangeRole(ctx context.Context, in input.ChangeMemberRoleInput) error
+	Remove(ctx context.Context, tripID, userID string) error
+	TransferOwnership(ctx context.Context, in input.TransferOwnershipInput) error
+}
+
+type MemberInteractor struct {
+	memberRepository   membership.MemberRepository
+	authorizer         tripAuthorizer
+	transactionManager transaction_manager.TransactionManager
+	timeService        service.TimeService
+}
+
+func NewMemberInteractor(
+	memberRepository membership.MemberRepository,
+	transactionManager transaction_manager.TransactionManager,
+	timeService service.TimeService,
+) MemberUsecase {
+	return &MemberInteractor{
+		memberRepository:   memberRepository,
+		authorizer:         newTripAuthorizer(memberRepository),
+		transactionManager: transactionManager,
+		timeService:        timeService,
+	}
+}
+
+// List は旅行のメンバーを所有者、編集者、閲覧者の順に取得する
+func (i *MemberInteractor) List(ctx context.Context, tripID string) (*output.ListMemberOutput, error) {
+	id := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorize(ctx, id, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	members, err := i.memberRepository.FindByTripID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list trip members", apperr.WithCause(err))
+	}
+
+	return output.NewListMemberOutput(members), nil
+}
+
+// ChangeRole は所有者以外のメンバーのロールを編集者か閲覧者に変更する。所有者だけが変更できる
+func (i *MemberInteractor) ChangeRole(ctx context.Context, in input.ChangeMemberRoleInput) error {
+	tripID := trip.NewTripID(in.TripID)
+
+	if _, err := i.authorizer.authorize(ctx, tripID, membership.RoleOwner); err != nil {
+		return err
+	}
+
+	role, err := membership.ParseRole(in.Role)
+	if err != nil {
+		return err
+	}
+	if role == membership.RoleOwner {
+		return membership.NewOwnerRoleChangeError()
+	}
+
+	m, err := i.findMember(ctx, tripID, user.NewUserID(in.UserID))
+	if err != nil {
+		return err
+	}
+	if m.IsOwner() {
+		return membership.NewOwnerRoleChangeError()
+	}
+
+	if err := i.memberRepository.Save(ctx, m.ChangeRole(role, i.timeService.Now())); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to change member role", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Remove はメンバーを旅行から外す。所有者は他のメンバーを外すことができ、所有者以外のメンバーは自分自身だけを外す（旅行から抜ける）ことができる。
+// 所有者自身は所有権を移譲するまで旅行から外れることはできない
+func (i *MemberInteractor) Remove(ctx context.Context, tripID, userID string) error {
+	id := trip.NewTripID(tripID)
+	targetID := user.NewUserID(userID)
+
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	required := membership.RoleOwner
+	if actorID.Equals(targetID) {
+		required = membership.RoleViewer
+	}
+	if _, err := i.authorizer.authorize(ctx, id, required); err != nil {
+		return err
+	}
+
+	m, err := i.findMember(ctx, id, targetID)
+	if err != nil {
+		return err
+	}
+	if m.IsOwner() {
+		return membership.NewOwnerRemovalError()
+	}
+
+	if err := i.memberRepository.Delete(ctx, id, targetID); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to remove trip member", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// TransferOwnership は旅行の所有権を他のメンバーに移譲する。移譲元の所有者は編集者になる
+func (i *MemberInteractor) TransferOwnership(ctx context.Context, in input.TransferOwnershipInput) error {
+	tripID := trip.NewTripID(in.TripID)
+
+	owner, err := i.authorizer.authorize(ctx, tripID, membership.RoleOwner)
+	if err != nil {
+		return err
+	}
+
+	newOwnerID := user.NewUserID(in.NewOwnerID)
+	if owner.UserID().Equals(newOwnerID) {
+		return nil
+	}
+
+	newOwner, err := i.findMember(ctx, tripID, newOwnerID)
+	if err != nil {
+		return err
+	}
+
+	now := i.timeService.Now()
+
+	// 所有者は旅行ごとに1人だけなので、先に移譲元を編集者に変更する
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.memberRepository.Save(txCtx, owner.ChangeRole(membership.RoleEditor, now)); err != nil {
+			return err
+		}
+		return i.memberRepository.Save(txCtx, newOwner.ChangeRole(membership.RoleOwner, now))
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to transfer trip ownership", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// findMember は操作対象のメンバーを取得する
+func (i *MemberInteractor) findMember(ctx context.Context, tripID trip.TripID, userID user.UserID) (*membership.Member, error) {
+	m, err := i.memberRepository.FindByTripAndUser(ctx, tripID, userID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip member", apperr.WithCause(err))
+	}
+	return m, nil
+}
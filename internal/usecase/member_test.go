@@ -0,0 +1,375 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var (
+	memberTripID    = trip.NewTripID("trip-id")
+	memberFixedTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	memberTargetID  = user.NewUserID("target-user-id")
+)
+
+func newMemberTestMember(userID user.UserID, role membership.Role) *membership.Member {
+	return membership.NewMember(memberTripID, userID, role, memberFixedTime, memberFixedTime)
+}
+
+func TestMemberInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+
+	interactor := NewMemberInteractor(mockMemberRepo, mockTxManager, mockTimeService)
+
+	members := []*membership.Member{
+		newMemberTestMember(testActorID, membership.RoleOwner),
+		newMemberTestMember(memberTargetID, membership.RoleViewer),
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *output.ListMemberOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 旅行のメンバー一覧が取得できる",
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripID(gomock.Any(), memberTripID).Return(members, nil)
+			},
+			want: output.NewListMemberOutput(members),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripID(gomock.Any(), memberTripID).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list trip members", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.List(newActorContext(), "trip-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMemberInteractor_ChangeRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	interactor := NewMemberInteractor(mockMemberRepo, mockTxManager, mockTimeService)
+
+	validInput := input.ChangeMemberRoleInput{TripID: "trip-id", UserID: "target-user-id", Role: "editor"}
+	ownerRoleInput := validInput
+	ownerRoleInput.Role = "owner"
+	invalidRoleInput := validInput
+	invalidRoleInput.Role = "admin"
+	selfInput := validInput
+	selfInput.UserID = testActorID.String()
+	later := memberFixedTime.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.ChangeMemberRoleInput
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: メンバーのロールを変更できる",
+			in:   validInput,
+			setup: func() {
+				target := newMemberTestMember(memberTargetID, membership.RoleViewer)
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), memberTripID, memberTargetID).Return(target, nil)
+				mockTimeService.EXPECT().Now().Return(later)
+				mockMemberRepo.EXPECT().Save(gomock.Any(), target.ChangeRole(membership.RoleEditor, later)).Return(nil)
+			},
+		},
+		{
+			name:    "異常系: 所有者のロールには変更できない",
+			in:      ownerRoleInput,
+			setup:   func() {},
+			wantErr: membership.NewOwnerRoleChangeError(),
+		},
+		{
+			name:    "異常系: 不正なロール",
+			in:      invalidRoleInput,
+			setup:   func() {},
+			wantErr: membership.NewInvalidRoleError(),
+		},
+		{
+			name:    "異常系: 所有者自身のロールは変更できない",
+			in:      selfInput,
+			setup:   func() {},
+			wantErr: membership.NewOwnerRoleChangeError(),
+		},
+		{
+			name: "異常系: 対象のメンバーが存在しない",
+			in:   validInput,
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), memberTripID, memberTargetID).Return(nil, membership.NewMemberNotFoundError())
+			},
+			wantErr: membership.NewMemberNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), memberTripID, memberTargetID).Return(newMemberTestMember(memberTargetID, membership.RoleViewer), nil)
+				mockTimeService.EXPECT().Now().Return(later)
+				mockMemberRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to change member role", apperr.WithCause(errors.New("database write error"))),
+		},
+		{
+			name:    "異常系: 所有者でなければ変更できない",
+			ctx:     viewerCtx,
+			in:      validInput,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			err := interactor.ChangeRole(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMemberInteractor_Remove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	interactor := NewMemberInteractor(mockMemberRepo, mockTxManager, mockTimeService)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		userID  string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name:   "正常系: 所有者は他のメンバーを外せる",
+			userID: "target-user-id",
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), memberTripID, memberTargetID).Return(newMemberTestMember(memberTargetID, membership.RoleEditor), nil)
+				mockMemberRepo.EXPECT().Delete(gomock.Any(), memberTripID, memberTargetID).Return(nil)
+			},
+		},
+		{
+			name:   "正常系: 所有者以外のメンバーは自分自身を外せる",
+			ctx:    viewerCtx,
+			userID: testViewerID.String(),
+			setup: func() {
+				mockMemberRepo.EXPECT().Delete(gomock.Any(), memberTripID, testViewerID).Return(nil)
+			},
+		},
+		{
+			name:    "異常系: 所有者以外のメンバーは他のメンバーを外せない",
+			ctx:     viewerCtx,
+			userID:  "target-user-id",
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+		{
+			name:    "異常系: 所有者自身は外れることができない",
+			userID:  testActorID.String(),
+			setup:   func() {},
+			wantErr: membership.NewOwnerRemovalError(),
+		},
+		{
+			name:   "異常系: リポジトリから予期しないエラーが返される",
+			userID: "target-user-id",
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), memberTripID, memberTargetID).Return(newMemberTestMember(memberTargetID, membership.RoleEditor), nil)
+				mockMemberRepo.EXPECT().Delete(gomock.Any(), memberTripID, memberTargetID).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to remove trip member", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			err := interactor.Remove(ctx, "trip-id", tt.userID)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMemberInteractor_TransferOwnership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	interactor := NewMemberInteractor(mockMemberRepo, mockTxManager, mockTimeService)
+
+	validInput := input.TransferOwnershipInput{TripID: "trip-id", NewOwnerID: "target-user-id"}
+	now := memberFixedTime.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.TransferOwnershipInput
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 所有権を移譲し、移譲元は編集者になる",
+			in:   validInput,
+			setup: func() {
+				target := newMemberTestMember(memberTargetID, membership.RoleViewer)
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), memberTripID, memberTargetID).Return(target, nil)
+				mockTimeService.EXPECT().Now().Return(now)
+				gomock.InOrder(
+					mockMemberRepo.EXPECT().Save(gomock.Any(), membership.NewMember(memberTripID, testActorID, membership.RoleEditor, time.Time{}, now)).Return(nil),
+					mockMemberRepo.EXPECT().Save(gomock.Any(), target.ChangeRole(membership.RoleOwner, now)).Return(nil),
+				)
+			},
+		},
+		{
+			name:  "正常系: 自分自身への移譲は何もしない",
+			in:    input.TransferOwnershipInput{TripID: "trip-id", NewOwnerID: testActorID.String()},
+			setup: func() {},
+		},
+		{
+			name: "異常系: 移譲先がメンバーでない",
+			in:   validInput,
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), memberTripID, memberTargetID).Return(nil, membership.NewMemberNotFoundError())
+			},
+			wantErr: membership.NewMemberNotFoundError(),
+		},
+		{
+			name:    "異常系: 所有者でなければ移譲できない",
+			ctx:     viewerCtx,
+			in:      validInput,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+		{
+			name: "異常系: 保存時に予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), memberTripID, memberTargetID).Return(newMemberTestMember(memberTargetID, membership.RoleEditor), nil)
+				mockTimeService.EXPECT().Now().Return(memberFixedTime)
+				mockMemberRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to transfer trip ownership", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			err := interactor.TransferOwnership(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
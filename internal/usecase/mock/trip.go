@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	input "github.com/hata0/travel-api/internal/usecase/input"
 	output "github.com/hata0/travel-api/internal/usecase/output"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,39 +43,54 @@ func (m *MockTripUsecase) EXPECT() *MockTripUsecaseMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockTripUsecase) Create(ctx context.Context, name string) (string, error) {
+func (m *MockTripUsecase) Create(ctx context.Context, in input.CreateTripInput) (*output.CreateTripOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, name)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Create", ctx, in)
+	ret0, _ := ret[0].(*output.CreateTripOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockTripUsecaseMockRecorder) Create(ctx, name any) *gomock.Call {
+func (mr *MockTripUsecaseMockRecorder) Create(ctx, in any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTripUsecase)(nil).Create), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTripUsecase)(nil).Create), ctx, in)
 }
 
 // Delete mocks base method.
-func (m *MockTripUsecase) Delete(ctx context.Context, id string) error {
+func (m *MockTripUsecase) Delete(ctx context.Context, id string, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockTripUsecaseMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockTripUsecaseMockRecorder) Delete(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTripUsecase)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTripUsecase)(nil).Delete), ctx, id, version)
+}
+
+// Duplicate mocks base method.
+func (m *MockTripUsecase) Duplicate(ctx context.Context, in input.DuplicateTripInput) (*output.CreateTripOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Duplicate", ctx, in)
+	ret0, _ := ret[0].(*output.CreateTripOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Duplicate indicates an expected call of Duplicate.
+func (mr *MockTripUsecaseMockRecorder) Duplicate(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Duplicate", reflect.TypeOf((*MockTripUsecase)(nil).Duplicate), ctx, in)
 }
 
 // Get mocks base method.
-func (m *MockTripUsecase) Get(ctx context.Context, id string) (output.GetTripOutput, error) {
+func (m *MockTripUsecase) Get(ctx context.Context, id string) (*output.GetTripOutput, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Get", ctx, id)
-	ret0, _ := ret[0].(output.GetTripOutput)
+	ret0, _ := ret[0].(*output.GetTripOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -86,30 +102,30 @@ func (mr *MockTripUsecaseMockRecorder) Get(ctx, id any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockTripUsecase) List(ctx context.Context) (output.ListTripOutput, error) {
+func (m *MockTripUsecase) List(ctx context.Context, in input.ListTripInput) (*output.ListTripOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx)
-	ret0, _ := ret[0].(output.ListTripOutput)
+	ret := m.ctrl.Call(m, "List", ctx, in)
+	ret0, _ := ret[0].(*output.ListTripOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockTripUsecaseMockRecorder) List(ctx any) *gomock.Call {
+func (mr *MockTripUsecaseMockRecorder) List(ctx, in any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTripUsecase)(nil).List), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTripUsecase)(nil).List), ctx, in)
 }
 
 // Update mocks base method.
-func (m *MockTripUsecase) Update(ctx context.Context, id, name string) error {
+func (m *MockTripUsecase) Update(ctx context.Context, in input.UpdateTripInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, id, name)
+	ret := m.ctrl.Call(m, "Update", ctx, in)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockTripUsecaseMockRecorder) Update(ctx, id, name any) *gomock.Call {
+func (mr *MockTripUsecaseMockRecorder) Update(ctx, in any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTripUsecase)(nil).Update), ctx, id, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTripUsecase)(nil).Update), ctx, in)
 }
@@ -0,0 +1,80 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+)
+
+// Accommodation の Timezone は宿泊先のタイムゾーンで、決まっていない場合は nil
+type Accommodation struct {
+	ID                 string
+	TripID             string
+	Name               string
+	Address            string
+	Timezone           *string
+	CheckInAt          time.Time
+	CheckOutAt         time.Time
+	ConfirmationNumber string
+	CostAmount         int64
+	CostCurrency       string
+	Notes              string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+type GetAccommodationOutput struct {
+	Accommodation *Accommodation
+}
+
+func NewGetAccommodationOutput(a *accommodation.Accommodation) *GetAccommodationOutput {
+	return &GetAccommodationOutput{
+		Accommodation: mapToAccommodation(a),
+	}
+}
+
+type ListAccommodationOutput struct {
+	Accommodations []*Accommodation
+	// UncoveredNights は宿泊予約が存在しない夜の一覧。旅行期間が未定の場合は nil
+	UncoveredNights []time.Time
+}
+
+func NewListAccommodationOutput(accommodations []*accommodation.Accommodation, uncoveredNights []time.Time) *ListAccommodationOutput {
+	formatted := make([]*Accommodation, 0, len(accommodations))
+	for _, a := range accommodations {
+		formatted = append(formatted, mapToAccommodation(a))
+	}
+
+	return &ListAccommodationOutput{
+		Accommodations:  formatted,
+		UncoveredNights: uncoveredNights,
+	}
+}
+
+type CreateAccommodationOutput struct {
+	ID string
+}
+
+func NewCreateAccommodationOutput(id accommodation.AccommodationID) *CreateAccommodationOutput {
+	return &CreateAccommodationOutput{
+		ID: id.String(),
+	}
+}
+
+func mapToAccommodation(a *accommodation.Accommodation) *Accommodation {
+	return &Accommodation{
+		ID:                 a.ID().String(),
+		TripID:             a.TripID().String(),
+		Name:               a.Name(),
+		Address:            a.Address(),
+		Timezone:           timezoneName(a.Timezone()),
+		CheckInAt:          a.Stay().CheckInAt(),
+		CheckOutAt:         a.Stay().CheckOutAt(),
+		ConfirmationNumber: a.ConfirmationNumber(),
+		CostAmount:         a.Cost().Amount(),
+		CostCurrency:       a.Cost().Currency(),
+		Notes:              a.Notes(),
+		CreatedAt:          a.CreatedAt(),
+		UpdatedAt:          a.UpdatedAt(),
+	}
+}
@@ -0,0 +1,81 @@
+package output
+
+import (
+	"io"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/attachment"
+)
+
+type Attachment struct {
+	ID          string
+	TripID      string
+	TargetType  string
+	TargetID    string
+	Filename    string
+	ContentType string
+	Size        int64
+	UploadedBy  string
+	CreatedAt   time.Time
+}
+
+type GetAttachmentOutput struct {
+	Attachment *Attachment
+}
+
+func NewGetAttachmentOutput(a *attachment.Attachment) *GetAttachmentOutput {
+	return &GetAttachmentOutput{
+		Attachment: mapToAttachment(a),
+	}
+}
+
+type ListAttachmentOutput struct {
+	Attachments []*Attachment
+}
+
+func NewListAttachmentOutput(attachments []*attachment.Attachment) *ListAttachmentOutput {
+	formatted := make([]*Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		formatted = append(formatted, mapToAttachment(a))
+	}
+	return &ListAttachmentOutput{
+		Attachments: formatted,
+	}
+}
+
+type UploadAttachmentOutput struct {
+	Attachment *Attachment
+}
+
+func NewUploadAttachmentOutput(a *attachment.Attachment) *UploadAttachmentOutput {
+	return &UploadAttachmentOutput{
+		Attachment: mapToAttachment(a),
+	}
+}
+
+// DownloadAttachmentOutput は添付ファイルのダウンロード時の出力。呼び出し側は Content を読み終えたら Close しなければならない
+type DownloadAttachmentOutput struct {
+	Attachment *Attachment
+	Content    io.ReadCloser
+}
+
+func NewDownloadAttachmentOutput(a *attachment.Attachment, content io.ReadCloser) *DownloadAttachmentOutput {
+	return &DownloadAttachmentOutput{
+		Attachment: mapToAttachment(a),
+		Content:    content,
+	}
+}
+
+func mapToAttachment(a *attachment.Attachment) *Attachment {
+	return &Attachment{
+		ID:          a.ID().String(),
+		TripID:      a.TripID().String(),
+		TargetType:  a.Target().Type().String(),
+		TargetID:    a.Target().ID(),
+		Filename:    a.Filename(),
+		ContentType: a.ContentType(),
+		Size:        a.Size(),
+		UploadedBy:  a.UploadedBy().String(),
+		CreatedAt:   a.CreatedAt(),
+	}
+}
@@ -1,12 +1,12 @@
 package output
 
-import "github.com/hata0/travel-api/internal/domain"
+import "github.com/hata0/travel-api/internal/domain/user"
 
 type RegisterOutput struct {
 	UserID string
 }
 
-func NewRegisterOutput(userID domain.UserID) *RegisterOutput {
+func NewRegisterOutput(userID user.UserID) *RegisterOutput {
 	return &RegisterOutput{
 		UserID: userID.String(),
 	}
@@ -0,0 +1,77 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/bookingimport"
+)
+
+// BookingDraft は確認ファイルから読み取った予約の下書き
+type BookingDraft struct {
+	Key                string
+	Kind               string
+	Status             string
+	Title              string
+	Location           string
+	StartAt            *time.Time
+	EndAt              *time.Time
+	ConfirmationNumber string
+	// PriceAmount は通貨の最小単位で表した金額。金額が読み取れなかった場合は nil
+	PriceAmount   *int64
+	PriceCurrency string
+	Notes         string
+	Transport     *bookingimport.Transport
+	// AccommodationID は宿泊の下書きから作成した宿泊予約の ID。保存していない場合は空
+	AccommodationID string
+	// TransportLegID は移動区間の下書きから作成した移動の ID。保存していない場合は空
+	TransportLegID string
+	// ActivityID は行動の下書きから作成した行動の ID。保存していない場合は空
+	ActivityID string
+}
+
+type ImportBookingsOutput struct {
+	DryRun bool
+	Drafts []*BookingDraft
+}
+
+// NewImportBookingsOutput は下書きと判定結果、下書きから作成した宿泊予約・移動・行動の ID（下書きのキーごと）から出力を作成する
+func NewImportBookingsOutput(dryRun bool, drafts []bookingimport.Draft, statuses []bookingimport.Status, created map[string]string) *ImportBookingsOutput {
+	formatted := make([]*BookingDraft, 0, len(drafts))
+	for idx, d := range drafts {
+		formatted = append(formatted, mapToBookingDraft(d, statuses[idx], created[d.Key()]))
+	}
+
+	return &ImportBookingsOutput{
+		DryRun: dryRun,
+		Drafts: formatted,
+	}
+}
+
+func mapToBookingDraft(d bookingimport.Draft, status bookingimport.Status, createdID string) *BookingDraft {
+	draft := &BookingDraft{
+		Key:                d.Key(),
+		Kind:               d.Kind().String(),
+		Status:             status.String(),
+		Title:              d.Title(),
+		Location:           d.Location(),
+		StartAt:            d.StartAt(),
+		EndAt:              d.EndAt(),
+		ConfirmationNumber: d.ConfirmationNumber(),
+		Notes:              d.Notes(),
+		Transport:          d.Transport(),
+	}
+	switch d.Kind() {
+	case bookingimport.KindLodging:
+		draft.AccommodationID = createdID
+	case bookingimport.KindTransport:
+		draft.TransportLegID = createdID
+	case bookingimport.KindActivity:
+		draft.ActivityID = createdID
+	}
+	if price := d.Price(); price != nil {
+		amount := price.Amount()
+		draft.PriceAmount = &amount
+		draft.PriceCurrency = price.Currency()
+	}
+	return draft
+}
@@ -0,0 +1,102 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+)
+
+// Money は最小単位の整数と10進数表記を併せ持つ金額
+type Money struct {
+	Amount   int64
+	Decimal  string
+	Currency string
+}
+
+type Budget struct {
+	TripID         string
+	Currency       string
+	CategoryLimits map[string]Money
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type GetBudgetOutput struct {
+	Budget *Budget
+}
+
+func NewGetBudgetOutput(b *budget.Budget) *GetBudgetOutput {
+	limits := make(map[string]Money)
+	for category, limit := range b.CategoryLimits() {
+		limits[category.String()] = mapToMoney(limit)
+	}
+
+	return &GetBudgetOutput{
+		Budget: &Budget{
+			TripID:         b.TripID().String(),
+			Currency:       b.Currency(),
+			CategoryLimits: limits,
+			CreatedAt:      b.CreatedAt(),
+			UpdatedAt:      b.UpdatedAt(),
+		},
+	}
+}
+
+type BudgetCategorySummary struct {
+	Category string
+	Spent    Money
+	// Limit と Remaining は上限が設定されていないカテゴリでは nil
+	Limit     *Money
+	Remaining *Money
+}
+
+type GetBudgetSummaryOutput struct {
+	Currency   string
+	Categories []*BudgetCategorySummary
+	TotalLimit Money
+	TotalSpent Money
+	// Unconverted は為替レートが見つからず集計する通貨に換算できなかった支出の通貨別合計
+	Unconverted []Money
+}
+
+func NewGetBudgetSummaryOutput(s *budget.Summary, unconverted []money.Money) *GetBudgetSummaryOutput {
+	categories := make([]*BudgetCategorySummary, 0, len(s.Categories()))
+	for _, c := range s.Categories() {
+		categories = append(categories, &BudgetCategorySummary{
+			Category:  c.Category().String(),
+			Spent:     mapToMoney(c.Spent()),
+			Limit:     mapToOptionalMoney(c.Limit()),
+			Remaining: mapToOptionalMoney(c.Remaining()),
+		})
+	}
+
+	formattedUnconverted := make([]Money, 0, len(unconverted))
+	for _, m := range unconverted {
+		formattedUnconverted = append(formattedUnconverted, mapToMoney(m))
+	}
+
+	return &GetBudgetSummaryOutput{
+		Currency:    s.Currency(),
+		Categories:  categories,
+		TotalLimit:  mapToMoney(s.TotalLimit()),
+		TotalSpent:  mapToMoney(s.TotalSpent()),
+		Unconverted: formattedUnconverted,
+	}
+}
+
+func mapToMoney(m money.Money) Money {
+	return Money{
+		Amount:   m.Amount(),
+		Decimal:  m.Decimal(),
+		Currency: m.Currency(),
+	}
+}
+
+func mapToOptionalMoney(m *money.Money) *Money {
+	if m == nil {
+		return nil
+	}
+	formatted := mapToMoney(*m)
+	return &formatted
+}
@@ -0,0 +1,56 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/calendarfeed"
+)
+
+// ExportCalendarOutput は iCalendar 形式に書き出したカレンダー
+type ExportCalendarOutput struct {
+	Filename string
+	Content  []byte
+}
+
+func NewExportCalendarOutput(filename string, content []byte) *ExportCalendarOutput {
+	return &ExportCalendarOutput{
+		Filename: filename,
+		Content:  content,
+	}
+}
+
+// CalendarFeed はカレンダーフィードの情報。トークンは保存されないため含まない
+type CalendarFeed struct {
+	ID        string
+	CreatedAt time.Time
+}
+
+type GetCalendarFeedOutput struct {
+	CalendarFeed *CalendarFeed
+}
+
+func NewGetCalendarFeedOutput(feed *calendarfeed.Feed) *GetCalendarFeedOutput {
+	return &GetCalendarFeedOutput{
+		CalendarFeed: mapToCalendarFeed(feed),
+	}
+}
+
+// IssueCalendarFeedOutput の Token は保存されないため、発行時にしか取得できない
+type IssueCalendarFeedOutput struct {
+	CalendarFeed *CalendarFeed
+	Token        string
+}
+
+func NewIssueCalendarFeedOutput(feed *calendarfeed.Feed, token string) *IssueCalendarFeedOutput {
+	return &IssueCalendarFeedOutput{
+		CalendarFeed: mapToCalendarFeed(feed),
+		Token:        token,
+	}
+}
+
+func mapToCalendarFeed(feed *calendarfeed.Feed) *CalendarFeed {
+	return &CalendarFeed{
+		ID:        feed.ID().String(),
+		CreatedAt: feed.CreatedAt(),
+	}
+}
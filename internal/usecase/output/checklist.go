@@ -0,0 +1,148 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/checklist"
+)
+
+type Checklist struct {
+	ID        string
+	TripID    string
+	Name      string
+	Kind      string
+	Items     []*ChecklistItem
+	Progress  ChecklistProgress
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ChecklistItem struct {
+	ID         string
+	Name       string
+	Quantity   int
+	Checked    bool
+	AssigneeID *string
+}
+
+// ChecklistProgress はチェックリストの進捗。Percent はチェック済みの割合（0〜100）
+type ChecklistProgress struct {
+	Total   int
+	Checked int
+	Percent int
+}
+
+type GetChecklistOutput struct {
+	Checklist *Checklist
+}
+
+func NewGetChecklistOutput(c *checklist.Checklist) *GetChecklistOutput {
+	return &GetChecklistOutput{
+		Checklist: mapToChecklist(c),
+	}
+}
+
+type ListChecklistOutput struct {
+	Checklists []*Checklist
+	// Progress は旅行のチェックリスト全体の進捗
+	Progress ChecklistProgress
+}
+
+func NewListChecklistOutput(checklists []*checklist.Checklist) *ListChecklistOutput {
+	formatted := make([]*Checklist, 0, len(checklists))
+	for _, c := range checklists {
+		formatted = append(formatted, mapToChecklist(c))
+	}
+
+	return &ListChecklistOutput{
+		Checklists: formatted,
+		Progress:   mapToChecklistProgress(checklist.TotalProgress(checklists)),
+	}
+}
+
+type CreateChecklistOutput struct {
+	ID string
+}
+
+func NewCreateChecklistOutput(id checklist.ChecklistID) *CreateChecklistOutput {
+	return &CreateChecklistOutput{
+		ID: id.String(),
+	}
+}
+
+type ItemTemplate struct {
+	ID        string
+	Name      string
+	Quantity  int
+	PerDay    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ListItemTemplateOutput struct {
+	ItemTemplates []*ItemTemplate
+}
+
+func NewListItemTemplateOutput(templates []*checklist.ItemTemplate) *ListItemTemplateOutput {
+	formatted := make([]*ItemTemplate, 0, len(templates))
+	for _, t := range templates {
+		formatted = append(formatted, &ItemTemplate{
+			ID:        t.ID().String(),
+			Name:      t.Name(),
+			Quantity:  t.Quantity(),
+			PerDay:    t.PerDay(),
+			CreatedAt: t.CreatedAt(),
+			UpdatedAt: t.UpdatedAt(),
+		})
+	}
+
+	return &ListItemTemplateOutput{
+		ItemTemplates: formatted,
+	}
+}
+
+type CreateItemTemplateOutput struct {
+	ID string
+}
+
+func NewCreateItemTemplateOutput(id checklist.ItemTemplateID) *CreateItemTemplateOutput {
+	return &CreateItemTemplateOutput{
+		ID: id.String(),
+	}
+}
+
+func mapToChecklist(c *checklist.Checklist) *Checklist {
+	items := make([]*ChecklistItem, 0, len(c.Items()))
+	for _, item := range c.Items() {
+		formatted := &ChecklistItem{
+			ID:       item.ID().String(),
+			Name:     item.Name(),
+			Quantity: item.Quantity(),
+			Checked:  item.Checked(),
+		}
+		if assigneeID := item.AssigneeID(); assigneeID != nil {
+			id := assigneeID.String()
+			formatted.AssigneeID = &id
+		}
+		items = append(items, formatted)
+	}
+
+	return &Checklist{
+		ID:        c.ID().String(),
+		TripID:    c.TripID().String(),
+		Name:      c.Name(),
+		Kind:      c.Kind().String(),
+		Items:     items,
+		Progress:  mapToChecklistProgress(c.Progress()),
+		CreatedAt: c.CreatedAt(),
+		UpdatedAt: c.UpdatedAt(),
+	}
+}
+
+func mapToChecklistProgress(p checklist.Progress) ChecklistProgress {
+	return ChecklistProgress{
+		Total:   p.Total(),
+		Checked: p.Checked(),
+		Percent: p.Percent(),
+	}
+}
@@ -0,0 +1,28 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+)
+
+type ImportExchangeRatesOutput struct {
+	// Imported は取り込んだレートの件数
+	Imported int
+	// FromDate と ToDate は取り込んだレートの日付の範囲
+	FromDate time.Time
+	ToDate   time.Time
+}
+
+func NewImportExchangeRatesOutput(rates []*exchangerate.ExchangeRate) *ImportExchangeRatesOutput {
+	out := &ImportExchangeRatesOutput{Imported: len(rates)}
+	for i, r := range rates {
+		if i == 0 || r.Date().Before(out.FromDate) {
+			out.FromDate = r.Date()
+		}
+		if i == 0 || r.Date().After(out.ToDate) {
+			out.ToDate = r.Date()
+		}
+	}
+	return out
+}
@@ -0,0 +1,116 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/expense"
+)
+
+type Expense struct {
+	ID     string
+	TripID string
+	// Amount は通貨の最小単位で表した金額
+	Amount        int64
+	AmountDecimal string
+	Currency      string
+	Category      string
+	SpentOn       time.Time
+	Payer         string
+	Description   string
+	ActivityID    *string
+	// Split は分担が設定されていない支出では nil
+	Split     *ExpenseSplit
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ExpenseSplit struct {
+	Method  string
+	Entries []*ExpenseSplitEntry
+}
+
+type ExpenseSplitEntry struct {
+	Participant string
+	Value       int64
+	// Share は支出額から配分された分担額
+	Share Money
+}
+
+type GetExpenseOutput struct {
+	Expense *Expense
+}
+
+func NewGetExpenseOutput(e *expense.Expense) *GetExpenseOutput {
+	return &GetExpenseOutput{
+		Expense: mapToExpense(e),
+	}
+}
+
+type ListExpenseOutput struct {
+	Expenses []*Expense
+}
+
+func NewListExpenseOutput(expenses []*expense.Expense) *ListExpenseOutput {
+	formatted := make([]*Expense, 0, len(expenses))
+	for _, e := range expenses {
+		formatted = append(formatted, mapToExpense(e))
+	}
+
+	return &ListExpenseOutput{
+		Expenses: formatted,
+	}
+}
+
+type CreateExpenseOutput struct {
+	ID string
+}
+
+func NewCreateExpenseOutput(id expense.ExpenseID) *CreateExpenseOutput {
+	return &CreateExpenseOutput{
+		ID: id.String(),
+	}
+}
+
+func mapToExpense(e *expense.Expense) *Expense {
+	return &Expense{
+		ID:            e.ID().String(),
+		TripID:        e.TripID().String(),
+		Amount:        e.Amount().Amount(),
+		AmountDecimal: e.Amount().Decimal(),
+		Currency:      e.Amount().Currency(),
+		Category:      e.Category().String(),
+		SpentOn:       e.SpentOn(),
+		Payer:         e.Payer(),
+		Description:   e.Description(),
+		ActivityID:    e.ActivityID(),
+		Split:         mapToExpenseSplit(e),
+		CreatedAt:     e.CreatedAt(),
+		UpdatedAt:     e.UpdatedAt(),
+	}
+}
+
+// mapToExpenseSplit は分担方法と参加者ごとの分担額を変換する。
+// 保存済みの支出は検証を通過しているため配分に失敗することはないが、失敗した場合は分担なしとして扱う
+func mapToExpenseSplit(e *expense.Expense) *ExpenseSplit {
+	if e.Split() == nil {
+		return nil
+	}
+	shares, err := e.Shares()
+	if err != nil {
+		return nil
+	}
+
+	entries := make([]*ExpenseSplitEntry, len(shares))
+	for i, entry := range e.Split().Entries() {
+		entries[i] = &ExpenseSplitEntry{
+			Participant: entry.Participant(),
+			Value:       entry.Value(),
+			Share:       mapToMoney(shares[i].Amount()),
+		}
+	}
+
+	return &ExpenseSplit{
+		Method:  e.Split().Method().String(),
+		Entries: entries,
+	}
+}
@@ -0,0 +1,77 @@
+package output
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/history"
+)
+
+// RevisionChange はリビジョンに含まれる 1 つのリソースへの変更。Before と After は変更前後のスナップショット
+type RevisionChange struct {
+	ResourceType  string
+	ResourceID    string
+	Action        string
+	ChangedFields []string
+	Before        json.RawMessage
+	After         json.RawMessage
+}
+
+type Revision struct {
+	Number     int64
+	ActorID    string
+	RevertedTo *int64
+	Changes    []*RevisionChange
+	CreatedAt  time.Time
+}
+
+type ListHistoryOutput struct {
+	Revisions []*Revision
+}
+
+func NewListHistoryOutput(revisions []*history.Revision) *ListHistoryOutput {
+	formatted := make([]*Revision, 0, len(revisions))
+	for _, r := range revisions {
+		formatted = append(formatted, mapToRevision(r))
+	}
+
+	return &ListHistoryOutput{
+		Revisions: formatted,
+	}
+}
+
+// RevertTripOutput は以前のリビジョンに戻した結果。既に戻す先と同じ状態だった場合 Revision は nil になる
+type RevertTripOutput struct {
+	Revision *Revision
+}
+
+func NewRevertTripOutput(revision *history.Revision) *RevertTripOutput {
+	if revision == nil {
+		return &RevertTripOutput{}
+	}
+	return &RevertTripOutput{
+		Revision: mapToRevision(revision),
+	}
+}
+
+func mapToRevision(r *history.Revision) *Revision {
+	changes := make([]*RevisionChange, 0, len(r.Changes()))
+	for _, c := range r.Changes() {
+		changes = append(changes, &RevisionChange{
+			ResourceType:  c.ResourceType().String(),
+			ResourceID:    c.ResourceID(),
+			Action:        c.Action().String(),
+			ChangedFields: c.ChangedFields(),
+			Before:        c.Before(),
+			After:         c.After(),
+		})
+	}
+
+	return &Revision{
+		Number:     r.Number(),
+		ActorID:    r.ActorID().String(),
+		RevertedTo: r.RevertedTo(),
+		Changes:    changes,
+		CreatedAt:  r.CreatedAt(),
+	}
+}
@@ -0,0 +1,58 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/membership"
+)
+
+type Invitation struct {
+	ID        string
+	TripID    string
+	InviterID string
+	// Email と Username は宛先として指定された方だけが設定される
+	Email    string
+	Username string
+	Role     string
+	// Status は取得時点の状態。未応答のまま有効期限を過ぎた招待は expired になる
+	Status      string
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+	RespondedAt *time.Time
+}
+
+type ListInvitationOutput struct {
+	Invitations []*Invitation
+}
+
+func NewListInvitationOutput(invitations []*membership.Invitation, now time.Time) *ListInvitationOutput {
+	formatted := make([]*Invitation, 0, len(invitations))
+	for _, i := range invitations {
+		formatted = append(formatted, &Invitation{
+			ID:          i.ID().String(),
+			TripID:      i.TripID().String(),
+			InviterID:   i.InviterID().String(),
+			Email:       i.Invitee().Email(),
+			Username:    i.Invitee().Username(),
+			Role:        i.Role().String(),
+			Status:      i.StatusAt(now).String(),
+			ExpiresAt:   i.ExpiresAt(),
+			CreatedAt:   i.CreatedAt(),
+			RespondedAt: i.RespondedAt(),
+		})
+	}
+
+	return &ListInvitationOutput{
+		Invitations: formatted,
+	}
+}
+
+type CreateInvitationOutput struct {
+	ID string
+}
+
+func NewCreateInvitationOutput(id membership.InvitationID) *CreateInvitationOutput {
+	return &CreateInvitationOutput{
+		ID: id.String(),
+	}
+}
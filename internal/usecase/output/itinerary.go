@@ -0,0 +1,216 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// WarningInsufficientTravelTime は行動の間の空き時間が見積もった移動時間より短いことを表す警告のコード
+const WarningInsufficientTravelTime = "insufficient_travel_time"
+
+type Activity struct {
+	ID         string
+	TripID     string
+	Date       time.Time
+	Position   int
+	Title      string
+	Place      *ActivityPlace
+	Timezone   *string
+	StartAt    *time.Time
+	EndAt      *time.Time
+	TravelMode string
+	Notes      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type ActivityPlace struct {
+	Name      string
+	Latitude  *float64
+	Longitude *float64
+	Timezone  *string
+}
+
+// ItineraryDay は 1 日分の行程。DayNumber は旅行の何日目かで、期間未定の旅行や期間外の日は nil
+type ItineraryDay struct {
+	Date                time.Time
+	DayNumber           *int
+	Activities          []*Activity
+	Legs                []*ItineraryLeg
+	TotalDistanceMeters float64
+	TotalTravelTime     time.Duration
+	Warnings            []*ItineraryWarning
+}
+
+// ItineraryLeg は連続する行動の間の移動。距離は直線距離で、座標が分からない場合は距離と移動時間は nil。
+// 出発地・到着地のタイムゾーンは行動のタイムゾーンが決まっていない場合は nil
+type ItineraryLeg struct {
+	FromActivityID string
+	ToActivityID   string
+	FromTimezone   *string
+	ToTimezone     *string
+	TravelMode     string
+	DistanceMeters *float64
+	TravelTime     *time.Duration
+	Gap            *time.Duration
+}
+
+type ItineraryWarning struct {
+	Code           string
+	FromActivityID string
+	ToActivityID   string
+	Gap            time.Duration
+	TravelTime     time.Duration
+}
+
+type GetActivityOutput struct {
+	Activity *Activity
+}
+
+func NewGetActivityOutput(a *itinerary.Activity) *GetActivityOutput {
+	return &GetActivityOutput{
+		Activity: mapToActivity(a),
+	}
+}
+
+// GetItineraryOutput は旅行の行程。AverageSpeeds は移動時間の見積もりに使った移動手段ごとの平均の速さ（km/h）
+type GetItineraryOutput struct {
+	Days          []*ItineraryDay
+	AverageSpeeds map[string]float64
+}
+
+// NewGetItineraryOutput は日ごとの行程から出力を作成する。period は日数の計算に使い、期間未定の旅行では nil を渡す
+func NewGetItineraryOutput(plans []itinerary.DayPlan, period *trip.Period, speeds itinerary.Speeds) *GetItineraryOutput {
+	days := make([]*ItineraryDay, 0, len(plans))
+	for _, plan := range plans {
+		days = append(days, mapToItineraryDay(plan, period))
+	}
+
+	averageSpeeds := make(map[string]float64, len(itinerary.TravelModes()))
+	for _, mode := range itinerary.TravelModes() {
+		averageSpeeds[mode.String()] = speeds.KilometersPerHour(mode)
+	}
+
+	return &GetItineraryOutput{
+		Days:          days,
+		AverageSpeeds: averageSpeeds,
+	}
+}
+
+// OptimizeDayOutput は行動を巡る順を並べ替えた提案。Day は提案した順の行程で、距離は出発地と到着地を含む直線距離の合計。
+// Complete が false の場合は制限時間内に探索を終えられず、途中までの最良の順を提案している
+type OptimizeDayOutput struct {
+	Day                     *ItineraryDay
+	OriginalDistanceMeters  float64
+	OptimizedDistanceMeters float64
+	SavedDistanceMeters     float64
+	Complete                bool
+	Applied                 bool
+}
+
+// NewOptimizeDayOutput は並べ替えの提案と提案した順の行程から出力を作成する
+func NewOptimizeDayOutput(o itinerary.Optimization, plan itinerary.DayPlan, period *trip.Period, applied bool) *OptimizeDayOutput {
+	return &OptimizeDayOutput{
+		Day:                     mapToItineraryDay(plan, period),
+		OriginalDistanceMeters:  o.OriginalDistanceMeters(),
+		OptimizedDistanceMeters: o.OptimizedDistanceMeters(),
+		SavedDistanceMeters:     o.SavedDistanceMeters(),
+		Complete:                o.Complete(),
+		Applied:                 applied,
+	}
+}
+
+type CreateActivityOutput struct {
+	ID string
+}
+
+func NewCreateActivityOutput(id itinerary.ActivityID) *CreateActivityOutput {
+	return &CreateActivityOutput{
+		ID: id.String(),
+	}
+}
+
+func mapToItineraryDay(plan itinerary.DayPlan, period *trip.Period) *ItineraryDay {
+	day := &ItineraryDay{
+		Date:                plan.Date(),
+		Activities:          make([]*Activity, 0, len(plan.Activities())),
+		Legs:                make([]*ItineraryLeg, 0, len(plan.Legs())),
+		TotalDistanceMeters: plan.TotalDistanceMeters(),
+		TotalTravelTime:     plan.TotalTravelTime(),
+		Warnings:            make([]*ItineraryWarning, 0),
+	}
+	if period != nil {
+		if n := period.DayNumber(plan.Date()); n > 0 {
+			day.DayNumber = &n
+		}
+	}
+	for _, a := range plan.Activities() {
+		day.Activities = append(day.Activities, mapToActivity(a))
+	}
+	for _, leg := range plan.Legs() {
+		day.Legs = append(day.Legs, &ItineraryLeg{
+			FromActivityID: leg.From().String(),
+			ToActivityID:   leg.To().String(),
+			FromTimezone:   timezoneName(leg.FromTimezone()),
+			ToTimezone:     timezoneName(leg.ToTimezone()),
+			TravelMode:     leg.Mode().String(),
+			DistanceMeters: leg.DistanceMeters(),
+			TravelTime:     leg.TravelTime(),
+			Gap:            leg.Gap(),
+		})
+	}
+	for _, leg := range plan.InsufficientLegs() {
+		day.Warnings = append(day.Warnings, &ItineraryWarning{
+			Code:           WarningInsufficientTravelTime,
+			FromActivityID: leg.From().String(),
+			ToActivityID:   leg.To().String(),
+			Gap:            *leg.Gap(),
+			TravelTime:     *leg.TravelTime(),
+		})
+	}
+	return day
+}
+
+func mapToActivity(a *itinerary.Activity) *Activity {
+	formatted := &Activity{
+		ID:         a.ID().String(),
+		TripID:     a.TripID().String(),
+		Date:       a.Date(),
+		Position:   a.Position(),
+		Title:      a.Title(),
+		Timezone:   timezoneName(a.Timezone()),
+		StartAt:    a.StartAt(),
+		EndAt:      a.EndAt(),
+		TravelMode: a.TravelMode().String(),
+		Notes:      a.Notes(),
+		CreatedAt:  a.CreatedAt(),
+		UpdatedAt:  a.UpdatedAt(),
+	}
+	formatted.Place = mapToActivityPlace(a.Place())
+	return formatted
+}
+
+// mapToActivityPlace は行動の場所を変換する。場所が設定されていない場合は nil を返す
+func mapToActivityPlace(place *geo.Place) *ActivityPlace {
+	if place == nil {
+		return nil
+	}
+	formatted := &ActivityPlace{Name: place.Name(), Timezone: timezoneName(place.Timezone())}
+	if c := place.Coordinate(); c != nil {
+		latitude, longitude := c.Latitude(), c.Longitude()
+		formatted.Latitude, formatted.Longitude = &latitude, &longitude
+	}
+	return formatted
+}
+
+// timezoneName はタイムゾーンの IANA の名前を返す。タイムゾーンが nil の場合は nil を返す
+func timezoneName(timezone *geo.Timezone) *string {
+	if timezone == nil {
+		return nil
+	}
+	name := timezone.Name()
+	return &name
+}
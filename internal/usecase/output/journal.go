@@ -0,0 +1,89 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/journal"
+)
+
+type JournalEntry struct {
+	ID     string
+	TripID string
+	Date   time.Time
+	Title  string
+	// Body は入力された Markdown、BodyHTML はそれを許可リストで絞り込んだ HTML に変換したもの
+	Body      string
+	BodyHTML  string
+	Mood      string
+	Place     *JournalPlace
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type JournalPlace struct {
+	Name      string
+	Latitude  *float64
+	Longitude *float64
+	Timezone  *string
+}
+
+type GetJournalEntryOutput struct {
+	JournalEntry *JournalEntry
+}
+
+// NewGetJournalEntryOutput は日記の出力を作成する。render は Markdown の本文を HTML に変換する
+func NewGetJournalEntryOutput(e *journal.Entry, render func(markdown string) string) *GetJournalEntryOutput {
+	return &GetJournalEntryOutput{
+		JournalEntry: mapToJournalEntry(e, render),
+	}
+}
+
+type ListJournalEntryOutput struct {
+	JournalEntries []*JournalEntry
+}
+
+func NewListJournalEntryOutput(entries []*journal.Entry, render func(markdown string) string) *ListJournalEntryOutput {
+	return &ListJournalEntryOutput{
+		JournalEntries: mapToJournalEntries(entries, render),
+	}
+}
+
+type CreateJournalEntryOutput struct {
+	ID string
+}
+
+func NewCreateJournalEntryOutput(id journal.EntryID) *CreateJournalEntryOutput {
+	return &CreateJournalEntryOutput{
+		ID: id.String(),
+	}
+}
+
+func mapToJournalEntries(entries []*journal.Entry, render func(markdown string) string) []*JournalEntry {
+	formatted := make([]*JournalEntry, 0, len(entries))
+	for _, e := range entries {
+		formatted = append(formatted, mapToJournalEntry(e, render))
+	}
+	return formatted
+}
+
+func mapToJournalEntry(e *journal.Entry, render func(markdown string) string) *JournalEntry {
+	formatted := &JournalEntry{
+		ID:        e.ID().String(),
+		TripID:    e.TripID().String(),
+		Date:      e.Date(),
+		Title:     e.Title(),
+		Body:      e.Body(),
+		BodyHTML:  render(e.Body()),
+		Mood:      e.Mood().String(),
+		CreatedAt: e.CreatedAt(),
+		UpdatedAt: e.UpdatedAt(),
+	}
+	if place := e.Place(); place != nil {
+		formatted.Place = &JournalPlace{Name: place.Name(), Timezone: timezoneName(place.Timezone())}
+		if c := place.Coordinate(); c != nil {
+			latitude, longitude := c.Latitude(), c.Longitude()
+			formatted.Place.Latitude, formatted.Place.Longitude = &latitude, &longitude
+		}
+	}
+	return formatted
+}
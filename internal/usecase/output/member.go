@@ -0,0 +1,34 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/membership"
+)
+
+type Member struct {
+	UserID    string
+	Role      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ListMemberOutput struct {
+	Members []*Member
+}
+
+func NewListMemberOutput(members []*membership.Member) *ListMemberOutput {
+	formatted := make([]*Member, 0, len(members))
+	for _, m := range members {
+		formatted = append(formatted, &Member{
+			UserID:    m.UserID().String(),
+			Role:      m.Role().String(),
+			CreatedAt: m.CreatedAt(),
+			UpdatedAt: m.UpdatedAt(),
+		})
+	}
+
+	return &ListMemberOutput{
+		Members: formatted,
+	}
+}
@@ -0,0 +1,126 @@
+package output
+
+import (
+	"io"
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/photo"
+)
+
+type Photo struct {
+	ID          string
+	TripID      string
+	Filename    string
+	ContentType string
+	Size        int64
+	Width       int
+	Height      int
+	CapturedAt  *time.Time
+	// Latitude・Longitude は撮影位置。位置情報の削除を指定してアップロードされた写真では公開しないため nil になる
+	Latitude         *float64
+	Longitude        *float64
+	LocationStripped bool
+	UploadedBy       string
+	CreatedAt        time.Time
+	Suggestion       *PhotoSuggestion
+}
+
+// PhotoSuggestion は写真が旅程のどの日・どの場所で撮影されたかの推定結果。推定できなかった項目は nil になる
+type PhotoSuggestion struct {
+	Date           *time.Time
+	Day            *int
+	Place          *JournalPlace
+	DistanceMeters *float64
+}
+
+type GetPhotoOutput struct {
+	Photo *Photo
+}
+
+func NewGetPhotoOutput(p *photo.Photo, suggestion photo.Suggestion) *GetPhotoOutput {
+	return &GetPhotoOutput{
+		Photo: mapToPhoto(p, suggestion),
+	}
+}
+
+type ListPhotoOutput struct {
+	Photos []*Photo
+}
+
+// NewListPhotoOutput は写真の一覧の出力を作成する。suggestions は photos と同じ順に並んだ推定結果
+func NewListPhotoOutput(photos []*photo.Photo, suggestions []photo.Suggestion) *ListPhotoOutput {
+	formatted := make([]*Photo, 0, len(photos))
+	for i, p := range photos {
+		formatted = append(formatted, mapToPhoto(p, suggestions[i]))
+	}
+	return &ListPhotoOutput{
+		Photos: formatted,
+	}
+}
+
+type UploadPhotoOutput struct {
+	Photo *Photo
+}
+
+func NewUploadPhotoOutput(p *photo.Photo, suggestion photo.Suggestion) *UploadPhotoOutput {
+	return &UploadPhotoOutput{
+		Photo: mapToPhoto(p, suggestion),
+	}
+}
+
+// DownloadPhotoOutput は写真またはサムネイルのダウンロード時の出力。
+// Size が分からない場合は -1 になる。呼び出し側は Content を読み終えたら Close しなければならない
+type DownloadPhotoOutput struct {
+	Photo       *Photo
+	ContentType string
+	Size        int64
+	Content     io.ReadCloser
+}
+
+func NewDownloadPhotoOutput(p *photo.Photo, contentType string, size int64, content io.ReadCloser) *DownloadPhotoOutput {
+	return &DownloadPhotoOutput{
+		Photo:       mapToPhoto(p, photo.Suggestion{}),
+		ContentType: contentType,
+		Size:        size,
+		Content:     content,
+	}
+}
+
+func mapToPhoto(p *photo.Photo, suggestion photo.Suggestion) *Photo {
+	formatted := &Photo{
+		ID:               p.ID().String(),
+		TripID:           p.TripID().String(),
+		Filename:         p.Filename(),
+		ContentType:      p.ContentType(),
+		Size:             p.Size(),
+		Width:            p.Width(),
+		Height:           p.Height(),
+		CapturedAt:       p.CapturedAt(),
+		LocationStripped: p.LocationStripped(),
+		UploadedBy:       p.UploadedBy().String(),
+		CreatedAt:        p.CreatedAt(),
+		Suggestion:       mapToPhotoSuggestion(suggestion),
+	}
+	if c := p.Coordinate(); c != nil && !p.LocationStripped() {
+		latitude, longitude := c.Latitude(), c.Longitude()
+		formatted.Latitude, formatted.Longitude = &latitude, &longitude
+	}
+	return formatted
+}
+
+func mapToPhotoSuggestion(s photo.Suggestion) *PhotoSuggestion {
+	formatted := &PhotoSuggestion{Date: s.Date()}
+	if day := s.Day(); day > 0 {
+		formatted.Day = &day
+	}
+	if place := s.Place(); place != nil {
+		formatted.Place = &JournalPlace{Name: place.Name(), Timezone: timezoneName(place.Timezone())}
+		if c := place.Coordinate(); c != nil {
+			latitude, longitude := c.Latitude(), c.Longitude()
+			formatted.Place.Latitude, formatted.Place.Longitude = &latitude, &longitude
+		}
+		distance := s.DistanceMeters()
+		formatted.DistanceMeters = &distance
+	}
+	return formatted
+}
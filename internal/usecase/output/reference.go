@@ -0,0 +1,96 @@
+package output
+
+import (
+	"github.com/hata0/travel-api/internal/domain/reference"
+)
+
+type Country struct {
+	Code       string
+	Alpha3Code string
+	Name       string
+	Currency   string
+}
+
+type Airport struct {
+	IATACode    string
+	ICAOCode    string
+	Name        string
+	City        string
+	CountryCode string
+	Latitude    float64
+	Longitude   float64
+	Timezone    string
+}
+
+type City struct {
+	Code        string
+	Name        string
+	CountryCode string
+	Latitude    float64
+	Longitude   float64
+	Timezone    string
+}
+
+type ListCountriesOutput struct {
+	Countries []*Country
+}
+
+func NewListCountriesOutput(countries []*reference.Country) *ListCountriesOutput {
+	out := &ListCountriesOutput{Countries: make([]*Country, len(countries))}
+	for i, c := range countries {
+		out.Countries[i] = &Country{
+			Code:       c.Code(),
+			Alpha3Code: c.Alpha3Code(),
+			Name:       c.Name(),
+			Currency:   c.Currency(),
+		}
+	}
+	return out
+}
+
+type SearchAirportsOutput struct {
+	Airports []*Airport
+}
+
+func NewSearchAirportsOutput(airports []*reference.Airport) *SearchAirportsOutput {
+	out := &SearchAirportsOutput{Airports: make([]*Airport, len(airports))}
+	for i, a := range airports {
+		out.Airports[i] = &Airport{
+			IATACode:    a.IATACode(),
+			ICAOCode:    a.ICAOCode(),
+			Name:        a.Name(),
+			City:        a.City(),
+			CountryCode: a.CountryCode(),
+			Latitude:    a.Coordinate().Latitude(),
+			Longitude:   a.Coordinate().Longitude(),
+			Timezone:    a.Timezone().Name(),
+		}
+	}
+	return out
+}
+
+type SearchCitiesOutput struct {
+	Cities []*City
+}
+
+func NewSearchCitiesOutput(cities []*reference.City) *SearchCitiesOutput {
+	out := &SearchCitiesOutput{Cities: make([]*City, len(cities))}
+	for i, c := range cities {
+		out.Cities[i] = &City{
+			Code:        c.Code(),
+			Name:        c.Name(),
+			CountryCode: c.CountryCode(),
+			Latitude:    c.Coordinate().Latitude(),
+			Longitude:   c.Coordinate().Longitude(),
+			Timezone:    c.Timezone().Name(),
+		}
+	}
+	return out
+}
+
+// SeedReferenceOutput は参照データの取り込み時の出力。それぞれ取り込んだ件数
+type SeedReferenceOutput struct {
+	Countries int
+	Airports  int
+	Cities    int
+}
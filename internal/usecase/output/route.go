@@ -0,0 +1,19 @@
+package output
+
+import "io"
+
+// ExportRouteOutput は地図アプリ向けに書き出した旅行の経路。
+// Content は読み進めるにつれて書き出されるため、呼び出し側は読み終えたら（途中でやめる場合も）Close しなければならない
+type ExportRouteOutput struct {
+	Filename string
+	Format   string
+	Content  io.ReadCloser
+}
+
+func NewExportRouteOutput(filename, format string, content io.ReadCloser) *ExportRouteOutput {
+	return &ExportRouteOutput{
+		Filename: filename,
+		Format:   format,
+		Content:  content,
+	}
+}
@@ -0,0 +1,72 @@
+package output
+
+import (
+	"github.com/hata0/travel-api/internal/domain/search"
+)
+
+// SnippetSegment は強調表示用に分割された文字列の一部分。Highlighted が true の部分は検索語に一致している
+type SnippetSegment struct {
+	Text        string
+	Highlighted bool
+}
+
+type SearchHit struct {
+	ResourceType string
+	ResourceID   string
+	TripID       string
+	// Title はリソースの名前全体、Snippet はそれ以外の検索対象の文章から一致箇所の周辺を切り出したもの
+	Title   []SnippetSegment
+	Snippet []SnippetSegment
+	Rank    float64
+}
+
+// SearchGroup はリソースの種類ごとにまとめた検索結果。Hits は関連度の高い順に並ぶ
+type SearchGroup struct {
+	ResourceType string
+	Hits         []*SearchHit
+}
+
+type SearchOutput struct {
+	Groups []*SearchGroup
+}
+
+// NewSearchOutput は検索結果を search.ResourceTypes の順にリソースの種類ごとにまとめる。一致がない種類は含めない
+func NewSearchOutput(hits []*search.Hit, query search.Query, snippetLength int) *SearchOutput {
+	terms := query.Terms()
+	byType := make(map[search.ResourceType][]*SearchHit)
+	for _, h := range hits {
+		byType[h.ResourceType()] = append(byType[h.ResourceType()], &SearchHit{
+			ResourceType: h.ResourceType().String(),
+			ResourceID:   h.ResourceID(),
+			TripID:       h.TripID().String(),
+			Title:        mapToSnippetSegments(search.NewSnippet(h.Title(), terms, 0)),
+			Snippet:      mapToSnippetSegments(search.NewSnippet(h.Body(), terms, snippetLength)),
+			Rank:         h.Rank(),
+		})
+	}
+
+	groups := make([]*SearchGroup, 0, len(byType))
+	for _, resourceType := range search.ResourceTypes {
+		if typeHits, ok := byType[resourceType]; ok {
+			groups = append(groups, &SearchGroup{
+				ResourceType: resourceType.String(),
+				Hits:         typeHits,
+			})
+		}
+	}
+
+	return &SearchOutput{
+		Groups: groups,
+	}
+}
+
+func mapToSnippetSegments(snippet search.Snippet) []SnippetSegment {
+	segments := make([]SnippetSegment, 0, len(snippet))
+	for _, s := range snippet {
+		segments = append(segments, SnippetSegment{
+			Text:        s.Text,
+			Highlighted: s.Highlighted,
+		})
+	}
+	return segments
+}
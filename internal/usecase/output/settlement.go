@@ -0,0 +1,53 @@
+package output
+
+import (
+	"github.com/hata0/travel-api/internal/domain/settlement"
+)
+
+type GetSettlementOutput struct {
+	// Settlements は通貨ごとの精算結果（通貨コード順）
+	Settlements []*Settlement
+}
+
+type Settlement struct {
+	Currency  string
+	Balances  []*SettlementBalance
+	Transfers []*SettlementTransfer
+}
+
+// SettlementBalance は参加者ごとの収支。正の値は受け取るべき額、負の値は支払うべき額を表す
+type SettlementBalance struct {
+	Participant string
+	Amount      Money
+}
+
+type SettlementTransfer struct {
+	From   string
+	To     string
+	Amount Money
+}
+
+func NewGetSettlementOutput(settlements []*settlement.Settlement) *GetSettlementOutput {
+	formatted := make([]*Settlement, len(settlements))
+	for i, s := range settlements {
+		balances := make([]*SettlementBalance, len(s.Balances()))
+		for j, b := range s.Balances() {
+			balances[j] = &SettlementBalance{Participant: b.Participant(), Amount: mapToMoney(b.Amount())}
+		}
+
+		transfers := make([]*SettlementTransfer, len(s.Transfers()))
+		for j, t := range s.Transfers() {
+			transfers[j] = &SettlementTransfer{From: t.From(), To: t.To(), Amount: mapToMoney(t.Amount())}
+		}
+
+		formatted[i] = &Settlement{
+			Currency:  s.Currency(),
+			Balances:  balances,
+			Transfers: transfers,
+		}
+	}
+
+	return &GetSettlementOutput{
+		Settlements: formatted,
+	}
+}
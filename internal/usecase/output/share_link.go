@@ -0,0 +1,176 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/sharelink"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+type ShareLink struct {
+	ID          string
+	TripID      string
+	HasPassword bool
+	// Status は取得時点の状態。active, expired, revoked のいずれか
+	Status    string
+	ExpiresAt *time.Time
+	CreatedBy string
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+type ListShareLinkOutput struct {
+	ShareLinks []*ShareLink
+}
+
+func NewListShareLinkOutput(links []*sharelink.ShareLink, now time.Time) *ListShareLinkOutput {
+	formatted := make([]*ShareLink, 0, len(links))
+	for _, l := range links {
+		formatted = append(formatted, &ShareLink{
+			ID:          l.ID().String(),
+			TripID:      l.TripID().String(),
+			HasPassword: l.HasPassword(),
+			Status:      l.StatusAt(now).String(),
+			ExpiresAt:   l.ExpiresAt(),
+			CreatedBy:   l.CreatedBy().String(),
+			CreatedAt:   l.CreatedAt(),
+			RevokedAt:   l.RevokedAt(),
+		})
+	}
+
+	return &ListShareLinkOutput{
+		ShareLinks: formatted,
+	}
+}
+
+// CreateShareLinkOutput の Token は保存されないため、作成時にしか取得できない
+type CreateShareLinkOutput struct {
+	ID    string
+	Token string
+}
+
+func NewCreateShareLinkOutput(id sharelink.ShareLinkID, token string) *CreateShareLinkOutput {
+	return &CreateShareLinkOutput{
+		ID:    id.String(),
+		Token: token,
+	}
+}
+
+// SharedTrip は共有リンクで公開する旅行の情報
+type SharedTrip struct {
+	Name      string
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+// SharedAccommodation は共有リンクで公開する宿泊予約の情報。費用や予約番号、メモは含めない
+type SharedAccommodation struct {
+	Name       string
+	Address    string
+	Timezone   *string
+	CheckInAt  time.Time
+	CheckOutAt time.Time
+}
+
+// SharedActivity は共有リンクで公開する行動の情報。メモは含めず、場所は名前だけを含める
+type SharedActivity struct {
+	Date       time.Time
+	Position   int
+	Title      string
+	PlaceName  *string
+	Timezone   *string
+	StartAt    *time.Time
+	EndAt      *time.Time
+	TravelMode string
+}
+
+// SharedMember は共有リンクで公開するメンバーの情報。メールアドレスは含めない
+type SharedMember struct {
+	Username string
+	Role     string
+}
+
+// SharedJournalEntry は共有リンクで公開する日記の情報。本文はサニタイズ済みの HTML だけを含め、場所は名前だけを含める
+type SharedJournalEntry struct {
+	Date      time.Time
+	Title     string
+	BodyHTML  string
+	Mood      string
+	PlaceName *string
+}
+
+type GetSharedTripOutput struct {
+	Trip           *SharedTrip
+	Accommodations []*SharedAccommodation
+	Activities     []*SharedActivity
+	JournalEntries []*SharedJournalEntry
+	Members        []*SharedMember
+}
+
+func NewGetSharedTripOutput(t *trip.Trip, accommodations []*accommodation.Accommodation, activities []*itinerary.Activity, entries []*journal.Entry, render func(markdown string) string, members []*SharedMember) *GetSharedTripOutput {
+	sharedTrip := &SharedTrip{Name: t.Name()}
+	if period := t.Period(); period != nil {
+		startDate := period.StartDate()
+		endDate := period.EndDate()
+		sharedTrip.StartDate = &startDate
+		sharedTrip.EndDate = &endDate
+	}
+
+	sharedAccommodations := make([]*SharedAccommodation, 0, len(accommodations))
+	for _, a := range accommodations {
+		sharedAccommodations = append(sharedAccommodations, &SharedAccommodation{
+			Name:       a.Name(),
+			Address:    a.Address(),
+			Timezone:   timezoneName(a.Timezone()),
+			CheckInAt:  a.Stay().CheckInAt(),
+			CheckOutAt: a.Stay().CheckOutAt(),
+		})
+	}
+
+	sharedActivities := make([]*SharedActivity, 0, len(activities))
+	for _, a := range activities {
+		sharedActivity := &SharedActivity{
+			Date:       a.Date(),
+			Position:   a.Position(),
+			Title:      a.Title(),
+			StartAt:    a.StartAt(),
+			EndAt:      a.EndAt(),
+			TravelMode: a.TravelMode().String(),
+		}
+		if place := a.Place(); place != nil {
+			name := place.Name()
+			sharedActivity.PlaceName = &name
+		}
+		if tz := a.Timezone(); tz != nil {
+			name := tz.Name()
+			sharedActivity.Timezone = &name
+		}
+		sharedActivities = append(sharedActivities, sharedActivity)
+	}
+
+	sharedEntries := make([]*SharedJournalEntry, 0, len(entries))
+	for _, e := range entries {
+		sharedEntry := &SharedJournalEntry{
+			Date:     e.Date(),
+			Title:    e.Title(),
+			BodyHTML: render(e.Body()),
+			Mood:     e.Mood().String(),
+		}
+		if place := e.Place(); place != nil {
+			name := place.Name()
+			sharedEntry.PlaceName = &name
+		}
+		sharedEntries = append(sharedEntries, sharedEntry)
+	}
+
+	return &GetSharedTripOutput{
+		Trip:           sharedTrip,
+		Accommodations: sharedAccommodations,
+		Activities:     sharedActivities,
+		JournalEntries: sharedEntries,
+		Members:        members,
+	}
+}
@@ -0,0 +1,165 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/triptemplate"
+)
+
+type Template struct {
+	ID          string
+	OwnerID     string
+	Name        string
+	Description string
+	Public      bool
+	// Days は旅行の日数。期間未定の旅行から作成した場合は nil
+	Days           *int
+	Accommodations []*TemplateAccommodation
+	// Budget は予算。予算のない旅行から作成した場合は nil
+	Budget     *TemplateBudget
+	Checklists []*TemplateChecklist
+	Activities []*TemplateActivity
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TemplateAccommodation は雛形の宿泊予約。チェックイン・チェックアウトは基準日の 0 時からの経過時間で表す
+type TemplateAccommodation struct {
+	Name           string
+	Address        string
+	CheckInOffset  time.Duration
+	CheckOutOffset time.Duration
+	CostAmount     int64
+	CostCurrency   string
+	Notes          string
+}
+
+type TemplateBudget struct {
+	Currency       string
+	CategoryLimits map[string]int64
+}
+
+// TemplateChecklist は雛形のチェックリスト。項目は名前と数量のみを持つ
+type TemplateChecklist struct {
+	Name  string
+	Kind  string
+	Items []*TemplateChecklistItem
+}
+
+type TemplateChecklistItem struct {
+	Name     string
+	Quantity int
+}
+
+// TemplateActivity は雛形の行動。Day は基準日を 0 日目とした日数、開始・終了日時は基準日の 0 時からの経過時間で表す
+type TemplateActivity struct {
+	Day         int
+	Position    int
+	Title       string
+	Place       *ActivityPlace
+	Timezone    *string
+	StartOffset *time.Duration
+	EndOffset   *time.Duration
+	TravelMode  string
+	Notes       string
+}
+
+type GetTemplateOutput struct {
+	Template *Template
+}
+
+func NewGetTemplateOutput(t *triptemplate.Template) *GetTemplateOutput {
+	return &GetTemplateOutput{
+		Template: mapToTemplate(t),
+	}
+}
+
+type ListTemplateOutput struct {
+	Templates []*Template
+}
+
+func NewListTemplateOutput(templates []*triptemplate.Template) *ListTemplateOutput {
+	formatted := make([]*Template, 0, len(templates))
+	for _, t := range templates {
+		formatted = append(formatted, mapToTemplate(t))
+	}
+
+	return &ListTemplateOutput{
+		Templates: formatted,
+	}
+}
+
+type CreateTemplateOutput struct {
+	ID string
+}
+
+func NewCreateTemplateOutput(id triptemplate.TemplateID) *CreateTemplateOutput {
+	return &CreateTemplateOutput{
+		ID: id.String(),
+	}
+}
+
+func mapToTemplate(t *triptemplate.Template) *Template {
+	content := t.Content()
+
+	accommodations := make([]*TemplateAccommodation, 0, len(content.Accommodations()))
+	for _, item := range content.Accommodations() {
+		accommodations = append(accommodations, &TemplateAccommodation{
+			Name:           item.Name(),
+			Address:        item.Address(),
+			CheckInOffset:  item.CheckInOffset(),
+			CheckOutOffset: item.CheckOutOffset(),
+			CostAmount:     item.Cost().Amount(),
+			CostCurrency:   item.Cost().Currency(),
+			Notes:          item.Notes(),
+		})
+	}
+
+	var b *TemplateBudget
+	if item := content.Budget(); item != nil {
+		limits := make(map[string]int64)
+		for category, limit := range item.CategoryLimits() {
+			limits[category.String()] = limit
+		}
+		b = &TemplateBudget{Currency: item.Currency(), CategoryLimits: limits}
+	}
+
+	checklists := make([]*TemplateChecklist, 0, len(content.Checklists()))
+	for _, item := range content.Checklists() {
+		entries := make([]*TemplateChecklistItem, 0, len(item.Entries()))
+		for _, entry := range item.Entries() {
+			entries = append(entries, &TemplateChecklistItem{Name: entry.Name(), Quantity: entry.Quantity()})
+		}
+		checklists = append(checklists, &TemplateChecklist{Name: item.Name(), Kind: item.Kind().String(), Items: entries})
+	}
+
+	activities := make([]*TemplateActivity, 0, len(content.Activities()))
+	for _, item := range content.Activities() {
+		activities = append(activities, &TemplateActivity{
+			Day:         item.Day(),
+			Position:    item.Position(),
+			Title:       item.Title(),
+			Place:       mapToActivityPlace(item.Place()),
+			Timezone:    timezoneName(item.Timezone()),
+			StartOffset: item.StartOffset(),
+			EndOffset:   item.EndOffset(),
+			TravelMode:  item.TravelMode().String(),
+			Notes:       item.Notes(),
+		})
+	}
+
+	return &Template{
+		ID:             t.ID().String(),
+		OwnerID:        t.OwnerID().String(),
+		Name:           t.Name(),
+		Description:    t.Description(),
+		Public:         t.IsPublic(),
+		Days:           content.Days(),
+		Accommodations: accommodations,
+		Budget:         b,
+		Checklists:     checklists,
+		Activities:     activities,
+		CreatedAt:      t.CreatedAt(),
+		UpdatedAt:      t.UpdatedAt(),
+	}
+}
@@ -0,0 +1,43 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/timeline"
+)
+
+// TimelineItem は時系列の 1 つの項目。StartAt は Timezone、EndAt は EndTimezone の現地時刻で、
+// EndTimezone は移動では到着地のもの、それ以外では Timezone と同じ。終日の項目は現地の日付が始まる瞬間を StartAt とし、EndAt は nil
+type TimelineItem struct {
+	Kind        string
+	ID          string
+	Title       string
+	Timezone    string
+	EndTimezone string
+	Date        time.Time
+	AllDay      bool
+	StartAt     time.Time
+	EndAt       *time.Time
+}
+
+type GetTimelineOutput struct {
+	Items []TimelineItem
+}
+
+func NewGetTimelineOutput(items []timeline.Item) *GetTimelineOutput {
+	result := make([]TimelineItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, TimelineItem{
+			Kind:        item.Kind().String(),
+			ID:          item.ID(),
+			Title:       item.Title(),
+			Timezone:    item.Timezone().Name(),
+			EndTimezone: item.EndTimezone().Name(),
+			Date:        item.Date(),
+			AllDay:      item.AllDay(),
+			StartAt:     item.StartAt(),
+			EndAt:       item.EndAt(),
+		})
+	}
+	return &GetTimelineOutput{Items: result}
+}
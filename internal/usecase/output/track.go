@@ -0,0 +1,125 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/track"
+)
+
+type Track struct {
+	ID         string
+	TripID     string
+	ActivityID *string
+	Name       string
+	Stats      TrackStats
+	UploadedBy string
+	CreatedAt  time.Time
+}
+
+// TrackStats はトラックの統計。距離と標高はメートル、速さは m/s で表す
+type TrackStats struct {
+	DistanceMeters      float64
+	MovingDuration      time.Duration
+	ElapsedDuration     time.Duration
+	ElevationGainMeters float64
+	ElevationLossMeters float64
+	MaxSpeed            float64
+	StartedAt           *time.Time
+	EndedAt             *time.Time
+	Bounds              TrackBounds
+	PointCount          int
+}
+
+type TrackBounds struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+}
+
+type TrackPoint struct {
+	Latitude   float64
+	Longitude  float64
+	Elevation  *float64
+	RecordedAt *time.Time
+}
+
+// GetTrackOutput はトラックの取得時の出力。Geometry は間引いた軌跡をセグメントごとに並べたもの
+type GetTrackOutput struct {
+	Track    *Track
+	Geometry [][]TrackPoint
+}
+
+func NewGetTrackOutput(t *track.Track) *GetTrackOutput {
+	geometry := make([][]TrackPoint, 0, len(t.Geometry()))
+	for _, segment := range t.Geometry() {
+		points := make([]TrackPoint, 0, len(segment))
+		for _, p := range segment {
+			points = append(points, TrackPoint{
+				Latitude:   p.Coordinate().Latitude(),
+				Longitude:  p.Coordinate().Longitude(),
+				Elevation:  p.Elevation(),
+				RecordedAt: p.RecordedAt(),
+			})
+		}
+		geometry = append(geometry, points)
+	}
+	return &GetTrackOutput{
+		Track:    mapToTrack(t),
+		Geometry: geometry,
+	}
+}
+
+type ListTrackOutput struct {
+	Tracks []*Track
+}
+
+func NewListTrackOutput(tracks []*track.Track) *ListTrackOutput {
+	formatted := make([]*Track, 0, len(tracks))
+	for _, t := range tracks {
+		formatted = append(formatted, mapToTrack(t))
+	}
+	return &ListTrackOutput{
+		Tracks: formatted,
+	}
+}
+
+type UploadTrackOutput struct {
+	Track *Track
+}
+
+func NewUploadTrackOutput(t *track.Track) *UploadTrackOutput {
+	return &UploadTrackOutput{
+		Track: mapToTrack(t),
+	}
+}
+
+func mapToTrack(t *track.Track) *Track {
+	s := t.Stats()
+	b := s.Bounds()
+	return &Track{
+		ID:         t.ID().String(),
+		TripID:     t.TripID().String(),
+		ActivityID: t.ActivityID(),
+		Name:       t.Name(),
+		Stats: TrackStats{
+			DistanceMeters:      s.DistanceMeters(),
+			MovingDuration:      s.MovingDuration(),
+			ElapsedDuration:     s.ElapsedDuration(),
+			ElevationGainMeters: s.ElevationGainMeters(),
+			ElevationLossMeters: s.ElevationLossMeters(),
+			MaxSpeed:            s.MaxSpeed(),
+			StartedAt:           s.StartedAt(),
+			EndedAt:             s.EndedAt(),
+			Bounds: TrackBounds{
+				MinLatitude:  b.MinLatitude(),
+				MinLongitude: b.MinLongitude(),
+				MaxLatitude:  b.MaxLatitude(),
+				MaxLongitude: b.MaxLongitude(),
+			},
+			PointCount: s.PointCount(),
+		},
+		UploadedBy: t.UploadedBy().String(),
+		CreatedAt:  t.CreatedAt(),
+	}
+}
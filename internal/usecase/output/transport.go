@@ -0,0 +1,76 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/transport"
+)
+
+// TransportLeg は移動。出発地・到着地のタイムゾーンは場所の Timezone で、決まっていない場合は nil
+type TransportLeg struct {
+	ID          string
+	TripID      string
+	Mode        string
+	Origin      *ActivityPlace
+	Destination *ActivityPlace
+	DepartureAt time.Time
+	ArrivalAt   time.Time
+	Carrier     string
+	Number      string
+	Notes       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type GetTransportLegOutput struct {
+	TransportLeg *TransportLeg
+}
+
+func NewGetTransportLegOutput(l *transport.Leg) *GetTransportLegOutput {
+	return &GetTransportLegOutput{
+		TransportLeg: mapToTransportLeg(l),
+	}
+}
+
+type ListTransportLegOutput struct {
+	TransportLegs []*TransportLeg
+}
+
+func NewListTransportLegOutput(legs []*transport.Leg) *ListTransportLegOutput {
+	formatted := make([]*TransportLeg, 0, len(legs))
+	for _, l := range legs {
+		formatted = append(formatted, mapToTransportLeg(l))
+	}
+
+	return &ListTransportLegOutput{
+		TransportLegs: formatted,
+	}
+}
+
+type CreateTransportLegOutput struct {
+	ID string
+}
+
+func NewCreateTransportLegOutput(id transport.LegID) *CreateTransportLegOutput {
+	return &CreateTransportLegOutput{
+		ID: id.String(),
+	}
+}
+
+func mapToTransportLeg(l *transport.Leg) *TransportLeg {
+	origin, destination := l.Origin(), l.Destination()
+	return &TransportLeg{
+		ID:          l.ID().String(),
+		TripID:      l.TripID().String(),
+		Mode:        l.Mode().String(),
+		Origin:      mapToActivityPlace(&origin),
+		Destination: mapToActivityPlace(&destination),
+		DepartureAt: l.DepartureAt(),
+		ArrivalAt:   l.ArrivalAt(),
+		Carrier:     l.Carrier(),
+		Number:      l.Number(),
+		Notes:       l.Notes(),
+		CreatedAt:   l.CreatedAt(),
+		UpdatedAt:   l.UpdatedAt(),
+	}
+}
@@ -0,0 +1,39 @@
+package output
+
+import (
+	"time"
+
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// TrashedTrip はゴミ箱の旅行。PurgeAt を過ぎると完全に削除される
+type TrashedTrip struct {
+	Trip      *Trip
+	TrashedAt time.Time
+	PurgeAt   time.Time
+}
+
+type ListTrashedTripOutput struct {
+	Trips []*TrashedTrip
+}
+
+func NewListTrashedTripOutput(trashed []*trip.TrashedTrip, retentionDays int) *ListTrashedTripOutput {
+	formatted := make([]*TrashedTrip, 0, len(trashed))
+	for _, t := range trashed {
+		formatted = append(formatted, &TrashedTrip{
+			Trip:      mapToTrip(t.Trip()),
+			TrashedAt: t.TrashedAt(),
+			PurgeAt:   t.PurgeAt(retentionDays),
+		})
+	}
+
+	return &ListTrashedTripOutput{
+		Trips: formatted,
+	}
+}
+
+// PurgeExpiredTripOutput は保持期間を過ぎた旅行の削除結果。Cutoff より前にゴミ箱に移された旅行が削除の対象になる
+type PurgeExpiredTripOutput struct {
+	Count  int64
+	Cutoff time.Time
+}
@@ -3,38 +3,79 @@ package output
 import (
 	"time"
 
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	"github.com/hata0/travel-api/internal/domain/shared/pagination"
 	"github.com/hata0/travel-api/internal/domain/trip"
 )
 
+// Trip の HomeCurrency は旅行の基準通貨で、設定されていない場合は nil
 type Trip struct {
-	ID        string
-	Name      string
+	ID           string
+	Name         string
+	StartDate    *time.Time
+	EndDate      *time.Time
+	HomeCurrency *string
+	// Version は楽観的排他制御に使用するバージョン。ETag として返す
+	Version   int64
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
 type GetTripOutput struct {
 	Trip *Trip
+	// ChecklistProgress は旅行のチェックリスト全体の進捗
+	ChecklistProgress ChecklistProgress
+	// Checklists はチェックリストごとの進捗
+	Checklists []*ChecklistSummary
 }
 
-func NewGetTripOutput(trip *trip.Trip) *GetTripOutput {
+// ChecklistSummary は旅行の取得結果に含めるチェックリストの概要
+type ChecklistSummary struct {
+	ID       string
+	Name     string
+	Kind     string
+	Progress ChecklistProgress
+}
+
+func NewGetTripOutput(trip *trip.Trip, checklists []*checklist.Checklist) *GetTripOutput {
+	summaries := make([]*ChecklistSummary, 0, len(checklists))
+	for _, c := range checklists {
+		summaries = append(summaries, &ChecklistSummary{
+			ID:       c.ID().String(),
+			Name:     c.Name(),
+			Kind:     c.Kind().String(),
+			Progress: mapToChecklistProgress(c.Progress()),
+		})
+	}
+
 	return &GetTripOutput{
-		Trip: mapToTrip(trip),
+		Trip:              mapToTrip(trip),
+		ChecklistProgress: mapToChecklistProgress(checklist.TotalProgress(checklists)),
+		Checklists:        summaries,
 	}
 }
 
 type ListTripOutput struct {
 	Trips []*Trip
+	// NextCursor は次のページを取得するためのカーソル。最後のページの場合は nil
+	NextCursor *string
 }
 
-func NewListTripOutput(trips []*trip.Trip) *ListTripOutput {
-	formattedTrips := make([]*Trip, len(trips))
-	for _, trip := range trips {
+func NewListTripOutput(page *pagination.Page[*trip.Trip]) *ListTripOutput {
+	formattedTrips := make([]*Trip, 0, len(page.Items))
+	for _, trip := range page.Items {
 		formattedTrips = append(formattedTrips, mapToTrip(trip))
 	}
 
+	var nextCursor *string
+	if page.NextCursor != nil {
+		encoded := page.NextCursor.Encode()
+		nextCursor = &encoded
+	}
+
 	return &ListTripOutput{
-		Trips: formattedTrips,
+		Trips:      formattedTrips,
+		NextCursor: nextCursor,
 	}
 }
 
@@ -49,10 +90,21 @@ func NewCreateTripOutput(id trip.TripID) *CreateTripOutput {
 }
 
 func mapToTrip(trip *trip.Trip) *Trip {
-	return &Trip{
+	t := &Trip{
 		ID:        trip.ID().String(),
 		Name:      trip.Name(),
+		Version:   trip.Version(),
 		CreatedAt: trip.CreatedAt(),
 		UpdatedAt: trip.UpdatedAt(),
 	}
+	if period := trip.Period(); period != nil {
+		startDate := period.StartDate()
+		endDate := period.EndDate()
+		t.StartDate = &startDate
+		t.EndDate = &endDate
+	}
+	if homeCurrency := trip.HomeCurrency(); homeCurrency != "" {
+		t.HomeCurrency = &homeCurrency
+	}
+	return t
 }
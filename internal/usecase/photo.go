@@ -0,0 +1,325 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/photo"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/photo.go github.com/hata0/travel-api/internal/usecase PhotoUsecase
+type PhotoUsecase interface {
+	Get(ctx context.Context, tripID, id string) (*output.GetPhotoOutput, error)
+	List(ctx context.Context, tripID string) (*output.ListPhotoOutput, error)
+	Upload(ctx context.Context, in input.UploadPhotoInput) (*output.UploadPhotoOutput, error)
+	Download(ctx context.Context, tripID, id string) (*output.DownloadPhotoOutput, error)
+	DownloadThumbnail(ctx context.Context, tripID, id string) (*output.DownloadPhotoOutput, error)
+	Delete(ctx context.Context, tripID, id string) error
+}
+
+type PhotoInteractor struct {
+	photoRepository   photo.PhotoRepository
+	tripRepository    trip.TripRepository
+	journalRepository journal.EntryRepository
+	authorizer        tripAuthorizer
+	blobStore         service.BlobStore
+	photoProcessor    service.PhotoProcessor
+	timeService       service.TimeService
+	idService         service.IDService
+	maxSizeBytes      int64
+}
+
+// NewPhotoInteractor は写真のユースケースを作成する。maxSizeBytes はアップロードできる写真1枚あたりの最大サイズ
+func NewPhotoInteractor(
+	photoRepository photo.PhotoRepository,
+	tripRepository trip.TripRepository,
+	journalRepository journal.EntryRepository,
+	memberRepository membership.MemberRepository,
+	blobStore service.BlobStore,
+	photoProcessor service.PhotoProcessor,
+	timeService service.TimeService,
+	idService service.IDService,
+	maxSizeBytes int64,
+) PhotoUsecase {
+	return &PhotoInteractor{
+		photoRepository:   photoRepository,
+		tripRepository:    tripRepository,
+		journalRepository: journalRepository,
+		authorizer:        newTripAuthorizer(memberRepository),
+		blobStore:         blobStore,
+		photoProcessor:    photoProcessor,
+		timeService:       timeService,
+		idService:         idService,
+		maxSizeBytes:      maxSizeBytes,
+	}
+}
+
+// Get は旅行に紐づく指定されたIDの写真の情報を、旅程のどの日・どの場所の写真かの推定とともに取得する
+func (i *PhotoInteractor) Get(ctx context.Context, tripID, id string) (*output.GetPhotoOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	p, err := i.findInTrip(ctx, trip.NewTripID(tripID), photo.NewPhotoID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions, err := i.suggest(ctx, p.TripID(), []*photo.Photo{p})
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetPhotoOutput(p, suggestions[0]), nil
+}
+
+// List は旅行のアルバムとして、写真を撮影日時の順に旅程のどの日・どの場所の写真かの推定とともに取得する
+func (i *PhotoInteractor) List(ctx context.Context, tripID string) (*output.ListPhotoOutput, error) {
+	id := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorize(ctx, id, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	photos, err := i.photoRepository.FindByTripID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list photos", apperr.WithCause(err))
+	}
+
+	suggestions, err := i.suggest(ctx, id, photos)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewListPhotoOutput(photos, suggestions), nil
+}
+
+// Upload は写真の EXIF から撮影日時と撮影位置を読み取り、写真とサムネイルを BlobStore に保存して旅行のアルバムに追加する。
+// 写真の形式は拡張子や申告された Content-Type ではなく先頭のバイト列から判定する
+func (i *PhotoInteractor) Upload(ctx context.Context, in input.UploadPhotoInput) (*output.UploadPhotoOutput, error) {
+	tripID := trip.NewTripID(in.TripID)
+
+	m, err := i.authorizer.authorize(ctx, tripID, membership.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case in.Size <= 0:
+		return nil, photo.NewEmptyFileError()
+	case in.Size > i.maxSizeBytes:
+		return nil, photo.NewFileTooLargeError()
+	}
+
+	// EXIF の読み取りとサムネイルの生成には全体が必要なため、サイズの上限を確認した上でメモリに読み込む
+	content := make([]byte, in.Size)
+	if _, err := io.ReadFull(in.Content, content); err != nil {
+		return nil, apperr.NewInternalError("Failed to read photo content", apperr.WithCause(err))
+	}
+
+	contentType, err := photo.DetectContentType(content)
+	if err != nil {
+		return nil, err
+	}
+
+	processed, err := i.photoProcessor.Process(content, contentType, in.StripLocation)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidImage):
+			return nil, photo.NewInvalidImageError(apperr.WithCause(err))
+		case errors.Is(err, service.ErrImageTooLarge):
+			return nil, photo.NewImageTooLargeError(apperr.WithCause(err))
+		}
+		return nil, apperr.NewInternalError("Failed to process photo", apperr.WithCause(err))
+	}
+
+	metadata := processed.Metadata
+	var coordinate *geo.Coordinate
+	if metadata.Latitude != nil && metadata.Longitude != nil {
+		// 範囲外の座標は EXIF が壊れているものとみなし、撮影位置は不明として扱う
+		if c, err := geo.NewCoordinate(*metadata.Latitude, *metadata.Longitude); err == nil {
+			coordinate = &c
+		}
+	}
+
+	id := photo.NewPhotoID(i.idService.Generate())
+	p := photo.NewPhoto(
+		id,
+		tripID,
+		attachment.SanitizeFilename(in.Filename),
+		contentType,
+		int64(len(processed.Content)),
+		metadata.Width,
+		metadata.Height,
+		metadata.CapturedAt,
+		coordinate,
+		in.StripLocation,
+		m.UserID(),
+		i.timeService.Now(),
+	)
+
+	if err := i.blobStore.Put(ctx, p.StorageKey(), bytes.NewReader(processed.Content), p.Size(), contentType); err != nil {
+		return nil, apperr.NewInternalError("Failed to store photo content", apperr.WithCause(err))
+	}
+	if err := i.blobStore.Put(ctx, p.ThumbnailKey(), bytes.NewReader(processed.Thumbnail), int64(len(processed.Thumbnail)), photo.ThumbnailContentType); err != nil {
+		_ = i.blobStore.Delete(ctx, p.StorageKey())
+		return nil, apperr.NewInternalError("Failed to store photo thumbnail", apperr.WithCause(err))
+	}
+
+	if err := i.photoRepository.Create(ctx, p); err != nil {
+		// 参照されなくなったファイルを残さないよう削除する。削除の失敗は元のエラーを優先して無視する
+		_ = i.blobStore.Delete(ctx, p.StorageKey())
+		_ = i.blobStore.Delete(ctx, p.ThumbnailKey())
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to create photo", apperr.WithCause(err))
+	}
+
+	suggestions, err := i.suggest(ctx, tripID, []*photo.Photo{p})
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewUploadPhotoOutput(p, suggestions[0]), nil
+}
+
+// Download は旅行に紐づく指定されたIDの写真の情報と中身を取得する
+func (i *PhotoInteractor) Download(ctx context.Context, tripID, id string) (*output.DownloadPhotoOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	p, err := i.findInTrip(ctx, trip.NewTripID(tripID), photo.NewPhotoID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := i.getBlob(ctx, p.StorageKey())
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewDownloadPhotoOutput(p, p.ContentType(), p.Size(), content), nil
+}
+
+// DownloadThumbnail は旅行に紐づく指定されたIDの写真のサムネイルを取得する
+func (i *PhotoInteractor) DownloadThumbnail(ctx context.Context, tripID, id string) (*output.DownloadPhotoOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	p, err := i.findInTrip(ctx, trip.NewTripID(tripID), photo.NewPhotoID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := i.getBlob(ctx, p.ThumbnailKey())
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewDownloadPhotoOutput(p, photo.ThumbnailContentType, -1, content), nil
+}
+
+// Delete は旅行に紐づく指定されたIDの写真を、BlobStore に保存した写真とサムネイルとともに削除する
+func (i *PhotoInteractor) Delete(ctx context.Context, tripID, id string) error {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleEditor); err != nil {
+		return err
+	}
+
+	p, err := i.findInTrip(ctx, trip.NewTripID(tripID), photo.NewPhotoID(id))
+	if err != nil {
+		return err
+	}
+
+	// 中身を先に消すと、レコードの削除に失敗したときに中身のない写真が残るため、レコードから削除する
+	if err := i.photoRepository.Delete(ctx, p.ID()); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to delete photo", apperr.WithCause(err))
+	}
+
+	for _, key := range []string{p.StorageKey(), p.ThumbnailKey()} {
+		if err := i.blobStore.Delete(ctx, key); err != nil {
+			return apperr.NewInternalError("Failed to delete photo content", apperr.WithCause(err))
+		}
+	}
+
+	return nil
+}
+
+// suggest は旅行の期間と日記の場所から、写真ごとに旅程のどの日・どの場所の写真かを推定する。
+// 推定結果は photos と同じ順に並ぶ
+func (i *PhotoInteractor) suggest(ctx context.Context, tripID trip.TripID, photos []*photo.Photo) ([]photo.Suggestion, error) {
+	if len(photos) == 0 {
+		return nil, nil
+	}
+
+	t, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+
+	entries, err := i.journalRepository.FindByTripID(ctx, tripID, nil)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list journal entries", apperr.WithCause(err))
+	}
+
+	suggestions := make([]photo.Suggestion, 0, len(photos))
+	for _, p := range photos {
+		suggestions = append(suggestions, photo.Suggest(p, t, entries))
+	}
+	return suggestions, nil
+}
+
+// getBlob は BlobStore から写真またはサムネイルを読み込む
+func (i *PhotoInteractor) getBlob(ctx context.Context, key string) (io.ReadCloser, error) {
+	content, err := i.blobStore.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, service.ErrBlobNotFound) {
+			return nil, apperr.NewInternalError("Photo content is missing from the blob store", apperr.WithCause(err))
+		}
+		return nil, apperr.NewInternalError("Failed to read photo content", apperr.WithCause(err))
+	}
+	return content, nil
+}
+
+// findInTrip は写真を取得し、指定された旅行に属していることを確認する。
+// 別の旅行の写真は存在しないものとして扱う
+func (i *PhotoInteractor) findInTrip(ctx context.Context, tripID trip.TripID, id photo.PhotoID) (*photo.Photo, error) {
+	p, err := i.photoRepository.FindByID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get photo", apperr.WithCause(err))
+	}
+
+	if !p.TripID().Equals(tripID) {
+		return nil, photo.NewPhotoNotFoundError()
+	}
+
+	return p, nil
+}
@@ -0,0 +1,723 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	mock_journal "github.com/hata0/travel-api/internal/domain/journal/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/photo"
+	mock_photo "github.com/hata0/travel-api/internal/domain/photo/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/actor"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+// photoMaxSize はテストで使うアップロードの最大サイズ
+const photoMaxSize = 1024
+
+var (
+	photoFixedTime = time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	photoTripID    = trip.NewTripID("trip-id")
+	// photoJPEG は JPEG として判定される最小限の内容
+	photoJPEG = []byte("\xff\xd8\xff\xe1\x00\x10Exif\x00\x00photo")
+	// photoKinkakuji は日記に記録した場所と写真の撮影位置に使う座標
+	photoKinkakuji = mustPhotoTestCoordinate(35.0394, 135.7292)
+)
+
+func mustPhotoTestCoordinate(latitude, longitude float64) geo.Coordinate {
+	c, err := geo.NewCoordinate(latitude, longitude)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// newPhotoTestPhoto は旅行の2日目に金閣寺で撮影した写真を生成する
+func newPhotoTestPhoto(id string, tripID trip.TripID, locationStripped bool) *photo.Photo {
+	capturedAt := time.Date(2024, 4, 2, 10, 30, 0, 0, time.UTC)
+	coordinate := photoKinkakuji
+	return photo.NewPhoto(
+		photo.NewPhotoID(id),
+		tripID,
+		"kinkakuji.jpg",
+		"image/jpeg",
+		int64(len(photoJPEG)),
+		4032,
+		3024,
+		&capturedAt,
+		&coordinate,
+		locationStripped,
+		testActorID,
+		photoFixedTime,
+	)
+}
+
+// newPhotoSuggestionSources は推定に使う旅行（4/1〜4/3）と、2日目に金閣寺を訪れた日記を生成する
+func newPhotoSuggestionSources() (*trip.Trip, []*journal.Entry) {
+	period, _ := trip.NewPeriod(photoFixedTime, photoFixedTime.AddDate(0, 0, 2))
+	t := trip.NewTrip(photoTripID, "京都旅行", period, "", photoFixedTime, photoFixedTime)
+
+	place, _ := geo.NewPlace("金閣寺", &photoKinkakuji, nil)
+	entry := journal.NewEntry(journal.NewEntryID("entry-id"), photoTripID, photoFixedTime.AddDate(0, 0, 1), "", "", journal.MoodGreat, &place, photoFixedTime, photoFixedTime)
+	return t, []*journal.Entry{entry}
+}
+
+// expectPhotoSuggestionSources は推定に使う旅行と日記として newPhotoSuggestionSources の内容を返すよう設定する
+func expectPhotoSuggestionSources(tripRepo *mock_trip.MockTripRepository, journalRepo *mock_journal.MockEntryRepository) {
+	t, entries := newPhotoSuggestionSources()
+	tripRepo.EXPECT().FindByID(gomock.Any(), photoTripID).Return(t, nil)
+	journalRepo.EXPECT().FindByTripID(gomock.Any(), photoTripID, nil).Return(entries, nil)
+}
+
+func newPhotoUploadInput(content []byte, stripLocation bool) input.UploadPhotoInput {
+	return input.UploadPhotoInput{
+		TripID:        "trip-id",
+		Filename:      "../kinkakuji.jpg",
+		Content:       bytes.NewReader(content),
+		Size:          int64(len(content)),
+		StripLocation: stripLocation,
+	}
+}
+
+func TestPhotoInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhotoRepo := mock_photo.NewMockPhotoRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockBlobStore := mock_service.NewMockBlobStore(ctrl)
+	mockPhotoProcessor := mock_service.NewMockPhotoProcessor(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewPhotoInteractor(mockPhotoRepo, mockTripRepo, mockJournalRepo, mockMemberRepo, mockBlobStore, mockPhotoProcessor, mockTimeService, mockIDService, photoMaxSize)
+
+	suggestionTrip, suggestionEntries := newPhotoSuggestionSources()
+	p := newPhotoTestPhoto("photo-id", photoTripID, false)
+	stripped := newPhotoTestPhoto("photo-id", photoTripID, true)
+	otherTrips := newPhotoTestPhoto("photo-id", trip.NewTripID("other-trip-id"), false)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.GetPhotoOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は写真の情報を旅程の日と場所の推定とともに取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByID(gomock.Any(), p.ID()).Return(p, nil)
+				expectPhotoSuggestionSources(mockTripRepo, mockJournalRepo)
+			},
+			want: output.NewGetPhotoOutput(p, photo.Suggest(p, suggestionTrip, suggestionEntries)),
+		},
+		{
+			name: "正常系: 位置情報を削除した写真は撮影位置を返さない",
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByID(gomock.Any(), stripped.ID()).Return(stripped, nil)
+				expectPhotoSuggestionSources(mockTripRepo, mockJournalRepo)
+			},
+			want: output.NewGetPhotoOutput(stripped, photo.Suggest(stripped, suggestionTrip, suggestionEntries)),
+		},
+		{
+			name: "異常系: 別の旅行の写真は NotFound になる",
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByID(gomock.Any(), p.ID()).Return(otherTrips, nil)
+			},
+			wantErr: photo.NewPhotoNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByID(gomock.Any(), p.ID()).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get photo", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Get(ctx, photoTripID.String(), "photo-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPhotoInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhotoRepo := mock_photo.NewMockPhotoRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockBlobStore := mock_service.NewMockBlobStore(ctrl)
+	mockPhotoProcessor := mock_service.NewMockPhotoProcessor(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewPhotoInteractor(mockPhotoRepo, mockTripRepo, mockJournalRepo, mockMemberRepo, mockBlobStore, mockPhotoProcessor, mockTimeService, mockIDService, photoMaxSize)
+
+	suggestionTrip, suggestionEntries := newPhotoSuggestionSources()
+	kinkakuji := newPhotoTestPhoto("photo-1", photoTripID, false)
+	// 撮影日時も撮影位置も分からない写真は推定しない
+	scan := photo.NewPhoto(photo.NewPhotoID("photo-2"), photoTripID, "scan.png", "image/png", 10, 1, 1, nil, nil, false, testActorID, photoFixedTime)
+	photos := []*photo.Photo{kinkakuji, scan}
+	outsiderID := user.NewUserID("outsider-user-id")
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.ListPhotoOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は旅行のアルバムを推定とともに取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByTripID(gomock.Any(), photoTripID).Return(photos, nil)
+				expectPhotoSuggestionSources(mockTripRepo, mockJournalRepo)
+			},
+			want: output.NewListPhotoOutput(photos, []photo.Suggestion{
+				photo.Suggest(kinkakuji, suggestionTrip, suggestionEntries),
+				{},
+			}),
+		},
+		{
+			name: "正常系: 写真がない場合は旅行と日記を取得しない",
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByTripID(gomock.Any(), photoTripID).Return(nil, nil)
+			},
+			want: output.NewListPhotoOutput(nil, nil),
+		},
+		{
+			name: "異常系: メンバーでない旅行のアルバムは取得できない",
+			ctx:  actor.WithUserID(context.Background(), outsiderID),
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), photoTripID, outsiderID).Return(nil, membership.NewMemberNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByTripID(gomock.Any(), photoTripID).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list photos", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name: "異常系: 日記の取得に失敗",
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByTripID(gomock.Any(), photoTripID).Return([]*photo.Photo{kinkakuji}, nil)
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), photoTripID).Return(suggestionTrip, nil)
+				mockJournalRepo.EXPECT().FindByTripID(gomock.Any(), photoTripID, nil).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list journal entries", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.List(ctx, photoTripID.String())
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPhotoInteractor_Upload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhotoRepo := mock_photo.NewMockPhotoRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockBlobStore := mock_service.NewMockBlobStore(ctrl)
+	mockPhotoProcessor := mock_service.NewMockPhotoProcessor(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewPhotoInteractor(mockPhotoRepo, mockTripRepo, mockJournalRepo, mockMemberRepo, mockBlobStore, mockPhotoProcessor, mockTimeService, mockIDService, photoMaxSize)
+
+	suggestionTrip, suggestionEntries := newPhotoSuggestionSources()
+	photoID := photo.NewPhotoID("photo-id")
+	key := photo.StorageKey(photoTripID, photoID)
+	thumbnailKey := photo.ThumbnailKey(photoTripID, photoID)
+	capturedAt := time.Date(2024, 4, 2, 10, 30, 0, 0, time.UTC)
+	latitude, longitude := 35.0395, 135.7293
+	coordinate := mustPhotoTestCoordinate(latitude, longitude)
+	strippedJPEG := []byte("\xff\xd8\xff\xe1stripped")
+	tooLarge := append(append([]byte(nil), photoJPEG...), make([]byte, photoMaxSize)...)
+	webp := []byte("RIFF\x24\x00\x00\x00WEBPVP8 ")
+	newUploadedPhoto := func(content []byte, locationStripped bool) *photo.Photo {
+		return photo.NewPhoto(photoID, photoTripID, "kinkakuji.jpg", "image/jpeg", int64(len(content)), 4032, 3024, &capturedAt, &coordinate, locationStripped, testActorID, photoFixedTime)
+	}
+	uploaded := newUploadedPhoto(photoJPEG, false)
+	locationStripped := newUploadedPhoto(strippedJPEG, true)
+	expectProcess := func(stripLocation bool, processed []byte) {
+		mockPhotoProcessor.EXPECT().Process(photoJPEG, "image/jpeg", stripLocation).Return(&service.ProcessedPhoto{
+			Metadata: service.PhotoMetadata{
+				CapturedAt: &capturedAt,
+				Latitude:   &latitude,
+				Longitude:  &longitude,
+				Width:      4032,
+				Height:     3024,
+			},
+			Content:   processed,
+			Thumbnail: []byte("thumbnail"),
+		}, nil)
+	}
+	// stored と storedThumbnail は BlobStore に保存された写真とサムネイルを返す
+	var stored, storedThumbnail func() []byte
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		in    input.UploadPhotoInput
+		setup func()
+		want  *output.UploadPhotoOutput
+		// wantStored は BlobStore に保存されるべき写真
+		wantStored []byte
+		wantErr    error
+	}{
+		{
+			name: "正常系: 写真とサムネイルを保存し、推定とともに返す",
+			in:   newPhotoUploadInput(photoJPEG, false),
+			setup: func() {
+				mockIDService.EXPECT().Generate().Return(photoID.String())
+				mockTimeService.EXPECT().Now().Return(photoFixedTime)
+				expectProcess(false, photoJPEG)
+				stored = expectBlobPut(mockBlobStore, key)
+				storedThumbnail = expectBlobPut(mockBlobStore, thumbnailKey)
+				mockPhotoRepo.EXPECT().Create(gomock.Any(), uploaded).Return(nil)
+				expectPhotoSuggestionSources(mockTripRepo, mockJournalRepo)
+			},
+			want:       output.NewUploadPhotoOutput(uploaded, photo.Suggest(uploaded, suggestionTrip, suggestionEntries)),
+			wantStored: photoJPEG,
+		},
+		{
+			name: "正常系: 位置情報の削除を指定すると取り除いた写真を保存し、推定には撮影位置を使う",
+			in:   newPhotoUploadInput(photoJPEG, true),
+			setup: func() {
+				mockIDService.EXPECT().Generate().Return(photoID.String())
+				mockTimeService.EXPECT().Now().Return(photoFixedTime)
+				expectProcess(true, strippedJPEG)
+				stored = expectBlobPut(mockBlobStore, key)
+				storedThumbnail = expectBlobPut(mockBlobStore, thumbnailKey)
+				mockPhotoRepo.EXPECT().Create(gomock.Any(), locationStripped).Return(nil)
+				expectPhotoSuggestionSources(mockTripRepo, mockJournalRepo)
+			},
+			want:       output.NewUploadPhotoOutput(locationStripped, photo.Suggest(locationStripped, suggestionTrip, suggestionEntries)),
+			wantStored: strippedJPEG,
+		},
+		{
+			name:    "異常系: 閲覧者はアップロードできない",
+			ctx:     viewerCtx,
+			in:      newPhotoUploadInput(photoJPEG, false),
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+		{
+			name:    "異常系: 空のファイル",
+			in:      newPhotoUploadInput(nil, false),
+			setup:   func() {},
+			wantErr: photo.NewEmptyFileError(),
+		},
+		{
+			name:    "異常系: 最大サイズを超えるファイル",
+			in:      newPhotoUploadInput(tooLarge, false),
+			setup:   func() {},
+			wantErr: photo.NewFileTooLargeError(),
+		},
+		{
+			name:    "異常系: デコードできない WebP は拒否される",
+			in:      newPhotoUploadInput(webp, false),
+			setup:   func() {},
+			wantErr: photo.NewUnsupportedContentTypeError(),
+		},
+		{
+			name: "異常系: 壊れた画像",
+			in:   newPhotoUploadInput(photoJPEG, false),
+			setup: func() {
+				mockPhotoProcessor.EXPECT().Process(photoJPEG, "image/jpeg", false).Return(nil, service.ErrInvalidImage)
+			},
+			wantErr: photo.NewInvalidImageError(),
+		},
+		{
+			name: "異常系: ピクセル数が上限を超える画像",
+			in:   newPhotoUploadInput(photoJPEG, false),
+			setup: func() {
+				mockPhotoProcessor.EXPECT().Process(photoJPEG, "image/jpeg", false).Return(nil, service.ErrImageTooLarge)
+			},
+			wantErr: photo.NewImageTooLargeError(),
+		},
+		{
+			name: "異常系: 写真の処理で予期しないエラーが返される",
+			in:   newPhotoUploadInput(photoJPEG, false),
+			setup: func() {
+				mockPhotoProcessor.EXPECT().Process(photoJPEG, "image/jpeg", false).Return(nil, errors.New("out of memory"))
+			},
+			wantErr: apperr.NewInternalError("Failed to process photo", apperr.WithCause(errors.New("out of memory"))),
+		},
+		{
+			name: "異常系: サムネイルの保存に失敗すると保存した写真を削除する",
+			in:   newPhotoUploadInput(photoJPEG, false),
+			setup: func() {
+				mockIDService.EXPECT().Generate().Return(photoID.String())
+				mockTimeService.EXPECT().Now().Return(photoFixedTime)
+				expectProcess(false, photoJPEG)
+				expectBlobPut(mockBlobStore, key)
+				mockBlobStore.EXPECT().Put(gomock.Any(), thumbnailKey, gomock.Any(), gomock.Any(), photo.ThumbnailContentType).
+					Return(errors.New("storage unavailable"))
+				mockBlobStore.EXPECT().Delete(gomock.Any(), key).Return(nil)
+			},
+			wantErr: apperr.NewInternalError("Failed to store photo thumbnail", apperr.WithCause(errors.New("storage unavailable"))),
+		},
+		{
+			name: "異常系: 記録の作成に失敗すると保存した写真とサムネイルを削除する",
+			in:   newPhotoUploadInput(photoJPEG, false),
+			setup: func() {
+				mockIDService.EXPECT().Generate().Return(photoID.String())
+				mockTimeService.EXPECT().Now().Return(photoFixedTime)
+				expectProcess(false, photoJPEG)
+				expectBlobPut(mockBlobStore, key)
+				expectBlobPut(mockBlobStore, thumbnailKey)
+				mockPhotoRepo.EXPECT().Create(gomock.Any(), uploaded).Return(errors.New("database connection error"))
+				mockBlobStore.EXPECT().Delete(gomock.Any(), key).Return(nil)
+				mockBlobStore.EXPECT().Delete(gomock.Any(), thumbnailKey).Return(nil)
+			},
+			wantErr: apperr.NewInternalError("Failed to create photo", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored, storedThumbnail = nil, nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Upload(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.wantStored, stored())
+				assert.Equal(t, []byte("thumbnail"), storedThumbnail())
+			}
+		})
+	}
+}
+
+func TestPhotoInteractor_Download(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhotoRepo := mock_photo.NewMockPhotoRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockBlobStore := mock_service.NewMockBlobStore(ctrl)
+	mockPhotoProcessor := mock_service.NewMockPhotoProcessor(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewPhotoInteractor(mockPhotoRepo, mockTripRepo, mockJournalRepo, mockMemberRepo, mockBlobStore, mockPhotoProcessor, mockTimeService, mockIDService, photoMaxSize)
+
+	p := newPhotoTestPhoto("photo-id", photoTripID, false)
+	otherTrips := newPhotoTestPhoto("photo-id", trip.NewTripID("other-trip-id"), false)
+	content := io.NopCloser(bytes.NewReader(photoJPEG))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.DownloadPhotoOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は写真の中身を取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByID(gomock.Any(), p.ID()).Return(p, nil)
+				mockBlobStore.EXPECT().Get(gomock.Any(), p.StorageKey()).Return(content, nil)
+			},
+			want: output.NewDownloadPhotoOutput(p, "image/jpeg", int64(len(photoJPEG)), content),
+		},
+		{
+			name: "異常系: 別の旅行の写真は NotFound になる",
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByID(gomock.Any(), p.ID()).Return(otherTrips, nil)
+			},
+			wantErr: photo.NewPhotoNotFoundError(),
+		},
+		{
+			name: "異常系: BlobStore に中身がない",
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByID(gomock.Any(), p.ID()).Return(p, nil)
+				mockBlobStore.EXPECT().Get(gomock.Any(), p.StorageKey()).Return(nil, service.ErrBlobNotFound)
+			},
+			wantErr: apperr.NewInternalError("Photo content is missing from the blob store", apperr.WithCause(service.ErrBlobNotFound)),
+		},
+		{
+			name: "異常系: BlobStore からの読み込みに失敗",
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByID(gomock.Any(), p.ID()).Return(p, nil)
+				mockBlobStore.EXPECT().Get(gomock.Any(), p.StorageKey()).Return(nil, errors.New("connection refused"))
+			},
+			wantErr: apperr.NewInternalError("Failed to read photo content", apperr.WithCause(errors.New("connection refused"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Download(ctx, photoTripID.String(), "photo-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				defer got.Content.Close()
+				assert.Equal(t, tt.want, got)
+				body, err := io.ReadAll(got.Content)
+				require.NoError(t, err)
+				assert.Equal(t, photoJPEG, body)
+			}
+		})
+	}
+}
+
+func TestPhotoInteractor_DownloadThumbnail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhotoRepo := mock_photo.NewMockPhotoRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockBlobStore := mock_service.NewMockBlobStore(ctrl)
+	mockPhotoProcessor := mock_service.NewMockPhotoProcessor(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewPhotoInteractor(mockPhotoRepo, mockTripRepo, mockJournalRepo, mockMemberRepo, mockBlobStore, mockPhotoProcessor, mockTimeService, mockIDService, photoMaxSize)
+
+	scan := photo.NewPhoto(photo.NewPhotoID("photo-id"), photoTripID, "scan.png", "image/png", 10, 1, 1, nil, nil, false, testActorID, photoFixedTime)
+	thumbnail := io.NopCloser(bytes.NewReader([]byte("thumbnail")))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.DownloadPhotoOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者はサムネイルを JPEG として取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByID(gomock.Any(), scan.ID()).Return(scan, nil)
+				mockBlobStore.EXPECT().Get(gomock.Any(), scan.ThumbnailKey()).Return(thumbnail, nil)
+			},
+			want: output.NewDownloadPhotoOutput(scan, photo.ThumbnailContentType, -1, thumbnail),
+		},
+		{
+			name: "異常系: BlobStore にサムネイルがない",
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByID(gomock.Any(), scan.ID()).Return(scan, nil)
+				mockBlobStore.EXPECT().Get(gomock.Any(), scan.ThumbnailKey()).Return(nil, service.ErrBlobNotFound)
+			},
+			wantErr: apperr.NewInternalError("Photo content is missing from the blob store", apperr.WithCause(service.ErrBlobNotFound)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.DownloadThumbnail(ctx, photoTripID.String(), "photo-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				defer got.Content.Close()
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPhotoInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhotoRepo := mock_photo.NewMockPhotoRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockBlobStore := mock_service.NewMockBlobStore(ctrl)
+	mockPhotoProcessor := mock_service.NewMockPhotoProcessor(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewPhotoInteractor(mockPhotoRepo, mockTripRepo, mockJournalRepo, mockMemberRepo, mockBlobStore, mockPhotoProcessor, mockTimeService, mockIDService, photoMaxSize)
+
+	p := newPhotoTestPhoto("photo-id", photoTripID, false)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 記録と写真・サムネイルが削除される",
+			setup: func() {
+				gomock.InOrder(
+					mockPhotoRepo.EXPECT().FindByID(gomock.Any(), p.ID()).Return(p, nil),
+					mockPhotoRepo.EXPECT().Delete(gomock.Any(), p.ID()).Return(nil),
+					mockBlobStore.EXPECT().Delete(gomock.Any(), p.StorageKey()).Return(nil),
+					mockBlobStore.EXPECT().Delete(gomock.Any(), p.ThumbnailKey()).Return(nil),
+				)
+			},
+		},
+		{
+			name: "異常系: 記録の削除に失敗すると中身は削除しない",
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByID(gomock.Any(), p.ID()).Return(p, nil)
+				mockPhotoRepo.EXPECT().Delete(gomock.Any(), p.ID()).Return(errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to delete photo", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name: "異常系: 写真の中身の削除に失敗",
+			setup: func() {
+				mockPhotoRepo.EXPECT().FindByID(gomock.Any(), p.ID()).Return(p, nil)
+				mockPhotoRepo.EXPECT().Delete(gomock.Any(), p.ID()).Return(nil)
+				mockBlobStore.EXPECT().Delete(gomock.Any(), p.StorageKey()).Return(errors.New("storage unavailable"))
+			},
+			wantErr: apperr.NewInternalError("Failed to delete photo content", apperr.WithCause(errors.New("storage unavailable"))),
+		},
+		{
+			name:    "異常系: 閲覧者は削除できない",
+			ctx:     viewerCtx,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			err := interactor.Delete(ctx, photoTripID.String(), "photo-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
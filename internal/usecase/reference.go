@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/reference"
+	"github.com/hata0/travel-api/internal/domain/search"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+// defaultReferenceLimit は参照データの検索で返す既定の件数
+const defaultReferenceLimit = 20
+
+//go:generate mockgen -destination mock/reference.go github.com/hata0/travel-api/internal/usecase ReferenceUsecase
+type ReferenceUsecase interface {
+	Seed(ctx context.Context) (*output.SeedReferenceOutput, error)
+	ListCountries(ctx context.Context, in input.SearchReferenceInput) (*output.ListCountriesOutput, error)
+	SearchAirports(ctx context.Context, in input.SearchReferenceInput) (*output.SearchAirportsOutput, error)
+	SearchCities(ctx context.Context, in input.SearchReferenceInput) (*output.SearchCitiesOutput, error)
+}
+
+type ReferenceInteractor struct {
+	referenceRepository reference.ReferenceRepository
+	dataset             service.ReferenceDataset
+	transactionManager  transaction_manager.TransactionManager
+}
+
+func NewReferenceInteractor(
+	referenceRepository reference.ReferenceRepository,
+	dataset service.ReferenceDataset,
+	transactionManager transaction_manager.TransactionManager,
+) ReferenceUsecase {
+	return &ReferenceInteractor{
+		referenceRepository: referenceRepository,
+		dataset:             dataset,
+		transactionManager:  transactionManager,
+	}
+}
+
+// Seed は参照データをすべて保存する。既に同じコードのデータがある場合は上書きする。
+// 空港と都市は国を参照するため国から保存する。途中で失敗した場合は何も保存しない
+func (i *ReferenceInteractor) Seed(ctx context.Context) (*output.SeedReferenceOutput, error) {
+	data, err := i.dataset.Load()
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to load reference dataset", apperr.WithCause(err))
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		for _, country := range data.Countries {
+			if err := i.referenceRepository.SaveCountry(txCtx, country); err != nil {
+				return err
+			}
+		}
+		for _, airport := range data.Airports {
+			if err := i.referenceRepository.SaveAirport(txCtx, airport); err != nil {
+				return err
+			}
+		}
+		for _, city := range data.Cities {
+			if err := i.referenceRepository.SaveCity(txCtx, city); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to seed reference data", apperr.WithCause(err))
+	}
+
+	return &output.SeedReferenceOutput{
+		Countries: len(data.Countries),
+		Airports:  len(data.Airports),
+		Cities:    len(data.Cities),
+	}, nil
+}
+
+// ListCountries は検索語がない場合はすべての国を名前の順に、ある場合は一致する国を関連度の高い順に返す
+func (i *ReferenceInteractor) ListCountries(ctx context.Context, in input.SearchReferenceInput) (*output.ListCountriesOutput, error) {
+	var (
+		countries []*reference.Country
+		err       error
+	)
+	if strings.TrimSpace(in.Query) == "" {
+		countries, err = i.referenceRepository.ListCountries(ctx)
+	} else {
+		var query search.Query
+		query, err = search.NewQuery(in.Query)
+		if err != nil {
+			return nil, err
+		}
+		countries, err = i.referenceRepository.SearchCountries(ctx, query, referenceLimit(in.Limit))
+	}
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list countries", apperr.WithCause(err))
+	}
+
+	return output.NewListCountriesOutput(countries), nil
+}
+
+// SearchAirports は IATA・ICAO コードか、名前・都市名に一致する空港を関連度の高い順に返す
+func (i *ReferenceInteractor) SearchAirports(ctx context.Context, in input.SearchReferenceInput) (*output.SearchAirportsOutput, error) {
+	query, err := search.NewQuery(in.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	airports, err := i.referenceRepository.SearchAirports(ctx, query, referenceLimit(in.Limit))
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to search airports", apperr.WithCause(err))
+	}
+
+	return output.NewSearchAirportsOutput(airports), nil
+}
+
+// SearchCities は UN/LOCODE か名前に一致する都市を関連度の高い順に返す
+func (i *ReferenceInteractor) SearchCities(ctx context.Context, in input.SearchReferenceInput) (*output.SearchCitiesOutput, error) {
+	query, err := search.NewQuery(in.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	cities, err := i.referenceRepository.SearchCities(ctx, query, referenceLimit(in.Limit))
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to search cities", apperr.WithCause(err))
+	}
+
+	return output.NewSearchCitiesOutput(cities), nil
+}
+
+func referenceLimit(limit int) int {
+	if limit == 0 {
+		return defaultReferenceLimit
+	}
+	return limit
+}
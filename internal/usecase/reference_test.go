@@ -0,0 +1,348 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/reference"
+	mock_reference "github.com/hata0/travel-api/internal/domain/reference/mock"
+	"github.com/hata0/travel-api/internal/domain/search"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+func newReferenceTestData(t *testing.T) *service.ReferenceData {
+	t.Helper()
+
+	country, err := reference.NewCountry("JP", "JPN", "Japan", "JPY")
+	require.NoError(t, err)
+	coordinate, err := geo.NewCoordinate(35.5523, 139.7798)
+	require.NoError(t, err)
+	timezone, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	haneda, err := reference.NewAirport("HND", "RJTT", "Haneda Airport", "Tokyo", "JP", coordinate, timezone)
+	require.NoError(t, err)
+	narita, err := reference.NewAirport("NRT", "RJAA", "Narita International Airport", "Tokyo", "JP", coordinate, timezone)
+	require.NoError(t, err)
+	tokyo, err := reference.NewCity("JPTYO", "Tokyo", "JP", coordinate, timezone)
+	require.NoError(t, err)
+
+	return &service.ReferenceData{
+		Countries: []*reference.Country{country},
+		Airports:  []*reference.Airport{haneda, narita},
+		Cities:    []*reference.City{tokyo},
+	}
+}
+
+func TestReferenceInteractor_Seed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReferenceRepo := mock_reference.NewMockReferenceRepository(ctrl)
+	mockDataset := mock_service.NewMockReferenceDataset(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	runInTxDirectly(mockTxManager)
+
+	interactor := NewReferenceInteractor(mockReferenceRepo, mockDataset, mockTxManager)
+
+	data := newReferenceTestData(t)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *output.SeedReferenceOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 国・空港・都市の順にトランザクション内で保存する",
+			setup: func() {
+				mockDataset.EXPECT().Load().Return(data, nil)
+				gomock.InOrder(
+					mockReferenceRepo.EXPECT().SaveCountry(gomock.Any(), data.Countries[0]).Return(nil),
+					mockReferenceRepo.EXPECT().SaveAirport(gomock.Any(), data.Airports[0]).Return(nil),
+					mockReferenceRepo.EXPECT().SaveAirport(gomock.Any(), data.Airports[1]).Return(nil),
+					mockReferenceRepo.EXPECT().SaveCity(gomock.Any(), data.Cities[0]).Return(nil),
+				)
+			},
+			want: &output.SeedReferenceOutput{Countries: 1, Airports: 2, Cities: 1},
+		},
+		{
+			name: "異常系: 保存に失敗した場合はそれ以降を保存しない",
+			setup: func() {
+				mockDataset.EXPECT().Load().Return(data, nil)
+				mockReferenceRepo.EXPECT().SaveCountry(gomock.Any(), data.Countries[0]).Return(nil)
+				mockReferenceRepo.EXPECT().SaveAirport(gomock.Any(), data.Airports[0]).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to seed reference data", apperr.WithCause(errors.New("database write error"))),
+		},
+		{
+			name: "異常系: 参照データが不正な場合はそのままのエラーを返す",
+			setup: func() {
+				mockDataset.EXPECT().Load().Return(nil, apperr.NewInternalError("Invalid reference data"))
+			},
+			wantErr: apperr.NewInternalError("Invalid reference data"),
+		},
+		{
+			name: "異常系: 参照データの読み込みで予期しないエラーが返される",
+			setup: func() {
+				mockDataset.EXPECT().Load().Return(nil, errors.New("file read error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to load reference dataset", apperr.WithCause(errors.New("file read error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.Seed(context.Background())
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestReferenceInteractor_ListCountries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReferenceRepo := mock_reference.NewMockReferenceRepository(ctrl)
+	mockDataset := mock_service.NewMockReferenceDataset(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+
+	interactor := NewReferenceInteractor(mockReferenceRepo, mockDataset, mockTxManager)
+
+	data := newReferenceTestData(t)
+	query, err := search.NewQuery("jap")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		in      input.SearchReferenceInput
+		setup   func()
+		want    *output.ListCountriesOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 検索語がない場合はすべての国を返す",
+			in:   input.SearchReferenceInput{Query: " "},
+			setup: func() {
+				mockReferenceRepo.EXPECT().ListCountries(gomock.Any()).Return(data.Countries, nil)
+			},
+			want: output.NewListCountriesOutput(data.Countries),
+		},
+		{
+			name: "正常系: 検索語がある場合は前後の空白を除いて既定の件数まで検索する",
+			in:   input.SearchReferenceInput{Query: " jap "},
+			setup: func() {
+				mockReferenceRepo.EXPECT().SearchCountries(gomock.Any(), query, defaultReferenceLimit).Return(data.Countries, nil)
+			},
+			want: output.NewListCountriesOutput(data.Countries),
+		},
+		{
+			name: "異常系: 一覧の取得で予期しないエラーが返される",
+			in:   input.SearchReferenceInput{},
+			setup: func() {
+				mockReferenceRepo.EXPECT().ListCountries(gomock.Any()).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list countries", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 検索で予期しないエラーが返される",
+			in:   input.SearchReferenceInput{Query: "jap"},
+			setup: func() {
+				mockReferenceRepo.EXPECT().SearchCountries(gomock.Any(), query, defaultReferenceLimit).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list countries", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.ListCountries(context.Background(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestReferenceInteractor_SearchAirports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReferenceRepo := mock_reference.NewMockReferenceRepository(ctrl)
+	mockDataset := mock_service.NewMockReferenceDataset(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+
+	interactor := NewReferenceInteractor(mockReferenceRepo, mockDataset, mockTxManager)
+
+	data := newReferenceTestData(t)
+	query, err := search.NewQuery("tokyo")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		in      input.SearchReferenceInput
+		setup   func()
+		want    *output.SearchAirportsOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 指定された件数で検索する",
+			in:   input.SearchReferenceInput{Query: "tokyo", Limit: 5},
+			setup: func() {
+				mockReferenceRepo.EXPECT().SearchAirports(gomock.Any(), query, 5).Return(data.Airports, nil)
+			},
+			want: output.NewSearchAirportsOutput(data.Airports),
+		},
+		{
+			name: "正常系: 件数を省略した場合は既定の件数で検索する",
+			in:   input.SearchReferenceInput{Query: "tokyo"},
+			setup: func() {
+				mockReferenceRepo.EXPECT().SearchAirports(gomock.Any(), query, defaultReferenceLimit).Return(data.Airports, nil)
+			},
+			want: output.NewSearchAirportsOutput(data.Airports),
+		},
+		{
+			name:    "異常系: 検索語が空",
+			in:      input.SearchReferenceInput{Query: " "},
+			setup:   func() {},
+			wantErr: search.NewEmptyQueryError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   input.SearchReferenceInput{Query: "tokyo"},
+			setup: func() {
+				mockReferenceRepo.EXPECT().SearchAirports(gomock.Any(), query, defaultReferenceLimit).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to search airports", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.SearchAirports(context.Background(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestReferenceInteractor_SearchCities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReferenceRepo := mock_reference.NewMockReferenceRepository(ctrl)
+	mockDataset := mock_service.NewMockReferenceDataset(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+
+	interactor := NewReferenceInteractor(mockReferenceRepo, mockDataset, mockTxManager)
+
+	data := newReferenceTestData(t)
+	query, err := search.NewQuery("tyo")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		in      input.SearchReferenceInput
+		setup   func()
+		want    *output.SearchCitiesOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 既定の件数で検索する",
+			in:   input.SearchReferenceInput{Query: "tyo"},
+			setup: func() {
+				mockReferenceRepo.EXPECT().SearchCities(gomock.Any(), query, defaultReferenceLimit).Return(data.Cities, nil)
+			},
+			want: output.NewSearchCitiesOutput(data.Cities),
+		},
+		{
+			name:    "異常系: 検索語が長すぎる",
+			in:      input.SearchReferenceInput{Query: strings.Repeat("a", search.MaxQueryLength+1)},
+			setup:   func() {},
+			wantErr: search.NewQueryTooLongError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   input.SearchReferenceInput{Query: "tyo"},
+			setup: func() {
+				mockReferenceRepo.EXPECT().SearchCities(gomock.Any(), query, defaultReferenceLimit).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to search cities", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.SearchCities(context.Background(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+const (
+	// routeStopKindPlace は日記に記録した場所の地点の種類
+	routeStopKindPlace = "place"
+	// routeStopKindActivity は行程の行動の場所の地点の種類
+	routeStopKindActivity = "activity"
+	// routeStopKindDeparture は移動の出発地の地点の種類
+	routeStopKindDeparture = "departure"
+	// routeStopKindArrival は移動の到着地の地点の種類
+	routeStopKindArrival = "arrival"
+)
+
+//go:generate mockgen -destination mock/route.go github.com/hata0/travel-api/internal/usecase RouteUsecase
+type RouteUsecase interface {
+	Export(ctx context.Context, in input.ExportRouteInput) (*output.ExportRouteOutput, error)
+}
+
+type RouteInteractor struct {
+	tripRepository     trip.TripRepository
+	activityRepository itinerary.ActivityRepository
+	legRepository      transport.LegRepository
+	journalRepository  journal.EntryRepository
+	authorizer         tripAuthorizer
+	routeWriter        service.RouteWriter
+	timeService        service.TimeService
+}
+
+func NewRouteInteractor(
+	tripRepository trip.TripRepository,
+	activityRepository itinerary.ActivityRepository,
+	legRepository transport.LegRepository,
+	journalRepository journal.EntryRepository,
+	memberRepository membership.MemberRepository,
+	routeWriter service.RouteWriter,
+	timeService service.TimeService,
+) RouteUsecase {
+	return &RouteInteractor{
+		tripRepository:     tripRepository,
+		activityRepository: activityRepository,
+		legRepository:      legRepository,
+		journalRepository:  journalRepository,
+		authorizer:         newTripAuthorizer(memberRepository),
+		routeWriter:        routeWriter,
+		timeService:        timeService,
+	}
+}
+
+// Export は旅行の経路を地図アプリ向けの形式で書き出す。
+// 経路は座標を持つ行程の行動の場所・移動の出発地と到着地・日記の場所を日付順にたどったもので（並び順は routeStops を参照）、
+// ファイルの中身は Content を読み進めるにつれて書き出される
+func (i *RouteInteractor) Export(ctx context.Context, in input.ExportRouteInput) (*output.ExportRouteOutput, error) {
+	switch in.Format {
+	case service.RouteFormatGeoJSON, service.RouteFormatKML, service.RouteFormatGPX:
+	default:
+		return nil, apperr.NewValidationError(fmt.Sprintf("Unsupported route format: %s", in.Format))
+	}
+
+	tripID := trip.NewTripID(in.TripID)
+	if _, err := i.authorizer.authorize(ctx, tripID, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	t, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+
+	activities, err := i.activityRepository.FindByTripID(ctx, t.ID(), nil)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list activities", apperr.WithCause(err))
+	}
+
+	legs, err := i.legRepository.FindByTripID(ctx, t.ID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list transport legs", apperr.WithCause(err))
+	}
+
+	entries, err := i.journalRepository.FindByTripID(ctx, t.ID(), nil)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list journal entries", apperr.WithCause(err))
+	}
+
+	route := service.Route{
+		Name:        t.Name(),
+		Stops:       routeStops(t, activities, legs, entries),
+		GeneratedAt: i.timeService.Now(),
+	}
+
+	// 書き出しを別の goroutine で行い、読み込み側に渡した分だけメモリに載るようにする。
+	// 読み込み側が途中で Close した場合は書き込みがエラーになり、goroutine は終了する
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(i.routeWriter.Write(pw, route, in.Format))
+	}()
+
+	return output.NewExportRouteOutput(t.Name()+"."+in.Format, in.Format, pr), nil
+}
+
+// routeCandidate は経路の地点の候補。at は同じ日付の中で並べるときに使う時刻で、開始日時の決まっていない行動と日記の場所は nil
+type routeCandidate struct {
+	stop service.RouteStop
+	at   *time.Time
+}
+
+// routeStops は座標を持つ地点を日付順に並べる。同じ日付の中では、行程の行動を並び順に並べ、
+// 移動の出発地（出発日）と到着地（到着日）をその時刻より後に始まる最初の行動の前に挟み、最後に日記の場所を記録した順に並べる。
+// 座標の決まっていない行動の場所・移動の出発地と到着地・日記の場所は含めない
+func routeStops(t *trip.Trip, activities []*itinerary.Activity, legs []*transport.Leg, entries []*journal.Entry) []service.RouteStop {
+	planned := make(map[time.Time][]routeCandidate)
+	moves := make(map[time.Time][]routeCandidate)
+	visited := make(map[time.Time][]routeCandidate)
+	seen := make(map[time.Time]bool)
+	var dates []time.Time
+	add := func(bucket map[time.Time][]routeCandidate, c routeCandidate, ok bool) {
+		if !ok {
+			return
+		}
+		date := c.stop.Date
+		if !seen[date] {
+			seen[date] = true
+			dates = append(dates, date)
+		}
+		bucket[date] = append(bucket[date], c)
+	}
+
+	for _, a := range activities {
+		stop, ok := newRouteStop(a.ID().String(), routeStopKindActivity, a.Place(), a.Title(), a.Date())
+		add(planned, routeCandidate{stop: stop, at: a.StartAt()}, ok)
+	}
+	for _, l := range legs {
+		origin, destination := l.Origin(), l.Destination()
+		departureAt, arrivalAt := l.DepartureAt(), l.ArrivalAt()
+		stop, ok := newRouteStop(l.ID().String()+"-departure", routeStopKindDeparture, &origin, l.Summary(), l.DepartureDate())
+		add(moves, routeCandidate{stop: stop, at: &departureAt}, ok)
+		stop, ok = newRouteStop(l.ID().String()+"-arrival", routeStopKindArrival, &destination, l.Summary(), l.ArrivalDate())
+		add(moves, routeCandidate{stop: stop, at: &arrivalAt}, ok)
+	}
+	for _, e := range entries {
+		stop, ok := newRouteStop(e.ID().String(), routeStopKindPlace, e.Place(), e.Title(), e.Date())
+		add(visited, routeCandidate{stop: stop}, ok)
+	}
+
+	sort.Slice(dates, func(a, b int) bool { return dates[a].Before(dates[b]) })
+
+	stops := make([]service.RouteStop, 0, len(activities)+2*len(legs)+len(entries))
+	appendStop := func(c routeCandidate) {
+		stop := c.stop
+		stop.Order = len(stops) + 1
+		if period := t.Period(); period != nil {
+			stop.Day = period.DayNumber(stop.Date)
+		}
+		stops = append(stops, stop)
+	}
+	for _, date := range dates {
+		pending := moves[date]
+		sort.SliceStable(pending, func(a, b int) bool { return pending[a].at.Before(*pending[b].at) })
+		for _, c := range planned[date] {
+			for c.at != nil && len(pending) > 0 && pending[0].at.Before(*c.at) {
+				appendStop(pending[0])
+				pending = pending[1:]
+			}
+			appendStop(c)
+		}
+		for _, c := range pending {
+			appendStop(c)
+		}
+		for _, c := range visited[date] {
+			appendStop(c)
+		}
+	}
+	return stops
+}
+
+// newRouteStop は場所を経路の地点にする。場所や座標が決まっていない場合は false を返す。
+// 説明 description は場所の名前と同じ場合は省く
+func newRouteStop(id, kind string, place *geo.Place, description string, date time.Time) (service.RouteStop, bool) {
+	if place == nil || place.Coordinate() == nil {
+		return service.RouteStop{}, false
+	}
+
+	stop := service.RouteStop{
+		ID:        id,
+		Name:      place.Name(),
+		Kind:      kind,
+		Date:      date,
+		Latitude:  place.Coordinate().Latitude(),
+		Longitude: place.Coordinate().Longitude(),
+	}
+	if description != place.Name() {
+		stop.Description = description
+	}
+	return stop, true
+}
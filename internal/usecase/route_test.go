@@ -0,0 +1,320 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	mock_itinerary "github.com/hata0/travel-api/internal/domain/itinerary/mock"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	mock_journal "github.com/hata0/travel-api/internal/domain/journal/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/actor"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	mock_transport "github.com/hata0/travel-api/internal/domain/transport/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/service"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+// newRouteTestEntry は指定した日付と場所の日記を生成する。coordinate が nil の場合は座標のない場所とする
+func newRouteTestEntry(t *testing.T, id string, date time.Time, title, placeName string, coordinate *geo.Coordinate) *journal.Entry {
+	t.Helper()
+
+	place, err := geo.NewPlace(placeName, coordinate, nil)
+	require.NoError(t, err)
+
+	return journal.NewEntry(journal.NewEntryID(id), journalTripID, date, title, "", journal.MoodGood, &place, journalFixedTime, journalFixedTime)
+}
+
+// newRouteTestLeg は 8/1 の 12 時に京都駅を出発し、13 時に大阪駅に到着する移動を生成する
+func newRouteTestLeg(t *testing.T, id string) *transport.Leg {
+	t.Helper()
+
+	tz, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	kyotoStation := mustPhotoTestCoordinate(34.9858, 135.7588)
+	osakaStation := mustPhotoTestCoordinate(34.7025, 135.4959)
+	origin, err := geo.NewPlace("京都駅", &kyotoStation, &tz)
+	require.NoError(t, err)
+	destination, err := geo.NewPlace("大阪駅", &osakaStation, &tz)
+	require.NoError(t, err)
+	departureAt := time.Date(2023, 8, 1, 3, 0, 0, 0, time.UTC)
+	l, err := transport.NewLeg(
+		transport.NewLegID(id), journalTripID, transport.ModeTrain, origin, destination,
+		departureAt, departureAt.Add(time.Hour), "JR西日本", "新快速", "", journalFixedTime, journalFixedTime,
+	)
+	require.NoError(t, err)
+	return l
+}
+
+// expectNoPlannedStops は行程の行動と移動がない旅行として取得を設定する
+func expectNoPlannedStops(activityRepo *mock_itinerary.MockActivityRepository, legRepo *mock_transport.MockLegRepository) {
+	activityRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return(nil, nil)
+	legRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID).Return(nil, nil)
+}
+
+func TestRouteInteractor_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockRouteWriter := mock_service.NewMockRouteWriter(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleViewer)
+	interactor := NewRouteInteractor(mockTripRepo, mockActivityRepo, mockLegRepo, mockJournalRepo, mockMemberRepo, mockRouteWriter, mockTimeService)
+
+	tr := newPeriodTestTrip(t, journalTripID)
+	outsiderID := user.NewUserID("outsider-user-id")
+	outsiderCtx := actor.WithUserID(context.Background(), outsiderID)
+	day1 := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	kinkakuji := mustPhotoTestCoordinate(35.0394, 135.7292)
+	ginkakuji := mustPhotoTestCoordinate(35.0270, 135.7982)
+	osakaCastle := mustPhotoTestCoordinate(34.6873, 135.5262)
+	place := func(name string, coordinate *geo.Coordinate) *geo.Place {
+		p, err := geo.NewPlace(name, coordinate, nil)
+		require.NoError(t, err)
+		return &p
+	}
+	at := func(hour int) *time.Time {
+		v := time.Date(2023, 8, 1, hour, 0, 0, 0, time.UTC)
+		return &v
+	}
+
+	// 座標のない場所と場所のない日記は含めない
+	visitedEntries := []*journal.Entry{
+		newRouteTestEntry(t, "entry-1", day1, "金閣寺", "金閣寺", &kinkakuji),
+		newRouteTestEntry(t, "entry-2", time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC), "雨の日", "ホテル", nil),
+		newRouteTestEntry(t, "entry-3", time.Date(2023, 8, 5, 0, 0, 0, 0, time.UTC), "延泊", "大阪城", &osakaCastle),
+		newJournalTestEntry("entry-4", journalTripID),
+	}
+	// 座標のない行動の場所は含めない
+	plannedActivities := []*itinerary.Activity{
+		newItineraryTestActivity(t, "act-1", journalTripID, 0, place("金閣寺", &kinkakuji), at(1), nil),
+		newItineraryTestActivity(t, "act-2", journalTripID, 1, place("銀閣寺", &ginkakuji), nil, nil),
+		newItineraryTestActivity(t, "act-3", journalTripID, 2, place("未定の場所", nil), at(2), nil),
+		newItineraryTestActivity(t, "act-4", journalTripID, 3, place("大阪城", &osakaCastle), at(6), nil),
+	}
+	legs := []*transport.Leg{newRouteTestLeg(t, "leg-1")}
+	sameDayEntries := []*journal.Entry{newRouteTestEntry(t, "entry-1", day1, "金閣寺", "金閣寺", &kinkakuji)}
+
+	var written *service.Route
+	// expectWrite は書き出す経路を記録し、writeErr が nil なら固定の内容を書き出すよう設定する
+	expectWrite := func(format string, writeErr error) {
+		mockRouteWriter.EXPECT().Write(gomock.Any(), gomock.Any(), format).
+			DoAndReturn(func(w io.Writer, route service.Route, _ string) error {
+				written = &route
+				if writeErr != nil {
+					return writeErr
+				}
+				_, err := io.WriteString(w, "<route/>")
+				return err
+			})
+	}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		in           input.ExportRouteInput
+		setup        func()
+		wantFilename string
+		wantRoute    *service.Route
+		// wantReadErr は Content の読み込みで返されるエラー。書き出しの途中のエラーは読み込み側に伝わる
+		wantReadErr error
+		wantErr     error
+	}{
+		{
+			name: "正常系: 座標を持つ日記の場所を日付順の経路として書き出す",
+			in:   input.ExportRouteInput{TripID: journalTripID.String(), Format: service.RouteFormatKML},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(tr, nil)
+				expectNoPlannedStops(mockActivityRepo, mockLegRepo)
+				mockJournalRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return(visitedEntries, nil)
+				mockTimeService.EXPECT().Now().Return(journalFixedTime)
+				expectWrite(service.RouteFormatKML, nil)
+			},
+			wantFilename: "Trip.kml",
+			wantRoute: &service.Route{
+				Name: "Trip",
+				Stops: []service.RouteStop{
+					{
+						ID: "entry-1", Name: "金閣寺", Kind: "place", Date: day1,
+						Day: 1, Order: 1, Latitude: 35.0394, Longitude: 135.7292,
+					},
+					// 旅行期間外の日付は 0 日目とし、場所の名前と異なるタイトルは説明とする
+					{
+						ID: "entry-3", Name: "大阪城", Kind: "place", Description: "延泊", Date: time.Date(2023, 8, 5, 0, 0, 0, 0, time.UTC),
+						Day: 0, Order: 2, Latitude: 34.6873, Longitude: 135.5262,
+					},
+				},
+				GeneratedAt: journalFixedTime,
+			},
+		},
+		{
+			name: "正常系: 同じ日付の中では行動の並び順に、移動をその時刻より後に始まる行動の前に挟み、日記の場所を最後に並べる",
+			in:   input.ExportRouteInput{TripID: journalTripID.String(), Format: service.RouteFormatGeoJSON},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return(plannedActivities, nil)
+				mockLegRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID).Return(legs, nil)
+				mockJournalRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return(sameDayEntries, nil)
+				mockTimeService.EXPECT().Now().Return(journalFixedTime)
+				expectWrite(service.RouteFormatGeoJSON, nil)
+			},
+			wantFilename: "Trip.geojson",
+			wantRoute: &service.Route{
+				Name: "Trip",
+				Stops: []service.RouteStop{
+					{
+						ID: "act-1", Name: "金閣寺", Kind: "activity", Description: "行動 act-1", Date: day1,
+						Day: 1, Order: 1, Latitude: 35.0394, Longitude: 135.7292,
+					},
+					{
+						ID: "act-2", Name: "銀閣寺", Kind: "activity", Description: "行動 act-2", Date: day1,
+						Day: 1, Order: 2, Latitude: 35.0270, Longitude: 135.7982,
+					},
+					{
+						ID: "leg-1-departure", Name: "京都駅", Kind: "departure", Description: "JR西日本 新快速: 京都駅 → 大阪駅", Date: day1,
+						Day: 1, Order: 3, Latitude: 34.9858, Longitude: 135.7588,
+					},
+					{
+						ID: "leg-1-arrival", Name: "大阪駅", Kind: "arrival", Description: "JR西日本 新快速: 京都駅 → 大阪駅", Date: day1,
+						Day: 1, Order: 4, Latitude: 34.7025, Longitude: 135.4959,
+					},
+					{
+						ID: "act-4", Name: "大阪城", Kind: "activity", Description: "行動 act-4", Date: day1,
+						Day: 1, Order: 5, Latitude: 34.6873, Longitude: 135.5262,
+					},
+					{
+						ID: "entry-1", Name: "金閣寺", Kind: "place", Date: day1,
+						Day: 1, Order: 6, Latitude: 35.0394, Longitude: 135.7292,
+					},
+				},
+				GeneratedAt: journalFixedTime,
+			},
+		},
+		{
+			name: "異常系: 書き出しの途中のエラーは読み込み側に伝わる",
+			in:   input.ExportRouteInput{TripID: journalTripID.String(), Format: service.RouteFormatGPX},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(tr, nil)
+				expectNoPlannedStops(mockActivityRepo, mockLegRepo)
+				mockJournalRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return(nil, nil)
+				mockTimeService.EXPECT().Now().Return(journalFixedTime)
+				expectWrite(service.RouteFormatGPX, errors.New("write failed"))
+			},
+			wantFilename: "Trip.gpx",
+			wantRoute: &service.Route{
+				Name:        "Trip",
+				Stops:       []service.RouteStop{},
+				GeneratedAt: journalFixedTime,
+			},
+			wantReadErr: errors.New("write failed"),
+		},
+		{
+			name:    "異常系: 未対応の形式",
+			in:      input.ExportRouteInput{TripID: journalTripID.String(), Format: "shp"},
+			setup:   func() {},
+			wantErr: apperr.NewValidationError("Unsupported route format: shp"),
+		},
+		{
+			name: "異常系: メンバーでない場合は旅行が存在しないものとして扱う",
+			ctx:  outsiderCtx,
+			in:   input.ExportRouteInput{TripID: journalTripID.String(), Format: service.RouteFormatGeoJSON},
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), journalTripID, outsiderID).Return(nil, membership.NewMemberNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: 旅行の取得で予期しないエラーが返される",
+			in:   input.ExportRouteInput{TripID: journalTripID.String(), Format: service.RouteFormatGeoJSON},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get trip", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 行動の取得で予期しないエラーが返される",
+			in:   input.ExportRouteInput{TripID: journalTripID.String(), Format: service.RouteFormatGeoJSON},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list activities", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 移動の取得で予期しないエラーが返される",
+			in:   input.ExportRouteInput{TripID: journalTripID.String(), Format: service.RouteFormatGeoJSON},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(tr, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return(nil, nil)
+				mockLegRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list transport legs", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: 日記の取得で予期しないエラーが返される",
+			in:   input.ExportRouteInput{TripID: journalTripID.String(), Format: service.RouteFormatGeoJSON},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(tr, nil)
+				expectNoPlannedStops(mockActivityRepo, mockLegRepo)
+				mockJournalRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list journal entries", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			written = nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Export(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			defer got.Content.Close()
+			assert.Equal(t, tt.wantFilename, got.Filename)
+			assert.Equal(t, tt.in.Format, got.Format)
+			content, err := io.ReadAll(got.Content)
+			if tt.wantReadErr != nil {
+				assert.EqualError(t, err, tt.wantReadErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "<route/>", string(content))
+			}
+			assert.Equal(t, tt.wantRoute, written)
+		})
+	}
+}
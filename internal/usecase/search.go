@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/search"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+const (
+	// defaultSearchLimit はリソースの種類ごとに返す検索結果の既定の件数
+	defaultSearchLimit = 10
+	// searchSnippetLength はスニペットとして切り出す最大文字数
+	searchSnippetLength = 120
+)
+
+//go:generate mockgen -destination mock/search.go github.com/hata0/travel-api/internal/usecase SearchUsecase
+type SearchUsecase interface {
+	Search(ctx context.Context, in input.SearchInput) (*output.SearchOutput, error)
+}
+
+type SearchInteractor struct {
+	repository search.SearchRepository
+}
+
+func NewSearchInteractor(repository search.SearchRepository) SearchUsecase {
+	return &SearchInteractor{
+		repository: repository,
+	}
+}
+
+// Search は実行ユーザーがメンバーとして参加している旅行とそのリソースを横断して検索する
+func (i *SearchInteractor) Search(ctx context.Context, in input.SearchInput) (*output.SearchOutput, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := search.NewQuery(in.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := in.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	hits, err := i.repository.Search(ctx, userID, query, limit)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to search", apperr.WithCause(err))
+	}
+
+	return output.NewSearchOutput(hits, query, searchSnippetLength), nil
+}
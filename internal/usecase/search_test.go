@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/search"
+	mock_search "github.com/hata0/travel-api/internal/domain/search/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+func TestSearchInteractor_Search(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_search.NewMockSearchRepository(ctrl)
+
+	interactor := NewSearchInteractor(mockRepo)
+
+	tripID := trip.NewTripID("trip-id")
+	hits := []*search.Hit{
+		search.NewHit(search.ResourceTypeAccommodation, "accommodation-id", tripID, "京都の旅館", "京都市東山区", 0.5),
+		search.NewHit(search.ResourceTypeTrip, "trip-id", tripID, "京都旅行", "", 0.8),
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.SearchInput
+		setup   func()
+		want    *output.SearchOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: リソースの種類ごとにまとめて一致箇所を強調する",
+			in:   input.SearchInput{Query: " 京都 "},
+			setup: func() {
+				mockRepo.EXPECT().
+					Search(gomock.Any(), testActorID, gomock.Any(), defaultSearchLimit).
+					DoAndReturn(func(_ context.Context, _ any, q search.Query, _ int) ([]*search.Hit, error) {
+						assert.Equal(t, "京都", q.String())
+						return hits, nil
+					})
+			},
+			// 旅行の結果が先頭にまとめられる
+			want: &output.SearchOutput{Groups: []*output.SearchGroup{
+				{
+					ResourceType: "trip",
+					Hits: []*output.SearchHit{{
+						ResourceType: "trip",
+						ResourceID:   "trip-id",
+						TripID:       "trip-id",
+						Title:        []output.SnippetSegment{{Text: "京都", Highlighted: true}, {Text: "旅行"}},
+						Snippet:      []output.SnippetSegment{},
+						Rank:         0.8,
+					}},
+				},
+				{
+					ResourceType: "accommodation",
+					Hits: []*output.SearchHit{{
+						ResourceType: "accommodation",
+						ResourceID:   "accommodation-id",
+						TripID:       "trip-id",
+						Title:        []output.SnippetSegment{{Text: "京都", Highlighted: true}, {Text: "の旅館"}},
+						Snippet:      []output.SnippetSegment{{Text: "京都", Highlighted: true}, {Text: "市東山区"}},
+						Rank:         0.5,
+					}},
+				},
+			}},
+		},
+		{
+			name: "正常系: 指定された件数で検索する",
+			in:   input.SearchInput{Query: "kyoto", Limit: 3},
+			setup: func() {
+				mockRepo.EXPECT().Search(gomock.Any(), testActorID, gomock.Any(), 3).Return([]*search.Hit{}, nil)
+			},
+			want: &output.SearchOutput{Groups: []*output.SearchGroup{}},
+		},
+		{
+			name:    "異常系: 空の検索語",
+			in:      input.SearchInput{Query: "  "},
+			setup:   func() {},
+			wantErr: search.NewEmptyQueryError(),
+		},
+		{
+			name:    "異常系: 実行ユーザーがいない",
+			ctx:     context.Background(),
+			in:      input.SearchInput{Query: "kyoto"},
+			setup:   func() {},
+			wantErr: apperr.NewInvalidCredentialsError("authenticated user is required"),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   input.SearchInput{Query: "kyoto"},
+			setup: func() {
+				mockRepo.EXPECT().Search(gomock.Any(), testActorID, gomock.Any(), defaultSearchLimit).Return(nil, errors.New("connection timeout"))
+			},
+			wantErr: apperr.NewInternalError("Failed to search", apperr.WithCause(errors.New("connection timeout"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Search(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
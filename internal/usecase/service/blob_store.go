@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound は指定されたキーのデータが BlobStore に存在しないことを表す
+var ErrBlobNotFound = errors.New("blob not found")
+
+//go:generate mockgen -destination mock/blob_store.go github.com/hata0/travel-api/internal/usecase/service BlobStore
+type BlobStore interface {
+	// Put は body から size バイトを読み込み、key に保存する。同じキーのデータがある場合は上書きする
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get は key に保存されたデータを読み込む。呼び出し側は読み終えたら Close しなければならない。
+	// データが存在しない場合は ErrBlobNotFound を返す
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete は key に保存されたデータを削除する。データが存在しない場合も成功とする
+	Delete(ctx context.Context, key string) error
+}
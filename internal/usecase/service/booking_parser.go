@@ -0,0 +1,20 @@
+package service
+
+import (
+	"io"
+
+	"github.com/hata0/travel-api/internal/domain/bookingimport"
+)
+
+// 予約の確認ファイルの形式
+const (
+	BookingFileFormatICS = "ics"
+	BookingFileFormatEML = "eml"
+)
+
+//go:generate mockgen -destination mock/booking_parser.go github.com/hata0/travel-api/internal/usecase/service BookingParser
+type BookingParser interface {
+	// Parse は iCalendar または RFC 822 形式の確認メールを読み込み、含まれる予約を下書きとして返す。
+	// 読み取れない形式や壊れたファイルの場合はバリデーションエラーを返す
+	Parse(r io.Reader, format string) ([]bookingimport.Draft, error)
+}
@@ -0,0 +1,38 @@
+package service
+
+import "time"
+
+// CalendarEvent は iCalendar の VEVENT として書き出す予定
+type CalendarEvent struct {
+	// UID はカレンダーアプリが同じ予定を識別するための、書き出しのたびに変わらない ID
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	// AllDay が true の場合 Start と End は日付だけを表し、End は最終日の翌日とする。
+	// 時刻のある予定で End がゼロ値の場合は終了日時のない予定として書き出す
+	AllDay bool
+	Start  time.Time
+	End    time.Time
+	// StartTimeZone と EndTimeZone は時刻のある予定の開始・終了を書き出す現地のタイムゾーン。nil の場合はカレンダーの Location を使う。
+	// 出発地と到着地でタイムゾーンが異なる移動を表せるように開始と終了で別に持つ
+	StartTimeZone *time.Location
+	EndTimeZone   *time.Location
+	LastModified  time.Time
+}
+
+// Calendar は iCalendar として書き出すカレンダー
+type Calendar struct {
+	Name string
+	// Location は時刻のある予定を表すタイムゾーン。nil の場合は UTC で書き出す
+	Location    *time.Location
+	Events      []CalendarEvent
+	GeneratedAt time.Time
+}
+
+//go:generate mockgen -destination mock/calendar.go github.com/hata0/travel-api/internal/usecase/service CalendarEncoder
+type CalendarEncoder interface {
+	// Encode はカレンダーを RFC 5545 の iCalendar 形式に変換する。
+	// カレンダーや予定にタイムゾーンを指定した場合は、予定の期間に必要な VTIMEZONE もタイムゾーンごとに含める
+	Encode(cal Calendar) []byte
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"io"
+
+	"github.com/hata0/travel-api/internal/domain/exchangerate"
+)
+
+// 為替レートファイルの形式
+const (
+	ExchangeRateFeedFormatXML = "xml"
+	ExchangeRateFeedFormatCSV = "csv"
+)
+
+//go:generate mockgen -destination mock/exchange_rate_feed.go github.com/hata0/travel-api/internal/usecase/service ExchangeRateFeedParser
+type ExchangeRateFeedParser interface {
+	// Parse は為替レートファイルを読み込み、含まれるすべてのレートを返す
+	Parse(r io.Reader, format string) ([]*exchangerate.ExchangeRate, error)
+}
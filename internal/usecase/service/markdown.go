@@ -0,0 +1,8 @@
+package service
+
+//go:generate mockgen -destination mock/markdown.go github.com/hata0/travel-api/internal/usecase/service MarkdownRenderer
+type MarkdownRenderer interface {
+	// Render は Markdown を HTML に変換する。
+	// 返す HTML は許可リストに含まれるタグ・属性だけに絞り込まれており、そのままクライアントに埋め込んでよい
+	Render(markdown string) string
+}
@@ -0,0 +1,9 @@
+package service
+
+//go:generate mockgen -destination mock/password.go github.com/hata0/travel-api/internal/usecase/service PasswordService
+type PasswordService interface {
+	// HashPassword はユーザーや共有リンクのパスワードをハッシュ化する
+	HashPassword(password string) ([]byte, error)
+	// VerifyPassword はパスワードがハッシュ値と一致するかを返す
+	VerifyPassword(hash []byte, password string) bool
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrInvalidImage は画像が壊れているなどの理由でデコードできないことを表す
+	ErrInvalidImage = errors.New("invalid image")
+	// ErrImageTooLarge は画像の縦横のピクセル数が処理できる上限を超えていることを表す
+	ErrImageTooLarge = errors.New("image too large")
+)
+
+// PhotoMetadata は写真の EXIF などから読み取ったメタデータ。読み取れなかった項目は nil になる
+type PhotoMetadata struct {
+	// CapturedAt は撮影日時。撮影した場所の現地時刻をタイムゾーンなし（UTC として扱う）で表す
+	CapturedAt *time.Time
+	Latitude   *float64
+	Longitude  *float64
+	Width      int
+	Height     int
+}
+
+// ProcessedPhoto は PhotoProcessor で処理した写真
+type ProcessedPhoto struct {
+	Metadata PhotoMetadata
+	// Content は保存する写真の中身。位置情報の削除を指定した場合は位置情報を取り除いたもの
+	Content []byte
+	// Thumbnail は JPEG 形式のサムネイル
+	Thumbnail []byte
+}
+
+//go:generate mockgen -destination mock/photo_processor.go github.com/hata0/travel-api/internal/usecase/service PhotoProcessor
+type PhotoProcessor interface {
+	// Process は写真からメタデータを読み取り、サムネイルを生成する。stripLocation が true の場合は
+	// 保存する写真から位置情報を取り除く。画像をデコードできない場合は ErrInvalidImage、
+	// ピクセル数が上限を超える場合は ErrImageTooLarge を返す
+	Process(content []byte, contentType string, stripLocation bool) (*ProcessedPhoto, error)
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"github.com/hata0/travel-api/internal/domain/reference"
+)
+
+// ReferenceData は空港・国・都市の参照データの一式
+type ReferenceData struct {
+	Countries []*reference.Country
+	Airports  []*reference.Airport
+	Cities    []*reference.City
+}
+
+//go:generate mockgen -destination mock/reference_dataset.go github.com/hata0/travel-api/internal/usecase/service ReferenceDataset
+type ReferenceDataset interface {
+	// Load は参照データをすべて読み込む。空港と都市の国コードはすべて Countries に含まれる
+	Load() (*ReferenceData, error)
+}
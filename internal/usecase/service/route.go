@@ -0,0 +1,42 @@
+package service
+
+import (
+	"io"
+	"time"
+)
+
+// 旅行の経路を書き出す形式
+const (
+	RouteFormatGeoJSON = "geojson"
+	RouteFormatKML     = "kml"
+	RouteFormatGPX     = "gpx"
+)
+
+// RouteStop は経路として書き出す、座標を持つ地点
+type RouteStop struct {
+	// ID は書き出すたびに変わらない地点の ID
+	ID          string
+	Name        string
+	Kind        string
+	Description string
+	Date        time.Time
+	// Day は旅行の開始日を 1 日目とした日数。旅行期間外や期間未定の場合は 0
+	Day int
+	// Order は経路全体での 1 から始まる順番
+	Order     int
+	Latitude  float64
+	Longitude float64
+}
+
+// Route は地図アプリ向けに書き出す旅行の経路。Stops は訪れる順に並んでいる
+type Route struct {
+	Name        string
+	Stops       []RouteStop
+	GeneratedAt time.Time
+}
+
+//go:generate mockgen -destination mock/route.go github.com/hata0/travel-api/internal/usecase/service RouteWriter
+type RouteWriter interface {
+	// Write は経路を format の形式で w に書き出す。地点ごとに書き出すため、経路全体を文字列として組み立てることはない
+	Write(w io.Writer, route Route, format string) error
+}
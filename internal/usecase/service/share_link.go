@@ -0,0 +1,7 @@
+package service
+
+//go:generate mockgen -destination mock/share_link.go github.com/hata0/travel-api/internal/usecase/service ShareLinkSecretService
+type ShareLinkSecretService interface {
+	// GenerateToken はURLにそのまま含められる共有リンクのトークンを生成する
+	GenerateToken() (string, error)
+}
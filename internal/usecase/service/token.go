@@ -1,11 +1,11 @@
 package service
 
 import (
-	"github.com/hata0/travel-api/internal/domain"
+	"github.com/hata0/travel-api/internal/domain/user"
 )
 
 //go:generate mockgen -destination mock/time.go github.com/hata0/travel-api/internal/usecase/service TokenService
 type TokenService interface {
-	GenerateAccessToken(userID domain.UserID) (string, error)
+	GenerateAccessToken(userID user.UserID) (string, error)
 	GenerateRefreshToken() (string, error)
 }
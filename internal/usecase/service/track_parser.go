@@ -0,0 +1,21 @@
+package service
+
+import (
+	"io"
+
+	"github.com/hata0/travel-api/internal/domain/track"
+)
+
+// ParsedTrack は GPX ファイルから読み取った軌跡
+type ParsedTrack struct {
+	// Name はファイルに記録されたトラックの名前。記録されていない場合は空文字
+	Name     string
+	Segments []track.Segment
+}
+
+//go:generate mockgen -destination mock/track_parser.go github.com/hata0/travel-api/internal/usecase/service TrackParser
+type TrackParser interface {
+	// Parse は GPX ファイルを読み込み、含まれるすべてのトラックのセグメントを順に返す。
+	// GPX として読み取れない場合は track.NewInvalidFileError のバリデーションエラーを返す
+	Parse(r io.Reader) (*ParsedTrack, error)
+}
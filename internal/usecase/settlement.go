@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/settlement"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/settlement.go github.com/hata0/travel-api/internal/usecase SettlementUsecase
+type SettlementUsecase interface {
+	Get(ctx context.Context, tripID string) (*output.GetSettlementOutput, error)
+	RecordTransfer(ctx context.Context, in input.RecordSettlementTransferInput) (*output.CreateExpenseOutput, error)
+}
+
+type SettlementInteractor struct {
+	expenseRepository expense.ExpenseRepository
+	tripRepository    trip.TripRepository
+	authorizer        tripAuthorizer
+	timeService       service.TimeService
+	idService         service.IDService
+}
+
+func NewSettlementInteractor(
+	expenseRepository expense.ExpenseRepository,
+	tripRepository trip.TripRepository,
+	memberRepository membership.MemberRepository,
+	timeService service.TimeService,
+	idService service.IDService,
+) SettlementUsecase {
+	return &SettlementInteractor{
+		expenseRepository: expenseRepository,
+		tripRepository:    tripRepository,
+		authorizer:        newTripAuthorizer(memberRepository),
+		timeService:       timeService,
+		idService:         idService,
+	}
+}
+
+// Get は旅行の支出から参加者ごとの収支と、精算に必要な送金の一覧を通貨ごとに計算する
+func (i *SettlementInteractor) Get(ctx context.Context, tripID string) (*output.GetSettlementOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(tripID))
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := i.expenseRepository.FindByTripID(ctx, t.ID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list expenses", apperr.WithCause(err))
+	}
+
+	settlements, err := settlement.SettleUp(expenses)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to settle up expenses", apperr.WithCause(err))
+	}
+
+	return output.NewGetSettlementOutput(settlements), nil
+}
+
+// RecordTransfer は精算の送金を精算分類の支出として記録する。記録した送金は以降の精算結果に反映される
+func (i *SettlementInteractor) RecordTransfer(ctx context.Context, in input.RecordSettlementTransferInput) (*output.CreateExpenseOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	amount, err := parseExpenseAmount(in.Amount, in.AmountMinor, in.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := settlement.NewTransfer(in.From, in.To, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(in.TripID))
+	if err != nil {
+		return nil, err
+	}
+
+	expenseID := expense.NewExpenseID(i.idService.Generate())
+
+	e, err := transfer.ToExpense(expenseID, t.ID(), in.PaidOn, in.Description, i.timeService.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.expenseRepository.Create(ctx, e); err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to record settlement transfer", apperr.WithCause(err))
+	}
+
+	return output.NewCreateExpenseOutput(expenseID), nil
+}
+
+// findTrip は精算の対象となる旅行を取得する
+func (i *SettlementInteractor) findTrip(ctx context.Context, tripID trip.TripID) (*trip.Trip, error) {
+	t, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+	return t, nil
+}
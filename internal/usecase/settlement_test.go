@@ -0,0 +1,248 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	mock_expense "github.com/hata0/travel-api/internal/domain/expense/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/settlement"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var (
+	settlementTripID    = trip.NewTripID("trip-id")
+	settlementFixedTime = time.Date(2023, 8, 10, 9, 0, 0, 0, time.UTC)
+)
+
+func newSettlementTestTrip() *trip.Trip {
+	return trip.NewTrip(settlementTripID, "Trip", nil, "", settlementFixedTime, settlementFixedTime)
+}
+
+// newSettlementTestExpense は payer が立て替え、participants で均等に分担する支出を生成する
+func newSettlementTestExpense(t *testing.T, payer string, amount int64, participants ...string) *expense.Expense {
+	t.Helper()
+
+	m, err := money.NewMoney(amount, "JPY")
+	require.NoError(t, err)
+	entries := make([]expense.SplitEntry, len(participants))
+	for i, p := range participants {
+		entries[i] = expense.NewSplitEntry(p, 0)
+	}
+	split, err := expense.NewSplit(expense.SplitMethodEqual, entries)
+	require.NoError(t, err)
+
+	return expense.NewExpense(expense.NewExpenseID("expense-id"), settlementTripID, m, expense.CategoryFood,
+		settlementFixedTime, payer, "", nil, split, settlementFixedTime, settlementFixedTime)
+}
+
+func TestSettlementInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+
+	interactor := NewSettlementInteractor(mockExpenseRepo, mockTripRepo, mockMemberRepo, mockTimeService, mockIDService)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *output.GetSettlementOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 収支と送金の一覧が返される",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), settlementTripID).Return(newSettlementTestTrip(), nil)
+				mockExpenseRepo.EXPECT().FindByTripID(gomock.Any(), settlementTripID).Return([]*expense.Expense{
+					newSettlementTestExpense(t, "Alice", 3000, "Alice", "Bob", "Carol"),
+					newSettlementTestExpense(t, "Bob", 600, "Alice", "Bob", "Carol"),
+				}, nil)
+			},
+			want: &output.GetSettlementOutput{
+				Settlements: []*output.Settlement{{
+					Currency: "JPY",
+					Balances: []*output.SettlementBalance{
+						{Participant: "Alice", Amount: output.Money{Amount: 1800, Decimal: "1800", Currency: "JPY"}},
+						{Participant: "Bob", Amount: output.Money{Amount: -600, Decimal: "-600", Currency: "JPY"}},
+						{Participant: "Carol", Amount: output.Money{Amount: -1200, Decimal: "-1200", Currency: "JPY"}},
+					},
+					Transfers: []*output.SettlementTransfer{
+						{From: "Carol", To: "Alice", Amount: output.Money{Amount: 1200, Decimal: "1200", Currency: "JPY"}},
+						{From: "Bob", To: "Alice", Amount: output.Money{Amount: 600, Decimal: "600", Currency: "JPY"}},
+					},
+				}},
+			},
+		},
+		{
+			name: "異常系: 旅行が存在しない",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), settlementTripID).Return(nil, trip.NewTripNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), settlementTripID).Return(newSettlementTestTrip(), nil)
+				mockExpenseRepo.EXPECT().FindByTripID(gomock.Any(), settlementTripID).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list expenses", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.Get(newActorContext(), "trip-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSettlementInteractor_RecordTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExpenseRepo := mock_expense.NewMockExpenseRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	interactor := NewSettlementInteractor(mockExpenseRepo, mockTripRepo, mockMemberRepo, mockTimeService, mockIDService)
+
+	generatedID := "generated-id"
+	validInput := input.RecordSettlementTransferInput{
+		TripID:   "trip-id",
+		From:     "Bob",
+		To:       "Alice",
+		Amount:   "1200",
+		Currency: "JPY",
+		PaidOn:   time.Date(2023, 8, 10, 18, 0, 0, 0, time.UTC),
+	}
+	samePersonInput := validInput
+	samePersonInput.To = "Bob"
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.RecordSettlementTransferInput
+		setup   func()
+		want    *output.CreateExpenseOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 送金が精算の支出として記録される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), settlementTripID).Return(newSettlementTestTrip(), nil)
+				mockIDService.EXPECT().Generate().Return(generatedID)
+				mockTimeService.EXPECT().Now().Return(settlementFixedTime)
+				mockExpenseRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e *expense.Expense) error {
+						assert.Equal(t, generatedID, e.ID().String())
+						assert.Equal(t, expense.CategorySettlement, e.Category())
+						assert.Equal(t, "Bob", e.Payer(), "送金者が支払者になるべき")
+						assert.Equal(t, []string{"Alice"}, e.Split().Participants(), "受取人が全額を分担するべき")
+						assert.Equal(t, int64(1200), e.Amount().Amount())
+						assert.Equal(t, time.Date(2023, 8, 10, 0, 0, 0, 0, time.UTC), e.SpentOn())
+						return nil
+					})
+			},
+			want: output.NewCreateExpenseOutput(expense.NewExpenseID(generatedID)),
+		},
+		{
+			name:    "異常系: 送金者と受取人が同じ",
+			in:      samePersonInput,
+			setup:   func() {},
+			wantErr: settlement.NewInvalidTransferParticipantsError(),
+		},
+		{
+			name: "異常系: 旅行が存在しない",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), settlementTripID).Return(nil, trip.NewTripNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), settlementTripID).Return(newSettlementTestTrip(), nil)
+				mockIDService.EXPECT().Generate().Return(generatedID)
+				mockTimeService.EXPECT().Now().Return(settlementFixedTime)
+				mockExpenseRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to record settlement transfer", apperr.WithCause(errors.New("database write error"))),
+		},
+		{
+			name:    "異常系: 閲覧者は送金を記録できない",
+			ctx:     viewerCtx,
+			in:      validInput,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.RecordTransfer(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
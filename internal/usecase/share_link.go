@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/tokenhash"
+	"github.com/hata0/travel-api/internal/domain/sharelink"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/share_link.go github.com/hata0/travel-api/internal/usecase ShareLinkUsecase
+type ShareLinkUsecase interface {
+	Create(ctx context.Context, in input.CreateShareLinkInput) (*output.CreateShareLinkOutput, error)
+	List(ctx context.Context, tripID string) (*output.ListShareLinkOutput, error)
+	Revoke(ctx context.Context, tripID, id string) error
+	GetShared(ctx context.Context, in input.GetSharedTripInput) (*output.GetSharedTripOutput, error)
+}
+
+type ShareLinkInteractor struct {
+	shareLinkRepository     sharelink.ShareLinkRepository
+	tripRepository          trip.TripRepository
+	accommodationRepository accommodation.AccommodationRepository
+	activityRepository      itinerary.ActivityRepository
+	journalRepository       journal.EntryRepository
+	memberRepository        membership.MemberRepository
+	userRepository          user.UserRepository
+	authorizer              tripAuthorizer
+	secretService           service.ShareLinkSecretService
+	passwordService         service.PasswordService
+	markdownRenderer        service.MarkdownRenderer
+	timeService             service.TimeService
+	idService               service.IDService
+}
+
+func NewShareLinkInteractor(
+	shareLinkRepository sharelink.ShareLinkRepository,
+	tripRepository trip.TripRepository,
+	accommodationRepository accommodation.AccommodationRepository,
+	activityRepository itinerary.ActivityRepository,
+	journalRepository journal.EntryRepository,
+	memberRepository membership.MemberRepository,
+	userRepository user.UserRepository,
+	secretService service.ShareLinkSecretService,
+	passwordService service.PasswordService,
+	markdownRenderer service.MarkdownRenderer,
+	timeService service.TimeService,
+	idService service.IDService,
+) ShareLinkUsecase {
+	return &ShareLinkInteractor{
+		shareLinkRepository:     shareLinkRepository,
+		tripRepository:          tripRepository,
+		accommodationRepository: accommodationRepository,
+		activityRepository:      activityRepository,
+		journalRepository:       journalRepository,
+		memberRepository:        memberRepository,
+		userRepository:          userRepository,
+		authorizer:              newTripAuthorizer(memberRepository),
+		secretService:           secretService,
+		passwordService:         passwordService,
+		markdownRenderer:        markdownRenderer,
+		timeService:             timeService,
+		idService:               idService,
+	}
+}
+
+// Create は旅行の共有リンクを作成する。所有者だけが作成でき、トークンは作成時の出力でしか取得できない
+func (i *ShareLinkInteractor) Create(ctx context.Context, in input.CreateShareLinkInput) (*output.CreateShareLinkOutput, error) {
+	tripID := trip.NewTripID(in.TripID)
+
+	owner, err := i.authorizer.authorize(ctx, tripID, membership.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := i.secretService.GenerateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	var passwordHash []byte
+	if in.Password != "" {
+		passwordHash, err = i.passwordService.HashPassword(in.Password)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := i.timeService.Now()
+	linkID := sharelink.NewShareLinkID(i.idService.Generate())
+
+	link, err := sharelink.NewShareLink(
+		linkID,
+		tripID,
+		tokenhash.Hash(token),
+		passwordHash,
+		in.ExpiresAt,
+		owner.UserID(),
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.shareLinkRepository.Create(ctx, link); err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to create share link", apperr.WithCause(err))
+	}
+
+	return output.NewCreateShareLinkOutput(linkID, token), nil
+}
+
+// List は旅行の共有リンクを作成日時の新しい順に取得する。所有者だけが取得できる
+func (i *ShareLinkInteractor) List(ctx context.Context, tripID string) (*output.ListShareLinkOutput, error) {
+	id := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorize(ctx, id, membership.RoleOwner); err != nil {
+		return nil, err
+	}
+
+	links, err := i.shareLinkRepository.FindByTripID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list share links", apperr.WithCause(err))
+	}
+
+	return output.NewListShareLinkOutput(links, i.timeService.Now()), nil
+}
+
+// Revoke は旅行の共有リンクを無効化する。所有者だけが無効化できる
+func (i *ShareLinkInteractor) Revoke(ctx context.Context, tripID, id string) error {
+	tid := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorize(ctx, tid, membership.RoleOwner); err != nil {
+		return err
+	}
+
+	link, err := i.shareLinkRepository.FindByID(ctx, sharelink.NewShareLinkID(id))
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to get share link", apperr.WithCause(err))
+	}
+	if !link.TripID().Equals(tid) {
+		return sharelink.NewShareLinkNotFoundError()
+	}
+
+	revoked, err := link.Revoke(i.timeService.Now())
+	if err != nil {
+		return err
+	}
+
+	if err := i.shareLinkRepository.Update(ctx, revoked); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to revoke share link", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// GetShared は共有リンクのトークンで旅行の公開用の情報を取得する。認証は不要だが、
+// パスワードが設定されたリンクではパスワードの一致が必要となる。
+// 期限切れや無効化済みのリンクは、存在しないリンクと区別しない
+func (i *ShareLinkInteractor) GetShared(ctx context.Context, in input.GetSharedTripInput) (*output.GetSharedTripOutput, error) {
+	link, err := i.shareLinkRepository.FindByTokenHash(ctx, tokenhash.Hash(in.Token))
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get share link", apperr.WithCause(err))
+	}
+
+	if !link.IsActiveAt(i.timeService.Now()) {
+		return nil, sharelink.NewShareLinkNotFoundError()
+	}
+	if link.HasPassword() && !i.passwordService.VerifyPassword(link.PasswordHash(), in.Password) {
+		return nil, sharelink.NewShareLinkPasswordMismatchError()
+	}
+
+	t, err := i.tripRepository.FindByID(ctx, link.TripID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get shared trip", apperr.WithCause(err))
+	}
+
+	accommodations, err := i.accommodationRepository.FindByTripID(ctx, t.ID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list shared accommodations", apperr.WithCause(err))
+	}
+
+	activities, err := i.activityRepository.FindByTripID(ctx, t.ID(), nil)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list shared activities", apperr.WithCause(err))
+	}
+
+	entries, err := i.journalRepository.FindByTripID(ctx, t.ID(), nil)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list shared journal entries", apperr.WithCause(err))
+	}
+
+	members, err := i.sharedMembers(ctx, t.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetSharedTripOutput(t, accommodations, activities, entries, i.markdownRenderer.Render, members), nil
+}
+
+// sharedMembers は旅行のメンバーをユーザー名とロールだけに絞って取得する
+func (i *ShareLinkInteractor) sharedMembers(ctx context.Context, tripID trip.TripID) ([]*output.SharedMember, error) {
+	members, err := i.memberRepository.FindByTripID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list shared members", apperr.WithCause(err))
+	}
+
+	shared := make([]*output.SharedMember, 0, len(members))
+	for _, m := range members {
+		u, err := i.userRepository.FindByID(ctx, m.UserID())
+		if err != nil {
+			if apperr.IsAppError(err) {
+				return nil, err
+			}
+			return nil, apperr.NewInternalError("Failed to get shared member", apperr.WithCause(err))
+		}
+		shared = append(shared, &output.SharedMember{
+			Username: u.Username(),
+			Role:     m.Role().String(),
+		})
+	}
+
+	return shared, nil
+}
@@ -0,0 +1,585 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	mock_accommodation "github.com/hata0/travel-api/internal/domain/accommodation/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	mock_itinerary "github.com/hata0/travel-api/internal/domain/itinerary/mock"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	mock_journal "github.com/hata0/travel-api/internal/domain/journal/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/shared/tokenhash"
+	"github.com/hata0/travel-api/internal/domain/sharelink"
+	mock_sharelink "github.com/hata0/travel-api/internal/domain/sharelink/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/domain/user"
+	mock_user "github.com/hata0/travel-api/internal/domain/user/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var (
+	shareLinkTripID    = trip.NewTripID("trip-id")
+	shareLinkID        = sharelink.NewShareLinkID("share-link-id")
+	shareLinkFixedTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func newTestShareLink(t *testing.T, passwordHash []byte, expiresAt *time.Time) *sharelink.ShareLink {
+	t.Helper()
+
+	link, err := sharelink.NewShareLink(
+		shareLinkID,
+		shareLinkTripID,
+		tokenhash.Hash("token"),
+		passwordHash,
+		expiresAt,
+		testActorID,
+		shareLinkFixedTime,
+	)
+	require.NoError(t, err)
+	return link
+}
+
+func TestShareLinkInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockShareLinkRepo := mock_sharelink.NewMockShareLinkRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockUserRepo := mock_user.NewMockUserRepository(ctrl)
+	mockSecretService := mock_service.NewMockShareLinkSecretService(ctrl)
+	mockPasswordService := mock_service.NewMockPasswordService(ctrl)
+	mockRenderer := mock_service.NewMockMarkdownRenderer(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockRenderer.EXPECT().Render(gomock.Any()).DoAndReturn(renderJournalForTest).AnyTimes()
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	interactor := NewShareLinkInteractor(
+		mockShareLinkRepo,
+		mockTripRepo,
+		mockAccommodationRepo,
+		mockActivityRepo,
+		mockJournalRepo,
+		mockMemberRepo,
+		mockUserRepo,
+		mockSecretService,
+		mockPasswordService,
+		mockRenderer,
+		mockTimeService,
+		mockIDService,
+	)
+
+	expiresAt := shareLinkFixedTime.Add(24 * time.Hour)
+	pastExpiresAt := shareLinkFixedTime.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.CreateShareLinkInput
+		setup   func()
+		want    *output.CreateShareLinkOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: トークンのハッシュ値とパスワードのハッシュ値を保存し、トークンを返す",
+			in:   input.CreateShareLinkInput{TripID: "trip-id", ExpiresAt: &expiresAt, Password: "secret"},
+			setup: func() {
+				mockSecretService.EXPECT().GenerateToken().Return("token", nil)
+				mockPasswordService.EXPECT().HashPassword("secret").Return([]byte("password-hash"), nil)
+				mockTimeService.EXPECT().Now().Return(shareLinkFixedTime)
+				mockIDService.EXPECT().Generate().Return("share-link-id")
+				mockShareLinkRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, link *sharelink.ShareLink) error {
+						assert.Equal(t, tokenhash.Hash("token"), link.TokenHash())
+						assert.Equal(t, []byte("password-hash"), link.PasswordHash())
+						assert.Equal(t, &expiresAt, link.ExpiresAt())
+						assert.Equal(t, testActorID, link.CreatedBy())
+						return nil
+					})
+			},
+			want: &output.CreateShareLinkOutput{ID: "share-link-id", Token: "token"},
+		},
+		{
+			name: "正常系: パスワードを指定しなければハッシュ化しない",
+			in:   input.CreateShareLinkInput{TripID: "trip-id"},
+			setup: func() {
+				mockSecretService.EXPECT().GenerateToken().Return("token", nil)
+				mockTimeService.EXPECT().Now().Return(shareLinkFixedTime)
+				mockIDService.EXPECT().Generate().Return("share-link-id")
+				mockShareLinkRepo.EXPECT().Create(gomock.Any(), newTestShareLink(t, nil, nil)).Return(nil)
+			},
+			want: &output.CreateShareLinkOutput{ID: "share-link-id", Token: "token"},
+		},
+		{
+			name: "異常系: 有効期限が過去",
+			in:   input.CreateShareLinkInput{TripID: "trip-id", ExpiresAt: &pastExpiresAt},
+			setup: func() {
+				mockSecretService.EXPECT().GenerateToken().Return("token", nil)
+				mockTimeService.EXPECT().Now().Return(shareLinkFixedTime)
+				mockIDService.EXPECT().Generate().Return("share-link-id")
+			},
+			wantErr: sharelink.NewInvalidShareLinkExpiryError(),
+		},
+		{
+			name:    "異常系: 所有者でなければ作成できない",
+			ctx:     viewerCtx,
+			in:      input.CreateShareLinkInput{TripID: "trip-id"},
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+		{
+			name: "異常系: 保存時に予期しないエラーが返される",
+			in:   input.CreateShareLinkInput{TripID: "trip-id"},
+			setup: func() {
+				mockSecretService.EXPECT().GenerateToken().Return("token", nil)
+				mockTimeService.EXPECT().Now().Return(shareLinkFixedTime)
+				mockIDService.EXPECT().Generate().Return("share-link-id")
+				mockShareLinkRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to create share link", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Create(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestShareLinkInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockShareLinkRepo := mock_sharelink.NewMockShareLinkRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockUserRepo := mock_user.NewMockUserRepository(ctrl)
+	mockSecretService := mock_service.NewMockShareLinkSecretService(ctrl)
+	mockPasswordService := mock_service.NewMockPasswordService(ctrl)
+	mockRenderer := mock_service.NewMockMarkdownRenderer(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockRenderer.EXPECT().Render(gomock.Any()).DoAndReturn(renderJournalForTest).AnyTimes()
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	interactor := NewShareLinkInteractor(
+		mockShareLinkRepo,
+		mockTripRepo,
+		mockAccommodationRepo,
+		mockActivityRepo,
+		mockJournalRepo,
+		mockMemberRepo,
+		mockUserRepo,
+		mockSecretService,
+		mockPasswordService,
+		mockRenderer,
+		mockTimeService,
+		mockIDService,
+	)
+
+	links := []*sharelink.ShareLink{newTestShareLink(t, []byte("hash"), nil)}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.ListShareLinkOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 旅行の共有リンク一覧を取得できる",
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByTripID(gomock.Any(), shareLinkTripID).Return(links, nil)
+				mockTimeService.EXPECT().Now().Return(shareLinkFixedTime)
+			},
+			want: output.NewListShareLinkOutput(links, shareLinkFixedTime),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByTripID(gomock.Any(), shareLinkTripID).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list share links", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name:    "異常系: 所有者でなければ取得できない",
+			ctx:     viewerCtx,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.List(ctx, "trip-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestShareLinkInteractor_Revoke(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockShareLinkRepo := mock_sharelink.NewMockShareLinkRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockUserRepo := mock_user.NewMockUserRepository(ctrl)
+	mockSecretService := mock_service.NewMockShareLinkSecretService(ctrl)
+	mockPasswordService := mock_service.NewMockPasswordService(ctrl)
+	mockRenderer := mock_service.NewMockMarkdownRenderer(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockRenderer.EXPECT().Render(gomock.Any()).DoAndReturn(renderJournalForTest).AnyTimes()
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+
+	interactor := NewShareLinkInteractor(
+		mockShareLinkRepo,
+		mockTripRepo,
+		mockAccommodationRepo,
+		mockActivityRepo,
+		mockJournalRepo,
+		mockMemberRepo,
+		mockUserRepo,
+		mockSecretService,
+		mockPasswordService,
+		mockRenderer,
+		mockTimeService,
+		mockIDService,
+	)
+
+	link := newTestShareLink(t, nil, nil)
+	now := shareLinkFixedTime.Add(time.Hour)
+	revoked, err := link.Revoke(now)
+	require.NoError(t, err)
+	otherTripLink, err := sharelink.NewShareLink(shareLinkID, trip.NewTripID("other-trip-id"), tokenhash.Hash("token"), nil, nil, testActorID, shareLinkFixedTime)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 共有リンクを無効化できる",
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByID(gomock.Any(), shareLinkID).Return(link, nil)
+				mockTimeService.EXPECT().Now().Return(now)
+				mockShareLinkRepo.EXPECT().Update(gomock.Any(), revoked).Return(nil)
+			},
+		},
+		{
+			name: "異常系: 他の旅行の共有リンクは無効化できない",
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByID(gomock.Any(), shareLinkID).Return(otherTripLink, nil)
+			},
+			wantErr: sharelink.NewShareLinkNotFoundError(),
+		},
+		{
+			name: "異常系: 無効化済みの共有リンク",
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByID(gomock.Any(), shareLinkID).Return(revoked, nil)
+				mockTimeService.EXPECT().Now().Return(now)
+			},
+			wantErr: sharelink.NewShareLinkAlreadyRevokedError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByID(gomock.Any(), shareLinkID).Return(link, nil)
+				mockTimeService.EXPECT().Now().Return(now)
+				mockShareLinkRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to revoke share link", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			err := interactor.Revoke(newActorContext(), "trip-id", "share-link-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestShareLinkInteractor_GetShared(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockShareLinkRepo := mock_sharelink.NewMockShareLinkRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockUserRepo := mock_user.NewMockUserRepository(ctrl)
+	mockSecretService := mock_service.NewMockShareLinkSecretService(ctrl)
+	mockPasswordService := mock_service.NewMockPasswordService(ctrl)
+	mockRenderer := mock_service.NewMockMarkdownRenderer(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockRenderer.EXPECT().Render(gomock.Any()).DoAndReturn(renderJournalForTest).AnyTimes()
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+
+	interactor := NewShareLinkInteractor(
+		mockShareLinkRepo,
+		mockTripRepo,
+		mockAccommodationRepo,
+		mockActivityRepo,
+		mockJournalRepo,
+		mockMemberRepo,
+		mockUserRepo,
+		mockSecretService,
+		mockPasswordService,
+		mockRenderer,
+		mockTimeService,
+		mockIDService,
+	)
+
+	period, err := trip.NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	sharedTrip := trip.NewTrip(shareLinkTripID, "家族旅行", period, "", shareLinkFixedTime, shareLinkFixedTime)
+	stay, err := accommodation.NewStay(time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	cost, err := money.NewMoney(30000, "JPY")
+	require.NoError(t, err)
+	hotel := accommodation.NewAccommodation(
+		accommodation.NewAccommodationID("accommodation-id"), shareLinkTripID,
+		"ホテル", "東京都", nil, stay, "CONF-123", cost, "暗証番号 1234", shareLinkFixedTime, shareLinkFixedTime,
+	)
+	place, err := geo.NewPlace("浅草寺", nil, nil)
+	require.NoError(t, err)
+	diary := journal.NewEntry(
+		journal.NewEntryID("entry-id"), shareLinkTripID, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		"初日", "雷門で写真を撮った", journal.MoodGreat, &place, shareLinkFixedTime, shareLinkFixedTime,
+	)
+	tz, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	startAt := time.Date(2024, 5, 2, 1, 0, 0, 0, time.UTC)
+	sightseeing, err := itinerary.NewActivity(
+		itinerary.NewActivityID("activity-id"), shareLinkTripID, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), 0,
+		"浅草寺を参拝", &place, &tz, &startAt, nil, itinerary.TravelModeWalk, "集合場所は雷門", shareLinkFixedTime, shareLinkFixedTime,
+	)
+	require.NoError(t, err)
+	owner := user.NewUser(testActorID, "owner", "owner@example.com", []byte("hash"), shareLinkFixedTime, shareLinkFixedTime)
+	expiresAt := shareLinkFixedTime.Add(time.Hour)
+	revoked, err := newTestShareLink(t, nil, nil).Revoke(shareLinkFixedTime)
+	require.NoError(t, err)
+
+	expectSharedTrip := func() {
+		mockTripRepo.EXPECT().FindByID(gomock.Any(), shareLinkTripID).Return(sharedTrip, nil)
+		mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), shareLinkTripID).Return([]*accommodation.Accommodation{hotel}, nil)
+		mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), shareLinkTripID, nil).Return([]*itinerary.Activity{sightseeing}, nil)
+		mockJournalRepo.EXPECT().FindByTripID(gomock.Any(), shareLinkTripID, nil).Return([]*journal.Entry{diary}, nil)
+		mockMemberRepo.EXPECT().FindByTripID(gomock.Any(), shareLinkTripID).Return([]*membership.Member{
+			membership.NewMember(shareLinkTripID, testActorID, membership.RoleOwner, shareLinkFixedTime, shareLinkFixedTime),
+		}, nil)
+		mockUserRepo.EXPECT().FindByID(gomock.Any(), testActorID).Return(owner, nil)
+	}
+
+	startDate := period.StartDate()
+	endDate := period.EndDate()
+	placeName := "浅草寺"
+	tzName := "Asia/Tokyo"
+	// 費用や予約番号、行動のメモ、メールアドレスは含まれない
+	wantShared := &output.GetSharedTripOutput{
+		Trip: &output.SharedTrip{Name: "家族旅行", StartDate: &startDate, EndDate: &endDate},
+		Accommodations: []*output.SharedAccommodation{{
+			Name:       "ホテル",
+			Address:    "東京都",
+			CheckInAt:  stay.CheckInAt(),
+			CheckOutAt: stay.CheckOutAt(),
+		}},
+		Activities: []*output.SharedActivity{{
+			Date:       sightseeing.Date(),
+			Position:   0,
+			Title:      "浅草寺を参拝",
+			PlaceName:  &placeName,
+			Timezone:   &tzName,
+			StartAt:    &startAt,
+			TravelMode: "walk",
+		}},
+		JournalEntries: []*output.SharedJournalEntry{{
+			Date:      diary.Date(),
+			Title:     "初日",
+			BodyHTML:  "<p>雷門で写真を撮った</p>",
+			Mood:      "great",
+			PlaceName: &placeName,
+		}},
+		Members: []*output.SharedMember{{Username: "owner", Role: "owner"}},
+	}
+
+	tests := []struct {
+		name    string
+		in      input.GetSharedTripInput
+		setup   func()
+		want    *output.GetSharedTripOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 費用や予約番号、行動のメモ、メールアドレスを含まない旅行の情報と HTML に変換した日記を取得できる",
+			in:   input.GetSharedTripInput{Token: "token"},
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByTokenHash(gomock.Any(), tokenhash.Hash("token")).Return(newTestShareLink(t, nil, nil), nil)
+				mockTimeService.EXPECT().Now().Return(shareLinkFixedTime)
+				expectSharedTrip()
+			},
+			want: wantShared,
+		},
+		{
+			name: "正常系: パスワードが一致すれば取得できる",
+			in:   input.GetSharedTripInput{Token: "token", Password: "secret"},
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByTokenHash(gomock.Any(), tokenhash.Hash("token")).Return(newTestShareLink(t, []byte("hash"), nil), nil)
+				mockTimeService.EXPECT().Now().Return(shareLinkFixedTime)
+				mockPasswordService.EXPECT().VerifyPassword([]byte("hash"), "secret").Return(true)
+				expectSharedTrip()
+			},
+			want: wantShared,
+		},
+		{
+			name: "異常系: パスワードが一致しない",
+			in:   input.GetSharedTripInput{Token: "token", Password: "wrong"},
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByTokenHash(gomock.Any(), tokenhash.Hash("token")).Return(newTestShareLink(t, []byte("hash"), nil), nil)
+				mockTimeService.EXPECT().Now().Return(shareLinkFixedTime)
+				mockPasswordService.EXPECT().VerifyPassword([]byte("hash"), "wrong").Return(false)
+			},
+			wantErr: sharelink.NewShareLinkPasswordMismatchError(),
+		},
+		{
+			name: "異常系: 期限切れのリンクは存在しないものとして扱う",
+			in:   input.GetSharedTripInput{Token: "token"},
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByTokenHash(gomock.Any(), tokenhash.Hash("token")).Return(newTestShareLink(t, nil, &expiresAt), nil)
+				mockTimeService.EXPECT().Now().Return(expiresAt)
+			},
+			wantErr: sharelink.NewShareLinkNotFoundError(),
+		},
+		{
+			name: "異常系: 無効化済みのリンクは存在しないものとして扱う",
+			in:   input.GetSharedTripInput{Token: "token"},
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByTokenHash(gomock.Any(), tokenhash.Hash("token")).Return(revoked, nil)
+				mockTimeService.EXPECT().Now().Return(shareLinkFixedTime)
+			},
+			wantErr: sharelink.NewShareLinkNotFoundError(),
+		},
+		{
+			name: "異常系: 存在しないトークン",
+			in:   input.GetSharedTripInput{Token: "unknown"},
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByTokenHash(gomock.Any(), tokenhash.Hash("unknown")).Return(nil, sharelink.NewShareLinkNotFoundError())
+			},
+			wantErr: sharelink.NewShareLinkNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   input.GetSharedTripInput{Token: "token"},
+			setup: func() {
+				mockShareLinkRepo.EXPECT().FindByTokenHash(gomock.Any(), tokenhash.Hash("token")).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get share link", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.GetShared(context.Background(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/triptemplate"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/template.go github.com/hata0/travel-api/internal/usecase TemplateUsecase
+type TemplateUsecase interface {
+	Create(ctx context.Context, in input.CreateTemplateInput) (*output.CreateTemplateOutput, error)
+	Get(ctx context.Context, id string) (*output.GetTemplateOutput, error)
+	List(ctx context.Context) (*output.ListTemplateOutput, error)
+	ListPublic(ctx context.Context) (*output.ListTemplateOutput, error)
+	Update(ctx context.Context, in input.UpdateTemplateInput) error
+	Delete(ctx context.Context, id string) error
+}
+
+type TemplateInteractor struct {
+	templateRepository      triptemplate.TemplateRepository
+	tripRepository          trip.TripRepository
+	accommodationRepository accommodation.AccommodationRepository
+	budgetRepository        budget.BudgetRepository
+	checklistRepository     checklist.ChecklistRepository
+	activityRepository      itinerary.ActivityRepository
+	authorizer              tripAuthorizer
+	timeService             service.TimeService
+	idService               service.IDService
+}
+
+func NewTemplateInteractor(
+	templateRepository triptemplate.TemplateRepository,
+	tripRepository trip.TripRepository,
+	accommodationRepository accommodation.AccommodationRepository,
+	budgetRepository budget.BudgetRepository,
+	checklistRepository checklist.ChecklistRepository,
+	activityRepository itinerary.ActivityRepository,
+	memberRepository membership.MemberRepository,
+	timeService service.TimeService,
+	idService service.IDService,
+) TemplateUsecase {
+	return &TemplateInteractor{
+		templateRepository:      templateRepository,
+		tripRepository:          tripRepository,
+		accommodationRepository: accommodationRepository,
+		budgetRepository:        budgetRepository,
+		checklistRepository:     checklistRepository,
+		activityRepository:      activityRepository,
+		authorizer:              newTripAuthorizer(memberRepository),
+		timeService:             timeService,
+		idService:               idService,
+	}
+}
+
+// Create は旅行の宿泊予約と予算、チェックリスト、行動を取り込んだ雛形を作成し、実行ユーザーをその所有者とする。
+// 旅行を閲覧できるメンバーであれば作成できる
+func (i *TemplateInteractor) Create(ctx context.Context, in input.CreateTemplateInput) (*output.CreateTemplateOutput, error) {
+	tripID := trip.NewTripID(in.TripID)
+
+	m, err := i.authorizer.authorize(ctx, tripID, membership.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := loadTripContents(ctx, i.tripRepository, i.accommodationRepository, i.budgetRepository, i.checklistRepository, i.activityRepository, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := in.Name
+	if name == "" {
+		name = contents.trip.Name()
+	}
+
+	now := i.timeService.Now()
+	tmpl := triptemplate.NewTemplate(
+		triptemplate.NewTemplateID(i.idService.Generate()),
+		m.UserID(),
+		name,
+		in.Description,
+		in.Public,
+		triptemplate.NewContentFromTrip(contents.trip, contents.accommodations, contents.budget, contents.checklists, contents.activities),
+		now,
+		now,
+	)
+
+	if err := i.templateRepository.Create(ctx, tmpl); err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to create template", apperr.WithCause(err))
+	}
+
+	return output.NewCreateTemplateOutput(tmpl.ID()), nil
+}
+
+// Get は実行ユーザーが所有する雛形か、公開されている雛形を取得する
+func (i *TemplateInteractor) Get(ctx context.Context, id string) (*output.GetTemplateOutput, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := i.findTemplate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tmpl.AuthorizeView(userID); err != nil {
+		return nil, err
+	}
+
+	return output.NewGetTemplateOutput(tmpl), nil
+}
+
+// List は実行ユーザーが所有する雛形を新しい順に取得する
+func (i *TemplateInteractor) List(ctx context.Context) (*output.ListTemplateOutput, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := i.templateRepository.FindByOwnerID(ctx, userID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list templates", apperr.WithCause(err))
+	}
+
+	return output.NewListTemplateOutput(templates), nil
+}
+
+// ListPublic は公開されている雛形を新しい順に取得する
+func (i *TemplateInteractor) ListPublic(ctx context.Context) (*output.ListTemplateOutput, error) {
+	templates, err := i.templateRepository.FindPublic(ctx)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list public templates", apperr.WithCause(err))
+	}
+
+	return output.NewListTemplateOutput(templates), nil
+}
+
+// Update は雛形の名前・説明・公開設定を更新する。所有者だけが更新できる
+func (i *TemplateInteractor) Update(ctx context.Context, in input.UpdateTemplateInput) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := i.findTemplate(ctx, in.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := tmpl.AuthorizeEdit(userID); err != nil {
+		return err
+	}
+
+	updated := tmpl.Update(in.Name, in.Description, in.Public, i.timeService.Now())
+
+	if err := i.templateRepository.Update(ctx, updated); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to update template", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は雛形を削除する。所有者だけが削除でき、雛形から作成済みの旅行には影響しない
+func (i *TemplateInteractor) Delete(ctx context.Context, id string) error {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := i.findTemplate(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := tmpl.AuthorizeEdit(userID); err != nil {
+		return err
+	}
+
+	if err := i.templateRepository.Delete(ctx, tmpl.ID()); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to delete template", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+func (i *TemplateInteractor) findTemplate(ctx context.Context, id string) (*triptemplate.Template, error) {
+	tmpl, err := i.templateRepository.FindByID(ctx, triptemplate.NewTemplateID(id))
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get template", apperr.WithCause(err))
+	}
+	return tmpl, nil
+}
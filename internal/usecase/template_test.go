@@ -0,0 +1,531 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	mock_accommodation "github.com/hata0/travel-api/internal/domain/accommodation/mock"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	mock_budget "github.com/hata0/travel-api/internal/domain/budget/mock"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	mock_checklist "github.com/hata0/travel-api/internal/domain/checklist/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	mock_itinerary "github.com/hata0/travel-api/internal/domain/itinerary/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/domain/triptemplate"
+	mock_triptemplate "github.com/hata0/travel-api/internal/domain/triptemplate/mock"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+func TestTemplateInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewTemplateInteractor(mockTemplateRepo, mockTripRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockMemberRepo, mockTimeService, mockIDService)
+
+	fixedTime := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	tripID := trip.NewTripID("trip-id")
+	period, err := trip.NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	source := trip.NewTrip(tripID, "京都旅行", period, "", fixedTime, fixedTime)
+	stay, err := accommodation.NewStay(time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	cost, err := money.NewMoney(32000, "JPY")
+	require.NoError(t, err)
+	accommodations := []*accommodation.Accommodation{
+		accommodation.NewAccommodation(accommodation.NewAccommodationID("accommodation-id"), tripID, "Hotel", "Kyoto", nil, stay, "ABC123", cost, "", fixedTime, fixedTime),
+	}
+	checklists := []*checklist.Checklist{newChecklistTestChecklist(t, "checklist-id", tripID)}
+	activity, err := itinerary.NewActivity(itinerary.NewActivityID("activity-id"), tripID, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), 0, "金閣寺", nil, nil, nil, nil, itinerary.TravelModeWalk, "", fixedTime, fixedTime)
+	require.NoError(t, err)
+	activities := []*itinerary.Activity{activity}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.CreateTemplateInput
+		setup   func()
+		want    *output.CreateTemplateOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者も旅行の内容を取り込んだ雛形を作成できる",
+			ctx:  viewerCtx,
+			in:   input.CreateTemplateInput{TripID: tripID.String(), Description: "定番", Public: true},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), tripID).Return(source, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(accommodations, nil)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(nil, budget.NewBudgetNotFoundError())
+				mockChecklistRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(checklists, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), tripID, nil).Return(activities, nil)
+				mockTimeService.EXPECT().Now().Return(fixedTime)
+				mockIDService.EXPECT().Generate().Return("template-id")
+				// 名前を省略した場合は旅行の名前を使う
+				mockTemplateRepo.EXPECT().Create(gomock.Any(), triptemplate.NewTemplate(
+					triptemplate.NewTemplateID("template-id"),
+					testViewerID,
+					"京都旅行",
+					"定番",
+					true,
+					triptemplate.NewContentFromTrip(source, accommodations, nil, checklists, activities),
+					fixedTime,
+					fixedTime,
+				)).Return(nil)
+			},
+			want: output.NewCreateTemplateOutput(triptemplate.NewTemplateID("template-id")),
+		},
+		{
+			name: "異常系: 旅行が存在しない",
+			in:   input.CreateTemplateInput{TripID: tripID.String(), Name: "雛形"},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), tripID).Return(nil, trip.NewTripNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   input.CreateTemplateInput{TripID: tripID.String(), Name: "雛形"},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), tripID).Return(source, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(nil, nil)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(nil, budget.NewBudgetNotFoundError())
+				mockChecklistRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(nil, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), tripID, nil).Return(nil, nil)
+				mockTimeService.EXPECT().Now().Return(fixedTime)
+				mockIDService.EXPECT().Generate().Return("template-id")
+				mockTemplateRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to create template", apperr.WithCause(errors.New("db error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Create(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTemplateInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	interactor := NewTemplateInteractor(mockTemplateRepo, mockTripRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockMemberRepo, mockTimeService, mockIDService)
+
+	fixedTime := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	templateID := triptemplate.NewTemplateID("template-id")
+	otherUserID := user.NewUserID("other-user-id")
+	ownPrivate := triptemplate.NewTemplate(templateID, testActorID, "雛形", "", false, triptemplate.NewContent(nil, nil, nil, nil, nil), fixedTime, fixedTime)
+	othersPublic := triptemplate.NewTemplate(templateID, otherUserID, "雛形", "", true, triptemplate.NewContent(nil, nil, nil, nil, nil), fixedTime, fixedTime)
+	othersPrivate := triptemplate.NewTemplate(templateID, otherUserID, "雛形", "", false, triptemplate.NewContent(nil, nil, nil, nil, nil), fixedTime, fixedTime)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *output.GetTemplateOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 自分の非公開の雛形を取得できる",
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(ownPrivate, nil)
+			},
+			want: output.NewGetTemplateOutput(ownPrivate),
+		},
+		{
+			name: "正常系: 他人の公開された雛形を取得できる",
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(othersPublic, nil)
+			},
+			want: output.NewGetTemplateOutput(othersPublic),
+		},
+		{
+			name: "異常系: 他人の非公開の雛形は存在しないものとして扱う",
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(othersPrivate, nil)
+			},
+			wantErr: triptemplate.NewTemplateNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(nil, errors.New("db error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get template", apperr.WithCause(errors.New("db error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.Get(newActorContext(), templateID.String())
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTemplateInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	interactor := NewTemplateInteractor(mockTemplateRepo, mockTripRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockMemberRepo, mockTimeService, mockIDService)
+
+	fixedTime := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	templates := []*triptemplate.Template{
+		triptemplate.NewTemplate(triptemplate.NewTemplateID("template-id"), testActorID, "雛形", "", false, triptemplate.NewContent(nil, nil, nil, nil, nil), fixedTime, fixedTime),
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.ListTemplateOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 自分の雛形を取得する",
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByOwnerID(gomock.Any(), testActorID).Return(templates, nil)
+			},
+			want: output.NewListTemplateOutput(templates),
+		},
+		{
+			name:    "異常系: 実行ユーザーがいない場合は取得できない",
+			ctx:     context.Background(),
+			setup:   func() {},
+			wantErr: apperr.NewInvalidCredentialsError("authenticated user is required"),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByOwnerID(gomock.Any(), testActorID).Return(nil, errors.New("db error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list templates", apperr.WithCause(errors.New("db error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.List(ctx)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTemplateInteractor_ListPublic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	interactor := NewTemplateInteractor(mockTemplateRepo, mockTripRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockMemberRepo, mockTimeService, mockIDService)
+
+	fixedTime := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	templates := []*triptemplate.Template{
+		triptemplate.NewTemplate(triptemplate.NewTemplateID("template-id"), user.NewUserID("other-user-id"), "雛形", "", true, triptemplate.NewContent(nil, nil, nil, nil, nil), fixedTime, fixedTime),
+	}
+
+	tests := []struct {
+		name    string
+		setup   func()
+		want    *output.ListTemplateOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 公開された雛形を取得する",
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindPublic(gomock.Any()).Return(templates, nil)
+			},
+			want: output.NewListTemplateOutput(templates),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindPublic(gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list public templates", apperr.WithCause(errors.New("db error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.ListPublic(newActorContext())
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTemplateInteractor_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	interactor := NewTemplateInteractor(mockTemplateRepo, mockTripRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockMemberRepo, mockTimeService, mockIDService)
+
+	fixedTime := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	now := fixedTime.Add(time.Hour)
+	templateID := triptemplate.NewTemplateID("template-id")
+	own := triptemplate.NewTemplate(templateID, testActorID, "雛形", "", false, triptemplate.NewContent(nil, nil, nil, nil, nil), fixedTime, fixedTime)
+	othersPublic := triptemplate.NewTemplate(templateID, user.NewUserID("other-user-id"), "雛形", "", true, triptemplate.NewContent(nil, nil, nil, nil, nil), fixedTime, fixedTime)
+	validInput := input.UpdateTemplateInput{ID: templateID.String(), Name: "新しい雛形", Description: "説明", Public: true}
+
+	tests := []struct {
+		name    string
+		in      input.UpdateTemplateInput
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 所有者は雛形を更新できる",
+			in:   validInput,
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(own, nil)
+				mockTimeService.EXPECT().Now().Return(now)
+				mockTemplateRepo.EXPECT().Update(gomock.Any(), own.Update("新しい雛形", "説明", true, now)).Return(nil)
+			},
+		},
+		{
+			name: "異常系: 他人の公開された雛形は更新できない",
+			in:   input.UpdateTemplateInput{ID: templateID.String(), Name: "乗っ取り"},
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(othersPublic, nil)
+			},
+			wantErr: triptemplate.NewNotTemplateOwnerError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(own, nil)
+				mockTimeService.EXPECT().Now().Return(now)
+				mockTemplateRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to update template", apperr.WithCause(errors.New("db error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			err := interactor.Update(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTemplateInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	interactor := NewTemplateInteractor(mockTemplateRepo, mockTripRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockMemberRepo, mockTimeService, mockIDService)
+
+	fixedTime := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	templateID := triptemplate.NewTemplateID("template-id")
+	own := triptemplate.NewTemplate(templateID, testActorID, "雛形", "", false, triptemplate.NewContent(nil, nil, nil, nil, nil), fixedTime, fixedTime)
+	othersPublic := triptemplate.NewTemplate(templateID, user.NewUserID("other-user-id"), "雛形", "", true, triptemplate.NewContent(nil, nil, nil, nil, nil), fixedTime, fixedTime)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 所有者は雛形を削除できる",
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(own, nil)
+				mockTemplateRepo.EXPECT().Delete(gomock.Any(), templateID).Return(nil)
+			},
+		},
+		{
+			name: "異常系: 他人の公開された雛形は削除できない",
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(othersPublic, nil)
+			},
+			wantErr: triptemplate.NewNotTemplateOwnerError(),
+		},
+		{
+			name: "異常系: 存在しない雛形は削除できない",
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(nil, triptemplate.NewTemplateNotFoundError())
+			},
+			wantErr: triptemplate.NewTemplateNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(own, nil)
+				mockTemplateRepo.EXPECT().Delete(gomock.Any(), templateID).Return(errors.New("db error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to delete template", apperr.WithCause(errors.New("db error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			err := interactor.Delete(newActorContext(), templateID.String())
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
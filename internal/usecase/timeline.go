@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/timeline"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+// defaultTimelineTimezone はタイムゾーンが指定されていない場合に時系列の項目を表すタイムゾーン
+const defaultTimelineTimezone = "UTC"
+
+//go:generate mockgen -destination mock/timeline.go github.com/hata0/travel-api/internal/usecase TimelineUsecase
+type TimelineUsecase interface {
+	Get(ctx context.Context, in input.GetTimelineInput) (*output.GetTimelineOutput, error)
+}
+
+type TimelineInteractor struct {
+	tripRepository          trip.TripRepository
+	activityRepository      itinerary.ActivityRepository
+	legRepository           transport.LegRepository
+	accommodationRepository accommodation.AccommodationRepository
+	journalRepository       journal.EntryRepository
+	authorizer              tripAuthorizer
+}
+
+func NewTimelineInteractor(
+	tripRepository trip.TripRepository,
+	activityRepository itinerary.ActivityRepository,
+	legRepository transport.LegRepository,
+	accommodationRepository accommodation.AccommodationRepository,
+	journalRepository journal.EntryRepository,
+	memberRepository membership.MemberRepository,
+) TimelineUsecase {
+	return &TimelineInteractor{
+		tripRepository:          tripRepository,
+		activityRepository:      activityRepository,
+		legRepository:           legRepository,
+		accommodationRepository: accommodationRepository,
+		journalRepository:       journalRepository,
+		authorizer:              newTripAuthorizer(memberRepository),
+	}
+}
+
+// Get は旅行の行動・移動・宿泊予約のチェックインとチェックアウト・日記を始まる瞬間の早い順に並べ、それぞれの現地時刻で返す。
+// 移動は出発地と到着地のタイムゾーンで表し、タイムゾーンが決まっていない項目は入力のタイムゾーン（空なら UTC）で表す。
+// 閲覧者以上のメンバーが取得できる
+func (i *TimelineInteractor) Get(ctx context.Context, in input.GetTimelineInput) (*output.GetTimelineOutput, error) {
+	name := in.TimeZone
+	if name == "" {
+		name = defaultTimelineTimezone
+	}
+	defaultTimezone, err := geo.NewTimezone(name)
+	if err != nil {
+		return nil, err
+	}
+
+	tripID := trip.NewTripID(in.TripID)
+	if _, err := i.authorizer.authorize(ctx, tripID, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	t, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+
+	activities, err := i.activityRepository.FindByTripID(ctx, t.ID(), nil)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list activities", apperr.WithCause(err))
+	}
+
+	legs, err := i.legRepository.FindByTripID(ctx, t.ID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list transport legs", apperr.WithCause(err))
+	}
+
+	accommodations, err := i.accommodationRepository.FindByTripID(ctx, t.ID())
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list accommodations", apperr.WithCause(err))
+	}
+
+	entries, err := i.journalRepository.FindByTripID(ctx, t.ID(), nil)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list journal entries", apperr.WithCause(err))
+	}
+
+	return output.NewGetTimelineOutput(timeline.Build(activities, legs, accommodations, entries, defaultTimezone)), nil
+}
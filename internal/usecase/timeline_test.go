@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	mock_accommodation "github.com/hata0/travel-api/internal/domain/accommodation/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	mock_itinerary "github.com/hata0/travel-api/internal/domain/itinerary/mock"
+	"github.com/hata0/travel-api/internal/domain/journal"
+	mock_journal "github.com/hata0/travel-api/internal/domain/journal/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	mock_transport "github.com/hata0/travel-api/internal/domain/transport/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+)
+
+func TestTimelineInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockJournalRepo := mock_journal.NewMockEntryRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleViewer)
+
+	interactor := NewTimelineInteractor(mockTripRepo, mockActivityRepo, mockLegRepo, mockAccommodationRepo, mockJournalRepo, mockMemberRepo)
+
+	paris, err := geo.NewTimezone("Europe/Paris")
+	require.NoError(t, err)
+	tokyo, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	// パリは 2023-08-01 が夏時間（+02:00）のため、UTC の 8 時は現地の 10 時
+	startAt := time.Date(2023, 8, 1, 8, 0, 0, 0, time.UTC)
+	endAt := time.Date(2023, 8, 1, 9, 30, 0, 0, time.UTC)
+	louvre, err := geo.NewPlace("ルーブル美術館", nil, &paris)
+	require.NoError(t, err)
+	museum := newItineraryTestActivity(t, "activity-1", journalTripID, 0, &louvre, &startAt, &endAt)
+	hotel := newAccommodationTestAccommodation(t, "accommodation-1", journalTripID)
+	entry := newJournalTestEntry("entry-1", journalTripID)
+	leg := newTransportTestLeg(t, "leg-1", journalTripID)
+
+	aug1 := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	aug2 := time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC)
+	legEndAt := time.Date(2023, 8, 1, 11, 30, 0, 0, tokyo.Location())
+	museumEndAt := time.Date(2023, 8, 1, 11, 30, 0, 0, paris.Location())
+	// 宿泊予約は宿泊先のタイムゾーンの現地時刻と日付で表される
+	checkIn := output.TimelineItem{Kind: "check_in", ID: "accommodation-1", Title: "Hotel", Timezone: "Asia/Tokyo", EndTimezone: "Asia/Tokyo", Date: aug2, StartAt: time.Date(2023, 8, 2, 0, 0, 0, 0, tokyo.Location())}
+	checkOut := output.TimelineItem{Kind: "check_out", ID: "accommodation-1", Title: "Hotel", Timezone: "Asia/Tokyo", EndTimezone: "Asia/Tokyo", Date: aug2, StartAt: time.Date(2023, 8, 2, 19, 0, 0, 0, tokyo.Location())}
+
+	tests := []struct {
+		name    string
+		in      input.GetTimelineInput
+		setup   func()
+		want    *output.GetTimelineOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 行動・移動・宿泊予約・日記を始まる瞬間の順に現地時刻で返す",
+			in:   input.GetTimelineInput{TripID: "trip-id", TimeZone: "Asia/Tokyo"},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(newPeriodTestTrip(t, journalTripID), nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return([]*itinerary.Activity{museum}, nil)
+				mockLegRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID).Return([]*transport.Leg{leg}, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID).Return([]*accommodation.Accommodation{hotel}, nil)
+				mockJournalRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return([]*journal.Entry{entry}, nil)
+			},
+			want: &output.GetTimelineOutput{Items: []output.TimelineItem{
+				// 場所のタイムゾーンがない日記は入力のタイムゾーンで表される
+				{Kind: "journal_entry", ID: "entry-1", Title: "1日目", Timezone: "Asia/Tokyo", EndTimezone: "Asia/Tokyo", Date: aug1, AllDay: true, StartAt: time.Date(2023, 8, 1, 0, 0, 0, 0, tokyo.Location())},
+				// 移動は出発地のタイムゾーンで始まり、到着地のタイムゾーンで終わる
+				{Kind: "transport_leg", ID: "leg-1", Title: "JR東海 のぞみ1号: 東京駅 → 新大阪駅", Timezone: "Asia/Tokyo", EndTimezone: "Asia/Tokyo", Date: aug1, StartAt: time.Date(2023, 8, 1, 9, 0, 0, 0, tokyo.Location()), EndAt: &legEndAt},
+				// 行動は場所のタイムゾーンで表される
+				{Kind: "activity", ID: "activity-1", Title: "行動 activity-1", Timezone: "Europe/Paris", EndTimezone: "Europe/Paris", Date: aug1, StartAt: time.Date(2023, 8, 1, 10, 0, 0, 0, paris.Location()), EndAt: &museumEndAt},
+				checkIn,
+				checkOut,
+			}},
+		},
+		{
+			name: "正常系: タイムゾーンを指定しない場合、タイムゾーンの決まっていない項目は UTC で表す",
+			in:   input.GetTimelineInput{TripID: "trip-id"},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(newPeriodTestTrip(t, journalTripID), nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return(nil, nil)
+				mockLegRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID).Return(nil, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID).Return([]*accommodation.Accommodation{hotel}, nil)
+				mockJournalRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return([]*journal.Entry{entry}, nil)
+			},
+			want: &output.GetTimelineOutput{Items: []output.TimelineItem{
+				{Kind: "journal_entry", ID: "entry-1", Title: "1日目", Timezone: "UTC", EndTimezone: "UTC", Date: aug1, AllDay: true, StartAt: aug1},
+				checkIn,
+				checkOut,
+			}},
+		},
+		{
+			name:    "異常系: IANA のタイムゾーン名でない場合はバリデーションエラー",
+			in:      input.GetTimelineInput{TripID: "trip-id", TimeZone: "Local"},
+			setup:   func() {},
+			wantErr: geo.NewInvalidTimezoneError(),
+		},
+		{
+			name: "異常系: 旅行が存在しない",
+			in:   input.GetTimelineInput{TripID: "trip-id"},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(nil, trip.NewTripNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: 行動の取得で予期しないエラーが返される",
+			in:   input.GetTimelineInput{TripID: "trip-id"},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(newPeriodTestTrip(t, journalTripID), nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list activities", apperr.WithCause(errors.New("database connection error"))),
+		},
+		{
+			name: "異常系: 移動の取得で予期しないエラーが返される",
+			in:   input.GetTimelineInput{TripID: "trip-id"},
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), journalTripID).Return(newPeriodTestTrip(t, journalTripID), nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID, nil).Return(nil, nil)
+				mockLegRepo.EXPECT().FindByTripID(gomock.Any(), journalTripID).Return(nil, errors.New("database connection error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list transport legs", apperr.WithCause(errors.New("database connection error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			got, err := interactor.Get(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTimelineInteractor_Get_NotMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), journalTripID, testActorID).Return(nil, membership.NewMemberNotFoundError())
+
+	interactor := NewTimelineInteractor(
+		mock_trip.NewMockTripRepository(ctrl),
+		mock_itinerary.NewMockActivityRepository(ctrl),
+		mock_transport.NewMockLegRepository(ctrl),
+		mock_accommodation.NewMockAccommodationRepository(ctrl),
+		mock_journal.NewMockEntryRepository(ctrl),
+		mockMemberRepo,
+	)
+
+	got, err := interactor.Get(newActorContext(), input.GetTimelineInput{TripID: "trip-id"})
+
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, trip.NewTripNotFoundError(), "旅行のメンバーでない場合は旅行が見つからないものとして扱うべき")
+}
@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/track"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/track.go github.com/hata0/travel-api/internal/usecase TrackUsecase
+type TrackUsecase interface {
+	Get(ctx context.Context, tripID, id string) (*output.GetTrackOutput, error)
+	List(ctx context.Context, tripID string, activityID *string) (*output.ListTrackOutput, error)
+	Upload(ctx context.Context, in input.UploadTrackInput) (*output.UploadTrackOutput, error)
+	Delete(ctx context.Context, tripID, id string) error
+}
+
+type TrackInteractor struct {
+	trackRepository    track.TrackRepository
+	activityRepository itinerary.ActivityRepository
+	authorizer         tripAuthorizer
+	trackParser        service.TrackParser
+	timeService        service.TimeService
+	idService          service.IDService
+	maxSizeBytes       int64
+}
+
+// NewTrackInteractor はトラックのユースケースを作成する。maxSizeBytes はアップロードできる GPX ファイル1つあたりの最大サイズ
+func NewTrackInteractor(
+	trackRepository track.TrackRepository,
+	activityRepository itinerary.ActivityRepository,
+	memberRepository membership.MemberRepository,
+	trackParser service.TrackParser,
+	timeService service.TimeService,
+	idService service.IDService,
+	maxSizeBytes int64,
+) TrackUsecase {
+	return &TrackInteractor{
+		trackRepository:    trackRepository,
+		activityRepository: activityRepository,
+		authorizer:         newTripAuthorizer(memberRepository),
+		trackParser:        trackParser,
+		timeService:        timeService,
+		idService:          idService,
+		maxSizeBytes:       maxSizeBytes,
+	}
+}
+
+// Get は旅行に紐づく指定されたIDのトラックを、統計と間引いた軌跡とともに取得する
+func (i *TrackInteractor) Get(ctx context.Context, tripID, id string) (*output.GetTrackOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	t, err := i.findInTrip(ctx, trip.NewTripID(tripID), track.NewTrackID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetTrackOutput(t), nil
+}
+
+// List は旅行のトラックを記録の開始日時の順に取得する。activityID が nil でない場合はその行動のトラックだけを返す
+func (i *TrackInteractor) List(ctx context.Context, tripID string, activityID *string) (*output.ListTrackOutput, error) {
+	id := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorize(ctx, id, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	tracks, err := i.trackRepository.FindByTripID(ctx, id, activityID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list tracks", apperr.WithCause(err))
+	}
+
+	return output.NewListTrackOutput(tracks), nil
+}
+
+// Upload は GPX ファイルの軌跡から距離・移動時間・標高などの統計を求め、間引いた軌跡とともにトラックとして旅行に追加する。
+// GPX ファイルそのものは保存しない
+func (i *TrackInteractor) Upload(ctx context.Context, in input.UploadTrackInput) (*output.UploadTrackOutput, error) {
+	tripID := trip.NewTripID(in.TripID)
+
+	m, err := i.authorizer.authorize(ctx, tripID, membership.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.ActivityID != nil {
+		if err := i.ensureActivityInTrip(ctx, tripID, itinerary.NewActivityID(*in.ActivityID)); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case in.Size <= 0:
+		return nil, track.NewEmptyFileError()
+	case in.Size > i.maxSizeBytes:
+		return nil, track.NewFileTooLargeError()
+	}
+
+	// 申告されたサイズを超えて読み込まないよう、上限を確認したサイズまでに制限して解析する
+	parsed, err := i.trackParser.Parse(io.LimitReader(in.Content, in.Size))
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to parse track file", apperr.WithCause(err))
+	}
+
+	t, err := track.NewTrack(
+		track.NewTrackID(i.idService.Generate()),
+		tripID,
+		in.ActivityID,
+		trackName(in.Name, parsed.Name, in.Filename),
+		parsed.Segments,
+		m.UserID(),
+		i.timeService.Now(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.trackRepository.Create(ctx, t); err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to create track", apperr.WithCause(err))
+	}
+
+	return output.NewUploadTrackOutput(t), nil
+}
+
+// Delete は旅行に紐づく指定されたIDのトラックを削除する
+func (i *TrackInteractor) Delete(ctx context.Context, tripID, id string) error {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleEditor); err != nil {
+		return err
+	}
+
+	t, err := i.findInTrip(ctx, trip.NewTripID(tripID), track.NewTrackID(id))
+	if err != nil {
+		return err
+	}
+
+	if err := i.trackRepository.Delete(ctx, t.ID()); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to delete track", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// findInTrip はトラックを取得し、指定された旅行に属していることを確認する。
+// 別の旅行のトラックは存在しないものとして扱う
+func (i *TrackInteractor) findInTrip(ctx context.Context, tripID trip.TripID, id track.TrackID) (*track.Track, error) {
+	t, err := i.trackRepository.FindByID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get track", apperr.WithCause(err))
+	}
+
+	if !t.TripID().Equals(tripID) {
+		return nil, track.NewTrackNotFoundError()
+	}
+
+	return t, nil
+}
+
+// ensureActivityInTrip はトラックを紐づける行動が存在し、指定された旅行に属していることを確認する。
+// 別の旅行の行動は存在しないものとして扱う
+func (i *TrackInteractor) ensureActivityInTrip(ctx context.Context, tripID trip.TripID, id itinerary.ActivityID) error {
+	a, err := i.activityRepository.FindByID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to get activity", apperr.WithCause(err))
+	}
+
+	if !a.TripID().Equals(tripID) {
+		return itinerary.NewActivityNotFoundError()
+	}
+
+	return nil
+}
+
+// trackName は指定された名前、GPX に記録された名前、拡張子を除いたファイル名の順に、空でない最初のものを返す
+func trackName(name, recorded, filename string) string {
+	if name = strings.TrimSpace(name); name != "" {
+		return name
+	}
+	if recorded != "" {
+		return recorded
+	}
+	filename = attachment.SanitizeFilename(filename)
+	if base := strings.TrimSuffix(filename, path.Ext(filename)); base != "" {
+		return base
+	}
+	return filename
+}
@@ -0,0 +1,471 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	mock_itinerary "github.com/hata0/travel-api/internal/domain/itinerary/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/track"
+	mock_track "github.com/hata0/travel-api/internal/domain/track/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+// trackMaxSize はテストで使うアップロードの最大サイズ
+const trackMaxSize = 1024
+
+var (
+	trackFixedTime = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	trackTripID    = trip.NewTripID("trip-id")
+	trackGPX       = []byte(`<gpx><trk><trkseg><trkpt lat="35" lon="135"/></trkseg></trk></gpx>`)
+)
+
+// newTrackTestSegments は 9:00 から 1 分ごとに東へ約 91 m ずつ進み、30 m 登った軌跡を生成する
+func newTrackTestSegments() []track.Segment {
+	segment := make(track.Segment, 0, 4)
+	for i := range 4 {
+		at := trackFixedTime.Add(9*time.Hour + time.Duration(i)*time.Minute)
+		elevation := 100 + float64(i)*10
+		segment = append(segment, track.NewPoint(mustPhotoTestCoordinate(35, 135+float64(i)*0.001), &elevation, &at))
+	}
+	return []track.Segment{segment}
+}
+
+func newTrackTestTrack(t *testing.T, id string, tripID trip.TripID) *track.Track {
+	t.Helper()
+	tr, err := track.NewTrack(track.NewTrackID(id), tripID, nil, "朝の散歩", newTrackTestSegments(), testActorID, trackFixedTime)
+	require.NoError(t, err)
+	return tr
+}
+
+func newTrackUploadInput(content []byte) input.UploadTrackInput {
+	return input.UploadTrackInput{
+		TripID:   "trip-id",
+		Filename: "walk.gpx",
+		Content:  bytes.NewReader(content),
+		Size:     int64(len(content)),
+	}
+}
+
+func TestTrackInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrackRepo := mock_track.NewMockTrackRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTrackParser := mock_service.NewMockTrackParser(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewTrackInteractor(mockTrackRepo, mockActivityRepo, mockMemberRepo, mockTrackParser, mockTimeService, mockIDService, trackMaxSize)
+
+	tr := newTrackTestTrack(t, "track-id", trackTripID)
+	otherTrips := newTrackTestTrack(t, "track-id", trip.NewTripID("other-trip-id"))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.GetTrackOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は統計と間引いた軌跡を取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockTrackRepo.EXPECT().FindByID(gomock.Any(), tr.ID()).Return(tr, nil)
+			},
+			want: output.NewGetTrackOutput(tr),
+		},
+		{
+			name: "異常系: 別の旅行のトラックは NotFound になる",
+			setup: func() {
+				mockTrackRepo.EXPECT().FindByID(gomock.Any(), tr.ID()).Return(otherTrips, nil)
+			},
+			wantErr: track.NewTrackNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockTrackRepo.EXPECT().FindByID(gomock.Any(), tr.ID()).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get track", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Get(ctx, trackTripID.String(), "track-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTrackInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrackRepo := mock_track.NewMockTrackRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTrackParser := mock_service.NewMockTrackParser(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewTrackInteractor(mockTrackRepo, mockActivityRepo, mockMemberRepo, mockTrackParser, mockTimeService, mockIDService, trackMaxSize)
+
+	activityID := "9b2e4c55-5d1f-4b45-9d7b-4b5ef7e6a1c0"
+	tracks := []*track.Track{newTrackTestTrack(t, "track-id", trackTripID)}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		activityID *string
+		setup      func()
+		want       *output.ListTrackOutput
+		wantErr    error
+	}{
+		{
+			name:       "正常系: 閲覧者は行動を指定してトラックを取得できる",
+			ctx:        viewerCtx,
+			activityID: &activityID,
+			setup: func() {
+				mockTrackRepo.EXPECT().FindByTripID(gomock.Any(), trackTripID, &activityID).Return(tracks, nil)
+			},
+			want: output.NewListTrackOutput(tracks),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockTrackRepo.EXPECT().FindByTripID(gomock.Any(), trackTripID, nil).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list tracks", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.List(ctx, trackTripID.String(), tt.activityID)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTrackInteractor_Upload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrackRepo := mock_track.NewMockTrackRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTrackParser := mock_service.NewMockTrackParser(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewTrackInteractor(mockTrackRepo, mockActivityRepo, mockMemberRepo, mockTrackParser, mockTimeService, mockIDService, trackMaxSize)
+
+	activityID := "9b2e4c55-5d1f-4b45-9d7b-4b5ef7e6a1c0"
+	withActivityInput := newTrackUploadInput(trackGPX)
+	withActivityInput.ActivityID = &activityID
+	activity := newItineraryTestActivity(t, activityID, trackTripID, 0, nil, nil, nil)
+	otherTripActivity := newItineraryTestActivity(t, activityID, trip.NewTripID("other-trip-id"), 0, nil, nil, nil)
+	namedInput := newTrackUploadInput(trackGPX)
+	namedInput.Name = " 高尾山 "
+	nestedFilenameInput := newTrackUploadInput(trackGPX)
+	nestedFilenameInput.Filename = "dir/walk.gpx"
+
+	// expectParse はアップロードされた内容を読み取り、name を GPX に記録された名前とする軌跡を返すよう設定する
+	expectParse := func(name string) {
+		mockTrackParser.EXPECT().Parse(gomock.Any()).DoAndReturn(func(r io.Reader) (*service.ParsedTrack, error) {
+			content, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, trackGPX, content)
+			return &service.ParsedTrack{Name: name, Segments: newTrackTestSegments()}, nil
+		})
+	}
+	// expectedTrack は名前 name でアップロードされたトラックを生成する
+	expectedTrack := func(activityID *string, name string) *track.Track {
+		tr, err := track.NewTrack(track.NewTrackID("track-id"), trackTripID, activityID, name, newTrackTestSegments(), testActorID, trackFixedTime)
+		require.NoError(t, err)
+		return tr
+	}
+	morningWalk := expectedTrack(&activityID, "Morning Walk")
+	takao := expectedTrack(nil, "高尾山")
+	walk := expectedTrack(nil, "walk")
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.UploadTrackInput
+		setup   func()
+		want    *output.UploadTrackOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 名前の指定がない場合は GPX に記録された名前で、統計を求めてトラックを追加する",
+			in:   withActivityInput,
+			setup: func() {
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), activity.ID()).Return(activity, nil)
+				expectParse("Morning Walk")
+				mockIDService.EXPECT().Generate().Return("track-id")
+				mockTimeService.EXPECT().Now().Return(trackFixedTime)
+				mockTrackRepo.EXPECT().Create(gomock.Any(), morningWalk).Return(nil)
+			},
+			want: output.NewUploadTrackOutput(morningWalk),
+		},
+		{
+			name: "正常系: 指定された名前を前後の空白を除いて優先する",
+			in:   namedInput,
+			setup: func() {
+				expectParse("Track 1")
+				mockIDService.EXPECT().Generate().Return("track-id")
+				mockTimeService.EXPECT().Now().Return(trackFixedTime)
+				mockTrackRepo.EXPECT().Create(gomock.Any(), takao).Return(nil)
+			},
+			want: output.NewUploadTrackOutput(takao),
+		},
+		{
+			name: "正常系: 名前がない場合は拡張子を除いたファイル名を使う",
+			in:   nestedFilenameInput,
+			setup: func() {
+				expectParse("")
+				mockIDService.EXPECT().Generate().Return("track-id")
+				mockTimeService.EXPECT().Now().Return(trackFixedTime)
+				mockTrackRepo.EXPECT().Create(gomock.Any(), walk).Return(nil)
+			},
+			want: output.NewUploadTrackOutput(walk),
+		},
+		{
+			name:    "異常系: 閲覧者はアップロードできない",
+			ctx:     viewerCtx,
+			in:      newTrackUploadInput(trackGPX),
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+		{
+			name: "異常系: 紐づける行動が存在しない",
+			in:   withActivityInput,
+			setup: func() {
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), activity.ID()).Return(nil, itinerary.NewActivityNotFoundError())
+			},
+			wantErr: itinerary.NewActivityNotFoundError(),
+		},
+		{
+			name: "異常系: 別の旅行の行動には紐づけられない",
+			in:   withActivityInput,
+			setup: func() {
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), activity.ID()).Return(otherTripActivity, nil)
+			},
+			wantErr: itinerary.NewActivityNotFoundError(),
+		},
+		{
+			name: "異常系: 行動の取得で予期しないエラーが返される",
+			in:   withActivityInput,
+			setup: func() {
+				mockActivityRepo.EXPECT().FindByID(gomock.Any(), activity.ID()).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get activity", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name:    "異常系: 空のファイル",
+			in:      newTrackUploadInput(nil),
+			setup:   func() {},
+			wantErr: track.NewEmptyFileError(),
+		},
+		{
+			name:    "異常系: 最大サイズを超えるファイル",
+			in:      newTrackUploadInput(make([]byte, trackMaxSize+1)),
+			setup:   func() {},
+			wantErr: track.NewFileTooLargeError(),
+		},
+		{
+			name: "異常系: GPX として読み取れないファイル",
+			in:   newTrackUploadInput(trackGPX),
+			setup: func() {
+				mockTrackParser.EXPECT().Parse(gomock.Any()).Return(nil, track.NewInvalidFileError())
+			},
+			wantErr: track.NewInvalidFileError(),
+		},
+		{
+			name: "異常系: 解析で予期しないエラーが返される",
+			in:   newTrackUploadInput(trackGPX),
+			setup: func() {
+				mockTrackParser.EXPECT().Parse(gomock.Any()).Return(nil, errors.New("read error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to parse track file", apperr.WithCause(errors.New("read error"))),
+		},
+		{
+			name: "異常系: トラックの点がないファイル",
+			in:   newTrackUploadInput(trackGPX),
+			setup: func() {
+				mockTrackParser.EXPECT().Parse(gomock.Any()).Return(&service.ParsedTrack{Name: "空"}, nil)
+				mockIDService.EXPECT().Generate().Return("track-id")
+				mockTimeService.EXPECT().Now().Return(trackFixedTime)
+			},
+			wantErr: track.NewEmptyTrackError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   newTrackUploadInput(trackGPX),
+			setup: func() {
+				expectParse("")
+				mockIDService.EXPECT().Generate().Return("track-id")
+				mockTimeService.EXPECT().Now().Return(trackFixedTime)
+				mockTrackRepo.EXPECT().Create(gomock.Any(), walk).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to create track", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Upload(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTrackInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrackRepo := mock_track.NewMockTrackRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTrackParser := mock_service.NewMockTrackParser(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewTrackInteractor(mockTrackRepo, mockActivityRepo, mockMemberRepo, mockTrackParser, mockTimeService, mockIDService, trackMaxSize)
+
+	tr := newTrackTestTrack(t, "track-id", trackTripID)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: トラックが削除される",
+			setup: func() {
+				mockTrackRepo.EXPECT().FindByID(gomock.Any(), tr.ID()).Return(tr, nil)
+				mockTrackRepo.EXPECT().Delete(gomock.Any(), tr.ID()).Return(nil)
+			},
+		},
+		{
+			name:    "異常系: 閲覧者は削除できない",
+			ctx:     viewerCtx,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+		{
+			name: "異常系: トラックが存在しない",
+			setup: func() {
+				mockTrackRepo.EXPECT().FindByID(gomock.Any(), tr.ID()).Return(nil, track.NewTrackNotFoundError())
+			},
+			wantErr: track.NewTrackNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockTrackRepo.EXPECT().FindByID(gomock.Any(), tr.ID()).Return(tr, nil)
+				mockTrackRepo.EXPECT().Delete(gomock.Any(), tr.ID()).Return(errors.New("database delete error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to delete track", apperr.WithCause(errors.New("database delete error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			err := interactor.Delete(ctx, trackTripID.String(), "track-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
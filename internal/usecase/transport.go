@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"context"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/transport.go github.com/hata0/travel-api/internal/usecase TransportUsecase
+type TransportUsecase interface {
+	Get(ctx context.Context, tripID, id string) (*output.GetTransportLegOutput, error)
+	List(ctx context.Context, tripID string) (*output.ListTransportLegOutput, error)
+	Create(ctx context.Context, in input.CreateTransportLegInput) (*output.CreateTransportLegOutput, error)
+	Update(ctx context.Context, in input.UpdateTransportLegInput) error
+	Delete(ctx context.Context, tripID, id string) error
+}
+
+type TransportInteractor struct {
+	legRepository      transport.LegRepository
+	tripRepository     trip.TripRepository
+	authorizer         tripAuthorizer
+	history            historyRecorder
+	transactionManager transaction_manager.TransactionManager
+	timeService        service.TimeService
+	idService          service.IDService
+}
+
+func NewTransportInteractor(
+	legRepository transport.LegRepository,
+	tripRepository trip.TripRepository,
+	memberRepository membership.MemberRepository,
+	historyRepository history.HistoryRepository,
+	transactionManager transaction_manager.TransactionManager,
+	timeService service.TimeService,
+	idService service.IDService,
+) TransportUsecase {
+	return &TransportInteractor{
+		legRepository:      legRepository,
+		tripRepository:     tripRepository,
+		authorizer:         newTripAuthorizer(memberRepository),
+		history:            newHistoryRecorder(historyRepository),
+		transactionManager: transactionManager,
+		timeService:        timeService,
+		idService:          idService,
+	}
+}
+
+// Get は旅行に紐づく指定されたIDの移動を取得する
+func (i *TransportInteractor) Get(ctx context.Context, tripID, id string) (*output.GetTransportLegOutput, error) {
+	if _, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	l, err := i.findInTrip(ctx, trip.NewTripID(tripID), transport.NewLegID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return output.NewGetTransportLegOutput(l), nil
+}
+
+// List は旅行に紐づく移動を出発日時の早い順に取得する
+func (i *TransportInteractor) List(ctx context.Context, tripID string) (*output.ListTransportLegOutput, error) {
+	id := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorize(ctx, id, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	legs, err := i.legRepository.FindByTripID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list transport legs", apperr.WithCause(err))
+	}
+
+	return output.NewListTransportLegOutput(legs), nil
+}
+
+// Create は旅行に新しい移動を追加する。出発日は出発地の現地の日付で旅行期間内でなければならない
+func (i *TransportInteractor) Create(ctx context.Context, in input.CreateTransportLegInput) (*output.CreateTransportLegOutput, error) {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, err := transport.ParseMode(in.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	origin, destination, err := newTransportPlaces(in.Origin, in.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(in.TripID))
+	if err != nil {
+		return nil, err
+	}
+
+	now := i.timeService.Now()
+	l, err := transport.NewLeg(
+		transport.NewLegID(i.idService.Generate()), t.ID(), mode, origin, destination,
+		in.DepartureAt, in.ArrivalAt, in.Carrier, in.Number, in.Notes, now, now,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := l.ValidateFor(t); err != nil {
+		return nil, err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.legRepository.Create(txCtx, l); err != nil {
+			return err
+		}
+		return i.history.recordCreated(txCtx, t.ID(), m.UserID(), now, l)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to create transport leg", apperr.WithCause(err))
+	}
+
+	return output.NewCreateTransportLegOutput(l.ID()), nil
+}
+
+// Update は既存の移動を更新する
+func (i *TransportInteractor) Update(ctx context.Context, in input.UpdateTransportLegInput) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(in.TripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	mode, err := transport.ParseMode(in.Mode)
+	if err != nil {
+		return err
+	}
+
+	origin, destination, err := newTransportPlaces(in.Origin, in.Destination)
+	if err != nil {
+		return err
+	}
+
+	t, err := i.findTrip(ctx, trip.NewTripID(in.TripID))
+	if err != nil {
+		return err
+	}
+
+	l, err := i.findInTrip(ctx, t.ID(), transport.NewLegID(in.ID))
+	if err != nil {
+		return err
+	}
+
+	now := i.timeService.Now()
+	updated, err := l.Update(mode, origin, destination, in.DepartureAt, in.ArrivalAt, in.Carrier, in.Number, in.Notes, now)
+	if err != nil {
+		return err
+	}
+
+	if err := updated.ValidateFor(t); err != nil {
+		return err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.legRepository.Update(txCtx, updated); err != nil {
+			return err
+		}
+		return i.history.recordUpdated(txCtx, t.ID(), m.UserID(), now, l, updated)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to update transport leg", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Delete は旅行に紐づく指定されたIDの移動を削除する
+func (i *TransportInteractor) Delete(ctx context.Context, tripID, id string) error {
+	m, err := i.authorizer.authorize(ctx, trip.NewTripID(tripID), membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	l, err := i.findInTrip(ctx, trip.NewTripID(tripID), transport.NewLegID(id))
+	if err != nil {
+		return err
+	}
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.legRepository.Delete(txCtx, l.ID()); err != nil {
+			return err
+		}
+		return i.history.recordDeleted(txCtx, l.TripID(), m.UserID(), i.timeService.Now(), l)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to delete transport leg", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// findTrip は移動の親となる旅行を取得する
+func (i *TransportInteractor) findTrip(ctx context.Context, tripID trip.TripID) (*trip.Trip, error) {
+	t, err := i.tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+	return t, nil
+}
+
+// findInTrip は移動を取得し、指定された旅行に属していることを確認する。
+// 別の旅行の移動は存在しないものとして扱う
+func (i *TransportInteractor) findInTrip(ctx context.Context, tripID trip.TripID, id transport.LegID) (*transport.Leg, error) {
+	l, err := i.legRepository.FindByID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get transport leg", apperr.WithCause(err))
+	}
+
+	if !l.TripID().Equals(tripID) {
+		return nil, transport.NewLegNotFoundError()
+	}
+
+	return l, nil
+}
+
+// newTransportPlaces は入力から移動の出発地と到着地を作成する
+func newTransportPlaces(origin, destination input.TransportPlaceInput) (geo.Place, geo.Place, error) {
+	from, err := newPlace(origin.Name, origin.Latitude, origin.Longitude, origin.Timezone)
+	if err != nil {
+		return geo.Place{}, geo.Place{}, err
+	}
+	to, err := newPlace(destination.Name, destination.Latitude, destination.Longitude, destination.Timezone)
+	if err != nil {
+		return geo.Place{}, geo.Place{}, err
+	}
+	return *from, *to, nil
+}
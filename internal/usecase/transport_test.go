@@ -0,0 +1,526 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	mock_history "github.com/hata0/travel-api/internal/domain/history/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/geo"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
+	"github.com/hata0/travel-api/internal/domain/transport"
+	mock_transport "github.com/hata0/travel-api/internal/domain/transport/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/usecase/input"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+var transportTripID = trip.NewTripID("trip-id")
+
+// newTransportTestTrip は 2023/8/1〜8/3 の旅行を生成する
+func newTransportTestTrip(t *testing.T) *trip.Trip {
+	t.Helper()
+
+	period, err := trip.NewPeriod(
+		time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 8, 3, 0, 0, 0, 0, time.UTC),
+	)
+	require.NoError(t, err)
+
+	return trip.NewTrip(transportTripID, "Trip", period, "", itineraryFixedTime, itineraryFixedTime)
+}
+
+// newTransportTestLeg は 8/1 の東京から大阪への列車の移動を生成する
+func newTransportTestLeg(t *testing.T, id string, tripID trip.TripID) *transport.Leg {
+	t.Helper()
+
+	tz, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	origin, err := geo.NewPlace("東京駅", nil, &tz)
+	require.NoError(t, err)
+	destination, err := geo.NewPlace("新大阪駅", nil, &tz)
+	require.NoError(t, err)
+	departureAt := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	l, err := transport.NewLeg(
+		transport.NewLegID(id), tripID, transport.ModeTrain, origin, destination,
+		departureAt, departureAt.Add(150*time.Minute), "JR東海", "のぞみ1号", "", itineraryFixedTime, itineraryFixedTime,
+	)
+	require.NoError(t, err)
+	return l
+}
+
+func TestTransportInteractor_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewTransportInteractor(mockLegRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	l := newTransportTestLeg(t, "leg-id", transportTripID)
+	otherTrips := newTransportTestLeg(t, "leg-id", trip.NewTripID("other-trip-id"))
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.GetTransportLegOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は旅行に属する移動を取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockLegRepo.EXPECT().FindByID(gomock.Any(), l.ID()).Return(l, nil)
+			},
+			want: output.NewGetTransportLegOutput(l),
+		},
+		{
+			name: "異常系: 別の旅行の移動は NotFound になる",
+			setup: func() {
+				mockLegRepo.EXPECT().FindByID(gomock.Any(), l.ID()).Return(otherTrips, nil)
+			},
+			wantErr: transport.NewLegNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockLegRepo.EXPECT().FindByID(gomock.Any(), l.ID()).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get transport leg", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Get(ctx, transportTripID.String(), "leg-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTransportInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewTransportInteractor(mockLegRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	legs := []*transport.Leg{newTransportTestLeg(t, "leg-id", transportTripID)}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.ListTransportLegOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 閲覧者は旅行の移動を取得できる",
+			ctx:  viewerCtx,
+			setup: func() {
+				mockLegRepo.EXPECT().FindByTripID(gomock.Any(), transportTripID).Return(legs, nil)
+			},
+			want: output.NewListTransportLegOutput(legs),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockLegRepo.EXPECT().FindByTripID(gomock.Any(), transportTripID).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list transport legs", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.List(ctx, transportTripID.String())
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTransportInteractor_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+	interactor := NewTransportInteractor(mockLegRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	tr := newTransportTestTrip(t)
+	validInput := input.CreateTransportLegInput{
+		TripID:      transportTripID.String(),
+		Mode:        "flight",
+		Origin:      input.TransportPlaceInput{Name: "羽田空港", Timezone: "Asia/Tokyo"},
+		Destination: input.TransportPlaceInput{Name: "那覇空港", Timezone: "Asia/Tokyo"},
+		DepartureAt: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+		ArrivalAt:   time.Date(2023, 8, 1, 3, 0, 0, 0, time.UTC),
+		Carrier:     "ANA",
+		Number:      "NH461",
+	}
+	invalidModeInput := validInput
+	invalidModeInput.Mode = "rocket"
+	invalidTimezoneInput := validInput
+	invalidTimezoneInput.Destination.Timezone = "Mars/Olympus"
+	// 出発地の現地時刻では 8/4 になる
+	outsidePeriodInput := validInput
+	outsidePeriodInput.DepartureAt = time.Date(2023, 8, 3, 16, 0, 0, 0, time.UTC)
+	outsidePeriodInput.ArrivalAt = outsidePeriodInput.DepartureAt.Add(time.Hour)
+
+	tokyo, err := geo.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+	origin, err := geo.NewPlace("羽田空港", nil, &tokyo)
+	require.NoError(t, err)
+	destination, err := geo.NewPlace("那覇空港", nil, &tokyo)
+	require.NoError(t, err)
+	expected, err := transport.NewLeg(
+		transport.NewLegID("generated-id"), transportTripID, transport.ModeFlight, origin, destination,
+		validInput.DepartureAt, validInput.ArrivalAt, "ANA", "NH461", "", itineraryFixedTime, itineraryFixedTime,
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		in      input.CreateTransportLegInput
+		setup   func()
+		want    *output.CreateTransportLegOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 移動が追加される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), transportTripID).Return(tr, nil)
+				mockTimeService.EXPECT().Now().Return(itineraryFixedTime)
+				mockIDService.EXPECT().Generate().Return("generated-id")
+				mockLegRepo.EXPECT().Create(gomock.Any(), expected).Return(nil)
+			},
+			want: output.NewCreateTransportLegOutput(transport.NewLegID("generated-id")),
+		},
+		{
+			name:    "異常系: 不正な交通機関",
+			in:      invalidModeInput,
+			setup:   func() {},
+			wantErr: transport.NewInvalidModeError(),
+		},
+		{
+			name:    "異常系: 不正なタイムゾーン",
+			in:      invalidTimezoneInput,
+			setup:   func() {},
+			wantErr: geo.NewInvalidTimezoneError(),
+		},
+		{
+			name: "異常系: 出発日が旅行期間外",
+			in:   outsidePeriodInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), transportTripID).Return(tr, nil)
+				mockTimeService.EXPECT().Now().Return(itineraryFixedTime)
+				mockIDService.EXPECT().Generate().Return("generated-id")
+			},
+			wantErr: transport.NewOutsideTripPeriodError(),
+		},
+		{
+			name:    "異常系: 閲覧者は移動を追加できない",
+			ctx:     viewerCtx,
+			in:      validInput,
+			setup:   func() {},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+		{
+			name: "異常系: 旅行の取得に失敗",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), transportTripID).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get trip", apperr.WithCause(errors.New("database find error"))),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), transportTripID).Return(tr, nil)
+				mockTimeService.EXPECT().Now().Return(itineraryFixedTime)
+				mockIDService.EXPECT().Generate().Return("generated-id")
+				mockLegRepo.EXPECT().Create(gomock.Any(), expected).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to create transport leg", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.Create(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assertRecordedChange(t, revisions, history.ResourceTypeTransportLeg, history.ActionCreated)
+			}
+		})
+	}
+}
+
+func TestTransportInteractor_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	interactor := NewTransportInteractor(mockLegRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	tr := newTransportTestTrip(t)
+	original := newTransportTestLeg(t, "leg-id", transportTripID)
+	updateTime := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	validInput := input.UpdateTransportLegInput{
+		ID:          "leg-id",
+		TripID:      transportTripID.String(),
+		Mode:        "bus",
+		Origin:      input.TransportPlaceInput{Name: "東京駅"},
+		Destination: input.TransportPlaceInput{Name: "大阪駅"},
+		DepartureAt: time.Date(2023, 8, 1, 13, 0, 0, 0, time.UTC),
+		ArrivalAt:   time.Date(2023, 8, 1, 21, 0, 0, 0, time.UTC),
+	}
+	invalidRangeInput := validInput
+	invalidRangeInput.ArrivalAt = validInput.DepartureAt.Add(-time.Hour)
+
+	origin, err := geo.NewPlace("東京駅", nil, nil)
+	require.NoError(t, err)
+	destination, err := geo.NewPlace("大阪駅", nil, nil)
+	require.NoError(t, err)
+	expected, err := original.Update(transport.ModeBus, origin, destination, validInput.DepartureAt, validInput.ArrivalAt, "", "", "", updateTime)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		in      input.UpdateTransportLegInput
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 移動が更新される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), transportTripID).Return(tr, nil)
+				mockLegRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+				mockLegRepo.EXPECT().Update(gomock.Any(), expected).Return(nil)
+			},
+		},
+		{
+			name: "異常系: 到着日時が出発日時より前",
+			in:   invalidRangeInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), transportTripID).Return(tr, nil)
+				mockLegRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+			},
+			wantErr: transport.NewInvalidTimeRangeError(),
+		},
+		{
+			name: "異常系: 移動が存在しない",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), transportTripID).Return(tr, nil)
+				mockLegRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(nil, transport.NewLegNotFoundError())
+			},
+			wantErr: transport.NewLegNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			in:   validInput,
+			setup: func() {
+				mockTripRepo.EXPECT().FindByID(gomock.Any(), transportTripID).Return(tr, nil)
+				mockLegRepo.EXPECT().FindByID(gomock.Any(), original.ID()).Return(original, nil)
+				mockTimeService.EXPECT().Now().Return(updateTime)
+				mockLegRepo.EXPECT().Update(gomock.Any(), expected).Return(errors.New("database write error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to update transport leg", apperr.WithCause(errors.New("database write error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			err := interactor.Update(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeTransportLeg, history.ActionUpdated)
+			}
+		})
+	}
+}
+
+func TestTransportInteractor_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLegRepo := mock_transport.NewMockLegRepository(ctrl)
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleEditor)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	interactor := NewTransportInteractor(mockLegRepo, mockTripRepo, mockMemberRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	l := newTransportTestLeg(t, "leg-id", transportTripID)
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 移動が削除できる",
+			setup: func() {
+				mockLegRepo.EXPECT().FindByID(gomock.Any(), l.ID()).Return(l, nil)
+				mockLegRepo.EXPECT().Delete(gomock.Any(), l.ID()).Return(nil)
+				mockTimeService.EXPECT().Now().Return(itineraryFixedTime)
+			},
+		},
+		{
+			name: "異常系: 移動が存在しない",
+			setup: func() {
+				mockLegRepo.EXPECT().FindByID(gomock.Any(), l.ID()).Return(nil, transport.NewLegNotFoundError())
+			},
+			wantErr: transport.NewLegNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockLegRepo.EXPECT().FindByID(gomock.Any(), l.ID()).Return(l, nil)
+				mockLegRepo.EXPECT().Delete(gomock.Any(), l.ID()).Return(errors.New("database delete error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to delete transport leg", apperr.WithCause(errors.New("database delete error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			tt.setup()
+
+			err := interactor.Delete(newActorContext(), transportTripID.String(), "leg-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assertRecordedChange(t, revisions, history.ResourceTypeTransportLeg, history.ActionDeleted)
+			}
+		})
+	}
+}
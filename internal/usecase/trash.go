@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/photo"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	"github.com/hata0/travel-api/internal/usecase/service"
+)
+
+//go:generate mockgen -destination mock/trash.go github.com/hata0/travel-api/internal/usecase TrashUsecase
+type TrashUsecase interface {
+	List(ctx context.Context) (*output.ListTrashedTripOutput, error)
+	Restore(ctx context.Context, tripID string) error
+	Purge(ctx context.Context, tripID string) error
+	PurgeExpired(ctx context.Context) (*output.PurgeExpiredTripOutput, error)
+}
+
+type TrashInteractor struct {
+	tripRepository  trip.TripRepository
+	authorizer      tripAuthorizer
+	photoRepository photo.PhotoRepository
+	attachments     attachmentCleaner
+	timeService     service.TimeService
+	retentionDays   int
+}
+
+// NewTrashInteractor はゴミ箱のユースケースを作成する。retentionDays はゴミ箱に移した旅行を保持する日数
+func NewTrashInteractor(
+	tripRepository trip.TripRepository,
+	memberRepository membership.MemberRepository,
+	attachmentRepository attachment.AttachmentRepository,
+	photoRepository photo.PhotoRepository,
+	blobStore service.BlobStore,
+	timeService service.TimeService,
+	retentionDays int,
+) TrashUsecase {
+	return &TrashInteractor{
+		tripRepository:  tripRepository,
+		authorizer:      newTripAuthorizer(memberRepository),
+		photoRepository: photoRepository,
+		attachments:     newAttachmentCleaner(attachmentRepository, blobStore),
+		timeService:     timeService,
+		retentionDays:   retentionDays,
+	}
+}
+
+// List は実行ユーザーが所有者であるゴミ箱の旅行を、ゴミ箱に移した日時の新しい順に取得する
+func (i *TrashInteractor) List(ctx context.Context) (*output.ListTrashedTripOutput, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	trashed, err := i.tripRepository.FindTrashedByOwner(ctx, userID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list trashed trips", apperr.WithCause(err))
+	}
+
+	return output.NewListTrashedTripOutput(trashed, i.retentionDays), nil
+}
+
+// Restore はゴミ箱の旅行を元に戻す。所有者だけが元に戻せる
+func (i *TrashInteractor) Restore(ctx context.Context, tripID string) error {
+	id := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorizeTrashed(ctx, id, membership.RoleOwner); err != nil {
+		return err
+	}
+
+	if err := i.tripRepository.Restore(ctx, id); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to restore trip", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+// Purge はゴミ箱の旅行を保持期間を待たずに完全に削除する。所有者だけが削除でき、削除した旅行は元に戻せない
+func (i *TrashInteractor) Purge(ctx context.Context, tripID string) error {
+	id := trip.NewTripID(tripID)
+
+	if _, err := i.authorizer.authorizeTrashed(ctx, id, membership.RoleOwner); err != nil {
+		return err
+	}
+
+	return i.purge(ctx, id)
+}
+
+// PurgeExpired は保持期間を過ぎたゴミ箱の旅行をすべて完全に削除する。定期実行のコマンドから呼び出す。
+// 一覧を取得した後に元に戻された旅行は削除しない
+func (i *TrashInteractor) PurgeExpired(ctx context.Context) (*output.PurgeExpiredTripOutput, error) {
+	cutoff := trip.TrashRetentionCutoff(i.timeService.Now(), i.retentionDays)
+
+	ids, err := i.tripRepository.FindTrashedBefore(ctx, cutoff)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list expired trips", apperr.WithCause(err))
+	}
+
+	var purged int64
+	for _, id := range ids {
+		if err := i.purge(ctx, id); err != nil {
+			if apperr.IsAppErrorWithCode(err, trip.CodeTripNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		purged++
+	}
+
+	return &output.PurgeExpiredTripOutput{Count: purged, Cutoff: cutoff}, nil
+}
+
+// purge はゴミ箱の旅行を関連するリソースとともに完全に削除し、保存先に残る添付ファイルと写真（元の画像とサムネイル）の中身も削除する。
+// 関連するレコードは外部キーで削除されるため、中身のキーは旅行を削除する前に取得しておく。
+// 中身の削除に失敗しても旅行の削除は済んでいるため、ログに記録するだけでエラーにしない
+func (i *TrashInteractor) purge(ctx context.Context, id trip.TripID) error {
+	keys, err := i.attachments.tripKeys(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	photos, err := i.photoRepository.FindByTripID(ctx, id)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to list photos", apperr.WithCause(err))
+	}
+	for _, p := range photos {
+		keys = append(keys, p.StorageKey(), p.ThumbnailKey())
+	}
+
+	if err := i.tripRepository.Purge(ctx, id); err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to purge trip", apperr.WithCause(err))
+	}
+
+	i.attachments.deleteBlobs(ctx, keys)
+	return nil
+}
@@ -0,0 +1,425 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/hata0/travel-api/internal/domain/attachment"
+	mock_attachment "github.com/hata0/travel-api/internal/domain/attachment/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/photo"
+	mock_photo "github.com/hata0/travel-api/internal/domain/photo/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+	"github.com/hata0/travel-api/internal/usecase/output"
+	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock"
+)
+
+const testRetentionDays = 30
+
+// trashedTripFileKeys は旅行の添付ファイルと写真（元の画像とサムネイル）の中身のキーを辞書順に返す
+func trashedTripFileKeys(tripID trip.TripID, attachmentIDs, photoIDs []string) []string {
+	var keys []string
+	for _, id := range attachmentIDs {
+		keys = append(keys, newAttachmentTestAttachment(id, tripID).StorageKey())
+	}
+	for _, id := range photoIDs {
+		p := newPhotoTestPhoto(id, tripID, false)
+		keys = append(keys, p.StorageKey(), p.ThumbnailKey())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// seedTrashedTripFiles は旅行に添付ファイルと写真があるものとして、レコードの取得を設定し中身を保存先に置く
+func seedTrashedTripFiles(
+	attachmentRepo *mock_attachment.MockAttachmentRepository,
+	photoRepo *mock_photo.MockPhotoRepository,
+	blobStore *memoryBlobStore,
+	tripID trip.TripID,
+	attachmentIDs, photoIDs []string,
+) {
+	attachments := make([]*attachment.Attachment, 0, len(attachmentIDs))
+	for _, id := range attachmentIDs {
+		attachments = append(attachments, newAttachmentTestAttachment(id, tripID))
+	}
+	attachmentRepo.EXPECT().FindByTripID(gomock.Any(), tripID, nil).Return(attachments, nil)
+
+	photos := make([]*photo.Photo, 0, len(photoIDs))
+	for _, id := range photoIDs {
+		photos = append(photos, newPhotoTestPhoto(id, tripID, false))
+	}
+	photoRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(photos, nil)
+
+	for _, key := range trashedTripFileKeys(tripID, attachmentIDs, photoIDs) {
+		blobStore.blobs[key] = []byte(key)
+	}
+}
+
+// expectTrashedTripMember は実行ユーザーを、ゴミ箱の旅行の指定したロールのメンバーとして扱うよう設定する
+func expectTrashedTripMember(repo *mock_membership.MockMemberRepository, tripID trip.TripID, role membership.Role) {
+	repo.EXPECT().
+		FindByTrashedTripAndUser(gomock.Any(), tripID, testActorID).
+		Return(membership.NewMember(tripID, testActorID, role, time.Time{}, time.Time{}), nil)
+}
+
+func TestTrashInteractor_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockPhotoRepo := mock_photo.NewMockPhotoRepository(ctrl)
+	blobStore := newMemoryBlobStore()
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+
+	interactor := NewTrashInteractor(mockTripRepo, mockMemberRepo, mockAttachmentRepo, mockPhotoRepo, blobStore, mockTimeService, testRetentionDays)
+
+	trashedAt := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	tr := trip.NewTrip(trip.NewTripID("trip-id"), "Trip", nil, "", trashedAt, trashedAt)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		setup   func()
+		want    *output.ListTrashedTripOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: ゴミ箱の旅行と完全に削除される日時を返す",
+			setup: func() {
+				mockTripRepo.EXPECT().
+					FindTrashedByOwner(gomock.Any(), testActorID).
+					Return([]*trip.TrashedTrip{trip.NewTrashedTrip(tr, trashedAt)}, nil)
+			},
+			want: &output.ListTrashedTripOutput{
+				Trips: []*output.TrashedTrip{{
+					Trip:      &output.Trip{ID: "trip-id", Name: "Trip", Version: tr.Version(), CreatedAt: trashedAt, UpdatedAt: trashedAt},
+					TrashedAt: trashedAt,
+					PurgeAt:   time.Date(2024, 2, 9, 9, 0, 0, 0, time.UTC),
+				}},
+			},
+		},
+		{
+			name:    "異常系: 実行ユーザーがいない",
+			ctx:     context.Background(),
+			setup:   func() {},
+			wantErr: apperr.NewInvalidCredentialsError("authenticated user is required"),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockTripRepo.EXPECT().
+					FindTrashedByOwner(gomock.Any(), testActorID).
+					Return(nil, errors.New("database error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to list trashed trips", apperr.WithCause(errors.New("database error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+
+			got, err := interactor.List(ctx)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTrashInteractor_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockPhotoRepo := mock_photo.NewMockPhotoRepository(ctrl)
+	blobStore := newMemoryBlobStore()
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+
+	interactor := NewTrashInteractor(mockTripRepo, mockMemberRepo, mockAttachmentRepo, mockPhotoRepo, blobStore, mockTimeService, testRetentionDays)
+
+	tripID := trip.NewTripID("trip-id")
+
+	tests := []struct {
+		name    string
+		setup   func()
+		wantErr error
+	}{
+		{
+			name: "正常系: 所有者はゴミ箱の旅行を元に戻せる",
+			setup: func() {
+				expectTrashedTripMember(mockMemberRepo, tripID, membership.RoleOwner)
+				mockTripRepo.EXPECT().Restore(gomock.Any(), tripID).Return(nil)
+			},
+		},
+		{
+			name: "異常系: 所有者でなければ元に戻せない",
+			setup: func() {
+				expectTrashedTripMember(mockMemberRepo, tripID, membership.RoleEditor)
+			},
+			wantErr: membership.NewInsufficientRoleError(),
+		},
+		{
+			name: "異常系: ゴミ箱にない旅行は元に戻せない",
+			setup: func() {
+				mockMemberRepo.EXPECT().
+					FindByTrashedTripAndUser(gomock.Any(), tripID, testActorID).
+					Return(nil, membership.NewMemberNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				expectTrashedTripMember(mockMemberRepo, tripID, membership.RoleOwner)
+				mockTripRepo.EXPECT().Restore(gomock.Any(), tripID).Return(errors.New("database error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to restore trip", apperr.WithCause(errors.New("database error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			err := interactor.Restore(newActorContext(), "trip-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTrashInteractor_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockPhotoRepo := mock_photo.NewMockPhotoRepository(ctrl)
+	blobStore := newMemoryBlobStore()
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+
+	interactor := NewTrashInteractor(mockTripRepo, mockMemberRepo, mockAttachmentRepo, mockPhotoRepo, blobStore, mockTimeService, testRetentionDays)
+
+	tripID := trip.NewTripID("trip-id")
+	keys := trashedTripFileKeys(tripID, []string{"attachment-1"}, []string{"photo-1"})
+
+	tests := []struct {
+		name  string
+		setup func()
+		// wantBlobKeys は削除後に保存先に残るファイルのキー
+		wantBlobKeys []string
+		wantErr      error
+	}{
+		{
+			name: "正常系: 所有者はゴミ箱の旅行を完全に削除でき、添付ファイルと写真の中身も保存先から削除される",
+			setup: func() {
+				expectTrashedTripMember(mockMemberRepo, tripID, membership.RoleOwner)
+				seedTrashedTripFiles(mockAttachmentRepo, mockPhotoRepo, blobStore, tripID, []string{"attachment-1", "attachment-2"}, []string{"photo-1"})
+				mockTripRepo.EXPECT().Purge(gomock.Any(), tripID).Return(nil)
+			},
+			wantBlobKeys: []string{},
+		},
+		{
+			name: "正常系: コミットした後に中身の削除に失敗しても完全な削除は成功し、残りの中身は削除する",
+			setup: func() {
+				expectTrashedTripMember(mockMemberRepo, tripID, membership.RoleOwner)
+				seedTrashedTripFiles(mockAttachmentRepo, mockPhotoRepo, blobStore, tripID, []string{"attachment-1"}, []string{"photo-1"})
+				blobStore.failKeys[keys[0]] = true
+				mockTripRepo.EXPECT().Purge(gomock.Any(), tripID).Return(nil)
+			},
+			wantBlobKeys: []string{keys[0]},
+		},
+		{
+			name: "異常系: 所有者でなければ完全に削除できない",
+			setup: func() {
+				expectTrashedTripMember(mockMemberRepo, tripID, membership.RoleViewer)
+			},
+			wantBlobKeys: []string{},
+			wantErr:      membership.NewInsufficientRoleError(),
+		},
+		{
+			name: "異常系: リポジトリからアプリケーションエラーが返される場合は中身を削除しない",
+			setup: func() {
+				expectTrashedTripMember(mockMemberRepo, tripID, membership.RoleOwner)
+				seedTrashedTripFiles(mockAttachmentRepo, mockPhotoRepo, blobStore, tripID, []string{"attachment-1"}, []string{"photo-1"})
+				mockTripRepo.EXPECT().Purge(gomock.Any(), tripID).Return(trip.NewTripNotFoundError())
+			},
+			wantBlobKeys: keys,
+			wantErr:      trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				expectTrashedTripMember(mockMemberRepo, tripID, membership.RoleOwner)
+				seedTrashedTripFiles(mockAttachmentRepo, mockPhotoRepo, blobStore, tripID, []string{"attachment-1"}, []string{"photo-1"})
+				mockTripRepo.EXPECT().Purge(gomock.Any(), tripID).Return(errors.New("database error"))
+			},
+			wantBlobKeys: keys,
+			wantErr:      apperr.NewInternalError("Failed to purge trip", apperr.WithCause(errors.New("database error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blobStore.blobs = map[string][]byte{}
+			blobStore.failKeys = map[string]bool{}
+			tt.setup()
+
+			err := interactor.Purge(newActorContext(), "trip-id")
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantBlobKeys, blobStore.keys())
+		})
+	}
+}
+
+func TestTrashInteractor_PurgeExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTripRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockAttachmentRepo := mock_attachment.NewMockAttachmentRepository(ctrl)
+	mockPhotoRepo := mock_photo.NewMockPhotoRepository(ctrl)
+	blobStore := newMemoryBlobStore()
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+
+	interactor := NewTrashInteractor(mockTripRepo, mockMemberRepo, mockAttachmentRepo, mockPhotoRepo, blobStore, mockTimeService, testRetentionDays)
+
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	cutoff := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	expired1, expired2 := trip.NewTripID("trip-1"), trip.NewTripID("trip-2")
+	restoredKeys := trashedTripFileKeys(expired1, []string{"attachment-1"}, []string{"photo-1"})
+	failedKeys := trashedTripFileKeys(expired1, []string{"attachment-1"}, nil)
+
+	tests := []struct {
+		name  string
+		setup func()
+		want  *output.PurgeExpiredTripOutput
+		// wantBlobKeys は削除後に保存先に残るファイルのキー
+		wantBlobKeys []string
+		wantErr      error
+	}{
+		{
+			name: "正常系: 保持期間を過ぎた旅行を添付ファイルと写真の中身とともに削除し件数を返す",
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockTripRepo.EXPECT().FindTrashedBefore(gomock.Any(), cutoff).Return([]trip.TripID{expired1, expired2}, nil)
+				seedTrashedTripFiles(mockAttachmentRepo, mockPhotoRepo, blobStore, expired1, []string{"attachment-1"}, []string{"photo-1"})
+				seedTrashedTripFiles(mockAttachmentRepo, mockPhotoRepo, blobStore, expired2, []string{"attachment-2", "attachment-3"}, []string{"photo-2", "photo-3"})
+				mockTripRepo.EXPECT().Purge(gomock.Any(), expired1).Return(nil)
+				mockTripRepo.EXPECT().Purge(gomock.Any(), expired2).Return(nil)
+			},
+			want:         &output.PurgeExpiredTripOutput{Count: 2, Cutoff: cutoff},
+			wantBlobKeys: []string{},
+		},
+		{
+			name: "正常系: 一覧を取得した後に元に戻された旅行は削除せず、中身も残す",
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockTripRepo.EXPECT().FindTrashedBefore(gomock.Any(), cutoff).Return([]trip.TripID{expired1}, nil)
+				seedTrashedTripFiles(mockAttachmentRepo, mockPhotoRepo, blobStore, expired1, []string{"attachment-1"}, []string{"photo-1"})
+				mockTripRepo.EXPECT().Purge(gomock.Any(), expired1).Return(trip.NewTripNotFoundError())
+			},
+			want:         &output.PurgeExpiredTripOutput{Count: 0, Cutoff: cutoff},
+			wantBlobKeys: restoredKeys,
+		},
+		{
+			name: "正常系: 中身の削除に失敗しても残りの旅行の削除を続ける",
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockTripRepo.EXPECT().FindTrashedBefore(gomock.Any(), cutoff).Return([]trip.TripID{expired1, expired2}, nil)
+				seedTrashedTripFiles(mockAttachmentRepo, mockPhotoRepo, blobStore, expired1, []string{"attachment-1"}, nil)
+				seedTrashedTripFiles(mockAttachmentRepo, mockPhotoRepo, blobStore, expired2, []string{"attachment-2"}, []string{"photo-2"})
+				blobStore.failKeys[failedKeys[0]] = true
+				mockTripRepo.EXPECT().Purge(gomock.Any(), expired1).Return(nil)
+				mockTripRepo.EXPECT().Purge(gomock.Any(), expired2).Return(nil)
+			},
+			want:         &output.PurgeExpiredTripOutput{Count: 2, Cutoff: cutoff},
+			wantBlobKeys: failedKeys,
+		},
+		{
+			name: "異常系: リポジトリから予期しないエラーが返される",
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockTripRepo.EXPECT().FindTrashedBefore(gomock.Any(), cutoff).Return(nil, errors.New("database error"))
+			},
+			wantBlobKeys: []string{},
+			wantErr:      apperr.NewInternalError("Failed to list expired trips", apperr.WithCause(errors.New("database error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blobStore.blobs = map[string][]byte{}
+			blobStore.failKeys = map[string]bool{}
+			tt.setup()
+
+			got, err := interactor.PurgeExpired(context.Background())
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+			assert.Equal(t, tt.wantBlobKeys, blobStore.keys())
+		})
+	}
+}
@@ -2,9 +2,20 @@ package usecase
 
 import (
 	"context"
+	"time"
 
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/checklist"
 	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/history"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	"github.com/hata0/travel-api/internal/domain/shared/transaction_manager"
 	"github.com/hata0/travel-api/internal/domain/trip"
+	"github.com/hata0/travel-api/internal/domain/triptemplate"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
 	"github.com/hata0/travel-api/internal/usecase/output"
 	"github.com/hata0/travel-api/internal/usecase/service"
 )
@@ -12,30 +23,65 @@ import (
 //go:generate mockgen -destination mock/trip.go github.com/hata0/travel-api/internal/usecase TripUsecase
 type TripUsecase interface {
 	Get(ctx context.Context, id string) (*output.GetTripOutput, error)
-	List(ctx context.Context) (*output.ListTripOutput, error)
-	Create(ctx context.Context, name string) (*output.CreateTripOutput, error)
-	Update(ctx context.Context, id string, name string) error
-	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, in input.ListTripInput) (*output.ListTripOutput, error)
+	Create(ctx context.Context, in input.CreateTripInput) (*output.CreateTripOutput, error)
+	Update(ctx context.Context, in input.UpdateTripInput) error
+	Delete(ctx context.Context, id string, version int64) error
+	Duplicate(ctx context.Context, in input.DuplicateTripInput) (*output.CreateTripOutput, error)
 }
 
 type TripInteractor struct {
-	repository  trip.TripRepository
-	timeService service.TimeService
-	idService   service.IDService
+	repository              trip.TripRepository
+	memberRepository        membership.MemberRepository
+	accommodationRepository accommodation.AccommodationRepository
+	budgetRepository        budget.BudgetRepository
+	checklistRepository     checklist.ChecklistRepository
+	activityRepository      itinerary.ActivityRepository
+	templateRepository      triptemplate.TemplateRepository
+	authorizer              tripAuthorizer
+	history                 historyRecorder
+	transactionManager      transaction_manager.TransactionManager
+	timeService             service.TimeService
+	idService               service.IDService
 }
 
-func NewTripInteractor(repository trip.TripRepository, timeService service.TimeService, idService service.IDService) TripUsecase {
+func NewTripInteractor(
+	repository trip.TripRepository,
+	memberRepository membership.MemberRepository,
+	accommodationRepository accommodation.AccommodationRepository,
+	budgetRepository budget.BudgetRepository,
+	checklistRepository checklist.ChecklistRepository,
+	activityRepository itinerary.ActivityRepository,
+	templateRepository triptemplate.TemplateRepository,
+	historyRepository history.HistoryRepository,
+	transactionManager transaction_manager.TransactionManager,
+	timeService service.TimeService,
+	idService service.IDService,
+) TripUsecase {
 	return &TripInteractor{
-		repository:  repository,
-		timeService: timeService,
-		idService:   idService,
+		repository:              repository,
+		memberRepository:        memberRepository,
+		accommodationRepository: accommodationRepository,
+		budgetRepository:        budgetRepository,
+		checklistRepository:     checklistRepository,
+		activityRepository:      activityRepository,
+		templateRepository:      templateRepository,
+		authorizer:              newTripAuthorizer(memberRepository),
+		history:                 newHistoryRecorder(historyRepository),
+		transactionManager:      transactionManager,
+		timeService:             timeService,
+		idService:               idService,
 	}
 }
 
-// Get は指定されたIDの旅行を取得する
+// Get は指定されたIDの旅行を、チェックリストの進捗とあわせて取得する
 func (i *TripInteractor) Get(ctx context.Context, id string) (*output.GetTripOutput, error) {
 	tripID := trip.NewTripID(id)
 
+	if _, err := i.authorizer.authorize(ctx, tripID, membership.RoleViewer); err != nil {
+		return nil, err
+	}
+
 	trip, err := i.repository.FindByID(ctx, tripID)
 	if err != nil {
 		if apperr.IsAppError(err) {
@@ -44,12 +90,38 @@ func (i *TripInteractor) Get(ctx context.Context, id string) (*output.GetTripOut
 		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
 	}
 
-	return output.NewGetTripOutput(trip), nil
+	checklists, err := i.checklistRepository.FindByTripID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list checklists", apperr.WithCause(err))
+	}
+
+	return output.NewGetTripOutput(trip, checklists), nil
 }
 
-// List はすべての旅行を取得する
-func (i *TripInteractor) List(ctx context.Context) (*output.ListTripOutput, error) {
-	trips, err := i.repository.FindMany(ctx)
+// List は実行ユーザーがメンバーとして参加している旅行を、指定された並び順・絞り込み条件で 1 ページ分取得する
+func (i *TripInteractor) List(ctx context.Context, in input.ListTripInput) (*output.ListTripOutput, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := trip.NewListQuery(trip.ListQueryParams{
+		Sort:       in.Sort,
+		Order:      in.Order,
+		NamePrefix: in.NamePrefix,
+		From:       in.From,
+		To:         in.To,
+		Limit:      in.Limit,
+		Cursor:     in.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := i.repository.FindByMember(ctx, userID, query)
 	if err != nil {
 		if apperr.IsAppError(err) {
 			return nil, err
@@ -57,39 +129,238 @@ func (i *TripInteractor) List(ctx context.Context) (*output.ListTripOutput, erro
 		return nil, apperr.NewInternalError("Failed to list trips", apperr.WithCause(err))
 	}
 
-	return output.NewListTripOutput(trips), nil
+	return output.NewListTripOutput(page), nil
 }
 
-// Create は新しい旅行を作成する
-func (i *TripInteractor) Create(ctx context.Context, name string) (*output.CreateTripOutput, error) {
-	newID := i.idService.Generate()
+// Create は新しい旅行を作成し、実行ユーザーをその所有者とする。旅行の作成は変更履歴の最初のリビジョンとして記録する。
+// in.TemplateID を指定した場合は雛形の宿泊予約と予算、チェックリスト、行動を開始日に合わせて作成する
+func (i *TripInteractor) Create(ctx context.Context, in input.CreateTripInput) (*output.CreateTripOutput, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := trip.ValidateHomeCurrency(in.HomeCurrency); err != nil {
+		return nil, err
+	}
+
+	if in.TemplateID != nil {
+		return i.createFromTemplate(ctx, userID, in)
+	}
+
+	period, err := trip.NewOptionalPeriod(in.StartDate, in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
 	now := i.timeService.Now()
+	t := trip.NewTrip(trip.NewTripID(i.idService.Generate()), in.Name, period, in.HomeCurrency, now, now)
 
-	tripID := trip.NewTripID(newID)
+	if err := i.createWithContents(ctx, userID, &tripContents{trip: t}, now); err != nil {
+		return nil, err
+	}
 
-	trip := trip.NewTrip(
-		tripID,
-		name,
-		now,
-		now,
-	)
+	return output.NewCreateTripOutput(t.ID()), nil
+}
 
-	err := i.repository.Create(ctx, trip)
+// createFromTemplate は雛形から旅行を作成する。名前を省略した場合は雛形の名前を使う。
+// 閲覧できない雛形は存在しないものとして扱う
+func (i *TripInteractor) createFromTemplate(ctx context.Context, userID user.UserID, in input.CreateTripInput) (*output.CreateTripOutput, error) {
+	tmpl, err := i.templateRepository.FindByID(ctx, triptemplate.NewTemplateID(*in.TemplateID))
 	if err != nil {
 		if apperr.IsAppError(err) {
 			return nil, err
 		}
-		return nil, apperr.NewInternalError("Failed to create trip", apperr.WithCause(err))
+		return nil, apperr.NewInternalError("Failed to get template", apperr.WithCause(err))
+	}
+
+	if err := tmpl.AuthorizeView(userID); err != nil {
+		return nil, err
+	}
+
+	content := tmpl.Content()
+	period, err := content.NewPeriod(in.StartDate, in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	name := in.Name
+	if name == "" {
+		name = tmpl.Name()
+	}
+
+	now := i.timeService.Now()
+	t := trip.NewTrip(trip.NewTripID(i.idService.Generate()), name, period, in.HomeCurrency, now, now)
+	contents := &tripContents{trip: t}
+
+	if period != nil {
+		contents.accommodations, err = content.NewAccommodations(t.ID(), period.StartDate(), i.newAccommodationID, now)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range contents.accommodations {
+			if err := a.ValidateFor(t); err != nil {
+				return nil, err
+			}
+		}
+		contents.activities, err = content.NewActivities(t.ID(), period.StartDate(), i.newActivityID, now)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range contents.activities {
+			if err := a.ValidateFor(t); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if contents.budget, err = content.NewBudget(t.ID(), now); err != nil {
+		return nil, err
+	}
+	if contents.checklists, err = content.NewChecklists(t.ID(), i.newChecklistID, i.newChecklistItemID, now); err != nil {
+		return nil, err
 	}
 
-	return output.NewCreateTripOutput(tripID), nil
+	if err := i.createWithContents(ctx, userID, contents, now); err != nil {
+		return nil, err
+	}
+
+	return output.NewCreateTripOutput(t.ID()), nil
 }
 
-// Update は既存の旅行を更新する
-func (i *TripInteractor) Update(ctx context.Context, id string, name string) error {
+// Duplicate は旅行を複製し、実行ユーザーを所有者とする新しい旅行を作成する。
+// 宿泊予約と予算、チェックリスト、行動を引き継ぎ、旅行期間と滞在期間、行動の日時は in.OffsetDays 日ずらす。支出とメンバーは引き継がない
+func (i *TripInteractor) Duplicate(ctx context.Context, in input.DuplicateTripInput) (*output.CreateTripOutput, error) {
+	sourceID := trip.NewTripID(in.TripID)
+
+	m, err := i.authorizer.authorize(ctx, sourceID, membership.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := loadTripContents(ctx, i.repository, i.accommodationRepository, i.budgetRepository, i.checklistRepository, i.activityRepository, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := in.Name
+	if name == "" {
+		name = source.trip.Name()
+	}
+
+	var period *trip.Period
+	if p := source.trip.Period(); p != nil {
+		period = p.Shift(in.OffsetDays)
+	}
+
 	now := i.timeService.Now()
+	t := trip.NewTrip(trip.NewTripID(i.idService.Generate()), name, period, source.trip.HomeCurrency(), now, now)
+	contents := &tripContents{trip: t}
 
-	tripID := trip.NewTripID(id)
+	for _, a := range source.accommodations {
+		contents.accommodations = append(contents.accommodations, a.Duplicate(i.newAccommodationID(), t.ID(), in.OffsetDays, now))
+	}
+	if source.budget != nil {
+		contents.budget = source.budget.Duplicate(t.ID(), now)
+	}
+	for _, c := range source.checklists {
+		contents.checklists = append(contents.checklists, c.Duplicate(i.newChecklistID(), t.ID(), i.newChecklistItemID, now))
+	}
+	for _, a := range source.activities {
+		contents.activities = append(contents.activities, a.Duplicate(i.newActivityID(), t.ID(), in.OffsetDays, now))
+	}
+
+	if err := i.createWithContents(ctx, m.UserID(), contents, now); err != nil {
+		return nil, err
+	}
+
+	return output.NewCreateTripOutput(t.ID()), nil
+}
+
+// createWithContents は旅行とその内容を作成し、ownerID のユーザーを所有者とする。
+// 作成したリソースはまとめて 1 つのリビジョンとして記録する
+func (i *TripInteractor) createWithContents(ctx context.Context, ownerID user.UserID, contents *tripContents, now time.Time) error {
+	tripID := contents.trip.ID()
+	owner := membership.NewMember(tripID, ownerID, membership.RoleOwner, now, now)
+
+	err := i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		resources := []any{contents.trip}
+		if err := i.repository.Create(txCtx, contents.trip); err != nil {
+			return err
+		}
+		if err := i.memberRepository.Save(txCtx, owner); err != nil {
+			return err
+		}
+		for _, a := range contents.accommodations {
+			if err := i.accommodationRepository.Create(txCtx, a); err != nil {
+				return err
+			}
+			resources = append(resources, a)
+		}
+		if contents.budget != nil {
+			if err := i.budgetRepository.Save(txCtx, contents.budget); err != nil {
+				return err
+			}
+			resources = append(resources, contents.budget)
+		}
+		for _, c := range contents.checklists {
+			if err := i.checklistRepository.Create(txCtx, c); err != nil {
+				return err
+			}
+			resources = append(resources, c)
+		}
+		for _, a := range contents.activities {
+			if err := i.activityRepository.Create(txCtx, a); err != nil {
+				return err
+			}
+			resources = append(resources, a)
+		}
+		return i.history.recordCreated(txCtx, tripID, ownerID, now, resources...)
+	})
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return err
+		}
+		return apperr.NewInternalError("Failed to create trip", apperr.WithCause(err))
+	}
+
+	return nil
+}
+
+func (i *TripInteractor) newAccommodationID() accommodation.AccommodationID {
+	return accommodation.NewAccommodationID(i.idService.Generate())
+}
+
+func (i *TripInteractor) newChecklistID() checklist.ChecklistID {
+	return checklist.NewChecklistID(i.idService.Generate())
+}
+
+func (i *TripInteractor) newChecklistItemID() checklist.ItemID {
+	return checklist.NewItemID(i.idService.Generate())
+}
+
+func (i *TripInteractor) newActivityID() itinerary.ActivityID {
+	return itinerary.NewActivityID(i.idService.Generate())
+}
+
+// Update は既存の旅行を更新する。読み込んだ旅行のバージョンが in.Version と異なる場合は更新しない
+func (i *TripInteractor) Update(ctx context.Context, in input.UpdateTripInput) error {
+	period, err := trip.NewOptionalPeriod(in.StartDate, in.EndDate)
+	if err != nil {
+		return err
+	}
+	if err := trip.ValidateHomeCurrency(in.HomeCurrency); err != nil {
+		return err
+	}
+
+	tripID := trip.NewTripID(in.ID)
+
+	m, err := i.authorizer.authorize(ctx, tripID, membership.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	now := i.timeService.Now()
 
 	trip, err := i.repository.FindByID(ctx, tripID)
 	if err != nil {
@@ -99,9 +370,19 @@ func (i *TripInteractor) Update(ctx context.Context, id string, name string) err
 		return apperr.NewInternalError("Failed to get trip for update", apperr.WithCause(err))
 	}
 
-	updatedTrip := trip.Update(name, now)
+	if err := trip.CheckVersion(in.Version); err != nil {
+		return err
+	}
 
-	if err := i.repository.Update(ctx, updatedTrip); err != nil {
+	updatedTrip := trip.Update(in.Name, period, in.HomeCurrency, now)
+
+	err = i.transactionManager.RunInTx(ctx, func(txCtx context.Context) error {
+		if err := i.repository.Update(txCtx, updatedTrip); err != nil {
+			return err
+		}
+		return i.history.recordUpdated(txCtx, tripID, m.UserID(), now, trip, updatedTrip)
+	})
+	if err != nil {
 		if apperr.IsAppError(err) {
 			return err
 		}
@@ -111,15 +392,20 @@ func (i *TripInteractor) Update(ctx context.Context, id string, name string) err
 	return nil
 }
 
-// Delete は指定されたIDの旅行を削除する
-func (i *TripInteractor) Delete(ctx context.Context, id string) error {
+// Delete は指定されたIDの旅行をゴミ箱に移す。所有者だけが削除でき、旅行のバージョンが version と異なる場合は削除しない。
+// ゴミ箱の旅行は TrashUsecase で元に戻すか完全に削除できる
+func (i *TripInteractor) Delete(ctx context.Context, id string, version int64) error {
 	tripID := trip.NewTripID(id)
 
-	if err := i.repository.Delete(ctx, tripID); err != nil {
+	if _, err := i.authorizer.authorize(ctx, tripID, membership.RoleOwner); err != nil {
+		return err
+	}
+
+	if err := i.repository.Trash(ctx, tripID, version, i.timeService.Now()); err != nil {
 		if apperr.IsAppError(err) {
 			return err
 		}
-		return apperr.NewInternalError("Failed to delete trip", apperr.WithCause(err))
+		return apperr.NewInternalError("Failed to move trip to trash", apperr.WithCause(err))
 	}
 
 	return nil
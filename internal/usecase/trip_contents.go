@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	"github.com/hata0/travel-api/internal/domain/trip"
+)
+
+// tripContents は旅行の複製や雛形の作成で引き継ぐ旅行の内容。予算が設定されていない場合 budget は nil
+type tripContents struct {
+	trip           *trip.Trip
+	accommodations []*accommodation.Accommodation
+	budget         *budget.Budget
+	checklists     []*checklist.Checklist
+	activities     []*itinerary.Activity
+}
+
+// loadTripContents は旅行と、それに紐づく宿泊予約・予算・チェックリスト・行動を読み込む
+func loadTripContents(
+	ctx context.Context,
+	tripRepository trip.TripRepository,
+	accommodationRepository accommodation.AccommodationRepository,
+	budgetRepository budget.BudgetRepository,
+	checklistRepository checklist.ChecklistRepository,
+	activityRepository itinerary.ActivityRepository,
+	tripID trip.TripID,
+) (*tripContents, error) {
+	t, err := tripRepository.FindByID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get trip", apperr.WithCause(err))
+	}
+
+	accommodations, err := accommodationRepository.FindByTripID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list accommodations", apperr.WithCause(err))
+	}
+
+	b, err := budgetRepository.FindByTripID(ctx, tripID)
+	if err != nil && !apperr.IsAppErrorWithCode(err, budget.CodeBudgetNotFound) {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to get budget", apperr.WithCause(err))
+	}
+
+	checklists, err := checklistRepository.FindByTripID(ctx, tripID)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list checklists", apperr.WithCause(err))
+	}
+
+	activities, err := activityRepository.FindByTripID(ctx, tripID, nil)
+	if err != nil {
+		if apperr.IsAppError(err) {
+			return nil, err
+		}
+		return nil, apperr.NewInternalError("Failed to list activities", apperr.WithCause(err))
+	}
+
+	return &tripContents{trip: t, accommodations: accommodations, budget: b, checklists: checklists, activities: activities}, nil
+}
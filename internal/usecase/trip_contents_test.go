@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mock_accommodation "github.com/hata0/travel-api/internal/domain/accommodation/mock"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	mock_budget "github.com/hata0/travel-api/internal/domain/budget/mock"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	mock_checklist "github.com/hata0/travel-api/internal/domain/checklist/mock"
+	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	mock_itinerary "github.com/hata0/travel-api/internal/domain/itinerary/mock"
+	"github.com/hata0/travel-api/internal/domain/trip"
+	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock"
+)
+
+func TestLoadTripContents(t *testing.T) {
+	fixedTime := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	tripID := trip.NewTripID("trip-id")
+	source := trip.NewTrip(tripID, "京都旅行", nil, "", fixedTime, fixedTime)
+	activity, err := itinerary.NewActivity(itinerary.NewActivityID("activity-id"), tripID, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), 0, "金閣寺", nil, nil, nil, nil, itinerary.TravelModeWalk, "", fixedTime, fixedTime)
+	require.NoError(t, err)
+	checklists := []*checklist.Checklist{newChecklistTestChecklist(t, "checklist-id", tripID)}
+
+	type mocks struct {
+		tripRepo          *mock_trip.MockTripRepository
+		accommodationRepo *mock_accommodation.MockAccommodationRepository
+		budgetRepo        *mock_budget.MockBudgetRepository
+		checklistRepo     *mock_checklist.MockChecklistRepository
+		activityRepo      *mock_itinerary.MockActivityRepository
+	}
+	load := func(t *testing.T, setup func(m mocks)) (*tripContents, error) {
+		ctrl := gomock.NewController(t)
+		m := mocks{
+			tripRepo:          mock_trip.NewMockTripRepository(ctrl),
+			accommodationRepo: mock_accommodation.NewMockAccommodationRepository(ctrl),
+			budgetRepo:        mock_budget.NewMockBudgetRepository(ctrl),
+			checklistRepo:     mock_checklist.NewMockChecklistRepository(ctrl),
+			activityRepo:      mock_itinerary.NewMockActivityRepository(ctrl),
+		}
+		setup(m)
+		return loadTripContents(context.Background(), m.tripRepo, m.accommodationRepo, m.budgetRepo, m.checklistRepo, m.activityRepo, tripID)
+	}
+
+	t.Run("正常系: 旅行の行動をすべての日付について読み込み、予算がなければ nil にする", func(t *testing.T) {
+		got, err := load(t, func(m mocks) {
+			m.tripRepo.EXPECT().FindByID(gomock.Any(), tripID).Return(source, nil)
+			m.accommodationRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(nil, nil)
+			m.budgetRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(nil, budget.NewBudgetNotFoundError())
+			m.checklistRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(checklists, nil)
+			m.activityRepo.EXPECT().FindByTripID(gomock.Any(), tripID, nil).Return([]*itinerary.Activity{activity}, nil)
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, source, got.trip)
+		assert.Nil(t, got.budget)
+		assert.Equal(t, checklists, got.checklists)
+		assert.Equal(t, []*itinerary.Activity{activity}, got.activities)
+	})
+
+	t.Run("異常系: 行動の取得に失敗した場合は内部エラーを返す", func(t *testing.T) {
+		got, err := load(t, func(m mocks) {
+			m.tripRepo.EXPECT().FindByID(gomock.Any(), tripID).Return(source, nil)
+			m.accommodationRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(nil, nil)
+			m.budgetRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(nil, budget.NewBudgetNotFoundError())
+			m.checklistRepo.EXPECT().FindByTripID(gomock.Any(), tripID).Return(nil, nil)
+			m.activityRepo.EXPECT().FindByTripID(gomock.Any(), tripID, nil).Return(nil, errors.New("db error"))
+		})
+
+		assert.Nil(t, got)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeInternalError))
+	})
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,9 +11,29 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/hata0/travel-api/internal/domain/accommodation"
+	mock_accommodation "github.com/hata0/travel-api/internal/domain/accommodation/mock"
+	"github.com/hata0/travel-api/internal/domain/budget"
+	mock_budget "github.com/hata0/travel-api/internal/domain/budget/mock"
+	"github.com/hata0/travel-api/internal/domain/checklist"
+	mock_checklist "github.com/hata0/travel-api/internal/domain/checklist/mock"
 	apperr "github.com/hata0/travel-api/internal/domain/errors"
+	"github.com/hata0/travel-api/internal/domain/expense"
+	"github.com/hata0/travel-api/internal/domain/history"
+	mock_history "github.com/hata0/travel-api/internal/domain/history/mock"
+	"github.com/hata0/travel-api/internal/domain/itinerary"
+	mock_itinerary "github.com/hata0/travel-api/internal/domain/itinerary/mock"
+	"github.com/hata0/travel-api/internal/domain/membership"
+	mock_membership "github.com/hata0/travel-api/internal/domain/membership/mock"
+	"github.com/hata0/travel-api/internal/domain/shared/money"
+	"github.com/hata0/travel-api/internal/domain/shared/pagination"
+	mock_transaction_manager "github.com/hata0/travel-api/internal/domain/shared/transaction_manager/mock"
 	"github.com/hata0/travel-api/internal/domain/trip"
 	mock_trip "github.com/hata0/travel-api/internal/domain/trip/mock" // repository mock
+	"github.com/hata0/travel-api/internal/domain/triptemplate"
+	mock_triptemplate "github.com/hata0/travel-api/internal/domain/triptemplate/mock"
+	"github.com/hata0/travel-api/internal/domain/user"
+	"github.com/hata0/travel-api/internal/usecase/input"
 	"github.com/hata0/travel-api/internal/usecase/output"
 	mock_service "github.com/hata0/travel-api/internal/usecase/service/mock" // service mocks
 )
@@ -24,12 +45,24 @@ func TestTripInteractor_Get(t *testing.T) {
 	mockRepo := mock_trip.NewMockTripRepository(ctrl)
 	mockTimeService := mock_service.NewMockTimeService(ctrl)
 	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	captureHistory(mockHistoryRepo)
 
-	interactor := NewTripInteractor(mockRepo, mockTimeService, mockIDService)
+	interactor := NewTripInteractor(mockRepo, mockMemberRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockTemplateRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
 
 	fixedTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	tripID := trip.NewTripID("test-id")
-	testTrip := trip.NewTrip(tripID, "Test Trip", fixedTime, fixedTime)
+	testTrip := trip.NewTrip(tripID, "Test Trip", nil, "", fixedTime, fixedTime)
+	testChecklists := []*checklist.Checklist{newChecklistTestChecklist(t, "checklist-id", tripID)}
 
 	tests := []struct {
 		name    string
@@ -46,8 +79,12 @@ func TestTripInteractor_Get(t *testing.T) {
 					FindByID(gomock.Any(), tripID).
 					Return(testTrip, nil).
 					Times(1)
+				mockChecklistRepo.EXPECT().
+					FindByTripID(gomock.Any(), tripID).
+					Return(testChecklists, nil).
+					Times(1)
 			},
-			want:    output.NewGetTripOutput(testTrip),
+			want:    output.NewGetTripOutput(testTrip, testChecklists),
 			wantErr: nil,
 		},
 		{
@@ -84,7 +121,7 @@ func TestTripInteractor_Get(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			got, err := interactor.Get(context.Background(), tt.id)
+			got, err := interactor.Get(newActorContext(), tt.id)
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -93,8 +130,8 @@ func TestTripInteractor_Get(t *testing.T) {
 				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
 					gotAppErr, ok := err.(*apperr.AppError)
 					require.True(t, ok, "Expected AppError but got %T", err)
-					assert.Equal(t, appErr.Code, gotAppErr.Code)
-					assert.Equal(t, appErr.Message, gotAppErr.Message)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
 				}
 			} else {
 				require.NoError(t, err)
@@ -111,17 +148,34 @@ func TestTripInteractor_List(t *testing.T) {
 	mockRepo := mock_trip.NewMockTripRepository(ctrl)
 	mockTimeService := mock_service.NewMockTimeService(ctrl)
 	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	captureHistory(mockHistoryRepo)
 
-	interactor := NewTripInteractor(mockRepo, mockTimeService, mockIDService)
+	interactor := NewTripInteractor(mockRepo, mockMemberRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockTemplateRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
 
 	fixedTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	testTrips := []*trip.Trip{
-		trip.NewTrip(trip.NewTripID("id1"), "Trip 1", fixedTime, fixedTime),
-		trip.NewTrip(trip.NewTripID("id2"), "Trip 2", fixedTime, fixedTime),
+		trip.NewTrip(trip.NewTripID("id1"), "Trip 1", nil, "", fixedTime, fixedTime),
+		trip.NewTrip(trip.NewTripID("id2"), "Trip 2", nil, "", fixedTime, fixedTime),
 	}
 
+	firstPage := &pagination.Page[*trip.Trip]{Items: testTrips}
+	nextCursor := pagination.NewCursor("name:asc", "Trip 2", "id2")
+	pageWithNext := &pagination.Page[*trip.Trip]{Items: testTrips, NextCursor: &nextCursor}
+	emptyPage := &pagination.Page[*trip.Trip]{Items: []*trip.Trip{}}
+
 	tests := []struct {
 		name    string
+		in      input.ListTripInput
 		setup   func()
 		want    *output.ListTripOutput
 		wantErr error
@@ -130,22 +184,47 @@ func TestTripInteractor_List(t *testing.T) {
 			name: "正常系: 旅行一覧が正常に取得できる",
 			setup: func() {
 				mockRepo.EXPECT().
-					FindMany(gomock.Any()).
-					Return(testTrips, nil).
+					FindByMember(gomock.Any(), testActorID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ any, query trip.ListQuery) (*pagination.Page[*trip.Trip], error) {
+						assert.Equal(t, trip.SortByCreatedAt, query.Sort(), "既定では作成日時で並び替えるべき")
+						assert.Equal(t, pagination.Desc, query.Direction(), "作成日時の既定の並び順は新しい順であるべき")
+						assert.Equal(t, pagination.DefaultLimit, query.Page().Limit())
+						return firstPage, nil
+					}).
+					Times(1)
+			},
+			want:    output.NewListTripOutput(firstPage),
+			wantErr: nil,
+		},
+		{
+			name: "正常系: 次のページがある場合はカーソルが返される",
+			in:   input.ListTripInput{Sort: "name", Limit: 2},
+			setup: func() {
+				mockRepo.EXPECT().
+					FindByMember(gomock.Any(), testActorID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ any, query trip.ListQuery) (*pagination.Page[*trip.Trip], error) {
+						assert.Equal(t, trip.SortByName, query.Sort())
+						assert.Equal(t, pagination.Asc, query.Direction())
+						assert.Equal(t, 2, query.Page().Limit())
+						return pageWithNext, nil
+					}).
 					Times(1)
 			},
-			want:    output.NewListTripOutput(testTrips),
+			want: &output.ListTripOutput{
+				Trips:      output.NewListTripOutput(pageWithNext).Trips,
+				NextCursor: func() *string { s := nextCursor.Encode(); return &s }(),
+			},
 			wantErr: nil,
 		},
 		{
 			name: "正常系: 空の旅行一覧が取得できる",
 			setup: func() {
 				mockRepo.EXPECT().
-					FindMany(gomock.Any()).
-					Return([]*trip.Trip{}, nil).
+					FindByMember(gomock.Any(), testActorID, gomock.Any()).
+					Return(emptyPage, nil).
 					Times(1)
 			},
-			want:    output.NewListTripOutput([]*trip.Trip{}),
+			want:    output.NewListTripOutput(emptyPage),
 			wantErr: nil,
 		},
 		{
@@ -153,7 +232,7 @@ func TestTripInteractor_List(t *testing.T) {
 			setup: func() {
 				appErr := apperr.NewInternalError("Database error")
 				mockRepo.EXPECT().
-					FindMany(gomock.Any()).
+					FindByMember(gomock.Any(), testActorID, gomock.Any()).
 					Return(nil, appErr).
 					Times(1)
 			},
@@ -165,7 +244,7 @@ func TestTripInteractor_List(t *testing.T) {
 			setup: func() {
 				unexpectedErr := errors.New("connection timeout")
 				mockRepo.EXPECT().
-					FindMany(gomock.Any()).
+					FindByMember(gomock.Any(), testActorID, gomock.Any()).
 					Return(nil, unexpectedErr).
 					Times(1)
 			},
@@ -178,7 +257,7 @@ func TestTripInteractor_List(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			got, err := interactor.List(context.Background())
+			got, err := interactor.List(newActorContext(), tt.in)
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -186,8 +265,8 @@ func TestTripInteractor_List(t *testing.T) {
 				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
 					gotAppErr, ok := err.(*apperr.AppError)
 					require.True(t, ok, "Expected AppError but got %T", err)
-					assert.Equal(t, appErr.Code, gotAppErr.Code)
-					assert.Equal(t, appErr.Message, gotAppErr.Message)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
 				}
 			} else {
 				require.NoError(t, err)
@@ -195,6 +274,29 @@ func TestTripInteractor_List(t *testing.T) {
 			}
 		})
 	}
+
+	invalidInputs := []struct {
+		name string
+		in   input.ListTripInput
+	}{
+		{name: "異常系: 不正な並び替えキー", in: input.ListTripInput{Sort: "budget"}},
+		{name: "異常系: 不正な並び順", in: input.ListTripInput{Order: "random"}},
+		{name: "異常系: 上限を超える取得件数", in: input.ListTripInput{Limit: pagination.MaxLimit + 1}},
+		{name: "異常系: 並び替え条件と一致しないカーソル", in: input.ListTripInput{Sort: "created_at", Cursor: nextCursor.Encode()}},
+		{name: "異常系: ID が UUID でないカーソル", in: input.ListTripInput{Sort: "name", Cursor: nextCursor.Encode()}},
+		{name: "異常系: 並び替えキーが日時でないカーソル", in: input.ListTripInput{Cursor: pagination.NewCursor("created_at:desc", "Trip 2", "3f9a1c2e-7b4d-4e8a-9c1f-2d5e6a7b8c90").Encode()}},
+		{name: "異常系: base64 として不正なカーソル", in: input.ListTripInput{Cursor: "!!!"}},
+		{name: "異常系: 終了日が開始日より前の期間", in: input.ListTripInput{From: &fixedTime, To: func() *time.Time { d := fixedTime.AddDate(0, 0, -1); return &d }()}},
+	}
+	for _, tt := range invalidInputs {
+		t.Run(tt.name, func(t *testing.T) {
+			// 検証エラーの場合はリポジトリを呼び出さない
+			got, err := interactor.List(newActorContext(), tt.in)
+
+			assert.Nil(t, got)
+			assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+		})
+	}
 }
 
 func TestTripInteractor_Create(t *testing.T) {
@@ -204,8 +306,19 @@ func TestTripInteractor_Create(t *testing.T) {
 	mockRepo := mock_trip.NewMockTripRepository(ctrl)
 	mockTimeService := mock_service.NewMockTimeService(ctrl)
 	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	captureHistory(mockHistoryRepo)
 
-	interactor := NewTripInteractor(mockRepo, mockTimeService, mockIDService)
+	interactor := NewTripInteractor(mockRepo, mockMemberRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockTemplateRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
 
 	fixedTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	generatedID := "generated-id"
@@ -233,6 +346,8 @@ func TestTripInteractor_Create(t *testing.T) {
 				expectedTrip := trip.NewTrip(
 					trip.NewTripID(generatedID),
 					"New Trip",
+					nil,
+					"",
 					fixedTime,
 					fixedTime,
 				)
@@ -240,6 +355,10 @@ func TestTripInteractor_Create(t *testing.T) {
 					Create(gomock.Any(), expectedTrip).
 					Return(nil).
 					Times(1)
+				mockMemberRepo.EXPECT().
+					Save(gomock.Any(), membership.NewMember(trip.NewTripID(generatedID), testActorID, membership.RoleOwner, fixedTime, fixedTime)).
+					Return(nil).
+					Times(1)
 			},
 			want:    output.NewCreateTripOutput(trip.NewTripID(generatedID)),
 			wantErr: nil,
@@ -261,6 +380,8 @@ func TestTripInteractor_Create(t *testing.T) {
 				expectedTrip := trip.NewTrip(
 					trip.NewTripID(generatedID),
 					"Error Trip",
+					nil,
+					"",
 					fixedTime,
 					fixedTime,
 				)
@@ -289,6 +410,8 @@ func TestTripInteractor_Create(t *testing.T) {
 				expectedTrip := trip.NewTrip(
 					trip.NewTripID(generatedID),
 					"Unexpected Error Trip",
+					nil,
+					"",
 					fixedTime,
 					fixedTime,
 				)
@@ -306,7 +429,7 @@ func TestTripInteractor_Create(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			got, err := interactor.Create(context.Background(), tt.tripName)
+			got, err := interactor.Create(newActorContext(), input.CreateTripInput{Name: tt.tripName})
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -314,8 +437,8 @@ func TestTripInteractor_Create(t *testing.T) {
 				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
 					gotAppErr, ok := err.(*apperr.AppError)
 					require.True(t, ok, "Expected AppError but got %T", err)
-					assert.Equal(t, appErr.Code, gotAppErr.Code)
-					assert.Equal(t, appErr.Message, gotAppErr.Message)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
 				}
 			} else {
 				require.NoError(t, err)
@@ -325,6 +448,121 @@ func TestTripInteractor_Create(t *testing.T) {
 	}
 }
 
+func TestTripInteractor_Create_WithPeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	captureHistory(mockHistoryRepo)
+
+	interactor := NewTripInteractor(mockRepo, mockMemberRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockTemplateRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	fixedTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	startDate := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 2, 3, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 期間付きの旅行が作成できる", func(t *testing.T) {
+		period, err := trip.NewPeriod(startDate, endDate)
+		require.NoError(t, err)
+
+		mockIDService.EXPECT().Generate().Return("generated-id").Times(1)
+		mockTimeService.EXPECT().Now().Return(fixedTime).Times(1)
+		mockRepo.EXPECT().
+			Create(gomock.Any(), trip.NewTrip(trip.NewTripID("generated-id"), "Period Trip", period, "", fixedTime, fixedTime)).
+			Return(nil).
+			Times(1)
+		mockMemberRepo.EXPECT().
+			Save(gomock.Any(), membership.NewMember(trip.NewTripID("generated-id"), testActorID, membership.RoleOwner, fixedTime, fixedTime)).
+			Return(nil).
+			Times(1)
+
+		got, err := interactor.Create(newActorContext(), input.CreateTripInput{
+			Name:      "Period Trip",
+			StartDate: &startDate,
+			EndDate:   &endDate,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, output.NewCreateTripOutput(trip.NewTripID("generated-id")), got)
+	})
+
+	t.Run("異常系: 終了日のみ指定するとバリデーションエラーになる", func(t *testing.T) {
+		got, err := interactor.Create(newActorContext(), input.CreateTripInput{
+			Name:    "Invalid Trip",
+			EndDate: &endDate,
+		})
+
+		assert.Nil(t, got)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+
+	t.Run("異常系: 終了日が開始日より前だとバリデーションエラーになる", func(t *testing.T) {
+		got, err := interactor.Create(newActorContext(), input.CreateTripInput{
+			Name:      "Invalid Trip",
+			StartDate: &endDate,
+			EndDate:   &startDate,
+		})
+
+		assert.Nil(t, got)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
+func TestTripInteractor_Create_WithHomeCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	runInTxDirectly(mockTxManager)
+	captureHistory(mockHistoryRepo)
+
+	interactor := NewTripInteractor(mockRepo, mockMemberRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockTemplateRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	fixedTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 基準通貨付きの旅行が作成できる", func(t *testing.T) {
+		mockIDService.EXPECT().Generate().Return("generated-id")
+		mockTimeService.EXPECT().Now().Return(fixedTime)
+		mockRepo.EXPECT().
+			Create(gomock.Any(), trip.NewTrip(trip.NewTripID("generated-id"), "Euro Trip", nil, "EUR", fixedTime, fixedTime)).
+			Return(nil)
+		mockMemberRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := interactor.Create(newActorContext(), input.CreateTripInput{Name: "Euro Trip", HomeCurrency: "EUR"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("異常系: 基準通貨が ISO 4217 形式でないとバリデーションエラーになる", func(t *testing.T) {
+		got, err := interactor.Create(newActorContext(), input.CreateTripInput{Name: "Euro Trip", HomeCurrency: "euro"})
+
+		assert.Nil(t, got)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeValidationError))
+	})
+}
+
 func TestTripInteractor_Update(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -332,20 +570,34 @@ func TestTripInteractor_Update(t *testing.T) {
 	mockRepo := mock_trip.NewMockTripRepository(ctrl)
 	mockTimeService := mock_service.NewMockTimeService(ctrl)
 	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	captureHistory(mockHistoryRepo)
 
-	interactor := NewTripInteractor(mockRepo, mockTimeService, mockIDService)
+	interactor := NewTripInteractor(mockRepo, mockMemberRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockTemplateRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
 
 	fixedTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 	updateTime := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
 	tripID := trip.NewTripID("test-id")
-	originalTrip := trip.NewTrip(tripID, "Original Trip", fixedTime, fixedTime)
+	originalTrip := trip.NewTrip(tripID, "Original Trip", nil, "", fixedTime, fixedTime)
 
 	tests := []struct {
-		name     string
-		id       string
-		tripName string
-		setup    func()
-		wantErr  error
+		name         string
+		id           string
+		tripName     string
+		homeCurrency string
+		// version を省略した場合は trip.InitialVersion を前提とする
+		version int64
+		setup   func()
+		wantErr error
 	}{
 		{
 			name:     "正常系: 旅行が正常に更新できる",
@@ -362,7 +614,7 @@ func TestTripInteractor_Update(t *testing.T) {
 					Return(originalTrip, nil).
 					Times(1)
 
-				updatedTrip := originalTrip.Update("Updated Trip", updateTime)
+				updatedTrip := originalTrip.Update("Updated Trip", nil, "", updateTime)
 				mockRepo.EXPECT().
 					Update(gomock.Any(), updatedTrip).
 					Return(nil).
@@ -370,6 +622,26 @@ func TestTripInteractor_Update(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name:         "正常系: 基準通貨を設定できる",
+			id:           "test-id",
+			tripName:     "Updated Trip",
+			homeCurrency: "USD",
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(updateTime)
+				mockRepo.EXPECT().FindByID(gomock.Any(), tripID).Return(originalTrip, nil)
+				mockRepo.EXPECT().Update(gomock.Any(), originalTrip.Update("Updated Trip", nil, "USD", updateTime)).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:         "異常系: 基準通貨が ISO 4217 形式でない",
+			id:           "test-id",
+			tripName:     "Updated Trip",
+			homeCurrency: "usd",
+			setup:        func() {},
+			wantErr:      money.NewInvalidCurrencyError(),
+		},
 		{
 			name:     "異常系: 取得時にアプリケーションエラーが返される",
 			id:       "not-found-id",
@@ -424,7 +696,7 @@ func TestTripInteractor_Update(t *testing.T) {
 					Times(1)
 
 				appErr := apperr.NewInternalError("Database error")
-				updatedTrip := originalTrip.Update("Updated Trip", updateTime)
+				updatedTrip := originalTrip.Update("Updated Trip", nil, "", updateTime)
 				mockRepo.EXPECT().
 					Update(gomock.Any(), updatedTrip).
 					Return(appErr).
@@ -448,7 +720,7 @@ func TestTripInteractor_Update(t *testing.T) {
 					Times(1)
 
 				unexpectedErr := errors.New("database update error")
-				updatedTrip := originalTrip.Update("Updated Trip", updateTime)
+				updatedTrip := originalTrip.Update("Updated Trip", nil, "", updateTime)
 				mockRepo.EXPECT().
 					Update(gomock.Any(), updatedTrip).
 					Return(unexpectedErr).
@@ -456,21 +728,44 @@ func TestTripInteractor_Update(t *testing.T) {
 			},
 			wantErr: apperr.NewInternalError("Failed to update trip", apperr.WithCause(errors.New("database update error"))),
 		},
+		{
+			name:     "異常系: 読み込んだ旅行のバージョンが前提と異なる場合は更新しない",
+			id:       "test-id",
+			tripName: "Updated Trip",
+			version:  trip.InitialVersion + 1,
+			setup: func() {
+				mockTimeService.EXPECT().
+					Now().
+					Return(updateTime).
+					Times(1)
+
+				mockRepo.EXPECT().
+					FindByID(gomock.Any(), tripID).
+					Return(originalTrip, nil).
+					Times(1)
+			},
+			wantErr: trip.NewTripVersionMismatchError(),
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			err := interactor.Update(context.Background(), tt.id, tt.tripName)
+			version := tt.version
+			if version == 0 {
+				version = trip.InitialVersion
+			}
+
+			err := interactor.Update(newActorContext(), input.UpdateTripInput{ID: tt.id, Name: tt.tripName, HomeCurrency: tt.homeCurrency, Version: version})
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
 				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
 					gotAppErr, ok := err.(*apperr.AppError)
 					require.True(t, ok, "Expected AppError but got %T", err)
-					assert.Equal(t, appErr.Code, gotAppErr.Code)
-					assert.Equal(t, appErr.Message, gotAppErr.Message)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
 				}
 			} else {
 				require.NoError(t, err)
@@ -486,8 +781,22 @@ func TestTripInteractor_Delete(t *testing.T) {
 	mockRepo := mock_trip.NewMockTripRepository(ctrl)
 	mockTimeService := mock_service.NewMockTimeService(ctrl)
 	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	allowAsMember(mockMemberRepo, membership.RoleOwner)
+	runInTxDirectly(mockTxManager)
+	captureHistory(mockHistoryRepo)
 
-	interactor := NewTripInteractor(mockRepo, mockTimeService, mockIDService)
+	interactor := NewTripInteractor(mockRepo, mockMemberRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockTemplateRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	fixedTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockTimeService.EXPECT().Now().Return(fixedTime).AnyTimes()
 
 	tests := []struct {
 		name    string
@@ -496,12 +805,12 @@ func TestTripInteractor_Delete(t *testing.T) {
 		wantErr error
 	}{
 		{
-			name: "正常系: 旅行が正常に削除できる",
+			name: "正常系: 旅行がゴミ箱に移される",
 			id:   "test-id",
 			setup: func() {
 				tripID := trip.NewTripID("test-id")
 				mockRepo.EXPECT().
-					Delete(gomock.Any(), tripID).
+					Trash(gomock.Any(), tripID, trip.InitialVersion, fixedTime).
 					Return(nil).
 					Times(1)
 			},
@@ -514,7 +823,7 @@ func TestTripInteractor_Delete(t *testing.T) {
 				notFoundID := trip.NewTripID("not-found-id")
 				appErr := trip.NewTripNotFoundError()
 				mockRepo.EXPECT().
-					Delete(gomock.Any(), notFoundID).
+					Trash(gomock.Any(), notFoundID, trip.InitialVersion, fixedTime).
 					Return(appErr).
 					Times(1)
 			},
@@ -527,11 +836,11 @@ func TestTripInteractor_Delete(t *testing.T) {
 				errorID := trip.NewTripID("error-id")
 				unexpectedErr := errors.New("database delete error")
 				mockRepo.EXPECT().
-					Delete(gomock.Any(), errorID).
+					Trash(gomock.Any(), errorID, trip.InitialVersion, fixedTime).
 					Return(unexpectedErr).
 					Times(1)
 			},
-			wantErr: apperr.NewInternalError("Failed to delete trip", apperr.WithCause(errors.New("database delete error"))),
+			wantErr: apperr.NewInternalError("Failed to move trip to trash", apperr.WithCause(errors.New("database delete error"))),
 		},
 	}
 
@@ -539,15 +848,15 @@ func TestTripInteractor_Delete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			err := interactor.Delete(context.Background(), tt.id)
+			err := interactor.Delete(newActorContext(), tt.id, trip.InitialVersion)
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
 				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
 					gotAppErr, ok := err.(*apperr.AppError)
 					require.True(t, ok, "Expected AppError but got %T", err)
-					assert.Equal(t, appErr.Code, gotAppErr.Code)
-					assert.Equal(t, appErr.Message, gotAppErr.Message)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
 				}
 			} else {
 				require.NoError(t, err)
@@ -564,8 +873,422 @@ func TestNewTripInteractor(t *testing.T) {
 	mockRepo := mock_trip.NewMockTripRepository(ctrl)
 	mockTimeService := mock_service.NewMockTimeService(ctrl)
 	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
 
-	interactor := NewTripInteractor(mockRepo, mockTimeService, mockIDService)
+	interactor := NewTripInteractor(mockRepo, mockMemberRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockTemplateRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
 
 	assert.NotNil(t, interactor)
 }
+
+func TestTripInteractor_Authorization(t *testing.T) {
+	newInteractor := func(t *testing.T) (TripUsecase, *mock_membership.MockMemberRepository) {
+		ctrl := gomock.NewController(t)
+		mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+		interactor := NewTripInteractor(
+			mock_trip.NewMockTripRepository(ctrl),
+			mockMemberRepo,
+			mock_accommodation.NewMockAccommodationRepository(ctrl),
+			mock_budget.NewMockBudgetRepository(ctrl),
+			mock_checklist.NewMockChecklistRepository(ctrl),
+			mock_itinerary.NewMockActivityRepository(ctrl),
+			mock_triptemplate.NewMockTemplateRepository(ctrl),
+			mock_history.NewMockHistoryRepository(ctrl),
+			mock_transaction_manager.NewMockTransactionManager(ctrl),
+			mock_service.NewMockTimeService(ctrl),
+			mock_service.NewMockIDService(ctrl),
+		)
+		return interactor, mockMemberRepo
+	}
+
+	t.Run("異常系: 実行ユーザーがいない場合は旅行を作成できない", func(t *testing.T) {
+		interactor, _ := newInteractor(t)
+
+		got, err := interactor.Create(context.Background(), input.CreateTripInput{Name: "Trip"})
+
+		assert.Nil(t, got)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeInvalidCredentials))
+	})
+
+	t.Run("異常系: 実行ユーザーがいない場合は旅行一覧を取得できない", func(t *testing.T) {
+		interactor, _ := newInteractor(t)
+
+		got, err := interactor.List(context.Background(), input.ListTripInput{})
+
+		assert.Nil(t, got)
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeInvalidCredentials))
+	})
+
+	t.Run("異常系: 閲覧者は旅行を更新できない", func(t *testing.T) {
+		interactor, mockMemberRepo := newInteractor(t)
+
+		err := interactor.Update(newViewerContext(mockMemberRepo), input.UpdateTripInput{ID: "test-id", Name: "Trip"})
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeForbidden))
+	})
+
+	t.Run("異常系: 所有者でなければ旅行を削除できない", func(t *testing.T) {
+		interactor, mockMemberRepo := newInteractor(t)
+
+		err := interactor.Delete(newViewerContext(mockMemberRepo), "test-id", trip.InitialVersion)
+
+		assert.True(t, apperr.IsAppErrorWithCode(err, apperr.CodeForbidden))
+	})
+}
+
+func TestTripInteractor_Duplicate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+	viewerCtx := newViewerContext(mockMemberRepo)
+
+	ids := 0
+	mockIDService.EXPECT().Generate().DoAndReturn(func() string {
+		ids++
+		return fmt.Sprintf("generated-id-%d", ids)
+	}).AnyTimes()
+
+	interactor := NewTripInteractor(mockRepo, mockMemberRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockTemplateRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	fixedTime := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	now := fixedTime.Add(time.Hour)
+	sourceID := trip.NewTripID("source-trip-id")
+	newTripID := trip.NewTripID("generated-id-1")
+	period, err := trip.NewPeriod(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	source := trip.NewTrip(sourceID, "京都旅行", period, "JPY", fixedTime, fixedTime)
+	undecided := trip.NewTrip(sourceID, "未定の旅行", nil, "", fixedTime, fixedTime)
+	stay, err := accommodation.NewStay(time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC), time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	cost, err := money.NewMoney(32000, "JPY")
+	require.NoError(t, err)
+	sourceAccommodation := accommodation.NewAccommodation(accommodation.NewAccommodationID("source-accommodation-id"), sourceID, "Hotel", "Kyoto", nil, stay, "ABC123", cost, "", fixedTime, fixedTime)
+	sourceBudget, err := budget.NewBudget(sourceID, "JPY", map[expense.Category]int64{expense.CategoryFood: 30000}, fixedTime, fixedTime)
+	require.NoError(t, err)
+	sourceChecklist := newChecklistTestChecklist(t, "source-checklist-id", sourceID)
+	activityStartAt := time.Date(2024, 5, 2, 1, 0, 0, 0, time.UTC)
+	sourceActivity, err := itinerary.NewActivity(itinerary.NewActivityID("source-activity-id"), sourceID, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), 0, "金閣寺", nil, nil, &activityStartAt, nil, itinerary.TravelModeWalk, "", fixedTime, fixedTime)
+	require.NoError(t, err)
+	sourceOwner := membership.NewMember(sourceID, testActorID, membership.RoleOwner, fixedTime, fixedTime)
+
+	tests := []struct {
+		name              string
+		ctx               context.Context
+		in                input.DuplicateTripInput
+		setup             func()
+		want              *output.CreateTripOutput
+		wantActorID       user.UserID
+		wantResourceTypes []history.ResourceType
+		wantErr           error
+	}{
+		{
+			name: "正常系: 閲覧者が基準通貨と宿泊予約、予算、チェックリスト、行動を日付をずらして複製する",
+			ctx:  viewerCtx,
+			in:   input.DuplicateTripInput{TripID: sourceID.String(), Name: "京都旅行 2025", OffsetDays: 365},
+			setup: func() {
+				mockTimeService.EXPECT().Now().Return(now)
+				mockRepo.EXPECT().FindByID(gomock.Any(), sourceID).Return(source, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), sourceID).Return([]*accommodation.Accommodation{sourceAccommodation}, nil)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), sourceID).Return(sourceBudget, nil)
+				mockChecklistRepo.EXPECT().FindByTripID(gomock.Any(), sourceID).Return([]*checklist.Checklist{sourceChecklist}, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), sourceID, nil).Return([]*itinerary.Activity{sourceActivity}, nil)
+				mockRepo.EXPECT().Create(gomock.Any(), trip.NewTrip(newTripID, "京都旅行 2025", period.Shift(365), "JPY", now, now)).Return(nil)
+				mockMemberRepo.EXPECT().Save(gomock.Any(), membership.NewMember(newTripID, testViewerID, membership.RoleOwner, now, now)).Return(nil)
+				mockAccommodationRepo.EXPECT().
+					Create(gomock.Any(), sourceAccommodation.Duplicate(accommodation.NewAccommodationID("generated-id-2"), newTripID, 365, now)).
+					Return(nil)
+				mockBudgetRepo.EXPECT().Save(gomock.Any(), sourceBudget.Duplicate(newTripID, now)).Return(nil)
+				mockChecklistRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *checklist.Checklist) error {
+						assert.Equal(t, checklist.NewChecklistID("generated-id-3"), c.ID())
+						assert.Equal(t, newTripID, c.TripID())
+						assert.Equal(t, sourceChecklist.Name(), c.Name())
+						assert.Equal(t, len(sourceChecklist.Items()), c.Progress().Total())
+						assert.Zero(t, c.Progress().Checked(), "複製したチェックリストは未チェックにすべき")
+						return nil
+					})
+				mockActivityRepo.EXPECT().
+					Create(gomock.Any(), sourceActivity.Duplicate(itinerary.NewActivityID("generated-id-6"), newTripID, 365, now)).
+					Return(nil)
+			},
+			want:        output.NewCreateTripOutput(newTripID),
+			wantActorID: testViewerID,
+			wantResourceTypes: []history.ResourceType{
+				history.ResourceTypeTrip,
+				history.ResourceTypeAccommodation,
+				history.ResourceTypeBudget,
+				history.ResourceTypeChecklist,
+				history.ResourceTypeActivity,
+			},
+		},
+		{
+			name: "正常系: 名前を省略すると元の名前を使い、予算がなければ複製しない",
+			in:   input.DuplicateTripInput{TripID: sourceID.String(), OffsetDays: 7},
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), sourceID, testActorID).Return(sourceOwner, nil)
+				mockTimeService.EXPECT().Now().Return(fixedTime)
+				mockRepo.EXPECT().FindByID(gomock.Any(), sourceID).Return(undecided, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), sourceID).Return(nil, nil)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), sourceID).Return(nil, budget.NewBudgetNotFoundError())
+				mockChecklistRepo.EXPECT().FindByTripID(gomock.Any(), sourceID).Return(nil, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), sourceID, nil).Return(nil, nil)
+				mockRepo.EXPECT().Create(gomock.Any(), trip.NewTrip(newTripID, "未定の旅行", nil, "", fixedTime, fixedTime)).Return(nil)
+				mockMemberRepo.EXPECT().Save(gomock.Any(), membership.NewMember(newTripID, testActorID, membership.RoleOwner, fixedTime, fixedTime)).Return(nil)
+			},
+			want:              output.NewCreateTripOutput(newTripID),
+			wantActorID:       testActorID,
+			wantResourceTypes: []history.ResourceType{history.ResourceTypeTrip},
+		},
+		{
+			name: "異常系: メンバーでない旅行は複製できない",
+			in:   input.DuplicateTripInput{TripID: sourceID.String()},
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), sourceID, testActorID).Return(nil, membership.NewMemberNotFoundError())
+			},
+			wantErr: trip.NewTripNotFoundError(),
+		},
+		{
+			name: "異常系: 作成時にリポジトリから予期しないエラーが返される",
+			in:   input.DuplicateTripInput{TripID: sourceID.String()},
+			setup: func() {
+				mockMemberRepo.EXPECT().FindByTripAndUser(gomock.Any(), sourceID, testActorID).Return(sourceOwner, nil)
+				mockTimeService.EXPECT().Now().Return(fixedTime)
+				mockRepo.EXPECT().FindByID(gomock.Any(), sourceID).Return(undecided, nil)
+				mockAccommodationRepo.EXPECT().FindByTripID(gomock.Any(), sourceID).Return(nil, nil)
+				mockBudgetRepo.EXPECT().FindByTripID(gomock.Any(), sourceID).Return(nil, budget.NewBudgetNotFoundError())
+				mockChecklistRepo.EXPECT().FindByTripID(gomock.Any(), sourceID).Return(nil, nil)
+				mockActivityRepo.EXPECT().FindByTripID(gomock.Any(), sourceID, nil).Return(nil, nil)
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database create error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to create trip", apperr.WithCause(errors.New("database create error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			ids = 0
+			tt.setup()
+
+			ctx := ctxOrActor(tt.ctx)
+			got, err := interactor.Duplicate(ctx, tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			require.Len(t, *revisions, 1, "複製は 1 つのリビジョンとして記録すべき")
+			revision := (*revisions)[0]
+			assert.Equal(t, newTripID, revision.TripID())
+			assert.Equal(t, tt.wantActorID, revision.ActorID(), "複製したユーザーが所有者として記録されるべき")
+			var gotResourceTypes []history.ResourceType
+			for _, change := range revision.Changes() {
+				gotResourceTypes = append(gotResourceTypes, change.ResourceType())
+			}
+			assert.Equal(t, tt.wantResourceTypes, gotResourceTypes)
+		})
+	}
+}
+
+func TestTripInteractor_Create_FromTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock_trip.NewMockTripRepository(ctrl)
+	mockTimeService := mock_service.NewMockTimeService(ctrl)
+	mockIDService := mock_service.NewMockIDService(ctrl)
+	mockMemberRepo := mock_membership.NewMockMemberRepository(ctrl)
+	mockTxManager := mock_transaction_manager.NewMockTransactionManager(ctrl)
+	mockHistoryRepo := mock_history.NewMockHistoryRepository(ctrl)
+	mockAccommodationRepo := mock_accommodation.NewMockAccommodationRepository(ctrl)
+	mockBudgetRepo := mock_budget.NewMockBudgetRepository(ctrl)
+	mockChecklistRepo := mock_checklist.NewMockChecklistRepository(ctrl)
+	mockActivityRepo := mock_itinerary.NewMockActivityRepository(ctrl)
+	mockTemplateRepo := mock_triptemplate.NewMockTemplateRepository(ctrl)
+	runInTxDirectly(mockTxManager)
+	revisions := captureHistory(mockHistoryRepo)
+
+	ids := 0
+	mockIDService.EXPECT().Generate().DoAndReturn(func() string {
+		ids++
+		return fmt.Sprintf("generated-id-%d", ids)
+	}).AnyTimes()
+
+	interactor := NewTripInteractor(mockRepo, mockMemberRepo, mockAccommodationRepo, mockBudgetRepo, mockChecklistRepo, mockActivityRepo, mockTemplateRepo, mockHistoryRepo, mockTxManager, mockTimeService, mockIDService)
+
+	fixedTime := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	templateID := triptemplate.NewTemplateID("template-id")
+	templateIDValue := templateID.String()
+	cost, err := money.NewMoney(32000, "JPY")
+	require.NoError(t, err)
+	days := 3
+	budgetItem := triptemplate.NewBudgetItem("JPY", map[expense.Category]int64{expense.CategoryFood: 30000})
+	content := triptemplate.NewContent(&days, []triptemplate.AccommodationItem{
+		triptemplate.NewAccommodationItem("Hotel", "Kyoto", nil, 15*time.Hour, 58*time.Hour, cost, ""),
+	}, &budgetItem, []triptemplate.ChecklistItem{
+		triptemplate.NewChecklistItem("持ち物", checklist.KindPacking, []triptemplate.ChecklistEntry{
+			triptemplate.NewChecklistEntry("パスポート", 1),
+		}),
+	}, []triptemplate.ActivityItem{
+		triptemplate.NewActivityItem(1, 0, "金閣寺", nil, nil, nil, nil, itinerary.TravelModeWalk, ""),
+	})
+	otherUserID := user.NewUserID("other-user-id")
+	publicTemplate := triptemplate.NewTemplate(templateID, otherUserID, "京都2泊", "", true, content, fixedTime, fixedTime)
+	ownTemplate := triptemplate.NewTemplate(templateID, testActorID, "京都2泊", "", false, content, fixedTime, fixedTime)
+	otherPrivateTemplate := triptemplate.NewTemplate(templateID, otherUserID, "京都2泊", "", false, content, fixedTime, fixedTime)
+	startDate := time.Date(2024, 8, 10, 0, 0, 0, 0, time.UTC)
+	endDate := startDate
+	newTripID := trip.NewTripID("generated-id-1")
+	period, err := trip.NewPeriod(startDate, time.Date(2024, 8, 12, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		in      input.CreateTripInput
+		setup   func()
+		want    *output.CreateTripOutput
+		wantErr error
+	}{
+		{
+			name: "正常系: 公開された雛形から開始日に合わせて旅行を作成する",
+			in:   input.CreateTripInput{StartDate: &startDate, TemplateID: &templateIDValue},
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(publicTemplate, nil)
+				mockTimeService.EXPECT().Now().Return(fixedTime)
+				mockRepo.EXPECT().Create(gomock.Any(), trip.NewTrip(newTripID, "京都2泊", period, "", fixedTime, fixedTime)).Return(nil)
+				mockMemberRepo.EXPECT().Save(gomock.Any(), membership.NewMember(newTripID, testActorID, membership.RoleOwner, fixedTime, fixedTime)).Return(nil)
+				mockAccommodationRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, a *accommodation.Accommodation) error {
+						assert.Equal(t, newTripID, a.TripID())
+						assert.Equal(t, time.Date(2024, 8, 10, 15, 0, 0, 0, time.UTC), a.Stay().CheckInAt())
+						assert.Equal(t, time.Date(2024, 8, 12, 10, 0, 0, 0, time.UTC), a.Stay().CheckOutAt())
+						return nil
+					})
+				mockBudgetRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+				mockChecklistRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *checklist.Checklist) error {
+						assert.Equal(t, newTripID, c.TripID())
+						assert.Equal(t, "持ち物", c.Name())
+						require.Len(t, c.Items(), 1)
+						assert.Equal(t, "パスポート", c.Items()[0].Name())
+						return nil
+					})
+				mockActivityRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, a *itinerary.Activity) error {
+						assert.Equal(t, newTripID, a.TripID())
+						assert.Equal(t, time.Date(2024, 8, 11, 0, 0, 0, 0, time.UTC), a.Date(), "開始日の翌日に作成すべき")
+						assert.Equal(t, "金閣寺", a.Title())
+						return nil
+					})
+			},
+			want: output.NewCreateTripOutput(newTripID),
+		},
+		{
+			name: "異常系: 日付を伴う雛形で開始日を省略するとバリデーションエラーになる",
+			in:   input.CreateTripInput{Name: "旅行", TemplateID: &templateIDValue},
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(ownTemplate, nil)
+			},
+			wantErr: triptemplate.NewStartDateRequiredError(),
+		},
+		{
+			name: "異常系: 期間が短く宿泊予約が収まらない場合はエラーになる",
+			in:   input.CreateTripInput{StartDate: &startDate, EndDate: &endDate, TemplateID: &templateIDValue},
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(ownTemplate, nil)
+				mockTimeService.EXPECT().Now().Return(fixedTime)
+			},
+			wantErr: accommodation.NewOutsideTripPeriodError(),
+		},
+		{
+			name: "異常系: 他人の非公開の雛形は存在しないものとして扱う",
+			in:   input.CreateTripInput{StartDate: &startDate, TemplateID: &templateIDValue},
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(otherPrivateTemplate, nil)
+			},
+			wantErr: triptemplate.NewTemplateNotFoundError(),
+		},
+		{
+			name: "異常系: 雛形の取得時にリポジトリから予期しないエラーが返される",
+			in:   input.CreateTripInput{StartDate: &startDate, TemplateID: &templateIDValue},
+			setup: func() {
+				mockTemplateRepo.EXPECT().FindByID(gomock.Any(), templateID).Return(nil, errors.New("database find error"))
+			},
+			wantErr: apperr.NewInternalError("Failed to get template", apperr.WithCause(errors.New("database find error"))),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*revisions = nil
+			ids = 0
+			tt.setup()
+
+			got, err := interactor.Create(newActorContext(), tt.in)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Nil(t, got)
+				if appErr, ok := tt.wantErr.(*apperr.AppError); ok {
+					gotAppErr, ok := err.(*apperr.AppError)
+					require.True(t, ok, "Expected AppError but got %T", err)
+					assert.Equal(t, appErr.Code(), gotAppErr.Code())
+					assert.Equal(t, appErr.Message(), gotAppErr.Message())
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			require.Len(t, *revisions, 1)
+			assert.Len(t, (*revisions)[0].Changes(), 5, "旅行・宿泊予約・予算・チェックリスト・行動の作成を 1 つのリビジョンにまとめるべき")
+		})
+	}
+}
+
+// newPeriodTestTrip は 2023/8/1〜8/3 の旅行を生成する
+func newPeriodTestTrip(t *testing.T, id trip.TripID) *trip.Trip {
+	t.Helper()
+
+	period, err := trip.NewPeriod(
+		time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 8, 3, 0, 0, 0, 0, time.UTC),
+	)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	return trip.NewTrip(id, "Trip", period, "", createdAt, createdAt)
+}